@@ -16,6 +16,7 @@ import (
 	entcluster "kv-shepherd.io/shepherd/ent/cluster"
 	entinstancesize "kv-shepherd.io/shepherd/ent/instancesize"
 	entnamespaceregistry "kv-shepherd.io/shepherd/ent/namespaceregistry"
+	entrrb "kv-shepherd.io/shepherd/ent/resourcerolebinding"
 	entrole "kv-shepherd.io/shepherd/ent/role"
 	entrolebinding "kv-shepherd.io/shepherd/ent/rolebinding"
 	entservice "kv-shepherd.io/shepherd/ent/service"
@@ -24,13 +25,15 @@ import (
 	entuser "kv-shepherd.io/shepherd/ent/user"
 	entvm "kv-shepherd.io/shepherd/ent/vm"
 	"kv-shepherd.io/shepherd/internal/config"
+	"kv-shepherd.io/shepherd/internal/domain"
 	"kv-shepherd.io/shepherd/internal/infrastructure"
 	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/service"
 )
 
 const (
 	defaultAdminUsername = "e2e-admin"
-	defaultAdminPassword = "e2e-admin-123"
+	defaultAdminPassword = "Shepherd-E2E-Pass-2026"
 	defaultAdminEmail    = "e2e-admin@localhost"
 
 	defaultNamespaceName = "e2e-test"
@@ -42,6 +45,12 @@ const (
 
 	defaultRunningVMID = "vm-e2e-running"
 	defaultStoppedVMID = "vm-e2e-stopped"
+
+	// The long namespace pushes the derived VM name past the DNS-1123 label
+	// limit, so the seeded VM carries a truncated, hash-suffixed name.
+	defaultLongNamespaceName = "e2e-long-namespace-for-vm-name-truncation"
+	defaultTruncatedVMID     = "vm-e2e-truncated"
+	truncatedVMInstanceIndex = 3
 )
 
 type fixtureConfig struct {
@@ -58,6 +67,9 @@ type fixtureConfig struct {
 
 	RunningVMID string
 	StoppedVMID string
+
+	LongNamespaceName string
+	TruncatedVMID     string
 }
 
 func main() {
@@ -95,9 +107,12 @@ func run() error {
 		return fmt.Errorf("ensure admin role binding: %w", err)
 	}
 
-	if err := ensureNamespaceRegistry(ctx, client, fx); err != nil {
+	if err := ensureNamespaceRegistry(ctx, client, fx.NamespaceName); err != nil {
 		return fmt.Errorf("ensure namespace: %w", err)
 	}
+	if err := ensureNamespaceRegistry(ctx, client, fx.LongNamespaceName); err != nil {
+		return fmt.Errorf("ensure long namespace: %w", err)
+	}
 	clusterID, err := ensureCluster(ctx, client, fx)
 	if err != nil {
 		return fmt.Errorf("ensure cluster: %w", err)
@@ -106,6 +121,9 @@ func run() error {
 	if err != nil {
 		return fmt.Errorf("ensure system: %w", err)
 	}
+	if err := ensureSystemMaintainer(ctx, client, adminID, systemID); err != nil {
+		return fmt.Errorf("ensure system maintainer: %w", err)
+	}
 	serviceID, err := ensureService(ctx, client, fx, systemID)
 	if err != nil {
 		return fmt.Errorf("ensure service: %w", err)
@@ -122,6 +140,13 @@ func run() error {
 	if err := ensureVM(ctx, client, fx.StoppedVMID, "vm-stopped", "02", entvm.StatusSTOPPED, fx.NamespaceName, clusterID, serviceID, fx.AdminUsername); err != nil {
 		return fmt.Errorf("ensure stopped vm: %w", err)
 	}
+	truncatedName, truncatedInstance, err := truncatedVMName(fx)
+	if err != nil {
+		return err
+	}
+	if err := ensureVM(ctx, client, fx.TruncatedVMID, truncatedName, truncatedInstance, entvm.StatusSTOPPED, fx.LongNamespaceName, clusterID, serviceID, fx.AdminUsername); err != nil {
+		return fmt.Errorf("ensure truncated vm: %w", err)
+	}
 
 	fmt.Printf("e2e fixtures ready (user=%s namespace=%s system=%s service=%s)\n",
 		fx.AdminUsername, fx.NamespaceName, fx.SystemName, fx.ServiceName,
@@ -142,6 +167,9 @@ func loadFixtureConfig() fixtureConfig {
 		SizeName:      envOrDefault("E2E_SIZE", defaultSizeName),
 		RunningVMID:   envOrDefault("E2E_VM_RUNNING_ID", defaultRunningVMID),
 		StoppedVMID:   envOrDefault("E2E_VM_STOPPED_ID", defaultStoppedVMID),
+
+		LongNamespaceName: envOrDefault("E2E_LONG_NAMESPACE", defaultLongNamespaceName),
+		TruncatedVMID:     envOrDefault("E2E_VM_TRUNCATED_ID", defaultTruncatedVMID),
 	}
 }
 
@@ -154,6 +182,10 @@ func envOrDefault(key, fallback string) string {
 }
 
 func ensureAdminUser(ctx context.Context, client *ent.Client, fx fixtureConfig) (string, error) {
+	// Fail early on a password the admin could not set through the API.
+	if violations := service.DefaultPasswordPolicy().Validate(fx.AdminPassword, fx.AdminUsername, fx.AdminEmail); len(violations) > 0 {
+		return "", fmt.Errorf("admin password violates the password policy: %s", violations[0].Message)
+	}
 	hash, err := bcrypt.GenerateFromPassword([]byte(fx.AdminPassword), bcrypt.DefaultCost)
 	if err != nil {
 		return "", fmt.Errorf("hash password: %w", err)
@@ -226,9 +258,18 @@ func ensureAdminRoleBinding(ctx context.Context, client *ent.Client, userID stri
 	return err
 }
 
-func ensureNamespaceRegistry(ctx context.Context, client *ent.Client, fx fixtureConfig) error {
+// truncatedVMName derives the platform-managed name of the truncated VM fixture.
+func truncatedVMName(fx fixtureConfig) (name, instance string, err error) {
+	name, instance = domain.DeriveVMName(fx.LongNamespaceName, fx.SystemName, fx.ServiceName, truncatedVMInstanceIndex)
+	if err := domain.ValidateVMName(name); err != nil {
+		return "", "", fmt.Errorf("derive truncated vm name: %w", err)
+	}
+	return name, instance, nil
+}
+
+func ensureNamespaceRegistry(ctx context.Context, client *ent.Client, name string) error {
 	ns, err := client.NamespaceRegistry.Query().
-		Where(entnamespaceregistry.NameEQ(fx.NamespaceName)).
+		Where(entnamespaceregistry.NameEQ(name)).
 		Only(ctx)
 	if err != nil {
 		if !ent.IsNotFound(err) {
@@ -237,7 +278,7 @@ func ensureNamespaceRegistry(ctx context.Context, client *ent.Client, fx fixture
 		id, _ := uuid.NewV7()
 		_, createErr := client.NamespaceRegistry.Create().
 			SetID(id.String()).
-			SetName(fx.NamespaceName).
+			SetName(name).
 			SetEnvironment(entnamespaceregistry.EnvironmentTest).
 			SetDescription("e2e namespace").
 			SetCreatedBy("e2e-seed").
@@ -323,6 +364,42 @@ func ensureSystem(ctx context.Context, client *ent.Client, fx fixtureConfig) (st
 	return updated.ID, nil
 }
 
+// ensureSystemMaintainer makes the admin a maintainer of the seeded system.
+// Owner and admin bindings already include maintainer access and are kept.
+func ensureSystemMaintainer(ctx context.Context, client *ent.Client, userID, systemID string) error {
+	binding, err := client.ResourceRoleBinding.Query().
+		Where(
+			entrrb.UserIDEQ(userID),
+			entrrb.ResourceTypeEQ("system"),
+			entrrb.ResourceIDEQ(systemID),
+		).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return err
+		}
+		id, _ := uuid.NewV7()
+		_, err = client.ResourceRoleBinding.Create().
+			SetID(id.String()).
+			SetUserID(userID).
+			SetResourceType("system").
+			SetResourceID(systemID).
+			SetRole(entrrb.RoleMaintainer).
+			SetCreatedBy("e2e-seed").
+			Save(ctx)
+		return err
+	}
+
+	switch binding.Role {
+	case entrrb.RoleOwner, entrrb.RoleAdmin, entrrb.RoleMaintainer:
+		return nil
+	}
+	_, err = client.ResourceRoleBinding.UpdateOneID(binding.ID).
+		SetRole(entrrb.RoleMaintainer).
+		Save(ctx)
+	return err
+}
+
 func ensureService(ctx context.Context, client *ent.Client, fx fixtureConfig, systemID string) (string, error) {
 	obj, err := client.Service.Query().
 		Where(
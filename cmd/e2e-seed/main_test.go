@@ -1,6 +1,11 @@
 package main
 
-import "testing"
+import (
+	"strings"
+	"testing"
+
+	"kv-shepherd.io/shepherd/internal/domain"
+)
 
 func TestEnvOrDefault(t *testing.T) {
 	t.Setenv("E2E_TEST_KEY", "")
@@ -51,3 +56,20 @@ func TestLoadFixtureConfig_Overrides(t *testing.T) {
 		t.Fatalf("RunningVMID = %q, want vm-live-x", cfg.RunningVMID)
 	}
 }
+
+func TestTruncatedVMName_Defaults(t *testing.T) {
+	t.Setenv("E2E_LONG_NAMESPACE", "")
+	t.Setenv("E2E_SYSTEM", "")
+	t.Setenv("E2E_SERVICE", "")
+
+	name, instance, err := truncatedVMName(loadFixtureConfig())
+	if err != nil {
+		t.Fatalf("truncatedVMName error = %v", err)
+	}
+	if len(name) != domain.MaxVMNameLength {
+		t.Fatalf("len(%q) = %d, want the truncated length %d", name, len(name), domain.MaxVMNameLength)
+	}
+	if !strings.HasPrefix(name, "e2e-long-namespace") || !strings.HasSuffix(name, "-03") || instance != "03" {
+		t.Fatalf("truncated name = %q instance = %q, want e2e-long-namespace...-03", name, instance)
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"kv-shepherd.io/shepherd/internal/app"
 	"kv-shepherd.io/shepherd/internal/config"
 	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/pkg/metrics"
 )
 
 func main() {
@@ -77,6 +78,27 @@ func run() error {
 
 	logger.Info("Server started", zap.String("addr", srv.Addr))
 
+	// Metrics are served on a separate internal port so the public ingress
+	// never routes /metrics.
+	var metricsSrv *http.Server
+	metricsErrCh := make(chan error, 1)
+	if cfg.Metrics.Enabled && cfg.Server.MetricsPort > 0 {
+		mux := http.NewServeMux()
+		mux.Handle("GET /metrics", metrics.Handler())
+		metricsSrv = &http.Server{
+			Addr:              fmt.Sprintf(":%d", cfg.Server.MetricsPort),
+			Handler:           mux,
+			ReadHeaderTimeout: cfg.Server.ReadTimeout,
+		}
+		go func() { //nolint:naked-goroutine // main metrics server goroutine is exempt
+			if err := metricsSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				metricsErrCh <- err
+			}
+			close(metricsErrCh)
+		}()
+		logger.Info("Metrics server started", zap.String("addr", metricsSrv.Addr))
+	}
+
 	// Wait for interrupt signal
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -88,6 +110,10 @@ func run() error {
 		if err != nil {
 			return fmt.Errorf("server error: %w", err)
 		}
+	case err := <-metricsErrCh:
+		if err != nil {
+			return fmt.Errorf("metrics server error: %w", err)
+		}
 	}
 
 	// Graceful shutdown with timeout
@@ -95,6 +121,11 @@ func run() error {
 	defer shutdownCancel()
 
 	logger.Info("Shutting down server...")
+	if metricsSrv != nil {
+		if err := metricsSrv.Shutdown(shutdownCtx); err != nil {
+			logger.Warn("metrics server shutdown failed", zap.Error(err))
+		}
+	}
 	if err := srv.Shutdown(shutdownCtx); err != nil {
 		return fmt.Errorf("server shutdown: %w", err)
 	}
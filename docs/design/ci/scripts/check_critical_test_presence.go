@@ -22,6 +22,10 @@ var criticalTargets = []criticalTarget{
 		sourceFile: "internal/jobs/vm_create.go",
 		testFiles:  []string{"internal/jobs/vm_create_test.go"},
 	},
+	{
+		sourceFile: "internal/service/vmspec/vmspec.go",
+		testFiles:  []string{"internal/service/vmspec/vmspec_test.go"},
+	},
 	{
 		sourceFile: "internal/provider/kubevirt.go",
 		testFiles:  []string{"internal/provider/kubevirt_test.go"},
@@ -104,6 +104,7 @@ func checkOpenAPI(violations *[]string) {
 	}{
 		{path: "/admin/templates", op: "get", id: "listAdminTemplates"},
 		{path: "/admin/templates", op: "post", id: "createAdminTemplate"},
+		{path: "/admin/templates/{template_id}", op: "get", id: "getAdminTemplate"},
 		{path: "/admin/templates/{template_id}", op: "patch", id: "updateAdminTemplate"},
 		{path: "/admin/templates/{template_id}", op: "delete", id: "deleteAdminTemplate"},
 		{path: "/admin/instance-sizes", op: "get", id: "listAdminInstanceSizes"},
@@ -34,7 +34,7 @@ var requirements = []behaviorTestRequirement{
 		},
 	},
 	{
-		file: "internal/jobs/vm_create_test.go",
+		file: "internal/service/vmspec/vmspec_test.go",
 		requiredTests: []string{
 			"TestApplyModifiedSpecOverrides",
 			"TestResolveInstanceSizeSpecOverrides",
@@ -14,6 +14,7 @@ import (
 const (
 	openAPIPath                 = "api/openapi.yaml"
 	systemHandlerPath           = "internal/api/handlers/server_system.go"
+	systemDeleteGuardPath       = "internal/api/handlers/server_system_delete.go"
 	vmHandlerPath               = "internal/api/handlers/server_vm.go"
 	deleteUseCaseTestPath       = "internal/usecase/delete_vm_test.go"
 	systemBehaviorTestPath      = "internal/api/handlers/server_system_behavior_test.go"
@@ -30,12 +31,16 @@ func main() {
 	checkFragments(&violations, systemHandlerPath, []string{
 		"func (s *Server) DeleteSystem(",
 		"func (s *Server) DeleteService(",
-		"SYSTEM_HAS_SERVICES",
-		"SERVICE_HAS_VMS",
+		"s.deleteServicesGuarded(",
 		"DELETE_CONFIRMATION_REQUIRED",
 		"confirm_name query parameter must match system name exactly",
 		"confirm=true query parameter is required",
 	})
+	checkFragments(&violations, systemDeleteGuardPath, []string{
+		"FOR UPDATE",
+		"SERVICE_HAS_VMS",
+		"SERVICE_HAS_PENDING_REQUESTS",
+	})
 	checkFragments(&violations, vmHandlerPath, []string{
 		"func (s *Server) DeleteVM(",
 		"input := usecase.DeleteVMInput{",
@@ -49,7 +54,7 @@ func main() {
 	})
 	checkFragments(&violations, systemBehaviorTestPath, []string{
 		"TestSystemHandler_DeleteSystem_RequiresConfirmNameMatch",
-		"TestSystemHandler_DeleteSystem_ConflictWhenServicesExist",
+		"TestSystemHandler_DeleteSystem_ConflictWhenServiceVMsExist",
 		"TestSystemHandler_DeleteSystem_Success",
 		"TestSystemHandler_DeleteService_RequiresConfirmTrue",
 		"TestSystemHandler_DeleteService_ConflictWhenVMsExist",
@@ -13,6 +13,12 @@ const (
 	providerFile = "internal/provider/kubevirt.go"
 )
 
+// specFiles hold the spec assembly shared by the worker and the approval preview.
+var specFiles = []string{
+	"internal/service/vmspec/vmspec.go",
+	"internal/service/vmspec/values.go",
+}
+
 func main() {
 	var violations []string
 
@@ -21,17 +27,29 @@ func main() {
 		fmt.Printf("FAIL: read %s: %v\n", workerFile, err)
 		os.Exit(1)
 	}
-	workerText := string(workerSrc)
+	if !strings.Contains(string(workerSrc), "vmspec.Build(") {
+		violations = append(violations, fmt.Sprintf("%s: missing %q", workerFile, "vmspec.Build("))
+	}
+
+	var specText strings.Builder
+	for _, file := range specFiles {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			fmt.Printf("FAIL: read %s: %v\n", file, err)
+			os.Exit(1)
+		}
+		specText.Write(src)
+	}
 
-	requiredWorkerFragments := []string{
+	requiredSpecFragments := []string{
 		"specOverrides := resolveInstanceSizeSpecOverrides(",
 		"SpecOverrides: specOverrides,",
 		"spec.SpecOverrides = applySpecOverridePatches(",
 		"extractSpecOverridesFromModifiedSpec(",
 	}
-	for _, fragment := range requiredWorkerFragments {
-		if !strings.Contains(workerText, fragment) {
-			violations = append(violations, fmt.Sprintf("%s: missing %q", workerFile, fragment))
+	for _, fragment := range requiredSpecFragments {
+		if !strings.Contains(specText.String(), fragment) {
+			violations = append(violations, fmt.Sprintf("internal/service/vmspec: missing %q", fragment))
 		}
 	}
 
@@ -0,0 +1,159 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"kv-shepherd.io/shepherd/ent/approvaldecision"
+)
+
+// ApprovalDecision is the model entity for the ApprovalDecision schema.
+type ApprovalDecision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// TicketID holds the value of the "ticket_id" field.
+	TicketID string `json:"ticket_id,omitempty"`
+	// Approver holds the value of the "approver" field.
+	Approver string `json:"approver,omitempty"`
+	// Decision holds the value of the "decision" field.
+	Decision approvaldecision.Decision `json:"decision,omitempty"`
+	// SelectedClusterID holds the value of the "selected_cluster_id" field.
+	SelectedClusterID string `json:"selected_cluster_id,omitempty"`
+	// SelectedStorageClass holds the value of the "selected_storage_class" field.
+	SelectedStorageClass string `json:"selected_storage_class,omitempty"`
+	selectValues         sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ApprovalDecision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case approvaldecision.FieldID, approvaldecision.FieldTicketID, approvaldecision.FieldApprover, approvaldecision.FieldDecision, approvaldecision.FieldSelectedClusterID, approvaldecision.FieldSelectedStorageClass:
+			values[i] = new(sql.NullString)
+		case approvaldecision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ApprovalDecision fields.
+func (_m *ApprovalDecision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case approvaldecision.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case approvaldecision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case approvaldecision.FieldTicketID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ticket_id", values[i])
+			} else if value.Valid {
+				_m.TicketID = value.String
+			}
+		case approvaldecision.FieldApprover:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field approver", values[i])
+			} else if value.Valid {
+				_m.Approver = value.String
+			}
+		case approvaldecision.FieldDecision:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field decision", values[i])
+			} else if value.Valid {
+				_m.Decision = approvaldecision.Decision(value.String)
+			}
+		case approvaldecision.FieldSelectedClusterID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field selected_cluster_id", values[i])
+			} else if value.Valid {
+				_m.SelectedClusterID = value.String
+			}
+		case approvaldecision.FieldSelectedStorageClass:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field selected_storage_class", values[i])
+			} else if value.Valid {
+				_m.SelectedStorageClass = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ApprovalDecision.
+// This includes values selected through modifiers, order, etc.
+func (_m *ApprovalDecision) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ApprovalDecision.
+// Note that you need to call ApprovalDecision.Unwrap() before calling this method if this ApprovalDecision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ApprovalDecision) Update() *ApprovalDecisionUpdateOne {
+	return NewApprovalDecisionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ApprovalDecision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ApprovalDecision) Unwrap() *ApprovalDecision {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ApprovalDecision is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ApprovalDecision) String() string {
+	var builder strings.Builder
+	builder.WriteString("ApprovalDecision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("ticket_id=")
+	builder.WriteString(_m.TicketID)
+	builder.WriteString(", ")
+	builder.WriteString("approver=")
+	builder.WriteString(_m.Approver)
+	builder.WriteString(", ")
+	builder.WriteString("decision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Decision))
+	builder.WriteString(", ")
+	builder.WriteString("selected_cluster_id=")
+	builder.WriteString(_m.SelectedClusterID)
+	builder.WriteString(", ")
+	builder.WriteString("selected_storage_class=")
+	builder.WriteString(_m.SelectedStorageClass)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ApprovalDecisions is a parsable slice of ApprovalDecision.
+type ApprovalDecisions []*ApprovalDecision
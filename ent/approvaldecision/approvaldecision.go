@@ -0,0 +1,122 @@
+// Code generated by ent, DO NOT EDIT.
+
+package approvaldecision
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the approvaldecision type in the database.
+	Label = "approval_decision"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldTicketID holds the string denoting the ticket_id field in the database.
+	FieldTicketID = "ticket_id"
+	// FieldApprover holds the string denoting the approver field in the database.
+	FieldApprover = "approver"
+	// FieldDecision holds the string denoting the decision field in the database.
+	FieldDecision = "decision"
+	// FieldSelectedClusterID holds the string denoting the selected_cluster_id field in the database.
+	FieldSelectedClusterID = "selected_cluster_id"
+	// FieldSelectedStorageClass holds the string denoting the selected_storage_class field in the database.
+	FieldSelectedStorageClass = "selected_storage_class"
+	// Table holds the table name of the approvaldecision in the database.
+	Table = "approval_decisions"
+)
+
+// Columns holds all SQL columns for approvaldecision fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldTicketID,
+	FieldApprover,
+	FieldDecision,
+	FieldSelectedClusterID,
+	FieldSelectedStorageClass,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// TicketIDValidator is a validator for the "ticket_id" field. It is called by the builders before save.
+	TicketIDValidator func(string) error
+	// ApproverValidator is a validator for the "approver" field. It is called by the builders before save.
+	ApproverValidator func(string) error
+)
+
+// Decision defines the type for the "decision" enum field.
+type Decision string
+
+// Decision values.
+const (
+	DecisionAPPROVED Decision = "APPROVED"
+	DecisionREJECTED Decision = "REJECTED"
+)
+
+func (d Decision) String() string {
+	return string(d)
+}
+
+// DecisionValidator is a validator for the "decision" field enum values. It is called by the builders before save.
+func DecisionValidator(d Decision) error {
+	switch d {
+	case DecisionAPPROVED, DecisionREJECTED:
+		return nil
+	default:
+		return fmt.Errorf("approvaldecision: invalid enum value for decision field: %q", d)
+	}
+}
+
+// OrderOption defines the ordering options for the ApprovalDecision queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByTicketID orders the results by the ticket_id field.
+func ByTicketID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTicketID, opts...).ToFunc()
+}
+
+// ByApprover orders the results by the approver field.
+func ByApprover(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprover, opts...).ToFunc()
+}
+
+// ByDecision orders the results by the decision field.
+func ByDecision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDecision, opts...).ToFunc()
+}
+
+// BySelectedClusterID orders the results by the selected_cluster_id field.
+func BySelectedClusterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSelectedClusterID, opts...).ToFunc()
+}
+
+// BySelectedStorageClass orders the results by the selected_storage_class field.
+func BySelectedStorageClass(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSelectedStorageClass, opts...).ToFunc()
+}
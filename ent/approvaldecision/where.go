@@ -0,0 +1,445 @@
+// Code generated by ent, DO NOT EDIT.
+
+package approvaldecision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"kv-shepherd.io/shepherd/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TicketID applies equality check predicate on the "ticket_id" field. It's identical to TicketIDEQ.
+func TicketID(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldEQ(FieldTicketID, v))
+}
+
+// Approver applies equality check predicate on the "approver" field. It's identical to ApproverEQ.
+func Approver(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldEQ(FieldApprover, v))
+}
+
+// SelectedClusterID applies equality check predicate on the "selected_cluster_id" field. It's identical to SelectedClusterIDEQ.
+func SelectedClusterID(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldEQ(FieldSelectedClusterID, v))
+}
+
+// SelectedStorageClass applies equality check predicate on the "selected_storage_class" field. It's identical to SelectedStorageClassEQ.
+func SelectedStorageClass(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldEQ(FieldSelectedStorageClass, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// TicketIDEQ applies the EQ predicate on the "ticket_id" field.
+func TicketIDEQ(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldEQ(FieldTicketID, v))
+}
+
+// TicketIDNEQ applies the NEQ predicate on the "ticket_id" field.
+func TicketIDNEQ(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldNEQ(FieldTicketID, v))
+}
+
+// TicketIDIn applies the In predicate on the "ticket_id" field.
+func TicketIDIn(vs ...string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldIn(FieldTicketID, vs...))
+}
+
+// TicketIDNotIn applies the NotIn predicate on the "ticket_id" field.
+func TicketIDNotIn(vs ...string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldNotIn(FieldTicketID, vs...))
+}
+
+// TicketIDGT applies the GT predicate on the "ticket_id" field.
+func TicketIDGT(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldGT(FieldTicketID, v))
+}
+
+// TicketIDGTE applies the GTE predicate on the "ticket_id" field.
+func TicketIDGTE(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldGTE(FieldTicketID, v))
+}
+
+// TicketIDLT applies the LT predicate on the "ticket_id" field.
+func TicketIDLT(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldLT(FieldTicketID, v))
+}
+
+// TicketIDLTE applies the LTE predicate on the "ticket_id" field.
+func TicketIDLTE(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldLTE(FieldTicketID, v))
+}
+
+// TicketIDContains applies the Contains predicate on the "ticket_id" field.
+func TicketIDContains(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldContains(FieldTicketID, v))
+}
+
+// TicketIDHasPrefix applies the HasPrefix predicate on the "ticket_id" field.
+func TicketIDHasPrefix(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldHasPrefix(FieldTicketID, v))
+}
+
+// TicketIDHasSuffix applies the HasSuffix predicate on the "ticket_id" field.
+func TicketIDHasSuffix(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldHasSuffix(FieldTicketID, v))
+}
+
+// TicketIDEqualFold applies the EqualFold predicate on the "ticket_id" field.
+func TicketIDEqualFold(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldEqualFold(FieldTicketID, v))
+}
+
+// TicketIDContainsFold applies the ContainsFold predicate on the "ticket_id" field.
+func TicketIDContainsFold(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldContainsFold(FieldTicketID, v))
+}
+
+// ApproverEQ applies the EQ predicate on the "approver" field.
+func ApproverEQ(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldEQ(FieldApprover, v))
+}
+
+// ApproverNEQ applies the NEQ predicate on the "approver" field.
+func ApproverNEQ(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldNEQ(FieldApprover, v))
+}
+
+// ApproverIn applies the In predicate on the "approver" field.
+func ApproverIn(vs ...string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldIn(FieldApprover, vs...))
+}
+
+// ApproverNotIn applies the NotIn predicate on the "approver" field.
+func ApproverNotIn(vs ...string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldNotIn(FieldApprover, vs...))
+}
+
+// ApproverGT applies the GT predicate on the "approver" field.
+func ApproverGT(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldGT(FieldApprover, v))
+}
+
+// ApproverGTE applies the GTE predicate on the "approver" field.
+func ApproverGTE(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldGTE(FieldApprover, v))
+}
+
+// ApproverLT applies the LT predicate on the "approver" field.
+func ApproverLT(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldLT(FieldApprover, v))
+}
+
+// ApproverLTE applies the LTE predicate on the "approver" field.
+func ApproverLTE(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldLTE(FieldApprover, v))
+}
+
+// ApproverContains applies the Contains predicate on the "approver" field.
+func ApproverContains(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldContains(FieldApprover, v))
+}
+
+// ApproverHasPrefix applies the HasPrefix predicate on the "approver" field.
+func ApproverHasPrefix(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldHasPrefix(FieldApprover, v))
+}
+
+// ApproverHasSuffix applies the HasSuffix predicate on the "approver" field.
+func ApproverHasSuffix(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldHasSuffix(FieldApprover, v))
+}
+
+// ApproverEqualFold applies the EqualFold predicate on the "approver" field.
+func ApproverEqualFold(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldEqualFold(FieldApprover, v))
+}
+
+// ApproverContainsFold applies the ContainsFold predicate on the "approver" field.
+func ApproverContainsFold(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldContainsFold(FieldApprover, v))
+}
+
+// DecisionEQ applies the EQ predicate on the "decision" field.
+func DecisionEQ(v Decision) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldEQ(FieldDecision, v))
+}
+
+// DecisionNEQ applies the NEQ predicate on the "decision" field.
+func DecisionNEQ(v Decision) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldNEQ(FieldDecision, v))
+}
+
+// DecisionIn applies the In predicate on the "decision" field.
+func DecisionIn(vs ...Decision) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldIn(FieldDecision, vs...))
+}
+
+// DecisionNotIn applies the NotIn predicate on the "decision" field.
+func DecisionNotIn(vs ...Decision) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldNotIn(FieldDecision, vs...))
+}
+
+// SelectedClusterIDEQ applies the EQ predicate on the "selected_cluster_id" field.
+func SelectedClusterIDEQ(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldEQ(FieldSelectedClusterID, v))
+}
+
+// SelectedClusterIDNEQ applies the NEQ predicate on the "selected_cluster_id" field.
+func SelectedClusterIDNEQ(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldNEQ(FieldSelectedClusterID, v))
+}
+
+// SelectedClusterIDIn applies the In predicate on the "selected_cluster_id" field.
+func SelectedClusterIDIn(vs ...string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldIn(FieldSelectedClusterID, vs...))
+}
+
+// SelectedClusterIDNotIn applies the NotIn predicate on the "selected_cluster_id" field.
+func SelectedClusterIDNotIn(vs ...string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldNotIn(FieldSelectedClusterID, vs...))
+}
+
+// SelectedClusterIDGT applies the GT predicate on the "selected_cluster_id" field.
+func SelectedClusterIDGT(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldGT(FieldSelectedClusterID, v))
+}
+
+// SelectedClusterIDGTE applies the GTE predicate on the "selected_cluster_id" field.
+func SelectedClusterIDGTE(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldGTE(FieldSelectedClusterID, v))
+}
+
+// SelectedClusterIDLT applies the LT predicate on the "selected_cluster_id" field.
+func SelectedClusterIDLT(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldLT(FieldSelectedClusterID, v))
+}
+
+// SelectedClusterIDLTE applies the LTE predicate on the "selected_cluster_id" field.
+func SelectedClusterIDLTE(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldLTE(FieldSelectedClusterID, v))
+}
+
+// SelectedClusterIDContains applies the Contains predicate on the "selected_cluster_id" field.
+func SelectedClusterIDContains(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldContains(FieldSelectedClusterID, v))
+}
+
+// SelectedClusterIDHasPrefix applies the HasPrefix predicate on the "selected_cluster_id" field.
+func SelectedClusterIDHasPrefix(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldHasPrefix(FieldSelectedClusterID, v))
+}
+
+// SelectedClusterIDHasSuffix applies the HasSuffix predicate on the "selected_cluster_id" field.
+func SelectedClusterIDHasSuffix(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldHasSuffix(FieldSelectedClusterID, v))
+}
+
+// SelectedClusterIDIsNil applies the IsNil predicate on the "selected_cluster_id" field.
+func SelectedClusterIDIsNil() predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldIsNull(FieldSelectedClusterID))
+}
+
+// SelectedClusterIDNotNil applies the NotNil predicate on the "selected_cluster_id" field.
+func SelectedClusterIDNotNil() predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldNotNull(FieldSelectedClusterID))
+}
+
+// SelectedClusterIDEqualFold applies the EqualFold predicate on the "selected_cluster_id" field.
+func SelectedClusterIDEqualFold(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldEqualFold(FieldSelectedClusterID, v))
+}
+
+// SelectedClusterIDContainsFold applies the ContainsFold predicate on the "selected_cluster_id" field.
+func SelectedClusterIDContainsFold(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldContainsFold(FieldSelectedClusterID, v))
+}
+
+// SelectedStorageClassEQ applies the EQ predicate on the "selected_storage_class" field.
+func SelectedStorageClassEQ(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldEQ(FieldSelectedStorageClass, v))
+}
+
+// SelectedStorageClassNEQ applies the NEQ predicate on the "selected_storage_class" field.
+func SelectedStorageClassNEQ(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldNEQ(FieldSelectedStorageClass, v))
+}
+
+// SelectedStorageClassIn applies the In predicate on the "selected_storage_class" field.
+func SelectedStorageClassIn(vs ...string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldIn(FieldSelectedStorageClass, vs...))
+}
+
+// SelectedStorageClassNotIn applies the NotIn predicate on the "selected_storage_class" field.
+func SelectedStorageClassNotIn(vs ...string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldNotIn(FieldSelectedStorageClass, vs...))
+}
+
+// SelectedStorageClassGT applies the GT predicate on the "selected_storage_class" field.
+func SelectedStorageClassGT(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldGT(FieldSelectedStorageClass, v))
+}
+
+// SelectedStorageClassGTE applies the GTE predicate on the "selected_storage_class" field.
+func SelectedStorageClassGTE(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldGTE(FieldSelectedStorageClass, v))
+}
+
+// SelectedStorageClassLT applies the LT predicate on the "selected_storage_class" field.
+func SelectedStorageClassLT(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldLT(FieldSelectedStorageClass, v))
+}
+
+// SelectedStorageClassLTE applies the LTE predicate on the "selected_storage_class" field.
+func SelectedStorageClassLTE(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldLTE(FieldSelectedStorageClass, v))
+}
+
+// SelectedStorageClassContains applies the Contains predicate on the "selected_storage_class" field.
+func SelectedStorageClassContains(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldContains(FieldSelectedStorageClass, v))
+}
+
+// SelectedStorageClassHasPrefix applies the HasPrefix predicate on the "selected_storage_class" field.
+func SelectedStorageClassHasPrefix(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldHasPrefix(FieldSelectedStorageClass, v))
+}
+
+// SelectedStorageClassHasSuffix applies the HasSuffix predicate on the "selected_storage_class" field.
+func SelectedStorageClassHasSuffix(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldHasSuffix(FieldSelectedStorageClass, v))
+}
+
+// SelectedStorageClassIsNil applies the IsNil predicate on the "selected_storage_class" field.
+func SelectedStorageClassIsNil() predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldIsNull(FieldSelectedStorageClass))
+}
+
+// SelectedStorageClassNotNil applies the NotNil predicate on the "selected_storage_class" field.
+func SelectedStorageClassNotNil() predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldNotNull(FieldSelectedStorageClass))
+}
+
+// SelectedStorageClassEqualFold applies the EqualFold predicate on the "selected_storage_class" field.
+func SelectedStorageClassEqualFold(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldEqualFold(FieldSelectedStorageClass, v))
+}
+
+// SelectedStorageClassContainsFold applies the ContainsFold predicate on the "selected_storage_class" field.
+func SelectedStorageClassContainsFold(v string) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.FieldContainsFold(FieldSelectedStorageClass, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ApprovalDecision) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ApprovalDecision) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ApprovalDecision) predicate.ApprovalDecision {
+	return predicate.ApprovalDecision(sql.NotPredicates(p))
+}
@@ -0,0 +1,303 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"kv-shepherd.io/shepherd/ent/approvaldecision"
+)
+
+// ApprovalDecisionCreate is the builder for creating a ApprovalDecision entity.
+type ApprovalDecisionCreate struct {
+	config
+	mutation *ApprovalDecisionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ApprovalDecisionCreate) SetCreatedAt(v time.Time) *ApprovalDecisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ApprovalDecisionCreate) SetNillableCreatedAt(v *time.Time) *ApprovalDecisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetTicketID sets the "ticket_id" field.
+func (_c *ApprovalDecisionCreate) SetTicketID(v string) *ApprovalDecisionCreate {
+	_c.mutation.SetTicketID(v)
+	return _c
+}
+
+// SetApprover sets the "approver" field.
+func (_c *ApprovalDecisionCreate) SetApprover(v string) *ApprovalDecisionCreate {
+	_c.mutation.SetApprover(v)
+	return _c
+}
+
+// SetDecision sets the "decision" field.
+func (_c *ApprovalDecisionCreate) SetDecision(v approvaldecision.Decision) *ApprovalDecisionCreate {
+	_c.mutation.SetDecision(v)
+	return _c
+}
+
+// SetSelectedClusterID sets the "selected_cluster_id" field.
+func (_c *ApprovalDecisionCreate) SetSelectedClusterID(v string) *ApprovalDecisionCreate {
+	_c.mutation.SetSelectedClusterID(v)
+	return _c
+}
+
+// SetNillableSelectedClusterID sets the "selected_cluster_id" field if the given value is not nil.
+func (_c *ApprovalDecisionCreate) SetNillableSelectedClusterID(v *string) *ApprovalDecisionCreate {
+	if v != nil {
+		_c.SetSelectedClusterID(*v)
+	}
+	return _c
+}
+
+// SetSelectedStorageClass sets the "selected_storage_class" field.
+func (_c *ApprovalDecisionCreate) SetSelectedStorageClass(v string) *ApprovalDecisionCreate {
+	_c.mutation.SetSelectedStorageClass(v)
+	return _c
+}
+
+// SetNillableSelectedStorageClass sets the "selected_storage_class" field if the given value is not nil.
+func (_c *ApprovalDecisionCreate) SetNillableSelectedStorageClass(v *string) *ApprovalDecisionCreate {
+	if v != nil {
+		_c.SetSelectedStorageClass(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ApprovalDecisionCreate) SetID(v string) *ApprovalDecisionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ApprovalDecisionMutation object of the builder.
+func (_c *ApprovalDecisionCreate) Mutation() *ApprovalDecisionMutation {
+	return _c.mutation
+}
+
+// Save creates the ApprovalDecision in the database.
+func (_c *ApprovalDecisionCreate) Save(ctx context.Context) (*ApprovalDecision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ApprovalDecisionCreate) SaveX(ctx context.Context) *ApprovalDecision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ApprovalDecisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ApprovalDecisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ApprovalDecisionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := approvaldecision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ApprovalDecisionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ApprovalDecision.created_at"`)}
+	}
+	if _, ok := _c.mutation.TicketID(); !ok {
+		return &ValidationError{Name: "ticket_id", err: errors.New(`ent: missing required field "ApprovalDecision.ticket_id"`)}
+	}
+	if v, ok := _c.mutation.TicketID(); ok {
+		if err := approvaldecision.TicketIDValidator(v); err != nil {
+			return &ValidationError{Name: "ticket_id", err: fmt.Errorf(`ent: validator failed for field "ApprovalDecision.ticket_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Approver(); !ok {
+		return &ValidationError{Name: "approver", err: errors.New(`ent: missing required field "ApprovalDecision.approver"`)}
+	}
+	if v, ok := _c.mutation.Approver(); ok {
+		if err := approvaldecision.ApproverValidator(v); err != nil {
+			return &ValidationError{Name: "approver", err: fmt.Errorf(`ent: validator failed for field "ApprovalDecision.approver": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Decision(); !ok {
+		return &ValidationError{Name: "decision", err: errors.New(`ent: missing required field "ApprovalDecision.decision"`)}
+	}
+	if v, ok := _c.mutation.Decision(); ok {
+		if err := approvaldecision.DecisionValidator(v); err != nil {
+			return &ValidationError{Name: "decision", err: fmt.Errorf(`ent: validator failed for field "ApprovalDecision.decision": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ApprovalDecisionCreate) sqlSave(ctx context.Context) (*ApprovalDecision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ApprovalDecision.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ApprovalDecisionCreate) createSpec() (*ApprovalDecision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ApprovalDecision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(approvaldecision.Table, sqlgraph.NewFieldSpec(approvaldecision.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(approvaldecision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.TicketID(); ok {
+		_spec.SetField(approvaldecision.FieldTicketID, field.TypeString, value)
+		_node.TicketID = value
+	}
+	if value, ok := _c.mutation.Approver(); ok {
+		_spec.SetField(approvaldecision.FieldApprover, field.TypeString, value)
+		_node.Approver = value
+	}
+	if value, ok := _c.mutation.Decision(); ok {
+		_spec.SetField(approvaldecision.FieldDecision, field.TypeEnum, value)
+		_node.Decision = value
+	}
+	if value, ok := _c.mutation.SelectedClusterID(); ok {
+		_spec.SetField(approvaldecision.FieldSelectedClusterID, field.TypeString, value)
+		_node.SelectedClusterID = value
+	}
+	if value, ok := _c.mutation.SelectedStorageClass(); ok {
+		_spec.SetField(approvaldecision.FieldSelectedStorageClass, field.TypeString, value)
+		_node.SelectedStorageClass = value
+	}
+	return _node, _spec
+}
+
+// ApprovalDecisionCreateBulk is the builder for creating many ApprovalDecision entities in bulk.
+type ApprovalDecisionCreateBulk struct {
+	config
+	err      error
+	builders []*ApprovalDecisionCreate
+}
+
+// Save creates the ApprovalDecision entities in the database.
+func (_c *ApprovalDecisionCreateBulk) Save(ctx context.Context) ([]*ApprovalDecision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ApprovalDecision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ApprovalDecisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ApprovalDecisionCreateBulk) SaveX(ctx context.Context) []*ApprovalDecision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ApprovalDecisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ApprovalDecisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
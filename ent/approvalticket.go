@@ -36,6 +36,10 @@ type ApprovalTicket struct {
 	Reason string `json:"reason,omitempty"`
 	// RejectReason holds the value of the "reject_reason" field.
 	RejectReason string `json:"reject_reason,omitempty"`
+	// ApprovalComment holds the value of the "approval_comment" field.
+	ApprovalComment string `json:"approval_comment,omitempty"`
+	// AssignedApprover holds the value of the "assigned_approver" field.
+	AssignedApprover string `json:"assigned_approver,omitempty"`
 	// SelectedClusterID holds the value of the "selected_cluster_id" field.
 	SelectedClusterID string `json:"selected_cluster_id,omitempty"`
 	// SelectedTemplateVersion holds the value of the "selected_template_version" field.
@@ -50,7 +54,19 @@ type ApprovalTicket struct {
 	ModifiedSpec map[string]interface{} `json:"modified_spec,omitempty"`
 	// ParentTicketID holds the value of the "parent_ticket_id" field.
 	ParentTicketID string `json:"parent_ticket_id,omitempty"`
-	selectValues   sql.SelectValues
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// RequiredApprovals holds the value of the "required_approvals" field.
+	RequiredApprovals int `json:"required_approvals,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// AttemptCount holds the value of the "attempt_count" field.
+	AttemptCount int `json:"attempt_count,omitempty"`
+	// RequestID holds the value of the "request_id" field.
+	RequestID    *string `json:"request_id,omitempty"`
+	selectValues sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -60,11 +76,11 @@ func (*ApprovalTicket) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case approvalticket.FieldTemplateSnapshot, approvalticket.FieldInstanceSizeSnapshot, approvalticket.FieldModifiedSpec:
 			values[i] = new([]byte)
-		case approvalticket.FieldSelectedTemplateVersion:
+		case approvalticket.FieldSelectedTemplateVersion, approvalticket.FieldRequiredApprovals, approvalticket.FieldAttemptCount:
 			values[i] = new(sql.NullInt64)
-		case approvalticket.FieldID, approvalticket.FieldEventID, approvalticket.FieldOperationType, approvalticket.FieldStatus, approvalticket.FieldRequester, approvalticket.FieldApprover, approvalticket.FieldReason, approvalticket.FieldRejectReason, approvalticket.FieldSelectedClusterID, approvalticket.FieldSelectedStorageClass, approvalticket.FieldParentTicketID:
+		case approvalticket.FieldID, approvalticket.FieldEventID, approvalticket.FieldOperationType, approvalticket.FieldStatus, approvalticket.FieldRequester, approvalticket.FieldApprover, approvalticket.FieldReason, approvalticket.FieldRejectReason, approvalticket.FieldApprovalComment, approvalticket.FieldAssignedApprover, approvalticket.FieldSelectedClusterID, approvalticket.FieldSelectedStorageClass, approvalticket.FieldParentTicketID, approvalticket.FieldRequestID:
 			values[i] = new(sql.NullString)
-		case approvalticket.FieldCreatedAt, approvalticket.FieldUpdatedAt:
+		case approvalticket.FieldCreatedAt, approvalticket.FieldUpdatedAt, approvalticket.FieldExpiresAt, approvalticket.FieldStartedAt, approvalticket.FieldFinishedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -141,6 +157,18 @@ func (_m *ApprovalTicket) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.RejectReason = value.String
 			}
+		case approvalticket.FieldApprovalComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field approval_comment", values[i])
+			} else if value.Valid {
+				_m.ApprovalComment = value.String
+			}
+		case approvalticket.FieldAssignedApprover:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field assigned_approver", values[i])
+			} else if value.Valid {
+				_m.AssignedApprover = value.String
+			}
 		case approvalticket.FieldSelectedClusterID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field selected_cluster_id", values[i])
@@ -189,6 +217,46 @@ func (_m *ApprovalTicket) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ParentTicketID = value.String
 			}
+		case approvalticket.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case approvalticket.FieldRequiredApprovals:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field required_approvals", values[i])
+			} else if value.Valid {
+				_m.RequiredApprovals = int(value.Int64)
+			}
+		case approvalticket.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = new(time.Time)
+				*_m.StartedAt = value.Time
+			}
+		case approvalticket.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = new(time.Time)
+				*_m.FinishedAt = value.Time
+			}
+		case approvalticket.FieldAttemptCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt_count", values[i])
+			} else if value.Valid {
+				_m.AttemptCount = int(value.Int64)
+			}
+		case approvalticket.FieldRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_id", values[i])
+			} else if value.Valid {
+				_m.RequestID = new(string)
+				*_m.RequestID = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -252,6 +320,12 @@ func (_m *ApprovalTicket) String() string {
 	builder.WriteString("reject_reason=")
 	builder.WriteString(_m.RejectReason)
 	builder.WriteString(", ")
+	builder.WriteString("approval_comment=")
+	builder.WriteString(_m.ApprovalComment)
+	builder.WriteString(", ")
+	builder.WriteString("assigned_approver=")
+	builder.WriteString(_m.AssignedApprover)
+	builder.WriteString(", ")
 	builder.WriteString("selected_cluster_id=")
 	builder.WriteString(_m.SelectedClusterID)
 	builder.WriteString(", ")
@@ -272,6 +346,32 @@ func (_m *ApprovalTicket) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("parent_ticket_id=")
 	builder.WriteString(_m.ParentTicketID)
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("required_approvals=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequiredApprovals))
+	builder.WriteString(", ")
+	if v := _m.StartedAt; v != nil {
+		builder.WriteString("started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("attempt_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttemptCount))
+	builder.WriteString(", ")
+	if v := _m.RequestID; v != nil {
+		builder.WriteString("request_id=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
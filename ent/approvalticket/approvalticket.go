@@ -32,6 +32,10 @@ const (
 	FieldReason = "reason"
 	// FieldRejectReason holds the string denoting the reject_reason field in the database.
 	FieldRejectReason = "reject_reason"
+	// FieldApprovalComment holds the string denoting the approval_comment field in the database.
+	FieldApprovalComment = "approval_comment"
+	// FieldAssignedApprover holds the string denoting the assigned_approver field in the database.
+	FieldAssignedApprover = "assigned_approver"
 	// FieldSelectedClusterID holds the string denoting the selected_cluster_id field in the database.
 	FieldSelectedClusterID = "selected_cluster_id"
 	// FieldSelectedTemplateVersion holds the string denoting the selected_template_version field in the database.
@@ -46,6 +50,18 @@ const (
 	FieldModifiedSpec = "modified_spec"
 	// FieldParentTicketID holds the string denoting the parent_ticket_id field in the database.
 	FieldParentTicketID = "parent_ticket_id"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldRequiredApprovals holds the string denoting the required_approvals field in the database.
+	FieldRequiredApprovals = "required_approvals"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// FieldAttemptCount holds the string denoting the attempt_count field in the database.
+	FieldAttemptCount = "attempt_count"
+	// FieldRequestID holds the string denoting the request_id field in the database.
+	FieldRequestID = "request_id"
 	// Table holds the table name of the approvalticket in the database.
 	Table = "approval_tickets"
 )
@@ -62,6 +78,8 @@ var Columns = []string{
 	FieldApprover,
 	FieldReason,
 	FieldRejectReason,
+	FieldApprovalComment,
+	FieldAssignedApprover,
 	FieldSelectedClusterID,
 	FieldSelectedTemplateVersion,
 	FieldSelectedStorageClass,
@@ -69,6 +87,12 @@ var Columns = []string{
 	FieldInstanceSizeSnapshot,
 	FieldModifiedSpec,
 	FieldParentTicketID,
+	FieldExpiresAt,
+	FieldRequiredApprovals,
+	FieldStartedAt,
+	FieldFinishedAt,
+	FieldAttemptCount,
+	FieldRequestID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -92,6 +116,18 @@ var (
 	EventIDValidator func(string) error
 	// RequesterValidator is a validator for the "requester" field. It is called by the builders before save.
 	RequesterValidator func(string) error
+	// ApprovalCommentValidator is a validator for the "approval_comment" field. It is called by the builders before save.
+	ApprovalCommentValidator func(string) error
+	// DefaultRequiredApprovals holds the default value on creation for the "required_approvals" field.
+	DefaultRequiredApprovals int
+	// RequiredApprovalsValidator is a validator for the "required_approvals" field. It is called by the builders before save.
+	RequiredApprovalsValidator func(int) error
+	// DefaultAttemptCount holds the default value on creation for the "attempt_count" field.
+	DefaultAttemptCount int
+	// AttemptCountValidator is a validator for the "attempt_count" field. It is called by the builders before save.
+	AttemptCountValidator func(int) error
+	// RequestIDValidator is a validator for the "request_id" field. It is called by the builders before save.
+	RequestIDValidator func(string) error
 )
 
 // OperationType defines the type for the "operation_type" enum field.
@@ -102,9 +138,13 @@ const DefaultOperationType = OperationTypeCREATE
 
 // OperationType values.
 const (
-	OperationTypeCREATE     OperationType = "CREATE"
-	OperationTypeDELETE     OperationType = "DELETE"
-	OperationTypeVNC_ACCESS OperationType = "VNC_ACCESS"
+	OperationTypeCREATE            OperationType = "CREATE"
+	OperationTypeDELETE            OperationType = "DELETE"
+	OperationTypeVNC_ACCESS        OperationType = "VNC_ACCESS"
+	OperationTypeMIGRATE           OperationType = "MIGRATE"
+	OperationTypeRESIZE            OperationType = "RESIZE"
+	OperationTypeSNAPSHOT          OperationType = "SNAPSHOT"
+	OperationTypeNAMESPACE_MIGRATE OperationType = "NAMESPACE_MIGRATE"
 )
 
 func (ot OperationType) String() string {
@@ -114,7 +154,7 @@ func (ot OperationType) String() string {
 // OperationTypeValidator is a validator for the "operation_type" field enum values. It is called by the builders before save.
 func OperationTypeValidator(ot OperationType) error {
 	switch ot {
-	case OperationTypeCREATE, OperationTypeDELETE, OperationTypeVNC_ACCESS:
+	case OperationTypeCREATE, OperationTypeDELETE, OperationTypeVNC_ACCESS, OperationTypeMIGRATE, OperationTypeRESIZE, OperationTypeSNAPSHOT, OperationTypeNAMESPACE_MIGRATE:
 		return nil
 	default:
 		return fmt.Errorf("approvalticket: invalid enum value for operation_type field: %q", ot)
@@ -136,6 +176,7 @@ const (
 	StatusEXECUTING Status = "EXECUTING"
 	StatusSUCCESS   Status = "SUCCESS"
 	StatusFAILED    Status = "FAILED"
+	StatusEXPIRED   Status = "EXPIRED"
 )
 
 func (s Status) String() string {
@@ -145,7 +186,7 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusPENDING, StatusAPPROVED, StatusREJECTED, StatusCANCELLED, StatusEXECUTING, StatusSUCCESS, StatusFAILED:
+	case StatusPENDING, StatusAPPROVED, StatusREJECTED, StatusCANCELLED, StatusEXECUTING, StatusSUCCESS, StatusFAILED, StatusEXPIRED:
 		return nil
 	default:
 		return fmt.Errorf("approvalticket: invalid enum value for status field: %q", s)
@@ -205,6 +246,16 @@ func ByRejectReason(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRejectReason, opts...).ToFunc()
 }
 
+// ByApprovalComment orders the results by the approval_comment field.
+func ByApprovalComment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovalComment, opts...).ToFunc()
+}
+
+// ByAssignedApprover orders the results by the assigned_approver field.
+func ByAssignedApprover(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssignedApprover, opts...).ToFunc()
+}
+
 // BySelectedClusterID orders the results by the selected_cluster_id field.
 func BySelectedClusterID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSelectedClusterID, opts...).ToFunc()
@@ -224,3 +275,33 @@ func BySelectedStorageClass(opts ...sql.OrderTermOption) OrderOption {
 func ByParentTicketID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldParentTicketID, opts...).ToFunc()
 }
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByRequiredApprovals orders the results by the required_approvals field.
+func ByRequiredApprovals(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequiredApprovals, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
+
+// ByAttemptCount orders the results by the attempt_count field.
+func ByAttemptCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttemptCount, opts...).ToFunc()
+}
+
+// ByRequestID orders the results by the request_id field.
+func ByRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestID, opts...).ToFunc()
+}
@@ -99,6 +99,16 @@ func RejectReason(v string) predicate.ApprovalTicket {
 	return predicate.ApprovalTicket(sql.FieldEQ(FieldRejectReason, v))
 }
 
+// ApprovalComment applies equality check predicate on the "approval_comment" field. It's identical to ApprovalCommentEQ.
+func ApprovalComment(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldEQ(FieldApprovalComment, v))
+}
+
+// AssignedApprover applies equality check predicate on the "assigned_approver" field. It's identical to AssignedApproverEQ.
+func AssignedApprover(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldEQ(FieldAssignedApprover, v))
+}
+
 // SelectedClusterID applies equality check predicate on the "selected_cluster_id" field. It's identical to SelectedClusterIDEQ.
 func SelectedClusterID(v string) predicate.ApprovalTicket {
 	return predicate.ApprovalTicket(sql.FieldEQ(FieldSelectedClusterID, v))
@@ -119,6 +129,36 @@ func ParentTicketID(v string) predicate.ApprovalTicket {
 	return predicate.ApprovalTicket(sql.FieldEQ(FieldParentTicketID, v))
 }
 
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// RequiredApprovals applies equality check predicate on the "required_approvals" field. It's identical to RequiredApprovalsEQ.
+func RequiredApprovals(v int) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldEQ(FieldRequiredApprovals, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// AttemptCount applies equality check predicate on the "attempt_count" field. It's identical to AttemptCountEQ.
+func AttemptCount(v int) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldEQ(FieldAttemptCount, v))
+}
+
+// RequestID applies equality check predicate on the "request_id" field. It's identical to RequestIDEQ.
+func RequestID(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldEQ(FieldRequestID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.ApprovalTicket {
 	return predicate.ApprovalTicket(sql.FieldEQ(FieldCreatedAt, v))
@@ -594,6 +634,156 @@ func RejectReasonContainsFold(v string) predicate.ApprovalTicket {
 	return predicate.ApprovalTicket(sql.FieldContainsFold(FieldRejectReason, v))
 }
 
+// ApprovalCommentEQ applies the EQ predicate on the "approval_comment" field.
+func ApprovalCommentEQ(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldEQ(FieldApprovalComment, v))
+}
+
+// ApprovalCommentNEQ applies the NEQ predicate on the "approval_comment" field.
+func ApprovalCommentNEQ(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldNEQ(FieldApprovalComment, v))
+}
+
+// ApprovalCommentIn applies the In predicate on the "approval_comment" field.
+func ApprovalCommentIn(vs ...string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldIn(FieldApprovalComment, vs...))
+}
+
+// ApprovalCommentNotIn applies the NotIn predicate on the "approval_comment" field.
+func ApprovalCommentNotIn(vs ...string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldNotIn(FieldApprovalComment, vs...))
+}
+
+// ApprovalCommentGT applies the GT predicate on the "approval_comment" field.
+func ApprovalCommentGT(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldGT(FieldApprovalComment, v))
+}
+
+// ApprovalCommentGTE applies the GTE predicate on the "approval_comment" field.
+func ApprovalCommentGTE(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldGTE(FieldApprovalComment, v))
+}
+
+// ApprovalCommentLT applies the LT predicate on the "approval_comment" field.
+func ApprovalCommentLT(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldLT(FieldApprovalComment, v))
+}
+
+// ApprovalCommentLTE applies the LTE predicate on the "approval_comment" field.
+func ApprovalCommentLTE(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldLTE(FieldApprovalComment, v))
+}
+
+// ApprovalCommentContains applies the Contains predicate on the "approval_comment" field.
+func ApprovalCommentContains(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldContains(FieldApprovalComment, v))
+}
+
+// ApprovalCommentHasPrefix applies the HasPrefix predicate on the "approval_comment" field.
+func ApprovalCommentHasPrefix(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldHasPrefix(FieldApprovalComment, v))
+}
+
+// ApprovalCommentHasSuffix applies the HasSuffix predicate on the "approval_comment" field.
+func ApprovalCommentHasSuffix(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldHasSuffix(FieldApprovalComment, v))
+}
+
+// ApprovalCommentIsNil applies the IsNil predicate on the "approval_comment" field.
+func ApprovalCommentIsNil() predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldIsNull(FieldApprovalComment))
+}
+
+// ApprovalCommentNotNil applies the NotNil predicate on the "approval_comment" field.
+func ApprovalCommentNotNil() predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldNotNull(FieldApprovalComment))
+}
+
+// ApprovalCommentEqualFold applies the EqualFold predicate on the "approval_comment" field.
+func ApprovalCommentEqualFold(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldEqualFold(FieldApprovalComment, v))
+}
+
+// ApprovalCommentContainsFold applies the ContainsFold predicate on the "approval_comment" field.
+func ApprovalCommentContainsFold(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldContainsFold(FieldApprovalComment, v))
+}
+
+// AssignedApproverEQ applies the EQ predicate on the "assigned_approver" field.
+func AssignedApproverEQ(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldEQ(FieldAssignedApprover, v))
+}
+
+// AssignedApproverNEQ applies the NEQ predicate on the "assigned_approver" field.
+func AssignedApproverNEQ(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldNEQ(FieldAssignedApprover, v))
+}
+
+// AssignedApproverIn applies the In predicate on the "assigned_approver" field.
+func AssignedApproverIn(vs ...string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldIn(FieldAssignedApprover, vs...))
+}
+
+// AssignedApproverNotIn applies the NotIn predicate on the "assigned_approver" field.
+func AssignedApproverNotIn(vs ...string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldNotIn(FieldAssignedApprover, vs...))
+}
+
+// AssignedApproverGT applies the GT predicate on the "assigned_approver" field.
+func AssignedApproverGT(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldGT(FieldAssignedApprover, v))
+}
+
+// AssignedApproverGTE applies the GTE predicate on the "assigned_approver" field.
+func AssignedApproverGTE(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldGTE(FieldAssignedApprover, v))
+}
+
+// AssignedApproverLT applies the LT predicate on the "assigned_approver" field.
+func AssignedApproverLT(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldLT(FieldAssignedApprover, v))
+}
+
+// AssignedApproverLTE applies the LTE predicate on the "assigned_approver" field.
+func AssignedApproverLTE(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldLTE(FieldAssignedApprover, v))
+}
+
+// AssignedApproverContains applies the Contains predicate on the "assigned_approver" field.
+func AssignedApproverContains(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldContains(FieldAssignedApprover, v))
+}
+
+// AssignedApproverHasPrefix applies the HasPrefix predicate on the "assigned_approver" field.
+func AssignedApproverHasPrefix(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldHasPrefix(FieldAssignedApprover, v))
+}
+
+// AssignedApproverHasSuffix applies the HasSuffix predicate on the "assigned_approver" field.
+func AssignedApproverHasSuffix(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldHasSuffix(FieldAssignedApprover, v))
+}
+
+// AssignedApproverIsNil applies the IsNil predicate on the "assigned_approver" field.
+func AssignedApproverIsNil() predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldIsNull(FieldAssignedApprover))
+}
+
+// AssignedApproverNotNil applies the NotNil predicate on the "assigned_approver" field.
+func AssignedApproverNotNil() predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldNotNull(FieldAssignedApprover))
+}
+
+// AssignedApproverEqualFold applies the EqualFold predicate on the "assigned_approver" field.
+func AssignedApproverEqualFold(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldEqualFold(FieldAssignedApprover, v))
+}
+
+// AssignedApproverContainsFold applies the ContainsFold predicate on the "assigned_approver" field.
+func AssignedApproverContainsFold(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldContainsFold(FieldAssignedApprover, v))
+}
+
 // SelectedClusterIDEQ applies the EQ predicate on the "selected_cluster_id" field.
 func SelectedClusterIDEQ(v string) predicate.ApprovalTicket {
 	return predicate.ApprovalTicket(sql.FieldEQ(FieldSelectedClusterID, v))
@@ -899,6 +1089,311 @@ func ParentTicketIDContainsFold(v string) predicate.ApprovalTicket {
 	return predicate.ApprovalTicket(sql.FieldContainsFold(FieldParentTicketID, v))
 }
 
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// RequiredApprovalsEQ applies the EQ predicate on the "required_approvals" field.
+func RequiredApprovalsEQ(v int) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldEQ(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsNEQ applies the NEQ predicate on the "required_approvals" field.
+func RequiredApprovalsNEQ(v int) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldNEQ(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsIn applies the In predicate on the "required_approvals" field.
+func RequiredApprovalsIn(vs ...int) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldIn(FieldRequiredApprovals, vs...))
+}
+
+// RequiredApprovalsNotIn applies the NotIn predicate on the "required_approvals" field.
+func RequiredApprovalsNotIn(vs ...int) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldNotIn(FieldRequiredApprovals, vs...))
+}
+
+// RequiredApprovalsGT applies the GT predicate on the "required_approvals" field.
+func RequiredApprovalsGT(v int) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldGT(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsGTE applies the GTE predicate on the "required_approvals" field.
+func RequiredApprovalsGTE(v int) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldGTE(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsLT applies the LT predicate on the "required_approvals" field.
+func RequiredApprovalsLT(v int) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldLT(FieldRequiredApprovals, v))
+}
+
+// RequiredApprovalsLTE applies the LTE predicate on the "required_approvals" field.
+func RequiredApprovalsLTE(v int) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldLTE(FieldRequiredApprovals, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldNotNull(FieldStartedAt))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// AttemptCountEQ applies the EQ predicate on the "attempt_count" field.
+func AttemptCountEQ(v int) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldEQ(FieldAttemptCount, v))
+}
+
+// AttemptCountNEQ applies the NEQ predicate on the "attempt_count" field.
+func AttemptCountNEQ(v int) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldNEQ(FieldAttemptCount, v))
+}
+
+// AttemptCountIn applies the In predicate on the "attempt_count" field.
+func AttemptCountIn(vs ...int) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldIn(FieldAttemptCount, vs...))
+}
+
+// AttemptCountNotIn applies the NotIn predicate on the "attempt_count" field.
+func AttemptCountNotIn(vs ...int) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldNotIn(FieldAttemptCount, vs...))
+}
+
+// AttemptCountGT applies the GT predicate on the "attempt_count" field.
+func AttemptCountGT(v int) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldGT(FieldAttemptCount, v))
+}
+
+// AttemptCountGTE applies the GTE predicate on the "attempt_count" field.
+func AttemptCountGTE(v int) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldGTE(FieldAttemptCount, v))
+}
+
+// AttemptCountLT applies the LT predicate on the "attempt_count" field.
+func AttemptCountLT(v int) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldLT(FieldAttemptCount, v))
+}
+
+// AttemptCountLTE applies the LTE predicate on the "attempt_count" field.
+func AttemptCountLTE(v int) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldLTE(FieldAttemptCount, v))
+}
+
+// RequestIDEQ applies the EQ predicate on the "request_id" field.
+func RequestIDEQ(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldEQ(FieldRequestID, v))
+}
+
+// RequestIDNEQ applies the NEQ predicate on the "request_id" field.
+func RequestIDNEQ(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldNEQ(FieldRequestID, v))
+}
+
+// RequestIDIn applies the In predicate on the "request_id" field.
+func RequestIDIn(vs ...string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldIn(FieldRequestID, vs...))
+}
+
+// RequestIDNotIn applies the NotIn predicate on the "request_id" field.
+func RequestIDNotIn(vs ...string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldNotIn(FieldRequestID, vs...))
+}
+
+// RequestIDGT applies the GT predicate on the "request_id" field.
+func RequestIDGT(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldGT(FieldRequestID, v))
+}
+
+// RequestIDGTE applies the GTE predicate on the "request_id" field.
+func RequestIDGTE(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldGTE(FieldRequestID, v))
+}
+
+// RequestIDLT applies the LT predicate on the "request_id" field.
+func RequestIDLT(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldLT(FieldRequestID, v))
+}
+
+// RequestIDLTE applies the LTE predicate on the "request_id" field.
+func RequestIDLTE(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldLTE(FieldRequestID, v))
+}
+
+// RequestIDContains applies the Contains predicate on the "request_id" field.
+func RequestIDContains(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldContains(FieldRequestID, v))
+}
+
+// RequestIDHasPrefix applies the HasPrefix predicate on the "request_id" field.
+func RequestIDHasPrefix(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldHasPrefix(FieldRequestID, v))
+}
+
+// RequestIDHasSuffix applies the HasSuffix predicate on the "request_id" field.
+func RequestIDHasSuffix(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldHasSuffix(FieldRequestID, v))
+}
+
+// RequestIDIsNil applies the IsNil predicate on the "request_id" field.
+func RequestIDIsNil() predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldIsNull(FieldRequestID))
+}
+
+// RequestIDNotNil applies the NotNil predicate on the "request_id" field.
+func RequestIDNotNil() predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldNotNull(FieldRequestID))
+}
+
+// RequestIDEqualFold applies the EqualFold predicate on the "request_id" field.
+func RequestIDEqualFold(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldEqualFold(FieldRequestID, v))
+}
+
+// RequestIDContainsFold applies the ContainsFold predicate on the "request_id" field.
+func RequestIDContainsFold(v string) predicate.ApprovalTicket {
+	return predicate.ApprovalTicket(sql.FieldContainsFold(FieldRequestID, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ApprovalTicket) predicate.ApprovalTicket {
 	return predicate.ApprovalTicket(sql.AndPredicates(predicates...))
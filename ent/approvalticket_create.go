@@ -130,6 +130,34 @@ func (_c *ApprovalTicketCreate) SetNillableRejectReason(v *string) *ApprovalTick
 	return _c
 }
 
+// SetApprovalComment sets the "approval_comment" field.
+func (_c *ApprovalTicketCreate) SetApprovalComment(v string) *ApprovalTicketCreate {
+	_c.mutation.SetApprovalComment(v)
+	return _c
+}
+
+// SetNillableApprovalComment sets the "approval_comment" field if the given value is not nil.
+func (_c *ApprovalTicketCreate) SetNillableApprovalComment(v *string) *ApprovalTicketCreate {
+	if v != nil {
+		_c.SetApprovalComment(*v)
+	}
+	return _c
+}
+
+// SetAssignedApprover sets the "assigned_approver" field.
+func (_c *ApprovalTicketCreate) SetAssignedApprover(v string) *ApprovalTicketCreate {
+	_c.mutation.SetAssignedApprover(v)
+	return _c
+}
+
+// SetNillableAssignedApprover sets the "assigned_approver" field if the given value is not nil.
+func (_c *ApprovalTicketCreate) SetNillableAssignedApprover(v *string) *ApprovalTicketCreate {
+	if v != nil {
+		_c.SetAssignedApprover(*v)
+	}
+	return _c
+}
+
 // SetSelectedClusterID sets the "selected_cluster_id" field.
 func (_c *ApprovalTicketCreate) SetSelectedClusterID(v string) *ApprovalTicketCreate {
 	_c.mutation.SetSelectedClusterID(v)
@@ -204,6 +232,90 @@ func (_c *ApprovalTicketCreate) SetNillableParentTicketID(v *string) *ApprovalTi
 	return _c
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ApprovalTicketCreate) SetExpiresAt(v time.Time) *ApprovalTicketCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *ApprovalTicketCreate) SetNillableExpiresAt(v *time.Time) *ApprovalTicketCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetRequiredApprovals sets the "required_approvals" field.
+func (_c *ApprovalTicketCreate) SetRequiredApprovals(v int) *ApprovalTicketCreate {
+	_c.mutation.SetRequiredApprovals(v)
+	return _c
+}
+
+// SetNillableRequiredApprovals sets the "required_approvals" field if the given value is not nil.
+func (_c *ApprovalTicketCreate) SetNillableRequiredApprovals(v *int) *ApprovalTicketCreate {
+	if v != nil {
+		_c.SetRequiredApprovals(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *ApprovalTicketCreate) SetStartedAt(v time.Time) *ApprovalTicketCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *ApprovalTicketCreate) SetNillableStartedAt(v *time.Time) *ApprovalTicketCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *ApprovalTicketCreate) SetFinishedAt(v time.Time) *ApprovalTicketCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *ApprovalTicketCreate) SetNillableFinishedAt(v *time.Time) *ApprovalTicketCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetAttemptCount sets the "attempt_count" field.
+func (_c *ApprovalTicketCreate) SetAttemptCount(v int) *ApprovalTicketCreate {
+	_c.mutation.SetAttemptCount(v)
+	return _c
+}
+
+// SetNillableAttemptCount sets the "attempt_count" field if the given value is not nil.
+func (_c *ApprovalTicketCreate) SetNillableAttemptCount(v *int) *ApprovalTicketCreate {
+	if v != nil {
+		_c.SetAttemptCount(*v)
+	}
+	return _c
+}
+
+// SetRequestID sets the "request_id" field.
+func (_c *ApprovalTicketCreate) SetRequestID(v string) *ApprovalTicketCreate {
+	_c.mutation.SetRequestID(v)
+	return _c
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_c *ApprovalTicketCreate) SetNillableRequestID(v *string) *ApprovalTicketCreate {
+	if v != nil {
+		_c.SetRequestID(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *ApprovalTicketCreate) SetID(v string) *ApprovalTicketCreate {
 	_c.mutation.SetID(v)
@@ -261,6 +373,14 @@ func (_c *ApprovalTicketCreate) defaults() {
 		v := approvalticket.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.RequiredApprovals(); !ok {
+		v := approvalticket.DefaultRequiredApprovals
+		_c.mutation.SetRequiredApprovals(v)
+	}
+	if _, ok := _c.mutation.AttemptCount(); !ok {
+		v := approvalticket.DefaultAttemptCount
+		_c.mutation.SetAttemptCount(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -303,6 +423,32 @@ func (_c *ApprovalTicketCreate) check() error {
 			return &ValidationError{Name: "requester", err: fmt.Errorf(`ent: validator failed for field "ApprovalTicket.requester": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.ApprovalComment(); ok {
+		if err := approvalticket.ApprovalCommentValidator(v); err != nil {
+			return &ValidationError{Name: "approval_comment", err: fmt.Errorf(`ent: validator failed for field "ApprovalTicket.approval_comment": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequiredApprovals(); !ok {
+		return &ValidationError{Name: "required_approvals", err: errors.New(`ent: missing required field "ApprovalTicket.required_approvals"`)}
+	}
+	if v, ok := _c.mutation.RequiredApprovals(); ok {
+		if err := approvalticket.RequiredApprovalsValidator(v); err != nil {
+			return &ValidationError{Name: "required_approvals", err: fmt.Errorf(`ent: validator failed for field "ApprovalTicket.required_approvals": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AttemptCount(); !ok {
+		return &ValidationError{Name: "attempt_count", err: errors.New(`ent: missing required field "ApprovalTicket.attempt_count"`)}
+	}
+	if v, ok := _c.mutation.AttemptCount(); ok {
+		if err := approvalticket.AttemptCountValidator(v); err != nil {
+			return &ValidationError{Name: "attempt_count", err: fmt.Errorf(`ent: validator failed for field "ApprovalTicket.attempt_count": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.RequestID(); ok {
+		if err := approvalticket.RequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "request_id", err: fmt.Errorf(`ent: validator failed for field "ApprovalTicket.request_id": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -374,6 +520,14 @@ func (_c *ApprovalTicketCreate) createSpec() (*ApprovalTicket, *sqlgraph.CreateS
 		_spec.SetField(approvalticket.FieldRejectReason, field.TypeString, value)
 		_node.RejectReason = value
 	}
+	if value, ok := _c.mutation.ApprovalComment(); ok {
+		_spec.SetField(approvalticket.FieldApprovalComment, field.TypeString, value)
+		_node.ApprovalComment = value
+	}
+	if value, ok := _c.mutation.AssignedApprover(); ok {
+		_spec.SetField(approvalticket.FieldAssignedApprover, field.TypeString, value)
+		_node.AssignedApprover = value
+	}
 	if value, ok := _c.mutation.SelectedClusterID(); ok {
 		_spec.SetField(approvalticket.FieldSelectedClusterID, field.TypeString, value)
 		_node.SelectedClusterID = value
@@ -402,6 +556,30 @@ func (_c *ApprovalTicketCreate) createSpec() (*ApprovalTicket, *sqlgraph.CreateS
 		_spec.SetField(approvalticket.FieldParentTicketID, field.TypeString, value)
 		_node.ParentTicketID = value
 	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(approvalticket.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.RequiredApprovals(); ok {
+		_spec.SetField(approvalticket.FieldRequiredApprovals, field.TypeInt, value)
+		_node.RequiredApprovals = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(approvalticket.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(approvalticket.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if value, ok := _c.mutation.AttemptCount(); ok {
+		_spec.SetField(approvalticket.FieldAttemptCount, field.TypeInt, value)
+		_node.AttemptCount = value
+	}
+	if value, ok := _c.mutation.RequestID(); ok {
+		_spec.SetField(approvalticket.FieldRequestID, field.TypeString, value)
+		_node.RequestID = &value
+	}
 	return _node, _spec
 }
 
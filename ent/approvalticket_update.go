@@ -122,6 +122,46 @@ func (_u *ApprovalTicketUpdate) ClearRejectReason() *ApprovalTicketUpdate {
 	return _u
 }
 
+// SetApprovalComment sets the "approval_comment" field.
+func (_u *ApprovalTicketUpdate) SetApprovalComment(v string) *ApprovalTicketUpdate {
+	_u.mutation.SetApprovalComment(v)
+	return _u
+}
+
+// SetNillableApprovalComment sets the "approval_comment" field if the given value is not nil.
+func (_u *ApprovalTicketUpdate) SetNillableApprovalComment(v *string) *ApprovalTicketUpdate {
+	if v != nil {
+		_u.SetApprovalComment(*v)
+	}
+	return _u
+}
+
+// ClearApprovalComment clears the value of the "approval_comment" field.
+func (_u *ApprovalTicketUpdate) ClearApprovalComment() *ApprovalTicketUpdate {
+	_u.mutation.ClearApprovalComment()
+	return _u
+}
+
+// SetAssignedApprover sets the "assigned_approver" field.
+func (_u *ApprovalTicketUpdate) SetAssignedApprover(v string) *ApprovalTicketUpdate {
+	_u.mutation.SetAssignedApprover(v)
+	return _u
+}
+
+// SetNillableAssignedApprover sets the "assigned_approver" field if the given value is not nil.
+func (_u *ApprovalTicketUpdate) SetNillableAssignedApprover(v *string) *ApprovalTicketUpdate {
+	if v != nil {
+		_u.SetAssignedApprover(*v)
+	}
+	return _u
+}
+
+// ClearAssignedApprover clears the value of the "assigned_approver" field.
+func (_u *ApprovalTicketUpdate) ClearAssignedApprover() *ApprovalTicketUpdate {
+	_u.mutation.ClearAssignedApprover()
+	return _u
+}
+
 // SetSelectedClusterID sets the "selected_cluster_id" field.
 func (_u *ApprovalTicketUpdate) SetSelectedClusterID(v string) *ApprovalTicketUpdate {
 	_u.mutation.SetSelectedClusterID(v)
@@ -245,6 +285,108 @@ func (_u *ApprovalTicketUpdate) ClearParentTicketID() *ApprovalTicketUpdate {
 	return _u
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ApprovalTicketUpdate) SetExpiresAt(v time.Time) *ApprovalTicketUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ApprovalTicketUpdate) SetNillableExpiresAt(v *time.Time) *ApprovalTicketUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *ApprovalTicketUpdate) ClearExpiresAt() *ApprovalTicketUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetRequiredApprovals sets the "required_approvals" field.
+func (_u *ApprovalTicketUpdate) SetRequiredApprovals(v int) *ApprovalTicketUpdate {
+	_u.mutation.ResetRequiredApprovals()
+	_u.mutation.SetRequiredApprovals(v)
+	return _u
+}
+
+// SetNillableRequiredApprovals sets the "required_approvals" field if the given value is not nil.
+func (_u *ApprovalTicketUpdate) SetNillableRequiredApprovals(v *int) *ApprovalTicketUpdate {
+	if v != nil {
+		_u.SetRequiredApprovals(*v)
+	}
+	return _u
+}
+
+// AddRequiredApprovals adds value to the "required_approvals" field.
+func (_u *ApprovalTicketUpdate) AddRequiredApprovals(v int) *ApprovalTicketUpdate {
+	_u.mutation.AddRequiredApprovals(v)
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *ApprovalTicketUpdate) SetStartedAt(v time.Time) *ApprovalTicketUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *ApprovalTicketUpdate) SetNillableStartedAt(v *time.Time) *ApprovalTicketUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *ApprovalTicketUpdate) ClearStartedAt() *ApprovalTicketUpdate {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *ApprovalTicketUpdate) SetFinishedAt(v time.Time) *ApprovalTicketUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *ApprovalTicketUpdate) SetNillableFinishedAt(v *time.Time) *ApprovalTicketUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *ApprovalTicketUpdate) ClearFinishedAt() *ApprovalTicketUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetAttemptCount sets the "attempt_count" field.
+func (_u *ApprovalTicketUpdate) SetAttemptCount(v int) *ApprovalTicketUpdate {
+	_u.mutation.ResetAttemptCount()
+	_u.mutation.SetAttemptCount(v)
+	return _u
+}
+
+// SetNillableAttemptCount sets the "attempt_count" field if the given value is not nil.
+func (_u *ApprovalTicketUpdate) SetNillableAttemptCount(v *int) *ApprovalTicketUpdate {
+	if v != nil {
+		_u.SetAttemptCount(*v)
+	}
+	return _u
+}
+
+// AddAttemptCount adds value to the "attempt_count" field.
+func (_u *ApprovalTicketUpdate) AddAttemptCount(v int) *ApprovalTicketUpdate {
+	_u.mutation.AddAttemptCount(v)
+	return _u
+}
+
 // Mutation returns the ApprovalTicketMutation object of the builder.
 func (_u *ApprovalTicketUpdate) Mutation() *ApprovalTicketMutation {
 	return _u.mutation
@@ -298,6 +440,21 @@ func (_u *ApprovalTicketUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ApprovalTicket.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ApprovalComment(); ok {
+		if err := approvalticket.ApprovalCommentValidator(v); err != nil {
+			return &ValidationError{Name: "approval_comment", err: fmt.Errorf(`ent: validator failed for field "ApprovalTicket.approval_comment": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RequiredApprovals(); ok {
+		if err := approvalticket.RequiredApprovalsValidator(v); err != nil {
+			return &ValidationError{Name: "required_approvals", err: fmt.Errorf(`ent: validator failed for field "ApprovalTicket.required_approvals": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AttemptCount(); ok {
+		if err := approvalticket.AttemptCountValidator(v); err != nil {
+			return &ValidationError{Name: "attempt_count", err: fmt.Errorf(`ent: validator failed for field "ApprovalTicket.attempt_count": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -340,6 +497,18 @@ func (_u *ApprovalTicketUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if _u.mutation.RejectReasonCleared() {
 		_spec.ClearField(approvalticket.FieldRejectReason, field.TypeString)
 	}
+	if value, ok := _u.mutation.ApprovalComment(); ok {
+		_spec.SetField(approvalticket.FieldApprovalComment, field.TypeString, value)
+	}
+	if _u.mutation.ApprovalCommentCleared() {
+		_spec.ClearField(approvalticket.FieldApprovalComment, field.TypeString)
+	}
+	if value, ok := _u.mutation.AssignedApprover(); ok {
+		_spec.SetField(approvalticket.FieldAssignedApprover, field.TypeString, value)
+	}
+	if _u.mutation.AssignedApproverCleared() {
+		_spec.ClearField(approvalticket.FieldAssignedApprover, field.TypeString)
+	}
 	if value, ok := _u.mutation.SelectedClusterID(); ok {
 		_spec.SetField(approvalticket.FieldSelectedClusterID, field.TypeString, value)
 	}
@@ -385,6 +554,39 @@ func (_u *ApprovalTicketUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if _u.mutation.ParentTicketIDCleared() {
 		_spec.ClearField(approvalticket.FieldParentTicketID, field.TypeString)
 	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(approvalticket.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(approvalticket.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RequiredApprovals(); ok {
+		_spec.SetField(approvalticket.FieldRequiredApprovals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRequiredApprovals(); ok {
+		_spec.AddField(approvalticket.FieldRequiredApprovals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(approvalticket.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(approvalticket.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(approvalticket.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(approvalticket.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AttemptCount(); ok {
+		_spec.SetField(approvalticket.FieldAttemptCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttemptCount(); ok {
+		_spec.AddField(approvalticket.FieldAttemptCount, field.TypeInt, value)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(approvalticket.FieldRequestID, field.TypeString)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{approvalticket.Label}
@@ -499,6 +701,46 @@ func (_u *ApprovalTicketUpdateOne) ClearRejectReason() *ApprovalTicketUpdateOne
 	return _u
 }
 
+// SetApprovalComment sets the "approval_comment" field.
+func (_u *ApprovalTicketUpdateOne) SetApprovalComment(v string) *ApprovalTicketUpdateOne {
+	_u.mutation.SetApprovalComment(v)
+	return _u
+}
+
+// SetNillableApprovalComment sets the "approval_comment" field if the given value is not nil.
+func (_u *ApprovalTicketUpdateOne) SetNillableApprovalComment(v *string) *ApprovalTicketUpdateOne {
+	if v != nil {
+		_u.SetApprovalComment(*v)
+	}
+	return _u
+}
+
+// ClearApprovalComment clears the value of the "approval_comment" field.
+func (_u *ApprovalTicketUpdateOne) ClearApprovalComment() *ApprovalTicketUpdateOne {
+	_u.mutation.ClearApprovalComment()
+	return _u
+}
+
+// SetAssignedApprover sets the "assigned_approver" field.
+func (_u *ApprovalTicketUpdateOne) SetAssignedApprover(v string) *ApprovalTicketUpdateOne {
+	_u.mutation.SetAssignedApprover(v)
+	return _u
+}
+
+// SetNillableAssignedApprover sets the "assigned_approver" field if the given value is not nil.
+func (_u *ApprovalTicketUpdateOne) SetNillableAssignedApprover(v *string) *ApprovalTicketUpdateOne {
+	if v != nil {
+		_u.SetAssignedApprover(*v)
+	}
+	return _u
+}
+
+// ClearAssignedApprover clears the value of the "assigned_approver" field.
+func (_u *ApprovalTicketUpdateOne) ClearAssignedApprover() *ApprovalTicketUpdateOne {
+	_u.mutation.ClearAssignedApprover()
+	return _u
+}
+
 // SetSelectedClusterID sets the "selected_cluster_id" field.
 func (_u *ApprovalTicketUpdateOne) SetSelectedClusterID(v string) *ApprovalTicketUpdateOne {
 	_u.mutation.SetSelectedClusterID(v)
@@ -622,6 +864,108 @@ func (_u *ApprovalTicketUpdateOne) ClearParentTicketID() *ApprovalTicketUpdateOn
 	return _u
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ApprovalTicketUpdateOne) SetExpiresAt(v time.Time) *ApprovalTicketUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ApprovalTicketUpdateOne) SetNillableExpiresAt(v *time.Time) *ApprovalTicketUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *ApprovalTicketUpdateOne) ClearExpiresAt() *ApprovalTicketUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetRequiredApprovals sets the "required_approvals" field.
+func (_u *ApprovalTicketUpdateOne) SetRequiredApprovals(v int) *ApprovalTicketUpdateOne {
+	_u.mutation.ResetRequiredApprovals()
+	_u.mutation.SetRequiredApprovals(v)
+	return _u
+}
+
+// SetNillableRequiredApprovals sets the "required_approvals" field if the given value is not nil.
+func (_u *ApprovalTicketUpdateOne) SetNillableRequiredApprovals(v *int) *ApprovalTicketUpdateOne {
+	if v != nil {
+		_u.SetRequiredApprovals(*v)
+	}
+	return _u
+}
+
+// AddRequiredApprovals adds value to the "required_approvals" field.
+func (_u *ApprovalTicketUpdateOne) AddRequiredApprovals(v int) *ApprovalTicketUpdateOne {
+	_u.mutation.AddRequiredApprovals(v)
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *ApprovalTicketUpdateOne) SetStartedAt(v time.Time) *ApprovalTicketUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *ApprovalTicketUpdateOne) SetNillableStartedAt(v *time.Time) *ApprovalTicketUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *ApprovalTicketUpdateOne) ClearStartedAt() *ApprovalTicketUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *ApprovalTicketUpdateOne) SetFinishedAt(v time.Time) *ApprovalTicketUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *ApprovalTicketUpdateOne) SetNillableFinishedAt(v *time.Time) *ApprovalTicketUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *ApprovalTicketUpdateOne) ClearFinishedAt() *ApprovalTicketUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetAttemptCount sets the "attempt_count" field.
+func (_u *ApprovalTicketUpdateOne) SetAttemptCount(v int) *ApprovalTicketUpdateOne {
+	_u.mutation.ResetAttemptCount()
+	_u.mutation.SetAttemptCount(v)
+	return _u
+}
+
+// SetNillableAttemptCount sets the "attempt_count" field if the given value is not nil.
+func (_u *ApprovalTicketUpdateOne) SetNillableAttemptCount(v *int) *ApprovalTicketUpdateOne {
+	if v != nil {
+		_u.SetAttemptCount(*v)
+	}
+	return _u
+}
+
+// AddAttemptCount adds value to the "attempt_count" field.
+func (_u *ApprovalTicketUpdateOne) AddAttemptCount(v int) *ApprovalTicketUpdateOne {
+	_u.mutation.AddAttemptCount(v)
+	return _u
+}
+
 // Mutation returns the ApprovalTicketMutation object of the builder.
 func (_u *ApprovalTicketUpdateOne) Mutation() *ApprovalTicketMutation {
 	return _u.mutation
@@ -688,6 +1032,21 @@ func (_u *ApprovalTicketUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ApprovalTicket.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ApprovalComment(); ok {
+		if err := approvalticket.ApprovalCommentValidator(v); err != nil {
+			return &ValidationError{Name: "approval_comment", err: fmt.Errorf(`ent: validator failed for field "ApprovalTicket.approval_comment": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RequiredApprovals(); ok {
+		if err := approvalticket.RequiredApprovalsValidator(v); err != nil {
+			return &ValidationError{Name: "required_approvals", err: fmt.Errorf(`ent: validator failed for field "ApprovalTicket.required_approvals": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AttemptCount(); ok {
+		if err := approvalticket.AttemptCountValidator(v); err != nil {
+			return &ValidationError{Name: "attempt_count", err: fmt.Errorf(`ent: validator failed for field "ApprovalTicket.attempt_count": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -747,6 +1106,18 @@ func (_u *ApprovalTicketUpdateOne) sqlSave(ctx context.Context) (_node *Approval
 	if _u.mutation.RejectReasonCleared() {
 		_spec.ClearField(approvalticket.FieldRejectReason, field.TypeString)
 	}
+	if value, ok := _u.mutation.ApprovalComment(); ok {
+		_spec.SetField(approvalticket.FieldApprovalComment, field.TypeString, value)
+	}
+	if _u.mutation.ApprovalCommentCleared() {
+		_spec.ClearField(approvalticket.FieldApprovalComment, field.TypeString)
+	}
+	if value, ok := _u.mutation.AssignedApprover(); ok {
+		_spec.SetField(approvalticket.FieldAssignedApprover, field.TypeString, value)
+	}
+	if _u.mutation.AssignedApproverCleared() {
+		_spec.ClearField(approvalticket.FieldAssignedApprover, field.TypeString)
+	}
 	if value, ok := _u.mutation.SelectedClusterID(); ok {
 		_spec.SetField(approvalticket.FieldSelectedClusterID, field.TypeString, value)
 	}
@@ -792,6 +1163,39 @@ func (_u *ApprovalTicketUpdateOne) sqlSave(ctx context.Context) (_node *Approval
 	if _u.mutation.ParentTicketIDCleared() {
 		_spec.ClearField(approvalticket.FieldParentTicketID, field.TypeString)
 	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(approvalticket.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(approvalticket.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RequiredApprovals(); ok {
+		_spec.SetField(approvalticket.FieldRequiredApprovals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRequiredApprovals(); ok {
+		_spec.AddField(approvalticket.FieldRequiredApprovals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(approvalticket.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(approvalticket.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(approvalticket.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(approvalticket.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AttemptCount(); ok {
+		_spec.SetField(approvalticket.FieldAttemptCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttemptCount(); ok {
+		_spec.AddField(approvalticket.FieldAttemptCount, field.TypeInt, value)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(approvalticket.FieldRequestID, field.TypeString)
+	}
 	_node = &ApprovalTicket{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -33,7 +33,25 @@ type AuthProvider struct {
 	// SortOrder holds the value of the "sort_order" field.
 	SortOrder int `json:"sort_order,omitempty"`
 	// CreatedBy holds the value of the "created_by" field.
-	CreatedBy    string `json:"created_by,omitempty"`
+	CreatedBy string `json:"created_by,omitempty"`
+	// SyncSchedule holds the value of the "sync_schedule" field.
+	SyncSchedule string `json:"sync_schedule,omitempty"`
+	// LastSyncAt holds the value of the "last_sync_at" field.
+	LastSyncAt *time.Time `json:"last_sync_at,omitempty"`
+	// LastSyncAdded holds the value of the "last_sync_added" field.
+	LastSyncAdded int `json:"last_sync_added,omitempty"`
+	// LastSyncUpdated holds the value of the "last_sync_updated" field.
+	LastSyncUpdated int `json:"last_sync_updated,omitempty"`
+	// LastSyncStale holds the value of the "last_sync_stale" field.
+	LastSyncStale int `json:"last_sync_stale,omitempty"`
+	// LastSyncDurationMs holds the value of the "last_sync_duration_ms" field.
+	LastSyncDurationMs int64 `json:"last_sync_duration_ms,omitempty"`
+	// LastSyncError holds the value of the "last_sync_error" field.
+	LastSyncError string `json:"last_sync_error,omitempty"`
+	// SyncFailures holds the value of the "sync_failures" field.
+	SyncFailures int `json:"sync_failures,omitempty"`
+	// SyncRetryAt holds the value of the "sync_retry_at" field.
+	SyncRetryAt  *time.Time `json:"sync_retry_at,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -46,11 +64,11 @@ func (*AuthProvider) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case authprovider.FieldEnabled:
 			values[i] = new(sql.NullBool)
-		case authprovider.FieldSortOrder:
+		case authprovider.FieldSortOrder, authprovider.FieldLastSyncAdded, authprovider.FieldLastSyncUpdated, authprovider.FieldLastSyncStale, authprovider.FieldLastSyncDurationMs, authprovider.FieldSyncFailures:
 			values[i] = new(sql.NullInt64)
-		case authprovider.FieldID, authprovider.FieldName, authprovider.FieldAuthType, authprovider.FieldCreatedBy:
+		case authprovider.FieldID, authprovider.FieldName, authprovider.FieldAuthType, authprovider.FieldCreatedBy, authprovider.FieldSyncSchedule, authprovider.FieldLastSyncError:
 			values[i] = new(sql.NullString)
-		case authprovider.FieldCreatedAt, authprovider.FieldUpdatedAt:
+		case authprovider.FieldCreatedAt, authprovider.FieldUpdatedAt, authprovider.FieldLastSyncAt, authprovider.FieldSyncRetryAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -123,6 +141,62 @@ func (_m *AuthProvider) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CreatedBy = value.String
 			}
+		case authprovider.FieldSyncSchedule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sync_schedule", values[i])
+			} else if value.Valid {
+				_m.SyncSchedule = value.String
+			}
+		case authprovider.FieldLastSyncAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_sync_at", values[i])
+			} else if value.Valid {
+				_m.LastSyncAt = new(time.Time)
+				*_m.LastSyncAt = value.Time
+			}
+		case authprovider.FieldLastSyncAdded:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_sync_added", values[i])
+			} else if value.Valid {
+				_m.LastSyncAdded = int(value.Int64)
+			}
+		case authprovider.FieldLastSyncUpdated:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_sync_updated", values[i])
+			} else if value.Valid {
+				_m.LastSyncUpdated = int(value.Int64)
+			}
+		case authprovider.FieldLastSyncStale:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_sync_stale", values[i])
+			} else if value.Valid {
+				_m.LastSyncStale = int(value.Int64)
+			}
+		case authprovider.FieldLastSyncDurationMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_sync_duration_ms", values[i])
+			} else if value.Valid {
+				_m.LastSyncDurationMs = value.Int64
+			}
+		case authprovider.FieldLastSyncError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_sync_error", values[i])
+			} else if value.Valid {
+				_m.LastSyncError = value.String
+			}
+		case authprovider.FieldSyncFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sync_failures", values[i])
+			} else if value.Valid {
+				_m.SyncFailures = int(value.Int64)
+			}
+		case authprovider.FieldSyncRetryAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sync_retry_at", values[i])
+			} else if value.Valid {
+				_m.SyncRetryAt = new(time.Time)
+				*_m.SyncRetryAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -181,6 +255,37 @@ func (_m *AuthProvider) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("created_by=")
 	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("sync_schedule=")
+	builder.WriteString(_m.SyncSchedule)
+	builder.WriteString(", ")
+	if v := _m.LastSyncAt; v != nil {
+		builder.WriteString("last_sync_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_sync_added=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastSyncAdded))
+	builder.WriteString(", ")
+	builder.WriteString("last_sync_updated=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastSyncUpdated))
+	builder.WriteString(", ")
+	builder.WriteString("last_sync_stale=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastSyncStale))
+	builder.WriteString(", ")
+	builder.WriteString("last_sync_duration_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastSyncDurationMs))
+	builder.WriteString(", ")
+	builder.WriteString("last_sync_error=")
+	builder.WriteString(_m.LastSyncError)
+	builder.WriteString(", ")
+	builder.WriteString("sync_failures=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SyncFailures))
+	builder.WriteString(", ")
+	if v := _m.SyncRetryAt; v != nil {
+		builder.WriteString("sync_retry_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -29,6 +29,24 @@ const (
 	FieldSortOrder = "sort_order"
 	// FieldCreatedBy holds the string denoting the created_by field in the database.
 	FieldCreatedBy = "created_by"
+	// FieldSyncSchedule holds the string denoting the sync_schedule field in the database.
+	FieldSyncSchedule = "sync_schedule"
+	// FieldLastSyncAt holds the string denoting the last_sync_at field in the database.
+	FieldLastSyncAt = "last_sync_at"
+	// FieldLastSyncAdded holds the string denoting the last_sync_added field in the database.
+	FieldLastSyncAdded = "last_sync_added"
+	// FieldLastSyncUpdated holds the string denoting the last_sync_updated field in the database.
+	FieldLastSyncUpdated = "last_sync_updated"
+	// FieldLastSyncStale holds the string denoting the last_sync_stale field in the database.
+	FieldLastSyncStale = "last_sync_stale"
+	// FieldLastSyncDurationMs holds the string denoting the last_sync_duration_ms field in the database.
+	FieldLastSyncDurationMs = "last_sync_duration_ms"
+	// FieldLastSyncError holds the string denoting the last_sync_error field in the database.
+	FieldLastSyncError = "last_sync_error"
+	// FieldSyncFailures holds the string denoting the sync_failures field in the database.
+	FieldSyncFailures = "sync_failures"
+	// FieldSyncRetryAt holds the string denoting the sync_retry_at field in the database.
+	FieldSyncRetryAt = "sync_retry_at"
 	// Table holds the table name of the authprovider in the database.
 	Table = "auth_providers"
 )
@@ -44,6 +62,15 @@ var Columns = []string{
 	FieldEnabled,
 	FieldSortOrder,
 	FieldCreatedBy,
+	FieldSyncSchedule,
+	FieldLastSyncAt,
+	FieldLastSyncAdded,
+	FieldLastSyncUpdated,
+	FieldLastSyncStale,
+	FieldLastSyncDurationMs,
+	FieldLastSyncError,
+	FieldSyncFailures,
+	FieldSyncRetryAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -73,6 +100,16 @@ var (
 	DefaultSortOrder int
 	// CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
 	CreatedByValidator func(string) error
+	// DefaultLastSyncAdded holds the default value on creation for the "last_sync_added" field.
+	DefaultLastSyncAdded int
+	// DefaultLastSyncUpdated holds the default value on creation for the "last_sync_updated" field.
+	DefaultLastSyncUpdated int
+	// DefaultLastSyncStale holds the default value on creation for the "last_sync_stale" field.
+	DefaultLastSyncStale int
+	// DefaultLastSyncDurationMs holds the default value on creation for the "last_sync_duration_ms" field.
+	DefaultLastSyncDurationMs int64
+	// DefaultSyncFailures holds the default value on creation for the "sync_failures" field.
+	DefaultSyncFailures int
 )
 
 // OrderOption defines the ordering options for the AuthProvider queries.
@@ -117,3 +154,48 @@ func BySortOrder(opts ...sql.OrderTermOption) OrderOption {
 func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
 }
+
+// BySyncSchedule orders the results by the sync_schedule field.
+func BySyncSchedule(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSyncSchedule, opts...).ToFunc()
+}
+
+// ByLastSyncAt orders the results by the last_sync_at field.
+func ByLastSyncAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSyncAt, opts...).ToFunc()
+}
+
+// ByLastSyncAdded orders the results by the last_sync_added field.
+func ByLastSyncAdded(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSyncAdded, opts...).ToFunc()
+}
+
+// ByLastSyncUpdated orders the results by the last_sync_updated field.
+func ByLastSyncUpdated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSyncUpdated, opts...).ToFunc()
+}
+
+// ByLastSyncStale orders the results by the last_sync_stale field.
+func ByLastSyncStale(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSyncStale, opts...).ToFunc()
+}
+
+// ByLastSyncDurationMs orders the results by the last_sync_duration_ms field.
+func ByLastSyncDurationMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSyncDurationMs, opts...).ToFunc()
+}
+
+// ByLastSyncError orders the results by the last_sync_error field.
+func ByLastSyncError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSyncError, opts...).ToFunc()
+}
+
+// BySyncFailures orders the results by the sync_failures field.
+func BySyncFailures(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSyncFailures, opts...).ToFunc()
+}
+
+// BySyncRetryAt orders the results by the sync_retry_at field.
+func BySyncRetryAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSyncRetryAt, opts...).ToFunc()
+}
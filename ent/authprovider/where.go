@@ -99,6 +99,51 @@ func CreatedBy(v string) predicate.AuthProvider {
 	return predicate.AuthProvider(sql.FieldEQ(FieldCreatedBy, v))
 }
 
+// SyncSchedule applies equality check predicate on the "sync_schedule" field. It's identical to SyncScheduleEQ.
+func SyncSchedule(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldEQ(FieldSyncSchedule, v))
+}
+
+// LastSyncAt applies equality check predicate on the "last_sync_at" field. It's identical to LastSyncAtEQ.
+func LastSyncAt(v time.Time) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldEQ(FieldLastSyncAt, v))
+}
+
+// LastSyncAdded applies equality check predicate on the "last_sync_added" field. It's identical to LastSyncAddedEQ.
+func LastSyncAdded(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldEQ(FieldLastSyncAdded, v))
+}
+
+// LastSyncUpdated applies equality check predicate on the "last_sync_updated" field. It's identical to LastSyncUpdatedEQ.
+func LastSyncUpdated(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldEQ(FieldLastSyncUpdated, v))
+}
+
+// LastSyncStale applies equality check predicate on the "last_sync_stale" field. It's identical to LastSyncStaleEQ.
+func LastSyncStale(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldEQ(FieldLastSyncStale, v))
+}
+
+// LastSyncDurationMs applies equality check predicate on the "last_sync_duration_ms" field. It's identical to LastSyncDurationMsEQ.
+func LastSyncDurationMs(v int64) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldEQ(FieldLastSyncDurationMs, v))
+}
+
+// LastSyncError applies equality check predicate on the "last_sync_error" field. It's identical to LastSyncErrorEQ.
+func LastSyncError(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldEQ(FieldLastSyncError, v))
+}
+
+// SyncFailures applies equality check predicate on the "sync_failures" field. It's identical to SyncFailuresEQ.
+func SyncFailures(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldEQ(FieldSyncFailures, v))
+}
+
+// SyncRetryAt applies equality check predicate on the "sync_retry_at" field. It's identical to SyncRetryAtEQ.
+func SyncRetryAt(v time.Time) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldEQ(FieldSyncRetryAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.AuthProvider {
 	return predicate.AuthProvider(sql.FieldEQ(FieldCreatedAt, v))
@@ -424,6 +469,456 @@ func CreatedByContainsFold(v string) predicate.AuthProvider {
 	return predicate.AuthProvider(sql.FieldContainsFold(FieldCreatedBy, v))
 }
 
+// SyncScheduleEQ applies the EQ predicate on the "sync_schedule" field.
+func SyncScheduleEQ(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldEQ(FieldSyncSchedule, v))
+}
+
+// SyncScheduleNEQ applies the NEQ predicate on the "sync_schedule" field.
+func SyncScheduleNEQ(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldNEQ(FieldSyncSchedule, v))
+}
+
+// SyncScheduleIn applies the In predicate on the "sync_schedule" field.
+func SyncScheduleIn(vs ...string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldIn(FieldSyncSchedule, vs...))
+}
+
+// SyncScheduleNotIn applies the NotIn predicate on the "sync_schedule" field.
+func SyncScheduleNotIn(vs ...string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldNotIn(FieldSyncSchedule, vs...))
+}
+
+// SyncScheduleGT applies the GT predicate on the "sync_schedule" field.
+func SyncScheduleGT(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldGT(FieldSyncSchedule, v))
+}
+
+// SyncScheduleGTE applies the GTE predicate on the "sync_schedule" field.
+func SyncScheduleGTE(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldGTE(FieldSyncSchedule, v))
+}
+
+// SyncScheduleLT applies the LT predicate on the "sync_schedule" field.
+func SyncScheduleLT(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldLT(FieldSyncSchedule, v))
+}
+
+// SyncScheduleLTE applies the LTE predicate on the "sync_schedule" field.
+func SyncScheduleLTE(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldLTE(FieldSyncSchedule, v))
+}
+
+// SyncScheduleContains applies the Contains predicate on the "sync_schedule" field.
+func SyncScheduleContains(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldContains(FieldSyncSchedule, v))
+}
+
+// SyncScheduleHasPrefix applies the HasPrefix predicate on the "sync_schedule" field.
+func SyncScheduleHasPrefix(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldHasPrefix(FieldSyncSchedule, v))
+}
+
+// SyncScheduleHasSuffix applies the HasSuffix predicate on the "sync_schedule" field.
+func SyncScheduleHasSuffix(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldHasSuffix(FieldSyncSchedule, v))
+}
+
+// SyncScheduleIsNil applies the IsNil predicate on the "sync_schedule" field.
+func SyncScheduleIsNil() predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldIsNull(FieldSyncSchedule))
+}
+
+// SyncScheduleNotNil applies the NotNil predicate on the "sync_schedule" field.
+func SyncScheduleNotNil() predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldNotNull(FieldSyncSchedule))
+}
+
+// SyncScheduleEqualFold applies the EqualFold predicate on the "sync_schedule" field.
+func SyncScheduleEqualFold(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldEqualFold(FieldSyncSchedule, v))
+}
+
+// SyncScheduleContainsFold applies the ContainsFold predicate on the "sync_schedule" field.
+func SyncScheduleContainsFold(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldContainsFold(FieldSyncSchedule, v))
+}
+
+// LastSyncAtEQ applies the EQ predicate on the "last_sync_at" field.
+func LastSyncAtEQ(v time.Time) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldEQ(FieldLastSyncAt, v))
+}
+
+// LastSyncAtNEQ applies the NEQ predicate on the "last_sync_at" field.
+func LastSyncAtNEQ(v time.Time) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldNEQ(FieldLastSyncAt, v))
+}
+
+// LastSyncAtIn applies the In predicate on the "last_sync_at" field.
+func LastSyncAtIn(vs ...time.Time) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldIn(FieldLastSyncAt, vs...))
+}
+
+// LastSyncAtNotIn applies the NotIn predicate on the "last_sync_at" field.
+func LastSyncAtNotIn(vs ...time.Time) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldNotIn(FieldLastSyncAt, vs...))
+}
+
+// LastSyncAtGT applies the GT predicate on the "last_sync_at" field.
+func LastSyncAtGT(v time.Time) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldGT(FieldLastSyncAt, v))
+}
+
+// LastSyncAtGTE applies the GTE predicate on the "last_sync_at" field.
+func LastSyncAtGTE(v time.Time) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldGTE(FieldLastSyncAt, v))
+}
+
+// LastSyncAtLT applies the LT predicate on the "last_sync_at" field.
+func LastSyncAtLT(v time.Time) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldLT(FieldLastSyncAt, v))
+}
+
+// LastSyncAtLTE applies the LTE predicate on the "last_sync_at" field.
+func LastSyncAtLTE(v time.Time) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldLTE(FieldLastSyncAt, v))
+}
+
+// LastSyncAtIsNil applies the IsNil predicate on the "last_sync_at" field.
+func LastSyncAtIsNil() predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldIsNull(FieldLastSyncAt))
+}
+
+// LastSyncAtNotNil applies the NotNil predicate on the "last_sync_at" field.
+func LastSyncAtNotNil() predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldNotNull(FieldLastSyncAt))
+}
+
+// LastSyncAddedEQ applies the EQ predicate on the "last_sync_added" field.
+func LastSyncAddedEQ(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldEQ(FieldLastSyncAdded, v))
+}
+
+// LastSyncAddedNEQ applies the NEQ predicate on the "last_sync_added" field.
+func LastSyncAddedNEQ(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldNEQ(FieldLastSyncAdded, v))
+}
+
+// LastSyncAddedIn applies the In predicate on the "last_sync_added" field.
+func LastSyncAddedIn(vs ...int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldIn(FieldLastSyncAdded, vs...))
+}
+
+// LastSyncAddedNotIn applies the NotIn predicate on the "last_sync_added" field.
+func LastSyncAddedNotIn(vs ...int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldNotIn(FieldLastSyncAdded, vs...))
+}
+
+// LastSyncAddedGT applies the GT predicate on the "last_sync_added" field.
+func LastSyncAddedGT(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldGT(FieldLastSyncAdded, v))
+}
+
+// LastSyncAddedGTE applies the GTE predicate on the "last_sync_added" field.
+func LastSyncAddedGTE(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldGTE(FieldLastSyncAdded, v))
+}
+
+// LastSyncAddedLT applies the LT predicate on the "last_sync_added" field.
+func LastSyncAddedLT(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldLT(FieldLastSyncAdded, v))
+}
+
+// LastSyncAddedLTE applies the LTE predicate on the "last_sync_added" field.
+func LastSyncAddedLTE(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldLTE(FieldLastSyncAdded, v))
+}
+
+// LastSyncUpdatedEQ applies the EQ predicate on the "last_sync_updated" field.
+func LastSyncUpdatedEQ(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldEQ(FieldLastSyncUpdated, v))
+}
+
+// LastSyncUpdatedNEQ applies the NEQ predicate on the "last_sync_updated" field.
+func LastSyncUpdatedNEQ(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldNEQ(FieldLastSyncUpdated, v))
+}
+
+// LastSyncUpdatedIn applies the In predicate on the "last_sync_updated" field.
+func LastSyncUpdatedIn(vs ...int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldIn(FieldLastSyncUpdated, vs...))
+}
+
+// LastSyncUpdatedNotIn applies the NotIn predicate on the "last_sync_updated" field.
+func LastSyncUpdatedNotIn(vs ...int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldNotIn(FieldLastSyncUpdated, vs...))
+}
+
+// LastSyncUpdatedGT applies the GT predicate on the "last_sync_updated" field.
+func LastSyncUpdatedGT(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldGT(FieldLastSyncUpdated, v))
+}
+
+// LastSyncUpdatedGTE applies the GTE predicate on the "last_sync_updated" field.
+func LastSyncUpdatedGTE(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldGTE(FieldLastSyncUpdated, v))
+}
+
+// LastSyncUpdatedLT applies the LT predicate on the "last_sync_updated" field.
+func LastSyncUpdatedLT(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldLT(FieldLastSyncUpdated, v))
+}
+
+// LastSyncUpdatedLTE applies the LTE predicate on the "last_sync_updated" field.
+func LastSyncUpdatedLTE(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldLTE(FieldLastSyncUpdated, v))
+}
+
+// LastSyncStaleEQ applies the EQ predicate on the "last_sync_stale" field.
+func LastSyncStaleEQ(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldEQ(FieldLastSyncStale, v))
+}
+
+// LastSyncStaleNEQ applies the NEQ predicate on the "last_sync_stale" field.
+func LastSyncStaleNEQ(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldNEQ(FieldLastSyncStale, v))
+}
+
+// LastSyncStaleIn applies the In predicate on the "last_sync_stale" field.
+func LastSyncStaleIn(vs ...int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldIn(FieldLastSyncStale, vs...))
+}
+
+// LastSyncStaleNotIn applies the NotIn predicate on the "last_sync_stale" field.
+func LastSyncStaleNotIn(vs ...int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldNotIn(FieldLastSyncStale, vs...))
+}
+
+// LastSyncStaleGT applies the GT predicate on the "last_sync_stale" field.
+func LastSyncStaleGT(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldGT(FieldLastSyncStale, v))
+}
+
+// LastSyncStaleGTE applies the GTE predicate on the "last_sync_stale" field.
+func LastSyncStaleGTE(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldGTE(FieldLastSyncStale, v))
+}
+
+// LastSyncStaleLT applies the LT predicate on the "last_sync_stale" field.
+func LastSyncStaleLT(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldLT(FieldLastSyncStale, v))
+}
+
+// LastSyncStaleLTE applies the LTE predicate on the "last_sync_stale" field.
+func LastSyncStaleLTE(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldLTE(FieldLastSyncStale, v))
+}
+
+// LastSyncDurationMsEQ applies the EQ predicate on the "last_sync_duration_ms" field.
+func LastSyncDurationMsEQ(v int64) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldEQ(FieldLastSyncDurationMs, v))
+}
+
+// LastSyncDurationMsNEQ applies the NEQ predicate on the "last_sync_duration_ms" field.
+func LastSyncDurationMsNEQ(v int64) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldNEQ(FieldLastSyncDurationMs, v))
+}
+
+// LastSyncDurationMsIn applies the In predicate on the "last_sync_duration_ms" field.
+func LastSyncDurationMsIn(vs ...int64) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldIn(FieldLastSyncDurationMs, vs...))
+}
+
+// LastSyncDurationMsNotIn applies the NotIn predicate on the "last_sync_duration_ms" field.
+func LastSyncDurationMsNotIn(vs ...int64) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldNotIn(FieldLastSyncDurationMs, vs...))
+}
+
+// LastSyncDurationMsGT applies the GT predicate on the "last_sync_duration_ms" field.
+func LastSyncDurationMsGT(v int64) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldGT(FieldLastSyncDurationMs, v))
+}
+
+// LastSyncDurationMsGTE applies the GTE predicate on the "last_sync_duration_ms" field.
+func LastSyncDurationMsGTE(v int64) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldGTE(FieldLastSyncDurationMs, v))
+}
+
+// LastSyncDurationMsLT applies the LT predicate on the "last_sync_duration_ms" field.
+func LastSyncDurationMsLT(v int64) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldLT(FieldLastSyncDurationMs, v))
+}
+
+// LastSyncDurationMsLTE applies the LTE predicate on the "last_sync_duration_ms" field.
+func LastSyncDurationMsLTE(v int64) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldLTE(FieldLastSyncDurationMs, v))
+}
+
+// LastSyncErrorEQ applies the EQ predicate on the "last_sync_error" field.
+func LastSyncErrorEQ(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldEQ(FieldLastSyncError, v))
+}
+
+// LastSyncErrorNEQ applies the NEQ predicate on the "last_sync_error" field.
+func LastSyncErrorNEQ(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldNEQ(FieldLastSyncError, v))
+}
+
+// LastSyncErrorIn applies the In predicate on the "last_sync_error" field.
+func LastSyncErrorIn(vs ...string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldIn(FieldLastSyncError, vs...))
+}
+
+// LastSyncErrorNotIn applies the NotIn predicate on the "last_sync_error" field.
+func LastSyncErrorNotIn(vs ...string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldNotIn(FieldLastSyncError, vs...))
+}
+
+// LastSyncErrorGT applies the GT predicate on the "last_sync_error" field.
+func LastSyncErrorGT(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldGT(FieldLastSyncError, v))
+}
+
+// LastSyncErrorGTE applies the GTE predicate on the "last_sync_error" field.
+func LastSyncErrorGTE(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldGTE(FieldLastSyncError, v))
+}
+
+// LastSyncErrorLT applies the LT predicate on the "last_sync_error" field.
+func LastSyncErrorLT(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldLT(FieldLastSyncError, v))
+}
+
+// LastSyncErrorLTE applies the LTE predicate on the "last_sync_error" field.
+func LastSyncErrorLTE(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldLTE(FieldLastSyncError, v))
+}
+
+// LastSyncErrorContains applies the Contains predicate on the "last_sync_error" field.
+func LastSyncErrorContains(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldContains(FieldLastSyncError, v))
+}
+
+// LastSyncErrorHasPrefix applies the HasPrefix predicate on the "last_sync_error" field.
+func LastSyncErrorHasPrefix(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldHasPrefix(FieldLastSyncError, v))
+}
+
+// LastSyncErrorHasSuffix applies the HasSuffix predicate on the "last_sync_error" field.
+func LastSyncErrorHasSuffix(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldHasSuffix(FieldLastSyncError, v))
+}
+
+// LastSyncErrorIsNil applies the IsNil predicate on the "last_sync_error" field.
+func LastSyncErrorIsNil() predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldIsNull(FieldLastSyncError))
+}
+
+// LastSyncErrorNotNil applies the NotNil predicate on the "last_sync_error" field.
+func LastSyncErrorNotNil() predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldNotNull(FieldLastSyncError))
+}
+
+// LastSyncErrorEqualFold applies the EqualFold predicate on the "last_sync_error" field.
+func LastSyncErrorEqualFold(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldEqualFold(FieldLastSyncError, v))
+}
+
+// LastSyncErrorContainsFold applies the ContainsFold predicate on the "last_sync_error" field.
+func LastSyncErrorContainsFold(v string) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldContainsFold(FieldLastSyncError, v))
+}
+
+// SyncFailuresEQ applies the EQ predicate on the "sync_failures" field.
+func SyncFailuresEQ(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldEQ(FieldSyncFailures, v))
+}
+
+// SyncFailuresNEQ applies the NEQ predicate on the "sync_failures" field.
+func SyncFailuresNEQ(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldNEQ(FieldSyncFailures, v))
+}
+
+// SyncFailuresIn applies the In predicate on the "sync_failures" field.
+func SyncFailuresIn(vs ...int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldIn(FieldSyncFailures, vs...))
+}
+
+// SyncFailuresNotIn applies the NotIn predicate on the "sync_failures" field.
+func SyncFailuresNotIn(vs ...int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldNotIn(FieldSyncFailures, vs...))
+}
+
+// SyncFailuresGT applies the GT predicate on the "sync_failures" field.
+func SyncFailuresGT(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldGT(FieldSyncFailures, v))
+}
+
+// SyncFailuresGTE applies the GTE predicate on the "sync_failures" field.
+func SyncFailuresGTE(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldGTE(FieldSyncFailures, v))
+}
+
+// SyncFailuresLT applies the LT predicate on the "sync_failures" field.
+func SyncFailuresLT(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldLT(FieldSyncFailures, v))
+}
+
+// SyncFailuresLTE applies the LTE predicate on the "sync_failures" field.
+func SyncFailuresLTE(v int) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldLTE(FieldSyncFailures, v))
+}
+
+// SyncRetryAtEQ applies the EQ predicate on the "sync_retry_at" field.
+func SyncRetryAtEQ(v time.Time) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldEQ(FieldSyncRetryAt, v))
+}
+
+// SyncRetryAtNEQ applies the NEQ predicate on the "sync_retry_at" field.
+func SyncRetryAtNEQ(v time.Time) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldNEQ(FieldSyncRetryAt, v))
+}
+
+// SyncRetryAtIn applies the In predicate on the "sync_retry_at" field.
+func SyncRetryAtIn(vs ...time.Time) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldIn(FieldSyncRetryAt, vs...))
+}
+
+// SyncRetryAtNotIn applies the NotIn predicate on the "sync_retry_at" field.
+func SyncRetryAtNotIn(vs ...time.Time) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldNotIn(FieldSyncRetryAt, vs...))
+}
+
+// SyncRetryAtGT applies the GT predicate on the "sync_retry_at" field.
+func SyncRetryAtGT(v time.Time) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldGT(FieldSyncRetryAt, v))
+}
+
+// SyncRetryAtGTE applies the GTE predicate on the "sync_retry_at" field.
+func SyncRetryAtGTE(v time.Time) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldGTE(FieldSyncRetryAt, v))
+}
+
+// SyncRetryAtLT applies the LT predicate on the "sync_retry_at" field.
+func SyncRetryAtLT(v time.Time) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldLT(FieldSyncRetryAt, v))
+}
+
+// SyncRetryAtLTE applies the LTE predicate on the "sync_retry_at" field.
+func SyncRetryAtLTE(v time.Time) predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldLTE(FieldSyncRetryAt, v))
+}
+
+// SyncRetryAtIsNil applies the IsNil predicate on the "sync_retry_at" field.
+func SyncRetryAtIsNil() predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldIsNull(FieldSyncRetryAt))
+}
+
+// SyncRetryAtNotNil applies the NotNil predicate on the "sync_retry_at" field.
+func SyncRetryAtNotNil() predicate.AuthProvider {
+	return predicate.AuthProvider(sql.FieldNotNull(FieldSyncRetryAt))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.AuthProvider) predicate.AuthProvider {
 	return predicate.AuthProvider(sql.AndPredicates(predicates...))
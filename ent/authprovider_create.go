@@ -100,6 +100,132 @@ func (_c *AuthProviderCreate) SetCreatedBy(v string) *AuthProviderCreate {
 	return _c
 }
 
+// SetSyncSchedule sets the "sync_schedule" field.
+func (_c *AuthProviderCreate) SetSyncSchedule(v string) *AuthProviderCreate {
+	_c.mutation.SetSyncSchedule(v)
+	return _c
+}
+
+// SetNillableSyncSchedule sets the "sync_schedule" field if the given value is not nil.
+func (_c *AuthProviderCreate) SetNillableSyncSchedule(v *string) *AuthProviderCreate {
+	if v != nil {
+		_c.SetSyncSchedule(*v)
+	}
+	return _c
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (_c *AuthProviderCreate) SetLastSyncAt(v time.Time) *AuthProviderCreate {
+	_c.mutation.SetLastSyncAt(v)
+	return _c
+}
+
+// SetNillableLastSyncAt sets the "last_sync_at" field if the given value is not nil.
+func (_c *AuthProviderCreate) SetNillableLastSyncAt(v *time.Time) *AuthProviderCreate {
+	if v != nil {
+		_c.SetLastSyncAt(*v)
+	}
+	return _c
+}
+
+// SetLastSyncAdded sets the "last_sync_added" field.
+func (_c *AuthProviderCreate) SetLastSyncAdded(v int) *AuthProviderCreate {
+	_c.mutation.SetLastSyncAdded(v)
+	return _c
+}
+
+// SetNillableLastSyncAdded sets the "last_sync_added" field if the given value is not nil.
+func (_c *AuthProviderCreate) SetNillableLastSyncAdded(v *int) *AuthProviderCreate {
+	if v != nil {
+		_c.SetLastSyncAdded(*v)
+	}
+	return _c
+}
+
+// SetLastSyncUpdated sets the "last_sync_updated" field.
+func (_c *AuthProviderCreate) SetLastSyncUpdated(v int) *AuthProviderCreate {
+	_c.mutation.SetLastSyncUpdated(v)
+	return _c
+}
+
+// SetNillableLastSyncUpdated sets the "last_sync_updated" field if the given value is not nil.
+func (_c *AuthProviderCreate) SetNillableLastSyncUpdated(v *int) *AuthProviderCreate {
+	if v != nil {
+		_c.SetLastSyncUpdated(*v)
+	}
+	return _c
+}
+
+// SetLastSyncStale sets the "last_sync_stale" field.
+func (_c *AuthProviderCreate) SetLastSyncStale(v int) *AuthProviderCreate {
+	_c.mutation.SetLastSyncStale(v)
+	return _c
+}
+
+// SetNillableLastSyncStale sets the "last_sync_stale" field if the given value is not nil.
+func (_c *AuthProviderCreate) SetNillableLastSyncStale(v *int) *AuthProviderCreate {
+	if v != nil {
+		_c.SetLastSyncStale(*v)
+	}
+	return _c
+}
+
+// SetLastSyncDurationMs sets the "last_sync_duration_ms" field.
+func (_c *AuthProviderCreate) SetLastSyncDurationMs(v int64) *AuthProviderCreate {
+	_c.mutation.SetLastSyncDurationMs(v)
+	return _c
+}
+
+// SetNillableLastSyncDurationMs sets the "last_sync_duration_ms" field if the given value is not nil.
+func (_c *AuthProviderCreate) SetNillableLastSyncDurationMs(v *int64) *AuthProviderCreate {
+	if v != nil {
+		_c.SetLastSyncDurationMs(*v)
+	}
+	return _c
+}
+
+// SetLastSyncError sets the "last_sync_error" field.
+func (_c *AuthProviderCreate) SetLastSyncError(v string) *AuthProviderCreate {
+	_c.mutation.SetLastSyncError(v)
+	return _c
+}
+
+// SetNillableLastSyncError sets the "last_sync_error" field if the given value is not nil.
+func (_c *AuthProviderCreate) SetNillableLastSyncError(v *string) *AuthProviderCreate {
+	if v != nil {
+		_c.SetLastSyncError(*v)
+	}
+	return _c
+}
+
+// SetSyncFailures sets the "sync_failures" field.
+func (_c *AuthProviderCreate) SetSyncFailures(v int) *AuthProviderCreate {
+	_c.mutation.SetSyncFailures(v)
+	return _c
+}
+
+// SetNillableSyncFailures sets the "sync_failures" field if the given value is not nil.
+func (_c *AuthProviderCreate) SetNillableSyncFailures(v *int) *AuthProviderCreate {
+	if v != nil {
+		_c.SetSyncFailures(*v)
+	}
+	return _c
+}
+
+// SetSyncRetryAt sets the "sync_retry_at" field.
+func (_c *AuthProviderCreate) SetSyncRetryAt(v time.Time) *AuthProviderCreate {
+	_c.mutation.SetSyncRetryAt(v)
+	return _c
+}
+
+// SetNillableSyncRetryAt sets the "sync_retry_at" field if the given value is not nil.
+func (_c *AuthProviderCreate) SetNillableSyncRetryAt(v *time.Time) *AuthProviderCreate {
+	if v != nil {
+		_c.SetSyncRetryAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AuthProviderCreate) SetID(v string) *AuthProviderCreate {
 	_c.mutation.SetID(v)
@@ -157,6 +283,26 @@ func (_c *AuthProviderCreate) defaults() {
 		v := authprovider.DefaultSortOrder
 		_c.mutation.SetSortOrder(v)
 	}
+	if _, ok := _c.mutation.LastSyncAdded(); !ok {
+		v := authprovider.DefaultLastSyncAdded
+		_c.mutation.SetLastSyncAdded(v)
+	}
+	if _, ok := _c.mutation.LastSyncUpdated(); !ok {
+		v := authprovider.DefaultLastSyncUpdated
+		_c.mutation.SetLastSyncUpdated(v)
+	}
+	if _, ok := _c.mutation.LastSyncStale(); !ok {
+		v := authprovider.DefaultLastSyncStale
+		_c.mutation.SetLastSyncStale(v)
+	}
+	if _, ok := _c.mutation.LastSyncDurationMs(); !ok {
+		v := authprovider.DefaultLastSyncDurationMs
+		_c.mutation.SetLastSyncDurationMs(v)
+	}
+	if _, ok := _c.mutation.SyncFailures(); !ok {
+		v := authprovider.DefaultSyncFailures
+		_c.mutation.SetSyncFailures(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -200,6 +346,21 @@ func (_c *AuthProviderCreate) check() error {
 			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "AuthProvider.created_by": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.LastSyncAdded(); !ok {
+		return &ValidationError{Name: "last_sync_added", err: errors.New(`ent: missing required field "AuthProvider.last_sync_added"`)}
+	}
+	if _, ok := _c.mutation.LastSyncUpdated(); !ok {
+		return &ValidationError{Name: "last_sync_updated", err: errors.New(`ent: missing required field "AuthProvider.last_sync_updated"`)}
+	}
+	if _, ok := _c.mutation.LastSyncStale(); !ok {
+		return &ValidationError{Name: "last_sync_stale", err: errors.New(`ent: missing required field "AuthProvider.last_sync_stale"`)}
+	}
+	if _, ok := _c.mutation.LastSyncDurationMs(); !ok {
+		return &ValidationError{Name: "last_sync_duration_ms", err: errors.New(`ent: missing required field "AuthProvider.last_sync_duration_ms"`)}
+	}
+	if _, ok := _c.mutation.SyncFailures(); !ok {
+		return &ValidationError{Name: "sync_failures", err: errors.New(`ent: missing required field "AuthProvider.sync_failures"`)}
+	}
 	return nil
 }
 
@@ -267,6 +428,42 @@ func (_c *AuthProviderCreate) createSpec() (*AuthProvider, *sqlgraph.CreateSpec)
 		_spec.SetField(authprovider.FieldCreatedBy, field.TypeString, value)
 		_node.CreatedBy = value
 	}
+	if value, ok := _c.mutation.SyncSchedule(); ok {
+		_spec.SetField(authprovider.FieldSyncSchedule, field.TypeString, value)
+		_node.SyncSchedule = value
+	}
+	if value, ok := _c.mutation.LastSyncAt(); ok {
+		_spec.SetField(authprovider.FieldLastSyncAt, field.TypeTime, value)
+		_node.LastSyncAt = &value
+	}
+	if value, ok := _c.mutation.LastSyncAdded(); ok {
+		_spec.SetField(authprovider.FieldLastSyncAdded, field.TypeInt, value)
+		_node.LastSyncAdded = value
+	}
+	if value, ok := _c.mutation.LastSyncUpdated(); ok {
+		_spec.SetField(authprovider.FieldLastSyncUpdated, field.TypeInt, value)
+		_node.LastSyncUpdated = value
+	}
+	if value, ok := _c.mutation.LastSyncStale(); ok {
+		_spec.SetField(authprovider.FieldLastSyncStale, field.TypeInt, value)
+		_node.LastSyncStale = value
+	}
+	if value, ok := _c.mutation.LastSyncDurationMs(); ok {
+		_spec.SetField(authprovider.FieldLastSyncDurationMs, field.TypeInt64, value)
+		_node.LastSyncDurationMs = value
+	}
+	if value, ok := _c.mutation.LastSyncError(); ok {
+		_spec.SetField(authprovider.FieldLastSyncError, field.TypeString, value)
+		_node.LastSyncError = value
+	}
+	if value, ok := _c.mutation.SyncFailures(); ok {
+		_spec.SetField(authprovider.FieldSyncFailures, field.TypeInt, value)
+		_node.SyncFailures = value
+	}
+	if value, ok := _c.mutation.SyncRetryAt(); ok {
+		_spec.SetField(authprovider.FieldSyncRetryAt, field.TypeTime, value)
+		_node.SyncRetryAt = &value
+	}
 	return _node, _spec
 }
 
@@ -117,6 +117,191 @@ func (_u *AuthProviderUpdate) SetNillableCreatedBy(v *string) *AuthProviderUpdat
 	return _u
 }
 
+// SetSyncSchedule sets the "sync_schedule" field.
+func (_u *AuthProviderUpdate) SetSyncSchedule(v string) *AuthProviderUpdate {
+	_u.mutation.SetSyncSchedule(v)
+	return _u
+}
+
+// SetNillableSyncSchedule sets the "sync_schedule" field if the given value is not nil.
+func (_u *AuthProviderUpdate) SetNillableSyncSchedule(v *string) *AuthProviderUpdate {
+	if v != nil {
+		_u.SetSyncSchedule(*v)
+	}
+	return _u
+}
+
+// ClearSyncSchedule clears the value of the "sync_schedule" field.
+func (_u *AuthProviderUpdate) ClearSyncSchedule() *AuthProviderUpdate {
+	_u.mutation.ClearSyncSchedule()
+	return _u
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (_u *AuthProviderUpdate) SetLastSyncAt(v time.Time) *AuthProviderUpdate {
+	_u.mutation.SetLastSyncAt(v)
+	return _u
+}
+
+// SetNillableLastSyncAt sets the "last_sync_at" field if the given value is not nil.
+func (_u *AuthProviderUpdate) SetNillableLastSyncAt(v *time.Time) *AuthProviderUpdate {
+	if v != nil {
+		_u.SetLastSyncAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSyncAt clears the value of the "last_sync_at" field.
+func (_u *AuthProviderUpdate) ClearLastSyncAt() *AuthProviderUpdate {
+	_u.mutation.ClearLastSyncAt()
+	return _u
+}
+
+// SetLastSyncAdded sets the "last_sync_added" field.
+func (_u *AuthProviderUpdate) SetLastSyncAdded(v int) *AuthProviderUpdate {
+	_u.mutation.ResetLastSyncAdded()
+	_u.mutation.SetLastSyncAdded(v)
+	return _u
+}
+
+// SetNillableLastSyncAdded sets the "last_sync_added" field if the given value is not nil.
+func (_u *AuthProviderUpdate) SetNillableLastSyncAdded(v *int) *AuthProviderUpdate {
+	if v != nil {
+		_u.SetLastSyncAdded(*v)
+	}
+	return _u
+}
+
+// AddLastSyncAdded adds value to the "last_sync_added" field.
+func (_u *AuthProviderUpdate) AddLastSyncAdded(v int) *AuthProviderUpdate {
+	_u.mutation.AddLastSyncAdded(v)
+	return _u
+}
+
+// SetLastSyncUpdated sets the "last_sync_updated" field.
+func (_u *AuthProviderUpdate) SetLastSyncUpdated(v int) *AuthProviderUpdate {
+	_u.mutation.ResetLastSyncUpdated()
+	_u.mutation.SetLastSyncUpdated(v)
+	return _u
+}
+
+// SetNillableLastSyncUpdated sets the "last_sync_updated" field if the given value is not nil.
+func (_u *AuthProviderUpdate) SetNillableLastSyncUpdated(v *int) *AuthProviderUpdate {
+	if v != nil {
+		_u.SetLastSyncUpdated(*v)
+	}
+	return _u
+}
+
+// AddLastSyncUpdated adds value to the "last_sync_updated" field.
+func (_u *AuthProviderUpdate) AddLastSyncUpdated(v int) *AuthProviderUpdate {
+	_u.mutation.AddLastSyncUpdated(v)
+	return _u
+}
+
+// SetLastSyncStale sets the "last_sync_stale" field.
+func (_u *AuthProviderUpdate) SetLastSyncStale(v int) *AuthProviderUpdate {
+	_u.mutation.ResetLastSyncStale()
+	_u.mutation.SetLastSyncStale(v)
+	return _u
+}
+
+// SetNillableLastSyncStale sets the "last_sync_stale" field if the given value is not nil.
+func (_u *AuthProviderUpdate) SetNillableLastSyncStale(v *int) *AuthProviderUpdate {
+	if v != nil {
+		_u.SetLastSyncStale(*v)
+	}
+	return _u
+}
+
+// AddLastSyncStale adds value to the "last_sync_stale" field.
+func (_u *AuthProviderUpdate) AddLastSyncStale(v int) *AuthProviderUpdate {
+	_u.mutation.AddLastSyncStale(v)
+	return _u
+}
+
+// SetLastSyncDurationMs sets the "last_sync_duration_ms" field.
+func (_u *AuthProviderUpdate) SetLastSyncDurationMs(v int64) *AuthProviderUpdate {
+	_u.mutation.ResetLastSyncDurationMs()
+	_u.mutation.SetLastSyncDurationMs(v)
+	return _u
+}
+
+// SetNillableLastSyncDurationMs sets the "last_sync_duration_ms" field if the given value is not nil.
+func (_u *AuthProviderUpdate) SetNillableLastSyncDurationMs(v *int64) *AuthProviderUpdate {
+	if v != nil {
+		_u.SetLastSyncDurationMs(*v)
+	}
+	return _u
+}
+
+// AddLastSyncDurationMs adds value to the "last_sync_duration_ms" field.
+func (_u *AuthProviderUpdate) AddLastSyncDurationMs(v int64) *AuthProviderUpdate {
+	_u.mutation.AddLastSyncDurationMs(v)
+	return _u
+}
+
+// SetLastSyncError sets the "last_sync_error" field.
+func (_u *AuthProviderUpdate) SetLastSyncError(v string) *AuthProviderUpdate {
+	_u.mutation.SetLastSyncError(v)
+	return _u
+}
+
+// SetNillableLastSyncError sets the "last_sync_error" field if the given value is not nil.
+func (_u *AuthProviderUpdate) SetNillableLastSyncError(v *string) *AuthProviderUpdate {
+	if v != nil {
+		_u.SetLastSyncError(*v)
+	}
+	return _u
+}
+
+// ClearLastSyncError clears the value of the "last_sync_error" field.
+func (_u *AuthProviderUpdate) ClearLastSyncError() *AuthProviderUpdate {
+	_u.mutation.ClearLastSyncError()
+	return _u
+}
+
+// SetSyncFailures sets the "sync_failures" field.
+func (_u *AuthProviderUpdate) SetSyncFailures(v int) *AuthProviderUpdate {
+	_u.mutation.ResetSyncFailures()
+	_u.mutation.SetSyncFailures(v)
+	return _u
+}
+
+// SetNillableSyncFailures sets the "sync_failures" field if the given value is not nil.
+func (_u *AuthProviderUpdate) SetNillableSyncFailures(v *int) *AuthProviderUpdate {
+	if v != nil {
+		_u.SetSyncFailures(*v)
+	}
+	return _u
+}
+
+// AddSyncFailures adds value to the "sync_failures" field.
+func (_u *AuthProviderUpdate) AddSyncFailures(v int) *AuthProviderUpdate {
+	_u.mutation.AddSyncFailures(v)
+	return _u
+}
+
+// SetSyncRetryAt sets the "sync_retry_at" field.
+func (_u *AuthProviderUpdate) SetSyncRetryAt(v time.Time) *AuthProviderUpdate {
+	_u.mutation.SetSyncRetryAt(v)
+	return _u
+}
+
+// SetNillableSyncRetryAt sets the "sync_retry_at" field if the given value is not nil.
+func (_u *AuthProviderUpdate) SetNillableSyncRetryAt(v *time.Time) *AuthProviderUpdate {
+	if v != nil {
+		_u.SetSyncRetryAt(*v)
+	}
+	return _u
+}
+
+// ClearSyncRetryAt clears the value of the "sync_retry_at" field.
+func (_u *AuthProviderUpdate) ClearSyncRetryAt() *AuthProviderUpdate {
+	_u.mutation.ClearSyncRetryAt()
+	return _u
+}
+
 // Mutation returns the AuthProviderMutation object of the builder.
 func (_u *AuthProviderUpdate) Mutation() *AuthProviderMutation {
 	return _u.mutation
@@ -214,6 +399,60 @@ func (_u *AuthProviderUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.CreatedBy(); ok {
 		_spec.SetField(authprovider.FieldCreatedBy, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.SyncSchedule(); ok {
+		_spec.SetField(authprovider.FieldSyncSchedule, field.TypeString, value)
+	}
+	if _u.mutation.SyncScheduleCleared() {
+		_spec.ClearField(authprovider.FieldSyncSchedule, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastSyncAt(); ok {
+		_spec.SetField(authprovider.FieldLastSyncAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSyncAtCleared() {
+		_spec.ClearField(authprovider.FieldLastSyncAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSyncAdded(); ok {
+		_spec.SetField(authprovider.FieldLastSyncAdded, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastSyncAdded(); ok {
+		_spec.AddField(authprovider.FieldLastSyncAdded, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastSyncUpdated(); ok {
+		_spec.SetField(authprovider.FieldLastSyncUpdated, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastSyncUpdated(); ok {
+		_spec.AddField(authprovider.FieldLastSyncUpdated, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastSyncStale(); ok {
+		_spec.SetField(authprovider.FieldLastSyncStale, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastSyncStale(); ok {
+		_spec.AddField(authprovider.FieldLastSyncStale, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastSyncDurationMs(); ok {
+		_spec.SetField(authprovider.FieldLastSyncDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastSyncDurationMs(); ok {
+		_spec.AddField(authprovider.FieldLastSyncDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LastSyncError(); ok {
+		_spec.SetField(authprovider.FieldLastSyncError, field.TypeString, value)
+	}
+	if _u.mutation.LastSyncErrorCleared() {
+		_spec.ClearField(authprovider.FieldLastSyncError, field.TypeString)
+	}
+	if value, ok := _u.mutation.SyncFailures(); ok {
+		_spec.SetField(authprovider.FieldSyncFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSyncFailures(); ok {
+		_spec.AddField(authprovider.FieldSyncFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SyncRetryAt(); ok {
+		_spec.SetField(authprovider.FieldSyncRetryAt, field.TypeTime, value)
+	}
+	if _u.mutation.SyncRetryAtCleared() {
+		_spec.ClearField(authprovider.FieldSyncRetryAt, field.TypeTime)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{authprovider.Label}
@@ -323,6 +562,191 @@ func (_u *AuthProviderUpdateOne) SetNillableCreatedBy(v *string) *AuthProviderUp
 	return _u
 }
 
+// SetSyncSchedule sets the "sync_schedule" field.
+func (_u *AuthProviderUpdateOne) SetSyncSchedule(v string) *AuthProviderUpdateOne {
+	_u.mutation.SetSyncSchedule(v)
+	return _u
+}
+
+// SetNillableSyncSchedule sets the "sync_schedule" field if the given value is not nil.
+func (_u *AuthProviderUpdateOne) SetNillableSyncSchedule(v *string) *AuthProviderUpdateOne {
+	if v != nil {
+		_u.SetSyncSchedule(*v)
+	}
+	return _u
+}
+
+// ClearSyncSchedule clears the value of the "sync_schedule" field.
+func (_u *AuthProviderUpdateOne) ClearSyncSchedule() *AuthProviderUpdateOne {
+	_u.mutation.ClearSyncSchedule()
+	return _u
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (_u *AuthProviderUpdateOne) SetLastSyncAt(v time.Time) *AuthProviderUpdateOne {
+	_u.mutation.SetLastSyncAt(v)
+	return _u
+}
+
+// SetNillableLastSyncAt sets the "last_sync_at" field if the given value is not nil.
+func (_u *AuthProviderUpdateOne) SetNillableLastSyncAt(v *time.Time) *AuthProviderUpdateOne {
+	if v != nil {
+		_u.SetLastSyncAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSyncAt clears the value of the "last_sync_at" field.
+func (_u *AuthProviderUpdateOne) ClearLastSyncAt() *AuthProviderUpdateOne {
+	_u.mutation.ClearLastSyncAt()
+	return _u
+}
+
+// SetLastSyncAdded sets the "last_sync_added" field.
+func (_u *AuthProviderUpdateOne) SetLastSyncAdded(v int) *AuthProviderUpdateOne {
+	_u.mutation.ResetLastSyncAdded()
+	_u.mutation.SetLastSyncAdded(v)
+	return _u
+}
+
+// SetNillableLastSyncAdded sets the "last_sync_added" field if the given value is not nil.
+func (_u *AuthProviderUpdateOne) SetNillableLastSyncAdded(v *int) *AuthProviderUpdateOne {
+	if v != nil {
+		_u.SetLastSyncAdded(*v)
+	}
+	return _u
+}
+
+// AddLastSyncAdded adds value to the "last_sync_added" field.
+func (_u *AuthProviderUpdateOne) AddLastSyncAdded(v int) *AuthProviderUpdateOne {
+	_u.mutation.AddLastSyncAdded(v)
+	return _u
+}
+
+// SetLastSyncUpdated sets the "last_sync_updated" field.
+func (_u *AuthProviderUpdateOne) SetLastSyncUpdated(v int) *AuthProviderUpdateOne {
+	_u.mutation.ResetLastSyncUpdated()
+	_u.mutation.SetLastSyncUpdated(v)
+	return _u
+}
+
+// SetNillableLastSyncUpdated sets the "last_sync_updated" field if the given value is not nil.
+func (_u *AuthProviderUpdateOne) SetNillableLastSyncUpdated(v *int) *AuthProviderUpdateOne {
+	if v != nil {
+		_u.SetLastSyncUpdated(*v)
+	}
+	return _u
+}
+
+// AddLastSyncUpdated adds value to the "last_sync_updated" field.
+func (_u *AuthProviderUpdateOne) AddLastSyncUpdated(v int) *AuthProviderUpdateOne {
+	_u.mutation.AddLastSyncUpdated(v)
+	return _u
+}
+
+// SetLastSyncStale sets the "last_sync_stale" field.
+func (_u *AuthProviderUpdateOne) SetLastSyncStale(v int) *AuthProviderUpdateOne {
+	_u.mutation.ResetLastSyncStale()
+	_u.mutation.SetLastSyncStale(v)
+	return _u
+}
+
+// SetNillableLastSyncStale sets the "last_sync_stale" field if the given value is not nil.
+func (_u *AuthProviderUpdateOne) SetNillableLastSyncStale(v *int) *AuthProviderUpdateOne {
+	if v != nil {
+		_u.SetLastSyncStale(*v)
+	}
+	return _u
+}
+
+// AddLastSyncStale adds value to the "last_sync_stale" field.
+func (_u *AuthProviderUpdateOne) AddLastSyncStale(v int) *AuthProviderUpdateOne {
+	_u.mutation.AddLastSyncStale(v)
+	return _u
+}
+
+// SetLastSyncDurationMs sets the "last_sync_duration_ms" field.
+func (_u *AuthProviderUpdateOne) SetLastSyncDurationMs(v int64) *AuthProviderUpdateOne {
+	_u.mutation.ResetLastSyncDurationMs()
+	_u.mutation.SetLastSyncDurationMs(v)
+	return _u
+}
+
+// SetNillableLastSyncDurationMs sets the "last_sync_duration_ms" field if the given value is not nil.
+func (_u *AuthProviderUpdateOne) SetNillableLastSyncDurationMs(v *int64) *AuthProviderUpdateOne {
+	if v != nil {
+		_u.SetLastSyncDurationMs(*v)
+	}
+	return _u
+}
+
+// AddLastSyncDurationMs adds value to the "last_sync_duration_ms" field.
+func (_u *AuthProviderUpdateOne) AddLastSyncDurationMs(v int64) *AuthProviderUpdateOne {
+	_u.mutation.AddLastSyncDurationMs(v)
+	return _u
+}
+
+// SetLastSyncError sets the "last_sync_error" field.
+func (_u *AuthProviderUpdateOne) SetLastSyncError(v string) *AuthProviderUpdateOne {
+	_u.mutation.SetLastSyncError(v)
+	return _u
+}
+
+// SetNillableLastSyncError sets the "last_sync_error" field if the given value is not nil.
+func (_u *AuthProviderUpdateOne) SetNillableLastSyncError(v *string) *AuthProviderUpdateOne {
+	if v != nil {
+		_u.SetLastSyncError(*v)
+	}
+	return _u
+}
+
+// ClearLastSyncError clears the value of the "last_sync_error" field.
+func (_u *AuthProviderUpdateOne) ClearLastSyncError() *AuthProviderUpdateOne {
+	_u.mutation.ClearLastSyncError()
+	return _u
+}
+
+// SetSyncFailures sets the "sync_failures" field.
+func (_u *AuthProviderUpdateOne) SetSyncFailures(v int) *AuthProviderUpdateOne {
+	_u.mutation.ResetSyncFailures()
+	_u.mutation.SetSyncFailures(v)
+	return _u
+}
+
+// SetNillableSyncFailures sets the "sync_failures" field if the given value is not nil.
+func (_u *AuthProviderUpdateOne) SetNillableSyncFailures(v *int) *AuthProviderUpdateOne {
+	if v != nil {
+		_u.SetSyncFailures(*v)
+	}
+	return _u
+}
+
+// AddSyncFailures adds value to the "sync_failures" field.
+func (_u *AuthProviderUpdateOne) AddSyncFailures(v int) *AuthProviderUpdateOne {
+	_u.mutation.AddSyncFailures(v)
+	return _u
+}
+
+// SetSyncRetryAt sets the "sync_retry_at" field.
+func (_u *AuthProviderUpdateOne) SetSyncRetryAt(v time.Time) *AuthProviderUpdateOne {
+	_u.mutation.SetSyncRetryAt(v)
+	return _u
+}
+
+// SetNillableSyncRetryAt sets the "sync_retry_at" field if the given value is not nil.
+func (_u *AuthProviderUpdateOne) SetNillableSyncRetryAt(v *time.Time) *AuthProviderUpdateOne {
+	if v != nil {
+		_u.SetSyncRetryAt(*v)
+	}
+	return _u
+}
+
+// ClearSyncRetryAt clears the value of the "sync_retry_at" field.
+func (_u *AuthProviderUpdateOne) ClearSyncRetryAt() *AuthProviderUpdateOne {
+	_u.mutation.ClearSyncRetryAt()
+	return _u
+}
+
 // Mutation returns the AuthProviderMutation object of the builder.
 func (_u *AuthProviderUpdateOne) Mutation() *AuthProviderMutation {
 	return _u.mutation
@@ -450,6 +874,60 @@ func (_u *AuthProviderUpdateOne) sqlSave(ctx context.Context) (_node *AuthProvid
 	if value, ok := _u.mutation.CreatedBy(); ok {
 		_spec.SetField(authprovider.FieldCreatedBy, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.SyncSchedule(); ok {
+		_spec.SetField(authprovider.FieldSyncSchedule, field.TypeString, value)
+	}
+	if _u.mutation.SyncScheduleCleared() {
+		_spec.ClearField(authprovider.FieldSyncSchedule, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastSyncAt(); ok {
+		_spec.SetField(authprovider.FieldLastSyncAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSyncAtCleared() {
+		_spec.ClearField(authprovider.FieldLastSyncAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSyncAdded(); ok {
+		_spec.SetField(authprovider.FieldLastSyncAdded, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastSyncAdded(); ok {
+		_spec.AddField(authprovider.FieldLastSyncAdded, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastSyncUpdated(); ok {
+		_spec.SetField(authprovider.FieldLastSyncUpdated, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastSyncUpdated(); ok {
+		_spec.AddField(authprovider.FieldLastSyncUpdated, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastSyncStale(); ok {
+		_spec.SetField(authprovider.FieldLastSyncStale, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastSyncStale(); ok {
+		_spec.AddField(authprovider.FieldLastSyncStale, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastSyncDurationMs(); ok {
+		_spec.SetField(authprovider.FieldLastSyncDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastSyncDurationMs(); ok {
+		_spec.AddField(authprovider.FieldLastSyncDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LastSyncError(); ok {
+		_spec.SetField(authprovider.FieldLastSyncError, field.TypeString, value)
+	}
+	if _u.mutation.LastSyncErrorCleared() {
+		_spec.ClearField(authprovider.FieldLastSyncError, field.TypeString)
+	}
+	if value, ok := _u.mutation.SyncFailures(); ok {
+		_spec.SetField(authprovider.FieldSyncFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSyncFailures(); ok {
+		_spec.AddField(authprovider.FieldSyncFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SyncRetryAt(); ok {
+		_spec.SetField(authprovider.FieldSyncRetryAt, field.TypeTime, value)
+	}
+	if _u.mutation.SyncRetryAtCleared() {
+		_spec.ClearField(authprovider.FieldSyncRetryAt, field.TypeTime)
+	}
 	_node = &AuthProvider{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
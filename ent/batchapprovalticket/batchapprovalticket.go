@@ -130,6 +130,7 @@ const DefaultStatus = StatusPENDING_APPROVAL
 // Status values.
 const (
 	StatusPENDING_APPROVAL Status = "PENDING_APPROVAL"
+	StatusPARTIAL_APPROVED Status = "PARTIAL_APPROVED"
 	StatusIN_PROGRESS      Status = "IN_PROGRESS"
 	StatusCOMPLETED        Status = "COMPLETED"
 	StatusPARTIAL_SUCCESS  Status = "PARTIAL_SUCCESS"
@@ -144,7 +145,7 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusPENDING_APPROVAL, StatusIN_PROGRESS, StatusCOMPLETED, StatusPARTIAL_SUCCESS, StatusFAILED, StatusCANCELLED:
+	case StatusPENDING_APPROVAL, StatusPARTIAL_APPROVED, StatusIN_PROGRESS, StatusCOMPLETED, StatusPARTIAL_SUCCESS, StatusFAILED, StatusCANCELLED:
 		return nil
 	default:
 		return fmt.Errorf("batchapprovalticket: invalid enum value for status field: %q", s)
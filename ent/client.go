@@ -15,6 +15,7 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"kv-shepherd.io/shepherd/ent/approvaldecision"
 	"kv-shepherd.io/shepherd/ent/approvalpolicy"
 	"kv-shepherd.io/shepherd/ent/approvalticket"
 	"kv-shepherd.io/shepherd/ent/auditlog"
@@ -26,21 +27,39 @@ import (
 	"kv-shepherd.io/shepherd/ent/idpgroupmapping"
 	"kv-shepherd.io/shepherd/ent/idpsyncedgroup"
 	"kv-shepherd.io/shepherd/ent/instancesize"
+	"kv-shepherd.io/shepherd/ent/instancesizecluster"
+	"kv-shepherd.io/shepherd/ent/loginsession"
+	"kv-shepherd.io/shepherd/ent/loginthrottle"
+	"kv-shepherd.io/shepherd/ent/namespacequota"
 	"kv-shepherd.io/shepherd/ent/namespaceregistry"
 	"kv-shepherd.io/shepherd/ent/notification"
+	"kv-shepherd.io/shepherd/ent/notificationpreference"
+	"kv-shepherd.io/shepherd/ent/passwordhistory"
 	"kv-shepherd.io/shepherd/ent/pendingadoption"
+	"kv-shepherd.io/shepherd/ent/platformconfig"
+	"kv-shepherd.io/shepherd/ent/quota"
 	"kv-shepherd.io/shepherd/ent/ratelimitexemption"
 	"kv-shepherd.io/shepherd/ent/ratelimituseroverride"
+	"kv-shepherd.io/shepherd/ent/refreshtoken"
 	"kv-shepherd.io/shepherd/ent/resourcerolebinding"
+	"kv-shepherd.io/shepherd/ent/revokedsession"
 	"kv-shepherd.io/shepherd/ent/role"
 	"kv-shepherd.io/shepherd/ent/rolebinding"
+	"kv-shepherd.io/shepherd/ent/scheduledbatchjob"
 	"kv-shepherd.io/shepherd/ent/service"
 	"kv-shepherd.io/shepherd/ent/system"
 	"kv-shepherd.io/shepherd/ent/systemsecret"
 	"kv-shepherd.io/shepherd/ent/template"
+	"kv-shepherd.io/shepherd/ent/ticketcomment"
 	"kv-shepherd.io/shepherd/ent/user"
 	"kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/ent/vmconsolesession"
+	"kv-shepherd.io/shepherd/ent/vmrequestidempotency"
 	"kv-shepherd.io/shepherd/ent/vmrevision"
+	"kv-shepherd.io/shepherd/ent/webhookdelivery"
+	"kv-shepherd.io/shepherd/ent/webhookendpoint"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -48,6 +67,8 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// ApprovalDecision is the client for interacting with the ApprovalDecision builders.
+	ApprovalDecision *ApprovalDecisionClient
 	// ApprovalPolicy is the client for interacting with the ApprovalPolicy builders.
 	ApprovalPolicy *ApprovalPolicyClient
 	// ApprovalTicket is the client for interacting with the ApprovalTicket builders.
@@ -70,22 +91,44 @@ type Client struct {
 	IdPSyncedGroup *IdPSyncedGroupClient
 	// InstanceSize is the client for interacting with the InstanceSize builders.
 	InstanceSize *InstanceSizeClient
+	// InstanceSizeCluster is the client for interacting with the InstanceSizeCluster builders.
+	InstanceSizeCluster *InstanceSizeClusterClient
+	// LoginSession is the client for interacting with the LoginSession builders.
+	LoginSession *LoginSessionClient
+	// LoginThrottle is the client for interacting with the LoginThrottle builders.
+	LoginThrottle *LoginThrottleClient
+	// NamespaceQuota is the client for interacting with the NamespaceQuota builders.
+	NamespaceQuota *NamespaceQuotaClient
 	// NamespaceRegistry is the client for interacting with the NamespaceRegistry builders.
 	NamespaceRegistry *NamespaceRegistryClient
 	// Notification is the client for interacting with the Notification builders.
 	Notification *NotificationClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
+	// PasswordHistory is the client for interacting with the PasswordHistory builders.
+	PasswordHistory *PasswordHistoryClient
 	// PendingAdoption is the client for interacting with the PendingAdoption builders.
 	PendingAdoption *PendingAdoptionClient
+	// PlatformConfig is the client for interacting with the PlatformConfig builders.
+	PlatformConfig *PlatformConfigClient
+	// Quota is the client for interacting with the Quota builders.
+	Quota *QuotaClient
 	// RateLimitExemption is the client for interacting with the RateLimitExemption builders.
 	RateLimitExemption *RateLimitExemptionClient
 	// RateLimitUserOverride is the client for interacting with the RateLimitUserOverride builders.
 	RateLimitUserOverride *RateLimitUserOverrideClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
 	// ResourceRoleBinding is the client for interacting with the ResourceRoleBinding builders.
 	ResourceRoleBinding *ResourceRoleBindingClient
+	// RevokedSession is the client for interacting with the RevokedSession builders.
+	RevokedSession *RevokedSessionClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
 	// RoleBinding is the client for interacting with the RoleBinding builders.
 	RoleBinding *RoleBindingClient
+	// ScheduledBatchJob is the client for interacting with the ScheduledBatchJob builders.
+	ScheduledBatchJob *ScheduledBatchJobClient
 	// Service is the client for interacting with the Service builders.
 	Service *ServiceClient
 	// System is the client for interacting with the System builders.
@@ -94,12 +137,22 @@ type Client struct {
 	SystemSecret *SystemSecretClient
 	// Template is the client for interacting with the Template builders.
 	Template *TemplateClient
+	// TicketComment is the client for interacting with the TicketComment builders.
+	TicketComment *TicketCommentClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// VM is the client for interacting with the VM builders.
 	VM *VMClient
+	// VMConsoleSession is the client for interacting with the VMConsoleSession builders.
+	VMConsoleSession *VMConsoleSessionClient
+	// VMRequestIdempotency is the client for interacting with the VMRequestIdempotency builders.
+	VMRequestIdempotency *VMRequestIdempotencyClient
 	// VMRevision is the client for interacting with the VMRevision builders.
 	VMRevision *VMRevisionClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookEndpoint is the client for interacting with the WebhookEndpoint builders.
+	WebhookEndpoint *WebhookEndpointClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -111,6 +164,7 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.ApprovalDecision = NewApprovalDecisionClient(c.config)
 	c.ApprovalPolicy = NewApprovalPolicyClient(c.config)
 	c.ApprovalTicket = NewApprovalTicketClient(c.config)
 	c.AuditLog = NewAuditLogClient(c.config)
@@ -122,21 +176,37 @@ func (c *Client) init() {
 	c.IdPGroupMapping = NewIdPGroupMappingClient(c.config)
 	c.IdPSyncedGroup = NewIdPSyncedGroupClient(c.config)
 	c.InstanceSize = NewInstanceSizeClient(c.config)
+	c.InstanceSizeCluster = NewInstanceSizeClusterClient(c.config)
+	c.LoginSession = NewLoginSessionClient(c.config)
+	c.LoginThrottle = NewLoginThrottleClient(c.config)
+	c.NamespaceQuota = NewNamespaceQuotaClient(c.config)
 	c.NamespaceRegistry = NewNamespaceRegistryClient(c.config)
 	c.Notification = NewNotificationClient(c.config)
+	c.NotificationPreference = NewNotificationPreferenceClient(c.config)
+	c.PasswordHistory = NewPasswordHistoryClient(c.config)
 	c.PendingAdoption = NewPendingAdoptionClient(c.config)
+	c.PlatformConfig = NewPlatformConfigClient(c.config)
+	c.Quota = NewQuotaClient(c.config)
 	c.RateLimitExemption = NewRateLimitExemptionClient(c.config)
 	c.RateLimitUserOverride = NewRateLimitUserOverrideClient(c.config)
+	c.RefreshToken = NewRefreshTokenClient(c.config)
 	c.ResourceRoleBinding = NewResourceRoleBindingClient(c.config)
+	c.RevokedSession = NewRevokedSessionClient(c.config)
 	c.Role = NewRoleClient(c.config)
 	c.RoleBinding = NewRoleBindingClient(c.config)
+	c.ScheduledBatchJob = NewScheduledBatchJobClient(c.config)
 	c.Service = NewServiceClient(c.config)
 	c.System = NewSystemClient(c.config)
 	c.SystemSecret = NewSystemSecretClient(c.config)
 	c.Template = NewTemplateClient(c.config)
+	c.TicketComment = NewTicketCommentClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.VM = NewVMClient(c.config)
+	c.VMConsoleSession = NewVMConsoleSessionClient(c.config)
+	c.VMRequestIdempotency = NewVMRequestIdempotencyClient(c.config)
 	c.VMRevision = NewVMRevisionClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
+	c.WebhookEndpoint = NewWebhookEndpointClient(c.config)
 }
 
 type (
@@ -229,6 +299,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:                    ctx,
 		config:                 cfg,
+		ApprovalDecision:       NewApprovalDecisionClient(cfg),
 		ApprovalPolicy:         NewApprovalPolicyClient(cfg),
 		ApprovalTicket:         NewApprovalTicketClient(cfg),
 		AuditLog:               NewAuditLogClient(cfg),
@@ -240,21 +311,37 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		IdPGroupMapping:        NewIdPGroupMappingClient(cfg),
 		IdPSyncedGroup:         NewIdPSyncedGroupClient(cfg),
 		InstanceSize:           NewInstanceSizeClient(cfg),
+		InstanceSizeCluster:    NewInstanceSizeClusterClient(cfg),
+		LoginSession:           NewLoginSessionClient(cfg),
+		LoginThrottle:          NewLoginThrottleClient(cfg),
+		NamespaceQuota:         NewNamespaceQuotaClient(cfg),
 		NamespaceRegistry:      NewNamespaceRegistryClient(cfg),
 		Notification:           NewNotificationClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		PasswordHistory:        NewPasswordHistoryClient(cfg),
 		PendingAdoption:        NewPendingAdoptionClient(cfg),
+		PlatformConfig:         NewPlatformConfigClient(cfg),
+		Quota:                  NewQuotaClient(cfg),
 		RateLimitExemption:     NewRateLimitExemptionClient(cfg),
 		RateLimitUserOverride:  NewRateLimitUserOverrideClient(cfg),
+		RefreshToken:           NewRefreshTokenClient(cfg),
 		ResourceRoleBinding:    NewResourceRoleBindingClient(cfg),
+		RevokedSession:         NewRevokedSessionClient(cfg),
 		Role:                   NewRoleClient(cfg),
 		RoleBinding:            NewRoleBindingClient(cfg),
+		ScheduledBatchJob:      NewScheduledBatchJobClient(cfg),
 		Service:                NewServiceClient(cfg),
 		System:                 NewSystemClient(cfg),
 		SystemSecret:           NewSystemSecretClient(cfg),
 		Template:               NewTemplateClient(cfg),
+		TicketComment:          NewTicketCommentClient(cfg),
 		User:                   NewUserClient(cfg),
 		VM:                     NewVMClient(cfg),
+		VMConsoleSession:       NewVMConsoleSessionClient(cfg),
+		VMRequestIdempotency:   NewVMRequestIdempotencyClient(cfg),
 		VMRevision:             NewVMRevisionClient(cfg),
+		WebhookDelivery:        NewWebhookDeliveryClient(cfg),
+		WebhookEndpoint:        NewWebhookEndpointClient(cfg),
 	}, nil
 }
 
@@ -274,6 +361,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:                    ctx,
 		config:                 cfg,
+		ApprovalDecision:       NewApprovalDecisionClient(cfg),
 		ApprovalPolicy:         NewApprovalPolicyClient(cfg),
 		ApprovalTicket:         NewApprovalTicketClient(cfg),
 		AuditLog:               NewAuditLogClient(cfg),
@@ -285,28 +373,44 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		IdPGroupMapping:        NewIdPGroupMappingClient(cfg),
 		IdPSyncedGroup:         NewIdPSyncedGroupClient(cfg),
 		InstanceSize:           NewInstanceSizeClient(cfg),
+		InstanceSizeCluster:    NewInstanceSizeClusterClient(cfg),
+		LoginSession:           NewLoginSessionClient(cfg),
+		LoginThrottle:          NewLoginThrottleClient(cfg),
+		NamespaceQuota:         NewNamespaceQuotaClient(cfg),
 		NamespaceRegistry:      NewNamespaceRegistryClient(cfg),
 		Notification:           NewNotificationClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		PasswordHistory:        NewPasswordHistoryClient(cfg),
 		PendingAdoption:        NewPendingAdoptionClient(cfg),
+		PlatformConfig:         NewPlatformConfigClient(cfg),
+		Quota:                  NewQuotaClient(cfg),
 		RateLimitExemption:     NewRateLimitExemptionClient(cfg),
 		RateLimitUserOverride:  NewRateLimitUserOverrideClient(cfg),
+		RefreshToken:           NewRefreshTokenClient(cfg),
 		ResourceRoleBinding:    NewResourceRoleBindingClient(cfg),
+		RevokedSession:         NewRevokedSessionClient(cfg),
 		Role:                   NewRoleClient(cfg),
 		RoleBinding:            NewRoleBindingClient(cfg),
+		ScheduledBatchJob:      NewScheduledBatchJobClient(cfg),
 		Service:                NewServiceClient(cfg),
 		System:                 NewSystemClient(cfg),
 		SystemSecret:           NewSystemSecretClient(cfg),
 		Template:               NewTemplateClient(cfg),
+		TicketComment:          NewTicketCommentClient(cfg),
 		User:                   NewUserClient(cfg),
 		VM:                     NewVMClient(cfg),
+		VMConsoleSession:       NewVMConsoleSessionClient(cfg),
+		VMRequestIdempotency:   NewVMRequestIdempotencyClient(cfg),
 		VMRevision:             NewVMRevisionClient(cfg),
+		WebhookDelivery:        NewWebhookDeliveryClient(cfg),
+		WebhookEndpoint:        NewWebhookEndpointClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		ApprovalPolicy.
+//		ApprovalDecision.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -329,12 +433,17 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.ApprovalPolicy, c.ApprovalTicket, c.AuditLog, c.AuthProvider,
-		c.BatchApprovalTicket, c.Cluster, c.DomainEvent, c.ExternalApprovalSystem,
-		c.IdPGroupMapping, c.IdPSyncedGroup, c.InstanceSize, c.NamespaceRegistry,
-		c.Notification, c.PendingAdoption, c.RateLimitExemption,
-		c.RateLimitUserOverride, c.ResourceRoleBinding, c.Role, c.RoleBinding,
-		c.Service, c.System, c.SystemSecret, c.Template, c.User, c.VM, c.VMRevision,
+		c.ApprovalDecision, c.ApprovalPolicy, c.ApprovalTicket, c.AuditLog,
+		c.AuthProvider, c.BatchApprovalTicket, c.Cluster, c.DomainEvent,
+		c.ExternalApprovalSystem, c.IdPGroupMapping, c.IdPSyncedGroup, c.InstanceSize,
+		c.InstanceSizeCluster, c.LoginSession, c.LoginThrottle, c.NamespaceQuota,
+		c.NamespaceRegistry, c.Notification, c.NotificationPreference,
+		c.PasswordHistory, c.PendingAdoption, c.PlatformConfig, c.Quota,
+		c.RateLimitExemption, c.RateLimitUserOverride, c.RefreshToken,
+		c.ResourceRoleBinding, c.RevokedSession, c.Role, c.RoleBinding,
+		c.ScheduledBatchJob, c.Service, c.System, c.SystemSecret, c.Template,
+		c.TicketComment, c.User, c.VM, c.VMConsoleSession, c.VMRequestIdempotency,
+		c.VMRevision, c.WebhookDelivery, c.WebhookEndpoint,
 	} {
 		n.Use(hooks...)
 	}
@@ -344,12 +453,17 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.ApprovalPolicy, c.ApprovalTicket, c.AuditLog, c.AuthProvider,
-		c.BatchApprovalTicket, c.Cluster, c.DomainEvent, c.ExternalApprovalSystem,
-		c.IdPGroupMapping, c.IdPSyncedGroup, c.InstanceSize, c.NamespaceRegistry,
-		c.Notification, c.PendingAdoption, c.RateLimitExemption,
-		c.RateLimitUserOverride, c.ResourceRoleBinding, c.Role, c.RoleBinding,
-		c.Service, c.System, c.SystemSecret, c.Template, c.User, c.VM, c.VMRevision,
+		c.ApprovalDecision, c.ApprovalPolicy, c.ApprovalTicket, c.AuditLog,
+		c.AuthProvider, c.BatchApprovalTicket, c.Cluster, c.DomainEvent,
+		c.ExternalApprovalSystem, c.IdPGroupMapping, c.IdPSyncedGroup, c.InstanceSize,
+		c.InstanceSizeCluster, c.LoginSession, c.LoginThrottle, c.NamespaceQuota,
+		c.NamespaceRegistry, c.Notification, c.NotificationPreference,
+		c.PasswordHistory, c.PendingAdoption, c.PlatformConfig, c.Quota,
+		c.RateLimitExemption, c.RateLimitUserOverride, c.RefreshToken,
+		c.ResourceRoleBinding, c.RevokedSession, c.Role, c.RoleBinding,
+		c.ScheduledBatchJob, c.Service, c.System, c.SystemSecret, c.Template,
+		c.TicketComment, c.User, c.VM, c.VMConsoleSession, c.VMRequestIdempotency,
+		c.VMRevision, c.WebhookDelivery, c.WebhookEndpoint,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -358,6 +472,8 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *ApprovalDecisionMutation:
+		return c.ApprovalDecision.mutate(ctx, m)
 	case *ApprovalPolicyMutation:
 		return c.ApprovalPolicy.mutate(ctx, m)
 	case *ApprovalTicketMutation:
@@ -380,22 +496,44 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.IdPSyncedGroup.mutate(ctx, m)
 	case *InstanceSizeMutation:
 		return c.InstanceSize.mutate(ctx, m)
+	case *InstanceSizeClusterMutation:
+		return c.InstanceSizeCluster.mutate(ctx, m)
+	case *LoginSessionMutation:
+		return c.LoginSession.mutate(ctx, m)
+	case *LoginThrottleMutation:
+		return c.LoginThrottle.mutate(ctx, m)
+	case *NamespaceQuotaMutation:
+		return c.NamespaceQuota.mutate(ctx, m)
 	case *NamespaceRegistryMutation:
 		return c.NamespaceRegistry.mutate(ctx, m)
 	case *NotificationMutation:
 		return c.Notification.mutate(ctx, m)
+	case *NotificationPreferenceMutation:
+		return c.NotificationPreference.mutate(ctx, m)
+	case *PasswordHistoryMutation:
+		return c.PasswordHistory.mutate(ctx, m)
 	case *PendingAdoptionMutation:
 		return c.PendingAdoption.mutate(ctx, m)
+	case *PlatformConfigMutation:
+		return c.PlatformConfig.mutate(ctx, m)
+	case *QuotaMutation:
+		return c.Quota.mutate(ctx, m)
 	case *RateLimitExemptionMutation:
 		return c.RateLimitExemption.mutate(ctx, m)
 	case *RateLimitUserOverrideMutation:
 		return c.RateLimitUserOverride.mutate(ctx, m)
+	case *RefreshTokenMutation:
+		return c.RefreshToken.mutate(ctx, m)
 	case *ResourceRoleBindingMutation:
 		return c.ResourceRoleBinding.mutate(ctx, m)
+	case *RevokedSessionMutation:
+		return c.RevokedSession.mutate(ctx, m)
 	case *RoleMutation:
 		return c.Role.mutate(ctx, m)
 	case *RoleBindingMutation:
 		return c.RoleBinding.mutate(ctx, m)
+	case *ScheduledBatchJobMutation:
+		return c.ScheduledBatchJob.mutate(ctx, m)
 	case *ServiceMutation:
 		return c.Service.mutate(ctx, m)
 	case *SystemMutation:
@@ -404,17 +542,160 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.SystemSecret.mutate(ctx, m)
 	case *TemplateMutation:
 		return c.Template.mutate(ctx, m)
+	case *TicketCommentMutation:
+		return c.TicketComment.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	case *VMMutation:
 		return c.VM.mutate(ctx, m)
+	case *VMConsoleSessionMutation:
+		return c.VMConsoleSession.mutate(ctx, m)
+	case *VMRequestIdempotencyMutation:
+		return c.VMRequestIdempotency.mutate(ctx, m)
 	case *VMRevisionMutation:
 		return c.VMRevision.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
+	case *WebhookEndpointMutation:
+		return c.WebhookEndpoint.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// ApprovalDecisionClient is a client for the ApprovalDecision schema.
+type ApprovalDecisionClient struct {
+	config
+}
+
+// NewApprovalDecisionClient returns a client for the ApprovalDecision from the given config.
+func NewApprovalDecisionClient(c config) *ApprovalDecisionClient {
+	return &ApprovalDecisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `approvaldecision.Hooks(f(g(h())))`.
+func (c *ApprovalDecisionClient) Use(hooks ...Hook) {
+	c.hooks.ApprovalDecision = append(c.hooks.ApprovalDecision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `approvaldecision.Intercept(f(g(h())))`.
+func (c *ApprovalDecisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ApprovalDecision = append(c.inters.ApprovalDecision, interceptors...)
+}
+
+// Create returns a builder for creating a ApprovalDecision entity.
+func (c *ApprovalDecisionClient) Create() *ApprovalDecisionCreate {
+	mutation := newApprovalDecisionMutation(c.config, OpCreate)
+	return &ApprovalDecisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ApprovalDecision entities.
+func (c *ApprovalDecisionClient) CreateBulk(builders ...*ApprovalDecisionCreate) *ApprovalDecisionCreateBulk {
+	return &ApprovalDecisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ApprovalDecisionClient) MapCreateBulk(slice any, setFunc func(*ApprovalDecisionCreate, int)) *ApprovalDecisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ApprovalDecisionCreateBulk{err: fmt.Errorf("calling to ApprovalDecisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ApprovalDecisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ApprovalDecisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ApprovalDecision.
+func (c *ApprovalDecisionClient) Update() *ApprovalDecisionUpdate {
+	mutation := newApprovalDecisionMutation(c.config, OpUpdate)
+	return &ApprovalDecisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ApprovalDecisionClient) UpdateOne(_m *ApprovalDecision) *ApprovalDecisionUpdateOne {
+	mutation := newApprovalDecisionMutation(c.config, OpUpdateOne, withApprovalDecision(_m))
+	return &ApprovalDecisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ApprovalDecisionClient) UpdateOneID(id string) *ApprovalDecisionUpdateOne {
+	mutation := newApprovalDecisionMutation(c.config, OpUpdateOne, withApprovalDecisionID(id))
+	return &ApprovalDecisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ApprovalDecision.
+func (c *ApprovalDecisionClient) Delete() *ApprovalDecisionDelete {
+	mutation := newApprovalDecisionMutation(c.config, OpDelete)
+	return &ApprovalDecisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ApprovalDecisionClient) DeleteOne(_m *ApprovalDecision) *ApprovalDecisionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ApprovalDecisionClient) DeleteOneID(id string) *ApprovalDecisionDeleteOne {
+	builder := c.Delete().Where(approvaldecision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ApprovalDecisionDeleteOne{builder}
+}
+
+// Query returns a query builder for ApprovalDecision.
+func (c *ApprovalDecisionClient) Query() *ApprovalDecisionQuery {
+	return &ApprovalDecisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeApprovalDecision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ApprovalDecision entity by its id.
+func (c *ApprovalDecisionClient) Get(ctx context.Context, id string) (*ApprovalDecision, error) {
+	return c.Query().Where(approvaldecision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ApprovalDecisionClient) GetX(ctx context.Context, id string) *ApprovalDecision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ApprovalDecisionClient) Hooks() []Hook {
+	return c.hooks.ApprovalDecision
+}
+
+// Interceptors returns the client interceptors.
+func (c *ApprovalDecisionClient) Interceptors() []Interceptor {
+	return c.inters.ApprovalDecision
+}
+
+func (c *ApprovalDecisionClient) mutate(ctx context.Context, m *ApprovalDecisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ApprovalDecisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ApprovalDecisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ApprovalDecisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ApprovalDecisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ApprovalDecision mutation op: %q", m.Op())
+	}
+}
+
 // ApprovalPolicyClient is a client for the ApprovalPolicy schema.
 type ApprovalPolicyClient struct {
 	config
@@ -1878,107 +2159,107 @@ func (c *InstanceSizeClient) mutate(ctx context.Context, m *InstanceSizeMutation
 	}
 }
 
-// NamespaceRegistryClient is a client for the NamespaceRegistry schema.
-type NamespaceRegistryClient struct {
+// InstanceSizeClusterClient is a client for the InstanceSizeCluster schema.
+type InstanceSizeClusterClient struct {
 	config
 }
 
-// NewNamespaceRegistryClient returns a client for the NamespaceRegistry from the given config.
-func NewNamespaceRegistryClient(c config) *NamespaceRegistryClient {
-	return &NamespaceRegistryClient{config: c}
+// NewInstanceSizeClusterClient returns a client for the InstanceSizeCluster from the given config.
+func NewInstanceSizeClusterClient(c config) *InstanceSizeClusterClient {
+	return &InstanceSizeClusterClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `namespaceregistry.Hooks(f(g(h())))`.
-func (c *NamespaceRegistryClient) Use(hooks ...Hook) {
-	c.hooks.NamespaceRegistry = append(c.hooks.NamespaceRegistry, hooks...)
+// A call to `Use(f, g, h)` equals to `instancesizecluster.Hooks(f(g(h())))`.
+func (c *InstanceSizeClusterClient) Use(hooks ...Hook) {
+	c.hooks.InstanceSizeCluster = append(c.hooks.InstanceSizeCluster, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `namespaceregistry.Intercept(f(g(h())))`.
-func (c *NamespaceRegistryClient) Intercept(interceptors ...Interceptor) {
-	c.inters.NamespaceRegistry = append(c.inters.NamespaceRegistry, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `instancesizecluster.Intercept(f(g(h())))`.
+func (c *InstanceSizeClusterClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InstanceSizeCluster = append(c.inters.InstanceSizeCluster, interceptors...)
 }
 
-// Create returns a builder for creating a NamespaceRegistry entity.
-func (c *NamespaceRegistryClient) Create() *NamespaceRegistryCreate {
-	mutation := newNamespaceRegistryMutation(c.config, OpCreate)
-	return &NamespaceRegistryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a InstanceSizeCluster entity.
+func (c *InstanceSizeClusterClient) Create() *InstanceSizeClusterCreate {
+	mutation := newInstanceSizeClusterMutation(c.config, OpCreate)
+	return &InstanceSizeClusterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of NamespaceRegistry entities.
-func (c *NamespaceRegistryClient) CreateBulk(builders ...*NamespaceRegistryCreate) *NamespaceRegistryCreateBulk {
-	return &NamespaceRegistryCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of InstanceSizeCluster entities.
+func (c *InstanceSizeClusterClient) CreateBulk(builders ...*InstanceSizeClusterCreate) *InstanceSizeClusterCreateBulk {
+	return &InstanceSizeClusterCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NamespaceRegistryClient) MapCreateBulk(slice any, setFunc func(*NamespaceRegistryCreate, int)) *NamespaceRegistryCreateBulk {
+func (c *InstanceSizeClusterClient) MapCreateBulk(slice any, setFunc func(*InstanceSizeClusterCreate, int)) *InstanceSizeClusterCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NamespaceRegistryCreateBulk{err: fmt.Errorf("calling to NamespaceRegistryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &InstanceSizeClusterCreateBulk{err: fmt.Errorf("calling to InstanceSizeClusterClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NamespaceRegistryCreate, rv.Len())
+	builders := make([]*InstanceSizeClusterCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NamespaceRegistryCreateBulk{config: c.config, builders: builders}
+	return &InstanceSizeClusterCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for NamespaceRegistry.
-func (c *NamespaceRegistryClient) Update() *NamespaceRegistryUpdate {
-	mutation := newNamespaceRegistryMutation(c.config, OpUpdate)
-	return &NamespaceRegistryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for InstanceSizeCluster.
+func (c *InstanceSizeClusterClient) Update() *InstanceSizeClusterUpdate {
+	mutation := newInstanceSizeClusterMutation(c.config, OpUpdate)
+	return &InstanceSizeClusterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NamespaceRegistryClient) UpdateOne(_m *NamespaceRegistry) *NamespaceRegistryUpdateOne {
-	mutation := newNamespaceRegistryMutation(c.config, OpUpdateOne, withNamespaceRegistry(_m))
-	return &NamespaceRegistryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InstanceSizeClusterClient) UpdateOne(_m *InstanceSizeCluster) *InstanceSizeClusterUpdateOne {
+	mutation := newInstanceSizeClusterMutation(c.config, OpUpdateOne, withInstanceSizeCluster(_m))
+	return &InstanceSizeClusterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NamespaceRegistryClient) UpdateOneID(id string) *NamespaceRegistryUpdateOne {
-	mutation := newNamespaceRegistryMutation(c.config, OpUpdateOne, withNamespaceRegistryID(id))
-	return &NamespaceRegistryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InstanceSizeClusterClient) UpdateOneID(id string) *InstanceSizeClusterUpdateOne {
+	mutation := newInstanceSizeClusterMutation(c.config, OpUpdateOne, withInstanceSizeClusterID(id))
+	return &InstanceSizeClusterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for NamespaceRegistry.
-func (c *NamespaceRegistryClient) Delete() *NamespaceRegistryDelete {
-	mutation := newNamespaceRegistryMutation(c.config, OpDelete)
-	return &NamespaceRegistryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for InstanceSizeCluster.
+func (c *InstanceSizeClusterClient) Delete() *InstanceSizeClusterDelete {
+	mutation := newInstanceSizeClusterMutation(c.config, OpDelete)
+	return &InstanceSizeClusterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NamespaceRegistryClient) DeleteOne(_m *NamespaceRegistry) *NamespaceRegistryDeleteOne {
+func (c *InstanceSizeClusterClient) DeleteOne(_m *InstanceSizeCluster) *InstanceSizeClusterDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NamespaceRegistryClient) DeleteOneID(id string) *NamespaceRegistryDeleteOne {
-	builder := c.Delete().Where(namespaceregistry.ID(id))
+func (c *InstanceSizeClusterClient) DeleteOneID(id string) *InstanceSizeClusterDeleteOne {
+	builder := c.Delete().Where(instancesizecluster.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NamespaceRegistryDeleteOne{builder}
+	return &InstanceSizeClusterDeleteOne{builder}
 }
 
-// Query returns a query builder for NamespaceRegistry.
-func (c *NamespaceRegistryClient) Query() *NamespaceRegistryQuery {
-	return &NamespaceRegistryQuery{
+// Query returns a query builder for InstanceSizeCluster.
+func (c *InstanceSizeClusterClient) Query() *InstanceSizeClusterQuery {
+	return &InstanceSizeClusterQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNamespaceRegistry},
+		ctx:    &QueryContext{Type: TypeInstanceSizeCluster},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a NamespaceRegistry entity by its id.
-func (c *NamespaceRegistryClient) Get(ctx context.Context, id string) (*NamespaceRegistry, error) {
-	return c.Query().Where(namespaceregistry.ID(id)).Only(ctx)
+// Get returns a InstanceSizeCluster entity by its id.
+func (c *InstanceSizeClusterClient) Get(ctx context.Context, id string) (*InstanceSizeCluster, error) {
+	return c.Query().Where(instancesizecluster.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NamespaceRegistryClient) GetX(ctx context.Context, id string) *NamespaceRegistry {
+func (c *InstanceSizeClusterClient) GetX(ctx context.Context, id string) *InstanceSizeCluster {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1987,131 +2268,131 @@ func (c *NamespaceRegistryClient) GetX(ctx context.Context, id string) *Namespac
 }
 
 // Hooks returns the client hooks.
-func (c *NamespaceRegistryClient) Hooks() []Hook {
-	return c.hooks.NamespaceRegistry
+func (c *InstanceSizeClusterClient) Hooks() []Hook {
+	return c.hooks.InstanceSizeCluster
 }
 
 // Interceptors returns the client interceptors.
-func (c *NamespaceRegistryClient) Interceptors() []Interceptor {
-	return c.inters.NamespaceRegistry
+func (c *InstanceSizeClusterClient) Interceptors() []Interceptor {
+	return c.inters.InstanceSizeCluster
 }
 
-func (c *NamespaceRegistryClient) mutate(ctx context.Context, m *NamespaceRegistryMutation) (Value, error) {
+func (c *InstanceSizeClusterClient) mutate(ctx context.Context, m *InstanceSizeClusterMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NamespaceRegistryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InstanceSizeClusterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NamespaceRegistryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InstanceSizeClusterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NamespaceRegistryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InstanceSizeClusterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NamespaceRegistryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&InstanceSizeClusterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown NamespaceRegistry mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown InstanceSizeCluster mutation op: %q", m.Op())
 	}
 }
 
-// NotificationClient is a client for the Notification schema.
-type NotificationClient struct {
+// LoginSessionClient is a client for the LoginSession schema.
+type LoginSessionClient struct {
 	config
 }
 
-// NewNotificationClient returns a client for the Notification from the given config.
-func NewNotificationClient(c config) *NotificationClient {
-	return &NotificationClient{config: c}
+// NewLoginSessionClient returns a client for the LoginSession from the given config.
+func NewLoginSessionClient(c config) *LoginSessionClient {
+	return &LoginSessionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
-func (c *NotificationClient) Use(hooks ...Hook) {
-	c.hooks.Notification = append(c.hooks.Notification, hooks...)
+// A call to `Use(f, g, h)` equals to `loginsession.Hooks(f(g(h())))`.
+func (c *LoginSessionClient) Use(hooks ...Hook) {
+	c.hooks.LoginSession = append(c.hooks.LoginSession, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
-func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Notification = append(c.inters.Notification, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `loginsession.Intercept(f(g(h())))`.
+func (c *LoginSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LoginSession = append(c.inters.LoginSession, interceptors...)
 }
 
-// Create returns a builder for creating a Notification entity.
-func (c *NotificationClient) Create() *NotificationCreate {
-	mutation := newNotificationMutation(c.config, OpCreate)
-	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a LoginSession entity.
+func (c *LoginSessionClient) Create() *LoginSessionCreate {
+	mutation := newLoginSessionMutation(c.config, OpCreate)
+	return &LoginSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Notification entities.
-func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
-	return &NotificationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of LoginSession entities.
+func (c *LoginSessionClient) CreateBulk(builders ...*LoginSessionCreate) *LoginSessionCreateBulk {
+	return &LoginSessionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
+func (c *LoginSessionClient) MapCreateBulk(slice any, setFunc func(*LoginSessionCreate, int)) *LoginSessionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &LoginSessionCreateBulk{err: fmt.Errorf("calling to LoginSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NotificationCreate, rv.Len())
+	builders := make([]*LoginSessionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NotificationCreateBulk{config: c.config, builders: builders}
+	return &LoginSessionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Notification.
-func (c *NotificationClient) Update() *NotificationUpdate {
-	mutation := newNotificationMutation(c.config, OpUpdate)
-	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for LoginSession.
+func (c *LoginSessionClient) Update() *LoginSessionUpdate {
+	mutation := newLoginSessionMutation(c.config, OpUpdate)
+	return &LoginSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NotificationClient) UpdateOne(_m *Notification) *NotificationUpdateOne {
-	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(_m))
-	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LoginSessionClient) UpdateOne(_m *LoginSession) *LoginSessionUpdateOne {
+	mutation := newLoginSessionMutation(c.config, OpUpdateOne, withLoginSession(_m))
+	return &LoginSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NotificationClient) UpdateOneID(id string) *NotificationUpdateOne {
-	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
-	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LoginSessionClient) UpdateOneID(id string) *LoginSessionUpdateOne {
+	mutation := newLoginSessionMutation(c.config, OpUpdateOne, withLoginSessionID(id))
+	return &LoginSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Notification.
-func (c *NotificationClient) Delete() *NotificationDelete {
-	mutation := newNotificationMutation(c.config, OpDelete)
-	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for LoginSession.
+func (c *LoginSessionClient) Delete() *LoginSessionDelete {
+	mutation := newLoginSessionMutation(c.config, OpDelete)
+	return &LoginSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NotificationClient) DeleteOne(_m *Notification) *NotificationDeleteOne {
+func (c *LoginSessionClient) DeleteOne(_m *LoginSession) *LoginSessionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NotificationClient) DeleteOneID(id string) *NotificationDeleteOne {
-	builder := c.Delete().Where(notification.ID(id))
+func (c *LoginSessionClient) DeleteOneID(id string) *LoginSessionDeleteOne {
+	builder := c.Delete().Where(loginsession.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NotificationDeleteOne{builder}
+	return &LoginSessionDeleteOne{builder}
 }
 
-// Query returns a query builder for Notification.
-func (c *NotificationClient) Query() *NotificationQuery {
-	return &NotificationQuery{
+// Query returns a query builder for LoginSession.
+func (c *LoginSessionClient) Query() *LoginSessionQuery {
+	return &LoginSessionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNotification},
+		ctx:    &QueryContext{Type: TypeLoginSession},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Notification entity by its id.
-func (c *NotificationClient) Get(ctx context.Context, id string) (*Notification, error) {
-	return c.Query().Where(notification.ID(id)).Only(ctx)
+// Get returns a LoginSession entity by its id.
+func (c *LoginSessionClient) Get(ctx context.Context, id string) (*LoginSession, error) {
+	return c.Query().Where(loginsession.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NotificationClient) GetX(ctx context.Context, id string) *Notification {
+func (c *LoginSessionClient) GetX(ctx context.Context, id string) *LoginSession {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2119,148 +2400,132 @@ func (c *NotificationClient) GetX(ctx context.Context, id string) *Notification
 	return obj
 }
 
-// QueryUser queries the user edge of a Notification.
-func (c *NotificationClient) QueryUser(_m *Notification) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(notification.Table, notification.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, notification.UserTable, notification.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *NotificationClient) Hooks() []Hook {
-	return c.hooks.Notification
+func (c *LoginSessionClient) Hooks() []Hook {
+	return c.hooks.LoginSession
 }
 
 // Interceptors returns the client interceptors.
-func (c *NotificationClient) Interceptors() []Interceptor {
-	return c.inters.Notification
+func (c *LoginSessionClient) Interceptors() []Interceptor {
+	return c.inters.LoginSession
 }
 
-func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
+func (c *LoginSessionClient) mutate(ctx context.Context, m *LoginSessionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LoginSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LoginSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LoginSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&LoginSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Notification mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown LoginSession mutation op: %q", m.Op())
 	}
 }
 
-// PendingAdoptionClient is a client for the PendingAdoption schema.
-type PendingAdoptionClient struct {
+// LoginThrottleClient is a client for the LoginThrottle schema.
+type LoginThrottleClient struct {
 	config
 }
 
-// NewPendingAdoptionClient returns a client for the PendingAdoption from the given config.
-func NewPendingAdoptionClient(c config) *PendingAdoptionClient {
-	return &PendingAdoptionClient{config: c}
+// NewLoginThrottleClient returns a client for the LoginThrottle from the given config.
+func NewLoginThrottleClient(c config) *LoginThrottleClient {
+	return &LoginThrottleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `pendingadoption.Hooks(f(g(h())))`.
-func (c *PendingAdoptionClient) Use(hooks ...Hook) {
-	c.hooks.PendingAdoption = append(c.hooks.PendingAdoption, hooks...)
+// A call to `Use(f, g, h)` equals to `loginthrottle.Hooks(f(g(h())))`.
+func (c *LoginThrottleClient) Use(hooks ...Hook) {
+	c.hooks.LoginThrottle = append(c.hooks.LoginThrottle, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `pendingadoption.Intercept(f(g(h())))`.
-func (c *PendingAdoptionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PendingAdoption = append(c.inters.PendingAdoption, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `loginthrottle.Intercept(f(g(h())))`.
+func (c *LoginThrottleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LoginThrottle = append(c.inters.LoginThrottle, interceptors...)
 }
 
-// Create returns a builder for creating a PendingAdoption entity.
-func (c *PendingAdoptionClient) Create() *PendingAdoptionCreate {
-	mutation := newPendingAdoptionMutation(c.config, OpCreate)
-	return &PendingAdoptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a LoginThrottle entity.
+func (c *LoginThrottleClient) Create() *LoginThrottleCreate {
+	mutation := newLoginThrottleMutation(c.config, OpCreate)
+	return &LoginThrottleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PendingAdoption entities.
-func (c *PendingAdoptionClient) CreateBulk(builders ...*PendingAdoptionCreate) *PendingAdoptionCreateBulk {
-	return &PendingAdoptionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of LoginThrottle entities.
+func (c *LoginThrottleClient) CreateBulk(builders ...*LoginThrottleCreate) *LoginThrottleCreateBulk {
+	return &LoginThrottleCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PendingAdoptionClient) MapCreateBulk(slice any, setFunc func(*PendingAdoptionCreate, int)) *PendingAdoptionCreateBulk {
+func (c *LoginThrottleClient) MapCreateBulk(slice any, setFunc func(*LoginThrottleCreate, int)) *LoginThrottleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PendingAdoptionCreateBulk{err: fmt.Errorf("calling to PendingAdoptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &LoginThrottleCreateBulk{err: fmt.Errorf("calling to LoginThrottleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PendingAdoptionCreate, rv.Len())
+	builders := make([]*LoginThrottleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PendingAdoptionCreateBulk{config: c.config, builders: builders}
+	return &LoginThrottleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PendingAdoption.
-func (c *PendingAdoptionClient) Update() *PendingAdoptionUpdate {
-	mutation := newPendingAdoptionMutation(c.config, OpUpdate)
-	return &PendingAdoptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for LoginThrottle.
+func (c *LoginThrottleClient) Update() *LoginThrottleUpdate {
+	mutation := newLoginThrottleMutation(c.config, OpUpdate)
+	return &LoginThrottleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PendingAdoptionClient) UpdateOne(_m *PendingAdoption) *PendingAdoptionUpdateOne {
-	mutation := newPendingAdoptionMutation(c.config, OpUpdateOne, withPendingAdoption(_m))
-	return &PendingAdoptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LoginThrottleClient) UpdateOne(_m *LoginThrottle) *LoginThrottleUpdateOne {
+	mutation := newLoginThrottleMutation(c.config, OpUpdateOne, withLoginThrottle(_m))
+	return &LoginThrottleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PendingAdoptionClient) UpdateOneID(id string) *PendingAdoptionUpdateOne {
-	mutation := newPendingAdoptionMutation(c.config, OpUpdateOne, withPendingAdoptionID(id))
-	return &PendingAdoptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LoginThrottleClient) UpdateOneID(id string) *LoginThrottleUpdateOne {
+	mutation := newLoginThrottleMutation(c.config, OpUpdateOne, withLoginThrottleID(id))
+	return &LoginThrottleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PendingAdoption.
-func (c *PendingAdoptionClient) Delete() *PendingAdoptionDelete {
-	mutation := newPendingAdoptionMutation(c.config, OpDelete)
-	return &PendingAdoptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for LoginThrottle.
+func (c *LoginThrottleClient) Delete() *LoginThrottleDelete {
+	mutation := newLoginThrottleMutation(c.config, OpDelete)
+	return &LoginThrottleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PendingAdoptionClient) DeleteOne(_m *PendingAdoption) *PendingAdoptionDeleteOne {
+func (c *LoginThrottleClient) DeleteOne(_m *LoginThrottle) *LoginThrottleDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PendingAdoptionClient) DeleteOneID(id string) *PendingAdoptionDeleteOne {
-	builder := c.Delete().Where(pendingadoption.ID(id))
+func (c *LoginThrottleClient) DeleteOneID(id string) *LoginThrottleDeleteOne {
+	builder := c.Delete().Where(loginthrottle.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PendingAdoptionDeleteOne{builder}
+	return &LoginThrottleDeleteOne{builder}
 }
 
-// Query returns a query builder for PendingAdoption.
-func (c *PendingAdoptionClient) Query() *PendingAdoptionQuery {
-	return &PendingAdoptionQuery{
+// Query returns a query builder for LoginThrottle.
+func (c *LoginThrottleClient) Query() *LoginThrottleQuery {
+	return &LoginThrottleQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePendingAdoption},
+		ctx:    &QueryContext{Type: TypeLoginThrottle},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PendingAdoption entity by its id.
-func (c *PendingAdoptionClient) Get(ctx context.Context, id string) (*PendingAdoption, error) {
-	return c.Query().Where(pendingadoption.ID(id)).Only(ctx)
+// Get returns a LoginThrottle entity by its id.
+func (c *LoginThrottleClient) Get(ctx context.Context, id string) (*LoginThrottle, error) {
+	return c.Query().Where(loginthrottle.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PendingAdoptionClient) GetX(ctx context.Context, id string) *PendingAdoption {
+func (c *LoginThrottleClient) GetX(ctx context.Context, id string) *LoginThrottle {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2269,131 +2534,131 @@ func (c *PendingAdoptionClient) GetX(ctx context.Context, id string) *PendingAdo
 }
 
 // Hooks returns the client hooks.
-func (c *PendingAdoptionClient) Hooks() []Hook {
-	return c.hooks.PendingAdoption
+func (c *LoginThrottleClient) Hooks() []Hook {
+	return c.hooks.LoginThrottle
 }
 
 // Interceptors returns the client interceptors.
-func (c *PendingAdoptionClient) Interceptors() []Interceptor {
-	return c.inters.PendingAdoption
+func (c *LoginThrottleClient) Interceptors() []Interceptor {
+	return c.inters.LoginThrottle
 }
 
-func (c *PendingAdoptionClient) mutate(ctx context.Context, m *PendingAdoptionMutation) (Value, error) {
+func (c *LoginThrottleClient) mutate(ctx context.Context, m *LoginThrottleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PendingAdoptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LoginThrottleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PendingAdoptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LoginThrottleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PendingAdoptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LoginThrottleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PendingAdoptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&LoginThrottleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PendingAdoption mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown LoginThrottle mutation op: %q", m.Op())
 	}
 }
 
-// RateLimitExemptionClient is a client for the RateLimitExemption schema.
-type RateLimitExemptionClient struct {
+// NamespaceQuotaClient is a client for the NamespaceQuota schema.
+type NamespaceQuotaClient struct {
 	config
 }
 
-// NewRateLimitExemptionClient returns a client for the RateLimitExemption from the given config.
-func NewRateLimitExemptionClient(c config) *RateLimitExemptionClient {
-	return &RateLimitExemptionClient{config: c}
+// NewNamespaceQuotaClient returns a client for the NamespaceQuota from the given config.
+func NewNamespaceQuotaClient(c config) *NamespaceQuotaClient {
+	return &NamespaceQuotaClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `ratelimitexemption.Hooks(f(g(h())))`.
-func (c *RateLimitExemptionClient) Use(hooks ...Hook) {
-	c.hooks.RateLimitExemption = append(c.hooks.RateLimitExemption, hooks...)
+// A call to `Use(f, g, h)` equals to `namespacequota.Hooks(f(g(h())))`.
+func (c *NamespaceQuotaClient) Use(hooks ...Hook) {
+	c.hooks.NamespaceQuota = append(c.hooks.NamespaceQuota, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `ratelimitexemption.Intercept(f(g(h())))`.
-func (c *RateLimitExemptionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.RateLimitExemption = append(c.inters.RateLimitExemption, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `namespacequota.Intercept(f(g(h())))`.
+func (c *NamespaceQuotaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NamespaceQuota = append(c.inters.NamespaceQuota, interceptors...)
 }
 
-// Create returns a builder for creating a RateLimitExemption entity.
-func (c *RateLimitExemptionClient) Create() *RateLimitExemptionCreate {
-	mutation := newRateLimitExemptionMutation(c.config, OpCreate)
-	return &RateLimitExemptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a NamespaceQuota entity.
+func (c *NamespaceQuotaClient) Create() *NamespaceQuotaCreate {
+	mutation := newNamespaceQuotaMutation(c.config, OpCreate)
+	return &NamespaceQuotaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of RateLimitExemption entities.
-func (c *RateLimitExemptionClient) CreateBulk(builders ...*RateLimitExemptionCreate) *RateLimitExemptionCreateBulk {
-	return &RateLimitExemptionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of NamespaceQuota entities.
+func (c *NamespaceQuotaClient) CreateBulk(builders ...*NamespaceQuotaCreate) *NamespaceQuotaCreateBulk {
+	return &NamespaceQuotaCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RateLimitExemptionClient) MapCreateBulk(slice any, setFunc func(*RateLimitExemptionCreate, int)) *RateLimitExemptionCreateBulk {
+func (c *NamespaceQuotaClient) MapCreateBulk(slice any, setFunc func(*NamespaceQuotaCreate, int)) *NamespaceQuotaCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RateLimitExemptionCreateBulk{err: fmt.Errorf("calling to RateLimitExemptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &NamespaceQuotaCreateBulk{err: fmt.Errorf("calling to NamespaceQuotaClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RateLimitExemptionCreate, rv.Len())
+	builders := make([]*NamespaceQuotaCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RateLimitExemptionCreateBulk{config: c.config, builders: builders}
+	return &NamespaceQuotaCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for RateLimitExemption.
-func (c *RateLimitExemptionClient) Update() *RateLimitExemptionUpdate {
-	mutation := newRateLimitExemptionMutation(c.config, OpUpdate)
-	return &RateLimitExemptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for NamespaceQuota.
+func (c *NamespaceQuotaClient) Update() *NamespaceQuotaUpdate {
+	mutation := newNamespaceQuotaMutation(c.config, OpUpdate)
+	return &NamespaceQuotaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RateLimitExemptionClient) UpdateOne(_m *RateLimitExemption) *RateLimitExemptionUpdateOne {
-	mutation := newRateLimitExemptionMutation(c.config, OpUpdateOne, withRateLimitExemption(_m))
-	return &RateLimitExemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NamespaceQuotaClient) UpdateOne(_m *NamespaceQuota) *NamespaceQuotaUpdateOne {
+	mutation := newNamespaceQuotaMutation(c.config, OpUpdateOne, withNamespaceQuota(_m))
+	return &NamespaceQuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RateLimitExemptionClient) UpdateOneID(id string) *RateLimitExemptionUpdateOne {
-	mutation := newRateLimitExemptionMutation(c.config, OpUpdateOne, withRateLimitExemptionID(id))
-	return &RateLimitExemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NamespaceQuotaClient) UpdateOneID(id string) *NamespaceQuotaUpdateOne {
+	mutation := newNamespaceQuotaMutation(c.config, OpUpdateOne, withNamespaceQuotaID(id))
+	return &NamespaceQuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for RateLimitExemption.
-func (c *RateLimitExemptionClient) Delete() *RateLimitExemptionDelete {
-	mutation := newRateLimitExemptionMutation(c.config, OpDelete)
-	return &RateLimitExemptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for NamespaceQuota.
+func (c *NamespaceQuotaClient) Delete() *NamespaceQuotaDelete {
+	mutation := newNamespaceQuotaMutation(c.config, OpDelete)
+	return &NamespaceQuotaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RateLimitExemptionClient) DeleteOne(_m *RateLimitExemption) *RateLimitExemptionDeleteOne {
+func (c *NamespaceQuotaClient) DeleteOne(_m *NamespaceQuota) *NamespaceQuotaDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RateLimitExemptionClient) DeleteOneID(id string) *RateLimitExemptionDeleteOne {
-	builder := c.Delete().Where(ratelimitexemption.ID(id))
+func (c *NamespaceQuotaClient) DeleteOneID(id string) *NamespaceQuotaDeleteOne {
+	builder := c.Delete().Where(namespacequota.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RateLimitExemptionDeleteOne{builder}
+	return &NamespaceQuotaDeleteOne{builder}
 }
 
-// Query returns a query builder for RateLimitExemption.
-func (c *RateLimitExemptionClient) Query() *RateLimitExemptionQuery {
-	return &RateLimitExemptionQuery{
+// Query returns a query builder for NamespaceQuota.
+func (c *NamespaceQuotaClient) Query() *NamespaceQuotaQuery {
+	return &NamespaceQuotaQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRateLimitExemption},
+		ctx:    &QueryContext{Type: TypeNamespaceQuota},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a RateLimitExemption entity by its id.
-func (c *RateLimitExemptionClient) Get(ctx context.Context, id string) (*RateLimitExemption, error) {
-	return c.Query().Where(ratelimitexemption.ID(id)).Only(ctx)
+// Get returns a NamespaceQuota entity by its id.
+func (c *NamespaceQuotaClient) Get(ctx context.Context, id string) (*NamespaceQuota, error) {
+	return c.Query().Where(namespacequota.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RateLimitExemptionClient) GetX(ctx context.Context, id string) *RateLimitExemption {
+func (c *NamespaceQuotaClient) GetX(ctx context.Context, id string) *NamespaceQuota {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2402,131 +2667,131 @@ func (c *RateLimitExemptionClient) GetX(ctx context.Context, id string) *RateLim
 }
 
 // Hooks returns the client hooks.
-func (c *RateLimitExemptionClient) Hooks() []Hook {
-	return c.hooks.RateLimitExemption
+func (c *NamespaceQuotaClient) Hooks() []Hook {
+	return c.hooks.NamespaceQuota
 }
 
 // Interceptors returns the client interceptors.
-func (c *RateLimitExemptionClient) Interceptors() []Interceptor {
-	return c.inters.RateLimitExemption
+func (c *NamespaceQuotaClient) Interceptors() []Interceptor {
+	return c.inters.NamespaceQuota
 }
 
-func (c *RateLimitExemptionClient) mutate(ctx context.Context, m *RateLimitExemptionMutation) (Value, error) {
+func (c *NamespaceQuotaClient) mutate(ctx context.Context, m *NamespaceQuotaMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RateLimitExemptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NamespaceQuotaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RateLimitExemptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NamespaceQuotaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RateLimitExemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NamespaceQuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RateLimitExemptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&NamespaceQuotaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown RateLimitExemption mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown NamespaceQuota mutation op: %q", m.Op())
 	}
 }
 
-// RateLimitUserOverrideClient is a client for the RateLimitUserOverride schema.
-type RateLimitUserOverrideClient struct {
+// NamespaceRegistryClient is a client for the NamespaceRegistry schema.
+type NamespaceRegistryClient struct {
 	config
 }
 
-// NewRateLimitUserOverrideClient returns a client for the RateLimitUserOverride from the given config.
-func NewRateLimitUserOverrideClient(c config) *RateLimitUserOverrideClient {
-	return &RateLimitUserOverrideClient{config: c}
+// NewNamespaceRegistryClient returns a client for the NamespaceRegistry from the given config.
+func NewNamespaceRegistryClient(c config) *NamespaceRegistryClient {
+	return &NamespaceRegistryClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `ratelimituseroverride.Hooks(f(g(h())))`.
-func (c *RateLimitUserOverrideClient) Use(hooks ...Hook) {
-	c.hooks.RateLimitUserOverride = append(c.hooks.RateLimitUserOverride, hooks...)
+// A call to `Use(f, g, h)` equals to `namespaceregistry.Hooks(f(g(h())))`.
+func (c *NamespaceRegistryClient) Use(hooks ...Hook) {
+	c.hooks.NamespaceRegistry = append(c.hooks.NamespaceRegistry, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `ratelimituseroverride.Intercept(f(g(h())))`.
-func (c *RateLimitUserOverrideClient) Intercept(interceptors ...Interceptor) {
-	c.inters.RateLimitUserOverride = append(c.inters.RateLimitUserOverride, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `namespaceregistry.Intercept(f(g(h())))`.
+func (c *NamespaceRegistryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NamespaceRegistry = append(c.inters.NamespaceRegistry, interceptors...)
 }
 
-// Create returns a builder for creating a RateLimitUserOverride entity.
-func (c *RateLimitUserOverrideClient) Create() *RateLimitUserOverrideCreate {
-	mutation := newRateLimitUserOverrideMutation(c.config, OpCreate)
-	return &RateLimitUserOverrideCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a NamespaceRegistry entity.
+func (c *NamespaceRegistryClient) Create() *NamespaceRegistryCreate {
+	mutation := newNamespaceRegistryMutation(c.config, OpCreate)
+	return &NamespaceRegistryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of RateLimitUserOverride entities.
-func (c *RateLimitUserOverrideClient) CreateBulk(builders ...*RateLimitUserOverrideCreate) *RateLimitUserOverrideCreateBulk {
-	return &RateLimitUserOverrideCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of NamespaceRegistry entities.
+func (c *NamespaceRegistryClient) CreateBulk(builders ...*NamespaceRegistryCreate) *NamespaceRegistryCreateBulk {
+	return &NamespaceRegistryCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RateLimitUserOverrideClient) MapCreateBulk(slice any, setFunc func(*RateLimitUserOverrideCreate, int)) *RateLimitUserOverrideCreateBulk {
+func (c *NamespaceRegistryClient) MapCreateBulk(slice any, setFunc func(*NamespaceRegistryCreate, int)) *NamespaceRegistryCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RateLimitUserOverrideCreateBulk{err: fmt.Errorf("calling to RateLimitUserOverrideClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &NamespaceRegistryCreateBulk{err: fmt.Errorf("calling to NamespaceRegistryClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RateLimitUserOverrideCreate, rv.Len())
+	builders := make([]*NamespaceRegistryCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RateLimitUserOverrideCreateBulk{config: c.config, builders: builders}
+	return &NamespaceRegistryCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for RateLimitUserOverride.
-func (c *RateLimitUserOverrideClient) Update() *RateLimitUserOverrideUpdate {
-	mutation := newRateLimitUserOverrideMutation(c.config, OpUpdate)
-	return &RateLimitUserOverrideUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for NamespaceRegistry.
+func (c *NamespaceRegistryClient) Update() *NamespaceRegistryUpdate {
+	mutation := newNamespaceRegistryMutation(c.config, OpUpdate)
+	return &NamespaceRegistryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RateLimitUserOverrideClient) UpdateOne(_m *RateLimitUserOverride) *RateLimitUserOverrideUpdateOne {
-	mutation := newRateLimitUserOverrideMutation(c.config, OpUpdateOne, withRateLimitUserOverride(_m))
-	return &RateLimitUserOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NamespaceRegistryClient) UpdateOne(_m *NamespaceRegistry) *NamespaceRegistryUpdateOne {
+	mutation := newNamespaceRegistryMutation(c.config, OpUpdateOne, withNamespaceRegistry(_m))
+	return &NamespaceRegistryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RateLimitUserOverrideClient) UpdateOneID(id string) *RateLimitUserOverrideUpdateOne {
-	mutation := newRateLimitUserOverrideMutation(c.config, OpUpdateOne, withRateLimitUserOverrideID(id))
-	return &RateLimitUserOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NamespaceRegistryClient) UpdateOneID(id string) *NamespaceRegistryUpdateOne {
+	mutation := newNamespaceRegistryMutation(c.config, OpUpdateOne, withNamespaceRegistryID(id))
+	return &NamespaceRegistryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for RateLimitUserOverride.
-func (c *RateLimitUserOverrideClient) Delete() *RateLimitUserOverrideDelete {
-	mutation := newRateLimitUserOverrideMutation(c.config, OpDelete)
-	return &RateLimitUserOverrideDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for NamespaceRegistry.
+func (c *NamespaceRegistryClient) Delete() *NamespaceRegistryDelete {
+	mutation := newNamespaceRegistryMutation(c.config, OpDelete)
+	return &NamespaceRegistryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RateLimitUserOverrideClient) DeleteOne(_m *RateLimitUserOverride) *RateLimitUserOverrideDeleteOne {
+func (c *NamespaceRegistryClient) DeleteOne(_m *NamespaceRegistry) *NamespaceRegistryDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RateLimitUserOverrideClient) DeleteOneID(id string) *RateLimitUserOverrideDeleteOne {
-	builder := c.Delete().Where(ratelimituseroverride.ID(id))
+func (c *NamespaceRegistryClient) DeleteOneID(id string) *NamespaceRegistryDeleteOne {
+	builder := c.Delete().Where(namespaceregistry.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RateLimitUserOverrideDeleteOne{builder}
+	return &NamespaceRegistryDeleteOne{builder}
 }
 
-// Query returns a query builder for RateLimitUserOverride.
-func (c *RateLimitUserOverrideClient) Query() *RateLimitUserOverrideQuery {
-	return &RateLimitUserOverrideQuery{
+// Query returns a query builder for NamespaceRegistry.
+func (c *NamespaceRegistryClient) Query() *NamespaceRegistryQuery {
+	return &NamespaceRegistryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRateLimitUserOverride},
+		ctx:    &QueryContext{Type: TypeNamespaceRegistry},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a RateLimitUserOverride entity by its id.
-func (c *RateLimitUserOverrideClient) Get(ctx context.Context, id string) (*RateLimitUserOverride, error) {
-	return c.Query().Where(ratelimituseroverride.ID(id)).Only(ctx)
+// Get returns a NamespaceRegistry entity by its id.
+func (c *NamespaceRegistryClient) Get(ctx context.Context, id string) (*NamespaceRegistry, error) {
+	return c.Query().Where(namespaceregistry.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RateLimitUserOverrideClient) GetX(ctx context.Context, id string) *RateLimitUserOverride {
+func (c *NamespaceRegistryClient) GetX(ctx context.Context, id string) *NamespaceRegistry {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2535,131 +2800,131 @@ func (c *RateLimitUserOverrideClient) GetX(ctx context.Context, id string) *Rate
 }
 
 // Hooks returns the client hooks.
-func (c *RateLimitUserOverrideClient) Hooks() []Hook {
-	return c.hooks.RateLimitUserOverride
+func (c *NamespaceRegistryClient) Hooks() []Hook {
+	return c.hooks.NamespaceRegistry
 }
 
 // Interceptors returns the client interceptors.
-func (c *RateLimitUserOverrideClient) Interceptors() []Interceptor {
-	return c.inters.RateLimitUserOverride
+func (c *NamespaceRegistryClient) Interceptors() []Interceptor {
+	return c.inters.NamespaceRegistry
 }
 
-func (c *RateLimitUserOverrideClient) mutate(ctx context.Context, m *RateLimitUserOverrideMutation) (Value, error) {
+func (c *NamespaceRegistryClient) mutate(ctx context.Context, m *NamespaceRegistryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RateLimitUserOverrideCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NamespaceRegistryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RateLimitUserOverrideUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NamespaceRegistryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RateLimitUserOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NamespaceRegistryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RateLimitUserOverrideDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&NamespaceRegistryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown RateLimitUserOverride mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown NamespaceRegistry mutation op: %q", m.Op())
 	}
 }
 
-// ResourceRoleBindingClient is a client for the ResourceRoleBinding schema.
-type ResourceRoleBindingClient struct {
+// NotificationClient is a client for the Notification schema.
+type NotificationClient struct {
 	config
 }
 
-// NewResourceRoleBindingClient returns a client for the ResourceRoleBinding from the given config.
-func NewResourceRoleBindingClient(c config) *ResourceRoleBindingClient {
-	return &ResourceRoleBindingClient{config: c}
+// NewNotificationClient returns a client for the Notification from the given config.
+func NewNotificationClient(c config) *NotificationClient {
+	return &NotificationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `resourcerolebinding.Hooks(f(g(h())))`.
-func (c *ResourceRoleBindingClient) Use(hooks ...Hook) {
-	c.hooks.ResourceRoleBinding = append(c.hooks.ResourceRoleBinding, hooks...)
+// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
+func (c *NotificationClient) Use(hooks ...Hook) {
+	c.hooks.Notification = append(c.hooks.Notification, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `resourcerolebinding.Intercept(f(g(h())))`.
-func (c *ResourceRoleBindingClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ResourceRoleBinding = append(c.inters.ResourceRoleBinding, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
+func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Notification = append(c.inters.Notification, interceptors...)
 }
 
-// Create returns a builder for creating a ResourceRoleBinding entity.
-func (c *ResourceRoleBindingClient) Create() *ResourceRoleBindingCreate {
-	mutation := newResourceRoleBindingMutation(c.config, OpCreate)
-	return &ResourceRoleBindingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Notification entity.
+func (c *NotificationClient) Create() *NotificationCreate {
+	mutation := newNotificationMutation(c.config, OpCreate)
+	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ResourceRoleBinding entities.
-func (c *ResourceRoleBindingClient) CreateBulk(builders ...*ResourceRoleBindingCreate) *ResourceRoleBindingCreateBulk {
-	return &ResourceRoleBindingCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Notification entities.
+func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
+	return &NotificationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ResourceRoleBindingClient) MapCreateBulk(slice any, setFunc func(*ResourceRoleBindingCreate, int)) *ResourceRoleBindingCreateBulk {
+func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ResourceRoleBindingCreateBulk{err: fmt.Errorf("calling to ResourceRoleBindingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ResourceRoleBindingCreate, rv.Len())
+	builders := make([]*NotificationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ResourceRoleBindingCreateBulk{config: c.config, builders: builders}
+	return &NotificationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ResourceRoleBinding.
-func (c *ResourceRoleBindingClient) Update() *ResourceRoleBindingUpdate {
-	mutation := newResourceRoleBindingMutation(c.config, OpUpdate)
-	return &ResourceRoleBindingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Notification.
+func (c *NotificationClient) Update() *NotificationUpdate {
+	mutation := newNotificationMutation(c.config, OpUpdate)
+	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ResourceRoleBindingClient) UpdateOne(_m *ResourceRoleBinding) *ResourceRoleBindingUpdateOne {
-	mutation := newResourceRoleBindingMutation(c.config, OpUpdateOne, withResourceRoleBinding(_m))
-	return &ResourceRoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NotificationClient) UpdateOne(_m *Notification) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(_m))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ResourceRoleBindingClient) UpdateOneID(id string) *ResourceRoleBindingUpdateOne {
-	mutation := newResourceRoleBindingMutation(c.config, OpUpdateOne, withResourceRoleBindingID(id))
-	return &ResourceRoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NotificationClient) UpdateOneID(id string) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ResourceRoleBinding.
-func (c *ResourceRoleBindingClient) Delete() *ResourceRoleBindingDelete {
-	mutation := newResourceRoleBindingMutation(c.config, OpDelete)
-	return &ResourceRoleBindingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Notification.
+func (c *NotificationClient) Delete() *NotificationDelete {
+	mutation := newNotificationMutation(c.config, OpDelete)
+	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ResourceRoleBindingClient) DeleteOne(_m *ResourceRoleBinding) *ResourceRoleBindingDeleteOne {
+func (c *NotificationClient) DeleteOne(_m *Notification) *NotificationDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ResourceRoleBindingClient) DeleteOneID(id string) *ResourceRoleBindingDeleteOne {
-	builder := c.Delete().Where(resourcerolebinding.ID(id))
+func (c *NotificationClient) DeleteOneID(id string) *NotificationDeleteOne {
+	builder := c.Delete().Where(notification.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ResourceRoleBindingDeleteOne{builder}
+	return &NotificationDeleteOne{builder}
 }
 
-// Query returns a query builder for ResourceRoleBinding.
-func (c *ResourceRoleBindingClient) Query() *ResourceRoleBindingQuery {
-	return &ResourceRoleBindingQuery{
+// Query returns a query builder for Notification.
+func (c *NotificationClient) Query() *NotificationQuery {
+	return &NotificationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeResourceRoleBinding},
+		ctx:    &QueryContext{Type: TypeNotification},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ResourceRoleBinding entity by its id.
-func (c *ResourceRoleBindingClient) Get(ctx context.Context, id string) (*ResourceRoleBinding, error) {
-	return c.Query().Where(resourcerolebinding.ID(id)).Only(ctx)
+// Get returns a Notification entity by its id.
+func (c *NotificationClient) Get(ctx context.Context, id string) (*Notification, error) {
+	return c.Query().Where(notification.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ResourceRoleBindingClient) GetX(ctx context.Context, id string) *ResourceRoleBinding {
+func (c *NotificationClient) GetX(ctx context.Context, id string) *Notification {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2667,132 +2932,148 @@ func (c *ResourceRoleBindingClient) GetX(ctx context.Context, id string) *Resour
 	return obj
 }
 
+// QueryUser queries the user edge of a Notification.
+func (c *NotificationClient) QueryUser(_m *Notification) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notification.Table, notification.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notification.UserTable, notification.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *ResourceRoleBindingClient) Hooks() []Hook {
-	return c.hooks.ResourceRoleBinding
+func (c *NotificationClient) Hooks() []Hook {
+	return c.hooks.Notification
 }
 
 // Interceptors returns the client interceptors.
-func (c *ResourceRoleBindingClient) Interceptors() []Interceptor {
-	return c.inters.ResourceRoleBinding
+func (c *NotificationClient) Interceptors() []Interceptor {
+	return c.inters.Notification
 }
 
-func (c *ResourceRoleBindingClient) mutate(ctx context.Context, m *ResourceRoleBindingMutation) (Value, error) {
+func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ResourceRoleBindingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ResourceRoleBindingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ResourceRoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ResourceRoleBindingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ResourceRoleBinding mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Notification mutation op: %q", m.Op())
 	}
 }
 
-// RoleClient is a client for the Role schema.
-type RoleClient struct {
+// NotificationPreferenceClient is a client for the NotificationPreference schema.
+type NotificationPreferenceClient struct {
 	config
 }
 
-// NewRoleClient returns a client for the Role from the given config.
-func NewRoleClient(c config) *RoleClient {
-	return &RoleClient{config: c}
+// NewNotificationPreferenceClient returns a client for the NotificationPreference from the given config.
+func NewNotificationPreferenceClient(c config) *NotificationPreferenceClient {
+	return &NotificationPreferenceClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `role.Hooks(f(g(h())))`.
-func (c *RoleClient) Use(hooks ...Hook) {
-	c.hooks.Role = append(c.hooks.Role, hooks...)
+// A call to `Use(f, g, h)` equals to `notificationpreference.Hooks(f(g(h())))`.
+func (c *NotificationPreferenceClient) Use(hooks ...Hook) {
+	c.hooks.NotificationPreference = append(c.hooks.NotificationPreference, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `role.Intercept(f(g(h())))`.
-func (c *RoleClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Role = append(c.inters.Role, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `notificationpreference.Intercept(f(g(h())))`.
+func (c *NotificationPreferenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationPreference = append(c.inters.NotificationPreference, interceptors...)
 }
 
-// Create returns a builder for creating a Role entity.
-func (c *RoleClient) Create() *RoleCreate {
-	mutation := newRoleMutation(c.config, OpCreate)
-	return &RoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a NotificationPreference entity.
+func (c *NotificationPreferenceClient) Create() *NotificationPreferenceCreate {
+	mutation := newNotificationPreferenceMutation(c.config, OpCreate)
+	return &NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Role entities.
-func (c *RoleClient) CreateBulk(builders ...*RoleCreate) *RoleCreateBulk {
-	return &RoleCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of NotificationPreference entities.
+func (c *NotificationPreferenceClient) CreateBulk(builders ...*NotificationPreferenceCreate) *NotificationPreferenceCreateBulk {
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RoleClient) MapCreateBulk(slice any, setFunc func(*RoleCreate, int)) *RoleCreateBulk {
+func (c *NotificationPreferenceClient) MapCreateBulk(slice any, setFunc func(*NotificationPreferenceCreate, int)) *NotificationPreferenceCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RoleCreateBulk{err: fmt.Errorf("calling to RoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &NotificationPreferenceCreateBulk{err: fmt.Errorf("calling to NotificationPreferenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RoleCreate, rv.Len())
+	builders := make([]*NotificationPreferenceCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RoleCreateBulk{config: c.config, builders: builders}
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Role.
-func (c *RoleClient) Update() *RoleUpdate {
-	mutation := newRoleMutation(c.config, OpUpdate)
-	return &RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Update() *NotificationPreferenceUpdate {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdate)
+	return &NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RoleClient) UpdateOne(_m *Role) *RoleUpdateOne {
-	mutation := newRoleMutation(c.config, OpUpdateOne, withRole(_m))
-	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NotificationPreferenceClient) UpdateOne(_m *NotificationPreference) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreference(_m))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RoleClient) UpdateOneID(id string) *RoleUpdateOne {
-	mutation := newRoleMutation(c.config, OpUpdateOne, withRoleID(id))
-	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NotificationPreferenceClient) UpdateOneID(id string) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreferenceID(id))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Role.
-func (c *RoleClient) Delete() *RoleDelete {
-	mutation := newRoleMutation(c.config, OpDelete)
-	return &RoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Delete() *NotificationPreferenceDelete {
+	mutation := newNotificationPreferenceMutation(c.config, OpDelete)
+	return &NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RoleClient) DeleteOne(_m *Role) *RoleDeleteOne {
+func (c *NotificationPreferenceClient) DeleteOne(_m *NotificationPreference) *NotificationPreferenceDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RoleClient) DeleteOneID(id string) *RoleDeleteOne {
-	builder := c.Delete().Where(role.ID(id))
+func (c *NotificationPreferenceClient) DeleteOneID(id string) *NotificationPreferenceDeleteOne {
+	builder := c.Delete().Where(notificationpreference.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RoleDeleteOne{builder}
+	return &NotificationPreferenceDeleteOne{builder}
 }
 
-// Query returns a query builder for Role.
-func (c *RoleClient) Query() *RoleQuery {
-	return &RoleQuery{
+// Query returns a query builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Query() *NotificationPreferenceQuery {
+	return &NotificationPreferenceQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRole},
+		ctx:    &QueryContext{Type: TypeNotificationPreference},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Role entity by its id.
-func (c *RoleClient) Get(ctx context.Context, id string) (*Role, error) {
-	return c.Query().Where(role.ID(id)).Only(ctx)
+// Get returns a NotificationPreference entity by its id.
+func (c *NotificationPreferenceClient) Get(ctx context.Context, id string) (*NotificationPreference, error) {
+	return c.Query().Where(notificationpreference.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RoleClient) GetX(ctx context.Context, id string) *Role {
+func (c *NotificationPreferenceClient) GetX(ctx context.Context, id string) *NotificationPreference {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2800,148 +3081,132 @@ func (c *RoleClient) GetX(ctx context.Context, id string) *Role {
 	return obj
 }
 
-// QueryRoleBindings queries the role_bindings edge of a Role.
-func (c *RoleClient) QueryRoleBindings(_m *Role) *RoleBindingQuery {
-	query := (&RoleBindingClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(role.Table, role.FieldID, id),
-			sqlgraph.To(rolebinding.Table, rolebinding.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, role.RoleBindingsTable, role.RoleBindingsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *RoleClient) Hooks() []Hook {
-	return c.hooks.Role
+func (c *NotificationPreferenceClient) Hooks() []Hook {
+	return c.hooks.NotificationPreference
 }
 
 // Interceptors returns the client interceptors.
-func (c *RoleClient) Interceptors() []Interceptor {
-	return c.inters.Role
+func (c *NotificationPreferenceClient) Interceptors() []Interceptor {
+	return c.inters.NotificationPreference
 }
 
-func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error) {
+func (c *NotificationPreferenceClient) mutate(ctx context.Context, m *NotificationPreferenceMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Role mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown NotificationPreference mutation op: %q", m.Op())
 	}
 }
 
-// RoleBindingClient is a client for the RoleBinding schema.
-type RoleBindingClient struct {
+// PasswordHistoryClient is a client for the PasswordHistory schema.
+type PasswordHistoryClient struct {
 	config
 }
 
-// NewRoleBindingClient returns a client for the RoleBinding from the given config.
-func NewRoleBindingClient(c config) *RoleBindingClient {
-	return &RoleBindingClient{config: c}
+// NewPasswordHistoryClient returns a client for the PasswordHistory from the given config.
+func NewPasswordHistoryClient(c config) *PasswordHistoryClient {
+	return &PasswordHistoryClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `rolebinding.Hooks(f(g(h())))`.
-func (c *RoleBindingClient) Use(hooks ...Hook) {
-	c.hooks.RoleBinding = append(c.hooks.RoleBinding, hooks...)
+// A call to `Use(f, g, h)` equals to `passwordhistory.Hooks(f(g(h())))`.
+func (c *PasswordHistoryClient) Use(hooks ...Hook) {
+	c.hooks.PasswordHistory = append(c.hooks.PasswordHistory, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `rolebinding.Intercept(f(g(h())))`.
-func (c *RoleBindingClient) Intercept(interceptors ...Interceptor) {
-	c.inters.RoleBinding = append(c.inters.RoleBinding, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `passwordhistory.Intercept(f(g(h())))`.
+func (c *PasswordHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PasswordHistory = append(c.inters.PasswordHistory, interceptors...)
 }
 
-// Create returns a builder for creating a RoleBinding entity.
-func (c *RoleBindingClient) Create() *RoleBindingCreate {
-	mutation := newRoleBindingMutation(c.config, OpCreate)
-	return &RoleBindingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PasswordHistory entity.
+func (c *PasswordHistoryClient) Create() *PasswordHistoryCreate {
+	mutation := newPasswordHistoryMutation(c.config, OpCreate)
+	return &PasswordHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of RoleBinding entities.
-func (c *RoleBindingClient) CreateBulk(builders ...*RoleBindingCreate) *RoleBindingCreateBulk {
-	return &RoleBindingCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PasswordHistory entities.
+func (c *PasswordHistoryClient) CreateBulk(builders ...*PasswordHistoryCreate) *PasswordHistoryCreateBulk {
+	return &PasswordHistoryCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RoleBindingClient) MapCreateBulk(slice any, setFunc func(*RoleBindingCreate, int)) *RoleBindingCreateBulk {
+func (c *PasswordHistoryClient) MapCreateBulk(slice any, setFunc func(*PasswordHistoryCreate, int)) *PasswordHistoryCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RoleBindingCreateBulk{err: fmt.Errorf("calling to RoleBindingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PasswordHistoryCreateBulk{err: fmt.Errorf("calling to PasswordHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RoleBindingCreate, rv.Len())
+	builders := make([]*PasswordHistoryCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RoleBindingCreateBulk{config: c.config, builders: builders}
+	return &PasswordHistoryCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for RoleBinding.
-func (c *RoleBindingClient) Update() *RoleBindingUpdate {
-	mutation := newRoleBindingMutation(c.config, OpUpdate)
-	return &RoleBindingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PasswordHistory.
+func (c *PasswordHistoryClient) Update() *PasswordHistoryUpdate {
+	mutation := newPasswordHistoryMutation(c.config, OpUpdate)
+	return &PasswordHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RoleBindingClient) UpdateOne(_m *RoleBinding) *RoleBindingUpdateOne {
-	mutation := newRoleBindingMutation(c.config, OpUpdateOne, withRoleBinding(_m))
-	return &RoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PasswordHistoryClient) UpdateOne(_m *PasswordHistory) *PasswordHistoryUpdateOne {
+	mutation := newPasswordHistoryMutation(c.config, OpUpdateOne, withPasswordHistory(_m))
+	return &PasswordHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RoleBindingClient) UpdateOneID(id string) *RoleBindingUpdateOne {
-	mutation := newRoleBindingMutation(c.config, OpUpdateOne, withRoleBindingID(id))
-	return &RoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PasswordHistoryClient) UpdateOneID(id string) *PasswordHistoryUpdateOne {
+	mutation := newPasswordHistoryMutation(c.config, OpUpdateOne, withPasswordHistoryID(id))
+	return &PasswordHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for RoleBinding.
-func (c *RoleBindingClient) Delete() *RoleBindingDelete {
-	mutation := newRoleBindingMutation(c.config, OpDelete)
-	return &RoleBindingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PasswordHistory.
+func (c *PasswordHistoryClient) Delete() *PasswordHistoryDelete {
+	mutation := newPasswordHistoryMutation(c.config, OpDelete)
+	return &PasswordHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RoleBindingClient) DeleteOne(_m *RoleBinding) *RoleBindingDeleteOne {
+func (c *PasswordHistoryClient) DeleteOne(_m *PasswordHistory) *PasswordHistoryDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RoleBindingClient) DeleteOneID(id string) *RoleBindingDeleteOne {
-	builder := c.Delete().Where(rolebinding.ID(id))
+func (c *PasswordHistoryClient) DeleteOneID(id string) *PasswordHistoryDeleteOne {
+	builder := c.Delete().Where(passwordhistory.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RoleBindingDeleteOne{builder}
+	return &PasswordHistoryDeleteOne{builder}
 }
 
-// Query returns a query builder for RoleBinding.
-func (c *RoleBindingClient) Query() *RoleBindingQuery {
-	return &RoleBindingQuery{
+// Query returns a query builder for PasswordHistory.
+func (c *PasswordHistoryClient) Query() *PasswordHistoryQuery {
+	return &PasswordHistoryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRoleBinding},
+		ctx:    &QueryContext{Type: TypePasswordHistory},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a RoleBinding entity by its id.
-func (c *RoleBindingClient) Get(ctx context.Context, id string) (*RoleBinding, error) {
-	return c.Query().Where(rolebinding.ID(id)).Only(ctx)
+// Get returns a PasswordHistory entity by its id.
+func (c *PasswordHistoryClient) Get(ctx context.Context, id string) (*PasswordHistory, error) {
+	return c.Query().Where(passwordhistory.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RoleBindingClient) GetX(ctx context.Context, id string) *RoleBinding {
+func (c *PasswordHistoryClient) GetX(ctx context.Context, id string) *PasswordHistory {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2949,164 +3214,132 @@ func (c *RoleBindingClient) GetX(ctx context.Context, id string) *RoleBinding {
 	return obj
 }
 
-// QueryUser queries the user edge of a RoleBinding.
-func (c *RoleBindingClient) QueryUser(_m *RoleBinding) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(rolebinding.Table, rolebinding.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, rolebinding.UserTable, rolebinding.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRole queries the role edge of a RoleBinding.
-func (c *RoleBindingClient) QueryRole(_m *RoleBinding) *RoleQuery {
-	query := (&RoleClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(rolebinding.Table, rolebinding.FieldID, id),
-			sqlgraph.To(role.Table, role.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, rolebinding.RoleTable, rolebinding.RoleColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *RoleBindingClient) Hooks() []Hook {
-	return c.hooks.RoleBinding
+func (c *PasswordHistoryClient) Hooks() []Hook {
+	return c.hooks.PasswordHistory
 }
 
 // Interceptors returns the client interceptors.
-func (c *RoleBindingClient) Interceptors() []Interceptor {
-	return c.inters.RoleBinding
+func (c *PasswordHistoryClient) Interceptors() []Interceptor {
+	return c.inters.PasswordHistory
 }
 
-func (c *RoleBindingClient) mutate(ctx context.Context, m *RoleBindingMutation) (Value, error) {
+func (c *PasswordHistoryClient) mutate(ctx context.Context, m *PasswordHistoryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RoleBindingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PasswordHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RoleBindingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PasswordHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PasswordHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RoleBindingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PasswordHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown RoleBinding mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PasswordHistory mutation op: %q", m.Op())
 	}
 }
 
-// ServiceClient is a client for the Service schema.
-type ServiceClient struct {
+// PendingAdoptionClient is a client for the PendingAdoption schema.
+type PendingAdoptionClient struct {
 	config
 }
 
-// NewServiceClient returns a client for the Service from the given config.
-func NewServiceClient(c config) *ServiceClient {
-	return &ServiceClient{config: c}
+// NewPendingAdoptionClient returns a client for the PendingAdoption from the given config.
+func NewPendingAdoptionClient(c config) *PendingAdoptionClient {
+	return &PendingAdoptionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `service.Hooks(f(g(h())))`.
-func (c *ServiceClient) Use(hooks ...Hook) {
-	c.hooks.Service = append(c.hooks.Service, hooks...)
+// A call to `Use(f, g, h)` equals to `pendingadoption.Hooks(f(g(h())))`.
+func (c *PendingAdoptionClient) Use(hooks ...Hook) {
+	c.hooks.PendingAdoption = append(c.hooks.PendingAdoption, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `service.Intercept(f(g(h())))`.
-func (c *ServiceClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Service = append(c.inters.Service, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `pendingadoption.Intercept(f(g(h())))`.
+func (c *PendingAdoptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PendingAdoption = append(c.inters.PendingAdoption, interceptors...)
 }
 
-// Create returns a builder for creating a Service entity.
-func (c *ServiceClient) Create() *ServiceCreate {
-	mutation := newServiceMutation(c.config, OpCreate)
-	return &ServiceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PendingAdoption entity.
+func (c *PendingAdoptionClient) Create() *PendingAdoptionCreate {
+	mutation := newPendingAdoptionMutation(c.config, OpCreate)
+	return &PendingAdoptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Service entities.
-func (c *ServiceClient) CreateBulk(builders ...*ServiceCreate) *ServiceCreateBulk {
-	return &ServiceCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PendingAdoption entities.
+func (c *PendingAdoptionClient) CreateBulk(builders ...*PendingAdoptionCreate) *PendingAdoptionCreateBulk {
+	return &PendingAdoptionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ServiceClient) MapCreateBulk(slice any, setFunc func(*ServiceCreate, int)) *ServiceCreateBulk {
+func (c *PendingAdoptionClient) MapCreateBulk(slice any, setFunc func(*PendingAdoptionCreate, int)) *PendingAdoptionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ServiceCreateBulk{err: fmt.Errorf("calling to ServiceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PendingAdoptionCreateBulk{err: fmt.Errorf("calling to PendingAdoptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ServiceCreate, rv.Len())
+	builders := make([]*PendingAdoptionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ServiceCreateBulk{config: c.config, builders: builders}
+	return &PendingAdoptionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Service.
-func (c *ServiceClient) Update() *ServiceUpdate {
-	mutation := newServiceMutation(c.config, OpUpdate)
-	return &ServiceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PendingAdoption.
+func (c *PendingAdoptionClient) Update() *PendingAdoptionUpdate {
+	mutation := newPendingAdoptionMutation(c.config, OpUpdate)
+	return &PendingAdoptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ServiceClient) UpdateOne(_m *Service) *ServiceUpdateOne {
-	mutation := newServiceMutation(c.config, OpUpdateOne, withService(_m))
-	return &ServiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PendingAdoptionClient) UpdateOne(_m *PendingAdoption) *PendingAdoptionUpdateOne {
+	mutation := newPendingAdoptionMutation(c.config, OpUpdateOne, withPendingAdoption(_m))
+	return &PendingAdoptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ServiceClient) UpdateOneID(id string) *ServiceUpdateOne {
-	mutation := newServiceMutation(c.config, OpUpdateOne, withServiceID(id))
-	return &ServiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PendingAdoptionClient) UpdateOneID(id string) *PendingAdoptionUpdateOne {
+	mutation := newPendingAdoptionMutation(c.config, OpUpdateOne, withPendingAdoptionID(id))
+	return &PendingAdoptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Service.
-func (c *ServiceClient) Delete() *ServiceDelete {
-	mutation := newServiceMutation(c.config, OpDelete)
-	return &ServiceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PendingAdoption.
+func (c *PendingAdoptionClient) Delete() *PendingAdoptionDelete {
+	mutation := newPendingAdoptionMutation(c.config, OpDelete)
+	return &PendingAdoptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ServiceClient) DeleteOne(_m *Service) *ServiceDeleteOne {
+func (c *PendingAdoptionClient) DeleteOne(_m *PendingAdoption) *PendingAdoptionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ServiceClient) DeleteOneID(id string) *ServiceDeleteOne {
-	builder := c.Delete().Where(service.ID(id))
+func (c *PendingAdoptionClient) DeleteOneID(id string) *PendingAdoptionDeleteOne {
+	builder := c.Delete().Where(pendingadoption.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ServiceDeleteOne{builder}
+	return &PendingAdoptionDeleteOne{builder}
 }
 
-// Query returns a query builder for Service.
-func (c *ServiceClient) Query() *ServiceQuery {
-	return &ServiceQuery{
+// Query returns a query builder for PendingAdoption.
+func (c *PendingAdoptionClient) Query() *PendingAdoptionQuery {
+	return &PendingAdoptionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeService},
+		ctx:    &QueryContext{Type: TypePendingAdoption},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Service entity by its id.
-func (c *ServiceClient) Get(ctx context.Context, id string) (*Service, error) {
-	return c.Query().Where(service.ID(id)).Only(ctx)
+// Get returns a PendingAdoption entity by its id.
+func (c *PendingAdoptionClient) Get(ctx context.Context, id string) (*PendingAdoption, error) {
+	return c.Query().Where(pendingadoption.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ServiceClient) GetX(ctx context.Context, id string) *Service {
+func (c *PendingAdoptionClient) GetX(ctx context.Context, id string) *PendingAdoption {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3114,164 +3347,132 @@ func (c *ServiceClient) GetX(ctx context.Context, id string) *Service {
 	return obj
 }
 
-// QuerySystem queries the system edge of a Service.
-func (c *ServiceClient) QuerySystem(_m *Service) *SystemQuery {
-	query := (&SystemClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(service.Table, service.FieldID, id),
-			sqlgraph.To(system.Table, system.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, service.SystemTable, service.SystemColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryVms queries the vms edge of a Service.
-func (c *ServiceClient) QueryVms(_m *Service) *VMQuery {
-	query := (&VMClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(service.Table, service.FieldID, id),
-			sqlgraph.To(vm.Table, vm.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, service.VmsTable, service.VmsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *ServiceClient) Hooks() []Hook {
-	return c.hooks.Service
+func (c *PendingAdoptionClient) Hooks() []Hook {
+	return c.hooks.PendingAdoption
 }
 
 // Interceptors returns the client interceptors.
-func (c *ServiceClient) Interceptors() []Interceptor {
-	return c.inters.Service
+func (c *PendingAdoptionClient) Interceptors() []Interceptor {
+	return c.inters.PendingAdoption
 }
 
-func (c *ServiceClient) mutate(ctx context.Context, m *ServiceMutation) (Value, error) {
+func (c *PendingAdoptionClient) mutate(ctx context.Context, m *PendingAdoptionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ServiceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PendingAdoptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ServiceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PendingAdoptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ServiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PendingAdoptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ServiceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PendingAdoptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Service mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PendingAdoption mutation op: %q", m.Op())
 	}
 }
 
-// SystemClient is a client for the System schema.
-type SystemClient struct {
+// PlatformConfigClient is a client for the PlatformConfig schema.
+type PlatformConfigClient struct {
 	config
 }
 
-// NewSystemClient returns a client for the System from the given config.
-func NewSystemClient(c config) *SystemClient {
-	return &SystemClient{config: c}
+// NewPlatformConfigClient returns a client for the PlatformConfig from the given config.
+func NewPlatformConfigClient(c config) *PlatformConfigClient {
+	return &PlatformConfigClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `system.Hooks(f(g(h())))`.
-func (c *SystemClient) Use(hooks ...Hook) {
-	c.hooks.System = append(c.hooks.System, hooks...)
+// A call to `Use(f, g, h)` equals to `platformconfig.Hooks(f(g(h())))`.
+func (c *PlatformConfigClient) Use(hooks ...Hook) {
+	c.hooks.PlatformConfig = append(c.hooks.PlatformConfig, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `system.Intercept(f(g(h())))`.
-func (c *SystemClient) Intercept(interceptors ...Interceptor) {
-	c.inters.System = append(c.inters.System, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `platformconfig.Intercept(f(g(h())))`.
+func (c *PlatformConfigClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PlatformConfig = append(c.inters.PlatformConfig, interceptors...)
 }
 
-// Create returns a builder for creating a System entity.
-func (c *SystemClient) Create() *SystemCreate {
-	mutation := newSystemMutation(c.config, OpCreate)
-	return &SystemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PlatformConfig entity.
+func (c *PlatformConfigClient) Create() *PlatformConfigCreate {
+	mutation := newPlatformConfigMutation(c.config, OpCreate)
+	return &PlatformConfigCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of System entities.
-func (c *SystemClient) CreateBulk(builders ...*SystemCreate) *SystemCreateBulk {
-	return &SystemCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PlatformConfig entities.
+func (c *PlatformConfigClient) CreateBulk(builders ...*PlatformConfigCreate) *PlatformConfigCreateBulk {
+	return &PlatformConfigCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SystemClient) MapCreateBulk(slice any, setFunc func(*SystemCreate, int)) *SystemCreateBulk {
+func (c *PlatformConfigClient) MapCreateBulk(slice any, setFunc func(*PlatformConfigCreate, int)) *PlatformConfigCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SystemCreateBulk{err: fmt.Errorf("calling to SystemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PlatformConfigCreateBulk{err: fmt.Errorf("calling to PlatformConfigClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SystemCreate, rv.Len())
+	builders := make([]*PlatformConfigCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SystemCreateBulk{config: c.config, builders: builders}
+	return &PlatformConfigCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for System.
-func (c *SystemClient) Update() *SystemUpdate {
-	mutation := newSystemMutation(c.config, OpUpdate)
-	return &SystemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PlatformConfig.
+func (c *PlatformConfigClient) Update() *PlatformConfigUpdate {
+	mutation := newPlatformConfigMutation(c.config, OpUpdate)
+	return &PlatformConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SystemClient) UpdateOne(_m *System) *SystemUpdateOne {
-	mutation := newSystemMutation(c.config, OpUpdateOne, withSystem(_m))
-	return &SystemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PlatformConfigClient) UpdateOne(_m *PlatformConfig) *PlatformConfigUpdateOne {
+	mutation := newPlatformConfigMutation(c.config, OpUpdateOne, withPlatformConfig(_m))
+	return &PlatformConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SystemClient) UpdateOneID(id string) *SystemUpdateOne {
-	mutation := newSystemMutation(c.config, OpUpdateOne, withSystemID(id))
-	return &SystemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PlatformConfigClient) UpdateOneID(id string) *PlatformConfigUpdateOne {
+	mutation := newPlatformConfigMutation(c.config, OpUpdateOne, withPlatformConfigID(id))
+	return &PlatformConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for System.
-func (c *SystemClient) Delete() *SystemDelete {
-	mutation := newSystemMutation(c.config, OpDelete)
-	return &SystemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PlatformConfig.
+func (c *PlatformConfigClient) Delete() *PlatformConfigDelete {
+	mutation := newPlatformConfigMutation(c.config, OpDelete)
+	return &PlatformConfigDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SystemClient) DeleteOne(_m *System) *SystemDeleteOne {
+func (c *PlatformConfigClient) DeleteOne(_m *PlatformConfig) *PlatformConfigDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SystemClient) DeleteOneID(id string) *SystemDeleteOne {
-	builder := c.Delete().Where(system.ID(id))
+func (c *PlatformConfigClient) DeleteOneID(id string) *PlatformConfigDeleteOne {
+	builder := c.Delete().Where(platformconfig.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SystemDeleteOne{builder}
+	return &PlatformConfigDeleteOne{builder}
 }
 
-// Query returns a query builder for System.
-func (c *SystemClient) Query() *SystemQuery {
-	return &SystemQuery{
+// Query returns a query builder for PlatformConfig.
+func (c *PlatformConfigClient) Query() *PlatformConfigQuery {
+	return &PlatformConfigQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSystem},
+		ctx:    &QueryContext{Type: TypePlatformConfig},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a System entity by its id.
-func (c *SystemClient) Get(ctx context.Context, id string) (*System, error) {
-	return c.Query().Where(system.ID(id)).Only(ctx)
+// Get returns a PlatformConfig entity by its id.
+func (c *PlatformConfigClient) Get(ctx context.Context, id string) (*PlatformConfig, error) {
+	return c.Query().Where(platformconfig.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SystemClient) GetX(ctx context.Context, id string) *System {
+func (c *PlatformConfigClient) GetX(ctx context.Context, id string) *PlatformConfig {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3279,148 +3480,132 @@ func (c *SystemClient) GetX(ctx context.Context, id string) *System {
 	return obj
 }
 
-// QueryServices queries the services edge of a System.
-func (c *SystemClient) QueryServices(_m *System) *ServiceQuery {
-	query := (&ServiceClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(system.Table, system.FieldID, id),
-			sqlgraph.To(service.Table, service.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, system.ServicesTable, system.ServicesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *SystemClient) Hooks() []Hook {
-	return c.hooks.System
+func (c *PlatformConfigClient) Hooks() []Hook {
+	return c.hooks.PlatformConfig
 }
 
 // Interceptors returns the client interceptors.
-func (c *SystemClient) Interceptors() []Interceptor {
-	return c.inters.System
+func (c *PlatformConfigClient) Interceptors() []Interceptor {
+	return c.inters.PlatformConfig
 }
 
-func (c *SystemClient) mutate(ctx context.Context, m *SystemMutation) (Value, error) {
+func (c *PlatformConfigClient) mutate(ctx context.Context, m *PlatformConfigMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SystemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PlatformConfigCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SystemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PlatformConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SystemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PlatformConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SystemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PlatformConfigDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown System mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PlatformConfig mutation op: %q", m.Op())
 	}
 }
 
-// SystemSecretClient is a client for the SystemSecret schema.
-type SystemSecretClient struct {
+// QuotaClient is a client for the Quota schema.
+type QuotaClient struct {
 	config
 }
 
-// NewSystemSecretClient returns a client for the SystemSecret from the given config.
-func NewSystemSecretClient(c config) *SystemSecretClient {
-	return &SystemSecretClient{config: c}
+// NewQuotaClient returns a client for the Quota from the given config.
+func NewQuotaClient(c config) *QuotaClient {
+	return &QuotaClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `systemsecret.Hooks(f(g(h())))`.
-func (c *SystemSecretClient) Use(hooks ...Hook) {
-	c.hooks.SystemSecret = append(c.hooks.SystemSecret, hooks...)
+// A call to `Use(f, g, h)` equals to `quota.Hooks(f(g(h())))`.
+func (c *QuotaClient) Use(hooks ...Hook) {
+	c.hooks.Quota = append(c.hooks.Quota, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `systemsecret.Intercept(f(g(h())))`.
-func (c *SystemSecretClient) Intercept(interceptors ...Interceptor) {
-	c.inters.SystemSecret = append(c.inters.SystemSecret, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `quota.Intercept(f(g(h())))`.
+func (c *QuotaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Quota = append(c.inters.Quota, interceptors...)
 }
 
-// Create returns a builder for creating a SystemSecret entity.
-func (c *SystemSecretClient) Create() *SystemSecretCreate {
-	mutation := newSystemSecretMutation(c.config, OpCreate)
-	return &SystemSecretCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Quota entity.
+func (c *QuotaClient) Create() *QuotaCreate {
+	mutation := newQuotaMutation(c.config, OpCreate)
+	return &QuotaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of SystemSecret entities.
-func (c *SystemSecretClient) CreateBulk(builders ...*SystemSecretCreate) *SystemSecretCreateBulk {
-	return &SystemSecretCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Quota entities.
+func (c *QuotaClient) CreateBulk(builders ...*QuotaCreate) *QuotaCreateBulk {
+	return &QuotaCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SystemSecretClient) MapCreateBulk(slice any, setFunc func(*SystemSecretCreate, int)) *SystemSecretCreateBulk {
+func (c *QuotaClient) MapCreateBulk(slice any, setFunc func(*QuotaCreate, int)) *QuotaCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SystemSecretCreateBulk{err: fmt.Errorf("calling to SystemSecretClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &QuotaCreateBulk{err: fmt.Errorf("calling to QuotaClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SystemSecretCreate, rv.Len())
+	builders := make([]*QuotaCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SystemSecretCreateBulk{config: c.config, builders: builders}
+	return &QuotaCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for SystemSecret.
-func (c *SystemSecretClient) Update() *SystemSecretUpdate {
-	mutation := newSystemSecretMutation(c.config, OpUpdate)
-	return &SystemSecretUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Quota.
+func (c *QuotaClient) Update() *QuotaUpdate {
+	mutation := newQuotaMutation(c.config, OpUpdate)
+	return &QuotaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SystemSecretClient) UpdateOne(_m *SystemSecret) *SystemSecretUpdateOne {
-	mutation := newSystemSecretMutation(c.config, OpUpdateOne, withSystemSecret(_m))
-	return &SystemSecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *QuotaClient) UpdateOne(_m *Quota) *QuotaUpdateOne {
+	mutation := newQuotaMutation(c.config, OpUpdateOne, withQuota(_m))
+	return &QuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SystemSecretClient) UpdateOneID(id string) *SystemSecretUpdateOne {
-	mutation := newSystemSecretMutation(c.config, OpUpdateOne, withSystemSecretID(id))
-	return &SystemSecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *QuotaClient) UpdateOneID(id string) *QuotaUpdateOne {
+	mutation := newQuotaMutation(c.config, OpUpdateOne, withQuotaID(id))
+	return &QuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for SystemSecret.
-func (c *SystemSecretClient) Delete() *SystemSecretDelete {
-	mutation := newSystemSecretMutation(c.config, OpDelete)
-	return &SystemSecretDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Quota.
+func (c *QuotaClient) Delete() *QuotaDelete {
+	mutation := newQuotaMutation(c.config, OpDelete)
+	return &QuotaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SystemSecretClient) DeleteOne(_m *SystemSecret) *SystemSecretDeleteOne {
+func (c *QuotaClient) DeleteOne(_m *Quota) *QuotaDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SystemSecretClient) DeleteOneID(id string) *SystemSecretDeleteOne {
-	builder := c.Delete().Where(systemsecret.ID(id))
+func (c *QuotaClient) DeleteOneID(id string) *QuotaDeleteOne {
+	builder := c.Delete().Where(quota.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SystemSecretDeleteOne{builder}
+	return &QuotaDeleteOne{builder}
 }
 
-// Query returns a query builder for SystemSecret.
-func (c *SystemSecretClient) Query() *SystemSecretQuery {
-	return &SystemSecretQuery{
+// Query returns a query builder for Quota.
+func (c *QuotaClient) Query() *QuotaQuery {
+	return &QuotaQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSystemSecret},
+		ctx:    &QueryContext{Type: TypeQuota},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a SystemSecret entity by its id.
-func (c *SystemSecretClient) Get(ctx context.Context, id string) (*SystemSecret, error) {
-	return c.Query().Where(systemsecret.ID(id)).Only(ctx)
+// Get returns a Quota entity by its id.
+func (c *QuotaClient) Get(ctx context.Context, id string) (*Quota, error) {
+	return c.Query().Where(quota.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SystemSecretClient) GetX(ctx context.Context, id string) *SystemSecret {
+func (c *QuotaClient) GetX(ctx context.Context, id string) *Quota {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3429,39 +3614,1598 @@ func (c *SystemSecretClient) GetX(ctx context.Context, id string) *SystemSecret
 }
 
 // Hooks returns the client hooks.
-func (c *SystemSecretClient) Hooks() []Hook {
-	return c.hooks.SystemSecret
+func (c *QuotaClient) Hooks() []Hook {
+	return c.hooks.Quota
 }
 
 // Interceptors returns the client interceptors.
-func (c *SystemSecretClient) Interceptors() []Interceptor {
-	return c.inters.SystemSecret
+func (c *QuotaClient) Interceptors() []Interceptor {
+	return c.inters.Quota
 }
 
-func (c *SystemSecretClient) mutate(ctx context.Context, m *SystemSecretMutation) (Value, error) {
+func (c *QuotaClient) mutate(ctx context.Context, m *QuotaMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SystemSecretCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QuotaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SystemSecretUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QuotaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SystemSecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SystemSecretDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&QuotaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown SystemSecret mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Quota mutation op: %q", m.Op())
 	}
 }
 
-// TemplateClient is a client for the Template schema.
-type TemplateClient struct {
+// RateLimitExemptionClient is a client for the RateLimitExemption schema.
+type RateLimitExemptionClient struct {
 	config
 }
 
-// NewTemplateClient returns a client for the Template from the given config.
-func NewTemplateClient(c config) *TemplateClient {
-	return &TemplateClient{config: c}
-}
+// NewRateLimitExemptionClient returns a client for the RateLimitExemption from the given config.
+func NewRateLimitExemptionClient(c config) *RateLimitExemptionClient {
+	return &RateLimitExemptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ratelimitexemption.Hooks(f(g(h())))`.
+func (c *RateLimitExemptionClient) Use(hooks ...Hook) {
+	c.hooks.RateLimitExemption = append(c.hooks.RateLimitExemption, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ratelimitexemption.Intercept(f(g(h())))`.
+func (c *RateLimitExemptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RateLimitExemption = append(c.inters.RateLimitExemption, interceptors...)
+}
+
+// Create returns a builder for creating a RateLimitExemption entity.
+func (c *RateLimitExemptionClient) Create() *RateLimitExemptionCreate {
+	mutation := newRateLimitExemptionMutation(c.config, OpCreate)
+	return &RateLimitExemptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RateLimitExemption entities.
+func (c *RateLimitExemptionClient) CreateBulk(builders ...*RateLimitExemptionCreate) *RateLimitExemptionCreateBulk {
+	return &RateLimitExemptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RateLimitExemptionClient) MapCreateBulk(slice any, setFunc func(*RateLimitExemptionCreate, int)) *RateLimitExemptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RateLimitExemptionCreateBulk{err: fmt.Errorf("calling to RateLimitExemptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RateLimitExemptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RateLimitExemptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RateLimitExemption.
+func (c *RateLimitExemptionClient) Update() *RateLimitExemptionUpdate {
+	mutation := newRateLimitExemptionMutation(c.config, OpUpdate)
+	return &RateLimitExemptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RateLimitExemptionClient) UpdateOne(_m *RateLimitExemption) *RateLimitExemptionUpdateOne {
+	mutation := newRateLimitExemptionMutation(c.config, OpUpdateOne, withRateLimitExemption(_m))
+	return &RateLimitExemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RateLimitExemptionClient) UpdateOneID(id string) *RateLimitExemptionUpdateOne {
+	mutation := newRateLimitExemptionMutation(c.config, OpUpdateOne, withRateLimitExemptionID(id))
+	return &RateLimitExemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RateLimitExemption.
+func (c *RateLimitExemptionClient) Delete() *RateLimitExemptionDelete {
+	mutation := newRateLimitExemptionMutation(c.config, OpDelete)
+	return &RateLimitExemptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RateLimitExemptionClient) DeleteOne(_m *RateLimitExemption) *RateLimitExemptionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RateLimitExemptionClient) DeleteOneID(id string) *RateLimitExemptionDeleteOne {
+	builder := c.Delete().Where(ratelimitexemption.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RateLimitExemptionDeleteOne{builder}
+}
+
+// Query returns a query builder for RateLimitExemption.
+func (c *RateLimitExemptionClient) Query() *RateLimitExemptionQuery {
+	return &RateLimitExemptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRateLimitExemption},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RateLimitExemption entity by its id.
+func (c *RateLimitExemptionClient) Get(ctx context.Context, id string) (*RateLimitExemption, error) {
+	return c.Query().Where(ratelimitexemption.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RateLimitExemptionClient) GetX(ctx context.Context, id string) *RateLimitExemption {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RateLimitExemptionClient) Hooks() []Hook {
+	return c.hooks.RateLimitExemption
+}
+
+// Interceptors returns the client interceptors.
+func (c *RateLimitExemptionClient) Interceptors() []Interceptor {
+	return c.inters.RateLimitExemption
+}
+
+func (c *RateLimitExemptionClient) mutate(ctx context.Context, m *RateLimitExemptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RateLimitExemptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RateLimitExemptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RateLimitExemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RateLimitExemptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RateLimitExemption mutation op: %q", m.Op())
+	}
+}
+
+// RateLimitUserOverrideClient is a client for the RateLimitUserOverride schema.
+type RateLimitUserOverrideClient struct {
+	config
+}
+
+// NewRateLimitUserOverrideClient returns a client for the RateLimitUserOverride from the given config.
+func NewRateLimitUserOverrideClient(c config) *RateLimitUserOverrideClient {
+	return &RateLimitUserOverrideClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ratelimituseroverride.Hooks(f(g(h())))`.
+func (c *RateLimitUserOverrideClient) Use(hooks ...Hook) {
+	c.hooks.RateLimitUserOverride = append(c.hooks.RateLimitUserOverride, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ratelimituseroverride.Intercept(f(g(h())))`.
+func (c *RateLimitUserOverrideClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RateLimitUserOverride = append(c.inters.RateLimitUserOverride, interceptors...)
+}
+
+// Create returns a builder for creating a RateLimitUserOverride entity.
+func (c *RateLimitUserOverrideClient) Create() *RateLimitUserOverrideCreate {
+	mutation := newRateLimitUserOverrideMutation(c.config, OpCreate)
+	return &RateLimitUserOverrideCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RateLimitUserOverride entities.
+func (c *RateLimitUserOverrideClient) CreateBulk(builders ...*RateLimitUserOverrideCreate) *RateLimitUserOverrideCreateBulk {
+	return &RateLimitUserOverrideCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RateLimitUserOverrideClient) MapCreateBulk(slice any, setFunc func(*RateLimitUserOverrideCreate, int)) *RateLimitUserOverrideCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RateLimitUserOverrideCreateBulk{err: fmt.Errorf("calling to RateLimitUserOverrideClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RateLimitUserOverrideCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RateLimitUserOverrideCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RateLimitUserOverride.
+func (c *RateLimitUserOverrideClient) Update() *RateLimitUserOverrideUpdate {
+	mutation := newRateLimitUserOverrideMutation(c.config, OpUpdate)
+	return &RateLimitUserOverrideUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RateLimitUserOverrideClient) UpdateOne(_m *RateLimitUserOverride) *RateLimitUserOverrideUpdateOne {
+	mutation := newRateLimitUserOverrideMutation(c.config, OpUpdateOne, withRateLimitUserOverride(_m))
+	return &RateLimitUserOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RateLimitUserOverrideClient) UpdateOneID(id string) *RateLimitUserOverrideUpdateOne {
+	mutation := newRateLimitUserOverrideMutation(c.config, OpUpdateOne, withRateLimitUserOverrideID(id))
+	return &RateLimitUserOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RateLimitUserOverride.
+func (c *RateLimitUserOverrideClient) Delete() *RateLimitUserOverrideDelete {
+	mutation := newRateLimitUserOverrideMutation(c.config, OpDelete)
+	return &RateLimitUserOverrideDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RateLimitUserOverrideClient) DeleteOne(_m *RateLimitUserOverride) *RateLimitUserOverrideDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RateLimitUserOverrideClient) DeleteOneID(id string) *RateLimitUserOverrideDeleteOne {
+	builder := c.Delete().Where(ratelimituseroverride.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RateLimitUserOverrideDeleteOne{builder}
+}
+
+// Query returns a query builder for RateLimitUserOverride.
+func (c *RateLimitUserOverrideClient) Query() *RateLimitUserOverrideQuery {
+	return &RateLimitUserOverrideQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRateLimitUserOverride},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RateLimitUserOverride entity by its id.
+func (c *RateLimitUserOverrideClient) Get(ctx context.Context, id string) (*RateLimitUserOverride, error) {
+	return c.Query().Where(ratelimituseroverride.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RateLimitUserOverrideClient) GetX(ctx context.Context, id string) *RateLimitUserOverride {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RateLimitUserOverrideClient) Hooks() []Hook {
+	return c.hooks.RateLimitUserOverride
+}
+
+// Interceptors returns the client interceptors.
+func (c *RateLimitUserOverrideClient) Interceptors() []Interceptor {
+	return c.inters.RateLimitUserOverride
+}
+
+func (c *RateLimitUserOverrideClient) mutate(ctx context.Context, m *RateLimitUserOverrideMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RateLimitUserOverrideCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RateLimitUserOverrideUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RateLimitUserOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RateLimitUserOverrideDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RateLimitUserOverride mutation op: %q", m.Op())
+	}
+}
+
+// RefreshTokenClient is a client for the RefreshToken schema.
+type RefreshTokenClient struct {
+	config
+}
+
+// NewRefreshTokenClient returns a client for the RefreshToken from the given config.
+func NewRefreshTokenClient(c config) *RefreshTokenClient {
+	return &RefreshTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `refreshtoken.Hooks(f(g(h())))`.
+func (c *RefreshTokenClient) Use(hooks ...Hook) {
+	c.hooks.RefreshToken = append(c.hooks.RefreshToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `refreshtoken.Intercept(f(g(h())))`.
+func (c *RefreshTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RefreshToken = append(c.inters.RefreshToken, interceptors...)
+}
+
+// Create returns a builder for creating a RefreshToken entity.
+func (c *RefreshTokenClient) Create() *RefreshTokenCreate {
+	mutation := newRefreshTokenMutation(c.config, OpCreate)
+	return &RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RefreshToken entities.
+func (c *RefreshTokenClient) CreateBulk(builders ...*RefreshTokenCreate) *RefreshTokenCreateBulk {
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RefreshTokenClient) MapCreateBulk(slice any, setFunc func(*RefreshTokenCreate, int)) *RefreshTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RefreshTokenCreateBulk{err: fmt.Errorf("calling to RefreshTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RefreshTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RefreshToken.
+func (c *RefreshTokenClient) Update() *RefreshTokenUpdate {
+	mutation := newRefreshTokenMutation(c.config, OpUpdate)
+	return &RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RefreshTokenClient) UpdateOne(_m *RefreshToken) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshToken(_m))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RefreshTokenClient) UpdateOneID(id string) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshTokenID(id))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RefreshToken.
+func (c *RefreshTokenClient) Delete() *RefreshTokenDelete {
+	mutation := newRefreshTokenMutation(c.config, OpDelete)
+	return &RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RefreshTokenClient) DeleteOne(_m *RefreshToken) *RefreshTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RefreshTokenClient) DeleteOneID(id string) *RefreshTokenDeleteOne {
+	builder := c.Delete().Where(refreshtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RefreshTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for RefreshToken.
+func (c *RefreshTokenClient) Query() *RefreshTokenQuery {
+	return &RefreshTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRefreshToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RefreshToken entity by its id.
+func (c *RefreshTokenClient) Get(ctx context.Context, id string) (*RefreshToken, error) {
+	return c.Query().Where(refreshtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RefreshTokenClient) GetX(ctx context.Context, id string) *RefreshToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RefreshTokenClient) Hooks() []Hook {
+	return c.hooks.RefreshToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *RefreshTokenClient) Interceptors() []Interceptor {
+	return c.inters.RefreshToken
+}
+
+func (c *RefreshTokenClient) mutate(ctx context.Context, m *RefreshTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RefreshToken mutation op: %q", m.Op())
+	}
+}
+
+// ResourceRoleBindingClient is a client for the ResourceRoleBinding schema.
+type ResourceRoleBindingClient struct {
+	config
+}
+
+// NewResourceRoleBindingClient returns a client for the ResourceRoleBinding from the given config.
+func NewResourceRoleBindingClient(c config) *ResourceRoleBindingClient {
+	return &ResourceRoleBindingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `resourcerolebinding.Hooks(f(g(h())))`.
+func (c *ResourceRoleBindingClient) Use(hooks ...Hook) {
+	c.hooks.ResourceRoleBinding = append(c.hooks.ResourceRoleBinding, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `resourcerolebinding.Intercept(f(g(h())))`.
+func (c *ResourceRoleBindingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResourceRoleBinding = append(c.inters.ResourceRoleBinding, interceptors...)
+}
+
+// Create returns a builder for creating a ResourceRoleBinding entity.
+func (c *ResourceRoleBindingClient) Create() *ResourceRoleBindingCreate {
+	mutation := newResourceRoleBindingMutation(c.config, OpCreate)
+	return &ResourceRoleBindingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ResourceRoleBinding entities.
+func (c *ResourceRoleBindingClient) CreateBulk(builders ...*ResourceRoleBindingCreate) *ResourceRoleBindingCreateBulk {
+	return &ResourceRoleBindingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ResourceRoleBindingClient) MapCreateBulk(slice any, setFunc func(*ResourceRoleBindingCreate, int)) *ResourceRoleBindingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ResourceRoleBindingCreateBulk{err: fmt.Errorf("calling to ResourceRoleBindingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ResourceRoleBindingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ResourceRoleBindingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ResourceRoleBinding.
+func (c *ResourceRoleBindingClient) Update() *ResourceRoleBindingUpdate {
+	mutation := newResourceRoleBindingMutation(c.config, OpUpdate)
+	return &ResourceRoleBindingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ResourceRoleBindingClient) UpdateOne(_m *ResourceRoleBinding) *ResourceRoleBindingUpdateOne {
+	mutation := newResourceRoleBindingMutation(c.config, OpUpdateOne, withResourceRoleBinding(_m))
+	return &ResourceRoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ResourceRoleBindingClient) UpdateOneID(id string) *ResourceRoleBindingUpdateOne {
+	mutation := newResourceRoleBindingMutation(c.config, OpUpdateOne, withResourceRoleBindingID(id))
+	return &ResourceRoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ResourceRoleBinding.
+func (c *ResourceRoleBindingClient) Delete() *ResourceRoleBindingDelete {
+	mutation := newResourceRoleBindingMutation(c.config, OpDelete)
+	return &ResourceRoleBindingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ResourceRoleBindingClient) DeleteOne(_m *ResourceRoleBinding) *ResourceRoleBindingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ResourceRoleBindingClient) DeleteOneID(id string) *ResourceRoleBindingDeleteOne {
+	builder := c.Delete().Where(resourcerolebinding.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ResourceRoleBindingDeleteOne{builder}
+}
+
+// Query returns a query builder for ResourceRoleBinding.
+func (c *ResourceRoleBindingClient) Query() *ResourceRoleBindingQuery {
+	return &ResourceRoleBindingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeResourceRoleBinding},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ResourceRoleBinding entity by its id.
+func (c *ResourceRoleBindingClient) Get(ctx context.Context, id string) (*ResourceRoleBinding, error) {
+	return c.Query().Where(resourcerolebinding.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ResourceRoleBindingClient) GetX(ctx context.Context, id string) *ResourceRoleBinding {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ResourceRoleBindingClient) Hooks() []Hook {
+	return c.hooks.ResourceRoleBinding
+}
+
+// Interceptors returns the client interceptors.
+func (c *ResourceRoleBindingClient) Interceptors() []Interceptor {
+	return c.inters.ResourceRoleBinding
+}
+
+func (c *ResourceRoleBindingClient) mutate(ctx context.Context, m *ResourceRoleBindingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ResourceRoleBindingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ResourceRoleBindingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ResourceRoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ResourceRoleBindingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ResourceRoleBinding mutation op: %q", m.Op())
+	}
+}
+
+// RevokedSessionClient is a client for the RevokedSession schema.
+type RevokedSessionClient struct {
+	config
+}
+
+// NewRevokedSessionClient returns a client for the RevokedSession from the given config.
+func NewRevokedSessionClient(c config) *RevokedSessionClient {
+	return &RevokedSessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `revokedsession.Hooks(f(g(h())))`.
+func (c *RevokedSessionClient) Use(hooks ...Hook) {
+	c.hooks.RevokedSession = append(c.hooks.RevokedSession, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `revokedsession.Intercept(f(g(h())))`.
+func (c *RevokedSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RevokedSession = append(c.inters.RevokedSession, interceptors...)
+}
+
+// Create returns a builder for creating a RevokedSession entity.
+func (c *RevokedSessionClient) Create() *RevokedSessionCreate {
+	mutation := newRevokedSessionMutation(c.config, OpCreate)
+	return &RevokedSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RevokedSession entities.
+func (c *RevokedSessionClient) CreateBulk(builders ...*RevokedSessionCreate) *RevokedSessionCreateBulk {
+	return &RevokedSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RevokedSessionClient) MapCreateBulk(slice any, setFunc func(*RevokedSessionCreate, int)) *RevokedSessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RevokedSessionCreateBulk{err: fmt.Errorf("calling to RevokedSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RevokedSessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RevokedSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RevokedSession.
+func (c *RevokedSessionClient) Update() *RevokedSessionUpdate {
+	mutation := newRevokedSessionMutation(c.config, OpUpdate)
+	return &RevokedSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RevokedSessionClient) UpdateOne(_m *RevokedSession) *RevokedSessionUpdateOne {
+	mutation := newRevokedSessionMutation(c.config, OpUpdateOne, withRevokedSession(_m))
+	return &RevokedSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RevokedSessionClient) UpdateOneID(id string) *RevokedSessionUpdateOne {
+	mutation := newRevokedSessionMutation(c.config, OpUpdateOne, withRevokedSessionID(id))
+	return &RevokedSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RevokedSession.
+func (c *RevokedSessionClient) Delete() *RevokedSessionDelete {
+	mutation := newRevokedSessionMutation(c.config, OpDelete)
+	return &RevokedSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RevokedSessionClient) DeleteOne(_m *RevokedSession) *RevokedSessionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RevokedSessionClient) DeleteOneID(id string) *RevokedSessionDeleteOne {
+	builder := c.Delete().Where(revokedsession.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RevokedSessionDeleteOne{builder}
+}
+
+// Query returns a query builder for RevokedSession.
+func (c *RevokedSessionClient) Query() *RevokedSessionQuery {
+	return &RevokedSessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRevokedSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RevokedSession entity by its id.
+func (c *RevokedSessionClient) Get(ctx context.Context, id string) (*RevokedSession, error) {
+	return c.Query().Where(revokedsession.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RevokedSessionClient) GetX(ctx context.Context, id string) *RevokedSession {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RevokedSessionClient) Hooks() []Hook {
+	return c.hooks.RevokedSession
+}
+
+// Interceptors returns the client interceptors.
+func (c *RevokedSessionClient) Interceptors() []Interceptor {
+	return c.inters.RevokedSession
+}
+
+func (c *RevokedSessionClient) mutate(ctx context.Context, m *RevokedSessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RevokedSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RevokedSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RevokedSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RevokedSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RevokedSession mutation op: %q", m.Op())
+	}
+}
+
+// RoleClient is a client for the Role schema.
+type RoleClient struct {
+	config
+}
+
+// NewRoleClient returns a client for the Role from the given config.
+func NewRoleClient(c config) *RoleClient {
+	return &RoleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `role.Hooks(f(g(h())))`.
+func (c *RoleClient) Use(hooks ...Hook) {
+	c.hooks.Role = append(c.hooks.Role, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `role.Intercept(f(g(h())))`.
+func (c *RoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Role = append(c.inters.Role, interceptors...)
+}
+
+// Create returns a builder for creating a Role entity.
+func (c *RoleClient) Create() *RoleCreate {
+	mutation := newRoleMutation(c.config, OpCreate)
+	return &RoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Role entities.
+func (c *RoleClient) CreateBulk(builders ...*RoleCreate) *RoleCreateBulk {
+	return &RoleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoleClient) MapCreateBulk(slice any, setFunc func(*RoleCreate, int)) *RoleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoleCreateBulk{err: fmt.Errorf("calling to RoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Role.
+func (c *RoleClient) Update() *RoleUpdate {
+	mutation := newRoleMutation(c.config, OpUpdate)
+	return &RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoleClient) UpdateOne(_m *Role) *RoleUpdateOne {
+	mutation := newRoleMutation(c.config, OpUpdateOne, withRole(_m))
+	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoleClient) UpdateOneID(id string) *RoleUpdateOne {
+	mutation := newRoleMutation(c.config, OpUpdateOne, withRoleID(id))
+	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Role.
+func (c *RoleClient) Delete() *RoleDelete {
+	mutation := newRoleMutation(c.config, OpDelete)
+	return &RoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoleClient) DeleteOne(_m *Role) *RoleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoleClient) DeleteOneID(id string) *RoleDeleteOne {
+	builder := c.Delete().Where(role.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleDeleteOne{builder}
+}
+
+// Query returns a query builder for Role.
+func (c *RoleClient) Query() *RoleQuery {
+	return &RoleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRole},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Role entity by its id.
+func (c *RoleClient) Get(ctx context.Context, id string) (*Role, error) {
+	return c.Query().Where(role.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleClient) GetX(ctx context.Context, id string) *Role {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRoleBindings queries the role_bindings edge of a Role.
+func (c *RoleClient) QueryRoleBindings(_m *Role) *RoleBindingQuery {
+	query := (&RoleBindingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(rolebinding.Table, rolebinding.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, role.RoleBindingsTable, role.RoleBindingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoleClient) Hooks() []Hook {
+	return c.hooks.Role
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoleClient) Interceptors() []Interceptor {
+	return c.inters.Role
+}
+
+func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Role mutation op: %q", m.Op())
+	}
+}
+
+// RoleBindingClient is a client for the RoleBinding schema.
+type RoleBindingClient struct {
+	config
+}
+
+// NewRoleBindingClient returns a client for the RoleBinding from the given config.
+func NewRoleBindingClient(c config) *RoleBindingClient {
+	return &RoleBindingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rolebinding.Hooks(f(g(h())))`.
+func (c *RoleBindingClient) Use(hooks ...Hook) {
+	c.hooks.RoleBinding = append(c.hooks.RoleBinding, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `rolebinding.Intercept(f(g(h())))`.
+func (c *RoleBindingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoleBinding = append(c.inters.RoleBinding, interceptors...)
+}
+
+// Create returns a builder for creating a RoleBinding entity.
+func (c *RoleBindingClient) Create() *RoleBindingCreate {
+	mutation := newRoleBindingMutation(c.config, OpCreate)
+	return &RoleBindingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RoleBinding entities.
+func (c *RoleBindingClient) CreateBulk(builders ...*RoleBindingCreate) *RoleBindingCreateBulk {
+	return &RoleBindingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoleBindingClient) MapCreateBulk(slice any, setFunc func(*RoleBindingCreate, int)) *RoleBindingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoleBindingCreateBulk{err: fmt.Errorf("calling to RoleBindingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoleBindingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoleBindingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RoleBinding.
+func (c *RoleBindingClient) Update() *RoleBindingUpdate {
+	mutation := newRoleBindingMutation(c.config, OpUpdate)
+	return &RoleBindingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoleBindingClient) UpdateOne(_m *RoleBinding) *RoleBindingUpdateOne {
+	mutation := newRoleBindingMutation(c.config, OpUpdateOne, withRoleBinding(_m))
+	return &RoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoleBindingClient) UpdateOneID(id string) *RoleBindingUpdateOne {
+	mutation := newRoleBindingMutation(c.config, OpUpdateOne, withRoleBindingID(id))
+	return &RoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RoleBinding.
+func (c *RoleBindingClient) Delete() *RoleBindingDelete {
+	mutation := newRoleBindingMutation(c.config, OpDelete)
+	return &RoleBindingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoleBindingClient) DeleteOne(_m *RoleBinding) *RoleBindingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoleBindingClient) DeleteOneID(id string) *RoleBindingDeleteOne {
+	builder := c.Delete().Where(rolebinding.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleBindingDeleteOne{builder}
+}
+
+// Query returns a query builder for RoleBinding.
+func (c *RoleBindingClient) Query() *RoleBindingQuery {
+	return &RoleBindingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoleBinding},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RoleBinding entity by its id.
+func (c *RoleBindingClient) Get(ctx context.Context, id string) (*RoleBinding, error) {
+	return c.Query().Where(rolebinding.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleBindingClient) GetX(ctx context.Context, id string) *RoleBinding {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a RoleBinding.
+func (c *RoleBindingClient) QueryUser(_m *RoleBinding) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rolebinding.Table, rolebinding.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rolebinding.UserTable, rolebinding.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRole queries the role edge of a RoleBinding.
+func (c *RoleBindingClient) QueryRole(_m *RoleBinding) *RoleQuery {
+	query := (&RoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rolebinding.Table, rolebinding.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rolebinding.RoleTable, rolebinding.RoleColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoleBindingClient) Hooks() []Hook {
+	return c.hooks.RoleBinding
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoleBindingClient) Interceptors() []Interceptor {
+	return c.inters.RoleBinding
+}
+
+func (c *RoleBindingClient) mutate(ctx context.Context, m *RoleBindingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoleBindingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoleBindingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoleBindingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RoleBinding mutation op: %q", m.Op())
+	}
+}
+
+// ScheduledBatchJobClient is a client for the ScheduledBatchJob schema.
+type ScheduledBatchJobClient struct {
+	config
+}
+
+// NewScheduledBatchJobClient returns a client for the ScheduledBatchJob from the given config.
+func NewScheduledBatchJobClient(c config) *ScheduledBatchJobClient {
+	return &ScheduledBatchJobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `scheduledbatchjob.Hooks(f(g(h())))`.
+func (c *ScheduledBatchJobClient) Use(hooks ...Hook) {
+	c.hooks.ScheduledBatchJob = append(c.hooks.ScheduledBatchJob, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `scheduledbatchjob.Intercept(f(g(h())))`.
+func (c *ScheduledBatchJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScheduledBatchJob = append(c.inters.ScheduledBatchJob, interceptors...)
+}
+
+// Create returns a builder for creating a ScheduledBatchJob entity.
+func (c *ScheduledBatchJobClient) Create() *ScheduledBatchJobCreate {
+	mutation := newScheduledBatchJobMutation(c.config, OpCreate)
+	return &ScheduledBatchJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ScheduledBatchJob entities.
+func (c *ScheduledBatchJobClient) CreateBulk(builders ...*ScheduledBatchJobCreate) *ScheduledBatchJobCreateBulk {
+	return &ScheduledBatchJobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScheduledBatchJobClient) MapCreateBulk(slice any, setFunc func(*ScheduledBatchJobCreate, int)) *ScheduledBatchJobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScheduledBatchJobCreateBulk{err: fmt.Errorf("calling to ScheduledBatchJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScheduledBatchJobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScheduledBatchJobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ScheduledBatchJob.
+func (c *ScheduledBatchJobClient) Update() *ScheduledBatchJobUpdate {
+	mutation := newScheduledBatchJobMutation(c.config, OpUpdate)
+	return &ScheduledBatchJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScheduledBatchJobClient) UpdateOne(_m *ScheduledBatchJob) *ScheduledBatchJobUpdateOne {
+	mutation := newScheduledBatchJobMutation(c.config, OpUpdateOne, withScheduledBatchJob(_m))
+	return &ScheduledBatchJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScheduledBatchJobClient) UpdateOneID(id string) *ScheduledBatchJobUpdateOne {
+	mutation := newScheduledBatchJobMutation(c.config, OpUpdateOne, withScheduledBatchJobID(id))
+	return &ScheduledBatchJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ScheduledBatchJob.
+func (c *ScheduledBatchJobClient) Delete() *ScheduledBatchJobDelete {
+	mutation := newScheduledBatchJobMutation(c.config, OpDelete)
+	return &ScheduledBatchJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScheduledBatchJobClient) DeleteOne(_m *ScheduledBatchJob) *ScheduledBatchJobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScheduledBatchJobClient) DeleteOneID(id string) *ScheduledBatchJobDeleteOne {
+	builder := c.Delete().Where(scheduledbatchjob.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScheduledBatchJobDeleteOne{builder}
+}
+
+// Query returns a query builder for ScheduledBatchJob.
+func (c *ScheduledBatchJobClient) Query() *ScheduledBatchJobQuery {
+	return &ScheduledBatchJobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScheduledBatchJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ScheduledBatchJob entity by its id.
+func (c *ScheduledBatchJobClient) Get(ctx context.Context, id string) (*ScheduledBatchJob, error) {
+	return c.Query().Where(scheduledbatchjob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScheduledBatchJobClient) GetX(ctx context.Context, id string) *ScheduledBatchJob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ScheduledBatchJobClient) Hooks() []Hook {
+	return c.hooks.ScheduledBatchJob
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScheduledBatchJobClient) Interceptors() []Interceptor {
+	return c.inters.ScheduledBatchJob
+}
+
+func (c *ScheduledBatchJobClient) mutate(ctx context.Context, m *ScheduledBatchJobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScheduledBatchJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScheduledBatchJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScheduledBatchJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScheduledBatchJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ScheduledBatchJob mutation op: %q", m.Op())
+	}
+}
+
+// ServiceClient is a client for the Service schema.
+type ServiceClient struct {
+	config
+}
+
+// NewServiceClient returns a client for the Service from the given config.
+func NewServiceClient(c config) *ServiceClient {
+	return &ServiceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `service.Hooks(f(g(h())))`.
+func (c *ServiceClient) Use(hooks ...Hook) {
+	c.hooks.Service = append(c.hooks.Service, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `service.Intercept(f(g(h())))`.
+func (c *ServiceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Service = append(c.inters.Service, interceptors...)
+}
+
+// Create returns a builder for creating a Service entity.
+func (c *ServiceClient) Create() *ServiceCreate {
+	mutation := newServiceMutation(c.config, OpCreate)
+	return &ServiceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Service entities.
+func (c *ServiceClient) CreateBulk(builders ...*ServiceCreate) *ServiceCreateBulk {
+	return &ServiceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ServiceClient) MapCreateBulk(slice any, setFunc func(*ServiceCreate, int)) *ServiceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ServiceCreateBulk{err: fmt.Errorf("calling to ServiceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ServiceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ServiceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Service.
+func (c *ServiceClient) Update() *ServiceUpdate {
+	mutation := newServiceMutation(c.config, OpUpdate)
+	return &ServiceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ServiceClient) UpdateOne(_m *Service) *ServiceUpdateOne {
+	mutation := newServiceMutation(c.config, OpUpdateOne, withService(_m))
+	return &ServiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ServiceClient) UpdateOneID(id string) *ServiceUpdateOne {
+	mutation := newServiceMutation(c.config, OpUpdateOne, withServiceID(id))
+	return &ServiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Service.
+func (c *ServiceClient) Delete() *ServiceDelete {
+	mutation := newServiceMutation(c.config, OpDelete)
+	return &ServiceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ServiceClient) DeleteOne(_m *Service) *ServiceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ServiceClient) DeleteOneID(id string) *ServiceDeleteOne {
+	builder := c.Delete().Where(service.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ServiceDeleteOne{builder}
+}
+
+// Query returns a query builder for Service.
+func (c *ServiceClient) Query() *ServiceQuery {
+	return &ServiceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeService},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Service entity by its id.
+func (c *ServiceClient) Get(ctx context.Context, id string) (*Service, error) {
+	return c.Query().Where(service.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ServiceClient) GetX(ctx context.Context, id string) *Service {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySystem queries the system edge of a Service.
+func (c *ServiceClient) QuerySystem(_m *Service) *SystemQuery {
+	query := (&SystemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(service.Table, service.FieldID, id),
+			sqlgraph.To(system.Table, system.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, service.SystemTable, service.SystemColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVms queries the vms edge of a Service.
+func (c *ServiceClient) QueryVms(_m *Service) *VMQuery {
+	query := (&VMClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(service.Table, service.FieldID, id),
+			sqlgraph.To(vm.Table, vm.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, service.VmsTable, service.VmsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ServiceClient) Hooks() []Hook {
+	return c.hooks.Service
+}
+
+// Interceptors returns the client interceptors.
+func (c *ServiceClient) Interceptors() []Interceptor {
+	return c.inters.Service
+}
+
+func (c *ServiceClient) mutate(ctx context.Context, m *ServiceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ServiceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ServiceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ServiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ServiceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Service mutation op: %q", m.Op())
+	}
+}
+
+// SystemClient is a client for the System schema.
+type SystemClient struct {
+	config
+}
+
+// NewSystemClient returns a client for the System from the given config.
+func NewSystemClient(c config) *SystemClient {
+	return &SystemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `system.Hooks(f(g(h())))`.
+func (c *SystemClient) Use(hooks ...Hook) {
+	c.hooks.System = append(c.hooks.System, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `system.Intercept(f(g(h())))`.
+func (c *SystemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.System = append(c.inters.System, interceptors...)
+}
+
+// Create returns a builder for creating a System entity.
+func (c *SystemClient) Create() *SystemCreate {
+	mutation := newSystemMutation(c.config, OpCreate)
+	return &SystemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of System entities.
+func (c *SystemClient) CreateBulk(builders ...*SystemCreate) *SystemCreateBulk {
+	return &SystemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SystemClient) MapCreateBulk(slice any, setFunc func(*SystemCreate, int)) *SystemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SystemCreateBulk{err: fmt.Errorf("calling to SystemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SystemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SystemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for System.
+func (c *SystemClient) Update() *SystemUpdate {
+	mutation := newSystemMutation(c.config, OpUpdate)
+	return &SystemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SystemClient) UpdateOne(_m *System) *SystemUpdateOne {
+	mutation := newSystemMutation(c.config, OpUpdateOne, withSystem(_m))
+	return &SystemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SystemClient) UpdateOneID(id string) *SystemUpdateOne {
+	mutation := newSystemMutation(c.config, OpUpdateOne, withSystemID(id))
+	return &SystemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for System.
+func (c *SystemClient) Delete() *SystemDelete {
+	mutation := newSystemMutation(c.config, OpDelete)
+	return &SystemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SystemClient) DeleteOne(_m *System) *SystemDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SystemClient) DeleteOneID(id string) *SystemDeleteOne {
+	builder := c.Delete().Where(system.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SystemDeleteOne{builder}
+}
+
+// Query returns a query builder for System.
+func (c *SystemClient) Query() *SystemQuery {
+	return &SystemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSystem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a System entity by its id.
+func (c *SystemClient) Get(ctx context.Context, id string) (*System, error) {
+	return c.Query().Where(system.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SystemClient) GetX(ctx context.Context, id string) *System {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryServices queries the services edge of a System.
+func (c *SystemClient) QueryServices(_m *System) *ServiceQuery {
+	query := (&ServiceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(system.Table, system.FieldID, id),
+			sqlgraph.To(service.Table, service.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, system.ServicesTable, system.ServicesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SystemClient) Hooks() []Hook {
+	return c.hooks.System
+}
+
+// Interceptors returns the client interceptors.
+func (c *SystemClient) Interceptors() []Interceptor {
+	return c.inters.System
+}
+
+func (c *SystemClient) mutate(ctx context.Context, m *SystemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SystemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SystemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SystemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SystemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown System mutation op: %q", m.Op())
+	}
+}
+
+// SystemSecretClient is a client for the SystemSecret schema.
+type SystemSecretClient struct {
+	config
+}
+
+// NewSystemSecretClient returns a client for the SystemSecret from the given config.
+func NewSystemSecretClient(c config) *SystemSecretClient {
+	return &SystemSecretClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `systemsecret.Hooks(f(g(h())))`.
+func (c *SystemSecretClient) Use(hooks ...Hook) {
+	c.hooks.SystemSecret = append(c.hooks.SystemSecret, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `systemsecret.Intercept(f(g(h())))`.
+func (c *SystemSecretClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SystemSecret = append(c.inters.SystemSecret, interceptors...)
+}
+
+// Create returns a builder for creating a SystemSecret entity.
+func (c *SystemSecretClient) Create() *SystemSecretCreate {
+	mutation := newSystemSecretMutation(c.config, OpCreate)
+	return &SystemSecretCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SystemSecret entities.
+func (c *SystemSecretClient) CreateBulk(builders ...*SystemSecretCreate) *SystemSecretCreateBulk {
+	return &SystemSecretCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SystemSecretClient) MapCreateBulk(slice any, setFunc func(*SystemSecretCreate, int)) *SystemSecretCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SystemSecretCreateBulk{err: fmt.Errorf("calling to SystemSecretClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SystemSecretCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SystemSecretCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SystemSecret.
+func (c *SystemSecretClient) Update() *SystemSecretUpdate {
+	mutation := newSystemSecretMutation(c.config, OpUpdate)
+	return &SystemSecretUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SystemSecretClient) UpdateOne(_m *SystemSecret) *SystemSecretUpdateOne {
+	mutation := newSystemSecretMutation(c.config, OpUpdateOne, withSystemSecret(_m))
+	return &SystemSecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SystemSecretClient) UpdateOneID(id string) *SystemSecretUpdateOne {
+	mutation := newSystemSecretMutation(c.config, OpUpdateOne, withSystemSecretID(id))
+	return &SystemSecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SystemSecret.
+func (c *SystemSecretClient) Delete() *SystemSecretDelete {
+	mutation := newSystemSecretMutation(c.config, OpDelete)
+	return &SystemSecretDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SystemSecretClient) DeleteOne(_m *SystemSecret) *SystemSecretDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SystemSecretClient) DeleteOneID(id string) *SystemSecretDeleteOne {
+	builder := c.Delete().Where(systemsecret.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SystemSecretDeleteOne{builder}
+}
+
+// Query returns a query builder for SystemSecret.
+func (c *SystemSecretClient) Query() *SystemSecretQuery {
+	return &SystemSecretQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSystemSecret},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SystemSecret entity by its id.
+func (c *SystemSecretClient) Get(ctx context.Context, id string) (*SystemSecret, error) {
+	return c.Query().Where(systemsecret.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SystemSecretClient) GetX(ctx context.Context, id string) *SystemSecret {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SystemSecretClient) Hooks() []Hook {
+	return c.hooks.SystemSecret
+}
+
+// Interceptors returns the client interceptors.
+func (c *SystemSecretClient) Interceptors() []Interceptor {
+	return c.inters.SystemSecret
+}
+
+func (c *SystemSecretClient) mutate(ctx context.Context, m *SystemSecretMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SystemSecretCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SystemSecretUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SystemSecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SystemSecretDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SystemSecret mutation op: %q", m.Op())
+	}
+}
+
+// TemplateClient is a client for the Template schema.
+type TemplateClient struct {
+	config
+}
+
+// NewTemplateClient returns a client for the Template from the given config.
+func NewTemplateClient(c config) *TemplateClient {
+	return &TemplateClient{config: c}
+}
 
 // Use adds a list of mutation hooks to the hooks stack.
 // A call to `Use(f, g, h)` equals to `template.Hooks(f(g(h())))`.
@@ -3561,6 +5305,38 @@ func (c *TemplateClient) GetX(ctx context.Context, id string) *Template {
 	return obj
 }
 
+// QueryParent queries the parent edge of a Template.
+func (c *TemplateClient) QueryParent(_m *Template) *TemplateQuery {
+	query := (&TemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(template.Table, template.FieldID, id),
+			sqlgraph.To(template.Table, template.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, template.ParentTable, template.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Template.
+func (c *TemplateClient) QueryChildren(_m *Template) *TemplateQuery {
+	query := (&TemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(template.Table, template.FieldID, id),
+			sqlgraph.To(template.Table, template.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, template.ChildrenTable, template.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *TemplateClient) Hooks() []Hook {
 	return c.hooks.Template
@@ -3571,18 +5347,151 @@ func (c *TemplateClient) Interceptors() []Interceptor {
 	return c.inters.Template
 }
 
-func (c *TemplateClient) mutate(ctx context.Context, m *TemplateMutation) (Value, error) {
+func (c *TemplateClient) mutate(ctx context.Context, m *TemplateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Template mutation op: %q", m.Op())
+	}
+}
+
+// TicketCommentClient is a client for the TicketComment schema.
+type TicketCommentClient struct {
+	config
+}
+
+// NewTicketCommentClient returns a client for the TicketComment from the given config.
+func NewTicketCommentClient(c config) *TicketCommentClient {
+	return &TicketCommentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ticketcomment.Hooks(f(g(h())))`.
+func (c *TicketCommentClient) Use(hooks ...Hook) {
+	c.hooks.TicketComment = append(c.hooks.TicketComment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ticketcomment.Intercept(f(g(h())))`.
+func (c *TicketCommentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TicketComment = append(c.inters.TicketComment, interceptors...)
+}
+
+// Create returns a builder for creating a TicketComment entity.
+func (c *TicketCommentClient) Create() *TicketCommentCreate {
+	mutation := newTicketCommentMutation(c.config, OpCreate)
+	return &TicketCommentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TicketComment entities.
+func (c *TicketCommentClient) CreateBulk(builders ...*TicketCommentCreate) *TicketCommentCreateBulk {
+	return &TicketCommentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TicketCommentClient) MapCreateBulk(slice any, setFunc func(*TicketCommentCreate, int)) *TicketCommentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TicketCommentCreateBulk{err: fmt.Errorf("calling to TicketCommentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TicketCommentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TicketCommentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TicketComment.
+func (c *TicketCommentClient) Update() *TicketCommentUpdate {
+	mutation := newTicketCommentMutation(c.config, OpUpdate)
+	return &TicketCommentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TicketCommentClient) UpdateOne(_m *TicketComment) *TicketCommentUpdateOne {
+	mutation := newTicketCommentMutation(c.config, OpUpdateOne, withTicketComment(_m))
+	return &TicketCommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TicketCommentClient) UpdateOneID(id string) *TicketCommentUpdateOne {
+	mutation := newTicketCommentMutation(c.config, OpUpdateOne, withTicketCommentID(id))
+	return &TicketCommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TicketComment.
+func (c *TicketCommentClient) Delete() *TicketCommentDelete {
+	mutation := newTicketCommentMutation(c.config, OpDelete)
+	return &TicketCommentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TicketCommentClient) DeleteOne(_m *TicketComment) *TicketCommentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TicketCommentClient) DeleteOneID(id string) *TicketCommentDeleteOne {
+	builder := c.Delete().Where(ticketcomment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TicketCommentDeleteOne{builder}
+}
+
+// Query returns a query builder for TicketComment.
+func (c *TicketCommentClient) Query() *TicketCommentQuery {
+	return &TicketCommentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTicketComment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TicketComment entity by its id.
+func (c *TicketCommentClient) Get(ctx context.Context, id string) (*TicketComment, error) {
+	return c.Query().Where(ticketcomment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TicketCommentClient) GetX(ctx context.Context, id string) *TicketComment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TicketCommentClient) Hooks() []Hook {
+	return c.hooks.TicketComment
+}
+
+// Interceptors returns the client interceptors.
+func (c *TicketCommentClient) Interceptors() []Interceptor {
+	return c.inters.TicketComment
+}
+
+func (c *TicketCommentClient) mutate(ctx context.Context, m *TicketCommentMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TicketCommentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TicketCommentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TicketCommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TicketCommentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Template mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown TicketComment mutation op: %q", m.Op())
 	}
 }
 
@@ -3727,131 +5636,429 @@ func (c *UserClient) QueryNotifications(_m *User) *NotificationQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *UserClient) Hooks() []Hook {
-	return c.hooks.User
+func (c *UserClient) Hooks() []Hook {
+	return c.hooks.User
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
+
+func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+	}
+}
+
+// VMClient is a client for the VM schema.
+type VMClient struct {
+	config
+}
+
+// NewVMClient returns a client for the VM from the given config.
+func NewVMClient(c config) *VMClient {
+	return &VMClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vm.Hooks(f(g(h())))`.
+func (c *VMClient) Use(hooks ...Hook) {
+	c.hooks.VM = append(c.hooks.VM, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vm.Intercept(f(g(h())))`.
+func (c *VMClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VM = append(c.inters.VM, interceptors...)
+}
+
+// Create returns a builder for creating a VM entity.
+func (c *VMClient) Create() *VMCreate {
+	mutation := newVMMutation(c.config, OpCreate)
+	return &VMCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VM entities.
+func (c *VMClient) CreateBulk(builders ...*VMCreate) *VMCreateBulk {
+	return &VMCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VMClient) MapCreateBulk(slice any, setFunc func(*VMCreate, int)) *VMCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VMCreateBulk{err: fmt.Errorf("calling to VMClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VMCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VMCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VM.
+func (c *VMClient) Update() *VMUpdate {
+	mutation := newVMMutation(c.config, OpUpdate)
+	return &VMUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VMClient) UpdateOne(_m *VM) *VMUpdateOne {
+	mutation := newVMMutation(c.config, OpUpdateOne, withVM(_m))
+	return &VMUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VMClient) UpdateOneID(id string) *VMUpdateOne {
+	mutation := newVMMutation(c.config, OpUpdateOne, withVMID(id))
+	return &VMUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VM.
+func (c *VMClient) Delete() *VMDelete {
+	mutation := newVMMutation(c.config, OpDelete)
+	return &VMDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VMClient) DeleteOne(_m *VM) *VMDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VMClient) DeleteOneID(id string) *VMDeleteOne {
+	builder := c.Delete().Where(vm.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VMDeleteOne{builder}
+}
+
+// Query returns a query builder for VM.
+func (c *VMClient) Query() *VMQuery {
+	return &VMQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVM},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VM entity by its id.
+func (c *VMClient) Get(ctx context.Context, id string) (*VM, error) {
+	return c.Query().Where(vm.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VMClient) GetX(ctx context.Context, id string) *VM {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryService queries the service edge of a VM.
+func (c *VMClient) QueryService(_m *VM) *ServiceQuery {
+	query := (&ServiceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vm.Table, vm.FieldID, id),
+			sqlgraph.To(service.Table, service.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, vm.ServiceTable, vm.ServiceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRevisions queries the revisions edge of a VM.
+func (c *VMClient) QueryRevisions(_m *VM) *VMRevisionQuery {
+	query := (&VMRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vm.Table, vm.FieldID, id),
+			sqlgraph.To(vmrevision.Table, vmrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vm.RevisionsTable, vm.RevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *VMClient) Hooks() []Hook {
+	return c.hooks.VM
+}
+
+// Interceptors returns the client interceptors.
+func (c *VMClient) Interceptors() []Interceptor {
+	return c.inters.VM
+}
+
+func (c *VMClient) mutate(ctx context.Context, m *VMMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VMCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VMUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VMUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VMDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown VM mutation op: %q", m.Op())
+	}
+}
+
+// VMConsoleSessionClient is a client for the VMConsoleSession schema.
+type VMConsoleSessionClient struct {
+	config
+}
+
+// NewVMConsoleSessionClient returns a client for the VMConsoleSession from the given config.
+func NewVMConsoleSessionClient(c config) *VMConsoleSessionClient {
+	return &VMConsoleSessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vmconsolesession.Hooks(f(g(h())))`.
+func (c *VMConsoleSessionClient) Use(hooks ...Hook) {
+	c.hooks.VMConsoleSession = append(c.hooks.VMConsoleSession, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vmconsolesession.Intercept(f(g(h())))`.
+func (c *VMConsoleSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VMConsoleSession = append(c.inters.VMConsoleSession, interceptors...)
+}
+
+// Create returns a builder for creating a VMConsoleSession entity.
+func (c *VMConsoleSessionClient) Create() *VMConsoleSessionCreate {
+	mutation := newVMConsoleSessionMutation(c.config, OpCreate)
+	return &VMConsoleSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VMConsoleSession entities.
+func (c *VMConsoleSessionClient) CreateBulk(builders ...*VMConsoleSessionCreate) *VMConsoleSessionCreateBulk {
+	return &VMConsoleSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VMConsoleSessionClient) MapCreateBulk(slice any, setFunc func(*VMConsoleSessionCreate, int)) *VMConsoleSessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VMConsoleSessionCreateBulk{err: fmt.Errorf("calling to VMConsoleSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VMConsoleSessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VMConsoleSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VMConsoleSession.
+func (c *VMConsoleSessionClient) Update() *VMConsoleSessionUpdate {
+	mutation := newVMConsoleSessionMutation(c.config, OpUpdate)
+	return &VMConsoleSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VMConsoleSessionClient) UpdateOne(_m *VMConsoleSession) *VMConsoleSessionUpdateOne {
+	mutation := newVMConsoleSessionMutation(c.config, OpUpdateOne, withVMConsoleSession(_m))
+	return &VMConsoleSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VMConsoleSessionClient) UpdateOneID(id string) *VMConsoleSessionUpdateOne {
+	mutation := newVMConsoleSessionMutation(c.config, OpUpdateOne, withVMConsoleSessionID(id))
+	return &VMConsoleSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VMConsoleSession.
+func (c *VMConsoleSessionClient) Delete() *VMConsoleSessionDelete {
+	mutation := newVMConsoleSessionMutation(c.config, OpDelete)
+	return &VMConsoleSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VMConsoleSessionClient) DeleteOne(_m *VMConsoleSession) *VMConsoleSessionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VMConsoleSessionClient) DeleteOneID(id string) *VMConsoleSessionDeleteOne {
+	builder := c.Delete().Where(vmconsolesession.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VMConsoleSessionDeleteOne{builder}
+}
+
+// Query returns a query builder for VMConsoleSession.
+func (c *VMConsoleSessionClient) Query() *VMConsoleSessionQuery {
+	return &VMConsoleSessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVMConsoleSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VMConsoleSession entity by its id.
+func (c *VMConsoleSessionClient) Get(ctx context.Context, id string) (*VMConsoleSession, error) {
+	return c.Query().Where(vmconsolesession.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VMConsoleSessionClient) GetX(ctx context.Context, id string) *VMConsoleSession {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *VMConsoleSessionClient) Hooks() []Hook {
+	return c.hooks.VMConsoleSession
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserClient) Interceptors() []Interceptor {
-	return c.inters.User
+func (c *VMConsoleSessionClient) Interceptors() []Interceptor {
+	return c.inters.VMConsoleSession
 }
 
-func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+func (c *VMConsoleSessionClient) mutate(ctx context.Context, m *VMConsoleSessionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VMConsoleSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VMConsoleSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VMConsoleSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&VMConsoleSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown VMConsoleSession mutation op: %q", m.Op())
 	}
 }
 
-// VMClient is a client for the VM schema.
-type VMClient struct {
+// VMRequestIdempotencyClient is a client for the VMRequestIdempotency schema.
+type VMRequestIdempotencyClient struct {
 	config
 }
 
-// NewVMClient returns a client for the VM from the given config.
-func NewVMClient(c config) *VMClient {
-	return &VMClient{config: c}
+// NewVMRequestIdempotencyClient returns a client for the VMRequestIdempotency from the given config.
+func NewVMRequestIdempotencyClient(c config) *VMRequestIdempotencyClient {
+	return &VMRequestIdempotencyClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `vm.Hooks(f(g(h())))`.
-func (c *VMClient) Use(hooks ...Hook) {
-	c.hooks.VM = append(c.hooks.VM, hooks...)
+// A call to `Use(f, g, h)` equals to `vmrequestidempotency.Hooks(f(g(h())))`.
+func (c *VMRequestIdempotencyClient) Use(hooks ...Hook) {
+	c.hooks.VMRequestIdempotency = append(c.hooks.VMRequestIdempotency, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `vm.Intercept(f(g(h())))`.
-func (c *VMClient) Intercept(interceptors ...Interceptor) {
-	c.inters.VM = append(c.inters.VM, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `vmrequestidempotency.Intercept(f(g(h())))`.
+func (c *VMRequestIdempotencyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VMRequestIdempotency = append(c.inters.VMRequestIdempotency, interceptors...)
 }
 
-// Create returns a builder for creating a VM entity.
-func (c *VMClient) Create() *VMCreate {
-	mutation := newVMMutation(c.config, OpCreate)
-	return &VMCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a VMRequestIdempotency entity.
+func (c *VMRequestIdempotencyClient) Create() *VMRequestIdempotencyCreate {
+	mutation := newVMRequestIdempotencyMutation(c.config, OpCreate)
+	return &VMRequestIdempotencyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of VM entities.
-func (c *VMClient) CreateBulk(builders ...*VMCreate) *VMCreateBulk {
-	return &VMCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of VMRequestIdempotency entities.
+func (c *VMRequestIdempotencyClient) CreateBulk(builders ...*VMRequestIdempotencyCreate) *VMRequestIdempotencyCreateBulk {
+	return &VMRequestIdempotencyCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *VMClient) MapCreateBulk(slice any, setFunc func(*VMCreate, int)) *VMCreateBulk {
+func (c *VMRequestIdempotencyClient) MapCreateBulk(slice any, setFunc func(*VMRequestIdempotencyCreate, int)) *VMRequestIdempotencyCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &VMCreateBulk{err: fmt.Errorf("calling to VMClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &VMRequestIdempotencyCreateBulk{err: fmt.Errorf("calling to VMRequestIdempotencyClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*VMCreate, rv.Len())
+	builders := make([]*VMRequestIdempotencyCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &VMCreateBulk{config: c.config, builders: builders}
+	return &VMRequestIdempotencyCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for VM.
-func (c *VMClient) Update() *VMUpdate {
-	mutation := newVMMutation(c.config, OpUpdate)
-	return &VMUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for VMRequestIdempotency.
+func (c *VMRequestIdempotencyClient) Update() *VMRequestIdempotencyUpdate {
+	mutation := newVMRequestIdempotencyMutation(c.config, OpUpdate)
+	return &VMRequestIdempotencyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *VMClient) UpdateOne(_m *VM) *VMUpdateOne {
-	mutation := newVMMutation(c.config, OpUpdateOne, withVM(_m))
-	return &VMUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *VMRequestIdempotencyClient) UpdateOne(_m *VMRequestIdempotency) *VMRequestIdempotencyUpdateOne {
+	mutation := newVMRequestIdempotencyMutation(c.config, OpUpdateOne, withVMRequestIdempotency(_m))
+	return &VMRequestIdempotencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *VMClient) UpdateOneID(id string) *VMUpdateOne {
-	mutation := newVMMutation(c.config, OpUpdateOne, withVMID(id))
-	return &VMUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *VMRequestIdempotencyClient) UpdateOneID(id string) *VMRequestIdempotencyUpdateOne {
+	mutation := newVMRequestIdempotencyMutation(c.config, OpUpdateOne, withVMRequestIdempotencyID(id))
+	return &VMRequestIdempotencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for VM.
-func (c *VMClient) Delete() *VMDelete {
-	mutation := newVMMutation(c.config, OpDelete)
-	return &VMDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for VMRequestIdempotency.
+func (c *VMRequestIdempotencyClient) Delete() *VMRequestIdempotencyDelete {
+	mutation := newVMRequestIdempotencyMutation(c.config, OpDelete)
+	return &VMRequestIdempotencyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *VMClient) DeleteOne(_m *VM) *VMDeleteOne {
+func (c *VMRequestIdempotencyClient) DeleteOne(_m *VMRequestIdempotency) *VMRequestIdempotencyDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *VMClient) DeleteOneID(id string) *VMDeleteOne {
-	builder := c.Delete().Where(vm.ID(id))
+func (c *VMRequestIdempotencyClient) DeleteOneID(id string) *VMRequestIdempotencyDeleteOne {
+	builder := c.Delete().Where(vmrequestidempotency.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &VMDeleteOne{builder}
+	return &VMRequestIdempotencyDeleteOne{builder}
 }
 
-// Query returns a query builder for VM.
-func (c *VMClient) Query() *VMQuery {
-	return &VMQuery{
+// Query returns a query builder for VMRequestIdempotency.
+func (c *VMRequestIdempotencyClient) Query() *VMRequestIdempotencyQuery {
+	return &VMRequestIdempotencyQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeVM},
+		ctx:    &QueryContext{Type: TypeVMRequestIdempotency},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a VM entity by its id.
-func (c *VMClient) Get(ctx context.Context, id string) (*VM, error) {
-	return c.Query().Where(vm.ID(id)).Only(ctx)
+// Get returns a VMRequestIdempotency entity by its id.
+func (c *VMRequestIdempotencyClient) Get(ctx context.Context, id string) (*VMRequestIdempotency, error) {
+	return c.Query().Where(vmrequestidempotency.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *VMClient) GetX(ctx context.Context, id string) *VM {
+func (c *VMRequestIdempotencyClient) GetX(ctx context.Context, id string) *VMRequestIdempotency {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3859,60 +6066,28 @@ func (c *VMClient) GetX(ctx context.Context, id string) *VM {
 	return obj
 }
 
-// QueryService queries the service edge of a VM.
-func (c *VMClient) QueryService(_m *VM) *ServiceQuery {
-	query := (&ServiceClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(vm.Table, vm.FieldID, id),
-			sqlgraph.To(service.Table, service.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, vm.ServiceTable, vm.ServiceColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRevisions queries the revisions edge of a VM.
-func (c *VMClient) QueryRevisions(_m *VM) *VMRevisionQuery {
-	query := (&VMRevisionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(vm.Table, vm.FieldID, id),
-			sqlgraph.To(vmrevision.Table, vmrevision.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, vm.RevisionsTable, vm.RevisionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *VMClient) Hooks() []Hook {
-	return c.hooks.VM
+func (c *VMRequestIdempotencyClient) Hooks() []Hook {
+	return c.hooks.VMRequestIdempotency
 }
 
 // Interceptors returns the client interceptors.
-func (c *VMClient) Interceptors() []Interceptor {
-	return c.inters.VM
+func (c *VMRequestIdempotencyClient) Interceptors() []Interceptor {
+	return c.inters.VMRequestIdempotency
 }
 
-func (c *VMClient) mutate(ctx context.Context, m *VMMutation) (Value, error) {
+func (c *VMRequestIdempotencyClient) mutate(ctx context.Context, m *VMRequestIdempotencyMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&VMCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VMRequestIdempotencyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&VMUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VMRequestIdempotencyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&VMUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VMRequestIdempotencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&VMDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&VMRequestIdempotencyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown VM mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown VMRequestIdempotency mutation op: %q", m.Op())
 	}
 }
 
@@ -4065,22 +6240,320 @@ func (c *VMRevisionClient) mutate(ctx context.Context, m *VMRevisionMutation) (V
 	}
 }
 
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(_m *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(_m))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id string) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(_m *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliveryClient) DeleteOneID(id string) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id string) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id string) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
+}
+
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookDelivery mutation op: %q", m.Op())
+	}
+}
+
+// WebhookEndpointClient is a client for the WebhookEndpoint schema.
+type WebhookEndpointClient struct {
+	config
+}
+
+// NewWebhookEndpointClient returns a client for the WebhookEndpoint from the given config.
+func NewWebhookEndpointClient(c config) *WebhookEndpointClient {
+	return &WebhookEndpointClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookendpoint.Hooks(f(g(h())))`.
+func (c *WebhookEndpointClient) Use(hooks ...Hook) {
+	c.hooks.WebhookEndpoint = append(c.hooks.WebhookEndpoint, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookendpoint.Intercept(f(g(h())))`.
+func (c *WebhookEndpointClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookEndpoint = append(c.inters.WebhookEndpoint, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookEndpoint entity.
+func (c *WebhookEndpointClient) Create() *WebhookEndpointCreate {
+	mutation := newWebhookEndpointMutation(c.config, OpCreate)
+	return &WebhookEndpointCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookEndpoint entities.
+func (c *WebhookEndpointClient) CreateBulk(builders ...*WebhookEndpointCreate) *WebhookEndpointCreateBulk {
+	return &WebhookEndpointCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookEndpointClient) MapCreateBulk(slice any, setFunc func(*WebhookEndpointCreate, int)) *WebhookEndpointCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookEndpointCreateBulk{err: fmt.Errorf("calling to WebhookEndpointClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookEndpointCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookEndpointCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookEndpoint.
+func (c *WebhookEndpointClient) Update() *WebhookEndpointUpdate {
+	mutation := newWebhookEndpointMutation(c.config, OpUpdate)
+	return &WebhookEndpointUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookEndpointClient) UpdateOne(_m *WebhookEndpoint) *WebhookEndpointUpdateOne {
+	mutation := newWebhookEndpointMutation(c.config, OpUpdateOne, withWebhookEndpoint(_m))
+	return &WebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookEndpointClient) UpdateOneID(id string) *WebhookEndpointUpdateOne {
+	mutation := newWebhookEndpointMutation(c.config, OpUpdateOne, withWebhookEndpointID(id))
+	return &WebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookEndpoint.
+func (c *WebhookEndpointClient) Delete() *WebhookEndpointDelete {
+	mutation := newWebhookEndpointMutation(c.config, OpDelete)
+	return &WebhookEndpointDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookEndpointClient) DeleteOne(_m *WebhookEndpoint) *WebhookEndpointDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookEndpointClient) DeleteOneID(id string) *WebhookEndpointDeleteOne {
+	builder := c.Delete().Where(webhookendpoint.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookEndpointDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookEndpoint.
+func (c *WebhookEndpointClient) Query() *WebhookEndpointQuery {
+	return &WebhookEndpointQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookEndpoint},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookEndpoint entity by its id.
+func (c *WebhookEndpointClient) Get(ctx context.Context, id string) (*WebhookEndpoint, error) {
+	return c.Query().Where(webhookendpoint.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookEndpointClient) GetX(ctx context.Context, id string) *WebhookEndpoint {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookEndpointClient) Hooks() []Hook {
+	return c.hooks.WebhookEndpoint
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookEndpointClient) Interceptors() []Interceptor {
+	return c.inters.WebhookEndpoint
+}
+
+func (c *WebhookEndpointClient) mutate(ctx context.Context, m *WebhookEndpointMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookEndpointCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookEndpointUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookEndpointDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookEndpoint mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		ApprovalPolicy, ApprovalTicket, AuditLog, AuthProvider, BatchApprovalTicket,
-		Cluster, DomainEvent, ExternalApprovalSystem, IdPGroupMapping, IdPSyncedGroup,
-		InstanceSize, NamespaceRegistry, Notification, PendingAdoption,
-		RateLimitExemption, RateLimitUserOverride, ResourceRoleBinding, Role,
-		RoleBinding, Service, System, SystemSecret, Template, User, VM,
-		VMRevision []ent.Hook
+		ApprovalDecision, ApprovalPolicy, ApprovalTicket, AuditLog, AuthProvider,
+		BatchApprovalTicket, Cluster, DomainEvent, ExternalApprovalSystem,
+		IdPGroupMapping, IdPSyncedGroup, InstanceSize, InstanceSizeCluster,
+		LoginSession, LoginThrottle, NamespaceQuota, NamespaceRegistry, Notification,
+		NotificationPreference, PasswordHistory, PendingAdoption, PlatformConfig,
+		Quota, RateLimitExemption, RateLimitUserOverride, RefreshToken,
+		ResourceRoleBinding, RevokedSession, Role, RoleBinding, ScheduledBatchJob,
+		Service, System, SystemSecret, Template, TicketComment, User, VM,
+		VMConsoleSession, VMRequestIdempotency, VMRevision, WebhookDelivery,
+		WebhookEndpoint []ent.Hook
 	}
 	inters struct {
-		ApprovalPolicy, ApprovalTicket, AuditLog, AuthProvider, BatchApprovalTicket,
-		Cluster, DomainEvent, ExternalApprovalSystem, IdPGroupMapping, IdPSyncedGroup,
-		InstanceSize, NamespaceRegistry, Notification, PendingAdoption,
-		RateLimitExemption, RateLimitUserOverride, ResourceRoleBinding, Role,
-		RoleBinding, Service, System, SystemSecret, Template, User, VM,
-		VMRevision []ent.Interceptor
+		ApprovalDecision, ApprovalPolicy, ApprovalTicket, AuditLog, AuthProvider,
+		BatchApprovalTicket, Cluster, DomainEvent, ExternalApprovalSystem,
+		IdPGroupMapping, IdPSyncedGroup, InstanceSize, InstanceSizeCluster,
+		LoginSession, LoginThrottle, NamespaceQuota, NamespaceRegistry, Notification,
+		NotificationPreference, PasswordHistory, PendingAdoption, PlatformConfig,
+		Quota, RateLimitExemption, RateLimitUserOverride, RefreshToken,
+		ResourceRoleBinding, RevokedSession, Role, RoleBinding, ScheduledBatchJob,
+		Service, System, SystemSecret, Template, TicketComment, User, VM,
+		VMConsoleSession, VMRequestIdempotency, VMRevision, WebhookDelivery,
+		WebhookEndpoint []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -49,8 +49,22 @@ type Cluster struct {
 	// Last StorageClass detection timestamp
 	StorageClassesUpdatedAt *time.Time `json:"storage_classes_updated_at,omitempty"`
 	// Enabled holds the value of the "enabled" field.
-	Enabled      bool `json:"enabled,omitempty"`
-	selectValues sql.SelectValues
+	Enabled bool `json:"enabled,omitempty"`
+	// Schedulable vCPU capacity; 0 disables the CPU capacity check
+	TotalCPUCores int `json:"total_cpu_cores,omitempty"`
+	// Schedulable memory capacity in MB; 0 disables the memory capacity check
+	TotalMemoryMB int `json:"total_memory_mb,omitempty"`
+	// Allocatable vCPU = total_cpu_cores * ratio
+	CPUOvercommitRatio float64 `json:"cpu_overcommit_ratio,omitempty"`
+	// Allocatable memory = total_memory_mb * ratio
+	MemoryOvercommitRatio float64 `json:"memory_overcommit_ratio,omitempty"`
+	// Consecutive failed health probes; reset on success
+	HealthCheckFailures int `json:"health_check_failures,omitempty"`
+	// Timestamp of the most recent health probe
+	LastHealthCheckAt *time.Time `json:"last_health_check_at,omitempty"`
+	// Failure reason from the most recent health probe; empty when healthy
+	HealthMessage string `json:"health_message,omitempty"`
+	selectValues  sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -62,9 +76,13 @@ func (*Cluster) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case cluster.FieldEnabled:
 			values[i] = new(sql.NullBool)
-		case cluster.FieldID, cluster.FieldName, cluster.FieldDisplayName, cluster.FieldAPIServerURL, cluster.FieldEncryptionKeyID, cluster.FieldStatus, cluster.FieldKubevirtVersion, cluster.FieldCreatedBy, cluster.FieldEnvironment, cluster.FieldDefaultStorageClass:
+		case cluster.FieldCPUOvercommitRatio, cluster.FieldMemoryOvercommitRatio:
+			values[i] = new(sql.NullFloat64)
+		case cluster.FieldTotalCPUCores, cluster.FieldTotalMemoryMB, cluster.FieldHealthCheckFailures:
+			values[i] = new(sql.NullInt64)
+		case cluster.FieldID, cluster.FieldName, cluster.FieldDisplayName, cluster.FieldAPIServerURL, cluster.FieldEncryptionKeyID, cluster.FieldStatus, cluster.FieldKubevirtVersion, cluster.FieldCreatedBy, cluster.FieldEnvironment, cluster.FieldDefaultStorageClass, cluster.FieldHealthMessage:
 			values[i] = new(sql.NullString)
-		case cluster.FieldCreatedAt, cluster.FieldUpdatedAt, cluster.FieldStorageClassesUpdatedAt:
+		case cluster.FieldCreatedAt, cluster.FieldUpdatedAt, cluster.FieldStorageClassesUpdatedAt, cluster.FieldLastHealthCheckAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -188,6 +206,49 @@ func (_m *Cluster) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Enabled = value.Bool
 			}
+		case cluster.FieldTotalCPUCores:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_cpu_cores", values[i])
+			} else if value.Valid {
+				_m.TotalCPUCores = int(value.Int64)
+			}
+		case cluster.FieldTotalMemoryMB:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_memory_mb", values[i])
+			} else if value.Valid {
+				_m.TotalMemoryMB = int(value.Int64)
+			}
+		case cluster.FieldCPUOvercommitRatio:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cpu_overcommit_ratio", values[i])
+			} else if value.Valid {
+				_m.CPUOvercommitRatio = value.Float64
+			}
+		case cluster.FieldMemoryOvercommitRatio:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field memory_overcommit_ratio", values[i])
+			} else if value.Valid {
+				_m.MemoryOvercommitRatio = value.Float64
+			}
+		case cluster.FieldHealthCheckFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field health_check_failures", values[i])
+			} else if value.Valid {
+				_m.HealthCheckFailures = int(value.Int64)
+			}
+		case cluster.FieldLastHealthCheckAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_health_check_at", values[i])
+			} else if value.Valid {
+				_m.LastHealthCheckAt = new(time.Time)
+				*_m.LastHealthCheckAt = value.Time
+			}
+		case cluster.FieldHealthMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field health_message", values[i])
+			} else if value.Valid {
+				_m.HealthMessage = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -272,6 +333,29 @@ func (_m *Cluster) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("enabled=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("total_cpu_cores=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalCPUCores))
+	builder.WriteString(", ")
+	builder.WriteString("total_memory_mb=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalMemoryMB))
+	builder.WriteString(", ")
+	builder.WriteString("cpu_overcommit_ratio=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CPUOvercommitRatio))
+	builder.WriteString(", ")
+	builder.WriteString("memory_overcommit_ratio=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MemoryOvercommitRatio))
+	builder.WriteString(", ")
+	builder.WriteString("health_check_failures=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HealthCheckFailures))
+	builder.WriteString(", ")
+	if v := _m.LastHealthCheckAt; v != nil {
+		builder.WriteString("last_health_check_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("health_message=")
+	builder.WriteString(_m.HealthMessage)
 	builder.WriteByte(')')
 	return builder.String()
 }
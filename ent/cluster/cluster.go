@@ -46,6 +46,20 @@ const (
 	FieldStorageClassesUpdatedAt = "storage_classes_updated_at"
 	// FieldEnabled holds the string denoting the enabled field in the database.
 	FieldEnabled = "enabled"
+	// FieldTotalCPUCores holds the string denoting the total_cpu_cores field in the database.
+	FieldTotalCPUCores = "total_cpu_cores"
+	// FieldTotalMemoryMB holds the string denoting the total_memory_mb field in the database.
+	FieldTotalMemoryMB = "total_memory_mb"
+	// FieldCPUOvercommitRatio holds the string denoting the cpu_overcommit_ratio field in the database.
+	FieldCPUOvercommitRatio = "cpu_overcommit_ratio"
+	// FieldMemoryOvercommitRatio holds the string denoting the memory_overcommit_ratio field in the database.
+	FieldMemoryOvercommitRatio = "memory_overcommit_ratio"
+	// FieldHealthCheckFailures holds the string denoting the health_check_failures field in the database.
+	FieldHealthCheckFailures = "health_check_failures"
+	// FieldLastHealthCheckAt holds the string denoting the last_health_check_at field in the database.
+	FieldLastHealthCheckAt = "last_health_check_at"
+	// FieldHealthMessage holds the string denoting the health_message field in the database.
+	FieldHealthMessage = "health_message"
 	// Table holds the table name of the cluster in the database.
 	Table = "clusters"
 )
@@ -69,6 +83,13 @@ var Columns = []string{
 	FieldDefaultStorageClass,
 	FieldStorageClassesUpdatedAt,
 	FieldEnabled,
+	FieldTotalCPUCores,
+	FieldTotalMemoryMB,
+	FieldCPUOvercommitRatio,
+	FieldMemoryOvercommitRatio,
+	FieldHealthCheckFailures,
+	FieldLastHealthCheckAt,
+	FieldHealthMessage,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -96,6 +117,26 @@ var (
 	CreatedByValidator func(string) error
 	// DefaultEnabled holds the default value on creation for the "enabled" field.
 	DefaultEnabled bool
+	// DefaultTotalCPUCores holds the default value on creation for the "total_cpu_cores" field.
+	DefaultTotalCPUCores int
+	// TotalCPUCoresValidator is a validator for the "total_cpu_cores" field. It is called by the builders before save.
+	TotalCPUCoresValidator func(int) error
+	// DefaultTotalMemoryMB holds the default value on creation for the "total_memory_mb" field.
+	DefaultTotalMemoryMB int
+	// TotalMemoryMBValidator is a validator for the "total_memory_mb" field. It is called by the builders before save.
+	TotalMemoryMBValidator func(int) error
+	// DefaultCPUOvercommitRatio holds the default value on creation for the "cpu_overcommit_ratio" field.
+	DefaultCPUOvercommitRatio float64
+	// CPUOvercommitRatioValidator is a validator for the "cpu_overcommit_ratio" field. It is called by the builders before save.
+	CPUOvercommitRatioValidator func(float64) error
+	// DefaultMemoryOvercommitRatio holds the default value on creation for the "memory_overcommit_ratio" field.
+	DefaultMemoryOvercommitRatio float64
+	// MemoryOvercommitRatioValidator is a validator for the "memory_overcommit_ratio" field. It is called by the builders before save.
+	MemoryOvercommitRatioValidator func(float64) error
+	// DefaultHealthCheckFailures holds the default value on creation for the "health_check_failures" field.
+	DefaultHealthCheckFailures int
+	// HealthCheckFailuresValidator is a validator for the "health_check_failures" field. It is called by the builders before save.
+	HealthCheckFailuresValidator func(int) error
 )
 
 // Status defines the type for the "status" enum field.
@@ -224,3 +265,38 @@ func ByStorageClassesUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
 }
+
+// ByTotalCPUCores orders the results by the total_cpu_cores field.
+func ByTotalCPUCores(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalCPUCores, opts...).ToFunc()
+}
+
+// ByTotalMemoryMB orders the results by the total_memory_mb field.
+func ByTotalMemoryMB(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalMemoryMB, opts...).ToFunc()
+}
+
+// ByCPUOvercommitRatio orders the results by the cpu_overcommit_ratio field.
+func ByCPUOvercommitRatio(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCPUOvercommitRatio, opts...).ToFunc()
+}
+
+// ByMemoryOvercommitRatio orders the results by the memory_overcommit_ratio field.
+func ByMemoryOvercommitRatio(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMemoryOvercommitRatio, opts...).ToFunc()
+}
+
+// ByHealthCheckFailures orders the results by the health_check_failures field.
+func ByHealthCheckFailures(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHealthCheckFailures, opts...).ToFunc()
+}
+
+// ByLastHealthCheckAt orders the results by the last_health_check_at field.
+func ByLastHealthCheckAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastHealthCheckAt, opts...).ToFunc()
+}
+
+// ByHealthMessage orders the results by the health_message field.
+func ByHealthMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHealthMessage, opts...).ToFunc()
+}
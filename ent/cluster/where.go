@@ -124,6 +124,41 @@ func Enabled(v bool) predicate.Cluster {
 	return predicate.Cluster(sql.FieldEQ(FieldEnabled, v))
 }
 
+// TotalCPUCores applies equality check predicate on the "total_cpu_cores" field. It's identical to TotalCPUCoresEQ.
+func TotalCPUCores(v int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldEQ(FieldTotalCPUCores, v))
+}
+
+// TotalMemoryMB applies equality check predicate on the "total_memory_mb" field. It's identical to TotalMemoryMBEQ.
+func TotalMemoryMB(v int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldEQ(FieldTotalMemoryMB, v))
+}
+
+// CPUOvercommitRatio applies equality check predicate on the "cpu_overcommit_ratio" field. It's identical to CPUOvercommitRatioEQ.
+func CPUOvercommitRatio(v float64) predicate.Cluster {
+	return predicate.Cluster(sql.FieldEQ(FieldCPUOvercommitRatio, v))
+}
+
+// MemoryOvercommitRatio applies equality check predicate on the "memory_overcommit_ratio" field. It's identical to MemoryOvercommitRatioEQ.
+func MemoryOvercommitRatio(v float64) predicate.Cluster {
+	return predicate.Cluster(sql.FieldEQ(FieldMemoryOvercommitRatio, v))
+}
+
+// HealthCheckFailures applies equality check predicate on the "health_check_failures" field. It's identical to HealthCheckFailuresEQ.
+func HealthCheckFailures(v int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldEQ(FieldHealthCheckFailures, v))
+}
+
+// LastHealthCheckAt applies equality check predicate on the "last_health_check_at" field. It's identical to LastHealthCheckAtEQ.
+func LastHealthCheckAt(v time.Time) predicate.Cluster {
+	return predicate.Cluster(sql.FieldEQ(FieldLastHealthCheckAt, v))
+}
+
+// HealthMessage applies equality check predicate on the "health_message" field. It's identical to HealthMessageEQ.
+func HealthMessage(v string) predicate.Cluster {
+	return predicate.Cluster(sql.FieldEQ(FieldHealthMessage, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Cluster {
 	return predicate.Cluster(sql.FieldEQ(FieldCreatedAt, v))
@@ -859,6 +894,331 @@ func EnabledNEQ(v bool) predicate.Cluster {
 	return predicate.Cluster(sql.FieldNEQ(FieldEnabled, v))
 }
 
+// TotalCPUCoresEQ applies the EQ predicate on the "total_cpu_cores" field.
+func TotalCPUCoresEQ(v int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldEQ(FieldTotalCPUCores, v))
+}
+
+// TotalCPUCoresNEQ applies the NEQ predicate on the "total_cpu_cores" field.
+func TotalCPUCoresNEQ(v int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldNEQ(FieldTotalCPUCores, v))
+}
+
+// TotalCPUCoresIn applies the In predicate on the "total_cpu_cores" field.
+func TotalCPUCoresIn(vs ...int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldIn(FieldTotalCPUCores, vs...))
+}
+
+// TotalCPUCoresNotIn applies the NotIn predicate on the "total_cpu_cores" field.
+func TotalCPUCoresNotIn(vs ...int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldNotIn(FieldTotalCPUCores, vs...))
+}
+
+// TotalCPUCoresGT applies the GT predicate on the "total_cpu_cores" field.
+func TotalCPUCoresGT(v int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldGT(FieldTotalCPUCores, v))
+}
+
+// TotalCPUCoresGTE applies the GTE predicate on the "total_cpu_cores" field.
+func TotalCPUCoresGTE(v int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldGTE(FieldTotalCPUCores, v))
+}
+
+// TotalCPUCoresLT applies the LT predicate on the "total_cpu_cores" field.
+func TotalCPUCoresLT(v int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldLT(FieldTotalCPUCores, v))
+}
+
+// TotalCPUCoresLTE applies the LTE predicate on the "total_cpu_cores" field.
+func TotalCPUCoresLTE(v int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldLTE(FieldTotalCPUCores, v))
+}
+
+// TotalMemoryMBEQ applies the EQ predicate on the "total_memory_mb" field.
+func TotalMemoryMBEQ(v int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldEQ(FieldTotalMemoryMB, v))
+}
+
+// TotalMemoryMBNEQ applies the NEQ predicate on the "total_memory_mb" field.
+func TotalMemoryMBNEQ(v int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldNEQ(FieldTotalMemoryMB, v))
+}
+
+// TotalMemoryMBIn applies the In predicate on the "total_memory_mb" field.
+func TotalMemoryMBIn(vs ...int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldIn(FieldTotalMemoryMB, vs...))
+}
+
+// TotalMemoryMBNotIn applies the NotIn predicate on the "total_memory_mb" field.
+func TotalMemoryMBNotIn(vs ...int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldNotIn(FieldTotalMemoryMB, vs...))
+}
+
+// TotalMemoryMBGT applies the GT predicate on the "total_memory_mb" field.
+func TotalMemoryMBGT(v int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldGT(FieldTotalMemoryMB, v))
+}
+
+// TotalMemoryMBGTE applies the GTE predicate on the "total_memory_mb" field.
+func TotalMemoryMBGTE(v int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldGTE(FieldTotalMemoryMB, v))
+}
+
+// TotalMemoryMBLT applies the LT predicate on the "total_memory_mb" field.
+func TotalMemoryMBLT(v int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldLT(FieldTotalMemoryMB, v))
+}
+
+// TotalMemoryMBLTE applies the LTE predicate on the "total_memory_mb" field.
+func TotalMemoryMBLTE(v int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldLTE(FieldTotalMemoryMB, v))
+}
+
+// CPUOvercommitRatioEQ applies the EQ predicate on the "cpu_overcommit_ratio" field.
+func CPUOvercommitRatioEQ(v float64) predicate.Cluster {
+	return predicate.Cluster(sql.FieldEQ(FieldCPUOvercommitRatio, v))
+}
+
+// CPUOvercommitRatioNEQ applies the NEQ predicate on the "cpu_overcommit_ratio" field.
+func CPUOvercommitRatioNEQ(v float64) predicate.Cluster {
+	return predicate.Cluster(sql.FieldNEQ(FieldCPUOvercommitRatio, v))
+}
+
+// CPUOvercommitRatioIn applies the In predicate on the "cpu_overcommit_ratio" field.
+func CPUOvercommitRatioIn(vs ...float64) predicate.Cluster {
+	return predicate.Cluster(sql.FieldIn(FieldCPUOvercommitRatio, vs...))
+}
+
+// CPUOvercommitRatioNotIn applies the NotIn predicate on the "cpu_overcommit_ratio" field.
+func CPUOvercommitRatioNotIn(vs ...float64) predicate.Cluster {
+	return predicate.Cluster(sql.FieldNotIn(FieldCPUOvercommitRatio, vs...))
+}
+
+// CPUOvercommitRatioGT applies the GT predicate on the "cpu_overcommit_ratio" field.
+func CPUOvercommitRatioGT(v float64) predicate.Cluster {
+	return predicate.Cluster(sql.FieldGT(FieldCPUOvercommitRatio, v))
+}
+
+// CPUOvercommitRatioGTE applies the GTE predicate on the "cpu_overcommit_ratio" field.
+func CPUOvercommitRatioGTE(v float64) predicate.Cluster {
+	return predicate.Cluster(sql.FieldGTE(FieldCPUOvercommitRatio, v))
+}
+
+// CPUOvercommitRatioLT applies the LT predicate on the "cpu_overcommit_ratio" field.
+func CPUOvercommitRatioLT(v float64) predicate.Cluster {
+	return predicate.Cluster(sql.FieldLT(FieldCPUOvercommitRatio, v))
+}
+
+// CPUOvercommitRatioLTE applies the LTE predicate on the "cpu_overcommit_ratio" field.
+func CPUOvercommitRatioLTE(v float64) predicate.Cluster {
+	return predicate.Cluster(sql.FieldLTE(FieldCPUOvercommitRatio, v))
+}
+
+// MemoryOvercommitRatioEQ applies the EQ predicate on the "memory_overcommit_ratio" field.
+func MemoryOvercommitRatioEQ(v float64) predicate.Cluster {
+	return predicate.Cluster(sql.FieldEQ(FieldMemoryOvercommitRatio, v))
+}
+
+// MemoryOvercommitRatioNEQ applies the NEQ predicate on the "memory_overcommit_ratio" field.
+func MemoryOvercommitRatioNEQ(v float64) predicate.Cluster {
+	return predicate.Cluster(sql.FieldNEQ(FieldMemoryOvercommitRatio, v))
+}
+
+// MemoryOvercommitRatioIn applies the In predicate on the "memory_overcommit_ratio" field.
+func MemoryOvercommitRatioIn(vs ...float64) predicate.Cluster {
+	return predicate.Cluster(sql.FieldIn(FieldMemoryOvercommitRatio, vs...))
+}
+
+// MemoryOvercommitRatioNotIn applies the NotIn predicate on the "memory_overcommit_ratio" field.
+func MemoryOvercommitRatioNotIn(vs ...float64) predicate.Cluster {
+	return predicate.Cluster(sql.FieldNotIn(FieldMemoryOvercommitRatio, vs...))
+}
+
+// MemoryOvercommitRatioGT applies the GT predicate on the "memory_overcommit_ratio" field.
+func MemoryOvercommitRatioGT(v float64) predicate.Cluster {
+	return predicate.Cluster(sql.FieldGT(FieldMemoryOvercommitRatio, v))
+}
+
+// MemoryOvercommitRatioGTE applies the GTE predicate on the "memory_overcommit_ratio" field.
+func MemoryOvercommitRatioGTE(v float64) predicate.Cluster {
+	return predicate.Cluster(sql.FieldGTE(FieldMemoryOvercommitRatio, v))
+}
+
+// MemoryOvercommitRatioLT applies the LT predicate on the "memory_overcommit_ratio" field.
+func MemoryOvercommitRatioLT(v float64) predicate.Cluster {
+	return predicate.Cluster(sql.FieldLT(FieldMemoryOvercommitRatio, v))
+}
+
+// MemoryOvercommitRatioLTE applies the LTE predicate on the "memory_overcommit_ratio" field.
+func MemoryOvercommitRatioLTE(v float64) predicate.Cluster {
+	return predicate.Cluster(sql.FieldLTE(FieldMemoryOvercommitRatio, v))
+}
+
+// HealthCheckFailuresEQ applies the EQ predicate on the "health_check_failures" field.
+func HealthCheckFailuresEQ(v int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldEQ(FieldHealthCheckFailures, v))
+}
+
+// HealthCheckFailuresNEQ applies the NEQ predicate on the "health_check_failures" field.
+func HealthCheckFailuresNEQ(v int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldNEQ(FieldHealthCheckFailures, v))
+}
+
+// HealthCheckFailuresIn applies the In predicate on the "health_check_failures" field.
+func HealthCheckFailuresIn(vs ...int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldIn(FieldHealthCheckFailures, vs...))
+}
+
+// HealthCheckFailuresNotIn applies the NotIn predicate on the "health_check_failures" field.
+func HealthCheckFailuresNotIn(vs ...int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldNotIn(FieldHealthCheckFailures, vs...))
+}
+
+// HealthCheckFailuresGT applies the GT predicate on the "health_check_failures" field.
+func HealthCheckFailuresGT(v int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldGT(FieldHealthCheckFailures, v))
+}
+
+// HealthCheckFailuresGTE applies the GTE predicate on the "health_check_failures" field.
+func HealthCheckFailuresGTE(v int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldGTE(FieldHealthCheckFailures, v))
+}
+
+// HealthCheckFailuresLT applies the LT predicate on the "health_check_failures" field.
+func HealthCheckFailuresLT(v int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldLT(FieldHealthCheckFailures, v))
+}
+
+// HealthCheckFailuresLTE applies the LTE predicate on the "health_check_failures" field.
+func HealthCheckFailuresLTE(v int) predicate.Cluster {
+	return predicate.Cluster(sql.FieldLTE(FieldHealthCheckFailures, v))
+}
+
+// LastHealthCheckAtEQ applies the EQ predicate on the "last_health_check_at" field.
+func LastHealthCheckAtEQ(v time.Time) predicate.Cluster {
+	return predicate.Cluster(sql.FieldEQ(FieldLastHealthCheckAt, v))
+}
+
+// LastHealthCheckAtNEQ applies the NEQ predicate on the "last_health_check_at" field.
+func LastHealthCheckAtNEQ(v time.Time) predicate.Cluster {
+	return predicate.Cluster(sql.FieldNEQ(FieldLastHealthCheckAt, v))
+}
+
+// LastHealthCheckAtIn applies the In predicate on the "last_health_check_at" field.
+func LastHealthCheckAtIn(vs ...time.Time) predicate.Cluster {
+	return predicate.Cluster(sql.FieldIn(FieldLastHealthCheckAt, vs...))
+}
+
+// LastHealthCheckAtNotIn applies the NotIn predicate on the "last_health_check_at" field.
+func LastHealthCheckAtNotIn(vs ...time.Time) predicate.Cluster {
+	return predicate.Cluster(sql.FieldNotIn(FieldLastHealthCheckAt, vs...))
+}
+
+// LastHealthCheckAtGT applies the GT predicate on the "last_health_check_at" field.
+func LastHealthCheckAtGT(v time.Time) predicate.Cluster {
+	return predicate.Cluster(sql.FieldGT(FieldLastHealthCheckAt, v))
+}
+
+// LastHealthCheckAtGTE applies the GTE predicate on the "last_health_check_at" field.
+func LastHealthCheckAtGTE(v time.Time) predicate.Cluster {
+	return predicate.Cluster(sql.FieldGTE(FieldLastHealthCheckAt, v))
+}
+
+// LastHealthCheckAtLT applies the LT predicate on the "last_health_check_at" field.
+func LastHealthCheckAtLT(v time.Time) predicate.Cluster {
+	return predicate.Cluster(sql.FieldLT(FieldLastHealthCheckAt, v))
+}
+
+// LastHealthCheckAtLTE applies the LTE predicate on the "last_health_check_at" field.
+func LastHealthCheckAtLTE(v time.Time) predicate.Cluster {
+	return predicate.Cluster(sql.FieldLTE(FieldLastHealthCheckAt, v))
+}
+
+// LastHealthCheckAtIsNil applies the IsNil predicate on the "last_health_check_at" field.
+func LastHealthCheckAtIsNil() predicate.Cluster {
+	return predicate.Cluster(sql.FieldIsNull(FieldLastHealthCheckAt))
+}
+
+// LastHealthCheckAtNotNil applies the NotNil predicate on the "last_health_check_at" field.
+func LastHealthCheckAtNotNil() predicate.Cluster {
+	return predicate.Cluster(sql.FieldNotNull(FieldLastHealthCheckAt))
+}
+
+// HealthMessageEQ applies the EQ predicate on the "health_message" field.
+func HealthMessageEQ(v string) predicate.Cluster {
+	return predicate.Cluster(sql.FieldEQ(FieldHealthMessage, v))
+}
+
+// HealthMessageNEQ applies the NEQ predicate on the "health_message" field.
+func HealthMessageNEQ(v string) predicate.Cluster {
+	return predicate.Cluster(sql.FieldNEQ(FieldHealthMessage, v))
+}
+
+// HealthMessageIn applies the In predicate on the "health_message" field.
+func HealthMessageIn(vs ...string) predicate.Cluster {
+	return predicate.Cluster(sql.FieldIn(FieldHealthMessage, vs...))
+}
+
+// HealthMessageNotIn applies the NotIn predicate on the "health_message" field.
+func HealthMessageNotIn(vs ...string) predicate.Cluster {
+	return predicate.Cluster(sql.FieldNotIn(FieldHealthMessage, vs...))
+}
+
+// HealthMessageGT applies the GT predicate on the "health_message" field.
+func HealthMessageGT(v string) predicate.Cluster {
+	return predicate.Cluster(sql.FieldGT(FieldHealthMessage, v))
+}
+
+// HealthMessageGTE applies the GTE predicate on the "health_message" field.
+func HealthMessageGTE(v string) predicate.Cluster {
+	return predicate.Cluster(sql.FieldGTE(FieldHealthMessage, v))
+}
+
+// HealthMessageLT applies the LT predicate on the "health_message" field.
+func HealthMessageLT(v string) predicate.Cluster {
+	return predicate.Cluster(sql.FieldLT(FieldHealthMessage, v))
+}
+
+// HealthMessageLTE applies the LTE predicate on the "health_message" field.
+func HealthMessageLTE(v string) predicate.Cluster {
+	return predicate.Cluster(sql.FieldLTE(FieldHealthMessage, v))
+}
+
+// HealthMessageContains applies the Contains predicate on the "health_message" field.
+func HealthMessageContains(v string) predicate.Cluster {
+	return predicate.Cluster(sql.FieldContains(FieldHealthMessage, v))
+}
+
+// HealthMessageHasPrefix applies the HasPrefix predicate on the "health_message" field.
+func HealthMessageHasPrefix(v string) predicate.Cluster {
+	return predicate.Cluster(sql.FieldHasPrefix(FieldHealthMessage, v))
+}
+
+// HealthMessageHasSuffix applies the HasSuffix predicate on the "health_message" field.
+func HealthMessageHasSuffix(v string) predicate.Cluster {
+	return predicate.Cluster(sql.FieldHasSuffix(FieldHealthMessage, v))
+}
+
+// HealthMessageIsNil applies the IsNil predicate on the "health_message" field.
+func HealthMessageIsNil() predicate.Cluster {
+	return predicate.Cluster(sql.FieldIsNull(FieldHealthMessage))
+}
+
+// HealthMessageNotNil applies the NotNil predicate on the "health_message" field.
+func HealthMessageNotNil() predicate.Cluster {
+	return predicate.Cluster(sql.FieldNotNull(FieldHealthMessage))
+}
+
+// HealthMessageEqualFold applies the EqualFold predicate on the "health_message" field.
+func HealthMessageEqualFold(v string) predicate.Cluster {
+	return predicate.Cluster(sql.FieldEqualFold(FieldHealthMessage, v))
+}
+
+// HealthMessageContainsFold applies the ContainsFold predicate on the "health_message" field.
+func HealthMessageContainsFold(v string) predicate.Cluster {
+	return predicate.Cluster(sql.FieldContainsFold(FieldHealthMessage, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Cluster) predicate.Cluster {
 	return predicate.Cluster(sql.AndPredicates(predicates...))
@@ -196,6 +196,104 @@ func (_c *ClusterCreate) SetNillableEnabled(v *bool) *ClusterCreate {
 	return _c
 }
 
+// SetTotalCPUCores sets the "total_cpu_cores" field.
+func (_c *ClusterCreate) SetTotalCPUCores(v int) *ClusterCreate {
+	_c.mutation.SetTotalCPUCores(v)
+	return _c
+}
+
+// SetNillableTotalCPUCores sets the "total_cpu_cores" field if the given value is not nil.
+func (_c *ClusterCreate) SetNillableTotalCPUCores(v *int) *ClusterCreate {
+	if v != nil {
+		_c.SetTotalCPUCores(*v)
+	}
+	return _c
+}
+
+// SetTotalMemoryMB sets the "total_memory_mb" field.
+func (_c *ClusterCreate) SetTotalMemoryMB(v int) *ClusterCreate {
+	_c.mutation.SetTotalMemoryMB(v)
+	return _c
+}
+
+// SetNillableTotalMemoryMB sets the "total_memory_mb" field if the given value is not nil.
+func (_c *ClusterCreate) SetNillableTotalMemoryMB(v *int) *ClusterCreate {
+	if v != nil {
+		_c.SetTotalMemoryMB(*v)
+	}
+	return _c
+}
+
+// SetCPUOvercommitRatio sets the "cpu_overcommit_ratio" field.
+func (_c *ClusterCreate) SetCPUOvercommitRatio(v float64) *ClusterCreate {
+	_c.mutation.SetCPUOvercommitRatio(v)
+	return _c
+}
+
+// SetNillableCPUOvercommitRatio sets the "cpu_overcommit_ratio" field if the given value is not nil.
+func (_c *ClusterCreate) SetNillableCPUOvercommitRatio(v *float64) *ClusterCreate {
+	if v != nil {
+		_c.SetCPUOvercommitRatio(*v)
+	}
+	return _c
+}
+
+// SetMemoryOvercommitRatio sets the "memory_overcommit_ratio" field.
+func (_c *ClusterCreate) SetMemoryOvercommitRatio(v float64) *ClusterCreate {
+	_c.mutation.SetMemoryOvercommitRatio(v)
+	return _c
+}
+
+// SetNillableMemoryOvercommitRatio sets the "memory_overcommit_ratio" field if the given value is not nil.
+func (_c *ClusterCreate) SetNillableMemoryOvercommitRatio(v *float64) *ClusterCreate {
+	if v != nil {
+		_c.SetMemoryOvercommitRatio(*v)
+	}
+	return _c
+}
+
+// SetHealthCheckFailures sets the "health_check_failures" field.
+func (_c *ClusterCreate) SetHealthCheckFailures(v int) *ClusterCreate {
+	_c.mutation.SetHealthCheckFailures(v)
+	return _c
+}
+
+// SetNillableHealthCheckFailures sets the "health_check_failures" field if the given value is not nil.
+func (_c *ClusterCreate) SetNillableHealthCheckFailures(v *int) *ClusterCreate {
+	if v != nil {
+		_c.SetHealthCheckFailures(*v)
+	}
+	return _c
+}
+
+// SetLastHealthCheckAt sets the "last_health_check_at" field.
+func (_c *ClusterCreate) SetLastHealthCheckAt(v time.Time) *ClusterCreate {
+	_c.mutation.SetLastHealthCheckAt(v)
+	return _c
+}
+
+// SetNillableLastHealthCheckAt sets the "last_health_check_at" field if the given value is not nil.
+func (_c *ClusterCreate) SetNillableLastHealthCheckAt(v *time.Time) *ClusterCreate {
+	if v != nil {
+		_c.SetLastHealthCheckAt(*v)
+	}
+	return _c
+}
+
+// SetHealthMessage sets the "health_message" field.
+func (_c *ClusterCreate) SetHealthMessage(v string) *ClusterCreate {
+	_c.mutation.SetHealthMessage(v)
+	return _c
+}
+
+// SetNillableHealthMessage sets the "health_message" field if the given value is not nil.
+func (_c *ClusterCreate) SetNillableHealthMessage(v *string) *ClusterCreate {
+	if v != nil {
+		_c.SetHealthMessage(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *ClusterCreate) SetID(v string) *ClusterCreate {
 	_c.mutation.SetID(v)
@@ -257,6 +355,26 @@ func (_c *ClusterCreate) defaults() {
 		v := cluster.DefaultEnabled
 		_c.mutation.SetEnabled(v)
 	}
+	if _, ok := _c.mutation.TotalCPUCores(); !ok {
+		v := cluster.DefaultTotalCPUCores
+		_c.mutation.SetTotalCPUCores(v)
+	}
+	if _, ok := _c.mutation.TotalMemoryMB(); !ok {
+		v := cluster.DefaultTotalMemoryMB
+		_c.mutation.SetTotalMemoryMB(v)
+	}
+	if _, ok := _c.mutation.CPUOvercommitRatio(); !ok {
+		v := cluster.DefaultCPUOvercommitRatio
+		_c.mutation.SetCPUOvercommitRatio(v)
+	}
+	if _, ok := _c.mutation.MemoryOvercommitRatio(); !ok {
+		v := cluster.DefaultMemoryOvercommitRatio
+		_c.mutation.SetMemoryOvercommitRatio(v)
+	}
+	if _, ok := _c.mutation.HealthCheckFailures(); !ok {
+		v := cluster.DefaultHealthCheckFailures
+		_c.mutation.SetHealthCheckFailures(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -313,6 +431,46 @@ func (_c *ClusterCreate) check() error {
 	if _, ok := _c.mutation.Enabled(); !ok {
 		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "Cluster.enabled"`)}
 	}
+	if _, ok := _c.mutation.TotalCPUCores(); !ok {
+		return &ValidationError{Name: "total_cpu_cores", err: errors.New(`ent: missing required field "Cluster.total_cpu_cores"`)}
+	}
+	if v, ok := _c.mutation.TotalCPUCores(); ok {
+		if err := cluster.TotalCPUCoresValidator(v); err != nil {
+			return &ValidationError{Name: "total_cpu_cores", err: fmt.Errorf(`ent: validator failed for field "Cluster.total_cpu_cores": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TotalMemoryMB(); !ok {
+		return &ValidationError{Name: "total_memory_mb", err: errors.New(`ent: missing required field "Cluster.total_memory_mb"`)}
+	}
+	if v, ok := _c.mutation.TotalMemoryMB(); ok {
+		if err := cluster.TotalMemoryMBValidator(v); err != nil {
+			return &ValidationError{Name: "total_memory_mb", err: fmt.Errorf(`ent: validator failed for field "Cluster.total_memory_mb": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CPUOvercommitRatio(); !ok {
+		return &ValidationError{Name: "cpu_overcommit_ratio", err: errors.New(`ent: missing required field "Cluster.cpu_overcommit_ratio"`)}
+	}
+	if v, ok := _c.mutation.CPUOvercommitRatio(); ok {
+		if err := cluster.CPUOvercommitRatioValidator(v); err != nil {
+			return &ValidationError{Name: "cpu_overcommit_ratio", err: fmt.Errorf(`ent: validator failed for field "Cluster.cpu_overcommit_ratio": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MemoryOvercommitRatio(); !ok {
+		return &ValidationError{Name: "memory_overcommit_ratio", err: errors.New(`ent: missing required field "Cluster.memory_overcommit_ratio"`)}
+	}
+	if v, ok := _c.mutation.MemoryOvercommitRatio(); ok {
+		if err := cluster.MemoryOvercommitRatioValidator(v); err != nil {
+			return &ValidationError{Name: "memory_overcommit_ratio", err: fmt.Errorf(`ent: validator failed for field "Cluster.memory_overcommit_ratio": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.HealthCheckFailures(); !ok {
+		return &ValidationError{Name: "health_check_failures", err: errors.New(`ent: missing required field "Cluster.health_check_failures"`)}
+	}
+	if v, ok := _c.mutation.HealthCheckFailures(); ok {
+		if err := cluster.HealthCheckFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "health_check_failures", err: fmt.Errorf(`ent: validator failed for field "Cluster.health_check_failures": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -412,6 +570,34 @@ func (_c *ClusterCreate) createSpec() (*Cluster, *sqlgraph.CreateSpec) {
 		_spec.SetField(cluster.FieldEnabled, field.TypeBool, value)
 		_node.Enabled = value
 	}
+	if value, ok := _c.mutation.TotalCPUCores(); ok {
+		_spec.SetField(cluster.FieldTotalCPUCores, field.TypeInt, value)
+		_node.TotalCPUCores = value
+	}
+	if value, ok := _c.mutation.TotalMemoryMB(); ok {
+		_spec.SetField(cluster.FieldTotalMemoryMB, field.TypeInt, value)
+		_node.TotalMemoryMB = value
+	}
+	if value, ok := _c.mutation.CPUOvercommitRatio(); ok {
+		_spec.SetField(cluster.FieldCPUOvercommitRatio, field.TypeFloat64, value)
+		_node.CPUOvercommitRatio = value
+	}
+	if value, ok := _c.mutation.MemoryOvercommitRatio(); ok {
+		_spec.SetField(cluster.FieldMemoryOvercommitRatio, field.TypeFloat64, value)
+		_node.MemoryOvercommitRatio = value
+	}
+	if value, ok := _c.mutation.HealthCheckFailures(); ok {
+		_spec.SetField(cluster.FieldHealthCheckFailures, field.TypeInt, value)
+		_node.HealthCheckFailures = value
+	}
+	if value, ok := _c.mutation.LastHealthCheckAt(); ok {
+		_spec.SetField(cluster.FieldLastHealthCheckAt, field.TypeTime, value)
+		_node.LastHealthCheckAt = &value
+	}
+	if value, ok := _c.mutation.HealthMessage(); ok {
+		_spec.SetField(cluster.FieldHealthMessage, field.TypeString, value)
+		_node.HealthMessage = value
+	}
 	return _node, _spec
 }
 
@@ -261,6 +261,151 @@ func (_u *ClusterUpdate) SetNillableEnabled(v *bool) *ClusterUpdate {
 	return _u
 }
 
+// SetTotalCPUCores sets the "total_cpu_cores" field.
+func (_u *ClusterUpdate) SetTotalCPUCores(v int) *ClusterUpdate {
+	_u.mutation.ResetTotalCPUCores()
+	_u.mutation.SetTotalCPUCores(v)
+	return _u
+}
+
+// SetNillableTotalCPUCores sets the "total_cpu_cores" field if the given value is not nil.
+func (_u *ClusterUpdate) SetNillableTotalCPUCores(v *int) *ClusterUpdate {
+	if v != nil {
+		_u.SetTotalCPUCores(*v)
+	}
+	return _u
+}
+
+// AddTotalCPUCores adds value to the "total_cpu_cores" field.
+func (_u *ClusterUpdate) AddTotalCPUCores(v int) *ClusterUpdate {
+	_u.mutation.AddTotalCPUCores(v)
+	return _u
+}
+
+// SetTotalMemoryMB sets the "total_memory_mb" field.
+func (_u *ClusterUpdate) SetTotalMemoryMB(v int) *ClusterUpdate {
+	_u.mutation.ResetTotalMemoryMB()
+	_u.mutation.SetTotalMemoryMB(v)
+	return _u
+}
+
+// SetNillableTotalMemoryMB sets the "total_memory_mb" field if the given value is not nil.
+func (_u *ClusterUpdate) SetNillableTotalMemoryMB(v *int) *ClusterUpdate {
+	if v != nil {
+		_u.SetTotalMemoryMB(*v)
+	}
+	return _u
+}
+
+// AddTotalMemoryMB adds value to the "total_memory_mb" field.
+func (_u *ClusterUpdate) AddTotalMemoryMB(v int) *ClusterUpdate {
+	_u.mutation.AddTotalMemoryMB(v)
+	return _u
+}
+
+// SetCPUOvercommitRatio sets the "cpu_overcommit_ratio" field.
+func (_u *ClusterUpdate) SetCPUOvercommitRatio(v float64) *ClusterUpdate {
+	_u.mutation.ResetCPUOvercommitRatio()
+	_u.mutation.SetCPUOvercommitRatio(v)
+	return _u
+}
+
+// SetNillableCPUOvercommitRatio sets the "cpu_overcommit_ratio" field if the given value is not nil.
+func (_u *ClusterUpdate) SetNillableCPUOvercommitRatio(v *float64) *ClusterUpdate {
+	if v != nil {
+		_u.SetCPUOvercommitRatio(*v)
+	}
+	return _u
+}
+
+// AddCPUOvercommitRatio adds value to the "cpu_overcommit_ratio" field.
+func (_u *ClusterUpdate) AddCPUOvercommitRatio(v float64) *ClusterUpdate {
+	_u.mutation.AddCPUOvercommitRatio(v)
+	return _u
+}
+
+// SetMemoryOvercommitRatio sets the "memory_overcommit_ratio" field.
+func (_u *ClusterUpdate) SetMemoryOvercommitRatio(v float64) *ClusterUpdate {
+	_u.mutation.ResetMemoryOvercommitRatio()
+	_u.mutation.SetMemoryOvercommitRatio(v)
+	return _u
+}
+
+// SetNillableMemoryOvercommitRatio sets the "memory_overcommit_ratio" field if the given value is not nil.
+func (_u *ClusterUpdate) SetNillableMemoryOvercommitRatio(v *float64) *ClusterUpdate {
+	if v != nil {
+		_u.SetMemoryOvercommitRatio(*v)
+	}
+	return _u
+}
+
+// AddMemoryOvercommitRatio adds value to the "memory_overcommit_ratio" field.
+func (_u *ClusterUpdate) AddMemoryOvercommitRatio(v float64) *ClusterUpdate {
+	_u.mutation.AddMemoryOvercommitRatio(v)
+	return _u
+}
+
+// SetHealthCheckFailures sets the "health_check_failures" field.
+func (_u *ClusterUpdate) SetHealthCheckFailures(v int) *ClusterUpdate {
+	_u.mutation.ResetHealthCheckFailures()
+	_u.mutation.SetHealthCheckFailures(v)
+	return _u
+}
+
+// SetNillableHealthCheckFailures sets the "health_check_failures" field if the given value is not nil.
+func (_u *ClusterUpdate) SetNillableHealthCheckFailures(v *int) *ClusterUpdate {
+	if v != nil {
+		_u.SetHealthCheckFailures(*v)
+	}
+	return _u
+}
+
+// AddHealthCheckFailures adds value to the "health_check_failures" field.
+func (_u *ClusterUpdate) AddHealthCheckFailures(v int) *ClusterUpdate {
+	_u.mutation.AddHealthCheckFailures(v)
+	return _u
+}
+
+// SetLastHealthCheckAt sets the "last_health_check_at" field.
+func (_u *ClusterUpdate) SetLastHealthCheckAt(v time.Time) *ClusterUpdate {
+	_u.mutation.SetLastHealthCheckAt(v)
+	return _u
+}
+
+// SetNillableLastHealthCheckAt sets the "last_health_check_at" field if the given value is not nil.
+func (_u *ClusterUpdate) SetNillableLastHealthCheckAt(v *time.Time) *ClusterUpdate {
+	if v != nil {
+		_u.SetLastHealthCheckAt(*v)
+	}
+	return _u
+}
+
+// ClearLastHealthCheckAt clears the value of the "last_health_check_at" field.
+func (_u *ClusterUpdate) ClearLastHealthCheckAt() *ClusterUpdate {
+	_u.mutation.ClearLastHealthCheckAt()
+	return _u
+}
+
+// SetHealthMessage sets the "health_message" field.
+func (_u *ClusterUpdate) SetHealthMessage(v string) *ClusterUpdate {
+	_u.mutation.SetHealthMessage(v)
+	return _u
+}
+
+// SetNillableHealthMessage sets the "health_message" field if the given value is not nil.
+func (_u *ClusterUpdate) SetNillableHealthMessage(v *string) *ClusterUpdate {
+	if v != nil {
+		_u.SetHealthMessage(*v)
+	}
+	return _u
+}
+
+// ClearHealthMessage clears the value of the "health_message" field.
+func (_u *ClusterUpdate) ClearHealthMessage() *ClusterUpdate {
+	_u.mutation.ClearHealthMessage()
+	return _u
+}
+
 // Mutation returns the ClusterMutation object of the builder.
 func (_u *ClusterUpdate) Mutation() *ClusterMutation {
 	return _u.mutation
@@ -329,6 +474,31 @@ func (_u *ClusterUpdate) check() error {
 			return &ValidationError{Name: "environment", err: fmt.Errorf(`ent: validator failed for field "Cluster.environment": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TotalCPUCores(); ok {
+		if err := cluster.TotalCPUCoresValidator(v); err != nil {
+			return &ValidationError{Name: "total_cpu_cores", err: fmt.Errorf(`ent: validator failed for field "Cluster.total_cpu_cores": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalMemoryMB(); ok {
+		if err := cluster.TotalMemoryMBValidator(v); err != nil {
+			return &ValidationError{Name: "total_memory_mb", err: fmt.Errorf(`ent: validator failed for field "Cluster.total_memory_mb": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CPUOvercommitRatio(); ok {
+		if err := cluster.CPUOvercommitRatioValidator(v); err != nil {
+			return &ValidationError{Name: "cpu_overcommit_ratio", err: fmt.Errorf(`ent: validator failed for field "Cluster.cpu_overcommit_ratio": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MemoryOvercommitRatio(); ok {
+		if err := cluster.MemoryOvercommitRatioValidator(v); err != nil {
+			return &ValidationError{Name: "memory_overcommit_ratio", err: fmt.Errorf(`ent: validator failed for field "Cluster.memory_overcommit_ratio": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HealthCheckFailures(); ok {
+		if err := cluster.HealthCheckFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "health_check_failures", err: fmt.Errorf(`ent: validator failed for field "Cluster.health_check_failures": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -420,6 +590,48 @@ func (_u *ClusterUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Enabled(); ok {
 		_spec.SetField(cluster.FieldEnabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.TotalCPUCores(); ok {
+		_spec.SetField(cluster.FieldTotalCPUCores, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalCPUCores(); ok {
+		_spec.AddField(cluster.FieldTotalCPUCores, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalMemoryMB(); ok {
+		_spec.SetField(cluster.FieldTotalMemoryMB, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalMemoryMB(); ok {
+		_spec.AddField(cluster.FieldTotalMemoryMB, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CPUOvercommitRatio(); ok {
+		_spec.SetField(cluster.FieldCPUOvercommitRatio, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCPUOvercommitRatio(); ok {
+		_spec.AddField(cluster.FieldCPUOvercommitRatio, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MemoryOvercommitRatio(); ok {
+		_spec.SetField(cluster.FieldMemoryOvercommitRatio, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMemoryOvercommitRatio(); ok {
+		_spec.AddField(cluster.FieldMemoryOvercommitRatio, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.HealthCheckFailures(); ok {
+		_spec.SetField(cluster.FieldHealthCheckFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHealthCheckFailures(); ok {
+		_spec.AddField(cluster.FieldHealthCheckFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastHealthCheckAt(); ok {
+		_spec.SetField(cluster.FieldLastHealthCheckAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastHealthCheckAtCleared() {
+		_spec.ClearField(cluster.FieldLastHealthCheckAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.HealthMessage(); ok {
+		_spec.SetField(cluster.FieldHealthMessage, field.TypeString, value)
+	}
+	if _u.mutation.HealthMessageCleared() {
+		_spec.ClearField(cluster.FieldHealthMessage, field.TypeString)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{cluster.Label}
@@ -672,6 +884,151 @@ func (_u *ClusterUpdateOne) SetNillableEnabled(v *bool) *ClusterUpdateOne {
 	return _u
 }
 
+// SetTotalCPUCores sets the "total_cpu_cores" field.
+func (_u *ClusterUpdateOne) SetTotalCPUCores(v int) *ClusterUpdateOne {
+	_u.mutation.ResetTotalCPUCores()
+	_u.mutation.SetTotalCPUCores(v)
+	return _u
+}
+
+// SetNillableTotalCPUCores sets the "total_cpu_cores" field if the given value is not nil.
+func (_u *ClusterUpdateOne) SetNillableTotalCPUCores(v *int) *ClusterUpdateOne {
+	if v != nil {
+		_u.SetTotalCPUCores(*v)
+	}
+	return _u
+}
+
+// AddTotalCPUCores adds value to the "total_cpu_cores" field.
+func (_u *ClusterUpdateOne) AddTotalCPUCores(v int) *ClusterUpdateOne {
+	_u.mutation.AddTotalCPUCores(v)
+	return _u
+}
+
+// SetTotalMemoryMB sets the "total_memory_mb" field.
+func (_u *ClusterUpdateOne) SetTotalMemoryMB(v int) *ClusterUpdateOne {
+	_u.mutation.ResetTotalMemoryMB()
+	_u.mutation.SetTotalMemoryMB(v)
+	return _u
+}
+
+// SetNillableTotalMemoryMB sets the "total_memory_mb" field if the given value is not nil.
+func (_u *ClusterUpdateOne) SetNillableTotalMemoryMB(v *int) *ClusterUpdateOne {
+	if v != nil {
+		_u.SetTotalMemoryMB(*v)
+	}
+	return _u
+}
+
+// AddTotalMemoryMB adds value to the "total_memory_mb" field.
+func (_u *ClusterUpdateOne) AddTotalMemoryMB(v int) *ClusterUpdateOne {
+	_u.mutation.AddTotalMemoryMB(v)
+	return _u
+}
+
+// SetCPUOvercommitRatio sets the "cpu_overcommit_ratio" field.
+func (_u *ClusterUpdateOne) SetCPUOvercommitRatio(v float64) *ClusterUpdateOne {
+	_u.mutation.ResetCPUOvercommitRatio()
+	_u.mutation.SetCPUOvercommitRatio(v)
+	return _u
+}
+
+// SetNillableCPUOvercommitRatio sets the "cpu_overcommit_ratio" field if the given value is not nil.
+func (_u *ClusterUpdateOne) SetNillableCPUOvercommitRatio(v *float64) *ClusterUpdateOne {
+	if v != nil {
+		_u.SetCPUOvercommitRatio(*v)
+	}
+	return _u
+}
+
+// AddCPUOvercommitRatio adds value to the "cpu_overcommit_ratio" field.
+func (_u *ClusterUpdateOne) AddCPUOvercommitRatio(v float64) *ClusterUpdateOne {
+	_u.mutation.AddCPUOvercommitRatio(v)
+	return _u
+}
+
+// SetMemoryOvercommitRatio sets the "memory_overcommit_ratio" field.
+func (_u *ClusterUpdateOne) SetMemoryOvercommitRatio(v float64) *ClusterUpdateOne {
+	_u.mutation.ResetMemoryOvercommitRatio()
+	_u.mutation.SetMemoryOvercommitRatio(v)
+	return _u
+}
+
+// SetNillableMemoryOvercommitRatio sets the "memory_overcommit_ratio" field if the given value is not nil.
+func (_u *ClusterUpdateOne) SetNillableMemoryOvercommitRatio(v *float64) *ClusterUpdateOne {
+	if v != nil {
+		_u.SetMemoryOvercommitRatio(*v)
+	}
+	return _u
+}
+
+// AddMemoryOvercommitRatio adds value to the "memory_overcommit_ratio" field.
+func (_u *ClusterUpdateOne) AddMemoryOvercommitRatio(v float64) *ClusterUpdateOne {
+	_u.mutation.AddMemoryOvercommitRatio(v)
+	return _u
+}
+
+// SetHealthCheckFailures sets the "health_check_failures" field.
+func (_u *ClusterUpdateOne) SetHealthCheckFailures(v int) *ClusterUpdateOne {
+	_u.mutation.ResetHealthCheckFailures()
+	_u.mutation.SetHealthCheckFailures(v)
+	return _u
+}
+
+// SetNillableHealthCheckFailures sets the "health_check_failures" field if the given value is not nil.
+func (_u *ClusterUpdateOne) SetNillableHealthCheckFailures(v *int) *ClusterUpdateOne {
+	if v != nil {
+		_u.SetHealthCheckFailures(*v)
+	}
+	return _u
+}
+
+// AddHealthCheckFailures adds value to the "health_check_failures" field.
+func (_u *ClusterUpdateOne) AddHealthCheckFailures(v int) *ClusterUpdateOne {
+	_u.mutation.AddHealthCheckFailures(v)
+	return _u
+}
+
+// SetLastHealthCheckAt sets the "last_health_check_at" field.
+func (_u *ClusterUpdateOne) SetLastHealthCheckAt(v time.Time) *ClusterUpdateOne {
+	_u.mutation.SetLastHealthCheckAt(v)
+	return _u
+}
+
+// SetNillableLastHealthCheckAt sets the "last_health_check_at" field if the given value is not nil.
+func (_u *ClusterUpdateOne) SetNillableLastHealthCheckAt(v *time.Time) *ClusterUpdateOne {
+	if v != nil {
+		_u.SetLastHealthCheckAt(*v)
+	}
+	return _u
+}
+
+// ClearLastHealthCheckAt clears the value of the "last_health_check_at" field.
+func (_u *ClusterUpdateOne) ClearLastHealthCheckAt() *ClusterUpdateOne {
+	_u.mutation.ClearLastHealthCheckAt()
+	return _u
+}
+
+// SetHealthMessage sets the "health_message" field.
+func (_u *ClusterUpdateOne) SetHealthMessage(v string) *ClusterUpdateOne {
+	_u.mutation.SetHealthMessage(v)
+	return _u
+}
+
+// SetNillableHealthMessage sets the "health_message" field if the given value is not nil.
+func (_u *ClusterUpdateOne) SetNillableHealthMessage(v *string) *ClusterUpdateOne {
+	if v != nil {
+		_u.SetHealthMessage(*v)
+	}
+	return _u
+}
+
+// ClearHealthMessage clears the value of the "health_message" field.
+func (_u *ClusterUpdateOne) ClearHealthMessage() *ClusterUpdateOne {
+	_u.mutation.ClearHealthMessage()
+	return _u
+}
+
 // Mutation returns the ClusterMutation object of the builder.
 func (_u *ClusterUpdateOne) Mutation() *ClusterMutation {
 	return _u.mutation
@@ -753,6 +1110,31 @@ func (_u *ClusterUpdateOne) check() error {
 			return &ValidationError{Name: "environment", err: fmt.Errorf(`ent: validator failed for field "Cluster.environment": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TotalCPUCores(); ok {
+		if err := cluster.TotalCPUCoresValidator(v); err != nil {
+			return &ValidationError{Name: "total_cpu_cores", err: fmt.Errorf(`ent: validator failed for field "Cluster.total_cpu_cores": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalMemoryMB(); ok {
+		if err := cluster.TotalMemoryMBValidator(v); err != nil {
+			return &ValidationError{Name: "total_memory_mb", err: fmt.Errorf(`ent: validator failed for field "Cluster.total_memory_mb": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CPUOvercommitRatio(); ok {
+		if err := cluster.CPUOvercommitRatioValidator(v); err != nil {
+			return &ValidationError{Name: "cpu_overcommit_ratio", err: fmt.Errorf(`ent: validator failed for field "Cluster.cpu_overcommit_ratio": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MemoryOvercommitRatio(); ok {
+		if err := cluster.MemoryOvercommitRatioValidator(v); err != nil {
+			return &ValidationError{Name: "memory_overcommit_ratio", err: fmt.Errorf(`ent: validator failed for field "Cluster.memory_overcommit_ratio": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HealthCheckFailures(); ok {
+		if err := cluster.HealthCheckFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "health_check_failures", err: fmt.Errorf(`ent: validator failed for field "Cluster.health_check_failures": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -861,6 +1243,48 @@ func (_u *ClusterUpdateOne) sqlSave(ctx context.Context) (_node *Cluster, err er
 	if value, ok := _u.mutation.Enabled(); ok {
 		_spec.SetField(cluster.FieldEnabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.TotalCPUCores(); ok {
+		_spec.SetField(cluster.FieldTotalCPUCores, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalCPUCores(); ok {
+		_spec.AddField(cluster.FieldTotalCPUCores, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalMemoryMB(); ok {
+		_spec.SetField(cluster.FieldTotalMemoryMB, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalMemoryMB(); ok {
+		_spec.AddField(cluster.FieldTotalMemoryMB, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CPUOvercommitRatio(); ok {
+		_spec.SetField(cluster.FieldCPUOvercommitRatio, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCPUOvercommitRatio(); ok {
+		_spec.AddField(cluster.FieldCPUOvercommitRatio, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MemoryOvercommitRatio(); ok {
+		_spec.SetField(cluster.FieldMemoryOvercommitRatio, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMemoryOvercommitRatio(); ok {
+		_spec.AddField(cluster.FieldMemoryOvercommitRatio, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.HealthCheckFailures(); ok {
+		_spec.SetField(cluster.FieldHealthCheckFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHealthCheckFailures(); ok {
+		_spec.AddField(cluster.FieldHealthCheckFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastHealthCheckAt(); ok {
+		_spec.SetField(cluster.FieldLastHealthCheckAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastHealthCheckAtCleared() {
+		_spec.ClearField(cluster.FieldLastHealthCheckAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.HealthMessage(); ok {
+		_spec.SetField(cluster.FieldHealthMessage, field.TypeString, value)
+	}
+	if _u.mutation.HealthMessageCleared() {
+		_spec.ClearField(cluster.FieldHealthMessage, field.TypeString)
+	}
 	_node = &Cluster{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
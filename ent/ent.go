@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"kv-shepherd.io/shepherd/ent/approvaldecision"
 	"kv-shepherd.io/shepherd/ent/approvalpolicy"
 	"kv-shepherd.io/shepherd/ent/approvalticket"
 	"kv-shepherd.io/shepherd/ent/auditlog"
@@ -23,21 +24,37 @@ import (
 	"kv-shepherd.io/shepherd/ent/idpgroupmapping"
 	"kv-shepherd.io/shepherd/ent/idpsyncedgroup"
 	"kv-shepherd.io/shepherd/ent/instancesize"
+	"kv-shepherd.io/shepherd/ent/instancesizecluster"
+	"kv-shepherd.io/shepherd/ent/loginsession"
+	"kv-shepherd.io/shepherd/ent/loginthrottle"
+	"kv-shepherd.io/shepherd/ent/namespacequota"
 	"kv-shepherd.io/shepherd/ent/namespaceregistry"
 	"kv-shepherd.io/shepherd/ent/notification"
+	"kv-shepherd.io/shepherd/ent/notificationpreference"
+	"kv-shepherd.io/shepherd/ent/passwordhistory"
 	"kv-shepherd.io/shepherd/ent/pendingadoption"
+	"kv-shepherd.io/shepherd/ent/platformconfig"
+	"kv-shepherd.io/shepherd/ent/quota"
 	"kv-shepherd.io/shepherd/ent/ratelimitexemption"
 	"kv-shepherd.io/shepherd/ent/ratelimituseroverride"
+	"kv-shepherd.io/shepherd/ent/refreshtoken"
 	"kv-shepherd.io/shepherd/ent/resourcerolebinding"
+	"kv-shepherd.io/shepherd/ent/revokedsession"
 	"kv-shepherd.io/shepherd/ent/role"
 	"kv-shepherd.io/shepherd/ent/rolebinding"
+	"kv-shepherd.io/shepherd/ent/scheduledbatchjob"
 	"kv-shepherd.io/shepherd/ent/service"
 	"kv-shepherd.io/shepherd/ent/system"
 	"kv-shepherd.io/shepherd/ent/systemsecret"
 	"kv-shepherd.io/shepherd/ent/template"
+	"kv-shepherd.io/shepherd/ent/ticketcomment"
 	"kv-shepherd.io/shepherd/ent/user"
 	"kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/ent/vmconsolesession"
+	"kv-shepherd.io/shepherd/ent/vmrequestidempotency"
 	"kv-shepherd.io/shepherd/ent/vmrevision"
+	"kv-shepherd.io/shepherd/ent/webhookdelivery"
+	"kv-shepherd.io/shepherd/ent/webhookendpoint"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -98,6 +115,7 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			approvaldecision.Table:       approvaldecision.ValidColumn,
 			approvalpolicy.Table:         approvalpolicy.ValidColumn,
 			approvalticket.Table:         approvalticket.ValidColumn,
 			auditlog.Table:               auditlog.ValidColumn,
@@ -109,21 +127,37 @@ func checkColumn(t, c string) error {
 			idpgroupmapping.Table:        idpgroupmapping.ValidColumn,
 			idpsyncedgroup.Table:         idpsyncedgroup.ValidColumn,
 			instancesize.Table:           instancesize.ValidColumn,
+			instancesizecluster.Table:    instancesizecluster.ValidColumn,
+			loginsession.Table:           loginsession.ValidColumn,
+			loginthrottle.Table:          loginthrottle.ValidColumn,
+			namespacequota.Table:         namespacequota.ValidColumn,
 			namespaceregistry.Table:      namespaceregistry.ValidColumn,
 			notification.Table:           notification.ValidColumn,
+			notificationpreference.Table: notificationpreference.ValidColumn,
+			passwordhistory.Table:        passwordhistory.ValidColumn,
 			pendingadoption.Table:        pendingadoption.ValidColumn,
+			platformconfig.Table:         platformconfig.ValidColumn,
+			quota.Table:                  quota.ValidColumn,
 			ratelimitexemption.Table:     ratelimitexemption.ValidColumn,
 			ratelimituseroverride.Table:  ratelimituseroverride.ValidColumn,
+			refreshtoken.Table:           refreshtoken.ValidColumn,
 			resourcerolebinding.Table:    resourcerolebinding.ValidColumn,
+			revokedsession.Table:         revokedsession.ValidColumn,
 			role.Table:                   role.ValidColumn,
 			rolebinding.Table:            rolebinding.ValidColumn,
+			scheduledbatchjob.Table:      scheduledbatchjob.ValidColumn,
 			service.Table:                service.ValidColumn,
 			system.Table:                 system.ValidColumn,
 			systemsecret.Table:           systemsecret.ValidColumn,
 			template.Table:               template.ValidColumn,
+			ticketcomment.Table:          ticketcomment.ValidColumn,
 			user.Table:                   user.ValidColumn,
 			vm.Table:                     vm.ValidColumn,
+			vmconsolesession.Table:       vmconsolesession.ValidColumn,
+			vmrequestidempotency.Table:   vmrequestidempotency.ValidColumn,
 			vmrevision.Table:             vmrevision.ValidColumn,
+			webhookdelivery.Table:        webhookdelivery.ValidColumn,
+			webhookendpoint.Table:        webhookendpoint.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
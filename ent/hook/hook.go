@@ -9,6 +9,18 @@ import (
 	"kv-shepherd.io/shepherd/ent"
 )
 
+// The ApprovalDecisionFunc type is an adapter to allow the use of ordinary
+// function as ApprovalDecision mutator.
+type ApprovalDecisionFunc func(context.Context, *ent.ApprovalDecisionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ApprovalDecisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ApprovalDecisionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ApprovalDecisionMutation", m)
+}
+
 // The ApprovalPolicyFunc type is an adapter to allow the use of ordinary
 // function as ApprovalPolicy mutator.
 type ApprovalPolicyFunc func(context.Context, *ent.ApprovalPolicyMutation) (ent.Value, error)
@@ -141,6 +153,54 @@ func (f InstanceSizeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InstanceSizeMutation", m)
 }
 
+// The InstanceSizeClusterFunc type is an adapter to allow the use of ordinary
+// function as InstanceSizeCluster mutator.
+type InstanceSizeClusterFunc func(context.Context, *ent.InstanceSizeClusterMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InstanceSizeClusterFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.InstanceSizeClusterMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InstanceSizeClusterMutation", m)
+}
+
+// The LoginSessionFunc type is an adapter to allow the use of ordinary
+// function as LoginSession mutator.
+type LoginSessionFunc func(context.Context, *ent.LoginSessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LoginSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LoginSessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LoginSessionMutation", m)
+}
+
+// The LoginThrottleFunc type is an adapter to allow the use of ordinary
+// function as LoginThrottle mutator.
+type LoginThrottleFunc func(context.Context, *ent.LoginThrottleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LoginThrottleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LoginThrottleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LoginThrottleMutation", m)
+}
+
+// The NamespaceQuotaFunc type is an adapter to allow the use of ordinary
+// function as NamespaceQuota mutator.
+type NamespaceQuotaFunc func(context.Context, *ent.NamespaceQuotaMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NamespaceQuotaFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NamespaceQuotaMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NamespaceQuotaMutation", m)
+}
+
 // The NamespaceRegistryFunc type is an adapter to allow the use of ordinary
 // function as NamespaceRegistry mutator.
 type NamespaceRegistryFunc func(context.Context, *ent.NamespaceRegistryMutation) (ent.Value, error)
@@ -165,6 +225,30 @@ func (f NotificationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NotificationMutation", m)
 }
 
+// The NotificationPreferenceFunc type is an adapter to allow the use of ordinary
+// function as NotificationPreference mutator.
+type NotificationPreferenceFunc func(context.Context, *ent.NotificationPreferenceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NotificationPreferenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NotificationPreferenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NotificationPreferenceMutation", m)
+}
+
+// The PasswordHistoryFunc type is an adapter to allow the use of ordinary
+// function as PasswordHistory mutator.
+type PasswordHistoryFunc func(context.Context, *ent.PasswordHistoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PasswordHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PasswordHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PasswordHistoryMutation", m)
+}
+
 // The PendingAdoptionFunc type is an adapter to allow the use of ordinary
 // function as PendingAdoption mutator.
 type PendingAdoptionFunc func(context.Context, *ent.PendingAdoptionMutation) (ent.Value, error)
@@ -177,6 +261,30 @@ func (f PendingAdoptionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PendingAdoptionMutation", m)
 }
 
+// The PlatformConfigFunc type is an adapter to allow the use of ordinary
+// function as PlatformConfig mutator.
+type PlatformConfigFunc func(context.Context, *ent.PlatformConfigMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PlatformConfigFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PlatformConfigMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PlatformConfigMutation", m)
+}
+
+// The QuotaFunc type is an adapter to allow the use of ordinary
+// function as Quota mutator.
+type QuotaFunc func(context.Context, *ent.QuotaMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QuotaFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.QuotaMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QuotaMutation", m)
+}
+
 // The RateLimitExemptionFunc type is an adapter to allow the use of ordinary
 // function as RateLimitExemption mutator.
 type RateLimitExemptionFunc func(context.Context, *ent.RateLimitExemptionMutation) (ent.Value, error)
@@ -201,6 +309,18 @@ func (f RateLimitUserOverrideFunc) Mutate(ctx context.Context, m ent.Mutation) (
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RateLimitUserOverrideMutation", m)
 }
 
+// The RefreshTokenFunc type is an adapter to allow the use of ordinary
+// function as RefreshToken mutator.
+type RefreshTokenFunc func(context.Context, *ent.RefreshTokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RefreshTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RefreshTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RefreshTokenMutation", m)
+}
+
 // The ResourceRoleBindingFunc type is an adapter to allow the use of ordinary
 // function as ResourceRoleBinding mutator.
 type ResourceRoleBindingFunc func(context.Context, *ent.ResourceRoleBindingMutation) (ent.Value, error)
@@ -213,6 +333,18 @@ func (f ResourceRoleBindingFunc) Mutate(ctx context.Context, m ent.Mutation) (en
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ResourceRoleBindingMutation", m)
 }
 
+// The RevokedSessionFunc type is an adapter to allow the use of ordinary
+// function as RevokedSession mutator.
+type RevokedSessionFunc func(context.Context, *ent.RevokedSessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RevokedSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RevokedSessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RevokedSessionMutation", m)
+}
+
 // The RoleFunc type is an adapter to allow the use of ordinary
 // function as Role mutator.
 type RoleFunc func(context.Context, *ent.RoleMutation) (ent.Value, error)
@@ -237,6 +369,18 @@ func (f RoleBindingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoleBindingMutation", m)
 }
 
+// The ScheduledBatchJobFunc type is an adapter to allow the use of ordinary
+// function as ScheduledBatchJob mutator.
+type ScheduledBatchJobFunc func(context.Context, *ent.ScheduledBatchJobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScheduledBatchJobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScheduledBatchJobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScheduledBatchJobMutation", m)
+}
+
 // The ServiceFunc type is an adapter to allow the use of ordinary
 // function as Service mutator.
 type ServiceFunc func(context.Context, *ent.ServiceMutation) (ent.Value, error)
@@ -285,6 +429,18 @@ func (f TemplateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TemplateMutation", m)
 }
 
+// The TicketCommentFunc type is an adapter to allow the use of ordinary
+// function as TicketComment mutator.
+type TicketCommentFunc func(context.Context, *ent.TicketCommentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TicketCommentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TicketCommentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TicketCommentMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -309,6 +465,30 @@ func (f VMFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VMMutation", m)
 }
 
+// The VMConsoleSessionFunc type is an adapter to allow the use of ordinary
+// function as VMConsoleSession mutator.
+type VMConsoleSessionFunc func(context.Context, *ent.VMConsoleSessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VMConsoleSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VMConsoleSessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VMConsoleSessionMutation", m)
+}
+
+// The VMRequestIdempotencyFunc type is an adapter to allow the use of ordinary
+// function as VMRequestIdempotency mutator.
+type VMRequestIdempotencyFunc func(context.Context, *ent.VMRequestIdempotencyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VMRequestIdempotencyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VMRequestIdempotencyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VMRequestIdempotencyMutation", m)
+}
+
 // The VMRevisionFunc type is an adapter to allow the use of ordinary
 // function as VMRevision mutator.
 type VMRevisionFunc func(context.Context, *ent.VMRevisionMutation) (ent.Value, error)
@@ -321,6 +501,30 @@ func (f VMRevisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VMRevisionMutation", m)
 }
 
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary
+// function as WebhookDelivery mutator.
+type WebhookDeliveryFunc func(context.Context, *ent.WebhookDeliveryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeliveryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WebhookDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebhookDeliveryMutation", m)
+}
+
+// The WebhookEndpointFunc type is an adapter to allow the use of ordinary
+// function as WebhookEndpoint mutator.
+type WebhookEndpointFunc func(context.Context, *ent.WebhookEndpointMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookEndpointFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WebhookEndpointMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebhookEndpointMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
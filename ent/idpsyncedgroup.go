@@ -33,6 +33,10 @@ type IdPSyncedGroup struct {
 	Description string `json:"description,omitempty"`
 	// LastSyncedAt holds the value of the "last_synced_at" field.
 	LastSyncedAt *time.Time `json:"last_synced_at,omitempty"`
+	// Stale holds the value of the "stale" field.
+	Stale bool `json:"stale,omitempty"`
+	// StaleSince holds the value of the "stale_since" field.
+	StaleSince   *time.Time `json:"stale_since,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -41,9 +45,11 @@ func (*IdPSyncedGroup) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case idpsyncedgroup.FieldStale:
+			values[i] = new(sql.NullBool)
 		case idpsyncedgroup.FieldID, idpsyncedgroup.FieldProviderID, idpsyncedgroup.FieldExternalGroupID, idpsyncedgroup.FieldGroupName, idpsyncedgroup.FieldSourceField, idpsyncedgroup.FieldDescription:
 			values[i] = new(sql.NullString)
-		case idpsyncedgroup.FieldCreatedAt, idpsyncedgroup.FieldUpdatedAt, idpsyncedgroup.FieldLastSyncedAt:
+		case idpsyncedgroup.FieldCreatedAt, idpsyncedgroup.FieldUpdatedAt, idpsyncedgroup.FieldLastSyncedAt, idpsyncedgroup.FieldStaleSince:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -115,6 +121,19 @@ func (_m *IdPSyncedGroup) assignValues(columns []string, values []any) error {
 				_m.LastSyncedAt = new(time.Time)
 				*_m.LastSyncedAt = value.Time
 			}
+		case idpsyncedgroup.FieldStale:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field stale", values[i])
+			} else if value.Valid {
+				_m.Stale = value.Bool
+			}
+		case idpsyncedgroup.FieldStaleSince:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field stale_since", values[i])
+			} else if value.Valid {
+				_m.StaleSince = new(time.Time)
+				*_m.StaleSince = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -176,6 +195,14 @@ func (_m *IdPSyncedGroup) String() string {
 		builder.WriteString("last_synced_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("stale=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Stale))
+	builder.WriteString(", ")
+	if v := _m.StaleSince; v != nil {
+		builder.WriteString("stale_since=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
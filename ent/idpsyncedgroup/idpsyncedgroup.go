@@ -29,6 +29,10 @@ const (
 	FieldDescription = "description"
 	// FieldLastSyncedAt holds the string denoting the last_synced_at field in the database.
 	FieldLastSyncedAt = "last_synced_at"
+	// FieldStale holds the string denoting the stale field in the database.
+	FieldStale = "stale"
+	// FieldStaleSince holds the string denoting the stale_since field in the database.
+	FieldStaleSince = "stale_since"
 	// Table holds the table name of the idpsyncedgroup in the database.
 	Table = "id_psynced_groups"
 )
@@ -44,6 +48,8 @@ var Columns = []string{
 	FieldSourceField,
 	FieldDescription,
 	FieldLastSyncedAt,
+	FieldStale,
+	FieldStaleSince,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -69,6 +75,8 @@ var (
 	ExternalGroupIDValidator func(string) error
 	// GroupNameValidator is a validator for the "group_name" field. It is called by the builders before save.
 	GroupNameValidator func(string) error
+	// DefaultStale holds the default value on creation for the "stale" field.
+	DefaultStale bool
 )
 
 // OrderOption defines the ordering options for the IdPSyncedGroup queries.
@@ -118,3 +126,13 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 func ByLastSyncedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastSyncedAt, opts...).ToFunc()
 }
+
+// ByStale orders the results by the stale field.
+func ByStale(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStale, opts...).ToFunc()
+}
+
+// ByStaleSince orders the results by the stale_since field.
+func ByStaleSince(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStaleSince, opts...).ToFunc()
+}
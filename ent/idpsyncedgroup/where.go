@@ -104,6 +104,16 @@ func LastSyncedAt(v time.Time) predicate.IdPSyncedGroup {
 	return predicate.IdPSyncedGroup(sql.FieldEQ(FieldLastSyncedAt, v))
 }
 
+// Stale applies equality check predicate on the "stale" field. It's identical to StaleEQ.
+func Stale(v bool) predicate.IdPSyncedGroup {
+	return predicate.IdPSyncedGroup(sql.FieldEQ(FieldStale, v))
+}
+
+// StaleSince applies equality check predicate on the "stale_since" field. It's identical to StaleSinceEQ.
+func StaleSince(v time.Time) predicate.IdPSyncedGroup {
+	return predicate.IdPSyncedGroup(sql.FieldEQ(FieldStaleSince, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.IdPSyncedGroup {
 	return predicate.IdPSyncedGroup(sql.FieldEQ(FieldCreatedAt, v))
@@ -579,6 +589,66 @@ func LastSyncedAtNotNil() predicate.IdPSyncedGroup {
 	return predicate.IdPSyncedGroup(sql.FieldNotNull(FieldLastSyncedAt))
 }
 
+// StaleEQ applies the EQ predicate on the "stale" field.
+func StaleEQ(v bool) predicate.IdPSyncedGroup {
+	return predicate.IdPSyncedGroup(sql.FieldEQ(FieldStale, v))
+}
+
+// StaleNEQ applies the NEQ predicate on the "stale" field.
+func StaleNEQ(v bool) predicate.IdPSyncedGroup {
+	return predicate.IdPSyncedGroup(sql.FieldNEQ(FieldStale, v))
+}
+
+// StaleSinceEQ applies the EQ predicate on the "stale_since" field.
+func StaleSinceEQ(v time.Time) predicate.IdPSyncedGroup {
+	return predicate.IdPSyncedGroup(sql.FieldEQ(FieldStaleSince, v))
+}
+
+// StaleSinceNEQ applies the NEQ predicate on the "stale_since" field.
+func StaleSinceNEQ(v time.Time) predicate.IdPSyncedGroup {
+	return predicate.IdPSyncedGroup(sql.FieldNEQ(FieldStaleSince, v))
+}
+
+// StaleSinceIn applies the In predicate on the "stale_since" field.
+func StaleSinceIn(vs ...time.Time) predicate.IdPSyncedGroup {
+	return predicate.IdPSyncedGroup(sql.FieldIn(FieldStaleSince, vs...))
+}
+
+// StaleSinceNotIn applies the NotIn predicate on the "stale_since" field.
+func StaleSinceNotIn(vs ...time.Time) predicate.IdPSyncedGroup {
+	return predicate.IdPSyncedGroup(sql.FieldNotIn(FieldStaleSince, vs...))
+}
+
+// StaleSinceGT applies the GT predicate on the "stale_since" field.
+func StaleSinceGT(v time.Time) predicate.IdPSyncedGroup {
+	return predicate.IdPSyncedGroup(sql.FieldGT(FieldStaleSince, v))
+}
+
+// StaleSinceGTE applies the GTE predicate on the "stale_since" field.
+func StaleSinceGTE(v time.Time) predicate.IdPSyncedGroup {
+	return predicate.IdPSyncedGroup(sql.FieldGTE(FieldStaleSince, v))
+}
+
+// StaleSinceLT applies the LT predicate on the "stale_since" field.
+func StaleSinceLT(v time.Time) predicate.IdPSyncedGroup {
+	return predicate.IdPSyncedGroup(sql.FieldLT(FieldStaleSince, v))
+}
+
+// StaleSinceLTE applies the LTE predicate on the "stale_since" field.
+func StaleSinceLTE(v time.Time) predicate.IdPSyncedGroup {
+	return predicate.IdPSyncedGroup(sql.FieldLTE(FieldStaleSince, v))
+}
+
+// StaleSinceIsNil applies the IsNil predicate on the "stale_since" field.
+func StaleSinceIsNil() predicate.IdPSyncedGroup {
+	return predicate.IdPSyncedGroup(sql.FieldIsNull(FieldStaleSince))
+}
+
+// StaleSinceNotNil applies the NotNil predicate on the "stale_since" field.
+func StaleSinceNotNil() predicate.IdPSyncedGroup {
+	return predicate.IdPSyncedGroup(sql.FieldNotNull(FieldStaleSince))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.IdPSyncedGroup) predicate.IdPSyncedGroup {
 	return predicate.IdPSyncedGroup(sql.AndPredicates(predicates...))
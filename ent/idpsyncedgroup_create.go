@@ -108,6 +108,34 @@ func (_c *IdPSyncedGroupCreate) SetNillableLastSyncedAt(v *time.Time) *IdPSynced
 	return _c
 }
 
+// SetStale sets the "stale" field.
+func (_c *IdPSyncedGroupCreate) SetStale(v bool) *IdPSyncedGroupCreate {
+	_c.mutation.SetStale(v)
+	return _c
+}
+
+// SetNillableStale sets the "stale" field if the given value is not nil.
+func (_c *IdPSyncedGroupCreate) SetNillableStale(v *bool) *IdPSyncedGroupCreate {
+	if v != nil {
+		_c.SetStale(*v)
+	}
+	return _c
+}
+
+// SetStaleSince sets the "stale_since" field.
+func (_c *IdPSyncedGroupCreate) SetStaleSince(v time.Time) *IdPSyncedGroupCreate {
+	_c.mutation.SetStaleSince(v)
+	return _c
+}
+
+// SetNillableStaleSince sets the "stale_since" field if the given value is not nil.
+func (_c *IdPSyncedGroupCreate) SetNillableStaleSince(v *time.Time) *IdPSyncedGroupCreate {
+	if v != nil {
+		_c.SetStaleSince(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *IdPSyncedGroupCreate) SetID(v string) *IdPSyncedGroupCreate {
 	_c.mutation.SetID(v)
@@ -157,6 +185,10 @@ func (_c *IdPSyncedGroupCreate) defaults() {
 		v := idpsyncedgroup.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.Stale(); !ok {
+		v := idpsyncedgroup.DefaultStale
+		_c.mutation.SetStale(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -191,6 +223,9 @@ func (_c *IdPSyncedGroupCreate) check() error {
 			return &ValidationError{Name: "group_name", err: fmt.Errorf(`ent: validator failed for field "IdPSyncedGroup.group_name": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Stale(); !ok {
+		return &ValidationError{Name: "stale", err: errors.New(`ent: missing required field "IdPSyncedGroup.stale"`)}
+	}
 	return nil
 }
 
@@ -258,6 +293,14 @@ func (_c *IdPSyncedGroupCreate) createSpec() (*IdPSyncedGroup, *sqlgraph.CreateS
 		_spec.SetField(idpsyncedgroup.FieldLastSyncedAt, field.TypeTime, value)
 		_node.LastSyncedAt = &value
 	}
+	if value, ok := _c.mutation.Stale(); ok {
+		_spec.SetField(idpsyncedgroup.FieldStale, field.TypeBool, value)
+		_node.Stale = value
+	}
+	if value, ok := _c.mutation.StaleSince(); ok {
+		_spec.SetField(idpsyncedgroup.FieldStaleSince, field.TypeTime, value)
+		_node.StaleSince = &value
+	}
 	return _node, _spec
 }
 
@@ -136,6 +136,40 @@ func (_u *IdPSyncedGroupUpdate) ClearLastSyncedAt() *IdPSyncedGroupUpdate {
 	return _u
 }
 
+// SetStale sets the "stale" field.
+func (_u *IdPSyncedGroupUpdate) SetStale(v bool) *IdPSyncedGroupUpdate {
+	_u.mutation.SetStale(v)
+	return _u
+}
+
+// SetNillableStale sets the "stale" field if the given value is not nil.
+func (_u *IdPSyncedGroupUpdate) SetNillableStale(v *bool) *IdPSyncedGroupUpdate {
+	if v != nil {
+		_u.SetStale(*v)
+	}
+	return _u
+}
+
+// SetStaleSince sets the "stale_since" field.
+func (_u *IdPSyncedGroupUpdate) SetStaleSince(v time.Time) *IdPSyncedGroupUpdate {
+	_u.mutation.SetStaleSince(v)
+	return _u
+}
+
+// SetNillableStaleSince sets the "stale_since" field if the given value is not nil.
+func (_u *IdPSyncedGroupUpdate) SetNillableStaleSince(v *time.Time) *IdPSyncedGroupUpdate {
+	if v != nil {
+		_u.SetStaleSince(*v)
+	}
+	return _u
+}
+
+// ClearStaleSince clears the value of the "stale_since" field.
+func (_u *IdPSyncedGroupUpdate) ClearStaleSince() *IdPSyncedGroupUpdate {
+	_u.mutation.ClearStaleSince()
+	return _u
+}
+
 // Mutation returns the IdPSyncedGroupMutation object of the builder.
 func (_u *IdPSyncedGroupUpdate) Mutation() *IdPSyncedGroupMutation {
 	return _u.mutation
@@ -239,6 +273,15 @@ func (_u *IdPSyncedGroupUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if _u.mutation.LastSyncedAtCleared() {
 		_spec.ClearField(idpsyncedgroup.FieldLastSyncedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.Stale(); ok {
+		_spec.SetField(idpsyncedgroup.FieldStale, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.StaleSince(); ok {
+		_spec.SetField(idpsyncedgroup.FieldStaleSince, field.TypeTime, value)
+	}
+	if _u.mutation.StaleSinceCleared() {
+		_spec.ClearField(idpsyncedgroup.FieldStaleSince, field.TypeTime)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{idpsyncedgroup.Label}
@@ -367,6 +410,40 @@ func (_u *IdPSyncedGroupUpdateOne) ClearLastSyncedAt() *IdPSyncedGroupUpdateOne
 	return _u
 }
 
+// SetStale sets the "stale" field.
+func (_u *IdPSyncedGroupUpdateOne) SetStale(v bool) *IdPSyncedGroupUpdateOne {
+	_u.mutation.SetStale(v)
+	return _u
+}
+
+// SetNillableStale sets the "stale" field if the given value is not nil.
+func (_u *IdPSyncedGroupUpdateOne) SetNillableStale(v *bool) *IdPSyncedGroupUpdateOne {
+	if v != nil {
+		_u.SetStale(*v)
+	}
+	return _u
+}
+
+// SetStaleSince sets the "stale_since" field.
+func (_u *IdPSyncedGroupUpdateOne) SetStaleSince(v time.Time) *IdPSyncedGroupUpdateOne {
+	_u.mutation.SetStaleSince(v)
+	return _u
+}
+
+// SetNillableStaleSince sets the "stale_since" field if the given value is not nil.
+func (_u *IdPSyncedGroupUpdateOne) SetNillableStaleSince(v *time.Time) *IdPSyncedGroupUpdateOne {
+	if v != nil {
+		_u.SetStaleSince(*v)
+	}
+	return _u
+}
+
+// ClearStaleSince clears the value of the "stale_since" field.
+func (_u *IdPSyncedGroupUpdateOne) ClearStaleSince() *IdPSyncedGroupUpdateOne {
+	_u.mutation.ClearStaleSince()
+	return _u
+}
+
 // Mutation returns the IdPSyncedGroupMutation object of the builder.
 func (_u *IdPSyncedGroupUpdateOne) Mutation() *IdPSyncedGroupMutation {
 	return _u.mutation
@@ -500,6 +577,15 @@ func (_u *IdPSyncedGroupUpdateOne) sqlSave(ctx context.Context) (_node *IdPSynce
 	if _u.mutation.LastSyncedAtCleared() {
 		_spec.ClearField(idpsyncedgroup.FieldLastSyncedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.Stale(); ok {
+		_spec.SetField(idpsyncedgroup.FieldStale, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.StaleSince(); ok {
+		_spec.SetField(idpsyncedgroup.FieldStaleSince, field.TypeTime, value)
+	}
+	if _u.mutation.StaleSinceCleared() {
+		_spec.ClearField(idpsyncedgroup.FieldStaleSince, field.TypeTime)
+	}
 	_node = &IdPSyncedGroup{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
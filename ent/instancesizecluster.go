@@ -0,0 +1,148 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"kv-shepherd.io/shepherd/ent/instancesizecluster"
+)
+
+// InstanceSizeCluster is the model entity for the InstanceSizeCluster schema.
+type InstanceSizeCluster struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// InstanceSizeID holds the value of the "instance_size_id" field.
+	InstanceSizeID string `json:"instance_size_id,omitempty"`
+	// ClusterID holds the value of the "cluster_id" field.
+	ClusterID string `json:"cluster_id,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy    string `json:"created_by,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InstanceSizeCluster) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case instancesizecluster.FieldID, instancesizecluster.FieldInstanceSizeID, instancesizecluster.FieldClusterID, instancesizecluster.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case instancesizecluster.FieldCreatedAt, instancesizecluster.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InstanceSizeCluster fields.
+func (_m *InstanceSizeCluster) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case instancesizecluster.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case instancesizecluster.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case instancesizecluster.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case instancesizecluster.FieldInstanceSizeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field instance_size_id", values[i])
+			} else if value.Valid {
+				_m.InstanceSizeID = value.String
+			}
+		case instancesizecluster.FieldClusterID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster_id", values[i])
+			} else if value.Valid {
+				_m.ClusterID = value.String
+			}
+		case instancesizecluster.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InstanceSizeCluster.
+// This includes values selected through modifiers, order, etc.
+func (_m *InstanceSizeCluster) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this InstanceSizeCluster.
+// Note that you need to call InstanceSizeCluster.Unwrap() before calling this method if this InstanceSizeCluster
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *InstanceSizeCluster) Update() *InstanceSizeClusterUpdateOne {
+	return NewInstanceSizeClusterClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the InstanceSizeCluster entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *InstanceSizeCluster) Unwrap() *InstanceSizeCluster {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InstanceSizeCluster is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *InstanceSizeCluster) String() string {
+	var builder strings.Builder
+	builder.WriteString("InstanceSizeCluster(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("instance_size_id=")
+	builder.WriteString(_m.InstanceSizeID)
+	builder.WriteString(", ")
+	builder.WriteString("cluster_id=")
+	builder.WriteString(_m.ClusterID)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InstanceSizeClusters is a parsable slice of InstanceSizeCluster.
+type InstanceSizeClusters []*InstanceSizeCluster
@@ -0,0 +1,380 @@
+// Code generated by ent, DO NOT EDIT.
+
+package instancesizecluster
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"kv-shepherd.io/shepherd/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// InstanceSizeID applies equality check predicate on the "instance_size_id" field. It's identical to InstanceSizeIDEQ.
+func InstanceSizeID(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldEQ(FieldInstanceSizeID, v))
+}
+
+// ClusterID applies equality check predicate on the "cluster_id" field. It's identical to ClusterIDEQ.
+func ClusterID(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldEQ(FieldClusterID, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// InstanceSizeIDEQ applies the EQ predicate on the "instance_size_id" field.
+func InstanceSizeIDEQ(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldEQ(FieldInstanceSizeID, v))
+}
+
+// InstanceSizeIDNEQ applies the NEQ predicate on the "instance_size_id" field.
+func InstanceSizeIDNEQ(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldNEQ(FieldInstanceSizeID, v))
+}
+
+// InstanceSizeIDIn applies the In predicate on the "instance_size_id" field.
+func InstanceSizeIDIn(vs ...string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldIn(FieldInstanceSizeID, vs...))
+}
+
+// InstanceSizeIDNotIn applies the NotIn predicate on the "instance_size_id" field.
+func InstanceSizeIDNotIn(vs ...string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldNotIn(FieldInstanceSizeID, vs...))
+}
+
+// InstanceSizeIDGT applies the GT predicate on the "instance_size_id" field.
+func InstanceSizeIDGT(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldGT(FieldInstanceSizeID, v))
+}
+
+// InstanceSizeIDGTE applies the GTE predicate on the "instance_size_id" field.
+func InstanceSizeIDGTE(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldGTE(FieldInstanceSizeID, v))
+}
+
+// InstanceSizeIDLT applies the LT predicate on the "instance_size_id" field.
+func InstanceSizeIDLT(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldLT(FieldInstanceSizeID, v))
+}
+
+// InstanceSizeIDLTE applies the LTE predicate on the "instance_size_id" field.
+func InstanceSizeIDLTE(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldLTE(FieldInstanceSizeID, v))
+}
+
+// InstanceSizeIDContains applies the Contains predicate on the "instance_size_id" field.
+func InstanceSizeIDContains(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldContains(FieldInstanceSizeID, v))
+}
+
+// InstanceSizeIDHasPrefix applies the HasPrefix predicate on the "instance_size_id" field.
+func InstanceSizeIDHasPrefix(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldHasPrefix(FieldInstanceSizeID, v))
+}
+
+// InstanceSizeIDHasSuffix applies the HasSuffix predicate on the "instance_size_id" field.
+func InstanceSizeIDHasSuffix(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldHasSuffix(FieldInstanceSizeID, v))
+}
+
+// InstanceSizeIDEqualFold applies the EqualFold predicate on the "instance_size_id" field.
+func InstanceSizeIDEqualFold(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldEqualFold(FieldInstanceSizeID, v))
+}
+
+// InstanceSizeIDContainsFold applies the ContainsFold predicate on the "instance_size_id" field.
+func InstanceSizeIDContainsFold(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldContainsFold(FieldInstanceSizeID, v))
+}
+
+// ClusterIDEQ applies the EQ predicate on the "cluster_id" field.
+func ClusterIDEQ(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldEQ(FieldClusterID, v))
+}
+
+// ClusterIDNEQ applies the NEQ predicate on the "cluster_id" field.
+func ClusterIDNEQ(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldNEQ(FieldClusterID, v))
+}
+
+// ClusterIDIn applies the In predicate on the "cluster_id" field.
+func ClusterIDIn(vs ...string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldIn(FieldClusterID, vs...))
+}
+
+// ClusterIDNotIn applies the NotIn predicate on the "cluster_id" field.
+func ClusterIDNotIn(vs ...string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldNotIn(FieldClusterID, vs...))
+}
+
+// ClusterIDGT applies the GT predicate on the "cluster_id" field.
+func ClusterIDGT(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldGT(FieldClusterID, v))
+}
+
+// ClusterIDGTE applies the GTE predicate on the "cluster_id" field.
+func ClusterIDGTE(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldGTE(FieldClusterID, v))
+}
+
+// ClusterIDLT applies the LT predicate on the "cluster_id" field.
+func ClusterIDLT(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldLT(FieldClusterID, v))
+}
+
+// ClusterIDLTE applies the LTE predicate on the "cluster_id" field.
+func ClusterIDLTE(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldLTE(FieldClusterID, v))
+}
+
+// ClusterIDContains applies the Contains predicate on the "cluster_id" field.
+func ClusterIDContains(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldContains(FieldClusterID, v))
+}
+
+// ClusterIDHasPrefix applies the HasPrefix predicate on the "cluster_id" field.
+func ClusterIDHasPrefix(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldHasPrefix(FieldClusterID, v))
+}
+
+// ClusterIDHasSuffix applies the HasSuffix predicate on the "cluster_id" field.
+func ClusterIDHasSuffix(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldHasSuffix(FieldClusterID, v))
+}
+
+// ClusterIDEqualFold applies the EqualFold predicate on the "cluster_id" field.
+func ClusterIDEqualFold(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldEqualFold(FieldClusterID, v))
+}
+
+// ClusterIDContainsFold applies the ContainsFold predicate on the "cluster_id" field.
+func ClusterIDContainsFold(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldContainsFold(FieldClusterID, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InstanceSizeCluster) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InstanceSizeCluster) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InstanceSizeCluster) predicate.InstanceSizeCluster {
+	return predicate.InstanceSizeCluster(sql.NotPredicates(p))
+}
@@ -0,0 +1,292 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"kv-shepherd.io/shepherd/ent/instancesizecluster"
+)
+
+// InstanceSizeClusterCreate is the builder for creating a InstanceSizeCluster entity.
+type InstanceSizeClusterCreate struct {
+	config
+	mutation *InstanceSizeClusterMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *InstanceSizeClusterCreate) SetCreatedAt(v time.Time) *InstanceSizeClusterCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *InstanceSizeClusterCreate) SetNillableCreatedAt(v *time.Time) *InstanceSizeClusterCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *InstanceSizeClusterCreate) SetUpdatedAt(v time.Time) *InstanceSizeClusterCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *InstanceSizeClusterCreate) SetNillableUpdatedAt(v *time.Time) *InstanceSizeClusterCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetInstanceSizeID sets the "instance_size_id" field.
+func (_c *InstanceSizeClusterCreate) SetInstanceSizeID(v string) *InstanceSizeClusterCreate {
+	_c.mutation.SetInstanceSizeID(v)
+	return _c
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (_c *InstanceSizeClusterCreate) SetClusterID(v string) *InstanceSizeClusterCreate {
+	_c.mutation.SetClusterID(v)
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *InstanceSizeClusterCreate) SetCreatedBy(v string) *InstanceSizeClusterCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *InstanceSizeClusterCreate) SetID(v string) *InstanceSizeClusterCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the InstanceSizeClusterMutation object of the builder.
+func (_c *InstanceSizeClusterCreate) Mutation() *InstanceSizeClusterMutation {
+	return _c.mutation
+}
+
+// Save creates the InstanceSizeCluster in the database.
+func (_c *InstanceSizeClusterCreate) Save(ctx context.Context) (*InstanceSizeCluster, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InstanceSizeClusterCreate) SaveX(ctx context.Context) *InstanceSizeCluster {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InstanceSizeClusterCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InstanceSizeClusterCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InstanceSizeClusterCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := instancesizecluster.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := instancesizecluster.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InstanceSizeClusterCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InstanceSizeCluster.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "InstanceSizeCluster.updated_at"`)}
+	}
+	if _, ok := _c.mutation.InstanceSizeID(); !ok {
+		return &ValidationError{Name: "instance_size_id", err: errors.New(`ent: missing required field "InstanceSizeCluster.instance_size_id"`)}
+	}
+	if v, ok := _c.mutation.InstanceSizeID(); ok {
+		if err := instancesizecluster.InstanceSizeIDValidator(v); err != nil {
+			return &ValidationError{Name: "instance_size_id", err: fmt.Errorf(`ent: validator failed for field "InstanceSizeCluster.instance_size_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClusterID(); !ok {
+		return &ValidationError{Name: "cluster_id", err: errors.New(`ent: missing required field "InstanceSizeCluster.cluster_id"`)}
+	}
+	if v, ok := _c.mutation.ClusterID(); ok {
+		if err := instancesizecluster.ClusterIDValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_id", err: fmt.Errorf(`ent: validator failed for field "InstanceSizeCluster.cluster_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "InstanceSizeCluster.created_by"`)}
+	}
+	if v, ok := _c.mutation.CreatedBy(); ok {
+		if err := instancesizecluster.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "InstanceSizeCluster.created_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *InstanceSizeClusterCreate) sqlSave(ctx context.Context) (*InstanceSizeCluster, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected InstanceSizeCluster.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InstanceSizeClusterCreate) createSpec() (*InstanceSizeCluster, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InstanceSizeCluster{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(instancesizecluster.Table, sqlgraph.NewFieldSpec(instancesizecluster.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(instancesizecluster.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(instancesizecluster.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.InstanceSizeID(); ok {
+		_spec.SetField(instancesizecluster.FieldInstanceSizeID, field.TypeString, value)
+		_node.InstanceSizeID = value
+	}
+	if value, ok := _c.mutation.ClusterID(); ok {
+		_spec.SetField(instancesizecluster.FieldClusterID, field.TypeString, value)
+		_node.ClusterID = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(instancesizecluster.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	return _node, _spec
+}
+
+// InstanceSizeClusterCreateBulk is the builder for creating many InstanceSizeCluster entities in bulk.
+type InstanceSizeClusterCreateBulk struct {
+	config
+	err      error
+	builders []*InstanceSizeClusterCreate
+}
+
+// Save creates the InstanceSizeCluster entities in the database.
+func (_c *InstanceSizeClusterCreateBulk) Save(ctx context.Context) ([]*InstanceSizeCluster, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InstanceSizeCluster, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InstanceSizeClusterMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InstanceSizeClusterCreateBulk) SaveX(ctx context.Context) []*InstanceSizeCluster {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InstanceSizeClusterCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InstanceSizeClusterCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"kv-shepherd.io/shepherd/ent/instancesizecluster"
+	"kv-shepherd.io/shepherd/ent/predicate"
+)
+
+// InstanceSizeClusterDelete is the builder for deleting a InstanceSizeCluster entity.
+type InstanceSizeClusterDelete struct {
+	config
+	hooks    []Hook
+	mutation *InstanceSizeClusterMutation
+}
+
+// Where appends a list predicates to the InstanceSizeClusterDelete builder.
+func (_d *InstanceSizeClusterDelete) Where(ps ...predicate.InstanceSizeCluster) *InstanceSizeClusterDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *InstanceSizeClusterDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *InstanceSizeClusterDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *InstanceSizeClusterDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(instancesizecluster.Table, sqlgraph.NewFieldSpec(instancesizecluster.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// InstanceSizeClusterDeleteOne is the builder for deleting a single InstanceSizeCluster entity.
+type InstanceSizeClusterDeleteOne struct {
+	_d *InstanceSizeClusterDelete
+}
+
+// Where appends a list predicates to the InstanceSizeClusterDelete builder.
+func (_d *InstanceSizeClusterDeleteOne) Where(ps ...predicate.InstanceSizeCluster) *InstanceSizeClusterDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *InstanceSizeClusterDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{instancesizecluster.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *InstanceSizeClusterDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
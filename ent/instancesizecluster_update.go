@@ -0,0 +1,272 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"kv-shepherd.io/shepherd/ent/instancesizecluster"
+	"kv-shepherd.io/shepherd/ent/predicate"
+)
+
+// InstanceSizeClusterUpdate is the builder for updating InstanceSizeCluster entities.
+type InstanceSizeClusterUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InstanceSizeClusterMutation
+}
+
+// Where appends a list predicates to the InstanceSizeClusterUpdate builder.
+func (_u *InstanceSizeClusterUpdate) Where(ps ...predicate.InstanceSizeCluster) *InstanceSizeClusterUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *InstanceSizeClusterUpdate) SetUpdatedAt(v time.Time) *InstanceSizeClusterUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *InstanceSizeClusterUpdate) SetCreatedBy(v string) *InstanceSizeClusterUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *InstanceSizeClusterUpdate) SetNillableCreatedBy(v *string) *InstanceSizeClusterUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// Mutation returns the InstanceSizeClusterMutation object of the builder.
+func (_u *InstanceSizeClusterUpdate) Mutation() *InstanceSizeClusterMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InstanceSizeClusterUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InstanceSizeClusterUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InstanceSizeClusterUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InstanceSizeClusterUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *InstanceSizeClusterUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := instancesizecluster.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InstanceSizeClusterUpdate) check() error {
+	if v, ok := _u.mutation.CreatedBy(); ok {
+		if err := instancesizecluster.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "InstanceSizeCluster.created_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InstanceSizeClusterUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(instancesizecluster.Table, instancesizecluster.Columns, sqlgraph.NewFieldSpec(instancesizecluster.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(instancesizecluster.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(instancesizecluster.FieldCreatedBy, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{instancesizecluster.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InstanceSizeClusterUpdateOne is the builder for updating a single InstanceSizeCluster entity.
+type InstanceSizeClusterUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InstanceSizeClusterMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *InstanceSizeClusterUpdateOne) SetUpdatedAt(v time.Time) *InstanceSizeClusterUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *InstanceSizeClusterUpdateOne) SetCreatedBy(v string) *InstanceSizeClusterUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *InstanceSizeClusterUpdateOne) SetNillableCreatedBy(v *string) *InstanceSizeClusterUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// Mutation returns the InstanceSizeClusterMutation object of the builder.
+func (_u *InstanceSizeClusterUpdateOne) Mutation() *InstanceSizeClusterMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the InstanceSizeClusterUpdate builder.
+func (_u *InstanceSizeClusterUpdateOne) Where(ps ...predicate.InstanceSizeCluster) *InstanceSizeClusterUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InstanceSizeClusterUpdateOne) Select(field string, fields ...string) *InstanceSizeClusterUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated InstanceSizeCluster entity.
+func (_u *InstanceSizeClusterUpdateOne) Save(ctx context.Context) (*InstanceSizeCluster, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InstanceSizeClusterUpdateOne) SaveX(ctx context.Context) *InstanceSizeCluster {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InstanceSizeClusterUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InstanceSizeClusterUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *InstanceSizeClusterUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := instancesizecluster.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InstanceSizeClusterUpdateOne) check() error {
+	if v, ok := _u.mutation.CreatedBy(); ok {
+		if err := instancesizecluster.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "InstanceSizeCluster.created_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InstanceSizeClusterUpdateOne) sqlSave(ctx context.Context) (_node *InstanceSizeCluster, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(instancesizecluster.Table, instancesizecluster.Columns, sqlgraph.NewFieldSpec(instancesizecluster.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InstanceSizeCluster.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, instancesizecluster.FieldID)
+		for _, f := range fields {
+			if !instancesizecluster.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != instancesizecluster.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(instancesizecluster.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(instancesizecluster.FieldCreatedBy, field.TypeString, value)
+	}
+	_node = &InstanceSizeCluster{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{instancesizecluster.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,328 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"kv-shepherd.io/shepherd/ent/loginsession"
+	"kv-shepherd.io/shepherd/ent/predicate"
+)
+
+// LoginSessionUpdate is the builder for updating LoginSession entities.
+type LoginSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoginSessionMutation
+}
+
+// Where appends a list predicates to the LoginSessionUpdate builder.
+func (_u *LoginSessionUpdate) Where(ps ...predicate.LoginSession) *LoginSessionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LoginSessionUpdate) SetUpdatedAt(v time.Time) *LoginSessionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *LoginSessionUpdate) SetRevokedAt(v time.Time) *LoginSessionUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *LoginSessionUpdate) SetNillableRevokedAt(v *time.Time) *LoginSessionUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *LoginSessionUpdate) ClearRevokedAt() *LoginSessionUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (_u *LoginSessionUpdate) SetRevokedBy(v string) *LoginSessionUpdate {
+	_u.mutation.SetRevokedBy(v)
+	return _u
+}
+
+// SetNillableRevokedBy sets the "revoked_by" field if the given value is not nil.
+func (_u *LoginSessionUpdate) SetNillableRevokedBy(v *string) *LoginSessionUpdate {
+	if v != nil {
+		_u.SetRevokedBy(*v)
+	}
+	return _u
+}
+
+// ClearRevokedBy clears the value of the "revoked_by" field.
+func (_u *LoginSessionUpdate) ClearRevokedBy() *LoginSessionUpdate {
+	_u.mutation.ClearRevokedBy()
+	return _u
+}
+
+// Mutation returns the LoginSessionMutation object of the builder.
+func (_u *LoginSessionUpdate) Mutation() *LoginSessionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LoginSessionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LoginSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LoginSessionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LoginSessionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LoginSessionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := loginsession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *LoginSessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(loginsession.Table, loginsession.Columns, sqlgraph.NewFieldSpec(loginsession.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(loginsession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(loginsession.FieldUserAgent, field.TypeString)
+	}
+	if _u.mutation.IPAddressCleared() {
+		_spec.ClearField(loginsession.FieldIPAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(loginsession.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(loginsession.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedBy(); ok {
+		_spec.SetField(loginsession.FieldRevokedBy, field.TypeString, value)
+	}
+	if _u.mutation.RevokedByCleared() {
+		_spec.ClearField(loginsession.FieldRevokedBy, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LoginSessionUpdateOne is the builder for updating a single LoginSession entity.
+type LoginSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoginSessionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LoginSessionUpdateOne) SetUpdatedAt(v time.Time) *LoginSessionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *LoginSessionUpdateOne) SetRevokedAt(v time.Time) *LoginSessionUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *LoginSessionUpdateOne) SetNillableRevokedAt(v *time.Time) *LoginSessionUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *LoginSessionUpdateOne) ClearRevokedAt() *LoginSessionUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (_u *LoginSessionUpdateOne) SetRevokedBy(v string) *LoginSessionUpdateOne {
+	_u.mutation.SetRevokedBy(v)
+	return _u
+}
+
+// SetNillableRevokedBy sets the "revoked_by" field if the given value is not nil.
+func (_u *LoginSessionUpdateOne) SetNillableRevokedBy(v *string) *LoginSessionUpdateOne {
+	if v != nil {
+		_u.SetRevokedBy(*v)
+	}
+	return _u
+}
+
+// ClearRevokedBy clears the value of the "revoked_by" field.
+func (_u *LoginSessionUpdateOne) ClearRevokedBy() *LoginSessionUpdateOne {
+	_u.mutation.ClearRevokedBy()
+	return _u
+}
+
+// Mutation returns the LoginSessionMutation object of the builder.
+func (_u *LoginSessionUpdateOne) Mutation() *LoginSessionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LoginSessionUpdate builder.
+func (_u *LoginSessionUpdateOne) Where(ps ...predicate.LoginSession) *LoginSessionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LoginSessionUpdateOne) Select(field string, fields ...string) *LoginSessionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LoginSession entity.
+func (_u *LoginSessionUpdateOne) Save(ctx context.Context) (*LoginSession, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LoginSessionUpdateOne) SaveX(ctx context.Context) *LoginSession {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LoginSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LoginSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LoginSessionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := loginsession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *LoginSessionUpdateOne) sqlSave(ctx context.Context) (_node *LoginSession, err error) {
+	_spec := sqlgraph.NewUpdateSpec(loginsession.Table, loginsession.Columns, sqlgraph.NewFieldSpec(loginsession.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LoginSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginsession.FieldID)
+		for _, f := range fields {
+			if !loginsession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loginsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(loginsession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(loginsession.FieldUserAgent, field.TypeString)
+	}
+	if _u.mutation.IPAddressCleared() {
+		_spec.ClearField(loginsession.FieldIPAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(loginsession.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(loginsession.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedBy(); ok {
+		_spec.SetField(loginsession.FieldRevokedBy, field.TypeString, value)
+	}
+	if _u.mutation.RevokedByCleared() {
+		_spec.ClearField(loginsession.FieldRevokedBy, field.TypeString)
+	}
+	_node = &LoginSession{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
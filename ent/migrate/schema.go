@@ -3,11 +3,35 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// ApprovalDecisionsColumns holds the columns for the "approval_decisions" table.
+	ApprovalDecisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "ticket_id", Type: field.TypeString},
+		{Name: "approver", Type: field.TypeString},
+		{Name: "decision", Type: field.TypeEnum, Enums: []string{"APPROVED", "REJECTED"}},
+		{Name: "selected_cluster_id", Type: field.TypeString, Nullable: true},
+		{Name: "selected_storage_class", Type: field.TypeString, Nullable: true},
+	}
+	// ApprovalDecisionsTable holds the schema information for the "approval_decisions" table.
+	ApprovalDecisionsTable = &schema.Table{
+		Name:       "approval_decisions",
+		Columns:    ApprovalDecisionsColumns,
+		PrimaryKey: []*schema.Column{ApprovalDecisionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "approvaldecision_ticket_id_approver",
+				Unique:  true,
+				Columns: []*schema.Column{ApprovalDecisionsColumns[2], ApprovalDecisionsColumns[3]},
+			},
+		},
+	}
 	// ApprovalPoliciesColumns holds the columns for the "approval_policies" table.
 	ApprovalPoliciesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -39,12 +63,14 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "event_id", Type: field.TypeString},
-		{Name: "operation_type", Type: field.TypeEnum, Enums: []string{"CREATE", "DELETE", "VNC_ACCESS"}, Default: "CREATE"},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING", "APPROVED", "REJECTED", "CANCELLED", "EXECUTING", "SUCCESS", "FAILED"}, Default: "PENDING"},
+		{Name: "operation_type", Type: field.TypeEnum, Enums: []string{"CREATE", "DELETE", "VNC_ACCESS", "MIGRATE", "RESIZE", "SNAPSHOT", "NAMESPACE_MIGRATE"}, Default: "CREATE"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING", "APPROVED", "REJECTED", "CANCELLED", "EXECUTING", "SUCCESS", "FAILED", "EXPIRED"}, Default: "PENDING"},
 		{Name: "requester", Type: field.TypeString},
 		{Name: "approver", Type: field.TypeString, Nullable: true},
 		{Name: "reason", Type: field.TypeString, Nullable: true},
 		{Name: "reject_reason", Type: field.TypeString, Nullable: true},
+		{Name: "approval_comment", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "assigned_approver", Type: field.TypeString, Nullable: true},
 		{Name: "selected_cluster_id", Type: field.TypeString, Nullable: true},
 		{Name: "selected_template_version", Type: field.TypeInt, Nullable: true},
 		{Name: "selected_storage_class", Type: field.TypeString, Nullable: true},
@@ -52,6 +78,12 @@ var (
 		{Name: "instance_size_snapshot", Type: field.TypeJSON, Nullable: true},
 		{Name: "modified_spec", Type: field.TypeJSON, Nullable: true},
 		{Name: "parent_ticket_id", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "required_approvals", Type: field.TypeInt, Default: 1},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "attempt_count", Type: field.TypeInt, Default: 0},
+		{Name: "request_id", Type: field.TypeString, Nullable: true, Size: 128},
 	}
 	// ApprovalTicketsTable holds the schema information for the "approval_tickets" table.
 	ApprovalTicketsTable = &schema.Table{
@@ -60,9 +92,9 @@ var (
 		PrimaryKey: []*schema.Column{ApprovalTicketsColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "approvalticket_status",
+				Name:    "approvalticket_status_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{ApprovalTicketsColumns[5]},
+				Columns: []*schema.Column{ApprovalTicketsColumns[5], ApprovalTicketsColumns[1]},
 			},
 			{
 				Name:    "approvalticket_requester",
@@ -77,7 +109,20 @@ var (
 			{
 				Name:    "approvalticket_parent_ticket_id",
 				Unique:  false,
-				Columns: []*schema.Column{ApprovalTicketsColumns[16]},
+				Columns: []*schema.Column{ApprovalTicketsColumns[18]},
+			},
+			{
+				Name:    "approvalticket_assigned_approver",
+				Unique:  false,
+				Columns: []*schema.Column{ApprovalTicketsColumns[11]},
+			},
+			{
+				Name:    "approvalticket_requester_request_id",
+				Unique:  true,
+				Columns: []*schema.Column{ApprovalTicketsColumns[6], ApprovalTicketsColumns[24]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "request_id IS NOT NULL AND status IN ('PENDING', 'APPROVED', 'EXECUTING')",
+				},
 			},
 		},
 	}
@@ -99,14 +144,14 @@ var (
 		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "auditlog_resource_type_resource_id",
+				Name:    "auditlog_resource_type_resource_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{AuditLogsColumns[3], AuditLogsColumns[4]},
+				Columns: []*schema.Column{AuditLogsColumns[3], AuditLogsColumns[4], AuditLogsColumns[1]},
 			},
 			{
-				Name:    "auditlog_actor",
+				Name:    "auditlog_actor_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{AuditLogsColumns[5]},
+				Columns: []*schema.Column{AuditLogsColumns[5], AuditLogsColumns[1]},
 			},
 			{
 				Name:    "auditlog_created_at",
@@ -126,6 +171,15 @@ var (
 		{Name: "enabled", Type: field.TypeBool, Default: true},
 		{Name: "sort_order", Type: field.TypeInt, Default: 0},
 		{Name: "created_by", Type: field.TypeString},
+		{Name: "sync_schedule", Type: field.TypeString, Nullable: true},
+		{Name: "last_sync_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_sync_added", Type: field.TypeInt, Default: 0},
+		{Name: "last_sync_updated", Type: field.TypeInt, Default: 0},
+		{Name: "last_sync_stale", Type: field.TypeInt, Default: 0},
+		{Name: "last_sync_duration_ms", Type: field.TypeInt64, Default: 0},
+		{Name: "last_sync_error", Type: field.TypeString, Nullable: true},
+		{Name: "sync_failures", Type: field.TypeInt, Default: 0},
+		{Name: "sync_retry_at", Type: field.TypeTime, Nullable: true},
 	}
 	// AuthProvidersTable holds the schema information for the "auth_providers" table.
 	AuthProvidersTable = &schema.Table{
@@ -155,7 +209,7 @@ var (
 		{Name: "success_count", Type: field.TypeInt, Default: 0},
 		{Name: "failed_count", Type: field.TypeInt, Default: 0},
 		{Name: "pending_count", Type: field.TypeInt, Default: 0},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING_APPROVAL", "IN_PROGRESS", "COMPLETED", "PARTIAL_SUCCESS", "FAILED", "CANCELLED"}, Default: "PENDING_APPROVAL"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING_APPROVAL", "PARTIAL_APPROVED", "IN_PROGRESS", "COMPLETED", "PARTIAL_SUCCESS", "FAILED", "CANCELLED"}, Default: "PENDING_APPROVAL"},
 		{Name: "request_id", Type: field.TypeString, Nullable: true},
 		{Name: "created_by", Type: field.TypeString},
 		{Name: "reason", Type: field.TypeString, Nullable: true},
@@ -186,6 +240,11 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{BatchApprovalTicketsColumns[3], BatchApprovalTicketsColumns[10]},
 			},
+			{
+				Name:    "batchapprovalticket_created_by_request_id_batch_type",
+				Unique:  false,
+				Columns: []*schema.Column{BatchApprovalTicketsColumns[10], BatchApprovalTicketsColumns[9], BatchApprovalTicketsColumns[3]},
+			},
 		},
 	}
 	// ClustersColumns holds the columns for the "clusters" table.
@@ -207,6 +266,13 @@ var (
 		{Name: "default_storage_class", Type: field.TypeString, Nullable: true},
 		{Name: "storage_classes_updated_at", Type: field.TypeTime, Nullable: true},
 		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "total_cpu_cores", Type: field.TypeInt, Default: 0},
+		{Name: "total_memory_mb", Type: field.TypeInt, Default: 0},
+		{Name: "cpu_overcommit_ratio", Type: field.TypeFloat64, Default: 1},
+		{Name: "memory_overcommit_ratio", Type: field.TypeFloat64, Default: 1},
+		{Name: "health_check_failures", Type: field.TypeInt, Default: 0},
+		{Name: "last_health_check_at", Type: field.TypeTime, Nullable: true},
+		{Name: "health_message", Type: field.TypeString, Nullable: true},
 	}
 	// ClustersTable holds the schema information for the "clusters" table.
 	ClustersTable = &schema.Table{
@@ -332,6 +398,8 @@ var (
 		{Name: "source_field", Type: field.TypeString, Nullable: true},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "last_synced_at", Type: field.TypeTime, Nullable: true},
+		{Name: "stale", Type: field.TypeBool, Default: false},
+		{Name: "stale_since", Type: field.TypeTime, Nullable: true},
 	}
 	// IDPsyncedGroupsTable holds the schema information for the "id_psynced_groups" table.
 	IDPsyncedGroupsTable = &schema.Table{
@@ -407,6 +475,93 @@ var (
 			},
 		},
 	}
+	// InstanceSizeClustersColumns holds the columns for the "instance_size_clusters" table.
+	InstanceSizeClustersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "instance_size_id", Type: field.TypeString},
+		{Name: "cluster_id", Type: field.TypeString},
+		{Name: "created_by", Type: field.TypeString},
+	}
+	// InstanceSizeClustersTable holds the schema information for the "instance_size_clusters" table.
+	InstanceSizeClustersTable = &schema.Table{
+		Name:       "instance_size_clusters",
+		Columns:    InstanceSizeClustersColumns,
+		PrimaryKey: []*schema.Column{InstanceSizeClustersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "instancesizecluster_instance_size_id_cluster_id",
+				Unique:  true,
+				Columns: []*schema.Column{InstanceSizeClustersColumns[3], InstanceSizeClustersColumns[4]},
+			},
+			{
+				Name:    "instancesizecluster_cluster_id",
+				Unique:  false,
+				Columns: []*schema.Column{InstanceSizeClustersColumns[4]},
+			},
+		},
+	}
+	// LoginSessionsColumns holds the columns for the "login_sessions" table.
+	LoginSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_by", Type: field.TypeString, Nullable: true},
+	}
+	// LoginSessionsTable holds the schema information for the "login_sessions" table.
+	LoginSessionsTable = &schema.Table{
+		Name:       "login_sessions",
+		Columns:    LoginSessionsColumns,
+		PrimaryKey: []*schema.Column{LoginSessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "loginsession_user_id_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoginSessionsColumns[3], LoginSessionsColumns[4]},
+			},
+			{
+				Name:    "loginsession_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoginSessionsColumns[4]},
+			},
+		},
+	}
+	// LoginThrottlesColumns holds the columns for the "login_throttles" table.
+	LoginThrottlesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "failures", Type: field.TypeInt, Default: 0},
+		{Name: "last_failure_at", Type: field.TypeTime},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+	}
+	// LoginThrottlesTable holds the schema information for the "login_throttles" table.
+	LoginThrottlesTable = &schema.Table{
+		Name:       "login_throttles",
+		Columns:    LoginThrottlesColumns,
+		PrimaryKey: []*schema.Column{LoginThrottlesColumns[0]},
+	}
+	// NamespaceQuotaColumns holds the columns for the "namespace_quota" table.
+	NamespaceQuotaColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "namespace_name", Type: field.TypeString, Unique: true, Size: 63},
+		{Name: "max_vms", Type: field.TypeInt},
+		{Name: "created_by", Type: field.TypeString},
+	}
+	// NamespaceQuotaTable holds the schema information for the "namespace_quota" table.
+	NamespaceQuotaTable = &schema.Table{
+		Name:       "namespace_quota",
+		Columns:    NamespaceQuotaColumns,
+		PrimaryKey: []*schema.Column{NamespaceQuotaColumns[0]},
+	}
 	// NamespaceRegistriesColumns holds the columns for the "namespace_registries" table.
 	NamespaceRegistriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -417,6 +572,7 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 512},
 		{Name: "created_by", Type: field.TypeString},
 		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "require_power_approval", Type: field.TypeBool, Default: false},
 	}
 	// NamespaceRegistriesTable holds the schema information for the "namespace_registries" table.
 	NamespaceRegistriesTable = &schema.Table{
@@ -440,7 +596,7 @@ var (
 	NotificationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
 		{Name: "created_at", Type: field.TypeTime},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"APPROVAL_PENDING", "APPROVAL_COMPLETED", "APPROVAL_REJECTED", "VM_STATUS_CHANGE"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"APPROVAL_PENDING", "APPROVAL_COMPLETED", "APPROVAL_REJECTED", "APPROVAL_COMMENT", "VM_STATUS_CHANGE", "CLUSTER_STATUS_CHANGE"}},
 		{Name: "title", Type: field.TypeString, Size: 255},
 		{Name: "message", Type: field.TypeString, Size: 2048},
 		{Name: "resource_type", Type: field.TypeString, Nullable: true},
@@ -480,6 +636,49 @@ var (
 			},
 		},
 	}
+	// NotificationPreferencesColumns holds the columns for the "notification_preferences" table.
+	NotificationPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "event_type", Type: field.TypeEnum, Enums: []string{"APPROVAL_PENDING", "APPROVAL_COMPLETED", "APPROVAL_REJECTED", "APPROVAL_COMMENT", "VM_STATUS_CHANGE", "CLUSTER_STATUS_CHANGE"}},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+	}
+	// NotificationPreferencesTable holds the schema information for the "notification_preferences" table.
+	NotificationPreferencesTable = &schema.Table{
+		Name:       "notification_preferences",
+		Columns:    NotificationPreferencesColumns,
+		PrimaryKey: []*schema.Column{NotificationPreferencesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationpreference_user_id_event_type",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationPreferencesColumns[3], NotificationPreferencesColumns[4]},
+			},
+		},
+	}
+	// PasswordHistoriesColumns holds the columns for the "password_histories" table.
+	PasswordHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "password_hash", Type: field.TypeString},
+	}
+	// PasswordHistoriesTable holds the schema information for the "password_histories" table.
+	PasswordHistoriesTable = &schema.Table{
+		Name:       "password_histories",
+		Columns:    PasswordHistoriesColumns,
+		PrimaryKey: []*schema.Column{PasswordHistoriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "passwordhistory_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PasswordHistoriesColumns[3], PasswordHistoriesColumns[1]},
+			},
+		},
+	}
 	// PendingAdoptionsColumns holds the columns for the "pending_adoptions" table.
 	PendingAdoptionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -511,6 +710,52 @@ var (
 			},
 		},
 	}
+	// PlatformConfigsColumns holds the columns for the "platform_configs" table.
+	PlatformConfigsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "approval_ttl_hours", Type: field.TypeInt, Default: 0},
+		{Name: "smtp_host", Type: field.TypeString, Nullable: true},
+		{Name: "smtp_port", Type: field.TypeInt, Default: 587},
+		{Name: "smtp_username", Type: field.TypeString, Nullable: true},
+		{Name: "smtp_password", Type: field.TypeString, Nullable: true},
+		{Name: "smtp_from_address", Type: field.TypeString, Nullable: true},
+		{Name: "allow_user_data", Type: field.TypeBool, Default: false},
+		{Name: "spec_override_policy", Type: field.TypeJSON, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+	}
+	// PlatformConfigsTable holds the schema information for the "platform_configs" table.
+	PlatformConfigsTable = &schema.Table{
+		Name:       "platform_configs",
+		Columns:    PlatformConfigsColumns,
+		PrimaryKey: []*schema.Column{PlatformConfigsColumns[0]},
+	}
+	// QuotaColumns holds the columns for the "quota" table.
+	QuotaColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "scope_type", Type: field.TypeEnum, Enums: []string{"system", "namespace"}},
+		{Name: "scope_id", Type: field.TypeString},
+		{Name: "max_vms", Type: field.TypeInt, Nullable: true},
+		{Name: "max_cpu_cores", Type: field.TypeInt, Nullable: true},
+		{Name: "max_memory_mb", Type: field.TypeInt, Nullable: true},
+		{Name: "created_by", Type: field.TypeString},
+	}
+	// QuotaTable holds the schema information for the "quota" table.
+	QuotaTable = &schema.Table{
+		Name:       "quota",
+		Columns:    QuotaColumns,
+		PrimaryKey: []*schema.Column{QuotaColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "quota_scope_type_scope_id",
+				Unique:  true,
+				Columns: []*schema.Column{QuotaColumns[3], QuotaColumns[4]},
+			},
+		},
+	}
 	// RateLimitExemptionsColumns holds the columns for the "rate_limit_exemptions" table.
 	RateLimitExemptionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -567,6 +812,29 @@ var (
 			},
 		},
 	}
+	// RefreshTokensColumns holds the columns for the "refresh_tokens" table.
+	RefreshTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+	}
+	// RefreshTokensTable holds the schema information for the "refresh_tokens" table.
+	RefreshTokensTable = &schema.Table{
+		Name:       "refresh_tokens",
+		Columns:    RefreshTokensColumns,
+		PrimaryKey: []*schema.Column{RefreshTokensColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "refreshtoken_user_id_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[3], RefreshTokensColumns[5]},
+			},
+		},
+	}
 	// ResourceRoleBindingsColumns holds the columns for the "resource_role_bindings" table.
 	ResourceRoleBindingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -575,7 +843,7 @@ var (
 		{Name: "user_id", Type: field.TypeString},
 		{Name: "resource_type", Type: field.TypeString},
 		{Name: "resource_id", Type: field.TypeString},
-		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "admin", "member", "viewer"}, Default: "viewer"},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "admin", "maintainer", "member", "viewer"}, Default: "viewer"},
 		{Name: "created_by", Type: field.TypeString},
 	}
 	// ResourceRoleBindingsTable holds the schema information for the "resource_role_bindings" table.
@@ -596,6 +864,20 @@ var (
 			},
 		},
 	}
+	// RevokedSessionsColumns holds the columns for the "revoked_sessions" table.
+	RevokedSessionsColumns = []*schema.Column{
+		{Name: "user_id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "revoked_before", Type: field.TypeTime},
+		{Name: "revoked_by", Type: field.TypeString, Nullable: true},
+	}
+	// RevokedSessionsTable holds the schema information for the "revoked_sessions" table.
+	RevokedSessionsTable = &schema.Table{
+		Name:       "revoked_sessions",
+		Columns:    RevokedSessionsColumns,
+		PrimaryKey: []*schema.Column{RevokedSessionsColumns[0]},
+	}
 	// RolesColumns holds the columns for the "roles" table.
 	RolesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -660,6 +942,34 @@ var (
 			},
 		},
 	}
+	// ScheduledBatchJobsColumns holds the columns for the "scheduled_batch_jobs" table.
+	ScheduledBatchJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "cron_expression", Type: field.TypeString, Size: 128},
+		{Name: "operation", Type: field.TypeEnum, Enums: []string{"START", "STOP", "RESTART"}},
+		{Name: "vm_ids", Type: field.TypeJSON},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "last_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_batch_id", Type: field.TypeString, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "created_by", Type: field.TypeString},
+	}
+	// ScheduledBatchJobsTable holds the schema information for the "scheduled_batch_jobs" table.
+	ScheduledBatchJobsTable = &schema.Table{
+		Name:       "scheduled_batch_jobs",
+		Columns:    ScheduledBatchJobsColumns,
+		PrimaryKey: []*schema.Column{ScheduledBatchJobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scheduledbatchjob_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduledBatchJobsColumns[6]},
+			},
+		},
+	}
 	// ServicesColumns holds the columns for the "services" table.
 	ServicesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -668,6 +978,7 @@ var (
 		{Name: "name", Type: field.TypeString, Size: 15},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "next_instance_index", Type: field.TypeInt, Default: 1},
+		{Name: "labels", Type: field.TypeJSON, Nullable: true},
 		{Name: "system_services", Type: field.TypeString},
 	}
 	// ServicesTable holds the schema information for the "services" table.
@@ -678,7 +989,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "services_systems_services",
-				Columns:    []*schema.Column{ServicesColumns[6]},
+				Columns:    []*schema.Column{ServicesColumns[7]},
 				RefColumns: []*schema.Column{SystemsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -687,7 +998,7 @@ var (
 			{
 				Name:    "service_name_system_services",
 				Unique:  true,
-				Columns: []*schema.Column{ServicesColumns[3], ServicesColumns[6]},
+				Columns: []*schema.Column{ServicesColumns[3], ServicesColumns[7]},
 			},
 		},
 	}
@@ -750,12 +1061,22 @@ var (
 		{Name: "os_version", Type: field.TypeString, Nullable: true},
 		{Name: "enabled", Type: field.TypeBool, Default: true},
 		{Name: "created_by", Type: field.TypeString},
+		{Name: "deprecated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "parent_template_id", Type: field.TypeString, Nullable: true},
 	}
 	// TemplatesTable holds the schema information for the "templates" table.
 	TemplatesTable = &schema.Table{
 		Name:       "templates",
 		Columns:    TemplatesColumns,
 		PrimaryKey: []*schema.Column{TemplatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "templates_templates_children",
+				Columns:    []*schema.Column{TemplatesColumns[13]},
+				RefColumns: []*schema.Column{TemplatesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "template_name_version",
@@ -767,6 +1088,32 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{TemplatesColumns[10]},
 			},
+			{
+				Name:    "template_parent_template_id",
+				Unique:  false,
+				Columns: []*schema.Column{TemplatesColumns[13]},
+			},
+		},
+	}
+	// TicketCommentsColumns holds the columns for the "ticket_comments" table.
+	TicketCommentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "ticket_id", Type: field.TypeString},
+		{Name: "author", Type: field.TypeString},
+		{Name: "body", Type: field.TypeString, Size: 2000},
+	}
+	// TicketCommentsTable holds the schema information for the "ticket_comments" table.
+	TicketCommentsTable = &schema.Table{
+		Name:       "ticket_comments",
+		Columns:    TicketCommentsColumns,
+		PrimaryKey: []*schema.Column{TicketCommentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ticketcomment_ticket_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{TicketCommentsColumns[2], TicketCommentsColumns[1]},
+			},
 		},
 	}
 	// UsersColumns holds the columns for the "users" table.
@@ -816,10 +1163,11 @@ var (
 		{Name: "instance", Type: field.TypeString},
 		{Name: "namespace", Type: field.TypeString},
 		{Name: "cluster_id", Type: field.TypeString, Nullable: true},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"CREATING", "RUNNING", "STOPPING", "STOPPED", "DELETING", "FAILED", "PENDING", "MIGRATING", "PAUSED", "UNKNOWN"}, Default: "CREATING"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"CREATING", "RUNNING", "STOPPING", "STOPPED", "DELETING", "FAILED", "PENDING", "MIGRATING", "RESIZING", "PAUSED", "UNKNOWN"}, Default: "CREATING"},
 		{Name: "hostname", Type: field.TypeString, Nullable: true},
 		{Name: "created_by", Type: field.TypeString},
 		{Name: "ticket_id", Type: field.TypeString, Nullable: true},
+		{Name: "labels", Type: field.TypeJSON, Nullable: true},
 		{Name: "service_vms", Type: field.TypeString},
 	}
 	// VmsTable holds the schema information for the "vms" table.
@@ -830,7 +1178,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "vms_services_vms",
-				Columns:    []*schema.Column{VmsColumns[11]},
+				Columns:    []*schema.Column{VmsColumns[12]},
 				RefColumns: []*schema.Column{ServicesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -853,6 +1201,59 @@ var (
 			},
 		},
 	}
+	// VMConsoleSessionsColumns holds the columns for the "vm_console_sessions" table.
+	VMConsoleSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "vm_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "ticket_id", Type: field.TypeString, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_by", Type: field.TypeString, Nullable: true},
+	}
+	// VMConsoleSessionsTable holds the schema information for the "vm_console_sessions" table.
+	VMConsoleSessionsTable = &schema.Table{
+		Name:       "vm_console_sessions",
+		Columns:    VMConsoleSessionsColumns,
+		PrimaryKey: []*schema.Column{VMConsoleSessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "vmconsolesession_vm_id_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{VMConsoleSessionsColumns[3], VMConsoleSessionsColumns[5]},
+			},
+			{
+				Name:    "vmconsolesession_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{VMConsoleSessionsColumns[1]},
+			},
+		},
+	}
+	// VMRequestIdempotenciesColumns holds the columns for the "vm_request_idempotencies" table.
+	VMRequestIdempotenciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "request_id", Type: field.TypeString, Size: 128},
+		{Name: "ticket_id", Type: field.TypeString},
+		{Name: "event_id", Type: field.TypeString},
+	}
+	// VMRequestIdempotenciesTable holds the schema information for the "vm_request_idempotencies" table.
+	VMRequestIdempotenciesTable = &schema.Table{
+		Name:       "vm_request_idempotencies",
+		Columns:    VMRequestIdempotenciesColumns,
+		PrimaryKey: []*schema.Column{VMRequestIdempotenciesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "vmrequestidempotency_created_by_request_id",
+				Unique:  true,
+				Columns: []*schema.Column{VMRequestIdempotenciesColumns[3], VMRequestIdempotenciesColumns[4]},
+			},
+		},
+	}
 	// VMRevisionsColumns holds the columns for the "vm_revisions" table.
 	VMRevisionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -884,8 +1285,64 @@ var (
 			},
 		},
 	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "endpoint_id", Type: field.TypeString},
+		{Name: "delivery_id", Type: field.TypeString},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "attempt", Type: field.TypeInt},
+		{Name: "success", Type: field.TypeBool},
+		{Name: "status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 1024},
+		{Name: "duration_ms", Type: field.TypeInt64, Default: 0},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_endpoint_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[2], WebhookDeliveriesColumns[1]},
+			},
+			{
+				Name:    "webhookdelivery_delivery_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[3]},
+			},
+		},
+	}
+	// WebhookEndpointsColumns holds the columns for the "webhook_endpoints" table.
+	WebhookEndpointsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "url", Type: field.TypeString, Size: 2048},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "event_types", Type: field.TypeJSON},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_by", Type: field.TypeString},
+	}
+	// WebhookEndpointsTable holds the schema information for the "webhook_endpoints" table.
+	WebhookEndpointsTable = &schema.Table{
+		Name:       "webhook_endpoints",
+		Columns:    WebhookEndpointsColumns,
+		PrimaryKey: []*schema.Column{WebhookEndpointsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookendpoint_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookEndpointsColumns[6]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		ApprovalDecisionsTable,
 		ApprovalPoliciesTable,
 		ApprovalTicketsTable,
 		AuditLogsTable,
@@ -897,21 +1354,37 @@ var (
 		IDPgroupMappingsTable,
 		IDPsyncedGroupsTable,
 		InstanceSizesTable,
+		InstanceSizeClustersTable,
+		LoginSessionsTable,
+		LoginThrottlesTable,
+		NamespaceQuotaTable,
 		NamespaceRegistriesTable,
 		NotificationsTable,
+		NotificationPreferencesTable,
+		PasswordHistoriesTable,
 		PendingAdoptionsTable,
+		PlatformConfigsTable,
+		QuotaTable,
 		RateLimitExemptionsTable,
 		RateLimitUserOverridesTable,
+		RefreshTokensTable,
 		ResourceRoleBindingsTable,
+		RevokedSessionsTable,
 		RolesTable,
 		RoleBindingsTable,
+		ScheduledBatchJobsTable,
 		ServicesTable,
 		SystemsTable,
 		SystemSecretsTable,
 		TemplatesTable,
+		TicketCommentsTable,
 		UsersTable,
 		VmsTable,
+		VMConsoleSessionsTable,
+		VMRequestIdempotenciesTable,
 		VMRevisionsTable,
+		WebhookDeliveriesTable,
+		WebhookEndpointsTable,
 	}
 )
 
@@ -920,6 +1393,7 @@ func init() {
 	RoleBindingsTable.ForeignKeys[0].RefTable = RolesTable
 	RoleBindingsTable.ForeignKeys[1].RefTable = UsersTable
 	ServicesTable.ForeignKeys[0].RefTable = SystemsTable
+	TemplatesTable.ForeignKeys[0].RefTable = TemplatesTable
 	VmsTable.ForeignKeys[0].RefTable = ServicesTable
 	VMRevisionsTable.ForeignKeys[0].RefTable = VmsTable
 }
@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"kv-shepherd.io/shepherd/ent/namespacequota"
+)
+
+// NamespaceQuota is the model entity for the NamespaceQuota schema.
+type NamespaceQuota struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// NamespaceRegistry name the quota applies to
+	NamespaceName string `json:"namespace_name,omitempty"`
+	// Maximum number of VMs allowed in the namespace
+	MaxVms int `json:"max_vms,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy    string `json:"created_by,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NamespaceQuota) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case namespacequota.FieldMaxVms:
+			values[i] = new(sql.NullInt64)
+		case namespacequota.FieldID, namespacequota.FieldNamespaceName, namespacequota.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case namespacequota.FieldCreatedAt, namespacequota.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NamespaceQuota fields.
+func (_m *NamespaceQuota) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case namespacequota.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case namespacequota.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case namespacequota.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case namespacequota.FieldNamespaceName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace_name", values[i])
+			} else if value.Valid {
+				_m.NamespaceName = value.String
+			}
+		case namespacequota.FieldMaxVms:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_vms", values[i])
+			} else if value.Valid {
+				_m.MaxVms = int(value.Int64)
+			}
+		case namespacequota.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NamespaceQuota.
+// This includes values selected through modifiers, order, etc.
+func (_m *NamespaceQuota) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this NamespaceQuota.
+// Note that you need to call NamespaceQuota.Unwrap() before calling this method if this NamespaceQuota
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *NamespaceQuota) Update() *NamespaceQuotaUpdateOne {
+	return NewNamespaceQuotaClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the NamespaceQuota entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *NamespaceQuota) Unwrap() *NamespaceQuota {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NamespaceQuota is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *NamespaceQuota) String() string {
+	var builder strings.Builder
+	builder.WriteString("NamespaceQuota(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("namespace_name=")
+	builder.WriteString(_m.NamespaceName)
+	builder.WriteString(", ")
+	builder.WriteString("max_vms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxVms))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamespaceQuotaSlice is a parsable slice of NamespaceQuota.
+type NamespaceQuotaSlice []*NamespaceQuota
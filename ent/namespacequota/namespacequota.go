@@ -0,0 +1,96 @@
+// Code generated by ent, DO NOT EDIT.
+
+package namespacequota
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the namespacequota type in the database.
+	Label = "namespace_quota"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldNamespaceName holds the string denoting the namespace_name field in the database.
+	FieldNamespaceName = "namespace_name"
+	// FieldMaxVms holds the string denoting the max_vms field in the database.
+	FieldMaxVms = "max_vms"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// Table holds the table name of the namespacequota in the database.
+	Table = "namespace_quota"
+)
+
+// Columns holds all SQL columns for namespacequota fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldNamespaceName,
+	FieldMaxVms,
+	FieldCreatedBy,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NamespaceNameValidator is a validator for the "namespace_name" field. It is called by the builders before save.
+	NamespaceNameValidator func(string) error
+	// MaxVmsValidator is a validator for the "max_vms" field. It is called by the builders before save.
+	MaxVmsValidator func(int) error
+	// CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	CreatedByValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the NamespaceQuota queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByNamespaceName orders the results by the namespace_name field.
+func ByNamespaceName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNamespaceName, opts...).ToFunc()
+}
+
+// ByMaxVms orders the results by the max_vms field.
+func ByMaxVms(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxVms, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
@@ -0,0 +1,355 @@
+// Code generated by ent, DO NOT EDIT.
+
+package namespacequota
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"kv-shepherd.io/shepherd/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NamespaceName applies equality check predicate on the "namespace_name" field. It's identical to NamespaceNameEQ.
+func NamespaceName(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldEQ(FieldNamespaceName, v))
+}
+
+// MaxVms applies equality check predicate on the "max_vms" field. It's identical to MaxVmsEQ.
+func MaxVms(v int) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldEQ(FieldMaxVms, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NamespaceNameEQ applies the EQ predicate on the "namespace_name" field.
+func NamespaceNameEQ(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldEQ(FieldNamespaceName, v))
+}
+
+// NamespaceNameNEQ applies the NEQ predicate on the "namespace_name" field.
+func NamespaceNameNEQ(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldNEQ(FieldNamespaceName, v))
+}
+
+// NamespaceNameIn applies the In predicate on the "namespace_name" field.
+func NamespaceNameIn(vs ...string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldIn(FieldNamespaceName, vs...))
+}
+
+// NamespaceNameNotIn applies the NotIn predicate on the "namespace_name" field.
+func NamespaceNameNotIn(vs ...string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldNotIn(FieldNamespaceName, vs...))
+}
+
+// NamespaceNameGT applies the GT predicate on the "namespace_name" field.
+func NamespaceNameGT(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldGT(FieldNamespaceName, v))
+}
+
+// NamespaceNameGTE applies the GTE predicate on the "namespace_name" field.
+func NamespaceNameGTE(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldGTE(FieldNamespaceName, v))
+}
+
+// NamespaceNameLT applies the LT predicate on the "namespace_name" field.
+func NamespaceNameLT(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldLT(FieldNamespaceName, v))
+}
+
+// NamespaceNameLTE applies the LTE predicate on the "namespace_name" field.
+func NamespaceNameLTE(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldLTE(FieldNamespaceName, v))
+}
+
+// NamespaceNameContains applies the Contains predicate on the "namespace_name" field.
+func NamespaceNameContains(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldContains(FieldNamespaceName, v))
+}
+
+// NamespaceNameHasPrefix applies the HasPrefix predicate on the "namespace_name" field.
+func NamespaceNameHasPrefix(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldHasPrefix(FieldNamespaceName, v))
+}
+
+// NamespaceNameHasSuffix applies the HasSuffix predicate on the "namespace_name" field.
+func NamespaceNameHasSuffix(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldHasSuffix(FieldNamespaceName, v))
+}
+
+// NamespaceNameEqualFold applies the EqualFold predicate on the "namespace_name" field.
+func NamespaceNameEqualFold(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldEqualFold(FieldNamespaceName, v))
+}
+
+// NamespaceNameContainsFold applies the ContainsFold predicate on the "namespace_name" field.
+func NamespaceNameContainsFold(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldContainsFold(FieldNamespaceName, v))
+}
+
+// MaxVmsEQ applies the EQ predicate on the "max_vms" field.
+func MaxVmsEQ(v int) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldEQ(FieldMaxVms, v))
+}
+
+// MaxVmsNEQ applies the NEQ predicate on the "max_vms" field.
+func MaxVmsNEQ(v int) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldNEQ(FieldMaxVms, v))
+}
+
+// MaxVmsIn applies the In predicate on the "max_vms" field.
+func MaxVmsIn(vs ...int) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldIn(FieldMaxVms, vs...))
+}
+
+// MaxVmsNotIn applies the NotIn predicate on the "max_vms" field.
+func MaxVmsNotIn(vs ...int) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldNotIn(FieldMaxVms, vs...))
+}
+
+// MaxVmsGT applies the GT predicate on the "max_vms" field.
+func MaxVmsGT(v int) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldGT(FieldMaxVms, v))
+}
+
+// MaxVmsGTE applies the GTE predicate on the "max_vms" field.
+func MaxVmsGTE(v int) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldGTE(FieldMaxVms, v))
+}
+
+// MaxVmsLT applies the LT predicate on the "max_vms" field.
+func MaxVmsLT(v int) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldLT(FieldMaxVms, v))
+}
+
+// MaxVmsLTE applies the LTE predicate on the "max_vms" field.
+func MaxVmsLTE(v int) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldLTE(FieldMaxVms, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NamespaceQuota) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NamespaceQuota) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NamespaceQuota) predicate.NamespaceQuota {
+	return predicate.NamespaceQuota(sql.NotPredicates(p))
+}
@@ -0,0 +1,292 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"kv-shepherd.io/shepherd/ent/namespacequota"
+)
+
+// NamespaceQuotaCreate is the builder for creating a NamespaceQuota entity.
+type NamespaceQuotaCreate struct {
+	config
+	mutation *NamespaceQuotaMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *NamespaceQuotaCreate) SetCreatedAt(v time.Time) *NamespaceQuotaCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *NamespaceQuotaCreate) SetNillableCreatedAt(v *time.Time) *NamespaceQuotaCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *NamespaceQuotaCreate) SetUpdatedAt(v time.Time) *NamespaceQuotaCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *NamespaceQuotaCreate) SetNillableUpdatedAt(v *time.Time) *NamespaceQuotaCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetNamespaceName sets the "namespace_name" field.
+func (_c *NamespaceQuotaCreate) SetNamespaceName(v string) *NamespaceQuotaCreate {
+	_c.mutation.SetNamespaceName(v)
+	return _c
+}
+
+// SetMaxVms sets the "max_vms" field.
+func (_c *NamespaceQuotaCreate) SetMaxVms(v int) *NamespaceQuotaCreate {
+	_c.mutation.SetMaxVms(v)
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *NamespaceQuotaCreate) SetCreatedBy(v string) *NamespaceQuotaCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *NamespaceQuotaCreate) SetID(v string) *NamespaceQuotaCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the NamespaceQuotaMutation object of the builder.
+func (_c *NamespaceQuotaCreate) Mutation() *NamespaceQuotaMutation {
+	return _c.mutation
+}
+
+// Save creates the NamespaceQuota in the database.
+func (_c *NamespaceQuotaCreate) Save(ctx context.Context) (*NamespaceQuota, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NamespaceQuotaCreate) SaveX(ctx context.Context) *NamespaceQuota {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NamespaceQuotaCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NamespaceQuotaCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *NamespaceQuotaCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := namespacequota.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := namespacequota.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NamespaceQuotaCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NamespaceQuota.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "NamespaceQuota.updated_at"`)}
+	}
+	if _, ok := _c.mutation.NamespaceName(); !ok {
+		return &ValidationError{Name: "namespace_name", err: errors.New(`ent: missing required field "NamespaceQuota.namespace_name"`)}
+	}
+	if v, ok := _c.mutation.NamespaceName(); ok {
+		if err := namespacequota.NamespaceNameValidator(v); err != nil {
+			return &ValidationError{Name: "namespace_name", err: fmt.Errorf(`ent: validator failed for field "NamespaceQuota.namespace_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxVms(); !ok {
+		return &ValidationError{Name: "max_vms", err: errors.New(`ent: missing required field "NamespaceQuota.max_vms"`)}
+	}
+	if v, ok := _c.mutation.MaxVms(); ok {
+		if err := namespacequota.MaxVmsValidator(v); err != nil {
+			return &ValidationError{Name: "max_vms", err: fmt.Errorf(`ent: validator failed for field "NamespaceQuota.max_vms": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "NamespaceQuota.created_by"`)}
+	}
+	if v, ok := _c.mutation.CreatedBy(); ok {
+		if err := namespacequota.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "NamespaceQuota.created_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *NamespaceQuotaCreate) sqlSave(ctx context.Context) (*NamespaceQuota, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected NamespaceQuota.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NamespaceQuotaCreate) createSpec() (*NamespaceQuota, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NamespaceQuota{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(namespacequota.Table, sqlgraph.NewFieldSpec(namespacequota.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(namespacequota.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(namespacequota.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.NamespaceName(); ok {
+		_spec.SetField(namespacequota.FieldNamespaceName, field.TypeString, value)
+		_node.NamespaceName = value
+	}
+	if value, ok := _c.mutation.MaxVms(); ok {
+		_spec.SetField(namespacequota.FieldMaxVms, field.TypeInt, value)
+		_node.MaxVms = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(namespacequota.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	return _node, _spec
+}
+
+// NamespaceQuotaCreateBulk is the builder for creating many NamespaceQuota entities in bulk.
+type NamespaceQuotaCreateBulk struct {
+	config
+	err      error
+	builders []*NamespaceQuotaCreate
+}
+
+// Save creates the NamespaceQuota entities in the database.
+func (_c *NamespaceQuotaCreateBulk) Save(ctx context.Context) ([]*NamespaceQuota, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*NamespaceQuota, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NamespaceQuotaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NamespaceQuotaCreateBulk) SaveX(ctx context.Context) []*NamespaceQuota {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NamespaceQuotaCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NamespaceQuotaCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
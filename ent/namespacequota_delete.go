@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"kv-shepherd.io/shepherd/ent/namespacequota"
+	"kv-shepherd.io/shepherd/ent/predicate"
+)
+
+// NamespaceQuotaDelete is the builder for deleting a NamespaceQuota entity.
+type NamespaceQuotaDelete struct {
+	config
+	hooks    []Hook
+	mutation *NamespaceQuotaMutation
+}
+
+// Where appends a list predicates to the NamespaceQuotaDelete builder.
+func (_d *NamespaceQuotaDelete) Where(ps ...predicate.NamespaceQuota) *NamespaceQuotaDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *NamespaceQuotaDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *NamespaceQuotaDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *NamespaceQuotaDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(namespacequota.Table, sqlgraph.NewFieldSpec(namespacequota.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// NamespaceQuotaDeleteOne is the builder for deleting a single NamespaceQuota entity.
+type NamespaceQuotaDeleteOne struct {
+	_d *NamespaceQuotaDelete
+}
+
+// Where appends a list predicates to the NamespaceQuotaDelete builder.
+func (_d *NamespaceQuotaDeleteOne) Where(ps ...predicate.NamespaceQuota) *NamespaceQuotaDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *NamespaceQuotaDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{namespacequota.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *NamespaceQuotaDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
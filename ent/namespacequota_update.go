@@ -0,0 +1,336 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"kv-shepherd.io/shepherd/ent/namespacequota"
+	"kv-shepherd.io/shepherd/ent/predicate"
+)
+
+// NamespaceQuotaUpdate is the builder for updating NamespaceQuota entities.
+type NamespaceQuotaUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NamespaceQuotaMutation
+}
+
+// Where appends a list predicates to the NamespaceQuotaUpdate builder.
+func (_u *NamespaceQuotaUpdate) Where(ps ...predicate.NamespaceQuota) *NamespaceQuotaUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *NamespaceQuotaUpdate) SetUpdatedAt(v time.Time) *NamespaceQuotaUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetMaxVms sets the "max_vms" field.
+func (_u *NamespaceQuotaUpdate) SetMaxVms(v int) *NamespaceQuotaUpdate {
+	_u.mutation.ResetMaxVms()
+	_u.mutation.SetMaxVms(v)
+	return _u
+}
+
+// SetNillableMaxVms sets the "max_vms" field if the given value is not nil.
+func (_u *NamespaceQuotaUpdate) SetNillableMaxVms(v *int) *NamespaceQuotaUpdate {
+	if v != nil {
+		_u.SetMaxVms(*v)
+	}
+	return _u
+}
+
+// AddMaxVms adds value to the "max_vms" field.
+func (_u *NamespaceQuotaUpdate) AddMaxVms(v int) *NamespaceQuotaUpdate {
+	_u.mutation.AddMaxVms(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *NamespaceQuotaUpdate) SetCreatedBy(v string) *NamespaceQuotaUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *NamespaceQuotaUpdate) SetNillableCreatedBy(v *string) *NamespaceQuotaUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// Mutation returns the NamespaceQuotaMutation object of the builder.
+func (_u *NamespaceQuotaUpdate) Mutation() *NamespaceQuotaMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NamespaceQuotaUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NamespaceQuotaUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NamespaceQuotaUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NamespaceQuotaUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NamespaceQuotaUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := namespacequota.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NamespaceQuotaUpdate) check() error {
+	if v, ok := _u.mutation.MaxVms(); ok {
+		if err := namespacequota.MaxVmsValidator(v); err != nil {
+			return &ValidationError{Name: "max_vms", err: fmt.Errorf(`ent: validator failed for field "NamespaceQuota.max_vms": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreatedBy(); ok {
+		if err := namespacequota.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "NamespaceQuota.created_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *NamespaceQuotaUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(namespacequota.Table, namespacequota.Columns, sqlgraph.NewFieldSpec(namespacequota.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(namespacequota.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.MaxVms(); ok {
+		_spec.SetField(namespacequota.FieldMaxVms, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxVms(); ok {
+		_spec.AddField(namespacequota.FieldMaxVms, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(namespacequota.FieldCreatedBy, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{namespacequota.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NamespaceQuotaUpdateOne is the builder for updating a single NamespaceQuota entity.
+type NamespaceQuotaUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NamespaceQuotaMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *NamespaceQuotaUpdateOne) SetUpdatedAt(v time.Time) *NamespaceQuotaUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetMaxVms sets the "max_vms" field.
+func (_u *NamespaceQuotaUpdateOne) SetMaxVms(v int) *NamespaceQuotaUpdateOne {
+	_u.mutation.ResetMaxVms()
+	_u.mutation.SetMaxVms(v)
+	return _u
+}
+
+// SetNillableMaxVms sets the "max_vms" field if the given value is not nil.
+func (_u *NamespaceQuotaUpdateOne) SetNillableMaxVms(v *int) *NamespaceQuotaUpdateOne {
+	if v != nil {
+		_u.SetMaxVms(*v)
+	}
+	return _u
+}
+
+// AddMaxVms adds value to the "max_vms" field.
+func (_u *NamespaceQuotaUpdateOne) AddMaxVms(v int) *NamespaceQuotaUpdateOne {
+	_u.mutation.AddMaxVms(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *NamespaceQuotaUpdateOne) SetCreatedBy(v string) *NamespaceQuotaUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *NamespaceQuotaUpdateOne) SetNillableCreatedBy(v *string) *NamespaceQuotaUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// Mutation returns the NamespaceQuotaMutation object of the builder.
+func (_u *NamespaceQuotaUpdateOne) Mutation() *NamespaceQuotaMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the NamespaceQuotaUpdate builder.
+func (_u *NamespaceQuotaUpdateOne) Where(ps ...predicate.NamespaceQuota) *NamespaceQuotaUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NamespaceQuotaUpdateOne) Select(field string, fields ...string) *NamespaceQuotaUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated NamespaceQuota entity.
+func (_u *NamespaceQuotaUpdateOne) Save(ctx context.Context) (*NamespaceQuota, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NamespaceQuotaUpdateOne) SaveX(ctx context.Context) *NamespaceQuota {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NamespaceQuotaUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NamespaceQuotaUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NamespaceQuotaUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := namespacequota.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NamespaceQuotaUpdateOne) check() error {
+	if v, ok := _u.mutation.MaxVms(); ok {
+		if err := namespacequota.MaxVmsValidator(v); err != nil {
+			return &ValidationError{Name: "max_vms", err: fmt.Errorf(`ent: validator failed for field "NamespaceQuota.max_vms": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreatedBy(); ok {
+		if err := namespacequota.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "NamespaceQuota.created_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *NamespaceQuotaUpdateOne) sqlSave(ctx context.Context) (_node *NamespaceQuota, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(namespacequota.Table, namespacequota.Columns, sqlgraph.NewFieldSpec(namespacequota.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NamespaceQuota.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, namespacequota.FieldID)
+		for _, f := range fields {
+			if !namespacequota.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != namespacequota.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(namespacequota.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.MaxVms(); ok {
+		_spec.SetField(namespacequota.FieldMaxVms, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxVms(); ok {
+		_spec.AddField(namespacequota.FieldMaxVms, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(namespacequota.FieldCreatedBy, field.TypeString, value)
+	}
+	_node = &NamespaceQuota{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{namespacequota.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -30,8 +30,10 @@ type NamespaceRegistry struct {
 	// CreatedBy holds the value of the "created_by" field.
 	CreatedBy string `json:"created_by,omitempty"`
 	// Enabled holds the value of the "enabled" field.
-	Enabled      bool `json:"enabled,omitempty"`
-	selectValues sql.SelectValues
+	Enabled bool `json:"enabled,omitempty"`
+	// Route batch power operations on this namespace's VMs through approval
+	RequirePowerApproval bool `json:"require_power_approval,omitempty"`
+	selectValues         sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -39,7 +41,7 @@ func (*NamespaceRegistry) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case namespaceregistry.FieldEnabled:
+		case namespaceregistry.FieldEnabled, namespaceregistry.FieldRequirePowerApproval:
 			values[i] = new(sql.NullBool)
 		case namespaceregistry.FieldID, namespaceregistry.FieldName, namespaceregistry.FieldEnvironment, namespaceregistry.FieldDescription, namespaceregistry.FieldCreatedBy:
 			values[i] = new(sql.NullString)
@@ -108,6 +110,12 @@ func (_m *NamespaceRegistry) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				_m.Enabled = value.Bool
 			}
+		case namespaceregistry.FieldRequirePowerApproval:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_power_approval", values[i])
+			} else if value.Valid {
+				_m.RequirePowerApproval = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -164,6 +172,9 @@ func (_m *NamespaceRegistry) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("enabled=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("require_power_approval=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequirePowerApproval))
 	builder.WriteByte(')')
 	return builder.String()
 }
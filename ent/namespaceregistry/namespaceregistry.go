@@ -28,6 +28,8 @@ const (
 	FieldCreatedBy = "created_by"
 	// FieldEnabled holds the string denoting the enabled field in the database.
 	FieldEnabled = "enabled"
+	// FieldRequirePowerApproval holds the string denoting the require_power_approval field in the database.
+	FieldRequirePowerApproval = "require_power_approval"
 	// Table holds the table name of the namespaceregistry in the database.
 	Table = "namespace_registries"
 )
@@ -42,6 +44,7 @@ var Columns = []string{
 	FieldDescription,
 	FieldCreatedBy,
 	FieldEnabled,
+	FieldRequirePowerApproval,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -69,6 +72,8 @@ var (
 	CreatedByValidator func(string) error
 	// DefaultEnabled holds the default value on creation for the "enabled" field.
 	DefaultEnabled bool
+	// DefaultRequirePowerApproval holds the default value on creation for the "require_power_approval" field.
+	DefaultRequirePowerApproval bool
 )
 
 // Environment defines the type for the "environment" enum field.
@@ -136,3 +141,8 @@ func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
 func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
 }
+
+// ByRequirePowerApproval orders the results by the require_power_approval field.
+func ByRequirePowerApproval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequirePowerApproval, opts...).ToFunc()
+}
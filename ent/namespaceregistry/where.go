@@ -94,6 +94,11 @@ func Enabled(v bool) predicate.NamespaceRegistry {
 	return predicate.NamespaceRegistry(sql.FieldEQ(FieldEnabled, v))
 }
 
+// RequirePowerApproval applies equality check predicate on the "require_power_approval" field. It's identical to RequirePowerApprovalEQ.
+func RequirePowerApproval(v bool) predicate.NamespaceRegistry {
+	return predicate.NamespaceRegistry(sql.FieldEQ(FieldRequirePowerApproval, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.NamespaceRegistry {
 	return predicate.NamespaceRegistry(sql.FieldEQ(FieldCreatedAt, v))
@@ -409,6 +414,16 @@ func EnabledNEQ(v bool) predicate.NamespaceRegistry {
 	return predicate.NamespaceRegistry(sql.FieldNEQ(FieldEnabled, v))
 }
 
+// RequirePowerApprovalEQ applies the EQ predicate on the "require_power_approval" field.
+func RequirePowerApprovalEQ(v bool) predicate.NamespaceRegistry {
+	return predicate.NamespaceRegistry(sql.FieldEQ(FieldRequirePowerApproval, v))
+}
+
+// RequirePowerApprovalNEQ applies the NEQ predicate on the "require_power_approval" field.
+func RequirePowerApprovalNEQ(v bool) predicate.NamespaceRegistry {
+	return predicate.NamespaceRegistry(sql.FieldNEQ(FieldRequirePowerApproval, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.NamespaceRegistry) predicate.NamespaceRegistry {
 	return predicate.NamespaceRegistry(sql.AndPredicates(predicates...))
@@ -94,6 +94,20 @@ func (_c *NamespaceRegistryCreate) SetNillableEnabled(v *bool) *NamespaceRegistr
 	return _c
 }
 
+// SetRequirePowerApproval sets the "require_power_approval" field.
+func (_c *NamespaceRegistryCreate) SetRequirePowerApproval(v bool) *NamespaceRegistryCreate {
+	_c.mutation.SetRequirePowerApproval(v)
+	return _c
+}
+
+// SetNillableRequirePowerApproval sets the "require_power_approval" field if the given value is not nil.
+func (_c *NamespaceRegistryCreate) SetNillableRequirePowerApproval(v *bool) *NamespaceRegistryCreate {
+	if v != nil {
+		_c.SetRequirePowerApproval(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *NamespaceRegistryCreate) SetID(v string) *NamespaceRegistryCreate {
 	_c.mutation.SetID(v)
@@ -147,6 +161,10 @@ func (_c *NamespaceRegistryCreate) defaults() {
 		v := namespaceregistry.DefaultEnabled
 		_c.mutation.SetEnabled(v)
 	}
+	if _, ok := _c.mutation.RequirePowerApproval(); !ok {
+		v := namespaceregistry.DefaultRequirePowerApproval
+		_c.mutation.SetRequirePowerApproval(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -189,6 +207,9 @@ func (_c *NamespaceRegistryCreate) check() error {
 	if _, ok := _c.mutation.Enabled(); !ok {
 		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "NamespaceRegistry.enabled"`)}
 	}
+	if _, ok := _c.mutation.RequirePowerApproval(); !ok {
+		return &ValidationError{Name: "require_power_approval", err: errors.New(`ent: missing required field "NamespaceRegistry.require_power_approval"`)}
+	}
 	return nil
 }
 
@@ -252,6 +273,10 @@ func (_c *NamespaceRegistryCreate) createSpec() (*NamespaceRegistry, *sqlgraph.C
 		_spec.SetField(namespaceregistry.FieldEnabled, field.TypeBool, value)
 		_node.Enabled = value
 	}
+	if value, ok := _c.mutation.RequirePowerApproval(); ok {
+		_spec.SetField(namespaceregistry.FieldRequirePowerApproval, field.TypeBool, value)
+		_node.RequirePowerApproval = value
+	}
 	return _node, _spec
 }
 
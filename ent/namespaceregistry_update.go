@@ -110,6 +110,20 @@ func (_u *NamespaceRegistryUpdate) SetNillableEnabled(v *bool) *NamespaceRegistr
 	return _u
 }
 
+// SetRequirePowerApproval sets the "require_power_approval" field.
+func (_u *NamespaceRegistryUpdate) SetRequirePowerApproval(v bool) *NamespaceRegistryUpdate {
+	_u.mutation.SetRequirePowerApproval(v)
+	return _u
+}
+
+// SetNillableRequirePowerApproval sets the "require_power_approval" field if the given value is not nil.
+func (_u *NamespaceRegistryUpdate) SetNillableRequirePowerApproval(v *bool) *NamespaceRegistryUpdate {
+	if v != nil {
+		_u.SetRequirePowerApproval(*v)
+	}
+	return _u
+}
+
 // Mutation returns the NamespaceRegistryMutation object of the builder.
 func (_u *NamespaceRegistryUpdate) Mutation() *NamespaceRegistryMutation {
 	return _u.mutation
@@ -209,6 +223,9 @@ func (_u *NamespaceRegistryUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.Enabled(); ok {
 		_spec.SetField(namespaceregistry.FieldEnabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.RequirePowerApproval(); ok {
+		_spec.SetField(namespaceregistry.FieldRequirePowerApproval, field.TypeBool, value)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{namespaceregistry.Label}
@@ -311,6 +328,20 @@ func (_u *NamespaceRegistryUpdateOne) SetNillableEnabled(v *bool) *NamespaceRegi
 	return _u
 }
 
+// SetRequirePowerApproval sets the "require_power_approval" field.
+func (_u *NamespaceRegistryUpdateOne) SetRequirePowerApproval(v bool) *NamespaceRegistryUpdateOne {
+	_u.mutation.SetRequirePowerApproval(v)
+	return _u
+}
+
+// SetNillableRequirePowerApproval sets the "require_power_approval" field if the given value is not nil.
+func (_u *NamespaceRegistryUpdateOne) SetNillableRequirePowerApproval(v *bool) *NamespaceRegistryUpdateOne {
+	if v != nil {
+		_u.SetRequirePowerApproval(*v)
+	}
+	return _u
+}
+
 // Mutation returns the NamespaceRegistryMutation object of the builder.
 func (_u *NamespaceRegistryUpdateOne) Mutation() *NamespaceRegistryMutation {
 	return _u.mutation
@@ -440,6 +471,9 @@ func (_u *NamespaceRegistryUpdateOne) sqlSave(ctx context.Context) (_node *Names
 	if value, ok := _u.mutation.Enabled(); ok {
 		_spec.SetField(namespaceregistry.FieldEnabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.RequirePowerApproval(); ok {
+		_spec.SetField(namespaceregistry.FieldRequirePowerApproval, field.TypeBool, value)
+	}
 	_node = &NamespaceRegistry{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -94,10 +94,12 @@ type Type string
 
 // Type values.
 const (
-	TypeAPPROVAL_PENDING   Type = "APPROVAL_PENDING"
-	TypeAPPROVAL_COMPLETED Type = "APPROVAL_COMPLETED"
-	TypeAPPROVAL_REJECTED  Type = "APPROVAL_REJECTED"
-	TypeVM_STATUS_CHANGE   Type = "VM_STATUS_CHANGE"
+	TypeAPPROVAL_PENDING      Type = "APPROVAL_PENDING"
+	TypeAPPROVAL_COMPLETED    Type = "APPROVAL_COMPLETED"
+	TypeAPPROVAL_REJECTED     Type = "APPROVAL_REJECTED"
+	TypeAPPROVAL_COMMENT      Type = "APPROVAL_COMMENT"
+	TypeVM_STATUS_CHANGE      Type = "VM_STATUS_CHANGE"
+	TypeCLUSTER_STATUS_CHANGE Type = "CLUSTER_STATUS_CHANGE"
 )
 
 func (_type Type) String() string {
@@ -107,7 +109,7 @@ func (_type Type) String() string {
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type Type) error {
 	switch _type {
-	case TypeAPPROVAL_PENDING, TypeAPPROVAL_COMPLETED, TypeAPPROVAL_REJECTED, TypeVM_STATUS_CHANGE:
+	case TypeAPPROVAL_PENDING, TypeAPPROVAL_COMPLETED, TypeAPPROVAL_REJECTED, TypeAPPROVAL_COMMENT, TypeVM_STATUS_CHANGE, TypeCLUSTER_STATUS_CHANGE:
 		return nil
 	default:
 		return fmt.Errorf("notification: invalid enum value for type field: %q", _type)
@@ -0,0 +1,222 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"kv-shepherd.io/shepherd/ent/platformconfig"
+)
+
+// PlatformConfig is the model entity for the PlatformConfig schema.
+type PlatformConfig struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// PENDING tickets older than this are auto-rejected; 0 disables
+	ApprovalTTLHours int `json:"approval_ttl_hours,omitempty"`
+	// SMTP relay for email notifications; empty disables email delivery
+	SMTPHost string `json:"smtp_host,omitempty"`
+	// SMTPPort holds the value of the "smtp_port" field.
+	SMTPPort int `json:"smtp_port,omitempty"`
+	// SMTPUsername holds the value of the "smtp_username" field.
+	SMTPUsername string `json:"smtp_username,omitempty"`
+	// SMTPPassword holds the value of the "smtp_password" field.
+	SMTPPassword string `json:"-"`
+	// SMTPFromAddress holds the value of the "smtp_from_address" field.
+	SMTPFromAddress string `json:"smtp_from_address,omitempty"`
+	// Accept cloud-init user_data on VM create requests
+	AllowUserData bool `json:"allow_user_data,omitempty"`
+	// spec.* paths approvers may set in modified_spec, mapped to the JSON type each accepts
+	SpecOverridePolicy map[string]string `json:"spec_override_policy,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy    string `json:"updated_by,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PlatformConfig) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case platformconfig.FieldSpecOverridePolicy:
+			values[i] = new([]byte)
+		case platformconfig.FieldAllowUserData:
+			values[i] = new(sql.NullBool)
+		case platformconfig.FieldApprovalTTLHours, platformconfig.FieldSMTPPort:
+			values[i] = new(sql.NullInt64)
+		case platformconfig.FieldID, platformconfig.FieldSMTPHost, platformconfig.FieldSMTPUsername, platformconfig.FieldSMTPPassword, platformconfig.FieldSMTPFromAddress, platformconfig.FieldUpdatedBy:
+			values[i] = new(sql.NullString)
+		case platformconfig.FieldCreatedAt, platformconfig.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PlatformConfig fields.
+func (_m *PlatformConfig) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case platformconfig.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case platformconfig.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case platformconfig.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case platformconfig.FieldApprovalTTLHours:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field approval_ttl_hours", values[i])
+			} else if value.Valid {
+				_m.ApprovalTTLHours = int(value.Int64)
+			}
+		case platformconfig.FieldSMTPHost:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field smtp_host", values[i])
+			} else if value.Valid {
+				_m.SMTPHost = value.String
+			}
+		case platformconfig.FieldSMTPPort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field smtp_port", values[i])
+			} else if value.Valid {
+				_m.SMTPPort = int(value.Int64)
+			}
+		case platformconfig.FieldSMTPUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field smtp_username", values[i])
+			} else if value.Valid {
+				_m.SMTPUsername = value.String
+			}
+		case platformconfig.FieldSMTPPassword:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field smtp_password", values[i])
+			} else if value.Valid {
+				_m.SMTPPassword = value.String
+			}
+		case platformconfig.FieldSMTPFromAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field smtp_from_address", values[i])
+			} else if value.Valid {
+				_m.SMTPFromAddress = value.String
+			}
+		case platformconfig.FieldAllowUserData:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field allow_user_data", values[i])
+			} else if value.Valid {
+				_m.AllowUserData = value.Bool
+			}
+		case platformconfig.FieldSpecOverridePolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field spec_override_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.SpecOverridePolicy); err != nil {
+					return fmt.Errorf("unmarshal field spec_override_policy: %w", err)
+				}
+			}
+		case platformconfig.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				_m.UpdatedBy = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PlatformConfig.
+// This includes values selected through modifiers, order, etc.
+func (_m *PlatformConfig) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PlatformConfig.
+// Note that you need to call PlatformConfig.Unwrap() before calling this method if this PlatformConfig
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PlatformConfig) Update() *PlatformConfigUpdateOne {
+	return NewPlatformConfigClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PlatformConfig entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PlatformConfig) Unwrap() *PlatformConfig {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PlatformConfig is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PlatformConfig) String() string {
+	var builder strings.Builder
+	builder.WriteString("PlatformConfig(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("approval_ttl_hours=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ApprovalTTLHours))
+	builder.WriteString(", ")
+	builder.WriteString("smtp_host=")
+	builder.WriteString(_m.SMTPHost)
+	builder.WriteString(", ")
+	builder.WriteString("smtp_port=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SMTPPort))
+	builder.WriteString(", ")
+	builder.WriteString("smtp_username=")
+	builder.WriteString(_m.SMTPUsername)
+	builder.WriteString(", ")
+	builder.WriteString("smtp_password=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("smtp_from_address=")
+	builder.WriteString(_m.SMTPFromAddress)
+	builder.WriteString(", ")
+	builder.WriteString("allow_user_data=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AllowUserData))
+	builder.WriteString(", ")
+	builder.WriteString("spec_override_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SpecOverridePolicy))
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(_m.UpdatedBy)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PlatformConfigs is a parsable slice of PlatformConfig.
+type PlatformConfigs []*PlatformConfig
@@ -0,0 +1,145 @@
+// Code generated by ent, DO NOT EDIT.
+
+package platformconfig
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the platformconfig type in the database.
+	Label = "platform_config"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldApprovalTTLHours holds the string denoting the approval_ttl_hours field in the database.
+	FieldApprovalTTLHours = "approval_ttl_hours"
+	// FieldSMTPHost holds the string denoting the smtp_host field in the database.
+	FieldSMTPHost = "smtp_host"
+	// FieldSMTPPort holds the string denoting the smtp_port field in the database.
+	FieldSMTPPort = "smtp_port"
+	// FieldSMTPUsername holds the string denoting the smtp_username field in the database.
+	FieldSMTPUsername = "smtp_username"
+	// FieldSMTPPassword holds the string denoting the smtp_password field in the database.
+	FieldSMTPPassword = "smtp_password"
+	// FieldSMTPFromAddress holds the string denoting the smtp_from_address field in the database.
+	FieldSMTPFromAddress = "smtp_from_address"
+	// FieldAllowUserData holds the string denoting the allow_user_data field in the database.
+	FieldAllowUserData = "allow_user_data"
+	// FieldSpecOverridePolicy holds the string denoting the spec_override_policy field in the database.
+	FieldSpecOverridePolicy = "spec_override_policy"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// Table holds the table name of the platformconfig in the database.
+	Table = "platform_configs"
+)
+
+// Columns holds all SQL columns for platformconfig fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldApprovalTTLHours,
+	FieldSMTPHost,
+	FieldSMTPPort,
+	FieldSMTPUsername,
+	FieldSMTPPassword,
+	FieldSMTPFromAddress,
+	FieldAllowUserData,
+	FieldSpecOverridePolicy,
+	FieldUpdatedBy,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultApprovalTTLHours holds the default value on creation for the "approval_ttl_hours" field.
+	DefaultApprovalTTLHours int
+	// ApprovalTTLHoursValidator is a validator for the "approval_ttl_hours" field. It is called by the builders before save.
+	ApprovalTTLHoursValidator func(int) error
+	// DefaultSMTPPort holds the default value on creation for the "smtp_port" field.
+	DefaultSMTPPort int
+	// SMTPPortValidator is a validator for the "smtp_port" field. It is called by the builders before save.
+	SMTPPortValidator func(int) error
+	// DefaultAllowUserData holds the default value on creation for the "allow_user_data" field.
+	DefaultAllowUserData bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID string
+)
+
+// OrderOption defines the ordering options for the PlatformConfig queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByApprovalTTLHours orders the results by the approval_ttl_hours field.
+func ByApprovalTTLHours(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovalTTLHours, opts...).ToFunc()
+}
+
+// BySMTPHost orders the results by the smtp_host field.
+func BySMTPHost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSMTPHost, opts...).ToFunc()
+}
+
+// BySMTPPort orders the results by the smtp_port field.
+func BySMTPPort(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSMTPPort, opts...).ToFunc()
+}
+
+// BySMTPUsername orders the results by the smtp_username field.
+func BySMTPUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSMTPUsername, opts...).ToFunc()
+}
+
+// BySMTPPassword orders the results by the smtp_password field.
+func BySMTPPassword(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSMTPPassword, opts...).ToFunc()
+}
+
+// BySMTPFromAddress orders the results by the smtp_from_address field.
+func BySMTPFromAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSMTPFromAddress, opts...).ToFunc()
+}
+
+// ByAllowUserData orders the results by the allow_user_data field.
+func ByAllowUserData(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAllowUserData, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
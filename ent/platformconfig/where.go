@@ -0,0 +1,685 @@
+// Code generated by ent, DO NOT EDIT.
+
+package platformconfig
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"kv-shepherd.io/shepherd/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ApprovalTTLHours applies equality check predicate on the "approval_ttl_hours" field. It's identical to ApprovalTTLHoursEQ.
+func ApprovalTTLHours(v int) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEQ(FieldApprovalTTLHours, v))
+}
+
+// SMTPHost applies equality check predicate on the "smtp_host" field. It's identical to SMTPHostEQ.
+func SMTPHost(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEQ(FieldSMTPHost, v))
+}
+
+// SMTPPort applies equality check predicate on the "smtp_port" field. It's identical to SMTPPortEQ.
+func SMTPPort(v int) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEQ(FieldSMTPPort, v))
+}
+
+// SMTPUsername applies equality check predicate on the "smtp_username" field. It's identical to SMTPUsernameEQ.
+func SMTPUsername(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEQ(FieldSMTPUsername, v))
+}
+
+// SMTPPassword applies equality check predicate on the "smtp_password" field. It's identical to SMTPPasswordEQ.
+func SMTPPassword(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEQ(FieldSMTPPassword, v))
+}
+
+// SMTPFromAddress applies equality check predicate on the "smtp_from_address" field. It's identical to SMTPFromAddressEQ.
+func SMTPFromAddress(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEQ(FieldSMTPFromAddress, v))
+}
+
+// AllowUserData applies equality check predicate on the "allow_user_data" field. It's identical to AllowUserDataEQ.
+func AllowUserData(v bool) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEQ(FieldAllowUserData, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ApprovalTTLHoursEQ applies the EQ predicate on the "approval_ttl_hours" field.
+func ApprovalTTLHoursEQ(v int) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEQ(FieldApprovalTTLHours, v))
+}
+
+// ApprovalTTLHoursNEQ applies the NEQ predicate on the "approval_ttl_hours" field.
+func ApprovalTTLHoursNEQ(v int) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNEQ(FieldApprovalTTLHours, v))
+}
+
+// ApprovalTTLHoursIn applies the In predicate on the "approval_ttl_hours" field.
+func ApprovalTTLHoursIn(vs ...int) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldIn(FieldApprovalTTLHours, vs...))
+}
+
+// ApprovalTTLHoursNotIn applies the NotIn predicate on the "approval_ttl_hours" field.
+func ApprovalTTLHoursNotIn(vs ...int) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNotIn(FieldApprovalTTLHours, vs...))
+}
+
+// ApprovalTTLHoursGT applies the GT predicate on the "approval_ttl_hours" field.
+func ApprovalTTLHoursGT(v int) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldGT(FieldApprovalTTLHours, v))
+}
+
+// ApprovalTTLHoursGTE applies the GTE predicate on the "approval_ttl_hours" field.
+func ApprovalTTLHoursGTE(v int) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldGTE(FieldApprovalTTLHours, v))
+}
+
+// ApprovalTTLHoursLT applies the LT predicate on the "approval_ttl_hours" field.
+func ApprovalTTLHoursLT(v int) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldLT(FieldApprovalTTLHours, v))
+}
+
+// ApprovalTTLHoursLTE applies the LTE predicate on the "approval_ttl_hours" field.
+func ApprovalTTLHoursLTE(v int) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldLTE(FieldApprovalTTLHours, v))
+}
+
+// SMTPHostEQ applies the EQ predicate on the "smtp_host" field.
+func SMTPHostEQ(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEQ(FieldSMTPHost, v))
+}
+
+// SMTPHostNEQ applies the NEQ predicate on the "smtp_host" field.
+func SMTPHostNEQ(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNEQ(FieldSMTPHost, v))
+}
+
+// SMTPHostIn applies the In predicate on the "smtp_host" field.
+func SMTPHostIn(vs ...string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldIn(FieldSMTPHost, vs...))
+}
+
+// SMTPHostNotIn applies the NotIn predicate on the "smtp_host" field.
+func SMTPHostNotIn(vs ...string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNotIn(FieldSMTPHost, vs...))
+}
+
+// SMTPHostGT applies the GT predicate on the "smtp_host" field.
+func SMTPHostGT(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldGT(FieldSMTPHost, v))
+}
+
+// SMTPHostGTE applies the GTE predicate on the "smtp_host" field.
+func SMTPHostGTE(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldGTE(FieldSMTPHost, v))
+}
+
+// SMTPHostLT applies the LT predicate on the "smtp_host" field.
+func SMTPHostLT(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldLT(FieldSMTPHost, v))
+}
+
+// SMTPHostLTE applies the LTE predicate on the "smtp_host" field.
+func SMTPHostLTE(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldLTE(FieldSMTPHost, v))
+}
+
+// SMTPHostContains applies the Contains predicate on the "smtp_host" field.
+func SMTPHostContains(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldContains(FieldSMTPHost, v))
+}
+
+// SMTPHostHasPrefix applies the HasPrefix predicate on the "smtp_host" field.
+func SMTPHostHasPrefix(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldHasPrefix(FieldSMTPHost, v))
+}
+
+// SMTPHostHasSuffix applies the HasSuffix predicate on the "smtp_host" field.
+func SMTPHostHasSuffix(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldHasSuffix(FieldSMTPHost, v))
+}
+
+// SMTPHostIsNil applies the IsNil predicate on the "smtp_host" field.
+func SMTPHostIsNil() predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldIsNull(FieldSMTPHost))
+}
+
+// SMTPHostNotNil applies the NotNil predicate on the "smtp_host" field.
+func SMTPHostNotNil() predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNotNull(FieldSMTPHost))
+}
+
+// SMTPHostEqualFold applies the EqualFold predicate on the "smtp_host" field.
+func SMTPHostEqualFold(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEqualFold(FieldSMTPHost, v))
+}
+
+// SMTPHostContainsFold applies the ContainsFold predicate on the "smtp_host" field.
+func SMTPHostContainsFold(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldContainsFold(FieldSMTPHost, v))
+}
+
+// SMTPPortEQ applies the EQ predicate on the "smtp_port" field.
+func SMTPPortEQ(v int) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEQ(FieldSMTPPort, v))
+}
+
+// SMTPPortNEQ applies the NEQ predicate on the "smtp_port" field.
+func SMTPPortNEQ(v int) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNEQ(FieldSMTPPort, v))
+}
+
+// SMTPPortIn applies the In predicate on the "smtp_port" field.
+func SMTPPortIn(vs ...int) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldIn(FieldSMTPPort, vs...))
+}
+
+// SMTPPortNotIn applies the NotIn predicate on the "smtp_port" field.
+func SMTPPortNotIn(vs ...int) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNotIn(FieldSMTPPort, vs...))
+}
+
+// SMTPPortGT applies the GT predicate on the "smtp_port" field.
+func SMTPPortGT(v int) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldGT(FieldSMTPPort, v))
+}
+
+// SMTPPortGTE applies the GTE predicate on the "smtp_port" field.
+func SMTPPortGTE(v int) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldGTE(FieldSMTPPort, v))
+}
+
+// SMTPPortLT applies the LT predicate on the "smtp_port" field.
+func SMTPPortLT(v int) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldLT(FieldSMTPPort, v))
+}
+
+// SMTPPortLTE applies the LTE predicate on the "smtp_port" field.
+func SMTPPortLTE(v int) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldLTE(FieldSMTPPort, v))
+}
+
+// SMTPUsernameEQ applies the EQ predicate on the "smtp_username" field.
+func SMTPUsernameEQ(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEQ(FieldSMTPUsername, v))
+}
+
+// SMTPUsernameNEQ applies the NEQ predicate on the "smtp_username" field.
+func SMTPUsernameNEQ(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNEQ(FieldSMTPUsername, v))
+}
+
+// SMTPUsernameIn applies the In predicate on the "smtp_username" field.
+func SMTPUsernameIn(vs ...string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldIn(FieldSMTPUsername, vs...))
+}
+
+// SMTPUsernameNotIn applies the NotIn predicate on the "smtp_username" field.
+func SMTPUsernameNotIn(vs ...string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNotIn(FieldSMTPUsername, vs...))
+}
+
+// SMTPUsernameGT applies the GT predicate on the "smtp_username" field.
+func SMTPUsernameGT(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldGT(FieldSMTPUsername, v))
+}
+
+// SMTPUsernameGTE applies the GTE predicate on the "smtp_username" field.
+func SMTPUsernameGTE(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldGTE(FieldSMTPUsername, v))
+}
+
+// SMTPUsernameLT applies the LT predicate on the "smtp_username" field.
+func SMTPUsernameLT(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldLT(FieldSMTPUsername, v))
+}
+
+// SMTPUsernameLTE applies the LTE predicate on the "smtp_username" field.
+func SMTPUsernameLTE(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldLTE(FieldSMTPUsername, v))
+}
+
+// SMTPUsernameContains applies the Contains predicate on the "smtp_username" field.
+func SMTPUsernameContains(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldContains(FieldSMTPUsername, v))
+}
+
+// SMTPUsernameHasPrefix applies the HasPrefix predicate on the "smtp_username" field.
+func SMTPUsernameHasPrefix(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldHasPrefix(FieldSMTPUsername, v))
+}
+
+// SMTPUsernameHasSuffix applies the HasSuffix predicate on the "smtp_username" field.
+func SMTPUsernameHasSuffix(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldHasSuffix(FieldSMTPUsername, v))
+}
+
+// SMTPUsernameIsNil applies the IsNil predicate on the "smtp_username" field.
+func SMTPUsernameIsNil() predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldIsNull(FieldSMTPUsername))
+}
+
+// SMTPUsernameNotNil applies the NotNil predicate on the "smtp_username" field.
+func SMTPUsernameNotNil() predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNotNull(FieldSMTPUsername))
+}
+
+// SMTPUsernameEqualFold applies the EqualFold predicate on the "smtp_username" field.
+func SMTPUsernameEqualFold(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEqualFold(FieldSMTPUsername, v))
+}
+
+// SMTPUsernameContainsFold applies the ContainsFold predicate on the "smtp_username" field.
+func SMTPUsernameContainsFold(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldContainsFold(FieldSMTPUsername, v))
+}
+
+// SMTPPasswordEQ applies the EQ predicate on the "smtp_password" field.
+func SMTPPasswordEQ(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEQ(FieldSMTPPassword, v))
+}
+
+// SMTPPasswordNEQ applies the NEQ predicate on the "smtp_password" field.
+func SMTPPasswordNEQ(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNEQ(FieldSMTPPassword, v))
+}
+
+// SMTPPasswordIn applies the In predicate on the "smtp_password" field.
+func SMTPPasswordIn(vs ...string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldIn(FieldSMTPPassword, vs...))
+}
+
+// SMTPPasswordNotIn applies the NotIn predicate on the "smtp_password" field.
+func SMTPPasswordNotIn(vs ...string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNotIn(FieldSMTPPassword, vs...))
+}
+
+// SMTPPasswordGT applies the GT predicate on the "smtp_password" field.
+func SMTPPasswordGT(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldGT(FieldSMTPPassword, v))
+}
+
+// SMTPPasswordGTE applies the GTE predicate on the "smtp_password" field.
+func SMTPPasswordGTE(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldGTE(FieldSMTPPassword, v))
+}
+
+// SMTPPasswordLT applies the LT predicate on the "smtp_password" field.
+func SMTPPasswordLT(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldLT(FieldSMTPPassword, v))
+}
+
+// SMTPPasswordLTE applies the LTE predicate on the "smtp_password" field.
+func SMTPPasswordLTE(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldLTE(FieldSMTPPassword, v))
+}
+
+// SMTPPasswordContains applies the Contains predicate on the "smtp_password" field.
+func SMTPPasswordContains(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldContains(FieldSMTPPassword, v))
+}
+
+// SMTPPasswordHasPrefix applies the HasPrefix predicate on the "smtp_password" field.
+func SMTPPasswordHasPrefix(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldHasPrefix(FieldSMTPPassword, v))
+}
+
+// SMTPPasswordHasSuffix applies the HasSuffix predicate on the "smtp_password" field.
+func SMTPPasswordHasSuffix(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldHasSuffix(FieldSMTPPassword, v))
+}
+
+// SMTPPasswordIsNil applies the IsNil predicate on the "smtp_password" field.
+func SMTPPasswordIsNil() predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldIsNull(FieldSMTPPassword))
+}
+
+// SMTPPasswordNotNil applies the NotNil predicate on the "smtp_password" field.
+func SMTPPasswordNotNil() predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNotNull(FieldSMTPPassword))
+}
+
+// SMTPPasswordEqualFold applies the EqualFold predicate on the "smtp_password" field.
+func SMTPPasswordEqualFold(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEqualFold(FieldSMTPPassword, v))
+}
+
+// SMTPPasswordContainsFold applies the ContainsFold predicate on the "smtp_password" field.
+func SMTPPasswordContainsFold(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldContainsFold(FieldSMTPPassword, v))
+}
+
+// SMTPFromAddressEQ applies the EQ predicate on the "smtp_from_address" field.
+func SMTPFromAddressEQ(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEQ(FieldSMTPFromAddress, v))
+}
+
+// SMTPFromAddressNEQ applies the NEQ predicate on the "smtp_from_address" field.
+func SMTPFromAddressNEQ(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNEQ(FieldSMTPFromAddress, v))
+}
+
+// SMTPFromAddressIn applies the In predicate on the "smtp_from_address" field.
+func SMTPFromAddressIn(vs ...string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldIn(FieldSMTPFromAddress, vs...))
+}
+
+// SMTPFromAddressNotIn applies the NotIn predicate on the "smtp_from_address" field.
+func SMTPFromAddressNotIn(vs ...string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNotIn(FieldSMTPFromAddress, vs...))
+}
+
+// SMTPFromAddressGT applies the GT predicate on the "smtp_from_address" field.
+func SMTPFromAddressGT(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldGT(FieldSMTPFromAddress, v))
+}
+
+// SMTPFromAddressGTE applies the GTE predicate on the "smtp_from_address" field.
+func SMTPFromAddressGTE(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldGTE(FieldSMTPFromAddress, v))
+}
+
+// SMTPFromAddressLT applies the LT predicate on the "smtp_from_address" field.
+func SMTPFromAddressLT(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldLT(FieldSMTPFromAddress, v))
+}
+
+// SMTPFromAddressLTE applies the LTE predicate on the "smtp_from_address" field.
+func SMTPFromAddressLTE(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldLTE(FieldSMTPFromAddress, v))
+}
+
+// SMTPFromAddressContains applies the Contains predicate on the "smtp_from_address" field.
+func SMTPFromAddressContains(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldContains(FieldSMTPFromAddress, v))
+}
+
+// SMTPFromAddressHasPrefix applies the HasPrefix predicate on the "smtp_from_address" field.
+func SMTPFromAddressHasPrefix(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldHasPrefix(FieldSMTPFromAddress, v))
+}
+
+// SMTPFromAddressHasSuffix applies the HasSuffix predicate on the "smtp_from_address" field.
+func SMTPFromAddressHasSuffix(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldHasSuffix(FieldSMTPFromAddress, v))
+}
+
+// SMTPFromAddressIsNil applies the IsNil predicate on the "smtp_from_address" field.
+func SMTPFromAddressIsNil() predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldIsNull(FieldSMTPFromAddress))
+}
+
+// SMTPFromAddressNotNil applies the NotNil predicate on the "smtp_from_address" field.
+func SMTPFromAddressNotNil() predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNotNull(FieldSMTPFromAddress))
+}
+
+// SMTPFromAddressEqualFold applies the EqualFold predicate on the "smtp_from_address" field.
+func SMTPFromAddressEqualFold(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEqualFold(FieldSMTPFromAddress, v))
+}
+
+// SMTPFromAddressContainsFold applies the ContainsFold predicate on the "smtp_from_address" field.
+func SMTPFromAddressContainsFold(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldContainsFold(FieldSMTPFromAddress, v))
+}
+
+// AllowUserDataEQ applies the EQ predicate on the "allow_user_data" field.
+func AllowUserDataEQ(v bool) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEQ(FieldAllowUserData, v))
+}
+
+// AllowUserDataNEQ applies the NEQ predicate on the "allow_user_data" field.
+func AllowUserDataNEQ(v bool) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNEQ(FieldAllowUserData, v))
+}
+
+// SpecOverridePolicyIsNil applies the IsNil predicate on the "spec_override_policy" field.
+func SpecOverridePolicyIsNil() predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldIsNull(FieldSpecOverridePolicy))
+}
+
+// SpecOverridePolicyNotNil applies the NotNil predicate on the "spec_override_policy" field.
+func SpecOverridePolicyNotNil() predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNotNull(FieldSpecOverridePolicy))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PlatformConfig) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PlatformConfig) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PlatformConfig) predicate.PlatformConfig {
+	return predicate.PlatformConfig(sql.NotPredicates(p))
+}
@@ -0,0 +1,435 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"kv-shepherd.io/shepherd/ent/platformconfig"
+)
+
+// PlatformConfigCreate is the builder for creating a PlatformConfig entity.
+type PlatformConfigCreate struct {
+	config
+	mutation *PlatformConfigMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PlatformConfigCreate) SetCreatedAt(v time.Time) *PlatformConfigCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PlatformConfigCreate) SetNillableCreatedAt(v *time.Time) *PlatformConfigCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PlatformConfigCreate) SetUpdatedAt(v time.Time) *PlatformConfigCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PlatformConfigCreate) SetNillableUpdatedAt(v *time.Time) *PlatformConfigCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetApprovalTTLHours sets the "approval_ttl_hours" field.
+func (_c *PlatformConfigCreate) SetApprovalTTLHours(v int) *PlatformConfigCreate {
+	_c.mutation.SetApprovalTTLHours(v)
+	return _c
+}
+
+// SetNillableApprovalTTLHours sets the "approval_ttl_hours" field if the given value is not nil.
+func (_c *PlatformConfigCreate) SetNillableApprovalTTLHours(v *int) *PlatformConfigCreate {
+	if v != nil {
+		_c.SetApprovalTTLHours(*v)
+	}
+	return _c
+}
+
+// SetSMTPHost sets the "smtp_host" field.
+func (_c *PlatformConfigCreate) SetSMTPHost(v string) *PlatformConfigCreate {
+	_c.mutation.SetSMTPHost(v)
+	return _c
+}
+
+// SetNillableSMTPHost sets the "smtp_host" field if the given value is not nil.
+func (_c *PlatformConfigCreate) SetNillableSMTPHost(v *string) *PlatformConfigCreate {
+	if v != nil {
+		_c.SetSMTPHost(*v)
+	}
+	return _c
+}
+
+// SetSMTPPort sets the "smtp_port" field.
+func (_c *PlatformConfigCreate) SetSMTPPort(v int) *PlatformConfigCreate {
+	_c.mutation.SetSMTPPort(v)
+	return _c
+}
+
+// SetNillableSMTPPort sets the "smtp_port" field if the given value is not nil.
+func (_c *PlatformConfigCreate) SetNillableSMTPPort(v *int) *PlatformConfigCreate {
+	if v != nil {
+		_c.SetSMTPPort(*v)
+	}
+	return _c
+}
+
+// SetSMTPUsername sets the "smtp_username" field.
+func (_c *PlatformConfigCreate) SetSMTPUsername(v string) *PlatformConfigCreate {
+	_c.mutation.SetSMTPUsername(v)
+	return _c
+}
+
+// SetNillableSMTPUsername sets the "smtp_username" field if the given value is not nil.
+func (_c *PlatformConfigCreate) SetNillableSMTPUsername(v *string) *PlatformConfigCreate {
+	if v != nil {
+		_c.SetSMTPUsername(*v)
+	}
+	return _c
+}
+
+// SetSMTPPassword sets the "smtp_password" field.
+func (_c *PlatformConfigCreate) SetSMTPPassword(v string) *PlatformConfigCreate {
+	_c.mutation.SetSMTPPassword(v)
+	return _c
+}
+
+// SetNillableSMTPPassword sets the "smtp_password" field if the given value is not nil.
+func (_c *PlatformConfigCreate) SetNillableSMTPPassword(v *string) *PlatformConfigCreate {
+	if v != nil {
+		_c.SetSMTPPassword(*v)
+	}
+	return _c
+}
+
+// SetSMTPFromAddress sets the "smtp_from_address" field.
+func (_c *PlatformConfigCreate) SetSMTPFromAddress(v string) *PlatformConfigCreate {
+	_c.mutation.SetSMTPFromAddress(v)
+	return _c
+}
+
+// SetNillableSMTPFromAddress sets the "smtp_from_address" field if the given value is not nil.
+func (_c *PlatformConfigCreate) SetNillableSMTPFromAddress(v *string) *PlatformConfigCreate {
+	if v != nil {
+		_c.SetSMTPFromAddress(*v)
+	}
+	return _c
+}
+
+// SetAllowUserData sets the "allow_user_data" field.
+func (_c *PlatformConfigCreate) SetAllowUserData(v bool) *PlatformConfigCreate {
+	_c.mutation.SetAllowUserData(v)
+	return _c
+}
+
+// SetNillableAllowUserData sets the "allow_user_data" field if the given value is not nil.
+func (_c *PlatformConfigCreate) SetNillableAllowUserData(v *bool) *PlatformConfigCreate {
+	if v != nil {
+		_c.SetAllowUserData(*v)
+	}
+	return _c
+}
+
+// SetSpecOverridePolicy sets the "spec_override_policy" field.
+func (_c *PlatformConfigCreate) SetSpecOverridePolicy(v map[string]string) *PlatformConfigCreate {
+	_c.mutation.SetSpecOverridePolicy(v)
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *PlatformConfigCreate) SetUpdatedBy(v string) *PlatformConfigCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *PlatformConfigCreate) SetNillableUpdatedBy(v *string) *PlatformConfigCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PlatformConfigCreate) SetID(v string) *PlatformConfigCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PlatformConfigCreate) SetNillableID(v *string) *PlatformConfigCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PlatformConfigMutation object of the builder.
+func (_c *PlatformConfigCreate) Mutation() *PlatformConfigMutation {
+	return _c.mutation
+}
+
+// Save creates the PlatformConfig in the database.
+func (_c *PlatformConfigCreate) Save(ctx context.Context) (*PlatformConfig, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PlatformConfigCreate) SaveX(ctx context.Context) *PlatformConfig {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlatformConfigCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlatformConfigCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PlatformConfigCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := platformconfig.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := platformconfig.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ApprovalTTLHours(); !ok {
+		v := platformconfig.DefaultApprovalTTLHours
+		_c.mutation.SetApprovalTTLHours(v)
+	}
+	if _, ok := _c.mutation.SMTPPort(); !ok {
+		v := platformconfig.DefaultSMTPPort
+		_c.mutation.SetSMTPPort(v)
+	}
+	if _, ok := _c.mutation.AllowUserData(); !ok {
+		v := platformconfig.DefaultAllowUserData
+		_c.mutation.SetAllowUserData(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := platformconfig.DefaultID
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PlatformConfigCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PlatformConfig.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PlatformConfig.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ApprovalTTLHours(); !ok {
+		return &ValidationError{Name: "approval_ttl_hours", err: errors.New(`ent: missing required field "PlatformConfig.approval_ttl_hours"`)}
+	}
+	if v, ok := _c.mutation.ApprovalTTLHours(); ok {
+		if err := platformconfig.ApprovalTTLHoursValidator(v); err != nil {
+			return &ValidationError{Name: "approval_ttl_hours", err: fmt.Errorf(`ent: validator failed for field "PlatformConfig.approval_ttl_hours": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SMTPPort(); !ok {
+		return &ValidationError{Name: "smtp_port", err: errors.New(`ent: missing required field "PlatformConfig.smtp_port"`)}
+	}
+	if v, ok := _c.mutation.SMTPPort(); ok {
+		if err := platformconfig.SMTPPortValidator(v); err != nil {
+			return &ValidationError{Name: "smtp_port", err: fmt.Errorf(`ent: validator failed for field "PlatformConfig.smtp_port": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AllowUserData(); !ok {
+		return &ValidationError{Name: "allow_user_data", err: errors.New(`ent: missing required field "PlatformConfig.allow_user_data"`)}
+	}
+	return nil
+}
+
+func (_c *PlatformConfigCreate) sqlSave(ctx context.Context) (*PlatformConfig, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PlatformConfig.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PlatformConfigCreate) createSpec() (*PlatformConfig, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PlatformConfig{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(platformconfig.Table, sqlgraph.NewFieldSpec(platformconfig.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(platformconfig.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(platformconfig.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.ApprovalTTLHours(); ok {
+		_spec.SetField(platformconfig.FieldApprovalTTLHours, field.TypeInt, value)
+		_node.ApprovalTTLHours = value
+	}
+	if value, ok := _c.mutation.SMTPHost(); ok {
+		_spec.SetField(platformconfig.FieldSMTPHost, field.TypeString, value)
+		_node.SMTPHost = value
+	}
+	if value, ok := _c.mutation.SMTPPort(); ok {
+		_spec.SetField(platformconfig.FieldSMTPPort, field.TypeInt, value)
+		_node.SMTPPort = value
+	}
+	if value, ok := _c.mutation.SMTPUsername(); ok {
+		_spec.SetField(platformconfig.FieldSMTPUsername, field.TypeString, value)
+		_node.SMTPUsername = value
+	}
+	if value, ok := _c.mutation.SMTPPassword(); ok {
+		_spec.SetField(platformconfig.FieldSMTPPassword, field.TypeString, value)
+		_node.SMTPPassword = value
+	}
+	if value, ok := _c.mutation.SMTPFromAddress(); ok {
+		_spec.SetField(platformconfig.FieldSMTPFromAddress, field.TypeString, value)
+		_node.SMTPFromAddress = value
+	}
+	if value, ok := _c.mutation.AllowUserData(); ok {
+		_spec.SetField(platformconfig.FieldAllowUserData, field.TypeBool, value)
+		_node.AllowUserData = value
+	}
+	if value, ok := _c.mutation.SpecOverridePolicy(); ok {
+		_spec.SetField(platformconfig.FieldSpecOverridePolicy, field.TypeJSON, value)
+		_node.SpecOverridePolicy = value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(platformconfig.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	return _node, _spec
+}
+
+// PlatformConfigCreateBulk is the builder for creating many PlatformConfig entities in bulk.
+type PlatformConfigCreateBulk struct {
+	config
+	err      error
+	builders []*PlatformConfigCreate
+}
+
+// Save creates the PlatformConfig entities in the database.
+func (_c *PlatformConfigCreateBulk) Save(ctx context.Context) ([]*PlatformConfig, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PlatformConfig, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlatformConfigMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PlatformConfigCreateBulk) SaveX(ctx context.Context) []*PlatformConfig {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlatformConfigCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlatformConfigCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
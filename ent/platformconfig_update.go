@@ -0,0 +1,686 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"kv-shepherd.io/shepherd/ent/platformconfig"
+	"kv-shepherd.io/shepherd/ent/predicate"
+)
+
+// PlatformConfigUpdate is the builder for updating PlatformConfig entities.
+type PlatformConfigUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlatformConfigMutation
+}
+
+// Where appends a list predicates to the PlatformConfigUpdate builder.
+func (_u *PlatformConfigUpdate) Where(ps ...predicate.PlatformConfig) *PlatformConfigUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PlatformConfigUpdate) SetUpdatedAt(v time.Time) *PlatformConfigUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetApprovalTTLHours sets the "approval_ttl_hours" field.
+func (_u *PlatformConfigUpdate) SetApprovalTTLHours(v int) *PlatformConfigUpdate {
+	_u.mutation.ResetApprovalTTLHours()
+	_u.mutation.SetApprovalTTLHours(v)
+	return _u
+}
+
+// SetNillableApprovalTTLHours sets the "approval_ttl_hours" field if the given value is not nil.
+func (_u *PlatformConfigUpdate) SetNillableApprovalTTLHours(v *int) *PlatformConfigUpdate {
+	if v != nil {
+		_u.SetApprovalTTLHours(*v)
+	}
+	return _u
+}
+
+// AddApprovalTTLHours adds value to the "approval_ttl_hours" field.
+func (_u *PlatformConfigUpdate) AddApprovalTTLHours(v int) *PlatformConfigUpdate {
+	_u.mutation.AddApprovalTTLHours(v)
+	return _u
+}
+
+// SetSMTPHost sets the "smtp_host" field.
+func (_u *PlatformConfigUpdate) SetSMTPHost(v string) *PlatformConfigUpdate {
+	_u.mutation.SetSMTPHost(v)
+	return _u
+}
+
+// SetNillableSMTPHost sets the "smtp_host" field if the given value is not nil.
+func (_u *PlatformConfigUpdate) SetNillableSMTPHost(v *string) *PlatformConfigUpdate {
+	if v != nil {
+		_u.SetSMTPHost(*v)
+	}
+	return _u
+}
+
+// ClearSMTPHost clears the value of the "smtp_host" field.
+func (_u *PlatformConfigUpdate) ClearSMTPHost() *PlatformConfigUpdate {
+	_u.mutation.ClearSMTPHost()
+	return _u
+}
+
+// SetSMTPPort sets the "smtp_port" field.
+func (_u *PlatformConfigUpdate) SetSMTPPort(v int) *PlatformConfigUpdate {
+	_u.mutation.ResetSMTPPort()
+	_u.mutation.SetSMTPPort(v)
+	return _u
+}
+
+// SetNillableSMTPPort sets the "smtp_port" field if the given value is not nil.
+func (_u *PlatformConfigUpdate) SetNillableSMTPPort(v *int) *PlatformConfigUpdate {
+	if v != nil {
+		_u.SetSMTPPort(*v)
+	}
+	return _u
+}
+
+// AddSMTPPort adds value to the "smtp_port" field.
+func (_u *PlatformConfigUpdate) AddSMTPPort(v int) *PlatformConfigUpdate {
+	_u.mutation.AddSMTPPort(v)
+	return _u
+}
+
+// SetSMTPUsername sets the "smtp_username" field.
+func (_u *PlatformConfigUpdate) SetSMTPUsername(v string) *PlatformConfigUpdate {
+	_u.mutation.SetSMTPUsername(v)
+	return _u
+}
+
+// SetNillableSMTPUsername sets the "smtp_username" field if the given value is not nil.
+func (_u *PlatformConfigUpdate) SetNillableSMTPUsername(v *string) *PlatformConfigUpdate {
+	if v != nil {
+		_u.SetSMTPUsername(*v)
+	}
+	return _u
+}
+
+// ClearSMTPUsername clears the value of the "smtp_username" field.
+func (_u *PlatformConfigUpdate) ClearSMTPUsername() *PlatformConfigUpdate {
+	_u.mutation.ClearSMTPUsername()
+	return _u
+}
+
+// SetSMTPPassword sets the "smtp_password" field.
+func (_u *PlatformConfigUpdate) SetSMTPPassword(v string) *PlatformConfigUpdate {
+	_u.mutation.SetSMTPPassword(v)
+	return _u
+}
+
+// SetNillableSMTPPassword sets the "smtp_password" field if the given value is not nil.
+func (_u *PlatformConfigUpdate) SetNillableSMTPPassword(v *string) *PlatformConfigUpdate {
+	if v != nil {
+		_u.SetSMTPPassword(*v)
+	}
+	return _u
+}
+
+// ClearSMTPPassword clears the value of the "smtp_password" field.
+func (_u *PlatformConfigUpdate) ClearSMTPPassword() *PlatformConfigUpdate {
+	_u.mutation.ClearSMTPPassword()
+	return _u
+}
+
+// SetSMTPFromAddress sets the "smtp_from_address" field.
+func (_u *PlatformConfigUpdate) SetSMTPFromAddress(v string) *PlatformConfigUpdate {
+	_u.mutation.SetSMTPFromAddress(v)
+	return _u
+}
+
+// SetNillableSMTPFromAddress sets the "smtp_from_address" field if the given value is not nil.
+func (_u *PlatformConfigUpdate) SetNillableSMTPFromAddress(v *string) *PlatformConfigUpdate {
+	if v != nil {
+		_u.SetSMTPFromAddress(*v)
+	}
+	return _u
+}
+
+// ClearSMTPFromAddress clears the value of the "smtp_from_address" field.
+func (_u *PlatformConfigUpdate) ClearSMTPFromAddress() *PlatformConfigUpdate {
+	_u.mutation.ClearSMTPFromAddress()
+	return _u
+}
+
+// SetAllowUserData sets the "allow_user_data" field.
+func (_u *PlatformConfigUpdate) SetAllowUserData(v bool) *PlatformConfigUpdate {
+	_u.mutation.SetAllowUserData(v)
+	return _u
+}
+
+// SetNillableAllowUserData sets the "allow_user_data" field if the given value is not nil.
+func (_u *PlatformConfigUpdate) SetNillableAllowUserData(v *bool) *PlatformConfigUpdate {
+	if v != nil {
+		_u.SetAllowUserData(*v)
+	}
+	return _u
+}
+
+// SetSpecOverridePolicy sets the "spec_override_policy" field.
+func (_u *PlatformConfigUpdate) SetSpecOverridePolicy(v map[string]string) *PlatformConfigUpdate {
+	_u.mutation.SetSpecOverridePolicy(v)
+	return _u
+}
+
+// ClearSpecOverridePolicy clears the value of the "spec_override_policy" field.
+func (_u *PlatformConfigUpdate) ClearSpecOverridePolicy() *PlatformConfigUpdate {
+	_u.mutation.ClearSpecOverridePolicy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *PlatformConfigUpdate) SetUpdatedBy(v string) *PlatformConfigUpdate {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *PlatformConfigUpdate) SetNillableUpdatedBy(v *string) *PlatformConfigUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *PlatformConfigUpdate) ClearUpdatedBy() *PlatformConfigUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// Mutation returns the PlatformConfigMutation object of the builder.
+func (_u *PlatformConfigUpdate) Mutation() *PlatformConfigMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PlatformConfigUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlatformConfigUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PlatformConfigUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlatformConfigUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PlatformConfigUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := platformconfig.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlatformConfigUpdate) check() error {
+	if v, ok := _u.mutation.ApprovalTTLHours(); ok {
+		if err := platformconfig.ApprovalTTLHoursValidator(v); err != nil {
+			return &ValidationError{Name: "approval_ttl_hours", err: fmt.Errorf(`ent: validator failed for field "PlatformConfig.approval_ttl_hours": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SMTPPort(); ok {
+		if err := platformconfig.SMTPPortValidator(v); err != nil {
+			return &ValidationError{Name: "smtp_port", err: fmt.Errorf(`ent: validator failed for field "PlatformConfig.smtp_port": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PlatformConfigUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(platformconfig.Table, platformconfig.Columns, sqlgraph.NewFieldSpec(platformconfig.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(platformconfig.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ApprovalTTLHours(); ok {
+		_spec.SetField(platformconfig.FieldApprovalTTLHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedApprovalTTLHours(); ok {
+		_spec.AddField(platformconfig.FieldApprovalTTLHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SMTPHost(); ok {
+		_spec.SetField(platformconfig.FieldSMTPHost, field.TypeString, value)
+	}
+	if _u.mutation.SMTPHostCleared() {
+		_spec.ClearField(platformconfig.FieldSMTPHost, field.TypeString)
+	}
+	if value, ok := _u.mutation.SMTPPort(); ok {
+		_spec.SetField(platformconfig.FieldSMTPPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSMTPPort(); ok {
+		_spec.AddField(platformconfig.FieldSMTPPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SMTPUsername(); ok {
+		_spec.SetField(platformconfig.FieldSMTPUsername, field.TypeString, value)
+	}
+	if _u.mutation.SMTPUsernameCleared() {
+		_spec.ClearField(platformconfig.FieldSMTPUsername, field.TypeString)
+	}
+	if value, ok := _u.mutation.SMTPPassword(); ok {
+		_spec.SetField(platformconfig.FieldSMTPPassword, field.TypeString, value)
+	}
+	if _u.mutation.SMTPPasswordCleared() {
+		_spec.ClearField(platformconfig.FieldSMTPPassword, field.TypeString)
+	}
+	if value, ok := _u.mutation.SMTPFromAddress(); ok {
+		_spec.SetField(platformconfig.FieldSMTPFromAddress, field.TypeString, value)
+	}
+	if _u.mutation.SMTPFromAddressCleared() {
+		_spec.ClearField(platformconfig.FieldSMTPFromAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.AllowUserData(); ok {
+		_spec.SetField(platformconfig.FieldAllowUserData, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SpecOverridePolicy(); ok {
+		_spec.SetField(platformconfig.FieldSpecOverridePolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.SpecOverridePolicyCleared() {
+		_spec.ClearField(platformconfig.FieldSpecOverridePolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(platformconfig.FieldUpdatedBy, field.TypeString, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(platformconfig.FieldUpdatedBy, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{platformconfig.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PlatformConfigUpdateOne is the builder for updating a single PlatformConfig entity.
+type PlatformConfigUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PlatformConfigMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PlatformConfigUpdateOne) SetUpdatedAt(v time.Time) *PlatformConfigUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetApprovalTTLHours sets the "approval_ttl_hours" field.
+func (_u *PlatformConfigUpdateOne) SetApprovalTTLHours(v int) *PlatformConfigUpdateOne {
+	_u.mutation.ResetApprovalTTLHours()
+	_u.mutation.SetApprovalTTLHours(v)
+	return _u
+}
+
+// SetNillableApprovalTTLHours sets the "approval_ttl_hours" field if the given value is not nil.
+func (_u *PlatformConfigUpdateOne) SetNillableApprovalTTLHours(v *int) *PlatformConfigUpdateOne {
+	if v != nil {
+		_u.SetApprovalTTLHours(*v)
+	}
+	return _u
+}
+
+// AddApprovalTTLHours adds value to the "approval_ttl_hours" field.
+func (_u *PlatformConfigUpdateOne) AddApprovalTTLHours(v int) *PlatformConfigUpdateOne {
+	_u.mutation.AddApprovalTTLHours(v)
+	return _u
+}
+
+// SetSMTPHost sets the "smtp_host" field.
+func (_u *PlatformConfigUpdateOne) SetSMTPHost(v string) *PlatformConfigUpdateOne {
+	_u.mutation.SetSMTPHost(v)
+	return _u
+}
+
+// SetNillableSMTPHost sets the "smtp_host" field if the given value is not nil.
+func (_u *PlatformConfigUpdateOne) SetNillableSMTPHost(v *string) *PlatformConfigUpdateOne {
+	if v != nil {
+		_u.SetSMTPHost(*v)
+	}
+	return _u
+}
+
+// ClearSMTPHost clears the value of the "smtp_host" field.
+func (_u *PlatformConfigUpdateOne) ClearSMTPHost() *PlatformConfigUpdateOne {
+	_u.mutation.ClearSMTPHost()
+	return _u
+}
+
+// SetSMTPPort sets the "smtp_port" field.
+func (_u *PlatformConfigUpdateOne) SetSMTPPort(v int) *PlatformConfigUpdateOne {
+	_u.mutation.ResetSMTPPort()
+	_u.mutation.SetSMTPPort(v)
+	return _u
+}
+
+// SetNillableSMTPPort sets the "smtp_port" field if the given value is not nil.
+func (_u *PlatformConfigUpdateOne) SetNillableSMTPPort(v *int) *PlatformConfigUpdateOne {
+	if v != nil {
+		_u.SetSMTPPort(*v)
+	}
+	return _u
+}
+
+// AddSMTPPort adds value to the "smtp_port" field.
+func (_u *PlatformConfigUpdateOne) AddSMTPPort(v int) *PlatformConfigUpdateOne {
+	_u.mutation.AddSMTPPort(v)
+	return _u
+}
+
+// SetSMTPUsername sets the "smtp_username" field.
+func (_u *PlatformConfigUpdateOne) SetSMTPUsername(v string) *PlatformConfigUpdateOne {
+	_u.mutation.SetSMTPUsername(v)
+	return _u
+}
+
+// SetNillableSMTPUsername sets the "smtp_username" field if the given value is not nil.
+func (_u *PlatformConfigUpdateOne) SetNillableSMTPUsername(v *string) *PlatformConfigUpdateOne {
+	if v != nil {
+		_u.SetSMTPUsername(*v)
+	}
+	return _u
+}
+
+// ClearSMTPUsername clears the value of the "smtp_username" field.
+func (_u *PlatformConfigUpdateOne) ClearSMTPUsername() *PlatformConfigUpdateOne {
+	_u.mutation.ClearSMTPUsername()
+	return _u
+}
+
+// SetSMTPPassword sets the "smtp_password" field.
+func (_u *PlatformConfigUpdateOne) SetSMTPPassword(v string) *PlatformConfigUpdateOne {
+	_u.mutation.SetSMTPPassword(v)
+	return _u
+}
+
+// SetNillableSMTPPassword sets the "smtp_password" field if the given value is not nil.
+func (_u *PlatformConfigUpdateOne) SetNillableSMTPPassword(v *string) *PlatformConfigUpdateOne {
+	if v != nil {
+		_u.SetSMTPPassword(*v)
+	}
+	return _u
+}
+
+// ClearSMTPPassword clears the value of the "smtp_password" field.
+func (_u *PlatformConfigUpdateOne) ClearSMTPPassword() *PlatformConfigUpdateOne {
+	_u.mutation.ClearSMTPPassword()
+	return _u
+}
+
+// SetSMTPFromAddress sets the "smtp_from_address" field.
+func (_u *PlatformConfigUpdateOne) SetSMTPFromAddress(v string) *PlatformConfigUpdateOne {
+	_u.mutation.SetSMTPFromAddress(v)
+	return _u
+}
+
+// SetNillableSMTPFromAddress sets the "smtp_from_address" field if the given value is not nil.
+func (_u *PlatformConfigUpdateOne) SetNillableSMTPFromAddress(v *string) *PlatformConfigUpdateOne {
+	if v != nil {
+		_u.SetSMTPFromAddress(*v)
+	}
+	return _u
+}
+
+// ClearSMTPFromAddress clears the value of the "smtp_from_address" field.
+func (_u *PlatformConfigUpdateOne) ClearSMTPFromAddress() *PlatformConfigUpdateOne {
+	_u.mutation.ClearSMTPFromAddress()
+	return _u
+}
+
+// SetAllowUserData sets the "allow_user_data" field.
+func (_u *PlatformConfigUpdateOne) SetAllowUserData(v bool) *PlatformConfigUpdateOne {
+	_u.mutation.SetAllowUserData(v)
+	return _u
+}
+
+// SetNillableAllowUserData sets the "allow_user_data" field if the given value is not nil.
+func (_u *PlatformConfigUpdateOne) SetNillableAllowUserData(v *bool) *PlatformConfigUpdateOne {
+	if v != nil {
+		_u.SetAllowUserData(*v)
+	}
+	return _u
+}
+
+// SetSpecOverridePolicy sets the "spec_override_policy" field.
+func (_u *PlatformConfigUpdateOne) SetSpecOverridePolicy(v map[string]string) *PlatformConfigUpdateOne {
+	_u.mutation.SetSpecOverridePolicy(v)
+	return _u
+}
+
+// ClearSpecOverridePolicy clears the value of the "spec_override_policy" field.
+func (_u *PlatformConfigUpdateOne) ClearSpecOverridePolicy() *PlatformConfigUpdateOne {
+	_u.mutation.ClearSpecOverridePolicy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *PlatformConfigUpdateOne) SetUpdatedBy(v string) *PlatformConfigUpdateOne {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *PlatformConfigUpdateOne) SetNillableUpdatedBy(v *string) *PlatformConfigUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *PlatformConfigUpdateOne) ClearUpdatedBy() *PlatformConfigUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// Mutation returns the PlatformConfigMutation object of the builder.
+func (_u *PlatformConfigUpdateOne) Mutation() *PlatformConfigMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PlatformConfigUpdate builder.
+func (_u *PlatformConfigUpdateOne) Where(ps ...predicate.PlatformConfig) *PlatformConfigUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PlatformConfigUpdateOne) Select(field string, fields ...string) *PlatformConfigUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PlatformConfig entity.
+func (_u *PlatformConfigUpdateOne) Save(ctx context.Context) (*PlatformConfig, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlatformConfigUpdateOne) SaveX(ctx context.Context) *PlatformConfig {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PlatformConfigUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlatformConfigUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PlatformConfigUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := platformconfig.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlatformConfigUpdateOne) check() error {
+	if v, ok := _u.mutation.ApprovalTTLHours(); ok {
+		if err := platformconfig.ApprovalTTLHoursValidator(v); err != nil {
+			return &ValidationError{Name: "approval_ttl_hours", err: fmt.Errorf(`ent: validator failed for field "PlatformConfig.approval_ttl_hours": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SMTPPort(); ok {
+		if err := platformconfig.SMTPPortValidator(v); err != nil {
+			return &ValidationError{Name: "smtp_port", err: fmt.Errorf(`ent: validator failed for field "PlatformConfig.smtp_port": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PlatformConfigUpdateOne) sqlSave(ctx context.Context) (_node *PlatformConfig, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(platformconfig.Table, platformconfig.Columns, sqlgraph.NewFieldSpec(platformconfig.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PlatformConfig.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, platformconfig.FieldID)
+		for _, f := range fields {
+			if !platformconfig.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != platformconfig.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(platformconfig.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ApprovalTTLHours(); ok {
+		_spec.SetField(platformconfig.FieldApprovalTTLHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedApprovalTTLHours(); ok {
+		_spec.AddField(platformconfig.FieldApprovalTTLHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SMTPHost(); ok {
+		_spec.SetField(platformconfig.FieldSMTPHost, field.TypeString, value)
+	}
+	if _u.mutation.SMTPHostCleared() {
+		_spec.ClearField(platformconfig.FieldSMTPHost, field.TypeString)
+	}
+	if value, ok := _u.mutation.SMTPPort(); ok {
+		_spec.SetField(platformconfig.FieldSMTPPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSMTPPort(); ok {
+		_spec.AddField(platformconfig.FieldSMTPPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SMTPUsername(); ok {
+		_spec.SetField(platformconfig.FieldSMTPUsername, field.TypeString, value)
+	}
+	if _u.mutation.SMTPUsernameCleared() {
+		_spec.ClearField(platformconfig.FieldSMTPUsername, field.TypeString)
+	}
+	if value, ok := _u.mutation.SMTPPassword(); ok {
+		_spec.SetField(platformconfig.FieldSMTPPassword, field.TypeString, value)
+	}
+	if _u.mutation.SMTPPasswordCleared() {
+		_spec.ClearField(platformconfig.FieldSMTPPassword, field.TypeString)
+	}
+	if value, ok := _u.mutation.SMTPFromAddress(); ok {
+		_spec.SetField(platformconfig.FieldSMTPFromAddress, field.TypeString, value)
+	}
+	if _u.mutation.SMTPFromAddressCleared() {
+		_spec.ClearField(platformconfig.FieldSMTPFromAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.AllowUserData(); ok {
+		_spec.SetField(platformconfig.FieldAllowUserData, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SpecOverridePolicy(); ok {
+		_spec.SetField(platformconfig.FieldSpecOverridePolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.SpecOverridePolicyCleared() {
+		_spec.ClearField(platformconfig.FieldSpecOverridePolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(platformconfig.FieldUpdatedBy, field.TypeString, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(platformconfig.FieldUpdatedBy, field.TypeString)
+	}
+	_node = &PlatformConfig{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{platformconfig.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,6 +6,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ApprovalDecision is the predicate function for approvaldecision builders.
+type ApprovalDecision func(*sql.Selector)
+
 // ApprovalPolicy is the predicate function for approvalpolicy builders.
 type ApprovalPolicy func(*sql.Selector)
 
@@ -39,30 +42,63 @@ type IdPSyncedGroup func(*sql.Selector)
 // InstanceSize is the predicate function for instancesize builders.
 type InstanceSize func(*sql.Selector)
 
+// InstanceSizeCluster is the predicate function for instancesizecluster builders.
+type InstanceSizeCluster func(*sql.Selector)
+
+// LoginSession is the predicate function for loginsession builders.
+type LoginSession func(*sql.Selector)
+
+// LoginThrottle is the predicate function for loginthrottle builders.
+type LoginThrottle func(*sql.Selector)
+
+// NamespaceQuota is the predicate function for namespacequota builders.
+type NamespaceQuota func(*sql.Selector)
+
 // NamespaceRegistry is the predicate function for namespaceregistry builders.
 type NamespaceRegistry func(*sql.Selector)
 
 // Notification is the predicate function for notification builders.
 type Notification func(*sql.Selector)
 
+// NotificationPreference is the predicate function for notificationpreference builders.
+type NotificationPreference func(*sql.Selector)
+
+// PasswordHistory is the predicate function for passwordhistory builders.
+type PasswordHistory func(*sql.Selector)
+
 // PendingAdoption is the predicate function for pendingadoption builders.
 type PendingAdoption func(*sql.Selector)
 
+// PlatformConfig is the predicate function for platformconfig builders.
+type PlatformConfig func(*sql.Selector)
+
+// Quota is the predicate function for quota builders.
+type Quota func(*sql.Selector)
+
 // RateLimitExemption is the predicate function for ratelimitexemption builders.
 type RateLimitExemption func(*sql.Selector)
 
 // RateLimitUserOverride is the predicate function for ratelimituseroverride builders.
 type RateLimitUserOverride func(*sql.Selector)
 
+// RefreshToken is the predicate function for refreshtoken builders.
+type RefreshToken func(*sql.Selector)
+
 // ResourceRoleBinding is the predicate function for resourcerolebinding builders.
 type ResourceRoleBinding func(*sql.Selector)
 
+// RevokedSession is the predicate function for revokedsession builders.
+type RevokedSession func(*sql.Selector)
+
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
 
 // RoleBinding is the predicate function for rolebinding builders.
 type RoleBinding func(*sql.Selector)
 
+// ScheduledBatchJob is the predicate function for scheduledbatchjob builders.
+type ScheduledBatchJob func(*sql.Selector)
+
 // Service is the predicate function for service builders.
 type Service func(*sql.Selector)
 
@@ -75,11 +111,26 @@ type SystemSecret func(*sql.Selector)
 // Template is the predicate function for template builders.
 type Template func(*sql.Selector)
 
+// TicketComment is the predicate function for ticketcomment builders.
+type TicketComment func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
 // VM is the predicate function for vm builders.
 type VM func(*sql.Selector)
 
+// VMConsoleSession is the predicate function for vmconsolesession builders.
+type VMConsoleSession func(*sql.Selector)
+
+// VMRequestIdempotency is the predicate function for vmrequestidempotency builders.
+type VMRequestIdempotency func(*sql.Selector)
+
 // VMRevision is the predicate function for vmrevision builders.
 type VMRevision func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
+// WebhookEndpoint is the predicate function for webhookendpoint builders.
+type WebhookEndpoint func(*sql.Selector)
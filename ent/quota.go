@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"kv-shepherd.io/shepherd/ent/quota"
+)
+
+// Quota is the model entity for the Quota schema.
+type Quota struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ScopeType holds the value of the "scope_type" field.
+	ScopeType quota.ScopeType `json:"scope_type,omitempty"`
+	// System ID or NamespaceRegistry name the quota applies to
+	ScopeID string `json:"scope_id,omitempty"`
+	// MaxVms holds the value of the "max_vms" field.
+	MaxVms *int `json:"max_vms,omitempty"`
+	// MaxCPUCores holds the value of the "max_cpu_cores" field.
+	MaxCPUCores *int `json:"max_cpu_cores,omitempty"`
+	// MaxMemoryMB holds the value of the "max_memory_mb" field.
+	MaxMemoryMB *int `json:"max_memory_mb,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy    string `json:"created_by,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Quota) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case quota.FieldMaxVms, quota.FieldMaxCPUCores, quota.FieldMaxMemoryMB:
+			values[i] = new(sql.NullInt64)
+		case quota.FieldID, quota.FieldScopeType, quota.FieldScopeID, quota.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case quota.FieldCreatedAt, quota.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Quota fields.
+func (_m *Quota) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case quota.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case quota.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case quota.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case quota.FieldScopeType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope_type", values[i])
+			} else if value.Valid {
+				_m.ScopeType = quota.ScopeType(value.String)
+			}
+		case quota.FieldScopeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope_id", values[i])
+			} else if value.Valid {
+				_m.ScopeID = value.String
+			}
+		case quota.FieldMaxVms:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_vms", values[i])
+			} else if value.Valid {
+				_m.MaxVms = new(int)
+				*_m.MaxVms = int(value.Int64)
+			}
+		case quota.FieldMaxCPUCores:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_cpu_cores", values[i])
+			} else if value.Valid {
+				_m.MaxCPUCores = new(int)
+				*_m.MaxCPUCores = int(value.Int64)
+			}
+		case quota.FieldMaxMemoryMB:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_memory_mb", values[i])
+			} else if value.Valid {
+				_m.MaxMemoryMB = new(int)
+				*_m.MaxMemoryMB = int(value.Int64)
+			}
+		case quota.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Quota.
+// This includes values selected through modifiers, order, etc.
+func (_m *Quota) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Quota.
+// Note that you need to call Quota.Unwrap() before calling this method if this Quota
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Quota) Update() *QuotaUpdateOne {
+	return NewQuotaClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Quota entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Quota) Unwrap() *Quota {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Quota is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Quota) String() string {
+	var builder strings.Builder
+	builder.WriteString("Quota(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("scope_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScopeType))
+	builder.WriteString(", ")
+	builder.WriteString("scope_id=")
+	builder.WriteString(_m.ScopeID)
+	builder.WriteString(", ")
+	if v := _m.MaxVms; v != nil {
+		builder.WriteString("max_vms=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MaxCPUCores; v != nil {
+		builder.WriteString("max_cpu_cores=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MaxMemoryMB; v != nil {
+		builder.WriteString("max_memory_mb=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QuotaSlice is a parsable slice of Quota.
+type QuotaSlice []*Quota
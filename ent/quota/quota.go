@@ -0,0 +1,148 @@
+// Code generated by ent, DO NOT EDIT.
+
+package quota
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the quota type in the database.
+	Label = "quota"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldScopeType holds the string denoting the scope_type field in the database.
+	FieldScopeType = "scope_type"
+	// FieldScopeID holds the string denoting the scope_id field in the database.
+	FieldScopeID = "scope_id"
+	// FieldMaxVms holds the string denoting the max_vms field in the database.
+	FieldMaxVms = "max_vms"
+	// FieldMaxCPUCores holds the string denoting the max_cpu_cores field in the database.
+	FieldMaxCPUCores = "max_cpu_cores"
+	// FieldMaxMemoryMB holds the string denoting the max_memory_mb field in the database.
+	FieldMaxMemoryMB = "max_memory_mb"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// Table holds the table name of the quota in the database.
+	Table = "quota"
+)
+
+// Columns holds all SQL columns for quota fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldScopeType,
+	FieldScopeID,
+	FieldMaxVms,
+	FieldMaxCPUCores,
+	FieldMaxMemoryMB,
+	FieldCreatedBy,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// ScopeIDValidator is a validator for the "scope_id" field. It is called by the builders before save.
+	ScopeIDValidator func(string) error
+	// MaxVmsValidator is a validator for the "max_vms" field. It is called by the builders before save.
+	MaxVmsValidator func(int) error
+	// MaxCPUCoresValidator is a validator for the "max_cpu_cores" field. It is called by the builders before save.
+	MaxCPUCoresValidator func(int) error
+	// MaxMemoryMBValidator is a validator for the "max_memory_mb" field. It is called by the builders before save.
+	MaxMemoryMBValidator func(int) error
+	// CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	CreatedByValidator func(string) error
+)
+
+// ScopeType defines the type for the "scope_type" enum field.
+type ScopeType string
+
+// ScopeType values.
+const (
+	ScopeTypeSystem    ScopeType = "system"
+	ScopeTypeNamespace ScopeType = "namespace"
+)
+
+func (st ScopeType) String() string {
+	return string(st)
+}
+
+// ScopeTypeValidator is a validator for the "scope_type" field enum values. It is called by the builders before save.
+func ScopeTypeValidator(st ScopeType) error {
+	switch st {
+	case ScopeTypeSystem, ScopeTypeNamespace:
+		return nil
+	default:
+		return fmt.Errorf("quota: invalid enum value for scope_type field: %q", st)
+	}
+}
+
+// OrderOption defines the ordering options for the Quota queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByScopeType orders the results by the scope_type field.
+func ByScopeType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScopeType, opts...).ToFunc()
+}
+
+// ByScopeID orders the results by the scope_id field.
+func ByScopeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScopeID, opts...).ToFunc()
+}
+
+// ByMaxVms orders the results by the max_vms field.
+func ByMaxVms(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxVms, opts...).ToFunc()
+}
+
+// ByMaxCPUCores orders the results by the max_cpu_cores field.
+func ByMaxCPUCores(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxCPUCores, opts...).ToFunc()
+}
+
+// ByMaxMemoryMB orders the results by the max_memory_mb field.
+func ByMaxMemoryMB(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxMemoryMB, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
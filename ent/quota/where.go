@@ -0,0 +1,495 @@
+// Code generated by ent, DO NOT EDIT.
+
+package quota
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"kv-shepherd.io/shepherd/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Quota {
+	return predicate.Quota(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Quota {
+	return predicate.Quota(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ScopeID applies equality check predicate on the "scope_id" field. It's identical to ScopeIDEQ.
+func ScopeID(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldScopeID, v))
+}
+
+// MaxVms applies equality check predicate on the "max_vms" field. It's identical to MaxVmsEQ.
+func MaxVms(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldMaxVms, v))
+}
+
+// MaxCPUCores applies equality check predicate on the "max_cpu_cores" field. It's identical to MaxCPUCoresEQ.
+func MaxCPUCores(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldMaxCPUCores, v))
+}
+
+// MaxMemoryMB applies equality check predicate on the "max_memory_mb" field. It's identical to MaxMemoryMBEQ.
+func MaxMemoryMB(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldMaxMemoryMB, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ScopeTypeEQ applies the EQ predicate on the "scope_type" field.
+func ScopeTypeEQ(v ScopeType) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldScopeType, v))
+}
+
+// ScopeTypeNEQ applies the NEQ predicate on the "scope_type" field.
+func ScopeTypeNEQ(v ScopeType) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldScopeType, v))
+}
+
+// ScopeTypeIn applies the In predicate on the "scope_type" field.
+func ScopeTypeIn(vs ...ScopeType) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldScopeType, vs...))
+}
+
+// ScopeTypeNotIn applies the NotIn predicate on the "scope_type" field.
+func ScopeTypeNotIn(vs ...ScopeType) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldScopeType, vs...))
+}
+
+// ScopeIDEQ applies the EQ predicate on the "scope_id" field.
+func ScopeIDEQ(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldScopeID, v))
+}
+
+// ScopeIDNEQ applies the NEQ predicate on the "scope_id" field.
+func ScopeIDNEQ(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldScopeID, v))
+}
+
+// ScopeIDIn applies the In predicate on the "scope_id" field.
+func ScopeIDIn(vs ...string) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldScopeID, vs...))
+}
+
+// ScopeIDNotIn applies the NotIn predicate on the "scope_id" field.
+func ScopeIDNotIn(vs ...string) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldScopeID, vs...))
+}
+
+// ScopeIDGT applies the GT predicate on the "scope_id" field.
+func ScopeIDGT(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldScopeID, v))
+}
+
+// ScopeIDGTE applies the GTE predicate on the "scope_id" field.
+func ScopeIDGTE(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldScopeID, v))
+}
+
+// ScopeIDLT applies the LT predicate on the "scope_id" field.
+func ScopeIDLT(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldScopeID, v))
+}
+
+// ScopeIDLTE applies the LTE predicate on the "scope_id" field.
+func ScopeIDLTE(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldScopeID, v))
+}
+
+// ScopeIDContains applies the Contains predicate on the "scope_id" field.
+func ScopeIDContains(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldContains(FieldScopeID, v))
+}
+
+// ScopeIDHasPrefix applies the HasPrefix predicate on the "scope_id" field.
+func ScopeIDHasPrefix(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldHasPrefix(FieldScopeID, v))
+}
+
+// ScopeIDHasSuffix applies the HasSuffix predicate on the "scope_id" field.
+func ScopeIDHasSuffix(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldHasSuffix(FieldScopeID, v))
+}
+
+// ScopeIDEqualFold applies the EqualFold predicate on the "scope_id" field.
+func ScopeIDEqualFold(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldEqualFold(FieldScopeID, v))
+}
+
+// ScopeIDContainsFold applies the ContainsFold predicate on the "scope_id" field.
+func ScopeIDContainsFold(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldContainsFold(FieldScopeID, v))
+}
+
+// MaxVmsEQ applies the EQ predicate on the "max_vms" field.
+func MaxVmsEQ(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldMaxVms, v))
+}
+
+// MaxVmsNEQ applies the NEQ predicate on the "max_vms" field.
+func MaxVmsNEQ(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldMaxVms, v))
+}
+
+// MaxVmsIn applies the In predicate on the "max_vms" field.
+func MaxVmsIn(vs ...int) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldMaxVms, vs...))
+}
+
+// MaxVmsNotIn applies the NotIn predicate on the "max_vms" field.
+func MaxVmsNotIn(vs ...int) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldMaxVms, vs...))
+}
+
+// MaxVmsGT applies the GT predicate on the "max_vms" field.
+func MaxVmsGT(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldMaxVms, v))
+}
+
+// MaxVmsGTE applies the GTE predicate on the "max_vms" field.
+func MaxVmsGTE(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldMaxVms, v))
+}
+
+// MaxVmsLT applies the LT predicate on the "max_vms" field.
+func MaxVmsLT(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldMaxVms, v))
+}
+
+// MaxVmsLTE applies the LTE predicate on the "max_vms" field.
+func MaxVmsLTE(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldMaxVms, v))
+}
+
+// MaxVmsIsNil applies the IsNil predicate on the "max_vms" field.
+func MaxVmsIsNil() predicate.Quota {
+	return predicate.Quota(sql.FieldIsNull(FieldMaxVms))
+}
+
+// MaxVmsNotNil applies the NotNil predicate on the "max_vms" field.
+func MaxVmsNotNil() predicate.Quota {
+	return predicate.Quota(sql.FieldNotNull(FieldMaxVms))
+}
+
+// MaxCPUCoresEQ applies the EQ predicate on the "max_cpu_cores" field.
+func MaxCPUCoresEQ(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldMaxCPUCores, v))
+}
+
+// MaxCPUCoresNEQ applies the NEQ predicate on the "max_cpu_cores" field.
+func MaxCPUCoresNEQ(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldMaxCPUCores, v))
+}
+
+// MaxCPUCoresIn applies the In predicate on the "max_cpu_cores" field.
+func MaxCPUCoresIn(vs ...int) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldMaxCPUCores, vs...))
+}
+
+// MaxCPUCoresNotIn applies the NotIn predicate on the "max_cpu_cores" field.
+func MaxCPUCoresNotIn(vs ...int) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldMaxCPUCores, vs...))
+}
+
+// MaxCPUCoresGT applies the GT predicate on the "max_cpu_cores" field.
+func MaxCPUCoresGT(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldMaxCPUCores, v))
+}
+
+// MaxCPUCoresGTE applies the GTE predicate on the "max_cpu_cores" field.
+func MaxCPUCoresGTE(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldMaxCPUCores, v))
+}
+
+// MaxCPUCoresLT applies the LT predicate on the "max_cpu_cores" field.
+func MaxCPUCoresLT(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldMaxCPUCores, v))
+}
+
+// MaxCPUCoresLTE applies the LTE predicate on the "max_cpu_cores" field.
+func MaxCPUCoresLTE(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldMaxCPUCores, v))
+}
+
+// MaxCPUCoresIsNil applies the IsNil predicate on the "max_cpu_cores" field.
+func MaxCPUCoresIsNil() predicate.Quota {
+	return predicate.Quota(sql.FieldIsNull(FieldMaxCPUCores))
+}
+
+// MaxCPUCoresNotNil applies the NotNil predicate on the "max_cpu_cores" field.
+func MaxCPUCoresNotNil() predicate.Quota {
+	return predicate.Quota(sql.FieldNotNull(FieldMaxCPUCores))
+}
+
+// MaxMemoryMBEQ applies the EQ predicate on the "max_memory_mb" field.
+func MaxMemoryMBEQ(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldMaxMemoryMB, v))
+}
+
+// MaxMemoryMBNEQ applies the NEQ predicate on the "max_memory_mb" field.
+func MaxMemoryMBNEQ(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldMaxMemoryMB, v))
+}
+
+// MaxMemoryMBIn applies the In predicate on the "max_memory_mb" field.
+func MaxMemoryMBIn(vs ...int) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldMaxMemoryMB, vs...))
+}
+
+// MaxMemoryMBNotIn applies the NotIn predicate on the "max_memory_mb" field.
+func MaxMemoryMBNotIn(vs ...int) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldMaxMemoryMB, vs...))
+}
+
+// MaxMemoryMBGT applies the GT predicate on the "max_memory_mb" field.
+func MaxMemoryMBGT(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldMaxMemoryMB, v))
+}
+
+// MaxMemoryMBGTE applies the GTE predicate on the "max_memory_mb" field.
+func MaxMemoryMBGTE(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldMaxMemoryMB, v))
+}
+
+// MaxMemoryMBLT applies the LT predicate on the "max_memory_mb" field.
+func MaxMemoryMBLT(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldMaxMemoryMB, v))
+}
+
+// MaxMemoryMBLTE applies the LTE predicate on the "max_memory_mb" field.
+func MaxMemoryMBLTE(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldMaxMemoryMB, v))
+}
+
+// MaxMemoryMBIsNil applies the IsNil predicate on the "max_memory_mb" field.
+func MaxMemoryMBIsNil() predicate.Quota {
+	return predicate.Quota(sql.FieldIsNull(FieldMaxMemoryMB))
+}
+
+// MaxMemoryMBNotNil applies the NotNil predicate on the "max_memory_mb" field.
+func MaxMemoryMBNotNil() predicate.Quota {
+	return predicate.Quota(sql.FieldNotNull(FieldMaxMemoryMB))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Quota) predicate.Quota {
+	return predicate.Quota(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Quota) predicate.Quota {
+	return predicate.Quota(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Quota) predicate.Quota {
+	return predicate.Quota(sql.NotPredicates(p))
+}
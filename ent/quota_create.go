@@ -0,0 +1,361 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"kv-shepherd.io/shepherd/ent/quota"
+)
+
+// QuotaCreate is the builder for creating a Quota entity.
+type QuotaCreate struct {
+	config
+	mutation *QuotaMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *QuotaCreate) SetCreatedAt(v time.Time) *QuotaCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *QuotaCreate) SetNillableCreatedAt(v *time.Time) *QuotaCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *QuotaCreate) SetUpdatedAt(v time.Time) *QuotaCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *QuotaCreate) SetNillableUpdatedAt(v *time.Time) *QuotaCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetScopeType sets the "scope_type" field.
+func (_c *QuotaCreate) SetScopeType(v quota.ScopeType) *QuotaCreate {
+	_c.mutation.SetScopeType(v)
+	return _c
+}
+
+// SetScopeID sets the "scope_id" field.
+func (_c *QuotaCreate) SetScopeID(v string) *QuotaCreate {
+	_c.mutation.SetScopeID(v)
+	return _c
+}
+
+// SetMaxVms sets the "max_vms" field.
+func (_c *QuotaCreate) SetMaxVms(v int) *QuotaCreate {
+	_c.mutation.SetMaxVms(v)
+	return _c
+}
+
+// SetNillableMaxVms sets the "max_vms" field if the given value is not nil.
+func (_c *QuotaCreate) SetNillableMaxVms(v *int) *QuotaCreate {
+	if v != nil {
+		_c.SetMaxVms(*v)
+	}
+	return _c
+}
+
+// SetMaxCPUCores sets the "max_cpu_cores" field.
+func (_c *QuotaCreate) SetMaxCPUCores(v int) *QuotaCreate {
+	_c.mutation.SetMaxCPUCores(v)
+	return _c
+}
+
+// SetNillableMaxCPUCores sets the "max_cpu_cores" field if the given value is not nil.
+func (_c *QuotaCreate) SetNillableMaxCPUCores(v *int) *QuotaCreate {
+	if v != nil {
+		_c.SetMaxCPUCores(*v)
+	}
+	return _c
+}
+
+// SetMaxMemoryMB sets the "max_memory_mb" field.
+func (_c *QuotaCreate) SetMaxMemoryMB(v int) *QuotaCreate {
+	_c.mutation.SetMaxMemoryMB(v)
+	return _c
+}
+
+// SetNillableMaxMemoryMB sets the "max_memory_mb" field if the given value is not nil.
+func (_c *QuotaCreate) SetNillableMaxMemoryMB(v *int) *QuotaCreate {
+	if v != nil {
+		_c.SetMaxMemoryMB(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *QuotaCreate) SetCreatedBy(v string) *QuotaCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *QuotaCreate) SetID(v string) *QuotaCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the QuotaMutation object of the builder.
+func (_c *QuotaCreate) Mutation() *QuotaMutation {
+	return _c.mutation
+}
+
+// Save creates the Quota in the database.
+func (_c *QuotaCreate) Save(ctx context.Context) (*Quota, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *QuotaCreate) SaveX(ctx context.Context) *Quota {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuotaCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuotaCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *QuotaCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := quota.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := quota.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *QuotaCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Quota.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Quota.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ScopeType(); !ok {
+		return &ValidationError{Name: "scope_type", err: errors.New(`ent: missing required field "Quota.scope_type"`)}
+	}
+	if v, ok := _c.mutation.ScopeType(); ok {
+		if err := quota.ScopeTypeValidator(v); err != nil {
+			return &ValidationError{Name: "scope_type", err: fmt.Errorf(`ent: validator failed for field "Quota.scope_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ScopeID(); !ok {
+		return &ValidationError{Name: "scope_id", err: errors.New(`ent: missing required field "Quota.scope_id"`)}
+	}
+	if v, ok := _c.mutation.ScopeID(); ok {
+		if err := quota.ScopeIDValidator(v); err != nil {
+			return &ValidationError{Name: "scope_id", err: fmt.Errorf(`ent: validator failed for field "Quota.scope_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MaxVms(); ok {
+		if err := quota.MaxVmsValidator(v); err != nil {
+			return &ValidationError{Name: "max_vms", err: fmt.Errorf(`ent: validator failed for field "Quota.max_vms": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MaxCPUCores(); ok {
+		if err := quota.MaxCPUCoresValidator(v); err != nil {
+			return &ValidationError{Name: "max_cpu_cores", err: fmt.Errorf(`ent: validator failed for field "Quota.max_cpu_cores": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MaxMemoryMB(); ok {
+		if err := quota.MaxMemoryMBValidator(v); err != nil {
+			return &ValidationError{Name: "max_memory_mb", err: fmt.Errorf(`ent: validator failed for field "Quota.max_memory_mb": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "Quota.created_by"`)}
+	}
+	if v, ok := _c.mutation.CreatedBy(); ok {
+		if err := quota.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Quota.created_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *QuotaCreate) sqlSave(ctx context.Context) (*Quota, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Quota.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *QuotaCreate) createSpec() (*Quota, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Quota{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(quota.Table, sqlgraph.NewFieldSpec(quota.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(quota.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(quota.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.ScopeType(); ok {
+		_spec.SetField(quota.FieldScopeType, field.TypeEnum, value)
+		_node.ScopeType = value
+	}
+	if value, ok := _c.mutation.ScopeID(); ok {
+		_spec.SetField(quota.FieldScopeID, field.TypeString, value)
+		_node.ScopeID = value
+	}
+	if value, ok := _c.mutation.MaxVms(); ok {
+		_spec.SetField(quota.FieldMaxVms, field.TypeInt, value)
+		_node.MaxVms = &value
+	}
+	if value, ok := _c.mutation.MaxCPUCores(); ok {
+		_spec.SetField(quota.FieldMaxCPUCores, field.TypeInt, value)
+		_node.MaxCPUCores = &value
+	}
+	if value, ok := _c.mutation.MaxMemoryMB(); ok {
+		_spec.SetField(quota.FieldMaxMemoryMB, field.TypeInt, value)
+		_node.MaxMemoryMB = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(quota.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	return _node, _spec
+}
+
+// QuotaCreateBulk is the builder for creating many Quota entities in bulk.
+type QuotaCreateBulk struct {
+	config
+	err      error
+	builders []*QuotaCreate
+}
+
+// Save creates the Quota entities in the database.
+func (_c *QuotaCreateBulk) Save(ctx context.Context) ([]*Quota, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Quota, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuotaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *QuotaCreateBulk) SaveX(ctx context.Context) []*Quota {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuotaCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuotaCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
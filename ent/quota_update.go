@@ -0,0 +1,518 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"kv-shepherd.io/shepherd/ent/predicate"
+	"kv-shepherd.io/shepherd/ent/quota"
+)
+
+// QuotaUpdate is the builder for updating Quota entities.
+type QuotaUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QuotaMutation
+}
+
+// Where appends a list predicates to the QuotaUpdate builder.
+func (_u *QuotaUpdate) Where(ps ...predicate.Quota) *QuotaUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *QuotaUpdate) SetUpdatedAt(v time.Time) *QuotaUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetMaxVms sets the "max_vms" field.
+func (_u *QuotaUpdate) SetMaxVms(v int) *QuotaUpdate {
+	_u.mutation.ResetMaxVms()
+	_u.mutation.SetMaxVms(v)
+	return _u
+}
+
+// SetNillableMaxVms sets the "max_vms" field if the given value is not nil.
+func (_u *QuotaUpdate) SetNillableMaxVms(v *int) *QuotaUpdate {
+	if v != nil {
+		_u.SetMaxVms(*v)
+	}
+	return _u
+}
+
+// AddMaxVms adds value to the "max_vms" field.
+func (_u *QuotaUpdate) AddMaxVms(v int) *QuotaUpdate {
+	_u.mutation.AddMaxVms(v)
+	return _u
+}
+
+// ClearMaxVms clears the value of the "max_vms" field.
+func (_u *QuotaUpdate) ClearMaxVms() *QuotaUpdate {
+	_u.mutation.ClearMaxVms()
+	return _u
+}
+
+// SetMaxCPUCores sets the "max_cpu_cores" field.
+func (_u *QuotaUpdate) SetMaxCPUCores(v int) *QuotaUpdate {
+	_u.mutation.ResetMaxCPUCores()
+	_u.mutation.SetMaxCPUCores(v)
+	return _u
+}
+
+// SetNillableMaxCPUCores sets the "max_cpu_cores" field if the given value is not nil.
+func (_u *QuotaUpdate) SetNillableMaxCPUCores(v *int) *QuotaUpdate {
+	if v != nil {
+		_u.SetMaxCPUCores(*v)
+	}
+	return _u
+}
+
+// AddMaxCPUCores adds value to the "max_cpu_cores" field.
+func (_u *QuotaUpdate) AddMaxCPUCores(v int) *QuotaUpdate {
+	_u.mutation.AddMaxCPUCores(v)
+	return _u
+}
+
+// ClearMaxCPUCores clears the value of the "max_cpu_cores" field.
+func (_u *QuotaUpdate) ClearMaxCPUCores() *QuotaUpdate {
+	_u.mutation.ClearMaxCPUCores()
+	return _u
+}
+
+// SetMaxMemoryMB sets the "max_memory_mb" field.
+func (_u *QuotaUpdate) SetMaxMemoryMB(v int) *QuotaUpdate {
+	_u.mutation.ResetMaxMemoryMB()
+	_u.mutation.SetMaxMemoryMB(v)
+	return _u
+}
+
+// SetNillableMaxMemoryMB sets the "max_memory_mb" field if the given value is not nil.
+func (_u *QuotaUpdate) SetNillableMaxMemoryMB(v *int) *QuotaUpdate {
+	if v != nil {
+		_u.SetMaxMemoryMB(*v)
+	}
+	return _u
+}
+
+// AddMaxMemoryMB adds value to the "max_memory_mb" field.
+func (_u *QuotaUpdate) AddMaxMemoryMB(v int) *QuotaUpdate {
+	_u.mutation.AddMaxMemoryMB(v)
+	return _u
+}
+
+// ClearMaxMemoryMB clears the value of the "max_memory_mb" field.
+func (_u *QuotaUpdate) ClearMaxMemoryMB() *QuotaUpdate {
+	_u.mutation.ClearMaxMemoryMB()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *QuotaUpdate) SetCreatedBy(v string) *QuotaUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *QuotaUpdate) SetNillableCreatedBy(v *string) *QuotaUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// Mutation returns the QuotaMutation object of the builder.
+func (_u *QuotaUpdate) Mutation() *QuotaMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *QuotaUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuotaUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *QuotaUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuotaUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *QuotaUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := quota.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QuotaUpdate) check() error {
+	if v, ok := _u.mutation.MaxVms(); ok {
+		if err := quota.MaxVmsValidator(v); err != nil {
+			return &ValidationError{Name: "max_vms", err: fmt.Errorf(`ent: validator failed for field "Quota.max_vms": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxCPUCores(); ok {
+		if err := quota.MaxCPUCoresValidator(v); err != nil {
+			return &ValidationError{Name: "max_cpu_cores", err: fmt.Errorf(`ent: validator failed for field "Quota.max_cpu_cores": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxMemoryMB(); ok {
+		if err := quota.MaxMemoryMBValidator(v); err != nil {
+			return &ValidationError{Name: "max_memory_mb", err: fmt.Errorf(`ent: validator failed for field "Quota.max_memory_mb": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreatedBy(); ok {
+		if err := quota.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Quota.created_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *QuotaUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(quota.Table, quota.Columns, sqlgraph.NewFieldSpec(quota.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(quota.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.MaxVms(); ok {
+		_spec.SetField(quota.FieldMaxVms, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxVms(); ok {
+		_spec.AddField(quota.FieldMaxVms, field.TypeInt, value)
+	}
+	if _u.mutation.MaxVmsCleared() {
+		_spec.ClearField(quota.FieldMaxVms, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MaxCPUCores(); ok {
+		_spec.SetField(quota.FieldMaxCPUCores, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxCPUCores(); ok {
+		_spec.AddField(quota.FieldMaxCPUCores, field.TypeInt, value)
+	}
+	if _u.mutation.MaxCPUCoresCleared() {
+		_spec.ClearField(quota.FieldMaxCPUCores, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MaxMemoryMB(); ok {
+		_spec.SetField(quota.FieldMaxMemoryMB, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxMemoryMB(); ok {
+		_spec.AddField(quota.FieldMaxMemoryMB, field.TypeInt, value)
+	}
+	if _u.mutation.MaxMemoryMBCleared() {
+		_spec.ClearField(quota.FieldMaxMemoryMB, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(quota.FieldCreatedBy, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quota.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// QuotaUpdateOne is the builder for updating a single Quota entity.
+type QuotaUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QuotaMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *QuotaUpdateOne) SetUpdatedAt(v time.Time) *QuotaUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetMaxVms sets the "max_vms" field.
+func (_u *QuotaUpdateOne) SetMaxVms(v int) *QuotaUpdateOne {
+	_u.mutation.ResetMaxVms()
+	_u.mutation.SetMaxVms(v)
+	return _u
+}
+
+// SetNillableMaxVms sets the "max_vms" field if the given value is not nil.
+func (_u *QuotaUpdateOne) SetNillableMaxVms(v *int) *QuotaUpdateOne {
+	if v != nil {
+		_u.SetMaxVms(*v)
+	}
+	return _u
+}
+
+// AddMaxVms adds value to the "max_vms" field.
+func (_u *QuotaUpdateOne) AddMaxVms(v int) *QuotaUpdateOne {
+	_u.mutation.AddMaxVms(v)
+	return _u
+}
+
+// ClearMaxVms clears the value of the "max_vms" field.
+func (_u *QuotaUpdateOne) ClearMaxVms() *QuotaUpdateOne {
+	_u.mutation.ClearMaxVms()
+	return _u
+}
+
+// SetMaxCPUCores sets the "max_cpu_cores" field.
+func (_u *QuotaUpdateOne) SetMaxCPUCores(v int) *QuotaUpdateOne {
+	_u.mutation.ResetMaxCPUCores()
+	_u.mutation.SetMaxCPUCores(v)
+	return _u
+}
+
+// SetNillableMaxCPUCores sets the "max_cpu_cores" field if the given value is not nil.
+func (_u *QuotaUpdateOne) SetNillableMaxCPUCores(v *int) *QuotaUpdateOne {
+	if v != nil {
+		_u.SetMaxCPUCores(*v)
+	}
+	return _u
+}
+
+// AddMaxCPUCores adds value to the "max_cpu_cores" field.
+func (_u *QuotaUpdateOne) AddMaxCPUCores(v int) *QuotaUpdateOne {
+	_u.mutation.AddMaxCPUCores(v)
+	return _u
+}
+
+// ClearMaxCPUCores clears the value of the "max_cpu_cores" field.
+func (_u *QuotaUpdateOne) ClearMaxCPUCores() *QuotaUpdateOne {
+	_u.mutation.ClearMaxCPUCores()
+	return _u
+}
+
+// SetMaxMemoryMB sets the "max_memory_mb" field.
+func (_u *QuotaUpdateOne) SetMaxMemoryMB(v int) *QuotaUpdateOne {
+	_u.mutation.ResetMaxMemoryMB()
+	_u.mutation.SetMaxMemoryMB(v)
+	return _u
+}
+
+// SetNillableMaxMemoryMB sets the "max_memory_mb" field if the given value is not nil.
+func (_u *QuotaUpdateOne) SetNillableMaxMemoryMB(v *int) *QuotaUpdateOne {
+	if v != nil {
+		_u.SetMaxMemoryMB(*v)
+	}
+	return _u
+}
+
+// AddMaxMemoryMB adds value to the "max_memory_mb" field.
+func (_u *QuotaUpdateOne) AddMaxMemoryMB(v int) *QuotaUpdateOne {
+	_u.mutation.AddMaxMemoryMB(v)
+	return _u
+}
+
+// ClearMaxMemoryMB clears the value of the "max_memory_mb" field.
+func (_u *QuotaUpdateOne) ClearMaxMemoryMB() *QuotaUpdateOne {
+	_u.mutation.ClearMaxMemoryMB()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *QuotaUpdateOne) SetCreatedBy(v string) *QuotaUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *QuotaUpdateOne) SetNillableCreatedBy(v *string) *QuotaUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// Mutation returns the QuotaMutation object of the builder.
+func (_u *QuotaUpdateOne) Mutation() *QuotaMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the QuotaUpdate builder.
+func (_u *QuotaUpdateOne) Where(ps ...predicate.Quota) *QuotaUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *QuotaUpdateOne) Select(field string, fields ...string) *QuotaUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Quota entity.
+func (_u *QuotaUpdateOne) Save(ctx context.Context) (*Quota, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuotaUpdateOne) SaveX(ctx context.Context) *Quota {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *QuotaUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuotaUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *QuotaUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := quota.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QuotaUpdateOne) check() error {
+	if v, ok := _u.mutation.MaxVms(); ok {
+		if err := quota.MaxVmsValidator(v); err != nil {
+			return &ValidationError{Name: "max_vms", err: fmt.Errorf(`ent: validator failed for field "Quota.max_vms": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxCPUCores(); ok {
+		if err := quota.MaxCPUCoresValidator(v); err != nil {
+			return &ValidationError{Name: "max_cpu_cores", err: fmt.Errorf(`ent: validator failed for field "Quota.max_cpu_cores": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxMemoryMB(); ok {
+		if err := quota.MaxMemoryMBValidator(v); err != nil {
+			return &ValidationError{Name: "max_memory_mb", err: fmt.Errorf(`ent: validator failed for field "Quota.max_memory_mb": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreatedBy(); ok {
+		if err := quota.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Quota.created_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *QuotaUpdateOne) sqlSave(ctx context.Context) (_node *Quota, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(quota.Table, quota.Columns, sqlgraph.NewFieldSpec(quota.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Quota.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, quota.FieldID)
+		for _, f := range fields {
+			if !quota.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != quota.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(quota.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.MaxVms(); ok {
+		_spec.SetField(quota.FieldMaxVms, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxVms(); ok {
+		_spec.AddField(quota.FieldMaxVms, field.TypeInt, value)
+	}
+	if _u.mutation.MaxVmsCleared() {
+		_spec.ClearField(quota.FieldMaxVms, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MaxCPUCores(); ok {
+		_spec.SetField(quota.FieldMaxCPUCores, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxCPUCores(); ok {
+		_spec.AddField(quota.FieldMaxCPUCores, field.TypeInt, value)
+	}
+	if _u.mutation.MaxCPUCoresCleared() {
+		_spec.ClearField(quota.FieldMaxCPUCores, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MaxMemoryMB(); ok {
+		_spec.SetField(quota.FieldMaxMemoryMB, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxMemoryMB(); ok {
+		_spec.AddField(quota.FieldMaxMemoryMB, field.TypeInt, value)
+	}
+	if _u.mutation.MaxMemoryMBCleared() {
+		_spec.ClearField(quota.FieldMaxMemoryMB, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(quota.FieldCreatedBy, field.TypeString, value)
+	}
+	_node = &Quota{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quota.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
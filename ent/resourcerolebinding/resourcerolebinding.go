@@ -79,10 +79,11 @@ const DefaultRole = RoleViewer
 
 // Role values.
 const (
-	RoleOwner  Role = "owner"
-	RoleAdmin  Role = "admin"
-	RoleMember Role = "member"
-	RoleViewer Role = "viewer"
+	RoleOwner      Role = "owner"
+	RoleAdmin      Role = "admin"
+	RoleMaintainer Role = "maintainer"
+	RoleMember     Role = "member"
+	RoleViewer     Role = "viewer"
 )
 
 func (r Role) String() string {
@@ -92,7 +93,7 @@ func (r Role) String() string {
 // RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
 func RoleValidator(r Role) error {
 	switch r {
-	case RoleOwner, RoleAdmin, RoleMember, RoleViewer:
+	case RoleOwner, RoleAdmin, RoleMaintainer, RoleMember, RoleViewer:
 		return nil
 	default:
 		return fmt.Errorf("resourcerolebinding: invalid enum value for role field: %q", r)
@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package revokedsession
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"kv-shepherd.io/shepherd/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// RevokedBefore applies equality check predicate on the "revoked_before" field. It's identical to RevokedBeforeEQ.
+func RevokedBefore(v time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldEQ(FieldRevokedBefore, v))
+}
+
+// RevokedBy applies equality check predicate on the "revoked_by" field. It's identical to RevokedByEQ.
+func RevokedBy(v string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldEQ(FieldRevokedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// RevokedBeforeEQ applies the EQ predicate on the "revoked_before" field.
+func RevokedBeforeEQ(v time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldEQ(FieldRevokedBefore, v))
+}
+
+// RevokedBeforeNEQ applies the NEQ predicate on the "revoked_before" field.
+func RevokedBeforeNEQ(v time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldNEQ(FieldRevokedBefore, v))
+}
+
+// RevokedBeforeIn applies the In predicate on the "revoked_before" field.
+func RevokedBeforeIn(vs ...time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldIn(FieldRevokedBefore, vs...))
+}
+
+// RevokedBeforeNotIn applies the NotIn predicate on the "revoked_before" field.
+func RevokedBeforeNotIn(vs ...time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldNotIn(FieldRevokedBefore, vs...))
+}
+
+// RevokedBeforeGT applies the GT predicate on the "revoked_before" field.
+func RevokedBeforeGT(v time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldGT(FieldRevokedBefore, v))
+}
+
+// RevokedBeforeGTE applies the GTE predicate on the "revoked_before" field.
+func RevokedBeforeGTE(v time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldGTE(FieldRevokedBefore, v))
+}
+
+// RevokedBeforeLT applies the LT predicate on the "revoked_before" field.
+func RevokedBeforeLT(v time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldLT(FieldRevokedBefore, v))
+}
+
+// RevokedBeforeLTE applies the LTE predicate on the "revoked_before" field.
+func RevokedBeforeLTE(v time.Time) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldLTE(FieldRevokedBefore, v))
+}
+
+// RevokedByEQ applies the EQ predicate on the "revoked_by" field.
+func RevokedByEQ(v string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldEQ(FieldRevokedBy, v))
+}
+
+// RevokedByNEQ applies the NEQ predicate on the "revoked_by" field.
+func RevokedByNEQ(v string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldNEQ(FieldRevokedBy, v))
+}
+
+// RevokedByIn applies the In predicate on the "revoked_by" field.
+func RevokedByIn(vs ...string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldIn(FieldRevokedBy, vs...))
+}
+
+// RevokedByNotIn applies the NotIn predicate on the "revoked_by" field.
+func RevokedByNotIn(vs ...string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldNotIn(FieldRevokedBy, vs...))
+}
+
+// RevokedByGT applies the GT predicate on the "revoked_by" field.
+func RevokedByGT(v string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldGT(FieldRevokedBy, v))
+}
+
+// RevokedByGTE applies the GTE predicate on the "revoked_by" field.
+func RevokedByGTE(v string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldGTE(FieldRevokedBy, v))
+}
+
+// RevokedByLT applies the LT predicate on the "revoked_by" field.
+func RevokedByLT(v string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldLT(FieldRevokedBy, v))
+}
+
+// RevokedByLTE applies the LTE predicate on the "revoked_by" field.
+func RevokedByLTE(v string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldLTE(FieldRevokedBy, v))
+}
+
+// RevokedByContains applies the Contains predicate on the "revoked_by" field.
+func RevokedByContains(v string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldContains(FieldRevokedBy, v))
+}
+
+// RevokedByHasPrefix applies the HasPrefix predicate on the "revoked_by" field.
+func RevokedByHasPrefix(v string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldHasPrefix(FieldRevokedBy, v))
+}
+
+// RevokedByHasSuffix applies the HasSuffix predicate on the "revoked_by" field.
+func RevokedByHasSuffix(v string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldHasSuffix(FieldRevokedBy, v))
+}
+
+// RevokedByIsNil applies the IsNil predicate on the "revoked_by" field.
+func RevokedByIsNil() predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldIsNull(FieldRevokedBy))
+}
+
+// RevokedByNotNil applies the NotNil predicate on the "revoked_by" field.
+func RevokedByNotNil() predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldNotNull(FieldRevokedBy))
+}
+
+// RevokedByEqualFold applies the EqualFold predicate on the "revoked_by" field.
+func RevokedByEqualFold(v string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldEqualFold(FieldRevokedBy, v))
+}
+
+// RevokedByContainsFold applies the ContainsFold predicate on the "revoked_by" field.
+func RevokedByContainsFold(v string) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.FieldContainsFold(FieldRevokedBy, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RevokedSession) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RevokedSession) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RevokedSession) predicate.RevokedSession {
+	return predicate.RevokedSession(sql.NotPredicates(p))
+}
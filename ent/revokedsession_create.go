@@ -0,0 +1,274 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"kv-shepherd.io/shepherd/ent/revokedsession"
+)
+
+// RevokedSessionCreate is the builder for creating a RevokedSession entity.
+type RevokedSessionCreate struct {
+	config
+	mutation *RevokedSessionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RevokedSessionCreate) SetCreatedAt(v time.Time) *RevokedSessionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RevokedSessionCreate) SetNillableCreatedAt(v *time.Time) *RevokedSessionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RevokedSessionCreate) SetUpdatedAt(v time.Time) *RevokedSessionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *RevokedSessionCreate) SetNillableUpdatedAt(v *time.Time) *RevokedSessionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetRevokedBefore sets the "revoked_before" field.
+func (_c *RevokedSessionCreate) SetRevokedBefore(v time.Time) *RevokedSessionCreate {
+	_c.mutation.SetRevokedBefore(v)
+	return _c
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (_c *RevokedSessionCreate) SetRevokedBy(v string) *RevokedSessionCreate {
+	_c.mutation.SetRevokedBy(v)
+	return _c
+}
+
+// SetNillableRevokedBy sets the "revoked_by" field if the given value is not nil.
+func (_c *RevokedSessionCreate) SetNillableRevokedBy(v *string) *RevokedSessionCreate {
+	if v != nil {
+		_c.SetRevokedBy(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RevokedSessionCreate) SetID(v string) *RevokedSessionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the RevokedSessionMutation object of the builder.
+func (_c *RevokedSessionCreate) Mutation() *RevokedSessionMutation {
+	return _c.mutation
+}
+
+// Save creates the RevokedSession in the database.
+func (_c *RevokedSessionCreate) Save(ctx context.Context) (*RevokedSession, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RevokedSessionCreate) SaveX(ctx context.Context) *RevokedSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RevokedSessionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RevokedSessionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RevokedSessionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := revokedsession.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := revokedsession.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RevokedSessionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RevokedSession.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RevokedSession.updated_at"`)}
+	}
+	if _, ok := _c.mutation.RevokedBefore(); !ok {
+		return &ValidationError{Name: "revoked_before", err: errors.New(`ent: missing required field "RevokedSession.revoked_before"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := revokedsession.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "RevokedSession.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *RevokedSessionCreate) sqlSave(ctx context.Context) (*RevokedSession, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected RevokedSession.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RevokedSessionCreate) createSpec() (*RevokedSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RevokedSession{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(revokedsession.Table, sqlgraph.NewFieldSpec(revokedsession.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(revokedsession.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(revokedsession.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.RevokedBefore(); ok {
+		_spec.SetField(revokedsession.FieldRevokedBefore, field.TypeTime, value)
+		_node.RevokedBefore = value
+	}
+	if value, ok := _c.mutation.RevokedBy(); ok {
+		_spec.SetField(revokedsession.FieldRevokedBy, field.TypeString, value)
+		_node.RevokedBy = value
+	}
+	return _node, _spec
+}
+
+// RevokedSessionCreateBulk is the builder for creating many RevokedSession entities in bulk.
+type RevokedSessionCreateBulk struct {
+	config
+	err      error
+	builders []*RevokedSessionCreate
+}
+
+// Save creates the RevokedSession entities in the database.
+func (_c *RevokedSessionCreateBulk) Save(ctx context.Context) ([]*RevokedSession, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RevokedSession, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RevokedSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RevokedSessionCreateBulk) SaveX(ctx context.Context) []*RevokedSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RevokedSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RevokedSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,298 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"kv-shepherd.io/shepherd/ent/predicate"
+	"kv-shepherd.io/shepherd/ent/revokedsession"
+)
+
+// RevokedSessionUpdate is the builder for updating RevokedSession entities.
+type RevokedSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RevokedSessionMutation
+}
+
+// Where appends a list predicates to the RevokedSessionUpdate builder.
+func (_u *RevokedSessionUpdate) Where(ps ...predicate.RevokedSession) *RevokedSessionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RevokedSessionUpdate) SetUpdatedAt(v time.Time) *RevokedSessionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetRevokedBefore sets the "revoked_before" field.
+func (_u *RevokedSessionUpdate) SetRevokedBefore(v time.Time) *RevokedSessionUpdate {
+	_u.mutation.SetRevokedBefore(v)
+	return _u
+}
+
+// SetNillableRevokedBefore sets the "revoked_before" field if the given value is not nil.
+func (_u *RevokedSessionUpdate) SetNillableRevokedBefore(v *time.Time) *RevokedSessionUpdate {
+	if v != nil {
+		_u.SetRevokedBefore(*v)
+	}
+	return _u
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (_u *RevokedSessionUpdate) SetRevokedBy(v string) *RevokedSessionUpdate {
+	_u.mutation.SetRevokedBy(v)
+	return _u
+}
+
+// SetNillableRevokedBy sets the "revoked_by" field if the given value is not nil.
+func (_u *RevokedSessionUpdate) SetNillableRevokedBy(v *string) *RevokedSessionUpdate {
+	if v != nil {
+		_u.SetRevokedBy(*v)
+	}
+	return _u
+}
+
+// ClearRevokedBy clears the value of the "revoked_by" field.
+func (_u *RevokedSessionUpdate) ClearRevokedBy() *RevokedSessionUpdate {
+	_u.mutation.ClearRevokedBy()
+	return _u
+}
+
+// Mutation returns the RevokedSessionMutation object of the builder.
+func (_u *RevokedSessionUpdate) Mutation() *RevokedSessionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RevokedSessionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RevokedSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RevokedSessionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RevokedSessionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RevokedSessionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := revokedsession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *RevokedSessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(revokedsession.Table, revokedsession.Columns, sqlgraph.NewFieldSpec(revokedsession.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(revokedsession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RevokedBefore(); ok {
+		_spec.SetField(revokedsession.FieldRevokedBefore, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RevokedBy(); ok {
+		_spec.SetField(revokedsession.FieldRevokedBy, field.TypeString, value)
+	}
+	if _u.mutation.RevokedByCleared() {
+		_spec.ClearField(revokedsession.FieldRevokedBy, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{revokedsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RevokedSessionUpdateOne is the builder for updating a single RevokedSession entity.
+type RevokedSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RevokedSessionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RevokedSessionUpdateOne) SetUpdatedAt(v time.Time) *RevokedSessionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetRevokedBefore sets the "revoked_before" field.
+func (_u *RevokedSessionUpdateOne) SetRevokedBefore(v time.Time) *RevokedSessionUpdateOne {
+	_u.mutation.SetRevokedBefore(v)
+	return _u
+}
+
+// SetNillableRevokedBefore sets the "revoked_before" field if the given value is not nil.
+func (_u *RevokedSessionUpdateOne) SetNillableRevokedBefore(v *time.Time) *RevokedSessionUpdateOne {
+	if v != nil {
+		_u.SetRevokedBefore(*v)
+	}
+	return _u
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (_u *RevokedSessionUpdateOne) SetRevokedBy(v string) *RevokedSessionUpdateOne {
+	_u.mutation.SetRevokedBy(v)
+	return _u
+}
+
+// SetNillableRevokedBy sets the "revoked_by" field if the given value is not nil.
+func (_u *RevokedSessionUpdateOne) SetNillableRevokedBy(v *string) *RevokedSessionUpdateOne {
+	if v != nil {
+		_u.SetRevokedBy(*v)
+	}
+	return _u
+}
+
+// ClearRevokedBy clears the value of the "revoked_by" field.
+func (_u *RevokedSessionUpdateOne) ClearRevokedBy() *RevokedSessionUpdateOne {
+	_u.mutation.ClearRevokedBy()
+	return _u
+}
+
+// Mutation returns the RevokedSessionMutation object of the builder.
+func (_u *RevokedSessionUpdateOne) Mutation() *RevokedSessionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RevokedSessionUpdate builder.
+func (_u *RevokedSessionUpdateOne) Where(ps ...predicate.RevokedSession) *RevokedSessionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RevokedSessionUpdateOne) Select(field string, fields ...string) *RevokedSessionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RevokedSession entity.
+func (_u *RevokedSessionUpdateOne) Save(ctx context.Context) (*RevokedSession, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RevokedSessionUpdateOne) SaveX(ctx context.Context) *RevokedSession {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RevokedSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RevokedSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RevokedSessionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := revokedsession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *RevokedSessionUpdateOne) sqlSave(ctx context.Context) (_node *RevokedSession, err error) {
+	_spec := sqlgraph.NewUpdateSpec(revokedsession.Table, revokedsession.Columns, sqlgraph.NewFieldSpec(revokedsession.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RevokedSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, revokedsession.FieldID)
+		for _, f := range fields {
+			if !revokedsession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != revokedsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(revokedsession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RevokedBefore(); ok {
+		_spec.SetField(revokedsession.FieldRevokedBefore, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RevokedBy(); ok {
+		_spec.SetField(revokedsession.FieldRevokedBy, field.TypeString, value)
+	}
+	if _u.mutation.RevokedByCleared() {
+		_spec.ClearField(revokedsession.FieldRevokedBy, field.TypeString)
+	}
+	_node = &RevokedSession{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{revokedsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
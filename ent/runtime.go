@@ -5,6 +5,7 @@ package ent
 import (
 	"time"
 
+	"kv-shepherd.io/shepherd/ent/approvaldecision"
 	"kv-shepherd.io/shepherd/ent/approvalpolicy"
 	"kv-shepherd.io/shepherd/ent/approvalticket"
 	"kv-shepherd.io/shepherd/ent/auditlog"
@@ -16,28 +17,61 @@ import (
 	"kv-shepherd.io/shepherd/ent/idpgroupmapping"
 	"kv-shepherd.io/shepherd/ent/idpsyncedgroup"
 	"kv-shepherd.io/shepherd/ent/instancesize"
+	"kv-shepherd.io/shepherd/ent/instancesizecluster"
+	"kv-shepherd.io/shepherd/ent/loginsession"
+	"kv-shepherd.io/shepherd/ent/loginthrottle"
+	"kv-shepherd.io/shepherd/ent/namespacequota"
 	"kv-shepherd.io/shepherd/ent/namespaceregistry"
 	"kv-shepherd.io/shepherd/ent/notification"
+	"kv-shepherd.io/shepherd/ent/notificationpreference"
+	"kv-shepherd.io/shepherd/ent/passwordhistory"
 	"kv-shepherd.io/shepherd/ent/pendingadoption"
+	"kv-shepherd.io/shepherd/ent/platformconfig"
+	"kv-shepherd.io/shepherd/ent/quota"
 	"kv-shepherd.io/shepherd/ent/ratelimitexemption"
 	"kv-shepherd.io/shepherd/ent/ratelimituseroverride"
+	"kv-shepherd.io/shepherd/ent/refreshtoken"
 	"kv-shepherd.io/shepherd/ent/resourcerolebinding"
+	"kv-shepherd.io/shepherd/ent/revokedsession"
 	"kv-shepherd.io/shepherd/ent/role"
 	"kv-shepherd.io/shepherd/ent/rolebinding"
+	"kv-shepherd.io/shepherd/ent/scheduledbatchjob"
 	"kv-shepherd.io/shepherd/ent/schema"
 	"kv-shepherd.io/shepherd/ent/service"
 	"kv-shepherd.io/shepherd/ent/system"
 	"kv-shepherd.io/shepherd/ent/systemsecret"
 	"kv-shepherd.io/shepherd/ent/template"
+	"kv-shepherd.io/shepherd/ent/ticketcomment"
 	"kv-shepherd.io/shepherd/ent/user"
 	"kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/ent/vmconsolesession"
+	"kv-shepherd.io/shepherd/ent/vmrequestidempotency"
 	"kv-shepherd.io/shepherd/ent/vmrevision"
+	"kv-shepherd.io/shepherd/ent/webhookdelivery"
+	"kv-shepherd.io/shepherd/ent/webhookendpoint"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	approvaldecisionMixin := schema.ApprovalDecision{}.Mixin()
+	approvaldecisionMixinFields0 := approvaldecisionMixin[0].Fields()
+	_ = approvaldecisionMixinFields0
+	approvaldecisionFields := schema.ApprovalDecision{}.Fields()
+	_ = approvaldecisionFields
+	// approvaldecisionDescCreatedAt is the schema descriptor for created_at field.
+	approvaldecisionDescCreatedAt := approvaldecisionMixinFields0[0].Descriptor()
+	// approvaldecision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	approvaldecision.DefaultCreatedAt = approvaldecisionDescCreatedAt.Default.(func() time.Time)
+	// approvaldecisionDescTicketID is the schema descriptor for ticket_id field.
+	approvaldecisionDescTicketID := approvaldecisionFields[1].Descriptor()
+	// approvaldecision.TicketIDValidator is a validator for the "ticket_id" field. It is called by the builders before save.
+	approvaldecision.TicketIDValidator = approvaldecisionDescTicketID.Validators[0].(func(string) error)
+	// approvaldecisionDescApprover is the schema descriptor for approver field.
+	approvaldecisionDescApprover := approvaldecisionFields[2].Descriptor()
+	// approvaldecision.ApproverValidator is a validator for the "approver" field. It is called by the builders before save.
+	approvaldecision.ApproverValidator = approvaldecisionDescApprover.Validators[0].(func(string) error)
 	approvalpolicyMixin := schema.ApprovalPolicy{}.Mixin()
 	approvalpolicyMixinFields0 := approvalpolicyMixin[0].Fields()
 	_ = approvalpolicyMixinFields0
@@ -88,6 +122,26 @@ func init() {
 	approvalticketDescRequester := approvalticketFields[4].Descriptor()
 	// approvalticket.RequesterValidator is a validator for the "requester" field. It is called by the builders before save.
 	approvalticket.RequesterValidator = approvalticketDescRequester.Validators[0].(func(string) error)
+	// approvalticketDescApprovalComment is the schema descriptor for approval_comment field.
+	approvalticketDescApprovalComment := approvalticketFields[8].Descriptor()
+	// approvalticket.ApprovalCommentValidator is a validator for the "approval_comment" field. It is called by the builders before save.
+	approvalticket.ApprovalCommentValidator = approvalticketDescApprovalComment.Validators[0].(func(string) error)
+	// approvalticketDescRequiredApprovals is the schema descriptor for required_approvals field.
+	approvalticketDescRequiredApprovals := approvalticketFields[18].Descriptor()
+	// approvalticket.DefaultRequiredApprovals holds the default value on creation for the required_approvals field.
+	approvalticket.DefaultRequiredApprovals = approvalticketDescRequiredApprovals.Default.(int)
+	// approvalticket.RequiredApprovalsValidator is a validator for the "required_approvals" field. It is called by the builders before save.
+	approvalticket.RequiredApprovalsValidator = approvalticketDescRequiredApprovals.Validators[0].(func(int) error)
+	// approvalticketDescAttemptCount is the schema descriptor for attempt_count field.
+	approvalticketDescAttemptCount := approvalticketFields[21].Descriptor()
+	// approvalticket.DefaultAttemptCount holds the default value on creation for the attempt_count field.
+	approvalticket.DefaultAttemptCount = approvalticketDescAttemptCount.Default.(int)
+	// approvalticket.AttemptCountValidator is a validator for the "attempt_count" field. It is called by the builders before save.
+	approvalticket.AttemptCountValidator = approvalticketDescAttemptCount.Validators[0].(func(int) error)
+	// approvalticketDescRequestID is the schema descriptor for request_id field.
+	approvalticketDescRequestID := approvalticketFields[22].Descriptor()
+	// approvalticket.RequestIDValidator is a validator for the "request_id" field. It is called by the builders before save.
+	approvalticket.RequestIDValidator = approvalticketDescRequestID.Validators[0].(func(string) error)
 	auditlogMixin := schema.AuditLog{}.Mixin()
 	auditlogMixinFields0 := auditlogMixin[0].Fields()
 	_ = auditlogMixinFields0
@@ -148,6 +202,26 @@ func init() {
 	authproviderDescCreatedBy := authproviderFields[6].Descriptor()
 	// authprovider.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
 	authprovider.CreatedByValidator = authproviderDescCreatedBy.Validators[0].(func(string) error)
+	// authproviderDescLastSyncAdded is the schema descriptor for last_sync_added field.
+	authproviderDescLastSyncAdded := authproviderFields[9].Descriptor()
+	// authprovider.DefaultLastSyncAdded holds the default value on creation for the last_sync_added field.
+	authprovider.DefaultLastSyncAdded = authproviderDescLastSyncAdded.Default.(int)
+	// authproviderDescLastSyncUpdated is the schema descriptor for last_sync_updated field.
+	authproviderDescLastSyncUpdated := authproviderFields[10].Descriptor()
+	// authprovider.DefaultLastSyncUpdated holds the default value on creation for the last_sync_updated field.
+	authprovider.DefaultLastSyncUpdated = authproviderDescLastSyncUpdated.Default.(int)
+	// authproviderDescLastSyncStale is the schema descriptor for last_sync_stale field.
+	authproviderDescLastSyncStale := authproviderFields[11].Descriptor()
+	// authprovider.DefaultLastSyncStale holds the default value on creation for the last_sync_stale field.
+	authprovider.DefaultLastSyncStale = authproviderDescLastSyncStale.Default.(int)
+	// authproviderDescLastSyncDurationMs is the schema descriptor for last_sync_duration_ms field.
+	authproviderDescLastSyncDurationMs := authproviderFields[12].Descriptor()
+	// authprovider.DefaultLastSyncDurationMs holds the default value on creation for the last_sync_duration_ms field.
+	authprovider.DefaultLastSyncDurationMs = authproviderDescLastSyncDurationMs.Default.(int64)
+	// authproviderDescSyncFailures is the schema descriptor for sync_failures field.
+	authproviderDescSyncFailures := authproviderFields[14].Descriptor()
+	// authprovider.DefaultSyncFailures holds the default value on creation for the sync_failures field.
+	authprovider.DefaultSyncFailures = authproviderDescSyncFailures.Default.(int)
 	batchapprovalticketMixin := schema.BatchApprovalTicket{}.Mixin()
 	batchapprovalticketMixinFields0 := batchapprovalticketMixin[0].Fields()
 	_ = batchapprovalticketMixinFields0
@@ -236,6 +310,36 @@ func init() {
 	clusterDescEnabled := clusterFields[14].Descriptor()
 	// cluster.DefaultEnabled holds the default value on creation for the enabled field.
 	cluster.DefaultEnabled = clusterDescEnabled.Default.(bool)
+	// clusterDescTotalCPUCores is the schema descriptor for total_cpu_cores field.
+	clusterDescTotalCPUCores := clusterFields[15].Descriptor()
+	// cluster.DefaultTotalCPUCores holds the default value on creation for the total_cpu_cores field.
+	cluster.DefaultTotalCPUCores = clusterDescTotalCPUCores.Default.(int)
+	// cluster.TotalCPUCoresValidator is a validator for the "total_cpu_cores" field. It is called by the builders before save.
+	cluster.TotalCPUCoresValidator = clusterDescTotalCPUCores.Validators[0].(func(int) error)
+	// clusterDescTotalMemoryMB is the schema descriptor for total_memory_mb field.
+	clusterDescTotalMemoryMB := clusterFields[16].Descriptor()
+	// cluster.DefaultTotalMemoryMB holds the default value on creation for the total_memory_mb field.
+	cluster.DefaultTotalMemoryMB = clusterDescTotalMemoryMB.Default.(int)
+	// cluster.TotalMemoryMBValidator is a validator for the "total_memory_mb" field. It is called by the builders before save.
+	cluster.TotalMemoryMBValidator = clusterDescTotalMemoryMB.Validators[0].(func(int) error)
+	// clusterDescCPUOvercommitRatio is the schema descriptor for cpu_overcommit_ratio field.
+	clusterDescCPUOvercommitRatio := clusterFields[17].Descriptor()
+	// cluster.DefaultCPUOvercommitRatio holds the default value on creation for the cpu_overcommit_ratio field.
+	cluster.DefaultCPUOvercommitRatio = clusterDescCPUOvercommitRatio.Default.(float64)
+	// cluster.CPUOvercommitRatioValidator is a validator for the "cpu_overcommit_ratio" field. It is called by the builders before save.
+	cluster.CPUOvercommitRatioValidator = clusterDescCPUOvercommitRatio.Validators[0].(func(float64) error)
+	// clusterDescMemoryOvercommitRatio is the schema descriptor for memory_overcommit_ratio field.
+	clusterDescMemoryOvercommitRatio := clusterFields[18].Descriptor()
+	// cluster.DefaultMemoryOvercommitRatio holds the default value on creation for the memory_overcommit_ratio field.
+	cluster.DefaultMemoryOvercommitRatio = clusterDescMemoryOvercommitRatio.Default.(float64)
+	// cluster.MemoryOvercommitRatioValidator is a validator for the "memory_overcommit_ratio" field. It is called by the builders before save.
+	cluster.MemoryOvercommitRatioValidator = clusterDescMemoryOvercommitRatio.Validators[0].(func(float64) error)
+	// clusterDescHealthCheckFailures is the schema descriptor for health_check_failures field.
+	clusterDescHealthCheckFailures := clusterFields[19].Descriptor()
+	// cluster.DefaultHealthCheckFailures holds the default value on creation for the health_check_failures field.
+	cluster.DefaultHealthCheckFailures = clusterDescHealthCheckFailures.Default.(int)
+	// cluster.HealthCheckFailuresValidator is a validator for the "health_check_failures" field. It is called by the builders before save.
+	cluster.HealthCheckFailuresValidator = clusterDescHealthCheckFailures.Validators[0].(func(int) error)
 	domaineventMixin := schema.DomainEvent{}.Mixin()
 	domaineventMixinFields0 := domaineventMixin[0].Fields()
 	_ = domaineventMixinFields0
@@ -346,6 +450,10 @@ func init() {
 	idpsyncedgroupDescGroupName := idpsyncedgroupFields[3].Descriptor()
 	// idpsyncedgroup.GroupNameValidator is a validator for the "group_name" field. It is called by the builders before save.
 	idpsyncedgroup.GroupNameValidator = idpsyncedgroupDescGroupName.Validators[0].(func(string) error)
+	// idpsyncedgroupDescStale is the schema descriptor for stale field.
+	idpsyncedgroupDescStale := idpsyncedgroupFields[7].Descriptor()
+	// idpsyncedgroup.DefaultStale holds the default value on creation for the stale field.
+	idpsyncedgroup.DefaultStale = idpsyncedgroupDescStale.Default.(bool)
 	instancesizeMixin := schema.InstanceSize{}.Mixin()
 	instancesizeMixinFields0 := instancesizeMixin[0].Fields()
 	_ = instancesizeMixinFields0
@@ -413,6 +521,118 @@ func init() {
 	instancesizeDescCreatedBy := instancesizeFields[17].Descriptor()
 	// instancesize.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
 	instancesize.CreatedByValidator = instancesizeDescCreatedBy.Validators[0].(func(string) error)
+	instancesizeclusterMixin := schema.InstanceSizeCluster{}.Mixin()
+	instancesizeclusterMixinFields0 := instancesizeclusterMixin[0].Fields()
+	_ = instancesizeclusterMixinFields0
+	instancesizeclusterFields := schema.InstanceSizeCluster{}.Fields()
+	_ = instancesizeclusterFields
+	// instancesizeclusterDescCreatedAt is the schema descriptor for created_at field.
+	instancesizeclusterDescCreatedAt := instancesizeclusterMixinFields0[0].Descriptor()
+	// instancesizecluster.DefaultCreatedAt holds the default value on creation for the created_at field.
+	instancesizecluster.DefaultCreatedAt = instancesizeclusterDescCreatedAt.Default.(func() time.Time)
+	// instancesizeclusterDescUpdatedAt is the schema descriptor for updated_at field.
+	instancesizeclusterDescUpdatedAt := instancesizeclusterMixinFields0[1].Descriptor()
+	// instancesizecluster.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	instancesizecluster.DefaultUpdatedAt = instancesizeclusterDescUpdatedAt.Default.(func() time.Time)
+	// instancesizecluster.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	instancesizecluster.UpdateDefaultUpdatedAt = instancesizeclusterDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// instancesizeclusterDescInstanceSizeID is the schema descriptor for instance_size_id field.
+	instancesizeclusterDescInstanceSizeID := instancesizeclusterFields[1].Descriptor()
+	// instancesizecluster.InstanceSizeIDValidator is a validator for the "instance_size_id" field. It is called by the builders before save.
+	instancesizecluster.InstanceSizeIDValidator = instancesizeclusterDescInstanceSizeID.Validators[0].(func(string) error)
+	// instancesizeclusterDescClusterID is the schema descriptor for cluster_id field.
+	instancesizeclusterDescClusterID := instancesizeclusterFields[2].Descriptor()
+	// instancesizecluster.ClusterIDValidator is a validator for the "cluster_id" field. It is called by the builders before save.
+	instancesizecluster.ClusterIDValidator = instancesizeclusterDescClusterID.Validators[0].(func(string) error)
+	// instancesizeclusterDescCreatedBy is the schema descriptor for created_by field.
+	instancesizeclusterDescCreatedBy := instancesizeclusterFields[3].Descriptor()
+	// instancesizecluster.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	instancesizecluster.CreatedByValidator = instancesizeclusterDescCreatedBy.Validators[0].(func(string) error)
+	loginsessionMixin := schema.LoginSession{}.Mixin()
+	loginsessionMixinFields0 := loginsessionMixin[0].Fields()
+	_ = loginsessionMixinFields0
+	loginsessionFields := schema.LoginSession{}.Fields()
+	_ = loginsessionFields
+	// loginsessionDescCreatedAt is the schema descriptor for created_at field.
+	loginsessionDescCreatedAt := loginsessionMixinFields0[0].Descriptor()
+	// loginsession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loginsession.DefaultCreatedAt = loginsessionDescCreatedAt.Default.(func() time.Time)
+	// loginsessionDescUpdatedAt is the schema descriptor for updated_at field.
+	loginsessionDescUpdatedAt := loginsessionMixinFields0[1].Descriptor()
+	// loginsession.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	loginsession.DefaultUpdatedAt = loginsessionDescUpdatedAt.Default.(func() time.Time)
+	// loginsession.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	loginsession.UpdateDefaultUpdatedAt = loginsessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// loginsessionDescUserID is the schema descriptor for user_id field.
+	loginsessionDescUserID := loginsessionFields[1].Descriptor()
+	// loginsession.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	loginsession.UserIDValidator = loginsessionDescUserID.Validators[0].(func(string) error)
+	// loginsessionDescUserAgent is the schema descriptor for user_agent field.
+	loginsessionDescUserAgent := loginsessionFields[3].Descriptor()
+	// loginsession.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	loginsession.UserAgentValidator = loginsessionDescUserAgent.Validators[0].(func(string) error)
+	loginthrottleMixin := schema.LoginThrottle{}.Mixin()
+	loginthrottleMixinFields0 := loginthrottleMixin[0].Fields()
+	_ = loginthrottleMixinFields0
+	loginthrottleFields := schema.LoginThrottle{}.Fields()
+	_ = loginthrottleFields
+	// loginthrottleDescCreatedAt is the schema descriptor for created_at field.
+	loginthrottleDescCreatedAt := loginthrottleMixinFields0[0].Descriptor()
+	// loginthrottle.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loginthrottle.DefaultCreatedAt = loginthrottleDescCreatedAt.Default.(func() time.Time)
+	// loginthrottleDescUpdatedAt is the schema descriptor for updated_at field.
+	loginthrottleDescUpdatedAt := loginthrottleMixinFields0[1].Descriptor()
+	// loginthrottle.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	loginthrottle.DefaultUpdatedAt = loginthrottleDescUpdatedAt.Default.(func() time.Time)
+	// loginthrottle.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	loginthrottle.UpdateDefaultUpdatedAt = loginthrottleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// loginthrottleDescFailures is the schema descriptor for failures field.
+	loginthrottleDescFailures := loginthrottleFields[1].Descriptor()
+	// loginthrottle.DefaultFailures holds the default value on creation for the failures field.
+	loginthrottle.DefaultFailures = loginthrottleDescFailures.Default.(int)
+	// loginthrottle.FailuresValidator is a validator for the "failures" field. It is called by the builders before save.
+	loginthrottle.FailuresValidator = loginthrottleDescFailures.Validators[0].(func(int) error)
+	namespacequotaMixin := schema.NamespaceQuota{}.Mixin()
+	namespacequotaMixinFields0 := namespacequotaMixin[0].Fields()
+	_ = namespacequotaMixinFields0
+	namespacequotaFields := schema.NamespaceQuota{}.Fields()
+	_ = namespacequotaFields
+	// namespacequotaDescCreatedAt is the schema descriptor for created_at field.
+	namespacequotaDescCreatedAt := namespacequotaMixinFields0[0].Descriptor()
+	// namespacequota.DefaultCreatedAt holds the default value on creation for the created_at field.
+	namespacequota.DefaultCreatedAt = namespacequotaDescCreatedAt.Default.(func() time.Time)
+	// namespacequotaDescUpdatedAt is the schema descriptor for updated_at field.
+	namespacequotaDescUpdatedAt := namespacequotaMixinFields0[1].Descriptor()
+	// namespacequota.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	namespacequota.DefaultUpdatedAt = namespacequotaDescUpdatedAt.Default.(func() time.Time)
+	// namespacequota.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	namespacequota.UpdateDefaultUpdatedAt = namespacequotaDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// namespacequotaDescNamespaceName is the schema descriptor for namespace_name field.
+	namespacequotaDescNamespaceName := namespacequotaFields[1].Descriptor()
+	// namespacequota.NamespaceNameValidator is a validator for the "namespace_name" field. It is called by the builders before save.
+	namespacequota.NamespaceNameValidator = func() func(string) error {
+		validators := namespacequotaDescNamespaceName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(namespace_name string) error {
+			for _, fn := range fns {
+				if err := fn(namespace_name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// namespacequotaDescMaxVms is the schema descriptor for max_vms field.
+	namespacequotaDescMaxVms := namespacequotaFields[2].Descriptor()
+	// namespacequota.MaxVmsValidator is a validator for the "max_vms" field. It is called by the builders before save.
+	namespacequota.MaxVmsValidator = namespacequotaDescMaxVms.Validators[0].(func(int) error)
+	// namespacequotaDescCreatedBy is the schema descriptor for created_by field.
+	namespacequotaDescCreatedBy := namespacequotaFields[3].Descriptor()
+	// namespacequota.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	namespacequota.CreatedByValidator = namespacequotaDescCreatedBy.Validators[0].(func(string) error)
 	namespaceregistryMixin := schema.NamespaceRegistry{}.Mixin()
 	namespaceregistryMixinFields0 := namespaceregistryMixin[0].Fields()
 	_ = namespaceregistryMixinFields0
@@ -458,6 +678,10 @@ func init() {
 	namespaceregistryDescEnabled := namespaceregistryFields[5].Descriptor()
 	// namespaceregistry.DefaultEnabled holds the default value on creation for the enabled field.
 	namespaceregistry.DefaultEnabled = namespaceregistryDescEnabled.Default.(bool)
+	// namespaceregistryDescRequirePowerApproval is the schema descriptor for require_power_approval field.
+	namespaceregistryDescRequirePowerApproval := namespaceregistryFields[6].Descriptor()
+	// namespaceregistry.DefaultRequirePowerApproval holds the default value on creation for the require_power_approval field.
+	namespaceregistry.DefaultRequirePowerApproval = namespaceregistryDescRequirePowerApproval.Default.(bool)
 	notificationMixin := schema.Notification{}.Mixin()
 	notificationMixinFields0 := notificationMixin[0].Fields()
 	_ = notificationMixinFields0
@@ -507,6 +731,52 @@ func init() {
 	notificationDescRead := notificationFields[6].Descriptor()
 	// notification.DefaultRead holds the default value on creation for the read field.
 	notification.DefaultRead = notificationDescRead.Default.(bool)
+	notificationpreferenceMixin := schema.NotificationPreference{}.Mixin()
+	notificationpreferenceMixinFields0 := notificationpreferenceMixin[0].Fields()
+	_ = notificationpreferenceMixinFields0
+	notificationpreferenceFields := schema.NotificationPreference{}.Fields()
+	_ = notificationpreferenceFields
+	// notificationpreferenceDescCreatedAt is the schema descriptor for created_at field.
+	notificationpreferenceDescCreatedAt := notificationpreferenceMixinFields0[0].Descriptor()
+	// notificationpreference.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationpreference.DefaultCreatedAt = notificationpreferenceDescCreatedAt.Default.(func() time.Time)
+	// notificationpreferenceDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationpreferenceDescUpdatedAt := notificationpreferenceMixinFields0[1].Descriptor()
+	// notificationpreference.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationpreference.DefaultUpdatedAt = notificationpreferenceDescUpdatedAt.Default.(func() time.Time)
+	// notificationpreference.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationpreference.UpdateDefaultUpdatedAt = notificationpreferenceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationpreferenceDescUserID is the schema descriptor for user_id field.
+	notificationpreferenceDescUserID := notificationpreferenceFields[1].Descriptor()
+	// notificationpreference.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	notificationpreference.UserIDValidator = notificationpreferenceDescUserID.Validators[0].(func(string) error)
+	// notificationpreferenceDescEnabled is the schema descriptor for enabled field.
+	notificationpreferenceDescEnabled := notificationpreferenceFields[3].Descriptor()
+	// notificationpreference.DefaultEnabled holds the default value on creation for the enabled field.
+	notificationpreference.DefaultEnabled = notificationpreferenceDescEnabled.Default.(bool)
+	passwordhistoryMixin := schema.PasswordHistory{}.Mixin()
+	passwordhistoryMixinFields0 := passwordhistoryMixin[0].Fields()
+	_ = passwordhistoryMixinFields0
+	passwordhistoryFields := schema.PasswordHistory{}.Fields()
+	_ = passwordhistoryFields
+	// passwordhistoryDescCreatedAt is the schema descriptor for created_at field.
+	passwordhistoryDescCreatedAt := passwordhistoryMixinFields0[0].Descriptor()
+	// passwordhistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	passwordhistory.DefaultCreatedAt = passwordhistoryDescCreatedAt.Default.(func() time.Time)
+	// passwordhistoryDescUpdatedAt is the schema descriptor for updated_at field.
+	passwordhistoryDescUpdatedAt := passwordhistoryMixinFields0[1].Descriptor()
+	// passwordhistory.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	passwordhistory.DefaultUpdatedAt = passwordhistoryDescUpdatedAt.Default.(func() time.Time)
+	// passwordhistory.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	passwordhistory.UpdateDefaultUpdatedAt = passwordhistoryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// passwordhistoryDescUserID is the schema descriptor for user_id field.
+	passwordhistoryDescUserID := passwordhistoryFields[1].Descriptor()
+	// passwordhistory.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	passwordhistory.UserIDValidator = passwordhistoryDescUserID.Validators[0].(func(string) error)
+	// passwordhistoryDescPasswordHash is the schema descriptor for password_hash field.
+	passwordhistoryDescPasswordHash := passwordhistoryFields[2].Descriptor()
+	// passwordhistory.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
+	passwordhistory.PasswordHashValidator = passwordhistoryDescPasswordHash.Validators[0].(func(string) error)
 	pendingadoptionMixin := schema.PendingAdoption{}.Mixin()
 	pendingadoptionMixinFields0 := pendingadoptionMixin[0].Fields()
 	_ = pendingadoptionMixinFields0
@@ -538,6 +808,76 @@ func init() {
 	pendingadoptionDescResourceType := pendingadoptionFields[4].Descriptor()
 	// pendingadoption.ResourceTypeValidator is a validator for the "resource_type" field. It is called by the builders before save.
 	pendingadoption.ResourceTypeValidator = pendingadoptionDescResourceType.Validators[0].(func(string) error)
+	platformconfigMixin := schema.PlatformConfig{}.Mixin()
+	platformconfigMixinFields0 := platformconfigMixin[0].Fields()
+	_ = platformconfigMixinFields0
+	platformconfigFields := schema.PlatformConfig{}.Fields()
+	_ = platformconfigFields
+	// platformconfigDescCreatedAt is the schema descriptor for created_at field.
+	platformconfigDescCreatedAt := platformconfigMixinFields0[0].Descriptor()
+	// platformconfig.DefaultCreatedAt holds the default value on creation for the created_at field.
+	platformconfig.DefaultCreatedAt = platformconfigDescCreatedAt.Default.(func() time.Time)
+	// platformconfigDescUpdatedAt is the schema descriptor for updated_at field.
+	platformconfigDescUpdatedAt := platformconfigMixinFields0[1].Descriptor()
+	// platformconfig.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	platformconfig.DefaultUpdatedAt = platformconfigDescUpdatedAt.Default.(func() time.Time)
+	// platformconfig.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	platformconfig.UpdateDefaultUpdatedAt = platformconfigDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// platformconfigDescApprovalTTLHours is the schema descriptor for approval_ttl_hours field.
+	platformconfigDescApprovalTTLHours := platformconfigFields[1].Descriptor()
+	// platformconfig.DefaultApprovalTTLHours holds the default value on creation for the approval_ttl_hours field.
+	platformconfig.DefaultApprovalTTLHours = platformconfigDescApprovalTTLHours.Default.(int)
+	// platformconfig.ApprovalTTLHoursValidator is a validator for the "approval_ttl_hours" field. It is called by the builders before save.
+	platformconfig.ApprovalTTLHoursValidator = platformconfigDescApprovalTTLHours.Validators[0].(func(int) error)
+	// platformconfigDescSMTPPort is the schema descriptor for smtp_port field.
+	platformconfigDescSMTPPort := platformconfigFields[3].Descriptor()
+	// platformconfig.DefaultSMTPPort holds the default value on creation for the smtp_port field.
+	platformconfig.DefaultSMTPPort = platformconfigDescSMTPPort.Default.(int)
+	// platformconfig.SMTPPortValidator is a validator for the "smtp_port" field. It is called by the builders before save.
+	platformconfig.SMTPPortValidator = platformconfigDescSMTPPort.Validators[0].(func(int) error)
+	// platformconfigDescAllowUserData is the schema descriptor for allow_user_data field.
+	platformconfigDescAllowUserData := platformconfigFields[7].Descriptor()
+	// platformconfig.DefaultAllowUserData holds the default value on creation for the allow_user_data field.
+	platformconfig.DefaultAllowUserData = platformconfigDescAllowUserData.Default.(bool)
+	// platformconfigDescID is the schema descriptor for id field.
+	platformconfigDescID := platformconfigFields[0].Descriptor()
+	// platformconfig.DefaultID holds the default value on creation for the id field.
+	platformconfig.DefaultID = platformconfigDescID.Default.(string)
+	quotaMixin := schema.Quota{}.Mixin()
+	quotaMixinFields0 := quotaMixin[0].Fields()
+	_ = quotaMixinFields0
+	quotaFields := schema.Quota{}.Fields()
+	_ = quotaFields
+	// quotaDescCreatedAt is the schema descriptor for created_at field.
+	quotaDescCreatedAt := quotaMixinFields0[0].Descriptor()
+	// quota.DefaultCreatedAt holds the default value on creation for the created_at field.
+	quota.DefaultCreatedAt = quotaDescCreatedAt.Default.(func() time.Time)
+	// quotaDescUpdatedAt is the schema descriptor for updated_at field.
+	quotaDescUpdatedAt := quotaMixinFields0[1].Descriptor()
+	// quota.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	quota.DefaultUpdatedAt = quotaDescUpdatedAt.Default.(func() time.Time)
+	// quota.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	quota.UpdateDefaultUpdatedAt = quotaDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// quotaDescScopeID is the schema descriptor for scope_id field.
+	quotaDescScopeID := quotaFields[2].Descriptor()
+	// quota.ScopeIDValidator is a validator for the "scope_id" field. It is called by the builders before save.
+	quota.ScopeIDValidator = quotaDescScopeID.Validators[0].(func(string) error)
+	// quotaDescMaxVms is the schema descriptor for max_vms field.
+	quotaDescMaxVms := quotaFields[3].Descriptor()
+	// quota.MaxVmsValidator is a validator for the "max_vms" field. It is called by the builders before save.
+	quota.MaxVmsValidator = quotaDescMaxVms.Validators[0].(func(int) error)
+	// quotaDescMaxCPUCores is the schema descriptor for max_cpu_cores field.
+	quotaDescMaxCPUCores := quotaFields[4].Descriptor()
+	// quota.MaxCPUCoresValidator is a validator for the "max_cpu_cores" field. It is called by the builders before save.
+	quota.MaxCPUCoresValidator = quotaDescMaxCPUCores.Validators[0].(func(int) error)
+	// quotaDescMaxMemoryMB is the schema descriptor for max_memory_mb field.
+	quotaDescMaxMemoryMB := quotaFields[5].Descriptor()
+	// quota.MaxMemoryMBValidator is a validator for the "max_memory_mb" field. It is called by the builders before save.
+	quota.MaxMemoryMBValidator = quotaDescMaxMemoryMB.Validators[0].(func(int) error)
+	// quotaDescCreatedBy is the schema descriptor for created_by field.
+	quotaDescCreatedBy := quotaFields[6].Descriptor()
+	// quota.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	quota.CreatedByValidator = quotaDescCreatedBy.Validators[0].(func(string) error)
 	ratelimitexemptionMixin := schema.RateLimitExemption{}.Mixin()
 	ratelimitexemptionMixinFields0 := ratelimitexemptionMixin[0].Fields()
 	_ = ratelimitexemptionMixinFields0
@@ -588,6 +928,29 @@ func init() {
 	ratelimituseroverrideDescUpdatedBy := ratelimituseroverrideFields[5].Descriptor()
 	// ratelimituseroverride.UpdatedByValidator is a validator for the "updated_by" field. It is called by the builders before save.
 	ratelimituseroverride.UpdatedByValidator = ratelimituseroverrideDescUpdatedBy.Validators[0].(func(string) error)
+	refreshtokenMixin := schema.RefreshToken{}.Mixin()
+	refreshtokenMixinFields0 := refreshtokenMixin[0].Fields()
+	_ = refreshtokenMixinFields0
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
+	refreshtokenDescCreatedAt := refreshtokenMixinFields0[0].Descriptor()
+	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
+	// refreshtokenDescUpdatedAt is the schema descriptor for updated_at field.
+	refreshtokenDescUpdatedAt := refreshtokenMixinFields0[1].Descriptor()
+	// refreshtoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	refreshtoken.DefaultUpdatedAt = refreshtokenDescUpdatedAt.Default.(func() time.Time)
+	// refreshtoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	refreshtoken.UpdateDefaultUpdatedAt = refreshtokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// refreshtokenDescUserID is the schema descriptor for user_id field.
+	refreshtokenDescUserID := refreshtokenFields[1].Descriptor()
+	// refreshtoken.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	refreshtoken.UserIDValidator = refreshtokenDescUserID.Validators[0].(func(string) error)
+	// refreshtokenDescTokenHash is the schema descriptor for token_hash field.
+	refreshtokenDescTokenHash := refreshtokenFields[2].Descriptor()
+	// refreshtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	refreshtoken.TokenHashValidator = refreshtokenDescTokenHash.Validators[0].(func(string) error)
 	resourcerolebindingMixin := schema.ResourceRoleBinding{}.Mixin()
 	resourcerolebindingMixinFields0 := resourcerolebindingMixin[0].Fields()
 	_ = resourcerolebindingMixinFields0
@@ -619,6 +982,25 @@ func init() {
 	resourcerolebindingDescCreatedBy := resourcerolebindingFields[5].Descriptor()
 	// resourcerolebinding.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
 	resourcerolebinding.CreatedByValidator = resourcerolebindingDescCreatedBy.Validators[0].(func(string) error)
+	revokedsessionMixin := schema.RevokedSession{}.Mixin()
+	revokedsessionMixinFields0 := revokedsessionMixin[0].Fields()
+	_ = revokedsessionMixinFields0
+	revokedsessionFields := schema.RevokedSession{}.Fields()
+	_ = revokedsessionFields
+	// revokedsessionDescCreatedAt is the schema descriptor for created_at field.
+	revokedsessionDescCreatedAt := revokedsessionMixinFields0[0].Descriptor()
+	// revokedsession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	revokedsession.DefaultCreatedAt = revokedsessionDescCreatedAt.Default.(func() time.Time)
+	// revokedsessionDescUpdatedAt is the schema descriptor for updated_at field.
+	revokedsessionDescUpdatedAt := revokedsessionMixinFields0[1].Descriptor()
+	// revokedsession.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	revokedsession.DefaultUpdatedAt = revokedsessionDescUpdatedAt.Default.(func() time.Time)
+	// revokedsession.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	revokedsession.UpdateDefaultUpdatedAt = revokedsessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// revokedsessionDescID is the schema descriptor for id field.
+	revokedsessionDescID := revokedsessionFields[0].Descriptor()
+	// revokedsession.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	revokedsession.IDValidator = revokedsessionDescID.Validators[0].(func(string) error)
 	roleMixin := schema.Role{}.Mixin()
 	roleMixinFields0 := roleMixin[0].Fields()
 	_ = roleMixinFields0
@@ -665,6 +1047,47 @@ func init() {
 	rolebindingDescCreatedBy := rolebindingFields[4].Descriptor()
 	// rolebinding.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
 	rolebinding.CreatedByValidator = rolebindingDescCreatedBy.Validators[0].(func(string) error)
+	scheduledbatchjobMixin := schema.ScheduledBatchJob{}.Mixin()
+	scheduledbatchjobMixinFields0 := scheduledbatchjobMixin[0].Fields()
+	_ = scheduledbatchjobMixinFields0
+	scheduledbatchjobFields := schema.ScheduledBatchJob{}.Fields()
+	_ = scheduledbatchjobFields
+	// scheduledbatchjobDescCreatedAt is the schema descriptor for created_at field.
+	scheduledbatchjobDescCreatedAt := scheduledbatchjobMixinFields0[0].Descriptor()
+	// scheduledbatchjob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	scheduledbatchjob.DefaultCreatedAt = scheduledbatchjobDescCreatedAt.Default.(func() time.Time)
+	// scheduledbatchjobDescUpdatedAt is the schema descriptor for updated_at field.
+	scheduledbatchjobDescUpdatedAt := scheduledbatchjobMixinFields0[1].Descriptor()
+	// scheduledbatchjob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	scheduledbatchjob.DefaultUpdatedAt = scheduledbatchjobDescUpdatedAt.Default.(func() time.Time)
+	// scheduledbatchjob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	scheduledbatchjob.UpdateDefaultUpdatedAt = scheduledbatchjobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// scheduledbatchjobDescCronExpression is the schema descriptor for cron_expression field.
+	scheduledbatchjobDescCronExpression := scheduledbatchjobFields[1].Descriptor()
+	// scheduledbatchjob.CronExpressionValidator is a validator for the "cron_expression" field. It is called by the builders before save.
+	scheduledbatchjob.CronExpressionValidator = func() func(string) error {
+		validators := scheduledbatchjobDescCronExpression.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(cron_expression string) error {
+			for _, fn := range fns {
+				if err := fn(cron_expression); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// scheduledbatchjobDescEnabled is the schema descriptor for enabled field.
+	scheduledbatchjobDescEnabled := scheduledbatchjobFields[4].Descriptor()
+	// scheduledbatchjob.DefaultEnabled holds the default value on creation for the enabled field.
+	scheduledbatchjob.DefaultEnabled = scheduledbatchjobDescEnabled.Default.(bool)
+	// scheduledbatchjobDescCreatedBy is the schema descriptor for created_by field.
+	scheduledbatchjobDescCreatedBy := scheduledbatchjobFields[9].Descriptor()
+	// scheduledbatchjob.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	scheduledbatchjob.CreatedByValidator = scheduledbatchjobDescCreatedBy.Validators[0].(func(string) error)
 	serviceMixin := schema.Service{}.Mixin()
 	serviceMixinFields0 := serviceMixin[0].Fields()
 	_ = serviceMixinFields0
@@ -797,6 +1220,41 @@ func init() {
 	templateDescCreatedBy := templateFields[9].Descriptor()
 	// template.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
 	template.CreatedByValidator = templateDescCreatedBy.Validators[0].(func(string) error)
+	ticketcommentMixin := schema.TicketComment{}.Mixin()
+	ticketcommentMixinFields0 := ticketcommentMixin[0].Fields()
+	_ = ticketcommentMixinFields0
+	ticketcommentFields := schema.TicketComment{}.Fields()
+	_ = ticketcommentFields
+	// ticketcommentDescCreatedAt is the schema descriptor for created_at field.
+	ticketcommentDescCreatedAt := ticketcommentMixinFields0[0].Descriptor()
+	// ticketcomment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ticketcomment.DefaultCreatedAt = ticketcommentDescCreatedAt.Default.(func() time.Time)
+	// ticketcommentDescTicketID is the schema descriptor for ticket_id field.
+	ticketcommentDescTicketID := ticketcommentFields[1].Descriptor()
+	// ticketcomment.TicketIDValidator is a validator for the "ticket_id" field. It is called by the builders before save.
+	ticketcomment.TicketIDValidator = ticketcommentDescTicketID.Validators[0].(func(string) error)
+	// ticketcommentDescAuthor is the schema descriptor for author field.
+	ticketcommentDescAuthor := ticketcommentFields[2].Descriptor()
+	// ticketcomment.AuthorValidator is a validator for the "author" field. It is called by the builders before save.
+	ticketcomment.AuthorValidator = ticketcommentDescAuthor.Validators[0].(func(string) error)
+	// ticketcommentDescBody is the schema descriptor for body field.
+	ticketcommentDescBody := ticketcommentFields[3].Descriptor()
+	// ticketcomment.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	ticketcomment.BodyValidator = func() func(string) error {
+		validators := ticketcommentDescBody.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(body string) error {
+			for _, fn := range fns {
+				if err := fn(body); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	userMixin := schema.User{}.Mixin()
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
@@ -873,6 +1331,74 @@ func init() {
 	vmDescCreatedBy := vmFields[7].Descriptor()
 	// vm.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
 	vm.CreatedByValidator = vmDescCreatedBy.Validators[0].(func(string) error)
+	vmconsolesessionMixin := schema.VMConsoleSession{}.Mixin()
+	vmconsolesessionMixinFields0 := vmconsolesessionMixin[0].Fields()
+	_ = vmconsolesessionMixinFields0
+	vmconsolesessionFields := schema.VMConsoleSession{}.Fields()
+	_ = vmconsolesessionFields
+	// vmconsolesessionDescCreatedAt is the schema descriptor for created_at field.
+	vmconsolesessionDescCreatedAt := vmconsolesessionMixinFields0[0].Descriptor()
+	// vmconsolesession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	vmconsolesession.DefaultCreatedAt = vmconsolesessionDescCreatedAt.Default.(func() time.Time)
+	// vmconsolesessionDescUpdatedAt is the schema descriptor for updated_at field.
+	vmconsolesessionDescUpdatedAt := vmconsolesessionMixinFields0[1].Descriptor()
+	// vmconsolesession.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	vmconsolesession.DefaultUpdatedAt = vmconsolesessionDescUpdatedAt.Default.(func() time.Time)
+	// vmconsolesession.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	vmconsolesession.UpdateDefaultUpdatedAt = vmconsolesessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// vmconsolesessionDescVMID is the schema descriptor for vm_id field.
+	vmconsolesessionDescVMID := vmconsolesessionFields[1].Descriptor()
+	// vmconsolesession.VMIDValidator is a validator for the "vm_id" field. It is called by the builders before save.
+	vmconsolesession.VMIDValidator = vmconsolesessionDescVMID.Validators[0].(func(string) error)
+	// vmconsolesessionDescUserID is the schema descriptor for user_id field.
+	vmconsolesessionDescUserID := vmconsolesessionFields[2].Descriptor()
+	// vmconsolesession.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	vmconsolesession.UserIDValidator = vmconsolesessionDescUserID.Validators[0].(func(string) error)
+	vmrequestidempotencyMixin := schema.VMRequestIdempotency{}.Mixin()
+	vmrequestidempotencyMixinFields0 := vmrequestidempotencyMixin[0].Fields()
+	_ = vmrequestidempotencyMixinFields0
+	vmrequestidempotencyFields := schema.VMRequestIdempotency{}.Fields()
+	_ = vmrequestidempotencyFields
+	// vmrequestidempotencyDescCreatedAt is the schema descriptor for created_at field.
+	vmrequestidempotencyDescCreatedAt := vmrequestidempotencyMixinFields0[0].Descriptor()
+	// vmrequestidempotency.DefaultCreatedAt holds the default value on creation for the created_at field.
+	vmrequestidempotency.DefaultCreatedAt = vmrequestidempotencyDescCreatedAt.Default.(func() time.Time)
+	// vmrequestidempotencyDescUpdatedAt is the schema descriptor for updated_at field.
+	vmrequestidempotencyDescUpdatedAt := vmrequestidempotencyMixinFields0[1].Descriptor()
+	// vmrequestidempotency.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	vmrequestidempotency.DefaultUpdatedAt = vmrequestidempotencyDescUpdatedAt.Default.(func() time.Time)
+	// vmrequestidempotency.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	vmrequestidempotency.UpdateDefaultUpdatedAt = vmrequestidempotencyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// vmrequestidempotencyDescCreatedBy is the schema descriptor for created_by field.
+	vmrequestidempotencyDescCreatedBy := vmrequestidempotencyFields[1].Descriptor()
+	// vmrequestidempotency.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	vmrequestidempotency.CreatedByValidator = vmrequestidempotencyDescCreatedBy.Validators[0].(func(string) error)
+	// vmrequestidempotencyDescRequestID is the schema descriptor for request_id field.
+	vmrequestidempotencyDescRequestID := vmrequestidempotencyFields[2].Descriptor()
+	// vmrequestidempotency.RequestIDValidator is a validator for the "request_id" field. It is called by the builders before save.
+	vmrequestidempotency.RequestIDValidator = func() func(string) error {
+		validators := vmrequestidempotencyDescRequestID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(request_id string) error {
+			for _, fn := range fns {
+				if err := fn(request_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// vmrequestidempotencyDescTicketID is the schema descriptor for ticket_id field.
+	vmrequestidempotencyDescTicketID := vmrequestidempotencyFields[3].Descriptor()
+	// vmrequestidempotency.TicketIDValidator is a validator for the "ticket_id" field. It is called by the builders before save.
+	vmrequestidempotency.TicketIDValidator = vmrequestidempotencyDescTicketID.Validators[0].(func(string) error)
+	// vmrequestidempotencyDescEventID is the schema descriptor for event_id field.
+	vmrequestidempotencyDescEventID := vmrequestidempotencyFields[4].Descriptor()
+	// vmrequestidempotency.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	vmrequestidempotency.EventIDValidator = vmrequestidempotencyDescEventID.Validators[0].(func(string) error)
 	vmrevisionMixin := schema.VMRevision{}.Mixin()
 	vmrevisionMixinFields0 := vmrevisionMixin[0].Fields()
 	_ = vmrevisionMixinFields0
@@ -890,4 +1416,82 @@ func init() {
 	vmrevisionDescChangedBy := vmrevisionFields[4].Descriptor()
 	// vmrevision.ChangedByValidator is a validator for the "changed_by" field. It is called by the builders before save.
 	vmrevision.ChangedByValidator = vmrevisionDescChangedBy.Validators[0].(func(string) error)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdeliveryMixinFields0 := webhookdeliveryMixin[0].Fields()
+	_ = webhookdeliveryMixinFields0
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryMixinFields0[0].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescEndpointID is the schema descriptor for endpoint_id field.
+	webhookdeliveryDescEndpointID := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.EndpointIDValidator is a validator for the "endpoint_id" field. It is called by the builders before save.
+	webhookdelivery.EndpointIDValidator = webhookdeliveryDescEndpointID.Validators[0].(func(string) error)
+	// webhookdeliveryDescDeliveryID is the schema descriptor for delivery_id field.
+	webhookdeliveryDescDeliveryID := webhookdeliveryFields[2].Descriptor()
+	// webhookdelivery.DeliveryIDValidator is a validator for the "delivery_id" field. It is called by the builders before save.
+	webhookdelivery.DeliveryIDValidator = webhookdeliveryDescDeliveryID.Validators[0].(func(string) error)
+	// webhookdeliveryDescEventType is the schema descriptor for event_type field.
+	webhookdeliveryDescEventType := webhookdeliveryFields[3].Descriptor()
+	// webhookdelivery.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	webhookdelivery.EventTypeValidator = webhookdeliveryDescEventType.Validators[0].(func(string) error)
+	// webhookdeliveryDescAttempt is the schema descriptor for attempt field.
+	webhookdeliveryDescAttempt := webhookdeliveryFields[4].Descriptor()
+	// webhookdelivery.AttemptValidator is a validator for the "attempt" field. It is called by the builders before save.
+	webhookdelivery.AttemptValidator = webhookdeliveryDescAttempt.Validators[0].(func(int) error)
+	// webhookdeliveryDescError is the schema descriptor for error field.
+	webhookdeliveryDescError := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	webhookdelivery.ErrorValidator = webhookdeliveryDescError.Validators[0].(func(string) error)
+	// webhookdeliveryDescDurationMs is the schema descriptor for duration_ms field.
+	webhookdeliveryDescDurationMs := webhookdeliveryFields[8].Descriptor()
+	// webhookdelivery.DefaultDurationMs holds the default value on creation for the duration_ms field.
+	webhookdelivery.DefaultDurationMs = webhookdeliveryDescDurationMs.Default.(int64)
+	webhookendpointMixin := schema.WebhookEndpoint{}.Mixin()
+	webhookendpointMixinFields0 := webhookendpointMixin[0].Fields()
+	_ = webhookendpointMixinFields0
+	webhookendpointFields := schema.WebhookEndpoint{}.Fields()
+	_ = webhookendpointFields
+	// webhookendpointDescCreatedAt is the schema descriptor for created_at field.
+	webhookendpointDescCreatedAt := webhookendpointMixinFields0[0].Descriptor()
+	// webhookendpoint.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookendpoint.DefaultCreatedAt = webhookendpointDescCreatedAt.Default.(func() time.Time)
+	// webhookendpointDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookendpointDescUpdatedAt := webhookendpointMixinFields0[1].Descriptor()
+	// webhookendpoint.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookendpoint.DefaultUpdatedAt = webhookendpointDescUpdatedAt.Default.(func() time.Time)
+	// webhookendpoint.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookendpoint.UpdateDefaultUpdatedAt = webhookendpointDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookendpointDescURL is the schema descriptor for url field.
+	webhookendpointDescURL := webhookendpointFields[1].Descriptor()
+	// webhookendpoint.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhookendpoint.URLValidator = func() func(string) error {
+		validators := webhookendpointDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookendpointDescSecret is the schema descriptor for secret field.
+	webhookendpointDescSecret := webhookendpointFields[2].Descriptor()
+	// webhookendpoint.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhookendpoint.SecretValidator = webhookendpointDescSecret.Validators[0].(func(string) error)
+	// webhookendpointDescEnabled is the schema descriptor for enabled field.
+	webhookendpointDescEnabled := webhookendpointFields[4].Descriptor()
+	// webhookendpoint.DefaultEnabled holds the default value on creation for the enabled field.
+	webhookendpoint.DefaultEnabled = webhookendpointDescEnabled.Default.(bool)
+	// webhookendpointDescCreatedBy is the schema descriptor for created_by field.
+	webhookendpointDescCreatedBy := webhookendpointFields[5].Descriptor()
+	// webhookendpoint.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	webhookendpoint.CreatedByValidator = webhookendpointDescCreatedBy.Validators[0].(func(string) error)
 }
@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"kv-shepherd.io/shepherd/ent/scheduledbatchjob"
+)
+
+// ScheduledBatchJob is the model entity for the ScheduledBatchJob schema.
+type ScheduledBatchJob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Standard 5-field cron expression, evaluated in UTC
+	CronExpression string `json:"cron_expression,omitempty"`
+	// Operation holds the value of the "operation" field.
+	Operation scheduledbatchjob.Operation `json:"operation,omitempty"`
+	// VMIds holds the value of the "vm_ids" field.
+	VMIds []string `json:"vm_ids,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Last time the schedule fired; next run is computed from here
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+	// LastBatchID holds the value of the "last_batch_id" field.
+	LastBatchID string `json:"last_batch_id,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// Submitting actor for the generated batches
+	CreatedBy    string `json:"created_by,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScheduledBatchJob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scheduledbatchjob.FieldVMIds:
+			values[i] = new([]byte)
+		case scheduledbatchjob.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case scheduledbatchjob.FieldID, scheduledbatchjob.FieldCronExpression, scheduledbatchjob.FieldOperation, scheduledbatchjob.FieldReason, scheduledbatchjob.FieldLastBatchID, scheduledbatchjob.FieldLastError, scheduledbatchjob.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case scheduledbatchjob.FieldCreatedAt, scheduledbatchjob.FieldUpdatedAt, scheduledbatchjob.FieldLastRunAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScheduledBatchJob fields.
+func (_m *ScheduledBatchJob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scheduledbatchjob.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case scheduledbatchjob.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case scheduledbatchjob.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case scheduledbatchjob.FieldCronExpression:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cron_expression", values[i])
+			} else if value.Valid {
+				_m.CronExpression = value.String
+			}
+		case scheduledbatchjob.FieldOperation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operation", values[i])
+			} else if value.Valid {
+				_m.Operation = scheduledbatchjob.Operation(value.String)
+			}
+		case scheduledbatchjob.FieldVMIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field vm_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.VMIds); err != nil {
+					return fmt.Errorf("unmarshal field vm_ids: %w", err)
+				}
+			}
+		case scheduledbatchjob.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case scheduledbatchjob.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case scheduledbatchjob.FieldLastRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_run_at", values[i])
+			} else if value.Valid {
+				_m.LastRunAt = new(time.Time)
+				*_m.LastRunAt = value.Time
+			}
+		case scheduledbatchjob.FieldLastBatchID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_batch_id", values[i])
+			} else if value.Valid {
+				_m.LastBatchID = value.String
+			}
+		case scheduledbatchjob.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = value.String
+			}
+		case scheduledbatchjob.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScheduledBatchJob.
+// This includes values selected through modifiers, order, etc.
+func (_m *ScheduledBatchJob) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ScheduledBatchJob.
+// Note that you need to call ScheduledBatchJob.Unwrap() before calling this method if this ScheduledBatchJob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ScheduledBatchJob) Update() *ScheduledBatchJobUpdateOne {
+	return NewScheduledBatchJobClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ScheduledBatchJob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ScheduledBatchJob) Unwrap() *ScheduledBatchJob {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScheduledBatchJob is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ScheduledBatchJob) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScheduledBatchJob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("cron_expression=")
+	builder.WriteString(_m.CronExpression)
+	builder.WriteString(", ")
+	builder.WriteString("operation=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Operation))
+	builder.WriteString(", ")
+	builder.WriteString("vm_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VMIds))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	if v := _m.LastRunAt; v != nil {
+		builder.WriteString("last_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_batch_id=")
+	builder.WriteString(_m.LastBatchID)
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(_m.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScheduledBatchJobs is a parsable slice of ScheduledBatchJob.
+type ScheduledBatchJobs []*ScheduledBatchJob
@@ -0,0 +1,640 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scheduledbatchjob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"kv-shepherd.io/shepherd/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CronExpression applies equality check predicate on the "cron_expression" field. It's identical to CronExpressionEQ.
+func CronExpression(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEQ(FieldCronExpression, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEQ(FieldEnabled, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEQ(FieldReason, v))
+}
+
+// LastRunAt applies equality check predicate on the "last_run_at" field. It's identical to LastRunAtEQ.
+func LastRunAt(v time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastBatchID applies equality check predicate on the "last_batch_id" field. It's identical to LastBatchIDEQ.
+func LastBatchID(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEQ(FieldLastBatchID, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEQ(FieldLastError, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CronExpressionEQ applies the EQ predicate on the "cron_expression" field.
+func CronExpressionEQ(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEQ(FieldCronExpression, v))
+}
+
+// CronExpressionNEQ applies the NEQ predicate on the "cron_expression" field.
+func CronExpressionNEQ(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNEQ(FieldCronExpression, v))
+}
+
+// CronExpressionIn applies the In predicate on the "cron_expression" field.
+func CronExpressionIn(vs ...string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldIn(FieldCronExpression, vs...))
+}
+
+// CronExpressionNotIn applies the NotIn predicate on the "cron_expression" field.
+func CronExpressionNotIn(vs ...string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNotIn(FieldCronExpression, vs...))
+}
+
+// CronExpressionGT applies the GT predicate on the "cron_expression" field.
+func CronExpressionGT(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldGT(FieldCronExpression, v))
+}
+
+// CronExpressionGTE applies the GTE predicate on the "cron_expression" field.
+func CronExpressionGTE(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldGTE(FieldCronExpression, v))
+}
+
+// CronExpressionLT applies the LT predicate on the "cron_expression" field.
+func CronExpressionLT(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldLT(FieldCronExpression, v))
+}
+
+// CronExpressionLTE applies the LTE predicate on the "cron_expression" field.
+func CronExpressionLTE(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldLTE(FieldCronExpression, v))
+}
+
+// CronExpressionContains applies the Contains predicate on the "cron_expression" field.
+func CronExpressionContains(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldContains(FieldCronExpression, v))
+}
+
+// CronExpressionHasPrefix applies the HasPrefix predicate on the "cron_expression" field.
+func CronExpressionHasPrefix(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldHasPrefix(FieldCronExpression, v))
+}
+
+// CronExpressionHasSuffix applies the HasSuffix predicate on the "cron_expression" field.
+func CronExpressionHasSuffix(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldHasSuffix(FieldCronExpression, v))
+}
+
+// CronExpressionEqualFold applies the EqualFold predicate on the "cron_expression" field.
+func CronExpressionEqualFold(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEqualFold(FieldCronExpression, v))
+}
+
+// CronExpressionContainsFold applies the ContainsFold predicate on the "cron_expression" field.
+func CronExpressionContainsFold(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldContainsFold(FieldCronExpression, v))
+}
+
+// OperationEQ applies the EQ predicate on the "operation" field.
+func OperationEQ(v Operation) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEQ(FieldOperation, v))
+}
+
+// OperationNEQ applies the NEQ predicate on the "operation" field.
+func OperationNEQ(v Operation) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNEQ(FieldOperation, v))
+}
+
+// OperationIn applies the In predicate on the "operation" field.
+func OperationIn(vs ...Operation) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldIn(FieldOperation, vs...))
+}
+
+// OperationNotIn applies the NotIn predicate on the "operation" field.
+func OperationNotIn(vs ...Operation) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNotIn(FieldOperation, vs...))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldContainsFold(FieldReason, v))
+}
+
+// LastRunAtEQ applies the EQ predicate on the "last_run_at" field.
+func LastRunAtEQ(v time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtNEQ applies the NEQ predicate on the "last_run_at" field.
+func LastRunAtNEQ(v time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtIn applies the In predicate on the "last_run_at" field.
+func LastRunAtIn(vs ...time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtNotIn applies the NotIn predicate on the "last_run_at" field.
+func LastRunAtNotIn(vs ...time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNotIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtGT applies the GT predicate on the "last_run_at" field.
+func LastRunAtGT(v time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldGT(FieldLastRunAt, v))
+}
+
+// LastRunAtGTE applies the GTE predicate on the "last_run_at" field.
+func LastRunAtGTE(v time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldGTE(FieldLastRunAt, v))
+}
+
+// LastRunAtLT applies the LT predicate on the "last_run_at" field.
+func LastRunAtLT(v time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldLT(FieldLastRunAt, v))
+}
+
+// LastRunAtLTE applies the LTE predicate on the "last_run_at" field.
+func LastRunAtLTE(v time.Time) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldLTE(FieldLastRunAt, v))
+}
+
+// LastRunAtIsNil applies the IsNil predicate on the "last_run_at" field.
+func LastRunAtIsNil() predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldIsNull(FieldLastRunAt))
+}
+
+// LastRunAtNotNil applies the NotNil predicate on the "last_run_at" field.
+func LastRunAtNotNil() predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNotNull(FieldLastRunAt))
+}
+
+// LastBatchIDEQ applies the EQ predicate on the "last_batch_id" field.
+func LastBatchIDEQ(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEQ(FieldLastBatchID, v))
+}
+
+// LastBatchIDNEQ applies the NEQ predicate on the "last_batch_id" field.
+func LastBatchIDNEQ(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNEQ(FieldLastBatchID, v))
+}
+
+// LastBatchIDIn applies the In predicate on the "last_batch_id" field.
+func LastBatchIDIn(vs ...string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldIn(FieldLastBatchID, vs...))
+}
+
+// LastBatchIDNotIn applies the NotIn predicate on the "last_batch_id" field.
+func LastBatchIDNotIn(vs ...string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNotIn(FieldLastBatchID, vs...))
+}
+
+// LastBatchIDGT applies the GT predicate on the "last_batch_id" field.
+func LastBatchIDGT(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldGT(FieldLastBatchID, v))
+}
+
+// LastBatchIDGTE applies the GTE predicate on the "last_batch_id" field.
+func LastBatchIDGTE(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldGTE(FieldLastBatchID, v))
+}
+
+// LastBatchIDLT applies the LT predicate on the "last_batch_id" field.
+func LastBatchIDLT(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldLT(FieldLastBatchID, v))
+}
+
+// LastBatchIDLTE applies the LTE predicate on the "last_batch_id" field.
+func LastBatchIDLTE(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldLTE(FieldLastBatchID, v))
+}
+
+// LastBatchIDContains applies the Contains predicate on the "last_batch_id" field.
+func LastBatchIDContains(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldContains(FieldLastBatchID, v))
+}
+
+// LastBatchIDHasPrefix applies the HasPrefix predicate on the "last_batch_id" field.
+func LastBatchIDHasPrefix(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldHasPrefix(FieldLastBatchID, v))
+}
+
+// LastBatchIDHasSuffix applies the HasSuffix predicate on the "last_batch_id" field.
+func LastBatchIDHasSuffix(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldHasSuffix(FieldLastBatchID, v))
+}
+
+// LastBatchIDIsNil applies the IsNil predicate on the "last_batch_id" field.
+func LastBatchIDIsNil() predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldIsNull(FieldLastBatchID))
+}
+
+// LastBatchIDNotNil applies the NotNil predicate on the "last_batch_id" field.
+func LastBatchIDNotNil() predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNotNull(FieldLastBatchID))
+}
+
+// LastBatchIDEqualFold applies the EqualFold predicate on the "last_batch_id" field.
+func LastBatchIDEqualFold(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEqualFold(FieldLastBatchID, v))
+}
+
+// LastBatchIDContainsFold applies the ContainsFold predicate on the "last_batch_id" field.
+func LastBatchIDContainsFold(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldContainsFold(FieldLastBatchID, v))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScheduledBatchJob) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScheduledBatchJob) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScheduledBatchJob) predicate.ScheduledBatchJob {
+	return predicate.ScheduledBatchJob(sql.NotPredicates(p))
+}
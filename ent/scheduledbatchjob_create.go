@@ -0,0 +1,402 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"kv-shepherd.io/shepherd/ent/scheduledbatchjob"
+)
+
+// ScheduledBatchJobCreate is the builder for creating a ScheduledBatchJob entity.
+type ScheduledBatchJobCreate struct {
+	config
+	mutation *ScheduledBatchJobMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ScheduledBatchJobCreate) SetCreatedAt(v time.Time) *ScheduledBatchJobCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ScheduledBatchJobCreate) SetNillableCreatedAt(v *time.Time) *ScheduledBatchJobCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ScheduledBatchJobCreate) SetUpdatedAt(v time.Time) *ScheduledBatchJobCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ScheduledBatchJobCreate) SetNillableUpdatedAt(v *time.Time) *ScheduledBatchJobCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCronExpression sets the "cron_expression" field.
+func (_c *ScheduledBatchJobCreate) SetCronExpression(v string) *ScheduledBatchJobCreate {
+	_c.mutation.SetCronExpression(v)
+	return _c
+}
+
+// SetOperation sets the "operation" field.
+func (_c *ScheduledBatchJobCreate) SetOperation(v scheduledbatchjob.Operation) *ScheduledBatchJobCreate {
+	_c.mutation.SetOperation(v)
+	return _c
+}
+
+// SetVMIds sets the "vm_ids" field.
+func (_c *ScheduledBatchJobCreate) SetVMIds(v []string) *ScheduledBatchJobCreate {
+	_c.mutation.SetVMIds(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *ScheduledBatchJobCreate) SetEnabled(v bool) *ScheduledBatchJobCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *ScheduledBatchJobCreate) SetNillableEnabled(v *bool) *ScheduledBatchJobCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *ScheduledBatchJobCreate) SetReason(v string) *ScheduledBatchJobCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *ScheduledBatchJobCreate) SetNillableReason(v *string) *ScheduledBatchJobCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_c *ScheduledBatchJobCreate) SetLastRunAt(v time.Time) *ScheduledBatchJobCreate {
+	_c.mutation.SetLastRunAt(v)
+	return _c
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_c *ScheduledBatchJobCreate) SetNillableLastRunAt(v *time.Time) *ScheduledBatchJobCreate {
+	if v != nil {
+		_c.SetLastRunAt(*v)
+	}
+	return _c
+}
+
+// SetLastBatchID sets the "last_batch_id" field.
+func (_c *ScheduledBatchJobCreate) SetLastBatchID(v string) *ScheduledBatchJobCreate {
+	_c.mutation.SetLastBatchID(v)
+	return _c
+}
+
+// SetNillableLastBatchID sets the "last_batch_id" field if the given value is not nil.
+func (_c *ScheduledBatchJobCreate) SetNillableLastBatchID(v *string) *ScheduledBatchJobCreate {
+	if v != nil {
+		_c.SetLastBatchID(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *ScheduledBatchJobCreate) SetLastError(v string) *ScheduledBatchJobCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *ScheduledBatchJobCreate) SetNillableLastError(v *string) *ScheduledBatchJobCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *ScheduledBatchJobCreate) SetCreatedBy(v string) *ScheduledBatchJobCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ScheduledBatchJobCreate) SetID(v string) *ScheduledBatchJobCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ScheduledBatchJobMutation object of the builder.
+func (_c *ScheduledBatchJobCreate) Mutation() *ScheduledBatchJobMutation {
+	return _c.mutation
+}
+
+// Save creates the ScheduledBatchJob in the database.
+func (_c *ScheduledBatchJobCreate) Save(ctx context.Context) (*ScheduledBatchJob, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ScheduledBatchJobCreate) SaveX(ctx context.Context) *ScheduledBatchJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScheduledBatchJobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScheduledBatchJobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ScheduledBatchJobCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := scheduledbatchjob.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := scheduledbatchjob.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := scheduledbatchjob.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ScheduledBatchJobCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ScheduledBatchJob.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ScheduledBatchJob.updated_at"`)}
+	}
+	if _, ok := _c.mutation.CronExpression(); !ok {
+		return &ValidationError{Name: "cron_expression", err: errors.New(`ent: missing required field "ScheduledBatchJob.cron_expression"`)}
+	}
+	if v, ok := _c.mutation.CronExpression(); ok {
+		if err := scheduledbatchjob.CronExpressionValidator(v); err != nil {
+			return &ValidationError{Name: "cron_expression", err: fmt.Errorf(`ent: validator failed for field "ScheduledBatchJob.cron_expression": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Operation(); !ok {
+		return &ValidationError{Name: "operation", err: errors.New(`ent: missing required field "ScheduledBatchJob.operation"`)}
+	}
+	if v, ok := _c.mutation.Operation(); ok {
+		if err := scheduledbatchjob.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "ScheduledBatchJob.operation": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.VMIds(); !ok {
+		return &ValidationError{Name: "vm_ids", err: errors.New(`ent: missing required field "ScheduledBatchJob.vm_ids"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "ScheduledBatchJob.enabled"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "ScheduledBatchJob.created_by"`)}
+	}
+	if v, ok := _c.mutation.CreatedBy(); ok {
+		if err := scheduledbatchjob.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "ScheduledBatchJob.created_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ScheduledBatchJobCreate) sqlSave(ctx context.Context) (*ScheduledBatchJob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ScheduledBatchJob.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ScheduledBatchJobCreate) createSpec() (*ScheduledBatchJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScheduledBatchJob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(scheduledbatchjob.Table, sqlgraph.NewFieldSpec(scheduledbatchjob.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(scheduledbatchjob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledbatchjob.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.CronExpression(); ok {
+		_spec.SetField(scheduledbatchjob.FieldCronExpression, field.TypeString, value)
+		_node.CronExpression = value
+	}
+	if value, ok := _c.mutation.Operation(); ok {
+		_spec.SetField(scheduledbatchjob.FieldOperation, field.TypeEnum, value)
+		_node.Operation = value
+	}
+	if value, ok := _c.mutation.VMIds(); ok {
+		_spec.SetField(scheduledbatchjob.FieldVMIds, field.TypeJSON, value)
+		_node.VMIds = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(scheduledbatchjob.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(scheduledbatchjob.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.LastRunAt(); ok {
+		_spec.SetField(scheduledbatchjob.FieldLastRunAt, field.TypeTime, value)
+		_node.LastRunAt = &value
+	}
+	if value, ok := _c.mutation.LastBatchID(); ok {
+		_spec.SetField(scheduledbatchjob.FieldLastBatchID, field.TypeString, value)
+		_node.LastBatchID = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(scheduledbatchjob.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(scheduledbatchjob.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	return _node, _spec
+}
+
+// ScheduledBatchJobCreateBulk is the builder for creating many ScheduledBatchJob entities in bulk.
+type ScheduledBatchJobCreateBulk struct {
+	config
+	err      error
+	builders []*ScheduledBatchJobCreate
+}
+
+// Save creates the ScheduledBatchJob entities in the database.
+func (_c *ScheduledBatchJobCreateBulk) Save(ctx context.Context) ([]*ScheduledBatchJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ScheduledBatchJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduledBatchJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ScheduledBatchJobCreateBulk) SaveX(ctx context.Context) []*ScheduledBatchJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScheduledBatchJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScheduledBatchJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
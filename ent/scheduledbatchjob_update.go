@@ -0,0 +1,643 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"kv-shepherd.io/shepherd/ent/predicate"
+	"kv-shepherd.io/shepherd/ent/scheduledbatchjob"
+)
+
+// ScheduledBatchJobUpdate is the builder for updating ScheduledBatchJob entities.
+type ScheduledBatchJobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScheduledBatchJobMutation
+}
+
+// Where appends a list predicates to the ScheduledBatchJobUpdate builder.
+func (_u *ScheduledBatchJobUpdate) Where(ps ...predicate.ScheduledBatchJob) *ScheduledBatchJobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ScheduledBatchJobUpdate) SetUpdatedAt(v time.Time) *ScheduledBatchJobUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCronExpression sets the "cron_expression" field.
+func (_u *ScheduledBatchJobUpdate) SetCronExpression(v string) *ScheduledBatchJobUpdate {
+	_u.mutation.SetCronExpression(v)
+	return _u
+}
+
+// SetNillableCronExpression sets the "cron_expression" field if the given value is not nil.
+func (_u *ScheduledBatchJobUpdate) SetNillableCronExpression(v *string) *ScheduledBatchJobUpdate {
+	if v != nil {
+		_u.SetCronExpression(*v)
+	}
+	return _u
+}
+
+// SetOperation sets the "operation" field.
+func (_u *ScheduledBatchJobUpdate) SetOperation(v scheduledbatchjob.Operation) *ScheduledBatchJobUpdate {
+	_u.mutation.SetOperation(v)
+	return _u
+}
+
+// SetNillableOperation sets the "operation" field if the given value is not nil.
+func (_u *ScheduledBatchJobUpdate) SetNillableOperation(v *scheduledbatchjob.Operation) *ScheduledBatchJobUpdate {
+	if v != nil {
+		_u.SetOperation(*v)
+	}
+	return _u
+}
+
+// SetVMIds sets the "vm_ids" field.
+func (_u *ScheduledBatchJobUpdate) SetVMIds(v []string) *ScheduledBatchJobUpdate {
+	_u.mutation.SetVMIds(v)
+	return _u
+}
+
+// AppendVMIds appends value to the "vm_ids" field.
+func (_u *ScheduledBatchJobUpdate) AppendVMIds(v []string) *ScheduledBatchJobUpdate {
+	_u.mutation.AppendVMIds(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ScheduledBatchJobUpdate) SetEnabled(v bool) *ScheduledBatchJobUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ScheduledBatchJobUpdate) SetNillableEnabled(v *bool) *ScheduledBatchJobUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ScheduledBatchJobUpdate) SetReason(v string) *ScheduledBatchJobUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ScheduledBatchJobUpdate) SetNillableReason(v *string) *ScheduledBatchJobUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *ScheduledBatchJobUpdate) ClearReason() *ScheduledBatchJobUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *ScheduledBatchJobUpdate) SetLastRunAt(v time.Time) *ScheduledBatchJobUpdate {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *ScheduledBatchJobUpdate) SetNillableLastRunAt(v *time.Time) *ScheduledBatchJobUpdate {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *ScheduledBatchJobUpdate) ClearLastRunAt() *ScheduledBatchJobUpdate {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// SetLastBatchID sets the "last_batch_id" field.
+func (_u *ScheduledBatchJobUpdate) SetLastBatchID(v string) *ScheduledBatchJobUpdate {
+	_u.mutation.SetLastBatchID(v)
+	return _u
+}
+
+// SetNillableLastBatchID sets the "last_batch_id" field if the given value is not nil.
+func (_u *ScheduledBatchJobUpdate) SetNillableLastBatchID(v *string) *ScheduledBatchJobUpdate {
+	if v != nil {
+		_u.SetLastBatchID(*v)
+	}
+	return _u
+}
+
+// ClearLastBatchID clears the value of the "last_batch_id" field.
+func (_u *ScheduledBatchJobUpdate) ClearLastBatchID() *ScheduledBatchJobUpdate {
+	_u.mutation.ClearLastBatchID()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *ScheduledBatchJobUpdate) SetLastError(v string) *ScheduledBatchJobUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *ScheduledBatchJobUpdate) SetNillableLastError(v *string) *ScheduledBatchJobUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *ScheduledBatchJobUpdate) ClearLastError() *ScheduledBatchJobUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *ScheduledBatchJobUpdate) SetCreatedBy(v string) *ScheduledBatchJobUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *ScheduledBatchJobUpdate) SetNillableCreatedBy(v *string) *ScheduledBatchJobUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ScheduledBatchJobMutation object of the builder.
+func (_u *ScheduledBatchJobUpdate) Mutation() *ScheduledBatchJobMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ScheduledBatchJobUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScheduledBatchJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ScheduledBatchJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScheduledBatchJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScheduledBatchJobUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := scheduledbatchjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScheduledBatchJobUpdate) check() error {
+	if v, ok := _u.mutation.CronExpression(); ok {
+		if err := scheduledbatchjob.CronExpressionValidator(v); err != nil {
+			return &ValidationError{Name: "cron_expression", err: fmt.Errorf(`ent: validator failed for field "ScheduledBatchJob.cron_expression": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Operation(); ok {
+		if err := scheduledbatchjob.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "ScheduledBatchJob.operation": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreatedBy(); ok {
+		if err := scheduledbatchjob.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "ScheduledBatchJob.created_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ScheduledBatchJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scheduledbatchjob.Table, scheduledbatchjob.Columns, sqlgraph.NewFieldSpec(scheduledbatchjob.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledbatchjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CronExpression(); ok {
+		_spec.SetField(scheduledbatchjob.FieldCronExpression, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Operation(); ok {
+		_spec.SetField(scheduledbatchjob.FieldOperation, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.VMIds(); ok {
+		_spec.SetField(scheduledbatchjob.FieldVMIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVMIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scheduledbatchjob.FieldVMIds, value)
+		})
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(scheduledbatchjob.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(scheduledbatchjob.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(scheduledbatchjob.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(scheduledbatchjob.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(scheduledbatchjob.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastBatchID(); ok {
+		_spec.SetField(scheduledbatchjob.FieldLastBatchID, field.TypeString, value)
+	}
+	if _u.mutation.LastBatchIDCleared() {
+		_spec.ClearField(scheduledbatchjob.FieldLastBatchID, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(scheduledbatchjob.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(scheduledbatchjob.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(scheduledbatchjob.FieldCreatedBy, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledbatchjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ScheduledBatchJobUpdateOne is the builder for updating a single ScheduledBatchJob entity.
+type ScheduledBatchJobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScheduledBatchJobMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ScheduledBatchJobUpdateOne) SetUpdatedAt(v time.Time) *ScheduledBatchJobUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCronExpression sets the "cron_expression" field.
+func (_u *ScheduledBatchJobUpdateOne) SetCronExpression(v string) *ScheduledBatchJobUpdateOne {
+	_u.mutation.SetCronExpression(v)
+	return _u
+}
+
+// SetNillableCronExpression sets the "cron_expression" field if the given value is not nil.
+func (_u *ScheduledBatchJobUpdateOne) SetNillableCronExpression(v *string) *ScheduledBatchJobUpdateOne {
+	if v != nil {
+		_u.SetCronExpression(*v)
+	}
+	return _u
+}
+
+// SetOperation sets the "operation" field.
+func (_u *ScheduledBatchJobUpdateOne) SetOperation(v scheduledbatchjob.Operation) *ScheduledBatchJobUpdateOne {
+	_u.mutation.SetOperation(v)
+	return _u
+}
+
+// SetNillableOperation sets the "operation" field if the given value is not nil.
+func (_u *ScheduledBatchJobUpdateOne) SetNillableOperation(v *scheduledbatchjob.Operation) *ScheduledBatchJobUpdateOne {
+	if v != nil {
+		_u.SetOperation(*v)
+	}
+	return _u
+}
+
+// SetVMIds sets the "vm_ids" field.
+func (_u *ScheduledBatchJobUpdateOne) SetVMIds(v []string) *ScheduledBatchJobUpdateOne {
+	_u.mutation.SetVMIds(v)
+	return _u
+}
+
+// AppendVMIds appends value to the "vm_ids" field.
+func (_u *ScheduledBatchJobUpdateOne) AppendVMIds(v []string) *ScheduledBatchJobUpdateOne {
+	_u.mutation.AppendVMIds(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ScheduledBatchJobUpdateOne) SetEnabled(v bool) *ScheduledBatchJobUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ScheduledBatchJobUpdateOne) SetNillableEnabled(v *bool) *ScheduledBatchJobUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ScheduledBatchJobUpdateOne) SetReason(v string) *ScheduledBatchJobUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ScheduledBatchJobUpdateOne) SetNillableReason(v *string) *ScheduledBatchJobUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *ScheduledBatchJobUpdateOne) ClearReason() *ScheduledBatchJobUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *ScheduledBatchJobUpdateOne) SetLastRunAt(v time.Time) *ScheduledBatchJobUpdateOne {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *ScheduledBatchJobUpdateOne) SetNillableLastRunAt(v *time.Time) *ScheduledBatchJobUpdateOne {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *ScheduledBatchJobUpdateOne) ClearLastRunAt() *ScheduledBatchJobUpdateOne {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// SetLastBatchID sets the "last_batch_id" field.
+func (_u *ScheduledBatchJobUpdateOne) SetLastBatchID(v string) *ScheduledBatchJobUpdateOne {
+	_u.mutation.SetLastBatchID(v)
+	return _u
+}
+
+// SetNillableLastBatchID sets the "last_batch_id" field if the given value is not nil.
+func (_u *ScheduledBatchJobUpdateOne) SetNillableLastBatchID(v *string) *ScheduledBatchJobUpdateOne {
+	if v != nil {
+		_u.SetLastBatchID(*v)
+	}
+	return _u
+}
+
+// ClearLastBatchID clears the value of the "last_batch_id" field.
+func (_u *ScheduledBatchJobUpdateOne) ClearLastBatchID() *ScheduledBatchJobUpdateOne {
+	_u.mutation.ClearLastBatchID()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *ScheduledBatchJobUpdateOne) SetLastError(v string) *ScheduledBatchJobUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *ScheduledBatchJobUpdateOne) SetNillableLastError(v *string) *ScheduledBatchJobUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *ScheduledBatchJobUpdateOne) ClearLastError() *ScheduledBatchJobUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *ScheduledBatchJobUpdateOne) SetCreatedBy(v string) *ScheduledBatchJobUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *ScheduledBatchJobUpdateOne) SetNillableCreatedBy(v *string) *ScheduledBatchJobUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ScheduledBatchJobMutation object of the builder.
+func (_u *ScheduledBatchJobUpdateOne) Mutation() *ScheduledBatchJobMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ScheduledBatchJobUpdate builder.
+func (_u *ScheduledBatchJobUpdateOne) Where(ps ...predicate.ScheduledBatchJob) *ScheduledBatchJobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ScheduledBatchJobUpdateOne) Select(field string, fields ...string) *ScheduledBatchJobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ScheduledBatchJob entity.
+func (_u *ScheduledBatchJobUpdateOne) Save(ctx context.Context) (*ScheduledBatchJob, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScheduledBatchJobUpdateOne) SaveX(ctx context.Context) *ScheduledBatchJob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ScheduledBatchJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScheduledBatchJobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScheduledBatchJobUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := scheduledbatchjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScheduledBatchJobUpdateOne) check() error {
+	if v, ok := _u.mutation.CronExpression(); ok {
+		if err := scheduledbatchjob.CronExpressionValidator(v); err != nil {
+			return &ValidationError{Name: "cron_expression", err: fmt.Errorf(`ent: validator failed for field "ScheduledBatchJob.cron_expression": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Operation(); ok {
+		if err := scheduledbatchjob.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "ScheduledBatchJob.operation": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreatedBy(); ok {
+		if err := scheduledbatchjob.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "ScheduledBatchJob.created_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ScheduledBatchJobUpdateOne) sqlSave(ctx context.Context) (_node *ScheduledBatchJob, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scheduledbatchjob.Table, scheduledbatchjob.Columns, sqlgraph.NewFieldSpec(scheduledbatchjob.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScheduledBatchJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scheduledbatchjob.FieldID)
+		for _, f := range fields {
+			if !scheduledbatchjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scheduledbatchjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledbatchjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CronExpression(); ok {
+		_spec.SetField(scheduledbatchjob.FieldCronExpression, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Operation(); ok {
+		_spec.SetField(scheduledbatchjob.FieldOperation, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.VMIds(); ok {
+		_spec.SetField(scheduledbatchjob.FieldVMIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVMIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scheduledbatchjob.FieldVMIds, value)
+		})
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(scheduledbatchjob.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(scheduledbatchjob.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(scheduledbatchjob.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(scheduledbatchjob.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(scheduledbatchjob.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastBatchID(); ok {
+		_spec.SetField(scheduledbatchjob.FieldLastBatchID, field.TypeString, value)
+	}
+	if _u.mutation.LastBatchIDCleared() {
+		_spec.ClearField(scheduledbatchjob.FieldLastBatchID, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(scheduledbatchjob.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(scheduledbatchjob.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(scheduledbatchjob.FieldCreatedBy, field.TypeString, value)
+	}
+	_node = &ScheduledBatchJob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledbatchjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
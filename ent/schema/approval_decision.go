@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ApprovalDecision records a single approver's decision on an ApprovalTicket.
+// ADR-0005 V2: multi-level chains accumulate decisions until the ticket's
+// required_approvals quorum is reached. Append-only.
+type ApprovalDecision struct {
+	ent.Schema
+}
+
+// Mixin of the ApprovalDecision.
+func (ApprovalDecision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		AuditMixin{}, // Append-only: created_at only
+	}
+}
+
+// Fields of the ApprovalDecision.
+func (ApprovalDecision) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("ticket_id").
+			NotEmpty().
+			Immutable(), // Reference to ApprovalTicket
+		field.String("approver").
+			NotEmpty().
+			Immutable(),
+		field.Enum("decision").
+			Values("APPROVED", "REJECTED").
+			Immutable(),
+		field.String("selected_cluster_id").
+			Optional().
+			Immutable(), // Cluster proposed by this approver (CREATE tickets)
+		field.String("selected_storage_class").
+			Optional().
+			Immutable(),
+	}
+}
+
+// Indexes of the ApprovalDecision.
+func (ApprovalDecision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("ticket_id", "approver").
+			Unique(), // One decision per approver per ticket
+	}
+}
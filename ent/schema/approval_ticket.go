@@ -2,12 +2,14 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 )
 
 // ApprovalTicket holds the schema definition for the ApprovalTicket entity.
 // ADR-0005: Simple approval flow — PENDING → APPROVED or PENDING → REJECTED.
+// ADR-0005 V2: required_approvals > 1 keeps the ticket PENDING until quorum (see ApprovalDecision).
 // ADR-0017: Admin-determined fields (cluster, template_version, storage_class).
 type ApprovalTicket struct {
 	ent.Schema
@@ -30,12 +32,12 @@ func (ApprovalTicket) Fields() []ent.Field {
 			NotEmpty().
 			Immutable(), // Reference to DomainEvent
 		field.Enum("operation_type").
-			Values("CREATE", "DELETE", "VNC_ACCESS").
+			Values("CREATE", "DELETE", "VNC_ACCESS", "MIGRATE", "RESIZE", "SNAPSHOT", "NAMESPACE_MIGRATE").
 			Default("CREATE"). // Backward compatible; existing tickets are CREATE
 			Comment("Distinguishes CREATE vs DELETE approval tickets (Phase 4 governance)"),
 		field.Enum("status").
-			Values("PENDING", "APPROVED", "REJECTED", "CANCELLED", "EXECUTING", "SUCCESS", "FAILED").
-			Default("PENDING"),
+			Values("PENDING", "APPROVED", "REJECTED", "CANCELLED", "EXECUTING", "SUCCESS", "FAILED", "EXPIRED").
+			Default("PENDING"), // EXPIRED: approved VNC access past expires_at
 		field.String("requester").
 			NotEmpty().
 			Immutable(),
@@ -45,6 +47,11 @@ func (ApprovalTicket) Fields() []ent.Field {
 			Optional(), // Requester's reason
 		field.String("reject_reason").
 			Optional(), // Approver's rejection reason
+		field.String("approval_comment").
+			Optional().
+			MaxLen(1000), // Approver's note attached to the approval
+		field.String("assigned_approver").
+			Optional(), // Set when a pending ticket is reassigned (delegation)
 		// Admin-determined fields (ADR-0017)
 		field.String("selected_cluster_id").
 			Optional(),
@@ -61,15 +68,46 @@ func (ApprovalTicket) Fields() []ent.Field {
 		// Batch support
 		field.String("parent_ticket_id").
 			Optional(), // For batch approval child tickets
+		field.Time("expires_at").
+			Optional().
+			Nillable(), // VNC_ACCESS: end of the approved access window
+		// Multi-level approval (ADR-0005 V2)
+		field.Int("required_approvals").
+			Default(1).
+			Positive(), // Distinct approvals needed before dispatch
+		// Execution progress, maintained by River workers on claim/finish
+		field.Time("started_at").
+			Optional().
+			Nillable(), // Latest worker claim
+		field.Time("finished_at").
+			Optional().
+			Nillable(), // Latest terminal outcome; cleared on re-claim
+		field.Int("attempt_count").
+			Default(0).
+			NonNegative(), // Worker claims across all retries
+		// Client idempotency key for single-VM requests
+		field.String("request_id").
+			Optional().
+			Nillable().
+			Immutable().
+			MaxLen(128),
 	}
 }
 
 // Indexes of the ApprovalTicket.
 func (ApprovalTicket) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("status"),
+		// Serves the approval list's status filter and its created_at
+		// ordering/keyset cursor together.
+		index.Fields("status", "created_at"),
 		index.Fields("requester"),
 		index.Fields("event_id"),
 		index.Fields("parent_ticket_id"),
+		index.Fields("assigned_approver"),
+		// One open ticket per requester and idempotency key; terminal tickets
+		// drop out so the key can be reused after a rejection or failure.
+		index.Fields("requester", "request_id").
+			Unique().
+			Annotations(entsql.IndexWhere("request_id IS NOT NULL AND status IN ('PENDING', 'APPROVED', 'EXECUTING')")),
 	}
 }
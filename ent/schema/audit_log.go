@@ -48,8 +48,9 @@ func (AuditLog) Fields() []ent.Field {
 // Indexes of the AuditLog.
 func (AuditLog) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("resource_type", "resource_id"),
-		index.Fields("actor"),
+		// Audit log lists filter by resource or actor and sort by created_at.
+		index.Fields("resource_type", "resource_id", "created_at"),
+		index.Fields("actor", "created_at"),
 		index.Fields("created_at"),
 	}
 }
@@ -37,6 +37,30 @@ func (AuthProvider) Fields() []ent.Field {
 			Default(0),
 		field.String("created_by").
 			NotEmpty(),
+		// Scheduled group re-sync (cron expression or Go duration); empty
+		// disables it. Only adapters that can list groups are synced.
+		field.String("sync_schedule").
+			Optional(),
+		// Outcome of the last scheduled group sync attempt.
+		field.Time("last_sync_at").
+			Optional().
+			Nillable(),
+		field.Int("last_sync_added").
+			Default(0),
+		field.Int("last_sync_updated").
+			Default(0),
+		field.Int("last_sync_stale").
+			Default(0),
+		field.Int64("last_sync_duration_ms").
+			Default(0),
+		field.String("last_sync_error").
+			Optional(),
+		// Consecutive failed sync attempts; drives the retry backoff.
+		field.Int("sync_failures").
+			Default(0),
+		field.Time("sync_retry_at").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -43,7 +43,7 @@ func (BatchApprovalTicket) Fields() []ent.Field {
 			Default(0).
 			NonNegative(),
 		field.Enum("status").
-			Values("PENDING_APPROVAL", "IN_PROGRESS", "COMPLETED", "PARTIAL_SUCCESS", "FAILED", "CANCELLED").
+			Values("PENDING_APPROVAL", "PARTIAL_APPROVED", "IN_PROGRESS", "COMPLETED", "PARTIAL_SUCCESS", "FAILED", "CANCELLED").
 			Default("PENDING_APPROVAL"),
 		field.String("request_id").
 			Optional().
@@ -63,5 +63,6 @@ func (BatchApprovalTicket) Indexes() []ent.Index {
 		index.Fields("created_by"),
 		index.Fields("created_at"),
 		index.Fields("batch_type", "created_by"),
+		index.Fields("created_by", "request_id", "batch_type"), // Submit idempotency lookup
 	}
 }
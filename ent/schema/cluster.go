@@ -61,6 +61,34 @@ func (Cluster) Fields() []ent.Field {
 			Comment("Last StorageClass detection timestamp"),
 		field.Bool("enabled").
 			Default(true),
+		// Capacity (0 = unset; cluster is exempt from capacity checks)
+		field.Int("total_cpu_cores").
+			Default(0).
+			NonNegative().
+			Comment("Schedulable vCPU capacity; 0 disables the CPU capacity check"),
+		field.Int("total_memory_mb").
+			Default(0).
+			NonNegative().
+			Comment("Schedulable memory capacity in MB; 0 disables the memory capacity check"),
+		field.Float("cpu_overcommit_ratio").
+			Default(1.0).
+			Positive().
+			Comment("Allocatable vCPU = total_cpu_cores * ratio"),
+		field.Float("memory_overcommit_ratio").
+			Default(1.0).
+			Positive().
+			Comment("Allocatable memory = total_memory_mb * ratio"),
+		field.Int("health_check_failures").
+			Default(0).
+			NonNegative().
+			Comment("Consecutive failed health probes; reset on success"),
+		field.Time("last_health_check_at").
+			Optional().
+			Nillable().
+			Comment("Timestamp of the most recent health probe"),
+		field.String("health_message").
+			Optional().
+			Comment("Failure reason from the most recent health probe; empty when healthy"),
 	}
 }
 
@@ -38,6 +38,13 @@ func (IdPSyncedGroup) Fields() []ent.Field {
 		field.Time("last_synced_at").
 			Optional().
 			Nillable(),
+		// Stale groups vanished from the IdP on a scheduled re-sync. They are
+		// kept so mappings pointing at them can be flagged and cleaned up.
+		field.Bool("stale").
+			Default(false),
+		field.Time("stale_since").
+			Optional().
+			Nillable(),
 	}
 }
 
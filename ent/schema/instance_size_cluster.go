@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InstanceSizeCluster restricts an InstanceSize to a cluster. A size with no
+// rows is available on every cluster; otherwise approvals may only place it
+// on the listed clusters (e.g. GPU or SR-IOV sizes).
+type InstanceSizeCluster struct {
+	ent.Schema
+}
+
+// Mixin of the InstanceSizeCluster.
+func (InstanceSizeCluster) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the InstanceSizeCluster.
+func (InstanceSizeCluster) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("instance_size_id").
+			NotEmpty().
+			Immutable(), // Reference to InstanceSize
+		field.String("cluster_id").
+			NotEmpty().
+			Immutable(), // Reference to Cluster
+		field.String("created_by").
+			NotEmpty(),
+	}
+}
+
+// Indexes of the InstanceSizeCluster.
+func (InstanceSizeCluster) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("instance_size_id", "cluster_id").Unique(),
+		index.Fields("cluster_id"),
+	}
+}
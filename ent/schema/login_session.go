@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LoginSession records a JWT issued at login so it can be listed and
+// revoked before it expires.
+//
+// The ID is the token ID (JWT jti). Rows are kept until expiry: a token
+// without a row is treated as not revoked.
+type LoginSession struct {
+	ent.Schema
+}
+
+// Mixin of the LoginSession.
+func (LoginSession) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the LoginSession.
+func (LoginSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable(),
+		field.Time("expires_at").
+			Immutable(),
+		field.String("user_agent").
+			Optional().
+			MaxLen(512).
+			Immutable(),
+		field.String("ip_address").
+			Optional().
+			Immutable(),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.String("revoked_by").
+			Optional(),
+	}
+}
+
+// Indexes of the LoginSession.
+func (LoginSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "expires_at"),
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginThrottle counts consecutive failed local logins for one key and
+// records the lockout they triggered.
+//
+// The ID is "user:{username}" or "ip:{address}". Usernames are tracked
+// whether or not the account exists, so lockouts do not reveal which
+// usernames are valid.
+type LoginThrottle struct {
+	ent.Schema
+}
+
+// Mixin of the LoginThrottle.
+func (LoginThrottle) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the LoginThrottle.
+func (LoginThrottle) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.Int("failures").
+			NonNegative().
+			Default(0),
+		field.Time("last_failure_at"),
+		field.Time("locked_until").
+			Optional().
+			Nillable(),
+	}
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// NamespaceQuota caps how many VMs may exist in a single Shepherd namespace.
+//
+// Namespaces without a quota row are unlimited. The cap is enforced when CREATE
+// batches are submitted.
+type NamespaceQuota struct {
+	ent.Schema
+}
+
+// Mixin of the NamespaceQuota.
+func (NamespaceQuota) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the NamespaceQuota.
+func (NamespaceQuota) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("namespace_name").
+			NotEmpty().
+			MaxLen(63).
+			Unique().
+			Immutable().
+			Comment("NamespaceRegistry name the quota applies to"),
+		field.Int("max_vms").
+			Min(0).
+			Comment("Maximum number of VMs allowed in the namespace"),
+		field.String("created_by").
+			NotEmpty(),
+	}
+}
@@ -46,6 +46,9 @@ func (NamespaceRegistry) Fields() []ent.Field {
 			NotEmpty(),
 		field.Bool("enabled").
 			Default(true),
+		field.Bool("require_power_approval").
+			Default(false).
+			Comment("Route batch power operations on this namespace's VMs through approval"),
 	}
 }
 
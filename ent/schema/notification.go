@@ -35,7 +35,9 @@ func (Notification) Fields() []ent.Field {
 				"APPROVAL_PENDING",
 				"APPROVAL_COMPLETED",
 				"APPROVAL_REJECTED",
+				"APPROVAL_COMMENT",
 				"VM_STATUS_CHANGE",
+				"CLUSTER_STATUS_CHANGE",
 			).
 			Comment("Notification type (ADR-0015 §20 trigger points)"),
 		field.String("title").
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// NotificationPreference records whether a user receives in-app
+// notifications of one type.
+//
+// A missing row means the type is enabled, so users who never saved
+// preferences get every notification.
+type NotificationPreference struct {
+	ent.Schema
+}
+
+// Mixin of the NotificationPreference.
+func (NotificationPreference) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the NotificationPreference.
+func (NotificationPreference) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable(),
+		field.Enum("event_type").
+			Values(
+				"APPROVAL_PENDING",
+				"APPROVAL_COMPLETED",
+				"APPROVAL_REJECTED",
+				"APPROVAL_COMMENT",
+				"VM_STATUS_CHANGE",
+				"CLUSTER_STATUS_CHANGE",
+			).
+			Immutable().
+			Comment("Notification type; mirrors notification.type"),
+		field.Bool("enabled").
+			Default(true),
+	}
+}
+
+// Indexes of the NotificationPreference.
+func (NotificationPreference) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "event_type").Unique(),
+	}
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PasswordHistory keeps the bcrypt hashes of a local user's recent
+// passwords so a password change cannot reuse one of them. Only the newest
+// security.password_policy.history_size rows per user are kept.
+type PasswordHistory struct {
+	ent.Schema
+}
+
+// Mixin of the PasswordHistory.
+func (PasswordHistory) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the PasswordHistory.
+func (PasswordHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable(),
+		field.String("password_hash").
+			NotEmpty().
+			Sensitive().
+			Immutable(),
+	}
+}
+
+// Indexes of the PasswordHistory.
+func (PasswordHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+	}
+}
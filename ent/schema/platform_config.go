@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// PlatformConfig stores runtime-tunable platform settings managed by admins.
+//
+// The table holds a single row keyed by the default id; settings that are
+// never saved fall back to the static server configuration.
+type PlatformConfig struct {
+	ent.Schema
+}
+
+// Mixin of the PlatformConfig.
+func (PlatformConfig) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the PlatformConfig.
+func (PlatformConfig) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Default("global").
+			Immutable(),
+		field.Int("approval_ttl_hours").
+			Default(0).
+			NonNegative().
+			Comment("PENDING tickets older than this are auto-rejected; 0 disables"),
+		field.String("smtp_host").
+			Optional().
+			Comment("SMTP relay for email notifications; empty disables email delivery"),
+		field.Int("smtp_port").
+			Default(587).
+			Range(1, 65535),
+		field.String("smtp_username").
+			Optional(),
+		field.String("smtp_password").
+			Optional().
+			Sensitive(), // Write-only via the API
+		field.String("smtp_from_address").
+			Optional(),
+		field.Bool("allow_user_data").
+			Default(false).
+			Comment("Accept cloud-init user_data on VM create requests"),
+		field.JSON("spec_override_policy", map[string]string{}).
+			Optional().
+			Comment("spec.* paths approvers may set in modified_spec, mapped to the JSON type each accepts"),
+		field.String("updated_by").
+			Optional(),
+	}
+}
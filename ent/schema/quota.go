@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Quota caps the VMs and aggregate resources a system or namespace may hold.
+//
+// Unset limits are unlimited. Quotas are enforced when CREATE tickets are
+// approved; VM requests that would exceed them only receive a warning.
+type Quota struct {
+	ent.Schema
+}
+
+// Mixin of the Quota.
+func (Quota) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Quota.
+func (Quota) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.Enum("scope_type").
+			Values("system", "namespace").
+			Immutable(),
+		field.String("scope_id").
+			NotEmpty().
+			Immutable().
+			Comment("System ID or NamespaceRegistry name the quota applies to"),
+		field.Int("max_vms").
+			Optional().
+			Nillable().
+			Min(0),
+		field.Int("max_cpu_cores").
+			Optional().
+			Nillable().
+			Min(0),
+		field.Int("max_memory_mb").
+			Optional().
+			Nillable().
+			Min(0),
+		field.String("created_by").
+			NotEmpty(),
+	}
+}
+
+// Indexes of the Quota.
+func (Quota) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("scope_type", "scope_id").Unique(),
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RefreshToken is a one-time token issued with a login JWT and exchanged at
+// POST /auth/refresh for a new JWT and a new refresh token.
+//
+// Only the SHA-256 of the token is stored. A used token is revoked, as are
+// all of a user's tokens when the password changes or is reset.
+type RefreshToken struct {
+	ent.Schema
+}
+
+// Mixin of the RefreshToken.
+func (RefreshToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the RefreshToken.
+func (RefreshToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable(),
+		field.String("token_hash").
+			NotEmpty().
+			Unique().
+			Sensitive().
+			Immutable(),
+		field.Time("expires_at").
+			Immutable(),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the RefreshToken.
+func (RefreshToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "expires_at"),
+	}
+}
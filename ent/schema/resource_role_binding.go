@@ -8,6 +8,8 @@ import (
 
 // ResourceRoleBinding holds the schema definition for the ResourceRoleBinding entity.
 // ADR-0018, master-flow Stage 4.A+: Resource-level member management (owner/admin/member/viewer).
+// Maintainers are the team that runs a system or service day to day; they can
+// manage it and its maintainer list without relying on the original creator.
 type ResourceRoleBinding struct {
 	ent.Schema
 }
@@ -32,7 +34,7 @@ func (ResourceRoleBinding) Fields() []ent.Field {
 		field.String("resource_id").
 			NotEmpty(),
 		field.Enum("role").
-			Values("owner", "admin", "member", "viewer").
+			Values("owner", "admin", "maintainer", "member", "viewer").
 			Default("viewer"),
 		field.String("created_by").
 			NotEmpty(),
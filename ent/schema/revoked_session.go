@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// RevokedSession invalidates every JWT of a user issued before a point in
+// time, including tokens without a LoginSession row.
+//
+// The ID is the user ID (stored as user_id); a later revocation moves
+// revoked_before forward instead of adding a row.
+type RevokedSession struct {
+	ent.Schema
+}
+
+// Mixin of the RevokedSession.
+func (RevokedSession) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the RevokedSession.
+func (RevokedSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("user_id").
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.Time("revoked_before"),
+		field.String("revoked_by").
+			Optional(),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ScheduledBatchJob is an operator-managed cron schedule that submits a batch
+// VM power request (e.g. stop dev VMs nightly, start them every morning).
+//
+// The scheduled_batch_power River job evaluates due schedules and submits them
+// through the same validation and rate-limit path as POST /vms/batch/power.
+type ScheduledBatchJob struct {
+	ent.Schema
+}
+
+// Mixin of the ScheduledBatchJob.
+func (ScheduledBatchJob) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the ScheduledBatchJob.
+func (ScheduledBatchJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("cron_expression").
+			NotEmpty().
+			MaxLen(128).
+			Comment("Standard 5-field cron expression, evaluated in UTC"),
+		field.Enum("operation").
+			Values("START", "STOP", "RESTART"),
+		field.JSON("vm_ids", []string{}),
+		field.Bool("enabled").
+			Default(true),
+		field.String("reason").
+			Optional(),
+		field.Time("last_run_at").
+			Optional().
+			Nillable().
+			Comment("Last time the schedule fired; next run is computed from here"),
+		field.String("last_batch_id").
+			Optional(),
+		field.String("last_error").
+			Optional(),
+		field.String("created_by").
+			NotEmpty().
+			Comment("Submitting actor for the generated batches"),
+	}
+}
+
+// Indexes of the ScheduledBatchJob.
+func (ScheduledBatchJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("enabled"),
+	}
+}
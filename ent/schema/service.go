@@ -36,6 +36,9 @@ func (Service) Fields() []ent.Field {
 		field.Int("next_instance_index").
 			Default(1).
 			Positive(),
+		field.JSON("labels", map[string]string{}).
+			Optional().
+			Comment("User-defined labels; keys match ^[a-z][a-z0-9_.-]{0,62}$"),
 		// NOTE: No created_by - inherited from System (ADR-0015 §2)
 		// NOTE: No maintainers - inherited from System via RoleBinding
 	}
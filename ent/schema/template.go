@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 )
@@ -44,6 +45,24 @@ func (Template) Fields() []ent.Field {
 			Default(true),
 		field.String("created_by").
 			NotEmpty(),
+		field.Time("deprecated_at").
+			Optional().
+			Nillable(), // Set by deprecate; deprecated templates cannot be requested
+		field.String("parent_template_id").
+			Optional().
+			Nillable().
+			Immutable(), // Spec is deep-merged over the parent's at approval time
+	}
+}
+
+// Edges of the Template.
+func (Template) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("children", Template.Type).
+			From("parent").
+			Field("parent_template_id").
+			Unique().
+			Immutable(),
 	}
 }
 
@@ -52,5 +71,6 @@ func (Template) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("name", "version").Unique(),
 		index.Fields("enabled"),
+		index.Fields("parent_template_id"),
 	}
 }
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TicketComment is a reviewer note attached to an ApprovalTicket without
+// deciding it. Comments are immutable; authors and platform admins may delete
+// them.
+type TicketComment struct {
+	ent.Schema
+}
+
+// Mixin of the TicketComment.
+func (TicketComment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		AuditMixin{}, // Immutable: created_at only
+	}
+}
+
+// Fields of the TicketComment.
+func (TicketComment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("ticket_id").
+			NotEmpty().
+			Immutable(), // Reference to ApprovalTicket
+		field.String("author").
+			NotEmpty().
+			Immutable(),
+		field.String("body").
+			NotEmpty().
+			MaxLen(2000).
+			Immutable(),
+	}
+}
+
+// Indexes of the TicketComment.
+func (TicketComment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("ticket_id", "created_at"),
+	}
+}
@@ -47,6 +47,7 @@ func (VM) Fields() []ent.Field {
 				// Extended states (K8s/KubeVirt specific)
 				"PENDING",   // K8s scheduler waiting
 				"MIGRATING", // Live migration in progress
+				"RESIZING",  // Approved resize being applied
 				"PAUSED",    // VM paused
 				"UNKNOWN",   // Status undetermined
 			).
@@ -57,6 +58,9 @@ func (VM) Fields() []ent.Field {
 			NotEmpty(),
 		field.String("ticket_id").
 			Optional(), // Reference to approval ticket
+		field.JSON("labels", map[string]string{}).
+			Optional().
+			Comment("User-defined labels; keys match ^[a-z][a-z0-9_.-]{0,62}$"),
 		// NOTE: No system_id field (ADR-0015 §3) — resolve via service.system edge
 	}
 }
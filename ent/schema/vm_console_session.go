@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// VMConsoleSession records an issued VNC console credential so it can be
+// listed and explicitly revoked before it expires.
+//
+// The ID is the single-use VNC token ID (JWT jti), i.e. the session_id.
+type VMConsoleSession struct {
+	ent.Schema
+}
+
+// Mixin of the VMConsoleSession.
+func (VMConsoleSession) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the VMConsoleSession.
+func (VMConsoleSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("vm_id").
+			NotEmpty().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable(),
+		field.Time("expires_at").
+			Immutable(),
+		field.String("ticket_id").
+			Optional().
+			Immutable(), // VNC_ACCESS ticket that approved the session, if any
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.String("revoked_by").
+			Optional(),
+	}
+}
+
+// Indexes of the VMConsoleSession.
+func (VMConsoleSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("vm_id", "expires_at"),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// VMRequestIdempotency claims a client request_id for a VM create request.
+//
+// The unique (created_by, request_id) index makes concurrent submissions with
+// the same key race-safe: only one transaction can insert the claim. A claim
+// whose ticket is closed (rejected, cancelled or finished) is taken over by
+// the next submission with that key.
+type VMRequestIdempotency struct {
+	ent.Schema
+}
+
+// Mixin of the VMRequestIdempotency.
+func (VMRequestIdempotency) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the VMRequestIdempotency.
+func (VMRequestIdempotency) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("created_by").
+			NotEmpty().
+			Immutable(),
+		field.String("request_id").
+			NotEmpty().
+			MaxLen(128).
+			Immutable(),
+		field.String("ticket_id").
+			NotEmpty().
+			Comment("Approval ticket currently holding the key"),
+		field.String("event_id").
+			NotEmpty(),
+	}
+}
+
+// Indexes of the VMRequestIdempotency.
+func (VMRequestIdempotency) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_by", "request_id").Unique(),
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookDelivery records a single webhook delivery attempt for troubleshooting.
+// Append-only: one row per attempt, retries share delivery_id.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Mixin of the WebhookDelivery.
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		AuditMixin{}, // Append-only: created_at only
+	}
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("endpoint_id").
+			NotEmpty().
+			Immutable(),
+		field.String("delivery_id").
+			NotEmpty().
+			Immutable().
+			Comment("Stable across retries; sent as X-Shepherd-Delivery"),
+		field.String("event_type").
+			NotEmpty().
+			Immutable(),
+		field.Int("attempt").
+			Positive().
+			Immutable(),
+		field.Bool("success").
+			Immutable(),
+		field.Int("status_code").
+			Optional().
+			Immutable().
+			Comment("HTTP status returned by the receiver; 0 when no response"),
+		field.String("error").
+			Optional().
+			MaxLen(1024).
+			Immutable(),
+		field.Int64("duration_ms").
+			Default(0).
+			Immutable(),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("endpoint_id", "created_at"),
+		index.Fields("delivery_id"),
+	}
+}
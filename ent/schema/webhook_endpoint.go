@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookEndpoint is an operator-managed HTTP receiver for approval ticket
+// lifecycle events (e.g. Slack, PagerDuty, custom endpoints).
+//
+// Payloads are signed with HMAC-SHA256 using secret and delivered
+// asynchronously by the webhook_delivery River job.
+type WebhookEndpoint struct {
+	ent.Schema
+}
+
+// Mixin of the WebhookEndpoint.
+func (WebhookEndpoint) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the WebhookEndpoint.
+func (WebhookEndpoint) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("url").
+			NotEmpty().
+			MaxLen(2048),
+		field.String("secret").
+			NotEmpty().
+			Sensitive(), // HMAC signing key; never returned by the API
+		field.JSON("event_types", []string{}).
+			Comment("Subscribed event types, e.g. ticket.approved"),
+		field.Bool("enabled").
+			Default(true),
+		field.String("created_by").
+			NotEmpty(),
+	}
+}
+
+// Indexes of the WebhookEndpoint.
+func (WebhookEndpoint) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("enabled"),
+	}
+}
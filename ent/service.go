@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -28,6 +29,8 @@ type Service struct {
 	Description string `json:"description,omitempty"`
 	// NextInstanceIndex holds the value of the "next_instance_index" field.
 	NextInstanceIndex int `json:"next_instance_index,omitempty"`
+	// User-defined labels; keys match ^[a-z][a-z0-9_.-]{0,62}$
+	Labels map[string]string `json:"labels,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ServiceQuery when eager-loading is set.
 	Edges           ServiceEdges `json:"edges"`
@@ -71,6 +74,8 @@ func (*Service) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case service.FieldLabels:
+			values[i] = new([]byte)
 		case service.FieldNextInstanceIndex:
 			values[i] = new(sql.NullInt64)
 		case service.FieldID, service.FieldName, service.FieldDescription:
@@ -130,6 +135,14 @@ func (_m *Service) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.NextInstanceIndex = int(value.Int64)
 			}
+		case service.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
 		case service.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field system_services", values[i])
@@ -197,6 +210,9 @@ func (_m *Service) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("next_instance_index=")
 	builder.WriteString(fmt.Sprintf("%v", _m.NextInstanceIndex))
+	builder.WriteString(", ")
+	builder.WriteString("labels=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Labels))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -24,6 +24,8 @@ const (
 	FieldDescription = "description"
 	// FieldNextInstanceIndex holds the string denoting the next_instance_index field in the database.
 	FieldNextInstanceIndex = "next_instance_index"
+	// FieldLabels holds the string denoting the labels field in the database.
+	FieldLabels = "labels"
 	// EdgeSystem holds the string denoting the system edge name in mutations.
 	EdgeSystem = "system"
 	// EdgeVms holds the string denoting the vms edge name in mutations.
@@ -54,6 +56,7 @@ var Columns = []string{
 	FieldName,
 	FieldDescription,
 	FieldNextInstanceIndex,
+	FieldLabels,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "services"
@@ -350,6 +350,16 @@ func NextInstanceIndexLTE(v int) predicate.Service {
 	return predicate.Service(sql.FieldLTE(FieldNextInstanceIndex, v))
 }
 
+// LabelsIsNil applies the IsNil predicate on the "labels" field.
+func LabelsIsNil() predicate.Service {
+	return predicate.Service(sql.FieldIsNull(FieldLabels))
+}
+
+// LabelsNotNil applies the NotNil predicate on the "labels" field.
+func LabelsNotNil() predicate.Service {
+	return predicate.Service(sql.FieldNotNull(FieldLabels))
+}
+
 // HasSystem applies the HasEdge predicate on the "system" edge.
 func HasSystem() predicate.Service {
 	return predicate.Service(func(s *sql.Selector) {
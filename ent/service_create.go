@@ -84,6 +84,12 @@ func (_c *ServiceCreate) SetNillableNextInstanceIndex(v *int) *ServiceCreate {
 	return _c
 }
 
+// SetLabels sets the "labels" field.
+func (_c *ServiceCreate) SetLabels(v map[string]string) *ServiceCreate {
+	_c.mutation.SetLabels(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *ServiceCreate) SetID(v string) *ServiceCreate {
 	_c.mutation.SetID(v)
@@ -247,6 +253,10 @@ func (_c *ServiceCreate) createSpec() (*Service, *sqlgraph.CreateSpec) {
 		_spec.SetField(service.FieldNextInstanceIndex, field.TypeInt, value)
 		_node.NextInstanceIndex = value
 	}
+	if value, ok := _c.mutation.Labels(); ok {
+		_spec.SetField(service.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
 	if nodes := _c.mutation.SystemIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
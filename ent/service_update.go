@@ -77,6 +77,18 @@ func (_u *ServiceUpdate) AddNextInstanceIndex(v int) *ServiceUpdate {
 	return _u
 }
 
+// SetLabels sets the "labels" field.
+func (_u *ServiceUpdate) SetLabels(v map[string]string) *ServiceUpdate {
+	_u.mutation.SetLabels(v)
+	return _u
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (_u *ServiceUpdate) ClearLabels() *ServiceUpdate {
+	_u.mutation.ClearLabels()
+	return _u
+}
+
 // SetSystemID sets the "system" edge to the System entity by ID.
 func (_u *ServiceUpdate) SetSystemID(id string) *ServiceUpdate {
 	_u.mutation.SetSystemID(id)
@@ -211,6 +223,12 @@ func (_u *ServiceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedNextInstanceIndex(); ok {
 		_spec.AddField(service.FieldNextInstanceIndex, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Labels(); ok {
+		_spec.SetField(service.FieldLabels, field.TypeJSON, value)
+	}
+	if _u.mutation.LabelsCleared() {
+		_spec.ClearField(service.FieldLabels, field.TypeJSON)
+	}
 	if _u.mutation.SystemCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -352,6 +370,18 @@ func (_u *ServiceUpdateOne) AddNextInstanceIndex(v int) *ServiceUpdateOne {
 	return _u
 }
 
+// SetLabels sets the "labels" field.
+func (_u *ServiceUpdateOne) SetLabels(v map[string]string) *ServiceUpdateOne {
+	_u.mutation.SetLabels(v)
+	return _u
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (_u *ServiceUpdateOne) ClearLabels() *ServiceUpdateOne {
+	_u.mutation.ClearLabels()
+	return _u
+}
+
 // SetSystemID sets the "system" edge to the System entity by ID.
 func (_u *ServiceUpdateOne) SetSystemID(id string) *ServiceUpdateOne {
 	_u.mutation.SetSystemID(id)
@@ -516,6 +546,12 @@ func (_u *ServiceUpdateOne) sqlSave(ctx context.Context) (_node *Service, err er
 	if value, ok := _u.mutation.AddedNextInstanceIndex(); ok {
 		_spec.AddField(service.FieldNextInstanceIndex, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Labels(); ok {
+		_spec.SetField(service.FieldLabels, field.TypeJSON, value)
+	}
+	if _u.mutation.LabelsCleared() {
+		_spec.ClearField(service.FieldLabels, field.TypeJSON)
+	}
 	if _u.mutation.SystemCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
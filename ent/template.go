@@ -39,10 +39,48 @@ type Template struct {
 	// Enabled holds the value of the "enabled" field.
 	Enabled bool `json:"enabled,omitempty"`
 	// CreatedBy holds the value of the "created_by" field.
-	CreatedBy    string `json:"created_by,omitempty"`
+	CreatedBy string `json:"created_by,omitempty"`
+	// DeprecatedAt holds the value of the "deprecated_at" field.
+	DeprecatedAt *time.Time `json:"deprecated_at,omitempty"`
+	// ParentTemplateID holds the value of the "parent_template_id" field.
+	ParentTemplateID *string `json:"parent_template_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TemplateQuery when eager-loading is set.
+	Edges        TemplateEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// TemplateEdges holds the relations/edges for other nodes in the graph.
+type TemplateEdges struct {
+	// Parent holds the value of the parent edge.
+	Parent *Template `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Template `json:"children,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TemplateEdges) ParentOrErr() (*Template, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: template.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e TemplateEdges) ChildrenOrErr() ([]*Template, error) {
+	if e.loadedTypes[1] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Template) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -54,9 +92,9 @@ func (*Template) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case template.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case template.FieldID, template.FieldName, template.FieldDisplayName, template.FieldDescription, template.FieldOsFamily, template.FieldOsVersion, template.FieldCreatedBy:
+		case template.FieldID, template.FieldName, template.FieldDisplayName, template.FieldDescription, template.FieldOsFamily, template.FieldOsVersion, template.FieldCreatedBy, template.FieldParentTemplateID:
 			values[i] = new(sql.NullString)
-		case template.FieldCreatedAt, template.FieldUpdatedAt:
+		case template.FieldCreatedAt, template.FieldUpdatedAt, template.FieldDeprecatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -147,6 +185,20 @@ func (_m *Template) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CreatedBy = value.String
 			}
+		case template.FieldDeprecatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deprecated_at", values[i])
+			} else if value.Valid {
+				_m.DeprecatedAt = new(time.Time)
+				*_m.DeprecatedAt = value.Time
+			}
+		case template.FieldParentTemplateID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_template_id", values[i])
+			} else if value.Valid {
+				_m.ParentTemplateID = new(string)
+				*_m.ParentTemplateID = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -160,6 +212,16 @@ func (_m *Template) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryParent queries the "parent" edge of the Template entity.
+func (_m *Template) QueryParent() *TemplateQuery {
+	return NewTemplateClient(_m.config).QueryParent(_m)
+}
+
+// QueryChildren queries the "children" edge of the Template entity.
+func (_m *Template) QueryChildren() *TemplateQuery {
+	return NewTemplateClient(_m.config).QueryChildren(_m)
+}
+
 // Update returns a builder for updating this Template.
 // Note that you need to call Template.Unwrap() before calling this method if this Template
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -215,6 +277,16 @@ func (_m *Template) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("created_by=")
 	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	if v := _m.DeprecatedAt; v != nil {
+		builder.WriteString("deprecated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ParentTemplateID; v != nil {
+		builder.WriteString("parent_template_id=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -35,8 +36,24 @@ const (
 	FieldEnabled = "enabled"
 	// FieldCreatedBy holds the string denoting the created_by field in the database.
 	FieldCreatedBy = "created_by"
+	// FieldDeprecatedAt holds the string denoting the deprecated_at field in the database.
+	FieldDeprecatedAt = "deprecated_at"
+	// FieldParentTemplateID holds the string denoting the parent_template_id field in the database.
+	FieldParentTemplateID = "parent_template_id"
+	// EdgeParent holds the string denoting the parent edge name in mutations.
+	EdgeParent = "parent"
+	// EdgeChildren holds the string denoting the children edge name in mutations.
+	EdgeChildren = "children"
 	// Table holds the table name of the template in the database.
 	Table = "templates"
+	// ParentTable is the table that holds the parent relation/edge.
+	ParentTable = "templates"
+	// ParentColumn is the table column denoting the parent relation/edge.
+	ParentColumn = "parent_template_id"
+	// ChildrenTable is the table that holds the children relation/edge.
+	ChildrenTable = "templates"
+	// ChildrenColumn is the table column denoting the children relation/edge.
+	ChildrenColumn = "parent_template_id"
 )
 
 // Columns holds all SQL columns for template fields.
@@ -53,6 +70,8 @@ var Columns = []string{
 	FieldOsVersion,
 	FieldEnabled,
 	FieldCreatedBy,
+	FieldDeprecatedAt,
+	FieldParentTemplateID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -141,3 +160,48 @@ func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
 func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
 }
+
+// ByDeprecatedAt orders the results by the deprecated_at field.
+func ByDeprecatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeprecatedAt, opts...).ToFunc()
+}
+
+// ByParentTemplateID orders the results by the parent_template_id field.
+func ByParentTemplateID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentTemplateID, opts...).ToFunc()
+}
+
+// ByParentField orders the results by parent field.
+func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChildrenCount orders the results by children count.
+func ByChildrenCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChildrenStep(), opts...)
+	}
+}
+
+// ByChildren orders the results by children terms.
+func ByChildren(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChildrenStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newParentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+	)
+}
+func newChildrenStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+	)
+}
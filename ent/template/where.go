@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"kv-shepherd.io/shepherd/ent/predicate"
 )
 
@@ -114,6 +115,16 @@ func CreatedBy(v string) predicate.Template {
 	return predicate.Template(sql.FieldEQ(FieldCreatedBy, v))
 }
 
+// DeprecatedAt applies equality check predicate on the "deprecated_at" field. It's identical to DeprecatedAtEQ.
+func DeprecatedAt(v time.Time) predicate.Template {
+	return predicate.Template(sql.FieldEQ(FieldDeprecatedAt, v))
+}
+
+// ParentTemplateID applies equality check predicate on the "parent_template_id" field. It's identical to ParentTemplateIDEQ.
+func ParentTemplateID(v string) predicate.Template {
+	return predicate.Template(sql.FieldEQ(FieldParentTemplateID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Template {
 	return predicate.Template(sql.FieldEQ(FieldCreatedAt, v))
@@ -684,6 +695,177 @@ func CreatedByContainsFold(v string) predicate.Template {
 	return predicate.Template(sql.FieldContainsFold(FieldCreatedBy, v))
 }
 
+// DeprecatedAtEQ applies the EQ predicate on the "deprecated_at" field.
+func DeprecatedAtEQ(v time.Time) predicate.Template {
+	return predicate.Template(sql.FieldEQ(FieldDeprecatedAt, v))
+}
+
+// DeprecatedAtNEQ applies the NEQ predicate on the "deprecated_at" field.
+func DeprecatedAtNEQ(v time.Time) predicate.Template {
+	return predicate.Template(sql.FieldNEQ(FieldDeprecatedAt, v))
+}
+
+// DeprecatedAtIn applies the In predicate on the "deprecated_at" field.
+func DeprecatedAtIn(vs ...time.Time) predicate.Template {
+	return predicate.Template(sql.FieldIn(FieldDeprecatedAt, vs...))
+}
+
+// DeprecatedAtNotIn applies the NotIn predicate on the "deprecated_at" field.
+func DeprecatedAtNotIn(vs ...time.Time) predicate.Template {
+	return predicate.Template(sql.FieldNotIn(FieldDeprecatedAt, vs...))
+}
+
+// DeprecatedAtGT applies the GT predicate on the "deprecated_at" field.
+func DeprecatedAtGT(v time.Time) predicate.Template {
+	return predicate.Template(sql.FieldGT(FieldDeprecatedAt, v))
+}
+
+// DeprecatedAtGTE applies the GTE predicate on the "deprecated_at" field.
+func DeprecatedAtGTE(v time.Time) predicate.Template {
+	return predicate.Template(sql.FieldGTE(FieldDeprecatedAt, v))
+}
+
+// DeprecatedAtLT applies the LT predicate on the "deprecated_at" field.
+func DeprecatedAtLT(v time.Time) predicate.Template {
+	return predicate.Template(sql.FieldLT(FieldDeprecatedAt, v))
+}
+
+// DeprecatedAtLTE applies the LTE predicate on the "deprecated_at" field.
+func DeprecatedAtLTE(v time.Time) predicate.Template {
+	return predicate.Template(sql.FieldLTE(FieldDeprecatedAt, v))
+}
+
+// DeprecatedAtIsNil applies the IsNil predicate on the "deprecated_at" field.
+func DeprecatedAtIsNil() predicate.Template {
+	return predicate.Template(sql.FieldIsNull(FieldDeprecatedAt))
+}
+
+// DeprecatedAtNotNil applies the NotNil predicate on the "deprecated_at" field.
+func DeprecatedAtNotNil() predicate.Template {
+	return predicate.Template(sql.FieldNotNull(FieldDeprecatedAt))
+}
+
+// ParentTemplateIDEQ applies the EQ predicate on the "parent_template_id" field.
+func ParentTemplateIDEQ(v string) predicate.Template {
+	return predicate.Template(sql.FieldEQ(FieldParentTemplateID, v))
+}
+
+// ParentTemplateIDNEQ applies the NEQ predicate on the "parent_template_id" field.
+func ParentTemplateIDNEQ(v string) predicate.Template {
+	return predicate.Template(sql.FieldNEQ(FieldParentTemplateID, v))
+}
+
+// ParentTemplateIDIn applies the In predicate on the "parent_template_id" field.
+func ParentTemplateIDIn(vs ...string) predicate.Template {
+	return predicate.Template(sql.FieldIn(FieldParentTemplateID, vs...))
+}
+
+// ParentTemplateIDNotIn applies the NotIn predicate on the "parent_template_id" field.
+func ParentTemplateIDNotIn(vs ...string) predicate.Template {
+	return predicate.Template(sql.FieldNotIn(FieldParentTemplateID, vs...))
+}
+
+// ParentTemplateIDGT applies the GT predicate on the "parent_template_id" field.
+func ParentTemplateIDGT(v string) predicate.Template {
+	return predicate.Template(sql.FieldGT(FieldParentTemplateID, v))
+}
+
+// ParentTemplateIDGTE applies the GTE predicate on the "parent_template_id" field.
+func ParentTemplateIDGTE(v string) predicate.Template {
+	return predicate.Template(sql.FieldGTE(FieldParentTemplateID, v))
+}
+
+// ParentTemplateIDLT applies the LT predicate on the "parent_template_id" field.
+func ParentTemplateIDLT(v string) predicate.Template {
+	return predicate.Template(sql.FieldLT(FieldParentTemplateID, v))
+}
+
+// ParentTemplateIDLTE applies the LTE predicate on the "parent_template_id" field.
+func ParentTemplateIDLTE(v string) predicate.Template {
+	return predicate.Template(sql.FieldLTE(FieldParentTemplateID, v))
+}
+
+// ParentTemplateIDContains applies the Contains predicate on the "parent_template_id" field.
+func ParentTemplateIDContains(v string) predicate.Template {
+	return predicate.Template(sql.FieldContains(FieldParentTemplateID, v))
+}
+
+// ParentTemplateIDHasPrefix applies the HasPrefix predicate on the "parent_template_id" field.
+func ParentTemplateIDHasPrefix(v string) predicate.Template {
+	return predicate.Template(sql.FieldHasPrefix(FieldParentTemplateID, v))
+}
+
+// ParentTemplateIDHasSuffix applies the HasSuffix predicate on the "parent_template_id" field.
+func ParentTemplateIDHasSuffix(v string) predicate.Template {
+	return predicate.Template(sql.FieldHasSuffix(FieldParentTemplateID, v))
+}
+
+// ParentTemplateIDIsNil applies the IsNil predicate on the "parent_template_id" field.
+func ParentTemplateIDIsNil() predicate.Template {
+	return predicate.Template(sql.FieldIsNull(FieldParentTemplateID))
+}
+
+// ParentTemplateIDNotNil applies the NotNil predicate on the "parent_template_id" field.
+func ParentTemplateIDNotNil() predicate.Template {
+	return predicate.Template(sql.FieldNotNull(FieldParentTemplateID))
+}
+
+// ParentTemplateIDEqualFold applies the EqualFold predicate on the "parent_template_id" field.
+func ParentTemplateIDEqualFold(v string) predicate.Template {
+	return predicate.Template(sql.FieldEqualFold(FieldParentTemplateID, v))
+}
+
+// ParentTemplateIDContainsFold applies the ContainsFold predicate on the "parent_template_id" field.
+func ParentTemplateIDContainsFold(v string) predicate.Template {
+	return predicate.Template(sql.FieldContainsFold(FieldParentTemplateID, v))
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.Template {
+	return predicate.Template(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.Template) predicate.Template {
+	return predicate.Template(func(s *sql.Selector) {
+		step := newParentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChildren applies the HasEdge predicate on the "children" edge.
+func HasChildren() predicate.Template {
+	return predicate.Template(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
+func HasChildrenWith(preds ...predicate.Template) predicate.Template {
+	return predicate.Template(func(s *sql.Selector) {
+		step := newChildrenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Template) predicate.Template {
 	return predicate.Template(sql.AndPredicates(predicates...))
@@ -150,12 +150,74 @@ func (_c *TemplateCreate) SetCreatedBy(v string) *TemplateCreate {
 	return _c
 }
 
+// SetDeprecatedAt sets the "deprecated_at" field.
+func (_c *TemplateCreate) SetDeprecatedAt(v time.Time) *TemplateCreate {
+	_c.mutation.SetDeprecatedAt(v)
+	return _c
+}
+
+// SetNillableDeprecatedAt sets the "deprecated_at" field if the given value is not nil.
+func (_c *TemplateCreate) SetNillableDeprecatedAt(v *time.Time) *TemplateCreate {
+	if v != nil {
+		_c.SetDeprecatedAt(*v)
+	}
+	return _c
+}
+
+// SetParentTemplateID sets the "parent_template_id" field.
+func (_c *TemplateCreate) SetParentTemplateID(v string) *TemplateCreate {
+	_c.mutation.SetParentTemplateID(v)
+	return _c
+}
+
+// SetNillableParentTemplateID sets the "parent_template_id" field if the given value is not nil.
+func (_c *TemplateCreate) SetNillableParentTemplateID(v *string) *TemplateCreate {
+	if v != nil {
+		_c.SetParentTemplateID(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *TemplateCreate) SetID(v string) *TemplateCreate {
 	_c.mutation.SetID(v)
 	return _c
 }
 
+// SetParentID sets the "parent" edge to the Template entity by ID.
+func (_c *TemplateCreate) SetParentID(id string) *TemplateCreate {
+	_c.mutation.SetParentID(id)
+	return _c
+}
+
+// SetNillableParentID sets the "parent" edge to the Template entity by ID if the given value is not nil.
+func (_c *TemplateCreate) SetNillableParentID(id *string) *TemplateCreate {
+	if id != nil {
+		_c = _c.SetParentID(*id)
+	}
+	return _c
+}
+
+// SetParent sets the "parent" edge to the Template entity.
+func (_c *TemplateCreate) SetParent(v *Template) *TemplateCreate {
+	return _c.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Template entity by IDs.
+func (_c *TemplateCreate) AddChildIDs(ids ...string) *TemplateCreate {
+	_c.mutation.AddChildIDs(ids...)
+	return _c
+}
+
+// AddChildren adds the "children" edges to the Template entity.
+func (_c *TemplateCreate) AddChildren(v ...*Template) *TemplateCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChildIDs(ids...)
+}
+
 // Mutation returns the TemplateMutation object of the builder.
 func (_c *TemplateCreate) Mutation() *TemplateMutation {
 	return _c.mutation
@@ -323,6 +385,43 @@ func (_c *TemplateCreate) createSpec() (*Template, *sqlgraph.CreateSpec) {
 		_spec.SetField(template.FieldCreatedBy, field.TypeString, value)
 		_node.CreatedBy = value
 	}
+	if value, ok := _c.mutation.DeprecatedAt(); ok {
+		_spec.SetField(template.FieldDeprecatedAt, field.TypeTime, value)
+		_node.DeprecatedAt = &value
+	}
+	if nodes := _c.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   template.ParentTable,
+			Columns: []string{template.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentTemplateID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.ChildrenTable,
+			Columns: []string{template.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -189,11 +189,67 @@ func (_u *TemplateUpdate) SetNillableCreatedBy(v *string) *TemplateUpdate {
 	return _u
 }
 
+// SetDeprecatedAt sets the "deprecated_at" field.
+func (_u *TemplateUpdate) SetDeprecatedAt(v time.Time) *TemplateUpdate {
+	_u.mutation.SetDeprecatedAt(v)
+	return _u
+}
+
+// SetNillableDeprecatedAt sets the "deprecated_at" field if the given value is not nil.
+func (_u *TemplateUpdate) SetNillableDeprecatedAt(v *time.Time) *TemplateUpdate {
+	if v != nil {
+		_u.SetDeprecatedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeprecatedAt clears the value of the "deprecated_at" field.
+func (_u *TemplateUpdate) ClearDeprecatedAt() *TemplateUpdate {
+	_u.mutation.ClearDeprecatedAt()
+	return _u
+}
+
+// AddChildIDs adds the "children" edge to the Template entity by IDs.
+func (_u *TemplateUpdate) AddChildIDs(ids ...string) *TemplateUpdate {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the Template entity.
+func (_u *TemplateUpdate) AddChildren(v ...*Template) *TemplateUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
 // Mutation returns the TemplateMutation object of the builder.
 func (_u *TemplateUpdate) Mutation() *TemplateMutation {
 	return _u.mutation
 }
 
+// ClearChildren clears all "children" edges to the Template entity.
+func (_u *TemplateUpdate) ClearChildren() *TemplateUpdate {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to Template entities by IDs.
+func (_u *TemplateUpdate) RemoveChildIDs(ids ...string) *TemplateUpdate {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to Template entities.
+func (_u *TemplateUpdate) RemoveChildren(v ...*Template) *TemplateUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *TemplateUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -310,6 +366,57 @@ func (_u *TemplateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.CreatedBy(); ok {
 		_spec.SetField(template.FieldCreatedBy, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.DeprecatedAt(); ok {
+		_spec.SetField(template.FieldDeprecatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeprecatedAtCleared() {
+		_spec.ClearField(template.FieldDeprecatedAt, field.TypeTime)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.ChildrenTable,
+			Columns: []string{template.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.ChildrenTable,
+			Columns: []string{template.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.ChildrenTable,
+			Columns: []string{template.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{template.Label}
@@ -491,11 +598,67 @@ func (_u *TemplateUpdateOne) SetNillableCreatedBy(v *string) *TemplateUpdateOne
 	return _u
 }
 
+// SetDeprecatedAt sets the "deprecated_at" field.
+func (_u *TemplateUpdateOne) SetDeprecatedAt(v time.Time) *TemplateUpdateOne {
+	_u.mutation.SetDeprecatedAt(v)
+	return _u
+}
+
+// SetNillableDeprecatedAt sets the "deprecated_at" field if the given value is not nil.
+func (_u *TemplateUpdateOne) SetNillableDeprecatedAt(v *time.Time) *TemplateUpdateOne {
+	if v != nil {
+		_u.SetDeprecatedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeprecatedAt clears the value of the "deprecated_at" field.
+func (_u *TemplateUpdateOne) ClearDeprecatedAt() *TemplateUpdateOne {
+	_u.mutation.ClearDeprecatedAt()
+	return _u
+}
+
+// AddChildIDs adds the "children" edge to the Template entity by IDs.
+func (_u *TemplateUpdateOne) AddChildIDs(ids ...string) *TemplateUpdateOne {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the Template entity.
+func (_u *TemplateUpdateOne) AddChildren(v ...*Template) *TemplateUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
 // Mutation returns the TemplateMutation object of the builder.
 func (_u *TemplateUpdateOne) Mutation() *TemplateMutation {
 	return _u.mutation
 }
 
+// ClearChildren clears all "children" edges to the Template entity.
+func (_u *TemplateUpdateOne) ClearChildren() *TemplateUpdateOne {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to Template entities by IDs.
+func (_u *TemplateUpdateOne) RemoveChildIDs(ids ...string) *TemplateUpdateOne {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to Template entities.
+func (_u *TemplateUpdateOne) RemoveChildren(v ...*Template) *TemplateUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
 // Where appends a list predicates to the TemplateUpdate builder.
 func (_u *TemplateUpdateOne) Where(ps ...predicate.Template) *TemplateUpdateOne {
 	_u.mutation.Where(ps...)
@@ -642,6 +805,57 @@ func (_u *TemplateUpdateOne) sqlSave(ctx context.Context) (_node *Template, err
 	if value, ok := _u.mutation.CreatedBy(); ok {
 		_spec.SetField(template.FieldCreatedBy, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.DeprecatedAt(); ok {
+		_spec.SetField(template.FieldDeprecatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeprecatedAtCleared() {
+		_spec.ClearField(template.FieldDeprecatedAt, field.TypeTime)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.ChildrenTable,
+			Columns: []string{template.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.ChildrenTable,
+			Columns: []string{template.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.ChildrenTable,
+			Columns: []string{template.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Template{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,335 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ticketcomment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"kv-shepherd.io/shepherd/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TicketID applies equality check predicate on the "ticket_id" field. It's identical to TicketIDEQ.
+func TicketID(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldEQ(FieldTicketID, v))
+}
+
+// Author applies equality check predicate on the "author" field. It's identical to AuthorEQ.
+func Author(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldEQ(FieldAuthor, v))
+}
+
+// Body applies equality check predicate on the "body" field. It's identical to BodyEQ.
+func Body(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldEQ(FieldBody, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// TicketIDEQ applies the EQ predicate on the "ticket_id" field.
+func TicketIDEQ(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldEQ(FieldTicketID, v))
+}
+
+// TicketIDNEQ applies the NEQ predicate on the "ticket_id" field.
+func TicketIDNEQ(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldNEQ(FieldTicketID, v))
+}
+
+// TicketIDIn applies the In predicate on the "ticket_id" field.
+func TicketIDIn(vs ...string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldIn(FieldTicketID, vs...))
+}
+
+// TicketIDNotIn applies the NotIn predicate on the "ticket_id" field.
+func TicketIDNotIn(vs ...string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldNotIn(FieldTicketID, vs...))
+}
+
+// TicketIDGT applies the GT predicate on the "ticket_id" field.
+func TicketIDGT(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldGT(FieldTicketID, v))
+}
+
+// TicketIDGTE applies the GTE predicate on the "ticket_id" field.
+func TicketIDGTE(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldGTE(FieldTicketID, v))
+}
+
+// TicketIDLT applies the LT predicate on the "ticket_id" field.
+func TicketIDLT(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldLT(FieldTicketID, v))
+}
+
+// TicketIDLTE applies the LTE predicate on the "ticket_id" field.
+func TicketIDLTE(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldLTE(FieldTicketID, v))
+}
+
+// TicketIDContains applies the Contains predicate on the "ticket_id" field.
+func TicketIDContains(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldContains(FieldTicketID, v))
+}
+
+// TicketIDHasPrefix applies the HasPrefix predicate on the "ticket_id" field.
+func TicketIDHasPrefix(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldHasPrefix(FieldTicketID, v))
+}
+
+// TicketIDHasSuffix applies the HasSuffix predicate on the "ticket_id" field.
+func TicketIDHasSuffix(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldHasSuffix(FieldTicketID, v))
+}
+
+// TicketIDEqualFold applies the EqualFold predicate on the "ticket_id" field.
+func TicketIDEqualFold(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldEqualFold(FieldTicketID, v))
+}
+
+// TicketIDContainsFold applies the ContainsFold predicate on the "ticket_id" field.
+func TicketIDContainsFold(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldContainsFold(FieldTicketID, v))
+}
+
+// AuthorEQ applies the EQ predicate on the "author" field.
+func AuthorEQ(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldEQ(FieldAuthor, v))
+}
+
+// AuthorNEQ applies the NEQ predicate on the "author" field.
+func AuthorNEQ(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldNEQ(FieldAuthor, v))
+}
+
+// AuthorIn applies the In predicate on the "author" field.
+func AuthorIn(vs ...string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldIn(FieldAuthor, vs...))
+}
+
+// AuthorNotIn applies the NotIn predicate on the "author" field.
+func AuthorNotIn(vs ...string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldNotIn(FieldAuthor, vs...))
+}
+
+// AuthorGT applies the GT predicate on the "author" field.
+func AuthorGT(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldGT(FieldAuthor, v))
+}
+
+// AuthorGTE applies the GTE predicate on the "author" field.
+func AuthorGTE(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldGTE(FieldAuthor, v))
+}
+
+// AuthorLT applies the LT predicate on the "author" field.
+func AuthorLT(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldLT(FieldAuthor, v))
+}
+
+// AuthorLTE applies the LTE predicate on the "author" field.
+func AuthorLTE(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldLTE(FieldAuthor, v))
+}
+
+// AuthorContains applies the Contains predicate on the "author" field.
+func AuthorContains(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldContains(FieldAuthor, v))
+}
+
+// AuthorHasPrefix applies the HasPrefix predicate on the "author" field.
+func AuthorHasPrefix(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldHasPrefix(FieldAuthor, v))
+}
+
+// AuthorHasSuffix applies the HasSuffix predicate on the "author" field.
+func AuthorHasSuffix(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldHasSuffix(FieldAuthor, v))
+}
+
+// AuthorEqualFold applies the EqualFold predicate on the "author" field.
+func AuthorEqualFold(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldEqualFold(FieldAuthor, v))
+}
+
+// AuthorContainsFold applies the ContainsFold predicate on the "author" field.
+func AuthorContainsFold(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldContainsFold(FieldAuthor, v))
+}
+
+// BodyEQ applies the EQ predicate on the "body" field.
+func BodyEQ(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldEQ(FieldBody, v))
+}
+
+// BodyNEQ applies the NEQ predicate on the "body" field.
+func BodyNEQ(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldNEQ(FieldBody, v))
+}
+
+// BodyIn applies the In predicate on the "body" field.
+func BodyIn(vs ...string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldIn(FieldBody, vs...))
+}
+
+// BodyNotIn applies the NotIn predicate on the "body" field.
+func BodyNotIn(vs ...string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldNotIn(FieldBody, vs...))
+}
+
+// BodyGT applies the GT predicate on the "body" field.
+func BodyGT(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldGT(FieldBody, v))
+}
+
+// BodyGTE applies the GTE predicate on the "body" field.
+func BodyGTE(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldGTE(FieldBody, v))
+}
+
+// BodyLT applies the LT predicate on the "body" field.
+func BodyLT(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldLT(FieldBody, v))
+}
+
+// BodyLTE applies the LTE predicate on the "body" field.
+func BodyLTE(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldLTE(FieldBody, v))
+}
+
+// BodyContains applies the Contains predicate on the "body" field.
+func BodyContains(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldContains(FieldBody, v))
+}
+
+// BodyHasPrefix applies the HasPrefix predicate on the "body" field.
+func BodyHasPrefix(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldHasPrefix(FieldBody, v))
+}
+
+// BodyHasSuffix applies the HasSuffix predicate on the "body" field.
+func BodyHasSuffix(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldHasSuffix(FieldBody, v))
+}
+
+// BodyEqualFold applies the EqualFold predicate on the "body" field.
+func BodyEqualFold(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldEqualFold(FieldBody, v))
+}
+
+// BodyContainsFold applies the ContainsFold predicate on the "body" field.
+func BodyContainsFold(v string) predicate.TicketComment {
+	return predicate.TicketComment(sql.FieldContainsFold(FieldBody, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TicketComment) predicate.TicketComment {
+	return predicate.TicketComment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TicketComment) predicate.TicketComment {
+	return predicate.TicketComment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TicketComment) predicate.TicketComment {
+	return predicate.TicketComment(sql.NotPredicates(p))
+}
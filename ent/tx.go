@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -12,6 +14,8 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ApprovalDecision is the client for interacting with the ApprovalDecision builders.
+	ApprovalDecision *ApprovalDecisionClient
 	// ApprovalPolicy is the client for interacting with the ApprovalPolicy builders.
 	ApprovalPolicy *ApprovalPolicyClient
 	// ApprovalTicket is the client for interacting with the ApprovalTicket builders.
@@ -34,22 +38,44 @@ type Tx struct {
 	IdPSyncedGroup *IdPSyncedGroupClient
 	// InstanceSize is the client for interacting with the InstanceSize builders.
 	InstanceSize *InstanceSizeClient
+	// InstanceSizeCluster is the client for interacting with the InstanceSizeCluster builders.
+	InstanceSizeCluster *InstanceSizeClusterClient
+	// LoginSession is the client for interacting with the LoginSession builders.
+	LoginSession *LoginSessionClient
+	// LoginThrottle is the client for interacting with the LoginThrottle builders.
+	LoginThrottle *LoginThrottleClient
+	// NamespaceQuota is the client for interacting with the NamespaceQuota builders.
+	NamespaceQuota *NamespaceQuotaClient
 	// NamespaceRegistry is the client for interacting with the NamespaceRegistry builders.
 	NamespaceRegistry *NamespaceRegistryClient
 	// Notification is the client for interacting with the Notification builders.
 	Notification *NotificationClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
+	// PasswordHistory is the client for interacting with the PasswordHistory builders.
+	PasswordHistory *PasswordHistoryClient
 	// PendingAdoption is the client for interacting with the PendingAdoption builders.
 	PendingAdoption *PendingAdoptionClient
+	// PlatformConfig is the client for interacting with the PlatformConfig builders.
+	PlatformConfig *PlatformConfigClient
+	// Quota is the client for interacting with the Quota builders.
+	Quota *QuotaClient
 	// RateLimitExemption is the client for interacting with the RateLimitExemption builders.
 	RateLimitExemption *RateLimitExemptionClient
 	// RateLimitUserOverride is the client for interacting with the RateLimitUserOverride builders.
 	RateLimitUserOverride *RateLimitUserOverrideClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
 	// ResourceRoleBinding is the client for interacting with the ResourceRoleBinding builders.
 	ResourceRoleBinding *ResourceRoleBindingClient
+	// RevokedSession is the client for interacting with the RevokedSession builders.
+	RevokedSession *RevokedSessionClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
 	// RoleBinding is the client for interacting with the RoleBinding builders.
 	RoleBinding *RoleBindingClient
+	// ScheduledBatchJob is the client for interacting with the ScheduledBatchJob builders.
+	ScheduledBatchJob *ScheduledBatchJobClient
 	// Service is the client for interacting with the Service builders.
 	Service *ServiceClient
 	// System is the client for interacting with the System builders.
@@ -58,12 +84,22 @@ type Tx struct {
 	SystemSecret *SystemSecretClient
 	// Template is the client for interacting with the Template builders.
 	Template *TemplateClient
+	// TicketComment is the client for interacting with the TicketComment builders.
+	TicketComment *TicketCommentClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// VM is the client for interacting with the VM builders.
 	VM *VMClient
+	// VMConsoleSession is the client for interacting with the VMConsoleSession builders.
+	VMConsoleSession *VMConsoleSessionClient
+	// VMRequestIdempotency is the client for interacting with the VMRequestIdempotency builders.
+	VMRequestIdempotency *VMRequestIdempotencyClient
 	// VMRevision is the client for interacting with the VMRevision builders.
 	VMRevision *VMRevisionClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookEndpoint is the client for interacting with the WebhookEndpoint builders.
+	WebhookEndpoint *WebhookEndpointClient
 
 	// lazily loaded.
 	client     *Client
@@ -195,6 +231,7 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ApprovalDecision = NewApprovalDecisionClient(tx.config)
 	tx.ApprovalPolicy = NewApprovalPolicyClient(tx.config)
 	tx.ApprovalTicket = NewApprovalTicketClient(tx.config)
 	tx.AuditLog = NewAuditLogClient(tx.config)
@@ -206,21 +243,37 @@ func (tx *Tx) init() {
 	tx.IdPGroupMapping = NewIdPGroupMappingClient(tx.config)
 	tx.IdPSyncedGroup = NewIdPSyncedGroupClient(tx.config)
 	tx.InstanceSize = NewInstanceSizeClient(tx.config)
+	tx.InstanceSizeCluster = NewInstanceSizeClusterClient(tx.config)
+	tx.LoginSession = NewLoginSessionClient(tx.config)
+	tx.LoginThrottle = NewLoginThrottleClient(tx.config)
+	tx.NamespaceQuota = NewNamespaceQuotaClient(tx.config)
 	tx.NamespaceRegistry = NewNamespaceRegistryClient(tx.config)
 	tx.Notification = NewNotificationClient(tx.config)
+	tx.NotificationPreference = NewNotificationPreferenceClient(tx.config)
+	tx.PasswordHistory = NewPasswordHistoryClient(tx.config)
 	tx.PendingAdoption = NewPendingAdoptionClient(tx.config)
+	tx.PlatformConfig = NewPlatformConfigClient(tx.config)
+	tx.Quota = NewQuotaClient(tx.config)
 	tx.RateLimitExemption = NewRateLimitExemptionClient(tx.config)
 	tx.RateLimitUserOverride = NewRateLimitUserOverrideClient(tx.config)
+	tx.RefreshToken = NewRefreshTokenClient(tx.config)
 	tx.ResourceRoleBinding = NewResourceRoleBindingClient(tx.config)
+	tx.RevokedSession = NewRevokedSessionClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
 	tx.RoleBinding = NewRoleBindingClient(tx.config)
+	tx.ScheduledBatchJob = NewScheduledBatchJobClient(tx.config)
 	tx.Service = NewServiceClient(tx.config)
 	tx.System = NewSystemClient(tx.config)
 	tx.SystemSecret = NewSystemSecretClient(tx.config)
 	tx.Template = NewTemplateClient(tx.config)
+	tx.TicketComment = NewTicketCommentClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.VM = NewVMClient(tx.config)
+	tx.VMConsoleSession = NewVMConsoleSessionClient(tx.config)
+	tx.VMRequestIdempotency = NewVMRequestIdempotencyClient(tx.config)
 	tx.VMRevision = NewVMRevisionClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
+	tx.WebhookEndpoint = NewWebhookEndpointClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -230,7 +283,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: ApprovalPolicy.QueryXXX(), the query will be executed
+// applies a query, for example: ApprovalDecision.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -283,3 +336,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -38,6 +39,8 @@ type VM struct {
 	CreatedBy string `json:"created_by,omitempty"`
 	// TicketID holds the value of the "ticket_id" field.
 	TicketID string `json:"ticket_id,omitempty"`
+	// User-defined labels; keys match ^[a-z][a-z0-9_.-]{0,62}$
+	Labels map[string]string `json:"labels,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the VMQuery when eager-loading is set.
 	Edges        VMEdges `json:"edges"`
@@ -81,6 +84,8 @@ func (*VM) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case vm.FieldLabels:
+			values[i] = new([]byte)
 		case vm.FieldID, vm.FieldName, vm.FieldInstance, vm.FieldNamespace, vm.FieldClusterID, vm.FieldStatus, vm.FieldHostname, vm.FieldCreatedBy, vm.FieldTicketID:
 			values[i] = new(sql.NullString)
 		case vm.FieldCreatedAt, vm.FieldUpdatedAt:
@@ -168,6 +173,14 @@ func (_m *VM) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.TicketID = value.String
 			}
+		case vm.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
 		case vm.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field service_vms", values[i])
@@ -250,6 +263,9 @@ func (_m *VM) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("ticket_id=")
 	builder.WriteString(_m.TicketID)
+	builder.WriteString(", ")
+	builder.WriteString("labels=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Labels))
 	builder.WriteByte(')')
 	return builder.String()
 }
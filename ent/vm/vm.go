@@ -35,6 +35,8 @@ const (
 	FieldCreatedBy = "created_by"
 	// FieldTicketID holds the string denoting the ticket_id field in the database.
 	FieldTicketID = "ticket_id"
+	// FieldLabels holds the string denoting the labels field in the database.
+	FieldLabels = "labels"
 	// EdgeService holds the string denoting the service edge name in mutations.
 	EdgeService = "service"
 	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
@@ -70,6 +72,7 @@ var Columns = []string{
 	FieldHostname,
 	FieldCreatedBy,
 	FieldTicketID,
+	FieldLabels,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "vms"
@@ -126,6 +129,7 @@ const (
 	StatusFAILED    Status = "FAILED"
 	StatusPENDING   Status = "PENDING"
 	StatusMIGRATING Status = "MIGRATING"
+	StatusRESIZING  Status = "RESIZING"
 	StatusPAUSED    Status = "PAUSED"
 	StatusUNKNOWN   Status = "UNKNOWN"
 )
@@ -137,7 +141,7 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusCREATING, StatusRUNNING, StatusSTOPPING, StatusSTOPPED, StatusDELETING, StatusFAILED, StatusPENDING, StatusMIGRATING, StatusPAUSED, StatusUNKNOWN:
+	case StatusCREATING, StatusRUNNING, StatusSTOPPING, StatusSTOPPED, StatusDELETING, StatusFAILED, StatusPENDING, StatusMIGRATING, StatusRESIZING, StatusPAUSED, StatusUNKNOWN:
 		return nil
 	default:
 		return fmt.Errorf("vm: invalid enum value for status field: %q", s)
@@ -695,6 +695,16 @@ func TicketIDContainsFold(v string) predicate.VM {
 	return predicate.VM(sql.FieldContainsFold(FieldTicketID, v))
 }
 
+// LabelsIsNil applies the IsNil predicate on the "labels" field.
+func LabelsIsNil() predicate.VM {
+	return predicate.VM(sql.FieldIsNull(FieldLabels))
+}
+
+// LabelsNotNil applies the NotNil predicate on the "labels" field.
+func LabelsNotNil() predicate.VM {
+	return predicate.VM(sql.FieldNotNull(FieldLabels))
+}
+
 // HasService applies the HasEdge predicate on the "service" edge.
 func HasService() predicate.VM {
 	return predicate.VM(func(s *sql.Selector) {
@@ -130,6 +130,12 @@ func (_c *VMCreate) SetNillableTicketID(v *string) *VMCreate {
 	return _c
 }
 
+// SetLabels sets the "labels" field.
+func (_c *VMCreate) SetLabels(v map[string]string) *VMCreate {
+	_c.mutation.SetLabels(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *VMCreate) SetID(v string) *VMCreate {
 	_c.mutation.SetID(v)
@@ -337,6 +343,10 @@ func (_c *VMCreate) createSpec() (*VM, *sqlgraph.CreateSpec) {
 		_spec.SetField(vm.FieldTicketID, field.TypeString, value)
 		_node.TicketID = value
 	}
+	if value, ok := _c.mutation.Labels(); ok {
+		_spec.SetField(vm.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
 	if nodes := _c.mutation.ServiceIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
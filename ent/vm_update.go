@@ -152,6 +152,18 @@ func (_u *VMUpdate) ClearTicketID() *VMUpdate {
 	return _u
 }
 
+// SetLabels sets the "labels" field.
+func (_u *VMUpdate) SetLabels(v map[string]string) *VMUpdate {
+	_u.mutation.SetLabels(v)
+	return _u
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (_u *VMUpdate) ClearLabels() *VMUpdate {
+	_u.mutation.ClearLabels()
+	return _u
+}
+
 // SetServiceID sets the "service" edge to the Service entity by ID.
 func (_u *VMUpdate) SetServiceID(id string) *VMUpdate {
 	_u.mutation.SetServiceID(id)
@@ -319,6 +331,12 @@ func (_u *VMUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.TicketIDCleared() {
 		_spec.ClearField(vm.FieldTicketID, field.TypeString)
 	}
+	if value, ok := _u.mutation.Labels(); ok {
+		_spec.SetField(vm.FieldLabels, field.TypeJSON, value)
+	}
+	if _u.mutation.LabelsCleared() {
+		_spec.ClearField(vm.FieldLabels, field.TypeJSON)
+	}
 	if _u.mutation.ServiceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -535,6 +553,18 @@ func (_u *VMUpdateOne) ClearTicketID() *VMUpdateOne {
 	return _u
 }
 
+// SetLabels sets the "labels" field.
+func (_u *VMUpdateOne) SetLabels(v map[string]string) *VMUpdateOne {
+	_u.mutation.SetLabels(v)
+	return _u
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (_u *VMUpdateOne) ClearLabels() *VMUpdateOne {
+	_u.mutation.ClearLabels()
+	return _u
+}
+
 // SetServiceID sets the "service" edge to the Service entity by ID.
 func (_u *VMUpdateOne) SetServiceID(id string) *VMUpdateOne {
 	_u.mutation.SetServiceID(id)
@@ -732,6 +762,12 @@ func (_u *VMUpdateOne) sqlSave(ctx context.Context) (_node *VM, err error) {
 	if _u.mutation.TicketIDCleared() {
 		_spec.ClearField(vm.FieldTicketID, field.TypeString)
 	}
+	if value, ok := _u.mutation.Labels(); ok {
+		_spec.SetField(vm.FieldLabels, field.TypeJSON, value)
+	}
+	if _u.mutation.LabelsCleared() {
+		_spec.ClearField(vm.FieldLabels, field.TypeJSON)
+	}
 	if _u.mutation.ServiceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
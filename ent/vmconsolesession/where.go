@@ -0,0 +1,570 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vmconsolesession
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"kv-shepherd.io/shepherd/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// VMID applies equality check predicate on the "vm_id" field. It's identical to VMIDEQ.
+func VMID(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldEQ(FieldVMID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldEQ(FieldUserID, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// TicketID applies equality check predicate on the "ticket_id" field. It's identical to TicketIDEQ.
+func TicketID(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldEQ(FieldTicketID, v))
+}
+
+// RevokedAt applies equality check predicate on the "revoked_at" field. It's identical to RevokedAtEQ.
+func RevokedAt(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RevokedBy applies equality check predicate on the "revoked_by" field. It's identical to RevokedByEQ.
+func RevokedBy(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldEQ(FieldRevokedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// VMIDEQ applies the EQ predicate on the "vm_id" field.
+func VMIDEQ(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldEQ(FieldVMID, v))
+}
+
+// VMIDNEQ applies the NEQ predicate on the "vm_id" field.
+func VMIDNEQ(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldNEQ(FieldVMID, v))
+}
+
+// VMIDIn applies the In predicate on the "vm_id" field.
+func VMIDIn(vs ...string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldIn(FieldVMID, vs...))
+}
+
+// VMIDNotIn applies the NotIn predicate on the "vm_id" field.
+func VMIDNotIn(vs ...string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldNotIn(FieldVMID, vs...))
+}
+
+// VMIDGT applies the GT predicate on the "vm_id" field.
+func VMIDGT(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldGT(FieldVMID, v))
+}
+
+// VMIDGTE applies the GTE predicate on the "vm_id" field.
+func VMIDGTE(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldGTE(FieldVMID, v))
+}
+
+// VMIDLT applies the LT predicate on the "vm_id" field.
+func VMIDLT(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldLT(FieldVMID, v))
+}
+
+// VMIDLTE applies the LTE predicate on the "vm_id" field.
+func VMIDLTE(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldLTE(FieldVMID, v))
+}
+
+// VMIDContains applies the Contains predicate on the "vm_id" field.
+func VMIDContains(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldContains(FieldVMID, v))
+}
+
+// VMIDHasPrefix applies the HasPrefix predicate on the "vm_id" field.
+func VMIDHasPrefix(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldHasPrefix(FieldVMID, v))
+}
+
+// VMIDHasSuffix applies the HasSuffix predicate on the "vm_id" field.
+func VMIDHasSuffix(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldHasSuffix(FieldVMID, v))
+}
+
+// VMIDEqualFold applies the EqualFold predicate on the "vm_id" field.
+func VMIDEqualFold(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldEqualFold(FieldVMID, v))
+}
+
+// VMIDContainsFold applies the ContainsFold predicate on the "vm_id" field.
+func VMIDContainsFold(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldContainsFold(FieldVMID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// TicketIDEQ applies the EQ predicate on the "ticket_id" field.
+func TicketIDEQ(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldEQ(FieldTicketID, v))
+}
+
+// TicketIDNEQ applies the NEQ predicate on the "ticket_id" field.
+func TicketIDNEQ(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldNEQ(FieldTicketID, v))
+}
+
+// TicketIDIn applies the In predicate on the "ticket_id" field.
+func TicketIDIn(vs ...string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldIn(FieldTicketID, vs...))
+}
+
+// TicketIDNotIn applies the NotIn predicate on the "ticket_id" field.
+func TicketIDNotIn(vs ...string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldNotIn(FieldTicketID, vs...))
+}
+
+// TicketIDGT applies the GT predicate on the "ticket_id" field.
+func TicketIDGT(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldGT(FieldTicketID, v))
+}
+
+// TicketIDGTE applies the GTE predicate on the "ticket_id" field.
+func TicketIDGTE(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldGTE(FieldTicketID, v))
+}
+
+// TicketIDLT applies the LT predicate on the "ticket_id" field.
+func TicketIDLT(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldLT(FieldTicketID, v))
+}
+
+// TicketIDLTE applies the LTE predicate on the "ticket_id" field.
+func TicketIDLTE(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldLTE(FieldTicketID, v))
+}
+
+// TicketIDContains applies the Contains predicate on the "ticket_id" field.
+func TicketIDContains(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldContains(FieldTicketID, v))
+}
+
+// TicketIDHasPrefix applies the HasPrefix predicate on the "ticket_id" field.
+func TicketIDHasPrefix(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldHasPrefix(FieldTicketID, v))
+}
+
+// TicketIDHasSuffix applies the HasSuffix predicate on the "ticket_id" field.
+func TicketIDHasSuffix(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldHasSuffix(FieldTicketID, v))
+}
+
+// TicketIDIsNil applies the IsNil predicate on the "ticket_id" field.
+func TicketIDIsNil() predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldIsNull(FieldTicketID))
+}
+
+// TicketIDNotNil applies the NotNil predicate on the "ticket_id" field.
+func TicketIDNotNil() predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldNotNull(FieldTicketID))
+}
+
+// TicketIDEqualFold applies the EqualFold predicate on the "ticket_id" field.
+func TicketIDEqualFold(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldEqualFold(FieldTicketID, v))
+}
+
+// TicketIDContainsFold applies the ContainsFold predicate on the "ticket_id" field.
+func TicketIDContainsFold(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldContainsFold(FieldTicketID, v))
+}
+
+// RevokedAtEQ applies the EQ predicate on the "revoked_at" field.
+func RevokedAtEQ(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtNEQ applies the NEQ predicate on the "revoked_at" field.
+func RevokedAtNEQ(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldNEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtIn applies the In predicate on the "revoked_at" field.
+func RevokedAtIn(vs ...time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtNotIn applies the NotIn predicate on the "revoked_at" field.
+func RevokedAtNotIn(vs ...time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldNotIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtGT applies the GT predicate on the "revoked_at" field.
+func RevokedAtGT(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldGT(FieldRevokedAt, v))
+}
+
+// RevokedAtGTE applies the GTE predicate on the "revoked_at" field.
+func RevokedAtGTE(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldGTE(FieldRevokedAt, v))
+}
+
+// RevokedAtLT applies the LT predicate on the "revoked_at" field.
+func RevokedAtLT(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldLT(FieldRevokedAt, v))
+}
+
+// RevokedAtLTE applies the LTE predicate on the "revoked_at" field.
+func RevokedAtLTE(v time.Time) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldLTE(FieldRevokedAt, v))
+}
+
+// RevokedAtIsNil applies the IsNil predicate on the "revoked_at" field.
+func RevokedAtIsNil() predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldIsNull(FieldRevokedAt))
+}
+
+// RevokedAtNotNil applies the NotNil predicate on the "revoked_at" field.
+func RevokedAtNotNil() predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldNotNull(FieldRevokedAt))
+}
+
+// RevokedByEQ applies the EQ predicate on the "revoked_by" field.
+func RevokedByEQ(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldEQ(FieldRevokedBy, v))
+}
+
+// RevokedByNEQ applies the NEQ predicate on the "revoked_by" field.
+func RevokedByNEQ(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldNEQ(FieldRevokedBy, v))
+}
+
+// RevokedByIn applies the In predicate on the "revoked_by" field.
+func RevokedByIn(vs ...string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldIn(FieldRevokedBy, vs...))
+}
+
+// RevokedByNotIn applies the NotIn predicate on the "revoked_by" field.
+func RevokedByNotIn(vs ...string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldNotIn(FieldRevokedBy, vs...))
+}
+
+// RevokedByGT applies the GT predicate on the "revoked_by" field.
+func RevokedByGT(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldGT(FieldRevokedBy, v))
+}
+
+// RevokedByGTE applies the GTE predicate on the "revoked_by" field.
+func RevokedByGTE(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldGTE(FieldRevokedBy, v))
+}
+
+// RevokedByLT applies the LT predicate on the "revoked_by" field.
+func RevokedByLT(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldLT(FieldRevokedBy, v))
+}
+
+// RevokedByLTE applies the LTE predicate on the "revoked_by" field.
+func RevokedByLTE(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldLTE(FieldRevokedBy, v))
+}
+
+// RevokedByContains applies the Contains predicate on the "revoked_by" field.
+func RevokedByContains(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldContains(FieldRevokedBy, v))
+}
+
+// RevokedByHasPrefix applies the HasPrefix predicate on the "revoked_by" field.
+func RevokedByHasPrefix(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldHasPrefix(FieldRevokedBy, v))
+}
+
+// RevokedByHasSuffix applies the HasSuffix predicate on the "revoked_by" field.
+func RevokedByHasSuffix(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldHasSuffix(FieldRevokedBy, v))
+}
+
+// RevokedByIsNil applies the IsNil predicate on the "revoked_by" field.
+func RevokedByIsNil() predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldIsNull(FieldRevokedBy))
+}
+
+// RevokedByNotNil applies the NotNil predicate on the "revoked_by" field.
+func RevokedByNotNil() predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldNotNull(FieldRevokedBy))
+}
+
+// RevokedByEqualFold applies the EqualFold predicate on the "revoked_by" field.
+func RevokedByEqualFold(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldEqualFold(FieldRevokedBy, v))
+}
+
+// RevokedByContainsFold applies the ContainsFold predicate on the "revoked_by" field.
+func RevokedByContainsFold(v string) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.FieldContainsFold(FieldRevokedBy, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VMConsoleSession) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VMConsoleSession) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VMConsoleSession) predicate.VMConsoleSession {
+	return predicate.VMConsoleSession(sql.NotPredicates(p))
+}
@@ -0,0 +1,341 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"kv-shepherd.io/shepherd/ent/vmconsolesession"
+)
+
+// VMConsoleSessionCreate is the builder for creating a VMConsoleSession entity.
+type VMConsoleSessionCreate struct {
+	config
+	mutation *VMConsoleSessionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *VMConsoleSessionCreate) SetCreatedAt(v time.Time) *VMConsoleSessionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *VMConsoleSessionCreate) SetNillableCreatedAt(v *time.Time) *VMConsoleSessionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *VMConsoleSessionCreate) SetUpdatedAt(v time.Time) *VMConsoleSessionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *VMConsoleSessionCreate) SetNillableUpdatedAt(v *time.Time) *VMConsoleSessionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetVMID sets the "vm_id" field.
+func (_c *VMConsoleSessionCreate) SetVMID(v string) *VMConsoleSessionCreate {
+	_c.mutation.SetVMID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *VMConsoleSessionCreate) SetUserID(v string) *VMConsoleSessionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *VMConsoleSessionCreate) SetExpiresAt(v time.Time) *VMConsoleSessionCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetTicketID sets the "ticket_id" field.
+func (_c *VMConsoleSessionCreate) SetTicketID(v string) *VMConsoleSessionCreate {
+	_c.mutation.SetTicketID(v)
+	return _c
+}
+
+// SetNillableTicketID sets the "ticket_id" field if the given value is not nil.
+func (_c *VMConsoleSessionCreate) SetNillableTicketID(v *string) *VMConsoleSessionCreate {
+	if v != nil {
+		_c.SetTicketID(*v)
+	}
+	return _c
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *VMConsoleSessionCreate) SetRevokedAt(v time.Time) *VMConsoleSessionCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *VMConsoleSessionCreate) SetNillableRevokedAt(v *time.Time) *VMConsoleSessionCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (_c *VMConsoleSessionCreate) SetRevokedBy(v string) *VMConsoleSessionCreate {
+	_c.mutation.SetRevokedBy(v)
+	return _c
+}
+
+// SetNillableRevokedBy sets the "revoked_by" field if the given value is not nil.
+func (_c *VMConsoleSessionCreate) SetNillableRevokedBy(v *string) *VMConsoleSessionCreate {
+	if v != nil {
+		_c.SetRevokedBy(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *VMConsoleSessionCreate) SetID(v string) *VMConsoleSessionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the VMConsoleSessionMutation object of the builder.
+func (_c *VMConsoleSessionCreate) Mutation() *VMConsoleSessionMutation {
+	return _c.mutation
+}
+
+// Save creates the VMConsoleSession in the database.
+func (_c *VMConsoleSessionCreate) Save(ctx context.Context) (*VMConsoleSession, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *VMConsoleSessionCreate) SaveX(ctx context.Context) *VMConsoleSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VMConsoleSessionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VMConsoleSessionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *VMConsoleSessionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := vmconsolesession.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := vmconsolesession.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *VMConsoleSessionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "VMConsoleSession.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "VMConsoleSession.updated_at"`)}
+	}
+	if _, ok := _c.mutation.VMID(); !ok {
+		return &ValidationError{Name: "vm_id", err: errors.New(`ent: missing required field "VMConsoleSession.vm_id"`)}
+	}
+	if v, ok := _c.mutation.VMID(); ok {
+		if err := vmconsolesession.VMIDValidator(v); err != nil {
+			return &ValidationError{Name: "vm_id", err: fmt.Errorf(`ent: validator failed for field "VMConsoleSession.vm_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "VMConsoleSession.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := vmconsolesession.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "VMConsoleSession.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "VMConsoleSession.expires_at"`)}
+	}
+	return nil
+}
+
+func (_c *VMConsoleSessionCreate) sqlSave(ctx context.Context) (*VMConsoleSession, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected VMConsoleSession.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *VMConsoleSessionCreate) createSpec() (*VMConsoleSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VMConsoleSession{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(vmconsolesession.Table, sqlgraph.NewFieldSpec(vmconsolesession.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(vmconsolesession.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(vmconsolesession.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.VMID(); ok {
+		_spec.SetField(vmconsolesession.FieldVMID, field.TypeString, value)
+		_node.VMID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(vmconsolesession.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(vmconsolesession.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.TicketID(); ok {
+		_spec.SetField(vmconsolesession.FieldTicketID, field.TypeString, value)
+		_node.TicketID = value
+	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(vmconsolesession.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := _c.mutation.RevokedBy(); ok {
+		_spec.SetField(vmconsolesession.FieldRevokedBy, field.TypeString, value)
+		_node.RevokedBy = value
+	}
+	return _node, _spec
+}
+
+// VMConsoleSessionCreateBulk is the builder for creating many VMConsoleSession entities in bulk.
+type VMConsoleSessionCreateBulk struct {
+	config
+	err      error
+	builders []*VMConsoleSessionCreate
+}
+
+// Save creates the VMConsoleSession entities in the database.
+func (_c *VMConsoleSessionCreateBulk) Save(ctx context.Context) ([]*VMConsoleSession, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*VMConsoleSession, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VMConsoleSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *VMConsoleSessionCreateBulk) SaveX(ctx context.Context) []*VMConsoleSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VMConsoleSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VMConsoleSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"kv-shepherd.io/shepherd/ent/predicate"
+	"kv-shepherd.io/shepherd/ent/vmconsolesession"
+)
+
+// VMConsoleSessionUpdate is the builder for updating VMConsoleSession entities.
+type VMConsoleSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VMConsoleSessionMutation
+}
+
+// Where appends a list predicates to the VMConsoleSessionUpdate builder.
+func (_u *VMConsoleSessionUpdate) Where(ps ...predicate.VMConsoleSession) *VMConsoleSessionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VMConsoleSessionUpdate) SetUpdatedAt(v time.Time) *VMConsoleSessionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *VMConsoleSessionUpdate) SetRevokedAt(v time.Time) *VMConsoleSessionUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *VMConsoleSessionUpdate) SetNillableRevokedAt(v *time.Time) *VMConsoleSessionUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *VMConsoleSessionUpdate) ClearRevokedAt() *VMConsoleSessionUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (_u *VMConsoleSessionUpdate) SetRevokedBy(v string) *VMConsoleSessionUpdate {
+	_u.mutation.SetRevokedBy(v)
+	return _u
+}
+
+// SetNillableRevokedBy sets the "revoked_by" field if the given value is not nil.
+func (_u *VMConsoleSessionUpdate) SetNillableRevokedBy(v *string) *VMConsoleSessionUpdate {
+	if v != nil {
+		_u.SetRevokedBy(*v)
+	}
+	return _u
+}
+
+// ClearRevokedBy clears the value of the "revoked_by" field.
+func (_u *VMConsoleSessionUpdate) ClearRevokedBy() *VMConsoleSessionUpdate {
+	_u.mutation.ClearRevokedBy()
+	return _u
+}
+
+// Mutation returns the VMConsoleSessionMutation object of the builder.
+func (_u *VMConsoleSessionUpdate) Mutation() *VMConsoleSessionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *VMConsoleSessionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VMConsoleSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *VMConsoleSessionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VMConsoleSessionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VMConsoleSessionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := vmconsolesession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *VMConsoleSessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(vmconsolesession.Table, vmconsolesession.Columns, sqlgraph.NewFieldSpec(vmconsolesession.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(vmconsolesession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TicketIDCleared() {
+		_spec.ClearField(vmconsolesession.FieldTicketID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(vmconsolesession.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(vmconsolesession.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedBy(); ok {
+		_spec.SetField(vmconsolesession.FieldRevokedBy, field.TypeString, value)
+	}
+	if _u.mutation.RevokedByCleared() {
+		_spec.ClearField(vmconsolesession.FieldRevokedBy, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vmconsolesession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// VMConsoleSessionUpdateOne is the builder for updating a single VMConsoleSession entity.
+type VMConsoleSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VMConsoleSessionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VMConsoleSessionUpdateOne) SetUpdatedAt(v time.Time) *VMConsoleSessionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *VMConsoleSessionUpdateOne) SetRevokedAt(v time.Time) *VMConsoleSessionUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *VMConsoleSessionUpdateOne) SetNillableRevokedAt(v *time.Time) *VMConsoleSessionUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *VMConsoleSessionUpdateOne) ClearRevokedAt() *VMConsoleSessionUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (_u *VMConsoleSessionUpdateOne) SetRevokedBy(v string) *VMConsoleSessionUpdateOne {
+	_u.mutation.SetRevokedBy(v)
+	return _u
+}
+
+// SetNillableRevokedBy sets the "revoked_by" field if the given value is not nil.
+func (_u *VMConsoleSessionUpdateOne) SetNillableRevokedBy(v *string) *VMConsoleSessionUpdateOne {
+	if v != nil {
+		_u.SetRevokedBy(*v)
+	}
+	return _u
+}
+
+// ClearRevokedBy clears the value of the "revoked_by" field.
+func (_u *VMConsoleSessionUpdateOne) ClearRevokedBy() *VMConsoleSessionUpdateOne {
+	_u.mutation.ClearRevokedBy()
+	return _u
+}
+
+// Mutation returns the VMConsoleSessionMutation object of the builder.
+func (_u *VMConsoleSessionUpdateOne) Mutation() *VMConsoleSessionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the VMConsoleSessionUpdate builder.
+func (_u *VMConsoleSessionUpdateOne) Where(ps ...predicate.VMConsoleSession) *VMConsoleSessionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *VMConsoleSessionUpdateOne) Select(field string, fields ...string) *VMConsoleSessionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated VMConsoleSession entity.
+func (_u *VMConsoleSessionUpdateOne) Save(ctx context.Context) (*VMConsoleSession, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VMConsoleSessionUpdateOne) SaveX(ctx context.Context) *VMConsoleSession {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *VMConsoleSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VMConsoleSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VMConsoleSessionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := vmconsolesession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *VMConsoleSessionUpdateOne) sqlSave(ctx context.Context) (_node *VMConsoleSession, err error) {
+	_spec := sqlgraph.NewUpdateSpec(vmconsolesession.Table, vmconsolesession.Columns, sqlgraph.NewFieldSpec(vmconsolesession.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VMConsoleSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vmconsolesession.FieldID)
+		for _, f := range fields {
+			if !vmconsolesession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != vmconsolesession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(vmconsolesession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TicketIDCleared() {
+		_spec.ClearField(vmconsolesession.FieldTicketID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(vmconsolesession.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(vmconsolesession.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedBy(); ok {
+		_spec.SetField(vmconsolesession.FieldRevokedBy, field.TypeString, value)
+	}
+	if _u.mutation.RevokedByCleared() {
+		_spec.ClearField(vmconsolesession.FieldRevokedBy, field.TypeString)
+	}
+	_node = &VMConsoleSession{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vmconsolesession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,450 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vmrequestidempotency
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"kv-shepherd.io/shepherd/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// RequestID applies equality check predicate on the "request_id" field. It's identical to RequestIDEQ.
+func RequestID(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldEQ(FieldRequestID, v))
+}
+
+// TicketID applies equality check predicate on the "ticket_id" field. It's identical to TicketIDEQ.
+func TicketID(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldEQ(FieldTicketID, v))
+}
+
+// EventID applies equality check predicate on the "event_id" field. It's identical to EventIDEQ.
+func EventID(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldEQ(FieldEventID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// RequestIDEQ applies the EQ predicate on the "request_id" field.
+func RequestIDEQ(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldEQ(FieldRequestID, v))
+}
+
+// RequestIDNEQ applies the NEQ predicate on the "request_id" field.
+func RequestIDNEQ(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldNEQ(FieldRequestID, v))
+}
+
+// RequestIDIn applies the In predicate on the "request_id" field.
+func RequestIDIn(vs ...string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldIn(FieldRequestID, vs...))
+}
+
+// RequestIDNotIn applies the NotIn predicate on the "request_id" field.
+func RequestIDNotIn(vs ...string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldNotIn(FieldRequestID, vs...))
+}
+
+// RequestIDGT applies the GT predicate on the "request_id" field.
+func RequestIDGT(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldGT(FieldRequestID, v))
+}
+
+// RequestIDGTE applies the GTE predicate on the "request_id" field.
+func RequestIDGTE(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldGTE(FieldRequestID, v))
+}
+
+// RequestIDLT applies the LT predicate on the "request_id" field.
+func RequestIDLT(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldLT(FieldRequestID, v))
+}
+
+// RequestIDLTE applies the LTE predicate on the "request_id" field.
+func RequestIDLTE(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldLTE(FieldRequestID, v))
+}
+
+// RequestIDContains applies the Contains predicate on the "request_id" field.
+func RequestIDContains(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldContains(FieldRequestID, v))
+}
+
+// RequestIDHasPrefix applies the HasPrefix predicate on the "request_id" field.
+func RequestIDHasPrefix(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldHasPrefix(FieldRequestID, v))
+}
+
+// RequestIDHasSuffix applies the HasSuffix predicate on the "request_id" field.
+func RequestIDHasSuffix(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldHasSuffix(FieldRequestID, v))
+}
+
+// RequestIDEqualFold applies the EqualFold predicate on the "request_id" field.
+func RequestIDEqualFold(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldEqualFold(FieldRequestID, v))
+}
+
+// RequestIDContainsFold applies the ContainsFold predicate on the "request_id" field.
+func RequestIDContainsFold(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldContainsFold(FieldRequestID, v))
+}
+
+// TicketIDEQ applies the EQ predicate on the "ticket_id" field.
+func TicketIDEQ(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldEQ(FieldTicketID, v))
+}
+
+// TicketIDNEQ applies the NEQ predicate on the "ticket_id" field.
+func TicketIDNEQ(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldNEQ(FieldTicketID, v))
+}
+
+// TicketIDIn applies the In predicate on the "ticket_id" field.
+func TicketIDIn(vs ...string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldIn(FieldTicketID, vs...))
+}
+
+// TicketIDNotIn applies the NotIn predicate on the "ticket_id" field.
+func TicketIDNotIn(vs ...string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldNotIn(FieldTicketID, vs...))
+}
+
+// TicketIDGT applies the GT predicate on the "ticket_id" field.
+func TicketIDGT(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldGT(FieldTicketID, v))
+}
+
+// TicketIDGTE applies the GTE predicate on the "ticket_id" field.
+func TicketIDGTE(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldGTE(FieldTicketID, v))
+}
+
+// TicketIDLT applies the LT predicate on the "ticket_id" field.
+func TicketIDLT(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldLT(FieldTicketID, v))
+}
+
+// TicketIDLTE applies the LTE predicate on the "ticket_id" field.
+func TicketIDLTE(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldLTE(FieldTicketID, v))
+}
+
+// TicketIDContains applies the Contains predicate on the "ticket_id" field.
+func TicketIDContains(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldContains(FieldTicketID, v))
+}
+
+// TicketIDHasPrefix applies the HasPrefix predicate on the "ticket_id" field.
+func TicketIDHasPrefix(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldHasPrefix(FieldTicketID, v))
+}
+
+// TicketIDHasSuffix applies the HasSuffix predicate on the "ticket_id" field.
+func TicketIDHasSuffix(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldHasSuffix(FieldTicketID, v))
+}
+
+// TicketIDEqualFold applies the EqualFold predicate on the "ticket_id" field.
+func TicketIDEqualFold(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldEqualFold(FieldTicketID, v))
+}
+
+// TicketIDContainsFold applies the ContainsFold predicate on the "ticket_id" field.
+func TicketIDContainsFold(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldContainsFold(FieldTicketID, v))
+}
+
+// EventIDEQ applies the EQ predicate on the "event_id" field.
+func EventIDEQ(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldEQ(FieldEventID, v))
+}
+
+// EventIDNEQ applies the NEQ predicate on the "event_id" field.
+func EventIDNEQ(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldNEQ(FieldEventID, v))
+}
+
+// EventIDIn applies the In predicate on the "event_id" field.
+func EventIDIn(vs ...string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldIn(FieldEventID, vs...))
+}
+
+// EventIDNotIn applies the NotIn predicate on the "event_id" field.
+func EventIDNotIn(vs ...string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldNotIn(FieldEventID, vs...))
+}
+
+// EventIDGT applies the GT predicate on the "event_id" field.
+func EventIDGT(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldGT(FieldEventID, v))
+}
+
+// EventIDGTE applies the GTE predicate on the "event_id" field.
+func EventIDGTE(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldGTE(FieldEventID, v))
+}
+
+// EventIDLT applies the LT predicate on the "event_id" field.
+func EventIDLT(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldLT(FieldEventID, v))
+}
+
+// EventIDLTE applies the LTE predicate on the "event_id" field.
+func EventIDLTE(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldLTE(FieldEventID, v))
+}
+
+// EventIDContains applies the Contains predicate on the "event_id" field.
+func EventIDContains(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldContains(FieldEventID, v))
+}
+
+// EventIDHasPrefix applies the HasPrefix predicate on the "event_id" field.
+func EventIDHasPrefix(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldHasPrefix(FieldEventID, v))
+}
+
+// EventIDHasSuffix applies the HasSuffix predicate on the "event_id" field.
+func EventIDHasSuffix(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldHasSuffix(FieldEventID, v))
+}
+
+// EventIDEqualFold applies the EqualFold predicate on the "event_id" field.
+func EventIDEqualFold(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldEqualFold(FieldEventID, v))
+}
+
+// EventIDContainsFold applies the ContainsFold predicate on the "event_id" field.
+func EventIDContainsFold(v string) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.FieldContainsFold(FieldEventID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VMRequestIdempotency) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VMRequestIdempotency) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VMRequestIdempotency) predicate.VMRequestIdempotency {
+	return predicate.VMRequestIdempotency(sql.NotPredicates(p))
+}
@@ -0,0 +1,310 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"kv-shepherd.io/shepherd/ent/vmrequestidempotency"
+)
+
+// VMRequestIdempotencyCreate is the builder for creating a VMRequestIdempotency entity.
+type VMRequestIdempotencyCreate struct {
+	config
+	mutation *VMRequestIdempotencyMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *VMRequestIdempotencyCreate) SetCreatedAt(v time.Time) *VMRequestIdempotencyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *VMRequestIdempotencyCreate) SetNillableCreatedAt(v *time.Time) *VMRequestIdempotencyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *VMRequestIdempotencyCreate) SetUpdatedAt(v time.Time) *VMRequestIdempotencyCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *VMRequestIdempotencyCreate) SetNillableUpdatedAt(v *time.Time) *VMRequestIdempotencyCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *VMRequestIdempotencyCreate) SetCreatedBy(v string) *VMRequestIdempotencyCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetRequestID sets the "request_id" field.
+func (_c *VMRequestIdempotencyCreate) SetRequestID(v string) *VMRequestIdempotencyCreate {
+	_c.mutation.SetRequestID(v)
+	return _c
+}
+
+// SetTicketID sets the "ticket_id" field.
+func (_c *VMRequestIdempotencyCreate) SetTicketID(v string) *VMRequestIdempotencyCreate {
+	_c.mutation.SetTicketID(v)
+	return _c
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *VMRequestIdempotencyCreate) SetEventID(v string) *VMRequestIdempotencyCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *VMRequestIdempotencyCreate) SetID(v string) *VMRequestIdempotencyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the VMRequestIdempotencyMutation object of the builder.
+func (_c *VMRequestIdempotencyCreate) Mutation() *VMRequestIdempotencyMutation {
+	return _c.mutation
+}
+
+// Save creates the VMRequestIdempotency in the database.
+func (_c *VMRequestIdempotencyCreate) Save(ctx context.Context) (*VMRequestIdempotency, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *VMRequestIdempotencyCreate) SaveX(ctx context.Context) *VMRequestIdempotency {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VMRequestIdempotencyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VMRequestIdempotencyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *VMRequestIdempotencyCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := vmrequestidempotency.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := vmrequestidempotency.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *VMRequestIdempotencyCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "VMRequestIdempotency.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "VMRequestIdempotency.updated_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "VMRequestIdempotency.created_by"`)}
+	}
+	if v, ok := _c.mutation.CreatedBy(); ok {
+		if err := vmrequestidempotency.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "VMRequestIdempotency.created_by": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequestID(); !ok {
+		return &ValidationError{Name: "request_id", err: errors.New(`ent: missing required field "VMRequestIdempotency.request_id"`)}
+	}
+	if v, ok := _c.mutation.RequestID(); ok {
+		if err := vmrequestidempotency.RequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "request_id", err: fmt.Errorf(`ent: validator failed for field "VMRequestIdempotency.request_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TicketID(); !ok {
+		return &ValidationError{Name: "ticket_id", err: errors.New(`ent: missing required field "VMRequestIdempotency.ticket_id"`)}
+	}
+	if v, ok := _c.mutation.TicketID(); ok {
+		if err := vmrequestidempotency.TicketIDValidator(v); err != nil {
+			return &ValidationError{Name: "ticket_id", err: fmt.Errorf(`ent: validator failed for field "VMRequestIdempotency.ticket_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "VMRequestIdempotency.event_id"`)}
+	}
+	if v, ok := _c.mutation.EventID(); ok {
+		if err := vmrequestidempotency.EventIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_id", err: fmt.Errorf(`ent: validator failed for field "VMRequestIdempotency.event_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *VMRequestIdempotencyCreate) sqlSave(ctx context.Context) (*VMRequestIdempotency, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected VMRequestIdempotency.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *VMRequestIdempotencyCreate) createSpec() (*VMRequestIdempotency, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VMRequestIdempotency{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(vmrequestidempotency.Table, sqlgraph.NewFieldSpec(vmrequestidempotency.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(vmrequestidempotency.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(vmrequestidempotency.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(vmrequestidempotency.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.RequestID(); ok {
+		_spec.SetField(vmrequestidempotency.FieldRequestID, field.TypeString, value)
+		_node.RequestID = value
+	}
+	if value, ok := _c.mutation.TicketID(); ok {
+		_spec.SetField(vmrequestidempotency.FieldTicketID, field.TypeString, value)
+		_node.TicketID = value
+	}
+	if value, ok := _c.mutation.EventID(); ok {
+		_spec.SetField(vmrequestidempotency.FieldEventID, field.TypeString, value)
+		_node.EventID = value
+	}
+	return _node, _spec
+}
+
+// VMRequestIdempotencyCreateBulk is the builder for creating many VMRequestIdempotency entities in bulk.
+type VMRequestIdempotencyCreateBulk struct {
+	config
+	err      error
+	builders []*VMRequestIdempotencyCreate
+}
+
+// Save creates the VMRequestIdempotency entities in the database.
+func (_c *VMRequestIdempotencyCreateBulk) Save(ctx context.Context) ([]*VMRequestIdempotency, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*VMRequestIdempotency, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VMRequestIdempotencyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *VMRequestIdempotencyCreateBulk) SaveX(ctx context.Context) []*VMRequestIdempotency {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VMRequestIdempotencyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VMRequestIdempotencyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"kv-shepherd.io/shepherd/ent/predicate"
+	"kv-shepherd.io/shepherd/ent/vmrequestidempotency"
+)
+
+// VMRequestIdempotencyDelete is the builder for deleting a VMRequestIdempotency entity.
+type VMRequestIdempotencyDelete struct {
+	config
+	hooks    []Hook
+	mutation *VMRequestIdempotencyMutation
+}
+
+// Where appends a list predicates to the VMRequestIdempotencyDelete builder.
+func (_d *VMRequestIdempotencyDelete) Where(ps ...predicate.VMRequestIdempotency) *VMRequestIdempotencyDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *VMRequestIdempotencyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *VMRequestIdempotencyDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *VMRequestIdempotencyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(vmrequestidempotency.Table, sqlgraph.NewFieldSpec(vmrequestidempotency.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// VMRequestIdempotencyDeleteOne is the builder for deleting a single VMRequestIdempotency entity.
+type VMRequestIdempotencyDeleteOne struct {
+	_d *VMRequestIdempotencyDelete
+}
+
+// Where appends a list predicates to the VMRequestIdempotencyDelete builder.
+func (_d *VMRequestIdempotencyDeleteOne) Where(ps ...predicate.VMRequestIdempotency) *VMRequestIdempotencyDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *VMRequestIdempotencyDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{vmrequestidempotency.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *VMRequestIdempotencyDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
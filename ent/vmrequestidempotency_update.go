@@ -0,0 +1,316 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"kv-shepherd.io/shepherd/ent/predicate"
+	"kv-shepherd.io/shepherd/ent/vmrequestidempotency"
+)
+
+// VMRequestIdempotencyUpdate is the builder for updating VMRequestIdempotency entities.
+type VMRequestIdempotencyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VMRequestIdempotencyMutation
+}
+
+// Where appends a list predicates to the VMRequestIdempotencyUpdate builder.
+func (_u *VMRequestIdempotencyUpdate) Where(ps ...predicate.VMRequestIdempotency) *VMRequestIdempotencyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VMRequestIdempotencyUpdate) SetUpdatedAt(v time.Time) *VMRequestIdempotencyUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTicketID sets the "ticket_id" field.
+func (_u *VMRequestIdempotencyUpdate) SetTicketID(v string) *VMRequestIdempotencyUpdate {
+	_u.mutation.SetTicketID(v)
+	return _u
+}
+
+// SetNillableTicketID sets the "ticket_id" field if the given value is not nil.
+func (_u *VMRequestIdempotencyUpdate) SetNillableTicketID(v *string) *VMRequestIdempotencyUpdate {
+	if v != nil {
+		_u.SetTicketID(*v)
+	}
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *VMRequestIdempotencyUpdate) SetEventID(v string) *VMRequestIdempotencyUpdate {
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *VMRequestIdempotencyUpdate) SetNillableEventID(v *string) *VMRequestIdempotencyUpdate {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the VMRequestIdempotencyMutation object of the builder.
+func (_u *VMRequestIdempotencyUpdate) Mutation() *VMRequestIdempotencyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *VMRequestIdempotencyUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VMRequestIdempotencyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *VMRequestIdempotencyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VMRequestIdempotencyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VMRequestIdempotencyUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := vmrequestidempotency.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VMRequestIdempotencyUpdate) check() error {
+	if v, ok := _u.mutation.TicketID(); ok {
+		if err := vmrequestidempotency.TicketIDValidator(v); err != nil {
+			return &ValidationError{Name: "ticket_id", err: fmt.Errorf(`ent: validator failed for field "VMRequestIdempotency.ticket_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventID(); ok {
+		if err := vmrequestidempotency.EventIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_id", err: fmt.Errorf(`ent: validator failed for field "VMRequestIdempotency.event_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *VMRequestIdempotencyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vmrequestidempotency.Table, vmrequestidempotency.Columns, sqlgraph.NewFieldSpec(vmrequestidempotency.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(vmrequestidempotency.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.TicketID(); ok {
+		_spec.SetField(vmrequestidempotency.FieldTicketID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(vmrequestidempotency.FieldEventID, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vmrequestidempotency.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// VMRequestIdempotencyUpdateOne is the builder for updating a single VMRequestIdempotency entity.
+type VMRequestIdempotencyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VMRequestIdempotencyMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VMRequestIdempotencyUpdateOne) SetUpdatedAt(v time.Time) *VMRequestIdempotencyUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTicketID sets the "ticket_id" field.
+func (_u *VMRequestIdempotencyUpdateOne) SetTicketID(v string) *VMRequestIdempotencyUpdateOne {
+	_u.mutation.SetTicketID(v)
+	return _u
+}
+
+// SetNillableTicketID sets the "ticket_id" field if the given value is not nil.
+func (_u *VMRequestIdempotencyUpdateOne) SetNillableTicketID(v *string) *VMRequestIdempotencyUpdateOne {
+	if v != nil {
+		_u.SetTicketID(*v)
+	}
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *VMRequestIdempotencyUpdateOne) SetEventID(v string) *VMRequestIdempotencyUpdateOne {
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *VMRequestIdempotencyUpdateOne) SetNillableEventID(v *string) *VMRequestIdempotencyUpdateOne {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the VMRequestIdempotencyMutation object of the builder.
+func (_u *VMRequestIdempotencyUpdateOne) Mutation() *VMRequestIdempotencyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the VMRequestIdempotencyUpdate builder.
+func (_u *VMRequestIdempotencyUpdateOne) Where(ps ...predicate.VMRequestIdempotency) *VMRequestIdempotencyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *VMRequestIdempotencyUpdateOne) Select(field string, fields ...string) *VMRequestIdempotencyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated VMRequestIdempotency entity.
+func (_u *VMRequestIdempotencyUpdateOne) Save(ctx context.Context) (*VMRequestIdempotency, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VMRequestIdempotencyUpdateOne) SaveX(ctx context.Context) *VMRequestIdempotency {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *VMRequestIdempotencyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VMRequestIdempotencyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VMRequestIdempotencyUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := vmrequestidempotency.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VMRequestIdempotencyUpdateOne) check() error {
+	if v, ok := _u.mutation.TicketID(); ok {
+		if err := vmrequestidempotency.TicketIDValidator(v); err != nil {
+			return &ValidationError{Name: "ticket_id", err: fmt.Errorf(`ent: validator failed for field "VMRequestIdempotency.ticket_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventID(); ok {
+		if err := vmrequestidempotency.EventIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_id", err: fmt.Errorf(`ent: validator failed for field "VMRequestIdempotency.event_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *VMRequestIdempotencyUpdateOne) sqlSave(ctx context.Context) (_node *VMRequestIdempotency, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vmrequestidempotency.Table, vmrequestidempotency.Columns, sqlgraph.NewFieldSpec(vmrequestidempotency.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VMRequestIdempotency.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vmrequestidempotency.FieldID)
+		for _, f := range fields {
+			if !vmrequestidempotency.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != vmrequestidempotency.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(vmrequestidempotency.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.TicketID(); ok {
+		_spec.SetField(vmrequestidempotency.FieldTicketID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(vmrequestidempotency.FieldEventID, field.TypeString, value)
+	}
+	_node = &VMRequestIdempotency{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vmrequestidempotency.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -26,9 +26,13 @@ const (
 
 // Defines values for ApprovalTicketOperationType.
 const (
-	ApprovalTicketOperationTypeCREATE    ApprovalTicketOperationType = "CREATE"
-	ApprovalTicketOperationTypeDELETE    ApprovalTicketOperationType = "DELETE"
-	ApprovalTicketOperationTypeVNCACCESS ApprovalTicketOperationType = "VNC_ACCESS"
+	ApprovalTicketOperationTypeCREATE           ApprovalTicketOperationType = "CREATE"
+	ApprovalTicketOperationTypeDELETE           ApprovalTicketOperationType = "DELETE"
+	ApprovalTicketOperationTypeMIGRATE          ApprovalTicketOperationType = "MIGRATE"
+	ApprovalTicketOperationTypeNAMESPACEMIGRATE ApprovalTicketOperationType = "NAMESPACE_MIGRATE"
+	ApprovalTicketOperationTypeRESIZE           ApprovalTicketOperationType = "RESIZE"
+	ApprovalTicketOperationTypeSNAPSHOT         ApprovalTicketOperationType = "SNAPSHOT"
+	ApprovalTicketOperationTypeVNCACCESS        ApprovalTicketOperationType = "VNC_ACCESS"
 )
 
 // Defines values for ApprovalTicketStatus.
@@ -36,6 +40,7 @@ const (
 	ApprovalTicketStatusAPPROVED  ApprovalTicketStatus = "APPROVED"
 	ApprovalTicketStatusCANCELLED ApprovalTicketStatus = "CANCELLED"
 	ApprovalTicketStatusEXECUTING ApprovalTicketStatus = "EXECUTING"
+	ApprovalTicketStatusEXPIRED   ApprovalTicketStatus = "EXPIRED"
 	ApprovalTicketStatusFAILED    ApprovalTicketStatus = "FAILED"
 	ApprovalTicketStatusPENDING   ApprovalTicketStatus = "PENDING"
 	ApprovalTicketStatusREJECTED  ApprovalTicketStatus = "REJECTED"
@@ -44,17 +49,19 @@ const (
 
 // Defines values for ApprovalTicketResponseStatus.
 const (
-	ApprovalTicketResponseStatusPENDING ApprovalTicketResponseStatus = "PENDING"
+	ApprovalTicketResponseStatusAPPROVED  ApprovalTicketResponseStatus = "APPROVED"
+	ApprovalTicketResponseStatusEXECUTING ApprovalTicketResponseStatus = "EXECUTING"
+	ApprovalTicketResponseStatusPENDING   ApprovalTicketResponseStatus = "PENDING"
 )
 
 // Defines values for AuthProviderSampleFieldValueType.
 const (
-	Array   AuthProviderSampleFieldValueType = "array"
-	Boolean AuthProviderSampleFieldValueType = "boolean"
-	Number  AuthProviderSampleFieldValueType = "number"
-	Object  AuthProviderSampleFieldValueType = "object"
-	String  AuthProviderSampleFieldValueType = "string"
-	Unknown AuthProviderSampleFieldValueType = "unknown"
+	AuthProviderSampleFieldValueTypeArray   AuthProviderSampleFieldValueType = "array"
+	AuthProviderSampleFieldValueTypeBoolean AuthProviderSampleFieldValueType = "boolean"
+	AuthProviderSampleFieldValueTypeNumber  AuthProviderSampleFieldValueType = "number"
+	AuthProviderSampleFieldValueTypeObject  AuthProviderSampleFieldValueType = "object"
+	AuthProviderSampleFieldValueTypeString  AuthProviderSampleFieldValueType = "string"
+	AuthProviderSampleFieldValueTypeUnknown AuthProviderSampleFieldValueType = "unknown"
 )
 
 // Defines values for ClusterEnvironment.
@@ -83,9 +90,18 @@ const (
 	ClusterEnvironmentUpdateEnvironmentTest ClusterEnvironmentUpdateEnvironment = "test"
 )
 
+// Defines values for ComponentHealthStatus.
+const (
+	ComponentHealthStatusDegraded ComponentHealthStatus = "degraded"
+	ComponentHealthStatusDown     ComponentHealthStatus = "down"
+	ComponentHealthStatusOk       ComponentHealthStatus = "ok"
+)
+
 // Defines values for DeleteVMResponseStatus.
 const (
-	DeleteVMResponseStatusPENDING DeleteVMResponseStatus = "PENDING"
+	DeleteVMResponseStatusAPPROVED  DeleteVMResponseStatus = "APPROVED"
+	DeleteVMResponseStatusEXECUTING DeleteVMResponseStatus = "EXECUTING"
+	DeleteVMResponseStatusPENDING   DeleteVMResponseStatus = "PENDING"
 )
 
 // Defines values for GlobalRoleBindingAllowedEnvironments.
@@ -125,32 +141,117 @@ const (
 	IdPGroupMappingUpdateRequestAllowedEnvironmentsTest IdPGroupMappingUpdateRequestAllowedEnvironments = "test"
 )
 
+// Defines values for MigrateVMResponseStatus.
+const (
+	MigrateVMResponseStatusPENDING MigrateVMResponseStatus = "PENDING"
+)
+
 // Defines values for NamespaceCreateRequestEnvironment.
 const (
 	NamespaceCreateRequestEnvironmentProd NamespaceCreateRequestEnvironment = "prod"
 	NamespaceCreateRequestEnvironmentTest NamespaceCreateRequestEnvironment = "test"
 )
 
+// Defines values for NamespaceMigrateEnvironmentRequestTargetEnvironment.
+const (
+	NamespaceMigrateEnvironmentRequestTargetEnvironmentProd NamespaceMigrateEnvironmentRequestTargetEnvironment = "prod"
+	NamespaceMigrateEnvironmentRequestTargetEnvironmentTest NamespaceMigrateEnvironmentRequestTargetEnvironment = "test"
+)
+
 // Defines values for NamespaceRegistryEnvironment.
 const (
 	NamespaceRegistryEnvironmentProd NamespaceRegistryEnvironment = "prod"
 	NamespaceRegistryEnvironmentTest NamespaceRegistryEnvironment = "test"
 )
 
+// Defines values for NamespaceSyncResultEnvironment.
+const (
+	NamespaceSyncResultEnvironmentProd NamespaceSyncResultEnvironment = "prod"
+	NamespaceSyncResultEnvironmentTest NamespaceSyncResultEnvironment = "test"
+)
+
 // Defines values for NotificationType.
 const (
-	APPROVALCOMPLETED NotificationType = "APPROVAL_COMPLETED"
-	APPROVALPENDING   NotificationType = "APPROVAL_PENDING"
-	APPROVALREJECTED  NotificationType = "APPROVAL_REJECTED"
-	VMSTATUSCHANGE    NotificationType = "VM_STATUS_CHANGE"
+	APPROVALCOMMENT     NotificationType = "APPROVAL_COMMENT"
+	APPROVALCOMPLETED   NotificationType = "APPROVAL_COMPLETED"
+	APPROVALPENDING     NotificationType = "APPROVAL_PENDING"
+	APPROVALREJECTED    NotificationType = "APPROVAL_REJECTED"
+	CLUSTERSTATUSCHANGE NotificationType = "CLUSTER_STATUS_CHANGE"
+	VMSTATUSCHANGE      NotificationType = "VM_STATUS_CHANGE"
+)
+
+// Defines values for PasswordPolicyMode.
+const (
+	Legacy PasswordPolicyMode = "legacy"
+	Nist   PasswordPolicyMode = "nist"
+)
+
+// Defines values for QuotaScopeType.
+const (
+	QuotaScopeTypeNamespace QuotaScopeType = "namespace"
+	QuotaScopeTypeSystem    QuotaScopeType = "system"
+)
+
+// Defines values for QuotaWarningResource.
+const (
+	CpuCores QuotaWarningResource = "cpu_cores"
+	MemoryMb QuotaWarningResource = "memory_mb"
+	Vms      QuotaWarningResource = "vms"
+)
+
+// Defines values for ResizeVMResponseStatus.
+const (
+	ResizeVMResponseStatusPENDING ResizeVMResponseStatus = "PENDING"
+)
+
+// Defines values for RoleBindingCreateRequestAllowedEnvironments.
+const (
+	RoleBindingCreateRequestAllowedEnvironmentsProd RoleBindingCreateRequestAllowedEnvironments = "prod"
+	RoleBindingCreateRequestAllowedEnvironmentsTest RoleBindingCreateRequestAllowedEnvironments = "test"
+)
+
+// Defines values for RoleBindingScopeType.
+const (
+	RoleBindingScopeTypeGlobal    RoleBindingScopeType = "global"
+	RoleBindingScopeTypeNamespace RoleBindingScopeType = "namespace"
+	RoleBindingScopeTypeService   RoleBindingScopeType = "service"
+	RoleBindingScopeTypeSystem    RoleBindingScopeType = "system"
+)
+
+// Defines values for ServiceRoleBindingRole.
+const (
+	ServiceRoleBindingRoleAdmin      ServiceRoleBindingRole = "admin"
+	ServiceRoleBindingRoleMaintainer ServiceRoleBindingRole = "maintainer"
+	ServiceRoleBindingRoleMember     ServiceRoleBindingRole = "member"
+	ServiceRoleBindingRoleOwner      ServiceRoleBindingRole = "owner"
+	ServiceRoleBindingRoleViewer     ServiceRoleBindingRole = "viewer"
+)
+
+// Defines values for ServiceRoleBindingCreateRequestRole.
+const (
+	ServiceRoleBindingCreateRequestRoleAdmin  ServiceRoleBindingCreateRequestRole = "admin"
+	ServiceRoleBindingCreateRequestRoleMember ServiceRoleBindingCreateRequestRole = "member"
+	ServiceRoleBindingCreateRequestRoleOwner  ServiceRoleBindingCreateRequestRole = "owner"
+	ServiceRoleBindingCreateRequestRoleViewer ServiceRoleBindingCreateRequestRole = "viewer"
+)
+
+// Defines values for SpecOverridePathType.
+const (
+	SpecOverridePathTypeArray   SpecOverridePathType = "array"
+	SpecOverridePathTypeBoolean SpecOverridePathType = "boolean"
+	SpecOverridePathTypeInteger SpecOverridePathType = "integer"
+	SpecOverridePathTypeNumber  SpecOverridePathType = "number"
+	SpecOverridePathTypeObject  SpecOverridePathType = "object"
+	SpecOverridePathTypeString  SpecOverridePathType = "string"
 )
 
 // Defines values for SystemMemberRole.
 const (
-	SystemMemberRoleAdmin  SystemMemberRole = "admin"
-	SystemMemberRoleMember SystemMemberRole = "member"
-	SystemMemberRoleOwner  SystemMemberRole = "owner"
-	SystemMemberRoleViewer SystemMemberRole = "viewer"
+	SystemMemberRoleAdmin      SystemMemberRole = "admin"
+	SystemMemberRoleMaintainer SystemMemberRole = "maintainer"
+	SystemMemberRoleMember     SystemMemberRole = "member"
+	SystemMemberRoleOwner      SystemMemberRole = "owner"
+	SystemMemberRoleViewer     SystemMemberRole = "viewer"
 )
 
 // Defines values for SystemMemberCreateRequestRole.
@@ -169,20 +270,6 @@ const (
 	Viewer SystemMemberRoleUpdateRequestRole = "viewer"
 )
 
-// Defines values for VMStatus.
-const (
-	VMStatusCREATING  VMStatus = "CREATING"
-	VMStatusDELETING  VMStatus = "DELETING"
-	VMStatusFAILED    VMStatus = "FAILED"
-	VMStatusMIGRATING VMStatus = "MIGRATING"
-	VMStatusPAUSED    VMStatus = "PAUSED"
-	VMStatusPENDING   VMStatus = "PENDING"
-	VMStatusRUNNING   VMStatus = "RUNNING"
-	VMStatusSTOPPED   VMStatus = "STOPPED"
-	VMStatusSTOPPING  VMStatus = "STOPPING"
-	VMStatusUNKNOWN   VMStatus = "UNKNOWN"
-)
-
 // Defines values for VMBatchChildStatusStatus.
 const (
 	VMBatchChildStatusStatusAPPROVED  VMBatchChildStatusStatus = "APPROVED"
@@ -194,6 +281,15 @@ const (
 	VMBatchChildStatusStatusSUCCESS   VMBatchChildStatusStatus = "SUCCESS"
 )
 
+// Defines values for VMBatchLimitsBlockedReason.
+const (
+	GlobalPendingLimit     VMBatchLimitsBlockedReason = "global_pending_limit"
+	GlobalRequestRateLimit VMBatchLimitsBlockedReason = "global_request_rate_limit"
+	UserPendingChildLimit  VMBatchLimitsBlockedReason = "user_pending_child_limit"
+	UserPendingParentLimit VMBatchLimitsBlockedReason = "user_pending_parent_limit"
+	UserSubmitCooldown     VMBatchLimitsBlockedReason = "user_submit_cooldown"
+)
+
 // Defines values for VMBatchOperation.
 const (
 	VMBatchOperationCREATE VMBatchOperation = "CREATE"
@@ -207,6 +303,7 @@ const (
 	VMBatchParentStatusCOMPLETED       VMBatchParentStatus = "COMPLETED"
 	VMBatchParentStatusFAILED          VMBatchParentStatus = "FAILED"
 	VMBatchParentStatusINPROGRESS      VMBatchParentStatus = "IN_PROGRESS"
+	VMBatchParentStatusPARTIALAPPROVED VMBatchParentStatus = "PARTIAL_APPROVED"
 	VMBatchParentStatusPARTIALSUCCESS  VMBatchParentStatus = "PARTIAL_SUCCESS"
 	VMBatchParentStatusPENDINGAPPROVAL VMBatchParentStatus = "PENDING_APPROVAL"
 )
@@ -218,6 +315,20 @@ const (
 	STOP    VMBatchPowerAction = "STOP"
 )
 
+// Defines values for VMBatchSkippedTicketReason.
+const (
+	INVALIDSTATE VMBatchSkippedTicketReason = "INVALID_STATE"
+	NOTINBATCH   VMBatchSkippedTicketReason = "NOT_IN_BATCH"
+)
+
+// Defines values for VMBatchType.
+const (
+	BATCHAPPROVE VMBatchType = "BATCH_APPROVE"
+	BATCHCREATE  VMBatchType = "BATCH_CREATE"
+	BATCHDELETE  VMBatchType = "BATCH_DELETE"
+	BATCHPOWER   VMBatchType = "BATCH_POWER"
+)
+
 // Defines values for VMConsoleRequestStatus.
 const (
 	VMConsoleRequestStatusAPPROVED        VMConsoleRequestStatus = "APPROVED"
@@ -233,11 +344,69 @@ const (
 	VMConsoleStatusREJECTED        VMConsoleStatus = "REJECTED"
 )
 
+// Defines values for VMListFiltersSortOrder.
+const (
+	VMListFiltersSortOrderAsc  VMListFiltersSortOrder = "asc"
+	VMListFiltersSortOrderDesc VMListFiltersSortOrder = "desc"
+)
+
+// Defines values for VMListOrderBy.
+const (
+	VMListOrderByCreatedAt VMListOrderBy = "created_at"
+	VMListOrderByName      VMListOrderBy = "name"
+	VMListOrderByStatus    VMListOrderBy = "status"
+)
+
+// Defines values for VMSnapshotOperationResponseStatus.
+const (
+	ACCEPTED VMSnapshotOperationResponseStatus = "ACCEPTED"
+	PENDING  VMSnapshotOperationResponseStatus = "PENDING"
+)
+
+// Defines values for VMSpecFieldSourceSource.
+const (
+	VMSpecFieldSourceSourceInstanceSize VMSpecFieldSourceSource = "instance_size"
+	VMSpecFieldSourceSourceOverride     VMSpecFieldSourceSource = "override"
+	VMSpecFieldSourceSourceRequest      VMSpecFieldSourceSource = "request"
+	VMSpecFieldSourceSourceTemplate     VMSpecFieldSourceSource = "template"
+)
+
+// Defines values for VMStatus.
+const (
+	VMStatusCREATING  VMStatus = "CREATING"
+	VMStatusDELETING  VMStatus = "DELETING"
+	VMStatusFAILED    VMStatus = "FAILED"
+	VMStatusMIGRATING VMStatus = "MIGRATING"
+	VMStatusPAUSED    VMStatus = "PAUSED"
+	VMStatusPENDING   VMStatus = "PENDING"
+	VMStatusRESIZING  VMStatus = "RESIZING"
+	VMStatusRUNNING   VMStatus = "RUNNING"
+	VMStatusSTOPPED   VMStatus = "STOPPED"
+	VMStatusSTOPPING  VMStatus = "STOPPING"
+	VMStatusUNKNOWN   VMStatus = "UNKNOWN"
+)
+
 // Defines values for VMVNCSessionResponseStatus.
 const (
 	SESSIONREADY VMVNCSessionResponseStatus = "SESSION_READY"
 )
 
+// Defines values for WebhookEventType.
+const (
+	TicketApproved WebhookEventType = "ticket.approved"
+	TicketCreated  WebhookEventType = "ticket.created"
+	TicketRejected WebhookEventType = "ticket.rejected"
+	VmCreateFailed WebhookEventType = "vm.create_failed"
+	VmCreated      WebhookEventType = "vm.created"
+	VmDeleteFailed WebhookEventType = "vm.delete_failed"
+	VmDeleted      WebhookEventType = "vm.deleted"
+)
+
+// Defines values for DeleteCascade.
+const (
+	DeleteCascadeRequests DeleteCascade = "requests"
+)
+
 // Defines values for SortOrder.
 const (
 	SortOrderAsc  SortOrder = "asc"
@@ -246,8 +415,8 @@ const (
 
 // Defines values for ListNamespacesParamsEnvironment.
 const (
-	Prod ListNamespacesParamsEnvironment = "prod"
-	Test ListNamespacesParamsEnvironment = "test"
+	ListNamespacesParamsEnvironmentProd ListNamespacesParamsEnvironment = "prod"
+	ListNamespacesParamsEnvironmentTest ListNamespacesParamsEnvironment = "test"
 )
 
 // Defines values for ListApprovalsParamsStatus.
@@ -255,18 +424,58 @@ const (
 	ListApprovalsParamsStatusAPPROVED  ListApprovalsParamsStatus = "APPROVED"
 	ListApprovalsParamsStatusCANCELLED ListApprovalsParamsStatus = "CANCELLED"
 	ListApprovalsParamsStatusEXECUTING ListApprovalsParamsStatus = "EXECUTING"
+	ListApprovalsParamsStatusEXPIRED   ListApprovalsParamsStatus = "EXPIRED"
 	ListApprovalsParamsStatusFAILED    ListApprovalsParamsStatus = "FAILED"
 	ListApprovalsParamsStatusPENDING   ListApprovalsParamsStatus = "PENDING"
 	ListApprovalsParamsStatusREJECTED  ListApprovalsParamsStatus = "REJECTED"
 	ListApprovalsParamsStatusSUCCESS   ListApprovalsParamsStatus = "SUCCESS"
 )
 
+// Defines values for ListApprovalsParamsOperationType.
+const (
+	CREATE           ListApprovalsParamsOperationType = "CREATE"
+	DELETE           ListApprovalsParamsOperationType = "DELETE"
+	MIGRATE          ListApprovalsParamsOperationType = "MIGRATE"
+	NAMESPACEMIGRATE ListApprovalsParamsOperationType = "NAMESPACE_MIGRATE"
+	RESIZE           ListApprovalsParamsOperationType = "RESIZE"
+	SNAPSHOT         ListApprovalsParamsOperationType = "SNAPSHOT"
+	VNCACCESS        ListApprovalsParamsOperationType = "VNC_ACCESS"
+)
+
+// Defines values for ListApprovalsParamsSortBy.
+const (
+	ListApprovalsParamsSortByCreatedAt ListApprovalsParamsSortBy = "created_at"
+	ListApprovalsParamsSortByPriority  ListApprovalsParamsSortBy = "priority"
+)
+
+// Defines values for ListApprovalsParamsSortOrder.
+const (
+	ListApprovalsParamsSortOrderAsc  ListApprovalsParamsSortOrder = "asc"
+	ListApprovalsParamsSortOrderDesc ListApprovalsParamsSortOrder = "desc"
+)
+
+// Defines values for ExportAuditLogsParamsFormat.
+const (
+	Csv    ExportAuditLogsParamsFormat = "csv"
+	Ndjson ExportAuditLogsParamsFormat = "ndjson"
+)
+
 // Defines values for ListSystemsParamsSortOrder.
 const (
 	ListSystemsParamsSortOrderAsc  ListSystemsParamsSortOrder = "asc"
 	ListSystemsParamsSortOrderDesc ListSystemsParamsSortOrder = "desc"
 )
 
+// Defines values for DeleteSystemParamsCascade.
+const (
+	DeleteSystemParamsCascadeRequests DeleteSystemParamsCascade = "requests"
+)
+
+// Defines values for DeleteServiceParamsCascade.
+const (
+	Requests DeleteServiceParamsCascade = "requests"
+)
+
 // Defines values for ListVMsParamsSortOrder.
 const (
 	Asc  ListVMsParamsSortOrder = "asc"
@@ -275,55 +484,170 @@ const (
 
 // ApprovalDecisionRequest defines model for ApprovalDecisionRequest.
 type ApprovalDecisionRequest struct {
+	// Children Batch parents only. Per-child cluster/storage selection keyed by
+	// child ticket ID. Children without an entry use
+	// selected_cluster_id/selected_storage_class.
+	Children map[string]BatchChildSelection `json:"children,omitempty,omitzero"`
+
+	// Comment Optional approver note shown to the requester
 	Comment string `json:"comment,omitempty,omitzero"`
 
+	// Resize RESIZE tickets only. Size to apply instead of the requested one:
+	// either instance_size_id from the catalog or explicit
+	// cpu/memory_mb/disk_gb, where omitted values keep the requested size.
+	Resize ResizeSelection `json:"resize,omitempty,omitzero"`
+
 	// SelectedClusterId Admin selects target cluster (ADR-0017)
 	SelectedClusterId    string `json:"selected_cluster_id,omitempty,omitzero"`
 	SelectedStorageClass string `json:"selected_storage_class,omitempty,omitzero"`
 }
 
+// ApprovalPreview defines model for ApprovalPreview.
+type ApprovalPreview struct {
+	// ClusterId Selected cluster; empty until an approver selects one
+	ClusterId string `json:"cluster_id,omitempty,omitzero"`
+
+	// FieldSources Spec fields sorted by name, with where each value came from
+	FieldSources []VMSpecFieldSource `json:"field_sources"`
+
+	// Manifest The rendered kubevirt.io/v1 VirtualMachine
+	Manifest map[string]interface{} `json:"manifest"`
+
+	// ManifestYaml manifest as YAML
+	ManifestYaml string `json:"manifest_yaml"`
+	Namespace    string `json:"namespace"`
+	TicketId     string `json:"ticket_id"`
+}
+
 // ApprovalTicket defines model for ApprovalTicket.
 type ApprovalTicket struct {
-	Approver  string    `json:"approver,omitempty,omitzero"`
-	CreatedAt time.Time `json:"created_at,omitempty,omitzero"`
-	EventId   string    `json:"event_id"`
-	Id        string    `json:"id"`
+	// ApprovalComment Approver's note attached to the approval
+	ApprovalComment string `json:"approval_comment,omitempty,omitzero"`
+
+	// ApprovalsReceived Distinct approvals recorded so far (multi-level chains, ADR-0005 V2)
+	ApprovalsReceived int `json:"approvals_received,omitempty,omitzero"`
+
+	// ApprovalsRequired Distinct approvals needed before the ticket is dispatched
+	ApprovalsRequired int    `json:"approvals_required,omitempty,omitzero"`
+	Approver          string `json:"approver,omitempty,omitzero"`
+
+	// AssignedApprover Approver the ticket was delegated to, if reassigned
+	AssignedApprover string `json:"assigned_approver,omitempty,omitzero"`
+
+	// Comments Review comments, oldest first; only set by GET /approvals/{ticket_id}
+	Comments  []TicketComment `json:"comments,omitempty,omitzero"`
+	CreatedAt time.Time       `json:"created_at,omitempty,omitzero"`
+	EventId   string          `json:"event_id"`
+
+	// ExpiresAt For approved VNC_ACCESS tickets, when console access ends
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Id        string     `json:"id"`
+
+	// InstanceSize Instance size of a CREATE ticket, with the approver's cpu, memory_mb
+	// and disk_gb overrides applied. Taken from the snapshot stored at
+	// approval when present, otherwise from the live catalog.
+	InstanceSize ApprovalTicketInstanceSize `json:"instance_size,omitempty,omitzero"`
+
+	// ModifiedSpec For CREATE tickets, the approver's modifications applied on top of the request
+	ModifiedSpec map[string]interface{} `json:"modified_spec,omitempty,omitzero"`
 
 	// OperationType Type of operation this ticket represents (ADR-0015)
 	OperationType ApprovalTicketOperationType `json:"operation_type,omitempty,omitzero"`
 	Reason        string                      `json:"reason,omitempty,omitzero"`
 	RejectReason  string                      `json:"reject_reason,omitempty,omitzero"`
 	Requester     string                      `json:"requester"`
-	Status        ApprovalTicketStatus        `json:"status"`
+	Resize        VMResizeSummary             `json:"resize,omitempty,omitzero"`
 
-	// TargetVmId For DELETE tickets, the VM being deleted
+	// Status EXPIRED marks an approved VNC_ACCESS ticket whose access window has ended.
+	Status ApprovalTicketStatus `json:"status"`
+
+	// TargetVmId For DELETE, RESIZE and SNAPSHOT tickets, the target VM
 	TargetVmId string `json:"target_vm_id,omitempty,omitzero"`
 
-	// TargetVmName For DELETE tickets, the VM name (for display)
+	// TargetVmName For DELETE, RESIZE and SNAPSHOT tickets, the VM name (for display)
 	TargetVmName string `json:"target_vm_name,omitempty,omitzero"`
+
+	// Template Template of a CREATE ticket. Taken from the snapshot stored at
+	// approval when present, otherwise from the live catalog.
+	Template ApprovalTicketTemplate `json:"template,omitempty,omitzero"`
 }
 
 // ApprovalTicketOperationType Type of operation this ticket represents (ADR-0015)
 type ApprovalTicketOperationType string
 
-// ApprovalTicketStatus defines model for ApprovalTicket.Status.
+// ApprovalTicketStatus EXPIRED marks an approved VNC_ACCESS ticket whose access window has ended.
 type ApprovalTicketStatus string
 
+// ApprovalTicketInstanceSize Instance size of a CREATE ticket, with the approver's cpu, memory_mb
+// and disk_gb overrides applied. Taken from the snapshot stored at
+// approval when present, otherwise from the live catalog.
+type ApprovalTicketInstanceSize struct {
+	// CatalogChanged The live instance size differs from the snapshot or was deleted
+	CatalogChanged bool   `json:"catalog_changed"`
+	CpuCores       int    `json:"cpu_cores"`
+	DiskGb         int    `json:"disk_gb"`
+	DisplayName    string `json:"display_name,omitempty,omitzero"`
+
+	// FromSnapshot Values come from the ticket's approval snapshot
+	FromSnapshot bool   `json:"from_snapshot"`
+	Id           string `json:"id"`
+	MemoryMb     int    `json:"memory_mb"`
+	Name         string `json:"name"`
+}
+
 // ApprovalTicketList defines model for ApprovalTicketList.
 type ApprovalTicketList struct {
-	Items      []ApprovalTicket `json:"items,omitempty,omitzero"`
-	Pagination Pagination       `json:"pagination,omitempty,omitzero"`
+	Items []ApprovalTicket `json:"items,omitempty,omitzero"`
+
+	// NextCursor Pass as cursor to fetch the following tickets; absent on the last page or with sort_by=priority
+	NextCursor string     `json:"next_cursor,omitempty,omitzero"`
+	Pagination Pagination `json:"pagination,omitempty,omitzero"`
+}
+
+// ApprovalTicketPatchRequest defines model for ApprovalTicketPatchRequest.
+type ApprovalTicketPatchRequest struct {
+	// ModifiedSpec Replaces the ticket's modified_spec; an empty object clears it
+	ModifiedSpec map[string]interface{} `json:"modified_spec"`
 }
 
 // ApprovalTicketResponse defines model for ApprovalTicketResponse.
 type ApprovalTicketResponse struct {
+	// QuotaWarnings Quotas the request would exceed if approved now. Advisory only:
+	// approval fails with QUOTA_EXCEEDED while the quota is exceeded.
+	QuotaWarnings []QuotaWarning `json:"quota_warnings,omitempty,omitzero"`
+
+	// Status PENDING for a new ticket; a request_id replay reports the open ticket's current status
 	Status   ApprovalTicketResponseStatus `json:"status"`
 	TicketId string                       `json:"ticket_id"`
+
+	// VmNameConflict True when a VM named vm_name_preview already exists in the
+	// namespace. Approval rejects such a request with VM_NAME_CONFLICT.
+	VmNameConflict bool `json:"vm_name_conflict,omitempty,omitzero"`
+
+	// VmNamePreview VM name the request would receive if approved now. Advisory only:
+	// the instance index is allocated at approval.
+	VmNamePreview string `json:"vm_name_preview,omitempty,omitzero"`
 }
 
-// ApprovalTicketResponseStatus defines model for ApprovalTicketResponse.Status.
+// ApprovalTicketResponseStatus PENDING for a new ticket; a request_id replay reports the open ticket's current status
 type ApprovalTicketResponseStatus string
 
+// ApprovalTicketTemplate Template of a CREATE ticket. Taken from the snapshot stored at
+// approval when present, otherwise from the live catalog.
+type ApprovalTicketTemplate struct {
+	// CatalogChanged The live template differs from the snapshot or was deleted
+	CatalogChanged bool   `json:"catalog_changed"`
+	DisplayName    string `json:"display_name,omitempty,omitzero"`
+
+	// FromSnapshot Values come from the ticket's approval snapshot
+	FromSnapshot bool   `json:"from_snapshot"`
+	Id           string `json:"id"`
+	Name         string `json:"name"`
+	OsFamily     string `json:"os_family,omitempty,omitzero"`
+	OsVersion    string `json:"os_version,omitempty,omitzero"`
+	Version      int    `json:"version"`
+}
+
 // AuditLog defines model for AuditLog.
 type AuditLog struct {
 	Action       string                 `json:"action"`
@@ -344,15 +668,23 @@ type AuditLogList struct {
 // AuthProvider defines model for AuthProvider.
 type AuthProvider struct {
 	// AuthType Registered auth provider plugin type key
-	AuthType  string                 `json:"auth_type"`
+	AuthType string `json:"auth_type"`
+
+	// Config Provider config; values of the adapter's secret fields are replaced by "__redacted__"
 	Config    map[string]interface{} `json:"config,omitempty,omitzero"`
 	CreatedAt time.Time              `json:"created_at,omitempty,omitzero"`
 	CreatedBy string                 `json:"created_by,omitempty,omitzero"`
 	Enabled   bool                   `json:"enabled"`
 	Id        string                 `json:"id"`
-	Name      string                 `json:"name"`
-	SortOrder int                    `json:"sort_order,omitempty,omitzero"`
-	UpdatedAt time.Time              `json:"updated_at,omitempty,omitzero"`
+
+	// LastSync Outcome of the last scheduled group sync; absent until one ran
+	LastSync  *AuthProviderSyncStatus `json:"last_sync,omitempty"`
+	Name      string                  `json:"name"`
+	SortOrder int                     `json:"sort_order,omitempty,omitzero"`
+
+	// SyncSchedule Scheduled group re-sync as a duration (e.g. 6h) or 5-field cron expression; empty when disabled
+	SyncSchedule string    `json:"sync_schedule,omitempty,omitzero"`
+	UpdatedAt    time.Time `json:"updated_at,omitempty,omitzero"`
 }
 
 // AuthProviderConnectionTestResult defines model for AuthProviderConnectionTestResult.
@@ -369,6 +701,9 @@ type AuthProviderCreateRequest struct {
 	Enabled   bool                   `json:"enabled,omitempty,omitzero"`
 	Name      string                 `json:"name"`
 	SortOrder int                    `json:"sort_order,omitempty,omitzero"`
+
+	// SyncSchedule Duration (at least 1m) or 5-field cron expression for scheduled group re-sync
+	SyncSchedule string `json:"sync_schedule,omitempty,omitzero"`
 }
 
 // AuthProviderGroupSyncRequest defines model for AuthProviderGroupSyncRequest.
@@ -404,6 +739,24 @@ type AuthProviderSampleResponse struct {
 	ProviderId string                    `json:"provider_id"`
 }
 
+// AuthProviderSyncStatus Outcome of the last scheduled group sync; absent until one ran
+type AuthProviderSyncStatus struct {
+	Added               int   `json:"added"`
+	ConsecutiveFailures int   `json:"consecutive_failures"`
+	DurationMs          int64 `json:"duration_ms"`
+
+	// Error Failure of the last attempt; counts are from the last successful sync
+	Error string `json:"error,omitempty,omitzero"`
+
+	// NextRetryAt When a failed sync is retried
+	NextRetryAt time.Time `json:"next_retry_at,omitempty,omitzero"`
+
+	// Stale Known groups missing from the IdP's group set
+	Stale    int       `json:"stale"`
+	SyncedAt time.Time `json:"synced_at"`
+	Updated  int       `json:"updated"`
+}
+
 // AuthProviderType defines model for AuthProviderType.
 type AuthProviderType struct {
 	BuiltIn      bool                   `json:"built_in"`
@@ -420,10 +773,83 @@ type AuthProviderTypeList struct {
 
 // AuthProviderUpdateRequest defines model for AuthProviderUpdateRequest.
 type AuthProviderUpdateRequest struct {
+	// Config Replacement config; a secret field set to "__redacted__" keeps its stored value
 	Config    map[string]interface{} `json:"config,omitempty,omitzero"`
 	Enabled   bool                   `json:"enabled,omitempty,omitzero"`
 	Name      string                 `json:"name,omitempty,omitzero"`
 	SortOrder int                    `json:"sort_order,omitempty,omitzero"`
+
+	// SyncSchedule Duration or cron expression for scheduled group re-sync; empty disables it
+	SyncSchedule string `json:"sync_schedule,omitempty,omitzero"`
+}
+
+// AuthProviderUserImport defines model for AuthProviderUserImport.
+type AuthProviderUserImport struct {
+	DisplayName string `json:"display_name,omitempty,omitzero"`
+	Email       string `json:"email,omitempty,omitzero"`
+
+	// ExternalId Stable subject identifier of the user at the provider
+	ExternalId string `json:"external_id"`
+
+	// Groups External group IDs matched against the provider's group mappings
+	Groups   []string `json:"groups,omitempty,omitzero"`
+	Username string   `json:"username"`
+}
+
+// AuthProviderUserImportError defines model for AuthProviderUserImportError.
+type AuthProviderUserImportError struct {
+	// Index Position of the entry in the request array
+	Index   int    `json:"index"`
+	Message string `json:"message"`
+}
+
+// AuthProviderUserImportResult defines model for AuthProviderUserImportResult.
+type AuthProviderUserImportResult struct {
+	Created int                           `json:"created"`
+	Errors  []AuthProviderUserImportError `json:"errors"`
+	Failed  int                           `json:"failed"`
+	Updated int                           `json:"updated"`
+}
+
+// BatchApproveRequest defines model for BatchApproveRequest.
+type BatchApproveRequest struct {
+	// Comment Optional approver note shown to the requester
+	Comment string `json:"comment,omitempty,omitzero"`
+
+	// SelectedClusterId Target cluster for CREATE children (ADR-0017)
+	SelectedClusterId string `json:"selected_cluster_id,omitempty,omitzero"`
+
+	// SelectedItems Child ticket IDs to approve. Omitted or empty approves every
+	// pending child. Each ID must be a pending child of the batch.
+	SelectedItems        []string `json:"selected_items,omitempty,omitzero"`
+	SelectedStorageClass string   `json:"selected_storage_class,omitempty,omitzero"`
+}
+
+// BatchChildSelection defines model for BatchChildSelection.
+type BatchChildSelection struct {
+	ClusterId    string `json:"cluster_id,omitempty,omitzero"`
+	StorageClass string `json:"storage_class,omitempty,omitzero"`
+}
+
+// BatchList defines model for BatchList.
+type BatchList struct {
+	Items      []BatchSummary `json:"items"`
+	Pagination Pagination     `json:"pagination"`
+}
+
+// BatchSummary defines model for BatchSummary.
+type BatchSummary struct {
+	BatchId      string              `json:"batch_id"`
+	BatchType    VMBatchType         `json:"batch_type"`
+	ChildCount   int                 `json:"child_count"`
+	CreatedAt    time.Time           `json:"created_at"`
+	CreatedBy    string              `json:"created_by"`
+	FailedCount  int                 `json:"failed_count"`
+	PendingCount int                 `json:"pending_count"`
+	Reason       string              `json:"reason,omitempty,omitzero"`
+	Status       VMBatchParentStatus `json:"status"`
+	SuccessCount int                 `json:"success_count"`
+	UpdatedAt    time.Time           `json:"updated_at"`
 }
 
 // ChangePasswordRequest defines model for ChangePasswordRequest.
@@ -435,20 +861,37 @@ type ChangePasswordRequest struct {
 // Cluster defines model for Cluster.
 type Cluster struct {
 	ApiServerUrl        string    `json:"api_server_url"`
+	CpuOvercommitRatio  float64   `json:"cpu_overcommit_ratio,omitempty,omitzero"`
 	CreatedAt           time.Time `json:"created_at,omitempty,omitzero"`
 	DefaultStorageClass string    `json:"default_storage_class,omitempty,omitzero"`
 	DisplayName         string    `json:"display_name,omitempty,omitzero"`
 	Enabled             bool      `json:"enabled,omitempty,omitzero"`
 
 	// Environment Cluster environment type (ADR-0015 §1, §15)
-	Environment     ClusterEnvironment `json:"environment,omitempty,omitzero"`
-	Id              string             `json:"id"`
-	KubevirtVersion string             `json:"kubevirt_version,omitempty,omitzero"`
-	Name            string             `json:"name"`
-	Status          ClusterStatus      `json:"status"`
+	Environment ClusterEnvironment `json:"environment,omitempty,omitzero"`
+
+	// HealthCheckFailures Consecutive failed health probes; reset on success
+	HealthCheckFailures int `json:"health_check_failures,omitempty,omitzero"`
+
+	// HealthMessage Failure reason from the most recent health probe; empty when healthy
+	HealthMessage   string `json:"health_message,omitempty,omitzero"`
+	Id              string `json:"id"`
+	KubevirtVersion string `json:"kubevirt_version,omitempty,omitzero"`
+
+	// LastHealthCheckAt Timestamp of the most recent health probe
+	LastHealthCheckAt     time.Time     `json:"last_health_check_at,omitempty,omitzero"`
+	MemoryOvercommitRatio float64       `json:"memory_overcommit_ratio,omitempty,omitzero"`
+	Name                  string        `json:"name"`
+	Status                ClusterStatus `json:"status"`
 
 	// StorageClasses Auto-detected StorageClass list (ADR-0015 §8)
 	StorageClasses []string `json:"storage_classes,omitempty,omitzero"`
+
+	// TotalCpuCores Schedulable vCPU capacity (0 = unset, capacity check disabled)
+	TotalCpuCores int `json:"total_cpu_cores,omitempty,omitzero"`
+
+	// TotalMemoryMb Schedulable memory capacity in MB (0 = unset, capacity check disabled)
+	TotalMemoryMb int `json:"total_memory_mb,omitempty,omitzero"`
 }
 
 // ClusterEnvironment Cluster environment type (ADR-0015 §1, §15)
@@ -457,6 +900,25 @@ type ClusterEnvironment string
 // ClusterStatus defines model for Cluster.Status.
 type ClusterStatus string
 
+// ClusterCapacityReport defines model for ClusterCapacityReport.
+type ClusterCapacityReport struct {
+	// AllocatableCpuCores Node allocatable CPU minus in-flight CREATING VM requests
+	AllocatableCpuCores int `json:"allocatable_cpu_cores"`
+
+	// AllocatableMemoryMb Node allocatable memory minus in-flight CREATING VM requests
+	AllocatableMemoryMb int `json:"allocatable_memory_mb"`
+	NodeCount           int `json:"node_count"`
+
+	// TotalCpuCores Sum of node CPU capacity in whole cores
+	TotalCpuCores int `json:"total_cpu_cores"`
+
+	// TotalMemoryMb Sum of node memory capacity in MiB
+	TotalMemoryMb int `json:"total_memory_mb"`
+
+	// VmCount Shepherd-managed VMs on the cluster, excluding FAILED
+	VmCount int `json:"vm_count"`
+}
+
 // ClusterCreateRequest defines model for ClusterCreateRequest.
 type ClusterCreateRequest struct {
 	DisplayName string                          `json:"display_name,omitempty,omitzero"`
@@ -465,6 +927,9 @@ type ClusterCreateRequest struct {
 	// Kubeconfig Base64-encoded kubeconfig (stored encrypted, ADR-0012)
 	Kubeconfig []byte `json:"kubeconfig"`
 	Name       string `json:"name"`
+
+	// SkipConnectivityCheck Store the kubeconfig without contacting its API server
+	SkipConnectivityCheck bool `json:"skip_connectivity_check,omitempty,omitzero"`
 }
 
 // ClusterCreateRequestEnvironment defines model for ClusterCreateRequest.Environment.
@@ -478,22 +943,102 @@ type ClusterEnvironmentUpdate struct {
 // ClusterEnvironmentUpdateEnvironment defines model for ClusterEnvironmentUpdate.Environment.
 type ClusterEnvironmentUpdateEnvironment string
 
+// ClusterKubeconfigValidation defines model for ClusterKubeconfigValidation.
+type ClusterKubeconfigValidation struct {
+	Reachable bool `json:"reachable"`
+
+	// ServerVersion API server git version, e.g. v1.33.2
+	ServerVersion string `json:"server_version"`
+}
+
+// ClusterKubeconfigValidationRequest defines model for ClusterKubeconfigValidationRequest.
+type ClusterKubeconfigValidationRequest struct {
+	// Kubeconfig Base64-encoded kubeconfig
+	Kubeconfig []byte `json:"kubeconfig"`
+}
+
 // ClusterList defines model for ClusterList.
 type ClusterList struct {
 	Items      []Cluster  `json:"items,omitempty,omitzero"`
 	Pagination Pagination `json:"pagination,omitempty,omitzero"`
 }
 
+// ClusterUpdateRequest defines model for ClusterUpdateRequest.
+type ClusterUpdateRequest struct {
+	CpuOvercommitRatio float64 `json:"cpu_overcommit_ratio,omitempty,omitzero"`
+
+	// Kubeconfig Base64-encoded replacement kubeconfig
+	Kubeconfig            []byte  `json:"kubeconfig,omitempty,omitzero"`
+	MemoryOvercommitRatio float64 `json:"memory_overcommit_ratio,omitempty,omitzero"`
+
+	// SkipConnectivityCheck Store the kubeconfig without contacting its API server
+	SkipConnectivityCheck bool `json:"skip_connectivity_check,omitempty,omitzero"`
+	TotalCpuCores         int  `json:"total_cpu_cores,omitempty,omitzero"`
+	TotalMemoryMb         int  `json:"total_memory_mb,omitempty,omitzero"`
+}
+
+// ComponentHealth defines model for ComponentHealth.
+type ComponentHealth struct {
+	// Details Per-item status, e.g. cluster name to cluster status
+	Details map[string]string `json:"details,omitempty,omitzero"`
+
+	// LatencyMs Time spent checking the component, in milliseconds
+	LatencyMs float64 `json:"latency_ms"`
+
+	// Message English reason when the component is not ok
+	Message string                `json:"message,omitempty,omitzero"`
+	Status  ComponentHealthStatus `json:"status"`
+}
+
+// ComponentHealthStatus defines model for ComponentHealthStatus.
+type ComponentHealthStatus string
+
+// CreateVMSnapshotRequest defines model for CreateVMSnapshotRequest.
+type CreateVMSnapshotRequest struct {
+	// Name DNS-1123 label; defaults to "<vm name>-snap-<unix seconds>"
+	Name   string `json:"name,omitempty,omitzero"`
+	Reason string `json:"reason,omitempty,omitzero"`
+}
+
 // DeleteVMResponse defines model for DeleteVMResponse.
 type DeleteVMResponse struct {
-	EventId  string                 `json:"event_id"`
+	EventId string `json:"event_id"`
+
+	// Status PENDING for a new ticket; a request_id replay reports the open ticket's current status
 	Status   DeleteVMResponseStatus `json:"status"`
 	TicketId string                 `json:"ticket_id"`
 }
 
-// DeleteVMResponseStatus defines model for DeleteVMResponse.Status.
+// DeleteVMResponseStatus PENDING for a new ticket; a request_id replay reports the open ticket's current status
 type DeleteVMResponseStatus string
 
+// DomainEventReplayResponse defines model for DomainEventReplayResponse.
+type DomainEventReplayResponse struct {
+	DryRun bool `json:"dry_run"`
+
+	// Duplicate An identical job was still live, so none was inserted
+	Duplicate bool                   `json:"duplicate"`
+	EventId   string                 `json:"event_id"`
+	EventType string                 `json:"event_type"`
+	JobArgs   map[string]interface{} `json:"job_args"`
+
+	// JobId River job ID; omitted for dry runs
+	JobId int64 `json:"job_id,omitempty,omitzero"`
+
+	// JobKind River job kind, e.g. vm_create
+	JobKind string `json:"job_kind"`
+
+	// PreviousStatus Event status before the replay (PENDING or FAILED)
+	PreviousStatus string `json:"previous_status"`
+	Queue          string `json:"queue"`
+}
+
+// EffectivePermissions defines model for EffectivePermissions.
+type EffectivePermissions struct {
+	Scopes []ScopePermissions `json:"scopes"`
+	UserId string             `json:"user_id"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Code Machine-readable error code (frontend handles i18n)
@@ -512,6 +1057,15 @@ type FieldError struct {
 	Message string `json:"message,omitempty,omitzero"`
 }
 
+// ForcePasswordResetRequest defines model for ForcePasswordResetRequest.
+type ForcePasswordResetRequest struct {
+	// NewTemporaryPassword Replaces the password; the user must change it at next login
+	NewTemporaryPassword string `json:"new_temporary_password,omitempty,omitzero"`
+
+	// NotifyUser Email the user that a password change is required
+	NotifyUser bool `json:"notify_user,omitempty,omitzero"`
+}
+
 // GlobalRoleBinding defines model for GlobalRoleBinding.
 type GlobalRoleBinding struct {
 	AllowedEnvironments []GlobalRoleBindingAllowedEnvironments `json:"allowed_environments,omitempty,omitzero"`
@@ -554,19 +1108,35 @@ type Health struct {
 // HealthStatus defines model for Health.Status.
 type HealthStatus string
 
+// HealthzComponents defines model for HealthzComponents.
+type HealthzComponents struct {
+	Database         ComponentHealth `json:"database"`
+	KubevirtClusters ComponentHealth `json:"kubevirt_clusters"`
+	RiverQueue       ComponentHealth `json:"river_queue"`
+}
+
+// HealthzResponse defines model for HealthzResponse.
+type HealthzResponse struct {
+	Components HealthzComponents     `json:"components"`
+	Status     ComponentHealthStatus `json:"status"`
+}
+
 // IdPGroupMapping defines model for IdPGroupMapping.
 type IdPGroupMapping struct {
 	AllowedEnvironments []IdPGroupMappingAllowedEnvironments `json:"allowed_environments,omitempty,omitzero"`
 	CreatedAt           time.Time                            `json:"created_at,omitempty,omitzero"`
 	ExternalGroupId     string                               `json:"external_group_id"`
 	GroupName           string                               `json:"group_name,omitempty,omitzero"`
-	Id                  string                               `json:"id"`
-	ProviderId          string                               `json:"provider_id"`
-	RoleId              string                               `json:"role_id"`
-	RoleName            string                               `json:"role_name,omitempty,omitzero"`
-	ScopeId             string                               `json:"scope_id,omitempty,omitzero"`
-	ScopeType           string                               `json:"scope_type,omitempty,omitzero"`
-	UpdatedAt           time.Time                            `json:"updated_at,omitempty,omitzero"`
+
+	// GroupStale The mapped group vanished from the IdP; the mapping no longer matches anyone
+	GroupStale bool      `json:"group_stale,omitempty,omitzero"`
+	Id         string    `json:"id"`
+	ProviderId string    `json:"provider_id"`
+	RoleId     string    `json:"role_id"`
+	RoleName   string    `json:"role_name,omitempty,omitzero"`
+	ScopeId    string    `json:"scope_id,omitempty,omitzero"`
+	ScopeType  string    `json:"scope_type,omitempty,omitzero"`
+	UpdatedAt  time.Time `json:"updated_at,omitempty,omitzero"`
 }
 
 // IdPGroupMappingAllowedEnvironments defines model for IdPGroupMapping.AllowedEnvironments.
@@ -603,16 +1173,25 @@ type IdPGroupMappingUpdateRequestAllowedEnvironments string
 
 // IdPSyncedGroup defines model for IdPSyncedGroup.
 type IdPSyncedGroup struct {
+	Description     string    `json:"description,omitempty,omitzero"`
 	ExternalGroupId string    `json:"external_group_id"`
 	GroupName       string    `json:"group_name"`
 	Id              string    `json:"id"`
 	LastSyncedAt    time.Time `json:"last_synced_at,omitempty,omitzero"`
 	ProviderId      string    `json:"provider_id"`
 	SourceField     string    `json:"source_field,omitempty,omitzero"`
+
+	// Stale The group vanished from the IdP on a scheduled re-sync
+	Stale      bool      `json:"stale,omitempty,omitzero"`
+	StaleSince time.Time `json:"stale_since,omitempty,omitzero"`
 }
 
 // InstanceSize defines model for InstanceSize.
 type InstanceSize struct {
+	// AllowedClusterIds Clusters the size is restricted to; empty means every cluster.
+	// Returned by GET /admin/instance-sizes/{instance_size_id} and
+	// associate-clusters only.
+	AllowedClusterIds []string               `json:"allowed_cluster_ids,omitempty,omitzero"`
 	CpuCores          int                    `json:"cpu_cores"`
 	DedicatedCpu      bool                   `json:"dedicated_cpu,omitempty,omitzero"`
 	Description       string                 `json:"description,omitempty,omitzero"`
@@ -629,6 +1208,11 @@ type InstanceSize struct {
 	SpecOverrides     map[string]interface{} `json:"spec_overrides,omitempty,omitzero"`
 }
 
+// InstanceSizeClusterAssociation defines model for InstanceSizeClusterAssociation.
+type InstanceSizeClusterAssociation struct {
+	ClusterIds []string `json:"cluster_ids"`
+}
+
 // InstanceSizeCreateRequest defines model for InstanceSizeCreateRequest.
 type InstanceSizeCreateRequest struct {
 	CpuCores          int                    `json:"cpu_cores"`
@@ -651,7 +1235,14 @@ type InstanceSizeCreateRequest struct {
 
 // InstanceSizeList defines model for InstanceSizeList.
 type InstanceSizeList struct {
-	Items []InstanceSize `json:"items,omitempty,omitzero"`
+	Items      []InstanceSize `json:"items,omitempty,omitzero"`
+	Pagination Pagination     `json:"pagination,omitempty,omitzero"`
+}
+
+// InstanceSizeSortUpdate defines model for InstanceSizeSortUpdate.
+type InstanceSizeSortUpdate struct {
+	Id        string `json:"id"`
+	SortOrder int    `json:"sort_order"`
 }
 
 // InstanceSizeUpdateRequest defines model for InstanceSizeUpdateRequest.
@@ -674,6 +1265,12 @@ type InstanceSizeUpdateRequest struct {
 	SpecOverrides     map[string]interface{} `json:"spec_overrides,omitempty,omitzero"`
 }
 
+// Labels User-defined metadata; keys match `^[a-z][a-z0-9_.-]{0,62}$`
+type Labels map[string]string
+
+// LabelsPatchRequest Label merge patch; a null value deletes the key
+type LabelsPatchRequest map[string]*string
+
 // LoginRequest defines model for LoginRequest.
 type LoginRequest struct {
 	Password string `json:"password"`
@@ -684,9 +1281,55 @@ type LoginRequest struct {
 type LoginResponse struct {
 	ExpiresAt           time.Time `json:"expires_at,omitzero"`
 	ForcePasswordChange bool      `json:"force_password_change,omitempty,omitzero"`
-	Token               string    `json:"token"`
+
+	// RefreshToken One-time token for POST /auth/refresh.
+	RefreshToken          string    `json:"refresh_token,omitempty,omitzero"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at,omitempty,omitzero"`
+	Token                 string    `json:"token"`
+}
+
+// LoginSession defines model for LoginSession.
+type LoginSession struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Current True for the session of the token making the request
+	Current   bool      `json:"current"`
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// Id Token ID (JWT jti) of the session
+	Id        string `json:"id"`
+	IpAddress string `json:"ip_address,omitempty,omitzero"`
+	UserAgent string `json:"user_agent,omitempty,omitzero"`
+}
+
+// LoginSessionList defines model for LoginSessionList.
+type LoginSessionList struct {
+	Items []LoginSession `json:"items"`
+}
+
+// MaintainerRequest defines model for MaintainerRequest.
+type MaintainerRequest struct {
+	UserId string `json:"user_id"`
+}
+
+// MigrateVMRequest defines model for MigrateVMRequest.
+type MigrateVMRequest struct {
+	Reason string `json:"reason,omitempty,omitzero"`
+
+	// TargetClusterId Cluster the VM is moved to; must match the namespace environment
+	TargetClusterId string `json:"target_cluster_id"`
+}
+
+// MigrateVMResponse defines model for MigrateVMResponse.
+type MigrateVMResponse struct {
+	EventId  string                  `json:"event_id"`
+	Status   MigrateVMResponseStatus `json:"status"`
+	TicketId string                  `json:"ticket_id"`
 }
 
+// MigrateVMResponseStatus defines model for MigrateVMResponse.Status.
+type MigrateVMResponseStatus string
+
 // NamespaceCreateRequest defines model for NamespaceCreateRequest.
 type NamespaceCreateRequest struct {
 	Description string                            `json:"description,omitempty,omitzero"`
@@ -694,11 +1337,38 @@ type NamespaceCreateRequest struct {
 
 	// Name Must follow RFC 1035 naming (ADR-0019)
 	Name string `json:"name"`
+
+	// RequirePowerApproval Batch power operations on VMs in this namespace require approval
+	RequirePowerApproval bool `json:"require_power_approval,omitempty,omitzero"`
 }
 
 // NamespaceCreateRequestEnvironment defines model for NamespaceCreateRequest.Environment.
 type NamespaceCreateRequestEnvironment string
 
+// NamespaceMigrateEnvironmentRequest defines model for NamespaceMigrateEnvironmentRequest.
+type NamespaceMigrateEnvironmentRequest struct {
+	Reason            string                                              `json:"reason,omitempty,omitzero"`
+	TargetEnvironment NamespaceMigrateEnvironmentRequestTargetEnvironment `json:"target_environment"`
+}
+
+// NamespaceMigrateEnvironmentRequestTargetEnvironment defines model for NamespaceMigrateEnvironmentRequest.TargetEnvironment.
+type NamespaceMigrateEnvironmentRequestTargetEnvironment string
+
+// NamespaceQuota defines model for NamespaceQuota.
+type NamespaceQuota struct {
+	CreatedAt     time.Time `json:"created_at"`
+	CreatedBy     string    `json:"created_by"`
+	Id            string    `json:"id"`
+	MaxVms        int       `json:"max_vms"`
+	NamespaceName string    `json:"namespace_name"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// NamespaceQuotaRequest defines model for NamespaceQuotaRequest.
+type NamespaceQuotaRequest struct {
+	MaxVms int `json:"max_vms"`
+}
+
 // NamespaceRegistry defines model for NamespaceRegistry.
 type NamespaceRegistry struct {
 	CreatedAt   time.Time                    `json:"created_at,omitempty,omitzero"`
@@ -709,8 +1379,11 @@ type NamespaceRegistry struct {
 	Id          string                       `json:"id"`
 
 	// Name Globally unique namespace name (RFC 1035)
-	Name      string    `json:"name"`
-	UpdatedAt time.Time `json:"updated_at,omitempty,omitzero"`
+	Name string `json:"name"`
+
+	// RequirePowerApproval Batch power operations on VMs in this namespace require approval
+	RequirePowerApproval bool      `json:"require_power_approval,omitempty,omitzero"`
+	UpdatedAt            time.Time `json:"updated_at,omitempty,omitzero"`
 }
 
 // NamespaceRegistryEnvironment defines model for NamespaceRegistry.Environment.
@@ -722,10 +1395,36 @@ type NamespaceRegistryList struct {
 	Pagination Pagination          `json:"pagination,omitempty,omitzero"`
 }
 
+// NamespaceSyncResult defines model for NamespaceSyncResult.
+type NamespaceSyncResult struct {
+	ClusterId string `json:"cluster_id"`
+
+	// Disabled Names of registry entries disabled by this sync
+	Disabled []string `json:"disabled"`
+
+	// Environment Cluster environment; only registry entries of this environment are compared
+	Environment NamespaceSyncResultEnvironment `json:"environment"`
+
+	// MatchedCount Registry entries that exist on the cluster
+	MatchedCount int `json:"matched_count"`
+
+	// MissingInCluster Registry entries whose namespace does not exist on the cluster
+	MissingInCluster []NamespaceRegistry `json:"missing_in_cluster"`
+
+	// MissingInRegistry Cluster namespaces with no registry entry
+	MissingInRegistry []string `json:"missing_in_registry"`
+}
+
+// NamespaceSyncResultEnvironment Cluster environment; only registry entries of this environment are compared
+type NamespaceSyncResultEnvironment string
+
 // NamespaceUpdateRequest defines model for NamespaceUpdateRequest.
 type NamespaceUpdateRequest struct {
 	Description string `json:"description,omitempty,omitzero"`
 	Enabled     bool   `json:"enabled,omitempty,omitzero"`
+
+	// RequirePowerApproval Batch power operations on VMs in this namespace require approval; unchanged when omitted
+	RequirePowerApproval *bool `json:"require_power_approval,omitempty"`
 }
 
 // Notification defines model for Notification.
@@ -741,15 +1440,42 @@ type Notification struct {
 	Type         NotificationType `json:"type"`
 }
 
-// NotificationType defines model for Notification.Type.
-type NotificationType string
-
 // NotificationList defines model for NotificationList.
 type NotificationList struct {
 	Items      []Notification `json:"items,omitempty,omitzero"`
 	Pagination Pagination     `json:"pagination,omitempty,omitzero"`
 }
 
+// NotificationPreference defines model for NotificationPreference.
+type NotificationPreference struct {
+	// Enabled Whether in-app notifications of this type are created
+	Enabled   bool             `json:"enabled"`
+	EventType NotificationType `json:"event_type"`
+
+	// Mutable False for APPROVAL_COMPLETED and APPROVAL_REJECTED: decisions on
+	// your own requests are always delivered and cannot be turned off.
+	Mutable bool `json:"mutable"`
+}
+
+// NotificationPreferenceList defines model for NotificationPreferenceList.
+type NotificationPreferenceList struct {
+	Items []NotificationPreference `json:"items"`
+}
+
+// NotificationPreferenceUpdate defines model for NotificationPreferenceUpdate.
+type NotificationPreferenceUpdate struct {
+	Enabled   bool             `json:"enabled"`
+	EventType NotificationType `json:"event_type"`
+}
+
+// NotificationPreferencesUpdateRequest defines model for NotificationPreferencesUpdateRequest.
+type NotificationPreferencesUpdateRequest struct {
+	Preferences []NotificationPreferenceUpdate `json:"preferences"`
+}
+
+// NotificationType defines model for NotificationType.
+type NotificationType string
+
 // Pagination defines model for Pagination.
 type Pagination struct {
 	Page       int `json:"page,omitempty,omitzero"`
@@ -758,6 +1484,27 @@ type Pagination struct {
 	TotalPages int `json:"total_pages,omitempty,omitzero"`
 }
 
+// PasswordPolicy defines model for PasswordPolicy.
+type PasswordPolicy struct {
+	// HistorySize Number of recent passwords a change may not reuse
+	HistorySize int `json:"history_size"`
+	MaxLength   int `json:"max_length"`
+	MinLength   int `json:"min_length"`
+
+	// Mode Character class rules apply in legacy mode only
+	Mode PasswordPolicyMode `json:"mode"`
+
+	// RejectUserInfo Passwords containing the username or email are rejected
+	RejectUserInfo   bool `json:"reject_user_info"`
+	RequireDigit     bool `json:"require_digit"`
+	RequireLowercase bool `json:"require_lowercase"`
+	RequireSpecial   bool `json:"require_special"`
+	RequireUppercase bool `json:"require_uppercase"`
+}
+
+// PasswordPolicyMode Character class rules apply in legacy mode only
+type PasswordPolicyMode string
+
 // Permission defines model for Permission.
 type Permission struct {
 	Description string `json:"description,omitempty,omitzero"`
@@ -769,6 +1516,137 @@ type PermissionList struct {
 	Items []Permission `json:"items,omitempty,omitzero"`
 }
 
+// PermissionMatrix defines model for PermissionMatrix.
+type PermissionMatrix struct {
+	// Permissions Roles granting each permission key, keyed by permission
+	Permissions map[string][]PermissionMatrixRole `json:"permissions"`
+
+	// TotalAssignments Number of permission to role pairs
+	TotalAssignments int `json:"total_assignments"`
+
+	// TotalPermissions Number of permission keys in the matrix
+	TotalPermissions int `json:"total_permissions"`
+
+	// TotalRoles Number of roles, including roles without permissions
+	TotalRoles int `json:"total_roles"`
+}
+
+// PermissionMatrixRole defines model for PermissionMatrixRole.
+type PermissionMatrixRole struct {
+	BuiltIn  bool   `json:"built_in"`
+	Enabled  bool   `json:"enabled"`
+	RoleId   string `json:"role_id"`
+	RoleName string `json:"role_name"`
+}
+
+// PlatformConfig defines model for PlatformConfig.
+type PlatformConfig struct {
+	// AllowUserData Whether VM create requests may carry cloud-init user_data. Checked
+	// at submission; requests already submitted are not affected.
+	AllowUserData bool `json:"allow_user_data"`
+
+	// ApprovalTtlHours PENDING approval tickets older than this are auto-rejected; 0
+	// disables auto-rejection. Until first saved, the server's
+	// approval.pending_ttl setting applies and this reports 0.
+	ApprovalTtlHours int `json:"approval_ttl_hours"`
+
+	// Smtp SMTP relay used for email notifications on ticket created, approved and
+	// rejected events. Email is disabled until host and from_address are set.
+	// The password is write-only.
+	Smtp      SMTPSettings `json:"smtp,omitempty,omitzero"`
+	UpdatedAt *time.Time   `json:"updated_at,omitempty"`
+	UpdatedBy string       `json:"updated_by,omitempty,omitzero"`
+}
+
+// PlatformConfigPatchRequest defines model for PlatformConfigPatchRequest.
+type PlatformConfigPatchRequest struct {
+	AllowUserData    *bool             `json:"allow_user_data,omitempty"`
+	ApprovalTtlHours *int              `json:"approval_ttl_hours,omitempty"`
+	Smtp             SMTPSettingsPatch `json:"smtp,omitempty,omitzero"`
+}
+
+// Quota defines model for Quota.
+type Quota struct {
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy string    `json:"created_by"`
+	Id        string    `json:"id"`
+
+	// MaxCpuCores Unset when unlimited
+	MaxCpuCores *int `json:"max_cpu_cores,omitempty"`
+
+	// MaxMemoryMb Unset when unlimited
+	MaxMemoryMb *int `json:"max_memory_mb,omitempty"`
+
+	// MaxVms Unset when unlimited
+	MaxVms *int `json:"max_vms,omitempty"`
+
+	// ScopeId System ID or namespace name
+	ScopeId   string         `json:"scope_id"`
+	ScopeType QuotaScopeType `json:"scope_type"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	Usage     QuotaUsage     `json:"usage"`
+}
+
+// QuotaCreateRequest defines model for QuotaCreateRequest.
+type QuotaCreateRequest struct {
+	MaxCpuCores *int `json:"max_cpu_cores,omitempty"`
+	MaxMemoryMb *int `json:"max_memory_mb,omitempty"`
+	MaxVms      *int `json:"max_vms,omitempty"`
+
+	// ScopeId System ID, or namespace registry ID or name
+	ScopeId   string         `json:"scope_id"`
+	ScopeType QuotaScopeType `json:"scope_type"`
+}
+
+// QuotaLimits defines model for QuotaLimits.
+type QuotaLimits struct {
+	MaxCpuCores *int `json:"max_cpu_cores,omitempty"`
+	MaxMemoryMb *int `json:"max_memory_mb,omitempty"`
+	MaxVms      *int `json:"max_vms,omitempty"`
+}
+
+// QuotaList defines model for QuotaList.
+type QuotaList struct {
+	Items      []Quota    `json:"items"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// QuotaScopeType defines model for QuotaScopeType.
+type QuotaScopeType string
+
+// QuotaUsage defines model for QuotaUsage.
+type QuotaUsage struct {
+	CpuCores int `json:"cpu_cores"`
+	MemoryMb int `json:"memory_mb"`
+	Vms      int `json:"vms"`
+}
+
+// QuotaWarning defines model for QuotaWarning.
+type QuotaWarning struct {
+	Current   int                  `json:"current"`
+	Limit     int                  `json:"limit"`
+	Requested int                  `json:"requested"`
+	Resource  QuotaWarningResource `json:"resource"`
+	ScopeId   string               `json:"scope_id"`
+	ScopeType QuotaScopeType       `json:"scope_type"`
+}
+
+// QuotaWarningResource defines model for QuotaWarning.Resource.
+type QuotaWarningResource string
+
+// RateLimitEffectivePolicy defines model for RateLimitEffectivePolicy.
+type RateLimitEffectivePolicy struct {
+	CooldownSeconds    int       `json:"cooldown_seconds"`
+	Exempted           bool      `json:"exempted"`
+	ExemptionExpiresAt time.Time `json:"exemption_expires_at,omitzero"`
+	MaxPendingChildren int       `json:"max_pending_children"`
+	MaxPendingParents  int       `json:"max_pending_parents"`
+	UserId             string    `json:"user_id"`
+
+	// UsesDefault True when neither an exemption nor an override applies.
+	UsesDefault bool `json:"uses_default"`
+}
+
 // RateLimitExemption defines model for RateLimitExemption.
 type RateLimitExemption struct {
 	CreatedAt  time.Time `json:"created_at"`
@@ -786,6 +1664,11 @@ type RateLimitExemptionCreateRequest struct {
 	UserId    string    `json:"user_id"`
 }
 
+// RateLimitExemptionList defines model for RateLimitExemptionList.
+type RateLimitExemptionList struct {
+	Items []RateLimitExemption `json:"items"`
+}
+
 // RateLimitStatusList defines model for RateLimitStatusList.
 type RateLimitStatusList struct {
 	GeneratedAt time.Time             `json:"generated_at"`
@@ -794,14 +1677,36 @@ type RateLimitStatusList struct {
 
 // RateLimitUserOverride defines model for RateLimitUserOverride.
 type RateLimitUserOverride struct {
-	CooldownSeconds    int       `json:"cooldown_seconds,omitzero"`
-	CreatedAt          time.Time `json:"created_at"`
-	MaxPendingChildren int       `json:"max_pending_children,omitzero"`
-	MaxPendingParents  int       `json:"max_pending_parents,omitzero"`
-	Reason             string    `json:"reason,omitempty,omitzero"`
-	UpdatedAt          time.Time `json:"updated_at"`
-	UpdatedBy          string    `json:"updated_by"`
-	UserId             string    `json:"user_id"`
+	CooldownSeconds    int                      `json:"cooldown_seconds,omitzero"`
+	CreatedAt          time.Time                `json:"created_at"`
+	EffectivePolicy    RateLimitEffectivePolicy `json:"effective_policy,omitempty,omitzero"`
+	MaxPendingChildren int                      `json:"max_pending_children,omitzero"`
+	MaxPendingParents  int                      `json:"max_pending_parents,omitzero"`
+	Reason             string                   `json:"reason,omitempty,omitzero"`
+	UpdatedAt          time.Time                `json:"updated_at"`
+	UpdatedBy          string                   `json:"updated_by"`
+	UserId             string                   `json:"user_id"`
+}
+
+// RateLimitUserOverrideCreateRequest defines model for RateLimitUserOverrideCreateRequest.
+type RateLimitUserOverrideCreateRequest struct {
+	CooldownSeconds    int    `json:"cooldown_seconds"`
+	MaxPendingChildren int    `json:"max_pending_children"`
+	MaxPendingParents  int    `json:"max_pending_parents"`
+	Reason             string `json:"reason,omitempty,omitzero"`
+}
+
+// RateLimitUserOverrideList defines model for RateLimitUserOverrideList.
+type RateLimitUserOverrideList struct {
+	Items []RateLimitUserOverride `json:"items"`
+}
+
+// RateLimitUserOverridePatchRequest defines model for RateLimitUserOverridePatchRequest.
+type RateLimitUserOverridePatchRequest struct {
+	CooldownSeconds    int    `json:"cooldown_seconds,omitempty,omitzero"`
+	MaxPendingChildren int    `json:"max_pending_children,omitempty,omitzero"`
+	MaxPendingParents  int    `json:"max_pending_parents,omitempty,omitzero"`
+	Reason             string `json:"reason,omitempty,omitzero"`
 }
 
 // RateLimitUserOverrideRequest defines model for RateLimitUserOverrideRequest.
@@ -825,11 +1730,63 @@ type RateLimitUserStatus struct {
 	UserId                      string    `json:"user_id"`
 }
 
+// ReassignTicketRequest defines model for ReassignTicketRequest.
+type ReassignTicketRequest struct {
+	// AssigneeId User ID of the new approver
+	AssigneeId string `json:"assignee_id"`
+}
+
+// RefreshTokenRequest defines model for RefreshTokenRequest.
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
 // RejectDecisionRequest defines model for RejectDecisionRequest.
 type RejectDecisionRequest struct {
 	Reason string `json:"reason"`
 }
 
+// ResizeSelection RESIZE tickets only. Size to apply instead of the requested one:
+// either instance_size_id from the catalog or explicit
+// cpu/memory_mb/disk_gb, where omitted values keep the requested size.
+type ResizeSelection struct {
+	Cpu            int    `json:"cpu,omitempty,omitzero"`
+	DiskGb         int    `json:"disk_gb,omitempty,omitzero"`
+	InstanceSizeId string `json:"instance_size_id,omitempty,omitzero"`
+	MemoryMb       int    `json:"memory_mb,omitempty,omitzero"`
+}
+
+// ResizeVMRequest Give either instance_size_id or explicit cpu/memory_mb/disk_gb. An
+// explicit request may set any subset and keep the other values.
+type ResizeVMRequest struct {
+	Cpu int `json:"cpu,omitempty,omitzero"`
+
+	// DiskGb New data disk size; must not be smaller than the current one
+	DiskGb         int    `json:"disk_gb,omitempty,omitzero"`
+	InstanceSizeId string `json:"instance_size_id,omitempty,omitzero"`
+	MemoryMb       int    `json:"memory_mb,omitempty,omitzero"`
+	Reason         string `json:"reason,omitempty,omitzero"`
+}
+
+// ResizeVMResponse defines model for ResizeVMResponse.
+type ResizeVMResponse struct {
+	EventId  string                 `json:"event_id"`
+	From     VMSize                 `json:"from"`
+	Status   ResizeVMResponseStatus `json:"status"`
+	TicketId string                 `json:"ticket_id"`
+	To       VMSize                 `json:"to"`
+}
+
+// ResizeVMResponseStatus defines model for ResizeVMResponse.Status.
+type ResizeVMResponseStatus string
+
+// RestoreVMSnapshotRequest defines model for RestoreVMSnapshotRequest.
+type RestoreVMSnapshotRequest struct {
+	// Force Stop a RUNNING VM for the restore instead of refusing it
+	Force  bool   `json:"force,omitempty,omitzero"`
+	Reason string `json:"reason,omitempty,omitzero"`
+}
+
 // Role defines model for Role.
 type Role struct {
 	BuiltIn     bool      `json:"built_in"`
@@ -842,6 +1799,33 @@ type Role struct {
 	Permissions []string  `json:"permissions"`
 }
 
+// RoleBindingCreateRequest defines model for RoleBindingCreateRequest.
+type RoleBindingCreateRequest struct {
+	AllowedEnvironments []RoleBindingCreateRequestAllowedEnvironments `json:"allowed_environments,omitempty,omitzero"`
+	RoleId              string                                        `json:"role_id"`
+	ScopeId             string                                        `json:"scope_id,omitempty,omitzero"`
+	ScopeType           RoleBindingScopeType                          `json:"scope_type,omitempty,omitzero"`
+	UserId              string                                        `json:"user_id"`
+}
+
+// RoleBindingCreateRequestAllowedEnvironments defines model for RoleBindingCreateRequest.AllowedEnvironments.
+type RoleBindingCreateRequestAllowedEnvironments string
+
+// RoleBindingList defines model for RoleBindingList.
+type RoleBindingList struct {
+	Items      []GlobalRoleBinding `json:"items"`
+	Pagination Pagination          `json:"pagination"`
+}
+
+// RoleBindingScopeType defines model for RoleBindingScopeType.
+type RoleBindingScopeType string
+
+// RoleCloneRequest defines model for RoleCloneRequest.
+type RoleCloneRequest struct {
+	DisplayName string `json:"display_name,omitempty,omitzero"`
+	Name        string `json:"name"`
+}
+
 // RoleCreateRequest defines model for RoleCreateRequest.
 type RoleCreateRequest struct {
 	Description string   `json:"description,omitempty,omitzero"`
@@ -864,14 +1848,103 @@ type RoleUpdateRequest struct {
 	Permissions []string `json:"permissions,omitempty,omitzero"`
 }
 
+// SAMLAssertionForm defines model for SAMLAssertionForm.
+type SAMLAssertionForm struct {
+	RelayState string `json:"RelayState,omitempty,omitzero"`
+
+	// SAMLResponse Base64-encoded SAML 2.0 Response
+	SAMLResponse string `json:"SAMLResponse"`
+}
+
+// SMTPSettings SMTP relay used for email notifications on ticket created, approved and
+// rejected events. Email is disabled until host and from_address are set.
+// The password is write-only.
+type SMTPSettings struct {
+	FromAddress string `json:"from_address"`
+	Host        string `json:"host"`
+	PasswordSet bool   `json:"password_set"`
+	Port        int    `json:"port"`
+	Username    string `json:"username"`
+}
+
+// SMTPSettingsPatch defines model for SMTPSettingsPatch.
+type SMTPSettingsPatch struct {
+	// FromAddress Sender address; an empty value disables email delivery
+	FromAddress *string `json:"from_address,omitempty"`
+	Host        *string `json:"host,omitempty"`
+	Password    *string `json:"password,omitempty"`
+	Port        *int    `json:"port,omitempty"`
+	Username    *string `json:"username,omitempty"`
+}
+
+// ScheduledBatchJob defines model for ScheduledBatchJob.
+type ScheduledBatchJob struct {
+	CreatedAt      time.Time `json:"created_at"`
+	CreatedBy      string    `json:"created_by"`
+	CronExpression string    `json:"cron_expression"`
+	Enabled        bool      `json:"enabled"`
+	Id             string    `json:"id"`
+
+	// LastBatchId Batch submitted by the most recent run
+	LastBatchId string `json:"last_batch_id,omitempty,omitzero"`
+
+	// LastError Submission error from the most recent run, if any
+	LastError string             `json:"last_error,omitempty,omitzero"`
+	LastRunAt *time.Time         `json:"last_run_at,omitempty"`
+	NextRunAt *time.Time         `json:"next_run_at,omitempty"`
+	Operation VMBatchPowerAction `json:"operation"`
+	Reason    string             `json:"reason,omitempty,omitzero"`
+	UpdatedAt time.Time          `json:"updated_at"`
+	VmIds     []string           `json:"vm_ids"`
+}
+
+// ScheduledBatchJobCreateRequest defines model for ScheduledBatchJobCreateRequest.
+type ScheduledBatchJobCreateRequest struct {
+	// CronExpression Standard 5-field cron expression (UTC), e.g. `0 20 * * 1-5`
+	CronExpression string             `json:"cron_expression"`
+	Enabled        *bool              `json:"enabled,omitempty"`
+	Operation      VMBatchPowerAction `json:"operation"`
+	Reason         string             `json:"reason,omitempty,omitzero"`
+	VmIds          []string           `json:"vm_ids"`
+}
+
+// ScheduledBatchJobList defines model for ScheduledBatchJobList.
+type ScheduledBatchJobList struct {
+	Items      []ScheduledBatchJob `json:"items"`
+	Pagination Pagination          `json:"pagination"`
+}
+
+// ScheduledBatchJobUpdateRequest defines model for ScheduledBatchJobUpdateRequest.
+type ScheduledBatchJobUpdateRequest struct {
+	CronExpression string             `json:"cron_expression,omitempty,omitzero"`
+	Enabled        *bool              `json:"enabled,omitempty"`
+	Operation      VMBatchPowerAction `json:"operation,omitempty,omitzero"`
+	Reason         *string            `json:"reason,omitempty"`
+	VmIds          []string           `json:"vm_ids,omitempty,omitzero"`
+}
+
+// ScopePermissions defines model for ScopePermissions.
+type ScopePermissions struct {
+	Permissions []string             `json:"permissions"`
+	Roles       []string             `json:"roles"`
+	ScopeId     string               `json:"scope_id,omitempty,omitzero"`
+	ScopeType   RoleBindingScopeType `json:"scope_type"`
+}
+
 // Service defines model for Service.
 type Service struct {
-	CreatedAt         time.Time `json:"created_at"`
-	Description       string    `json:"description,omitempty,omitzero"`
-	Id                string    `json:"id"`
-	Name              string    `json:"name"`
-	NextInstanceIndex int       `json:"next_instance_index,omitempty,omitzero"`
-	SystemId          string    `json:"system_id"`
+	CreatedAt   time.Time `json:"created_at"`
+	Description string    `json:"description,omitempty,omitzero"`
+	Id          string    `json:"id"`
+
+	// Labels User-defined metadata; keys match `^[a-z][a-z0-9_.-]{0,62}$`
+	Labels Labels `json:"labels,omitempty,omitzero"`
+
+	// Maintainers User IDs holding the maintainer role on this service
+	Maintainers       []string `json:"maintainers,omitempty,omitzero"`
+	Name              string   `json:"name"`
+	NextInstanceIndex int      `json:"next_instance_index,omitempty,omitzero"`
+	SystemId          string   `json:"system_id"`
 }
 
 // ServiceCreateRequest defines model for ServiceCreateRequest.
@@ -886,17 +1959,97 @@ type ServiceList struct {
 	Pagination Pagination `json:"pagination,omitempty,omitzero"`
 }
 
+// ServiceReindexResponse defines model for ServiceReindexResponse.
+type ServiceReindexResponse struct {
+	// MaxInstanceIndex Highest numeric instance among the service's VMs, 0 if none
+	MaxInstanceIndex          int    `json:"max_instance_index"`
+	NextInstanceIndex         int    `json:"next_instance_index"`
+	PreviousNextInstanceIndex int    `json:"previous_next_instance_index"`
+	ServiceId                 string `json:"service_id"`
+}
+
+// ServiceRoleBinding defines model for ServiceRoleBinding.
+type ServiceRoleBinding struct {
+	CreatedAt time.Time              `json:"created_at"`
+	CreatedBy string                 `json:"created_by,omitempty,omitzero"`
+	Id        string                 `json:"id"`
+	Role      ServiceRoleBindingRole `json:"role"`
+	ServiceId string                 `json:"service_id"`
+	UserId    string                 `json:"user_id"`
+}
+
+// ServiceRoleBindingRole defines model for ServiceRoleBinding.Role.
+type ServiceRoleBindingRole string
+
+// ServiceRoleBindingCreateRequest defines model for ServiceRoleBindingCreateRequest.
+type ServiceRoleBindingCreateRequest struct {
+	Role   ServiceRoleBindingCreateRequestRole `json:"role"`
+	UserId string                              `json:"user_id"`
+}
+
+// ServiceRoleBindingCreateRequestRole defines model for ServiceRoleBindingCreateRequest.Role.
+type ServiceRoleBindingCreateRequestRole string
+
+// ServiceTopology defines model for ServiceTopology.
+type ServiceTopology struct {
+	Service Service `json:"service"`
+
+	// VmCount Total VMs in the service, including any omitted from vms
+	VmCount int  `json:"vm_count"`
+	Vms     []VM `json:"vms"`
+}
+
 // ServiceUpdateRequest defines model for ServiceUpdateRequest.
 type ServiceUpdateRequest struct {
 	Description string `json:"description"`
 }
 
+// ServiceUsage defines model for ServiceUsage.
+type ServiceUsage struct {
+	ServiceId          string  `json:"service_id"`
+	ServiceName        string  `json:"service_name"`
+	TotalCpuHours      float64 `json:"total_cpu_hours"`
+	TotalDiskGbHours   float64 `json:"total_disk_gb_hours"`
+	TotalMemoryGbHours float64 `json:"total_memory_gb_hours"`
+
+	// VmCount VMs that existed for part of the window
+	VmCount int `json:"vm_count"`
+}
+
+// SessionRevocationResult defines model for SessionRevocationResult.
+type SessionRevocationResult struct {
+	// Revoked Number of sessions revoked
+	Revoked int `json:"revoked"`
+}
+
+// SpecOverridePath defines model for SpecOverridePath.
+type SpecOverridePath struct {
+	Path string `json:"path"`
+
+	// Type JSON type the path accepts; paths beneath it accept any type
+	Type SpecOverridePathType `json:"type"`
+}
+
+// SpecOverridePathType JSON type the path accepts; paths beneath it accept any type
+type SpecOverridePathType string
+
+// SpecOverridePolicy defines model for SpecOverridePolicy.
+type SpecOverridePolicy struct {
+	// AllowedPaths Sorted by path
+	AllowedPaths []SpecOverridePath `json:"allowed_paths"`
+	UpdatedAt    *time.Time         `json:"updated_at,omitempty"`
+	UpdatedBy    string             `json:"updated_by,omitempty,omitzero"`
+}
+
 // System defines model for System.
 type System struct {
 	CreatedAt   time.Time `json:"created_at"`
 	CreatedBy   string    `json:"created_by"`
 	Description string    `json:"description,omitempty,omitzero"`
 	Id          string    `json:"id"`
+
+	// Maintainers User IDs holding the maintainer role on this system
+	Maintainers []string  `json:"maintainers,omitempty,omitzero"`
 	Name        string    `json:"name"`
 	TenantId    string    `json:"tenant_id,omitempty,omitzero"`
 	UpdatedAt   time.Time `json:"updated_at,omitempty,omitzero"`
@@ -951,33 +2104,73 @@ type SystemMemberRoleUpdateRequest struct {
 // SystemMemberRoleUpdateRequestRole defines model for SystemMemberRoleUpdateRequest.Role.
 type SystemMemberRoleUpdateRequestRole string
 
+// SystemTopology defines model for SystemTopology.
+type SystemTopology struct {
+	Services []ServiceTopology `json:"services"`
+	System   System            `json:"system"`
+
+	// Truncated True when VMs were omitted to stay within the 1000 VM limit
+	Truncated bool `json:"truncated"`
+
+	// VmCount Total VMs across the returned services
+	VmCount int `json:"vm_count"`
+}
+
 // SystemUpdateRequest defines model for SystemUpdateRequest.
 type SystemUpdateRequest struct {
 	Description string `json:"description"`
 }
 
+// SystemUsageReport defines model for SystemUsageReport.
+type SystemUsageReport struct {
+	From time.Time `json:"from"`
+
+	// Services One entry per visible service, ordered by name
+	Services []ServiceUsage `json:"services"`
+	SystemId string         `json:"system_id"`
+	To       time.Time      `json:"to"`
+}
+
 // Template defines model for Template.
 type Template struct {
+	// DeprecatedAt Set when the template version is deprecated; unset after promotion
+	DeprecatedAt time.Time `json:"deprecated_at,omitempty,omitzero"`
+	Description  string    `json:"description,omitempty,omitzero"`
+	DisplayName  string    `json:"display_name,omitempty,omitzero"`
+	Enabled      bool      `json:"enabled,omitempty,omitzero"`
+	Id           string    `json:"id"`
+	Name         string    `json:"name"`
+	OsFamily     string    `json:"os_family,omitempty,omitzero"`
+	OsVersion    string    `json:"os_version,omitempty,omitzero"`
+
+	// ParentId Parent template whose spec this template inherits and overrides
+	ParentId string `json:"parent_id,omitempty,omitzero"`
+
+	// ResolvedSpec Effective spec after inheritance; only returned by
+	// GET /admin/templates/{template_id} for templates with a parent
+	ResolvedSpec map[string]interface{} `json:"resolved_spec,omitempty,omitzero"`
+
+	// Spec The template's own spec; only returned by GET /admin/templates/{template_id}.
+	// An optional cloud_init_type (nocloud or configdrive) selects the
+	// cloud-init volume VM user_data is delivered through.
+	Spec    map[string]interface{} `json:"spec,omitempty,omitzero"`
+	Version int                    `json:"version"`
+}
+
+// TemplateCreateRequest defines model for TemplateCreateRequest.
+type TemplateCreateRequest struct {
 	Description string `json:"description,omitempty,omitzero"`
 	DisplayName string `json:"display_name,omitempty,omitzero"`
 	Enabled     bool   `json:"enabled,omitempty,omitzero"`
-	Id          string `json:"id"`
 	Name        string `json:"name"`
 	OsFamily    string `json:"os_family,omitempty,omitzero"`
 	OsVersion   string `json:"os_version,omitempty,omitzero"`
-	Version     int    `json:"version"`
-}
 
-// TemplateCreateRequest defines model for TemplateCreateRequest.
-type TemplateCreateRequest struct {
-	Description string                 `json:"description,omitempty,omitzero"`
-	DisplayName string                 `json:"display_name,omitempty,omitzero"`
-	Enabled     bool                   `json:"enabled,omitempty,omitzero"`
-	Name        string                 `json:"name"`
-	OsFamily    string                 `json:"os_family,omitempty,omitzero"`
-	OsVersion   string                 `json:"os_version,omitempty,omitzero"`
-	Spec        map[string]interface{} `json:"spec,omitempty,omitzero"`
-	Version     int                    `json:"version,omitempty,omitzero"`
+	// ParentId Inherit from this template. The child spec is deep-merged over the
+	// parent chain (max 5 templates deep, no cycles).
+	ParentId string                 `json:"parent_id,omitempty,omitzero"`
+	Spec     map[string]interface{} `json:"spec,omitempty,omitzero"`
+	Version  int                    `json:"version,omitempty,omitzero"`
 }
 
 // TemplateList defines model for TemplateList.
@@ -996,11 +2189,42 @@ type TemplateUpdateRequest struct {
 	Spec        map[string]interface{} `json:"spec,omitempty,omitzero"`
 }
 
+// TemplateValidationResult defines model for TemplateValidationResult.
+type TemplateValidationResult struct {
+	Valid      bool         `json:"valid"`
+	Violations []FieldError `json:"violations,omitempty,omitzero"`
+}
+
+// TicketComment defines model for TicketComment.
+type TicketComment struct {
+	Author    string    `json:"author"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	Id        string    `json:"id"`
+	TicketId  string    `json:"ticket_id"`
+}
+
+// TicketCommentList defines model for TicketCommentList.
+type TicketCommentList struct {
+	Items []TicketComment `json:"items"`
+}
+
+// TicketCommentRequest defines model for TicketCommentRequest.
+type TicketCommentRequest struct {
+	Body string `json:"body"`
+}
+
 // UnreadCount defines model for UnreadCount.
 type UnreadCount struct {
 	Count int `json:"count"`
 }
 
+// UpdateVMHostnameRequest defines model for UpdateVMHostnameRequest.
+type UpdateVMHostnameRequest struct {
+	// Hostname RFC 1123 hostname, e.g. redis-01.shop.example.com
+	Hostname string `json:"hostname"`
+}
+
 // User defines model for User.
 type User struct {
 	CreatedAt   time.Time `json:"created_at"`
@@ -1055,24 +2279,39 @@ type VM struct {
 	Hostname  string    `json:"hostname,omitempty,omitzero"`
 	Id        string    `json:"id"`
 	Instance  string    `json:"instance,omitempty,omitzero"`
-	Name      string    `json:"name"`
-	Namespace string    `json:"namespace"`
-	ServiceId string    `json:"service_id,omitempty,omitzero"`
-	Status    VMStatus  `json:"status"`
-	TicketId  string    `json:"ticket_id,omitempty,omitzero"`
+
+	// Labels User-defined metadata; keys match `^[a-z][a-z0-9_.-]{0,62}$`
+	Labels    Labels `json:"labels,omitempty,omitzero"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+
+	// Runtime Live instance state; null unless requested with `runtime=true` and reachable
+	Runtime *VMRuntime `json:"runtime"`
+
+	// RuntimeWarning Why runtime details could not be loaded
+	RuntimeWarning string   `json:"runtime_warning,omitempty,omitzero"`
+	ServiceId      string   `json:"service_id,omitempty,omitzero"`
+	Status         VMStatus `json:"status"`
+	TicketId       string   `json:"ticket_id,omitempty,omitzero"`
 }
 
-// VMStatus defines model for VM.Status.
-type VMStatus string
+// VMBatchActionRequest defines model for VMBatchActionRequest.
+type VMBatchActionRequest struct {
+	// TicketIds Restrict the action to these child ticket IDs.
+	TicketIds []string `json:"ticket_ids,omitempty,omitzero"`
+}
 
 // VMBatchActionResponse defines model for VMBatchActionResponse.
 type VMBatchActionResponse struct {
 	AffectedCount int `json:"affected_count"`
 
 	// AffectedTicketIds Child ticket IDs that were actually affected by retry/cancel action.
-	AffectedTicketIds []string            `json:"affected_ticket_ids,omitempty,omitzero"`
-	BatchId           string              `json:"batch_id"`
-	Status            VMBatchParentStatus `json:"status"`
+	AffectedTicketIds []string `json:"affected_ticket_ids,omitempty,omitzero"`
+	BatchId           string   `json:"batch_id"`
+
+	// Skipped Requested ticket IDs that were not acted on, with the reason.
+	Skipped []VMBatchSkippedTicket `json:"skipped,omitempty,omitzero"`
+	Status  VMBatchParentStatus    `json:"status"`
 }
 
 // VMBatchChildItem defines model for VMBatchChildItem.
@@ -1080,13 +2319,19 @@ type VMBatchChildItem struct {
 	// InstanceSizeId Required for CREATE operation
 	InstanceSizeId openapi_types.UUID `json:"instance_size_id,omitempty,omitzero"`
 
-	// Namespace Required for CREATE operation
+	// Namespace Required for CREATE operation unless source_vm_id is set
 	Namespace string `json:"namespace,omitempty,omitzero"`
 	Reason    string `json:"reason,omitempty,omitzero"`
 
 	// ServiceId Required for CREATE operation
 	ServiceId openapi_types.UUID `json:"service_id,omitempty,omitzero"`
 
+	// SourceVmId CREATE only. Clone this stopped VM's root disk instead of booting the template image
+	SourceVmId string `json:"source_vm_id,omitempty,omitzero"`
+
+	// TargetNamespace CREATE only. Namespace for the clone when it differs from the source VM's namespace
+	TargetNamespace string `json:"target_namespace,omitempty,omitzero"`
+
 	// TemplateId Required for CREATE operation
 	TemplateId openapi_types.UUID `json:"template_id,omitempty,omitzero"`
 
@@ -1096,18 +2341,59 @@ type VMBatchChildItem struct {
 
 // VMBatchChildStatus defines model for VMBatchChildStatus.
 type VMBatchChildStatus struct {
-	AttemptCount int                      `json:"attempt_count,omitempty,omitzero"`
-	EventId      string                   `json:"event_id"`
-	LastError    string                   `json:"last_error,omitempty,omitzero"`
-	ResourceId   string                   `json:"resource_id,omitempty,omitzero"`
-	ResourceName string                   `json:"resource_name,omitempty,omitzero"`
-	Status       VMBatchChildStatusStatus `json:"status"`
-	TicketId     string                   `json:"ticket_id"`
+	// AttemptCount Number of times a worker has claimed this child, across retries.
+	AttemptCount int `json:"attempt_count,omitempty,omitzero"`
+
+	// DurationSeconds Latest attempt duration: finished_at - started_at, or elapsed time
+	// so far while the attempt is still running.
+	DurationSeconds int    `json:"duration_seconds,omitempty,omitzero"`
+	EventId         string `json:"event_id"`
+
+	// FinishedAt When the latest attempt reached SUCCESS or FAILED.
+	FinishedAt   time.Time `json:"finished_at,omitempty,omitzero"`
+	LastError    string    `json:"last_error,omitempty,omitzero"`
+	ResourceId   string    `json:"resource_id,omitempty,omitzero"`
+	ResourceName string    `json:"resource_name,omitempty,omitzero"`
+
+	// StartedAt When a worker last claimed this child. Absent if never dispatched.
+	StartedAt time.Time                `json:"started_at,omitempty,omitzero"`
+	Status    VMBatchChildStatusStatus `json:"status"`
+	TicketId  string                   `json:"ticket_id"`
 }
 
 // VMBatchChildStatusStatus defines model for VMBatchChildStatus.Status.
 type VMBatchChildStatusStatus string
 
+// VMBatchLimits defines model for VMBatchLimits.
+type VMBatchLimits struct {
+	// BlockedReason Limit that currently blocks submission; empty when can_submit is true.
+	BlockedReason VMBatchLimitsBlockedReason `json:"blocked_reason,omitempty,omitzero"`
+
+	// CanSubmit Whether a single-item batch submitted now would pass every rate limit.
+	CanSubmit                bool      `json:"can_submit"`
+	CooldownRemainingSeconds int       `json:"cooldown_remaining_seconds"`
+	CooldownSeconds          int       `json:"cooldown_seconds"`
+	Exempted                 bool      `json:"exempted"`
+	ExemptionExpiresAt       time.Time `json:"exemption_expires_at,omitzero"`
+
+	// GlobalPendingParents Only returned to rate-limit managers.
+	GlobalPendingParents *int `json:"global_pending_parents,omitempty"`
+
+	// GlobalRecentSubmits Only returned to rate-limit managers.
+	GlobalRecentSubmits *int `json:"global_recent_submits,omitempty"`
+
+	// GlobalSaturated True when a global limit blocks every non-exempt submitter.
+	GlobalSaturated    bool `json:"global_saturated"`
+	MaxPendingChildren int  `json:"max_pending_children"`
+	MaxPendingParents  int  `json:"max_pending_parents"`
+	PendingChildren    int  `json:"pending_children"`
+	PendingParents     int  `json:"pending_parents"`
+	RetryAfterSeconds  int  `json:"retry_after_seconds,omitempty,omitzero"`
+}
+
+// VMBatchLimitsBlockedReason Limit that currently blocks submission; empty when can_submit is true.
+type VMBatchLimitsBlockedReason string
+
 // VMBatchOperation defines model for VMBatchOperation.
 type VMBatchOperation string
 
@@ -1131,21 +2417,43 @@ type VMBatchPowerRequest struct {
 
 	// RequestId Client idempotency key
 	RequestId string `json:"request_id,omitempty,omitzero"`
+
+	// SkipInvalid Skip items whose VM status does not allow the operation instead of
+	// rejecting the whole request. At least one item must remain valid.
+	SkipInvalid bool `json:"skip_invalid,omitempty,omitzero"`
+}
+
+// VMBatchSkippedTicket defines model for VMBatchSkippedTicket.
+type VMBatchSkippedTicket struct {
+	Reason VMBatchSkippedTicketReason `json:"reason"`
+
+	// Status Current child ticket status when reason is INVALID_STATE.
+	Status   string `json:"status,omitempty,omitzero"`
+	TicketId string `json:"ticket_id"`
 }
 
+// VMBatchSkippedTicketReason defines model for VMBatchSkippedTicket.Reason.
+type VMBatchSkippedTicketReason string
+
 // VMBatchStatusResponse defines model for VMBatchStatusResponse.
 type VMBatchStatusResponse struct {
-	BatchId      string               `json:"batch_id"`
-	ChildCount   int                  `json:"child_count"`
-	Children     []VMBatchChildStatus `json:"children"`
-	CreatedAt    time.Time            `json:"created_at"`
-	CreatedBy    string               `json:"created_by"`
-	FailedCount  int                  `json:"failed_count"`
-	Operation    VMBatchOperation     `json:"operation"`
-	PendingCount int                  `json:"pending_count"`
-	Status       VMBatchParentStatus  `json:"status"`
-	SuccessCount int                  `json:"success_count"`
-	UpdatedAt    time.Time            `json:"updated_at"`
+	BatchId    string               `json:"batch_id"`
+	ChildCount int                  `json:"child_count"`
+	Children   []VMBatchChildStatus `json:"children"`
+
+	// CompletedAt Latest child finished_at, set once no child is pending or executing.
+	CompletedAt  time.Time        `json:"completed_at,omitempty,omitzero"`
+	CreatedAt    time.Time        `json:"created_at"`
+	CreatedBy    string           `json:"created_by"`
+	FailedCount  int              `json:"failed_count"`
+	Operation    VMBatchOperation `json:"operation"`
+	PendingCount int              `json:"pending_count"`
+
+	// StartedAt Earliest child started_at. Absent until a child is dispatched.
+	StartedAt    time.Time           `json:"started_at,omitempty,omitzero"`
+	Status       VMBatchParentStatus `json:"status"`
+	SuccessCount int                 `json:"success_count"`
+	UpdatedAt    time.Time           `json:"updated_at"`
 }
 
 // VMBatchSubmitRequest defines model for VMBatchSubmitRequest.
@@ -1166,24 +2474,59 @@ type VMBatchSubmitResponse struct {
 	StatusUrl         string              `json:"status_url"`
 }
 
+// VMBatchType defines model for VMBatchType.
+type VMBatchType string
+
+// VMConsoleAccessRequest defines model for VMConsoleAccessRequest.
+type VMConsoleAccessRequest struct {
+	// DurationMinutes Requested console access window once approved. Omitted or longer
+	// requests get the configured maximum (approval.vnc_max_access_duration,
+	// default 4h).
+	DurationMinutes *int `json:"duration_minutes,omitempty"`
+}
+
 // VMConsoleRequestResponse defines model for VMConsoleRequestResponse.
 type VMConsoleRequestResponse struct {
-	Status   VMConsoleRequestStatus `json:"status"`
-	TicketId string                 `json:"ticket_id,omitzero"`
-	VncUrl   string                 `json:"vnc_url,omitzero"`
+	// SessionId Console session ID for the issued VNC credential; used to revoke it.
+	SessionId string                 `json:"session_id,omitzero"`
+	Status    VMConsoleRequestStatus `json:"status"`
+	TicketId  string                 `json:"ticket_id,omitzero"`
+	VncUrl    string                 `json:"vnc_url,omitzero"`
 }
 
 // VMConsoleRequestStatus defines model for VMConsoleRequestStatus.
 type VMConsoleRequestStatus string
 
+// VMConsoleSession defines model for VMConsoleSession.
+type VMConsoleSession struct {
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	SessionId string    `json:"session_id"`
+	UserId    string    `json:"user_id"`
+	VmId      string    `json:"vm_id"`
+}
+
+// VMConsoleSessionList defines model for VMConsoleSessionList.
+type VMConsoleSessionList struct {
+	Items []VMConsoleSession `json:"items"`
+}
+
 // VMConsoleStatus defines model for VMConsoleStatus.
 type VMConsoleStatus string
 
 // VMConsoleStatusResponse defines model for VMConsoleStatusResponse.
 type VMConsoleStatusResponse struct {
-	Status   VMConsoleStatus `json:"status"`
-	TicketId string          `json:"ticket_id,omitzero"`
-	VncUrl   string          `json:"vnc_url,omitzero"`
+	// ExpiresAt End of the approved access window (approval-gated access only)
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// RemainingSeconds Seconds left in the approved access window, for a countdown
+	RemainingSeconds *int `json:"remaining_seconds,omitempty"`
+
+	// SessionId Console session ID for the issued VNC credential; used to revoke it.
+	SessionId string          `json:"session_id,omitzero"`
+	Status    VMConsoleStatus `json:"status"`
+	TicketId  string          `json:"ticket_id,omitzero"`
+	VncUrl    string          `json:"vnc_url,omitzero"`
 }
 
 // VMCreateRequest defines model for VMCreateRequest.
@@ -1191,18 +2534,105 @@ type VMCreateRequest struct {
 	InstanceSizeId openapi_types.UUID `json:"instance_size_id"`
 
 	// Namespace Target K8s namespace (immutable after submission, ADR-0017)
-	Namespace  string             `json:"namespace"`
-	Reason     string             `json:"reason"`
-	ServiceId  openapi_types.UUID `json:"service_id"`
-	TemplateId openapi_types.UUID `json:"template_id"`
+	Namespace string `json:"namespace"`
+	Reason    string `json:"reason"`
+
+	// RequestId Client idempotency key, scoped to the requester. Resubmitting
+	// while the original ticket is still open (PENDING, APPROVED or
+	// EXECUTING) returns that ticket with HTTP 200; concurrent
+	// submissions with one key create a single ticket. Once the ticket
+	// is closed the key may be reused.
+	RequestId string             `json:"request_id,omitempty,omitzero"`
+	ServiceId openapi_types.UUID `json:"service_id"`
+
+	// SourceVmId Clone a stopped VM instead of booting the template image. The new
+	// VM's root disk is a copy of the source VM's root disk PVC and is
+	// created on the source VM's cluster. `namespace` must equal the
+	// source VM's namespace unless `target_namespace` is set.
+	SourceVmId string `json:"source_vm_id,omitempty,omitzero"`
+
+	// TargetNamespace Namespace for a cloned VM when it differs from the source VM's
+	// namespace. Requires visibility into that namespace. Only valid
+	// with source_vm_id.
+	TargetNamespace string             `json:"target_namespace,omitempty,omitzero"`
+	TemplateId      openapi_types.UUID `json:"template_id"`
+
+	// UserData Base64-encoded cloud-init user-data (hostname, SSH keys, bootstrap
+	// scripts). Served to the guest through the template's
+	// cloud_init_type datasource (nocloud by default). Rejected with
+	// USER_DATA_DISABLED unless the platform config allows user data.
+	UserData string `json:"user_data,omitempty,omitzero"`
+}
+
+// VMEvent defines model for VMEvent.
+type VMEvent struct {
+	// BatchId Parent batch of a batch child event
+	BatchId   string    `json:"batch_id,omitempty,omitzero"`
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy string    `json:"created_by"`
+	EventType string    `json:"event_type"`
+	Id        string    `json:"id"`
+
+	// Payload Event payload with sensitive fields replaced by "[REDACTED]"
+	Payload map[string]interface{} `json:"payload"`
+
+	// Status PENDING, PROCESSING, COMPLETED, FAILED or CANCELLED
+	Status string `json:"status"`
+}
+
+// VMEventList defines model for VMEventList.
+type VMEventList struct {
+	Items      []VMEvent  `json:"items"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// VMGuestOSInfo Reported by the QEMU guest agent
+type VMGuestOSInfo struct {
+	KernelRelease string `json:"kernel_release,omitempty,omitzero"`
+	Name          string `json:"name,omitempty,omitzero"`
+	PrettyName    string `json:"pretty_name,omitempty,omitzero"`
+	Version       string `json:"version,omitempty,omitzero"`
+}
+
+// VMHostnameUpdateResponse defines model for VMHostnameUpdateResponse.
+type VMHostnameUpdateResponse struct {
+	EventId string `json:"event_id"`
+
+	// Status Always ACCEPTED; follow event_id for the outcome
+	Status string `json:"status"`
 }
 
 // VMList defines model for VMList.
 type VMList struct {
-	Items      []VM       `json:"items,omitempty,omitzero"`
+	// Filters Filters applied to a VM list, echoed back for rendering
+	Filters VMListFilters `json:"filters,omitempty,omitzero"`
+	Items   []VM          `json:"items,omitempty,omitzero"`
+
+	// NextCursor Pass as cursor to fetch the following VMs; absent on the last page or unless ordered by created_at
+	NextCursor string     `json:"next_cursor,omitempty,omitzero"`
 	Pagination Pagination `json:"pagination,omitempty,omitzero"`
 }
 
+// VMListFilters Filters applied to a VM list, echoed back for rendering
+type VMListFilters struct {
+	ClusterId     string                 `json:"cluster_id,omitempty,omitzero"`
+	CreatedBy     string                 `json:"created_by,omitempty,omitzero"`
+	LabelSelector string                 `json:"label_selector,omitempty,omitzero"`
+	NamePrefix    string                 `json:"name_prefix,omitempty,omitzero"`
+	Namespace     string                 `json:"namespace,omitempty,omitzero"`
+	OrderBy       VMListOrderBy          `json:"order_by"`
+	ServiceId     string                 `json:"service_id,omitempty,omitzero"`
+	SortOrder     VMListFiltersSortOrder `json:"sort_order"`
+	Status        []VMStatus             `json:"status,omitempty,omitzero"`
+	SystemId      string                 `json:"system_id,omitempty,omitzero"`
+}
+
+// VMListFiltersSortOrder defines model for VMListFilters.SortOrder.
+type VMListFiltersSortOrder string
+
+// VMListOrderBy defines model for VMListOrderBy.
+type VMListOrderBy string
+
 // VMRequestContext defines model for VMRequestContext.
 type VMRequestContext struct {
 	InstanceSizes []InstanceSize `json:"instance_sizes"`
@@ -1210,29 +2640,228 @@ type VMRequestContext struct {
 	Templates     []Template     `json:"templates"`
 }
 
-// VMVNCSessionResponse defines model for VMVNCSessionResponse.
-type VMVNCSessionResponse struct {
-	Status VMVNCSessionResponseStatus `json:"status"`
-	VmId   string                     `json:"vm_id"`
-
-	// WebsocketPath Relative websocket/proxy path for noVNC bootstrap.
-	WebsocketPath string `json:"websocket_path,omitempty,omitzero"`
+// VMResizeSummary defines model for VMResizeSummary.
+type VMResizeSummary struct {
+	From VMSize `json:"from"`
+	To   VMSize `json:"to"`
 }
 
-// VMVNCSessionResponseStatus defines model for VMVNCSessionResponse.Status.
-type VMVNCSessionResponseStatus string
+// VMRuntime defines model for VMRuntime.
+type VMRuntime struct {
+	GuestAgentConnected bool `json:"guest_agent_connected"`
 
-// BatchID defines model for BatchID.
-type BatchID = string
+	// GuestOs Reported by the QEMU guest agent
+	GuestOs    VMGuestOSInfo        `json:"guest_os,omitempty,omitzero"`
+	Interfaces []VMRuntimeInterface `json:"interfaces"`
+	LaunchedAt *time.Time           `json:"launched_at,omitempty"`
+	NodeName   string               `json:"node_name,omitempty,omitzero"`
 
-// Confirm defines model for Confirm.
-type Confirm = bool
+	// Phase VirtualMachineInstance phase (e.g. Running, Scheduling)
+	Phase string `json:"phase"`
+}
 
-// ConfirmName defines model for ConfirmName.
-type ConfirmName = string
+// VMRuntimeInterface defines model for VMRuntimeInterface.
+type VMRuntimeInterface struct {
+	IpAddresses []string `json:"ip_addresses"`
+	Mac         string   `json:"mac,omitempty,omitzero"`
+	Name        string   `json:"name"`
+}
 
-// InstanceSizeID defines model for InstanceSizeID.
-type InstanceSizeID = string
+// VMSize defines model for VMSize.
+type VMSize struct {
+	Cpu              int    `json:"cpu"`
+	DiskGb           int    `json:"disk_gb,omitempty,omitzero"`
+	InstanceSizeId   string `json:"instance_size_id,omitempty,omitzero"`
+	InstanceSizeName string `json:"instance_size_name,omitempty,omitzero"`
+	MemoryMb         int    `json:"memory_mb"`
+}
+
+// VMSnapshot defines model for VMSnapshot.
+type VMSnapshot struct {
+	CreatedAt time.Time `json:"created_at"`
+	Error     string    `json:"error,omitempty,omitzero"`
+	Name      string    `json:"name"`
+	Phase     string    `json:"phase,omitempty,omitzero"`
+	Ready     bool      `json:"ready"`
+}
+
+// VMSnapshotList defines model for VMSnapshotList.
+type VMSnapshotList struct {
+	Items []VMSnapshot `json:"items"`
+}
+
+// VMSnapshotOperationResponse defines model for VMSnapshotOperationResponse.
+type VMSnapshotOperationResponse struct {
+	EventId      string                            `json:"event_id"`
+	SnapshotName string                            `json:"snapshot_name"`
+	Status       VMSnapshotOperationResponseStatus `json:"status"`
+
+	// TicketId Set when the snapshot waits for approval
+	TicketId string `json:"ticket_id,omitempty,omitzero"`
+}
+
+// VMSnapshotOperationResponseStatus defines model for VMSnapshotOperationResponse.Status.
+type VMSnapshotOperationResponseStatus string
+
+// VMSpecFieldSource defines model for VMSpecFieldSource.
+type VMSpecFieldSource struct {
+	// Field name, cpu, memory_mb, disk_gb, image, clone_source, user_data,
+	// cloud_init_type, or spec_overrides.<path>
+	Field string `json:"field"`
+
+	// Source override is the approver's modified_spec
+	Source VMSpecFieldSourceSource `json:"source"`
+}
+
+// VMSpecFieldSourceSource override is the approver's modified_spec
+type VMSpecFieldSourceSource string
+
+// VMStatus defines model for VMStatus.
+type VMStatus string
+
+// VMVNCSessionResponse defines model for VMVNCSessionResponse.
+type VMVNCSessionResponse struct {
+	SessionId string                     `json:"session_id,omitempty,omitzero"`
+	Status    VMVNCSessionResponseStatus `json:"status"`
+	VmId      string                     `json:"vm_id"`
+
+	// WebsocketPath Relative WebSocket proxy path, including the session token, for noVNC bootstrap.
+	WebsocketPath string `json:"websocket_path,omitempty,omitzero"`
+}
+
+// VMVNCSessionResponseStatus defines model for VMVNCSessionResponse.Status.
+type VMVNCSessionResponseStatus string
+
+// WebhookCreateRequest defines model for WebhookCreateRequest.
+type WebhookCreateRequest struct {
+	Enabled    *bool              `json:"enabled,omitempty"`
+	EventTypes []WebhookEventType `json:"event_types"`
+
+	// Secret HMAC-SHA256 signing key (write-only)
+	Secret string `json:"secret"`
+
+	// Url Absolute http(s) URL receiving POSTed events
+	Url string `json:"url"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempt   int       `json:"attempt"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// DeliveryId Stable across retries of the same event (X-Shepherd-Delivery)
+	DeliveryId string `json:"delivery_id"`
+	DurationMs int64  `json:"duration_ms"`
+	EndpointId string `json:"endpoint_id"`
+	Error      string `json:"error,omitempty,omitzero"`
+	EventType  string `json:"event_type"`
+	Id         string `json:"id"`
+
+	// StatusCode HTTP status returned by the receiver; 0 on transport errors
+	StatusCode int  `json:"status_code,omitempty,omitzero"`
+	Success    bool `json:"success"`
+}
+
+// WebhookDeliveryList defines model for WebhookDeliveryList.
+type WebhookDeliveryList struct {
+	Items      []WebhookDelivery `json:"items"`
+	Pagination Pagination        `json:"pagination"`
+}
+
+// WebhookEndpoint defines model for WebhookEndpoint.
+type WebhookEndpoint struct {
+	CreatedAt  time.Time          `json:"created_at"`
+	CreatedBy  string             `json:"created_by"`
+	Enabled    bool               `json:"enabled"`
+	EventTypes []WebhookEventType `json:"event_types"`
+	Id         string             `json:"id"`
+	UpdatedAt  time.Time          `json:"updated_at"`
+	Url        string             `json:"url"`
+}
+
+// WebhookEndpointList defines model for WebhookEndpointList.
+type WebhookEndpointList struct {
+	Items      []WebhookEndpoint `json:"items"`
+	Pagination Pagination        `json:"pagination"`
+}
+
+// WebhookEventType defines model for WebhookEventType.
+type WebhookEventType string
+
+// WebhookTestResult defines model for WebhookTestResult.
+type WebhookTestResult struct {
+	DeliveryId string `json:"delivery_id"`
+	DurationMs int64  `json:"duration_ms"`
+	Error      string `json:"error,omitempty,omitzero"`
+
+	// StatusCode HTTP status returned by the receiver; 0 on transport errors
+	StatusCode int  `json:"status_code"`
+	Success    bool `json:"success"`
+}
+
+// WebhookUpdateRequest defines model for WebhookUpdateRequest.
+type WebhookUpdateRequest struct {
+	Enabled    *bool              `json:"enabled,omitempty"`
+	EventTypes []WebhookEventType `json:"event_types,omitempty,omitzero"`
+
+	// Secret Rotates the signing key when set
+	Secret string `json:"secret,omitempty,omitzero"`
+	Url    string `json:"url,omitempty,omitzero"`
+}
+
+// BatchCreatedFrom defines model for BatchCreatedFrom.
+type BatchCreatedFrom = time.Time
+
+// BatchCreatedTo defines model for BatchCreatedTo.
+type BatchCreatedTo = time.Time
+
+// BatchID defines model for BatchID.
+type BatchID = string
+
+// BatchStatusFilter defines model for BatchStatusFilter.
+type BatchStatusFilter = VMBatchParentStatus
+
+// BatchTypeFilter defines model for BatchTypeFilter.
+type BatchTypeFilter = VMBatchType
+
+// CommentID defines model for CommentID.
+type CommentID = string
+
+// Confirm defines model for Confirm.
+type Confirm = bool
+
+// ConfirmName defines model for ConfirmName.
+type ConfirmName = string
+
+// ConsoleSessionID defines model for ConsoleSessionID.
+type ConsoleSessionID = string
+
+// DeleteCascade defines model for DeleteCascade.
+type DeleteCascade string
+
+// Force defines model for Force.
+type Force = bool
+
+// IncludeDeprecated defines model for IncludeDeprecated.
+type IncludeDeprecated = bool
+
+// InstanceSizeDedicatedCPU defines model for InstanceSizeDedicatedCPU.
+type InstanceSizeDedicatedCPU = bool
+
+// InstanceSizeID defines model for InstanceSizeID.
+type InstanceSizeID = string
+
+// InstanceSizeRequiresGPU defines model for InstanceSizeRequiresGPU.
+type InstanceSizeRequiresGPU = bool
+
+// InstanceSizeRequiresSRIOV defines model for InstanceSizeRequiresSRIOV.
+type InstanceSizeRequiresSRIOV = bool
+
+// InstanceSizeSearch defines model for InstanceSizeSearch.
+type InstanceSizeSearch = string
+
+// LoginSessionID defines model for LoginSessionID.
+type LoginSessionID = string
 
 // MappingID defines model for MappingID.
 type MappingID = string
@@ -1252,15 +2881,27 @@ type PerPage = int
 // ProviderID defines model for ProviderID.
 type ProviderID = string
 
+// QuotaID defines model for QuotaID.
+type QuotaID = string
+
+// RequestID defines model for RequestID.
+type RequestID = string
+
 // RoleBindingID defines model for RoleBindingID.
 type RoleBindingID = string
 
 // RoleID defines model for RoleID.
 type RoleID = string
 
+// ScheduledJobID defines model for ScheduledJobID.
+type ScheduledJobID = string
+
 // ServiceID defines model for ServiceID.
 type ServiceID = string
 
+// SnapshotName defines model for SnapshotName.
+type SnapshotName = string
+
 // SortBy defines model for SortBy.
 type SortBy = string
 
@@ -1282,6 +2923,12 @@ type UserID = string
 // VMID defines model for VMID.
 type VMID = string
 
+// ValidateOnly defines model for ValidateOnly.
+type ValidateOnly = bool
+
+// WebhookID defines model for WebhookID.
+type WebhookID = string
+
 // BadRequest defines model for BadRequest.
 type BadRequest = Error
 
@@ -1297,6 +2944,52 @@ type NotFound = Error
 // Unauthorized defines model for Unauthorized.
 type Unauthorized = Error
 
+// ListAdminAuditLogsParams defines parameters for ListAdminAuditLogs.
+type ListAdminAuditLogsParams struct {
+	// Page Page number (1-indexed)
+	Page Page `form:"page,omitempty" json:"page,omitempty,omitzero"`
+
+	// PerPage Items per page
+	PerPage      PerPage `form:"per_page,omitempty" json:"per_page,omitempty,omitzero"`
+	Action       string  `form:"action,omitempty" json:"action,omitempty,omitzero"`
+	Actor        string  `form:"actor,omitempty" json:"actor,omitempty,omitzero"`
+	ResourceType string  `form:"resource_type,omitempty" json:"resource_type,omitempty,omitzero"`
+	ResourceId   string  `form:"resource_id,omitempty" json:"resource_id,omitempty,omitzero"`
+
+	// From Only records created at or after this time
+	From time.Time `form:"from,omitempty" json:"from,omitempty,omitzero"`
+
+	// To Only records created before this time
+	To time.Time `form:"to,omitempty" json:"to,omitempty,omitzero"`
+}
+
+// ImportAuthProviderUsersJSONBody defines parameters for ImportAuthProviderUsers.
+type ImportAuthProviderUsersJSONBody = []AuthProviderUserImport
+
+// ListAdminBatchesParams defines parameters for ListAdminBatches.
+type ListAdminBatchesParams struct {
+	// Status Only batches in this status
+	Status BatchStatusFilter `form:"status,omitempty" json:"status,omitempty,omitzero"`
+
+	// BatchType Only batches of this type
+	BatchType BatchTypeFilter `form:"batch_type,omitempty" json:"batch_type,omitempty,omitzero"`
+
+	// CreatedBy Only batches submitted by this user ID
+	CreatedBy string `form:"created_by,omitempty" json:"created_by,omitempty,omitzero"`
+
+	// CreatedFrom Only batches created at or after this time
+	CreatedFrom BatchCreatedFrom `form:"created_from,omitempty" json:"created_from,omitempty,omitzero"`
+
+	// CreatedTo Only batches created before this time
+	CreatedTo BatchCreatedTo `form:"created_to,omitempty" json:"created_to,omitempty,omitzero"`
+
+	// Page Page number (1-indexed)
+	Page Page `form:"page,omitempty" json:"page,omitempty,omitzero"`
+
+	// PerPage Items per page
+	PerPage PerPage `form:"per_page,omitempty" json:"per_page,omitempty,omitzero"`
+}
+
 // ListClustersParams defines parameters for ListClusters.
 type ListClustersParams struct {
 	// Page Page number (1-indexed)
@@ -1306,6 +2999,31 @@ type ListClustersParams struct {
 	PerPage PerPage `form:"per_page,omitempty" json:"per_page,omitempty,omitzero"`
 }
 
+// ReplayDomainEventParams defines parameters for ReplayDomainEvent.
+type ReplayDomainEventParams struct {
+	// DryRun Describe the job that would be enqueued without enqueuing it
+	DryRun bool `form:"dry_run,omitempty" json:"dry_run,omitempty,omitzero"`
+}
+
+// ListAdminInstanceSizesParams defines parameters for ListAdminInstanceSizes.
+type ListAdminInstanceSizesParams struct {
+	// Page Page number (1-indexed)
+	Page Page `form:"page,omitempty" json:"page,omitempty,omitzero"`
+
+	// PerPage Items per page
+	PerPage       PerPage                    `form:"per_page,omitempty" json:"per_page,omitempty,omitzero"`
+	Enabled       *bool                      `form:"enabled,omitempty" json:"enabled,omitempty"`
+	RequiresGpu   *InstanceSizeRequiresGPU   `form:"requires_gpu,omitempty" json:"requires_gpu,omitempty"`
+	RequiresSriov *InstanceSizeRequiresSRIOV `form:"requires_sriov,omitempty" json:"requires_sriov,omitempty"`
+	DedicatedCpu  *InstanceSizeDedicatedCPU  `form:"dedicated_cpu,omitempty" json:"dedicated_cpu,omitempty"`
+
+	// Search Case-insensitive substring match on name or display_name
+	Search InstanceSizeSearch `form:"search,omitempty" json:"search,omitempty,omitzero"`
+}
+
+// BulkUpdateAdminInstanceSizesJSONBody defines parameters for BulkUpdateAdminInstanceSizes.
+type BulkUpdateAdminInstanceSizesJSONBody = []InstanceSizeSortUpdate
+
 // ListNamespacesParams defines parameters for ListNamespaces.
 type ListNamespacesParams struct {
 	// Page Page number (1-indexed)
@@ -1321,6 +3039,14 @@ type ListNamespacesParams struct {
 // ListNamespacesParamsEnvironment defines parameters for ListNamespaces.
 type ListNamespacesParamsEnvironment string
 
+// SyncNamespacesParams defines parameters for SyncNamespaces.
+type SyncNamespacesParams struct {
+	ClusterId string `form:"cluster_id" json:"cluster_id"`
+
+	// DisableMissing Disable enabled registry entries whose namespace no longer exists on the cluster
+	DisableMissing bool `form:"disable_missing,omitempty" json:"disable_missing,omitempty,omitzero"`
+}
+
 // DeleteNamespaceParams defines parameters for DeleteNamespace.
 type DeleteNamespaceParams struct {
 	// ConfirmName Type namespace name to confirm deletion (ADR-0015 §13 addendum).
@@ -1328,6 +3054,54 @@ type DeleteNamespaceParams struct {
 	ConfirmName string `form:"confirm_name" json:"confirm_name"`
 }
 
+// ListQuotasParams defines parameters for ListQuotas.
+type ListQuotasParams struct {
+	ScopeType QuotaScopeType `form:"scope_type,omitempty" json:"scope_type,omitempty,omitzero"`
+	ScopeId   string         `form:"scope_id,omitempty" json:"scope_id,omitempty,omitzero"`
+
+	// Page Page number (1-indexed)
+	Page Page `form:"page,omitempty" json:"page,omitempty,omitzero"`
+
+	// PerPage Items per page
+	PerPage PerPage `form:"per_page,omitempty" json:"per_page,omitempty,omitzero"`
+}
+
+// ListRoleBindingsParams defines parameters for ListRoleBindings.
+type ListRoleBindingsParams struct {
+	// UserId Only bindings of this user
+	UserId string `form:"user_id,omitempty" json:"user_id,omitempty,omitzero"`
+
+	// RoleId Only bindings of this role
+	RoleId string `form:"role_id,omitempty" json:"role_id,omitempty,omitzero"`
+
+	// ScopeType Only bindings with this scope type
+	ScopeType RoleBindingScopeType `form:"scope_type,omitempty" json:"scope_type,omitempty,omitzero"`
+
+	// ScopeId Only bindings on this scope target
+	ScopeId string `form:"scope_id,omitempty" json:"scope_id,omitempty,omitzero"`
+
+	// Page Page number (1-indexed)
+	Page Page `form:"page,omitempty" json:"page,omitempty,omitzero"`
+
+	// PerPage Items per page
+	PerPage PerPage `form:"per_page,omitempty" json:"per_page,omitempty,omitzero"`
+}
+
+// ListScheduledJobsParams defines parameters for ListScheduledJobs.
+type ListScheduledJobsParams struct {
+	// Page Page number (1-indexed)
+	Page Page `form:"page,omitempty" json:"page,omitempty,omitzero"`
+
+	// PerPage Items per page
+	PerPage PerPage `form:"per_page,omitempty" json:"per_page,omitempty,omitzero"`
+}
+
+// GetSystemUsageReportParams defines parameters for GetSystemUsageReport.
+type GetSystemUsageReportParams struct {
+	From time.Time `form:"from" json:"from"`
+	To   time.Time `form:"to" json:"to"`
+}
+
 // ListAdminTemplatesParams defines parameters for ListAdminTemplates.
 type ListAdminTemplatesParams struct {
 	// Page Page number (1-indexed)
@@ -1335,6 +3109,27 @@ type ListAdminTemplatesParams struct {
 
 	// PerPage Items per page
 	PerPage PerPage `form:"per_page,omitempty" json:"per_page,omitempty,omitzero"`
+
+	// IncludeDeprecated Include deprecated templates (excluded by default)
+	IncludeDeprecated IncludeDeprecated `form:"include_deprecated,omitempty" json:"include_deprecated,omitempty,omitzero"`
+}
+
+// CreateAdminTemplateParams defines parameters for CreateAdminTemplate.
+type CreateAdminTemplateParams struct {
+	// ValidateOnly Validate the request without persisting it (dry run)
+	ValidateOnly ValidateOnly `form:"validate_only,omitempty" json:"validate_only,omitempty,omitzero"`
+}
+
+// DeleteAdminTemplateParams defines parameters for DeleteAdminTemplate.
+type DeleteAdminTemplateParams struct {
+	// Force Override in-use safety checks (platform:admin only)
+	Force Force `form:"force,omitempty" json:"force,omitempty,omitzero"`
+}
+
+// UpdateAdminTemplateParams defines parameters for UpdateAdminTemplate.
+type UpdateAdminTemplateParams struct {
+	// ValidateOnly Validate the request without persisting it (dry run)
+	ValidateOnly ValidateOnly `form:"validate_only,omitempty" json:"validate_only,omitempty,omitzero"`
 }
 
 // ListUsersParams defines parameters for ListUsers.
@@ -1346,19 +3141,86 @@ type ListUsersParams struct {
 	PerPage PerPage `form:"per_page,omitempty" json:"per_page,omitempty,omitzero"`
 }
 
+// ListWebhooksParams defines parameters for ListWebhooks.
+type ListWebhooksParams struct {
+	// Page Page number (1-indexed)
+	Page Page `form:"page,omitempty" json:"page,omitempty,omitzero"`
+
+	// PerPage Items per page
+	PerPage PerPage `form:"per_page,omitempty" json:"per_page,omitempty,omitzero"`
+}
+
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	// Page Page number (1-indexed)
+	Page Page `form:"page,omitempty" json:"page,omitempty,omitzero"`
+
+	// PerPage Items per page
+	PerPage PerPage `form:"per_page,omitempty" json:"per_page,omitempty,omitzero"`
+}
+
 // ListApprovalsParams defines parameters for ListApprovals.
 type ListApprovalsParams struct {
 	// Page Page number (1-indexed)
 	Page Page `form:"page,omitempty" json:"page,omitempty,omitzero"`
 
 	// PerPage Items per page
-	PerPage PerPage                   `form:"per_page,omitempty" json:"per_page,omitempty,omitzero"`
-	Status  ListApprovalsParamsStatus `form:"status,omitempty" json:"status,omitempty,omitzero"`
+	PerPage PerPage `form:"per_page,omitempty" json:"per_page,omitempty,omitzero"`
+
+	// Status Filter by one or more statuses (repeat the parameter)
+	Status        []ListApprovalsParamsStatus      `form:"status,omitempty" json:"status,omitempty,omitzero"`
+	OperationType ListApprovalsParamsOperationType `form:"operation_type,omitempty" json:"operation_type,omitempty,omitzero"`
+
+	// Requester Filter by requester user ID
+	Requester string `form:"requester,omitempty" json:"requester,omitempty,omitzero"`
+
+	// Approver Filter by a user who approved or rejected the ticket, including partial multi-level approvals
+	Approver string `form:"approver,omitempty" json:"approver,omitempty,omitzero"`
+
+	// ServiceId Only tickets for VMs of this service (requested or existing)
+	ServiceId string `form:"service_id,omitempty" json:"service_id,omitempty,omitzero"`
+
+	// Search Case-insensitive match against reason and reject_reason
+	Search string `form:"search,omitempty" json:"search,omitempty,omitzero"`
+
+	// CreatedAfter Only tickets created at or after this time
+	CreatedAfter time.Time `form:"created_after,omitempty" json:"created_after,omitempty,omitzero"`
+
+	// CreatedBefore Only tickets created before this time
+	CreatedBefore time.Time `form:"created_before,omitempty" json:"created_before,omitempty,omitzero"`
+
+	// ParentOnly Hide batch child tickets (batch parents and standalone tickets remain)
+	ParentOnly bool `form:"parent_only,omitempty" json:"parent_only,omitempty,omitzero"`
+
+	// AssignedToMe Only tickets reassigned to the current user
+	AssignedToMe bool `form:"assigned_to_me,omitempty" json:"assigned_to_me,omitempty,omitzero"`
+
+	// SortBy created_at orders by creation time in sort_order direction.
+	// priority lists PENDING tickets by urgency tier (urgent, warning, normal)
+	// ahead of other tickets, then by creation time.
+	SortBy ListApprovalsParamsSortBy `form:"sort_by,omitempty" json:"sort_by,omitempty,omitzero"`
+
+	// SortOrder Sort direction
+	SortOrder ListApprovalsParamsSortOrder `form:"sort_order,omitempty" json:"sort_order,omitempty,omitzero"`
+
+	// Cursor Opaque next_cursor from a previous response. Continues after that
+	// ticket instead of using page; only valid with sort_by=created_at.
+	// Cursor responses report pagination.page as 0.
+	Cursor string `form:"cursor,omitempty" json:"cursor,omitempty,omitzero"`
 }
 
 // ListApprovalsParamsStatus defines parameters for ListApprovals.
 type ListApprovalsParamsStatus string
 
+// ListApprovalsParamsOperationType defines parameters for ListApprovals.
+type ListApprovalsParamsOperationType string
+
+// ListApprovalsParamsSortBy defines parameters for ListApprovals.
+type ListApprovalsParamsSortBy string
+
+// ListApprovalsParamsSortOrder defines parameters for ListApprovals.
+type ListApprovalsParamsSortOrder string
+
 // ListAuditLogsParams defines parameters for ListAuditLogs.
 type ListAuditLogsParams struct {
 	// Page Page number (1-indexed)
@@ -1370,6 +3232,40 @@ type ListAuditLogsParams struct {
 	Actor        string  `form:"actor,omitempty" json:"actor,omitempty,omitzero"`
 	ResourceType string  `form:"resource_type,omitempty" json:"resource_type,omitempty,omitzero"`
 	ResourceId   string  `form:"resource_id,omitempty" json:"resource_id,omitempty,omitzero"`
+
+	// From Only records created at or after this time
+	From time.Time `form:"from,omitempty" json:"from,omitempty,omitzero"`
+
+	// To Only records created before this time
+	To time.Time `form:"to,omitempty" json:"to,omitempty,omitzero"`
+}
+
+// ExportAuditLogsParams defines parameters for ExportAuditLogs.
+type ExportAuditLogsParams struct {
+	Format       ExportAuditLogsParamsFormat `form:"format,omitempty" json:"format,omitempty,omitzero"`
+	Action       string                      `form:"action,omitempty" json:"action,omitempty,omitzero"`
+	Actor        string                      `form:"actor,omitempty" json:"actor,omitempty,omitzero"`
+	ResourceType string                      `form:"resource_type,omitempty" json:"resource_type,omitempty,omitzero"`
+	ResourceId   string                      `form:"resource_id,omitempty" json:"resource_id,omitempty,omitzero"`
+
+	// From Only records created at or after this time
+	From time.Time `form:"from,omitempty" json:"from,omitempty,omitzero"`
+
+	// To Only records created before this time
+	To time.Time `form:"to,omitempty" json:"to,omitempty,omitzero"`
+}
+
+// ExportAuditLogsParamsFormat defines parameters for ExportAuditLogs.
+type ExportAuditLogsParamsFormat string
+
+// ListInstanceSizesParams defines parameters for ListInstanceSizes.
+type ListInstanceSizesParams struct {
+	RequiresGpu   *InstanceSizeRequiresGPU   `form:"requires_gpu,omitempty" json:"requires_gpu,omitempty"`
+	RequiresSriov *InstanceSizeRequiresSRIOV `form:"requires_sriov,omitempty" json:"requires_sriov,omitempty"`
+	DedicatedCpu  *InstanceSizeDedicatedCPU  `form:"dedicated_cpu,omitempty" json:"dedicated_cpu,omitempty"`
+
+	// Search Case-insensitive substring match on name or display_name
+	Search InstanceSizeSearch `form:"search,omitempty" json:"search,omitempty,omitzero"`
 }
 
 // ListNotificationsParams defines parameters for ListNotifications.
@@ -1407,8 +3303,15 @@ type DeleteSystemParams struct {
 	// ConfirmName Type system name to confirm deletion (ADR-0015 §13 addendum).
 	// Must match the system name exactly.
 	ConfirmName string `form:"confirm_name" json:"confirm_name"`
+
+	// Cascade requests: cancel pending approval requests tied to the deleted
+	// services instead of rejecting the delete. VMs are never cascaded.
+	Cascade DeleteSystemParamsCascade `form:"cascade,omitempty" json:"cascade,omitempty,omitzero"`
 }
 
+// DeleteSystemParamsCascade defines parameters for DeleteSystem.
+type DeleteSystemParamsCascade string
+
 // ListServicesParams defines parameters for ListServices.
 type ListServicesParams struct {
 	// Page Page number (1-indexed)
@@ -1422,8 +3325,15 @@ type ListServicesParams struct {
 type DeleteServiceParams struct {
 	// Confirm Simple deletion confirmation flag (ADR-0015 §13)
 	Confirm Confirm `form:"confirm,omitempty" json:"confirm,omitempty,omitzero"`
+
+	// Cascade requests: cancel pending approval requests tied to the deleted
+	// services instead of rejecting the delete. VMs are never cascaded.
+	Cascade DeleteServiceParamsCascade `form:"cascade,omitempty" json:"cascade,omitempty,omitzero"`
 }
 
+// DeleteServiceParamsCascade defines parameters for DeleteService.
+type DeleteServiceParamsCascade string
+
 // ListTemplatesParams defines parameters for ListTemplates.
 type ListTemplatesParams struct {
 	// Page Page number (1-indexed)
@@ -1431,6 +3341,9 @@ type ListTemplatesParams struct {
 
 	// PerPage Items per page
 	PerPage PerPage `form:"per_page,omitempty" json:"per_page,omitempty,omitzero"`
+
+	// IncludeDeprecated Include deprecated templates (excluded by default)
+	IncludeDeprecated IncludeDeprecated `form:"include_deprecated,omitempty" json:"include_deprecated,omitempty,omitzero"`
 }
 
 // ListVMsParams defines parameters for ListVMs.
@@ -1441,18 +3354,60 @@ type ListVMsParams struct {
 	// PerPage Items per page
 	PerPage PerPage `form:"per_page,omitempty" json:"per_page,omitempty,omitzero"`
 
-	// SortBy Field to sort by
-	SortBy SortBy `form:"sort_by,omitempty" json:"sort_by,omitempty,omitzero"`
+	// OrderBy Sort field; defaults to created_at (newest first unless sort_order is set)
+	OrderBy VMListOrderBy `form:"order_by,omitempty" json:"order_by,omitempty,omitzero"`
 
 	// SortOrder Sort direction
 	SortOrder ListVMsParamsSortOrder `form:"sort_order,omitempty" json:"sort_order,omitempty,omitzero"`
 	Namespace string                 `form:"namespace,omitempty" json:"namespace,omitempty,omitzero"`
-	Status    string                 `form:"status,omitempty" json:"status,omitempty,omitzero"`
+
+	// Status Filter by one or more statuses (repeat the parameter)
+	Status    []VMStatus `form:"status,omitempty" json:"status,omitempty,omitzero"`
+	ClusterId string     `form:"cluster_id,omitempty" json:"cluster_id,omitempty,omitzero"`
+	ServiceId string     `form:"service_id,omitempty" json:"service_id,omitempty,omitzero"`
+
+	// SystemId Filter by owning system (resolved through the service edge)
+	SystemId  string `form:"system_id,omitempty" json:"system_id,omitempty,omitzero"`
+	CreatedBy string `form:"created_by,omitempty" json:"created_by,omitempty,omitzero"`
+
+	// NamePrefix Case-sensitive VM name prefix
+	NamePrefix string `form:"name_prefix,omitempty" json:"name_prefix,omitempty,omitzero"`
+
+	// LabelSelector Comma-separated `key=value` label equality terms, all of which must
+	// match (e.g. `env=prod,tier=frontend`).
+	LabelSelector string `form:"label_selector,omitempty" json:"label_selector,omitempty,omitzero"`
+
+	// Cursor Opaque next_cursor from a previous response. Continues after that
+	// VM instead of using page; only valid with order_by=created_at.
+	// Cursor responses report pagination.page as 0. The cursor is signed;
+	// altered cursors are rejected with 400.
+	Cursor string `form:"cursor,omitempty" json:"cursor,omitempty,omitzero"`
 }
 
 // ListVMsParamsSortOrder defines parameters for ListVMs.
 type ListVMsParamsSortOrder string
 
+// ListVMBatchesParams defines parameters for ListVMBatches.
+type ListVMBatchesParams struct {
+	// Status Only batches in this status
+	Status BatchStatusFilter `form:"status,omitempty" json:"status,omitempty,omitzero"`
+
+	// BatchType Only batches of this type
+	BatchType BatchTypeFilter `form:"batch_type,omitempty" json:"batch_type,omitempty,omitzero"`
+
+	// CreatedFrom Only batches created at or after this time
+	CreatedFrom BatchCreatedFrom `form:"created_from,omitempty" json:"created_from,omitempty,omitzero"`
+
+	// CreatedTo Only batches created before this time
+	CreatedTo BatchCreatedTo `form:"created_to,omitempty" json:"created_to,omitempty,omitzero"`
+
+	// Page Page number (1-indexed)
+	Page Page `form:"page,omitempty" json:"page,omitempty,omitzero"`
+
+	// PerPage Items per page
+	PerPage PerPage `form:"per_page,omitempty" json:"per_page,omitempty,omitzero"`
+}
+
 // DeleteVMParams defines parameters for DeleteVM.
 type DeleteVMParams struct {
 	// Confirm Simple deletion confirmation flag (ADR-0015 §13)
@@ -1461,6 +3416,35 @@ type DeleteVMParams struct {
 	// ConfirmName Type resource name to confirm deletion (ADR-0015 §13 addendum).
 	// Must match the resource name exactly.
 	ConfirmName ConfirmName `form:"confirm_name,omitempty" json:"confirm_name,omitempty,omitzero"`
+
+	// RequestId Client idempotency key. While a ticket submitted with the same key by
+	// the same requester is still open (PENDING, APPROVED or EXECUTING), the
+	// request returns that ticket instead of creating another.
+	RequestId RequestID `form:"request_id,omitempty" json:"request_id,omitempty,omitzero"`
+}
+
+// GetVMParams defines parameters for GetVM.
+type GetVMParams struct {
+	// Runtime Include live VirtualMachineInstance state from the VM's cluster.
+	// Lookups are cached for a few seconds per VM. When the cluster cannot
+	// be reached the VM is still returned with `runtime: null` and a
+	// `runtime_warning`.
+	Runtime bool `form:"runtime,omitempty" json:"runtime,omitempty,omitzero"`
+}
+
+// ListVMEventsParams defines parameters for ListVMEvents.
+type ListVMEventsParams struct {
+	// Page Page number (1-indexed)
+	Page Page `form:"page,omitempty" json:"page,omitempty,omitzero"`
+
+	// PerPage Items per page
+	PerPage PerPage `form:"per_page,omitempty" json:"per_page,omitempty,omitzero"`
+}
+
+// ProxyVMVNCWebSocketParams defines parameters for ProxyVMVNCWebSocket.
+type ProxyVMVNCWebSocketParams struct {
+	// Token Console session ID of the caller's VNC session
+	Token string `form:"token" json:"token"`
 }
 
 // CreateAuthProviderJSONRequestBody defines body for CreateAuthProvider for application/json ContentType.
@@ -1475,60 +3459,138 @@ type CreateAuthProviderGroupMappingJSONRequestBody = IdPGroupMappingCreateReques
 // UpdateAuthProviderGroupMappingJSONRequestBody defines body for UpdateAuthProviderGroupMapping for application/json ContentType.
 type UpdateAuthProviderGroupMappingJSONRequestBody = IdPGroupMappingUpdateRequest
 
+// ImportAuthProviderUsersJSONRequestBody defines body for ImportAuthProviderUsers for application/json ContentType.
+type ImportAuthProviderUsersJSONRequestBody = ImportAuthProviderUsersJSONBody
+
 // SyncAuthProviderGroupsJSONRequestBody defines body for SyncAuthProviderGroups for application/json ContentType.
 type SyncAuthProviderGroupsJSONRequestBody = AuthProviderGroupSyncRequest
 
 // CreateClusterJSONRequestBody defines body for CreateCluster for application/json ContentType.
 type CreateClusterJSONRequestBody = ClusterCreateRequest
 
+// UpdateClusterJSONRequestBody defines body for UpdateCluster for application/json ContentType.
+type UpdateClusterJSONRequestBody = ClusterUpdateRequest
+
 // UpdateClusterEnvironmentJSONRequestBody defines body for UpdateClusterEnvironment for application/json ContentType.
 type UpdateClusterEnvironmentJSONRequestBody = ClusterEnvironmentUpdate
 
+// ValidateClusterKubeconfigJSONRequestBody defines body for ValidateClusterKubeconfig for application/json ContentType.
+type ValidateClusterKubeconfigJSONRequestBody = ClusterKubeconfigValidationRequest
+
 // CreateAdminInstanceSizeJSONRequestBody defines body for CreateAdminInstanceSize for application/json ContentType.
 type CreateAdminInstanceSizeJSONRequestBody = InstanceSizeCreateRequest
 
+// BulkUpdateAdminInstanceSizesJSONRequestBody defines body for BulkUpdateAdminInstanceSizes for application/json ContentType.
+type BulkUpdateAdminInstanceSizesJSONRequestBody = BulkUpdateAdminInstanceSizesJSONBody
+
 // UpdateAdminInstanceSizeJSONRequestBody defines body for UpdateAdminInstanceSize for application/json ContentType.
 type UpdateAdminInstanceSizeJSONRequestBody = InstanceSizeUpdateRequest
 
+// AssociateInstanceSizeClustersJSONRequestBody defines body for AssociateInstanceSizeClusters for application/json ContentType.
+type AssociateInstanceSizeClustersJSONRequestBody = InstanceSizeClusterAssociation
+
 // CreateNamespaceJSONRequestBody defines body for CreateNamespace for application/json ContentType.
 type CreateNamespaceJSONRequestBody = NamespaceCreateRequest
 
 // UpdateNamespaceJSONRequestBody defines body for UpdateNamespace for application/json ContentType.
 type UpdateNamespaceJSONRequestBody = NamespaceUpdateRequest
 
+// MigrateNamespaceEnvironmentJSONRequestBody defines body for MigrateNamespaceEnvironment for application/json ContentType.
+type MigrateNamespaceEnvironmentJSONRequestBody = NamespaceMigrateEnvironmentRequest
+
+// UpdateNamespaceQuotaJSONRequestBody defines body for UpdateNamespaceQuota for application/json ContentType.
+type UpdateNamespaceQuotaJSONRequestBody = NamespaceQuotaRequest
+
+// CreateNamespaceQuotaJSONRequestBody defines body for CreateNamespaceQuota for application/json ContentType.
+type CreateNamespaceQuotaJSONRequestBody = NamespaceQuotaRequest
+
+// PatchPlatformConfigJSONRequestBody defines body for PatchPlatformConfig for application/json ContentType.
+type PatchPlatformConfigJSONRequestBody = PlatformConfigPatchRequest
+
+// CreateQuotaJSONRequestBody defines body for CreateQuota for application/json ContentType.
+type CreateQuotaJSONRequestBody = QuotaCreateRequest
+
+// UpdateQuotaJSONRequestBody defines body for UpdateQuota for application/json ContentType.
+type UpdateQuotaJSONRequestBody = QuotaLimits
+
 // CreateRateLimitExemptionJSONRequestBody defines body for CreateRateLimitExemption for application/json ContentType.
 type CreateRateLimitExemptionJSONRequestBody = RateLimitExemptionCreateRequest
 
+// PatchRateLimitUserOverrideJSONRequestBody defines body for PatchRateLimitUserOverride for application/json ContentType.
+type PatchRateLimitUserOverrideJSONRequestBody = RateLimitUserOverridePatchRequest
+
+// CreateRateLimitUserOverrideJSONRequestBody defines body for CreateRateLimitUserOverride for application/json ContentType.
+type CreateRateLimitUserOverrideJSONRequestBody = RateLimitUserOverrideCreateRequest
+
 // UpdateRateLimitUserOverridesJSONRequestBody defines body for UpdateRateLimitUserOverrides for application/json ContentType.
 type UpdateRateLimitUserOverridesJSONRequestBody = RateLimitUserOverrideRequest
 
+// CreateRoleBindingJSONRequestBody defines body for CreateRoleBinding for application/json ContentType.
+type CreateRoleBindingJSONRequestBody = RoleBindingCreateRequest
+
 // CreateRoleJSONRequestBody defines body for CreateRole for application/json ContentType.
 type CreateRoleJSONRequestBody = RoleCreateRequest
 
 // UpdateRoleJSONRequestBody defines body for UpdateRole for application/json ContentType.
 type UpdateRoleJSONRequestBody = RoleUpdateRequest
 
+// CloneRoleJSONRequestBody defines body for CloneRole for application/json ContentType.
+type CloneRoleJSONRequestBody = RoleCloneRequest
+
+// CreateScheduledJobJSONRequestBody defines body for CreateScheduledJob for application/json ContentType.
+type CreateScheduledJobJSONRequestBody = ScheduledBatchJobCreateRequest
+
+// UpdateScheduledJobJSONRequestBody defines body for UpdateScheduledJob for application/json ContentType.
+type UpdateScheduledJobJSONRequestBody = ScheduledBatchJobUpdateRequest
+
+// PutSpecOverridePolicyJSONRequestBody defines body for PutSpecOverridePolicy for application/json ContentType.
+type PutSpecOverridePolicyJSONRequestBody = SpecOverridePolicy
+
 // CreateAdminTemplateJSONRequestBody defines body for CreateAdminTemplate for application/json ContentType.
 type CreateAdminTemplateJSONRequestBody = TemplateCreateRequest
 
 // UpdateAdminTemplateJSONRequestBody defines body for UpdateAdminTemplate for application/json ContentType.
 type UpdateAdminTemplateJSONRequestBody = TemplateUpdateRequest
 
+// CloneAdminTemplateJSONRequestBody defines body for CloneAdminTemplate for application/json ContentType.
+type CloneAdminTemplateJSONRequestBody = TemplateUpdateRequest
+
 // CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
 type CreateUserJSONRequestBody = UserCreateRequest
 
 // UpdateUserJSONRequestBody defines body for UpdateUser for application/json ContentType.
 type UpdateUserJSONRequestBody = UserUpdateRequest
 
+// ForceUserPasswordResetJSONRequestBody defines body for ForceUserPasswordReset for application/json ContentType.
+type ForceUserPasswordResetJSONRequestBody = ForcePasswordResetRequest
+
 // CreateUserRoleBindingJSONRequestBody defines body for CreateUserRoleBinding for application/json ContentType.
 type CreateUserRoleBindingJSONRequestBody = GlobalRoleBindingCreateRequest
 
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody = WebhookCreateRequest
+
+// UpdateWebhookJSONRequestBody defines body for UpdateWebhook for application/json ContentType.
+type UpdateWebhookJSONRequestBody = WebhookUpdateRequest
+
 // SubmitApprovalBatchJSONRequestBody defines body for SubmitApprovalBatch for application/json ContentType.
 type SubmitApprovalBatchJSONRequestBody = VMBatchSubmitRequest
 
+// ApproveBatchJSONRequestBody defines body for ApproveBatch for application/json ContentType.
+type ApproveBatchJSONRequestBody = BatchApproveRequest
+
+// UpdateApprovalTicketJSONRequestBody defines body for UpdateApprovalTicket for application/json ContentType.
+type UpdateApprovalTicketJSONRequestBody = ApprovalTicketPatchRequest
+
 // ApproveTicketJSONRequestBody defines body for ApproveTicket for application/json ContentType.
 type ApproveTicketJSONRequestBody = ApprovalDecisionRequest
 
+// CreateTicketCommentJSONRequestBody defines body for CreateTicketComment for application/json ContentType.
+type CreateTicketCommentJSONRequestBody = TicketCommentRequest
+
+// ReassignTicketJSONRequestBody defines body for ReassignTicket for application/json ContentType.
+type ReassignTicketJSONRequestBody = ReassignTicketRequest
+
 // RejectTicketJSONRequestBody defines body for RejectTicket for application/json ContentType.
 type RejectTicketJSONRequestBody = RejectDecisionRequest
 
@@ -1538,12 +3600,30 @@ type ChangePasswordJSONRequestBody = ChangePasswordRequest
 // LoginJSONRequestBody defines body for Login for application/json ContentType.
 type LoginJSONRequestBody = LoginRequest
 
-// CreateSystemJSONRequestBody defines body for CreateSystem for application/json ContentType.
-type CreateSystemJSONRequestBody = SystemCreateRequest
+// RefreshTokenJSONRequestBody defines body for RefreshToken for application/json ContentType.
+type RefreshTokenJSONRequestBody = RefreshTokenRequest
+
+// SamlAssertionConsumerFormdataRequestBody defines body for SamlAssertionConsumer for application/x-www-form-urlencoded ContentType.
+type SamlAssertionConsumerFormdataRequestBody = SAMLAssertionForm
+
+// PutNotificationPreferencesJSONRequestBody defines body for PutNotificationPreferences for application/json ContentType.
+type PutNotificationPreferencesJSONRequestBody = NotificationPreferencesUpdateRequest
+
+// PatchServiceLabelsJSONRequestBody defines body for PatchServiceLabels for application/json ContentType.
+type PatchServiceLabelsJSONRequestBody = LabelsPatchRequest
+
+// CreateServiceRoleBindingJSONRequestBody defines body for CreateServiceRoleBinding for application/json ContentType.
+type CreateServiceRoleBindingJSONRequestBody = ServiceRoleBindingCreateRequest
+
+// CreateSystemJSONRequestBody defines body for CreateSystem for application/json ContentType.
+type CreateSystemJSONRequestBody = SystemCreateRequest
 
 // UpdateSystemJSONRequestBody defines body for UpdateSystem for application/json ContentType.
 type UpdateSystemJSONRequestBody = SystemUpdateRequest
 
+// AddSystemMaintainerJSONRequestBody defines body for AddSystemMaintainer for application/json ContentType.
+type AddSystemMaintainerJSONRequestBody = MaintainerRequest
+
 // AddSystemMemberJSONRequestBody defines body for AddSystemMember for application/json ContentType.
 type AddSystemMemberJSONRequestBody = SystemMemberCreateRequest
 
@@ -1556,17 +3636,50 @@ type CreateServiceJSONRequestBody = ServiceCreateRequest
 // UpdateServiceJSONRequestBody defines body for UpdateService for application/json ContentType.
 type UpdateServiceJSONRequestBody = ServiceUpdateRequest
 
+// AddServiceMaintainerJSONRequestBody defines body for AddServiceMaintainer for application/json ContentType.
+type AddServiceMaintainerJSONRequestBody = MaintainerRequest
+
 // SubmitVMBatchJSONRequestBody defines body for SubmitVMBatch for application/json ContentType.
 type SubmitVMBatchJSONRequestBody = VMBatchSubmitRequest
 
 // SubmitVMBatchPowerJSONRequestBody defines body for SubmitVMBatchPower for application/json ContentType.
 type SubmitVMBatchPowerJSONRequestBody = VMBatchPowerRequest
 
+// CancelVMBatchJSONRequestBody defines body for CancelVMBatch for application/json ContentType.
+type CancelVMBatchJSONRequestBody = VMBatchActionRequest
+
+// RetryVMBatchJSONRequestBody defines body for RetryVMBatch for application/json ContentType.
+type RetryVMBatchJSONRequestBody = VMBatchActionRequest
+
 // CreateVMRequestJSONRequestBody defines body for CreateVMRequest for application/json ContentType.
 type CreateVMRequestJSONRequestBody = VMCreateRequest
 
+// RequestVMConsoleAccessJSONRequestBody defines body for RequestVMConsoleAccess for application/json ContentType.
+type RequestVMConsoleAccessJSONRequestBody = VMConsoleAccessRequest
+
+// UpdateVMHostnameJSONRequestBody defines body for UpdateVMHostname for application/json ContentType.
+type UpdateVMHostnameJSONRequestBody = UpdateVMHostnameRequest
+
+// PatchVMLabelsJSONRequestBody defines body for PatchVMLabels for application/json ContentType.
+type PatchVMLabelsJSONRequestBody = LabelsPatchRequest
+
+// MigrateVMJSONRequestBody defines body for MigrateVM for application/json ContentType.
+type MigrateVMJSONRequestBody = MigrateVMRequest
+
+// ResizeVMJSONRequestBody defines body for ResizeVM for application/json ContentType.
+type ResizeVMJSONRequestBody = ResizeVMRequest
+
+// CreateVMSnapshotJSONRequestBody defines body for CreateVMSnapshot for application/json ContentType.
+type CreateVMSnapshotJSONRequestBody = CreateVMSnapshotRequest
+
+// RestoreVMSnapshotJSONRequestBody defines body for RestoreVMSnapshot for application/json ContentType.
+type RestoreVMSnapshotJSONRequestBody = RestoreVMSnapshotRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List audit logs of all users
+	// (GET /admin/audit-logs)
+	ListAdminAuditLogs(c *gin.Context, params ListAdminAuditLogsParams)
 	// List registered authentication provider plugin types
 	// (GET /admin/auth-provider-types)
 	ListAuthProviderTypes(c *gin.Context)
@@ -1594,6 +3707,9 @@ type ServerInterface interface {
 	// Update IdP group mapping
 	// (PATCH /admin/auth-providers/{provider_id}/group-mappings/{mapping_id})
 	UpdateAuthProviderGroupMapping(c *gin.Context, providerId ProviderID, mappingId MappingID)
+	// Bulk-provision users of an auth provider
+	// (POST /admin/auth-providers/{provider_id}/import-users)
+	ImportAuthProviderUsers(c *gin.Context, providerId ProviderID)
 	// Fetch sample identity data fields for mapping
 	// (GET /admin/auth-providers/{provider_id}/sample)
 	GetAuthProviderSample(c *gin.Context, providerId ProviderID)
@@ -1603,33 +3719,60 @@ type ServerInterface interface {
 	// Test authentication provider connectivity
 	// (POST /admin/auth-providers/{provider_id}/test-connection)
 	TestAuthProviderConnection(c *gin.Context, providerId ProviderID)
+	// List VM batches across the platform
+	// (GET /admin/batch)
+	ListAdminBatches(c *gin.Context, params ListAdminBatchesParams)
 	// List clusters
 	// (GET /admin/clusters)
 	ListClusters(c *gin.Context, params ListClustersParams)
 	// Register a cluster
 	// (POST /admin/clusters)
 	CreateCluster(c *gin.Context)
+	// Update cluster capacity and kubeconfig
+	// (PATCH /admin/clusters/{cluster_id})
+	UpdateCluster(c *gin.Context, clusterId string)
+	// Get live cluster capacity
+	// (GET /admin/clusters/{cluster_id}/capacity)
+	GetClusterCapacity(c *gin.Context, clusterId string)
 	// Update cluster environment
 	// (PUT /admin/clusters/{cluster_id}/environment)
 	UpdateClusterEnvironment(c *gin.Context, clusterId string)
+	// Test a kubeconfig against its API server
+	// (POST /admin/clusters/{cluster_id}/validate-kubeconfig)
+	ValidateClusterKubeconfig(c *gin.Context, clusterId string)
+	// Replay a domain event
+	// (POST /admin/events/{event_id}/replay)
+	ReplayDomainEvent(c *gin.Context, eventId string, params ReplayDomainEventParams)
 	// List instance sizes for admin management
 	// (GET /admin/instance-sizes)
-	ListAdminInstanceSizes(c *gin.Context)
+	ListAdminInstanceSizes(c *gin.Context, params ListAdminInstanceSizesParams)
 	// Create instance size
 	// (POST /admin/instance-sizes)
 	CreateAdminInstanceSize(c *gin.Context)
+	// Reorder instance sizes
+	// (POST /admin/instance-sizes/bulk-update)
+	BulkUpdateAdminInstanceSizes(c *gin.Context)
 	// Delete instance size
 	// (DELETE /admin/instance-sizes/{instance_size_id})
 	DeleteAdminInstanceSize(c *gin.Context, instanceSizeId InstanceSizeID)
+	// Get instance size
+	// (GET /admin/instance-sizes/{instance_size_id})
+	GetAdminInstanceSize(c *gin.Context, instanceSizeId InstanceSizeID)
 	// Update instance size
 	// (PATCH /admin/instance-sizes/{instance_size_id})
 	UpdateAdminInstanceSize(c *gin.Context, instanceSizeId InstanceSizeID)
+	// Replace the clusters an instance size is restricted to
+	// (PUT /admin/instance-sizes/{instance_size_id}/associate-clusters)
+	AssociateInstanceSizeClusters(c *gin.Context, instanceSizeId InstanceSizeID)
 	// List registered namespaces
 	// (GET /admin/namespaces)
 	ListNamespaces(c *gin.Context, params ListNamespacesParams)
 	// Register a namespace
 	// (POST /admin/namespaces)
 	CreateNamespace(c *gin.Context)
+	// Reconcile the namespace registry against a cluster
+	// (POST /admin/namespaces/sync)
+	SyncNamespaces(c *gin.Context, params SyncNamespacesParams)
 	// Delete namespace
 	// (DELETE /admin/namespaces/{namespace_id})
 	DeleteNamespace(c *gin.Context, namespaceId NamespaceID, params DeleteNamespaceParams)
@@ -1639,9 +3782,51 @@ type ServerInterface interface {
 	// Update namespace
 	// (PUT /admin/namespaces/{namespace_id})
 	UpdateNamespace(c *gin.Context, namespaceId NamespaceID)
+	// Request a namespace environment migration
+	// (POST /admin/namespaces/{namespace_id}/migrate-environment)
+	MigrateNamespaceEnvironment(c *gin.Context, namespaceId NamespaceID)
+	// Remove namespace VM quota
+	// (DELETE /admin/namespaces/{namespace_id}/quota)
+	DeleteNamespaceQuota(c *gin.Context, namespaceId NamespaceID)
+	// Update namespace VM quota
+	// (PATCH /admin/namespaces/{namespace_id}/quota)
+	UpdateNamespaceQuota(c *gin.Context, namespaceId NamespaceID)
+	// Create namespace VM quota
+	// (POST /admin/namespaces/{namespace_id}/quota)
+	CreateNamespaceQuota(c *gin.Context, namespaceId NamespaceID)
 	// List supported permission keys
 	// (GET /admin/permissions)
 	ListPermissions(c *gin.Context)
+	// Show which roles grant each permission
+	// (GET /admin/permissions/matrix)
+	GetPermissionMatrix(c *gin.Context)
+	// Get runtime platform settings
+	// (GET /admin/platform-config)
+	GetPlatformConfig(c *gin.Context)
+	// Update runtime platform settings
+	// (PATCH /admin/platform-config)
+	PatchPlatformConfig(c *gin.Context)
+	// List system and namespace quotas
+	// (GET /admin/quotas)
+	ListQuotas(c *gin.Context, params ListQuotasParams)
+	// Create a quota
+	// (POST /admin/quotas)
+	CreateQuota(c *gin.Context)
+	// Delete a quota
+	// (DELETE /admin/quotas/{quota_id})
+	DeleteQuota(c *gin.Context, quotaId QuotaID)
+	// Get a quota
+	// (GET /admin/quotas/{quota_id})
+	GetQuota(c *gin.Context, quotaId QuotaID)
+	// Replace quota limits
+	// (PUT /admin/quotas/{quota_id})
+	UpdateQuota(c *gin.Context, quotaId QuotaID)
+	// Get the resolved batch rate-limit policy for a user
+	// (GET /admin/rate-limits/effective/{user_id})
+	GetEffectiveRateLimitPolicy(c *gin.Context, userId UserID)
+	// List active user rate-limit exemptions
+	// (GET /admin/rate-limits/exemptions)
+	ListRateLimitExemptions(c *gin.Context)
 	// Create or update a user rate-limit exemption
 	// (POST /admin/rate-limits/exemptions)
 	CreateRateLimitExemption(c *gin.Context)
@@ -1651,9 +3836,36 @@ type ServerInterface interface {
 	// List current user rate-limit statuses
 	// (GET /admin/rate-limits/status)
 	ListRateLimitStatus(c *gin.Context)
+	// Remove a per-user rate-limit override
+	// (DELETE /admin/rate-limits/user-overrides/{user_id})
+	RemoveRateLimitUserOverride(c *gin.Context, userId UserID)
+	// Get a per-user rate-limit override
+	// (GET /admin/rate-limits/user-overrides/{user_id})
+	GetRateLimitUserOverride(c *gin.Context, userId UserID)
+	// Update a per-user rate-limit override
+	// (PATCH /admin/rate-limits/user-overrides/{user_id})
+	PatchRateLimitUserOverride(c *gin.Context, userId UserID)
+	// Create a per-user rate-limit override
+	// (POST /admin/rate-limits/user-overrides/{user_id})
+	CreateRateLimitUserOverride(c *gin.Context, userId UserID)
+	// List per-user rate-limit overrides
+	// (GET /admin/rate-limits/users)
+	ListRateLimitUserOverrides(c *gin.Context)
+	// Remove per-user rate-limit overrides
+	// (DELETE /admin/rate-limits/users/{user_id})
+	DeleteRateLimitUserOverrides(c *gin.Context, userId UserID)
 	// Upsert per-user rate-limit overrides
 	// (PUT /admin/rate-limits/users/{user_id})
 	UpdateRateLimitUserOverrides(c *gin.Context, userId UserID)
+	// List role bindings across users
+	// (GET /admin/role-bindings)
+	ListRoleBindings(c *gin.Context, params ListRoleBindingsParams)
+	// Bind a role to a user, globally or on one scope
+	// (POST /admin/role-bindings)
+	CreateRoleBinding(c *gin.Context)
+	// Delete a role binding
+	// (DELETE /admin/role-bindings/{binding_id})
+	DeleteRoleBinding(c *gin.Context, bindingId RoleBindingID)
 	// List RBAC roles
 	// (GET /admin/roles)
 	ListRoles(c *gin.Context)
@@ -1666,18 +3878,63 @@ type ServerInterface interface {
 	// Update RBAC role
 	// (PATCH /admin/roles/{role_id})
 	UpdateRole(c *gin.Context, roleId RoleID)
+	// Clone RBAC role
+	// (POST /admin/roles/{role_id}/clone)
+	CloneRole(c *gin.Context, roleId RoleID)
+	// List scheduled batch power jobs
+	// (GET /admin/scheduled-jobs)
+	ListScheduledJobs(c *gin.Context, params ListScheduledJobsParams)
+	// Create a scheduled batch power job
+	// (POST /admin/scheduled-jobs)
+	CreateScheduledJob(c *gin.Context)
+	// Delete a scheduled batch power job
+	// (DELETE /admin/scheduled-jobs/{scheduled_job_id})
+	DeleteScheduledJob(c *gin.Context, scheduledJobId ScheduledJobID)
+	// Get a scheduled batch power job
+	// (GET /admin/scheduled-jobs/{scheduled_job_id})
+	GetScheduledJob(c *gin.Context, scheduledJobId ScheduledJobID)
+	// Update a scheduled batch power job
+	// (PATCH /admin/scheduled-jobs/{scheduled_job_id})
+	UpdateScheduledJob(c *gin.Context, scheduledJobId ScheduledJobID)
+	// Repair a service's next instance index
+	// (POST /admin/services/{service_id}/reindex)
+	ReindexServiceInstances(c *gin.Context, serviceId string)
+	// Get the spec override policy
+	// (GET /admin/spec-override-policy)
+	GetSpecOverridePolicy(c *gin.Context)
+	// Replace the spec override policy
+	// (PUT /admin/spec-override-policy)
+	PutSpecOverridePolicy(c *gin.Context)
+	// Get a system's service and VM tree
+	// (GET /admin/systems/{system_id}/topology)
+	GetSystemTopology(c *gin.Context, systemId SystemID)
+	// Report a system's VM resource usage per service
+	// (GET /admin/systems/{system_id}/usage-report)
+	GetSystemUsageReport(c *gin.Context, systemId SystemID, params GetSystemUsageReportParams)
 	// List templates for admin management
 	// (GET /admin/templates)
 	ListAdminTemplates(c *gin.Context, params ListAdminTemplatesParams)
 	// Create template
 	// (POST /admin/templates)
-	CreateAdminTemplate(c *gin.Context)
+	CreateAdminTemplate(c *gin.Context, params CreateAdminTemplateParams)
 	// Delete template
 	// (DELETE /admin/templates/{template_id})
-	DeleteAdminTemplate(c *gin.Context, templateId TemplateID)
+	DeleteAdminTemplate(c *gin.Context, templateId TemplateID, params DeleteAdminTemplateParams)
+	// Get template
+	// (GET /admin/templates/{template_id})
+	GetAdminTemplate(c *gin.Context, templateId TemplateID)
 	// Update template
 	// (PATCH /admin/templates/{template_id})
-	UpdateAdminTemplate(c *gin.Context, templateId TemplateID)
+	UpdateAdminTemplate(c *gin.Context, templateId TemplateID, params UpdateAdminTemplateParams)
+	// Clone template into a new version
+	// (POST /admin/templates/{template_id}/clone)
+	CloneAdminTemplate(c *gin.Context, templateId TemplateID)
+	// Deprecate template version
+	// (POST /admin/templates/{template_id}/deprecate)
+	DeprecateAdminTemplate(c *gin.Context, templateId TemplateID)
+	// Promote (un-deprecate) template version
+	// (POST /admin/templates/{template_id}/promote)
+	PromoteAdminTemplate(c *gin.Context, templateId TemplateID)
 	// List users
 	// (GET /admin/users)
 	ListUsers(c *gin.Context, params ListUsersParams)
@@ -1690,6 +3947,12 @@ type ServerInterface interface {
 	// Update a local JWT user
 	// (PATCH /admin/users/{user_id})
 	UpdateUser(c *gin.Context, userId UserID)
+	// Force a user to change their password
+	// (PATCH /admin/users/{user_id}/force-password-reset)
+	ForceUserPasswordReset(c *gin.Context, userId UserID)
+	// Revoke all login sessions of a user
+	// (POST /admin/users/{user_id}/revoke-sessions)
+	RevokeUserSessions(c *gin.Context, userId UserID)
 	// List global role bindings for a user
 	// (GET /admin/users/{user_id}/role-bindings)
 	ListUserRoleBindings(c *gin.Context, userId UserID)
@@ -1699,24 +3962,75 @@ type ServerInterface interface {
 	// Delete a user's global role binding
 	// (DELETE /admin/users/{user_id}/role-bindings/{binding_id})
 	DeleteUserRoleBinding(c *gin.Context, userId UserID, bindingId RoleBindingID)
+	// Clear a user's login lockout
+	// (POST /admin/users/{user_id}/unlock)
+	UnlockUser(c *gin.Context, userId UserID)
+	// List outbound webhook endpoints
+	// (GET /admin/webhooks)
+	ListWebhooks(c *gin.Context, params ListWebhooksParams)
+	// Register an outbound webhook endpoint
+	// (POST /admin/webhooks)
+	CreateWebhook(c *gin.Context)
+	// Delete an outbound webhook endpoint
+	// (DELETE /admin/webhooks/{webhook_id})
+	DeleteWebhook(c *gin.Context, webhookId WebhookID)
+	// Get an outbound webhook endpoint
+	// (GET /admin/webhooks/{webhook_id})
+	GetWebhook(c *gin.Context, webhookId WebhookID)
+	// Update an outbound webhook endpoint
+	// (PATCH /admin/webhooks/{webhook_id})
+	UpdateWebhook(c *gin.Context, webhookId WebhookID)
+	// List delivery attempts for a webhook endpoint
+	// (GET /admin/webhooks/{webhook_id}/deliveries)
+	ListWebhookDeliveries(c *gin.Context, webhookId WebhookID, params ListWebhookDeliveriesParams)
+	// Send a test event to a webhook endpoint
+	// (POST /admin/webhooks/{webhook_id}/test)
+	TestWebhook(c *gin.Context, webhookId WebhookID)
 	// List approval tickets
 	// (GET /approvals)
 	ListApprovals(c *gin.Context, params ListApprovalsParams)
 	// Submit batch approval request (compatibility endpoint)
 	// (POST /approvals/batch)
 	SubmitApprovalBatch(c *gin.Context)
+	// Approve a VM batch in one operation
+	// (PATCH /approvals/batch/{batch_id}/approve)
+	ApproveBatch(c *gin.Context, batchId BatchID)
+	// Get an approval ticket
+	// (GET /approvals/{ticket_id})
+	GetApproval(c *gin.Context, ticketId TicketID)
+	// Set the approver's modifications of a pending create ticket
+	// (PATCH /approvals/{ticket_id})
+	UpdateApprovalTicket(c *gin.Context, ticketId TicketID)
 	// Approve a request
 	// (POST /approvals/{ticket_id}/approve)
 	ApproveTicket(c *gin.Context, ticketId TicketID)
 	// Cancel own pending request
 	// (POST /approvals/{ticket_id}/cancel)
 	CancelTicket(c *gin.Context, ticketId TicketID)
+	// List review comments on a ticket
+	// (GET /approvals/{ticket_id}/comments)
+	ListTicketComments(c *gin.Context, ticketId TicketID)
+	// Comment on a ticket
+	// (POST /approvals/{ticket_id}/comments)
+	CreateTicketComment(c *gin.Context, ticketId TicketID)
+	// Delete a comment
+	// (DELETE /approvals/{ticket_id}/comments/{comment_id})
+	DeleteTicketComment(c *gin.Context, ticketId TicketID, commentId CommentID)
+	// Preview the VirtualMachine a pending create ticket would apply
+	// (GET /approvals/{ticket_id}/preview)
+	PreviewApprovalTicket(c *gin.Context, ticketId TicketID)
+	// Reassign a pending ticket to another approver
+	// (POST /approvals/{ticket_id}/reassign)
+	ReassignTicket(c *gin.Context, ticketId TicketID)
 	// Reject a request
 	// (POST /approvals/{ticket_id}/reject)
 	RejectTicket(c *gin.Context, ticketId TicketID)
-	// List audit logs
+	// List the current user's audit logs
 	// (GET /audit-logs)
 	ListAuditLogs(c *gin.Context, params ListAuditLogsParams)
+	// Export audit logs
+	// (GET /audit-logs/export)
+	ExportAuditLogs(c *gin.Context, params ExportAuditLogsParams)
 	// Change current user password
 	// (POST /auth/change-password)
 	ChangePassword(c *gin.Context)
@@ -1726,27 +4040,57 @@ type ServerInterface interface {
 	// Get current user info
 	// (GET /auth/me)
 	GetCurrentUser(c *gin.Context)
+	// Get the local password policy
+	// (GET /auth/password-policy)
+	GetPasswordPolicy(c *gin.Context)
+	// Exchange a refresh token for a new access token
+	// (POST /auth/refresh)
+	RefreshToken(c *gin.Context)
+	// SAML 2.0 assertion consumer service
+	// (POST /auth/saml/{provider_id}/acs)
+	SamlAssertionConsumer(c *gin.Context, providerId ProviderID)
+	// List the current user's login sessions
+	// (GET /auth/sessions)
+	ListLoginSessions(c *gin.Context)
+	// Revoke one of the current user's login sessions
+	// (DELETE /auth/sessions/{session_id})
+	RevokeLoginSession(c *gin.Context, sessionId LoginSessionID)
 	// Liveness probe
 	// (GET /health/live)
 	GetLiveness(c *gin.Context)
 	// Readiness probe
 	// (GET /health/ready)
 	GetReadiness(c *gin.Context)
+	// Component health report
+	// (GET /healthz)
+	GetHealthz(c *gin.Context)
 	// List instance sizes
 	// (GET /instance-sizes)
-	ListInstanceSizes(c *gin.Context)
+	ListInstanceSizes(c *gin.Context, params ListInstanceSizesParams)
 	// List notifications for current user
 	// (GET /notifications)
 	ListNotifications(c *gin.Context, params ListNotificationsParams)
 	// Mark all notifications as read
 	// (POST /notifications/mark-all-read)
 	MarkAllNotificationsRead(c *gin.Context)
+	// Get the current user's notification preferences
+	// (GET /notifications/preferences)
+	GetNotificationPreferences(c *gin.Context)
+	// Replace the current user's notification preferences
+	// (PUT /notifications/preferences)
+	PutNotificationPreferences(c *gin.Context)
 	// Get unread notification count
 	// (GET /notifications/unread-count)
 	GetUnreadCount(c *gin.Context)
 	// Mark notification as read
 	// (PATCH /notifications/{notification_id}/read)
 	MarkNotificationRead(c *gin.Context, notificationId NotificationID)
+	// Merge-patch service labels
+	// (PATCH /services/{service_id}/labels)
+	PatchServiceLabels(c *gin.Context, serviceId ServiceID)
+	// Create service-scoped role binding
+	// (POST /services/{service_id}/role-bindings)
+	CreateServiceRoleBinding(c *gin.Context, serviceId ServiceID)
 	// List systems
 	// (GET /systems)
 	ListSystems(c *gin.Context, params ListSystemsParams)
@@ -1762,6 +4106,12 @@ type ServerInterface interface {
 	// Update system description
 	// (PATCH /systems/{system_id})
 	UpdateSystem(c *gin.Context, systemId SystemID)
+	// Add system maintainer
+	// (POST /systems/{system_id}/maintainers)
+	AddSystemMaintainer(c *gin.Context, systemId SystemID)
+	// Remove system maintainer
+	// (DELETE /systems/{system_id}/maintainers/{user_id})
+	RemoveSystemMaintainer(c *gin.Context, systemId SystemID, userId UserID)
 	// List system members
 	// (GET /systems/{system_id}/members)
 	ListSystemMembers(c *gin.Context, systemId SystemID)
@@ -1789,15 +4139,30 @@ type ServerInterface interface {
 	// Update service description
 	// (PATCH /systems/{system_id}/services/{service_id})
 	UpdateService(c *gin.Context, systemId SystemID, serviceId ServiceID)
+	// Add service maintainer
+	// (POST /systems/{system_id}/services/{service_id}/maintainers)
+	AddServiceMaintainer(c *gin.Context, systemId SystemID, serviceId ServiceID)
+	// Remove service maintainer
+	// (DELETE /systems/{system_id}/services/{service_id}/maintainers/{user_id})
+	RemoveServiceMaintainer(c *gin.Context, systemId SystemID, serviceId ServiceID, userId UserID)
 	// List templates
 	// (GET /templates)
 	ListTemplates(c *gin.Context, params ListTemplatesParams)
+	// Show a user's permissions per scope
+	// (GET /users/{user_id}/effective-permissions)
+	GetUserEffectivePermissions(c *gin.Context, userId UserID)
 	// List VMs
 	// (GET /vms)
 	ListVMs(c *gin.Context, params ListVMsParams)
+	// List the caller's VM batches
+	// (GET /vms/batch)
+	ListVMBatches(c *gin.Context, params ListVMBatchesParams)
 	// Submit VM batch request
 	// (POST /vms/batch)
 	SubmitVMBatch(c *gin.Context)
+	// Get the caller's batch rate-limit standing
+	// (GET /vms/batch/limits)
+	GetVMBatchLimits(c *gin.Context)
 	// Submit VM batch power request (compatibility endpoint)
 	// (POST /vms/batch/power)
 	SubmitVMBatchPower(c *gin.Context)
@@ -1807,6 +4172,9 @@ type ServerInterface interface {
 	// Cancel pending children in a VM batch
 	// (POST /vms/batch/{batch_id}/cancel)
 	CancelVMBatch(c *gin.Context, batchId BatchID)
+	// Stream VM batch progress
+	// (GET /vms/batch/{batch_id}/events)
+	StreamVMBatchEvents(c *gin.Context, batchId BatchID)
 	// Retry failed children in a VM batch
 	// (POST /vms/batch/{batch_id}/retry)
 	RetryVMBatch(c *gin.Context, batchId BatchID)
@@ -1821,16 +4189,52 @@ type ServerInterface interface {
 	DeleteVM(c *gin.Context, vmId VMID, params DeleteVMParams)
 	// Get VM by ID
 	// (GET /vms/{vm_id})
-	GetVM(c *gin.Context, vmId VMID)
+	GetVM(c *gin.Context, vmId VMID, params GetVMParams)
 	// Request VM console access
 	// (POST /vms/{vm_id}/console/request)
 	RequestVMConsoleAccess(c *gin.Context, vmId VMID)
+	// List active VM console sessions
+	// (GET /vms/{vm_id}/console/sessions)
+	ListVMConsoleSessions(c *gin.Context, vmId VMID)
+	// Revoke VM console session
+	// (DELETE /vms/{vm_id}/console/sessions/{session_id})
+	RevokeVMConsoleSession(c *gin.Context, vmId VMID, sessionId ConsoleSessionID)
 	// Get VM console access status
 	// (GET /vms/{vm_id}/console/status)
 	GetVMConsoleStatus(c *gin.Context, vmId VMID)
+	// List lifecycle events of a VM
+	// (GET /vms/{vm_id}/events)
+	ListVMEvents(c *gin.Context, vmId VMID, params ListVMEventsParams)
+	// Update VM hostname
+	// (PATCH /vms/{vm_id}/hostname)
+	UpdateVMHostname(c *gin.Context, vmId VMID)
+	// Merge-patch VM labels
+	// (PATCH /vms/{vm_id}/labels)
+	PatchVMLabels(c *gin.Context, vmId VMID)
+	// Request VM migration to another cluster
+	// (POST /vms/{vm_id}/migrate)
+	MigrateVM(c *gin.Context, vmId VMID)
+	// Request VM resize (CPU/memory/disk)
+	// (POST /vms/{vm_id}/resize)
+	ResizeVM(c *gin.Context, vmId VMID)
 	// Restart VM
 	// (POST /vms/{vm_id}/restart)
 	RestartVM(c *gin.Context, vmId VMID)
+	// List VM snapshots
+	// (GET /vms/{vm_id}/snapshots)
+	ListVMSnapshots(c *gin.Context, vmId VMID)
+	// Create VM snapshot
+	// (POST /vms/{vm_id}/snapshots)
+	CreateVMSnapshot(c *gin.Context, vmId VMID)
+	// Delete VM snapshot
+	// (DELETE /vms/{vm_id}/snapshots/{snapshot_name})
+	DeleteVMSnapshot(c *gin.Context, vmId VMID, snapshotName SnapshotName)
+	// Get VM snapshot
+	// (GET /vms/{vm_id}/snapshots/{snapshot_name})
+	GetVMSnapshot(c *gin.Context, vmId VMID, snapshotName SnapshotName)
+	// Restore VM from snapshot
+	// (POST /vms/{vm_id}/snapshots/{snapshot_name}/restore)
+	RestoreVMSnapshot(c *gin.Context, vmId VMID, snapshotName SnapshotName)
 	// Start VM
 	// (POST /vms/{vm_id}/start)
 	StartVM(c *gin.Context, vmId VMID)
@@ -1840,6 +4244,9 @@ type ServerInterface interface {
 	// Open VM VNC session
 	// (GET /vms/{vm_id}/vnc)
 	OpenVMVNC(c *gin.Context, vmId VMID)
+	// Proxy VM VNC over WebSocket
+	// (GET /vms/{vm_id}/vnc/ws)
+	ProxyVMVNCWebSocket(c *gin.Context, vmId VMID, params ProxyVMVNCWebSocketParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -1851,6 +4258,90 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
+// ListAdminAuditLogs operation middleware
+func (siw *ServerInterfaceWrapper) ListAdminAuditLogs(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAdminAuditLogsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", c.Request.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter action: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", c.Request.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter actor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "resource_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resource_type", c.Request.URL.Query(), &params.ResourceType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter resource_type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "resource_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resource_id", c.Request.URL.Query(), &params.ResourceId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter resource_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListAdminAuditLogs(c, params)
+}
+
 // ListAuthProviderTypes operation middleware
 func (siw *ServerInterfaceWrapper) ListAuthProviderTypes(c *gin.Context) {
 
@@ -2070,6 +4561,32 @@ func (siw *ServerInterfaceWrapper) UpdateAuthProviderGroupMapping(c *gin.Context
 	siw.Handler.UpdateAuthProviderGroupMapping(c, providerId, mappingId)
 }
 
+// ImportAuthProviderUsers operation middleware
+func (siw *ServerInterfaceWrapper) ImportAuthProviderUsers(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "provider_id" -------------
+	var providerId ProviderID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider_id", c.Param("provider_id"), &providerId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ImportAuthProviderUsers(c, providerId)
+}
+
 // GetAuthProviderSample operation middleware
 func (siw *ServerInterfaceWrapper) GetAuthProviderSample(c *gin.Context) {
 
@@ -2148,6 +4665,82 @@ func (siw *ServerInterfaceWrapper) TestAuthProviderConnection(c *gin.Context) {
 	siw.Handler.TestAuthProviderConnection(c, providerId)
 }
 
+// ListAdminBatches operation middleware
+func (siw *ServerInterfaceWrapper) ListAdminBatches(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAdminBatchesParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "batch_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "batch_type", c.Request.URL.Query(), &params.BatchType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter batch_type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "created_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_by", c.Request.URL.Query(), &params.CreatedBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter created_by: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", c.Request.URL.Query(), &params.CreatedFrom)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter created_from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", c.Request.URL.Query(), &params.CreatedTo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter created_to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListAdminBatches(c, params)
+}
+
 // ListClusters operation middleware
 func (siw *ServerInterfaceWrapper) ListClusters(c *gin.Context) {
 
@@ -2199,8 +4792,8 @@ func (siw *ServerInterfaceWrapper) CreateCluster(c *gin.Context) {
 	siw.Handler.CreateCluster(c)
 }
 
-// UpdateClusterEnvironment operation middleware
-func (siw *ServerInterfaceWrapper) UpdateClusterEnvironment(c *gin.Context) {
+// UpdateCluster operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCluster(c *gin.Context) {
 
 	var err error
 
@@ -2222,11 +4815,22 @@ func (siw *ServerInterfaceWrapper) UpdateClusterEnvironment(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateClusterEnvironment(c, clusterId)
+	siw.Handler.UpdateCluster(c, clusterId)
 }
 
-// ListAdminInstanceSizes operation middleware
-func (siw *ServerInterfaceWrapper) ListAdminInstanceSizes(c *gin.Context) {
+// GetClusterCapacity operation middleware
+func (siw *ServerInterfaceWrapper) GetClusterCapacity(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "cluster_id" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster_id", c.Param("cluster_id"), &clusterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cluster_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -2237,11 +4841,22 @@ func (siw *ServerInterfaceWrapper) ListAdminInstanceSizes(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListAdminInstanceSizes(c)
+	siw.Handler.GetClusterCapacity(c, clusterId)
 }
 
-// CreateAdminInstanceSize operation middleware
-func (siw *ServerInterfaceWrapper) CreateAdminInstanceSize(c *gin.Context) {
+// UpdateClusterEnvironment operation middleware
+func (siw *ServerInterfaceWrapper) UpdateClusterEnvironment(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "cluster_id" -------------
+	var clusterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster_id", c.Param("cluster_id"), &clusterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cluster_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -2252,20 +4867,20 @@ func (siw *ServerInterfaceWrapper) CreateAdminInstanceSize(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateAdminInstanceSize(c)
+	siw.Handler.UpdateClusterEnvironment(c, clusterId)
 }
 
-// DeleteAdminInstanceSize operation middleware
-func (siw *ServerInterfaceWrapper) DeleteAdminInstanceSize(c *gin.Context) {
+// ValidateClusterKubeconfig operation middleware
+func (siw *ServerInterfaceWrapper) ValidateClusterKubeconfig(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "instance_size_id" -------------
-	var instanceSizeId InstanceSizeID
+	// ------------- Path parameter "cluster_id" -------------
+	var clusterId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "instance_size_id", c.Param("instance_size_id"), &instanceSizeId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster_id", c.Param("cluster_id"), &clusterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter instance_size_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cluster_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -2278,25 +4893,36 @@ func (siw *ServerInterfaceWrapper) DeleteAdminInstanceSize(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteAdminInstanceSize(c, instanceSizeId)
+	siw.Handler.ValidateClusterKubeconfig(c, clusterId)
 }
 
-// UpdateAdminInstanceSize operation middleware
-func (siw *ServerInterfaceWrapper) UpdateAdminInstanceSize(c *gin.Context) {
+// ReplayDomainEvent operation middleware
+func (siw *ServerInterfaceWrapper) ReplayDomainEvent(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "instance_size_id" -------------
-	var instanceSizeId InstanceSizeID
+	// ------------- Path parameter "event_id" -------------
+	var eventId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "instance_size_id", c.Param("instance_size_id"), &instanceSizeId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "event_id", c.Param("event_id"), &eventId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter instance_size_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter event_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
 	c.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReplayDomainEventParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -2304,18 +4930,18 @@ func (siw *ServerInterfaceWrapper) UpdateAdminInstanceSize(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateAdminInstanceSize(c, instanceSizeId)
+	siw.Handler.ReplayDomainEvent(c, eventId, params)
 }
 
-// ListNamespaces operation middleware
-func (siw *ServerInterfaceWrapper) ListNamespaces(c *gin.Context) {
+// ListAdminInstanceSizes operation middleware
+func (siw *ServerInterfaceWrapper) ListAdminInstanceSizes(c *gin.Context) {
 
 	var err error
 
 	c.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListNamespacesParams
+	var params ListAdminInstanceSizesParams
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -2333,28 +4959,45 @@ func (siw *ServerInterfaceWrapper) ListNamespaces(c *gin.Context) {
 		return
 	}
 
-	// ------------- Optional query parameter "environment" -------------
+	// ------------- Optional query parameter "enabled" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "environment", c.Request.URL.Query(), &params.Environment)
+	err = runtime.BindQueryParameter("form", true, false, "enabled", c.Request.URL.Query(), &params.Enabled)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter environment: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter enabled: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+	// ------------- Optional query parameter "requires_gpu" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "requires_gpu", c.Request.URL.Query(), &params.RequiresGpu)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter requires_gpu: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	siw.Handler.ListNamespaces(c, params)
-}
+	// ------------- Optional query parameter "requires_sriov" -------------
 
-// CreateNamespace operation middleware
-func (siw *ServerInterfaceWrapper) CreateNamespace(c *gin.Context) {
+	err = runtime.BindQueryParameter("form", true, false, "requires_sriov", c.Request.URL.Query(), &params.RequiresSriov)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter requires_sriov: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	c.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "dedicated_cpu" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dedicated_cpu", c.Request.URL.Query(), &params.DedicatedCpu)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dedicated_cpu: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", c.Request.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -2363,42 +5006,28 @@ func (siw *ServerInterfaceWrapper) CreateNamespace(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateNamespace(c)
+	siw.Handler.ListAdminInstanceSizes(c, params)
 }
 
-// DeleteNamespace operation middleware
-func (siw *ServerInterfaceWrapper) DeleteNamespace(c *gin.Context) {
-
-	var err error
-
-	// ------------- Path parameter "namespace_id" -------------
-	var namespaceId NamespaceID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "namespace_id", c.Param("namespace_id"), &namespaceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace_id: %w", err), http.StatusBadRequest)
-		return
-	}
+// CreateAdminInstanceSize operation middleware
+func (siw *ServerInterfaceWrapper) CreateAdminInstanceSize(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteNamespaceParams
-
-	// ------------- Required query parameter "confirm_name" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	if paramValue := c.Query("confirm_name"); paramValue != "" {
+	siw.Handler.CreateAdminInstanceSize(c)
+}
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument confirm_name is required, but not found"), http.StatusBadRequest)
-		return
-	}
+// BulkUpdateAdminInstanceSizes operation middleware
+func (siw *ServerInterfaceWrapper) BulkUpdateAdminInstanceSizes(c *gin.Context) {
 
-	err = runtime.BindQueryParameter("form", true, true, "confirm_name", c.Request.URL.Query(), &params.ConfirmName)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter confirm_name: %w", err), http.StatusBadRequest)
-		return
-	}
+	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -2407,20 +5036,20 @@ func (siw *ServerInterfaceWrapper) DeleteNamespace(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteNamespace(c, namespaceId, params)
+	siw.Handler.BulkUpdateAdminInstanceSizes(c)
 }
 
-// GetNamespace operation middleware
-func (siw *ServerInterfaceWrapper) GetNamespace(c *gin.Context) {
+// DeleteAdminInstanceSize operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminInstanceSize(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "namespace_id" -------------
-	var namespaceId NamespaceID
+	// ------------- Path parameter "instance_size_id" -------------
+	var instanceSizeId InstanceSizeID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "namespace_id", c.Param("namespace_id"), &namespaceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "instance_size_id", c.Param("instance_size_id"), &instanceSizeId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter instance_size_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -2433,20 +5062,20 @@ func (siw *ServerInterfaceWrapper) GetNamespace(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetNamespace(c, namespaceId)
+	siw.Handler.DeleteAdminInstanceSize(c, instanceSizeId)
 }
 
-// UpdateNamespace operation middleware
-func (siw *ServerInterfaceWrapper) UpdateNamespace(c *gin.Context) {
+// GetAdminInstanceSize operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminInstanceSize(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "namespace_id" -------------
-	var namespaceId NamespaceID
+	// ------------- Path parameter "instance_size_id" -------------
+	var instanceSizeId InstanceSizeID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "namespace_id", c.Param("namespace_id"), &namespaceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "instance_size_id", c.Param("instance_size_id"), &instanceSizeId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter instance_size_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -2459,11 +5088,22 @@ func (siw *ServerInterfaceWrapper) UpdateNamespace(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateNamespace(c, namespaceId)
+	siw.Handler.GetAdminInstanceSize(c, instanceSizeId)
 }
 
-// ListPermissions operation middleware
-func (siw *ServerInterfaceWrapper) ListPermissions(c *gin.Context) {
+// UpdateAdminInstanceSize operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAdminInstanceSize(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "instance_size_id" -------------
+	var instanceSizeId InstanceSizeID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "instance_size_id", c.Param("instance_size_id"), &instanceSizeId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter instance_size_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -2474,11 +5114,22 @@ func (siw *ServerInterfaceWrapper) ListPermissions(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListPermissions(c)
+	siw.Handler.UpdateAdminInstanceSize(c, instanceSizeId)
 }
 
-// CreateRateLimitExemption operation middleware
-func (siw *ServerInterfaceWrapper) CreateRateLimitExemption(c *gin.Context) {
+// AssociateInstanceSizeClusters operation middleware
+func (siw *ServerInterfaceWrapper) AssociateInstanceSizeClusters(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "instance_size_id" -------------
+	var instanceSizeId InstanceSizeID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "instance_size_id", c.Param("instance_size_id"), &instanceSizeId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter instance_size_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -2489,24 +5140,42 @@ func (siw *ServerInterfaceWrapper) CreateRateLimitExemption(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateRateLimitExemption(c)
+	siw.Handler.AssociateInstanceSizeClusters(c, instanceSizeId)
 }
 
-// DeleteRateLimitExemption operation middleware
-func (siw *ServerInterfaceWrapper) DeleteRateLimitExemption(c *gin.Context) {
+// ListNamespaces operation middleware
+func (siw *ServerInterfaceWrapper) ListNamespaces(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "user_id" -------------
-	var userId UserID
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListNamespacesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "environment" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "environment", c.Request.URL.Query(), &params.Environment)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter environment: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -2515,11 +5184,11 @@ func (siw *ServerInterfaceWrapper) DeleteRateLimitExemption(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteRateLimitExemption(c, userId)
+	siw.Handler.ListNamespaces(c, params)
 }
 
-// ListRateLimitStatus operation middleware
-func (siw *ServerInterfaceWrapper) ListRateLimitStatus(c *gin.Context) {
+// CreateNamespace operation middleware
+func (siw *ServerInterfaceWrapper) CreateNamespace(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -2530,24 +5199,41 @@ func (siw *ServerInterfaceWrapper) ListRateLimitStatus(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListRateLimitStatus(c)
+	siw.Handler.CreateNamespace(c)
 }
 
-// UpdateRateLimitUserOverrides operation middleware
-func (siw *ServerInterfaceWrapper) UpdateRateLimitUserOverrides(c *gin.Context) {
+// SyncNamespaces operation middleware
+func (siw *ServerInterfaceWrapper) SyncNamespaces(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "user_id" -------------
-	var userId UserID
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SyncNamespacesParams
+
+	// ------------- Required query parameter "cluster_id" -------------
+
+	if paramValue := c.Query("cluster_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument cluster_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "cluster_id", c.Request.URL.Query(), &params.ClusterId)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cluster_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "disable_missing" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "disable_missing", c.Request.URL.Query(), &params.DisableMissing)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter disable_missing: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -2556,14 +5242,43 @@ func (siw *ServerInterfaceWrapper) UpdateRateLimitUserOverrides(c *gin.Context)
 		}
 	}
 
-	siw.Handler.UpdateRateLimitUserOverrides(c, userId)
+	siw.Handler.SyncNamespaces(c, params)
 }
 
-// ListRoles operation middleware
-func (siw *ServerInterfaceWrapper) ListRoles(c *gin.Context) {
+// DeleteNamespace operation middleware
+func (siw *ServerInterfaceWrapper) DeleteNamespace(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "namespace_id" -------------
+	var namespaceId NamespaceID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace_id", c.Param("namespace_id"), &namespaceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteNamespaceParams
+
+	// ------------- Required query parameter "confirm_name" -------------
+
+	if paramValue := c.Query("confirm_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument confirm_name is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "confirm_name", c.Request.URL.Query(), &params.ConfirmName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter confirm_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -2571,11 +5286,22 @@ func (siw *ServerInterfaceWrapper) ListRoles(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListRoles(c)
+	siw.Handler.DeleteNamespace(c, namespaceId, params)
 }
 
-// CreateRole operation middleware
-func (siw *ServerInterfaceWrapper) CreateRole(c *gin.Context) {
+// GetNamespace operation middleware
+func (siw *ServerInterfaceWrapper) GetNamespace(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "namespace_id" -------------
+	var namespaceId NamespaceID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace_id", c.Param("namespace_id"), &namespaceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -2586,20 +5312,20 @@ func (siw *ServerInterfaceWrapper) CreateRole(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateRole(c)
+	siw.Handler.GetNamespace(c, namespaceId)
 }
 
-// DeleteRole operation middleware
-func (siw *ServerInterfaceWrapper) DeleteRole(c *gin.Context) {
+// UpdateNamespace operation middleware
+func (siw *ServerInterfaceWrapper) UpdateNamespace(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "role_id" -------------
-	var roleId RoleID
+	// ------------- Path parameter "namespace_id" -------------
+	var namespaceId NamespaceID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "role_id", c.Param("role_id"), &roleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace_id", c.Param("namespace_id"), &namespaceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter role_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -2612,20 +5338,20 @@ func (siw *ServerInterfaceWrapper) DeleteRole(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteRole(c, roleId)
+	siw.Handler.UpdateNamespace(c, namespaceId)
 }
 
-// UpdateRole operation middleware
-func (siw *ServerInterfaceWrapper) UpdateRole(c *gin.Context) {
+// MigrateNamespaceEnvironment operation middleware
+func (siw *ServerInterfaceWrapper) MigrateNamespaceEnvironment(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "role_id" -------------
-	var roleId RoleID
+	// ------------- Path parameter "namespace_id" -------------
+	var namespaceId NamespaceID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "role_id", c.Param("role_id"), &roleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace_id", c.Param("namespace_id"), &namespaceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter role_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -2638,35 +5364,51 @@ func (siw *ServerInterfaceWrapper) UpdateRole(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateRole(c, roleId)
+	siw.Handler.MigrateNamespaceEnvironment(c, namespaceId)
 }
 
-// ListAdminTemplates operation middleware
-func (siw *ServerInterfaceWrapper) ListAdminTemplates(c *gin.Context) {
+// DeleteNamespaceQuota operation middleware
+func (siw *ServerInterfaceWrapper) DeleteNamespaceQuota(c *gin.Context) {
 
 	var err error
 
+	// ------------- Path parameter "namespace_id" -------------
+	var namespaceId NamespaceID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace_id", c.Param("namespace_id"), &namespaceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	c.Set(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListAdminTemplatesParams
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// ------------- Optional query parameter "page" -------------
+	siw.Handler.DeleteNamespaceQuota(c, namespaceId)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
-		return
-	}
+// UpdateNamespaceQuota operation middleware
+func (siw *ServerInterfaceWrapper) UpdateNamespaceQuota(c *gin.Context) {
 
-	// ------------- Optional query parameter "per_page" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	// ------------- Path parameter "namespace_id" -------------
+	var namespaceId NamespaceID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace_id", c.Param("namespace_id"), &namespaceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(BearerAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -2674,11 +5416,22 @@ func (siw *ServerInterfaceWrapper) ListAdminTemplates(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListAdminTemplates(c, params)
+	siw.Handler.UpdateNamespaceQuota(c, namespaceId)
 }
 
-// CreateAdminTemplate operation middleware
-func (siw *ServerInterfaceWrapper) CreateAdminTemplate(c *gin.Context) {
+// CreateNamespaceQuota operation middleware
+func (siw *ServerInterfaceWrapper) CreateNamespaceQuota(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "namespace_id" -------------
+	var namespaceId NamespaceID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace_id", c.Param("namespace_id"), &namespaceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -2689,23 +5442,27 @@ func (siw *ServerInterfaceWrapper) CreateAdminTemplate(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateAdminTemplate(c)
+	siw.Handler.CreateNamespaceQuota(c, namespaceId)
 }
 
-// DeleteAdminTemplate operation middleware
-func (siw *ServerInterfaceWrapper) DeleteAdminTemplate(c *gin.Context) {
-
-	var err error
+// ListPermissions operation middleware
+func (siw *ServerInterfaceWrapper) ListPermissions(c *gin.Context) {
 
-	// ------------- Path parameter "template_id" -------------
-	var templateId TemplateID
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "template_id", c.Param("template_id"), &templateId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter template_id: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
+	siw.Handler.ListPermissions(c)
+}
+
+// GetPermissionMatrix operation middleware
+func (siw *ServerInterfaceWrapper) GetPermissionMatrix(c *gin.Context) {
+
 	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2715,23 +5472,27 @@ func (siw *ServerInterfaceWrapper) DeleteAdminTemplate(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteAdminTemplate(c, templateId)
+	siw.Handler.GetPermissionMatrix(c)
 }
 
-// UpdateAdminTemplate operation middleware
-func (siw *ServerInterfaceWrapper) UpdateAdminTemplate(c *gin.Context) {
-
-	var err error
+// GetPlatformConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetPlatformConfig(c *gin.Context) {
 
-	// ------------- Path parameter "template_id" -------------
-	var templateId TemplateID
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "template_id", c.Param("template_id"), &templateId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter template_id: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
+	siw.Handler.GetPlatformConfig(c)
+}
+
+// PatchPlatformConfig operation middleware
+func (siw *ServerInterfaceWrapper) PatchPlatformConfig(c *gin.Context) {
+
 	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2741,18 +5502,34 @@ func (siw *ServerInterfaceWrapper) UpdateAdminTemplate(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateAdminTemplate(c, templateId)
+	siw.Handler.PatchPlatformConfig(c)
 }
 
-// ListUsers operation middleware
-func (siw *ServerInterfaceWrapper) ListUsers(c *gin.Context) {
+// ListQuotas operation middleware
+func (siw *ServerInterfaceWrapper) ListQuotas(c *gin.Context) {
 
 	var err error
 
 	c.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListUsersParams
+	var params ListQuotasParams
+
+	// ------------- Optional query parameter "scope_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scope_type", c.Request.URL.Query(), &params.ScopeType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scope_type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "scope_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scope_id", c.Request.URL.Query(), &params.ScopeId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scope_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -2777,11 +5554,11 @@ func (siw *ServerInterfaceWrapper) ListUsers(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListUsers(c, params)
+	siw.Handler.ListQuotas(c, params)
 }
 
-// CreateUser operation middleware
-func (siw *ServerInterfaceWrapper) CreateUser(c *gin.Context) {
+// CreateQuota operation middleware
+func (siw *ServerInterfaceWrapper) CreateQuota(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -2792,20 +5569,20 @@ func (siw *ServerInterfaceWrapper) CreateUser(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateUser(c)
+	siw.Handler.CreateQuota(c)
 }
 
-// DeleteUser operation middleware
-func (siw *ServerInterfaceWrapper) DeleteUser(c *gin.Context) {
+// DeleteQuota operation middleware
+func (siw *ServerInterfaceWrapper) DeleteQuota(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "user_id" -------------
-	var userId UserID
+	// ------------- Path parameter "quota_id" -------------
+	var quotaId QuotaID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "quota_id", c.Param("quota_id"), &quotaId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter quota_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -2818,20 +5595,20 @@ func (siw *ServerInterfaceWrapper) DeleteUser(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteUser(c, userId)
+	siw.Handler.DeleteQuota(c, quotaId)
 }
 
-// UpdateUser operation middleware
-func (siw *ServerInterfaceWrapper) UpdateUser(c *gin.Context) {
+// GetQuota operation middleware
+func (siw *ServerInterfaceWrapper) GetQuota(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "user_id" -------------
-	var userId UserID
+	// ------------- Path parameter "quota_id" -------------
+	var quotaId QuotaID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "quota_id", c.Param("quota_id"), &quotaId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter quota_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -2844,20 +5621,20 @@ func (siw *ServerInterfaceWrapper) UpdateUser(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateUser(c, userId)
+	siw.Handler.GetQuota(c, quotaId)
 }
 
-// ListUserRoleBindings operation middleware
-func (siw *ServerInterfaceWrapper) ListUserRoleBindings(c *gin.Context) {
+// UpdateQuota operation middleware
+func (siw *ServerInterfaceWrapper) UpdateQuota(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "user_id" -------------
-	var userId UserID
+	// ------------- Path parameter "quota_id" -------------
+	var quotaId QuotaID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "quota_id", c.Param("quota_id"), &quotaId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter quota_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -2870,11 +5647,11 @@ func (siw *ServerInterfaceWrapper) ListUserRoleBindings(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListUserRoleBindings(c, userId)
+	siw.Handler.UpdateQuota(c, quotaId)
 }
 
-// CreateUserRoleBinding operation middleware
-func (siw *ServerInterfaceWrapper) CreateUserRoleBinding(c *gin.Context) {
+// GetEffectiveRateLimitPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetEffectiveRateLimitPolicy(c *gin.Context) {
 
 	var err error
 
@@ -2896,31 +5673,26 @@ func (siw *ServerInterfaceWrapper) CreateUserRoleBinding(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateUserRoleBinding(c, userId)
+	siw.Handler.GetEffectiveRateLimitPolicy(c, userId)
 }
 
-// DeleteUserRoleBinding operation middleware
-func (siw *ServerInterfaceWrapper) DeleteUserRoleBinding(c *gin.Context) {
-
-	var err error
+// ListRateLimitExemptions operation middleware
+func (siw *ServerInterfaceWrapper) ListRateLimitExemptions(c *gin.Context) {
 
-	// ------------- Path parameter "user_id" -------------
-	var userId UserID
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// ------------- Path parameter "binding_id" -------------
-	var bindingId RoleBindingID
+	siw.Handler.ListRateLimitExemptions(c)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "binding_id", c.Param("binding_id"), &bindingId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter binding_id: %w", err), http.StatusBadRequest)
-		return
-	}
+// CreateRateLimitExemption operation middleware
+func (siw *ServerInterfaceWrapper) CreateRateLimitExemption(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -2931,42 +5703,24 @@ func (siw *ServerInterfaceWrapper) DeleteUserRoleBinding(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteUserRoleBinding(c, userId, bindingId)
+	siw.Handler.CreateRateLimitExemption(c)
 }
 
-// ListApprovals operation middleware
-func (siw *ServerInterfaceWrapper) ListApprovals(c *gin.Context) {
+// DeleteRateLimitExemption operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRateLimitExemption(c *gin.Context) {
 
 	var err error
 
-	c.Set(BearerAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListApprovalsParams
-
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Optional query parameter "per_page" -------------
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
 
-	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "status" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
-		return
-	}
+	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -2975,11 +5729,11 @@ func (siw *ServerInterfaceWrapper) ListApprovals(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListApprovals(c, params)
+	siw.Handler.DeleteRateLimitExemption(c, userId)
 }
 
-// SubmitApprovalBatch operation middleware
-func (siw *ServerInterfaceWrapper) SubmitApprovalBatch(c *gin.Context) {
+// ListRateLimitStatus operation middleware
+func (siw *ServerInterfaceWrapper) ListRateLimitStatus(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -2990,20 +5744,20 @@ func (siw *ServerInterfaceWrapper) SubmitApprovalBatch(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.SubmitApprovalBatch(c)
+	siw.Handler.ListRateLimitStatus(c)
 }
 
-// ApproveTicket operation middleware
-func (siw *ServerInterfaceWrapper) ApproveTicket(c *gin.Context) {
+// RemoveRateLimitUserOverride operation middleware
+func (siw *ServerInterfaceWrapper) RemoveRateLimitUserOverride(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "ticket_id" -------------
-	var ticketId TicketID
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ticket_id", c.Param("ticket_id"), &ticketId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter ticket_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -3016,20 +5770,20 @@ func (siw *ServerInterfaceWrapper) ApproveTicket(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ApproveTicket(c, ticketId)
+	siw.Handler.RemoveRateLimitUserOverride(c, userId)
 }
 
-// CancelTicket operation middleware
-func (siw *ServerInterfaceWrapper) CancelTicket(c *gin.Context) {
+// GetRateLimitUserOverride operation middleware
+func (siw *ServerInterfaceWrapper) GetRateLimitUserOverride(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "ticket_id" -------------
-	var ticketId TicketID
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ticket_id", c.Param("ticket_id"), &ticketId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter ticket_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -3042,20 +5796,20 @@ func (siw *ServerInterfaceWrapper) CancelTicket(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CancelTicket(c, ticketId)
+	siw.Handler.GetRateLimitUserOverride(c, userId)
 }
 
-// RejectTicket operation middleware
-func (siw *ServerInterfaceWrapper) RejectTicket(c *gin.Context) {
+// PatchRateLimitUserOverride operation middleware
+func (siw *ServerInterfaceWrapper) PatchRateLimitUserOverride(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "ticket_id" -------------
-	var ticketId TicketID
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ticket_id", c.Param("ticket_id"), &ticketId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter ticket_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -3068,64 +5822,157 @@ func (siw *ServerInterfaceWrapper) RejectTicket(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RejectTicket(c, ticketId)
+	siw.Handler.PatchRateLimitUserOverride(c, userId)
 }
 
-// ListAuditLogs operation middleware
-func (siw *ServerInterfaceWrapper) ListAuditLogs(c *gin.Context) {
+// CreateRateLimitUserOverride operation middleware
+func (siw *ServerInterfaceWrapper) CreateRateLimitUserOverride(c *gin.Context) {
 
 	var err error
 
-	c.Set(BearerAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListAuditLogsParams
-
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
 
-	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "per_page" -------------
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// ------------- Optional query parameter "action" -------------
+	siw.Handler.CreateRateLimitUserOverride(c, userId)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "action", c.Request.URL.Query(), &params.Action)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter action: %w", err), http.StatusBadRequest)
-		return
-	}
+// ListRateLimitUserOverrides operation middleware
+func (siw *ServerInterfaceWrapper) ListRateLimitUserOverrides(c *gin.Context) {
 
-	// ------------- Optional query parameter "actor" -------------
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "actor", c.Request.URL.Query(), &params.Actor)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListRateLimitUserOverrides(c)
+}
+
+// DeleteRateLimitUserOverrides operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRateLimitUserOverrides(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter actor: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "resource_type" -------------
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "resource_type", c.Request.URL.Query(), &params.ResourceType)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteRateLimitUserOverrides(c, userId)
+}
+
+// UpdateRateLimitUserOverrides operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRateLimitUserOverrides(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter resource_type: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "resource_id" -------------
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "resource_id", c.Request.URL.Query(), &params.ResourceId)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateRateLimitUserOverrides(c, userId)
+}
+
+// ListRoleBindings operation middleware
+func (siw *ServerInterfaceWrapper) ListRoleBindings(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRoleBindingsParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", c.Request.URL.Query(), &params.UserId)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter resource_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "role_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "role_id", c.Request.URL.Query(), &params.RoleId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter role_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "scope_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scope_type", c.Request.URL.Query(), &params.ScopeType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scope_type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "scope_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scope_id", c.Request.URL.Query(), &params.ScopeId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scope_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -3136,11 +5983,11 @@ func (siw *ServerInterfaceWrapper) ListAuditLogs(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListAuditLogs(c, params)
+	siw.Handler.ListRoleBindings(c, params)
 }
 
-// ChangePassword operation middleware
-func (siw *ServerInterfaceWrapper) ChangePassword(c *gin.Context) {
+// CreateRoleBinding operation middleware
+func (siw *ServerInterfaceWrapper) CreateRoleBinding(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -3151,11 +5998,24 @@ func (siw *ServerInterfaceWrapper) ChangePassword(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ChangePassword(c)
+	siw.Handler.CreateRoleBinding(c)
 }
 
-// Login operation middleware
-func (siw *ServerInterfaceWrapper) Login(c *gin.Context) {
+// DeleteRoleBinding operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRoleBinding(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "binding_id" -------------
+	var bindingId RoleBindingID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "binding_id", c.Param("binding_id"), &bindingId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter binding_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -3164,11 +6024,11 @@ func (siw *ServerInterfaceWrapper) Login(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.Login(c)
+	siw.Handler.DeleteRoleBinding(c, bindingId)
 }
 
-// GetCurrentUser operation middleware
-func (siw *ServerInterfaceWrapper) GetCurrentUser(c *gin.Context) {
+// ListRoles operation middleware
+func (siw *ServerInterfaceWrapper) ListRoles(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -3179,11 +6039,13 @@ func (siw *ServerInterfaceWrapper) GetCurrentUser(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetCurrentUser(c)
+	siw.Handler.ListRoles(c)
 }
 
-// GetLiveness operation middleware
-func (siw *ServerInterfaceWrapper) GetLiveness(c *gin.Context) {
+// CreateRole operation middleware
+func (siw *ServerInterfaceWrapper) CreateRole(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -3192,11 +6054,24 @@ func (siw *ServerInterfaceWrapper) GetLiveness(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetLiveness(c)
+	siw.Handler.CreateRole(c)
 }
 
-// GetReadiness operation middleware
-func (siw *ServerInterfaceWrapper) GetReadiness(c *gin.Context) {
+// DeleteRole operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRole(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "role_id" -------------
+	var roleId RoleID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "role_id", c.Param("role_id"), &roleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter role_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -3205,11 +6080,22 @@ func (siw *ServerInterfaceWrapper) GetReadiness(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetReadiness(c)
+	siw.Handler.DeleteRole(c, roleId)
 }
 
-// ListInstanceSizes operation middleware
-func (siw *ServerInterfaceWrapper) ListInstanceSizes(c *gin.Context) {
+// UpdateRole operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRole(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "role_id" -------------
+	var roleId RoleID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "role_id", c.Param("role_id"), &roleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter role_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -3220,18 +6106,44 @@ func (siw *ServerInterfaceWrapper) ListInstanceSizes(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListInstanceSizes(c)
+	siw.Handler.UpdateRole(c, roleId)
 }
 
-// ListNotifications operation middleware
-func (siw *ServerInterfaceWrapper) ListNotifications(c *gin.Context) {
+// CloneRole operation middleware
+func (siw *ServerInterfaceWrapper) CloneRole(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "role_id" -------------
+	var roleId RoleID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "role_id", c.Param("role_id"), &roleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter role_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CloneRole(c, roleId)
+}
+
+// ListScheduledJobs operation middleware
+func (siw *ServerInterfaceWrapper) ListScheduledJobs(c *gin.Context) {
 
 	var err error
 
 	c.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListNotificationsParams
+	var params ListScheduledJobsParams
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -3249,14 +6161,6 @@ func (siw *ServerInterfaceWrapper) ListNotifications(c *gin.Context) {
 		return
 	}
 
-	// ------------- Optional query parameter "unread_only" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "unread_only", c.Request.URL.Query(), &params.UnreadOnly)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter unread_only: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -3264,11 +6168,11 @@ func (siw *ServerInterfaceWrapper) ListNotifications(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListNotifications(c, params)
+	siw.Handler.ListScheduledJobs(c, params)
 }
 
-// MarkAllNotificationsRead operation middleware
-func (siw *ServerInterfaceWrapper) MarkAllNotificationsRead(c *gin.Context) {
+// CreateScheduledJob operation middleware
+func (siw *ServerInterfaceWrapper) CreateScheduledJob(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -3279,11 +6183,22 @@ func (siw *ServerInterfaceWrapper) MarkAllNotificationsRead(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.MarkAllNotificationsRead(c)
+	siw.Handler.CreateScheduledJob(c)
 }
 
-// GetUnreadCount operation middleware
-func (siw *ServerInterfaceWrapper) GetUnreadCount(c *gin.Context) {
+// DeleteScheduledJob operation middleware
+func (siw *ServerInterfaceWrapper) DeleteScheduledJob(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "scheduled_job_id" -------------
+	var scheduledJobId ScheduledJobID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduled_job_id", c.Param("scheduled_job_id"), &scheduledJobId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scheduled_job_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -3294,20 +6209,20 @@ func (siw *ServerInterfaceWrapper) GetUnreadCount(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetUnreadCount(c)
+	siw.Handler.DeleteScheduledJob(c, scheduledJobId)
 }
 
-// MarkNotificationRead operation middleware
-func (siw *ServerInterfaceWrapper) MarkNotificationRead(c *gin.Context) {
+// GetScheduledJob operation middleware
+func (siw *ServerInterfaceWrapper) GetScheduledJob(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "notification_id" -------------
-	var notificationId NotificationID
+	// ------------- Path parameter "scheduled_job_id" -------------
+	var scheduledJobId ScheduledJobID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "notification_id", c.Param("notification_id"), &notificationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduled_job_id", c.Param("scheduled_job_id"), &scheduledJobId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter notification_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scheduled_job_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -3320,48 +6235,2790 @@ func (siw *ServerInterfaceWrapper) MarkNotificationRead(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.MarkNotificationRead(c, notificationId)
+	siw.Handler.GetScheduledJob(c, scheduledJobId)
 }
 
-// ListSystems operation middleware
-func (siw *ServerInterfaceWrapper) ListSystems(c *gin.Context) {
+// UpdateScheduledJob operation middleware
+func (siw *ServerInterfaceWrapper) UpdateScheduledJob(c *gin.Context) {
 
 	var err error
 
-	c.Set(BearerAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListSystemsParams
+	// ------------- Path parameter "scheduled_job_id" -------------
+	var scheduledJobId ScheduledJobID
 
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduled_job_id", c.Param("scheduled_job_id"), &scheduledJobId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scheduled_job_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "per_page" -------------
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateScheduledJob(c, scheduledJobId)
+}
+
+// ReindexServiceInstances operation middleware
+func (siw *ServerInterfaceWrapper) ReindexServiceInstances(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "service_id" -------------
+	var serviceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "service_id", c.Param("service_id"), &serviceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter service_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "sort_by" -------------
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_by", c.Request.URL.Query(), &params.SortBy)
-	if err != nil {
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ReindexServiceInstances(c, serviceId)
+}
+
+// GetSpecOverridePolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetSpecOverridePolicy(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSpecOverridePolicy(c)
+}
+
+// PutSpecOverridePolicy operation middleware
+func (siw *ServerInterfaceWrapper) PutSpecOverridePolicy(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutSpecOverridePolicy(c)
+}
+
+// GetSystemTopology operation middleware
+func (siw *ServerInterfaceWrapper) GetSystemTopology(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "system_id" -------------
+	var systemId SystemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSystemTopology(c, systemId)
+}
+
+// GetSystemUsageReport operation middleware
+func (siw *ServerInterfaceWrapper) GetSystemUsageReport(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "system_id" -------------
+	var systemId SystemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSystemUsageReportParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument from is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := c.Query("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument to is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSystemUsageReport(c, systemId, params)
+}
+
+// ListAdminTemplates operation middleware
+func (siw *ServerInterfaceWrapper) ListAdminTemplates(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAdminTemplatesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "include_deprecated" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_deprecated", c.Request.URL.Query(), &params.IncludeDeprecated)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_deprecated: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListAdminTemplates(c, params)
+}
+
+// CreateAdminTemplate operation middleware
+func (siw *ServerInterfaceWrapper) CreateAdminTemplate(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateAdminTemplateParams
+
+	// ------------- Optional query parameter "validate_only" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "validate_only", c.Request.URL.Query(), &params.ValidateOnly)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter validate_only: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateAdminTemplate(c, params)
+}
+
+// DeleteAdminTemplate operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminTemplate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "template_id" -------------
+	var templateId TemplateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "template_id", c.Param("template_id"), &templateId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter template_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteAdminTemplateParams
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", c.Request.URL.Query(), &params.Force)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter force: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteAdminTemplate(c, templateId, params)
+}
+
+// GetAdminTemplate operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminTemplate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "template_id" -------------
+	var templateId TemplateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "template_id", c.Param("template_id"), &templateId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter template_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminTemplate(c, templateId)
+}
+
+// UpdateAdminTemplate operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAdminTemplate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "template_id" -------------
+	var templateId TemplateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "template_id", c.Param("template_id"), &templateId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter template_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateAdminTemplateParams
+
+	// ------------- Optional query parameter "validate_only" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "validate_only", c.Request.URL.Query(), &params.ValidateOnly)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter validate_only: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateAdminTemplate(c, templateId, params)
+}
+
+// CloneAdminTemplate operation middleware
+func (siw *ServerInterfaceWrapper) CloneAdminTemplate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "template_id" -------------
+	var templateId TemplateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "template_id", c.Param("template_id"), &templateId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter template_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CloneAdminTemplate(c, templateId)
+}
+
+// DeprecateAdminTemplate operation middleware
+func (siw *ServerInterfaceWrapper) DeprecateAdminTemplate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "template_id" -------------
+	var templateId TemplateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "template_id", c.Param("template_id"), &templateId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter template_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeprecateAdminTemplate(c, templateId)
+}
+
+// PromoteAdminTemplate operation middleware
+func (siw *ServerInterfaceWrapper) PromoteAdminTemplate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "template_id" -------------
+	var templateId TemplateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "template_id", c.Param("template_id"), &templateId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter template_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PromoteAdminTemplate(c, templateId)
+}
+
+// ListUsers operation middleware
+func (siw *ServerInterfaceWrapper) ListUsers(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUsersParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListUsers(c, params)
+}
+
+// CreateUser operation middleware
+func (siw *ServerInterfaceWrapper) CreateUser(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateUser(c)
+}
+
+// DeleteUser operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUser(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteUser(c, userId)
+}
+
+// UpdateUser operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUser(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateUser(c, userId)
+}
+
+// ForceUserPasswordReset operation middleware
+func (siw *ServerInterfaceWrapper) ForceUserPasswordReset(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ForceUserPasswordReset(c, userId)
+}
+
+// RevokeUserSessions operation middleware
+func (siw *ServerInterfaceWrapper) RevokeUserSessions(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RevokeUserSessions(c, userId)
+}
+
+// ListUserRoleBindings operation middleware
+func (siw *ServerInterfaceWrapper) ListUserRoleBindings(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListUserRoleBindings(c, userId)
+}
+
+// CreateUserRoleBinding operation middleware
+func (siw *ServerInterfaceWrapper) CreateUserRoleBinding(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateUserRoleBinding(c, userId)
+}
+
+// DeleteUserRoleBinding operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUserRoleBinding(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "binding_id" -------------
+	var bindingId RoleBindingID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "binding_id", c.Param("binding_id"), &bindingId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter binding_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteUserRoleBinding(c, userId, bindingId)
+}
+
+// UnlockUser operation middleware
+func (siw *ServerInterfaceWrapper) UnlockUser(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UnlockUser(c, userId)
+}
+
+// ListWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhooks(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhooksParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListWebhooks(c, params)
+}
+
+// CreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhook(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateWebhook(c)
+}
+
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "webhook_id" -------------
+	var webhookId WebhookID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_id", c.Param("webhook_id"), &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhook_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteWebhook(c, webhookId)
+}
+
+// GetWebhook operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhook(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "webhook_id" -------------
+	var webhookId WebhookID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_id", c.Param("webhook_id"), &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhook_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetWebhook(c, webhookId)
+}
+
+// UpdateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWebhook(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "webhook_id" -------------
+	var webhookId WebhookID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_id", c.Param("webhook_id"), &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhook_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateWebhook(c, webhookId)
+}
+
+// ListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "webhook_id" -------------
+	var webhookId WebhookID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_id", c.Param("webhook_id"), &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhook_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookDeliveriesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListWebhookDeliveries(c, webhookId, params)
+}
+
+// TestWebhook operation middleware
+func (siw *ServerInterfaceWrapper) TestWebhook(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "webhook_id" -------------
+	var webhookId WebhookID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_id", c.Param("webhook_id"), &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhook_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TestWebhook(c, webhookId)
+}
+
+// ListApprovals operation middleware
+func (siw *ServerInterfaceWrapper) ListApprovals(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListApprovalsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "operation_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "operation_type", c.Request.URL.Query(), &params.OperationType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter operation_type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "requester" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "requester", c.Request.URL.Query(), &params.Requester)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter requester: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "approver" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "approver", c.Request.URL.Query(), &params.Approver)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter approver: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "service_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "service_id", c.Request.URL.Query(), &params.ServiceId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter service_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", c.Request.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "created_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_after", c.Request.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter created_after: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "created_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_before", c.Request.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter created_before: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "parent_only" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parent_only", c.Request.URL.Query(), &params.ParentOnly)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter parent_only: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "assigned_to_me" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "assigned_to_me", c.Request.URL.Query(), &params.AssignedToMe)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter assigned_to_me: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_by", c.Request.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort_by: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort_order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_order", c.Request.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort_order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListApprovals(c, params)
+}
+
+// SubmitApprovalBatch operation middleware
+func (siw *ServerInterfaceWrapper) SubmitApprovalBatch(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SubmitApprovalBatch(c)
+}
+
+// ApproveBatch operation middleware
+func (siw *ServerInterfaceWrapper) ApproveBatch(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "batch_id" -------------
+	var batchId BatchID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "batch_id", c.Param("batch_id"), &batchId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter batch_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ApproveBatch(c, batchId)
+}
+
+// GetApproval operation middleware
+func (siw *ServerInterfaceWrapper) GetApproval(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "ticket_id" -------------
+	var ticketId TicketID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ticket_id", c.Param("ticket_id"), &ticketId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter ticket_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApproval(c, ticketId)
+}
+
+// UpdateApprovalTicket operation middleware
+func (siw *ServerInterfaceWrapper) UpdateApprovalTicket(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "ticket_id" -------------
+	var ticketId TicketID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ticket_id", c.Param("ticket_id"), &ticketId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter ticket_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateApprovalTicket(c, ticketId)
+}
+
+// ApproveTicket operation middleware
+func (siw *ServerInterfaceWrapper) ApproveTicket(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "ticket_id" -------------
+	var ticketId TicketID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ticket_id", c.Param("ticket_id"), &ticketId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter ticket_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ApproveTicket(c, ticketId)
+}
+
+// CancelTicket operation middleware
+func (siw *ServerInterfaceWrapper) CancelTicket(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "ticket_id" -------------
+	var ticketId TicketID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ticket_id", c.Param("ticket_id"), &ticketId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter ticket_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CancelTicket(c, ticketId)
+}
+
+// ListTicketComments operation middleware
+func (siw *ServerInterfaceWrapper) ListTicketComments(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "ticket_id" -------------
+	var ticketId TicketID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ticket_id", c.Param("ticket_id"), &ticketId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter ticket_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListTicketComments(c, ticketId)
+}
+
+// CreateTicketComment operation middleware
+func (siw *ServerInterfaceWrapper) CreateTicketComment(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "ticket_id" -------------
+	var ticketId TicketID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ticket_id", c.Param("ticket_id"), &ticketId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter ticket_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateTicketComment(c, ticketId)
+}
+
+// DeleteTicketComment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTicketComment(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "ticket_id" -------------
+	var ticketId TicketID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ticket_id", c.Param("ticket_id"), &ticketId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter ticket_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "comment_id" -------------
+	var commentId CommentID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "comment_id", c.Param("comment_id"), &commentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter comment_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteTicketComment(c, ticketId, commentId)
+}
+
+// PreviewApprovalTicket operation middleware
+func (siw *ServerInterfaceWrapper) PreviewApprovalTicket(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "ticket_id" -------------
+	var ticketId TicketID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ticket_id", c.Param("ticket_id"), &ticketId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter ticket_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PreviewApprovalTicket(c, ticketId)
+}
+
+// ReassignTicket operation middleware
+func (siw *ServerInterfaceWrapper) ReassignTicket(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "ticket_id" -------------
+	var ticketId TicketID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ticket_id", c.Param("ticket_id"), &ticketId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter ticket_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ReassignTicket(c, ticketId)
+}
+
+// RejectTicket operation middleware
+func (siw *ServerInterfaceWrapper) RejectTicket(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "ticket_id" -------------
+	var ticketId TicketID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ticket_id", c.Param("ticket_id"), &ticketId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter ticket_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RejectTicket(c, ticketId)
+}
+
+// ListAuditLogs operation middleware
+func (siw *ServerInterfaceWrapper) ListAuditLogs(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAuditLogsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", c.Request.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter action: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", c.Request.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter actor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "resource_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resource_type", c.Request.URL.Query(), &params.ResourceType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter resource_type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "resource_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resource_id", c.Request.URL.Query(), &params.ResourceId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter resource_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListAuditLogs(c, params)
+}
+
+// ExportAuditLogs operation middleware
+func (siw *ServerInterfaceWrapper) ExportAuditLogs(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportAuditLogsParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", c.Request.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter action: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", c.Request.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter actor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "resource_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resource_type", c.Request.URL.Query(), &params.ResourceType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter resource_type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "resource_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resource_id", c.Request.URL.Query(), &params.ResourceId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter resource_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ExportAuditLogs(c, params)
+}
+
+// ChangePassword operation middleware
+func (siw *ServerInterfaceWrapper) ChangePassword(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ChangePassword(c)
+}
+
+// Login operation middleware
+func (siw *ServerInterfaceWrapper) Login(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.Login(c)
+}
+
+// GetCurrentUser operation middleware
+func (siw *ServerInterfaceWrapper) GetCurrentUser(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCurrentUser(c)
+}
+
+// GetPasswordPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetPasswordPolicy(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPasswordPolicy(c)
+}
+
+// RefreshToken operation middleware
+func (siw *ServerInterfaceWrapper) RefreshToken(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RefreshToken(c)
+}
+
+// SamlAssertionConsumer operation middleware
+func (siw *ServerInterfaceWrapper) SamlAssertionConsumer(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "provider_id" -------------
+	var providerId ProviderID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider_id", c.Param("provider_id"), &providerId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SamlAssertionConsumer(c, providerId)
+}
+
+// ListLoginSessions operation middleware
+func (siw *ServerInterfaceWrapper) ListLoginSessions(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListLoginSessions(c)
+}
+
+// RevokeLoginSession operation middleware
+func (siw *ServerInterfaceWrapper) RevokeLoginSession(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "session_id" -------------
+	var sessionId LoginSessionID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session_id", c.Param("session_id"), &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter session_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RevokeLoginSession(c, sessionId)
+}
+
+// GetLiveness operation middleware
+func (siw *ServerInterfaceWrapper) GetLiveness(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetLiveness(c)
+}
+
+// GetReadiness operation middleware
+func (siw *ServerInterfaceWrapper) GetReadiness(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetReadiness(c)
+}
+
+// GetHealthz operation middleware
+func (siw *ServerInterfaceWrapper) GetHealthz(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetHealthz(c)
+}
+
+// ListInstanceSizes operation middleware
+func (siw *ServerInterfaceWrapper) ListInstanceSizes(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListInstanceSizesParams
+
+	// ------------- Optional query parameter "requires_gpu" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "requires_gpu", c.Request.URL.Query(), &params.RequiresGpu)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter requires_gpu: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "requires_sriov" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "requires_sriov", c.Request.URL.Query(), &params.RequiresSriov)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter requires_sriov: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "dedicated_cpu" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dedicated_cpu", c.Request.URL.Query(), &params.DedicatedCpu)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dedicated_cpu: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", c.Request.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListInstanceSizes(c, params)
+}
+
+// ListNotifications operation middleware
+func (siw *ServerInterfaceWrapper) ListNotifications(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListNotificationsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "unread_only" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unread_only", c.Request.URL.Query(), &params.UnreadOnly)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter unread_only: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListNotifications(c, params)
+}
+
+// MarkAllNotificationsRead operation middleware
+func (siw *ServerInterfaceWrapper) MarkAllNotificationsRead(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MarkAllNotificationsRead(c)
+}
+
+// GetNotificationPreferences operation middleware
+func (siw *ServerInterfaceWrapper) GetNotificationPreferences(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetNotificationPreferences(c)
+}
+
+// PutNotificationPreferences operation middleware
+func (siw *ServerInterfaceWrapper) PutNotificationPreferences(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutNotificationPreferences(c)
+}
+
+// GetUnreadCount operation middleware
+func (siw *ServerInterfaceWrapper) GetUnreadCount(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUnreadCount(c)
+}
+
+// MarkNotificationRead operation middleware
+func (siw *ServerInterfaceWrapper) MarkNotificationRead(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "notification_id" -------------
+	var notificationId NotificationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "notification_id", c.Param("notification_id"), &notificationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter notification_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MarkNotificationRead(c, notificationId)
+}
+
+// PatchServiceLabels operation middleware
+func (siw *ServerInterfaceWrapper) PatchServiceLabels(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "service_id" -------------
+	var serviceId ServiceID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "service_id", c.Param("service_id"), &serviceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter service_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchServiceLabels(c, serviceId)
+}
+
+// CreateServiceRoleBinding operation middleware
+func (siw *ServerInterfaceWrapper) CreateServiceRoleBinding(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "service_id" -------------
+	var serviceId ServiceID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "service_id", c.Param("service_id"), &serviceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter service_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateServiceRoleBinding(c, serviceId)
+}
+
+// ListSystems operation middleware
+func (siw *ServerInterfaceWrapper) ListSystems(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSystemsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_by", c.Request.URL.Query(), &params.SortBy)
+	if err != nil {
 		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort_by: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "sort_order" -------------
+	// ------------- Optional query parameter "sort_order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_order", c.Request.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort_order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListSystems(c, params)
+}
+
+// CreateSystem operation middleware
+func (siw *ServerInterfaceWrapper) CreateSystem(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateSystem(c)
+}
+
+// DeleteSystem operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSystem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "system_id" -------------
+	var systemId SystemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteSystemParams
+
+	// ------------- Required query parameter "confirm_name" -------------
+
+	if paramValue := c.Query("confirm_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument confirm_name is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "confirm_name", c.Request.URL.Query(), &params.ConfirmName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter confirm_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cascade" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cascade", c.Request.URL.Query(), &params.Cascade)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cascade: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteSystem(c, systemId, params)
+}
+
+// GetSystem operation middleware
+func (siw *ServerInterfaceWrapper) GetSystem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "system_id" -------------
+	var systemId SystemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSystem(c, systemId)
+}
+
+// UpdateSystem operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSystem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "system_id" -------------
+	var systemId SystemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateSystem(c, systemId)
+}
+
+// AddSystemMaintainer operation middleware
+func (siw *ServerInterfaceWrapper) AddSystemMaintainer(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "system_id" -------------
+	var systemId SystemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AddSystemMaintainer(c, systemId)
+}
+
+// RemoveSystemMaintainer operation middleware
+func (siw *ServerInterfaceWrapper) RemoveSystemMaintainer(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "system_id" -------------
+	var systemId SystemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RemoveSystemMaintainer(c, systemId, userId)
+}
+
+// ListSystemMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListSystemMembers(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "system_id" -------------
+	var systemId SystemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListSystemMembers(c, systemId)
+}
+
+// AddSystemMember operation middleware
+func (siw *ServerInterfaceWrapper) AddSystemMember(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "system_id" -------------
+	var systemId SystemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AddSystemMember(c, systemId)
+}
+
+// DeleteSystemMember operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSystemMember(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "system_id" -------------
+	var systemId SystemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteSystemMember(c, systemId, userId)
+}
+
+// UpdateSystemMemberRole operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSystemMemberRole(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "system_id" -------------
+	var systemId SystemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateSystemMemberRole(c, systemId, userId)
+}
+
+// ListServices operation middleware
+func (siw *ServerInterfaceWrapper) ListServices(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "system_id" -------------
+	var systemId SystemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListServicesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListServices(c, systemId, params)
+}
+
+// CreateService operation middleware
+func (siw *ServerInterfaceWrapper) CreateService(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "system_id" -------------
+	var systemId SystemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateService(c, systemId)
+}
+
+// DeleteService operation middleware
+func (siw *ServerInterfaceWrapper) DeleteService(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "system_id" -------------
+	var systemId SystemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "service_id" -------------
+	var serviceId ServiceID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "service_id", c.Param("service_id"), &serviceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter service_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteServiceParams
+
+	// ------------- Optional query parameter "confirm" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "confirm", c.Request.URL.Query(), &params.Confirm)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter confirm: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cascade" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cascade", c.Request.URL.Query(), &params.Cascade)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cascade: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteService(c, systemId, serviceId, params)
+}
+
+// GetService operation middleware
+func (siw *ServerInterfaceWrapper) GetService(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "system_id" -------------
+	var systemId SystemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "service_id" -------------
+	var serviceId ServiceID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "service_id", c.Param("service_id"), &serviceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter service_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetService(c, systemId, serviceId)
+}
+
+// UpdateService operation middleware
+func (siw *ServerInterfaceWrapper) UpdateService(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "system_id" -------------
+	var systemId SystemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "service_id" -------------
+	var serviceId ServiceID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "service_id", c.Param("service_id"), &serviceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter service_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateService(c, systemId, serviceId)
+}
+
+// AddServiceMaintainer operation middleware
+func (siw *ServerInterfaceWrapper) AddServiceMaintainer(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "system_id" -------------
+	var systemId SystemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "service_id" -------------
+	var serviceId ServiceID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "service_id", c.Param("service_id"), &serviceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter service_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AddServiceMaintainer(c, systemId, serviceId)
+}
+
+// RemoveServiceMaintainer operation middleware
+func (siw *ServerInterfaceWrapper) RemoveServiceMaintainer(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "system_id" -------------
+	var systemId SystemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "service_id" -------------
+	var serviceId ServiceID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "service_id", c.Param("service_id"), &serviceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter service_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RemoveServiceMaintainer(c, systemId, serviceId, userId)
+}
+
+// ListTemplates operation middleware
+func (siw *ServerInterfaceWrapper) ListTemplates(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTemplatesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "include_deprecated" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_deprecated", c.Request.URL.Query(), &params.IncludeDeprecated)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_deprecated: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListTemplates(c, params)
+}
+
+// GetUserEffectivePermissions operation middleware
+func (siw *ServerInterfaceWrapper) GetUserEffectivePermissions(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUserEffectivePermissions(c, userId)
+}
+
+// ListVMs operation middleware
+func (siw *ServerInterfaceWrapper) ListVMs(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListVMsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", c.Request.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order_by: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort_order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_order", c.Request.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort_order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", c.Request.URL.Query(), &params.Namespace)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cluster_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cluster_id", c.Request.URL.Query(), &params.ClusterId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cluster_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "service_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "service_id", c.Request.URL.Query(), &params.ServiceId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter service_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "system_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "system_id", c.Request.URL.Query(), &params.SystemId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "created_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_by", c.Request.URL.Query(), &params.CreatedBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter created_by: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "name_prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name_prefix", c.Request.URL.Query(), &params.NamePrefix)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name_prefix: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "label_selector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label_selector", c.Request.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter label_selector: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListVMs(c, params)
+}
+
+// ListVMBatches operation middleware
+func (siw *ServerInterfaceWrapper) ListVMBatches(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListVMBatchesParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "batch_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "batch_type", c.Request.URL.Query(), &params.BatchType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter batch_type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", c.Request.URL.Query(), &params.CreatedFrom)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter created_from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", c.Request.URL.Query(), &params.CreatedTo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter created_to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_order", c.Request.URL.Query(), &params.SortOrder)
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort_order: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -3372,11 +9029,11 @@ func (siw *ServerInterfaceWrapper) ListSystems(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListSystems(c, params)
+	siw.Handler.ListVMBatches(c, params)
 }
 
-// CreateSystem operation middleware
-func (siw *ServerInterfaceWrapper) CreateSystem(c *gin.Context) {
+// SubmitVMBatch operation middleware
+func (siw *ServerInterfaceWrapper) SubmitVMBatch(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -3387,43 +9044,14 @@ func (siw *ServerInterfaceWrapper) CreateSystem(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateSystem(c)
+	siw.Handler.SubmitVMBatch(c)
 }
 
-// DeleteSystem operation middleware
-func (siw *ServerInterfaceWrapper) DeleteSystem(c *gin.Context) {
-
-	var err error
-
-	// ------------- Path parameter "system_id" -------------
-	var systemId SystemID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
-		return
-	}
+// GetVMBatchLimits operation middleware
+func (siw *ServerInterfaceWrapper) GetVMBatchLimits(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteSystemParams
-
-	// ------------- Required query parameter "confirm_name" -------------
-
-	if paramValue := c.Query("confirm_name"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument confirm_name is required, but not found"), http.StatusBadRequest)
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "confirm_name", c.Request.URL.Query(), &params.ConfirmName)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter confirm_name: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -3431,22 +9059,11 @@ func (siw *ServerInterfaceWrapper) DeleteSystem(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteSystem(c, systemId, params)
+	siw.Handler.GetVMBatchLimits(c)
 }
 
-// GetSystem operation middleware
-func (siw *ServerInterfaceWrapper) GetSystem(c *gin.Context) {
-
-	var err error
-
-	// ------------- Path parameter "system_id" -------------
-	var systemId SystemID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
-		return
-	}
+// SubmitVMBatchPower operation middleware
+func (siw *ServerInterfaceWrapper) SubmitVMBatchPower(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -3457,20 +9074,20 @@ func (siw *ServerInterfaceWrapper) GetSystem(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetSystem(c, systemId)
+	siw.Handler.SubmitVMBatchPower(c)
 }
 
-// UpdateSystem operation middleware
-func (siw *ServerInterfaceWrapper) UpdateSystem(c *gin.Context) {
+// GetVMBatch operation middleware
+func (siw *ServerInterfaceWrapper) GetVMBatch(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "system_id" -------------
-	var systemId SystemID
+	// ------------- Path parameter "batch_id" -------------
+	var batchId BatchID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "batch_id", c.Param("batch_id"), &batchId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter batch_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -3483,20 +9100,20 @@ func (siw *ServerInterfaceWrapper) UpdateSystem(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateSystem(c, systemId)
+	siw.Handler.GetVMBatch(c, batchId)
 }
 
-// ListSystemMembers operation middleware
-func (siw *ServerInterfaceWrapper) ListSystemMembers(c *gin.Context) {
+// CancelVMBatch operation middleware
+func (siw *ServerInterfaceWrapper) CancelVMBatch(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "system_id" -------------
-	var systemId SystemID
+	// ------------- Path parameter "batch_id" -------------
+	var batchId BatchID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "batch_id", c.Param("batch_id"), &batchId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter batch_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -3509,20 +9126,20 @@ func (siw *ServerInterfaceWrapper) ListSystemMembers(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListSystemMembers(c, systemId)
+	siw.Handler.CancelVMBatch(c, batchId)
 }
 
-// AddSystemMember operation middleware
-func (siw *ServerInterfaceWrapper) AddSystemMember(c *gin.Context) {
+// StreamVMBatchEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamVMBatchEvents(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "system_id" -------------
-	var systemId SystemID
+	// ------------- Path parameter "batch_id" -------------
+	var batchId BatchID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "batch_id", c.Param("batch_id"), &batchId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter batch_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -3535,29 +9152,20 @@ func (siw *ServerInterfaceWrapper) AddSystemMember(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AddSystemMember(c, systemId)
+	siw.Handler.StreamVMBatchEvents(c, batchId)
 }
 
-// DeleteSystemMember operation middleware
-func (siw *ServerInterfaceWrapper) DeleteSystemMember(c *gin.Context) {
+// RetryVMBatch operation middleware
+func (siw *ServerInterfaceWrapper) RetryVMBatch(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "system_id" -------------
-	var systemId SystemID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Path parameter "user_id" -------------
-	var userId UserID
+	// ------------- Path parameter "batch_id" -------------
+	var batchId BatchID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "batch_id", c.Param("batch_id"), &batchId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter batch_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -3570,31 +9178,26 @@ func (siw *ServerInterfaceWrapper) DeleteSystemMember(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteSystemMember(c, systemId, userId)
+	siw.Handler.RetryVMBatch(c, batchId)
 }
 
-// UpdateSystemMemberRole operation middleware
-func (siw *ServerInterfaceWrapper) UpdateSystemMemberRole(c *gin.Context) {
-
-	var err error
+// CreateVMRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreateVMRequest(c *gin.Context) {
 
-	// ------------- Path parameter "system_id" -------------
-	var systemId SystemID
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// ------------- Path parameter "user_id" -------------
-	var userId UserID
+	siw.Handler.CreateVMRequest(c)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
-		return
-	}
+// GetVMRequestContext operation middleware
+func (siw *ServerInterfaceWrapper) GetVMRequestContext(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -3605,41 +9208,49 @@ func (siw *ServerInterfaceWrapper) UpdateSystemMemberRole(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateSystemMemberRole(c, systemId, userId)
+	siw.Handler.GetVMRequestContext(c)
 }
 
-// ListServices operation middleware
-func (siw *ServerInterfaceWrapper) ListServices(c *gin.Context) {
+// DeleteVM operation middleware
+func (siw *ServerInterfaceWrapper) DeleteVM(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "system_id" -------------
-	var systemId SystemID
+	// ------------- Path parameter "vm_id" -------------
+	var vmId VMID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "vm_id", c.Param("vm_id"), &vmId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vm_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
 	c.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListServicesParams
+	var params DeleteVMParams
 
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Optional query parameter "confirm" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	err = runtime.BindQueryParameter("form", true, false, "confirm", c.Request.URL.Query(), &params.Confirm)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter confirm: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "per_page" -------------
+	// ------------- Optional query parameter "confirm_name" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	err = runtime.BindQueryParameter("form", true, false, "confirm_name", c.Request.URL.Query(), &params.ConfirmName)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter confirm_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "request_id", c.Request.URL.Query(), &params.RequestId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter request_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -3650,25 +9261,36 @@ func (siw *ServerInterfaceWrapper) ListServices(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListServices(c, systemId, params)
+	siw.Handler.DeleteVM(c, vmId, params)
 }
 
-// CreateService operation middleware
-func (siw *ServerInterfaceWrapper) CreateService(c *gin.Context) {
+// GetVM operation middleware
+func (siw *ServerInterfaceWrapper) GetVM(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "system_id" -------------
-	var systemId SystemID
+	// ------------- Path parameter "vm_id" -------------
+	var vmId VMID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "vm_id", c.Param("vm_id"), &vmId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vm_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
 	c.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVMParams
+
+	// ------------- Optional query parameter "runtime" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "runtime", c.Request.URL.Query(), &params.Runtime)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter runtime: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -3676,45 +9298,25 @@ func (siw *ServerInterfaceWrapper) CreateService(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateService(c, systemId)
+	siw.Handler.GetVM(c, vmId, params)
 }
 
-// DeleteService operation middleware
-func (siw *ServerInterfaceWrapper) DeleteService(c *gin.Context) {
+// RequestVMConsoleAccess operation middleware
+func (siw *ServerInterfaceWrapper) RequestVMConsoleAccess(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "system_id" -------------
-	var systemId SystemID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Path parameter "service_id" -------------
-	var serviceId ServiceID
+	// ------------- Path parameter "vm_id" -------------
+	var vmId VMID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "service_id", c.Param("service_id"), &serviceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "vm_id", c.Param("vm_id"), &vmId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter service_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vm_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
 	c.Set(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteServiceParams
-
-	// ------------- Optional query parameter "confirm" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "confirm", c.Request.URL.Query(), &params.Confirm)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter confirm: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -3722,29 +9324,20 @@ func (siw *ServerInterfaceWrapper) DeleteService(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteService(c, systemId, serviceId, params)
+	siw.Handler.RequestVMConsoleAccess(c, vmId)
 }
 
-// GetService operation middleware
-func (siw *ServerInterfaceWrapper) GetService(c *gin.Context) {
+// ListVMConsoleSessions operation middleware
+func (siw *ServerInterfaceWrapper) ListVMConsoleSessions(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "system_id" -------------
-	var systemId SystemID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Path parameter "service_id" -------------
-	var serviceId ServiceID
+	// ------------- Path parameter "vm_id" -------------
+	var vmId VMID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "service_id", c.Param("service_id"), &serviceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "vm_id", c.Param("vm_id"), &vmId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter service_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vm_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -3757,29 +9350,29 @@ func (siw *ServerInterfaceWrapper) GetService(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetService(c, systemId, serviceId)
+	siw.Handler.ListVMConsoleSessions(c, vmId)
 }
 
-// UpdateService operation middleware
-func (siw *ServerInterfaceWrapper) UpdateService(c *gin.Context) {
+// RevokeVMConsoleSession operation middleware
+func (siw *ServerInterfaceWrapper) RevokeVMConsoleSession(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "system_id" -------------
-	var systemId SystemID
+	// ------------- Path parameter "vm_id" -------------
+	var vmId VMID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "system_id", c.Param("system_id"), &systemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "vm_id", c.Param("vm_id"), &vmId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter system_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vm_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Path parameter "service_id" -------------
-	var serviceId ServiceID
+	// ------------- Path parameter "session_id" -------------
+	var sessionId ConsoleSessionID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "service_id", c.Param("service_id"), &serviceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "session_id", c.Param("session_id"), &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter service_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter session_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -3792,34 +9385,24 @@ func (siw *ServerInterfaceWrapper) UpdateService(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateService(c, systemId, serviceId)
+	siw.Handler.RevokeVMConsoleSession(c, vmId, sessionId)
 }
 
-// ListTemplates operation middleware
-func (siw *ServerInterfaceWrapper) ListTemplates(c *gin.Context) {
+// GetVMConsoleStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetVMConsoleStatus(c *gin.Context) {
 
 	var err error
 
-	c.Set(BearerAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListTemplatesParams
-
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Path parameter "vm_id" -------------
+	var vmId VMID
 
-	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	err = runtime.BindStyledParameterWithOptions("simple", "vm_id", c.Param("vm_id"), &vmId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vm_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "per_page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
-		return
-	}
+	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -3828,18 +9411,27 @@ func (siw *ServerInterfaceWrapper) ListTemplates(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListTemplates(c, params)
+	siw.Handler.GetVMConsoleStatus(c, vmId)
 }
 
-// ListVMs operation middleware
-func (siw *ServerInterfaceWrapper) ListVMs(c *gin.Context) {
+// ListVMEvents operation middleware
+func (siw *ServerInterfaceWrapper) ListVMEvents(c *gin.Context) {
 
 	var err error
 
+	// ------------- Path parameter "vm_id" -------------
+	var vmId VMID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vm_id", c.Param("vm_id"), &vmId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vm_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	c.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListVMsParams
+	var params ListVMEventsParams
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -3857,38 +9449,32 @@ func (siw *ServerInterfaceWrapper) ListVMs(c *gin.Context) {
 		return
 	}
 
-	// ------------- Optional query parameter "sort_by" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "sort_by", c.Request.URL.Query(), &params.SortBy)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort_by: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// ------------- Optional query parameter "sort_order" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "sort_order", c.Request.URL.Query(), &params.SortOrder)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort_order: %w", err), http.StatusBadRequest)
-		return
-	}
+	siw.Handler.ListVMEvents(c, vmId, params)
+}
 
-	// ------------- Optional query parameter "namespace" -------------
+// UpdateVMHostname operation middleware
+func (siw *ServerInterfaceWrapper) UpdateVMHostname(c *gin.Context) {
 
-	err = runtime.BindQueryParameter("form", true, false, "namespace", c.Request.URL.Query(), &params.Namespace)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace: %w", err), http.StatusBadRequest)
-		return
-	}
+	var err error
 
-	// ------------- Optional query parameter "status" -------------
+	// ------------- Path parameter "vm_id" -------------
+	var vmId VMID
 
-	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	err = runtime.BindStyledParameterWithOptions("simple", "vm_id", c.Param("vm_id"), &vmId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vm_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(BearerAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -3896,26 +9482,22 @@ func (siw *ServerInterfaceWrapper) ListVMs(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListVMs(c, params)
+	siw.Handler.UpdateVMHostname(c, vmId)
 }
 
-// SubmitVMBatch operation middleware
-func (siw *ServerInterfaceWrapper) SubmitVMBatch(c *gin.Context) {
-
-	c.Set(BearerAuthScopes, []string{})
+// PatchVMLabels operation middleware
+func (siw *ServerInterfaceWrapper) PatchVMLabels(c *gin.Context) {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	var err error
 
-	siw.Handler.SubmitVMBatch(c)
-}
+	// ------------- Path parameter "vm_id" -------------
+	var vmId VMID
 
-// SubmitVMBatchPower operation middleware
-func (siw *ServerInterfaceWrapper) SubmitVMBatchPower(c *gin.Context) {
+	err = runtime.BindStyledParameterWithOptions("simple", "vm_id", c.Param("vm_id"), &vmId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vm_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -3926,20 +9508,20 @@ func (siw *ServerInterfaceWrapper) SubmitVMBatchPower(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.SubmitVMBatchPower(c)
+	siw.Handler.PatchVMLabels(c, vmId)
 }
 
-// GetVMBatch operation middleware
-func (siw *ServerInterfaceWrapper) GetVMBatch(c *gin.Context) {
+// MigrateVM operation middleware
+func (siw *ServerInterfaceWrapper) MigrateVM(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "batch_id" -------------
-	var batchId BatchID
+	// ------------- Path parameter "vm_id" -------------
+	var vmId VMID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "batch_id", c.Param("batch_id"), &batchId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "vm_id", c.Param("vm_id"), &vmId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter batch_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vm_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -3952,20 +9534,20 @@ func (siw *ServerInterfaceWrapper) GetVMBatch(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetVMBatch(c, batchId)
+	siw.Handler.MigrateVM(c, vmId)
 }
 
-// CancelVMBatch operation middleware
-func (siw *ServerInterfaceWrapper) CancelVMBatch(c *gin.Context) {
+// ResizeVM operation middleware
+func (siw *ServerInterfaceWrapper) ResizeVM(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "batch_id" -------------
-	var batchId BatchID
+	// ------------- Path parameter "vm_id" -------------
+	var vmId VMID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "batch_id", c.Param("batch_id"), &batchId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "vm_id", c.Param("vm_id"), &vmId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter batch_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vm_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -3978,20 +9560,20 @@ func (siw *ServerInterfaceWrapper) CancelVMBatch(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CancelVMBatch(c, batchId)
+	siw.Handler.ResizeVM(c, vmId)
 }
 
-// RetryVMBatch operation middleware
-func (siw *ServerInterfaceWrapper) RetryVMBatch(c *gin.Context) {
+// RestartVM operation middleware
+func (siw *ServerInterfaceWrapper) RestartVM(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "batch_id" -------------
-	var batchId BatchID
+	// ------------- Path parameter "vm_id" -------------
+	var vmId VMID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "batch_id", c.Param("batch_id"), &batchId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "vm_id", c.Param("vm_id"), &vmId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter batch_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vm_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -4004,26 +9586,22 @@ func (siw *ServerInterfaceWrapper) RetryVMBatch(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RetryVMBatch(c, batchId)
+	siw.Handler.RestartVM(c, vmId)
 }
 
-// CreateVMRequest operation middleware
-func (siw *ServerInterfaceWrapper) CreateVMRequest(c *gin.Context) {
-
-	c.Set(BearerAuthScopes, []string{})
+// ListVMSnapshots operation middleware
+func (siw *ServerInterfaceWrapper) ListVMSnapshots(c *gin.Context) {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	var err error
 
-	siw.Handler.CreateVMRequest(c)
-}
+	// ------------- Path parameter "vm_id" -------------
+	var vmId VMID
 
-// GetVMRequestContext operation middleware
-func (siw *ServerInterfaceWrapper) GetVMRequestContext(c *gin.Context) {
+	err = runtime.BindStyledParameterWithOptions("simple", "vm_id", c.Param("vm_id"), &vmId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vm_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -4034,11 +9612,11 @@ func (siw *ServerInterfaceWrapper) GetVMRequestContext(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetVMRequestContext(c)
+	siw.Handler.ListVMSnapshots(c, vmId)
 }
 
-// DeleteVM operation middleware
-func (siw *ServerInterfaceWrapper) DeleteVM(c *gin.Context) {
+// CreateVMSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) CreateVMSnapshot(c *gin.Context) {
 
 	var err error
 
@@ -4053,25 +9631,6 @@ func (siw *ServerInterfaceWrapper) DeleteVM(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteVMParams
-
-	// ------------- Optional query parameter "confirm" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "confirm", c.Request.URL.Query(), &params.Confirm)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter confirm: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Optional query parameter "confirm_name" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "confirm_name", c.Request.URL.Query(), &params.ConfirmName)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter confirm_name: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -4079,11 +9638,11 @@ func (siw *ServerInterfaceWrapper) DeleteVM(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteVM(c, vmId, params)
+	siw.Handler.CreateVMSnapshot(c, vmId)
 }
 
-// GetVM operation middleware
-func (siw *ServerInterfaceWrapper) GetVM(c *gin.Context) {
+// DeleteVMSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) DeleteVMSnapshot(c *gin.Context) {
 
 	var err error
 
@@ -4096,6 +9655,15 @@ func (siw *ServerInterfaceWrapper) GetVM(c *gin.Context) {
 		return
 	}
 
+	// ------------- Path parameter "snapshot_name" -------------
+	var snapshotName SnapshotName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "snapshot_name", c.Param("snapshot_name"), &snapshotName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter snapshot_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4105,11 +9673,11 @@ func (siw *ServerInterfaceWrapper) GetVM(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetVM(c, vmId)
+	siw.Handler.DeleteVMSnapshot(c, vmId, snapshotName)
 }
 
-// RequestVMConsoleAccess operation middleware
-func (siw *ServerInterfaceWrapper) RequestVMConsoleAccess(c *gin.Context) {
+// GetVMSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) GetVMSnapshot(c *gin.Context) {
 
 	var err error
 
@@ -4122,6 +9690,15 @@ func (siw *ServerInterfaceWrapper) RequestVMConsoleAccess(c *gin.Context) {
 		return
 	}
 
+	// ------------- Path parameter "snapshot_name" -------------
+	var snapshotName SnapshotName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "snapshot_name", c.Param("snapshot_name"), &snapshotName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter snapshot_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4131,11 +9708,11 @@ func (siw *ServerInterfaceWrapper) RequestVMConsoleAccess(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RequestVMConsoleAccess(c, vmId)
+	siw.Handler.GetVMSnapshot(c, vmId, snapshotName)
 }
 
-// GetVMConsoleStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetVMConsoleStatus(c *gin.Context) {
+// RestoreVMSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) RestoreVMSnapshot(c *gin.Context) {
 
 	var err error
 
@@ -4148,6 +9725,15 @@ func (siw *ServerInterfaceWrapper) GetVMConsoleStatus(c *gin.Context) {
 		return
 	}
 
+	// ------------- Path parameter "snapshot_name" -------------
+	var snapshotName SnapshotName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "snapshot_name", c.Param("snapshot_name"), &snapshotName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter snapshot_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4157,11 +9743,11 @@ func (siw *ServerInterfaceWrapper) GetVMConsoleStatus(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetVMConsoleStatus(c, vmId)
+	siw.Handler.RestoreVMSnapshot(c, vmId, snapshotName)
 }
 
-// RestartVM operation middleware
-func (siw *ServerInterfaceWrapper) RestartVM(c *gin.Context) {
+// StartVM operation middleware
+func (siw *ServerInterfaceWrapper) StartVM(c *gin.Context) {
 
 	var err error
 
@@ -4183,11 +9769,11 @@ func (siw *ServerInterfaceWrapper) RestartVM(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RestartVM(c, vmId)
+	siw.Handler.StartVM(c, vmId)
 }
 
-// StartVM operation middleware
-func (siw *ServerInterfaceWrapper) StartVM(c *gin.Context) {
+// StopVM operation middleware
+func (siw *ServerInterfaceWrapper) StopVM(c *gin.Context) {
 
 	var err error
 
@@ -4209,11 +9795,11 @@ func (siw *ServerInterfaceWrapper) StartVM(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.StartVM(c, vmId)
+	siw.Handler.StopVM(c, vmId)
 }
 
-// StopVM operation middleware
-func (siw *ServerInterfaceWrapper) StopVM(c *gin.Context) {
+// OpenVMVNC operation middleware
+func (siw *ServerInterfaceWrapper) OpenVMVNC(c *gin.Context) {
 
 	var err error
 
@@ -4235,11 +9821,11 @@ func (siw *ServerInterfaceWrapper) StopVM(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.StopVM(c, vmId)
+	siw.Handler.OpenVMVNC(c, vmId)
 }
 
-// OpenVMVNC operation middleware
-func (siw *ServerInterfaceWrapper) OpenVMVNC(c *gin.Context) {
+// ProxyVMVNCWebSocket operation middleware
+func (siw *ServerInterfaceWrapper) ProxyVMVNCWebSocket(c *gin.Context) {
 
 	var err error
 
@@ -4254,6 +9840,24 @@ func (siw *ServerInterfaceWrapper) OpenVMVNC(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ProxyVMVNCWebSocketParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if paramValue := c.Query("token"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument token is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "token", c.Request.URL.Query(), &params.Token)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter token: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -4261,7 +9865,7 @@ func (siw *ServerInterfaceWrapper) OpenVMVNC(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.OpenVMVNC(c, vmId)
+	siw.Handler.ProxyVMVNCWebSocket(c, vmId, params)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -4291,6 +9895,7 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/admin/audit-logs", wrapper.ListAdminAuditLogs)
 	router.GET(options.BaseURL+"/admin/auth-provider-types", wrapper.ListAuthProviderTypes)
 	router.GET(options.BaseURL+"/admin/auth-providers", wrapper.ListAuthProviders)
 	router.POST(options.BaseURL+"/admin/auth-providers", wrapper.CreateAuthProvider)
@@ -4300,62 +9905,141 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.POST(options.BaseURL+"/admin/auth-providers/:provider_id/group-mappings", wrapper.CreateAuthProviderGroupMapping)
 	router.DELETE(options.BaseURL+"/admin/auth-providers/:provider_id/group-mappings/:mapping_id", wrapper.DeleteAuthProviderGroupMapping)
 	router.PATCH(options.BaseURL+"/admin/auth-providers/:provider_id/group-mappings/:mapping_id", wrapper.UpdateAuthProviderGroupMapping)
+	router.POST(options.BaseURL+"/admin/auth-providers/:provider_id/import-users", wrapper.ImportAuthProviderUsers)
 	router.GET(options.BaseURL+"/admin/auth-providers/:provider_id/sample", wrapper.GetAuthProviderSample)
 	router.POST(options.BaseURL+"/admin/auth-providers/:provider_id/sync", wrapper.SyncAuthProviderGroups)
 	router.POST(options.BaseURL+"/admin/auth-providers/:provider_id/test-connection", wrapper.TestAuthProviderConnection)
+	router.GET(options.BaseURL+"/admin/batch", wrapper.ListAdminBatches)
 	router.GET(options.BaseURL+"/admin/clusters", wrapper.ListClusters)
 	router.POST(options.BaseURL+"/admin/clusters", wrapper.CreateCluster)
+	router.PATCH(options.BaseURL+"/admin/clusters/:cluster_id", wrapper.UpdateCluster)
+	router.GET(options.BaseURL+"/admin/clusters/:cluster_id/capacity", wrapper.GetClusterCapacity)
 	router.PUT(options.BaseURL+"/admin/clusters/:cluster_id/environment", wrapper.UpdateClusterEnvironment)
+	router.POST(options.BaseURL+"/admin/clusters/:cluster_id/validate-kubeconfig", wrapper.ValidateClusterKubeconfig)
+	router.POST(options.BaseURL+"/admin/events/:event_id/replay", wrapper.ReplayDomainEvent)
 	router.GET(options.BaseURL+"/admin/instance-sizes", wrapper.ListAdminInstanceSizes)
 	router.POST(options.BaseURL+"/admin/instance-sizes", wrapper.CreateAdminInstanceSize)
+	router.POST(options.BaseURL+"/admin/instance-sizes/bulk-update", wrapper.BulkUpdateAdminInstanceSizes)
 	router.DELETE(options.BaseURL+"/admin/instance-sizes/:instance_size_id", wrapper.DeleteAdminInstanceSize)
+	router.GET(options.BaseURL+"/admin/instance-sizes/:instance_size_id", wrapper.GetAdminInstanceSize)
 	router.PATCH(options.BaseURL+"/admin/instance-sizes/:instance_size_id", wrapper.UpdateAdminInstanceSize)
+	router.PUT(options.BaseURL+"/admin/instance-sizes/:instance_size_id/associate-clusters", wrapper.AssociateInstanceSizeClusters)
 	router.GET(options.BaseURL+"/admin/namespaces", wrapper.ListNamespaces)
 	router.POST(options.BaseURL+"/admin/namespaces", wrapper.CreateNamespace)
+	router.POST(options.BaseURL+"/admin/namespaces/sync", wrapper.SyncNamespaces)
 	router.DELETE(options.BaseURL+"/admin/namespaces/:namespace_id", wrapper.DeleteNamespace)
 	router.GET(options.BaseURL+"/admin/namespaces/:namespace_id", wrapper.GetNamespace)
 	router.PUT(options.BaseURL+"/admin/namespaces/:namespace_id", wrapper.UpdateNamespace)
+	router.POST(options.BaseURL+"/admin/namespaces/:namespace_id/migrate-environment", wrapper.MigrateNamespaceEnvironment)
+	router.DELETE(options.BaseURL+"/admin/namespaces/:namespace_id/quota", wrapper.DeleteNamespaceQuota)
+	router.PATCH(options.BaseURL+"/admin/namespaces/:namespace_id/quota", wrapper.UpdateNamespaceQuota)
+	router.POST(options.BaseURL+"/admin/namespaces/:namespace_id/quota", wrapper.CreateNamespaceQuota)
 	router.GET(options.BaseURL+"/admin/permissions", wrapper.ListPermissions)
+	router.GET(options.BaseURL+"/admin/permissions/matrix", wrapper.GetPermissionMatrix)
+	router.GET(options.BaseURL+"/admin/platform-config", wrapper.GetPlatformConfig)
+	router.PATCH(options.BaseURL+"/admin/platform-config", wrapper.PatchPlatformConfig)
+	router.GET(options.BaseURL+"/admin/quotas", wrapper.ListQuotas)
+	router.POST(options.BaseURL+"/admin/quotas", wrapper.CreateQuota)
+	router.DELETE(options.BaseURL+"/admin/quotas/:quota_id", wrapper.DeleteQuota)
+	router.GET(options.BaseURL+"/admin/quotas/:quota_id", wrapper.GetQuota)
+	router.PUT(options.BaseURL+"/admin/quotas/:quota_id", wrapper.UpdateQuota)
+	router.GET(options.BaseURL+"/admin/rate-limits/effective/:user_id", wrapper.GetEffectiveRateLimitPolicy)
+	router.GET(options.BaseURL+"/admin/rate-limits/exemptions", wrapper.ListRateLimitExemptions)
 	router.POST(options.BaseURL+"/admin/rate-limits/exemptions", wrapper.CreateRateLimitExemption)
 	router.DELETE(options.BaseURL+"/admin/rate-limits/exemptions/:user_id", wrapper.DeleteRateLimitExemption)
 	router.GET(options.BaseURL+"/admin/rate-limits/status", wrapper.ListRateLimitStatus)
+	router.DELETE(options.BaseURL+"/admin/rate-limits/user-overrides/:user_id", wrapper.RemoveRateLimitUserOverride)
+	router.GET(options.BaseURL+"/admin/rate-limits/user-overrides/:user_id", wrapper.GetRateLimitUserOverride)
+	router.PATCH(options.BaseURL+"/admin/rate-limits/user-overrides/:user_id", wrapper.PatchRateLimitUserOverride)
+	router.POST(options.BaseURL+"/admin/rate-limits/user-overrides/:user_id", wrapper.CreateRateLimitUserOverride)
+	router.GET(options.BaseURL+"/admin/rate-limits/users", wrapper.ListRateLimitUserOverrides)
+	router.DELETE(options.BaseURL+"/admin/rate-limits/users/:user_id", wrapper.DeleteRateLimitUserOverrides)
 	router.PUT(options.BaseURL+"/admin/rate-limits/users/:user_id", wrapper.UpdateRateLimitUserOverrides)
+	router.GET(options.BaseURL+"/admin/role-bindings", wrapper.ListRoleBindings)
+	router.POST(options.BaseURL+"/admin/role-bindings", wrapper.CreateRoleBinding)
+	router.DELETE(options.BaseURL+"/admin/role-bindings/:binding_id", wrapper.DeleteRoleBinding)
 	router.GET(options.BaseURL+"/admin/roles", wrapper.ListRoles)
 	router.POST(options.BaseURL+"/admin/roles", wrapper.CreateRole)
 	router.DELETE(options.BaseURL+"/admin/roles/:role_id", wrapper.DeleteRole)
 	router.PATCH(options.BaseURL+"/admin/roles/:role_id", wrapper.UpdateRole)
+	router.POST(options.BaseURL+"/admin/roles/:role_id/clone", wrapper.CloneRole)
+	router.GET(options.BaseURL+"/admin/scheduled-jobs", wrapper.ListScheduledJobs)
+	router.POST(options.BaseURL+"/admin/scheduled-jobs", wrapper.CreateScheduledJob)
+	router.DELETE(options.BaseURL+"/admin/scheduled-jobs/:scheduled_job_id", wrapper.DeleteScheduledJob)
+	router.GET(options.BaseURL+"/admin/scheduled-jobs/:scheduled_job_id", wrapper.GetScheduledJob)
+	router.PATCH(options.BaseURL+"/admin/scheduled-jobs/:scheduled_job_id", wrapper.UpdateScheduledJob)
+	router.POST(options.BaseURL+"/admin/services/:service_id/reindex", wrapper.ReindexServiceInstances)
+	router.GET(options.BaseURL+"/admin/spec-override-policy", wrapper.GetSpecOverridePolicy)
+	router.PUT(options.BaseURL+"/admin/spec-override-policy", wrapper.PutSpecOverridePolicy)
+	router.GET(options.BaseURL+"/admin/systems/:system_id/topology", wrapper.GetSystemTopology)
+	router.GET(options.BaseURL+"/admin/systems/:system_id/usage-report", wrapper.GetSystemUsageReport)
 	router.GET(options.BaseURL+"/admin/templates", wrapper.ListAdminTemplates)
 	router.POST(options.BaseURL+"/admin/templates", wrapper.CreateAdminTemplate)
 	router.DELETE(options.BaseURL+"/admin/templates/:template_id", wrapper.DeleteAdminTemplate)
+	router.GET(options.BaseURL+"/admin/templates/:template_id", wrapper.GetAdminTemplate)
 	router.PATCH(options.BaseURL+"/admin/templates/:template_id", wrapper.UpdateAdminTemplate)
+	router.POST(options.BaseURL+"/admin/templates/:template_id/clone", wrapper.CloneAdminTemplate)
+	router.POST(options.BaseURL+"/admin/templates/:template_id/deprecate", wrapper.DeprecateAdminTemplate)
+	router.POST(options.BaseURL+"/admin/templates/:template_id/promote", wrapper.PromoteAdminTemplate)
 	router.GET(options.BaseURL+"/admin/users", wrapper.ListUsers)
 	router.POST(options.BaseURL+"/admin/users", wrapper.CreateUser)
 	router.DELETE(options.BaseURL+"/admin/users/:user_id", wrapper.DeleteUser)
 	router.PATCH(options.BaseURL+"/admin/users/:user_id", wrapper.UpdateUser)
+	router.PATCH(options.BaseURL+"/admin/users/:user_id/force-password-reset", wrapper.ForceUserPasswordReset)
+	router.POST(options.BaseURL+"/admin/users/:user_id/revoke-sessions", wrapper.RevokeUserSessions)
 	router.GET(options.BaseURL+"/admin/users/:user_id/role-bindings", wrapper.ListUserRoleBindings)
 	router.POST(options.BaseURL+"/admin/users/:user_id/role-bindings", wrapper.CreateUserRoleBinding)
 	router.DELETE(options.BaseURL+"/admin/users/:user_id/role-bindings/:binding_id", wrapper.DeleteUserRoleBinding)
+	router.POST(options.BaseURL+"/admin/users/:user_id/unlock", wrapper.UnlockUser)
+	router.GET(options.BaseURL+"/admin/webhooks", wrapper.ListWebhooks)
+	router.POST(options.BaseURL+"/admin/webhooks", wrapper.CreateWebhook)
+	router.DELETE(options.BaseURL+"/admin/webhooks/:webhook_id", wrapper.DeleteWebhook)
+	router.GET(options.BaseURL+"/admin/webhooks/:webhook_id", wrapper.GetWebhook)
+	router.PATCH(options.BaseURL+"/admin/webhooks/:webhook_id", wrapper.UpdateWebhook)
+	router.GET(options.BaseURL+"/admin/webhooks/:webhook_id/deliveries", wrapper.ListWebhookDeliveries)
+	router.POST(options.BaseURL+"/admin/webhooks/:webhook_id/test", wrapper.TestWebhook)
 	router.GET(options.BaseURL+"/approvals", wrapper.ListApprovals)
 	router.POST(options.BaseURL+"/approvals/batch", wrapper.SubmitApprovalBatch)
+	router.PATCH(options.BaseURL+"/approvals/batch/:batch_id/approve", wrapper.ApproveBatch)
+	router.GET(options.BaseURL+"/approvals/:ticket_id", wrapper.GetApproval)
+	router.PATCH(options.BaseURL+"/approvals/:ticket_id", wrapper.UpdateApprovalTicket)
 	router.POST(options.BaseURL+"/approvals/:ticket_id/approve", wrapper.ApproveTicket)
 	router.POST(options.BaseURL+"/approvals/:ticket_id/cancel", wrapper.CancelTicket)
+	router.GET(options.BaseURL+"/approvals/:ticket_id/comments", wrapper.ListTicketComments)
+	router.POST(options.BaseURL+"/approvals/:ticket_id/comments", wrapper.CreateTicketComment)
+	router.DELETE(options.BaseURL+"/approvals/:ticket_id/comments/:comment_id", wrapper.DeleteTicketComment)
+	router.GET(options.BaseURL+"/approvals/:ticket_id/preview", wrapper.PreviewApprovalTicket)
+	router.POST(options.BaseURL+"/approvals/:ticket_id/reassign", wrapper.ReassignTicket)
 	router.POST(options.BaseURL+"/approvals/:ticket_id/reject", wrapper.RejectTicket)
 	router.GET(options.BaseURL+"/audit-logs", wrapper.ListAuditLogs)
+	router.GET(options.BaseURL+"/audit-logs/export", wrapper.ExportAuditLogs)
 	router.POST(options.BaseURL+"/auth/change-password", wrapper.ChangePassword)
 	router.POST(options.BaseURL+"/auth/login", wrapper.Login)
 	router.GET(options.BaseURL+"/auth/me", wrapper.GetCurrentUser)
+	router.GET(options.BaseURL+"/auth/password-policy", wrapper.GetPasswordPolicy)
+	router.POST(options.BaseURL+"/auth/refresh", wrapper.RefreshToken)
+	router.POST(options.BaseURL+"/auth/saml/:provider_id/acs", wrapper.SamlAssertionConsumer)
+	router.GET(options.BaseURL+"/auth/sessions", wrapper.ListLoginSessions)
+	router.DELETE(options.BaseURL+"/auth/sessions/:session_id", wrapper.RevokeLoginSession)
 	router.GET(options.BaseURL+"/health/live", wrapper.GetLiveness)
 	router.GET(options.BaseURL+"/health/ready", wrapper.GetReadiness)
+	router.GET(options.BaseURL+"/healthz", wrapper.GetHealthz)
 	router.GET(options.BaseURL+"/instance-sizes", wrapper.ListInstanceSizes)
 	router.GET(options.BaseURL+"/notifications", wrapper.ListNotifications)
 	router.POST(options.BaseURL+"/notifications/mark-all-read", wrapper.MarkAllNotificationsRead)
+	router.GET(options.BaseURL+"/notifications/preferences", wrapper.GetNotificationPreferences)
+	router.PUT(options.BaseURL+"/notifications/preferences", wrapper.PutNotificationPreferences)
 	router.GET(options.BaseURL+"/notifications/unread-count", wrapper.GetUnreadCount)
 	router.PATCH(options.BaseURL+"/notifications/:notification_id/read", wrapper.MarkNotificationRead)
+	router.PATCH(options.BaseURL+"/services/:service_id/labels", wrapper.PatchServiceLabels)
+	router.POST(options.BaseURL+"/services/:service_id/role-bindings", wrapper.CreateServiceRoleBinding)
 	router.GET(options.BaseURL+"/systems", wrapper.ListSystems)
 	router.POST(options.BaseURL+"/systems", wrapper.CreateSystem)
 	router.DELETE(options.BaseURL+"/systems/:system_id", wrapper.DeleteSystem)
 	router.GET(options.BaseURL+"/systems/:system_id", wrapper.GetSystem)
 	router.PATCH(options.BaseURL+"/systems/:system_id", wrapper.UpdateSystem)
+	router.POST(options.BaseURL+"/systems/:system_id/maintainers", wrapper.AddSystemMaintainer)
+	router.DELETE(options.BaseURL+"/systems/:system_id/maintainers/:user_id", wrapper.RemoveSystemMaintainer)
 	router.GET(options.BaseURL+"/systems/:system_id/members", wrapper.ListSystemMembers)
 	router.POST(options.BaseURL+"/systems/:system_id/members", wrapper.AddSystemMember)
 	router.DELETE(options.BaseURL+"/systems/:system_id/members/:user_id", wrapper.DeleteSystemMember)
@@ -4365,182 +10049,642 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.DELETE(options.BaseURL+"/systems/:system_id/services/:service_id", wrapper.DeleteService)
 	router.GET(options.BaseURL+"/systems/:system_id/services/:service_id", wrapper.GetService)
 	router.PATCH(options.BaseURL+"/systems/:system_id/services/:service_id", wrapper.UpdateService)
+	router.POST(options.BaseURL+"/systems/:system_id/services/:service_id/maintainers", wrapper.AddServiceMaintainer)
+	router.DELETE(options.BaseURL+"/systems/:system_id/services/:service_id/maintainers/:user_id", wrapper.RemoveServiceMaintainer)
 	router.GET(options.BaseURL+"/templates", wrapper.ListTemplates)
+	router.GET(options.BaseURL+"/users/:user_id/effective-permissions", wrapper.GetUserEffectivePermissions)
 	router.GET(options.BaseURL+"/vms", wrapper.ListVMs)
+	router.GET(options.BaseURL+"/vms/batch", wrapper.ListVMBatches)
 	router.POST(options.BaseURL+"/vms/batch", wrapper.SubmitVMBatch)
+	router.GET(options.BaseURL+"/vms/batch/limits", wrapper.GetVMBatchLimits)
 	router.POST(options.BaseURL+"/vms/batch/power", wrapper.SubmitVMBatchPower)
 	router.GET(options.BaseURL+"/vms/batch/:batch_id", wrapper.GetVMBatch)
 	router.POST(options.BaseURL+"/vms/batch/:batch_id/cancel", wrapper.CancelVMBatch)
+	router.GET(options.BaseURL+"/vms/batch/:batch_id/events", wrapper.StreamVMBatchEvents)
 	router.POST(options.BaseURL+"/vms/batch/:batch_id/retry", wrapper.RetryVMBatch)
 	router.POST(options.BaseURL+"/vms/request", wrapper.CreateVMRequest)
 	router.GET(options.BaseURL+"/vms/request-context", wrapper.GetVMRequestContext)
 	router.DELETE(options.BaseURL+"/vms/:vm_id", wrapper.DeleteVM)
 	router.GET(options.BaseURL+"/vms/:vm_id", wrapper.GetVM)
 	router.POST(options.BaseURL+"/vms/:vm_id/console/request", wrapper.RequestVMConsoleAccess)
+	router.GET(options.BaseURL+"/vms/:vm_id/console/sessions", wrapper.ListVMConsoleSessions)
+	router.DELETE(options.BaseURL+"/vms/:vm_id/console/sessions/:session_id", wrapper.RevokeVMConsoleSession)
 	router.GET(options.BaseURL+"/vms/:vm_id/console/status", wrapper.GetVMConsoleStatus)
+	router.GET(options.BaseURL+"/vms/:vm_id/events", wrapper.ListVMEvents)
+	router.PATCH(options.BaseURL+"/vms/:vm_id/hostname", wrapper.UpdateVMHostname)
+	router.PATCH(options.BaseURL+"/vms/:vm_id/labels", wrapper.PatchVMLabels)
+	router.POST(options.BaseURL+"/vms/:vm_id/migrate", wrapper.MigrateVM)
+	router.POST(options.BaseURL+"/vms/:vm_id/resize", wrapper.ResizeVM)
 	router.POST(options.BaseURL+"/vms/:vm_id/restart", wrapper.RestartVM)
+	router.GET(options.BaseURL+"/vms/:vm_id/snapshots", wrapper.ListVMSnapshots)
+	router.POST(options.BaseURL+"/vms/:vm_id/snapshots", wrapper.CreateVMSnapshot)
+	router.DELETE(options.BaseURL+"/vms/:vm_id/snapshots/:snapshot_name", wrapper.DeleteVMSnapshot)
+	router.GET(options.BaseURL+"/vms/:vm_id/snapshots/:snapshot_name", wrapper.GetVMSnapshot)
+	router.POST(options.BaseURL+"/vms/:vm_id/snapshots/:snapshot_name/restore", wrapper.RestoreVMSnapshot)
 	router.POST(options.BaseURL+"/vms/:vm_id/start", wrapper.StartVM)
 	router.POST(options.BaseURL+"/vms/:vm_id/stop", wrapper.StopVM)
 	router.GET(options.BaseURL+"/vms/:vm_id/vnc", wrapper.OpenVMVNC)
+	router.GET(options.BaseURL+"/vms/:vm_id/vnc/ws", wrapper.ProxyVMVNCWebSocket)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x97XLbOLbgq6C4t2qTLcmy091zZ7y1taUoTtoztuO1bM/e6mR1IRKScE0SbBCUo3bl",
-	"ee573CfbwgcpkAT4IZGSMzV/uhUTn+cbBwfnvDguCSISopDFzvmLE0EKA8QQFf96D5m7uvzAf+LQOXci",
-	"yFbOwAlhgJxzZ86/zrDnDByKfk8wRZ5zzmiCBk7srlAAeT+2iXjbmFEcLp3v3wfOhIQLTAP+0UOxS3HE",
-	"MOGjT3EQ+Qh4yEf8L8CVDaH4x8KHS/Bm/OFueHp69gv4r/88++mtM5DL+j1BdLNdl+rnGJYxJ8RHMNTX",
-	"cSM6Fddyv4kQoCgmCXUR4AMDRtIVbZeYXxCAnodCLwnennwJr5OYgYCDCLBVcSz0DbrM35x8Cav3MBP/",
-	"rIbnZRgzGLpoiv9AVlxh1WgW4z9Qe5xdwyjC4dI6fCC/tx+YQz+OoGtfeZi22GFwwvACu4KA7ONrjdpP",
-	"cQuXBurhfwVhEswRBW/Ohjj00Dfk2eg14mPo03hoAROfOednAyfAIQ6SQPxW0+OQoSWicn5EzUu4ZCiI",
-	"QYQoUMMbZ0Z0Zp/93enACeA3Nf3paf1iKFljD1ErrCPVoD2c74iP3uPQqyLCufy+2+DWUSnxdyC9KaJr",
-	"XEHVsfy+w8CEsvebMr4/YuR7XEbFhDIw31gwzr/OxNe6ST5TD1GDkObDe5giV/yhYhYiBjBSlgNj1xk4",
-	"KOS09Jv6F5/H+TowLWcTMxTYYSk+twflPQoiHzI7kphqsMPQ2H1CzD6w+Nx+2Ie4grmSeBfGery2Drhu",
-	"DdPvvHEckTBGyn7w7tDvCYoZ/5dLQoZC8RNGka9k7ug/Yk5YL9qw/0LRwjl3/ttoa5uM5Nd4dEEpoXKq",
-	"PGG+hx6gajKl3X3sHmDiu1Szu+mU3wfOR0LnmFsD/c+/nUqqvI8kCb0DbjskDCzEnJxCQ5iwFaH4D3SA",
-	"NeRm459VDz7gOOLaBvofkItjTEKNECNKIkQZlkTqkiBQSyzQ88CJkY9chryZ6ycxk/xVEoljL8AhkE1j",
-	"wCBdIgZUh8xC/Feu/e3jx4xQuEQz14dxbOZU9Rcy/w8kaSzdoRQ25Y1B8V0K8dLMLkWQTwxFxwXhdrZz",
-	"7niQoSHDwugs9UFrFDIFgtJHy5/5gqRtJT8ZDW2yAFk7wFY4BlJCAooiimJODFtT+62mOSZ3F+P7C2fg",
-	"fLi4uhA/Hm8ms/FkcjGdGnQJF09QkZ7hEwfsrLKFICELRGMGWSIAn67u9uLmw+XNJ2fgjG9v7z4/Xnxw",
-	"Bs7dxV8vJvfi52R8M7m4uhK/L/7vxeThXraePsgNDJyP40v+2bQTSWczKaTL5gChQMJEgTIeiGPI4zWY",
-	"Ixwu5REGeU7lyKHxbFQxtjjcvFkQCjwcRz7cGKj+u65RfnOEiskoKwOjDu2vtcR/hU2cjbkNnPtRJWUK",
-	"7LTlOEgp3PB/R3CJQyihUD3W7bZlA9a9UzqzvAM7TRlJIjMrjAJEh7pugahJjFBOPMyuyNIgXNwUDqVl",
-	"QJeR7oSOhxjEvpzT8zCfFfq32lqkUVJaukUepefwWd33VFw1oF6Y2sL5zvnJUrjkoFAF805oOsVfv9Sc",
-	"sFV69jNQSsJWFuF/h5aYczjyAG8F0vMhiPxkiUPAe4EntDHRhfCQLFuTxS4kmPaZb4wkg0I495FncjNZ",
-	"yTCVrGUtsj06bT9nh+yBk0Rey/WbKFa5lbao2e7iaw2CJyQM5eHvHsVcdIkTXRHpAYpj5ZcobzFxXZSz",
-	"cnS3nL7WtGXtmgSCrBbe66LASnLZkS4KcCuhtw6AnyhJoukmdK0wXPIWecFTWmOAw0v58awsbpQkXGDk",
-	"N9BPudaDdPYW27Bp1Hby89K75cMhT4xclqJ10rAbGa5J19YrmMIg8tHHFOr5hdiQMXBi0a0a3UUMJyH+",
-	"PUEzlyS505QmvNbQT7aaNTVp1IgDNdIg3cnAkS5UZ5BxCJ/kKSTPodlRpFNQSjranIUlfm0EOjspiRl2",
-	"w6OOFZNq1vyktaySd6qqRdXt7V7hIL+jeYJ9NsOhWTZJeTfbntlbib2c3DVQkzowzKwSsJlBphCdG22w",
-	"3VgTuHTNtALWJsbNqWUxat3yHoT2r3BlvCaNVJpnsoLhEt3COH4m1LPuIkTPs0g1yhk52R8Nypj4XttO",
-	"BQzkRhjkV2HCy0R6eEx+FzyLEV0jOkuo3+FBSHjPa11FDVipEuEoXGNKwtQnlreX1KaB1kjaSLmb0AH/",
-	"T85RwzimhWDzjEdXi5n8lMzRGlM2WyMa2ySHnUJLp+eHm7/dfP77jTNwfr0YX93/+m/OwHm40X/fXYwn",
-	"v47fX10Yl5mDvcR2wReYMDL0EBNePTCVzSe8NfBxzHJg+rO4EGyoXqus+Dy9VR7oFf5qDOYGBFSgkfRi",
-	"R+G5Kdo5frdCq+jQj9Gffh6i0CUe8sC2KXjD0YA8gEKXbiKGvAFQYH3HQZqx03zDjJxk2ZbZiNaWWAHQ",
-	"iy1ApIwuA7UAs2YgKqxJH6NiNV1osFS89eo5+CC8kI/XdiOr0ud8EPdY2Tdpgry8ozBoZM9w6ryG7gqH",
-	"aEgR9LgkBoj3BrwxeLOg4s7EAysYej6KAT77c2i8PRC23kz0bY5XYXSqG5UyarVze37JF+HSx/EK+GQJ",
-	"VCPwRl79UPBwWeHvHcigorYevAJGBCBNgNf2Y4W+GXLmM6zVcWE5X1gX9sknc+hrgQsGS8H3yTPyZhpb",
-	"5xHZVI4W0diDl8vmL1XhEdZvdu3sksjaVX60mPyD7K67mX9WuxnfBnNka8tN1giRde6mvrBaBesW0Nxq",
-	"66XYWa1lnM7bCDhd6J4y6zTye/yKoM9WBjGwQu5ThfyxG1/bscuqhjyJoJUlhZ64RxNy2IhHu/Fa9HrZ",
-	"9culdyt8UCoS75XLEvSNIRpCfyYcdzaylB+tAsLSq9o5cjSJ1IlfPu/LKUNxUMmLBRo5lpjqBPkdyboa",
-	"c3o/AHch6op83UjQFTrVeIVeuz5qEOpS8MOXTwk9yhsfxmwWi9lbycA6OdXuQqSheNC2aCRgLVbcoCej",
-	"ZOYSmlOJmvfeQx52hZBzo8TsO2rg5H2aLeeW8fdyW62SJYrgEsUixL0NggMUELqZBZZl2UWURE88W9rA",
-	"kbXIFlfTLqaYrM1t4gi5M7JGlGIP7XmY0r1HW6TrkKgjnhrdkqOkqqhxOT/djlPduFsSrJmrb3LM0V31",
-	"WlRTBadGXV4L2dbEM3RJ1ntRdCfKXBevzTS51qPucuefPPVPnjoIT5Wo9IossT2AuvVdXRJzu6WJDz5r",
-	"OXAq7+LUAq1O5G+RgGmF+RYmvs9JrwAVzWVIuLWWrmLmirtMM34YeUINTvuymWk72au4unuaPF8G8NsV",
-	"Cpds5Zz/cvZuUHtt09TuN4cBiweOC8IPF+Du4wScnf70CwhhgMPtQ82/vHUG+rL+9NOg2a1L3UVHBiEZ",
-	"wEU3BnnZvQ+0Tg62uVfd82bUjBPpOPM3QAa6gOzxpIrMTvFkdNN3GlrYGoFd6N8yVfR6e5VNV6O527Op",
-	"lYyMy9BesHbDBrjt3Yh4WOHZNBpsN/u+IdoDh2HmVwcRpdwnH2aMr2bFtxrjq9nk8/Xt1YV8qpH9UXu+",
-	"8Xg9m96P7x+ms8mv45tPF/XXtzhTh+kat0BVIKwND9ex3QnP6NTTK7vc5kYq2hA5utKMmeyNsvErIwz6",
-	"FZ9mRVOrMkDpFtEAx7FxhXWy/wlt6lU+b/S1cuIuUKpto9Eh5A4ydIUDzC6+oSDqTowgMVxF4Hy9Wdbm",
-	"AVd7/dXiInF7h6jvKsetuRV8bQTnGvuuC7u1CmBtN1+5qam4vDLT7xKFiLZXQ62oPlvIQ4yoXEzDqMtB",
-	"fn2Vu+SDf1ZHK1PAAfE98hzOYuSSUAYHWzCkH9d34K0AfptFSCY8cFfY96g8ctTPpveMIE1vA+o7ds16",
-	"qo9FOOzAmdqIOzKmjt2KKNsykjO/wemgJQp05Oneh50R2W4QK1K/18Fpmt2IW8BDUQBxyFenAcpA/Qnl",
-	"azcCpL61tvFyY7RYIJfhNZqZcFbV3oahpn2ql6U0iOWcmCqHWRfifw8F59RtrhZglRiw47KCJgZV5GVk",
-	"bfGquzYJQBUb5EJhZDvjTMRv/6RipzjwPR9SdPpeMcpszbjNa6EKz4E+ovZyo/qFIgd+O2dZx3DrGUAG",
-	"2NjA0MUJQtBys7MD8Vu6PzoG/O7wLe1FJW3q5vBTt+u2jBaib5wPVBo3kVLM4v3P0iE1CypIgyC1LEo1",
-	"LggFpz35Ld2p5g47+2XgRJAxREPn3Pl/v8HhH1/f8P+eDv8y/Po/1K+vb//3vziN/MgVi++CS1J66dVr",
-	"oibZi8cKsNEbG0EkSOFVeNRruKRAO2WeRyG0Px/o1OGtbbSegQSAO+KfwoP29CaGk5qPYciU699yI3MQ",
-	"lhPb7YTjJGX2y3Bijmsknh53owpqFVwAsW8Ng8wFHT+H4kE09AJhEwVIvZBeY/SMzOHH9iNA26vYWRZO",
-	"r4heLO9rDRBr6LzfLVp30Wjp3dGsoqdmdojWo4F9tT8ADfH+FaA5pCpKczQe2qpsa52ReLaAAfY3tq9V",
-	"D1jL32yJPXSFk/aqAtvrPBLtBaw4Qm7rF+7agDU5bBsptBS8XYiHjMJ7VWrpLEc9qh0a7yZAPIQUQW+S",
-	"5igpui4tqUtKjxFt+UMe4ldgNOwi2bjMj1vme2lsOxTNhnSBtYYyB+feb9V3g1OLeK9XEAAnMgOHC9Ip",
-	"fGwPsHbzuByUxmww6kJiCx7vVVrzGeok9Q9H9qaNPl4bhGUu1W4nqVNqnBArErO2r4JST1xLJ14aMWbJ",
-	"NpwlhW+W7UCkv5VRS3cPNzcqe+z959tb7aeIVRL5WuUfVU7ZgZae9vry01060O34YSo+p7lS9kyloJus",
-	"2+1X5lJ4vBaFR8Yiy6A9qheKuyXkVWUcy9pkKzYkbZmssO+lKYcvP8SArSADz4giAF2WiIDKdCAw3wCK",
-	"GN2MXI5+H8jknyctUrkMtpVTqtFcJUIUjG7FlVka7VAAvVagJcuqWwBaBfgFVC6NjsBSNRFDXkW5DJEe",
-	"QmZp3qZ41nO0JAn2bDlaMk5pN3abEJg8y3W8B71+QPejWzI/58ZVaZoroPO9hgBst/yQ8d2xLe/pERCG",
-	"K++qNC7ihSVKU3jsHg/aIhFUv6m5+0w0o5DzOUNpUR/k0qHffv77xZ1xkSYBUgbQLA18dQbO5c3s9u7z",
-	"pzu5fz069nZ8d385vpqVoKMDsmoR5BnRsVvczvR+fHev1JhAj/xD3UBmmVUhBNbNbstkswqciNmtFls7",
-	"I7O0IRnwk+ZXVdV47OlWiU4fTSdSKKjLky8fTJmEz8THKGQAeyiICEOhuzEnzy2m3tPkkz0RolqppFW7",
-	"WVCpXEUgSZXBoAf7tEGULiwPk5BnAbFfbfy0pYGtTBGnPBV6Yx9/D0slywJdNf7e93OaAaSTWGYM6dRQ",
-	"XFEBwEWAaJRiv/urDTxMSTqZB5h1Kzm25lvPkiNHNa9Zbigg7yQ3hMk/gwt+MK0MIdyPJ8QvS9bQBsa9",
-	"1t+8ZDN4JiSMiZ/6GppUo6jeW348TSjqdlFt5OI6dFNI1LRtnkXJsrZqu8dkIZptEDV4edSbz/ezu4v/",
-	"83AxvdeP3h3M0hm2Xhmaqp2+pgPoPkfKe1m06W9/jrUnk29wECRM5GcUXARiLkGE4zNLOGou69T4wNn2",
-	"CFnTvghhvchgvpidoR6o7pypCHN9vO7Ch/p43a8H9fFa0c6EhAx9qyOhrlI8aFBs6ehO0dPFxWHxjJkN",
-	"PSjuOrdeM7YfbyZTFMeVnrjy+Xp6MZ1efr6Z3V2MP/ybOSleYNO1z2geEyGCRD1Cg4fDhwyvEcgajiJK",
-	"vm0Aby7cHiF5vJmAOSEsZhRGJ05DWTSwnvEE57oJxWwz5fBX9Q0RpIiOE7nKufjXx5RF//r3+7T6pXCc",
-	"i6/blawYi2RNO6zubVwSMigrFqoKjH9L5ugRUwamKxStEPXAPYIBNyu5wBVDxOej0RKzVTI/cUkweloP",
-	"Y9V2lP4oxdk549tLAacAhpyPliCbaI0pS6APApmhNgYw9IDrk8QbhhLoS7JGNOQ0dPIlHHsrRFEMGFEC",
-	"8d3ZOeCjc7aj0GXDj5jGDHxAa+STKEAhkzWPfewiRUpqr+MIuisE3p2clvb3/Px8AsXnE0KXI9U3Hl1d",
-	"Ti5uphfDdyenJysW+NrTXwPoxreXWtTEuXN2cnpyqgzeEEbYOXd+OjkT03NCEggeiRiaEUzYapgm3xpy",
-	"BKpXbQJZmRV66TnnDpeOxez/spyZVhjz3elpZ9URjeULjAUbc6VuUMjUfMaiN9KbHCdBAOlGbQvQlkMM",
-	"HAaXsSjxqkMwzoKTvvJJTEBuDt+DwdYG17EFEr5sXwKiBXKNoDVwIhIbgCLNpVylmOzA9Z54m14AkrfR",
-	"vudlKrcAv5cwc9bLQtpgRZ3OOd//LAnFNEu27JFWwlZ0+Ut9l6z0bB75ElxWxllQEugMpjHSPnw0etGS",
-	"Bn6XytRHMqAuT0MyF3uBhkTqbsQEQ/5m3vi2yUgrAP79awn5PxvLJRiBkVanFCD/uR7kWdnbPMjllmwg",
-	"b8hw/Jxdhpa8nu8WWv2yaz6goBG7nh6dXZX/bGd23Z12JLj2oZ1mLDkSKTuHgUzl2lzv6Qlg4445tTu8",
-	"mzLmGtAv2gAFA6U590OfULWX3i1Y6kPHwuyFYb7YX7eaN5fO9xXKhMos0QfW4qXsx3Wksa/6bkVQnej7",
-	"Eg32JjpGL+pXe03fGc0OalurWRqbCHn8d2sY7ISbFibBEcHau9w4qjnRWm4c1I7YT24ow6NPubGt7mk0",
-	"NT4hVi5W+WpNjIqSnQaykC2AyLoOUqDvKU0+IuaugAQqwB43F9kGeJBBOU+snG2do3ETiicRZstkugnd",
-	"kjCKX/sppVSJ+IgHlXI5YRNBicoAilW3lutBzyp8DSAtByCXsrul25D4GIrZ0M2Kgdvp8B7lDy7bAuI/",
-	"hEgx1js30EHabs15nwMHUNV2P9zyWa1OI1ebtB1uVZR99XlzkjZqjSi4RE2slltEZdM+sakXRTQhTpU0",
-	"tflr3S0QUvhqf2p2PkyLKfYjP41FPQ98ksvKRdoBvHVuFsCc3kwAmAK7GtZlKh69bF+NfB8VMi1HCbMZ",
-	"6+XqnWVSx6F41sJW6TuGc/2JShHGAw1exTvHr72iv1yC9MCqswEJ6GWDcyb53n46tzxDUyJK78SHWSSA",
-	"3QHHO+ghAL3ePpXKUxggm7YBfPFWGYb1Vso04FuRN8CoAK0CQBo7wYrA6Unc2evQHNp7lYsFqcPN0W+e",
-	"ckTQBN02Fhm9FMOHmribDNTRzqjQOzd2H+Vx0K37qDVA61xH/YCoXw48rh+oFQce/TJpDw7Mx5VZFdTN",
-	"tlnvNvugyGsfsc9V8HyT0/PqBluYUb8niG62dlReWW9R3rA2ep+HBnN9CgOJZQ21w/9ZPaE8hPyQRij+",
-	"A3k1oTahjtOUZHJ/bKafb3IBnt1LBUvVmgMrZUMNkCqk6YeSgytm7eCjR99W4tgkEkYv2e+yMs7v/HPo",
-	"b4CqCArwAoQEPF7HAFKuHCOfbPifQ8BWWAuFPvkSqmelMXBJuMA0EI8thSEZwwViGxnWZ1L8Otm1k0hZ",
-	"T3UFUojZ3kSlAjeMpOuTqh6TMMt89wv4r/88+wlAz0OhlwRvT76EooRRwFUyYKvSYOgbdJmvdmYSXzoo",
-	"2h8E6yyXLY3ubrXsR57KzGlMmgPrdUJHNHBQgV8tNzzEIPbjfQ2DT4hpZDffgMsPDYS83aHRJaB71BBH",
-	"NRpbYrpbP8UOcr6Qb8dq+93mEmf3Br5CrRoD7LYtrA6JOIkiQhnywHZ34AltdBOHzqFrBAiFDA19HGAW",
-	"j7Js+bH9BkLZI+UqN/1QeV2ZlwOTu2HfBpxlH0EM13sYQz/Vd/lI6BxzLbwvSym/BknDBAEESYwo2NIH",
-	"QBqus9uRhgQ1elHZQht4N4zE1U4AixReTd0aW3RRFJAMYYeE/p2YuBOYb98PWYVbocyQcwiG0Soamd5T",
-	"bHcs168dANvhwXDnJOtflEArJ8q/rKiELB+gQMgV1oOxDk68HyX3KF9N1XqOJVxzdaEM1JJ++4HE60MU",
-	"I8q4gh4W6ZBotFFBiGm+PztXixZ94ictiGFiYOLbb0zu3o8ngKrlWSySaneLqKHREweUqp0c2Mki64NY",
-	"QHr0iw43iRkJtihsZFNyVI9e+P8aanyyQ0wc79RYxwtgHvns3wCGNZca+8OpH/456hG0kn+Ofk3RinFy",
-	"D9erL87vtYfoP3A8US77twGJ6XerbslAVncRr7/cb3EHn2UG6Id7zMnlD6yBttkPKhBwdE3EtpiowqmB",
-	"m0YvWsKOptfrGuLb8VfasbFuykDc7Y16Q3g1uUfvDhb9cdBRdVAjDjq6LmrNQeLEW6mLHuIfPqQ1S2Vu",
-	"wB3/ZlU9SVzINNBIq4iM5/2wQjnD/4EViczmbgHj8bMFAJ+40Ad//fu9wF3ledvg7KlWGgqvPfopBRRz",
-	"OuKQHoz0/X89EGs0yv6A6odzjqpAKjnn+A/39+Ac4Q0YzrFI+FmvTPip7X3auDt26g5Tn3wyh762zEqX",
-	"mNp3d8/wl2J6caJMB1dHnyJmWjnYCqB/bfxZAvpR1VxpNbXo//Ge2hvorBGZNZQDoxf1q7ly7YI8B428",
-	"ZWqWds7FFEgdp9sR4P7vsQkfNUiIIkrW0K/xJWWtDhHiagr82ibcLYWs9lhYoN8njwqo9yL5qzXXmWqV",
-	"Fkux5jjLt8udONSnIspH89QAS2V+8X1lEEGG59jHbANQ6EUEhwyEhAbQx3+IsEVGwJTBJQK/nFyAx2sg",
-	"hgQRjpCPQ2QKUZRJodNtiaTMPR10jKm+GymBd32twf6oWTQDCgwAui6K9lAF7/7S2Q4uRJUQy208SOMP",
-	"XIS8Uli13LWiiYxA0z2+cY309bYJ5b5kGZO/q78iezCSpDUk+ay9c0p06/MtvtrVB+RimfS1BaX+bC5H",
-	"gzKJsL+OUeADMMVcWwTJsk0VwWLiezfoaQocuSb/QEfkPW0tWfaKPIdAVUTYFRMUidy6Vkzcie+vlVHk",
-	"6rpmEwmT/dlErq4RlyQeZkOf1Kae8zC7IsvjGV0wzRlhj6u39yR0l45ZZSn1hmn3AWSdhhYPArpMZiEx",
-	"Z89f62EGfLLc9wGTyo8taELPjP3bV76/UhZcNWs+762HWfFMkLDVSJahHOoVJy3SWzS83Rah7OXBfW6S",
-	"fVk/HQfITXpA1aFZJL6/2fn03SsGJQDyUYp64U8tDYmORZ8scUWimCvxuR+UibGP5CdVc9utbdFAQ3sn",
-	"GMyznJjhGbMVcCkSGark+dmGqqAyO9hEIj67FurRwSyKGpsySmi0dwCK/4QKQbkiab8RfisEfU7seF0J",
-	"wyu8RiGKe41+/FUsxfhEgxJObADHAIqVVlKPWiqIKJnrN7Fyq/l9UwQl39o2foegh4+386ksisJ3Lpf6",
-	"feD8Ik3ufk+o2sQhYenkFWDPAFUN9xbpS374zCX2N/MSFiFheKGWXPNQPtfyaG/lGQFJyEkB5JYOSOhv",
-	"LK9OZfuZarHFh4cWMPGZc76AfrytvpQV7O739aS2eutLea1Nt4/l87BbEJqT1fpLuxzWDTQzCiB9GkLf",
-	"H3Ig222Va0ifxr6foyLOr06jrPe+X1gynxV5AEqZVNginwvAUp+0cZvdSdoZZjUXbTL6QbSbqEqH/Sl4",
-	"bRrTRbLkDLnaDmiFK3EDt4G0omNzOL7o/1TODEUu5jACjkOdWBSttHyiqw3Q2MeU47oine3nZBCEmYNk",
-	"M5qMN3Fa4Moqn6eqzSEkc03TKaHs/aZpy89UlDjoU9hK2NjErPzarYCNM2ykeE3/UndJL1fT06lODn7U",
-	"e3W1Pzsejh5CJjEF3sTIXwxVScABCEl2B/LWiFaNUUcv8kddbpEsRwjbiKTbauZiZo58Qo63J1/CCYxd",
-	"6CHeImYU4pCdgyCJGVjBNQJ/IEqAqLYL1PJje7aRjN7aiQ3ZzZ5nxLKVHZKM6CMdOcOIotAjPzGKU4yZ",
-	"RIvNQNkbzf3L5wqZ0GHyEEVOxcwhOfGcmiSFtYib8p9PJufitAH+Xfv87/yUqoqfnnwJpxrN4hgU66IK",
-	"EYdJaOJKGY3XDbr6UiBHjaKsJZZ9IykP+2TY01SOvp0WKmYUoGBeF8QvgXOtWr5mOSDXWGOtyS3vnEGg",
-	"gyDNWF9IO0tv7Hn6Vl8rm8vVvQJrUYGplhr2NR1fdRzB2PPyNLeLiGjz2KEjEh10+0Aij/FjJ3OpR0jN",
-	"QwkdyDu9/N4Z0P1KjaO/GG8nOX5cmyFlhPzr83qBkJ4Mq42GtFGfVPmqHgqqHVutD3UnZU3TpgAGcAig",
-	"4aSWwrPWCyQbvkLLQC7suEaBAk4Ffo7vRFILaehF2tJFHb+OXtSvOudSYx/R43VsyFf7vzgWgXCvgIzA",
-	"DK4om1tpbwJu4D2WczRrPJHbamplKPQd29WTQdEoQazOnsMC/wDyuIrXu3QOFYa0Se79HURqoj08REfA",
-	"cW/q5LiWYj2J/YjmYUbKRp9SXuE0y0n0z3REhXRExhwbEqLrmuvax+sf96rWErutZ2puHfhteCF4yJjv",
-	"x2sbNTxeW+lA4jClgHWg4b7ucd721Z18XhXLt1YoZHQj3+nlLK2/cEvrIUYxN8VQyIbSclOPCgPiIV8G",
-	"q2IPBRFhKHQ34Alt0rTR9pd86oXbP9/w/UO/4cuedpZftxjIdhSRZ0Q7fFmaI1rtdenFN+QmjJ855Bc+",
-	"LciolB+iPRSh0EMh8zeSwOcoZkO0WBDKrbUAhgy7cS1534oN9UrjYoofg8QlnP+xCT2/xwaPVU188CL+",
-	"lx60baetrQhtp85Fr77PTylpCPVaTxpKDXdwlMowkWn2ZpBu+N70RwD62JWRi3agq7eh8qVegRP3SPMv",
-	"R01fmwrhSlEofZIpXpojhCJGN1WvThnd/GOgQ2yla2zIQRcQ+8hri4tUXddU6ni8vsv0ej8qbgd/77ue",
-	"Um1UIzCv0wYZE2SPeHfRchZNkzpptmqGpk5Uk5PXiNqhgNA3Pbi8RJMJDWMRmD9c4xjPfeHT5Z1AigMw",
-	"3/AFpet4xn9A6p18CSeqHY4B32TCkAeSWAgFFe9/93480es/AzGFVJM08c2Rg0LpKeioKZxe+bcwl/mY",
-	"lu7ezVoZdFKhUdXbhzy+Xta14ZzjeBO6YI0huMPrrbP89E9vT0CKxnen78BYUae0aNEahWyGObpEFXwU",
-	"rs8BbeKNP/kSRpR45h6y3pwIo+T4frwuRlDeY1EsUTVXdfIRBTkPv93B/3jdWtg/Xrd01TduegMDo9Oo",
-	"OxmUbrpK+nxIg1tT8QPeFPL6pPdSb491oSCwlifwQYVhuyOK+1XmFvbv8BqA6+VCfKhRGIxcEsbERyY9",
-	"bfL3/Ak83kwEdcSx5uvJcb6HKXIZYOSJWwlxnHBjLsfprsp0WiAtGHqACimTKT1pept4WAnUx+uJ3MFY",
-	"rOlVolutUK240pqWLVMApxl0AA4C5GHIkL8Bb1JICxbs9hC+80qLR3GBy6LlAt6kJPD2B4hWSy0xbibl",
-	"NtuYp0o1rgoPsonvc/Bk7ieuyVM2S2E2UgBWjGA2ZBQysjpZr5YF6g/xBbrST/OvmlqU0HWNy68jGIpi",
-	"BmllXiTRoEN19s5kp4tJOjw2yvEMGrsIgJrtT/vf/LTTrU+bb5xEVfsmUd/bJlGHuyZRk02vQ9cqFB+h",
-	"jz1RKIWEaMhwgITFMSeExYzCSEtmAhaUBECkUuDnSfKEkVA7nOzmPo5X/BQbZqyIRMVRfqT0Md8PuH6Y",
-	"3oObz/cijw2Yi1Qgeq4UcQ56uLuUh5aTL+HjmTJPstG0dQWIQQ8y+D9BRMm3DcAhQzTkw0CKAA4iX5R6",
-	"EcgdemiBQ+SZ7JrPEQofrx9vJq9Sjj/eTKZy61VCnGMshVCWcWOHV6kHluEc9FyIa8sv03KDFDKIrlOU",
-	"lVKweIn0zY1vL52Bk1DfOXdGMMKj9ZnAnZqt2FMmNwHuCrlPmcEQby+fVXqQ8lPGNEg4qzW0vZZ9q91d",
-	"q2BbQ38VhJErVpT2SkMwyt0eMWUJ9EEA+eHd3H1tnDDLN/tM6NPCJ8+ZE0JfsOYMK93t+YkosG+a0pXf",
-	"TPNmMROmftvYiHLHfFITA6D/rK27kMLEsP2Erbj8kfypbTgxolfUvdEuHLUOIqGaaYI095uxl0jHVu61",
-	"rY1NVQVt007/9a0hlsK0y1sfsgWhAcDhnHwrZLnQ4wbenepD5hILlEfNipgJNaBSUacJr01oFfmoTatL",
-	"lksZypbDBpfsa+xZaIu3HaYtYuf71+//PwAA///J/nNdaiMBAA==",
+	"H4sIAAAAAAAC/+z9+3IjudEnDN8Kgu9GjLQvRal7pr2Pu8PxBUdiz8jWyaJaYz/mfBRUBYnlLgIcoEpq",
+	"umOuZ+9jr+yNzATqRFSxSJGS2us/7FGzqnBIJBKJPPzyaydQ05mSQiam8/5rZ8Y1n4pEaPzXjzwJJoda",
+	"8ESEH7Wawm+hMIGOZkmkZOd951zGc3YLrwnDAnqT8YQpzfhdIjRLJpFhSTQVnW4ngi9+S4Wed7odyaei",
+	"875jvxnfQfPdjgkmYsqhnzulpzzpvO+EPBF7toVkPoOPTKIjed/5/fduaYhXquUAb8Wd0qL12BK19siO",
+	"j+ALbHzGk0neNg5pHIWdbkeL39JIi7DzPtGpKPZU0+gw4UlqPkZxIvSSGUeSZmnwk5p5Zg/znv+HFned",
+	"953/Zz9nj316avavT3EUF1wLmdBY8rFdzWei1cjUnaU/zNE/LqKRfWGlscEocEyHajoVMqldhoCer74Q",
+	"h0reRdqzI4bRdBYLFopYwC8soBc5/uMu5vdsp390uXdw8OYd+z//+833u3XMZzvwDONWqVhwWRzHGX5U",
+	"HQuQgWlhVKoDwaBhlig3onyI5QExHoZChul0tzeSp6lJ2BRIypJJtS3xhQdJPO+NZPMcxvjPpfQ0KhZD",
+	"YUykZO16GXq++nodwWTFITcBDz2UgqaEScx7FnAZiJjNhAwjec/4bKbVA4+Ze4MlkQiBjEAPJKEIR9II",
+	"/RAFuOFMIngI7K3FP0WQQCP5qz12fWoY14JJ8SA0C2hAYQMN7ZCL0xMynXbe/yMbdedXnwD6qHTgmer5",
+	"g9A6CgWL5F5qBDP8TiRzFkxE8NmwnVnME5Bw73k4jSRTMp7XsegddrCEQY9lEKehOBIzLQIQp4sjsq+w",
+	"MHuHJWIKAxGG7Ygv+DRkt3MWijuexkndgCJqaJw3tHx0JoEFH0b/EkcijPCjw4tPGftVegjdO+NgljY2",
+	"3u182btXe/DznvkczfYUTpfHezMVSZSPdzw2ojKIWs6P7EtjE/1LrM7/xT4u6TvzU/08bdNmfL+daboh",
+	"DC+Pz6+XDsLoSD1sYxhDwXUwWeTIQ27EXiSNkCZKogfBTHpLxLTCUEkSgUqzMDKzmM+dkPMesNRN8wqd",
+	"qPtIbk3+nfLZLJL3tQ1P6fnqDcPJY2Y8qOdc6d5Yo3GVRHew4ZpoIgsvrd7FBb/3CEn4lcl0eis023mz",
+	"F8lQfBFhndyZQRvFbqyc6rx/0+1MIxlNQV6/yYQ0cOS90NS/0P4hHCdiathMaGab9/Ys9Li+97cH3c6U",
+	"f7HdHxwsH4xWD1EodC2tZ/aF1en811QlvLbd3+Dp6o1e0gF4fLRIvsM4EjJhUSimM5UIGczZZzHvsV8m",
+	"USwYZ0kUfBYJbOxplMCR8xglpOQY2NifxZzdzkcy+8GetUIzVKejOGZqJiTbuRicHR2f/dRl/YuLy/Pr",
+	"wREIhcHfBoefro7PftrtQpsjaT9nWiSploYlE564MRR0BrxyoN4hVTIRul4vsA0SzXIaTfmXEyHvk0nn",
+	"/Zu3/+VTCy5VLH6MULupv53Q8zUWRMX1gkCreA0ZMAwmIkxjEf5Z3dY2bdxL43+q2zX6IPWtvnl6vkbD",
+	"ks/MRCVOP/e1bV9xB8hKzSud/DhfZP6PkYhRSTVKJ+x2XncuKZ2M8emyTs516LvRwSMWRloE+ENDLwob",
+	"8EqpDjdBp5sptfQv6Mev1g7nJhHT+qXCx6uv1JXVOGsbdirpGk3jNq9vGB+v3uwn0yCoU7OOkL4+rW3w",
+	"YQ2aXvM4Cnki4OK/yDzuqb1ZknwEKazSBM49ExkUhVHCdkI9ZzqVdQfwg21qDNeVZTr/L+J2otTn2pk+",
+	"0vNVp/s7vGxmShphjWehPZ7gX4GSiZD4J5/NYquu7P/TACm+trRuDLRWmroqk/JHHjoKdqxRII6CZ+j4",
+	"0hkEAtclXTxvozAUcvv9512RtvhRpTJ8xmlLlbA77BM2pORpMlE6+pd4hjGUeoPH9gtosG+tFkciiOC+",
+	"UGDEmVYzoZOImDSYRHGoaaV4GEZ0aboovdM0OjK/QiNDEdtTwMOdcGWaob3QoEWhxy6E3sPOWRCnJhF6",
+	"3yRKg9ZtXEOgg+G1fyTpTasuHR/12KEddyYvuGRCJnrOUiNGktqAWzo1Po7C/ew329E4iLkxpGDZvaxu",
+	"wWIDE7B2QY/1xN4rrWFIaGABwcxEPUpnFcpUxU63pI8dHBxkXTmxgUIj+pdYRuhLfKtEZM8kF8fbRysO",
+	"vWpYwvW9SBzJM8Pf/9rteAbmJ5hf0i8Q0HHghRYPkXj0cF7DoIe2ZzfQD0xMZ8mcpTKJYljqjPhuYkoK",
+	"3xTuQA8a0141nn5mImD4jkFNiYxMcAx06TrwOBFaMMGDCXvgcSpYAFcB66uIEjE1yw3S0AnqY0McRien",
+	"Fteaz+HfUy6jO7s//buQDp+KdRdZTYZCi5B9Tm/FQ6STXqT2H96w60gnKY9PeTCJiqTJF8j1OZ7zabxI",
+	"GveYccP+3j898VE3u9h7eKJbUGu8HJMfrP8oaUB5owW6VIdbXdlfGziQtK9FBnSW3XHtXu9bLvvO0Cbn",
+	"ScLhnuH2uWvBRxv3zIy1CET04LN7HqGCEyRZQ4ZpESgdipAZxe64ZjvTNE6ivVg8iJgFEx5J02W0aw/e",
+	"seu3u53Fe3y5c0flFp1LIcKid0xkF1SDVi703IQNPdIVYZEWxkT3UoTj4lt+Uhd7feQGreb3ZBFWXRaB",
+	"Ud215qO6XUrPVr9EMcTcC12m4hDY+y7SJvmAhxIzAu5K7KfBFdvPqLL/NePO39tuemI563vybXjnWeRJ",
+	"W4dityMerKPKR2LxZYaWUu5h44/gjCX6huz67HDcPzwcDIeWzKYLUg79VEaBZSQIhDFMyNB0um2GtoLp",
+	"tWbwJdP2MtqWt3XRmItSTYXRXQRn1kwEq4lTINPh5aB/Ncgpk+9ylALUOqlxhqFOJ0KwBSdqRg7N7PD3",
+	"iVzoH78ln6bfXafuWPae81DjdtBipoVBFSpz2O0W7s009k63czQ4GeAf+Vp3up3T458u6fnlYHj83/DH",
+	"8Kx/Mfz5/KrT7Zz1TwfDi/7hYOze+9WrqnCru3oewSzHjW84rcj/tM3iX59aPSidTrnGrWSd1wvEHPzt",
+	"4vhycMSmXH82BZ3BswXY40SZjPMfIxmqRzbhuAlE2CvQ2Nr6Ot2OM/YhPf88OLzCPw/7Z4eDkxP8OzMB",
+	"AqU/uWX42D92j3F8XjqTmjamK7d3P9MadxmtJeMyZG41y9xLTbHr005jP9LrP16pp+tTcons3OVOEa9e",
+	"6Qwpq+10Z59ZUB5Qa8iEYzePZsgZbrl6UJIjHgclPWXAe7BDeVlSdHPjcUFaBLO0y6ZiqvR8PL0dSSBd",
+	"GJnP4/tbpqwTNpMiPXbFPwuJuiU25MyCzCRKY1DNSGbOaJTYVhx0GVqKHyMj8q9jcFoFPOGxuqcrTkX7",
+	"pkfjYMLlvU89uHKNRKW5h9HdndDGM0ylswM7KR7OBRddMEvHgdKieIco6BCWNrUPcy+bT4DAiMZuNF5j",
+	"UyoMnP8FKtHqfWdyL3/WgG/8NcdXtsT+kdeM2MfF1gKc06nYeE6g6mS7C8u5nOFPIp85INNuWqk55RZ9",
+	"eo4UX5JxkGqjtM/HZgxcL+g5KNV3wgWa3Kk4Vo8YO4GNmw+M3wK342kLvMlNgo4xZDzYfdaY/aeZjpSO",
+	"krlP9Mz4fSQ59d88t4v8zcb7Lc39AlTjWiPLE5SSSzGLeSBMmV1LDX5A2wfej2l4LIgF14ZFSQcUQw7x",
+	"SLjnZ2nn/Q8lfv2vN3982+1AK+NMIsGr8EvPyeke/itUUx7JXjBLe1kQxOEsvYDh0fUJxu+hVYXRy8RY",
+	"zqiX1qa6SFbyGT5yLSN579EA0ONoytZllcYhE18CIUK4TWQ6gVSPPdYPHyKj9BxvA+8L4vaOR7EhLvvr",
+	"p/Or/njwt8PB4GhwxB7Rmwhd4GjgpkStZyE9bTYSjvQXmohvG9XpOFYdYXDicibFo2WRD4yz3D/INDDR",
+	"HP6jdEIEQddlxk9BqjXsrezobNR3csXGq7s0XPu7HatqjIOChbpy8OhU0PnGnUoRMvfZjKxJjMda8HDO",
+	"xJfIJDbKUYxkZjvosX4et/VPtBCZNJjkZKHFvD4dg+I7Pjw/+3hyfHhVMgYWBH+le8/pYnWfRV6zt//l",
+	"zJZMCmctxhsAM/E4VoELqnX8WBpmC5OKXdble+2qoJlVVsU+8Sg/r1ZzcfLraUrLK1Y8aoekzPiOT6N4",
+	"Xvf0QWgT1dzTFp8VI0TqdBb31Zq6SRpGyYm691jpgqRuoDxIlP8yuY55JRQJSPllx/PC0GvWxkXKjpc9",
+	"d6aAFuohd1728sflzhxdSlRoovlGNEG3fp7Da4M6V5pMXISSh1PSZFJjWLkU95FJ0E4ObzEXxcRmcXoP",
+	"hwcYXj6Lud+kKO+i+9W0NjdICrW+/0DOA+NMRDzkswSviEYEWiTOBcG1oLM6IEfEqDMeaxFydMKMRx2v",
+	"o2oNVnff3Prlg5D8NhahP8yyhp1BHR+buQyWc0q+hsO5DFz+QIM0K8SPeC9Y0O3YBQB5nDz2ScjutUpn",
+	"TIs9+AJuHpyFqbW07YjefY/9YbILZ8K7PVwRFmglmfgy0xR16dxQeIaFkSEyeQiczsIVF6VBsuZ8nS/N",
+	"r0t2x6GSkhyFV8KACo2BNgv3EmGMDT1cJHqK1rCaEIriWN2bS8eEXFd7SXrR7bsw8MY9sC1OPcqYkScs",
+	"FnDDfTNtYkhU/I2fv5fy2AJ7LVvAn6B52LO1a4gDKJ8ai9aSSB7Twzeeiw4dYzjZ5Ydi6e2u632FadTd",
+	"LFc7/I7DC2hOhNjy4hG47CjbzAGct7f6CIZoI/joqF4eSN1idDsmMy3UL3d1hVMZ/ZbC/S8lj+viJsGz",
+	"MpME7iaaOZuopa6bSbdDUdqdbrZDoZPPUj1Kf/xgkYMc6xT6rAzx11akq2cl7GG9dSyuik+vKoRiL90q",
+	"xZe7blBL55afz4txMGmCVxqr0qA5riqJQAxldjuK3VBSMM0Xb3U8DEXo5wdwTIogTaIHMQZDTFpvQLby",
+	"czw1pXM3kskffvC6rQUGVy36Paib0uR4AvfJ5ANDviBlLb+04vTpILxLY+YXwNYeqkWi515P7S9k9oBZ",
+	"ihAbYZFh8H6EikY79c4k3He6/AW2BK2MYdPIGLCuZjM4Di++M27dROKllkEpt5KqaTWhFpfJvPGu5Yb8",
+	"azen8hLXsMYCV6/goC5y/5WVQGVGvU2jOBlH0q8ZkLYxziP7VlI6SuvlkaVLTRHt7pJWzFXylrKJLZMK",
+	"QJdNH1mUr+s5torjplaXDe8T8kx9wOMa1zlrhJ+CGHM3Ol66u2HoSKIWbmzssxAzw6LEOGMYnjSdV6dx",
+	"Kr2KbukuQvYOZF0NbaICiwsF4evTmdKeVVrK6WLKo7gmEiYRWvLYH1iYwHgh6QdGxKJQyCS6i4R2oj41",
+	"QoOpFf52Z6ZPruWabiXqwPZu6XV8ZChhEK4u9zySptx0JnJt+p0pegyW61JG6BoKVbZO9maZPr+2XqKB",
+	"OygrOx7s1B6/hDIRsRVRlSJzI1myjztFbpFp6++lVYmA3ecftJ9P3ZXYmkb8mwm1hfUEXJWSntWkU9/f",
+	"c/tj1E2geHjalrMJ+MiEMdo2Cq9BeD5bVHSr4OarcjjzXR7B5eLa24Y4ZwtaSSIsx50bmIudYo+d28RB",
+	"pa04tE8MEw9Cz0fSYQjgYHpsAGHEx0dsmpqE3QrGWekFt1kQ9aLiPFy8RfMv7hZ9cLDIS08K3fbF9C8J",
+	"314k7Lr9bkKzIJCUPERsYxbpRW2k1FjtvnJjWdQmHQqMj4YF+JNVUE+6lNTRdMXegtGYZExTp5bZm15p",
+	"iB7MXeArQ9Nkxsymrp9ssi0A+pRxa5xPvbgq1SFV6FclVon6peUrDdzHf4fodYNQm0elw1rJLsXjeGZf",
+	"KhEg+9HDEioOV/2oQrRSC93yKLyzIanjC+aPxkboB6HHqfYrhhBVBUcTHGJRMkbFt7zWKr2NCwttDUtr",
+	"+xMxvXWpBG5xrWu8Ggj5EGkl/eeypRcrvETW8hLkTxf+rxRLnFAE80yr0BvkMRE8TiZjxIwpGWUq3ef3",
+	"c2fUoC9BAb4V5gPTwgiM6bL7wasP2t4KaqHfXEPiI7dpTBUm3Acw62K/JT8OPfD6Dmrksku1afSio0Os",
+	"RCafwecqmgqT8GkWPV435NbGHxvctSaj198zM/HrWOTT2V/Ozn8563Q7Pw/6J1c//73T7Xw6K/59Oegf",
+	"/tz/8cQfS17aFz7m6aeJ2gtFQplgQ3r9EN5mcWSSEgv/1+5KF6dEJZD3UwxH9foM8bL4cHjxiQV8xoMo",
+	"mbOdA/Ynlkojkm7+Iy5w5hH05+RQn6VY0fo+6bW8g0iy0x/X7bvJt1gWm42hQlaWHNqOL4X/6m4jlmA0",
+	"TRQ+U6FghXcZUHkaydQALtVdHN1PElLmIcDt+jTD3/ISt9hpA4kXOrV0XrtfqcJGX8ZyRkunsPWhHVbi",
+	"s0hCVkIsGH24HkcVGvdxVPSjt92HaT6lSoMTMZsIHe5NueT3kEpxalxArr0QdBnhdcG1Jst1WMKRVSp1",
+	"a5hoccp1K1+YRGmRmvi62T3d4pCunMMO3cKepW2PVjhdciNlNZHaiD/8sCdkoEKb80mvsh1rXhQy0PNZ",
+	"IkKXJfgGUwQz0X87T0RdKqdf8H+OZuPAhhM8RMmcTrPSFNF63l0wtbkkwsIwXbZ2oGTCA4vuYFj/4piR",
+	"GPKEv/nd1nmjTYs6yBeF7MKLC1tZt3bLVBlTsY2G0fwlG7OFvvDerDWkGwM/+/U9K64Lukfl2Mxoye6j",
+	"hNn3ugzjWx7e9L7/vvd2qV6ej2GhwxXnV7uh1uLz5axcmUg7NtmE0cE2td0IONvJMh9HzU0HJbOJHsSp",
+	"QwEjb8eiYpjBhB14lMQVVk4XPCerrGKjHruhaby8ZPPqB54xN5/5TR94ecix3c94v/AcdcsiYX132LL9",
+	"X+g92DQ2ocAKH2emzXBo7b8zC8nCUGOOwG3jqfFfnZiZoU8O1s3hrGa7qgs6zjSK48jAIlXSmWuvQLW3",
+	"zIG8jyMzcbdMvDyWOmQR4gQw9bnGK9/CgFVZnAK6cslX7ihWINCvy5d6uHCJw6GG4l5zcriH/qiZboe0",
+	"o+tTh2dWb0jyJpIenQ333rx5+z2L+a2IPzgcV0M+01F6cPB98DBFzsB/iD0j+WyPHqQy+sLsGtLTUafs",
+	"Q/jD943Jysu8Db5dQnjB16f1kT2N+fj/Lhk7DVkliym3PhY8wrSxAbyLbvR5PUFDPR/rtCayIkwJQMnD",
+	"XH1pHbkBj9k/1S2mdBBCI+SBdJlRTCop8PdIGqHrcj0al5Qe1oRYdDuAO8j1/eoJBBawcDHqNQIdDuZz",
+	"fPSBKetswuRqAkMrCbT6GCdo/3MkG3uA505FnI7J3OnNn9TiIVKpGddm3T/kXFkEErEM7cAywWdGt0Ov",
+	"W+63VKQt3L8FDiwszuIoCzRwbRfWq5sxXpHLfLw8uLtDXUFcCI0BVEqaRTY2gZqJ9nrjEF4vNljj6G+1",
+	"P92LXTcK7zT8vnzQ2BYX1CII7WnBQzSZoA+Zwcts504jwlnIJlyGGAfy5r/kbj0Q04oOdAx9rPWXLz2o",
+	"Y3XP7Etsh4DaNPt03IhNgPUvVt3DVRc8ENJH+MJ8aqnvp5z3SeswCRflWj8wpYOCj8iIpNFRlAiIZOB6",
+	"XvL+NCQuu9c+5DE26ASnlDAWJYwnDGIjYc0iWZRrTf4nBH6ej6E9DxdAhFDeH+Lt8mwkWdeGZZTymh4W",
+	"aPVTrG55XADR9ZtAH0U4LpgFykzf1ha0CdigJX7buvQ0C9Vb+6zeYASCp+5Telh7hraWcyjicmFXABbO",
+	"xlbq7Nc2C7ksQWVbq9pE6xWomVsc73Fmy408tt9WxNmEiWSh0XaZCnX3VKpYsdI1daFts/RKhGeWdx3r",
+	"3X/+69qvtXP712G5CFNZLQbrNjdixatjyVNpr9pmjTY0KInjTCNb6esKHbKZlFv1jbOBVvUXiHIpq6aR",
+	"LpJ9Wzf0wph8czoOLzBryJZneOVnSRY7ikGrdWKJHtYeEPS4JkkBkvchFjaLOH7gMjIQPlvMVCA9wsbM",
+	"MqlYrOQ9KBW2thSX8xIg6PL81eZkmhc7DzeSR1rO/Vlcw27jSVDh0Jc6JDfCehs6aZtp/kQCb+KgrTTZ",
+	"7pitfLTEx/DataEWJr5K3ubiwbskHWcjLLksn35FCb1Mji1JsO12GuRyg0CGIABeyBZZyEEuOjChh7GJ",
+	"ZCDazms9qVagvHffVSD//Pydxzmb2vA6uuQiPh7eJ2HYAWHWulCzqeDSxoU7v0dvJC+xGIwIc9TZcBrJ",
+	"fQcDtAdNmv2v1dJfvzMuw5HkxqggAqoFbhyIrb4kdHxBEViCyleqeOa3zS7PW3sCsF9j9OMkvRczfi9M",
+	"hh3bdoeth9rXLVdG844peyMb3JL3qLyZ951FaLb17VLLIQaXbRPL733LeEsSA5YBATSlMFSGXmx06SCb",
+	"NRS/s/WNz3IOr+q8neaXN7tPlvS17T3jdzC/8Sdr4avOedXmk9eyt5blTm5w7z1p221EJawAZW8vRqVU",
+	"X1Hp2sCrWg2laVV8Iq3wxTJKtgic+Y9s+I9s2L5sWODSEwiMeErMDWSY7oXiLgJ9cioSDta2DwCLZFOQ",
+	"2c3//x9871+/wv8d7P1x3Nv79etB9w9vf/8fN53aAVWBbesGJ9M4ppjF0ozrBouNs6nQ94JhWQmIf4A2",
+	"bJkVAmQkvboE7FQYH3iKanfyyrlMa+VSN+Yq2QHWho+UKjZ4b0FLiYoFmDMnnEVYrGP6Oy3MZJyoz0L6",
+	"SrNTrwyfo6f04nwIN5I0mezbj3sdb5hNoeFxi1kttJANqZnu9FotpW3l3NqU7pWGZMNratBpgTp446Mu",
+	"Xe4OkW7Ks1C0hVIQhfVYh1DeFGjs9PiI7fz5lyv2zyTadcOxo/M2NBvzMNSiJiENHWv8XsjE89h3ApcS",
+	"Egszywm5bNk2oeIU23sCmMgpj2TCIyl0rXRZOR7D2090rzmFmNV00z6CLSvl0JQt75IBbbGGyLCperBW",
+	"imm5zn8GpFzMHFyOObwwhiXzfmJoXTXm7dmD27La18uSR8rKXWE13715212aS9LWlOqPvTyFpSU8fXb5",
+	"8ZC9Ofj+HSwwSCmXQ/fH3VYBlUir8Uw9gnxwxa88MeDARvhWXsoG84QgXSiyVW1yFrPtesppLckBWZZ2",
+	"kS2PZblCNshqe86/SpbdN5FA4mmqcUIIGL+ho26t+JAp/zJ+mJp62xkOs/4SsjmE1EJH+bCekDBfpnF9",
+	"TYecAEsC8Iujdl81dkxwpz64iC2s71Inxwq5523l1BI489KQbCRKPGcEy1iQG1RtyIm03c7rElkbRgFu",
+	"LRoc92xCo1podLuWo6w7i87qR2hqBp7JYJkXk3+hdVCOtZ0MwlJFwmTJ0+CGwYW2vqv2PpRVQRhswcGF",
+	"kaDqHpniqwg0CcTkFKPYdpNZ2LG6VN7LatcYIIn1NCoZvX6ILsKPHEfSqXstuqAya/kuCpWgjJmabjfH",
+	"o4Xh6oJ09a9TNkBb8kWq8kLNV2CNWj9GZUtX18tLYf88CjzfKBiW2D5X11EbDobnkrsfWCptXQlKyLJp",
+	"EovyuDUIqFcwQaCxrfu4mVM5WjWkG2jKa6nNV+v9qcUpup0kSuJmDNLGPVugJ4FW+U4+m81BXeW0sZRY",
+	"Wt+i2MlGDsNCe1s+Bws9XWhxJ7SwwRLV1PWao+6XiUgmQgOiBZ/NmCy0l58x0C0dLhlkYF1G1Hpr2u1M",
+	"08QlslcRe2JDdjTKUuufjA/PTy+g0uQRlpjMfnbFNd+z0NayB/EwknOVagbogg6fA6fC40c+x3o+0QPV",
+	"C5AhC7iEQ+ZWMBttoe7u/JWevPlFldIL+ax+bb10m2a/vOUn2Ln8DdbDJDTdA57AJW1o3n74ZskpN8vf",
+	"fCLlLaGW0b/Y4bJpXFUw77NNUE3qLG6X4o+FSrTFF08HZ1dQDvh0PLzqX30ajg9/7p/9NOh0O4cnn4ZX",
+	"g8vK7z518qIk3arOltKRVcL80+P6p5jM3vBoXHXiNea1u/ylCxVHgefyPIlMAt5I4y3xeoYJ4HQ9QPQt",
+	"51UxjLtkoSmfo7qqRWqEXy3mX8axVZt805pGsvm5NwfvcMI1DxKhGYJlMZ3GtnAs4vjE4p4HcygKKfBa",
+	"UbgkyAgvCfRGTUlnoN+YDNXyTvnLdBIhENsgks694RxhBIAK2VZUwAgarDlOnF4YRvdR0qw6QvidDmze",
+	"Q/1rZiaCiMfNL6WzWX1bi/UpRae0UqVl9TXqG3R1rosj9tC+W2ZSn7zIM0VXD1gFT+pSKzi81NzxJk6z",
+	"wjRaBSfn75/yREdfPEKonJNb56hecXTUG6Qs+VS+yn1Xwba811wi/IcAuN98VODH7sL/kZ0hf+BzbpP4",
+	"48ZE93lodZ3AKvSRKKZVLNiMR7oJNKxCrBYtYxyBRfCe0hLUNw9jaGwYX+iySDqoMPwhA1EpDm+pTbXy",
+	"8sL8yoPy0fbXFgyHLLBiNYjmK/KaGSU1SXzFjwrFHJp1qIuYJ3BjPMzQfDxB0CSfQp7wxRV1F43rU3uL",
+	"yNVxOCwDrjHeWaXhXiSjhGVN9dghZO8JCGNOAI/fEvtD3oCr8YoPEfsAjhc4fznm4bsKu4ukddaBcZLE",
+	"44lKdQMQh3vX1ZlmKg4pXdgaH6BTDlCU7mD7wA5GMqt8UHgUKdljn7DMzV2kTcIMfxBh1zrjNRaFzyuh",
+	"9hzEb5LEzIgEZQYVgzd4bcHeHQLIQWmuhQ1npslsKcTA6dXFkHowaxmmV6ig4tq+bXHUeNapu8Bzy/m2",
+	"ufi2h4fXNQ3VcVaDA2iFtlddSZy2/7R8Fe7BBtTLT9KIhAx2qYyjaVTSF9egHfTXAIS5lf4eps8xs2LK",
+	"UwWGbG4SMYXQH6UrXjHfQpbTo5ZWI0dYEmfHWd2T1e2kzpC5tKtP+KY/tDcfdIEUrvEneHmx44WoDR7H",
+	"53ed9/9oMegTWFuQpl74l+YF65ZXLHMu5Eu52QWsUNZP1EUq/eroZOfq9YG3xa57ymbeXLPLPfZP8hNY",
+	"aj39eoQNvXTpigobFYsxIicXAzC8toXC7m4Ota+NHK/LzqoJPanM00aCtM65wPH+wrX0ZuIXYkIXB4Si",
+	"3v/IKrR1pX2cr6VI33YDXysvduNyozCDPNyzOGtHHB/FL3kiULrk0Fo11rtAqRjQCccOzNFLTPFFTGdJ",
+	"rZkan0ZKjjcRfA3yJCvTYcsN1TBz4c0ZliapGX59XCk+M+MM48Ubl4xqhxQRXsm4ZNl8mVT4g0tYcPeM",
+	"3nIfSA6yk9G2Mhb//Gro011cyGa+cFPYjDbr5lCnzq4Tld1QtGY9vWllrLfirFZTgxbpvCSSdRMbp4lg",
+	"mwisXpzUJo7kxVaf4HvLGiP4GP/47oUUemX+WXNWkD6UF0xqMa1ueXyNs4TGz63saSfaa5joiTWshDtl",
+	"xrPsmGm35pXjqUH8Lx95zXGw/MNNS5omU81agqjQ4ppyqMgpyxK5PWyzJB2xZsnaf1VYruaPapfKExC8",
+	"paOzSMqNCsBiw5uQgcX2mm153+ySt5v8evM+6K4oc+rosLbkWq2R9emU45fXkEeLKfmnm28J9pbSUnuv",
+	"vt2owecnTMsLS/Z+++uE/5vmYT3jvegJCmxn2eSWEqxxBerXsoEnuk3s5ZVrgnyLV+hQqvdL4EvCbyoE",
+	"bkdzIKVyAk68q6xLsQlZNuCyHIJiN/7RYvouppE2JEVVkodXGUH5Y/8Y4K8jG9/XIjVrWYf4nr8nCKwo",
+	"lbWt+PAHw+P/HuTOQMBTYoAcYSv/YsSNSQTPyvVmhg6mpHg/ctfvKmZTDpcV8IQDNLXSUG09joIoGclg",
+	"lu5nNp59C/bQhRu9Fhn2O+bGG6wpX+kaOiEP4YKVrRViRDtoieqcngYP8Xvt+pRSYivZQNGDYHUkLlCU",
+	"eQnaY305ktk7ln7oqjYiAdhI8DbTn2FOZ4XdEfU3QeVKVIR4ZOCZZPAGrqRNx7VBq2bK4zh3SYus9gNB",
+	"XD7nkj21qEa+vGtl/sIWWl4B2CHcbC5PuNtJVNt+V8optlPC9mvEVaJ0m7IriAThcTklasY4u/x0dmbL",
+	"GTrsAk1NF6WZFnepocJFNcF7T1r7NQJo1qu7uxRI5wn4OEtSFxceVAKt1kwbKiYClkObWgb6/PugnDfe",
+	"UPNZlt3Xq2ukTYCtz4+K/rx+Py8ZCyufwctnbkAj9EMUCMuk9Q5BaPkwVvIJZTbbRcXVgn7iCFYCa9iw",
+	"ANmypPAIiToybMQm5A2MrRP/q2Ugbpjw69N3YS7D/ulJ3xgYuZIflZ4uzuVSxHwO9gr/SKGFohLUWEQR",
+	"XmZvewcs+2LZlavUvG/9SxGBi1rD6dUF0zADlhpbc4oC/CuZZK4gmUsh67pbakgItS5kkqHqY3qMirJE",
+	"hZTrFOMlJ8qQzg36kMMmwtBLI5LeSF4VisjA5486SsRejnZbUYYKjXjJD915H7g+xkbUpCe4otd+5207",
+	"6YTd26YK33XLA6+MZtkyUjjgomJYoUVlpYUMhWb2+Qd0GiNesYVhc1GutPo2rW7+lPhQR/qCCvn23bsn",
+	"NLga0lu3g6xzDmky1oDVvie79FP+hW5If3j37vt3jTewFVqvZ58nhSQNHSY3plr/Wd0+S1hooMmYqHNQ",
+	"to3o2QiLfgsz8ZrNcI6FgHHEcxBsCtLFpnVRnTd/w8KV5qqWUXfR6bb4WWbKqTTcZdEdGBFqO9Cp3ErQ",
+	"NZTO2lrjWV7+8isw0v8CcqD6gfOQb9hp+TBdjiy9/C5V5c/iLLM+ijm/aweaLuy/ZV7NxZ1TvdJzGXId",
+	"snd7iOLP4AuWf8F2Pl0d7tpijjcH7O0B+5/sf7I3e+9uyhhfb97+VzOsQxZsVDL0rxE1vz0OepiujDM+",
+	"jaT75xJOacUkrdZ8E6r2QqMvfU1cGNAycOVFzl6FG18d+60wgo2zqWcxKlVEl+VHtsc2ypL52n+yVXtO",
+	"U1Sqy/Fbdv8dWoPFRnShZffWWl3GIT43gprSW8ggDpfU1DoMDZuoOHQJ2vkXlBWqbEpbbq5pv6T1BhnQ",
+	"PTI3QyRD8aUGMhutRe3LO7oqjtlnSxFf7Ko+0b7jZloUTu9AGiaJ0EBrgtHesTjae7/+T/vXr7v/v/+x",
+	"9Gpea5myg9/IUWHXd6sgNbaTS4FLXu/RATf9InuUuffn6H4iTMJkOhU6CjLPHuNTZXnZ8ux3BpChuuwA",
+	"VG1ZKvJWYLXWPJlVim79hR1HKzYuvLukK/+Quz7iNfBOY1Ha560dW6qo+SjxKozFjTpFQdZB3+Mt/vEQ",
+	"iUfhL7TZSPP1q8aWlgcH3VbCtHenLKVFi+mv6b5omsCVmqlY3XvyLEx+MrYUMQ9Ti1nnwSZPeJwjuWWb",
+	"uJj4Dz73rLY93Ksp96U25aeVALw+XXoNzM9A6jCbRQPVnmS/rvRffLmpS3/21JId4R7XHtqEgQAJRlkS",
+	"cy4RVHobF8SBRNSG/CsbyLDGlzbOYMVv6/nr+rQIW2kN1TOuExeb8xjJUD0uh5AoSYIS8QrdL1Ktbl5+",
+	"SvlX2VDI04Mio3od6qkWD+qzF9U0w9SweP+GuXeXTtu96B3ZTASFoNyJD/WJfhVf+HQGUq5jZiLoJWI6",
+	"i3kievivUIG07wWztJcVwTmcpRcxD4QfU979UJ3on4fnZ4RZRxXlkwnjQSBmifmA/zLsVkgBP0eJfYLC",
+	"JYMSs6mL7vKWm2gtq3ULd0tLh64VHkvxu5EW9qWl1KzJcHOed5yMx+6jtDVp2s7aqYLVZfTohK8GDqJE",
+	"AC8ZyeH8GnCxa4EPNnZJc8711e9olZvL4ndC8vooq43CVtfZTetXd0O3t0pYp6t7AFskjrhMLHp4Tf2D",
+	"Z7nw4XQ3ct/DlrZ83cM+Tkld3YzZZKk7H5yOz3PDWJJ4u0JlqHF2ybA7oF4VL1D0W7tFFIa+OQam9lqG",
+	"YBS+aBFa8nQCemC3Gkiz9H61sjEna9Fnbs2OxZZSQqcSNbGmPHJQrh+L0eeJYibhcwRps/c5CP6EoFJK",
+	"8PfFjLa5HPJAK0O13rQriJyRabnuXglCMxWdPZtr/Wo9672OeoRr3aVwEQWLIRvtxWiRoRZquhFePZtB",
+	"+Hpkotu4cAfH6oakUVpRtQo/WtCeOmZsDqReQ6komoHzoOnC9H3EvrJ3Ed+azrQICmdWNS7GgjgBU7ob",
+	"DXsQGj2skWH59wBBjxkDd4nQbKbVVFkP4bcYr6zM+I5Po3he99TSoCZ6SufR+1UQWXiUk5JKQZiZCCwY",
+	"uXsQyYnQUUIQeHmBzRoQ+/hBhAiouqwCZ3k0WTo3jYCWzvbMZSCy8hxZefaRLNRnd4M1+1/dn1iVHWPr",
+	"3TOqHcEZEWXkhflcfeRXBX78ziAAOjSyOGC2fLy9kexL5u5uBNM4jmRE8NtsRyr8iSnNAgTaC3X0IHaZ",
+	"wfQpFNgjWQB3fFBxOsXSchnaHm0VB8aeTLRK7yc9PzEWOaux6q8zz9ivmrb/6wz43dZWOyY+dlFKhc3V",
+	"Y8A+mNlIjI+rI2Z7WIiVthutKjUPuNeRZDtT/oW9K3A2fNNlUrFgHsTC7JYWNB9jG+5u4oIlyWOtLlmO",
+	"BTahpbq2tnvRcr28aLD0k3hzjXVvIsQ1j6Ow0Tj6AG/4J/IQqRi/bb/MHyMRhwMMBVzmRKCOvXyHcdGH",
+	"aupKQpVHDFV1lfZS71aFdTGVGyszs0JRTJS1+ftdN3Q70KVGnRIhNrILS5RdH36i1E7tNnOrUQxXPrDx",
+	"Oa0zoLER3xg+SS14eOguSFVUg9SPNldpvd5tRSLk+vRnZRKQA7WznNgXagxnb95+z9wrNrBQizAyewdv",
+	"emaiZj3rAugFqJeXQru/77YIhq8V32AxfXFr0zoK9hpBU+3tTFUT02KYKk9qyblMGdoSnVYoVf4KarcD",
+	"oY5tNYyN0aeGVZ4zMG9dHquj0SYEOrSzXZUKelimTn1zbO+b6PXpyvUyt+A6K54mbTeBC3LaSKRkY/Io",
+	"Zaf6nupU4oxbA0Ffn17aT37/tXpRPwHrgpsVMwlPxAcG2DmACy6MKYB3oKHgxvb+J9CPb9D6oQUPJpyi",
+	"IqqoO+0csPAemG8xpyt3ytquxo851G21rMOc2ZdYKBIexYYFKo1Dh0kRKx6KsLN6rFYOyrAETCGHIlxR",
+	"V7XiPV/qxgruNuSbor1rpUM2BuOrcgqjCRI0FXJsB0zlyUQYd9emz8Hz2+t024eAL/eCVEZfF4Lp6mWM",
+	"a1XKbv5O01wPK9Oh6Bv0EvAgSbFMs2sIbFBaJHq+H8AWiC1teit5tIupXgsvA+fPfE6My2xreYcKPMxx",
+	"iEp2afeR74GbyvhaJAsMaRB0m/BNoS3HU+oB2l0c81evEY4YWaPd6tI2sDiu3bE3fMIHErNIURgGmjgP",
+	"Lwf9qwErJsNk50aaRl6xUJK8K7TtpKWtR4qJFAxD2JMVsXjLgmnD0ysOz7NtbIsIJoX4BzbEI1HAO+z6",
+	"9DvDtFIJQQAVIFlulUpcoEhuIp9S8YPF3cP1vUjGDbQujSSrDJyBwgQ4NnR8RDCYuzuhTZ7uSLOk4Rbl",
+	"6+JAcivzFoj9MF3e7tHgZFBpt5X+lG+VOrBBnuBxWufWzOPx4PQ0jLNHpT8LzSbcQPm+aCpsaR88G7rO",
+	"+6kF1sjuFRGdDrw4UinNqIgrWFE9eCJMwuxAmfvgPbuLZGQmqOyxPdBJNGl+WI9CxHxmUGROxUgaxe64",
+	"Zo+TKKaAO9casm0Ux6AfgPJAtt/mITeDOuWD8taXIh9cXJ4TqkYAjfDp8HAwHML4P/aPTwZHvdZ+t3LG",
+	"7/olkmuVzZy+NfPKWAOG4uGNHuvfGiETTHUQYJuHWwqVCW8/z3oYLFckFOuFFkqHHvbPDgcnJ/j34G+D",
+	"w09X9LYldqfbIVqvjKO1EjZWw1FWV4/kNlZQUmycnwJVnXwaJaQIWAy1eM7wI1MqQUYQCCgGAy7H+Ag5",
+	"H3TvXqdbQcLJMCNdGAS6v8oQk9kz+4nV/scapKT3O2SB8iMaSIZr6aV/PuD6am2cmUjex2IPFB12W0ma",
+	"l+qRPaKyD5dPBow3ZzBOCvPoeeM8VoZgfXXlHCprWYBTrUZVFB2tiULS7CFp2JRLfi90sa7CGkgQGYsE",
+	"wDi0MC85EMMTOEKaw4U4o7eJSdyuIuaRSu7RYmVspv1stIWaGu2aa9UUXmfGGC3QxLdV+3y+I0tIt9Uu",
+	"PUNt3FdPrbvhWd8GmXteTKJ28o+0t063Q+pWp9u5OP9lcOkVTL4bzuKhNHZ1q6Gt/uXVcf9kXDiljs/G",
+	"F5fnP13SMVSsge1eXjikiudZ07gKSd+FYQ2v+pdXcPZdnV/gKUk/LGvIf89aBmSw/Mik1xqWCXuvtWOs",
+	"ZphdmNBKWerbhH1wp6f3shVHqDOFYjpTiZDBHGrXejWjz9FsHMnMe5zhXVjbWSUk7HM0Y0g3G7x0fcpI",
+	"VWGhEoasCpDBQJCx2QU2v805PCx3oXucQLy/nUuP9RMWC9AE4Q6GJzOiwNLGZzhKf8HTCo8U7zz17k+v",
+	"+aKBY92GODu/Gh+fjX/sXx3+jBvyun9yfIQF5P2F43P9s7JOFsW2ZCKzBMUThfoGtavUSa+zOa2zASna",
+	"0QcHVG9aazRQkQrXYHQrnkmrbMniBdVjcoIPY1F397DXQ6J74fbVRQxkJQOBoT34ODLMHiUEriyCFNi3",
+	"/e1jC+6FOx7FzbbMVQVPfrYV9YX69ptudgOu4yinb/5qdpsjxDueU/hpt7qVrYrdjkmDQBjTNMUnJwEV",
+	"jJVFgZQZLot7ozqiyhpX16Swb54AzOQ2OGpmmz0xc1Prlk/MEuNu+bx80iljibyWFG2pdT91T+Bf41TH",
+	"y48QnyG+8L1/yA3kqQLs4uE6zpRr+memYtM/rVKc/btJ8T5U0qhY9HGP1bvAnWFxGsk0EabJrxJQi5hn",
+	"a4zNs6azwyGA9ti5NSgozWIl74UGBcgWd78X5DCjwOJUi5BZWEW2k1VIf5ABVvmgXsZugN2RtKoa+2Gy",
+	"WzFAvtlsRdeMeHbu9Txs06/9e8ySy74DlT2cyT0yJgUXwNkhC7QIhUwiHn8g3FW402OKNiOzy1IbRtsd",
+	"UJ5Tja91aW+wPHa/LHm3sn8aLXzesTVfFH1mzMadMMwhyDZRU3L1mpFlZlkpHbHlTbHQg/smb7dyUhZm",
+	"0LgmlmybCPpZWIr1AznzphZ4BS4rl4O/fhoMrZFgE7yz5EZQZoeKciizsjE5UHJJhGbCb++eJ/lTcNjt",
+	"tlMOV7DveW211UQofMBicZc4FBf/0Lso0jhDHQ3N090NFe7+BiXrKxOpzSGfPvf/Uxz6V+iGZn/5r4KX",
+	"mO1E02mawIRsulXucOkym4X/v3ZX9Omvrtd2GeIFhjZEJ4vC0j1AVyfjdCTvRzL3fCodQXhh7GwUmQdU",
+	"zYRkO1amdJmTJEzpkcz8ZrvWRG8DUGwbGHTy89XVBXt7cPABtCDrkBrJnC42hUxJASO3GOuZ88Y21WPn",
+	"MqCB0g8jCf7DWCGbT+hTKHB0C5MF5rcK0zLozXK8xFMjIDCwgBciHtpFOVDGkhSPI1kNkjAoa2ZzJ1CL",
+	"wQn5axfXhxhLF5mRtGceIWyUP7BBkj12k3HsDZnfxG8pjykpyhv+4AJUbqrBFzc2TKUmOWp5rEY5PINT",
+	"cAaSrk2AxkhmTQNro6QwlAQcxVEyZ5HELcATVngRfUpoahxJZL7istbNpBzssZRTsuTApYUPCrmF8NEe",
+	"fMR28kyE4fBnYG/TRQ4yieazkaT2zG6PQaZyvtPvYZ+7FMQSqwGxqsmP0JWlZpYHeTtn9t6xCyS1pQ2A",
+	"TCP5aTi4HB/1r/rjo+Nh/8eTwZFjDOgJugG62OsO2YkNTgp78lK2CaOqSHNPka9y+GOjlXPw4E1QqsdY",
+	"t5m8+ALsPW7/JHMW+vGfyRCIfWVAsjkM1fUpXZaPz89K2l/rgHw+h/jWFVOKYTDMfkqC2whpIswyRqBu",
+	"w7SYxTyg0MhR5x+Xg6M+6Ju/jjre5OAaw3l24FxcnoOrC//OXGFdGwgDt+5iIEeLyNkCPYuGuloDm6NT",
+	"A2Nt5qqATb003PX16U8gQc6HLi+kahuZZQBdsOX/Ojj9ZGUOv6c9USbCZ6GlAC8/OH3EilXFtEiShmSF",
+	"+uxMv43DJYi5JIm1qvPV8Ws/fuRzw/qHh4OLq8HRB3an0E3mGssUdpUmgaKEpmwvu6+WcnDbACI/R95F",
+	"cWIhu5pZET7/aF9eudi+D5bSIr8GqTa+2hMX3BjGDaPncJbdCZC2QC+iIyhO16dQu4XcC8oFzBkQR/eg",
+	"v7qjqID4UdrIHgm4qdSbMsUWpmcfYGXViM5qToAyJukyEUwUDJcHn5FJNFaroUvuWkkut/P6/JIxwRrU",
+	"hAPC7hjPtLiLvqyRWYKEt70v569zePvHeZtsCqWTMTZetHpwE3ToeFrikG3JtPWOxhUgvTMSlEZdv0cd",
+	"EQrzKvGsQwev7vWixcbeeA+VTMSXZRff9hQ5tp+5QqM+GD7khRWT8zKAhQ0gElSonzfdrc66NF7/etiq",
+	"yel0yvW8Hq+oXVnWtUupNpdKzXOxFsaHp/AYT+FxoKREvd0fUkivqhaboqgM/E5oovqOrwLslY342H3r",
+	"Y4qYpzKYbAmdU6qwIYB5NuG+6nTXkYZUn1MeTCIp3GZg+DbbwezwS4oN7zJbIySS97tLT3DqrkTKbs3a",
+	"NTJATs7FDT9zpdBW3ZtTHjy1HGW33L1/Dsj577/6C0w3FpVes/Zz+aVaXiiViF4W8DhLO8UvamZqSxpv",
+	"yAlTG8i/nL19Fsdw7hMQ/mWl15cm3+dT3sytKCPgU1wnrpEskmBd5d+205gOUfHOFHT71pW5G2Da3BDY",
+	"I48SQ0Yz60xZ6fZQmsmS2wTAKyNezBBtRb6LhYg9Ayf7VTBLuyzbJ12Wlf1H22eXLH5jMkN1c1Ct7oK5",
+	"ChN4zEwE4wwxrTdKDw6+D2Y8meBfYiRLFyvapDVm3MUBu3YxCyL3AenvDJuqMLqLHA5bnhxhTesFa1VV",
+	"+eh0s3aXI24SJbMR1qzHApNhFAXlr9gq6Daa96LwJ/Ig2Yzwxyx0OE+VOT3+6TJraDA8/m/686L/aYhv",
+	"fjr7y9n5L2c1muj12WEG9d4ugKDF/hmC8QdtXP2jv3s7fqjFYXwUt0bhvnIw7lVzRszRdPWLuB3ii2ym",
+	"1RcCHy/Wb8CdRyNnifosJPkBpQK/W2aW7XVaOrC6DSHOv4jbiVKfl3iztlHALreMtRfQdrRou3KFyBuD",
+	"v4wItPA4jX8+7R/uDX/uv333B2aie1Cs0KmzkxfB3e0sASTqdqxXsWKauTUqThPBJkky2zG77NPlCdaz",
+	"jB6gl4vz4VVWvLcC7HPww38tW1KKhbLTKhOxYXmPXJHZuszLmlDatQp3UVf+s8U6K0vpmZmziU8F0YXt",
+	"/G1vOBGzidDhnhu714+Zx1eVK19EMvnDD96SJ0KGyIp1m7he6Smbxtsavm0MGzhfPGwI3kp6o4QzSV5U",
+	"YBmhP7ADtD9pLs1M6YTqpfrrudiQzxZqFhmnC7Qor1zFcO24JO+hTPqlelqFDzehrFWafGlTthNNlqTP",
+	"Uk+hESVnU/K1StSNVTjI5Gcb0CQUe8UpbaSQbGXRNsiWrsnXwpbZihZ0HRvsYAmWQRL2XCxS/ourOY+q",
+	"ROGD7B9jCi6nn0KBiRLFf7jnPoXKDvGKIkG9YJSVQ2UTx0CtmH+lArssnXMxXBxumRAN7LAEuGsjFWJf",
+	"VL+7VAmi6qJeUdDv8GJLOCftdLsW6tki7KoRQaqjZA6WuilN/0fBtdD9lO4Ft/ivj45N//wLpEMiEZDY",
+	"+DTnF1AkO7//joYlcpMGSiY8wHmTbaDzl/RWgBGROb2JXQk+tZKTmjDv9/fvo2SS3gKm5P7nhz1j3913",
+	"fyxgp3f6F8d498DkZ6Bi1tEDmSzZlGyWBC5O0SWSrjn3cBGVcDMFVOxwIjSsiLKRaW/fvGfQOngSNA+S",
+	"vY+RNgk7Eg8iVrOpkDbKJ44CYe92dq79GQ8mgr3tHSzM7/HxscfxcU/p+337rdk/OT4cnA0He297B71J",
+	"Mo3JBpLEftL1L44LKNjvO296B70Dm0si+SzqvO9833uD3WeFpSwsOE/DKNmL1T3+eO/jTThlXBY3vg6S",
+	"Q+mwCzFZwiTsDgjRY5kfTwsWqOltJB2uWf/sqDeSWQASNvJeC26jibI0kuPQdteHsfXhtRMYGQxb86kg",
+	"/2ENIFv+ChxDsBWXvyd09moEU/0tFXruHEvvO4RW5Vide8/+2i+VXufDDFLEhWCs3UAULvvcCyUAK2uc",
+	"a5jxBKxKFKxJMOKkGvl6tr6ZvMt2GWOtxnEr7pQWS4eQqNUH8Gu3o609BvfA24MDJ7JsVBQ6pqk03/4/",
+	"bRhq3knT+eBYGBU1lIgVaYXbKVb36OyGHfvDwUFdo9ko93/koTsL8ZM3yz/5JAmzOfqXCOmj75d/9FHp",
+	"2ygMhSydErgDi+fDP34FIhrnGsQdbCUFCBYMEAPMQyNIq+AgbP7RwTey+ju/QheZUEome6DTRaHQe9mR",
+	"bKWTR1ykyeTCvn5lte0trmm5s7q1vRT3kUkw1gLmI2Ri+2NuZmwWp/eRZDTB339foKFesYkibQsUNMuJ",
+	"3J6+z0bb+j3jpwTtoN89jOh9vxW1up2ZMh6ikPmxONpOFoj+o0UL3zhByjbP38sKd6JT8fvCyrzZykBW",
+	"WRV391pXtP1x+SeHSt7FUVBd/EMbKl8zMIyXLmywwkZ6yj7a/+r+hOoq9i4oErHIQ0f4e4WHVtRz7IfH",
+	"Rx3PMfaDx9ZbQwx3A0aS/7Cc5Gcq+ahSGVZITlOqI3nLDQeBxIvUohvgZqm13e1avrO22q4HL75drf1p",
+	"7e26Pu8QuZ7CO+225P69Vulsb8pns0jetz/3foLPTt1Xm92pm1v34/CiONC6MxTfYZYGBd1z/eXDo/Y4",
+	"vGD3xaatB17isq4qCFqevMX5vkaZUFmSFz3FK2NZzhpPPb5XYqiNnPcLPLg10bH/1f61+km/MZ5dbuOw",
+	"vbRWEcrrv1nFYK21WUEleEGybl1uvKg6sbLceFY94mlywyoe25Qb0XSmdLJHBpD3X7OjzYtdbdgNNDZ2",
+	"LbzP4FFuwBZXeUggnzc99mlmhE7MSKYzsFm/OzgggwuLI/k5z4B0H4IT6EZ8SYSWPB5H4U03t7GJSI8k",
+	"GnXBfhPJHht8iUxCqgI2Ri1b/JZIMyyMggZ1W0MF80kB6uVOCwOgVmzAgwl+951hN0hoc4Om4nvNZWLz",
+	"lLH4/W2EQE8uzmIk3Zi/M4urZD4w4QaXfQjNfhYzMMiP5EBS1AamucITi/YHxCQQP1fhhik3k1sBYDWG",
+	"JWokuVQImAtv4fe25ABOF1QnEbJIshvymt30WB/SwvETYbvmWowkROokQsK7CP6uuTRkYH7POGaA3nIj",
+	"GDgeUxgl8gxCCk4IYnskf8EiIQDhM0ves+J2/rInQ9jSN0RGy/PMJFrwqYEOR/KmdDsxQh9jFxda3Wth",
+	"zA0srsAywe8O8qHLkAkZmqxEQm075AulVjAWkUt2gyX0bMsRLqed2Eg+cgPrHdvkHp8rgBqudmdeSstr",
+	"5RL0E6cMAvZuCQjYy90Vq8uJstLHaJ33XxfPAPqSOdm6rvBfzTL9NM3kxzT+THIboxdJsKm7te4sLU8D",
+	"Y8Nvay6eP4kSxw/p7dd64Vwcahbc6lES6A1KhS6zyfor+BFzIYmoLEKIl2SO8tSlXJM/eNOHupnLoHiY",
+	"l1dxOJfBgmpqXrvNCkcJQ38FZqvCWBoYai4DEVqV4Ek+tPUZEMbAnCpFQ1nf7tGS+RJhkj2bC+VAzLx8",
+	"CFFKJSdC/s23IFLy4RbCrTx84N57gL0PxGHavvu0tYVea10IQaHT1db21t1ovQEXP9piDDCIyBaRV6lD",
+	"k7W12KrRF5+MsPXpH6aGOtj/6hA8qCy9Ren4zpYW0UI2xl/gMMTqMquAmUwhIW3u0xkGZuETT1zALY2p",
+	"UKkCg9kii6NyfFQTGFCKuGwMimgzTjI1hR+1mnZW/OZKtfli5QCWbe5HW27Fb0m+PqU1eZrwXT0WoWx4",
+	"dqMQxsXqFxF3insTtyIEeprShrTYAc3ugEP30tbjkba5nHYWdQtqH9e604OcCI6ohZ8WzfcVWDgAI0tv",
+	"hcVAwipHAur1MH7PI2kSFiUGw+yM0A9CO6NEZCHXlBZhdyS5gWs0pKawygLuf81hIH7ff6Cy8WIv79Mn",
+	"8mhv2plvyZNvW39R87+bYcOy5x7xdTfz27cbG68twL84WmCjApNYTMoCY5UKlbpCYYgecpcaEY4kvJ8j",
+	"Qhq2c3jyaXg1uBx/Orsc9A9/Bviu3R4D6JWRxCIRxdN+jFwLNjVkyWrvXM4f+Rw4rbyBXEgQArk5ZmvY",
+	"RYvyqcTeqPU5TWIhKdbY+aIBjgRiAKGmoCGlhk7ODGgUq7xmj3F2BoJgWaISHsOF+ABMexBmbZtCAhBq",
+	"D0+YizrssT7oJQViEBRh201u0bGoD9ruTEnh27Rkt803bUUkoxaAeY25EpCRrlPddU1Kwa9bFQgvatdv",
+	"IRCe25L/H/FRKz6sp8Kycb5dwUabf/4kkbLvGq29nAzTqWFShaLcP6AZBpzSJZ0wKIBSujFzGSK6KUbQ",
+	"G2estm+rOwCxysPaM5BV1N5torEWGEoOlzsbak6rA6Lo+wNmQYzRjO0APT3C4yfhtLlDN+FtS5DtbmE3",
+	"DYKga9rQ2bJp4SzTWze5djvvDr7f2JRr97WbIrCnWdjEYXGX9q/7xye4Syub7CeRMMhcWthmT9tXQj5E",
+	"WsmpnfwsTeoc2nYSg8IH3+zhVpgETe4VHnCFlSkfdk+OZQsWe3gaE3muM/XuZErbyXG9HChg4eCDh+Hi",
+	"8WfrofORfGflKeZcqDTpoqyHvWRQh7MpRz12Rl7K/JKGGOug0YELlY477rQ7JHXenVP/LqCGSeN9zifJ",
+	"ry1N7HL+pXgOfqO7Jp+DnRxChrykgugbkTesNOct1JpQHciK6xcVrFx3eq1uwv/ook26KBnGS29673Zt",
+	"BR7hi+x/dSBMv++jsJg3hcvsCflbKlJ7W7yEdGP2T3Vrbd0WticvC85ChVUUsQvSRKfqwX5NPyLKaKKy",
+	"b3co9fPgj1SZew9pBaji6QyjMwCR3sZIdm2sHErIGVSxpDbNhwy0X4buHXrCpMAwkpHMqmk4PP8/q1vG",
+	"tQ1lSWX0Wyq6zCiSoHOQtIu1CUYSJp8pzUga4hRC4rMKn2FhSlws/gTio1SckigKL3Mn+v+pbn1y9xJH",
+	"coQkHTy01VIKGFvtpe2CK+AI/3VL04dJow2C6lXfCmbZIswcJ/msMOHM5yAI9Xys03KuZ7UW6ELG+zb1",
+	"+gJlidRNbtAjqM6dyoLT6+3B25cZCnButgA7sBNjxMZDpXr3FQv7J4QQElUgiqsgYRa8DkVx5yDQ9jLU",
+	"We9l+zwHa84Bc4HrJepuPfYj8SK7K+ReOxxlAIVCAAG4cdNvH9iNEVwHkxs2tf4SF/hmA/cQ8Y4F3Ii9",
+	"SGbg9fG80VNYBMN9uWztHF9lQZQUYGba4kEsHU5x0i5086eLT501Px1eHp9fr/rxkQhRkIeHq3c8REbY",
+	"cjpKob86h5N7h8FWqHU7RcW3bHgFsJ6tcl+5W1W2V+u0kiozb8kXVOziZfNBinNdujYvnstZYoI2y10n",
+	"cPdvIeyPbvv1GiY6UW5ywXsDV+g8vLrCkotxvT02ANCMkTw+snWEIFLZXXe5nGd3Zhsb/J6l8rNUj1DC",
+	"zGAkMF2efjj4YSRvjs+GV1DMYzw8/u/BGMrafTz/dHZ0g4rlDe5y08OyUZiSAqHIJzYy2sHV4VCSxyhA",
+	"DE9dLF/Dfjg4YDeuyrYtmXIDNW5c5SrhzCMjSTNWS04nz5EB4ZY2b8N3cGw1Mrck/JTOLFKvNzK3jfik",
+	"WYQVbnyBMLhLQetfGchTdunXKnp1mzQrjwxfTR8pftw6baosKTebNrWy2Ov6tcljBGwVhkpPQWntzPpl",
+	"ukU3jcOh+pcVE3ATQ0GRqJ7PlfIcND94mUPvqUsI7oQ11q855W075N6unvOy+Wsr6TkvngS/OT1nUYLu",
+	"c2NUEIEXoRj0Zh1SC/Ws8miMuzSOmREJuGkX5YStLmlrm4Hu05dMTGfJfCRjwrLJjW1OomDdzyn/nKko",
+	"0BJ/4BEWPQVtS5AClZVm7KOhjOxT1qymZB5Ow1SamCgkwxCMFXQoY8vzWQWnqEQ5SykoU/2Tk/NfBkeQ",
+	"SmeVMNfo8ZFN4fIoTMUWPOqY1eecpDU3PqWo71akdC9YN9jwBfa2HaubR+YceK0bHJcvSrKDMOPnF1Gb",
+	"cM+Uj18uyzJg4RheTSiUixHVxree5a89hw3HVwUM7hJFh6yF4/Gbe4pe1ZxNMrRYqjsw08oH47pVDSMj",
+	"JAX8WfxoD1tmLxbCp1cGc2vEDZPFNXUsU/qxnWnkrFRLdPPiJGv/Re0hCwvXvGhPD5Z9ktE5CyYtFnpt",
+	"XGOfSFhIZPOFEYB0WgwlKER1MRg613TAT3Ofr7aEHEmXUazuit9+Z4r7HcwN9H6egFysaJhpAmjo1q7c",
+	"JqRfZ4aP7LC9+ZANsDB0HJlUcJgXepqzHfHFlbMAb7eWIhGGUXG7wve7LJIjWezNtXPTY5SfbeNkx/Yd",
+	"dLLddJk1T7uJjaR9vkBMLVyobUilr2GBsNa1w2q9F17kVEhEa5Lh3sSY9QIgFl1yNOJslrq6jpRqnxGS",
+	"ScUgx15osopVearOTVem7etx12V0txmLNXlqjr33FzgTC3m/XvfY88bv5du1FP1g0RbahPFdikDJwLnI",
+	"ZUVk63kWruALxW8vO79mfy9apzzpa07fjO6A/yHYFXe7mMVq7iKxokLQVhE1GcMs9JQcdOD/MPxOJF7H",
+	"HNmNikf2atpc9qXFwqlErEBt9MJGhvEkyo2PbF+Rki544s079n/+95vvGQfeC9Ppbm8kT8Eajh7IyvJg",
+	"Y+ILDxLncvQKrQIpnhiI+0NTzf31zXhPO9qt3a/1sd6tRRLYEA88q7LcrHPZ9NdN2OVytrudU+roMgW5",
+	"Pmp3k4Teonb9ola4FVd6s8G4T9ORy3J+fxrda7CgVaO6vRo03WgM4+ysfzoYXvQPB2OqJDfIErCywC8C",
+	"96ko3OwYS/lbp9q5LHxWes1a2AjpKeH6XiSl2zTo6YTjf30Kh02UUHaWVsbs+XK0vEr6BzaNDApvEWZn",
+	"mNPFRzKSWViWSpNZSt3CTxkkuO/MOiWSZsvfGP/+mraUHXhhvCttr82FafUtU1whKzXFaNGQ4Yy2lCnk",
+	"05dKZv6bRmvRnAv35tIumTrqbEJS/JaqhC/3Wmbc9Fd8f8OHtUfJwX6sUT58ftilS+y4sADXp+w3O/Vl",
+	"h3CTa2zjdNyi4MAhvvRRTHTyyAh88GRX2HPyVPWgX4Wn/Ac3n9mDOJ3eCu3yE+0Bl1/S2hzaA3mnNLjG",
+	"sKITFqQd5JgVWuQSuB6g4N+bud88O3M/NZ7tVZ9yNmRu9d2Qn2ozodHOpmSz3+ii8N4WZVbeTZ07JX+j",
+	"No7UUOaGCFk+O6i0VnSP6FseLCPI/pQnOvpSG7mdg7lCa1jsiuBb8Z8ZauuxfBDaSo5C67ZkzkhqFWNo",
+	"YaIYr4wY9Hx47KDtyPwcydKL3ZG8TaM42Ysko7YCNRUuBDFITaKmTElhugwyizCOjyL6KL4crFYjWRyZ",
+	"g2sF9IiExYKbBBqgoYAgIyMdWa4pH4FFZiQLadpvsjRtm9MSCJlQA8GEy3thMJxAqoSZiXpkc5HU5HDn",
+	"C35Ky/Es7Gf7ambAfHXo5SfCHA2BEI+TKJjYdcR1oEXLl6cVE1tUpL08gbTOenRhXz10CZXbI265Jx9p",
+	"7RvMDvupBAUDkE4lAp84kjAjksTWd6jmbnTroFbO3cWJwCY/CzGzqMhBqjVw9gOPU9xLgWCGP4gQg+2M",
+	"yLobyay0PuGe8CQKXEagg3/mFFJsN/mNmSazmy5T1PtI2u4d9DFLlPrAuI3BgXgUYx6VDm9YEAuuDYu8",
+	"e+oC5uhZ9s1rCuVOsN8X0oVX5r1n1op9Su4qnJtvfTz/m8/yv9IrrXyHJlAzT6HCJlpj80P4jsql/t5t",
+	"anp5CcNvCXgN516nuuBD6512ciM1NPwNAORZPzZY4nKN8De31h5Z13whgqxXlVqLIr+/1+IeuPLw4tP+",
+	"VEyVnqMC43rdQfP6LmKCj2TeP/ye+ePy29IuROAZhOGYRolLgcV/4O3oE5CF+ndlSaWSezbJ9/q0yyLp",
+	"XPlonnTJtbdpgkrFXCQWVB7OTNBVimGF9m5WSCkVXwJM1CWClWIK//rp/Ko/HvztcDA4Ghx9AMJYYWko",
+	"/84WWWY3+O34kWtIxfXHAZLK7i532xC62PaLRtj850rm+KiFpN7/SlzTKvFhPaMAfrWi1bDkFn1OC4+r",
+	"L1dLwHpH6Mapc/BcW2IzR8LTvaVNVPdGj5+Q+FZOP3ZYYLcqhFhxvIjmcr0G328T67YlOUrze25t9d/I",
+	"YOtCn+lYpdO+USqiz5Xe2xd3dwhhIva/pibHw6zb/wP3+iVPBK7chYqjYL4ya2GFjC1LhGyM2ajtYD3L",
+	"nr3CZvadDVyMHS5fjGoTxunktLcdWZgVIH77RfsipjjwesiDwZcZbCSWv4oK4CzV95hYguhTXQoeAoUN",
+	"7CK3FjD91ppBRtIO3tgBfmcWx1+HaJATPx/ss6y1667uipC9UAgWf+q9gBPrAI2KFBLFqdffDnzq6+J8",
+	"tqTLLna0hmK7zXVsXkM0BH0TYtqqrcphwTJezy9rSIKy/G7Wcb3MtSn5/YNPGLnlemlP+SZobrAmQ6P5",
+	"JyMw1W94FsFHXdWW0c9nTOPfoPTLteoyaakj0V4ZgQb2nA23JUfTwmZUAL48ty1sl6ldL6+Ap2dC71WJ",
+	"r3IitL/ebZuMW2D70kg9jJ8tU5ZLYzUZsQGNbxPXwZUX72kOlA+u8E7oDIOIqQJJx4pQqnp+d8YWeGOL",
+	"2kxxkC/pFVmdT7/BWKF1mNhrGYean8lEC1E0WrvFQiv5ArNCxSaLeUsYueD5Ro+dq2fqxlFvK/52WftF",
+	"jdCr8/b/HXbpFTdDvS7UUskskv95dM1ij3UaZ7bom1M0mwi7mpa53nWpSuj/G7TLVWnemN6zDUo+k6T9",
+	"ZvSHb8ciQrXWn7KrVSz2XLnyp8YQnpXqQP5oW3XgxyPJGUZTIJZGOW/+EfPV8zCO9+w+Vrc8vqm1japY",
+	"uA4Wmd9Xr7FUy92WaazJ6rRyrbNS3rm/F6BvTS/w6Im92AtZZAqEreltjRCZAo0rgTKNM5elEWHuV+OY",
+	"/r1iawpEqzUkqVg4er1stUpdGElWsDI15aKKlfjNbi0KfklGZELhhmJvLBoVhBJGgbgh9jAs4Z9FIUkQ",
+	"YUG5caIgCm+67CaL08m/qklvYDtU8wPrMrqXdqEUnIO4oG6YtliiPxz8wG6Gfx9eDU4LwFndkbwZDi6v",
+	"jw9L6KYg8PLEyfxBj/2EwiqnJM4KoD3yefQY7qEwf0mKB6FHkoehS5S0ZhUSfcUobJiBYxh1l4Go0vqB",
+	"lyn7hXZdPr0/spvL85PB+MdjrCMwHvzteHg1vKGgaHfTA2YXZiTLw8huf4n6LCQJG4z3FDioXj6+MYZU",
+	"j5MkvhnJHZ4wJQPhYDS0wK1EYUyw/CK0vyMj7zbcKfOttC3PTd7Di14DiX+K810mNv6tr4FABMaJuzHf",
+	"ADiya/dFPIeNqAjjGNm9Tax5Sc/Z/2r/WgaVUSPTamAuyvy6mj5e+Lb1BafEEC8fClU8TNouyZLrOb6x",
+	"5cO68ZSuS965/LF/yLQd3tKDsk64bVGqvaxVC+ZWR9IXx263uUbZErbm1f2vVmVvY/CghlcXAqvt/hfG",
+	"hWlBwyVp0k+n03b2z4vCkzTunxfHBH7KxtkPYiVFG4QSu0vhw9zvSPVZ8cfvTFFB7rJCOyMJVw2H/3YX",
+	"83uWypDwCcWjq1dTSUbkEnwiOLzwg0P4UxIBT1FTZy590auwwquvlZdpcK/xKEBqP1tB56ccHcgKK3E+",
+	"UCBMYxHu/VPdNus5Q/fqn+HNrSPebtP8kU3lR5D6f1a3depV9qKNmUQibSbDqNIylcD7J5G2bAntdh6m",
+	"psGmcZSKrDnypAqIAMAyF5TvM41kijjp7NPVIZo4cgAbbiDLqDgIu8Ph9nIrJjy+yzBIXV1pHFcXGgGA",
+	"b2sYGEm82z9kFS+xIw3C2Dl5Dbu5OB9esf2HqdnHLvexy4b0niLXbUkTXeCGF1VLF0bTki+f+bLt94jW",
+	"cnUtU9eJov2v2b/H/1S3y+7APzpoEFs9L+fv2zkdyrY13B9SJYxjWJAvk4LUxgrjrSbtih+31pV9i/ry",
+	"F+bVl7Q+7GzLND148U34UrFl6yxS441n8yv1DHL7Ra9Da8vtbzIO7EmCnrwrIOLpL1u/OJKh+NJUwBhG",
+	"mibCMCm+JOOsVAt+l+fLTaL7iTAJk+lU6CjIK0PwqZL31gtBHX9nIOOZ3AzUCiYhEy7kndKPXIcjuTPl",
+	"X3ZsbGU3az5r9v9lb3Z3EZkl+4kAsNC/agU4VD4m3YyuaVqkRoQlzN+3UHTa4RMgpXA0/mLCONohzcIV",
+	"6zCtSgrnNH8iFPAGtw4Nyc6qCYnxGBdJO054kWiZGY/gkp6zEHBjvvbExU3RDGYmgiz6fc8GJdcFNQBr",
+	"wge9/8lgHbP0fE2gPwbrZTFuAVG/M2yqwuguEuEYvuravHvg+T8Pz88oqAFRcDiBsIHTDhpmPDYK2c7B",
+	"K2Fvt0IKjtVfaiCFhjMRZPG3LkVwe5yy2JtPys5EkEWWbDrPz/gab53ke4FUxeBWk3CdkNVo1MElHnXw",
+	"ynTrSpj3GGGT5mptwLWGcuYjScsDAwJrEQ2jUCQAkdPz4PBpF9EcskucGMlHpT8LzaJ7qVwZCi/CTVq3",
+	"xFs40GtW9xkP8bX56yVBboo1kVqyZ0EaYeADHMb4B57FiZqpWN3PG0Kt8iqn9F0XAZDd0Y58jGKmdNKW",
+	"kvNHkvKGoIiJdWBSUxi59YEFPI6Fpm9UClruQyQeXTSBtTjgB3RqG2Ep4MaQTMScTXkkEx5BgbWETZVJ",
+	"2JuDgwOHwwyZrzATLPWd6FRidegbMKeCZEXwyanSgsIMuhQd8jAdI5oK1reFSY6k7ZPx+JHPjY0YK9SB",
+	"w/frJCjO4cqRfGVlGz/f+oWoPEjfpqClyFjnpW5COIzvMlbEJbs+ZYkWYuV9gPARe7SatXuh7/B8DAD6",
+	"dJlF9IF+w8h8ZhOVkiro2Q8Kg2v+AbprlyVqF5PEA+BSEOCBxTDrs+tTSMkW5FkgPTLBAjFRwh45wAWS",
+	"8Yc22A7wXQbjEy3WcrPPdB5+ufthJGOeoGZqon/ZPqRKmBZ3MRlKcBwOSAjVbdjy2HOkJEtlEsUjCb9l",
+	"NS1g/VD/7WI+LLzBbhJ1w3YCPpuB9TFhUj3uAlRfjLMxSQRAjrjfTI+EBs4064cU6KxlHCgVIxJhLktG",
+	"ckVhwjyyJNvYVWHStJMRb+mSeGb9zVwD9AVkb9Tb4fbAk877DpxGe0mEFT880Yq+xhP19Ka3L4SK9PUV",
+	"eIbHVgJ/K7AeSpdE1/VpttcJwYbNhHaSo1GIJWI6g23c7MnBgrhX2avPUbxwaRFO3L9HYqZFQGrUNhnJ",
+	"zb3O7eOe10bWZHQmVA+8rFPclS3A4JYoKVC5XchNaXFWXptr8r4IiHbemuHNje5FASTcIK4zf1N9GbFs",
+	"PVE7th4qML+4P8cg9bHyXBcOPURKmQltEJt3F3b4Jl082eo2DfXFI5CSnAebuNkjfPa/uj+Xhy7epcZV",
+	"GYQ44KvB6cVJ/2owPj4bfxoOrF4wExivt5/pNA500F6PlbYag8Mw1OJOaCHtnciN5gPDUmo93C8GL9OR",
+	"jdMmraY3klSTEMHnqRIh23Ggoe9zo9xuqV24LrgShKhuCR5SeIcdeDZQNy74LUpsdgkVSa4vTPY0ieA+",
+	"tErFkrc/wsRb+qsyVrU2TrajdE4HvDshHcPdZzlUNxIr1pLpu8uvxRlzZNWT8SZ4AyIIItuzEwRDziM5",
+	"ETpKSK3mYN7BTH40yqHRh904gCk07N28z2/7oRCzvakgwCfQjd0TuHWQ7c82F0w4+O2l4FoUTjH2GEkJ",
+	"kOh+tXZz/PccZ3qjUC1VQ3vuy2lr3mr0zD2nNHhWbWLr3jslxfldLZEW+ai7rgLyaxMLWjshXogr6giK",
+	"zEWV5OWCKDelAiwNqFQzOIjJY6HM+I5Po3iOf4KnI1KyCwfLLOZzqueJxpW8CRugNJL20pQfzFQJQuJV",
+	"/7EQogmNZC3ZPtifGI49+X/f9EYSU49cbKUzr2SnWypjYQy7sfGbZDFMgf1qKtJASxsWpM+4FbcZ8NRO",
+	"G/7GgjB9HGjZ7MmbKXS35PoNNRRohbPvhWOe9Fh+uS5cX0EDneAJZx3oxZuvYbfzkbR1onGnOGUVK56I",
+	"R7QH2tgv6UIB7A+WP41fr7VD+bdSLXLbxVNDr2xL+WpsinVmWk1VE+McUrWLEuswo8oarQ1DtyvsamB6",
+	"4KSot3+jRbb0e/ISW8qwnVTuZbTeXX+9l4PIfLL50t9w1DZMoc5iB89qrXXVXPFlSeJXeGOiAjDWV8+T",
+	"yNzNWVJ8Qs7VD+whUjHOx9iUZvbDwcFI3lz0h8Nfzi+PxhfnJ8eHfx9fH5+f9K+Oz88awp0/Ed7DNg53",
+	"aPpFI5txbnVr9+LmLs7A4RazP/9ytRykuRFaqC47F94uljLD3OBEc2l4kJCOi20YT5o9lyGhNGepRVmK",
+	"frcQY5+jnMIXmXuPHNeRvFVfRlKqJLqzK2g+MC0e1GfQBAgj8fqMEgRidR9JZrPo8bU99UimjZG0Y0Mn",
+	"umE3NOwQwV3eZ0S5+YANTbgO96oT640kGlzQNjYRLOYmyXKh4AU2UXHonrqoODxIaPK00cxIInjASX94",
+	"Ne4fnR77txZ25bbWFrGckJGfM2J7IyavFoxfi0XZp+ijJ8hKWMEDtqKspPvJ0xd0O0L2RcOQG4Xsi2dl",
+	"PkXI7qMxec+x1J4WhpSdGt6sE7x4NyIL/9g1NiaQkRsrJ50NxowkJVDBeOn+c6eFmRDYCIuMSUUJFwVU",
+	"5Tuuu2DKSSZCI+SLgrLxCZtww3hZrlJ5OnYjxeMYFDyluZ5ng7rplvdQZMgejBP/gG0u23BZByDz52MY",
+	"om0VRwuFJsWURyB1d9D6NDy9uoCOXBU9Ee5ininD17LYC4yCsIeB69K3UdGdAKx3YV+6xEV7ZZsWR1ka",
+	"YaPpY/ub1Y2Fltp6Ub6JYAYkpUNfT5QD7yF8YscpK+160k/2nCbSlB/g3++XVsGhnWzVnNI2dNpVcW/b",
+	"lEfCdIEFsMrIFGYVq3uMur6viQWiLmF1hyIr9Pv6sLXt4GC0wRIPupuHVRdfJPAeOoa4uIpuSrXzVj5N",
+	"6jAF/VfnZiS/V7CWC+BMK+K6rb8w0JG7ppSh2rwVb1bCn6mQ/rUdHAtE/78Mn+v5QZc9fNaKzVrKgQbM",
+	"rbor5SbYs/vMyFsbSAsumydWRdWqLkIqYxV8bnO2l4NxbnrMWqzRiqCCzxjcK0OsOiqcGQOjeyDSunCq",
+	"f0cYkpLn9d2LbQisq+X1XnzCwW7fnHBih4IVqF/iyEXS5mtNtLQEajxrH8XtRKnPzcfqL+6lb9oobWcx",
+	"kOFMRTKpO3Xta0zY9zaEIqLS5BYWjj0utL+Yh1sy/DWYv4fpLfzzFhw7GHDHYxvA5pIp4uhOBPMgBqQR",
+	"GC66EQHZQyCeCGQajuTODcSGAzSqCjDlB3xJdMPm7CbkCb9hUz7LctrYDQ8SpW/YLE7t1fKGugVkUvhu",
+	"H7BNHyA14wYSbqN76TIefj7tH+4Nf+6/ffcHp7lj2czPYg65t7dzAAQNtEhuQG+Hxzd/2xtOxGwidLg3",
+	"jO4lT1ItbthE8FBotnNjJvztuz/8aZQeHHwfTMQX/EPcABDoRxItoYijB4ERhBTHl+gIrJczuCG8Y0k0",
+	"dYGN4gstawToqzz4rO7uPgDOtG1hjsKKQgINmUJ5kojpLIGbuBaB0mEG1HJjV7rnPh6HgofjWCSJ0BCI",
+	"wNMwSpiQiZ5TYjNNHJp61FEi9uqSiumEtYy6JR+Ebf1F1aTKjm2zW18SW+USK34Lzbis3+4tdvuidN7/",
+	"av9a5sK4sFGsxOKk16NNyJEH+D/gMhBxTEUn6b6PidGWletgVnJ+W+0QsN+1PkwXlvTFkVWetpz1ICtb",
+	"oejBS26/F8olfOoCNYZxbmqVtiajX9SLsY6M/hZxVLYq0vdzDaU2efVcCqthYI7Zz1dXF05id8G3l1fK",
+	"8Ba4sItwlHf0BH7ufpOav537vE7zd88dWV8g+ByvCmF1HNZusgW+SwRdK2quF3MZTLSSKjXxHG8N4Bez",
+	"2nym3kIbN3S9cA42N8LuSJbdayxC9dbGD3Stpy5PwdciEDB3yqFGWtkA34KeTbnOqMP7tOMrYb6RkxVG",
+	"2pQKV+QFje99YCYNAmEM0OGOx0ZQKHqRdtag8vzMOxR4YQR+yNlhfba1F9olCbLZW8+RG1teoI9RnACO",
+	"7xzDg5Qm/AlXYpftaDETPLHOXtvebqfbEV9msQqFS9v2VrJxRYpzfooSMUVaCJlOgXgXAyzB0el2+hcX",
+	"l+fXg6NOt3M5+PPg8Ar/POyfHQ5OTvDvwd8Gh5+u6O3hp8PDwXDY6XY+9o/d44vjy8FR59eFLPHsB641",
+	"R6gIk8xj+AFMe7XJ79lCLVYIcsOnxMBOt3M0OBngH9dnh+O+G9vp8U+X9PxyMDz+b/hjeNa/GP58ftXp",
+	"dvKyKe69X7vLix3lC+YCYjW5P4+P6moqufdWq6qUd2T9q48TlcM8KJ1HZwNzkOmkyyJMrcaEVq7RBDFN",
+	"4yTai8WDiBkvcLpvqLZ5vUb9J5fzCMcMYl3YYlMOmGMnjx9WOqvjslszkBJs2QpDOeRG7EXSCEl1RNkU",
+	"g9TRdWtA4nPjkGqRemP6pXYUXAeT0gim/MuJkPfJpPP+7cFBd0XiuMQSngAR+F2C6XuRYRZewTcI+80Y",
+	"3+6sA/7QYkCZSbzdWOj1DQzm5ygULpFgEsVhNrAd+pEyGQlgyCRchpzyLexbWkx5JOuYiD7GzKrSUG2K",
+	"Q+c9Hn7ZKG+VigWXS2kGLGP1F6uqFCul1+0s+8k4UeOpeOJwMpYANgqFhsyNHDEFaA92T6N0MsbnLIy0",
+	"wKDT3kjOdKR0lMxtzoc9AbLZ3c5Zqu+FDGDCYBvFfyVd9sg1ZI12mYSVjndHkoP5FDa6Qu3MtoABR3Jh",
+	"RKRleXcZjPO2ZokKc+10M7lf+tFNqEZ8L8NgUTo5ByJ5DufzGf8tFYQ/GaTaKG0zdtlMi4dIpQUNkx0q",
+	"mUQyFSbb1zwZSWtJt2niQKzUkHS+Fx8IfQbDz8h0bEnxp3x+vZE8pJ5dTw5vCpqIJGEGQWtgMT6opzKN",
+	"v/NSoI9OxyKIu7rbU7/igKgL8a84KkruD/uoqgESAHlTUuJ0xpPoNophb2RmBmL26F+Y6p8oNkyA1O96",
+	"A3CMWBkVzUQcSW8h6iECU7tpIVbslmzt16fYOnW4kh3n7bbGUA/sia9lyPOETbm+LeftHzc2A0SM8AZS",
+	"FIPuAyFCsXBzwVlbnsgY1M1xJ/Dy125rzt3/iv/BGzc9Eg3RsMRxNgS/eJQ6oLKZRVCPEpPBVuAJrIXM",
+	"EmdH8j56EJIFcWoSofdNojSwvxGxPU4o4JT+LcIx3i+6JNYQsWwkFxrnWuQDCD8URmgSQNO76F9eHfdP",
+	"xu5CQuF6dDmF077UmM1Nc2pxN1eKlS74KBDrDESp+w5RGOCOmw0FxzXl+rMIGd1pCoYF3P1EEYcgmI8Z",
+	"QA09W98ugdvzq10s8astWn2xfTvCFzL6OmGBBFwuLIjQ9mx1i/bqSw1uVyhlx2Vgo6i6TPTue+zH/tXh",
+	"z1gM1Gl3SjPaT7CxTi4H/aO/jy8Hh+eXR4OjXkWQWbZgPD/iIspeyhi8jdT6mnnzf28FLEqv5/gpoGGJ",
+	"Rxao6RSvAJGEQ7bLVBzmZuqRrGADYci8mN5mBT0JRSXPtaSQ/wJGYh0MipvXyhmsOJJtW//K+lQLXerF",
+	"vGoVXc3POt36tA4Euy2ySBX1GhLPmJjOkjlTVKEmoCCxKOkxuLmNZDBLHVLneHqL6BKfx/e3XVZIl+5m",
+	"bDEGthhHIfJKkiFyOxRmBFsulBrxwQMDWPctHJSwJSO8HnHAZ56bkVQaeoKXOX48dh8bO/4eC2Ypdp4N",
+	"GRuUyikfdB7GXN+LQiZdia0dZgW8SHCYkJIykuWIOkhMpPsHkdSaT0tQVQ6u62AkT8+Pjj8eD47Gw4vB",
+	"4fj47Lp/cnyEWjqcyoh4mn11F4k4pAVwl8yF3WqPb7ec9clfFXZ/4p7c/HlaHt8F8PILHasrC4bX7kl9",
+	"WiDx0OK4O8tmJjwcp2M6QaakWiy8BklVe8iVtHJvkLs9WF87Dx+JIKJEkRUY+Ad/FK/ILutPKwj6MnrU",
+	"4cmn4dXgcnzYv+gfHl/9fTz42+FgcDQ4YjsFUMF5nqrdLaJkgCPrgUcxCOfdLvvrp/Orfm0LVJMdLDJd",
+	"KiCNJ1LeblaQpNwB3kh3ERGxXr8byVoNz7a2KqvTzaqe0w/x+WYYvS2bZbe9b0AqEX2YepSZ6Fl3Jax6",
+	"3OjgJIoeuldfp0ZbGmSdgdDNoXwNeKEYi+oNReWVWWq1XK/tsR+GhnHXnlQWRlKlCQtFEGXICNR2j53P",
+	"hES/eFYcJrORZCpy5nXssTP0jAsXHWF/t0UbdBwJ7eYgtKmPFS4t0Os7vkrDe6Fg4zKJ6vmX8TD8RmLX",
+	"3IiXMvdyGbX/1f61LAK5nyYTpel6QO/YEGMQmK61D6yC1Ft4m8t5XQTyprh4uWfJ9tH6IHOUfvkikEFG",
+	"nZXWeUYCrNbacwQhSKks1ZcVjCoTfUd4kIwbI6a38ZztuKt5t3yv7VbknJF8ZibK+ohLhoFdpoUMhaZs",
+	"CvjqL+mtuI50MpLw/ymPT3kwQauSk7cIdhBQ2Vtn9y5fYi0atXPtZZdYO3uoSnF8l88DjMymy354+5Zd",
+	"n5buzSNZhKSeCoPY/8nEkYQ9qjSm1Laqgcxbuon63+xNecuXVDtmr8PFLd2Uy+gO7xESMVO1IHhlMC8A",
+	"8acCnbKvU+sD79Tb7V9gXNk44EWpEnSJEAMC1EeF73YX0OlI7wAeK2+Lupux5UyYyHxVIeGiJ+o9sTAZ",
+	"ekcIgjyZKOoNm3vP7e0lknRTyhJUnEwYScmnwsx4IEyP/VgOJEHDViGA455CS53LK9JuyiPpvEwfihEq",
+	"1utkqVxoKpJh9BCFKY/rqjjSq6/1+l8e31Mv/9RKgT7/nuYlR7TCTrFbBNRzSYExhai6FbcKmFLrb9mX",
+	"+Pz18hOMbtPGJGdefjq+CLTTygICGZZ7sbqvT6s4wUgqfNGmV7gyVpjjyiI64nmaTASoDRhyR1gzlHQx",
+	"kuTOYhT0SWIqUNPbKMt57Z8doaODWrzD95jkU9RUiNEIqY9CHoVxlU167JMR7KfBFbNR/PmEUHISUE6e",
+	"9O29AWKYNHx3ou6fJ0zaG0QXWOdjY0RozZdKr/Ohs8AtxiCv2sCqoayoczpuWidw1BYP20i8aHUcLeNF",
+	"E9V5VSXFHAvXxp/hFga8pxwqZ40j683yTz5JjpdciCwj0SSCFKMYYT/9KLgWGq7Bnff/+PX3X4uSiypS",
+	"VaJOv3PiJ6b9mcky+HFBkO2LL401DoeJFmCbJtGA8gTFTEHAZRemPArRRjYGk1R+Rhel5tLcCc2EDFSI",
+	"kuiKf7bXnTsr6NSdFU25UEJAAD6ShShXzeU9hFgOr5lKk1lqy+nahHvu8vgB9D+SOeQ/3hHA4RqS/xUe",
+	"OBagAslazLQwQiY4gw+uYgjKX3hhD8eOnsmzI/xCYMlkJQstzRCMGAMAR9LVHYUIdqF7OK8x0Xs85V/G",
+	"Ggr1uu2049DW33QPDg7gf7tUp5Q+gLvkL1lRUvcRrgfB+hlcKCZkmJHCljWNlBxJDGfS7OuoY38V4ajz",
+	"nlHhq1HHDQd+O/v9vaMQQhLY2dqQCz2Slq6OQIGK06kk7xl+AGuDRReoUCXiF446/0+hY9+5MsB5Npws",
+	"XsFGYsQfLyzDf1JAv4sVzn4IzENNiPB/zpr/nDUtzpovezJcPG8WJtVJxJdkH7it8b2Gw4d2v93dz3cK",
+	"rQdd0fbcoq3edExVoKPSZLJPgJIZCmyz0WAlcGK2Y4QYSXv4JJP9DGmWnu++XwPpnYSwkvboYUJrpfF8",
+	"sABVOo3hmLgUdFbCm9YaikL0ZhKZBCJ+wNJ5kw3Z9W+qA7gcHA7Ork7+DsXzjhbAL+n2uYB9CQFMFfxL",
+	"UwTA9HqAcB0uckTPbdwYy5089cbo2rGgpKHLeoW6bPPXqdoRAUqKnRdFFT4ubBJc4fqt0UcJfuNG0cPX",
+	"xxbYqwdKADBnqoW5YQFMIUgxdc6yrEsgH8lMW3m3a3MSEU4tMogSBiFfqr6fMCV2uim08+bddLcAP58x",
+	"+dvv2U3/8PD809nV+OT88C/I231m4fCPL0bSQSjV9RbNxuWJET5T1vPbA8hfCrQyOS6coXu6VkkSZ1Fv",
+	"bwFv/vyn47MxZIiOT45Pj69wOD+qZOKCNzi7uRSJnu8hqTNYKSwfT2EePQ3PKYdvbESgZGhoThlTjqSj",
+	"ghFJjp0PI/vOOEw7790cPtvSlsS2XyiSzfZdHxh+QpIto+D6x97b758hyCjANXR7hfQqSu8WZQBD82xZ",
+	"LUO3owp83zywsjgrX0xxOXDbBFqEhIBmGuTWVNRGrfwkkkOSglmJlC2Cch/LO+X11hcE8TOIfwiYLsn+",
+	"CMZVT7+KxtIqyh4UEMOEJJBxAn4gkP5c2eDaRi4bxYI4gvmBr1IyM1GPhIptdXKDOU9JfTFRdwhf0Ai3",
+	"uI6Vnppg1i25nmdBLcpogcCu//qFtbpZ/ZE++EJKDa3pjX1/jLrcDctTlOb2ZCXsF9CPOSF//PmXq5F0",
+	"Jhwt9gqmaTTUXJa1Q+SISN7HeCa9d5lQRKGssjIHqQHR+lOXaJZMMh4RoYVaN4maGXRxE9tcTcTc4q9a",
+	"2fO/WAiJYNAAuesQgzwHKbEGr0KlhJEkelhcBGRepYsvZ/DhhRZ77JP8LNWj7KLBBU61LrxvW6FeLQWy",
+	"C8AbdmP9qOPLwcfLwfDn8dX5Xwb+yiWWjFfQRmdbbpa8i9d6UOPgXDWNJ7gCn7hZK9dRYhnG3cAsfy7s",
+	"lcSuXt1uNXwa738Fn1EUWoBjHjRULuhjuivwNwJw7QEmUgbcPOyfnjhSumTzvDoHPkY/0ki6DkGLpBQO",
+	"64jmxggNfYE+O+WzGXIx48whoOKeGMkdbAF2BaE4oguKBAZp5eKL21VEEwJg0CFID2+yM5/Gfdf5oZIm",
+	"na4Bmnxh57WSa/LL3uPj4x7YfPZSHVuj7QqlEfqnJ9nIPyIozTeh6z6XVWj7HvWaXYr8/rZ3UGDqwDKW",
+	"Q5Zp2pmFKiINjtu0IP2lk/uVqhM7WfUfOA52sxLERX2tAqHHbuzDG8wrprPaNmi/p+bAUP/Zxfhafq9z",
+	"wiIfFMqMbJcjbUe17jJPdRXzgi6wykCWM8b+V/vX8rJ+ZFgrLOF39mSwVje3fm5IbqHRpUWsQll4YJ5g",
+	"52iaA3UpCrixUQ05R+AtKnKQbK5eCzQRTAS7ujphO7b9Xv54jE/HSRLv1lepKS7ryqK5+HHrsFb7frmU",
+	"zPal0Cr8RKQpWmPXYKyJ4DEY46KHxmvtCSAqCLPVvfszDsV7B9LKIf9xHGnjhd4Olc20ui3KWZpqed5a",
+	"8HDeNPFLwcPo5WY+tDhkiLEOQ/2923l38Ax2n0LHBDqJnTeQPSNUG7r/q+HWT5CYIU/4LTeiyy4R2fG3",
+	"VKSUBu+ioV2cM6MmmRGw5xOBcYyH9pnFXwjUVBhbPHwiWCT3KEe52gbKog9MqpF0TyI7IZs5HZmms+4n",
+	"kfxsJ7h1dvlXk+LVj2OWf4nXR/UZmOftwf961nEkLBbcJCilsiaAqKG41zy0CYESHsOP6lFumsWfNkoc",
+	"UAPbH2ZvWxYi6JU69ndJAXvgKqvX8Aa+3Pgc0/761DEhC3jCY3XfJUg14tIcQg2zDiSTfCp6bJjOcrhZ",
+	"jDQJ+IxbaB8X2WKjKSg3JY7qVbpjO7QhTmTVM7n4taub89PFpzbhdr5Ph5fH59erfnwkQgpqPFy94yFh",
+	"LG417KvYX50ue1xkkFrgsTIbFXizwo7Eo2VI2qYMzbPSmy8GQ5sovAFxkCNJMUmeIBR9YRf0/hogi9tc",
+	"8CI56xa8+E4h3G+ti0uZScq0A1FTAYh0TOODLC79tg8Xxz0ex3tA5PpI8FOuP/fjuMRFoEZ02ijocMKV",
+	"h2xhsDipSpUpQl+ML3zjXl5ldjMt7oQWMhBmqfMCDhQsc4PhFMV2GLBWj13NZ4WS41S7NrcLw7ltcVJq",
+	"1I0i8S4KA3smNs27bMWwRdJtgG+dp6Jy75F1XdavcrczS30hpQKiNSdRMFlcOxfqBdokn81KLxi3sFhX",
+	"GbapcF4BQ1kWblXZUWT4bUyZe9CsDUW8maYJvHHD7mJ+j84CAj3fyRATDs9PLwA/+qibo2Q5EOxdFrn7",
+	"uQ0KGMmz86vjj8eHGPMzvvr7xQCxtk4/XfV/PBn02ACLJ/NClYccjV8Lmgm/u8MWvZl8aSMzbt6HUNPb",
+	"i9YE2czeYIY/PLfDoXCRQ2/YhjbWoviko3cPwwqabt6f8L1DfG2bnvRCN76S9PiYAlk2JbI8yortYBU6",
+	"fi3+0yUphiV0zcXjtshx9qhdTWkrNtDamFbi8+ox/bSMKDzXS5Rsd6RbMzzaUh1o++/7Mb8VsSnRsDyT",
+	"v4i5YTb43sWuU2wseLPAQqEFwSQwpRFfDerZJZCNCTBq9pORlGkcF77QYgpYQz2G7UuVsKmQCfm44Hks",
+	"7oBtrFrglb6ISklTOaFZrLq09ustJtfRwF4SZ8zO0eurwsF9UxWaToXGMGNEGqWZsdgtvuN++6CZ8RcK",
+	"jfudwD9pjuYkUlZ5hvREtXVh87mAC9t4j/WDRGmTJd6gTyHLzbGVea9PQT2eRmRyDzi6ECRu467TsmA7",
+	"IccLvNcRngKUbLgVsZL30Bri2vPE9d1lfAH2EMZZjxVjueMp1ZK3v4kWB/milSo9NGswJ+tNVvZ+3WBZ",
+	"xLaWF/cw5z+sq0Fd3aNz40re1Npehvad57C6tChG8OO8s1rZgm0aUog2dVo3Pd2s8cRkq5Etqf2liJ/l",
+	"lTn43pauSNT4y8oHml/9OjxVCjx9i9I4doyI7/ays0OqDLtj17ushY26/5X+WO6PtxXik/kMBKDtGfMR",
+	"EkURU3rKdvpHl3sHB2/esf/zv998v+sQ4J0sIXcO9RFmECC2MQgGCYXObfz3KcQ+AYYuVZtivkH7tYL3",
+	"gEgFh3Mk4S8LLZJpGmReMDbBEkZDgxkOLq+PDwfjn/vD8fXpkErdZfAkls0zZ8bUtsOiZPFzC140vhz8",
+	"9dNgeDW08MAQUmACHoo/Za1FhiHqv+9wJ4SobKOteKDjZxY7qxIgCOaamjVEgoA2U15MvBvIMJ3Cqp6m",
+	"JrGlnpJJuSXxhQeJQ2TxFkahfsb4z+p+XpJFuWTGRK5DonDbcAkaewkE7BkPWxqypWCNEK6zMzyZL7Z/",
+	"kjVIT5vZvAnUc8t/t3MqCuc9yPy3Yiov80Pv8D0V0bgpPL7BeE4yZvZGclhg8siwaGof2SBqV3ypHlN7",
+	"M8u1raP2RY2PS5nlG6w+bByb59NZ4TDen/JIJjySQi+/1SKeXfZ+dqXNRXOPnebNIbY9EZQutXakWKkh",
+	"MYXDWiIaHL8vtm667DZNHCRXjhaZNQOHo0OiUI/wxSSa9djAViBkU6gWofcRu0+7G4UhGIZ0ZkMrIsnQ",
+	"lust9BKGxBX5nF7fpsrH9qLa6ykSu2FjFdjmVWOkPu2U7YchM9UJr7sd97+mhvIOyhpzNfwTDKMbZNTl",
+	"6g+m1h2tpvcUxQaZcp9fYBKpnrpAyOltLA+n9s3XrDfRGJfYAWjKBXPAsyNym+JAVrMh5FIcP36tahGN",
+	"7hXYIZZLcuKGf2vTZFGOO7ZZWUS0k9/Fq/eTWXRLsptW/LXI7foF6dY5dosXIyIyWOOfj9DblRowl1dw",
+	"rWorOb7dO5bbCMQ77QVC5rxoVBrcS9vkypV9G79u39Vcq304f21NzG52f4zQq7pg2co9Rkv8C1m+4WvT",
+	"DGhgr8F52bQ+L++esANp6Z/wehKX2/qb3BbWFIw5rImGi8V7i3DOHwT7l9DKlsG/PjU2SfAxMoL9cPDH",
+	"kax4A8jGb6tIPUzHhC4DNpIHMmYbtsPBczGLBdjILyw+dbU0cZ4MUXZHLHgjFgZR41NgtS6FLkWAIphI",
+	"IGJDXosiYidaagh60SVQ0BAQNM36fKzF/k/A0wyt+Szbbh6XT50X48nbubtKDEObeiE4rc62HAt2dV/a",
+	"s7CQtV0SwLW+heddrV9fJnIqX6PN+SIqTdYdfE/3R9iOnuCQeIE13tpp/LKK9nIW+xa164yVvS6MNc/r",
+	"TXg2FoP1HJkLjVsMLSEsHCnBBOS+DpeIeH26cCTXuR3o6TOZc7e/dV7eSbFSCN7/Rb6KhRlveOOt5MN4",
+	"Ka7ftNVskY1e3HS2wjq7+mxLSo9mb72C8MpjwCIIxZGYaRHQ6bfViqZ27nWGC/e81nKRFIjnViH/jZYh",
+	"NaXtsy8wsSx6EHt5IHhTdqW9U93oWx6814KHN0xp+09ytt90ser8LMlOJUKy+c6AP30kC/302MeYJ4mQ",
+	"poi953KfiiG7hkUyUXlWJzZD9by6Lpjdwigdw1KEFoUsFLfpPcaoW3Q2gJSIxdTUZHXCjhw4klwUKLIq",
+	"O9Zv7c0xjHegHsbJ3mPFNX52oTEEOFDuVrkwFJatZYFxgaMszz5M6znSVUDCgRhrehDyIdJKIq4kQNYR",
+	"1MJ7VJUiyfJybyWkJRsTYgSlBmFGcAaOCbYInuBPxXokhs/rcBquT18iMx8CvQlN/gOzOfUGwyPz6ig7",
+	"RdgxZ4TJoSvgMmZEslsT/4jvjG/L2ftNXHp9CtTA4PMf563iIAvB6jWVK7IVXK1uBTELBNqBIFGaCqUQ",
+	"VA3YvwjF2OnbNBygg/gyi1UoXIynb0TUSGk4kUslaKbOkL78PcM84FpzxBsyyTx2BUxqSWHhclrU8PAO",
+	"O9Ov1qbkoyxEVO9oYVT8IEJEiE7vJyVLoQjvRR1fZerfOtNw3H07X/b1goFV7BkhTYTi8fqUzBGQYRt9",
+	"qRko/GecvbFKZ2o65XsOLSlkN5/F/E+YinhDyWNM/JZyBIVJhJ6aLsImqDubBw+GX5vBxXZE777HboR8",
+	"+NNMq7CbREL/6U7joRLe7NZHL2M/YyNisVB1RnxB22/nfcffbKuCLDP+Wwqi80syDlJtlHagpFjuVqWG",
+	"uWOixw6VTCKZCpMVjeFQafcUUVMgT1fd2RpRM34vPpBFiaBLUco7SfSnXLZBxD5167oxFheoUHiqB82B",
+	"ufigx64o19pQ1T1CRP0wkhy4W4T2kUMBLiT1Q2WNeirTZ43csU29gCSuTxO4Pq1VHum4cqfvQ+Z4fJia",
+	"/Vtn7fMewVQxlZqLRJ5xSK4Ja0isgl1i6R5sV5iRzEGfbbqgPZKJ7nQCfyBsJCzAUKxPiI3UH8I/Uh8r",
+	"H8X4HclmEnZtzmX8CLITVvyEPE7hRypMvNI3V+qbc9Di8BtYFFf0BUrqeQBD3c3FjUosbpJujQmQbOHv",
+	"egM7H+Jxur7MVCSTsu/pjyC1P4HAIlPfHm0fWx52qkIRk+SJQjGdqUTIYA6p7cwQuhiIPqw0zlDvYPci",
+	"yf1kFqyMBRMRfEbwHUSRxs1tLXMfSBcmpdDVS4GmSulGjxO8lFFJORpOYtibPUAMxJrG4guL4JJHZUzg",
+	"ey/0M9LCbs4tpeDZ1qmrlQyEb7c1hnogPHwtM+lyhPxe30L4HLVALskSgSz9JRAiXNhFNOts63hK53pO",
+	"mX1s0iyFqcTdSUVuTHGD2Ux25PMuE6BeobKVbYVHPh9JSAHIEuNzMOVCC1B2pVjRApPjQ4QMsu8lI2nL",
+	"WkyopgXjUP+nx34ic0Q2uuIhBltP80cmU4zlcxn1ykoai1OheSLGSAhrU/lAJbrQ/BEbwYwQxpo9xoYn",
+	"KXxQB1RlefCE6LpVtaPYUT2TQ4E9YhxEiEd9aIOQVE5k39b21syAM/UodL1nB3AneWJNCkzIkGS5VHrK",
+	"YxgXWapy6V8S57NoJmztz8EXEaSJMFZPwm5ZtnoGhelMyFDIJJ4TX9wKk+yJuzus9iemXCZRAJas4VX/",
+	"8grr64P+Bb1fnV9cDI7givuxf3wyOAL17gP+jL6jy0H+yZwlaiQvP52dHZ/9BF9c9D8N6YseO8azhNJQ",
+	"bYE4k8DOLwR92H1NBUKYq3Zxcf7L4HI8vIITydkYPkezcSRJhScrQxfapvtNwA26umB7ikBNBTvsnx0O",
+	"TmD0WSl9wuiKuUnGVCwPruY8sgZE6KA3klgD1cWt9Ky0HyOBx+7nrH+lcW5wUgHQkjMsjOSEG+b/loqp",
+	"En9FeeFJF9/iMMGA0iOZDV2nkq4wSgai3AI17N/BpaPyAnlzq+cldvFtHJe0Zf69D83yHHcCr/TZXSLS",
+	"vuJ/nLusLmYmV8fWuClt29Z9fVq4kC1nDZMZ1Z4aEJOtRGbha0fpfQpaqz9IfrH6B2e3KpyzHaUtSJVk",
+	"YjpL5lb1H0ehwbvQri3TacUMycSRjFA1CUSMoIbQaOHDLkmcBKVmJolAIcm++cCOj8xIqjQxUViUSwph",
+	"Mx1+gNViqMw0CG2QtTO/yDrEtjfDTluTc/2AoNgyQff79rnX9VnPvUQ65kIanyjSnsD6diBu9TPewaBo",
+	"tyfabwbxAN3WV5DH6ud7CO5Gr7pa5VQiDIZA+4/NVBxjzbEBDyb08neG3YQ84Te4Gziz1C7Livcjucdu",
+	"jOQzM1HJzXuGneFZTFVipAgSe6eljYZz7uFnFP3kPsLKYpyeM1dZzQ5PaVcBlSJsP7AbR7ubkWRsouLQ",
+	"uF0psmKz7h3qDhYqFoUOK4OqqhBacDBNMI6G40jyGLqyI9opwJVe9C+vjvsn4+Gnw8PBcNi12mE3V7V2",
+	"P2QWe6GhFQQEC2JlXDkTXJfeSPbJoTolswhZ5Hxr71VqsBG7TgPijS0eO1hhG1llj4a/Yqltq25oda9h",
+	"ythSqdz2E5yixOb5eW87ab+1sFTs5o8Ze29QeiQdtq1lPgS4TXS0ynkzkvYTPG5Y7WmD4gVnRBdtvGvY",
+	"71udPVhY9z9HzxpHD1LuFZw8NA5bR3bFc8cuWnPd9yyTw8XGdMs4/VQYw10ESVsCexIWt7a+CjQSvYdN",
+	"lFJNSGBgax4KWQbQuwAGTRXZXVYEwEB/PP90dtRlV4PTi5P+lf+3o+MhQEUfdUfy+Gx4BbJ6PDz+79LL",
+	"5QeFL876p4PhRd92dzn46Xh4Nbgk40D+zH3QY313Q7bG4mQipiPJ73kkuy7JIrMnc2mPMLjEx7Shrck6",
+	"Mk57qMd9vD69zIyC29lwa2Q5bW7vOVJeIUWaNp+d/DgKSeOZY9FUpmZCOnryGGsBFQqkOsAxwCgHT0Bk",
+	"CnZG4GGFdSlt2wVTxUhSRZq3LzDTy8p1vZvfMGwba8mcmku0yyLIb9DahbD5kri80mQPKfQlWVpzIDVC",
+	"72G8USyY/ciZkNCzWSgf8xj9i2s4wQ7texHFMKW4sOj1dnFwlz/2D/f9IU1Un7nW8GupY7vYru230pff",
+	"s+dmH2Rvea7blZeaKmKU1+vrw1IgwL6Zy4A9RNxWt7IuuIM/7PaYW8a3B29Z33JnpnpL2Ju9kUxgZEI+",
+	"vGe6TX5ZDwuvhv4vMO2OZZi8Lvokh6C7iigKgV4nRp4JzUo5a/Upa9enK2tA16cbTz6zr57xaSsPtOUj",
+	"v2K/OYHlKNQkqo4clKCTVWwnMx9boWwFKnIPiG1yA+XSfCTpXIwSUzgXTRLFMQl3nRVb50n2BsW/9Eby",
+	"pbLurk8XNlm3wW64PptViyphwDWLMRgr0knK41MOu0Pk9ZbwSpBVlLs+hYhhioHrjeSJUp/TmbEmrmCS",
+	"FSO+E4/MiEDJkMJNr0977BdXIdx+byNAwf9gr9Sh7SNftOyARcFwo1OZRFPxngGu/A3VIB9J9/P4kWuI",
+	"jrupDxWyb76eWkjXpzWye4NJhtenC2CHXkm+HyhpVCyW6/XkbvsDuz47dJHeeaBFSWyHkUbHFdZNjYxJ",
+	"gatKYpr2NKtudcq5gtXPNBaysPivoTjg69NDmgEZS9bcJ1u7jZYG92z3Udur7a/RGkpvuhV1bjIWTaci",
+	"jLDkJNtxS7u7aZ32CSOt+qSKSLw5Y+04ntv9BvK6LrN0QxaUJtt6Ez+lvDbsa9eta6dQlRH2b8wTCJR+",
+	"TxUUMUKCLFk24cB99sH6sV3EhREis8dGiPlYH0xol7lQUHvt/bzt7dWuFneVpi8ExMYDF3+9MKBVuWvl",
+	"Gt282iczCvU15DktQiGTCMwhXDKpGNS8gMh3TFcCHa1vC10AN1aYMMvKpXYRTrRQ/5tLd6kfZYzu3kVH",
+	"hlR7alZfnLu61tvU9gvdtM5YPKzQtVTS+3nTFaFjD3u15y5y/tZJrgtySuXhQMAMTidx8n7fHg6Z1tB3",
+	"51mmsYSG2fwTUjq+M4yEoRnz5APFyT9yHdrEpaw7d4v44eB7YNtxH90748HfLo7B0gc6Zux6yQspQ89g",
+	"1qu1H7h1d57v1yvsloYFVA7oYnzAqz52rboceIe/jHuXeF2P1JRH0jlc+a0tE8SuT8vR+u/dKxR9xe/v",
+	"tbjHAozGVQPqll+Z8XmsuLWiswj9Ojc4qBuqSgBf4Rc26yPnSJC8GfYHu6CG6EJHVIn+5W5fRgRaJAa+",
+	"DjlWR2TkSyzYSDnGUydM3YEHDF1NAdeavK83zot2U3/kr+mdbCtaX1Vgvp1tQ2i+5aiX0RLi6E4E8yAW",
+	"jtlwUa9Pl+6DiTIJ3bdr68s1GQaxGCnwy+f0VjxEOulFaj+kzYMWA6Q1U3e0G7I6+denwOtd8tbjqoBS",
+	"C6+4ATGTKG11h0yTvSq+gHBft4JxyS4/HrI3b95+nz+E+SdsqkzC3r77HjwxGvaBNkX4q4fpe+Jr8cH2",
+	"QY06f4IAZHOmJO28zJJSA7pzffqzI+arusxWR/diEYxuAA7Qp/5Icm86MPsn+1xf9UFG9ADum+QM1Lxt",
+	"v/GikNena9aD3OpGeflSkH4L4zdeBRIyK6sFIP1cPY3uQRjX2zKbjiJyZxvG2enxT5cQW+8xU46kuw8U",
+	"XVk91sc02/yDzLSthYtht2U3Eq7vRTKSzjBOtk/cFbnpnSpQQl4uRmukWrAoYZ+FmBmmU4lp4UqOZP5u",
+	"0/FySmS5Pn1d2yUb1gsdKIX+608Seqmdp+rf83QpWCenGTESxbi0xj5ivKWbUwuIAHrq3rwcQBDOKlsT",
+	"dD56XWgscGMzc/L0GhFScBIE5M2i4HM2NSWhNr3t6kgEkcmDy3o0n13wdbnwHPppJHUqTUEG4JiPz37q",
+	"scOLT7jhp2Kq9JyUbJcedH1K0XgTlezN4vT+HpFR4BjNtF5w3u3ZRbB5ddenFDMrMePBqaEYrauFSbgm",
+	"0RPP6bU8MNZhstxm+jM27wxrEPQ7kmFkPrN7rR6NTSsv5DK5RChAfgm4ZLdu/mE3a4FBAyNpuzITHcnP",
+	"dEt1yrWS7jNcm1uR2fLJlTiSOz8c/NEu+7h/cjnoH/3d4d3u+g140NprE3ZuVC8k6/Lum8KHcBn+I+cc",
+	"Q+4cXnzap626D4y820bGwZYrCrkF5oQXnsadizyysJDQSeXW8xQbL7XXwhzgkgCWeaKSSTUKYei+hKxh",
+	"AVf+zGCm4rAAb3EF5lkIpyb1ihfUJnsUYXKxqbZn46KHEzGbCB2SsI0oLCKsN1Jl43qVRlo3ulpE/owK",
+	"GT2faSu+O3iz/aS/q0qYCgOBFYVCs1AJul9aDIecH/wILYXnbVEomhSW5YflSLoeMVKzeia6h3nasjsf",
+	"I5mnS8wgkeT6lOEZOTzrXwx/Pr8an18MLvtXx+dn+TnJ/Im7rpcs/xYVByMSxrPmFnStQl6uszdno43s",
+	"dhtJXroRWc8wQujDB/9Ut/CukL+lIi2HHTSFNDt2fl1ne3V0jeEeb7ew+88dsZqOd/fyv58x7NsRNsQp",
+	"RXHT/kTd/5rtVsmnokWJqifvlxZ4grYDCkFtB7ZrPymXP/jPeVQNE61nkW5drDwPmzSrBT2px8hNMY1g",
+	"Ptbx7Xr9LvOm40tX6pOh9J0C3GWUZC85v+JVNBXdkbS3SHULCTrf5W5HlkRTYRI+nVnPeen0cMmZ9WH3",
+	"L87Q21De6jgpY4D/7BCP3/7pEhSva0qvaZO6pI9NHisNd0SqjWxmIsgqFY8kbjwl0auIe8aN6ANLNA8+",
+	"5wqdNRJnodDoje2xfo4i48zKdxAjxZxx5Or8coDVf44vB8Pxx/PLw8Guw4a5UzqggAI/KkwWhK0g8zNz",
+	"mFri1JhY4NHLbMet2GbK03mdCpwd5n/0t5cTPW4Jrk/pMG0vg5rNQsPtG4WGGzUJDVsbhBI1a5q3mm17",
+	"2mq2wVmrWZtJP8ig1v51DRBd6MxQUuyBOoTRsLdKJSbRfFaMiyUeEwH4/wKlPkekgQkD1Zwig7gUMgtc",
+	"o7hLyHu0uHqnn4ZX7Oz8is24MexWcC10oXmDB9uny2PKrMPAFnKg2KYKg5qKhINB/wN7FLdGISjBjCcT",
+	"hkGSBrO3M5iKAhn2H4sVdNwAXZA3Jolk2bZc5mkIeaRl5uHRIjv1wCsykjURmRmARxbnaQn0GMlQPbIJ",
+	"x3BQv83vfCbk9en12eGrtPZdnx1a0jWdE8BOeVwwD+drYgC+eos9LBaI4sKE22zN/cd66/Sn2b3mISH9",
+	"cfaLuB2qLENpptWXSBiom8H1nF1+/BG0t7u7KIC3i+FpI4lDSm+1sN55a311vntIkbvBPCks3VbYHZSm",
+	"TtrfSN7OmW9XfcC4Tzqy+OLG7jIT2U0wkrZdF5hmFHr8MCmgmAUCE4Qg+gwmGjgBmgsoq51SOnpsEBFS",
+	"IZjSg1gZG5pNcyAKhQ7yhzZ7fyEJAKJEIxKAhVcZh8mgQNzBApewt4eD4RAMmcdn40/DwS4OE8EqnK2U",
+	"0HR6DzIYT/mXse3CjGdCjx+mI7ljM/7Y213GA61yQWnYzg9v/8iKvZwcnx5feZ2KF1p9meMGzHhiU5mZ",
+	"1VD+4yPHLTlEconBfbmOyEudqkexmPk4jeSJkPfJpPP+TXcpZvobEheVs/QxSihzk9g93x4zrRIVqPjf",
+	"SNI8E2zhlVJsCiiZbu/YdCy7KYyjNflt3h283f6QYARZ+kPmNgJ5A+KCBxNAYqmIYtwfThZjpEVxn1RE",
+	"MnwJSk2UzHHf/IgCrJ8Cb/7jV+BF2tW0qyrpIFqFKcmL/sVxp9tJddx539nns2j/4Q0ewLa36pc/Cx4n",
+	"E4JeyeZn8i00wee+ui+2lDjCBSNKRIYuvlstsmF832elvFwDC0VCfJ9ZIx6bkhXP+/mDt8MMZuZR6c93",
+	"sXrM7BbFARcwQRZEkr0g+bq0lydfv1kVLd93ebUsX3J6ESPIQ+j/KozbAQrtwcve6ecnFwlMN+HUu7x9",
+	"yidz4r7AEZhp5u0gjBIGID3er+Cp56uzDPZIi/vIABKTZ6b/a9dTisc3ywubD8cieau+MKmS6M5O2ZTg",
+	"798eFJssvuZpFQBRqDoYnLS2+pctFOZdVqwl5Rtden9PJWdLq5HfuX2Nwbt77g3T+f3X3/+/AQDjT0OA",
+	"80kDAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -358,6 +358,86 @@ func (s *Server) DeleteSystemMember(c *gin.Context, systemId generated.SystemID,
 	c.Status(http.StatusNoContent)
 }
 
+// CreateServiceRoleBinding handles POST /services/{service_id}/role-bindings.
+// The binding grants VM access scoped to the service (see requireVMPermission).
+func (s *Server) CreateServiceRoleBinding(c *gin.Context, serviceId generated.ServiceID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "rbac:manage")
+	if !ok {
+		return
+	}
+
+	var req generated.ServiceRoleBindingCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+
+	role := string(req.Role)
+	if !isValidMemberRole(role) {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_ROLE"})
+		return
+	}
+
+	if _, err := s.client.Service.Get(ctx, serviceId); err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "SERVICE_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to get service for role binding", zap.Error(err), zap.String("service_id", serviceId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if _, err := s.client.User.Get(ctx, req.UserId); err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "USER_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to get user for service role binding", zap.Error(err), zap.String("user_id", req.UserId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	id, _ := uuid.NewV7()
+	binding, err := s.client.ResourceRoleBinding.Create().
+		SetID(id.String()).
+		SetUserID(req.UserId).
+		SetResourceType("service").
+		SetResourceID(serviceId).
+		SetRole(resourcerolebinding.Role(role)).
+		SetCreatedBy(actor).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			c.JSON(http.StatusConflict, generated.Error{Code: "ROLE_BINDING_ALREADY_EXISTS"})
+			return
+		}
+		logger.Error("failed to create service role binding",
+			zap.Error(err),
+			zap.String("service_id", serviceId),
+			zap.String("user_id", req.UserId),
+		)
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "service.role_binding.create", "service", serviceId, actor, map[string]interface{}{
+			"user_id": req.UserId,
+			"role":    role,
+		})
+	}
+
+	c.JSON(http.StatusCreated, generated.ServiceRoleBinding{
+		Id:        binding.ID,
+		UserId:    binding.UserID,
+		ServiceId: binding.ResourceID,
+		Role:      generated.ServiceRoleBindingRole(binding.Role.String()),
+		CreatedBy: binding.CreatedBy,
+		CreatedAt: binding.CreatedAt,
+	})
+}
+
 func (s *Server) requireSystemRole(c *gin.Context, systemID, action string) (string, bool) {
 	ctx := c.Request.Context()
 	actor := middleware.GetUserID(ctx)
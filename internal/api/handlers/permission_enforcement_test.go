@@ -124,7 +124,7 @@ func TestPermissionEnforcement_ListInstanceSizes_RequiresVmCreateOrInstanceSizeR
 	srv := NewServer(ServerDeps{})
 	c, w := newAuthedGinContext(t, http.MethodGet, "/instance-sizes", "", "user-a", []string{"vm:read"})
 
-	srv.ListInstanceSizes(c)
+	srv.ListInstanceSizes(c, generated.ListInstanceSizesParams{})
 	if w.Code != http.StatusForbidden {
 		t.Fatalf("status = %d, want %d body=%s", w.Code, http.StatusForbidden, w.Body.String())
 	}
@@ -243,7 +243,7 @@ func TestPermissionEnforcement_CreateAdminTemplate_RequiresTemplateWrite(t *test
 		[]string{"template:read"},
 	)
 
-	srv.CreateAdminTemplate(c)
+	srv.CreateAdminTemplate(c, generated.CreateAdminTemplateParams{})
 	if w.Code != http.StatusForbidden {
 		t.Fatalf("status = %d, want %d body=%s", w.Code, http.StatusForbidden, w.Body.String())
 	}
@@ -256,7 +256,7 @@ func TestPermissionEnforcement_ListAdminInstanceSizes_RequiresInstanceSizeRead(t
 	srv := NewServer(ServerDeps{})
 	c, w := newAuthedGinContext(t, http.MethodGet, "/admin/instance-sizes", "", "user-a", []string{"vm:create"})
 
-	srv.ListAdminInstanceSizes(c)
+	srv.ListAdminInstanceSizes(c, generated.ListAdminInstanceSizesParams{})
 	if w.Code != http.StatusForbidden {
 		t.Fatalf("status = %d, want %d body=%s", w.Code, http.StatusForbidden, w.Body.String())
 	}
@@ -58,6 +58,29 @@ func requireAnyGlobalPermission(c *gin.Context, permissions ...string) bool {
 	return false
 }
 
+// hasAnyGlobalPermission reports whether the caller holds one of the provided
+// permissions (or platform:admin) without writing a response. Use it to
+// shape a response, never to authorize an action.
+func hasAnyGlobalPermission(c *gin.Context, permissions ...string) bool {
+	permsRaw, exists := c.Get("permissions")
+	if !exists {
+		return false
+	}
+	permList, ok := permsRaw.([]string)
+	if !ok {
+		return false
+	}
+	if slices.Contains(permList, "platform:admin") {
+		return true
+	}
+	for _, permission := range permissions {
+		if slices.Contains(permList, permission) {
+			return true
+		}
+	}
+	return false
+}
+
 // requireActorWithAnyGlobalPermission returns request context and actor ID after permission check.
 func requireActorWithAnyGlobalPermission(c *gin.Context, permissions ...string) (context.Context, string, bool) {
 	if !requireAnyGlobalPermission(c, permissions...) {
@@ -8,6 +8,9 @@
 package handlers
 
 import (
+	"context"
+	"time"
+
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/riverqueue/river"
@@ -17,7 +20,9 @@ import (
 	"kv-shepherd.io/shepherd/internal/api/middleware"
 	"kv-shepherd.io/shepherd/internal/governance/approval"
 	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/health"
 	"kv-shepherd.io/shepherd/internal/notification"
+	"kv-shepherd.io/shepherd/internal/provider"
 	"kv-shepherd.io/shepherd/internal/service"
 	"kv-shepherd.io/shepherd/internal/usecase"
 )
@@ -27,33 +32,69 @@ var _ generated.ServerInterface = (*Server)(nil)
 
 // Server implements all API handlers satisfying generated.ServerInterface.
 type Server struct {
-	client      *ent.Client
-	pool        *pgxpool.Pool
-	jwtCfg      middleware.JWTConfig
-	audit       *audit.Logger
-	vmService   *service.VMService
-	vncTokens   *service.VNCTokenManager
-	createVMUC  *usecase.CreateVMUseCase
-	deleteVMUC  *usecase.DeleteVMUseCase
-	gateway     *approval.Gateway
-	riverClient *river.Client[pgx.Tx]
-	notifier    *notification.Triggers // Optional: notification trigger service
+	client        *ent.Client
+	pool          *pgxpool.Pool
+	jwtCfg        middleware.JWTConfig
+	audit         *audit.Logger
+	vmService     *service.VMService
+	vncTokens     *service.VNCTokenManager
+	vncProxy      *service.VNCProxyWorker
+	createVMUC    *usecase.CreateVMUseCase
+	deleteVMUC    *usecase.DeleteVMUseCase
+	migrateVMUC   *usecase.MigrateVMUseCase
+	resizeVMUC    *usecase.ResizeVMUseCase
+	snapshotVMUC  *usecase.SnapshotVMUseCase
+	replayEventUC *usecase.ReplayEventUseCase
+	gateway       *approval.Gateway
+	riverClient   *river.Client[pgx.Tx]
+	notifier      *notification.Triggers // Optional: notification trigger service
+	healthReport  func(context.Context) health.Report
+	probeKube     provider.KubeconfigProbe
+	loginSessions *service.LoginSessionStore
+	loginThrottle *service.LoginThrottle
+	refreshTokens *service.RefreshTokenStore
+	permissions   *service.PermissionStore
+
+	passwordPolicy  service.PasswordPolicy
+	passwordHistory *service.PasswordHistory
+
+	permissionMatrix *permissionMatrixCache
+
+	batchEventsInterval  time.Duration
+	auditExportMaxRows   int
+	vncMaxAccessDuration time.Duration
+	requirePowerApproval bool
 }
 
 // ServerDeps holds all dependencies for creating a Server.
 // ADR-0013: Manual DI, no Wire/Dig.
 type ServerDeps struct {
-	EntClient   *ent.Client
-	Pool        *pgxpool.Pool
-	JWTCfg      middleware.JWTConfig
-	Audit       *audit.Logger
-	VMService   *service.VMService
-	VNCTokens   *service.VNCTokenManager
-	CreateVMUC  *usecase.CreateVMUseCase
-	DeleteVMUC  *usecase.DeleteVMUseCase
-	Gateway     *approval.Gateway
-	RiverClient *river.Client[pgx.Tx]  // ISSUE-001: needed for async VM delete/power operations
-	Notifier    *notification.Triggers // Optional: notification trigger service
+	EntClient     *ent.Client
+	Pool          *pgxpool.Pool
+	JWTCfg        middleware.JWTConfig
+	Audit         *audit.Logger
+	VMService     *service.VMService
+	VNCTokens     *service.VNCTokenManager
+	VNCProxy      *service.VNCProxyWorker // Optional: defaults to dialing VMs through VMService
+	CreateVMUC    *usecase.CreateVMUseCase
+	DeleteVMUC    *usecase.DeleteVMUseCase
+	MigrateVMUC   *usecase.MigrateVMUseCase
+	ResizeVMUC    *usecase.ResizeVMUseCase
+	SnapshotVMUC  *usecase.SnapshotVMUseCase
+	ReplayEventUC *usecase.ReplayEventUseCase
+	Gateway       *approval.Gateway
+	RiverClient   *river.Client[pgx.Tx]               // ISSUE-001: needed for async VM delete/power operations
+	Notifier      *notification.Triggers              // Optional: notification trigger service
+	HealthReport  func(context.Context) health.Report // Component health for /healthz; without it every component is down
+
+	BatchEventsInterval  time.Duration              // Poll interval for batch SSE streams; defaults to 2s
+	AuditExportMaxRows   int                        // Row cap for audit log exports; defaults to audit.DefaultExportMaxRows
+	KubeconfigProbe      provider.KubeconfigProbe   // Kubeconfig connectivity check; defaults to provider.ProbeKubeconfig
+	VNCMaxAccessDuration time.Duration              // Cap on requested VNC access windows; defaults to service.DefaultVNCMaxAccessDuration
+	VNCMaxSessionsPerVM  int                        // Concurrent proxied VNC connections per VM; defaults to service.DefaultVNCMaxSessionsPerVM
+	LoginLockout         service.LoginLockoutPolicy // Failed login lockout; zero values use the service defaults
+	PasswordPolicy       *service.PasswordPolicy    // Local password rules; defaults to service.DefaultPasswordPolicy
+	RequirePowerApproval bool                       // Route every batch power request through batch approval
 }
 
 // NewServer creates a new Server with all dependencies.
@@ -66,19 +107,68 @@ func NewServer(deps ServerDeps) *Server {
 		}
 		vncTokens = service.NewVNCTokenManager(deps.JWTCfg.SigningKey, deps.JWTCfg.Issuer, service.DefaultVNCTokenTTL, replay)
 	}
+	vncProxy := deps.VNCProxy
+	if vncProxy == nil {
+		var dial service.VNCDialer
+		if deps.VMService != nil {
+			dial = deps.VMService.OpenVNCStream
+		}
+		vncProxy = service.NewVNCProxyWorker(dial, deps.VNCMaxSessionsPerVM)
+	}
+	batchEventsInterval := deps.BatchEventsInterval
+	if batchEventsInterval <= 0 {
+		batchEventsInterval = defaultBatchEventsInterval
+	}
+	auditExportMaxRows := deps.AuditExportMaxRows
+	if auditExportMaxRows <= 0 {
+		auditExportMaxRows = audit.DefaultExportMaxRows
+	}
+	probeKube := deps.KubeconfigProbe
+	if probeKube == nil {
+		probeKube = provider.ProbeKubeconfig
+	}
+	passwordPolicy := service.DefaultPasswordPolicy()
+	if deps.PasswordPolicy != nil {
+		passwordPolicy = deps.PasswordPolicy.WithDefaults()
+	}
+	vncMaxAccessDuration := deps.VNCMaxAccessDuration
+	if vncMaxAccessDuration <= 0 {
+		vncMaxAccessDuration = service.DefaultVNCMaxAccessDuration
+	}
 
 	return &Server{
-		client:      deps.EntClient,
-		pool:        deps.Pool,
-		jwtCfg:      deps.JWTCfg,
-		audit:       deps.Audit,
-		vmService:   deps.VMService,
-		vncTokens:   vncTokens,
-		createVMUC:  deps.CreateVMUC,
-		deleteVMUC:  deps.DeleteVMUC,
-		gateway:     deps.Gateway,
-		riverClient: deps.RiverClient,
-		notifier:    deps.Notifier,
+		client:        deps.EntClient,
+		pool:          deps.Pool,
+		jwtCfg:        deps.JWTCfg,
+		audit:         deps.Audit,
+		vmService:     deps.VMService,
+		vncTokens:     vncTokens,
+		vncProxy:      vncProxy,
+		createVMUC:    deps.CreateVMUC,
+		deleteVMUC:    deps.DeleteVMUC,
+		migrateVMUC:   deps.MigrateVMUC,
+		resizeVMUC:    deps.ResizeVMUC,
+		snapshotVMUC:  deps.SnapshotVMUC,
+		replayEventUC: deps.ReplayEventUC,
+		gateway:       deps.Gateway,
+		riverClient:   deps.RiverClient,
+		notifier:      deps.Notifier,
+		healthReport:  deps.HealthReport,
+		probeKube:     probeKube,
+		loginSessions: service.NewLoginSessionStore(deps.EntClient),
+		loginThrottle: service.NewLoginThrottle(deps.EntClient, deps.LoginLockout),
+		refreshTokens: service.NewRefreshTokenStore(deps.EntClient, service.DefaultRefreshTokenTTL),
+		permissions:   service.NewPermissionStore(deps.EntClient),
+
+		passwordPolicy:  passwordPolicy,
+		passwordHistory: service.NewPasswordHistory(deps.EntClient, passwordPolicy.HistorySize),
+
+		permissionMatrix: &permissionMatrixCache{},
+
+		batchEventsInterval:  batchEventsInterval,
+		auditExportMaxRows:   auditExportMaxRows,
+		vncMaxAccessDuration: vncMaxAccessDuration,
+		requirePowerApproval: deps.RequirePowerApproval,
 	}
 }
 
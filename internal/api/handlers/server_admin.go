@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -13,9 +14,13 @@ import (
 	"kv-shepherd.io/shepherd/ent/auditlog"
 	"kv-shepherd.io/shepherd/ent/cluster"
 	"kv-shepherd.io/shepherd/ent/instancesize"
+	"kv-shepherd.io/shepherd/ent/predicate"
 	enttemplate "kv-shepherd.io/shepherd/ent/template"
 	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/api/middleware"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
 	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/provider"
 )
 
 // kubeConfig is a minimal struct for parsing kubeconfig YAML.
@@ -111,6 +116,11 @@ func (s *Server) CreateCluster(c *gin.Context) {
 		})
 		return
 	}
+	if !req.SkipConnectivityCheck {
+		if _, ok := s.probeClusterKubeconfig(c, req.Kubeconfig, apiServerURL); !ok {
+			return
+		}
+	}
 
 	id, _ := uuid.NewV7()
 	create := s.client.Cluster.Create().
@@ -139,7 +149,11 @@ func (s *Server) CreateCluster(c *gin.Context) {
 	}
 
 	if s.audit != nil {
-		if err := s.audit.LogAction(ctx, "cluster.create", "cluster", cl.ID, actor, nil); err != nil {
+		var details map[string]interface{}
+		if req.SkipConnectivityCheck {
+			details = map[string]interface{}{"connectivity_check_skipped": true}
+		}
+		if err := s.audit.LogAction(ctx, "cluster.create", "cluster", cl.ID, actor, details); err != nil {
 			logger.Warn("audit log write failed",
 				zap.Error(err),
 				zap.String("action", "cluster.create"),
@@ -164,7 +178,11 @@ func (s *Server) UpdateClusterEnvironment(c *gin.Context, clusterId string) {
 		return
 	}
 
-	cl, err := s.client.Cluster.UpdateOneID(clusterId).
+	before, ok := s.getClusterForUpdate(c, clusterId)
+	if !ok {
+		return
+	}
+	cl, err := before.Update().
 		SetEnvironment(cluster.Environment(req.Environment)).
 		Save(ctx)
 	if err != nil {
@@ -180,12 +198,216 @@ func (s *Server) UpdateClusterEnvironment(c *gin.Context, clusterId string) {
 	if s.audit != nil {
 		_ = s.audit.LogAction(ctx, "cluster.update_environment", "cluster", cl.ID, actor, map[string]interface{}{
 			"environment": string(req.Environment),
+			"changes":     audit.Diff(before, cl),
 		})
 	}
 
 	c.JSON(http.StatusOK, clusterToAPI(cl))
 }
 
+// GetClusterCapacity handles GET /admin/clusters/{cluster_id}/capacity.
+func (s *Server) GetClusterCapacity(c *gin.Context, clusterId string) {
+	ctx, _, ok := requireActorWithAnyGlobalPermission(c, "cluster:read", "cluster:manage")
+	if !ok {
+		return
+	}
+
+	exists, err := s.client.Cluster.Query().Where(cluster.IDEQ(clusterId)).Exist(ctx)
+	if err != nil {
+		logger.Error("failed to look up cluster", zap.Error(err), zap.String("cluster_id", clusterId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if !exists {
+		c.JSON(http.StatusNotFound, generated.Error{Code: "CLUSTER_NOT_FOUND"})
+		return
+	}
+	if s.vmService == nil {
+		c.JSON(http.StatusServiceUnavailable, generated.Error{Code: "CLUSTER_UNAVAILABLE", Message: "no infrastructure provider configured"})
+		return
+	}
+
+	report, err := s.vmService.GetClusterCapacity(ctx, s.client, clusterId)
+	if err != nil {
+		logger.Warn("failed to read cluster capacity", zap.Error(err), zap.String("cluster_id", clusterId))
+		c.JSON(http.StatusServiceUnavailable, generated.Error{Code: "CLUSTER_UNAVAILABLE"})
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.ClusterCapacityReport{
+		TotalCpuCores:       report.TotalCPUCores,
+		AllocatableCpuCores: report.AllocatableCPUCores,
+		TotalMemoryMb:       report.TotalMemoryMB,
+		AllocatableMemoryMb: report.AllocatableMemoryMB,
+		VmCount:             report.VMCount,
+		NodeCount:           report.NodeCount,
+	})
+}
+
+type clusterUpdateRequest struct {
+	TotalCPUCores         *int     `json:"total_cpu_cores"`
+	TotalMemoryMB         *int     `json:"total_memory_mb"`
+	CPUOvercommitRatio    *float64 `json:"cpu_overcommit_ratio"`
+	MemoryOvercommitRatio *float64 `json:"memory_overcommit_ratio"`
+	Kubeconfig            []byte   `json:"kubeconfig"`
+	SkipConnectivityCheck bool     `json:"skip_connectivity_check"`
+}
+
+// UpdateCluster handles PATCH /admin/clusters/{cluster_id}.
+// Sets approval-time capacity; a total of 0 exempts that resource. A new
+// kubeconfig must reach its API server unless skip_connectivity_check is set.
+func (s *Server) UpdateCluster(c *gin.Context, clusterId string) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "cluster:write", "cluster:manage")
+	if !ok {
+		return
+	}
+
+	var req clusterUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+	if (req.TotalCPUCores != nil && *req.TotalCPUCores < 0) ||
+		(req.TotalMemoryMB != nil && *req.TotalMemoryMB < 0) {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "capacity totals must be >= 0"})
+		return
+	}
+	if (req.CPUOvercommitRatio != nil && *req.CPUOvercommitRatio <= 0) ||
+		(req.MemoryOvercommitRatio != nil && *req.MemoryOvercommitRatio <= 0) {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "overcommit ratios must be > 0"})
+		return
+	}
+
+	before, ok := s.getClusterForUpdate(c, clusterId)
+	if !ok {
+		return
+	}
+	update := before.Update()
+	if req.Kubeconfig != nil {
+		apiServerURL, err := parseAPIServerURL(req.Kubeconfig)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_KUBECONFIG", Message: err.Error()})
+			return
+		}
+		if !req.SkipConnectivityCheck {
+			if _, ok := s.probeClusterKubeconfig(c, req.Kubeconfig, apiServerURL); !ok {
+				return
+			}
+		}
+		update = update.
+			SetAPIServerURL(apiServerURL).
+			SetEncryptedKubeconfig(req.Kubeconfig). // V1: stored as plaintext, as in CreateCluster
+			ClearEncryptionKeyID()
+	}
+	if req.TotalCPUCores != nil {
+		update = update.SetTotalCPUCores(*req.TotalCPUCores)
+	}
+	if req.TotalMemoryMB != nil {
+		update = update.SetTotalMemoryMB(*req.TotalMemoryMB)
+	}
+	if req.CPUOvercommitRatio != nil {
+		update = update.SetCPUOvercommitRatio(*req.CPUOvercommitRatio)
+	}
+	if req.MemoryOvercommitRatio != nil {
+		update = update.SetMemoryOvercommitRatio(*req.MemoryOvercommitRatio)
+	}
+
+	cl, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "CLUSTER_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to update cluster", zap.Error(err), zap.String("cluster_id", clusterId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		details := audit.ChangeDetails(before, cl)
+		if req.Kubeconfig != nil {
+			details["kubeconfig_replaced"] = true
+			details["connectivity_check_skipped"] = req.SkipConnectivityCheck
+		}
+		_ = s.audit.LogAction(ctx, "cluster.update", "cluster", cl.ID, actor, details)
+	}
+
+	c.JSON(http.StatusOK, clusterToAPI(cl))
+}
+
+// ValidateClusterKubeconfig handles POST /admin/clusters/{cluster_id}/validate-kubeconfig.
+// It only probes the kubeconfig; nothing is stored.
+func (s *Server) ValidateClusterKubeconfig(c *gin.Context, clusterId string) {
+	ctx, _, ok := requireActorWithAnyGlobalPermission(c, "cluster:write", "cluster:manage")
+	if !ok {
+		return
+	}
+
+	var req generated.ClusterKubeconfigValidationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+	exists, err := s.client.Cluster.Query().Where(cluster.IDEQ(clusterId)).Exist(ctx)
+	if err != nil {
+		logger.Error("failed to look up cluster", zap.Error(err), zap.String("cluster_id", clusterId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if !exists {
+		c.JSON(http.StatusNotFound, generated.Error{Code: "CLUSTER_NOT_FOUND"})
+		return
+	}
+	apiServerURL, err := parseAPIServerURL(req.Kubeconfig)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_KUBECONFIG", Message: err.Error()})
+		return
+	}
+
+	version, ok := s.probeClusterKubeconfig(c, req.Kubeconfig, apiServerURL)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, generated.ClusterKubeconfigValidation{Reachable: true, ServerVersion: version})
+}
+
+// probeClusterKubeconfig checks that kubeconfig reaches its API server and
+// returns the server version, writing 400 INVALID_KUBECONFIG or
+// 422 CLUSTER_UNREACHABLE on failure.
+func (s *Server) probeClusterKubeconfig(c *gin.Context, kubeconfig []byte, apiServerURL string) (string, bool) {
+	version, err := s.probeKube(c.Request.Context(), kubeconfig)
+	if err == nil {
+		return version, true
+	}
+	if errors.Is(err, provider.ErrInvalidKubeconfig) {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_KUBECONFIG", Message: err.Error()})
+		return "", false
+	}
+	logger.Warn("kubeconfig connectivity check failed", zap.Error(err), zap.String("api_server_url", apiServerURL))
+	c.JSON(http.StatusUnprocessableEntity, generated.Error{
+		Code:    "CLUSTER_UNREACHABLE",
+		Message: err.Error(),
+		Params:  map[string]interface{}{"api_server_url": apiServerURL},
+	})
+	return "", false
+}
+
+// getClusterForUpdate loads a cluster ahead of an update so the audit entry
+// can record what changed, writing 404/500 on failure.
+func (s *Server) getClusterForUpdate(c *gin.Context, clusterID string) (*ent.Cluster, bool) {
+	cl, err := s.client.Cluster.Get(c.Request.Context(), clusterID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "CLUSTER_NOT_FOUND"})
+			return nil, false
+		}
+		logger.Error("failed to get cluster for update", zap.Error(err), zap.String("cluster_id", clusterID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return nil, false
+	}
+	return cl, true
+}
+
 // ListTemplates handles GET /templates.
 func (s *Server) ListTemplates(c *gin.Context, params generated.ListTemplatesParams) {
 	if !requireAnyGlobalPermission(c, "vm:create", "template:read", "template:manage") {
@@ -195,6 +417,9 @@ func (s *Server) ListTemplates(c *gin.Context, params generated.ListTemplatesPar
 
 	query := s.client.Template.Query().
 		Where(enttemplate.EnabledEQ(true))
+	if !params.IncludeDeprecated {
+		query = query.Where(enttemplate.DeprecatedAtIsNil())
+	}
 
 	page, perPage := defaultPagination(params.Page, params.PerPage)
 	offset := (page - 1) * perPage
@@ -234,7 +459,7 @@ func (s *Server) ListTemplates(c *gin.Context, params generated.ListTemplatesPar
 }
 
 // ListInstanceSizes handles GET /instance-sizes.
-func (s *Server) ListInstanceSizes(c *gin.Context) {
+func (s *Server) ListInstanceSizes(c *gin.Context, params generated.ListInstanceSizesParams) {
 	if !requireAnyGlobalPermission(c, "vm:create", "instance_size:read", "instance_size:write") {
 		return
 	}
@@ -242,7 +467,8 @@ func (s *Server) ListInstanceSizes(c *gin.Context) {
 
 	sizes, err := s.client.InstanceSize.Query().
 		Where(instancesize.EnabledEQ(true)).
-		Order(ent.Asc(instancesize.FieldSortOrder)).
+		Where(instanceSizeFilters(params.RequiresGpu, params.RequiresSriov, params.DedicatedCpu, params.Search)...).
+		Order(ent.Asc(instancesize.FieldSortOrder), ent.Asc(instancesize.FieldName)).
 		All(ctx)
 	if err != nil {
 		logger.Error("failed to list instance sizes", zap.Error(err))
@@ -260,29 +486,57 @@ func (s *Server) ListInstanceSizes(c *gin.Context) {
 	})
 }
 
-// ListAuditLogs handles GET /audit-logs.
+// ListAuditLogs handles GET /audit-logs: the current user's own audit logs.
 func (s *Server) ListAuditLogs(c *gin.Context, params generated.ListAuditLogsParams) {
-	if !requireGlobalPermission(c, "audit:read") {
+	userID := middleware.GetUserID(c.Request.Context())
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, generated.Error{Code: "UNAUTHORIZED"})
 		return
 	}
-	ctx := c.Request.Context()
 
-	query := s.client.AuditLog.Query()
+	filter := audit.ExportFilter{
+		From:         params.From,
+		To:           params.To,
+		Action:       params.Action,
+		Actor:        params.Actor,
+		ResourceType: params.ResourceType,
+		ResourceID:   params.ResourceId,
+	}
+	s.listAuditLogs(c, filter, auditlog.ActorEQ(userID), params.Page, params.PerPage)
+}
 
-	if params.Action != "" {
-		query = query.Where(auditlog.ActionEQ(params.Action))
+// ListAdminAuditLogs handles GET /admin/audit-logs: every user's audit logs.
+func (s *Server) ListAdminAuditLogs(c *gin.Context, params generated.ListAdminAuditLogsParams) {
+	if !requireGlobalPermission(c, "audit:read") {
+		return
 	}
-	if params.Actor != "" {
-		query = query.Where(auditlog.ActorEQ(params.Actor))
+
+	filter := audit.ExportFilter{
+		From:         params.From,
+		To:           params.To,
+		Action:       params.Action,
+		Actor:        params.Actor,
+		ResourceType: params.ResourceType,
+		ResourceID:   params.ResourceId,
 	}
-	if params.ResourceType != "" {
-		query = query.Where(auditlog.ResourceTypeEQ(params.ResourceType))
+	s.listAuditLogs(c, filter, nil, params.Page, params.PerPage)
+}
+
+// listAuditLogs writes one page of audit logs matching filter and, when set,
+// scope, newest first.
+func (s *Server) listAuditLogs(c *gin.Context, filter audit.ExportFilter, scope predicate.AuditLog, pageParam, perPageParam int) {
+	ctx := c.Request.Context()
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "from must be before to"})
+		return
 	}
-	if params.ResourceId != "" {
-		query = query.Where(auditlog.ResourceIDEQ(params.ResourceId))
+
+	query := s.client.AuditLog.Query().Where(filter.Predicates()...)
+	if scope != nil {
+		query = query.Where(scope)
 	}
 
-	page, perPage := defaultPagination(params.Page, params.PerPage)
+	page, perPage := defaultPagination(pageParam, perPageParam)
 	offset := (page - 1) * perPage
 
 	total, err := query.Clone().Count(ctx)
@@ -295,7 +549,7 @@ func (s *Server) ListAuditLogs(c *gin.Context, params generated.ListAuditLogsPar
 	logs, err := query.
 		Offset(offset).
 		Limit(perPage).
-		Order(ent.Desc(auditlog.FieldCreatedAt)).
+		Order(ent.Desc(auditlog.FieldCreatedAt), ent.Desc(auditlog.FieldID)).
 		All(ctx)
 	if err != nil {
 		logger.Error("failed to list audit logs", zap.Error(err), zap.Int("page", page))
@@ -305,14 +559,7 @@ func (s *Server) ListAuditLogs(c *gin.Context, params generated.ListAuditLogsPar
 
 	items := make([]generated.AuditLog, 0, len(logs))
 	for _, l := range logs {
-		items = append(items, generated.AuditLog{
-			Id:           l.ID,
-			Action:       l.Action,
-			Actor:        l.Actor,
-			ResourceType: l.ResourceType,
-			ResourceId:   l.ResourceID,
-			CreatedAt:    l.CreatedAt,
-		})
+		items = append(items, auditLogToAPI(l))
 	}
 
 	totalPages := (total + perPage - 1) / perPage
@@ -330,22 +577,32 @@ func (s *Server) ListAuditLogs(c *gin.Context, params generated.ListAuditLogsPar
 // ---- Converters ----
 
 func clusterToAPI(cl *ent.Cluster) generated.Cluster {
-	return generated.Cluster{
-		Id:              cl.ID,
-		Name:            cl.Name,
-		DisplayName:     cl.DisplayName,
-		ApiServerUrl:    cl.APIServerURL,
-		Status:          generated.ClusterStatus(cl.Status),
-		Environment:     generated.ClusterEnvironment(cl.Environment),
-		KubevirtVersion: cl.KubevirtVersion,
-		StorageClasses:  cl.StorageClasses,
-		Enabled:         cl.Enabled,
-		CreatedAt:       cl.CreatedAt,
-	}
+	out := generated.Cluster{
+		Id:                    cl.ID,
+		Name:                  cl.Name,
+		DisplayName:           cl.DisplayName,
+		ApiServerUrl:          cl.APIServerURL,
+		Status:                generated.ClusterStatus(cl.Status),
+		Environment:           generated.ClusterEnvironment(cl.Environment),
+		KubevirtVersion:       cl.KubevirtVersion,
+		StorageClasses:        cl.StorageClasses,
+		Enabled:               cl.Enabled,
+		CreatedAt:             cl.CreatedAt,
+		TotalCpuCores:         cl.TotalCPUCores,
+		TotalMemoryMb:         cl.TotalMemoryMB,
+		CpuOvercommitRatio:    cl.CPUOvercommitRatio,
+		MemoryOvercommitRatio: cl.MemoryOvercommitRatio,
+		HealthCheckFailures:   cl.HealthCheckFailures,
+		HealthMessage:         cl.HealthMessage,
+	}
+	if cl.LastHealthCheckAt != nil {
+		out.LastHealthCheckAt = *cl.LastHealthCheckAt
+	}
+	return out
 }
 
 func templateToAPI(t *ent.Template) generated.Template {
-	return generated.Template{
+	out := generated.Template{
 		Id:          t.ID,
 		Name:        t.Name,
 		DisplayName: t.DisplayName,
@@ -355,6 +612,13 @@ func templateToAPI(t *ent.Template) generated.Template {
 		Version:     t.Version,
 		Enabled:     t.Enabled,
 	}
+	if t.DeprecatedAt != nil {
+		out.DeprecatedAt = *t.DeprecatedAt
+	}
+	if t.ParentTemplateID != nil {
+		out.ParentId = *t.ParentTemplateID
+	}
+	return out
 }
 
 func instanceSizeToAPI(sz *ent.InstanceSize) generated.InstanceSize {
@@ -0,0 +1,218 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	entuser "kv-shepherd.io/shepherd/ent/user"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	providerregistry "kv-shepherd.io/shepherd/internal/provider"
+)
+
+const (
+	// authProviderImportMaxUsers caps the entries of one import request.
+	authProviderImportMaxUsers = 500
+	// authProviderImportProgressEvery is the number of entries between
+	// streamed progress lines.
+	authProviderImportProgressEvery = 50
+)
+
+// authProviderImportProgress is a streamed progress line.
+type authProviderImportProgress struct {
+	Processed int `json:"processed"`
+	Total     int `json:"total"`
+}
+
+// authProviderImportSeen tracks the keys used by earlier entries of one
+// request, so duplicates fail as entries instead of aborting the import.
+type authProviderImportSeen struct {
+	externalIDs map[string]struct{}
+	usernames   map[string]struct{}
+	emails      map[string]struct{}
+}
+
+// ImportAuthProviderUsers handles POST /admin/auth-providers/{provider_id}/import-users.
+func (s *Server) ImportAuthProviderUsers(c *gin.Context, providerId generated.ProviderID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "auth_provider:configure", "auth_provider:manage")
+	if !ok {
+		return
+	}
+
+	var req generated.ImportAuthProviderUsersJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+	if len(req) == 0 || len(req) > authProviderImportMaxUsers {
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:    "INVALID_REQUEST",
+			Message: fmt.Sprintf("import between 1 and %d users per request", authProviderImportMaxUsers),
+		})
+		return
+	}
+
+	if _, err := s.client.AuthProvider.Get(ctx, providerId); err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "AUTH_PROVIDER_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to get auth provider for user import", zap.Error(err), zap.String("provider_id", providerId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	stream := strings.Contains(c.GetHeader("Accept"), "application/x-ndjson")
+	encoder := json.NewEncoder(c.Writer)
+	started := false
+	writeLine := func(v any) error {
+		if !started {
+			started = true
+			c.Header("Content-Type", "application/x-ndjson")
+			c.Header("Cache-Control", "no-store")
+			c.Status(http.StatusOK)
+		}
+		if err := encoder.Encode(v); err != nil {
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	}
+	fail := func(err error) {
+		logger.Error("auth provider user import failed", zap.Error(err), zap.String("provider_id", providerId))
+		if !started {
+			c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+			return
+		}
+		// Headers are already on the wire; the error line tells the client
+		// that nothing was imported.
+		_ = writeLine(generated.Error{Code: "INTERNAL_ERROR", Message: "import rolled back"})
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		fail(fmt.Errorf("start import transaction: %w", err))
+		return
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	result := generated.AuthProviderUserImportResult{Errors: []generated.AuthProviderUserImportError{}}
+	seen := authProviderImportSeen{
+		externalIDs: make(map[string]struct{}, len(req)),
+		usernames:   make(map[string]struct{}, len(req)),
+		emails:      make(map[string]struct{}, len(req)),
+	}
+	for i, item := range req {
+		created, problem, err := importAuthProviderUser(ctx, tx.Client(), providerId, item, seen)
+		if err != nil {
+			fail(fmt.Errorf("import entry %d: %w", i, err))
+			return
+		}
+		switch {
+		case problem != "":
+			result.Failed++
+			result.Errors = append(result.Errors, generated.AuthProviderUserImportError{Index: i, Message: problem})
+		case created:
+			result.Created++
+		default:
+			result.Updated++
+		}
+		if stream && ((i+1)%authProviderImportProgressEvery == 0 || i+1 == len(req)) {
+			if err := writeLine(authProviderImportProgress{Processed: i + 1, Total: len(req)}); err != nil {
+				fail(fmt.Errorf("write import progress: %w", err))
+				return
+			}
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		fail(fmt.Errorf("commit import transaction: %w", err))
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "auth_provider.users_imported", "auth_provider", providerId, actor, map[string]interface{}{
+			"created": result.Created,
+			"updated": result.Updated,
+			"failed":  result.Failed,
+		})
+	}
+
+	if stream {
+		if err := writeLine(result); err != nil {
+			logger.Error("failed to write import result", zap.Error(err), zap.String("provider_id", providerId))
+		}
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// importAuthProviderUser upserts one import entry and grants its group
+// mappings. A non-empty problem means the entry was skipped; err is a
+// database failure that aborts the whole import.
+func importAuthProviderUser(ctx context.Context, client *ent.Client, providerID string, item generated.AuthProviderUserImport, seen authProviderImportSeen) (created bool, problem string, err error) {
+	identity := &providerregistry.AuthResult{
+		Username:    strings.TrimSpace(item.Username),
+		Email:       strings.TrimSpace(item.Email),
+		DisplayName: strings.TrimSpace(item.DisplayName),
+		ExternalID:  strings.TrimSpace(item.ExternalId),
+		Groups:      item.Groups,
+	}
+	switch {
+	case identity.Username == "":
+		return false, "username is required", nil
+	case identity.ExternalID == "":
+		return false, "external_id is required", nil
+	}
+	if _, dup := seen.externalIDs[identity.ExternalID]; dup {
+		return false, "duplicate external_id in request", nil
+	}
+	if _, dup := seen.usernames[identity.Username]; dup {
+		return false, "duplicate username in request", nil
+	}
+	if _, dup := seen.emails[identity.Email]; dup && identity.Email != "" {
+		return false, "duplicate email in request", nil
+	}
+
+	// A username or email held by any user other than the linked one would
+	// violate a unique index and abort the transaction; check first.
+	notLinked := entuser.Not(entuser.And(
+		entuser.AuthProviderIDEQ(providerID),
+		entuser.ExternalIDEQ(identity.ExternalID),
+	))
+	taken, err := client.User.Query().Where(entuser.UsernameEQ(identity.Username), notLinked).Exist(ctx)
+	if err != nil {
+		return false, "", fmt.Errorf("check username: %w", err)
+	}
+	if taken {
+		return false, "username already in use by another user", nil
+	}
+	if identity.Email != "" {
+		if taken, err = client.User.Query().Where(entuser.EmailEQ(identity.Email), notLinked).Exist(ctx); err != nil {
+			return false, "", fmt.Errorf("check email: %w", err)
+		}
+		if taken {
+			return false, "email already in use by another user", nil
+		}
+	}
+
+	user, created, err := upsertExternalUser(ctx, client, providerID, identity)
+	if err != nil {
+		return false, "", err
+	}
+	if err := applyIdPGroupMappings(ctx, client, providerID, user.ID, identity.Groups); err != nil {
+		return false, "", err
+	}
+	seen.externalIDs[identity.ExternalID] = struct{}{}
+	seen.usernames[identity.Username] = struct{}{}
+	if identity.Email != "" {
+		seen.emails[identity.Email] = struct{}{}
+	}
+	return created, "", nil
+}
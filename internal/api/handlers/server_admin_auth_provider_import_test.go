@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/ent/auditlog"
+	"kv-shepherd.io/shepherd/ent/rolebinding"
+	entuser "kv-shepherd.io/shepherd/ent/user"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestImportAuthProviderUsers(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	client := testutil.OpenEntPostgres(t, "auth_provider_import_users")
+	srv := NewServer(ServerDeps{EntClient: client, Audit: audit.NewLogger(client)})
+	ctx := t.Context()
+	client.AuthProvider.Create().
+		SetID("provider-1").
+		SetName("corp-oidc").
+		SetAuthType("oidc").
+		SetConfig(map[string]interface{}{}).
+		SetCreatedBy("admin-1").
+		SaveX(ctx)
+	client.Role.Create().SetID("role-dev").SetName("Developer").SetPermissions([]string{"vm:read"}).SaveX(ctx)
+	client.IdPGroupMapping.Create().
+		SetID("mapping-1").
+		SetProviderID("provider-1").
+		SetExternalGroupID("devs").
+		SetRoleID("role-dev").
+		SetCreatedBy("admin-1").
+		SaveX(ctx)
+	mustCreateUser(t, client, "local-1", "taken")
+	client.User.Create().
+		SetID("linked-1").
+		SetUsername("carol").
+		SetEmail("carol-old@example.com").
+		SetAuthProviderID("provider-1").
+		SetExternalID("ext-carol").
+		SaveX(ctx)
+
+	c, w := newAuthedGinContext(t, http.MethodPost, "/api/v1/admin/auth-providers/provider-1/import-users",
+		`[{"username":"x","external_id":"x"}]`, "admin-1", []string{"auth_provider:read"})
+	srv.ImportAuthProviderUsers(c, "provider-1")
+	assertStatusAndCode(t, w, http.StatusForbidden, "FORBIDDEN")
+
+	c, w = newAuthedGinContext(t, http.MethodPost, "/api/v1/admin/auth-providers/missing/import-users",
+		`[{"username":"x","external_id":"x"}]`, "admin-1", []string{"auth_provider:configure"})
+	srv.ImportAuthProviderUsers(c, "missing")
+	assertStatusAndCode(t, w, http.StatusNotFound, "AUTH_PROVIDER_NOT_FOUND")
+
+	tooMany := make([]string, authProviderImportMaxUsers+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf(`{"username":"u%d","external_id":"e%d"}`, i, i)
+	}
+	c, w = newAuthedGinContext(t, http.MethodPost, "/api/v1/admin/auth-providers/provider-1/import-users",
+		"["+strings.Join(tooMany, ",")+"]", "admin-1", []string{"auth_provider:configure"})
+	srv.ImportAuthProviderUsers(c, "provider-1")
+	assertStatusAndCode(t, w, http.StatusBadRequest, "INVALID_REQUEST")
+
+	c, w = newAuthedGinContext(t, http.MethodPost, "/api/v1/admin/auth-providers/provider-1/import-users", `[
+		{"username":"alice","email":"alice@example.com","external_id":"ext-alice","groups":["devs","unmapped"]},
+		{"username":"carol","email":"carol@example.com","external_id":"ext-carol","groups":["devs"]},
+		{"username":"taken","external_id":"ext-taken"},
+		{"username":"alice2","external_id":"ext-alice"},
+		{"username":"","external_id":"ext-blank"}
+	]`, "admin-1", []string{"auth_provider:configure"})
+	srv.ImportAuthProviderUsers(c, "provider-1")
+	if w.Code != http.StatusOK {
+		t.Fatalf("import status = %d, want 200, body=%s", w.Code, w.Body.String())
+	}
+	var result generated.AuthProviderUserImportResult
+	mustDecodeJSON(t, w.Body.Bytes(), &result)
+	if result.Created != 1 || result.Updated != 1 || result.Failed != 3 {
+		t.Fatalf("result = %+v, want 1 created, 1 updated, 3 failed", result)
+	}
+	wantErrors := map[int]string{
+		2: "username already in use by another user",
+		3: "duplicate external_id in request",
+		4: "username is required",
+	}
+	for _, e := range result.Errors {
+		if wantErrors[e.Index] != e.Message {
+			t.Fatalf("error for entry %d = %q, want %q", e.Index, e.Message, wantErrors[e.Index])
+		}
+	}
+
+	alice := client.User.Query().Where(entuser.ExternalIDEQ("ext-alice")).OnlyX(ctx)
+	if alice.Username != "alice" || alice.AuthProviderID != "provider-1" || alice.Email != "alice@example.com" {
+		t.Fatalf("imported alice = %+v", alice)
+	}
+	if carol := client.User.GetX(ctx, "linked-1"); carol.Email != "carol@example.com" {
+		t.Fatalf("carol email = %q, want refreshed", carol.Email)
+	}
+	for _, userID := range []string{alice.ID, "linked-1"} {
+		n := client.RoleBinding.Query().Where(rolebinding.HasUserWith(entuser.IDEQ(userID))).CountX(ctx)
+		if n != 1 {
+			t.Fatalf("user %s role bindings = %d, want 1 from the devs mapping", userID, n)
+		}
+	}
+	if n := client.AuditLog.Query().Where(auditlog.ActionEQ("auth_provider.users_imported")).CountX(ctx); n != 1 {
+		t.Fatalf("auth_provider.users_imported audit entries = %d, want 1", n)
+	}
+
+	// Streamed imports emit progress lines, then the result.
+	entries := make([]string, 60)
+	for i := range entries {
+		entries[i] = fmt.Sprintf(`{"username":"bulk-%d","external_id":"bulk-%d"}`, i, i)
+	}
+	c, w = newAuthedGinContext(t, http.MethodPost, "/api/v1/admin/auth-providers/provider-1/import-users",
+		"["+strings.Join(entries, ",")+"]", "admin-1", []string{"auth_provider:manage"})
+	c.Request.Header.Set("Accept", "application/x-ndjson")
+	srv.ImportAuthProviderUsers(c, "provider-1")
+	if w.Code != http.StatusOK || !strings.HasPrefix(w.Header().Get("Content-Type"), "application/x-ndjson") {
+		t.Fatalf("streamed import status = %d content-type = %q, body=%s", w.Code, w.Header().Get("Content-Type"), w.Body.String())
+	}
+	lines := decodeNDJSONLines(t, w.Body.String())
+	if len(lines) != 3 {
+		t.Fatalf("streamed lines = %v, want two progress lines and the result", lines)
+	}
+	if lines[0]["processed"] != float64(50) || lines[1]["processed"] != float64(60) || lines[1]["total"] != float64(60) {
+		t.Fatalf("progress lines = %v, want 50/60 then 60/60", lines[:2])
+	}
+	if lines[2]["created"] != float64(60) || lines[2]["failed"] != float64(0) {
+		t.Fatalf("result line = %v, want 60 created", lines[2])
+	}
+}
+
+func decodeNDJSONLines(t *testing.T, body string) []map[string]any {
+	t.Helper()
+	var lines []map[string]any
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	for scanner.Scan() {
+		var line map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatalf("decode ndjson line %q: %v", scanner.Text(), err)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
@@ -2,8 +2,12 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"maps"
 	"net/http"
+	"reflect"
 	"regexp"
 	"sort"
 	"strings"
@@ -18,17 +22,25 @@ import (
 	"kv-shepherd.io/shepherd/ent/idpgroupmapping"
 	"kv-shepherd.io/shepherd/ent/idpsyncedgroup"
 	"kv-shepherd.io/shepherd/ent/instancesize"
+	"kv-shepherd.io/shepherd/ent/instancesizecluster"
+	"kv-shepherd.io/shepherd/ent/predicate"
 	"kv-shepherd.io/shepherd/ent/role"
 	"kv-shepherd.io/shepherd/ent/rolebinding"
 	enttemplate "kv-shepherd.io/shepherd/ent/template"
 	entuser "kv-shepherd.io/shepherd/ent/user"
 	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/governance/approval"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/jobs"
+	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
 	"kv-shepherd.io/shepherd/internal/pkg/logger"
 	providerregistry "kv-shepherd.io/shepherd/internal/provider"
+	"kv-shepherd.io/shepherd/internal/service"
 )
 
 type templateCreateRequest struct {
 	Name        string                 `json:"name" binding:"required"`
+	ParentID    *string                `json:"parent_id"`
 	DisplayName *string                `json:"display_name"`
 	Description *string                `json:"description"`
 	Version     *int                   `json:"version"`
@@ -101,18 +113,20 @@ type roleUpdateRequest struct {
 }
 
 type authProviderCreateRequest struct {
-	Name      string                 `json:"name" binding:"required"`
-	AuthType  string                 `json:"auth_type" binding:"required"`
-	Config    map[string]interface{} `json:"config"`
-	Enabled   *bool                  `json:"enabled"`
-	SortOrder *int                   `json:"sort_order"`
+	Name         string                 `json:"name" binding:"required"`
+	AuthType     string                 `json:"auth_type" binding:"required"`
+	Config       map[string]interface{} `json:"config"`
+	Enabled      *bool                  `json:"enabled"`
+	SortOrder    *int                   `json:"sort_order"`
+	SyncSchedule string                 `json:"sync_schedule"`
 }
 
 type authProviderUpdateRequest struct {
-	Name      *string                 `json:"name"`
-	Config    *map[string]interface{} `json:"config"`
-	Enabled   *bool                   `json:"enabled"`
-	SortOrder *int                    `json:"sort_order"`
+	Name         *string                 `json:"name"`
+	Config       *map[string]interface{} `json:"config"`
+	Enabled      *bool                   `json:"enabled"`
+	SortOrder    *int                    `json:"sort_order"`
+	SyncSchedule *string                 `json:"sync_schedule"`
 }
 
 var permissionKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*:[a-z][a-z0-9_]*$`)
@@ -168,6 +182,9 @@ func (s *Server) ListAdminTemplates(c *gin.Context, params generated.ListAdminTe
 
 	query := s.client.Template.Query().
 		Order(ent.Desc(enttemplate.FieldUpdatedAt))
+	if !params.IncludeDeprecated {
+		query = query.Where(enttemplate.DeprecatedAtIsNil())
+	}
 
 	total, err := query.Clone().Count(ctx)
 	if err != nil {
@@ -200,8 +217,37 @@ func (s *Server) ListAdminTemplates(c *gin.Context, params generated.ListAdminTe
 	})
 }
 
+// GetAdminTemplate handles GET /admin/templates/{template_id}.
+func (s *Server) GetAdminTemplate(c *gin.Context, templateId generated.TemplateID) {
+	ctx, _, ok := requireActorWithAnyGlobalPermission(c, "template:read", "template:manage")
+	if !ok {
+		return
+	}
+
+	tpl, err := s.client.Template.Get(ctx, templateId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "TEMPLATE_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to get admin template", zap.Error(err), zap.String("template_id", templateId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	out := templateToAPI(tpl)
+	out.Spec = tpl.Spec
+	if tpl.ParentTemplateID != nil {
+		ancestors, ok := s.loadTemplateAncestors(c, tpl)
+		if !ok {
+			return
+		}
+		out.ResolvedSpec = approval.ResolveTemplateSpec(tpl, ancestors)
+	}
+	c.JSON(http.StatusOK, out)
+}
+
 // CreateAdminTemplate handles POST /admin/templates.
-func (s *Server) CreateAdminTemplate(c *gin.Context) {
+func (s *Server) CreateAdminTemplate(c *gin.Context, params generated.CreateAdminTemplateParams) {
 	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "template:write", "template:manage")
 	if !ok {
 		return
@@ -219,6 +265,26 @@ func (s *Server) CreateAdminTemplate(c *gin.Context) {
 		return
 	}
 
+	id, _ := uuid.NewV7()
+	var parentID *string
+	if req.ParentID != nil {
+		if v := strings.TrimSpace(*req.ParentID); v != "" {
+			parentID = &v
+		}
+	}
+	draft := &ent.Template{ID: id.String(), ParentTemplateID: parentID, Spec: req.Spec}
+	ancestors, ok := s.loadTemplateAncestors(c, draft)
+	if !ok {
+		return
+	}
+	if req.Spec != nil && !validateTemplateSpecRequest(c, approval.ResolveTemplateSpec(draft, ancestors)) {
+		return
+	}
+	if params.ValidateOnly {
+		c.JSON(http.StatusOK, generated.TemplateValidationResult{Valid: true})
+		return
+	}
+
 	version := 1
 	if req.Version != nil {
 		if *req.Version < 1 {
@@ -227,24 +293,20 @@ func (s *Server) CreateAdminTemplate(c *gin.Context) {
 		}
 		version = *req.Version
 	} else {
-		latest, err := s.client.Template.Query().
-			Where(enttemplate.NameEQ(name)).
-			Order(ent.Desc(enttemplate.FieldVersion)).
-			First(ctx)
-		if err == nil {
-			version = latest.Version + 1
-		} else if err != nil && !ent.IsNotFound(err) {
+		next, err := s.nextTemplateVersion(ctx, name)
+		if err != nil {
 			logger.Error("failed to resolve latest template version", zap.Error(err), zap.String("name", name))
 			c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 			return
 		}
+		version = next
 	}
 
-	id, _ := uuid.NewV7()
 	create := s.client.Template.Create().
 		SetID(id.String()).
 		SetName(name).
 		SetVersion(version).
+		SetNillableParentTemplateID(parentID).
 		SetCreatedBy(actor)
 	if req.DisplayName != nil {
 		if v := strings.TrimSpace(*req.DisplayName); v != "" {
@@ -285,17 +347,21 @@ func (s *Server) CreateAdminTemplate(c *gin.Context) {
 	}
 
 	if s.audit != nil {
-		_ = s.audit.LogAction(ctx, "template.create", "template", tpl.ID, actor, map[string]interface{}{
+		details := map[string]interface{}{
 			"name":    tpl.Name,
 			"version": tpl.Version,
-		})
+		}
+		if tpl.ParentTemplateID != nil {
+			details["parent_template_id"] = *tpl.ParentTemplateID
+		}
+		_ = s.audit.LogAction(ctx, "template.create", "template", tpl.ID, actor, details)
 	}
 
 	c.JSON(http.StatusCreated, templateToAPI(tpl))
 }
 
 // UpdateAdminTemplate handles PATCH /admin/templates/{template_id}.
-func (s *Server) UpdateAdminTemplate(c *gin.Context, templateId generated.TemplateID) {
+func (s *Server) UpdateAdminTemplate(c *gin.Context, templateId generated.TemplateID, params generated.UpdateAdminTemplateParams) {
 	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "template:write", "template:manage")
 	if !ok {
 		return
@@ -306,8 +372,37 @@ func (s *Server) UpdateAdminTemplate(c *gin.Context, templateId generated.Templa
 		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
 		return
 	}
+	// The current version feeds both validation and the audit diff.
+	before, err := s.client.Template.Get(ctx, templateId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "TEMPLATE_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to get admin template for update", zap.Error(err), zap.String("template_id", templateId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if req.Spec != nil || params.ValidateOnly {
+		// Validate the effective spec, resolved over the parent chain.
+		candidate := *before
+		if req.Spec != nil {
+			candidate.Spec = *req.Spec
+		}
+		ancestors, ok := s.loadTemplateAncestors(c, &candidate)
+		if !ok {
+			return
+		}
+		if !validateTemplateSpecRequest(c, approval.ResolveTemplateSpec(&candidate, ancestors)) {
+			return
+		}
+	}
+	if params.ValidateOnly {
+		c.JSON(http.StatusOK, generated.TemplateValidationResult{Valid: true})
+		return
+	}
 
-	update := s.client.Template.UpdateOneID(templateId)
+	update := before.Update()
 	if req.DisplayName != nil {
 		if v := strings.TrimSpace(*req.DisplayName); v == "" {
 			update = update.ClearDisplayName()
@@ -355,18 +450,315 @@ func (s *Server) UpdateAdminTemplate(c *gin.Context, templateId generated.Templa
 	}
 
 	if s.audit != nil {
-		_ = s.audit.LogAction(ctx, "template.update", "template", tpl.ID, actor, nil)
+		_ = s.audit.LogAction(ctx, "template.update", "template", tpl.ID, actor, audit.ChangeDetails(before, tpl))
 	}
 
 	c.JSON(http.StatusOK, templateToAPI(tpl))
 }
 
+// CloneAdminTemplate handles POST /admin/templates/{template_id}/clone.
+// The clone keeps the source name, takes version = latest+1 and starts disabled
+// unless the optional override body says otherwise.
+func (s *Server) CloneAdminTemplate(c *gin.Context, templateId generated.TemplateID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "template:write", "template:manage")
+	if !ok {
+		return
+	}
+
+	var req templateUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+
+	source, err := s.client.Template.Get(ctx, templateId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "TEMPLATE_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to get source template for clone", zap.Error(err), zap.String("template_id", templateId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if req.Spec != nil {
+		draft := &ent.Template{ID: source.ID, ParentTemplateID: source.ParentTemplateID, Spec: *req.Spec}
+		ancestors, ok := s.loadTemplateAncestors(c, draft)
+		if !ok {
+			return
+		}
+		if !validateTemplateSpecRequest(c, approval.ResolveTemplateSpec(draft, ancestors)) {
+			return
+		}
+	}
+
+	version, err := s.nextTemplateVersion(ctx, source.Name)
+	if err != nil {
+		logger.Error("failed to resolve latest template version", zap.Error(err), zap.String("name", source.Name))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	displayName := source.DisplayName
+	if req.DisplayName != nil {
+		displayName = strings.TrimSpace(*req.DisplayName)
+	}
+	description := source.Description
+	if req.Description != nil {
+		description = strings.TrimSpace(*req.Description)
+	}
+	osFamily := source.OsFamily
+	if req.OsFamily != nil {
+		osFamily = strings.TrimSpace(*req.OsFamily)
+	}
+	osVersion := source.OsVersion
+	if req.OsVersion != nil {
+		osVersion = strings.TrimSpace(*req.OsVersion)
+	}
+	spec := source.Spec
+	if req.Spec != nil {
+		spec = *req.Spec
+	}
+	enabled := false
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+
+	id, _ := uuid.NewV7()
+	create := s.client.Template.Create().
+		SetID(id.String()).
+		SetName(source.Name).
+		SetVersion(version).
+		SetEnabled(enabled).
+		SetNillableParentTemplateID(source.ParentTemplateID).
+		SetCreatedBy(actor)
+	if displayName != "" {
+		create = create.SetDisplayName(displayName)
+	}
+	if description != "" {
+		create = create.SetDescription(description)
+	}
+	if osFamily != "" {
+		create = create.SetOsFamily(osFamily)
+	}
+	if osVersion != "" {
+		create = create.SetOsVersion(osVersion)
+	}
+	if spec != nil {
+		create = create.SetSpec(spec)
+	}
+
+	tpl, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			c.JSON(http.StatusConflict, generated.Error{Code: "TEMPLATE_NAME_VERSION_EXISTS"})
+			return
+		}
+		logger.Error("failed to clone admin template", zap.Error(err), zap.String("template_id", templateId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "template.clone", "template", tpl.ID, actor, map[string]interface{}{
+			"source_template_id": source.ID,
+			"new_template_id":    tpl.ID,
+			"name":               tpl.Name,
+			"source_version":     source.Version,
+			"version":            tpl.Version,
+		})
+	}
+
+	c.JSON(http.StatusCreated, templateToAPI(tpl))
+}
+
+// DeprecateAdminTemplate handles POST /admin/templates/{template_id}/deprecate.
+func (s *Server) DeprecateAdminTemplate(c *gin.Context, templateId generated.TemplateID) {
+	s.setTemplateDeprecation(c, templateId, true)
+}
+
+// PromoteAdminTemplate handles POST /admin/templates/{template_id}/promote.
+func (s *Server) PromoteAdminTemplate(c *gin.Context, templateId generated.TemplateID) {
+	s.setTemplateDeprecation(c, templateId, false)
+}
+
+// setTemplateDeprecation sets or clears deprecated_at. Deprecating an already
+// deprecated template keeps the original timestamp.
+func (s *Server) setTemplateDeprecation(c *gin.Context, templateId string, deprecate bool) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "template:write", "template:manage")
+	if !ok {
+		return
+	}
+
+	current, err := s.client.Template.Get(ctx, templateId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "TEMPLATE_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to get admin template", zap.Error(err), zap.String("template_id", templateId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	action := "template.promote"
+	update := s.client.Template.UpdateOneID(templateId)
+	if deprecate {
+		action = "template.deprecate"
+		if current.DeprecatedAt == nil {
+			update = update.SetDeprecatedAt(time.Now().UTC())
+		}
+	} else {
+		update = update.ClearDeprecatedAt()
+	}
+
+	tpl, err := update.Save(ctx)
+	if err != nil {
+		logger.Error("failed to update template deprecation", zap.Error(err), zap.String("template_id", templateId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, action, "template", tpl.ID, actor, map[string]interface{}{
+			"name":    tpl.Name,
+			"version": tpl.Version,
+		})
+	}
+
+	c.JSON(http.StatusOK, templateToAPI(tpl))
+}
+
+// nextTemplateVersion returns latest+1 for a template name, or 1 if none exists.
+func (s *Server) nextTemplateVersion(ctx context.Context, name string) (int, error) {
+	latest, err := s.client.Template.Query().
+		Where(enttemplate.NameEQ(name)).
+		Order(ent.Desc(enttemplate.FieldVersion)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 1, nil
+		}
+		return 0, err
+	}
+	return latest.Version + 1, nil
+}
+
+// loadTemplateAncestors resolves the parent chain of tpl. On a missing parent,
+// a cycle or a chain deeper than approval.MaxTemplateInheritanceDepth it
+// writes 400 and returns false.
+func (s *Server) loadTemplateAncestors(c *gin.Context, tpl *ent.Template) ([]*ent.Template, bool) {
+	ancestors, err := approval.LoadTemplateAncestors(c.Request.Context(), s.client, tpl)
+	switch {
+	case err == nil:
+		return ancestors, true
+	case ent.IsNotFound(err):
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "TEMPLATE_PARENT_NOT_FOUND"})
+	case errors.Is(err, approval.ErrTemplateInheritanceCycle):
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "TEMPLATE_INHERITANCE_CYCLE"})
+	case errors.Is(err, approval.ErrTemplateInheritanceTooDeep):
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:   "TEMPLATE_INHERITANCE_TOO_DEEP",
+			Params: map[string]interface{}{"max_depth": approval.MaxTemplateInheritanceDepth},
+		})
+	default:
+		logger.Error("failed to resolve template inheritance", zap.Error(err), zap.String("template_id", tpl.ID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+	}
+	return nil, false
+}
+
+// validateTemplateSpecRequest runs admin-time template spec validation.
+// On failure it writes 400 TEMPLATE_SPEC_INVALID with field-level violations and returns false.
+func validateTemplateSpecRequest(c *gin.Context, spec map[string]interface{}) bool {
+	err := service.ValidateTemplateSpec(spec)
+	if err == nil {
+		return true
+	}
+	appErr, ok := apperrors.IsAppError(err)
+	if !ok {
+		logger.Error("template spec validation failed unexpectedly", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return false
+	}
+	fieldErrors := make([]generated.FieldError, 0, len(appErr.FieldErrors))
+	for _, fe := range appErr.FieldErrors {
+		fieldErrors = append(fieldErrors, generated.FieldError{
+			Field:   fe.Field,
+			Code:    fe.Code,
+			Message: fe.Message,
+		})
+	}
+	c.JSON(appErr.HTTPStatus, generated.Error{
+		Code:        appErr.Code,
+		Message:     appErr.Message,
+		Params:      appErr.Params,
+		FieldErrors: fieldErrors,
+	})
+	return false
+}
+
 // DeleteAdminTemplate handles DELETE /admin/templates/{template_id}.
-func (s *Server) DeleteAdminTemplate(c *gin.Context, templateId generated.TemplateID) {
+func (s *Server) DeleteAdminTemplate(c *gin.Context, templateId generated.TemplateID, params generated.DeleteAdminTemplateParams) {
 	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "template:write", "template:manage")
 	if !ok {
 		return
 	}
+	force := params.Force
+	if force && !hasPlatformAdmin(c) {
+		c.JSON(http.StatusForbidden, generated.Error{Code: "FORBIDDEN", Message: "force delete requires platform:admin"})
+		return
+	}
+
+	tpl, err := s.client.Template.Get(ctx, templateId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "TEMPLATE_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to get admin template", zap.Error(err), zap.String("template_id", templateId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	children, err := tpl.QueryChildren().Count(ctx)
+	if err != nil {
+		logger.Error("failed to count child templates", zap.Error(err), zap.String("template_id", templateId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if children > 0 {
+		c.JSON(http.StatusConflict, generated.Error{
+			Code:    "TEMPLATE_HAS_CHILDREN",
+			Message: "template is the parent of other templates",
+			Params:  map[string]interface{}{"children": children},
+		})
+		return
+	}
+
+	refs, err := approval.FindTemplateReferences(ctx, s.client, templateId)
+	if err != nil {
+		logger.Error("failed to check template references", zap.Error(err), zap.String("template_id", templateId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if refs.InUse() {
+		if !force {
+			c.JSON(http.StatusConflict, generated.Error{
+				Code:    "TEMPLATE_IN_USE",
+				Message: "template is referenced by in-flight approval tickets or existing VMs",
+				Params: map[string]interface{}{
+					"pending_tickets":  len(refs.PendingTicketIDs),
+					"approved_tickets": refs.ApprovedTickets,
+					"vms":              refs.VMs,
+				},
+			})
+			return
+		}
+		s.forceRetireTemplate(c, tpl, refs, actor)
+		return
+	}
 
 	if err := s.client.Template.DeleteOneID(templateId).Exec(ctx); err != nil {
 		if ent.IsNotFound(err) {
@@ -385,18 +777,76 @@ func (s *Server) DeleteAdminTemplate(c *gin.Context, templateId generated.Templa
 	c.Status(http.StatusNoContent)
 }
 
+// forceRetireTemplate disables an in-use template instead of deleting it and
+// rejects pending tickets that reference it. Approved tickets keep running on
+// their immutable template snapshot; existing VMs are untouched.
+func (s *Server) forceRetireTemplate(c *gin.Context, tpl *ent.Template, refs *approval.TemplateReferences, actor string) {
+	ctx := c.Request.Context()
+	if len(refs.PendingTicketIDs) > 0 && s.gateway == nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR", Message: "approval gateway is not configured"})
+		return
+	}
+
+	if _, err := s.client.Template.UpdateOneID(tpl.ID).SetEnabled(false).Save(ctx); err != nil {
+		logger.Error("failed to disable in-use template", zap.Error(err), zap.String("template_id", tpl.ID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	reason := fmt.Sprintf("template %s (v%d) was retired by an administrator", tpl.Name, tpl.Version)
+	rejected := make([]string, 0, len(refs.PendingTicketIDs))
+	for _, ticketID := range refs.PendingTicketIDs {
+		if err := s.gateway.Reject(ctx, ticketID, actor, reason); err != nil {
+			logger.Warn("failed to reject ticket for retired template",
+				zap.String("template_id", tpl.ID),
+				zap.String("ticket_id", ticketID),
+				zap.Error(err),
+			)
+			continue
+		}
+		rejected = append(rejected, ticketID)
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "template.force_delete", "template", tpl.ID, actor, map[string]interface{}{
+			"disabled":         true,
+			"rejected_tickets": rejected,
+			"approved_tickets": refs.ApprovedTickets,
+			"referencing_vms":  refs.VMs,
+			"rejection_reason": reason,
+		})
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
 // ListAdminInstanceSizes handles GET /admin/instance-sizes.
-func (s *Server) ListAdminInstanceSizes(c *gin.Context) {
+func (s *Server) ListAdminInstanceSizes(c *gin.Context, params generated.ListAdminInstanceSizesParams) {
 	ctx, _, ok := requireActorWithAnyGlobalPermission(c, "instance_size:read")
 	if !ok {
 		return
 	}
 
-	sizes, err := s.client.InstanceSize.Query().
-		Order(ent.Asc(instancesize.FieldSortOrder), ent.Asc(instancesize.FieldName)).
-		All(ctx)
+	page, perPage := defaultPagination(params.Page, params.PerPage)
+	offset := (page - 1) * perPage
+
+	query := s.client.InstanceSize.Query().
+		Where(instanceSizeFilters(params.RequiresGpu, params.RequiresSriov, params.DedicatedCpu, params.Search)...).
+		Order(ent.Asc(instancesize.FieldSortOrder), ent.Asc(instancesize.FieldName))
+	if params.Enabled != nil {
+		query = query.Where(instancesize.EnabledEQ(*params.Enabled))
+	}
+
+	total, err := query.Clone().Count(ctx)
 	if err != nil {
-		logger.Error("failed to list admin instance sizes", zap.Error(err))
+		logger.Error("failed to count instance sizes", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	sizes, err := query.Offset(offset).Limit(perPage).All(ctx)
+	if err != nil {
+		logger.Error("failed to list admin instance sizes", zap.Error(err), zap.Int("page", page))
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
@@ -406,7 +856,38 @@ func (s *Server) ListAdminInstanceSizes(c *gin.Context) {
 		items = append(items, instanceSizeToAPI(sz))
 	}
 
-	c.JSON(http.StatusOK, generated.InstanceSizeList{Items: items})
+	totalPages := (total + perPage - 1) / perPage
+	c.JSON(http.StatusOK, generated.InstanceSizeList{
+		Items: items,
+		Pagination: generated.Pagination{
+			Page:       page,
+			PerPage:    perPage,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+	})
+}
+
+// instanceSizeFilters builds the capability/search predicates shared by the
+// admin and user instance size lists. Nil booleans are not filtered.
+func instanceSizeFilters(requiresGPU, requiresSRIOV, dedicatedCPU *bool, search string) []predicate.InstanceSize {
+	preds := make([]predicate.InstanceSize, 0, 4)
+	if requiresGPU != nil {
+		preds = append(preds, instancesize.RequiresGpuEQ(*requiresGPU))
+	}
+	if requiresSRIOV != nil {
+		preds = append(preds, instancesize.RequiresSriovEQ(*requiresSRIOV))
+	}
+	if dedicatedCPU != nil {
+		preds = append(preds, instancesize.DedicatedCPUEQ(*dedicatedCPU))
+	}
+	if search = strings.TrimSpace(search); search != "" {
+		preds = append(preds, instancesize.Or(
+			instancesize.NameContainsFold(search),
+			instancesize.DisplayNameContainsFold(search),
+		))
+	}
+	return preds
 }
 
 // CreateAdminInstanceSize handles POST /admin/instance-sizes.
@@ -518,7 +999,18 @@ func (s *Server) UpdateAdminInstanceSize(c *gin.Context, instanceSizeId generate
 		return
 	}
 
-	update := s.client.InstanceSize.UpdateOneID(instanceSizeId)
+	before, err := s.client.InstanceSize.Get(ctx, instanceSizeId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "INSTANCE_SIZE_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to get admin instance size for update", zap.Error(err), zap.String("instance_size_id", instanceSizeId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	update := before.Update()
 	if req.Name != nil {
 		v := strings.TrimSpace(*req.Name)
 		if v == "" {
@@ -613,7 +1105,7 @@ func (s *Server) UpdateAdminInstanceSize(c *gin.Context, instanceSizeId generate
 	}
 
 	if s.audit != nil {
-		_ = s.audit.LogAction(ctx, "instance_size.update", "instance_size", sz.ID, actor, nil)
+		_ = s.audit.LogAction(ctx, "instance_size.update", "instance_size", sz.ID, actor, audit.ChangeDetails(before, sz))
 	}
 
 	c.JSON(http.StatusOK, instanceSizeToAPI(sz))
@@ -635,6 +1127,11 @@ func (s *Server) DeleteAdminInstanceSize(c *gin.Context, instanceSizeId generate
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
+	if _, err := s.client.InstanceSizeCluster.Delete().
+		Where(instancesizecluster.InstanceSizeIDEQ(instanceSizeId)).
+		Exec(ctx); err != nil {
+		logger.Warn("failed to delete instance size cluster associations", zap.Error(err), zap.String("instance_size_id", instanceSizeId))
+	}
 
 	if s.audit != nil {
 		_ = s.audit.LogAction(ctx, "instance_size.delete", "instance_size", instanceSizeId, actor, nil)
@@ -728,6 +1225,77 @@ func (s *Server) CreateRole(c *gin.Context) {
 	c.JSON(http.StatusCreated, roleToAPI(r))
 }
 
+// CloneRole handles POST /admin/roles/{role_id}/clone.
+// The clone copies permissions, description and enabled from the source and
+// is always a custom role, even when the source is built-in.
+func (s *Server) CloneRole(c *gin.Context, roleId generated.RoleID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "rbac:manage")
+	if !ok {
+		return
+	}
+
+	var req generated.RoleCloneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "name is required"})
+		return
+	}
+
+	source, err := s.client.Role.Get(ctx, roleId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "ROLE_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to query role for clone", zap.Error(err), zap.String("role_id", roleId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	id, _ := uuid.NewV7()
+	create := s.client.Role.Create().
+		SetID(id.String()).
+		SetName(name).
+		SetPermissions(source.Permissions).
+		SetBuiltIn(false).
+		SetEnabled(source.Enabled)
+	displayName := strings.TrimSpace(req.DisplayName)
+	if displayName == "" {
+		displayName = source.DisplayName
+	}
+	if displayName != "" {
+		create = create.SetDisplayName(displayName)
+	}
+	if source.Description != "" {
+		create = create.SetDescription(source.Description)
+	}
+
+	r, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			c.JSON(http.StatusConflict, generated.Error{Code: "ROLE_NAME_EXISTS"})
+			return
+		}
+		logger.Error("failed to clone role", zap.Error(err), zap.String("role_id", roleId), zap.String("name", name))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "rbac.role.clone", "role", r.ID, actor, map[string]interface{}{
+			"name":           r.Name,
+			"role_id":        r.ID,
+			"source_role_id": source.ID,
+		})
+	}
+
+	c.JSON(http.StatusCreated, roleToAPI(r))
+}
+
 // UpdateRole handles PATCH /admin/roles/{role_id}.
 func (s *Server) UpdateRole(c *gin.Context, roleId generated.RoleID) {
 	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "rbac:manage")
@@ -789,9 +1357,10 @@ func (s *Server) UpdateRole(c *gin.Context, roleId generated.RoleID) {
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
+	s.invalidatePermissions()
 
 	if s.audit != nil {
-		_ = s.audit.LogAction(ctx, "rbac.role.update", "role", r.ID, actor, nil)
+		_ = s.audit.LogAction(ctx, "rbac.role.update", "role", r.ID, actor, audit.ChangeDetails(existing, r))
 	}
 
 	c.JSON(http.StatusOK, roleToAPI(r))
@@ -841,6 +1410,7 @@ func (s *Server) DeleteRole(c *gin.Context, roleId generated.RoleID) {
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
+	s.invalidatePermissions()
 
 	if s.audit != nil {
 		_ = s.audit.LogAction(ctx, "rbac.role.delete", "role", roleId, actor, nil)
@@ -966,7 +1536,14 @@ func (s *Server) CreateAuthProvider(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "config is required"})
 		return
 	}
-	if err := validateAuthProviderConfig(authType, req.Config); err != nil {
+	// There is no stored secret to keep, so sentinels are dropped.
+	config := mergeAuthProviderSecrets(authType, req.Config, nil)
+	if err := validateAuthProviderConfig(authType, config); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
+		return
+	}
+	syncSchedule, err := validateAuthProviderSyncSchedule(req.SyncSchedule)
+	if err != nil {
 		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
 		return
 	}
@@ -976,10 +1553,9 @@ func (s *Server) CreateAuthProvider(c *gin.Context) {
 		SetID(id.String()).
 		SetName(name).
 		SetAuthType(authType).
+		SetConfig(config).
+		SetSyncSchedule(syncSchedule).
 		SetCreatedBy(actor)
-	if req.Config != nil {
-		create = create.SetConfig(req.Config)
-	}
 	if req.Enabled != nil {
 		create = create.SetEnabled(*req.Enabled)
 	}
@@ -1020,7 +1596,18 @@ func (s *Server) UpdateAuthProvider(c *gin.Context, providerId generated.Provide
 		return
 	}
 
-	update := s.client.AuthProvider.UpdateOneID(providerId)
+	existing, err := s.client.AuthProvider.Get(ctx, providerId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "AUTH_PROVIDER_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to query auth provider for update", zap.Error(err), zap.String("provider_id", providerId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	update := existing.Update()
 	if req.Name != nil {
 		name := strings.TrimSpace(*req.Name)
 		if name == "" {
@@ -1030,21 +1617,14 @@ func (s *Server) UpdateAuthProvider(c *gin.Context, providerId generated.Provide
 		update = update.SetName(name)
 	}
 	if req.Config != nil {
-		existing, err := s.client.AuthProvider.Get(ctx, providerId)
-		if err != nil {
-			if ent.IsNotFound(err) {
-				c.JSON(http.StatusNotFound, generated.Error{Code: "AUTH_PROVIDER_NOT_FOUND"})
-				return
-			}
-			logger.Error("failed to query auth provider for update validation", zap.Error(err), zap.String("provider_id", providerId))
-			c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-			return
-		}
-		if err := validateAuthProviderConfig(existing.AuthType, *req.Config); err != nil {
+		// Responses carry redacted secrets; a sentinel sent back keeps the
+		// stored value, and validation sees the merged config.
+		config := mergeAuthProviderSecrets(existing.AuthType, *req.Config, existing.Config)
+		if err := validateAuthProviderConfig(existing.AuthType, config); err != nil {
 			c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
 			return
 		}
-		update = update.SetConfig(*req.Config)
+		update = update.SetConfig(config)
 	}
 	if req.Enabled != nil {
 		update = update.SetEnabled(*req.Enabled)
@@ -1052,6 +1632,17 @@ func (s *Server) UpdateAuthProvider(c *gin.Context, providerId generated.Provide
 	if req.SortOrder != nil {
 		update = update.SetSortOrder(*req.SortOrder)
 	}
+	if req.SyncSchedule != nil {
+		syncSchedule, err := validateAuthProviderSyncSchedule(*req.SyncSchedule)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
+			return
+		}
+		if syncSchedule != existing.SyncSchedule {
+			// A new schedule starts from now rather than a pending retry.
+			update = update.SetSyncSchedule(syncSchedule).ClearSyncRetryAt()
+		}
+	}
 
 	provider, err := update.Save(ctx)
 	if err != nil {
@@ -1069,7 +1660,7 @@ func (s *Server) UpdateAuthProvider(c *gin.Context, providerId generated.Provide
 	}
 
 	if s.audit != nil {
-		_ = s.audit.LogAction(ctx, "auth_provider.update", "auth_provider", provider.ID, actor, nil)
+		_ = s.audit.LogAction(ctx, "auth_provider.update", "auth_provider", provider.ID, actor, authProviderChangeDetails(existing, provider))
 	}
 
 	c.JSON(http.StatusOK, authProviderToAPI(provider))
@@ -1254,6 +1845,8 @@ func (s *Server) SyncAuthProviderGroups(c *gin.Context, providerId generated.Pro
 			SetGroupName(grp).
 			SetSourceField(sourceField).
 			SetLastSyncedAt(now).
+			SetStale(false).
+			ClearStaleSince().
 			Save(ctx); err != nil {
 			logger.Error("failed to update synced group", zap.Error(err), zap.String("provider_id", providerId), zap.String("group", grp))
 			c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
@@ -1318,7 +1911,7 @@ func (s *Server) ListAuthProviderGroupMappings(c *gin.Context, providerId genera
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
-	groupNameByID, err := s.syncedGroupNameMapByProvider(ctx, providerId)
+	groupByID, err := s.syncedGroupMapByProvider(ctx, providerId)
 	if err != nil {
 		logger.Error("failed to resolve group names for mappings", zap.Error(err), zap.String("provider_id", providerId))
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
@@ -1327,7 +1920,14 @@ func (s *Server) ListAuthProviderGroupMappings(c *gin.Context, providerId genera
 
 	items := make([]generated.IdPGroupMapping, 0, len(mappings))
 	for _, m := range mappings {
-		items = append(items, idpGroupMappingToAPI(m, roleNameByID[m.RoleID], groupNameByID[m.ExternalGroupID]))
+		var groupName string
+		group := groupByID[m.ExternalGroupID]
+		if group != nil {
+			groupName = group.GroupName
+		}
+		item := idpGroupMappingToAPI(m, roleNameByID[m.RoleID], groupName)
+		item.GroupStale = group != nil && group.Stale
+		items = append(items, item)
 	}
 	c.JSON(http.StatusOK, generated.IdPGroupMappingList{Items: items})
 }
@@ -1770,16 +2370,16 @@ func (s *Server) roleNameMapByMappings(ctx context.Context, mappings []*ent.IdPG
 	return out, nil
 }
 
-func (s *Server) syncedGroupNameMapByProvider(ctx context.Context, providerID string) (map[string]string, error) {
+func (s *Server) syncedGroupMapByProvider(ctx context.Context, providerID string) (map[string]*ent.IdPSyncedGroup, error) {
 	groups, err := s.client.IdPSyncedGroup.Query().
 		Where(idpsyncedgroup.ProviderIDEQ(providerID)).
 		All(ctx)
 	if err != nil {
 		return nil, err
 	}
-	out := make(map[string]string, len(groups))
+	out := make(map[string]*ent.IdPSyncedGroup, len(groups))
 	for _, g := range groups {
-		out[g.ExternalGroupID] = g.GroupName
+		out[g.ExternalGroupID] = g
 	}
 	return out, nil
 }
@@ -1816,7 +2416,10 @@ func syncedGroupToAPI(g *ent.IdPSyncedGroup) generated.IdPSyncedGroup {
 		ExternalGroupId: g.ExternalGroupID,
 		GroupName:       g.GroupName,
 		SourceField:     g.SourceField,
+		Description:     g.Description,
 		LastSyncedAt:    last,
+		Stale:           g.Stale,
+		StaleSince:      timeOrZero(g.StaleSince),
 	}
 }
 
@@ -1952,16 +2555,120 @@ func roleToAPI(r *ent.Role) generated.Role {
 	}
 }
 
+// authProviderRedactedValue replaces secret config values in responses. An
+// update that sends it back keeps the stored secret.
+const authProviderRedactedValue = "__redacted__"
+
+// authProviderSecretFields returns the secret config keys declared by the
+// adapter of authType.
+func authProviderSecretFields(authType string) []string {
+	adapter := providerregistry.ResolveAuthProviderAdminAdapter(authType)
+	if adapter == nil {
+		return nil
+	}
+	return adapter.SecretFields()
+}
+
+// redactAuthProviderConfig returns a copy of config with every secret value
+// replaced by authProviderRedactedValue.
+func redactAuthProviderConfig(authType string, config map[string]interface{}) map[string]interface{} {
+	secrets := authProviderSecretFields(authType)
+	if len(config) == 0 || len(secrets) == 0 {
+		return config
+	}
+	out := maps.Clone(config)
+	for _, key := range secrets {
+		if _, ok := out[key]; ok {
+			out[key] = authProviderRedactedValue
+		}
+	}
+	return out
+}
+
+// mergeAuthProviderSecrets returns a copy of incoming where secret keys set to
+// authProviderRedactedValue take their value from existing, or are dropped
+// when existing has none.
+func mergeAuthProviderSecrets(authType string, incoming, existing map[string]interface{}) map[string]interface{} {
+	out := maps.Clone(incoming)
+	for _, key := range authProviderSecretFields(authType) {
+		if out[key] != authProviderRedactedValue {
+			continue
+		}
+		if value, ok := existing[key]; ok {
+			out[key] = value
+		} else {
+			delete(out, key)
+		}
+	}
+	return out
+}
+
+// authProviderAuditState exposes the Sensitive config field, with secrets
+// redacted, to audit.Diff.
+func authProviderAuditState(p *ent.AuthProvider) interface{} {
+	return struct {
+		*ent.AuthProvider
+		Config map[string]interface{} `json:"config,omitempty"`
+	}{p, redactAuthProviderConfig(p.AuthType, p.Config)}
+}
+
+// authProviderChangeDetails diffs two versions of a provider for the audit
+// log. Redaction hides secret changes from the diff, so they are reported
+// separately without their values.
+func authProviderChangeDetails(before, after *ent.AuthProvider) map[string]interface{} {
+	changes := audit.Diff(authProviderAuditState(before), authProviderAuditState(after))
+	if changes == nil {
+		changes = map[string]audit.Change{}
+	}
+	for _, key := range authProviderSecretFields(after.AuthType) {
+		if !reflect.DeepEqual(before.Config[key], after.Config[key]) {
+			changes["config."+key] = audit.Change{Old: audit.RedactedValue, New: audit.RedactedValue}
+		}
+	}
+	return map[string]interface{}{"changes": changes}
+}
+
 func authProviderToAPI(p *ent.AuthProvider) generated.AuthProvider {
 	return generated.AuthProvider{
-		Id:        p.ID,
-		Name:      p.Name,
-		AuthType:  p.AuthType,
-		Config:    p.Config,
-		Enabled:   p.Enabled,
-		SortOrder: p.SortOrder,
-		CreatedBy: p.CreatedBy,
-		CreatedAt: p.CreatedAt,
-		UpdatedAt: p.UpdatedAt,
+		Id:           p.ID,
+		Name:         p.Name,
+		AuthType:     p.AuthType,
+		Config:       redactAuthProviderConfig(p.AuthType, p.Config),
+		Enabled:      p.Enabled,
+		SortOrder:    p.SortOrder,
+		SyncSchedule: p.SyncSchedule,
+		LastSync:     authProviderSyncStatusToAPI(p),
+		CreatedBy:    p.CreatedBy,
+		CreatedAt:    p.CreatedAt,
+		UpdatedAt:    p.UpdatedAt,
+	}
+}
+
+func authProviderSyncStatusToAPI(p *ent.AuthProvider) *generated.AuthProviderSyncStatus {
+	if p.LastSyncAt == nil {
+		return nil
+	}
+	return &generated.AuthProviderSyncStatus{
+		SyncedAt:            *p.LastSyncAt,
+		Added:               p.LastSyncAdded,
+		Updated:             p.LastSyncUpdated,
+		Stale:               p.LastSyncStale,
+		DurationMs:          p.LastSyncDurationMs,
+		Error:               p.LastSyncError,
+		ConsecutiveFailures: p.SyncFailures,
+		NextRetryAt:         timeOrZero(p.SyncRetryAt),
+	}
+}
+
+// validateAuthProviderSyncSchedule trims raw and checks it parses; empty
+// disables scheduled group sync.
+func validateAuthProviderSyncSchedule(raw string) (string, error) {
+	schedule := strings.TrimSpace(raw)
+	if schedule == "" {
+		return "", nil
+	}
+	if _, err := jobs.ParseAuthProviderSyncSchedule(schedule); err != nil {
+		return "", err
 	}
+	return schedule, nil
 }
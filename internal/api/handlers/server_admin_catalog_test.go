@@ -3,12 +3,23 @@ package handlers
 import (
 	"encoding/json"
 	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 
 	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/auditlog"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	enttemplate "kv-shepherd.io/shepherd/ent/template"
 	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/governance/approval"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
 	"kv-shepherd.io/shepherd/internal/testutil"
 )
 
@@ -25,7 +36,7 @@ func TestAdminTemplateCRUD(t *testing.T) {
 		"admin-1",
 		[]string{"platform:admin"},
 	)
-	srv.CreateAdminTemplate(createCtx)
+	srv.CreateAdminTemplate(createCtx, generated.CreateAdminTemplateParams{})
 	if createW.Code != http.StatusCreated {
 		t.Fatalf("create status = %d, want %d, body=%s", createW.Code, http.StatusCreated, createW.Body.String())
 	}
@@ -44,7 +55,7 @@ func TestAdminTemplateCRUD(t *testing.T) {
 		"admin-1",
 		[]string{"platform:admin"},
 	)
-	srv.UpdateAdminTemplate(updateCtx, created.Id)
+	srv.UpdateAdminTemplate(updateCtx, created.Id, generated.UpdateAdminTemplateParams{})
 	if updateW.Code != http.StatusOK {
 		t.Fatalf("update status = %d, want %d, body=%s", updateW.Code, http.StatusOK, updateW.Body.String())
 	}
@@ -79,7 +90,7 @@ func TestAdminTemplateCRUD(t *testing.T) {
 		"admin-1",
 		[]string{"platform:admin"},
 	)
-	srv.DeleteAdminTemplate(deleteCtx, created.Id)
+	srv.DeleteAdminTemplate(deleteCtx, created.Id, generated.DeleteAdminTemplateParams{})
 	if got := deleteCtx.Writer.Status(); got != http.StatusNoContent {
 		t.Fatalf("delete status = %d, want %d, body=%s", got, http.StatusNoContent, deleteW.Body.String())
 	}
@@ -89,6 +100,397 @@ func TestAdminTemplateCRUD(t *testing.T) {
 	}
 }
 
+func TestAdminTemplateSpecValidation(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newAdminCatalogTestServer(t)
+
+	invalidCtx, invalidW := newAuthedGinContext(
+		t,
+		http.MethodPost,
+		"/admin/templates",
+		`{"name":"broken-base","spec":{"volumes":["rootdisk"]}}`,
+		"admin-1",
+		[]string{"platform:admin"},
+	)
+	srv.CreateAdminTemplate(invalidCtx, generated.CreateAdminTemplateParams{})
+	if invalidW.Code != http.StatusBadRequest {
+		t.Fatalf("invalid create status = %d, want %d, body=%s", invalidW.Code, http.StatusBadRequest, invalidW.Body.String())
+	}
+	var apiErr generated.Error
+	mustDecodeJSON(t, invalidW.Body.Bytes(), &apiErr)
+	if apiErr.Code != "TEMPLATE_SPEC_INVALID" {
+		t.Fatalf("error code = %q, want TEMPLATE_SPEC_INVALID", apiErr.Code)
+	}
+	if _, ok := apiErr.Params["violations"]; !ok || len(apiErr.FieldErrors) == 0 {
+		t.Fatalf("expected field-level violations, got %+v", apiErr)
+	}
+
+	dryRunCtx, dryRunW := newAuthedGinContext(
+		t,
+		http.MethodPost,
+		"/admin/templates?validate_only=true",
+		`{"name":"dry-run-base","spec":{"image":"quay.io/kubevirt/fedora:40"}}`,
+		"admin-1",
+		[]string{"platform:admin"},
+	)
+	srv.CreateAdminTemplate(dryRunCtx, generated.CreateAdminTemplateParams{ValidateOnly: true})
+	if dryRunW.Code != http.StatusOK {
+		t.Fatalf("dry-run status = %d, want %d, body=%s", dryRunW.Code, http.StatusOK, dryRunW.Body.String())
+	}
+	var result generated.TemplateValidationResult
+	mustDecodeJSON(t, dryRunW.Body.Bytes(), &result)
+	if !result.Valid {
+		t.Fatalf("expected valid dry-run result, got %+v", result)
+	}
+
+	count, err := client.Template.Query().Count(t.Context())
+	if err != nil {
+		t.Fatalf("count templates: %v", err)
+	}
+	if count != 0 {
+		t.Fatalf("template count = %d, want 0 (nothing persisted)", count)
+	}
+}
+
+func TestAdminTemplateClone(t *testing.T) {
+	t.Parallel()
+
+	srv, _ := newAdminCatalogTestServer(t)
+
+	createCtx, createW := newAuthedGinContext(
+		t,
+		http.MethodPost,
+		"/admin/templates",
+		`{"name":"centos-base","display_name":"CentOS Base","os_family":"linux","os_version":"9","enabled":true,"spec":{"image":"quay.io/centos/centos:stream9"}}`,
+		"admin-1",
+		[]string{"platform:admin"},
+	)
+	srv.CreateAdminTemplate(createCtx, generated.CreateAdminTemplateParams{})
+	if createW.Code != http.StatusCreated {
+		t.Fatalf("create status = %d, want %d, body=%s", createW.Code, http.StatusCreated, createW.Body.String())
+	}
+	var source generated.Template
+	mustDecodeJSON(t, createW.Body.Bytes(), &source)
+
+	cloneCtx, cloneW := newAuthedGinContext(
+		t,
+		http.MethodPost,
+		"/admin/templates/"+source.Id+"/clone",
+		"",
+		"admin-1",
+		[]string{"platform:admin"},
+	)
+	srv.CloneAdminTemplate(cloneCtx, source.Id)
+	if cloneW.Code != http.StatusCreated {
+		t.Fatalf("clone status = %d, want %d, body=%s", cloneW.Code, http.StatusCreated, cloneW.Body.String())
+	}
+	var cloned generated.Template
+	mustDecodeJSON(t, cloneW.Body.Bytes(), &cloned)
+	if cloned.Id == source.Id || cloned.Name != source.Name {
+		t.Fatalf("unexpected clone identity: %+v", cloned)
+	}
+	if cloned.Version != source.Version+1 {
+		t.Fatalf("clone version = %d, want %d", cloned.Version, source.Version+1)
+	}
+	if cloned.Enabled {
+		t.Fatal("expected clone to start disabled")
+	}
+	if cloned.DisplayName != source.DisplayName || cloned.OsVersion != source.OsVersion {
+		t.Fatalf("clone did not copy source fields: %+v", cloned)
+	}
+
+	overrideCtx, overrideW := newAuthedGinContext(
+		t,
+		http.MethodPost,
+		"/admin/templates/"+source.Id+"/clone",
+		`{"os_version":"10","enabled":true}`,
+		"admin-1",
+		[]string{"platform:admin"},
+	)
+	srv.CloneAdminTemplate(overrideCtx, source.Id)
+	if overrideW.Code != http.StatusCreated {
+		t.Fatalf("override clone status = %d, want %d, body=%s", overrideW.Code, http.StatusCreated, overrideW.Body.String())
+	}
+	var overridden generated.Template
+	mustDecodeJSON(t, overrideW.Body.Bytes(), &overridden)
+	if overridden.Version != source.Version+2 || overridden.OsVersion != "10" || !overridden.Enabled {
+		t.Fatalf("unexpected override clone: %+v", overridden)
+	}
+
+	missingCtx, missingW := newAuthedGinContext(
+		t,
+		http.MethodPost,
+		"/admin/templates/missing/clone",
+		"",
+		"admin-1",
+		[]string{"platform:admin"},
+	)
+	srv.CloneAdminTemplate(missingCtx, "missing")
+	if missingW.Code != http.StatusNotFound {
+		t.Fatalf("missing clone status = %d, want %d", missingW.Code, http.StatusNotFound)
+	}
+}
+
+func TestAdminTemplateDeleteReferenceGuard(t *testing.T) {
+	t.Parallel()
+
+	t.Run("pending ticket blocks delete", func(t *testing.T) {
+		t.Parallel()
+		srv, client := newAdminCatalogTestServer(t)
+		tpl := mustCreateCatalogTemplate(t, client, "guard-pending")
+		mustSeedTemplateCreateTicket(t, client, tpl.ID, approvalticket.StatusPENDING)
+
+		c, w := newAuthedGinContext(t, http.MethodDelete, "/admin/templates/"+tpl.ID, "", "admin-1", []string{"platform:admin"})
+		srv.DeleteAdminTemplate(c, tpl.ID, generated.DeleteAdminTemplateParams{})
+		if w.Code != http.StatusConflict {
+			t.Fatalf("delete status = %d, want %d, body=%s", w.Code, http.StatusConflict, w.Body.String())
+		}
+		var apiErr generated.Error
+		mustDecodeJSON(t, w.Body.Bytes(), &apiErr)
+		if apiErr.Code != "TEMPLATE_IN_USE" {
+			t.Fatalf("error code = %q, want TEMPLATE_IN_USE", apiErr.Code)
+		}
+		if got := apiErr.Params["pending_tickets"]; got != float64(1) {
+			t.Fatalf("pending_tickets = %v, want 1", got)
+		}
+		if _, err := client.Template.Get(t.Context(), tpl.ID); err != nil {
+			t.Fatalf("template should still exist: %v", err)
+		}
+	})
+
+	t.Run("vm reference blocks delete", func(t *testing.T) {
+		t.Parallel()
+		srv, client := newAdminCatalogTestServer(t)
+		tpl := mustCreateCatalogTemplate(t, client, "guard-vm")
+		ticketID := mustSeedTemplateCreateTicket(t, client, tpl.ID, approvalticket.StatusSUCCESS)
+		if _, err := client.ApprovalTicket.UpdateOneID(ticketID).
+			SetTemplateSnapshot(map[string]interface{}{"id": tpl.ID, "name": tpl.Name}).
+			Save(t.Context()); err != nil {
+			t.Fatalf("set template snapshot: %v", err)
+		}
+		vmID := mustCreateBatchDeleteTargetVM(t, client, "owner-1")
+		if _, err := client.VM.UpdateOneID(vmID).SetTicketID(ticketID).Save(t.Context()); err != nil {
+			t.Fatalf("link vm to ticket: %v", err)
+		}
+
+		c, w := newAuthedGinContext(t, http.MethodDelete, "/admin/templates/"+tpl.ID, "", "admin-1", []string{"platform:admin"})
+		srv.DeleteAdminTemplate(c, tpl.ID, generated.DeleteAdminTemplateParams{})
+		if w.Code != http.StatusConflict {
+			t.Fatalf("delete status = %d, want %d, body=%s", w.Code, http.StatusConflict, w.Body.String())
+		}
+		var apiErr generated.Error
+		mustDecodeJSON(t, w.Body.Bytes(), &apiErr)
+		if got := apiErr.Params["vms"]; got != float64(1) {
+			t.Fatalf("vms = %v, want 1", got)
+		}
+	})
+
+	t.Run("force disables template and rejects pending tickets", func(t *testing.T) {
+		t.Parallel()
+		srv, client := newAdminCatalogTestServer(t)
+		srv.gateway = approval.NewGateway(client, nil, nil)
+		tpl := mustCreateCatalogTemplate(t, client, "guard-force")
+		ticketID := mustSeedTemplateCreateTicket(t, client, tpl.ID, approvalticket.StatusPENDING)
+
+		deniedCtx, deniedW := newAuthedGinContext(t, http.MethodDelete, "/admin/templates/"+tpl.ID+"?force=true", "", "editor-1", []string{"template:write"})
+		srv.DeleteAdminTemplate(deniedCtx, tpl.ID, generated.DeleteAdminTemplateParams{Force: true})
+		if deniedW.Code != http.StatusForbidden {
+			t.Fatalf("non-admin force status = %d, want %d", deniedW.Code, http.StatusForbidden)
+		}
+
+		c, w := newAuthedGinContext(t, http.MethodDelete, "/admin/templates/"+tpl.ID+"?force=true", "", "admin-1", []string{"platform:admin"})
+		srv.DeleteAdminTemplate(c, tpl.ID, generated.DeleteAdminTemplateParams{Force: true})
+		if got := c.Writer.Status(); got != http.StatusNoContent {
+			t.Fatalf("force delete status = %d, want %d, body=%s", got, http.StatusNoContent, w.Body.String())
+		}
+
+		retired, err := client.Template.Get(t.Context(), tpl.ID)
+		if err != nil {
+			t.Fatalf("forced template should be retained: %v", err)
+		}
+		if retired.Enabled {
+			t.Fatal("expected forced template to be disabled")
+		}
+		ticket, err := client.ApprovalTicket.Get(t.Context(), ticketID)
+		if err != nil {
+			t.Fatalf("get ticket: %v", err)
+		}
+		if ticket.Status != approvalticket.StatusREJECTED || ticket.RejectReason == "" {
+			t.Fatalf("ticket status = %s reason = %q, want REJECTED with reason", ticket.Status, ticket.RejectReason)
+		}
+	})
+}
+
+func TestAdminTemplateInheritance(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newAdminCatalogTestServer(t)
+	create := func(body string) (int, []byte) {
+		c, w := newAuthedGinContext(t, http.MethodPost, "/admin/templates", body, "admin-1", []string{"platform:admin"})
+		srv.CreateAdminTemplate(c, generated.CreateAdminTemplateParams{})
+		return w.Code, w.Body.Bytes()
+	}
+
+	base := mustCreateCatalogTemplate(t, client, "base")
+	client.Template.UpdateOneID(base.ID).
+		SetSpec(map[string]interface{}{
+			"image":         "quay.io/kubevirt/fedora:40",
+			"storage_class": "ceph-rbd",
+			"domain":        map[string]interface{}{"cpu": map[string]interface{}{"model": "host-passthrough", "sockets": 1}},
+		}).
+		ExecX(t.Context())
+
+	// The child spec alone has no image; it is valid once merged over the parent.
+	code, raw := create(`{"name":"pinned","parent_id":"` + base.ID + `","spec":{"domain":{"cpu":{"sockets":2,"dedicatedCpuPlacement":true}}}}`)
+	if code != http.StatusCreated {
+		t.Fatalf("create child status = %d, want %d body=%s", code, http.StatusCreated, raw)
+	}
+	var child generated.Template
+	mustDecodeJSON(t, raw, &child)
+	if child.ParentId != base.ID {
+		t.Fatalf("child parent_id = %q, want %q", child.ParentId, base.ID)
+	}
+
+	getCtx, getW := newAuthedGinContext(t, http.MethodGet, "/admin/templates/"+child.Id, "", "admin-1", []string{"template:read"})
+	srv.GetAdminTemplate(getCtx, child.Id)
+	if getW.Code != http.StatusOK {
+		t.Fatalf("get status = %d, want %d body=%s", getW.Code, http.StatusOK, getW.Body.String())
+	}
+	var got generated.Template
+	mustDecodeJSON(t, getW.Body.Bytes(), &got)
+	wantSpec := map[string]interface{}{
+		"image":         "quay.io/kubevirt/fedora:40",
+		"storage_class": "ceph-rbd",
+		"domain": map[string]interface{}{"cpu": map[string]interface{}{
+			"model":                 "host-passthrough",
+			"sockets":               float64(2),
+			"dedicatedCpuPlacement": true,
+		}},
+	}
+	if got.ParentId != base.ID || !reflect.DeepEqual(got.ResolvedSpec, wantSpec) {
+		t.Fatalf("get = parent %q resolved %#v, want parent %q resolved %#v", got.ParentId, got.ResolvedSpec, base.ID, wantSpec)
+	}
+	if cpu, _ := got.Spec["domain"].(map[string]interface{})["cpu"].(map[string]interface{}); cpu["model"] != nil || cpu["sockets"] != float64(2) {
+		t.Fatalf("get spec = %#v, want the child's own spec only", got.Spec)
+	}
+
+	getCtx, getW = newAuthedGinContext(t, http.MethodGet, "/admin/templates/"+base.ID, "", "admin-1", []string{"template:read"})
+	srv.GetAdminTemplate(getCtx, base.ID)
+	var root generated.Template
+	mustDecodeJSON(t, getW.Body.Bytes(), &root)
+	if root.Spec["image"] != "quay.io/kubevirt/fedora:40" || root.ResolvedSpec != nil {
+		t.Fatalf("get root = spec %#v resolved %#v, want own spec and no resolved_spec", root.Spec, root.ResolvedSpec)
+	}
+
+	// base -> pinned -> l3 -> l4 -> l5 is the deepest allowed chain.
+	parentID := child.Id
+	for _, name := range []string{"l3", "l4", "l5"} {
+		code, raw := create(`{"name":"` + name + `","parent_id":"` + parentID + `"}`)
+		if code != http.StatusCreated {
+			t.Fatalf("create %s status = %d, want %d body=%s", name, code, http.StatusCreated, raw)
+		}
+		var tpl generated.Template
+		mustDecodeJSON(t, raw, &tpl)
+		parentID = tpl.Id
+	}
+	code, raw = create(`{"name":"l6","parent_id":"` + parentID + `"}`)
+	if code != http.StatusBadRequest {
+		t.Fatalf("create l6 status = %d, want %d body=%s", code, http.StatusBadRequest, raw)
+	}
+	assertErrorCode(t, raw, "TEMPLATE_INHERITANCE_TOO_DEEP")
+	if n := client.Template.Query().Where(enttemplate.NameEQ("l6")).CountX(t.Context()); n != 0 {
+		t.Fatalf("l6 templates persisted = %d, want 0", n)
+	}
+
+	code, raw = create(`{"name":"orphan","parent_id":"tpl-missing"}`)
+	if code != http.StatusBadRequest {
+		t.Fatalf("missing parent status = %d, want %d body=%s", code, http.StatusBadRequest, raw)
+	}
+	assertErrorCode(t, raw, "TEMPLATE_PARENT_NOT_FOUND")
+
+	delCtx, delW := newAuthedGinContext(t, http.MethodDelete, "/admin/templates/"+base.ID, "", "admin-1", []string{"platform:admin"})
+	srv.DeleteAdminTemplate(delCtx, base.ID, generated.DeleteAdminTemplateParams{})
+	if delW.Code != http.StatusConflict {
+		t.Fatalf("delete parent status = %d, want %d body=%s", delW.Code, http.StatusConflict, delW.Body.String())
+	}
+	assertErrorCode(t, delW.Body.Bytes(), "TEMPLATE_HAS_CHILDREN")
+}
+
+func TestAdminTemplateDeprecateAndPromote(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newAdminCatalogTestServer(t)
+	tpl := mustCreateCatalogTemplate(t, client, "deprecate-me")
+
+	deprecateCtx, deprecateW := newAuthedGinContext(
+		t,
+		http.MethodPost,
+		"/admin/templates/"+tpl.ID+"/deprecate",
+		"",
+		"admin-1",
+		[]string{"platform:admin"},
+	)
+	srv.DeprecateAdminTemplate(deprecateCtx, tpl.ID)
+	if deprecateW.Code != http.StatusOK {
+		t.Fatalf("deprecate status = %d, want %d, body=%s", deprecateW.Code, http.StatusOK, deprecateW.Body.String())
+	}
+	var deprecated generated.Template
+	mustDecodeJSON(t, deprecateW.Body.Bytes(), &deprecated)
+	if deprecated.DeprecatedAt.IsZero() {
+		t.Fatal("expected deprecated_at to be set")
+	}
+
+	listIDs := func(includeDeprecated bool) map[string]bool {
+		t.Helper()
+		listCtx, listW := newAuthedGinContext(t, http.MethodGet, "/templates", "", "user-1", []string{"template:read"})
+		srv.ListTemplates(listCtx, generated.ListTemplatesParams{IncludeDeprecated: includeDeprecated})
+		if listW.Code != http.StatusOK {
+			t.Fatalf("list status = %d, want %d, body=%s", listW.Code, http.StatusOK, listW.Body.String())
+		}
+		var list generated.TemplateList
+		mustDecodeJSON(t, listW.Body.Bytes(), &list)
+		ids := make(map[string]bool, len(list.Items))
+		for _, item := range list.Items {
+			ids[item.Id] = true
+		}
+		return ids
+	}
+	if listIDs(false)[tpl.ID] {
+		t.Fatal("deprecated template should be hidden by default")
+	}
+	if !listIDs(true)[tpl.ID] {
+		t.Fatal("deprecated template should be listed with include_deprecated=true")
+	}
+
+	promoteCtx, promoteW := newAuthedGinContext(
+		t,
+		http.MethodPost,
+		"/admin/templates/"+tpl.ID+"/promote",
+		"",
+		"admin-1",
+		[]string{"platform:admin"},
+	)
+	srv.PromoteAdminTemplate(promoteCtx, tpl.ID)
+	if promoteW.Code != http.StatusOK {
+		t.Fatalf("promote status = %d, want %d, body=%s", promoteW.Code, http.StatusOK, promoteW.Body.String())
+	}
+	var promoted generated.Template
+	mustDecodeJSON(t, promoteW.Body.Bytes(), &promoted)
+	if !promoted.DeprecatedAt.IsZero() {
+		t.Fatalf("expected deprecated_at to be cleared, got %s", promoted.DeprecatedAt)
+	}
+	if !listIDs(false)[tpl.ID] {
+		t.Fatal("promoted template should be listed by default")
+	}
+
+	missingCtx, missingW := newAuthedGinContext(t, http.MethodPost, "/admin/templates/missing/deprecate", "", "admin-1", []string{"platform:admin"})
+	srv.DeprecateAdminTemplate(missingCtx, "missing")
+	if missingW.Code != http.StatusNotFound {
+		t.Fatalf("missing deprecate status = %d, want %d", missingW.Code, http.StatusNotFound)
+	}
+}
+
 func TestAdminInstanceSizeCRUD(t *testing.T) {
 	t.Parallel()
 
@@ -143,7 +545,7 @@ func TestAdminInstanceSizeCRUD(t *testing.T) {
 		"admin-1",
 		[]string{"platform:admin"},
 	)
-	srv.ListAdminInstanceSizes(listCtx)
+	srv.ListAdminInstanceSizes(listCtx, generated.ListAdminInstanceSizesParams{})
 	if listW.Code != http.StatusOK {
 		t.Fatalf("list status = %d, want %d, body=%s", listW.Code, http.StatusOK, listW.Body.String())
 	}
@@ -166,6 +568,355 @@ func TestAdminInstanceSizeCRUD(t *testing.T) {
 	}
 }
 
+func TestAdminInstanceSizeClusterAssociations(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newAdminCatalogTestServer(t)
+	ctx := t.Context()
+	client.InstanceSize.Create().SetID("size-gpu").SetName("gpu.large").SetCPUCores(8).SetMemoryMB(32768).SetCreatedBy("seed").SaveX(ctx)
+	for _, id := range []string{"cluster-a", "cluster-b"} {
+		client.Cluster.Create().
+			SetID(id).
+			SetName(id).
+			SetAPIServerURL("https://" + id + ".example.com").
+			SetEncryptedKubeconfig([]byte("x")).
+			SetCreatedBy("seed").
+			SaveX(ctx)
+	}
+
+	associate := func(body string, perms []string) *httptest.ResponseRecorder {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodPut, "/admin/instance-sizes/size-gpu/associate-clusters", body, "admin-1", perms)
+		srv.AssociateInstanceSizeClusters(c, "size-gpu")
+		return w
+	}
+	get := func() generated.InstanceSize {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodGet, "/admin/instance-sizes/size-gpu", "", "admin-1", []string{"instance_size:read"})
+		srv.GetAdminInstanceSize(c, "size-gpu")
+		if w.Code != http.StatusOK {
+			t.Fatalf("get status = %d, want 200, body=%s", w.Code, w.Body.String())
+		}
+		var sz generated.InstanceSize
+		mustDecodeJSON(t, w.Body.Bytes(), &sz)
+		return sz
+	}
+
+	assertStatusAndCode(t, associate(`{"cluster_ids":["cluster-a"]}`, []string{"instance_size:read"}), http.StatusForbidden, "FORBIDDEN")
+	assertStatusAndCode(t, associate(`{"cluster_ids":["cluster-a","cluster-x"]}`, []string{"instance_size:write"}), http.StatusBadRequest, "CLUSTER_NOT_FOUND")
+	if sz := get(); len(sz.AllowedClusterIds) != 0 {
+		t.Fatalf("allowed clusters = %v, want none before association", sz.AllowedClusterIds)
+	}
+
+	w := associate(`{"cluster_ids":["cluster-b","cluster-a","cluster-b"]}`, []string{"instance_size:write"})
+	if w.Code != http.StatusOK {
+		t.Fatalf("associate status = %d, want 200, body=%s", w.Code, w.Body.String())
+	}
+	if sz := get(); !reflect.DeepEqual(sz.AllowedClusterIds, []string{"cluster-a", "cluster-b"}) {
+		t.Fatalf("allowed clusters = %v, want [cluster-a cluster-b]", sz.AllowedClusterIds)
+	}
+
+	// The PUT replaces the whole set; an empty list lifts the restriction.
+	if w := associate(`{"cluster_ids":["cluster-b"]}`, []string{"instance_size:write"}); w.Code != http.StatusOK {
+		t.Fatalf("replace status = %d, body=%s", w.Code, w.Body.String())
+	}
+	if sz := get(); !reflect.DeepEqual(sz.AllowedClusterIds, []string{"cluster-b"}) {
+		t.Fatalf("allowed clusters = %v, want [cluster-b]", sz.AllowedClusterIds)
+	}
+	if w := associate(`{"cluster_ids":[]}`, []string{"instance_size:write"}); w.Code != http.StatusOK {
+		t.Fatalf("clear status = %d, body=%s", w.Code, w.Body.String())
+	}
+	if n := client.InstanceSizeCluster.Query().CountX(ctx); n != 0 {
+		t.Fatalf("associations = %d, want 0 after clearing", n)
+	}
+}
+
+func TestAdminInstanceSizeBulkSortUpdate(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "admin_instance_size_bulk_sort")
+	srv := NewServer(ServerDeps{EntClient: client, Audit: audit.NewLogger(client)})
+	ctx := t.Context()
+	for i, name := range []string{"small", "medium", "large"} {
+		client.InstanceSize.Create().SetID("size-" + name).SetName(name).SetCPUCores(2).SetMemoryMB(4096).SetSortOrder(i).SetCreatedBy("seed").SaveX(ctx)
+	}
+	sortOrders := func() map[string]int {
+		out := map[string]int{}
+		for _, sz := range client.InstanceSize.Query().AllX(ctx) {
+			out[sz.ID] = sz.SortOrder
+		}
+		return out
+	}
+	bulkUpdate := func(body string, perms []string) *httptest.ResponseRecorder {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodPost, "/admin/instance-sizes/bulk-update", body, "admin-1", perms)
+		srv.BulkUpdateAdminInstanceSizes(c)
+		return w
+	}
+	initial := sortOrders()
+
+	assertStatusAndCode(t, bulkUpdate(`[{"id":"size-large","sort_order":0}]`, []string{"instance_size:read"}), http.StatusForbidden, "FORBIDDEN")
+	assertStatusAndCode(t, bulkUpdate(`[]`, []string{"instance_size:write"}), http.StatusBadRequest, "INVALID_REQUEST")
+	assertStatusAndCode(t, bulkUpdate(`[{"id":"size-large","sort_order":0},{"id":"size-large","sort_order":1}]`, []string{"instance_size:write"}), http.StatusBadRequest, "INVALID_REQUEST")
+
+	w := bulkUpdate(`[{"id":"size-large","sort_order":0},{"id":"size-ghost","sort_order":1},{"id":"size-small","sort_order":2}]`, []string{"instance_size:write"})
+	assertStatusAndCode(t, w, http.StatusNotFound, "INSTANCE_SIZE_NOT_FOUND")
+	var notFound generated.Error
+	mustDecodeJSON(t, w.Body.Bytes(), &notFound)
+	if got := notFound.Params["missing_ids"]; !reflect.DeepEqual(got, []interface{}{"size-ghost"}) {
+		t.Fatalf("missing_ids = %v, want [size-ghost]", got)
+	}
+	if got := sortOrders(); !reflect.DeepEqual(got, initial) {
+		t.Fatalf("sort orders = %v, want unchanged %v after a rejected update", got, initial)
+	}
+
+	w = bulkUpdate(`[{"id":"size-large","sort_order":0},{"id":"size-medium","sort_order":1},{"id":"size-small","sort_order":2}]`, []string{"instance_size:write"})
+	if w.Code != http.StatusOK {
+		t.Fatalf("bulk update status = %d, want 200, body=%s", w.Code, w.Body.String())
+	}
+	var list generated.InstanceSizeList
+	mustDecodeJSON(t, w.Body.Bytes(), &list)
+	names := make([]string, 0, len(list.Items))
+	for _, item := range list.Items {
+		names = append(names, item.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"large", "medium", "small"}) {
+		t.Fatalf("updated sizes = %v, want [large medium small]", names)
+	}
+
+	entries := client.AuditLog.Query().Where(auditlog.ResourceTypeEQ("instance_size")).AllX(ctx)
+	if len(entries) != 1 || entries[0].Action != "instance_size.bulk_sort_update" {
+		t.Fatalf("audit entries = %+v, want one instance_size.bulk_sort_update", entries)
+	}
+	if got := entries[0].Details["count"]; got != float64(3) {
+		t.Fatalf("audit count = %v, want 3", got)
+	}
+}
+
+func TestInstanceSizeListFilters(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newAdminCatalogTestServer(t)
+	seed := []struct {
+		name      string
+		display   string
+		sortOrder int
+		gpu       bool
+		enabled   bool
+	}{
+		{name: "gpu-large", display: "GPU Large", sortOrder: 20, gpu: true, enabled: true},
+		{name: "gpu-small", display: "GPU Small", sortOrder: 10, gpu: true, enabled: true},
+		{name: "cpu-small", display: "General Small", sortOrder: 10, enabled: true},
+		{name: "gpu-legacy", display: "Legacy Accelerator", sortOrder: 30, gpu: true, enabled: false},
+	}
+	for _, sz := range seed {
+		if _, err := client.InstanceSize.Create().
+			SetID("size-" + sz.name).
+			SetName(sz.name).
+			SetDisplayName(sz.display).
+			SetCPUCores(2).
+			SetMemoryMB(4096).
+			SetSortOrder(sz.sortOrder).
+			SetRequiresGpu(sz.gpu).
+			SetEnabled(sz.enabled).
+			SetCreatedBy("admin-1").
+			Save(t.Context()); err != nil {
+			t.Fatalf("create instance size %s: %v", sz.name, err)
+		}
+	}
+
+	names := func(list generated.InstanceSizeList) []string {
+		out := make([]string, 0, len(list.Items))
+		for _, item := range list.Items {
+			out = append(out, item.Name)
+		}
+		return out
+	}
+	gpu := true
+
+	adminCtx, adminW := newAuthedGinContext(t, http.MethodGet, "/admin/instance-sizes?requires_gpu=true&page=1&per_page=2", "", "admin-1", []string{"platform:admin"})
+	srv.ListAdminInstanceSizes(adminCtx, generated.ListAdminInstanceSizesParams{Page: 1, PerPage: 2, RequiresGpu: &gpu})
+	if adminW.Code != http.StatusOK {
+		t.Fatalf("admin list status = %d, want %d, body=%s", adminW.Code, http.StatusOK, adminW.Body.String())
+	}
+	var adminList generated.InstanceSizeList
+	mustDecodeJSON(t, adminW.Body.Bytes(), &adminList)
+	if got := names(adminList); !reflect.DeepEqual(got, []string{"gpu-small", "gpu-large"}) {
+		t.Fatalf("admin page 1 = %v, want [gpu-small gpu-large]", got)
+	}
+	if adminList.Pagination.Total != 3 || adminList.Pagination.TotalPages != 2 {
+		t.Fatalf("unexpected pagination: %+v", adminList.Pagination)
+	}
+
+	searchCtx, searchW := newAuthedGinContext(t, http.MethodGet, "/admin/instance-sizes?search=accel", "", "admin-1", []string{"platform:admin"})
+	srv.ListAdminInstanceSizes(searchCtx, generated.ListAdminInstanceSizesParams{Search: "ACCEL"})
+	var searchList generated.InstanceSizeList
+	mustDecodeJSON(t, searchW.Body.Bytes(), &searchList)
+	if got := names(searchList); !reflect.DeepEqual(got, []string{"gpu-legacy"}) {
+		t.Fatalf("search result = %v, want [gpu-legacy]", got)
+	}
+
+	userCtx, userW := newAuthedGinContext(t, http.MethodGet, "/instance-sizes?requires_gpu=true", "", "user-1", []string{"vm:create"})
+	srv.ListInstanceSizes(userCtx, generated.ListInstanceSizesParams{RequiresGpu: &gpu})
+	if userW.Code != http.StatusOK {
+		t.Fatalf("user list status = %d, want %d, body=%s", userW.Code, http.StatusOK, userW.Body.String())
+	}
+	var userList generated.InstanceSizeList
+	mustDecodeJSON(t, userW.Body.Bytes(), &userList)
+	if got := names(userList); !reflect.DeepEqual(got, []string{"gpu-small", "gpu-large"}) {
+		t.Fatalf("user gpu list = %v, want enabled GPU sizes only", got)
+	}
+}
+
+func TestAdminUpdatesAuditFieldChanges(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "admin_update_audit")
+	srv := NewServer(ServerDeps{EntClient: client, Audit: audit.NewLogger(client)})
+	admin := []string{"platform:admin"}
+
+	lastChanges := func(action, resourceID string) map[string]interface{} {
+		t.Helper()
+		entry, err := client.AuditLog.Query().
+			Where(auditlog.ActionEQ(action), auditlog.ResourceIDEQ(resourceID)).
+			Only(t.Context())
+		if err != nil {
+			t.Fatalf("query %s audit entry: %v", action, err)
+		}
+		changes, ok := entry.Details["changes"].(map[string]interface{})
+		if !ok {
+			t.Fatalf("%s details = %v, want a changes map", action, entry.Details)
+		}
+		return changes
+	}
+
+	size := client.InstanceSize.Create().
+		SetID("size-e2e-small").
+		SetName("e2e-small").
+		SetCPUCores(2).
+		SetMemoryMB(4096).
+		SetCreatedBy("admin-1").
+		SaveX(t.Context())
+	sizeCtx, sizeW := newAuthedGinContext(t, http.MethodPatch, "/admin/instance-sizes/"+size.ID, `{"memory_mb":8192,"cpu_cores":2}`, "admin-1", admin)
+	srv.UpdateAdminInstanceSize(sizeCtx, size.ID)
+	if sizeW.Code != http.StatusOK {
+		t.Fatalf("update instance size status = %d, body=%s", sizeW.Code, sizeW.Body.String())
+	}
+	want := map[string]interface{}{"memory_mb": map[string]interface{}{"old": float64(4096), "new": float64(8192)}}
+	if got := lastChanges("instance_size.update", size.ID); !reflect.DeepEqual(got, want) {
+		t.Fatalf("instance size changes = %v, want only memory_mb", got)
+	}
+
+	createCtx, createW := newAuthedGinContext(t, http.MethodPost, "/admin/auth-providers",
+		`{"name":"Corp SSO","auth_type":"oidc","enabled":true,"config":{"issuer":"https://sso.example.com","client_id":"shepherd","client_secret":"old-secret"}}`,
+		"admin-1", admin)
+	srv.CreateAuthProvider(createCtx)
+	if createW.Code != http.StatusCreated {
+		t.Fatalf("create provider status = %d, body=%s", createW.Code, createW.Body.String())
+	}
+	var provider generated.AuthProvider
+	mustDecodeJSON(t, createW.Body.Bytes(), &provider)
+
+	updateCtx, updateW := newAuthedGinContext(t, http.MethodPatch, "/admin/auth-providers/"+provider.Id,
+		`{"config":{"issuer":"https://sso.example.com","client_id":"shepherd-v2","client_secret":"new-secret"}}`,
+		"admin-1", admin)
+	srv.UpdateAuthProvider(updateCtx, provider.Id)
+	if updateW.Code != http.StatusOK {
+		t.Fatalf("update provider status = %d, body=%s", updateW.Code, updateW.Body.String())
+	}
+	want = map[string]interface{}{
+		"config.client_id":     map[string]interface{}{"old": "shepherd", "new": "shepherd-v2"},
+		"config.client_secret": map[string]interface{}{"old": audit.RedactedValue, "new": audit.RedactedValue},
+	}
+	if got := lastChanges("auth_provider.update", provider.Id); !reflect.DeepEqual(got, want) {
+		t.Fatalf("auth provider changes = %v, want %v", got, want)
+	}
+}
+
+func TestAdminAuthProviderSecretsAreRedacted(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "admin_auth_provider_secrets")
+	srv := NewServer(ServerDeps{EntClient: client, Audit: audit.NewLogger(client)})
+	admin := []string{"platform:admin"}
+	const firstSecret, secondSecret = "s3cr3t-first-value", "s3cr3t-second-value"
+
+	assertRedacted := func(step string, body []byte) {
+		t.Helper()
+		if strings.Contains(string(body), firstSecret) || strings.Contains(string(body), secondSecret) {
+			t.Fatalf("%s response leaks a secret: %s", step, body)
+		}
+		if !strings.Contains(string(body), authProviderRedactedValue) {
+			t.Fatalf("%s response = %s, want %q for client_secret", step, body, authProviderRedactedValue)
+		}
+	}
+	storedSecret := func(providerID string) interface{} {
+		t.Helper()
+		return client.AuthProvider.GetX(t.Context(), providerID).Config["client_secret"]
+	}
+
+	createCtx, createW := newAuthedGinContext(t, http.MethodPost, "/admin/auth-providers",
+		`{"name":"Corp SSO","auth_type":"oidc","enabled":true,"config":{"issuer":"https://sso.example.com","client_id":"shepherd","client_secret":"`+firstSecret+`"}}`,
+		"admin-1", admin)
+	srv.CreateAuthProvider(createCtx)
+	if createW.Code != http.StatusCreated {
+		t.Fatalf("create provider status = %d, body=%s", createW.Code, createW.Body.String())
+	}
+	assertRedacted("create", createW.Body.Bytes())
+	var provider generated.AuthProvider
+	mustDecodeJSON(t, createW.Body.Bytes(), &provider)
+	if got := storedSecret(provider.Id); got != firstSecret {
+		t.Fatalf("stored client_secret = %v, want the submitted secret", got)
+	}
+
+	listCtx, listW := newAuthedGinContext(t, http.MethodGet, "/admin/auth-providers", "", "admin-1", admin)
+	srv.ListAuthProviders(listCtx)
+	if listW.Code != http.StatusOK {
+		t.Fatalf("list providers status = %d, body=%s", listW.Code, listW.Body.String())
+	}
+	assertRedacted("list", listW.Body.Bytes())
+
+	// Sending the sentinel back keeps the stored secret.
+	keepCtx, keepW := newAuthedGinContext(t, http.MethodPatch, "/admin/auth-providers/"+provider.Id,
+		`{"config":{"issuer":"https://sso.example.com","client_id":"shepherd-v2","client_secret":"`+authProviderRedactedValue+`"}}`,
+		"admin-1", admin)
+	srv.UpdateAuthProvider(keepCtx, provider.Id)
+	if keepW.Code != http.StatusOK {
+		t.Fatalf("keep-secret update status = %d, body=%s", keepW.Code, keepW.Body.String())
+	}
+	assertRedacted("keep-secret update", keepW.Body.Bytes())
+	if got := storedSecret(provider.Id); got != firstSecret {
+		t.Fatalf("stored client_secret after sentinel update = %v, want it kept", got)
+	}
+
+	rotateCtx, rotateW := newAuthedGinContext(t, http.MethodPatch, "/admin/auth-providers/"+provider.Id,
+		`{"config":{"issuer":"https://sso.example.com","client_id":"shepherd-v2","client_secret":"`+secondSecret+`"}}`,
+		"admin-1", admin)
+	srv.UpdateAuthProvider(rotateCtx, provider.Id)
+	if rotateW.Code != http.StatusOK {
+		t.Fatalf("rotate-secret update status = %d, body=%s", rotateW.Code, rotateW.Body.String())
+	}
+	assertRedacted("rotate-secret update", rotateW.Body.Bytes())
+	if got := storedSecret(provider.Id); got != secondSecret {
+		t.Fatalf("stored client_secret after rotation = %v, want the new secret", got)
+	}
+
+	entries := client.AuditLog.Query().Where(auditlog.ResourceIDEQ(provider.Id)).AllX(t.Context())
+	if len(entries) != 3 {
+		t.Fatalf("audit entries = %d, want create and two updates", len(entries))
+	}
+	for _, entry := range entries {
+		details, err := json.Marshal(entry.Details)
+		if err != nil {
+			t.Fatalf("marshal %s details: %v", entry.Action, err)
+		}
+		if strings.Contains(string(details), firstSecret) || strings.Contains(string(details), secondSecret) {
+			t.Fatalf("%s audit details leak a secret: %s", entry.Action, details)
+		}
+	}
+}
+
 func newAdminCatalogTestServer(t *testing.T) (*Server, *ent.Client) {
 	t.Helper()
 	gin.SetMode(gin.TestMode)
@@ -179,3 +930,55 @@ func mustDecodeJSON(t *testing.T, payload []byte, out any) {
 		t.Fatalf("decode json: %v; payload=%s", err, string(payload))
 	}
 }
+
+func mustCreateCatalogTemplate(t *testing.T, client *ent.Client, name string) *ent.Template {
+	t.Helper()
+
+	tpl, err := client.Template.Create().
+		SetID("tpl-" + uuid.NewString()).
+		SetName(name).
+		SetVersion(1).
+		SetEnabled(true).
+		SetCreatedBy("admin-1").
+		SetSpec(map[string]interface{}{"image": "quay.io/kubevirt/fedora:40"}).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("create template: %v", err)
+	}
+	return tpl
+}
+
+func mustSeedTemplateCreateTicket(t *testing.T, client *ent.Client, templateID string, status approvalticket.Status) string {
+	t.Helper()
+
+	eventID := "ev-" + uuid.NewString()
+	ticketID := "ticket-" + uuid.NewString()
+	payload := mustJSON(t, map[string]string{
+		"service_id":       "svc-1",
+		"template_id":      templateID,
+		"namespace":        "test-ns",
+		"requester_id":     "owner-1",
+		"instance_size_id": "size-1",
+	})
+	if _, err := client.DomainEvent.Create().
+		SetID(eventID).
+		SetEventType(string(domain.EventVMCreationRequested)).
+		SetAggregateType("vm").
+		SetAggregateID("vm-" + uuid.NewString()).
+		SetPayload([]byte(payload)).
+		SetStatus(domainevent.StatusPENDING).
+		SetCreatedBy("owner-1").
+		Save(t.Context()); err != nil {
+		t.Fatalf("create event: %v", err)
+	}
+	if _, err := client.ApprovalTicket.Create().
+		SetID(ticketID).
+		SetEventID(eventID).
+		SetRequester("owner-1").
+		SetStatus(status).
+		SetOperationType(approvalticket.OperationTypeCREATE).
+		Save(t.Context()); err != nil {
+		t.Fatalf("create ticket: %v", err)
+	}
+	return ticketID
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/usecase"
+)
+
+// ReplayDomainEvent handles POST /admin/events/{event_id}/replay.
+// Re-dispatches the River job of a PENDING or FAILED event; dry_run only
+// reports the job that would be enqueued.
+func (s *Server) ReplayDomainEvent(c *gin.Context, eventId string, params generated.ReplayDomainEventParams) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+	if s.replayEventUC == nil {
+		c.JSON(http.StatusServiceUnavailable, generated.Error{Code: "EVENT_REPLAY_UNAVAILABLE"})
+		return
+	}
+
+	out, err := s.replayEventUC.Execute(ctx, usecase.ReplayEventInput{
+		EventID: eventId,
+		DryRun:  params.DryRun,
+		Actor:   actor,
+	})
+	if err != nil {
+		if appErr, ok := apperrors.IsAppError(err); ok {
+			c.JSON(appErr.HTTPStatus, generated.Error{
+				Code:    appErr.Code,
+				Message: appErr.Message,
+				Params:  appErr.Params,
+			})
+			return
+		}
+		logger.Error("domain event replay failed",
+			zap.Error(err),
+			zap.String("event_id", eventId),
+			zap.String("actor", actor),
+		)
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	resp := generated.DomainEventReplayResponse{
+		EventId:        out.EventID,
+		EventType:      out.EventType,
+		PreviousStatus: out.PreviousStatus,
+		JobKind:        out.JobKind,
+		Queue:          out.Queue,
+		JobArgs:        replayJobArgsToAPI(out),
+		DryRun:         out.DryRun,
+		Duplicate:      out.Duplicate,
+		JobId:          out.JobID,
+	}
+	status := http.StatusAccepted
+	if out.DryRun {
+		status = http.StatusOK
+	}
+	c.JSON(status, resp)
+}
+
+func replayJobArgsToAPI(out *usecase.ReplayEventOutput) map[string]interface{} {
+	args := map[string]interface{}{}
+	raw, err := json.Marshal(out.JobArgs)
+	if err != nil {
+		return args
+	}
+	_ = json.Unmarshal(raw, &args)
+	return args
+}
@@ -1,15 +1,19 @@
 package handlers
 
 import (
+	"maps"
 	"net/http"
 	"net/http/httptest"
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
 	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/auditlog"
 	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
 	"kv-shepherd.io/shepherd/internal/testutil"
 )
 
@@ -401,6 +405,218 @@ func TestListAuthProviderTypesAndRejectUnknownType(t *testing.T) {
 	}
 }
 
+func TestAdminPermissionMatrix(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "admin_permission_matrix")
+	srv := NewServer(ServerDeps{EntClient: client})
+	seedRole := func(id, name string, builtIn bool, permissions ...string) {
+		t.Helper()
+		client.Role.Create().
+			SetID(id).
+			SetName(name).
+			SetPermissions(permissions).
+			SetBuiltIn(builtIn).
+			SaveX(t.Context())
+	}
+	seedRole("role-viewer", "Viewer", true, "vm:read", "system:read")
+	seedRole("role-auditor", "auditor", false, "audit:read", "vm:read", "vm:read")
+	seedRole("role-approver", "approver", false, "vm:read", "approval:approve")
+	seedRole("role-empty", "empty", false)
+
+	get := func(perms []string) *httptest.ResponseRecorder {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodGet, "/admin/permissions/matrix", "", "auditor-1", perms)
+		srv.GetPermissionMatrix(c)
+		return w
+	}
+
+	assertStatusAndCode(t, get([]string{"vm:read"}), http.StatusForbidden, "FORBIDDEN")
+
+	w := get([]string{"rbac:read"})
+	if w.Code != http.StatusOK {
+		t.Fatalf("matrix status = %d, want %d, body=%s", w.Code, http.StatusOK, w.Body.String())
+	}
+	var matrix generated.PermissionMatrix
+	mustDecodeJSON(t, w.Body.Bytes(), &matrix)
+	if matrix.TotalPermissions != 4 || matrix.TotalRoles != 4 || matrix.TotalAssignments != 6 {
+		t.Fatalf("totals = %d permissions, %d roles, %d assignments; want 4, 4, 6",
+			matrix.TotalPermissions, matrix.TotalRoles, matrix.TotalAssignments)
+	}
+	roleIDs := func(permission string) []string {
+		ids := make([]string, 0, len(matrix.Permissions[permission]))
+		for _, role := range matrix.Permissions[permission] {
+			ids = append(ids, role.RoleId)
+		}
+		return ids
+	}
+	if got, want := roleIDs("vm:read"), []string{"role-viewer", "role-approver", "role-auditor"}; !slices.Equal(got, want) {
+		t.Fatalf("vm:read roles = %v, want %v (built-in first, then by name)", got, want)
+	}
+	if got := matrix.Permissions["system:read"]; len(got) != 1 || !got[0].BuiltIn || got[0].RoleName != "Viewer" {
+		t.Fatalf("system:read roles = %+v, want only built-in Viewer", got)
+	}
+	if got, want := roleIDs("audit:read"), []string{"role-auditor"}; !slices.Equal(got, want) {
+		t.Fatalf("audit:read roles = %v, want %v", got, want)
+	}
+
+	// Served from the cache: a role created right after is not listed yet.
+	seedRole("role-late", "late", false, "cluster:read")
+	var cached generated.PermissionMatrix
+	mustDecodeJSON(t, get([]string{"rbac:manage"}).Body.Bytes(), &cached)
+	if _, ok := cached.Permissions["cluster:read"]; ok || cached.TotalRoles != 4 {
+		t.Fatalf("matrix recomputed within the cache TTL: %+v", cached)
+	}
+}
+
+func TestAdminRoleClone(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "admin_role_clone")
+	srv := NewServer(ServerDeps{EntClient: client, Audit: audit.NewLogger(client)})
+	source := client.Role.Create().
+		SetID("role-builtin-viewer").
+		SetName("Viewer").
+		SetDisplayName("Viewer").
+		SetDescription("Read-only access").
+		SetPermissions([]string{"system:read", "vm:read"}).
+		SetBuiltIn(true).
+		SaveX(t.Context())
+
+	clone := func(roleID, body string) *httptest.ResponseRecorder {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodPost, "/admin/roles/"+roleID+"/clone", body, "admin-1", []string{"rbac:manage"})
+		srv.CloneRole(c, roleID)
+		return w
+	}
+
+	w := clone(source.ID, `{"name":"viewer-plus","display_name":"Viewer Plus"}`)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("clone status = %d, want %d, body=%s", w.Code, http.StatusCreated, w.Body.String())
+	}
+	var cloned generated.Role
+	mustDecodeJSON(t, w.Body.Bytes(), &cloned)
+	if cloned.Id == source.ID || cloned.BuiltIn || cloned.Name != "viewer-plus" || cloned.DisplayName != "Viewer Plus" {
+		t.Fatalf("unexpected clone: %+v", cloned)
+	}
+	if !slices.Equal(cloned.Permissions, source.Permissions) || cloned.Description != source.Description || !cloned.Enabled {
+		t.Fatalf("clone did not copy source fields: %+v", cloned)
+	}
+
+	entry := client.AuditLog.Query().
+		Where(auditlog.ActionEQ("rbac.role.clone")).
+		OnlyX(t.Context())
+	if entry.ResourceID != cloned.Id || entry.Details["source_role_id"] != source.ID || entry.Details["role_id"] != cloned.Id {
+		t.Fatalf("clone audit entry = %+v, want source_role_id and role_id", entry)
+	}
+
+	for name, body := range map[string]string{
+		"existing custom name":   `{"name":"viewer-plus"}`,
+		"existing built-in name": `{"name":"Viewer"}`,
+	} {
+		w := clone(source.ID, body)
+		if w.Code != http.StatusConflict {
+			t.Fatalf("%s: clone status = %d, want %d", name, w.Code, http.StatusConflict)
+		}
+		assertErrorCode(t, w.Body.Bytes(), "ROLE_NAME_EXISTS")
+	}
+	if w := clone(source.ID, `{"name":"  "}`); w.Code != http.StatusBadRequest {
+		t.Fatalf("blank name clone status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+	if w := clone("missing", `{"name":"other"}`); w.Code != http.StatusNotFound {
+		t.Fatalf("missing source clone status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestAuthProviderSyncScheduleAndStaleMappings(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newAdminIdentityTestServer(t)
+	admin := []string{"platform:admin"}
+
+	badCtx, badW := newAuthedGinContext(t, http.MethodPost, "/admin/auth-providers",
+		`{"name":"Bad","auth_type":"ldap","config":{"groups_endpoint":"https://idp.example.com/groups"},"sync_schedule":"10s"}`,
+		"admin-1", admin)
+	srv.CreateAuthProvider(badCtx)
+	assertStatusAndCode(t, badW, http.StatusBadRequest, "INVALID_REQUEST")
+
+	createCtx, createW := newAuthedGinContext(t, http.MethodPost, "/admin/auth-providers",
+		`{"name":"Corp LDAP","auth_type":"ldap","config":{"groups_endpoint":"https://idp.example.com/groups"},"sync_schedule":" 0 3 * * * "}`,
+		"admin-1", admin)
+	srv.CreateAuthProvider(createCtx)
+	if createW.Code != http.StatusCreated {
+		t.Fatalf("create provider status = %d, body=%s", createW.Code, createW.Body.String())
+	}
+	var provider generated.AuthProvider
+	mustDecodeJSON(t, createW.Body.Bytes(), &provider)
+	if provider.SyncSchedule != "0 3 * * *" || provider.LastSync != nil {
+		t.Fatalf("provider = %+v, want trimmed schedule and no sync status yet", provider)
+	}
+
+	updateCtx, updateW := newAuthedGinContext(t, http.MethodPatch, "/admin/auth-providers/"+provider.Id,
+		`{"sync_schedule":"nightly"}`, "admin-1", admin)
+	srv.UpdateAuthProvider(updateCtx, provider.Id)
+	assertStatusAndCode(t, updateW, http.StatusBadRequest, "INVALID_REQUEST")
+
+	ctx := t.Context()
+	syncedAt := time.Now().UTC().Truncate(time.Second)
+	client.AuthProvider.UpdateOneID(provider.Id).
+		SetLastSyncAt(syncedAt).
+		SetLastSyncAdded(1).
+		SetLastSyncStale(1).
+		ExecX(ctx)
+	client.Role.Create().SetID("role-dev").SetName("Developer").SetPermissions([]string{"vm:read"}).ExecX(ctx)
+	client.IdPSyncedGroup.Create().
+		SetID("grp-devs").
+		SetProviderID(provider.Id).
+		SetExternalGroupID("devs").
+		SetGroupName("Developers").
+		ExecX(ctx)
+	client.IdPSyncedGroup.Create().
+		SetID("grp-old").
+		SetProviderID(provider.Id).
+		SetExternalGroupID("old-team").
+		SetGroupName("Old Team").
+		SetStale(true).
+		SetStaleSince(syncedAt).
+		ExecX(ctx)
+	for _, group := range []string{"devs", "old-team"} {
+		client.IdPGroupMapping.Create().
+			SetID("mapping-" + group).
+			SetProviderID(provider.Id).
+			SetExternalGroupID(group).
+			SetRoleID("role-dev").
+			SetCreatedBy("admin-1").
+			ExecX(ctx)
+	}
+
+	listCtx, listW := newAuthedGinContext(t, http.MethodGet, "/admin/auth-providers/"+provider.Id+"/group-mappings", "", "admin-1", admin)
+	srv.ListAuthProviderGroupMappings(listCtx, provider.Id)
+	if listW.Code != http.StatusOK {
+		t.Fatalf("list mappings status = %d, body=%s", listW.Code, listW.Body.String())
+	}
+	var mappings generated.IdPGroupMappingList
+	mustDecodeJSON(t, listW.Body.Bytes(), &mappings)
+	stale := map[string]bool{}
+	for _, m := range mappings.Items {
+		stale[m.ExternalGroupId] = m.GroupStale
+	}
+	if want := map[string]bool{"devs": false, "old-team": true}; !maps.Equal(stale, want) {
+		t.Fatalf("group_stale by group = %v, want %v", stale, want)
+	}
+
+	getCtx, getW := newAuthedGinContext(t, http.MethodGet, "/admin/auth-providers", "", "admin-1", admin)
+	srv.ListAuthProviders(getCtx)
+	var providers generated.AuthProviderList
+	mustDecodeJSON(t, getW.Body.Bytes(), &providers)
+	if len(providers.Items) != 1 || providers.Items[0].LastSync == nil ||
+		providers.Items[0].LastSync.Added != 1 || providers.Items[0].LastSync.Stale != 1 {
+		t.Fatalf("providers = %+v, want last_sync with 1 added and 1 stale", providers.Items)
+	}
+}
+
 func newAdminIdentityTestServer(t *testing.T) (*Server, *ent.Client) {
 	t.Helper()
 	gin.SetMode(gin.TestMode)
@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/cluster"
+	"kv-shepherd.io/shepherd/ent/instancesizecluster"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// GetAdminInstanceSize handles GET /admin/instance-sizes/{instance_size_id}.
+func (s *Server) GetAdminInstanceSize(c *gin.Context, instanceSizeId generated.InstanceSizeID) {
+	ctx, _, ok := requireActorWithAnyGlobalPermission(c, "instance_size:read")
+	if !ok {
+		return
+	}
+
+	sz, err := s.client.InstanceSize.Get(ctx, instanceSizeId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "INSTANCE_SIZE_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to get admin instance size", zap.Error(err), zap.String("instance_size_id", instanceSizeId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	clusterIDs, err := instanceSizeClusterIDs(ctx, s.client, sz.ID)
+	if err != nil {
+		logger.Error("failed to load instance size clusters", zap.Error(err), zap.String("instance_size_id", instanceSizeId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	item := instanceSizeToAPI(sz)
+	item.AllowedClusterIds = clusterIDs
+	c.JSON(http.StatusOK, item)
+}
+
+// AssociateInstanceSizeClusters handles
+// PUT /admin/instance-sizes/{instance_size_id}/associate-clusters.
+func (s *Server) AssociateInstanceSizeClusters(c *gin.Context, instanceSizeId generated.InstanceSizeID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "instance_size:write")
+	if !ok {
+		return
+	}
+
+	var req generated.AssociateInstanceSizeClustersJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+	clusterIDs := make([]string, 0, len(req.ClusterIds))
+	for _, raw := range req.ClusterIds {
+		id := strings.TrimSpace(raw)
+		if id == "" {
+			c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "cluster_ids must not contain empty values"})
+			return
+		}
+		if !slices.Contains(clusterIDs, id) {
+			clusterIDs = append(clusterIDs, id)
+		}
+	}
+	slices.Sort(clusterIDs)
+
+	sz, err := s.client.InstanceSize.Get(ctx, instanceSizeId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "INSTANCE_SIZE_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to get instance size for cluster association", zap.Error(err), zap.String("instance_size_id", instanceSizeId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if len(clusterIDs) > 0 {
+		known, err := s.client.Cluster.Query().Where(cluster.IDIn(clusterIDs...)).IDs(ctx)
+		if err != nil {
+			logger.Error("failed to query clusters for instance size association", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+			return
+		}
+		var unknown []string
+		for _, id := range clusterIDs {
+			if !slices.Contains(known, id) {
+				unknown = append(unknown, id)
+			}
+		}
+		if len(unknown) > 0 {
+			c.JSON(http.StatusBadRequest, generated.Error{
+				Code:   "CLUSTER_NOT_FOUND",
+				Params: map[string]interface{}{"unknown_clusters": unknown},
+			})
+			return
+		}
+	}
+
+	before, err := s.replaceInstanceSizeClusters(ctx, sz.ID, clusterIDs, actor)
+	if err != nil {
+		logger.Error("failed to replace instance size clusters", zap.Error(err), zap.String("instance_size_id", instanceSizeId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "instance_size.clusters_associated", "instance_size", sz.ID, actor, map[string]interface{}{
+			"before": before,
+			"after":  clusterIDs,
+		})
+	}
+
+	item := instanceSizeToAPI(sz)
+	item.AllowedClusterIds = clusterIDs
+	c.JSON(http.StatusOK, item)
+}
+
+// replaceInstanceSizeClusters swaps the size's cluster associations for
+// clusterIDs and returns the previous set.
+func (s *Server) replaceInstanceSizeClusters(ctx context.Context, sizeID string, clusterIDs []string, actor string) ([]string, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("start instance size clusters transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	before, err := instanceSizeClusterIDs(ctx, tx.Client(), sizeID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.InstanceSizeCluster.Delete().
+		Where(instancesizecluster.InstanceSizeIDEQ(sizeID)).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("delete instance size clusters: %w", err)
+	}
+	for _, clusterID := range clusterIDs {
+		id, _ := uuid.NewV7()
+		if err := tx.InstanceSizeCluster.Create().
+			SetID(id.String()).
+			SetInstanceSizeID(sizeID).
+			SetClusterID(clusterID).
+			SetCreatedBy(actor).
+			Exec(ctx); err != nil {
+			return nil, fmt.Errorf("create instance size cluster %s: %w", clusterID, err)
+		}
+	}
+	return before, tx.Commit()
+}
+
+// instanceSizeClusterIDs lists the clusters a size is restricted to, sorted.
+func instanceSizeClusterIDs(ctx context.Context, client *ent.Client, sizeID string) ([]string, error) {
+	ids, err := client.InstanceSizeCluster.Query().
+		Where(instancesizecluster.InstanceSizeIDEQ(sizeID)).
+		Order(ent.Asc(instancesizecluster.FieldClusterID)).
+		Select(instancesizecluster.FieldClusterID).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list instance size clusters: %w", err)
+	}
+	return ids, nil
+}
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/instancesize"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+const (
+	// instanceSizeBulkUpdateMaxItems bounds one reorder request.
+	instanceSizeBulkUpdateMaxItems = 500
+	// instanceSizeBulkResourceID is the audit resource ID of changes that
+	// span several instance sizes.
+	instanceSizeBulkResourceID = "bulk"
+)
+
+// BulkUpdateAdminInstanceSizes handles POST /admin/instance-sizes/bulk-update.
+func (s *Server) BulkUpdateAdminInstanceSizes(c *gin.Context) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "instance_size:write")
+	if !ok {
+		return
+	}
+
+	var req generated.BulkUpdateAdminInstanceSizesJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+	if len(req) == 0 || len(req) > instanceSizeBulkUpdateMaxItems {
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:    "INVALID_REQUEST",
+			Message: fmt.Sprintf("update between 1 and %d instance sizes per request", instanceSizeBulkUpdateMaxItems),
+		})
+		return
+	}
+	updates := make([]generated.InstanceSizeSortUpdate, 0, len(req))
+	ids := make([]string, 0, len(req))
+	for _, item := range req {
+		id := strings.TrimSpace(item.Id)
+		if id == "" {
+			c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "id must not be empty"})
+			return
+		}
+		if slices.Contains(ids, id) {
+			c.JSON(http.StatusBadRequest, generated.Error{
+				Code:    "INVALID_REQUEST",
+				Message: fmt.Sprintf("instance size %s is listed more than once", id),
+				Params:  map[string]interface{}{"id": id},
+			})
+			return
+		}
+		ids = append(ids, id)
+		updates = append(updates, generated.InstanceSizeSortUpdate{Id: id, SortOrder: item.SortOrder})
+	}
+
+	missing, updated, err := s.updateInstanceSizeSortOrders(ctx, updates)
+	if err != nil {
+		logger.Error("failed to bulk update instance size sort order", zap.Error(err), zap.Int("count", len(updates)))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if len(missing) > 0 {
+		c.JSON(http.StatusNotFound, generated.Error{
+			Code:   "INSTANCE_SIZE_NOT_FOUND",
+			Params: map[string]interface{}{"missing_ids": missing},
+		})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "instance_size.bulk_sort_update", "instance_size", instanceSizeBulkResourceID, actor, map[string]interface{}{
+			"count": updated,
+			"ids":   ids,
+		})
+	}
+
+	sizes, err := s.client.InstanceSize.Query().
+		Where(instancesize.IDIn(ids...)).
+		Order(ent.Asc(instancesize.FieldSortOrder), ent.Asc(instancesize.FieldName)).
+		All(ctx)
+	if err != nil {
+		logger.Error("failed to list reordered instance sizes", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	items := make([]generated.InstanceSize, 0, len(sizes))
+	for _, sz := range sizes {
+		items = append(items, instanceSizeToAPI(sz))
+	}
+	c.JSON(http.StatusOK, generated.InstanceSizeList{Items: items})
+}
+
+// updateInstanceSizeSortOrders applies updates in one transaction. When any
+// ID is unknown nothing is written and the unknown IDs are returned instead.
+func (s *Server) updateInstanceSizeSortOrders(ctx context.Context, updates []generated.InstanceSizeSortUpdate) ([]string, int, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("start instance size sort transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	ids := make([]string, 0, len(updates))
+	for _, u := range updates {
+		ids = append(ids, u.Id)
+	}
+	known, err := tx.InstanceSize.Query().Where(instancesize.IDIn(ids...)).IDs(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query instance sizes: %w", err)
+	}
+	var missing []string
+	for _, id := range ids {
+		if !slices.Contains(known, id) {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		return missing, 0, nil
+	}
+
+	updated := 0
+	for _, u := range updates {
+		n, err := tx.InstanceSize.Update().
+			Where(instancesize.IDEQ(u.Id)).
+			SetSortOrder(u.SortOrder).
+			Save(ctx)
+		if err != nil {
+			return nil, 0, fmt.Errorf("update sort order of instance size %s: %w", u.Id, err)
+		}
+		updated += n
+	}
+	return nil, updated, tx.Commit()
+}
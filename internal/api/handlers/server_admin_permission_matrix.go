@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// permissionMatrixCacheTTL absorbs bursts of audit traffic; role changes
+// show up in the matrix within it.
+const permissionMatrixCacheTTL = 10 * time.Second
+
+// permissionMatrixCache holds the last computed matrix.
+type permissionMatrixCache struct {
+	mu         sync.Mutex
+	matrix     *generated.PermissionMatrix
+	computedAt time.Time
+}
+
+// get returns the cached matrix, recomputing it with load once it is older
+// than permissionMatrixCacheTTL. The lock is held while loading so a burst
+// of requests queries the roles once.
+func (m *permissionMatrixCache) get(ctx context.Context, load func(context.Context) (*generated.PermissionMatrix, error)) (*generated.PermissionMatrix, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.matrix != nil && time.Since(m.computedAt) < permissionMatrixCacheTTL {
+		return m.matrix, nil
+	}
+	matrix, err := load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m.matrix, m.computedAt = matrix, time.Now()
+	return matrix, nil
+}
+
+// GetPermissionMatrix handles GET /admin/permissions/matrix.
+func (s *Server) GetPermissionMatrix(c *gin.Context) {
+	ctx, _, ok := requireActorWithAnyGlobalPermission(c, "rbac:read", "rbac:manage")
+	if !ok {
+		return
+	}
+
+	matrix, err := s.permissionMatrix.get(ctx, s.loadPermissionMatrix)
+	if err != nil {
+		logger.Error("failed to build permission matrix", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	c.JSON(http.StatusOK, matrix)
+}
+
+func (s *Server) loadPermissionMatrix(ctx context.Context) (*generated.PermissionMatrix, error) {
+	roles, err := s.client.Role.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return buildPermissionMatrix(roles), nil
+}
+
+// buildPermissionMatrix inverts role permissions into permission → roles,
+// built-in roles first and each group sorted by name.
+func buildPermissionMatrix(roles []*ent.Role) *generated.PermissionMatrix {
+	sorted := make([]*ent.Role, len(roles))
+	copy(sorted, roles)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].BuiltIn != sorted[j].BuiltIn {
+			return sorted[i].BuiltIn
+		}
+		if sorted[i].Name != sorted[j].Name {
+			return sorted[i].Name < sorted[j].Name
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	matrix := &generated.PermissionMatrix{
+		Permissions: make(map[string][]generated.PermissionMatrixRole),
+		TotalRoles:  len(roles),
+	}
+	for _, role := range sorted {
+		seen := make(map[string]struct{}, len(role.Permissions))
+		for _, permission := range role.Permissions {
+			if _, dup := seen[permission]; dup {
+				continue
+			}
+			seen[permission] = struct{}{}
+			matrix.Permissions[permission] = append(matrix.Permissions[permission], generated.PermissionMatrixRole{
+				RoleId:   role.ID,
+				RoleName: role.Name,
+				BuiltIn:  role.BuiltIn,
+				Enabled:  role.Enabled,
+			})
+			matrix.TotalAssignments++
+		}
+	}
+	matrix.TotalPermissions = len(matrix.Permissions)
+	return matrix
+}
@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"net"
+	"net/http"
+	"net/mail"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/platformconfig"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// GetPlatformConfig handles GET /admin/platform-config.
+func (s *Server) GetPlatformConfig(c *gin.Context) {
+	ctx, _, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+
+	cfg, err := s.client.PlatformConfig.Get(ctx, platformconfig.DefaultID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusOK, generated.PlatformConfig{})
+			return
+		}
+		logger.Error("failed to get platform config", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	c.JSON(http.StatusOK, platformConfigToAPI(cfg))
+}
+
+// PatchPlatformConfig handles PATCH /admin/platform-config.
+func (s *Server) PatchPlatformConfig(c *gin.Context) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+
+	var req generated.PlatformConfigPatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+	if req.ApprovalTtlHours != nil && *req.ApprovalTtlHours < 0 {
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:    "INVALID_REQUEST",
+			Message: "approval_ttl_hours must not be negative",
+		})
+		return
+	}
+	if msg := validateSMTPPatch(&req.Smtp); msg != "" {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: msg})
+		return
+	}
+
+	existing, err := s.client.PlatformConfig.Get(ctx, platformconfig.DefaultID)
+	if err != nil && !ent.IsNotFound(err) {
+		logger.Error("failed to get platform config", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	var saved *ent.PlatformConfig
+	if existing == nil {
+		create := s.client.PlatformConfig.Create().SetUpdatedBy(actor)
+		if req.ApprovalTtlHours != nil {
+			create = create.SetApprovalTTLHours(*req.ApprovalTtlHours)
+		}
+		if req.AllowUserData != nil {
+			create = create.SetAllowUserData(*req.AllowUserData)
+		}
+		applySMTPPatch(create.Mutation(), req.Smtp)
+		saved, err = create.Save(ctx)
+	} else {
+		update := existing.Update().SetUpdatedBy(actor)
+		if req.ApprovalTtlHours != nil {
+			update = update.SetApprovalTTLHours(*req.ApprovalTtlHours)
+		}
+		if req.AllowUserData != nil {
+			update = update.SetAllowUserData(*req.AllowUserData)
+		}
+		applySMTPPatch(update.Mutation(), req.Smtp)
+		saved, err = update.Save(ctx)
+	}
+	if err != nil {
+		logger.Error("failed to save platform config", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "platform_config.update", "platform_config", saved.ID, actor, map[string]interface{}{
+			"approval_ttl_hours":    saved.ApprovalTTLHours,
+			"smtp_host":             saved.SMTPHost,
+			"smtp_port":             saved.SMTPPort,
+			"smtp_username":         saved.SMTPUsername,
+			"smtp_from_address":     saved.SMTPFromAddress,
+			"smtp_password_changed": req.Smtp.Password != nil,
+			"allow_user_data":       saved.AllowUserData,
+		})
+	}
+	c.JSON(http.StatusOK, platformConfigToAPI(saved))
+}
+
+// validateSMTPPatch normalizes the SMTP section in place and returns a
+// message for the first invalid field.
+func validateSMTPPatch(p *generated.SMTPSettingsPatch) string {
+	if p.Host != nil {
+		host := strings.TrimSpace(*p.Host)
+		if strings.ContainsAny(host, " /") || (strings.Contains(host, ":") && net.ParseIP(host) == nil) {
+			return "smtp.host must be a host name or IP address without port"
+		}
+		p.Host = &host
+	}
+	if p.Port != nil && (*p.Port < 1 || *p.Port > 65535) {
+		return "smtp.port must be between 1 and 65535"
+	}
+	if p.Username != nil {
+		username := strings.TrimSpace(*p.Username)
+		p.Username = &username
+	}
+	if p.FromAddress != nil {
+		from := strings.TrimSpace(*p.FromAddress)
+		if from != "" {
+			addr, err := mail.ParseAddress(from)
+			if err != nil || addr.Address != from {
+				return "smtp.from_address must be a plain email address"
+			}
+		}
+		p.FromAddress = &from
+	}
+	return ""
+}
+
+// applySMTPPatch sets the SMTP fields present in p; an empty password clears
+// the stored one.
+func applySMTPPatch(m *ent.PlatformConfigMutation, p generated.SMTPSettingsPatch) {
+	if p.Host != nil {
+		m.SetSMTPHost(*p.Host)
+	}
+	if p.Port != nil {
+		m.SetSMTPPort(*p.Port)
+	}
+	if p.Username != nil {
+		m.SetSMTPUsername(*p.Username)
+	}
+	if p.Password != nil {
+		m.SetSMTPPassword(*p.Password)
+	}
+	if p.FromAddress != nil {
+		m.SetSMTPFromAddress(*p.FromAddress)
+	}
+}
+
+func platformConfigToAPI(cfg *ent.PlatformConfig) generated.PlatformConfig {
+	updatedAt := cfg.UpdatedAt
+	return generated.PlatformConfig{
+		ApprovalTtlHours: cfg.ApprovalTTLHours,
+		Smtp: generated.SMTPSettings{
+			Host:        cfg.SMTPHost,
+			Port:        cfg.SMTPPort,
+			Username:    cfg.SMTPUsername,
+			FromAddress: cfg.SMTPFromAddress,
+			PasswordSet: cfg.SMTPPassword != "",
+		},
+		AllowUserData: cfg.AllowUserData,
+		UpdatedBy:     cfg.UpdatedBy,
+		UpdatedAt:     &updatedAt,
+	}
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/ent/platformconfig"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestAdminPlatformConfig(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "admin_platform_config")
+	srv := NewServer(ServerDeps{EntClient: client})
+	admin := []string{"platform:admin"}
+
+	get := func(perms []string) (int, generated.PlatformConfig) {
+		c, w := newAuthedGinContext(t, http.MethodGet, "/admin/platform-config", "", "admin-1", perms)
+		srv.GetPlatformConfig(c)
+		var resp generated.PlatformConfig
+		if w.Code == http.StatusOK {
+			mustDecodeJSON(t, w.Body.Bytes(), &resp)
+		}
+		return w.Code, resp
+	}
+	patch := func(perms []string, body any) (int, generated.PlatformConfig) {
+		c, w := newAuthedGinContext(t, http.MethodPatch, "/admin/platform-config", mustJSON(t, body), "admin-1", perms)
+		srv.PatchPlatformConfig(c)
+		var resp generated.PlatformConfig
+		if w.Code == http.StatusOK {
+			mustDecodeJSON(t, w.Body.Bytes(), &resp)
+		}
+		return w.Code, resp
+	}
+
+	if code, _ := get([]string{"approval:view"}); code != http.StatusForbidden {
+		t.Fatalf("non-admin get status = %d, want %d", code, http.StatusForbidden)
+	}
+	code, cfg := get(admin)
+	if code != http.StatusOK || cfg.ApprovalTtlHours != 0 || cfg.UpdatedAt != nil {
+		t.Fatalf("unsaved config status=%d body=%+v", code, cfg)
+	}
+
+	if code, _ := patch([]string{"approval:view"}, map[string]any{"approval_ttl_hours": 24}); code != http.StatusForbidden {
+		t.Fatalf("non-admin patch status = %d, want %d", code, http.StatusForbidden)
+	}
+	if code, _ := patch(admin, map[string]any{"approval_ttl_hours": -1}); code != http.StatusBadRequest {
+		t.Fatalf("negative ttl status = %d, want %d", code, http.StatusBadRequest)
+	}
+
+	code, cfg = patch(admin, map[string]any{"approval_ttl_hours": 72})
+	if code != http.StatusOK || cfg.ApprovalTtlHours != 72 || cfg.UpdatedBy != "admin-1" {
+		t.Fatalf("patch status=%d body=%+v", code, cfg)
+	}
+	// Omitted fields keep their value.
+	if code, cfg = patch(admin, map[string]any{}); code != http.StatusOK || cfg.ApprovalTtlHours != 72 {
+		t.Fatalf("empty patch status=%d body=%+v", code, cfg)
+	}
+	if code, cfg = get(admin); code != http.StatusOK || cfg.ApprovalTtlHours != 72 || cfg.UpdatedAt == nil {
+		t.Fatalf("saved config status=%d body=%+v", code, cfg)
+	}
+	if n := client.PlatformConfig.Query().Where(platformconfig.IDEQ(platformconfig.DefaultID)).CountX(t.Context()); n != 1 {
+		t.Fatalf("platform config rows = %d, want 1", n)
+	}
+
+	for _, smtp := range []map[string]any{
+		{"port": 0},
+		{"host": "smtp.example.com:587"},
+		{"from_address": "Shepherd <noreply@example.com>"},
+	} {
+		if code, _ := patch(admin, map[string]any{"smtp": smtp}); code != http.StatusBadRequest {
+			t.Fatalf("invalid smtp %v status = %d, want %d", smtp, code, http.StatusBadRequest)
+		}
+	}
+	code, cfg = patch(admin, map[string]any{"smtp": map[string]any{
+		"host": " smtp.example.com ", "port": 2525, "username": "mailer", "password": "hunter2", "from_address": "noreply@example.com",
+	}})
+	if code != http.StatusOK || cfg.Smtp.Host != "smtp.example.com" || cfg.Smtp.Port != 2525 || !cfg.Smtp.PasswordSet || cfg.ApprovalTtlHours != 72 {
+		t.Fatalf("smtp patch status=%d body=%+v", code, cfg)
+	}
+	c, w := newAuthedGinContext(t, http.MethodGet, "/admin/platform-config", "", "admin-1", admin)
+	srv.GetPlatformConfig(c)
+	if strings.Contains(w.Body.String(), "hunter2") {
+		t.Fatal("platform config response exposes smtp password")
+	}
+	// Omitted smtp fields keep their value; an empty password clears it.
+	code, cfg = patch(admin, map[string]any{"smtp": map[string]any{"password": ""}})
+	if code != http.StatusOK || cfg.Smtp.PasswordSet || cfg.Smtp.Username != "mailer" || cfg.Smtp.FromAddress != "noreply@example.com" {
+		t.Fatalf("password clear status=%d body=%+v", code, cfg)
+	}
+}
@@ -0,0 +1,307 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/quota"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/service"
+)
+
+// ListQuotas handles GET /admin/quotas.
+func (s *Server) ListQuotas(c *gin.Context, params generated.ListQuotasParams) {
+	ctx, _, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+
+	page, perPage := defaultPagination(params.Page, params.PerPage)
+	query := s.client.Quota.Query()
+	if params.ScopeType != "" {
+		scopeType := quota.ScopeType(params.ScopeType)
+		if err := quota.ScopeTypeValidator(scopeType); err != nil {
+			c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "scope_type must be system or namespace"})
+			return
+		}
+		query = query.Where(quota.ScopeTypeEQ(scopeType))
+	}
+	if params.ScopeId != "" {
+		query = query.Where(quota.ScopeIDEQ(params.ScopeId))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		logger.Error("failed to count quotas", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	quotas, err := query.
+		Order(quota.ByScopeType(), quota.ByScopeID()).
+		Offset((page - 1) * perPage).
+		Limit(perPage).
+		All(ctx)
+	if err != nil {
+		logger.Error("failed to list quotas", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	checker := service.NewQuotaChecker(s.client)
+	items := make([]generated.Quota, 0, len(quotas))
+	for _, q := range quotas {
+		out, err := quotaToAPI(ctx, checker, q)
+		if err != nil {
+			logger.Error("failed to compute quota usage", zap.Error(err), zap.String("quota_id", q.ID))
+			c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+			return
+		}
+		items = append(items, out)
+	}
+	c.JSON(http.StatusOK, generated.QuotaList{
+		Items: items,
+		Pagination: generated.Pagination{
+			Page:       page,
+			PerPage:    perPage,
+			Total:      total,
+			TotalPages: (total + perPage - 1) / perPage,
+		},
+	})
+}
+
+// CreateQuota handles POST /admin/quotas.
+func (s *Server) CreateQuota(c *gin.Context) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+
+	var req generated.QuotaCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+	limits := generated.QuotaLimits{MaxVms: req.MaxVms, MaxCpuCores: req.MaxCpuCores, MaxMemoryMb: req.MaxMemoryMb}
+	if !validateQuotaLimits(c, limits) {
+		return
+	}
+	if limits.MaxVms == nil && limits.MaxCpuCores == nil && limits.MaxMemoryMb == nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "at least one of max_vms, max_cpu_cores or max_memory_mb is required"})
+		return
+	}
+
+	var scopeID string
+	switch req.ScopeType {
+	case generated.QuotaScopeTypeNamespace:
+		ns, ok := s.resolveNamespaceRef(c, req.ScopeId)
+		if !ok {
+			return
+		}
+		scopeID = ns.Name
+	case generated.QuotaScopeTypeSystem:
+		sys, err := s.client.System.Get(ctx, req.ScopeId)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, generated.Error{Code: "SYSTEM_NOT_FOUND"})
+				return
+			}
+			logger.Error("failed to look up quota system", zap.Error(err), zap.String("system_id", req.ScopeId))
+			c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+			return
+		}
+		scopeID = sys.ID
+	default:
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "scope_type must be system or namespace"})
+		return
+	}
+
+	id, _ := uuid.NewV7()
+	created, err := s.client.Quota.Create().
+		SetID(id.String()).
+		SetScopeType(quota.ScopeType(req.ScopeType)).
+		SetScopeID(scopeID).
+		SetNillableMaxVms(limits.MaxVms).
+		SetNillableMaxCPUCores(limits.MaxCpuCores).
+		SetNillableMaxMemoryMB(limits.MaxMemoryMb).
+		SetCreatedBy(actor).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			c.JSON(http.StatusConflict, generated.Error{
+				Code:   "QUOTA_EXISTS",
+				Params: map[string]interface{}{"scope_type": req.ScopeType, "scope_id": scopeID},
+			})
+			return
+		}
+		logger.Error("failed to create quota", zap.Error(err), zap.String("scope_id", scopeID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "quota.create", "quota", created.ID, actor, quotaAuditDetails(created))
+	}
+
+	s.respondQuota(c, http.StatusCreated, created)
+}
+
+// GetQuota handles GET /admin/quotas/{quota_id}.
+func (s *Server) GetQuota(c *gin.Context, quotaId generated.QuotaID) {
+	if _, _, ok := requireActorWithAnyGlobalPermission(c, "platform:admin"); !ok {
+		return
+	}
+
+	q, ok := s.getQuota(c, quotaId)
+	if !ok {
+		return
+	}
+	s.respondQuota(c, http.StatusOK, q)
+}
+
+// UpdateQuota handles PUT /admin/quotas/{quota_id}.
+func (s *Server) UpdateQuota(c *gin.Context, quotaId generated.QuotaID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+
+	var req generated.QuotaLimits
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+	if !validateQuotaLimits(c, req) {
+		return
+	}
+
+	existing, ok := s.getQuota(c, quotaId)
+	if !ok {
+		return
+	}
+	updated, err := existing.Update().
+		ClearMaxVms().
+		ClearMaxCPUCores().
+		ClearMaxMemoryMB().
+		SetNillableMaxVms(req.MaxVms).
+		SetNillableMaxCPUCores(req.MaxCpuCores).
+		SetNillableMaxMemoryMB(req.MaxMemoryMb).
+		Save(ctx)
+	if err != nil {
+		logger.Error("failed to update quota", zap.Error(err), zap.String("quota_id", quotaId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		details := quotaAuditDetails(updated)
+		details["previous"] = quotaAuditDetails(existing)
+		_ = s.audit.LogAction(ctx, "quota.update", "quota", updated.ID, actor, details)
+	}
+
+	s.respondQuota(c, http.StatusOK, updated)
+}
+
+// DeleteQuota handles DELETE /admin/quotas/{quota_id}.
+func (s *Server) DeleteQuota(c *gin.Context, quotaId generated.QuotaID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+
+	q, ok := s.getQuota(c, quotaId)
+	if !ok {
+		return
+	}
+	if err := s.client.Quota.DeleteOne(q).Exec(ctx); err != nil {
+		logger.Error("failed to delete quota", zap.Error(err), zap.String("quota_id", quotaId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "quota.delete", "quota", q.ID, actor, quotaAuditDetails(q))
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (s *Server) getQuota(c *gin.Context, quotaID string) (*ent.Quota, bool) {
+	q, err := s.client.Quota.Get(c.Request.Context(), quotaID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "QUOTA_NOT_FOUND"})
+			return nil, false
+		}
+		logger.Error("failed to get quota", zap.Error(err), zap.String("quota_id", quotaID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return nil, false
+	}
+	return q, true
+}
+
+func (s *Server) respondQuota(c *gin.Context, status int, q *ent.Quota) {
+	out, err := quotaToAPI(c.Request.Context(), service.NewQuotaChecker(s.client), q)
+	if err != nil {
+		logger.Error("failed to compute quota usage", zap.Error(err), zap.String("quota_id", q.ID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	c.JSON(status, out)
+}
+
+func validateQuotaLimits(c *gin.Context, limits generated.QuotaLimits) bool {
+	for field, limit := range map[string]*int{
+		"max_vms":       limits.MaxVms,
+		"max_cpu_cores": limits.MaxCpuCores,
+		"max_memory_mb": limits.MaxMemoryMb,
+	} {
+		if limit != nil && *limit < 0 {
+			c.JSON(http.StatusBadRequest, generated.Error{
+				Code:    "INVALID_REQUEST",
+				Message: field + " must be >= 0",
+				Params:  map[string]interface{}{"field": field},
+			})
+			return false
+		}
+	}
+	return true
+}
+
+func quotaAuditDetails(q *ent.Quota) map[string]interface{} {
+	return map[string]interface{}{
+		"scope_type":    string(q.ScopeType),
+		"scope_id":      q.ScopeID,
+		"max_vms":       q.MaxVms,
+		"max_cpu_cores": q.MaxCPUCores,
+		"max_memory_mb": q.MaxMemoryMB,
+	}
+}
+
+func quotaToAPI(ctx context.Context, checker *service.QuotaChecker, q *ent.Quota) (generated.Quota, error) {
+	usage, err := checker.Usage(ctx, q.ScopeType, q.ScopeID)
+	if err != nil {
+		return generated.Quota{}, err
+	}
+	return generated.Quota{
+		Id:          q.ID,
+		ScopeType:   generated.QuotaScopeType(q.ScopeType),
+		ScopeId:     q.ScopeID,
+		MaxVms:      q.MaxVms,
+		MaxCpuCores: q.MaxCPUCores,
+		MaxMemoryMb: q.MaxMemoryMB,
+		Usage: generated.QuotaUsage{
+			Vms:      usage.VMs,
+			CpuCores: usage.CPUCores,
+			MemoryMb: usage.MemoryMB,
+		},
+		CreatedBy: q.CreatedBy,
+		CreatedAt: q.CreatedAt,
+		UpdatedAt: q.UpdatedAt,
+	}, nil
+}
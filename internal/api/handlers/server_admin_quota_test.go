@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+)
+
+func TestAdminQuotaCRUD(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newSystemBehaviorTestServer(t)
+	sys := mustCreateSystem(t, client, "sys-quota", "shop", "owner-1")
+	svc := mustCreateService(t, client, "svc-quota", "redis", sys.ID, "cache")
+	mustCreateVMForService(t, client, "vm-quota-1", "ns-test-shop-redis-01", svc.ID)
+	failed := mustCreateVMForService(t, client, "vm-quota-2", "ns-test-shop-redis-02", svc.ID)
+	failed.Update().SetStatus(entvm.StatusFAILED).ExecX(t.Context())
+	admin := []string{"platform:admin"}
+
+	create := func(body string) (int, generated.Quota, []byte) {
+		c, w := newAuthedGinContext(t, http.MethodPost, "/admin/quotas", body, "admin-1", admin)
+		srv.CreateQuota(c)
+		var out generated.Quota
+		if w.Code == http.StatusCreated {
+			mustDecodeJSON(t, w.Body.Bytes(), &out)
+		}
+		return w.Code, out, w.Body.Bytes()
+	}
+
+	code, created, raw := create(`{"scope_type":"system","scope_id":"sys-quota","max_vms":3,"max_memory_mb":8192}`)
+	if code != http.StatusCreated {
+		t.Fatalf("create status = %d, body=%s", code, raw)
+	}
+	if created.MaxVms == nil || *created.MaxVms != 3 || created.MaxCpuCores != nil || created.Usage.Vms != 1 {
+		t.Fatalf("created quota = %+v, want max_vms 3, unlimited cpu and 1 counted VM", created)
+	}
+	if code, _, _ := create(`{"scope_type":"system","scope_id":"sys-quota","max_vms":5}`); code != http.StatusConflict {
+		t.Fatalf("duplicate create status = %d, want %d", code, http.StatusConflict)
+	}
+	if code, _, _ := create(`{"scope_type":"system","scope_id":"sys-missing","max_vms":5}`); code != http.StatusNotFound {
+		t.Fatalf("unknown system status = %d, want %d", code, http.StatusNotFound)
+	}
+	if code, _, _ := create(`{"scope_type":"system","scope_id":"sys-quota"}`); code != http.StatusBadRequest {
+		t.Fatalf("no limits status = %d, want %d", code, http.StatusBadRequest)
+	}
+
+	// PUT replaces the limits; omitted ones become unlimited.
+	c, w := newAuthedGinContext(t, http.MethodPut, "/admin/quotas/"+created.Id, `{"max_cpu_cores":16}`, "admin-1", admin)
+	srv.UpdateQuota(c, created.Id)
+	if w.Code != http.StatusOK {
+		t.Fatalf("update status = %d, body=%s", w.Code, w.Body.String())
+	}
+	var updated generated.Quota
+	mustDecodeJSON(t, w.Body.Bytes(), &updated)
+	if updated.MaxVms != nil || updated.MaxMemoryMb != nil || updated.MaxCpuCores == nil || *updated.MaxCpuCores != 16 {
+		t.Fatalf("updated quota = %+v, want only max_cpu_cores 16", updated)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodGet, "/admin/quotas?scope_type=system", "", "admin-1", admin)
+	srv.ListQuotas(c, generated.ListQuotasParams{ScopeType: generated.QuotaScopeTypeSystem})
+	var list generated.QuotaList
+	mustDecodeJSON(t, w.Body.Bytes(), &list)
+	if len(list.Items) != 1 || list.Items[0].Id != created.Id {
+		t.Fatalf("list = %+v, want the system quota", list.Items)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodDelete, "/admin/quotas/"+created.Id, "", "admin-1", []string{"vm:read"})
+	srv.DeleteQuota(c, created.Id)
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("delete without platform:admin status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+	c, _ = newAuthedGinContext(t, http.MethodDelete, "/admin/quotas/"+created.Id, "", "admin-1", admin)
+	srv.DeleteQuota(c, created.Id)
+	if got := c.Writer.Status(); got != http.StatusNoContent {
+		t.Fatalf("delete status = %d, want %d", got, http.StatusNoContent)
+	}
+	if n := client.Quota.Query().CountX(t.Context()); n != 0 {
+		t.Fatalf("quotas after delete = %d, want 0", n)
+	}
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
 	"sort"
 	"strings"
@@ -119,19 +120,7 @@ func (s *Server) CreateRateLimitExemption(c *gin.Context) {
 		})
 	}
 
-	expiresAt := time.Time{}
-	if saved.ExpiresAt != nil {
-		expiresAt = *saved.ExpiresAt
-	}
-
-	c.JSON(http.StatusOK, generated.RateLimitExemption{
-		UserId:     saved.ID,
-		ExemptedBy: saved.ExemptedBy,
-		Reason:     saved.Reason,
-		ExpiresAt:  expiresAt,
-		CreatedAt:  saved.CreatedAt,
-		UpdatedAt:  saved.UpdatedAt,
-	})
+	c.JSON(http.StatusOK, rateLimitExemptionToAPI(saved))
 }
 
 // DeleteRateLimitExemption handles DELETE /admin/rate-limits/exemptions/{user_id}.
@@ -286,29 +275,336 @@ func (s *Server) UpdateRateLimitUserOverrides(c *gin.Context, userId string) {
 		})
 	}
 
-	maxPendingParents := 0
-	if saved.MaxPendingParents != nil {
-		maxPendingParents = *saved.MaxPendingParents
+	c.JSON(http.StatusOK, rateLimitUserOverrideToAPI(saved))
+}
+
+// GetRateLimitUserOverride handles GET /admin/rate-limits/user-overrides/{user_id}.
+func (s *Server) GetRateLimitUserOverride(c *gin.Context, userId generated.UserID) {
+	ctx, _, ok := requireActorWithAnyGlobalPermission(c, "rate_limit:manage")
+	if !ok {
+		return
 	}
-	maxPendingChildren := 0
-	if saved.MaxPendingChildren != nil {
-		maxPendingChildren = *saved.MaxPendingChildren
+
+	userID := strings.TrimSpace(userId)
+	override, err := s.client.RateLimitUserOverride.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "RATE_LIMIT_OVERRIDE_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to get rate-limit override", zap.Error(err), zap.String("user_id", userID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
 	}
-	cooldownSeconds := 0
-	if saved.CooldownSeconds != nil {
-		cooldownSeconds = *saved.CooldownSeconds
+
+	s.respondRateLimitUserOverride(c, http.StatusOK, override)
+}
+
+// CreateRateLimitUserOverride handles POST /admin/rate-limits/user-overrides/{user_id}.
+// Unlike the PUT upsert, every limit is required and must be positive, and an
+// existing override is reported as a conflict.
+func (s *Server) CreateRateLimitUserOverride(c *gin.Context, userId generated.UserID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "rate_limit:manage")
+	if !ok {
+		return
 	}
 
-	c.JSON(http.StatusOK, generated.RateLimitUserOverride{
-		UserId:             saved.ID,
-		MaxPendingParents:  maxPendingParents,
-		MaxPendingChildren: maxPendingChildren,
-		CooldownSeconds:    cooldownSeconds,
-		Reason:             saved.Reason,
-		UpdatedBy:          saved.UpdatedBy,
-		CreatedAt:          saved.CreatedAt,
-		UpdatedAt:          saved.UpdatedAt,
-	})
+	userID := strings.TrimSpace(userId)
+	var req generated.RateLimitUserOverrideCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+	if !validateRateLimitOverrideValues(c, &req.MaxPendingParents, &req.MaxPendingChildren, &req.CooldownSeconds) {
+		return
+	}
+	if _, err := s.client.User.Get(ctx, userID); err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "USER_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to query user for rate-limit override", zap.Error(err), zap.String("user_id", userID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	create := s.client.RateLimitUserOverride.Create().
+		SetID(userID).
+		SetMaxPendingParents(req.MaxPendingParents).
+		SetMaxPendingChildren(req.MaxPendingChildren).
+		SetCooldownSeconds(req.CooldownSeconds).
+		SetUpdatedBy(actor)
+	if reason := strings.TrimSpace(req.Reason); reason != "" {
+		create = create.SetReason(reason)
+	}
+	saved, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			c.JSON(http.StatusConflict, generated.Error{Code: "RATE_LIMIT_OVERRIDE_EXISTS"})
+			return
+		}
+		logger.Error("failed to create rate-limit override", zap.Error(err), zap.String("user_id", userID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "admin.rate_limit.override.create", "user", userID, actor, map[string]interface{}{
+			"max_pending_parents":  req.MaxPendingParents,
+			"max_pending_children": req.MaxPendingChildren,
+			"cooldown_seconds":     req.CooldownSeconds,
+		})
+	}
+
+	s.respondRateLimitUserOverride(c, http.StatusCreated, saved)
+}
+
+// PatchRateLimitUserOverride handles PATCH /admin/rate-limits/user-overrides/{user_id}.
+func (s *Server) PatchRateLimitUserOverride(c *gin.Context, userId generated.UserID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "rate_limit:manage")
+	if !ok {
+		return
+	}
+
+	userID := strings.TrimSpace(userId)
+	var req rateLimitUserOverrideRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+	if req.MaxPendingParents == nil && req.MaxPendingChildren == nil && req.CooldownSeconds == nil && req.Reason == nil {
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:    "INVALID_REQUEST",
+			Message: "at least one override field must be provided",
+		})
+		return
+	}
+	if !validateRateLimitOverrideValues(c, req.MaxPendingParents, req.MaxPendingChildren, req.CooldownSeconds) {
+		return
+	}
+
+	existing, err := s.client.RateLimitUserOverride.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "RATE_LIMIT_OVERRIDE_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to get rate-limit override", zap.Error(err), zap.String("user_id", userID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	update := existing.Update().SetUpdatedBy(actor)
+	changes := map[string]interface{}{}
+	if req.MaxPendingParents != nil {
+		update = update.SetMaxPendingParents(*req.MaxPendingParents)
+		changes["max_pending_parents"] = *req.MaxPendingParents
+	}
+	if req.MaxPendingChildren != nil {
+		update = update.SetMaxPendingChildren(*req.MaxPendingChildren)
+		changes["max_pending_children"] = *req.MaxPendingChildren
+	}
+	if req.CooldownSeconds != nil {
+		update = update.SetCooldownSeconds(*req.CooldownSeconds)
+		changes["cooldown_seconds"] = *req.CooldownSeconds
+	}
+	if req.Reason != nil {
+		if reason := strings.TrimSpace(*req.Reason); reason == "" {
+			update = update.ClearReason()
+		} else {
+			update = update.SetReason(reason)
+		}
+	}
+	saved, err := update.Save(ctx)
+	if err != nil {
+		logger.Error("failed to update rate-limit override", zap.Error(err), zap.String("user_id", userID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "admin.rate_limit.override.update", "user", userID, actor, changes)
+	}
+
+	s.respondRateLimitUserOverride(c, http.StatusOK, saved)
+}
+
+// validateRateLimitOverrideValues rejects zero or negative limits. Nil values
+// are left alone so PATCH can omit fields.
+func validateRateLimitOverrideValues(c *gin.Context, maxParents, maxChildren, cooldown *int) bool {
+	for _, f := range []struct {
+		name  string
+		value *int
+	}{
+		{"max_pending_parents", maxParents},
+		{"max_pending_children", maxChildren},
+		{"cooldown_seconds", cooldown},
+	} {
+		if f.value != nil && *f.value < 1 {
+			c.JSON(http.StatusBadRequest, generated.Error{
+				Code:    "INVALID_REQUEST",
+				Message: f.name + " must be >= 1",
+				Params:  map[string]interface{}{"field": f.name},
+			})
+			return false
+		}
+	}
+	return true
+}
+
+// respondRateLimitUserOverride writes the override together with the policy
+// batch submission would currently apply to the user.
+func (s *Server) respondRateLimitUserOverride(c *gin.Context, status int, ov *ent.RateLimitUserOverride) {
+	policy, err := s.effectiveRateLimitPolicy(c.Request.Context(), ov.ID)
+	if err != nil {
+		logger.Error("failed to resolve rate-limit policy", zap.Error(err), zap.String("user_id", ov.ID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	out := rateLimitUserOverrideToAPI(ov)
+	out.EffectivePolicy = policy
+	c.JSON(status, out)
+}
+
+// ListRateLimitExemptions handles GET /admin/rate-limits/exemptions.
+// Expired rows are purged rather than listed, the same way
+// resolveBatchUserLimitPolicy drops them when a user next submits a batch.
+func (s *Server) ListRateLimitExemptions(c *gin.Context) {
+	ctx, _, ok := requireActorWithAnyGlobalPermission(c, "rate_limit:manage")
+	if !ok {
+		return
+	}
+
+	now := time.Now().UTC()
+	purged, err := s.client.RateLimitExemption.Delete().
+		Where(ratelimitexemption.ExpiresAtLT(now)).
+		Exec(ctx)
+	if err != nil {
+		logger.Warn("failed to purge expired rate-limit exemptions", zap.Error(err))
+	} else if purged > 0 {
+		logger.Info("purged expired rate-limit exemptions", zap.Int("count", purged))
+	}
+
+	exemptions, err := s.client.RateLimitExemption.Query().
+		Where(ratelimitexemption.Or(
+			ratelimitexemption.ExpiresAtIsNil(),
+			ratelimitexemption.ExpiresAtGTE(now),
+		)).
+		Order(ent.Asc(ratelimitexemption.FieldID)).
+		All(ctx)
+	if err != nil {
+		logger.Error("failed to list rate-limit exemptions", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	items := make([]generated.RateLimitExemption, 0, len(exemptions))
+	for _, ex := range exemptions {
+		items = append(items, rateLimitExemptionToAPI(ex))
+	}
+	c.JSON(http.StatusOK, generated.RateLimitExemptionList{Items: items})
+}
+
+// ListRateLimitUserOverrides handles GET /admin/rate-limits/users.
+func (s *Server) ListRateLimitUserOverrides(c *gin.Context) {
+	ctx, _, ok := requireActorWithAnyGlobalPermission(c, "rate_limit:manage")
+	if !ok {
+		return
+	}
+
+	overrides, err := s.client.RateLimitUserOverride.Query().
+		Order(ent.Asc(ratelimituseroverride.FieldID)).
+		All(ctx)
+	if err != nil {
+		logger.Error("failed to list rate-limit overrides", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	items := make([]generated.RateLimitUserOverride, 0, len(overrides))
+	for _, ov := range overrides {
+		items = append(items, rateLimitUserOverrideToAPI(ov))
+	}
+	c.JSON(http.StatusOK, generated.RateLimitUserOverrideList{Items: items})
+}
+
+// DeleteRateLimitUserOverrides handles DELETE /admin/rate-limits/users/{user_id}.
+func (s *Server) DeleteRateLimitUserOverrides(c *gin.Context, userId string) {
+	s.deleteRateLimitUserOverride(c, userId)
+}
+
+// RemoveRateLimitUserOverride handles DELETE /admin/rate-limits/user-overrides/{user_id}.
+func (s *Server) RemoveRateLimitUserOverride(c *gin.Context, userId generated.UserID) {
+	s.deleteRateLimitUserOverride(c, userId)
+}
+
+func (s *Server) deleteRateLimitUserOverride(c *gin.Context, userId string) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "rate_limit:manage")
+	if !ok {
+		return
+	}
+
+	userID := strings.TrimSpace(userId)
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+
+	if err := s.client.RateLimitUserOverride.DeleteOneID(userID).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "RATE_LIMIT_OVERRIDE_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to delete rate-limit override", zap.Error(err), zap.String("user_id", userID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "admin.rate_limit.override.delete", "user", userID, actor, nil)
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetEffectiveRateLimitPolicy handles GET /admin/rate-limits/effective/{user_id}.
+// It reports the policy batch submission would apply to the user right now.
+func (s *Server) GetEffectiveRateLimitPolicy(c *gin.Context, userId string) {
+	ctx, _, ok := requireActorWithAnyGlobalPermission(c, "rate_limit:manage")
+	if !ok {
+		return
+	}
+
+	userID := strings.TrimSpace(userId)
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+
+	policy, err := s.effectiveRateLimitPolicy(ctx, userID)
+	if err != nil {
+		logger.Error("failed to resolve rate-limit policy", zap.Error(err), zap.String("user_id", userID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	c.JSON(http.StatusOK, policy)
+}
+
+func (s *Server) effectiveRateLimitPolicy(ctx context.Context, userID string) (generated.RateLimitEffectivePolicy, error) {
+	policy, err := s.resolveBatchUserLimitPolicy(ctx, s.client, userID)
+	if err != nil {
+		return generated.RateLimitEffectivePolicy{}, err
+	}
+	return generated.RateLimitEffectivePolicy{
+		UserId:             userID,
+		Exempted:           policy.Exempt,
+		UsesDefault:        policy.UsesDefault,
+		ExemptionExpiresAt: effectiveExemptionExpiry(policy.ExemptionExpiresAt),
+		MaxPendingParents:  policy.MaxPendingParents,
+		MaxPendingChildren: policy.MaxPendingChildren,
+		CooldownSeconds:    int(policy.Cooldown.Seconds()),
+	}, nil
 }
 
 // ListRateLimitStatus handles GET /admin/rate-limits/status.
@@ -430,7 +726,7 @@ func (s *Server) ListRateLimitStatus(c *gin.Context) {
 
 	items := make([]generated.RateLimitUserStatus, 0, len(userIDs))
 	for _, userID := range userIDs {
-		policy, err := s.resolveBatchUserLimitPolicy(ctx, userID)
+		policy, err := s.resolveBatchUserLimitPolicy(ctx, s.client, userID)
 		if err != nil {
 			logger.Warn("failed to resolve user policy in rate-limit status",
 				zap.Error(err),
@@ -474,3 +770,34 @@ func effectiveExemptionExpiry(v *time.Time) time.Time {
 	}
 	return *v
 }
+
+func rateLimitExemptionToAPI(ex *ent.RateLimitExemption) generated.RateLimitExemption {
+	return generated.RateLimitExemption{
+		UserId:     ex.ID,
+		ExemptedBy: ex.ExemptedBy,
+		Reason:     ex.Reason,
+		ExpiresAt:  effectiveExemptionExpiry(ex.ExpiresAt),
+		CreatedAt:  ex.CreatedAt,
+		UpdatedAt:  ex.UpdatedAt,
+	}
+}
+
+func rateLimitUserOverrideToAPI(ov *ent.RateLimitUserOverride) generated.RateLimitUserOverride {
+	out := generated.RateLimitUserOverride{
+		UserId:    ov.ID,
+		Reason:    ov.Reason,
+		UpdatedBy: ov.UpdatedBy,
+		CreatedAt: ov.CreatedAt,
+		UpdatedAt: ov.UpdatedAt,
+	}
+	if ov.MaxPendingParents != nil {
+		out.MaxPendingParents = *ov.MaxPendingParents
+	}
+	if ov.MaxPendingChildren != nil {
+		out.MaxPendingChildren = *ov.MaxPendingChildren
+	}
+	if ov.CooldownSeconds != nil {
+		out.CooldownSeconds = *ov.CooldownSeconds
+	}
+	return out
+}
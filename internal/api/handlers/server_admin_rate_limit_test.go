@@ -3,8 +3,15 @@ package handlers
 import (
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/ent/auditlog"
+	"kv-shepherd.io/shepherd/ent/ratelimitexemption"
 	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/testutil"
 )
 
 func TestRateLimitStatus_RequiresRateLimitManagePermission(t *testing.T) {
@@ -34,3 +41,289 @@ func TestRateLimitStatus_AllowsRateLimitManagerWithoutPlatformAdmin(t *testing.T
 	var list generated.RateLimitStatusList
 	mustDecodeJSON(t, w.Body.Bytes(), &list)
 }
+
+func TestRateLimitAdmin_ExemptionAndOverrideLifecycle(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "rate_limit_admin")
+	srv := NewServer(ServerDeps{EntClient: client, Audit: audit.NewLogger(client)})
+	mustCreateUser(t, client, "user-bulk", "bulk")
+	perms := []string{"rate_limit:manage"}
+
+	expiresAt := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+	c, w := newAuthedGinContext(t, http.MethodPost, "/admin/rate-limits/exemptions",
+		`{"user_id":"user-bulk","reason":"migration","expires_at":"`+expiresAt.Format(time.RFC3339)+`"}`, "ops", perms)
+	srv.CreateRateLimitExemption(c)
+	if w.Code != http.StatusOK {
+		t.Fatalf("create exemption = %d body=%s", w.Code, w.Body.String())
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodPut, "/admin/rate-limits/users/user-bulk",
+		`{"max_pending_parents":5,"cooldown_seconds":0}`, "ops", perms)
+	srv.UpdateRateLimitUserOverrides(c, "user-bulk")
+	if w.Code != http.StatusOK {
+		t.Fatalf("upsert override = %d body=%s", w.Code, w.Body.String())
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodGet, "/admin/rate-limits/exemptions", "", "ops", perms)
+	srv.ListRateLimitExemptions(c)
+	var exemptions generated.RateLimitExemptionList
+	mustDecodeJSON(t, w.Body.Bytes(), &exemptions)
+	if len(exemptions.Items) != 1 || !exemptions.Items[0].ExpiresAt.Equal(expiresAt) {
+		t.Fatalf("exemptions = %+v, want user-bulk expiring at %s", exemptions.Items, expiresAt)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodGet, "/admin/rate-limits/users", "", "ops", perms)
+	srv.ListRateLimitUserOverrides(c)
+	var overrides generated.RateLimitUserOverrideList
+	mustDecodeJSON(t, w.Body.Bytes(), &overrides)
+	if len(overrides.Items) != 1 || overrides.Items[0].MaxPendingParents != 5 {
+		t.Fatalf("overrides = %+v, want user-bulk with max_pending_parents=5", overrides.Items)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodGet, "/admin/rate-limits/effective/user-bulk", "", "ops", perms)
+	srv.GetEffectiveRateLimitPolicy(c, "user-bulk")
+	var policy generated.RateLimitEffectivePolicy
+	mustDecodeJSON(t, w.Body.Bytes(), &policy)
+	if !policy.Exempted || policy.UsesDefault || policy.MaxPendingParents != 5 ||
+		policy.MaxPendingChildren != maxPendingBatchChildrenUser || policy.CooldownSeconds != 0 {
+		t.Fatalf("effective policy = %+v", policy)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodDelete, "/admin/rate-limits/users/user-bulk", "", "ops", perms)
+	srv.DeleteRateLimitUserOverrides(c, "user-bulk")
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("delete override = %d body=%s", w.Code, w.Body.String())
+	}
+	c, w = newAuthedGinContext(t, http.MethodDelete, "/admin/rate-limits/users/user-bulk", "", "ops", perms)
+	srv.DeleteRateLimitUserOverrides(c, "user-bulk")
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("repeat delete override = %d, want %d", w.Code, http.StatusNotFound)
+	}
+	assertErrorCode(t, w.Body.Bytes(), "RATE_LIMIT_OVERRIDE_NOT_FOUND")
+
+	c, w = newAuthedGinContext(t, http.MethodDelete, "/admin/rate-limits/exemptions/user-bulk", "", "ops", perms)
+	srv.DeleteRateLimitExemption(c, "user-bulk")
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("delete exemption = %d body=%s", w.Code, w.Body.String())
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodGet, "/admin/rate-limits/effective/user-bulk", "", "ops", perms)
+	srv.GetEffectiveRateLimitPolicy(c, "user-bulk")
+	mustDecodeJSON(t, w.Body.Bytes(), &policy)
+	if policy.Exempted || !policy.UsesDefault || policy.MaxPendingParents != maxPendingBatchParentsUser {
+		t.Fatalf("effective policy after deletes = %+v, want defaults", policy)
+	}
+
+	for _, action := range []string{
+		"admin.rate_limit.exemption.upsert",
+		"admin.rate_limit.override.upsert",
+		"admin.rate_limit.override.delete",
+		"admin.rate_limit.exemption.delete",
+	} {
+		n := client.AuditLog.Query().
+			Where(auditlog.ActionEQ(action), auditlog.ResourceIDEQ("user-bulk"), auditlog.ActorEQ("ops")).
+			CountX(t.Context())
+		if n != 1 {
+			t.Fatalf("audit rows for %s = %d, want 1", action, n)
+		}
+	}
+}
+
+func TestRateLimitAdmin_PurgesExpiredExemptions(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newAdminIdentityTestServer(t)
+	perms := []string{"rate_limit:manage"}
+	past := time.Now().UTC().Add(-time.Minute)
+	future := time.Now().UTC().Add(time.Hour)
+	client.RateLimitExemption.Create().SetID("user-expired").SetExemptedBy("ops").SetExpiresAt(past).SaveX(t.Context())
+	client.RateLimitExemption.Create().SetID("user-stale").SetExemptedBy("ops").SetExpiresAt(past).SaveX(t.Context())
+	client.RateLimitExemption.Create().SetID("user-active").SetExemptedBy("ops").SetExpiresAt(future).SaveX(t.Context())
+	client.RateLimitExemption.Create().SetID("user-forever").SetExemptedBy("ops").SaveX(t.Context())
+
+	// The effective policy resolves the same way batch submission does: an
+	// expired exemption does not apply and is dropped on read.
+	c, w := newAuthedGinContext(t, http.MethodGet, "/admin/rate-limits/effective/user-expired", "", "ops", perms)
+	srv.GetEffectiveRateLimitPolicy(c, "user-expired")
+	var policy generated.RateLimitEffectivePolicy
+	mustDecodeJSON(t, w.Body.Bytes(), &policy)
+	if policy.Exempted || !policy.UsesDefault {
+		t.Fatalf("effective policy for expired exemption = %+v, want defaults", policy)
+	}
+	if client.RateLimitExemption.Query().Where(ratelimitexemption.IDEQ("user-expired")).ExistX(t.Context()) {
+		t.Fatal("expired exemption was not purged by effective-policy lookup")
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodGet, "/admin/rate-limits/exemptions", "", "ops", perms)
+	srv.ListRateLimitExemptions(c)
+	var list generated.RateLimitExemptionList
+	mustDecodeJSON(t, w.Body.Bytes(), &list)
+	got := make([]string, 0, len(list.Items))
+	for _, item := range list.Items {
+		got = append(got, item.UserId)
+	}
+	if len(got) != 2 || got[0] != "user-active" || got[1] != "user-forever" {
+		t.Fatalf("listed exemptions = %v, want [user-active user-forever]", got)
+	}
+	if n := client.RateLimitExemption.Query().CountX(t.Context()); n != 2 {
+		t.Fatalf("stored exemptions = %d, want 2 after purge", n)
+	}
+}
+
+func TestRateLimitAdmin_RejectsInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newAdminIdentityTestServer(t)
+	mustCreateUser(t, client, "user-bulk", "bulk")
+	perms := []string{"rate_limit:manage"}
+
+	tests := []struct {
+		name   string
+		method string
+		body   string
+		call   func(*gin.Context)
+	}{
+		{
+			name: "expired exemption", method: http.MethodPost,
+			body: `{"user_id":"user-bulk","expires_at":"` + time.Now().UTC().Add(-time.Hour).Format(time.RFC3339) + `"}`,
+			call: srv.CreateRateLimitExemption,
+		},
+		{
+			name: "negative cooldown", method: http.MethodPut, body: `{"cooldown_seconds":-1}`,
+			call: func(c *gin.Context) { srv.UpdateRateLimitUserOverrides(c, "user-bulk") },
+		},
+		{
+			name: "negative parents", method: http.MethodPut, body: `{"max_pending_parents":-3}`,
+			call: func(c *gin.Context) { srv.UpdateRateLimitUserOverrides(c, "user-bulk") },
+		},
+		{
+			name: "zero children", method: http.MethodPut, body: `{"max_pending_children":0}`,
+			call: func(c *gin.Context) { srv.UpdateRateLimitUserOverrides(c, "user-bulk") },
+		},
+		{
+			name: "create zero cooldown", method: http.MethodPost,
+			body: `{"max_pending_parents":1,"max_pending_children":1,"cooldown_seconds":0}`,
+			call: func(c *gin.Context) { srv.CreateRateLimitUserOverride(c, "user-bulk") },
+		},
+		{
+			name: "create missing children", method: http.MethodPost,
+			body: `{"max_pending_parents":1,"cooldown_seconds":5}`,
+			call: func(c *gin.Context) { srv.CreateRateLimitUserOverride(c, "user-bulk") },
+		},
+		{
+			name: "patch negative parents", method: http.MethodPatch, body: `{"max_pending_parents":-1}`,
+			call: func(c *gin.Context) { srv.PatchRateLimitUserOverride(c, "user-bulk") },
+		},
+	}
+	for _, tc := range tests {
+		c, w := newAuthedGinContext(t, tc.method, "/admin/rate-limits", tc.body, "ops", perms)
+		tc.call(c)
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("%s: status = %d, want %d body=%s", tc.name, w.Code, http.StatusBadRequest, w.Body.String())
+		}
+		assertErrorCode(t, w.Body.Bytes(), "INVALID_REQUEST")
+	}
+
+	c, w := newAuthedGinContext(t, http.MethodGet, "/admin/rate-limits/users", "", "user-a", []string{"vm:read"})
+	srv.ListRateLimitUserOverrides(c)
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("list overrides without permission = %d, want %d", w.Code, http.StatusForbidden)
+	}
+}
+
+func TestRateLimitAdmin_UserOverrideCRUD(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "rate_limit_user_override_crud")
+	srv := NewServer(ServerDeps{EntClient: client, Audit: audit.NewLogger(client)})
+	mustCreateUser(t, client, "user-bulk", "bulk")
+	perms := []string{"rate_limit:manage"}
+	body := `{"max_pending_parents":4,"max_pending_children":40,"cooldown_seconds":30,"reason":"migration"}`
+
+	c, w := newAuthedGinContext(t, http.MethodPost, "/admin/rate-limits/user-overrides/user-bulk", body, "user-a", []string{"vm:read"})
+	srv.CreateRateLimitUserOverride(c, "user-bulk")
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("create without permission = %d, want %d", w.Code, http.StatusForbidden)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodPost, "/admin/rate-limits/user-overrides/ghost", body, "ops", perms)
+	srv.CreateRateLimitUserOverride(c, "ghost")
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("create for unknown user = %d, want %d", w.Code, http.StatusNotFound)
+	}
+	assertErrorCode(t, w.Body.Bytes(), "USER_NOT_FOUND")
+
+	c, w = newAuthedGinContext(t, http.MethodPost, "/admin/rate-limits/user-overrides/user-bulk", body, "ops", perms)
+	srv.CreateRateLimitUserOverride(c, "user-bulk")
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create override = %d body=%s", w.Code, w.Body.String())
+	}
+	var created generated.RateLimitUserOverride
+	mustDecodeJSON(t, w.Body.Bytes(), &created)
+	if created.MaxPendingParents != 4 || created.EffectivePolicy.UsesDefault ||
+		created.EffectivePolicy.MaxPendingChildren != 40 || created.EffectivePolicy.CooldownSeconds != 30 {
+		t.Fatalf("created override = %+v", created)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodPost, "/admin/rate-limits/user-overrides/user-bulk", body, "ops", perms)
+	srv.CreateRateLimitUserOverride(c, "user-bulk")
+	if w.Code != http.StatusConflict {
+		t.Fatalf("duplicate create = %d, want %d", w.Code, http.StatusConflict)
+	}
+	assertErrorCode(t, w.Body.Bytes(), "RATE_LIMIT_OVERRIDE_EXISTS")
+
+	// An exemption shows up in the effective policy alongside the override.
+	client.RateLimitExemption.Create().SetID("user-bulk").SetExemptedBy("ops").SaveX(t.Context())
+	c, w = newAuthedGinContext(t, http.MethodPatch, "/admin/rate-limits/user-overrides/user-bulk", `{"cooldown_seconds":90}`, "ops", perms)
+	srv.PatchRateLimitUserOverride(c, "user-bulk")
+	if w.Code != http.StatusOK {
+		t.Fatalf("patch override = %d body=%s", w.Code, w.Body.String())
+	}
+	var patched generated.RateLimitUserOverride
+	mustDecodeJSON(t, w.Body.Bytes(), &patched)
+	if patched.CooldownSeconds != 90 || patched.MaxPendingParents != 4 || patched.Reason != "migration" ||
+		!patched.EffectivePolicy.Exempted {
+		t.Fatalf("patched override = %+v", patched)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodGet, "/admin/rate-limits/user-overrides/user-bulk", "", "ops", perms)
+	srv.GetRateLimitUserOverride(c, "user-bulk")
+	var fetched generated.RateLimitUserOverride
+	mustDecodeJSON(t, w.Body.Bytes(), &fetched)
+	if w.Code != http.StatusOK || fetched.CooldownSeconds != 90 || fetched.EffectivePolicy.UserId != "user-bulk" {
+		t.Fatalf("get override = %d %+v", w.Code, fetched)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodDelete, "/admin/rate-limits/user-overrides/user-bulk", "", "ops", perms)
+	srv.RemoveRateLimitUserOverride(c, "user-bulk")
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("delete override = %d body=%s", w.Code, w.Body.String())
+	}
+	for _, call := range []func(*gin.Context){
+		func(c *gin.Context) { srv.GetRateLimitUserOverride(c, "user-bulk") },
+		func(c *gin.Context) { srv.PatchRateLimitUserOverride(c, "user-bulk") },
+	} {
+		c, w = newAuthedGinContext(t, http.MethodPatch, "/admin/rate-limits/user-overrides/user-bulk", `{"max_pending_parents":2}`, "ops", perms)
+		call(c)
+		if w.Code != http.StatusNotFound {
+			t.Fatalf("after delete = %d, want %d", w.Code, http.StatusNotFound)
+		}
+		assertErrorCode(t, w.Body.Bytes(), "RATE_LIMIT_OVERRIDE_NOT_FOUND")
+	}
+
+	for _, action := range []string{
+		"admin.rate_limit.override.create",
+		"admin.rate_limit.override.update",
+		"admin.rate_limit.override.delete",
+	} {
+		n := client.AuditLog.Query().
+			Where(auditlog.ActionEQ(action), auditlog.ResourceIDEQ("user-bulk"), auditlog.ActorEQ("ops")).
+			CountX(t.Context())
+		if n != 1 {
+			t.Fatalf("audit rows for %s = %d, want 1", action, n)
+		}
+	}
+}
@@ -0,0 +1,359 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/predicate"
+	"kv-shepherd.io/shepherd/ent/role"
+	"kv-shepherd.io/shepherd/ent/rolebinding"
+	entuser "kv-shepherd.io/shepherd/ent/user"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/api/middleware"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/service"
+)
+
+// ListRoleBindings handles GET /admin/role-bindings.
+func (s *Server) ListRoleBindings(c *gin.Context, params generated.ListRoleBindingsParams) {
+	ctx, _, ok := requireActorWithAnyGlobalPermission(c, "rbac:read", "rbac:manage")
+	if !ok {
+		return
+	}
+
+	var predicates []predicate.RoleBinding
+	if v := strings.TrimSpace(params.UserId); v != "" {
+		predicates = append(predicates, rolebinding.HasUserWith(entuser.IDEQ(v)))
+	}
+	if v := strings.TrimSpace(params.RoleId); v != "" {
+		predicates = append(predicates, rolebinding.HasRoleWith(role.IDEQ(v)))
+	}
+	switch params.ScopeType {
+	case "":
+	case generated.RoleBindingScopeTypeGlobal:
+		predicates = append(predicates, globalScopePredicate())
+	case generated.RoleBindingScopeTypeSystem, generated.RoleBindingScopeTypeService, generated.RoleBindingScopeTypeNamespace:
+		predicates = append(predicates, rolebinding.ScopeTypeEQ(string(params.ScopeType)))
+	default:
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "invalid scope_type"})
+		return
+	}
+	if v := strings.TrimSpace(params.ScopeId); v != "" {
+		predicates = append(predicates, rolebinding.ScopeIDEQ(v))
+	}
+
+	page, perPage := defaultPagination(params.Page, params.PerPage)
+	query := s.client.RoleBinding.Query().Where(predicates...)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		logger.Error("failed to count role bindings", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	bindings, err := query.
+		WithUser().
+		WithRole().
+		Order(rolebinding.ByCreatedAt(sql.OrderDesc()), rolebinding.ByID(sql.OrderDesc())).
+		Offset((page - 1) * perPage).
+		Limit(perPage).
+		All(ctx)
+	if err != nil {
+		logger.Error("failed to list role bindings", zap.Error(err), zap.Int("page", page))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	items := make([]generated.GlobalRoleBinding, 0, len(bindings))
+	for _, binding := range bindings {
+		var userID, roleID, roleName string
+		if binding.Edges.User != nil {
+			userID = binding.Edges.User.ID
+		}
+		if binding.Edges.Role != nil {
+			roleID = binding.Edges.Role.ID
+			roleName = binding.Edges.Role.Name
+		}
+		items = append(items, roleBindingToAPI(binding, userID, roleID, roleName))
+	}
+	c.JSON(http.StatusOK, generated.RoleBindingList{
+		Items: items,
+		Pagination: generated.Pagination{
+			Page:       page,
+			PerPage:    perPage,
+			Total:      total,
+			TotalPages: (total + perPage - 1) / perPage,
+		},
+	})
+}
+
+// CreateRoleBinding handles POST /admin/role-bindings.
+func (s *Server) CreateRoleBinding(c *gin.Context) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "rbac:manage")
+	if !ok {
+		return
+	}
+
+	var req generated.RoleBindingCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+	userID := strings.TrimSpace(req.UserId)
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "user_id is required"})
+		return
+	}
+	if _, err := s.client.User.Get(ctx, userID); err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "USER_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to query user for role binding create", zap.Error(err), zap.String("user_id", userID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	scopeType := string(req.ScopeType)
+	environments := make([]string, 0, len(req.AllowedEnvironments))
+	for _, env := range req.AllowedEnvironments {
+		environments = append(environments, string(env))
+	}
+	s.createRoleBinding(c, ctx, actor, userID, userRoleBindingCreateRequest{
+		RoleId:              req.RoleId,
+		ScopeType:           &scopeType,
+		ScopeId:             &req.ScopeId,
+		AllowedEnvironments: environments,
+	})
+}
+
+// DeleteRoleBinding handles DELETE /admin/role-bindings/{binding_id}.
+func (s *Server) DeleteRoleBinding(c *gin.Context, bindingId generated.RoleBindingID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "rbac:manage")
+	if !ok {
+		return
+	}
+	s.deleteRoleBinding(c, ctx, actor, rolebinding.IDEQ(bindingId))
+}
+
+// GetUserEffectivePermissions handles GET /users/{user_id}/effective-permissions.
+func (s *Server) GetUserEffectivePermissions(c *gin.Context, userId generated.UserID) {
+	ctx := c.Request.Context()
+	if caller := middleware.GetUserID(ctx); caller == "" || caller != userId {
+		if _, _, ok := requireActorWithAnyGlobalPermission(c, "rbac:read", "rbac:manage"); !ok {
+			return
+		}
+	}
+
+	if _, err := s.client.User.Get(ctx, userId); err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "USER_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to query user for effective permissions", zap.Error(err), zap.String("user_id", userId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	scopes, err := s.permissions.EffectivePermissions(ctx, userId)
+	if err != nil {
+		logger.Error("failed to resolve effective permissions", zap.Error(err), zap.String("user_id", userId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	out := generated.EffectivePermissions{UserId: userId, Scopes: make([]generated.ScopePermissions, 0, len(scopes))}
+	for _, scope := range scopes {
+		out.Scopes = append(out.Scopes, generated.ScopePermissions{
+			ScopeType:   generated.RoleBindingScopeType(scope.ScopeType),
+			ScopeId:     scope.ScopeID,
+			Roles:       scope.Roles,
+			Permissions: scope.Permissions,
+		})
+	}
+	c.JSON(http.StatusOK, out)
+}
+
+// createRoleBinding validates and creates a binding for an existing user and
+// writes the response. Shared by the per-user and cross-user endpoints.
+func (s *Server) createRoleBinding(c *gin.Context, ctx context.Context, actor, userID string, req userRoleBindingCreateRequest) {
+	roleID := strings.TrimSpace(req.RoleId)
+	if roleID == "" {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "role_id is required"})
+		return
+	}
+	roleEnt, err := s.client.Role.Get(ctx, roleID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "ROLE_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to query role for role binding create", zap.Error(err), zap.String("role_id", roleID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	scopeType := service.RoleBindingScopeGlobal
+	if req.ScopeType != nil {
+		if v := strings.TrimSpace(*req.ScopeType); v != "" {
+			scopeType = v
+		}
+	}
+	scopeID := ""
+	if req.ScopeId != nil {
+		scopeID = strings.TrimSpace(*req.ScopeId)
+	}
+	scopeRef := scopeID
+	scopeID, err = service.NormalizeRoleBindingScope(ctx, s.client, scopeType, scopeID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidRoleBindingScope):
+			c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
+		case errors.Is(err, service.ErrRoleBindingScopeNotFound):
+			// SYSTEM_NOT_FOUND, SERVICE_NOT_FOUND or NAMESPACE_NOT_FOUND.
+			c.JSON(http.StatusNotFound, generated.Error{Code: strings.ToUpper(scopeType) + "_NOT_FOUND"})
+		default:
+			logger.Error("failed to validate role binding scope", zap.Error(err), zap.String("scope_type", scopeType), zap.String("scope_id", scopeRef))
+			c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		}
+		return
+	}
+
+	allowedEnvs, err := normalizeAllowedEnvironments(req.AllowedEnvironments)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
+		return
+	}
+
+	dupQuery := s.client.RoleBinding.Query().Where(
+		rolebinding.HasUserWith(entuser.IDEQ(userID)),
+		rolebinding.HasRoleWith(role.IDEQ(roleID)),
+	)
+	if service.IsGlobalRoleBindingScope(scopeType) {
+		dupQuery = dupQuery.Where(globalScopePredicate())
+	} else {
+		dupQuery = dupQuery.Where(rolebinding.ScopeTypeEQ(scopeType), rolebinding.ScopeIDEQ(scopeID))
+	}
+	exists, err := dupQuery.Exist(ctx)
+	if err != nil {
+		logger.Error("failed to check duplicate role binding", zap.Error(err), zap.String("user_id", userID), zap.String("role_id", roleID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if exists {
+		c.JSON(http.StatusConflict, generated.Error{Code: "ROLE_BINDING_EXISTS"})
+		return
+	}
+
+	id, _ := uuid.NewV7()
+	create := s.client.RoleBinding.Create().
+		SetID(id.String()).
+		SetUserID(userID).
+		SetRoleID(roleID).
+		SetScopeType(scopeType).
+		SetCreatedBy(actor)
+	if scopeID != "" {
+		create = create.SetScopeID(scopeID)
+	}
+	if len(allowedEnvs) > 0 {
+		create = create.SetAllowedEnvironments(allowedEnvs)
+	}
+
+	binding, err := create.Save(ctx)
+	if err != nil {
+		logger.Error("failed to create role binding", zap.Error(err), zap.String("user_id", userID), zap.String("role_id", roleID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	s.invalidatePermissions(userID)
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "rbac.binding.create", "user", userID, actor, map[string]interface{}{
+			"binding_id": binding.ID,
+			"role_id":    roleID,
+			"scope_type": scopeType,
+			"scope_id":   scopeID,
+		})
+	}
+
+	c.JSON(http.StatusCreated, roleBindingToAPI(binding, userID, roleEnt.ID, roleEnt.Name))
+}
+
+// deleteRoleBinding deletes the binding matched by where and writes the
+// response.
+func (s *Server) deleteRoleBinding(c *gin.Context, ctx context.Context, actor string, where ...predicate.RoleBinding) {
+	binding, err := s.client.RoleBinding.Query().
+		Where(where...).
+		WithUser().
+		WithRole().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "ROLE_BINDING_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to query role binding for delete", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if err := s.client.RoleBinding.DeleteOneID(binding.ID).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "ROLE_BINDING_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to delete role binding", zap.Error(err), zap.String("binding_id", binding.ID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	var userID, roleID string
+	if binding.Edges.User != nil {
+		userID = binding.Edges.User.ID
+		s.invalidatePermissions(userID)
+	}
+	if binding.Edges.Role != nil {
+		roleID = binding.Edges.Role.ID
+	}
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "rbac.binding.delete", "user", userID, actor, map[string]interface{}{
+			"binding_id": binding.ID,
+			"role_id":    roleID,
+			"scope_type": binding.ScopeType,
+			"scope_id":   binding.ScopeID,
+		})
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// invalidatePermissions makes role binding changes effective on this replica
+// at once instead of after the permission cache TTL. Without user IDs the
+// whole cache is dropped, e.g. after a role's permissions change.
+func (s *Server) invalidatePermissions(userIDs ...string) {
+	cache, ok := s.jwtCfg.PermissionResolver.(*middleware.CachedPermissionResolver)
+	if !ok {
+		return
+	}
+	if len(userIDs) == 0 {
+		cache.InvalidateAll()
+		return
+	}
+	cache.Invalidate(userIDs...)
+}
+
+// globalScopePredicate matches global bindings, including those created
+// before scopes were validated with an empty scope type.
+func globalScopePredicate() predicate.RoleBinding {
+	return rolebinding.Or(
+		rolebinding.ScopeTypeEQ(service.RoleBindingScopeGlobal),
+		rolebinding.ScopeTypeEQ(""),
+		rolebinding.ScopeTypeIsNil(),
+	)
+}
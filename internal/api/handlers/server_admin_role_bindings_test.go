@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/ent/auditlog"
+	"kv-shepherd.io/shepherd/ent/namespaceregistry"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/api/middleware"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/service"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestRoleBindingEndpoints(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	client := testutil.OpenEntPostgres(t, "admin_role_bindings")
+	ctx := t.Context()
+	resolver := middleware.NewCachedPermissionResolver(service.NewPermissionStore(client), time.Hour)
+	srv := NewServer(ServerDeps{
+		EntClient: client,
+		JWTCfg:    middleware.JWTConfig{PermissionResolver: resolver},
+		Audit:     audit.NewLogger(client),
+	})
+
+	mustCreateUser(t, client, "user-1", "alice")
+	client.Role.Create().SetID("role-sysadmin").SetName("SystemAdmin").
+		SetPermissions([]string{"system:write", "vm:operate"}).SetEnabled(true).ExecX(ctx)
+	client.Role.Create().SetID("role-viewer").SetName("Viewer").
+		SetPermissions([]string{"vm:read"}).SetEnabled(true).ExecX(ctx)
+	client.System.Create().SetID("sys-1").SetName("shop").SetCreatedBy("admin-1").ExecX(ctx)
+	client.NamespaceRegistry.Create().SetID("ns-1").SetName("dev-shop").
+		SetEnvironment(namespaceregistry.EnvironmentTest).SetCreatedBy("admin-1").ExecX(ctx)
+
+	// Prime the cache: the user has no permissions yet.
+	if perms, err := resolver.GlobalPermissions(ctx, "user-1"); err != nil || len(perms) != 0 {
+		t.Fatalf("initial permissions = %v, %v; want none", perms, err)
+	}
+
+	create := func(body string, perms []string) (int, generated.GlobalRoleBinding, string) {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodPost, "/api/v1/admin/role-bindings", body, "admin-1", perms)
+		srv.CreateRoleBinding(c)
+		var out generated.GlobalRoleBinding
+		if w.Code == http.StatusCreated {
+			mustDecodeJSON(t, w.Body.Bytes(), &out)
+		}
+		return w.Code, out, w.Body.String()
+	}
+	manage := []string{"rbac:manage"}
+
+	if code, _, body := create(`{"user_id":"user-1","role_id":"role-viewer"}`, []string{"rbac:read"}); code != http.StatusForbidden {
+		t.Fatalf("create without rbac:manage = %d, body=%s", code, body)
+	}
+	for _, tc := range []struct {
+		name, body string
+		code       int
+		errCode    string
+	}{
+		{"unknown scope type", `{"user_id":"user-1","role_id":"role-sysadmin","scope_type":"cluster","scope_id":"c-1"}`, http.StatusBadRequest, "INVALID_REQUEST"},
+		{"scoped without id", `{"user_id":"user-1","role_id":"role-sysadmin","scope_type":"system"}`, http.StatusBadRequest, "INVALID_REQUEST"},
+		{"global with id", `{"user_id":"user-1","role_id":"role-viewer","scope_type":"global","scope_id":"sys-1"}`, http.StatusBadRequest, "INVALID_REQUEST"},
+		{"missing system", `{"user_id":"user-1","role_id":"role-sysadmin","scope_type":"system","scope_id":"sys-404"}`, http.StatusNotFound, "SYSTEM_NOT_FOUND"},
+		{"missing namespace", `{"user_id":"user-1","role_id":"role-sysadmin","scope_type":"namespace","scope_id":"prod-shop"}`, http.StatusNotFound, "NAMESPACE_NOT_FOUND"},
+		{"missing role", `{"user_id":"user-1","role_id":"role-404"}`, http.StatusNotFound, "ROLE_NOT_FOUND"},
+		{"missing user", `{"user_id":"user-404","role_id":"role-viewer"}`, http.StatusNotFound, "USER_NOT_FOUND"},
+	} {
+		c, w := newAuthedGinContext(t, http.MethodPost, "/api/v1/admin/role-bindings", tc.body, "admin-1", manage)
+		srv.CreateRoleBinding(c)
+		if w.Code != tc.code {
+			t.Fatalf("%s: status = %d, want %d, body=%s", tc.name, w.Code, tc.code, w.Body.String())
+		}
+		assertStatusAndCode(t, w, tc.code, tc.errCode)
+	}
+
+	code, scoped, body := create(`{"user_id":"user-1","role_id":"role-sysadmin","scope_type":"system","scope_id":"sys-1"}`, manage)
+	if code != http.StatusCreated || scoped.ScopeType != "system" || scoped.ScopeId != "sys-1" || scoped.RoleName != "SystemAdmin" {
+		t.Fatalf("create system binding = %d %+v, body=%s", code, scoped, body)
+	}
+	if code, _, body := create(`{"user_id":"user-1","role_id":"role-sysadmin","scope_type":"system","scope_id":"sys-1"}`, manage); code != http.StatusConflict {
+		t.Fatalf("duplicate binding status = %d, body=%s", code, body)
+	}
+	// Namespaces may be referenced by ID; they are stored by name.
+	if code, ns, body := create(`{"user_id":"user-1","role_id":"role-sysadmin","scope_type":"namespace","scope_id":"ns-1"}`, manage); code != http.StatusCreated || ns.ScopeId != "dev-shop" {
+		t.Fatalf("create namespace binding = %d %+v, body=%s", code, ns, body)
+	}
+	code, global, body := create(`{"user_id":"user-1","role_id":"role-viewer"}`, manage)
+	if code != http.StatusCreated || global.ScopeType != "global" {
+		t.Fatalf("create global binding = %d %+v, body=%s", code, global, body)
+	}
+
+	// The cached permissions were invalidated, and scoped bindings add none.
+	if perms, err := resolver.GlobalPermissions(ctx, "user-1"); err != nil || !reflect.DeepEqual(perms, []string{"vm:read"}) {
+		t.Fatalf("permissions after binding = %v, %v; want [vm:read]", perms, err)
+	}
+	if n := client.AuditLog.Query().Where(auditlog.ActionEQ("rbac.binding.create")).CountX(ctx); n != 3 {
+		t.Fatalf("rbac.binding.create audit entries = %d, want 3", n)
+	}
+
+	list := func(params generated.ListRoleBindingsParams) []string {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodGet, "/api/v1/admin/role-bindings", "", "admin-1", []string{"rbac:read"})
+		srv.ListRoleBindings(c, params)
+		if w.Code != http.StatusOK {
+			t.Fatalf("list %+v status = %d, body=%s", params, w.Code, w.Body.String())
+		}
+		var out generated.RoleBindingList
+		mustDecodeJSON(t, w.Body.Bytes(), &out)
+		ids := make([]string, 0, len(out.Items))
+		for _, item := range out.Items {
+			if item.UserId != "user-1" {
+				t.Fatalf("list item user_id = %q, want user-1", item.UserId)
+			}
+			ids = append(ids, item.Id)
+		}
+		slices.Sort(ids)
+		return ids
+	}
+	if got := list(generated.ListRoleBindingsParams{UserId: "user-1"}); len(got) != 3 {
+		t.Fatalf("list by user = %v, want 3 bindings", got)
+	}
+	if got := list(generated.ListRoleBindingsParams{ScopeType: generated.RoleBindingScopeTypeSystem, ScopeId: "sys-1"}); !reflect.DeepEqual(got, []string{scoped.Id}) {
+		t.Fatalf("list by system scope = %v, want [%s]", got, scoped.Id)
+	}
+	if got := list(generated.ListRoleBindingsParams{ScopeType: generated.RoleBindingScopeTypeGlobal}); !reflect.DeepEqual(got, []string{global.Id}) {
+		t.Fatalf("list by global scope = %v, want [%s]", got, global.Id)
+	}
+	if got := list(generated.ListRoleBindingsParams{RoleId: "role-viewer"}); !reflect.DeepEqual(got, []string{global.Id}) {
+		t.Fatalf("list by role = %v, want [%s]", got, global.Id)
+	}
+
+	// Effective permissions: self-service, otherwise rbac:read.
+	effective := func(caller string, perms []string) (int, generated.EffectivePermissions) {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodGet, "/api/v1/users/user-1/effective-permissions", "", caller, perms)
+		srv.GetUserEffectivePermissions(c, "user-1")
+		var out generated.EffectivePermissions
+		if w.Code == http.StatusOK {
+			mustDecodeJSON(t, w.Body.Bytes(), &out)
+		}
+		return w.Code, out
+	}
+	if code, _ := effective("user-2", nil); code != http.StatusForbidden {
+		t.Fatalf("effective permissions of another user without rbac:read = %d, want 403", code)
+	}
+	code, eff := effective("user-1", nil)
+	if code != http.StatusOK {
+		t.Fatalf("own effective permissions status = %d", code)
+	}
+	want := []generated.ScopePermissions{
+		{ScopeType: "global", Roles: []string{"Viewer"}, Permissions: []string{"vm:read"}},
+		{ScopeType: "namespace", ScopeId: "dev-shop", Roles: []string{"SystemAdmin"}, Permissions: []string{"system:write", "vm:operate"}},
+		{ScopeType: "system", ScopeId: "sys-1", Roles: []string{"SystemAdmin"}, Permissions: []string{"system:write", "vm:operate"}},
+	}
+	if !reflect.DeepEqual(eff.Scopes, want) {
+		t.Fatalf("effective permissions = %+v, want %+v", eff.Scopes, want)
+	}
+
+	c, w := newAuthedGinContext(t, http.MethodDelete, "/api/v1/admin/role-bindings/"+global.Id, "", "admin-1", manage)
+	srv.DeleteRoleBinding(c, global.Id)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("delete status = %d, body=%s", w.Code, w.Body.String())
+	}
+	c, w = newAuthedGinContext(t, http.MethodDelete, "/api/v1/admin/role-bindings/"+global.Id, "", "admin-1", manage)
+	srv.DeleteRoleBinding(c, global.Id)
+	assertStatusAndCode(t, w, http.StatusNotFound, "ROLE_BINDING_NOT_FOUND")
+	if perms, err := resolver.GlobalPermissions(ctx, "user-1"); err != nil || len(perms) != 0 {
+		t.Fatalf("permissions after delete = %v, %v; want none", perms, err)
+	}
+	if n := client.AuditLog.Query().Where(auditlog.ActionEQ("rbac.binding.delete")).CountX(ctx); n != 1 {
+		t.Fatalf("rbac.binding.delete audit entries = %d, want 1", n)
+	}
+}
@@ -0,0 +1,315 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/scheduledbatchjob"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/jobs"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// maxCronExpressionLength mirrors the scheduled_batch_jobs.cron_expression column limit.
+const maxCronExpressionLength = 128
+
+// ListScheduledJobs handles GET /admin/scheduled-jobs.
+func (s *Server) ListScheduledJobs(c *gin.Context, params generated.ListScheduledJobsParams) {
+	ctx, _, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+
+	page, perPage := defaultPagination(params.Page, params.PerPage)
+	query := s.client.ScheduledBatchJob.Query()
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		logger.Error("failed to count scheduled batch jobs", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	schedules, err := query.
+		Order(scheduledbatchjob.ByCreatedAt(), scheduledbatchjob.ByID()).
+		Offset((page - 1) * perPage).
+		Limit(perPage).
+		All(ctx)
+	if err != nil {
+		logger.Error("failed to list scheduled batch jobs", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	items := make([]generated.ScheduledBatchJob, 0, len(schedules))
+	for _, schedule := range schedules {
+		items = append(items, scheduledBatchJobToAPI(schedule))
+	}
+	c.JSON(http.StatusOK, generated.ScheduledBatchJobList{
+		Items: items,
+		Pagination: generated.Pagination{
+			Page:       page,
+			PerPage:    perPage,
+			Total:      total,
+			TotalPages: (total + perPage - 1) / perPage,
+		},
+	})
+}
+
+// CreateScheduledJob handles POST /admin/scheduled-jobs.
+func (s *Server) CreateScheduledJob(c *gin.Context) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+
+	var req generated.ScheduledBatchJobCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+	cronExpr, err := validateCronExpression(req.CronExpression)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
+		return
+	}
+	operation, err := validateScheduledJobOperation(req.Operation)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
+		return
+	}
+	vmIDs, err := validateScheduledJobVMIDs(req.VmIds)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
+		return
+	}
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+
+	id, _ := uuid.NewV7()
+	schedule, err := s.client.ScheduledBatchJob.Create().
+		SetID(id.String()).
+		SetCronExpression(cronExpr).
+		SetOperation(operation).
+		SetVMIds(vmIDs).
+		SetEnabled(enabled).
+		SetReason(strings.TrimSpace(req.Reason)).
+		SetCreatedBy(actor).
+		Save(ctx)
+	if err != nil {
+		logger.Error("failed to create scheduled batch job", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "scheduled_job.create", "scheduled_job", schedule.ID, actor, map[string]interface{}{
+			"cron_expression": schedule.CronExpression,
+			"operation":       schedule.Operation.String(),
+			"vm_count":        len(schedule.VMIds),
+			"enabled":         schedule.Enabled,
+		})
+	}
+
+	c.JSON(http.StatusCreated, scheduledBatchJobToAPI(schedule))
+}
+
+// GetScheduledJob handles GET /admin/scheduled-jobs/{scheduled_job_id}.
+func (s *Server) GetScheduledJob(c *gin.Context, scheduledJobId generated.ScheduledJobID) {
+	if _, _, ok := requireActorWithAnyGlobalPermission(c, "platform:admin"); !ok {
+		return
+	}
+
+	schedule, ok := s.getScheduledBatchJob(c, scheduledJobId)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, scheduledBatchJobToAPI(schedule))
+}
+
+// UpdateScheduledJob handles PATCH /admin/scheduled-jobs/{scheduled_job_id}.
+func (s *Server) UpdateScheduledJob(c *gin.Context, scheduledJobId generated.ScheduledJobID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+
+	var req generated.ScheduledBatchJobUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+
+	existing, ok := s.getScheduledBatchJob(c, scheduledJobId)
+	if !ok {
+		return
+	}
+
+	update := existing.Update()
+	changes := map[string]interface{}{}
+	if req.CronExpression != "" {
+		cronExpr, err := validateCronExpression(req.CronExpression)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
+			return
+		}
+		update.SetCronExpression(cronExpr)
+		changes["cron_expression"] = cronExpr
+	}
+	if req.Operation != "" {
+		operation, err := validateScheduledJobOperation(req.Operation)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
+			return
+		}
+		update.SetOperation(operation)
+		changes["operation"] = operation.String()
+	}
+	if req.VmIds != nil {
+		vmIDs, err := validateScheduledJobVMIDs(req.VmIds)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
+			return
+		}
+		update.SetVMIds(vmIDs)
+		changes["vm_count"] = len(vmIDs)
+	}
+	if req.Reason != nil {
+		update.SetReason(strings.TrimSpace(*req.Reason))
+		changes["reason"] = strings.TrimSpace(*req.Reason)
+	}
+	if req.Enabled != nil {
+		update.SetEnabled(*req.Enabled)
+		changes["enabled"] = *req.Enabled
+	}
+
+	schedule, err := update.Save(ctx)
+	if err != nil {
+		logger.Error("failed to update scheduled batch job", zap.Error(err), zap.String("scheduled_job_id", scheduledJobId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil && len(changes) > 0 {
+		_ = s.audit.LogAction(ctx, "scheduled_job.update", "scheduled_job", schedule.ID, actor, changes)
+	}
+
+	c.JSON(http.StatusOK, scheduledBatchJobToAPI(schedule))
+}
+
+// DeleteScheduledJob handles DELETE /admin/scheduled-jobs/{scheduled_job_id}.
+// Batches already submitted by the schedule are left untouched.
+func (s *Server) DeleteScheduledJob(c *gin.Context, scheduledJobId generated.ScheduledJobID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+
+	schedule, ok := s.getScheduledBatchJob(c, scheduledJobId)
+	if !ok {
+		return
+	}
+	if err := s.client.ScheduledBatchJob.DeleteOne(schedule).Exec(ctx); err != nil {
+		logger.Error("failed to delete scheduled batch job", zap.Error(err), zap.String("scheduled_job_id", scheduledJobId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "scheduled_job.delete", "scheduled_job", schedule.ID, actor, map[string]interface{}{
+			"cron_expression": schedule.CronExpression,
+			"operation":       schedule.Operation.String(),
+		})
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (s *Server) getScheduledBatchJob(c *gin.Context, id string) (*ent.ScheduledBatchJob, bool) {
+	schedule, err := s.client.ScheduledBatchJob.Get(c.Request.Context(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "SCHEDULED_JOB_NOT_FOUND"})
+			return nil, false
+		}
+		logger.Error("failed to get scheduled batch job", zap.Error(err), zap.String("scheduled_job_id", id))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return nil, false
+	}
+	return schedule, true
+}
+
+// validateCronExpression requires a parseable standard 5-field cron expression.
+func validateCronExpression(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", fmt.Errorf("cron_expression is required")
+	}
+	if len(raw) > maxCronExpressionLength {
+		return "", fmt.Errorf("cron_expression must be at most %d characters", maxCronExpressionLength)
+	}
+	if _, err := jobs.ParseCronExpression(raw); err != nil {
+		return "", fmt.Errorf("invalid cron_expression: %v", err)
+	}
+	return raw, nil
+}
+
+func validateScheduledJobOperation(op generated.VMBatchPowerAction) (scheduledbatchjob.Operation, error) {
+	operation := scheduledbatchjob.Operation(strings.ToUpper(strings.TrimSpace(string(op))))
+	if err := scheduledbatchjob.OperationValidator(operation); err != nil {
+		return "", fmt.Errorf("unsupported power operation %q", op)
+	}
+	return operation, nil
+}
+
+// validateScheduledJobVMIDs rejects empty or oversized VM lists and drops duplicates.
+func validateScheduledJobVMIDs(raw []string) ([]string, error) {
+	out := make([]string, 0, len(raw))
+	for _, vmID := range raw {
+		vmID = strings.TrimSpace(vmID)
+		if vmID == "" {
+			return nil, fmt.Errorf("vm_ids must not contain blank entries")
+		}
+		if !slices.Contains(out, vmID) {
+			out = append(out, vmID)
+		}
+	}
+	if len(out) == 0 || len(out) > maxBatchItems {
+		return nil, fmt.Errorf("vm_ids must contain between 1 and %d entries", maxBatchItems)
+	}
+	return out, nil
+}
+
+func scheduledBatchJobToAPI(schedule *ent.ScheduledBatchJob) generated.ScheduledBatchJob {
+	out := generated.ScheduledBatchJob{
+		Id:             schedule.ID,
+		CronExpression: schedule.CronExpression,
+		Operation:      generated.VMBatchPowerAction(schedule.Operation),
+		VmIds:          schedule.VMIds,
+		Reason:         schedule.Reason,
+		Enabled:        schedule.Enabled,
+		LastRunAt:      schedule.LastRunAt,
+		LastBatchId:    schedule.LastBatchID,
+		LastError:      schedule.LastError,
+		CreatedBy:      schedule.CreatedBy,
+		CreatedAt:      schedule.CreatedAt,
+		UpdatedAt:      schedule.UpdatedAt,
+	}
+	if out.VmIds == nil {
+		out.VmIds = []string{}
+	}
+	if schedule.Enabled {
+		if next, err := jobs.NextScheduledRun(schedule); err == nil {
+			out.NextRunAt = &next
+		}
+	}
+	return out
+}
@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/riverqueue/river"
+
+	"kv-shepherd.io/shepherd/ent/batchapprovalticket"
+	"kv-shepherd.io/shepherd/ent/scheduledbatchjob"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/jobs"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestAdminScheduledJobCRUD(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "admin_scheduled_job")
+	srv := NewServer(ServerDeps{EntClient: client})
+	admin := []string{"platform:admin"}
+
+	c, w := newAuthedGinContext(t, http.MethodPost, "/admin/scheduled-jobs", mustJSON(t, map[string]any{
+		"cron_expression": "0 20 * * *", "operation": "STOP", "vm_ids": []string{"vm-1"},
+	}), "user-1", []string{"vm:operate"})
+	srv.CreateScheduledJob(c)
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("non-admin create status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+
+	invalid := []map[string]any{
+		{"cron_expression": "every night", "operation": "STOP", "vm_ids": []string{"vm-1"}},
+		{"cron_expression": "0 20 * * *", "operation": "HIBERNATE", "vm_ids": []string{"vm-1"}},
+		{"cron_expression": "0 20 * * *", "operation": "STOP", "vm_ids": []string{}},
+		{"cron_expression": "0 20 * * *", "operation": "STOP", "vm_ids": []string{" "}},
+	}
+	for _, body := range invalid {
+		c, w = newAuthedGinContext(t, http.MethodPost, "/admin/scheduled-jobs", mustJSON(t, body), "admin-1", admin)
+		srv.CreateScheduledJob(c)
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("create %v status = %d, want %d", body, w.Code, http.StatusBadRequest)
+		}
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodPost, "/admin/scheduled-jobs", mustJSON(t, map[string]any{
+		"cron_expression": "0 20 * * 1-5",
+		"operation":       "STOP",
+		"vm_ids":          []string{"vm-1", "vm-2", "vm-1"},
+	}), "admin-1", admin)
+	srv.CreateScheduledJob(c)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create status = %d, want %d body=%s", w.Code, http.StatusCreated, w.Body.String())
+	}
+	var created generated.ScheduledBatchJob
+	mustDecodeJSON(t, w.Body.Bytes(), &created)
+	if !created.Enabled || len(created.VmIds) != 2 || created.CreatedBy != "admin-1" || created.NextRunAt == nil {
+		t.Fatalf("created = %+v, want enabled schedule with 2 VMs by admin-1 and next_run_at", created)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodPatch, "/admin/scheduled-jobs/"+created.Id, mustJSON(t, map[string]any{
+		"operation": "START", "enabled": false,
+	}), "admin-1", admin)
+	srv.UpdateScheduledJob(c, created.Id)
+	if w.Code != http.StatusOK {
+		t.Fatalf("update status = %d, want %d body=%s", w.Code, http.StatusOK, w.Body.String())
+	}
+	var updated generated.ScheduledBatchJob
+	mustDecodeJSON(t, w.Body.Bytes(), &updated)
+	if updated.Operation != generated.START || updated.Enabled || updated.NextRunAt != nil {
+		t.Fatalf("updated = %+v, want disabled START schedule", updated)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodGet, "/admin/scheduled-jobs", "", "admin-1", admin)
+	srv.ListScheduledJobs(c, generated.ListScheduledJobsParams{})
+	if w.Code != http.StatusOK {
+		t.Fatalf("list status = %d, want %d", w.Code, http.StatusOK)
+	}
+	var list generated.ScheduledBatchJobList
+	mustDecodeJSON(t, w.Body.Bytes(), &list)
+	if list.Pagination.Total != 1 || len(list.Items) != 1 {
+		t.Fatalf("list = %+v, want 1 schedule", list)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodDelete, "/admin/scheduled-jobs/"+created.Id, "", "admin-1", admin)
+	srv.DeleteScheduledJob(c, created.Id)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("delete status = %d, want %d", w.Code, http.StatusNoContent)
+	}
+	c, w = newAuthedGinContext(t, http.MethodGet, "/admin/scheduled-jobs/"+created.Id, "", "admin-1", admin)
+	srv.GetScheduledJob(c, created.Id)
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("get deleted status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestScheduledBatchPower_DueScheduleCreatesBatch(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newBatchBehaviorTestServer(t)
+	vmA := mustCreateBatchDeleteTargetVM(t, client, "admin-1")
+	vmB := mustCreateBatchDeleteTargetVM(t, client, "admin-1")
+
+	lastRun := time.Now().UTC().Add(-2 * time.Hour)
+	due := client.ScheduledBatchJob.Create().
+		SetID("sched-due").
+		SetCronExpression("0 * * * *").
+		SetOperation(scheduledbatchjob.OperationSTOP).
+		SetVMIds([]string{vmA, vmB}).
+		SetLastRunAt(lastRun).
+		SetCreatedBy("admin-1").
+		SaveX(t.Context())
+	client.ScheduledBatchJob.Create().
+		SetID("sched-disabled").
+		SetCronExpression("* * * * *").
+		SetOperation(scheduledbatchjob.OperationSTART).
+		SetVMIds([]string{vmA}).
+		SetEnabled(false).
+		SetLastRunAt(lastRun).
+		SetCreatedBy("admin-1").
+		SaveX(t.Context())
+
+	worker := jobs.NewScheduledBatchPowerWorker(client, srv)
+	if err := worker.Work(t.Context(), &river.Job[jobs.ScheduledBatchPowerArgs]{}); err != nil {
+		t.Fatalf("Work() error = %v", err)
+	}
+
+	batches := client.BatchApprovalTicket.Query().
+		Where(batchapprovalticket.BatchTypeEQ(batchapprovalticket.BatchTypeBATCH_POWER)).
+		AllX(t.Context())
+	if len(batches) != 1 {
+		t.Fatalf("power batch count = %d, want 1", len(batches))
+	}
+	if batches[0].ChildCount != 2 || batches[0].CreatedBy != "admin-1" || batches[0].RequestID == nil {
+		t.Fatalf("batch = %+v, want 2 children by admin-1 with request_id", batches[0])
+	}
+
+	fired := client.ScheduledBatchJob.GetX(t.Context(), due.ID)
+	if fired.LastBatchID != batches[0].ID || fired.LastError != "" {
+		t.Fatalf("schedule last_batch_id = %q last_error = %q, want %q and no error", fired.LastBatchID, fired.LastError, batches[0].ID)
+	}
+	if fired.LastRunAt == nil || !fired.LastRunAt.After(lastRun) {
+		t.Fatalf("schedule last_run_at = %v, want advanced past %v", fired.LastRunAt, lastRun)
+	}
+
+	// The next tick within the same hour finds nothing due.
+	if err := worker.Work(t.Context(), &river.Job[jobs.ScheduledBatchPowerArgs]{}); err != nil {
+		t.Fatalf("second Work() error = %v", err)
+	}
+	if n := client.BatchApprovalTicket.Query().CountX(t.Context()); n != 1 {
+		t.Fatalf("batch count after second tick = %d, want 1", n)
+	}
+}
+
+func TestScheduledBatchPower_RecordsSubmissionError(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newBatchBehaviorTestServer(t)
+	client.ScheduledBatchJob.Create().
+		SetID("sched-missing-vm").
+		SetCronExpression("* * * * *").
+		SetOperation(scheduledbatchjob.OperationSTART).
+		SetVMIds([]string{"vm-missing"}).
+		SetLastRunAt(time.Now().UTC().Add(-time.Hour)).
+		SetCreatedBy("admin-1").
+		SaveX(t.Context())
+
+	worker := jobs.NewScheduledBatchPowerWorker(client, srv)
+	if err := worker.Work(t.Context(), &river.Job[jobs.ScheduledBatchPowerArgs]{}); err != nil {
+		t.Fatalf("Work() error = %v", err)
+	}
+
+	if n := client.BatchApprovalTicket.Query().CountX(t.Context()); n != 0 {
+		t.Fatalf("batch count = %d, want 0", n)
+	}
+	schedule := client.ScheduledBatchJob.GetX(t.Context(), "sched-missing-vm")
+	if schedule.LastError == "" || schedule.LastBatchID != "" {
+		t.Fatalf("schedule last_error = %q last_batch_id = %q, want VM_NOT_FOUND error", schedule.LastError, schedule.LastBatchID)
+	}
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/service"
+)
+
+// ReindexServiceInstances handles POST /admin/services/{service_id}/reindex.
+// Repairs next_instance_index after duplicate or out-of-band VM instances.
+func (s *Server) ReindexServiceInstances(c *gin.Context, serviceId string) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+
+	result, err := s.reindexServiceInstances(ctx, serviceId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "SERVICE_NOT_FOUND"})
+			return
+		}
+		logger.Error("service instance reindex failed",
+			zap.Error(err),
+			zap.String("service_id", serviceId),
+			zap.String("actor", actor),
+		)
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "service.reindex", "service", serviceId, actor, map[string]interface{}{
+			"previous_next_instance_index": result.PreviousNextIndex,
+			"next_instance_index":          result.NextIndex,
+			"max_instance_index":           result.MaxInstance,
+		})
+	}
+
+	c.JSON(http.StatusOK, generated.ServiceReindexResponse{
+		ServiceId:                 serviceId,
+		PreviousNextInstanceIndex: result.PreviousNextIndex,
+		NextInstanceIndex:         result.NextIndex,
+		MaxInstanceIndex:          result.MaxInstance,
+	})
+}
+
+// reindexServiceInstances runs the naming service repair in its own transaction.
+func (s *Server) reindexServiceInstances(ctx context.Context, serviceID string) (service.InstanceReindexResult, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return service.InstanceReindexResult{}, fmt.Errorf("start reindex transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	result, err := service.NewVMNamingService(tx.Client()).ReindexInstances(ctx, serviceID)
+	if err != nil {
+		return result, err
+	}
+	if err := tx.Commit(); err != nil {
+		return result, fmt.Errorf("commit reindex transaction: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestAdminReindexServiceInstances(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "admin_service_reindex")
+	srv := NewServer(ServerDeps{EntClient: client})
+
+	sys := mustCreateSystem(t, client, "sys-reindex", "shop", "owner-1")
+	svc := mustCreateService(t, client, "svc-reindex", "redis", sys.ID, "svc")
+	mustCreateVMForService(t, client, "vm-reindex-1", "prod-shop-redis-01", svc.ID)
+	client.VM.Create().
+		SetID("vm-reindex-7").
+		SetName("prod-shop-redis-07").
+		SetInstance("07").
+		SetNamespace("ns-test").
+		SetCreatedBy("owner-1").
+		SetServiceID(svc.ID).
+		SaveX(t.Context())
+	// The counter lags behind the existing instances, e.g. after a racy allocation.
+	client.Service.UpdateOneID(svc.ID).SetNextInstanceIndex(3).ExecX(t.Context())
+
+	reindex := func(serviceID string, perms []string) (int, generated.ServiceReindexResponse) {
+		c, w := newAuthedGinContext(t, http.MethodPost, "/admin/services/"+serviceID+"/reindex", "", "admin-1", perms)
+		srv.ReindexServiceInstances(c, serviceID)
+		var resp generated.ServiceReindexResponse
+		if w.Code == http.StatusOK {
+			mustDecodeJSON(t, w.Body.Bytes(), &resp)
+		}
+		return w.Code, resp
+	}
+
+	if code, _ := reindex(svc.ID, []string{"service:update"}); code != http.StatusForbidden {
+		t.Fatalf("non-admin status = %d, want %d", code, http.StatusForbidden)
+	}
+	if code, _ := reindex("svc-missing", []string{"platform:admin"}); code != http.StatusNotFound {
+		t.Fatalf("missing service status = %d, want %d", code, http.StatusNotFound)
+	}
+
+	code, resp := reindex(svc.ID, []string{"platform:admin"})
+	if code != http.StatusOK || resp.PreviousNextInstanceIndex != 3 || resp.NextInstanceIndex != 8 || resp.MaxInstanceIndex != 7 {
+		t.Fatalf("reindex status=%d body=%+v, want 3 -> 8 (max 7)", code, resp)
+	}
+	if got := client.Service.GetX(t.Context(), svc.ID).NextInstanceIndex; got != 8 {
+		t.Fatalf("next_instance_index = %d, want 8", got)
+	}
+
+	// The index never moves backwards once VMs are gone.
+	client.VM.DeleteOneID("vm-reindex-7").ExecX(t.Context())
+	code, resp = reindex(svc.ID, []string{"platform:admin"})
+	if code != http.StatusOK || resp.NextInstanceIndex != 8 || resp.MaxInstanceIndex != 1 {
+		t.Fatalf("second reindex status=%d body=%+v, want next 8 (max 1)", code, resp)
+	}
+}
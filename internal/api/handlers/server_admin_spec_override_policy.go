@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/platformconfig"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/service/vmspec"
+)
+
+// GetSpecOverridePolicy handles GET /admin/spec-override-policy.
+func (s *Server) GetSpecOverridePolicy(c *gin.Context) {
+	ctx, _, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+
+	cfg, err := s.client.PlatformConfig.Get(ctx, platformconfig.DefaultID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusOK, generated.SpecOverridePolicy{AllowedPaths: []generated.SpecOverridePath{}})
+			return
+		}
+		logger.Error("failed to get spec override policy", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	c.JSON(http.StatusOK, specOverridePolicyToAPI(cfg))
+}
+
+// PutSpecOverridePolicy handles PUT /admin/spec-override-policy.
+func (s *Server) PutSpecOverridePolicy(c *gin.Context) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+
+	var req generated.SpecOverridePolicy
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+	policy := make(map[string]string, len(req.AllowedPaths))
+	var fieldErrors []generated.FieldError
+	for _, entry := range req.AllowedPaths {
+		path := strings.TrimSpace(entry.Path)
+		switch {
+		case !vmspec.ValidOverridePath(path):
+			fieldErrors = append(fieldErrors, generated.FieldError{
+				Field: path, Code: "INVALID_PATH", Message: `paths must be dotted spec.* paths`,
+			})
+		case !vmspec.OverrideType(entry.Type).Valid():
+			fieldErrors = append(fieldErrors, generated.FieldError{
+				Field: path, Code: "INVALID_TYPE", Message: "unknown type " + string(entry.Type),
+			})
+		case policy[path] != "":
+			fieldErrors = append(fieldErrors, generated.FieldError{
+				Field: path, Code: "DUPLICATE_PATH", Message: "path is listed more than once",
+			})
+		default:
+			policy[path] = string(entry.Type)
+		}
+	}
+	if len(fieldErrors) > 0 {
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:        "INVALID_REQUEST",
+			Message:     "invalid spec override policy",
+			FieldErrors: fieldErrors,
+		})
+		return
+	}
+
+	existing, err := s.client.PlatformConfig.Get(ctx, platformconfig.DefaultID)
+	if err != nil && !ent.IsNotFound(err) {
+		logger.Error("failed to get platform config", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	var saved *ent.PlatformConfig
+	if existing == nil {
+		saved, err = s.client.PlatformConfig.Create().
+			SetSpecOverridePolicy(policy).
+			SetUpdatedBy(actor).
+			Save(ctx)
+	} else {
+		saved, err = existing.Update().
+			SetSpecOverridePolicy(policy).
+			SetUpdatedBy(actor).
+			Save(ctx)
+	}
+	if err != nil {
+		logger.Error("failed to save spec override policy", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "spec_override_policy.update", "platform_config", saved.ID, actor, map[string]interface{}{
+			"allowed_paths": policy,
+		})
+	}
+	c.JSON(http.StatusOK, specOverridePolicyToAPI(saved))
+}
+
+func specOverridePolicyToAPI(cfg *ent.PlatformConfig) generated.SpecOverridePolicy {
+	paths := make([]generated.SpecOverridePath, 0, len(cfg.SpecOverridePolicy))
+	for path, t := range cfg.SpecOverridePolicy {
+		paths = append(paths, generated.SpecOverridePath{Path: path, Type: generated.SpecOverridePathType(t)})
+	}
+	sort.Slice(paths, func(i, j int) bool { return paths[i].Path < paths[j].Path })
+	updatedAt := cfg.UpdatedAt
+	return generated.SpecOverridePolicy{
+		AllowedPaths: paths,
+		UpdatedBy:    cfg.UpdatedBy,
+		UpdatedAt:    &updatedAt,
+	}
+}
@@ -0,0 +1,193 @@
+package handlers
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/provider"
+	"kv-shepherd.io/shepherd/internal/service"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestGetClusterCapacity(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "cluster_capacity")
+	client.Cluster.Create().
+		SetID("cluster-a").
+		SetName("cluster-a").
+		SetAPIServerURL("https://cluster-a.example.com").
+		SetEncryptedKubeconfig([]byte("x")).
+		SetCreatedBy("admin").
+		SaveX(t.Context())
+	mustCreateSystem(t, client, "sys-shop", "shop", "alice")
+	mustCreateService(t, client, "svc-redis", "redis", "sys-shop", "cache")
+
+	// One VM still being created (counts against allocatable), one running
+	// (already on the nodes), one failed (ignored).
+	for _, v := range []struct {
+		id     string
+		status entvm.Status
+	}{
+		{"vm-creating", entvm.StatusCREATING},
+		{"vm-running", entvm.StatusRUNNING},
+		{"vm-failed", entvm.StatusFAILED},
+	} {
+		client.ApprovalTicket.Create().
+			SetID("tkt-" + v.id).
+			SetEventID("ev-" + v.id).
+			SetRequester("alice").
+			SetStatus(approvalticket.StatusAPPROVED).
+			SetInstanceSizeSnapshot(map[string]interface{}{"cpu_cores": 4, "memory_mb": 8192}).
+			SaveX(t.Context())
+		client.VM.Create().
+			SetID(v.id).
+			SetName(v.id).
+			SetInstance("01").
+			SetNamespace("prod-shop").
+			SetClusterID("cluster-a").
+			SetServiceID("svc-redis").
+			SetStatus(v.status).
+			SetTicketID("tkt-" + v.id).
+			SetCreatedBy("alice").
+			SaveX(t.Context())
+	}
+
+	mock := provider.NewMockProvider()
+	mock.SeedNodeResources(domain.ClusterNodeResources{
+		NodeCount:           3,
+		TotalCPUCores:       96,
+		AllocatableCPUCores: 90,
+		TotalMemoryMB:       393216,
+		AllocatableMemoryMB: 380000,
+	})
+	srv := NewServer(ServerDeps{EntClient: client, VMService: service.NewVMService(mock)})
+
+	get := func(clusterID string, perms []string) (int, []byte) {
+		c, w := newAuthedGinContext(t, http.MethodGet, "/admin/clusters/"+clusterID+"/capacity", "", "approver", perms)
+		srv.GetClusterCapacity(c, clusterID)
+		return w.Code, w.Body.Bytes()
+	}
+
+	code, raw := get("cluster-a", []string{"cluster:read"})
+	if code != http.StatusOK {
+		t.Fatalf("status = %d, want %d body=%s", code, http.StatusOK, raw)
+	}
+	var report generated.ClusterCapacityReport
+	mustDecodeJSON(t, raw, &report)
+	want := generated.ClusterCapacityReport{
+		TotalCpuCores:       96,
+		AllocatableCpuCores: 86,
+		TotalMemoryMb:       393216,
+		AllocatableMemoryMb: 371808,
+		VmCount:             2,
+		NodeCount:           3,
+	}
+	if report != want {
+		t.Fatalf("report = %+v, want %+v", report, want)
+	}
+
+	// Served from cache: node changes within the TTL are not visible yet.
+	mock.SeedNodeResources(domain.ClusterNodeResources{NodeCount: 1})
+	code, raw = get("cluster-a", []string{"cluster:read"})
+	mustDecodeJSON(t, raw, &report)
+	if code != http.StatusOK || report != want {
+		t.Fatalf("cached report = %d %+v, want %+v", code, report, want)
+	}
+
+	if code, raw := get("cluster-missing", []string{"cluster:read"}); code != http.StatusNotFound {
+		t.Fatalf("missing cluster status = %d, want %d body=%s", code, http.StatusNotFound, raw)
+	}
+	if code, raw := get("cluster-a", []string{"vm:read"}); code != http.StatusForbidden {
+		t.Fatalf("without cluster:read status = %d, want %d body=%s", code, http.StatusForbidden, raw)
+	}
+}
+
+func TestClusterKubeconfigConnectivityCheck(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "cluster_kubeconfig_check")
+	reachable := map[string]bool{"https://good.example.com:6443": true}
+	probes := 0
+	srv := NewServer(ServerDeps{
+		EntClient: client,
+		KubeconfigProbe: func(_ context.Context, kubeconfig []byte) (string, error) {
+			probes++
+			server, err := parseAPIServerURL(kubeconfig)
+			if err != nil {
+				return "", fmt.Errorf("%w: %v", provider.ErrInvalidKubeconfig, err)
+			}
+			if !reachable[server] {
+				return "", errors.New("dial tcp: connection refused")
+			}
+			return "v1.33.2", nil
+		},
+	})
+	admin := []string{"cluster:write"}
+	kubeconfig := func(server string) string {
+		return base64.StdEncoding.EncodeToString([]byte("clusters:\n- name: c\n  cluster:\n    server: " + server + "\n"))
+	}
+
+	createCtx, createW := newAuthedGinContext(t, http.MethodPost, "/admin/clusters",
+		`{"name":"down","kubeconfig":"`+kubeconfig("https://down.example.com:6443")+`"}`, "admin-1", admin)
+	srv.CreateCluster(createCtx)
+	assertStatusAndCode(t, createW, http.StatusUnprocessableEntity, "CLUSTER_UNREACHABLE")
+	if n := client.Cluster.Query().CountX(t.Context()); n != 0 {
+		t.Fatalf("clusters after rejected create = %d, want 0", n)
+	}
+
+	probes = 0
+	skipCtx, skipW := newAuthedGinContext(t, http.MethodPost, "/admin/clusters",
+		`{"name":"down","kubeconfig":"`+kubeconfig("https://down.example.com:6443")+`","skip_connectivity_check":true}`, "admin-1", admin)
+	srv.CreateCluster(skipCtx)
+	if skipW.Code != http.StatusCreated || probes != 0 {
+		t.Fatalf("skipped create status = %d probes = %d, want 201 without probing; body=%s", skipW.Code, probes, skipW.Body.String())
+	}
+	var created generated.Cluster
+	mustDecodeJSON(t, skipW.Body.Bytes(), &created)
+
+	validate := func(clusterID, server string) *httptest.ResponseRecorder {
+		c, w := newAuthedGinContext(t, http.MethodPost, "/admin/clusters/"+clusterID+"/validate-kubeconfig",
+			`{"kubeconfig":"`+kubeconfig(server)+`"}`, "admin-1", admin)
+		srv.ValidateClusterKubeconfig(c, clusterID)
+		return w
+	}
+	w := validate(created.Id, "https://good.example.com:6443")
+	var result generated.ClusterKubeconfigValidation
+	mustDecodeJSON(t, w.Body.Bytes(), &result)
+	if w.Code != http.StatusOK || !result.Reachable || result.ServerVersion != "v1.33.2" {
+		t.Fatalf("validate = %d %+v, want reachable v1.33.2", w.Code, result)
+	}
+	assertStatusAndCode(t, validate(created.Id, "https://down.example.com:6443"), http.StatusUnprocessableEntity, "CLUSTER_UNREACHABLE")
+	assertStatusAndCode(t, validate("missing", "https://good.example.com:6443"), http.StatusNotFound, "CLUSTER_NOT_FOUND")
+
+	update := func(body string) *httptest.ResponseRecorder {
+		c, w := newAuthedGinContext(t, http.MethodPatch, "/admin/clusters/"+created.Id, body, "admin-1", admin)
+		srv.UpdateCluster(c, created.Id)
+		return w
+	}
+	assertStatusAndCode(t, update(`{"kubeconfig":"`+kubeconfig("https://down.example.com:7443")+`"}`), http.StatusUnprocessableEntity, "CLUSTER_UNREACHABLE")
+	if got := client.Cluster.GetX(t.Context(), created.Id).APIServerURL; got != "https://down.example.com:6443" {
+		t.Fatalf("api_server_url after rejected update = %q, want unchanged", got)
+	}
+	if w := update(`{"kubeconfig":"` + kubeconfig("https://good.example.com:6443") + `"}`); w.Code != http.StatusOK {
+		t.Fatalf("update status = %d, want %d; body=%s", w.Code, http.StatusOK, w.Body.String())
+	}
+	if got := client.Cluster.GetX(t.Context(), created.Id).APIServerURL; got != "https://good.example.com:6443" {
+		t.Fatalf("api_server_url after update = %q, want the new server", got)
+	}
+}
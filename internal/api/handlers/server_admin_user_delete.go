@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/loginsession"
+	entnotification "kv-shepherd.io/shepherd/ent/notification"
+	"kv-shepherd.io/shepherd/ent/notificationpreference"
+	"kv-shepherd.io/shepherd/ent/passwordhistory"
+	"kv-shepherd.io/shepherd/ent/ratelimitexemption"
+	"kv-shepherd.io/shepherd/ent/ratelimituseroverride"
+	"kv-shepherd.io/shepherd/ent/refreshtoken"
+	"kv-shepherd.io/shepherd/ent/resourcerolebinding"
+	"kv-shepherd.io/shepherd/ent/role"
+	"kv-shepherd.io/shepherd/ent/rolebinding"
+	entuser "kv-shepherd.io/shepherd/ent/user"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/ent/vmconsolesession"
+	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
+)
+
+// platformAdminRoleName is the seeded role whose last active holder cannot
+// be deleted.
+const platformAdminRoleName = "PlatformAdmin"
+
+// deletedUserOwnerPrefix replaces VM.created_by for VMs of a deleted user, so
+// the VMs keep a traceable owner.
+const deletedUserOwnerPrefix = "deleted-user:"
+
+// userDeleteResult counts the rows touched by a cascading user delete.
+type userDeleteResult struct {
+	roleBindings         int
+	resourceRoleBindings int
+	rateLimitRows        int
+	notifications        int
+	reassignedVMs        int
+	revokedSessions      int
+	revokedLoginSessions []string
+}
+
+// deleteUserCascade removes a user and everything bound to them in one
+// transaction: role and resource role bindings, rate limit exemptions and
+// overrides, inbox notifications and notification preferences are deleted,
+// active VNC and login sessions are revoked, and the user's VMs are re-owned by
+// "deleted-user:{id}".
+//
+// The PlatformAdmin role row is locked FOR UPDATE first, so two concurrent
+// deletes cannot both remove the last active platform admin.
+func (s *Server) deleteUserCascade(ctx context.Context, userID, actor string) (userDeleteResult, error) {
+	var result userDeleteResult
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return result, fmt.Errorf("start user delete transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx,
+		fmt.Sprintf("SELECT 1 FROM %s WHERE %s = $1 FOR UPDATE", role.Table, role.FieldName),
+		platformAdminRoleName); err != nil {
+		return result, fmt.Errorf("lock platform admin role: %w", err)
+	}
+	target, err := tx.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return result, apperrors.NotFound("USER_NOT_FOUND", fmt.Sprintf("user %s not found", userID))
+		}
+		return result, fmt.Errorf("get user %s: %w", userID, err)
+	}
+	if err := guardLastPlatformAdmin(ctx, tx, target); err != nil {
+		return result, err
+	}
+
+	if result.roleBindings, err = tx.RoleBinding.Delete().
+		Where(rolebinding.HasUserWith(entuser.IDEQ(userID))).
+		Exec(ctx); err != nil {
+		return result, fmt.Errorf("delete role bindings: %w", err)
+	}
+	if result.resourceRoleBindings, err = tx.ResourceRoleBinding.Delete().
+		Where(resourcerolebinding.UserIDEQ(userID)).
+		Exec(ctx); err != nil {
+		return result, fmt.Errorf("delete resource role bindings: %w", err)
+	}
+	// Exemptions and overrides are keyed by user ID and have no soft-delete
+	// state; removing the row is how the rate limit admin API revokes them.
+	exemptions, err := tx.RateLimitExemption.Delete().Where(ratelimitexemption.IDEQ(userID)).Exec(ctx)
+	if err != nil {
+		return result, fmt.Errorf("delete rate limit exemption: %w", err)
+	}
+	overrides, err := tx.RateLimitUserOverride.Delete().Where(ratelimituseroverride.IDEQ(userID)).Exec(ctx)
+	if err != nil {
+		return result, fmt.Errorf("delete rate limit override: %w", err)
+	}
+	result.rateLimitRows = exemptions + overrides
+	if result.notifications, err = tx.Notification.Delete().
+		Where(entnotification.HasUserWith(entuser.IDEQ(userID))).
+		Exec(ctx); err != nil {
+		return result, fmt.Errorf("delete notifications: %w", err)
+	}
+	if _, err := tx.NotificationPreference.Delete().
+		Where(notificationpreference.UserIDEQ(userID)).
+		Exec(ctx); err != nil {
+		return result, fmt.Errorf("delete notification preferences: %w", err)
+	}
+	if result.reassignedVMs, err = tx.VM.Update().
+		Where(entvm.CreatedByEQ(userID)).
+		SetCreatedBy(deletedUserOwnerPrefix + userID).
+		Save(ctx); err != nil {
+		return result, fmt.Errorf("reassign vms: %w", err)
+	}
+	now := time.Now().UTC()
+	if result.revokedSessions, err = tx.VMConsoleSession.Update().
+		Where(
+			vmconsolesession.UserIDEQ(userID),
+			vmconsolesession.RevokedAtIsNil(),
+			vmconsolesession.ExpiresAtGT(now),
+		).
+		SetRevokedAt(now).
+		SetRevokedBy(actor).
+		Save(ctx); err != nil {
+		return result, fmt.Errorf("revoke console sessions: %w", err)
+	}
+	if result.revokedLoginSessions, err = tx.LoginSession.Query().
+		Where(
+			loginsession.UserIDEQ(userID),
+			loginsession.RevokedAtIsNil(),
+			loginsession.ExpiresAtGT(now),
+		).
+		IDs(ctx); err != nil {
+		return result, fmt.Errorf("list login sessions: %w", err)
+	}
+	if len(result.revokedLoginSessions) > 0 {
+		if _, err := tx.LoginSession.Update().
+			Where(loginsession.IDIn(result.revokedLoginSessions...)).
+			SetRevokedAt(now).
+			SetRevokedBy(actor).
+			Save(ctx); err != nil {
+			return result, fmt.Errorf("revoke login sessions: %w", err)
+		}
+	}
+	if _, err := tx.PasswordHistory.Delete().
+		Where(passwordhistory.UserIDEQ(userID)).
+		Exec(ctx); err != nil {
+		return result, fmt.Errorf("delete password history: %w", err)
+	}
+	if _, err := tx.RefreshToken.Delete().
+		Where(refreshtoken.UserIDEQ(userID)).
+		Exec(ctx); err != nil {
+		return result, fmt.Errorf("delete refresh tokens: %w", err)
+	}
+
+	if err := tx.User.DeleteOneID(userID).Exec(ctx); err != nil {
+		return result, fmt.Errorf("delete user %s: %w", userID, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return result, fmt.Errorf("commit user delete transaction: %w", err)
+	}
+	return result, nil
+}
+
+// guardLastPlatformAdmin rejects deleting an enabled PlatformAdmin holder
+// when no other enabled user holds the role.
+func guardLastPlatformAdmin(ctx context.Context, tx *ent.Tx, target *ent.User) error {
+	if !target.Enabled {
+		return nil
+	}
+	isAdmin := rolebinding.HasRoleWith(role.NameEQ(platformAdminRoleName))
+	held, err := tx.RoleBinding.Query().
+		Where(isAdmin, rolebinding.HasUserWith(entuser.IDEQ(target.ID))).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("check platform admin binding: %w", err)
+	}
+	if !held {
+		return nil
+	}
+	others, err := tx.RoleBinding.Query().
+		Where(isAdmin, rolebinding.HasUserWith(entuser.IDNEQ(target.ID), entuser.EnabledEQ(true))).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("check other platform admins: %w", err)
+	}
+	if !others {
+		return apperrors.Conflict("LAST_ADMIN", "cannot delete the last active platform administrator")
+	}
+	return nil
+}
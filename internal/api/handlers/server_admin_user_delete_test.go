@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"kv-shepherd.io/shepherd/ent"
+	entnotification "kv-shepherd.io/shepherd/ent/notification"
+)
+
+func TestDeleteUser_CascadesUserData(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newSystemBehaviorTestServer(t)
+	ctx := t.Context()
+	adminRole := mustCreatePlatformAdminRole(t, client)
+	mustCreateRoleUser(t, client, "admin-1", true, adminRole)
+	devRole := client.Role.Create().SetID("role-dev").SetName("Dev").SetPermissions([]string{"vm:read"}).SaveX(ctx)
+	owner := mustCreateRoleUser(t, client, "owner-1", true, devRole)
+
+	sys := mustCreateSystem(t, client, "sys-user-delete", "shop", "owner-1")
+	svc := mustCreateService(t, client, "svc-user-delete", "redis", sys.ID, "cache")
+	mustCreateSystemBinding(t, client, owner.ID, sys.ID, "owner")
+	vm := mustCreateVMForService(t, client, "vm-user-delete", "prod-shop-redis-01", svc.ID)
+	client.RateLimitExemption.Create().SetID(owner.ID).SetExemptedBy("admin-1").SaveX(ctx)
+	client.RateLimitUserOverride.Create().SetID(owner.ID).SetCooldownSeconds(0).SetUpdatedBy("admin-1").SaveX(ctx)
+	client.Notification.Create().
+		SetID("notif-owner-1").
+		SetType(entnotification.TypeVM_STATUS_CHANGE).
+		SetTitle("VM is running").
+		SetMessage("VM is running").
+		SetUser(owner).
+		SaveX(ctx)
+	active := client.VMConsoleSession.Create().
+		SetID("vnc-active").
+		SetVMID(vm.ID).
+		SetUserID(owner.ID).
+		SetExpiresAt(time.Now().Add(time.Hour)).
+		SaveX(ctx)
+
+	c, w := newAuthedGinContext(t, http.MethodDelete, "/admin/users/"+owner.ID, "", "admin-1", []string{"user:manage"})
+	srv.DeleteUser(c, owner.ID)
+	if got := c.Writer.Status(); got != http.StatusNoContent {
+		t.Fatalf("delete user status = %d, want %d, body=%s", got, http.StatusNoContent, w.Body.String())
+	}
+
+	if _, err := client.User.Get(ctx, owner.ID); !ent.IsNotFound(err) {
+		t.Fatalf("expected user deleted, err=%v", err)
+	}
+	if n := client.RoleBinding.Query().CountX(ctx); n != 1 {
+		t.Fatalf("role bindings = %d, want only the admin binding", n)
+	}
+	if n := client.ResourceRoleBinding.Query().CountX(ctx); n != 0 {
+		t.Fatalf("resource role bindings = %d, want 0", n)
+	}
+	if n := client.RateLimitExemption.Query().CountX(ctx) + client.RateLimitUserOverride.Query().CountX(ctx); n != 0 {
+		t.Fatalf("rate limit rows = %d, want 0", n)
+	}
+	if n := client.Notification.Query().CountX(ctx); n != 0 {
+		t.Fatalf("notifications = %d, want 0", n)
+	}
+	if got := client.VM.GetX(ctx, vm.ID).CreatedBy; got != "deleted-user:owner-1" {
+		t.Fatalf("vm created_by = %q, want deleted-user:owner-1", got)
+	}
+	if session := client.VMConsoleSession.GetX(ctx, active.ID); session.RevokedAt == nil || session.RevokedBy != "admin-1" {
+		t.Fatalf("session revoked_at=%v revoked_by=%q, want revoked by admin-1", session.RevokedAt, session.RevokedBy)
+	}
+
+	c, _ = newAuthedGinContext(t, http.MethodDelete, "/admin/users/"+owner.ID, "", "admin-1", []string{"user:manage"})
+	srv.DeleteUser(c, owner.ID)
+	if got := c.Writer.Status(); got != http.StatusNotFound {
+		t.Fatalf("second delete status = %d, want %d", got, http.StatusNotFound)
+	}
+}
+
+func TestDeleteUser_LastAdmin(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newSystemBehaviorTestServer(t)
+	adminRole := mustCreatePlatformAdminRole(t, client)
+	mustCreateRoleUser(t, client, "admin-1", true, adminRole)
+	standby := mustCreateRoleUser(t, client, "admin-2", false, adminRole)
+
+	// A disabled PlatformAdmin holder does not count as a remaining admin.
+	c, w := newAuthedGinContext(t, http.MethodDelete, "/admin/users/admin-1", "", "ops-1", []string{"user:manage"})
+	srv.DeleteUser(c, "admin-1")
+	assertStatusAndCode(t, w, http.StatusConflict, "LAST_ADMIN")
+	if _, err := client.User.Get(t.Context(), "admin-1"); err != nil {
+		t.Fatalf("admin-1 should survive LAST_ADMIN rejection: %v", err)
+	}
+
+	standby.Update().SetEnabled(true).ExecX(t.Context())
+	c, w = newAuthedGinContext(t, http.MethodDelete, "/admin/users/admin-1", "", "ops-1", []string{"user:manage"})
+	srv.DeleteUser(c, "admin-1")
+	if got := c.Writer.Status(); got != http.StatusNoContent {
+		t.Fatalf("delete admin with another active admin status = %d, want %d, body=%s", got, http.StatusNoContent, w.Body.String())
+	}
+}
+
+func mustCreatePlatformAdminRole(t *testing.T, client *ent.Client) *ent.Role {
+	t.Helper()
+	return client.Role.Create().
+		SetID("role-platform-admin").
+		SetName(platformAdminRoleName).
+		SetPermissions([]string{"platform:admin"}).
+		SetBuiltIn(true).
+		SaveX(t.Context())
+}
+
+func mustCreateRoleUser(t *testing.T, client *ent.Client, id string, enabled bool, role *ent.Role) *ent.User {
+	t.Helper()
+	user := client.User.Create().SetID(id).SetUsername(id).SetEnabled(enabled).SaveX(t.Context())
+	client.RoleBinding.Create().
+		SetID("rb-" + id).
+		SetUser(user).
+		SetRole(role).
+		SetScopeType("global").
+		SetCreatedBy("seed").
+		SaveX(t.Context())
+	return user
+}
@@ -2,22 +2,23 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	"kv-shepherd.io/shepherd/ent"
-	"kv-shepherd.io/shepherd/ent/resourcerolebinding"
-	"kv-shepherd.io/shepherd/ent/role"
 	"kv-shepherd.io/shepherd/ent/rolebinding"
 	entuser "kv-shepherd.io/shepherd/ent/user"
 	"kv-shepherd.io/shepherd/internal/api/generated"
 	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/service"
 )
 
 type userCreateRequest struct {
@@ -63,6 +64,13 @@ func (s *Server) CreateUser(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "username and password are required"})
 		return
 	}
+	email := ""
+	if req.Email != nil {
+		email = strings.TrimSpace(*req.Email)
+	}
+	if !s.requirePasswordPolicy(c, "password", password, username, email) {
+		return
+	}
 
 	hash, err := HashPassword(password)
 	if err != nil {
@@ -75,10 +83,8 @@ func (s *Server) CreateUser(c *gin.Context) {
 		SetID(GenerateUserID()).
 		SetUsername(username).
 		SetPasswordHash(hash)
-	if req.Email != nil {
-		if v := strings.TrimSpace(*req.Email); v != "" {
-			create = create.SetEmail(v)
-		}
+	if email != "" {
+		create = create.SetEmail(email)
 	}
 	if req.DisplayName != nil {
 		if v := strings.TrimSpace(*req.DisplayName); v != "" {
@@ -104,6 +110,7 @@ func (s *Server) CreateUser(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
+	s.recordPasswordHistory(c, userEnt.ID, hash)
 
 	if s.audit != nil {
 		_ = s.audit.LogAction(ctx, "user.create", "user", userEnt.ID, actor, map[string]interface{}{
@@ -156,12 +163,20 @@ func (s *Server) UpdateUser(c *gin.Context, userId generated.UserID) {
 	if req.Enabled != nil {
 		update = update.SetEnabled(*req.Enabled)
 	}
+	var newPasswordHash string
 	if req.Password != nil {
 		password := strings.TrimSpace(*req.Password)
 		if password == "" {
 			c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "password cannot be empty"})
 			return
 		}
+		email := existing.Email
+		if req.Email != nil {
+			email = strings.TrimSpace(*req.Email)
+		}
+		if !s.requirePasswordPolicy(c, "password", password, existing.Username, email) {
+			return
+		}
 		hash, err := HashPassword(password)
 		if err != nil {
 			logger.Error("failed to hash updated password", zap.Error(err), zap.String("user_id", userId))
@@ -169,6 +184,7 @@ func (s *Server) UpdateUser(c *gin.Context, userId generated.UserID) {
 			return
 		}
 		update = update.SetPasswordHash(hash)
+		newPasswordHash = hash
 		if req.ForcePasswordChange == nil {
 			update = update.SetForcePasswordChange(true)
 		}
@@ -187,6 +203,9 @@ func (s *Server) UpdateUser(c *gin.Context, userId generated.UserID) {
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
+	if newPasswordHash != "" {
+		s.recordPasswordHistory(c, updated.ID, newPasswordHash)
+	}
 
 	roles, err := s.loadRoleNamesForUser(ctx, userId)
 	if err != nil {
@@ -200,50 +219,137 @@ func (s *Server) UpdateUser(c *gin.Context, userId generated.UserID) {
 	c.JSON(http.StatusOK, userToAPI(updated, roles))
 }
 
-// DeleteUser handles DELETE /admin/users/{user_id}.
-func (s *Server) DeleteUser(c *gin.Context, userId generated.UserID) {
+// ForceUserPasswordReset handles PATCH /admin/users/{user_id}/force-password-reset.
+// Every token issued to the user so far stops validating, including ones
+// without a login session row.
+func (s *Server) ForceUserPasswordReset(c *gin.Context, userId generated.UserID) {
 	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "user:manage")
 	if !ok {
 		return
 	}
 
-	if userId == actor {
-		c.JSON(http.StatusForbidden, generated.Error{Code: "FORBIDDEN", Message: "cannot delete current user"})
+	var req generated.ForcePasswordResetRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
 		return
 	}
 
-	if _, err := s.client.User.Get(ctx, userId); err != nil {
+	existing, err := s.client.User.Get(ctx, userId)
+	if err != nil {
 		if ent.IsNotFound(err) {
 			c.JSON(http.StatusNotFound, generated.Error{Code: "USER_NOT_FOUND"})
 			return
 		}
-		logger.Error("failed to query user for delete", zap.Error(err), zap.String("user_id", userId))
+		logger.Error("failed to query user for password reset", zap.Error(err), zap.String("user_id", userId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	update := existing.Update().SetForcePasswordChange(true)
+	var newPasswordHash string
+	if req.NewTemporaryPassword != "" {
+		password := strings.TrimSpace(req.NewTemporaryPassword)
+		if password == "" {
+			c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "new_temporary_password cannot be blank"})
+			return
+		}
+		if !s.requirePasswordPolicy(c, "new_temporary_password", password, existing.Username, existing.Email) {
+			return
+		}
+		hash, err := HashPassword(password)
+		if err != nil {
+			logger.Error("failed to hash temporary password", zap.Error(err), zap.String("user_id", userId))
+			c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+			return
+		}
+		update = update.SetPasswordHash(hash)
+		newPasswordHash = hash
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		logger.Error("failed to force password reset", zap.Error(err), zap.String("user_id", userId))
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
+	if newPasswordHash != "" {
+		s.recordPasswordHistory(c, updated.ID, newPasswordHash)
+	}
 
-	if _, err := s.client.RoleBinding.Delete().Where(rolebinding.HasUserWith(entuser.IDEQ(userId))).Exec(ctx); err != nil {
-		logger.Error("failed to delete role bindings for user", zap.Error(err), zap.String("user_id", userId))
+	revokedBefore := time.Now().UTC().Truncate(time.Second)
+	if err := s.loginSessions.RevokeUserTokensBefore(ctx, userId, actor, revokedBefore); err != nil {
+		logger.Error("failed to revoke user tokens", zap.Error(err), zap.String("user_id", userId))
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
-	if _, err := s.client.ResourceRoleBinding.Delete().Where(resourcerolebinding.UserIDEQ(userId)).Exec(ctx); err != nil {
-		logger.Error("failed to delete resource role bindings for user", zap.Error(err), zap.String("user_id", userId))
+	// Tokens issued earlier in the same second are only caught by their rows.
+	revoked, err := s.loginSessions.RevokeUserSessions(ctx, userId, actor, "")
+	if err != nil {
+		logger.Error("failed to revoke user sessions", zap.Error(err), zap.String("user_id", userId))
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
-	if err := s.client.User.DeleteOneID(userId).Exec(ctx); err != nil {
-		if ent.IsNotFound(err) {
-			c.JSON(http.StatusNotFound, generated.Error{Code: "USER_NOT_FOUND"})
-			return
-		}
-		logger.Error("failed to delete user", zap.Error(err), zap.String("user_id", userId))
+	s.markTokensRevoked(revoked...)
+	s.markUserTokensRevoked(userId, revokedBefore)
+	revokedRefresh, err := s.refreshTokens.RevokeUser(ctx, userId)
+	if err != nil {
+		logger.Error("failed to revoke refresh tokens", zap.Error(err), zap.String("user_id", userId))
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
 
 	if s.audit != nil {
-		_ = s.audit.LogAction(ctx, "user.delete", "user", userId, actor, nil)
+		_ = s.audit.LogAction(ctx, "user.force_password_reset", "user", updated.ID, actor, map[string]interface{}{
+			"password_reset":         newPasswordHash != "",
+			"revoked_before":         revokedBefore,
+			"revoked_sessions":       len(revoked),
+			"revoked_refresh_tokens": revokedRefresh,
+			"notify_user":            req.NotifyUser,
+		})
+	}
+	if req.NotifyUser && s.notifier != nil {
+		s.notifier.OnPasswordResetForced(ctx, updated.ID, updated.Username, actor)
+	}
+
+	roles, err := s.loadRoleNamesForUser(ctx, userId)
+	if err != nil {
+		logger.Error("failed to load role names for user", zap.Error(err), zap.String("user_id", userId))
+	}
+	c.JSON(http.StatusOK, userToAPI(updated, roles))
+}
+
+// DeleteUser handles DELETE /admin/users/{user_id}. The cleanup of the
+// user's bindings, sessions and VM ownership is transactional; see
+// deleteUserCascade.
+func (s *Server) DeleteUser(c *gin.Context, userId generated.UserID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "user:manage")
+	if !ok {
+		return
+	}
+
+	if userId == actor {
+		c.JSON(http.StatusForbidden, generated.Error{Code: "FORBIDDEN", Message: "cannot delete current user"})
+		return
+	}
+
+	result, err := s.deleteUserCascade(ctx, userId, actor)
+	if err != nil {
+		respondDeleteGuardError(c, err, zap.String("user_id", userId))
+		return
+	}
+	s.markTokensRevoked(result.revokedLoginSessions...)
+	s.invalidatePermissions(userId)
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "user.delete", "user", userId, actor, map[string]interface{}{
+			"role_bindings":          result.roleBindings,
+			"resource_role_bindings": result.resourceRoleBindings,
+			"rate_limit_rows":        result.rateLimitRows,
+			"notifications":          result.notifications,
+			"reassigned_vms":         result.reassignedVMs,
+			"revoked_sessions":       result.revokedSessions,
+			"revoked_login_sessions": len(result.revokedLoginSessions),
+		})
 	}
 
 	c.Status(http.StatusNoContent)
@@ -313,91 +419,7 @@ func (s *Server) CreateUserRoleBinding(c *gin.Context, userId generated.UserID)
 		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
 		return
 	}
-
-	roleID := strings.TrimSpace(req.RoleId)
-	if roleID == "" {
-		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "role_id is required"})
-		return
-	}
-	roleEnt, err := s.client.Role.Get(ctx, roleID)
-	if err != nil {
-		if ent.IsNotFound(err) {
-			c.JSON(http.StatusNotFound, generated.Error{Code: "ROLE_NOT_FOUND"})
-			return
-		}
-		logger.Error("failed to query role for role binding create", zap.Error(err), zap.String("role_id", roleID))
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
-	}
-
-	scopeType := "global"
-	if req.ScopeType != nil {
-		if v := strings.TrimSpace(*req.ScopeType); v != "" {
-			scopeType = v
-		}
-	}
-	scopeID := ""
-	if req.ScopeId != nil {
-		scopeID = strings.TrimSpace(*req.ScopeId)
-	}
-
-	allowedEnvs, err := normalizeAllowedEnvironments(req.AllowedEnvironments)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
-		return
-	}
-
-	dupQuery := s.client.RoleBinding.Query().Where(
-		rolebinding.HasUserWith(entuser.IDEQ(userId)),
-		rolebinding.HasRoleWith(role.IDEQ(roleID)),
-		rolebinding.ScopeTypeEQ(scopeType),
-	)
-	if scopeID == "" {
-		dupQuery = dupQuery.Where(rolebinding.ScopeIDIsNil())
-	} else {
-		dupQuery = dupQuery.Where(rolebinding.ScopeIDEQ(scopeID))
-	}
-	exists, err := dupQuery.Exist(ctx)
-	if err != nil {
-		logger.Error("failed to check duplicate role binding", zap.Error(err), zap.String("user_id", userId), zap.String("role_id", roleID))
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
-	}
-	if exists {
-		c.JSON(http.StatusConflict, generated.Error{Code: "ROLE_BINDING_EXISTS"})
-		return
-	}
-
-	id, _ := uuid.NewV7()
-	create := s.client.RoleBinding.Create().
-		SetID(id.String()).
-		SetUserID(userId).
-		SetRoleID(roleID).
-		SetScopeType(scopeType).
-		SetCreatedBy(actor)
-	if scopeID != "" {
-		create = create.SetScopeID(scopeID)
-	}
-	if len(allowedEnvs) > 0 {
-		create = create.SetAllowedEnvironments(allowedEnvs)
-	}
-
-	binding, err := create.Save(ctx)
-	if err != nil {
-		logger.Error("failed to create role binding", zap.Error(err), zap.String("user_id", userId), zap.String("role_id", roleID))
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
-	}
-
-	if s.audit != nil {
-		_ = s.audit.LogAction(ctx, "rbac.binding.create", "user", userId, actor, map[string]interface{}{
-			"role_id":    roleID,
-			"scope_type": scopeType,
-			"scope_id":   scopeID,
-		})
-	}
-
-	c.JSON(http.StatusCreated, roleBindingToAPI(binding, userId, roleEnt.ID, roleEnt.Name))
+	s.createRoleBinding(c, ctx, actor, userId, req)
 }
 
 // DeleteUserRoleBinding handles DELETE /admin/users/{user_id}/role-bindings/{binding_id}.
@@ -407,39 +429,10 @@ func (s *Server) DeleteUserRoleBinding(c *gin.Context, userId generated.UserID,
 		return
 	}
 
-	binding, err := s.client.RoleBinding.Query().
-		Where(
-			rolebinding.IDEQ(bindingId),
-			rolebinding.HasUserWith(entuser.IDEQ(userId)),
-		).
-		Only(ctx)
-	if err != nil {
-		if ent.IsNotFound(err) {
-			c.JSON(http.StatusNotFound, generated.Error{Code: "ROLE_BINDING_NOT_FOUND"})
-			return
-		}
-		logger.Error("failed to query role binding for delete", zap.Error(err), zap.String("binding_id", bindingId), zap.String("user_id", userId))
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
-	}
-
-	if err := s.client.RoleBinding.DeleteOneID(binding.ID).Exec(ctx); err != nil {
-		if ent.IsNotFound(err) {
-			c.JSON(http.StatusNotFound, generated.Error{Code: "ROLE_BINDING_NOT_FOUND"})
-			return
-		}
-		logger.Error("failed to delete role binding", zap.Error(err), zap.String("binding_id", bindingId), zap.String("user_id", userId))
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
-	}
-
-	if s.audit != nil {
-		_ = s.audit.LogAction(ctx, "rbac.binding.delete", "user", userId, actor, map[string]interface{}{
-			"binding_id": bindingId,
-		})
-	}
-
-	c.Status(http.StatusNoContent)
+	s.deleteRoleBinding(c, ctx, actor,
+		rolebinding.IDEQ(bindingId),
+		rolebinding.HasUserWith(entuser.IDEQ(userId)),
+	)
 }
 
 func loadRoleNames(bindings []*ent.RoleBinding) []string {
@@ -486,12 +479,16 @@ func roleBindingToAPI(binding *ent.RoleBinding, userID, roleID, roleName string)
 	for _, env := range binding.AllowedEnvironments {
 		allowed = append(allowed, generated.GlobalRoleBindingAllowedEnvironments(env))
 	}
+	scopeType := binding.ScopeType
+	if service.IsGlobalRoleBindingScope(scopeType) {
+		scopeType = service.RoleBindingScopeGlobal
+	}
 	return generated.GlobalRoleBinding{
 		Id:                  binding.ID,
 		UserId:              userID,
 		RoleId:              roleID,
 		RoleName:            roleName,
-		ScopeType:           binding.ScopeType,
+		ScopeType:           scopeType,
 		ScopeId:             binding.ScopeID,
 		AllowedEnvironments: allowed,
 		CreatedBy:           binding.CreatedBy,
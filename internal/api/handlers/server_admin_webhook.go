@@ -0,0 +1,408 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/webhookdelivery"
+	"kv-shepherd.io/shepherd/ent/webhookendpoint"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/notification"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// maxWebhookURLLength mirrors the webhook_endpoints.url column limit.
+const maxWebhookURLLength = 2048
+
+// webhookTestTimeout bounds the synchronous test delivery so a slow receiver
+// cannot hold the admin request open.
+const webhookTestTimeout = 10 * time.Second
+
+// ListWebhooks handles GET /admin/webhooks.
+func (s *Server) ListWebhooks(c *gin.Context, params generated.ListWebhooksParams) {
+	ctx, _, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+
+	page, perPage := defaultPagination(params.Page, params.PerPage)
+	query := s.client.WebhookEndpoint.Query()
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		logger.Error("failed to count webhook endpoints", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	endpoints, err := query.
+		Order(webhookendpoint.ByCreatedAt(), webhookendpoint.ByID()).
+		Offset((page - 1) * perPage).
+		Limit(perPage).
+		All(ctx)
+	if err != nil {
+		logger.Error("failed to list webhook endpoints", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	items := make([]generated.WebhookEndpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		items = append(items, webhookEndpointToAPI(endpoint))
+	}
+	c.JSON(http.StatusOK, generated.WebhookEndpointList{
+		Items: items,
+		Pagination: generated.Pagination{
+			Page:       page,
+			PerPage:    perPage,
+			Total:      total,
+			TotalPages: (total + perPage - 1) / perPage,
+		},
+	})
+}
+
+// CreateWebhook handles POST /admin/webhooks.
+func (s *Server) CreateWebhook(c *gin.Context) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+
+	var req generated.WebhookCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+	endpointURL, err := validateWebhookURL(req.Url)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
+		return
+	}
+	if strings.TrimSpace(req.Secret) == "" {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "secret is required"})
+		return
+	}
+	eventTypes, err := validateWebhookEventTypes(req.EventTypes)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
+		return
+	}
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+
+	id, _ := uuid.NewV7()
+	endpoint, err := s.client.WebhookEndpoint.Create().
+		SetID(id.String()).
+		SetURL(endpointURL).
+		SetSecret(req.Secret).
+		SetEventTypes(eventTypes).
+		SetEnabled(enabled).
+		SetCreatedBy(actor).
+		Save(ctx)
+	if err != nil {
+		logger.Error("failed to create webhook endpoint", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "webhook.create", "webhook", endpoint.ID, actor, map[string]interface{}{
+			"url":         endpoint.URL,
+			"event_types": endpoint.EventTypes,
+			"enabled":     endpoint.Enabled,
+		})
+	}
+
+	c.JSON(http.StatusCreated, webhookEndpointToAPI(endpoint))
+}
+
+// GetWebhook handles GET /admin/webhooks/{webhook_id}.
+func (s *Server) GetWebhook(c *gin.Context, webhookId generated.WebhookID) {
+	if _, _, ok := requireActorWithAnyGlobalPermission(c, "platform:admin"); !ok {
+		return
+	}
+
+	endpoint, ok := s.getWebhookEndpoint(c, webhookId)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, webhookEndpointToAPI(endpoint))
+}
+
+// UpdateWebhook handles PATCH /admin/webhooks/{webhook_id}.
+func (s *Server) UpdateWebhook(c *gin.Context, webhookId generated.WebhookID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+
+	var req generated.WebhookUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+
+	existing, ok := s.getWebhookEndpoint(c, webhookId)
+	if !ok {
+		return
+	}
+
+	update := existing.Update()
+	changes := map[string]interface{}{}
+	if req.Url != "" {
+		endpointURL, err := validateWebhookURL(req.Url)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
+			return
+		}
+		update.SetURL(endpointURL)
+		changes["url"] = endpointURL
+	}
+	if req.Secret != "" {
+		if strings.TrimSpace(req.Secret) == "" {
+			c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "secret must not be blank"})
+			return
+		}
+		update.SetSecret(req.Secret)
+		changes["secret_rotated"] = true
+	}
+	if req.EventTypes != nil {
+		eventTypes, err := validateWebhookEventTypes(req.EventTypes)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
+			return
+		}
+		update.SetEventTypes(eventTypes)
+		changes["event_types"] = eventTypes
+	}
+	if req.Enabled != nil {
+		update.SetEnabled(*req.Enabled)
+		changes["enabled"] = *req.Enabled
+	}
+
+	endpoint, err := update.Save(ctx)
+	if err != nil {
+		logger.Error("failed to update webhook endpoint", zap.Error(err), zap.String("webhook_id", webhookId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil && len(changes) > 0 {
+		_ = s.audit.LogAction(ctx, "webhook.update", "webhook", endpoint.ID, actor, changes)
+	}
+
+	c.JSON(http.StatusOK, webhookEndpointToAPI(endpoint))
+}
+
+// DeleteWebhook handles DELETE /admin/webhooks/{webhook_id}.
+// Delivery records are kept for troubleshooting.
+func (s *Server) DeleteWebhook(c *gin.Context, webhookId generated.WebhookID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+
+	endpoint, ok := s.getWebhookEndpoint(c, webhookId)
+	if !ok {
+		return
+	}
+	if err := s.client.WebhookEndpoint.DeleteOne(endpoint).Exec(ctx); err != nil {
+		logger.Error("failed to delete webhook endpoint", zap.Error(err), zap.String("webhook_id", webhookId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "webhook.delete", "webhook", endpoint.ID, actor, map[string]interface{}{
+			"url": endpoint.URL,
+		})
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListWebhookDeliveries handles GET /admin/webhooks/{webhook_id}/deliveries.
+func (s *Server) ListWebhookDeliveries(c *gin.Context, webhookId generated.WebhookID, params generated.ListWebhookDeliveriesParams) {
+	ctx, _, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+	if _, ok := s.getWebhookEndpoint(c, webhookId); !ok {
+		return
+	}
+
+	page, perPage := defaultPagination(params.Page, params.PerPage)
+	query := s.client.WebhookDelivery.Query().
+		Where(webhookdelivery.EndpointIDEQ(webhookId))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		logger.Error("failed to count webhook deliveries", zap.Error(err), zap.String("webhook_id", webhookId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	deliveries, err := query.
+		Order(ent.Desc(webhookdelivery.FieldCreatedAt), ent.Desc(webhookdelivery.FieldID)).
+		Offset((page - 1) * perPage).
+		Limit(perPage).
+		All(ctx)
+	if err != nil {
+		logger.Error("failed to list webhook deliveries", zap.Error(err), zap.String("webhook_id", webhookId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	items := make([]generated.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		items = append(items, generated.WebhookDelivery{
+			Id:         d.ID,
+			EndpointId: d.EndpointID,
+			DeliveryId: d.DeliveryID,
+			EventType:  d.EventType,
+			Attempt:    d.Attempt,
+			Success:    d.Success,
+			StatusCode: d.StatusCode,
+			Error:      d.Error,
+			DurationMs: d.DurationMs,
+			CreatedAt:  d.CreatedAt,
+		})
+	}
+	c.JSON(http.StatusOK, generated.WebhookDeliveryList{
+		Items: items,
+		Pagination: generated.Pagination{
+			Page:       page,
+			PerPage:    perPage,
+			Total:      total,
+			TotalPages: (total + perPage - 1) / perPage,
+		},
+	})
+}
+
+// TestWebhook handles POST /admin/webhooks/{webhook_id}/test.
+// The test event is sent once, synchronously, and is not recorded as a
+// delivery; a receiver failure is reported in the result, not as an error.
+func (s *Server) TestWebhook(c *gin.Context, webhookId generated.WebhookID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+	endpoint, ok := s.getWebhookEndpoint(c, webhookId)
+	if !ok {
+		return
+	}
+
+	payload, err := json.Marshal(notification.WebhookEvent{
+		Type:       notification.WebhookEventTest,
+		OccurredAt: time.Now().UTC(),
+		Data: map[string]string{
+			"webhook_id": endpoint.ID,
+			"actor":      actor,
+		},
+	})
+	if err != nil {
+		logger.Error("failed to marshal webhook test event", zap.Error(err), zap.String("webhook_id", webhookId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	deliveryID := uuid.NewString()
+	client := &http.Client{Timeout: webhookTestTimeout}
+	start := time.Now()
+	statusCode, postErr := notification.PostWebhook(ctx, client, endpoint.URL, endpoint.Secret,
+		notification.WebhookEventTest, deliveryID, payload)
+	result := generated.WebhookTestResult{
+		DeliveryId: deliveryID,
+		Success:    postErr == nil,
+		StatusCode: statusCode,
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+	if postErr != nil {
+		result.Error = postErr.Error()
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "webhook.test", "webhook", endpoint.ID, actor, map[string]interface{}{
+			"url":         endpoint.URL,
+			"delivery_id": deliveryID,
+			"success":     result.Success,
+			"status_code": statusCode,
+		})
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (s *Server) getWebhookEndpoint(c *gin.Context, id string) (*ent.WebhookEndpoint, bool) {
+	endpoint, err := s.client.WebhookEndpoint.Get(c.Request.Context(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "WEBHOOK_NOT_FOUND"})
+			return nil, false
+		}
+		logger.Error("failed to get webhook endpoint", zap.Error(err), zap.String("webhook_id", id))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return nil, false
+	}
+	return endpoint, true
+}
+
+// validateWebhookURL requires an absolute http(s) URL with a host.
+func validateWebhookURL(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", fmt.Errorf("url is required")
+	}
+	if len(raw) > maxWebhookURLLength {
+		return "", fmt.Errorf("url must be at most %d characters", maxWebhookURLLength)
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return "", fmt.Errorf("url must be an absolute http or https URL")
+	}
+	return raw, nil
+}
+
+// validateWebhookEventTypes rejects empty or unknown subscriptions and drops duplicates.
+func validateWebhookEventTypes(raw []generated.WebhookEventType) ([]string, error) {
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("event_types must not be empty")
+	}
+	out := make([]string, 0, len(raw))
+	for _, eventType := range raw {
+		value := string(eventType)
+		if !slices.Contains(notification.WebhookEventTypes, value) {
+			return nil, fmt.Errorf("unsupported event type %q", value)
+		}
+		if !slices.Contains(out, value) {
+			out = append(out, value)
+		}
+	}
+	return out, nil
+}
+
+func webhookEndpointToAPI(endpoint *ent.WebhookEndpoint) generated.WebhookEndpoint {
+	eventTypes := make([]generated.WebhookEventType, 0, len(endpoint.EventTypes))
+	for _, eventType := range endpoint.EventTypes {
+		eventTypes = append(eventTypes, generated.WebhookEventType(eventType))
+	}
+	return generated.WebhookEndpoint{
+		Id:         endpoint.ID,
+		Url:        endpoint.URL,
+		EventTypes: eventTypes,
+		Enabled:    endpoint.Enabled,
+		CreatedBy:  endpoint.CreatedBy,
+		CreatedAt:  endpoint.CreatedAt,
+		UpdatedAt:  endpoint.UpdatedAt,
+	}
+}
@@ -0,0 +1,190 @@
+package handlers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/notification"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestAdminWebhookCRUD(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "admin_webhook")
+	srv := NewServer(ServerDeps{EntClient: client})
+	admin := []string{"platform:admin"}
+
+	c, w := newAuthedGinContext(t, http.MethodPost, "/admin/webhooks", mustJSON(t, map[string]any{
+		"url": "https://hooks.example.com/shepherd", "secret": "s3cret", "event_types": []string{"ticket.approved"},
+	}), "user-1", []string{"approval:approve"})
+	srv.CreateWebhook(c)
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("non-admin create status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+
+	invalid := []map[string]any{
+		{"url": "ftp://hooks.example.com", "secret": "s3cret", "event_types": []string{"ticket.approved"}},
+		{"url": "https://hooks.example.com", "secret": "s3cret", "event_types": []string{}},
+		{"url": "https://hooks.example.com", "secret": "s3cret", "event_types": []string{"vm.migrated"}},
+		{"url": "https://hooks.example.com", "secret": "  ", "event_types": []string{"ticket.approved"}},
+	}
+	for _, body := range invalid {
+		c, w = newAuthedGinContext(t, http.MethodPost, "/admin/webhooks", mustJSON(t, body), "admin-1", admin)
+		srv.CreateWebhook(c)
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("create %v status = %d, want %d", body, w.Code, http.StatusBadRequest)
+		}
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodPost, "/admin/webhooks", mustJSON(t, map[string]any{
+		"url":         "https://hooks.example.com/shepherd",
+		"secret":      "s3cret",
+		"event_types": []string{"ticket.approved", "ticket.rejected", "ticket.approved"},
+	}), "admin-1", admin)
+	srv.CreateWebhook(c)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create status = %d, want %d body=%s", w.Code, http.StatusCreated, w.Body.String())
+	}
+	var created generated.WebhookEndpoint
+	mustDecodeJSON(t, w.Body.Bytes(), &created)
+	if !created.Enabled || len(created.EventTypes) != 2 || created.CreatedBy != "admin-1" {
+		t.Fatalf("created = %+v, want enabled endpoint with 2 event types by admin-1", created)
+	}
+	if _, leaked := mustDecodeMap(t, w.Body.Bytes())["secret"]; leaked {
+		t.Fatal("create response exposes secret")
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodPatch, "/admin/webhooks/"+created.Id, `{"enabled":false,"secret":"rotated"}`, "admin-1", admin)
+	srv.UpdateWebhook(c, created.Id)
+	if w.Code != http.StatusOK {
+		t.Fatalf("update status = %d, want %d body=%s", w.Code, http.StatusOK, w.Body.String())
+	}
+	stored, err := client.WebhookEndpoint.Get(t.Context(), created.Id)
+	if err != nil {
+		t.Fatalf("get endpoint: %v", err)
+	}
+	if stored.Enabled || stored.Secret != "rotated" || stored.URL != created.Url {
+		t.Fatalf("stored = enabled:%v secret:%q url:%q, want disabled rotated endpoint with unchanged url", stored.Enabled, stored.Secret, stored.URL)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodGet, "/admin/webhooks", "", "admin-1", admin)
+	srv.ListWebhooks(c, generated.ListWebhooksParams{})
+	var list generated.WebhookEndpointList
+	mustDecodeJSON(t, w.Body.Bytes(), &list)
+	if w.Code != http.StatusOK || list.Pagination.Total != 1 || len(list.Items) != 1 {
+		t.Fatalf("list status = %d total = %d, want 200 with 1 endpoint", w.Code, list.Pagination.Total)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodDelete, "/admin/webhooks/"+created.Id, "", "admin-1", admin)
+	srv.DeleteWebhook(c, created.Id)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("delete status = %d, want %d", w.Code, http.StatusNoContent)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodGet, "/admin/webhooks/"+created.Id+"/deliveries", "", "admin-1", admin)
+	srv.ListWebhookDeliveries(c, created.Id, generated.ListWebhookDeliveriesParams{})
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("deliveries after delete status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+	assertErrorCode(t, w.Body.Bytes(), "WEBHOOK_NOT_FOUND")
+}
+
+func TestAdminWebhookTest(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "admin_webhook_test")
+	srv := NewServer(ServerDeps{EntClient: client, Audit: audit.NewLogger(client)})
+	admin := []string{"platform:admin"}
+
+	var (
+		mu        sync.Mutex
+		signature string
+		body      []byte
+		status    = http.StatusOK
+	)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		signature = r.Header.Get(notification.WebhookSignatureHeader)
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	defer receiver.Close()
+
+	endpoint, err := client.WebhookEndpoint.Create().
+		SetID("hook-test").
+		SetURL(receiver.URL).
+		SetSecret("s3cret").
+		SetEventTypes([]string{notification.WebhookEventVMCreated}).
+		SetEnabled(false).
+		SetCreatedBy("admin-1").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("create endpoint: %v", err)
+	}
+
+	c, w := newAuthedGinContext(t, http.MethodPost, "/admin/webhooks/"+endpoint.ID+"/test", "", "user-1", []string{"approval:approve"})
+	srv.TestWebhook(c, endpoint.ID)
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("non-admin test status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodPost, "/admin/webhooks/missing/test", "", "admin-1", admin)
+	srv.TestWebhook(c, "missing")
+	assertStatusAndCode(t, w, http.StatusNotFound, "WEBHOOK_NOT_FOUND")
+
+	c, w = newAuthedGinContext(t, http.MethodPost, "/admin/webhooks/"+endpoint.ID+"/test", "", "admin-1", admin)
+	srv.TestWebhook(c, endpoint.ID)
+	if w.Code != http.StatusOK {
+		t.Fatalf("test status = %d, want %d body=%s", w.Code, http.StatusOK, w.Body.String())
+	}
+	var result generated.WebhookTestResult
+	mustDecodeJSON(t, w.Body.Bytes(), &result)
+	if !result.Success || result.StatusCode != http.StatusOK || result.DeliveryId == "" {
+		t.Fatalf("result = %+v, want successful HTTP 200 delivery", result)
+	}
+	mu.Lock()
+	if want := notification.SignWebhookPayload("s3cret", body); signature != want {
+		t.Fatalf("signature = %q, want %q", signature, want)
+	}
+	var event notification.WebhookEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		t.Fatalf("decode event: %v", err)
+	}
+	if event.Type != notification.WebhookEventTest || event.Data["webhook_id"] != endpoint.ID || event.Data["actor"] != "admin-1" {
+		t.Fatalf("event = %+v, want webhook.test for %s by admin-1", event, endpoint.ID)
+	}
+	status = http.StatusServiceUnavailable
+	mu.Unlock()
+
+	c, w = newAuthedGinContext(t, http.MethodPost, "/admin/webhooks/"+endpoint.ID+"/test", "", "admin-1", admin)
+	srv.TestWebhook(c, endpoint.ID)
+	mustDecodeJSON(t, w.Body.Bytes(), &result)
+	if w.Code != http.StatusOK || result.Success || result.StatusCode != http.StatusServiceUnavailable || result.Error == "" {
+		t.Fatalf("failing receiver: status = %d result = %+v, want 200 with failed HTTP 503 result", w.Code, result)
+	}
+	if n := client.WebhookDelivery.Query().CountX(t.Context()); n != 0 {
+		t.Fatalf("delivery records = %d, want test sends not recorded", n)
+	}
+}
+
+func mustDecodeMap(t *testing.T, payload []byte) map[string]any {
+	t.Helper()
+	var out map[string]any
+	mustDecodeJSON(t, payload, &out)
+	return out
+}
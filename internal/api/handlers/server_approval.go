@@ -1,25 +1,46 @@
 package handlers
 
 import (
+	"context"
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"entgo.io/ent/dialect/sql"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
 
 	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvaldecision"
 	"kv-shepherd.io/shepherd/ent/approvalticket"
 	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/ent/predicate"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
 	"kv-shepherd.io/shepherd/internal/api/generated"
 	"kv-shepherd.io/shepherd/internal/api/middleware"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/governance/approval"
 	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
 	"kv-shepherd.io/shepherd/internal/pkg/logger"
 )
 
-// vmTargetInfo holds extracted VM information from a DELETE domain event payload.
+// maxApprovalCommentLength mirrors the approval_comment column limit.
+const maxApprovalCommentLength = 1000
+
+// maxApprovalSearchLength bounds the ListApprovals search term.
+const maxApprovalSearchLength = 200
+
+// vmTargetInfo holds extracted VM information from a DELETE or RESIZE domain
+// event payload. Resize is set only for RESIZE payloads.
 type vmTargetInfo struct {
 	VMID   string
 	VMName string
+	Resize *domain.VMResizePayload
 }
 
 // ListApprovals handles GET /approvals.
@@ -29,11 +50,44 @@ func (s *Server) ListApprovals(c *gin.Context, params generated.ListApprovalsPar
 		return
 	}
 
-	query := s.client.ApprovalTicket.Query()
+	predicates, err := approvalListFilters(params, middleware.GetUserID(ctx))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
+		return
+	}
+	query := s.client.ApprovalTicket.Query().Where(predicates...)
+
+	var order []approvalticket.OrderOption
+	desc := params.SortOrder == generated.ListApprovalsParamsSortOrderDesc
+	createdOrder := approvalticket.ByCreatedAt()
+	if desc {
+		createdOrder = approvalticket.ByCreatedAt(sql.OrderDesc())
+	}
+	keyset := true
+	switch params.SortBy {
+	case "", generated.ListApprovalsParamsSortByCreatedAt:
+		order = append(order, createdOrder)
+	case generated.ListApprovalsParamsSortByPriority:
+		order = append(order, approval.OrderByPriority(time.Now()), createdOrder)
+		keyset = false
+	default:
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "sort_by must be created_at or priority"})
+		return
+	}
+	order = append(order, approvalticket.ByID())
 
-	// Filter by status (omitzero: empty string = not specified).
-	if params.Status != "" {
-		query = query.Where(approvalticket.StatusEQ(approvalticket.Status(params.Status)))
+	var cursor *approvalCursor
+	if params.Cursor != "" {
+		if !keyset {
+			c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "cursor requires sort_by=created_at"})
+			return
+		}
+		decoded, err := decodeApprovalCursor(params.Cursor)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
+			return
+		}
+		cursor = &decoded
 	}
 
 	page, perPage := defaultPagination(params.Page, params.PerPage)
@@ -46,57 +100,74 @@ func (s *Server) ListApprovals(c *gin.Context, params generated.ListApprovalsPar
 		return
 	}
 
-	tickets, err := query.
-		Offset(offset).
-		Limit(perPage).
-		Order(ent.Asc(approvalticket.FieldCreatedAt)).
-		All(ctx)
+	// Fetch one extra row to learn whether another page follows.
+	pageQuery := query.Limit(perPage + 1).Order(order...)
+	if cursor != nil {
+		pageQuery = pageQuery.Where(cursor.after(desc))
+		page = 0
+	} else {
+		pageQuery = pageQuery.Offset(offset)
+	}
+	tickets, err := pageQuery.All(ctx)
 	if err != nil {
 		logger.Error("failed to list approval tickets", zap.Error(err), zap.Int("page", page))
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
+	var nextCursor string
+	if len(tickets) > perPage {
+		tickets = tickets[:perPage]
+		if keyset {
+			nextCursor = encodeApprovalCursor(tickets[len(tickets)-1])
+		}
+	}
 
-	// Collect event IDs for DELETE tickets to batch-fetch target VM info.
-	deleteEventIDs := make([]string, 0)
+	// Collect event IDs for DELETE/RESIZE/SNAPSHOT tickets to batch-fetch target VM info.
+	targetEventIDs := make([]string, 0)
 	for _, t := range tickets {
-		if t.OperationType == approvalticket.OperationTypeDELETE {
-			deleteEventIDs = append(deleteEventIDs, t.EventID)
+		switch t.OperationType {
+		case approvalticket.OperationTypeDELETE, approvalticket.OperationTypeRESIZE, approvalticket.OperationTypeSNAPSHOT:
+			targetEventIDs = append(targetEventIDs, t.EventID)
 		}
 	}
 
-	// Batch-fetch domain events for DELETE tickets and extract VM info from payload.
+	// Batch-fetch domain events and extract VM info (and resize sizes) from payload.
 	vmInfoMap := make(map[string]vmTargetInfo) // key = event_id
-	if len(deleteEventIDs) > 0 {
+	if len(targetEventIDs) > 0 {
 		events, err := s.client.DomainEvent.Query().
-			Where(domainevent.IDIn(deleteEventIDs...)).
+			Where(domainevent.IDIn(targetEventIDs...)).
 			All(ctx)
 		if err != nil {
 			// Non-fatal: log and continue without VM info.
-			logger.Warn("failed to fetch domain events for delete tickets", zap.Error(err))
+			logger.Warn("failed to fetch domain events for vm target tickets", zap.Error(err))
 		} else {
 			for _, ev := range events {
-				var payload struct {
-					VMID   string `json:"vm_id"`
-					VMName string `json:"vm_name"`
-				}
-				if err := json.Unmarshal(ev.Payload, &payload); err == nil {
-					vmInfoMap[ev.ID] = vmTargetInfo{
-						VMID:   payload.VMID,
-						VMName: payload.VMName,
-					}
+				if info, ok := vmTargetInfoFromEvent(ev); ok {
+					vmInfoMap[ev.ID] = info
 				}
 			}
 		}
 	}
 
+	// Multi-level approval progress (ADR-0005 V2).
+	ticketIDs := make([]string, 0, len(tickets))
+	for _, t := range tickets {
+		ticketIDs = append(ticketIDs, t.ID)
+	}
+	approvalsReceived, err := approval.CountApprovalDecisions(ctx, s.client, ticketIDs...)
+	if err != nil {
+		logger.Error("failed to count approval decisions", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
 	items := make([]generated.ApprovalTicket, 0, len(tickets))
 	for _, t := range tickets {
 		item := ticketToAPI(t)
-		// Enrich DELETE tickets with target VM info.
+		item.ApprovalsReceived = approvalsReceived[t.ID]
+		// Enrich DELETE/RESIZE/SNAPSHOT tickets with target VM info.
 		if info, ok := vmInfoMap[t.EventID]; ok {
-			item.TargetVmId = info.VMID
-			item.TargetVmName = info.VMName
+			applyVMTargetInfo(&item, t, info)
 		}
 		items = append(items, item)
 	}
@@ -110,9 +181,265 @@ func (s *Server) ListApprovals(c *gin.Context, params generated.ListApprovalsPar
 			Total:      total,
 			TotalPages: totalPages,
 		},
+		NextCursor: nextCursor,
 	})
 }
 
+// GetApproval handles GET /approvals/{ticket_id}. Requesters may read their
+// own tickets without approval:view.
+func (s *Server) GetApproval(c *gin.Context, ticketId generated.TicketID) {
+	ctx, _, t, ok := s.loadTicketForComments(c, ticketId, "approval:view")
+	if !ok {
+		return
+	}
+
+	item := ticketToAPI(t)
+	approvalsReceived, err := approval.CountApprovalDecisions(ctx, s.client, t.ID)
+	if err != nil {
+		logger.Error("failed to count approval decisions", zap.Error(err), zap.String("ticket_id", t.ID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	item.ApprovalsReceived = approvalsReceived[t.ID]
+
+	switch t.OperationType {
+	case approvalticket.OperationTypeDELETE, approvalticket.OperationTypeRESIZE, approvalticket.OperationTypeSNAPSHOT:
+		ev, err := s.client.DomainEvent.Get(ctx, t.EventID)
+		if err != nil {
+			// Non-fatal, as in ListApprovals.
+			logger.Warn("failed to fetch domain event for vm target ticket", zap.Error(err), zap.String("ticket_id", t.ID))
+		} else if info, ok := vmTargetInfoFromEvent(ev); ok {
+			applyVMTargetInfo(&item, t, info)
+		}
+	case approvalticket.OperationTypeCREATE:
+		s.applyTicketCatalog(ctx, &item, t)
+	}
+
+	comments, err := s.listTicketComments(ctx, t.ID)
+	if err != nil {
+		logger.Error("failed to list ticket comments", zap.Error(err), zap.String("ticket_id", t.ID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	item.Comments = comments
+
+	c.JSON(http.StatusOK, item)
+}
+
+// applyVMTargetInfo copies the target VM of a DELETE, RESIZE or SNAPSHOT
+// ticket onto its API representation.
+func applyVMTargetInfo(item *generated.ApprovalTicket, t *ent.ApprovalTicket, info vmTargetInfo) {
+	item.TargetVmId = info.VMID
+	item.TargetVmName = info.VMName
+	if info.Resize != nil {
+		// Show the approver's size once one was picked.
+		item.Resize = generated.VMResizeSummary{
+			From: vmSizeToAPI(info.Resize.From),
+			To:   vmSizeToAPI(info.Resize.Target(t.ModifiedSpec)),
+		}
+	}
+}
+
+// applyTicketCatalog embeds the template and instance size of a CREATE
+// ticket. Failures are logged and leave them unset, as for VM targets.
+func (s *Server) applyTicketCatalog(ctx context.Context, item *generated.ApprovalTicket, t *ent.ApprovalTicket) {
+	ev, err := s.client.DomainEvent.Get(ctx, t.EventID)
+	if err != nil {
+		logger.Warn("failed to fetch domain event for create ticket", zap.Error(err), zap.String("ticket_id", t.ID))
+		return
+	}
+	tpl, size, err := approval.ResolveTicketCatalog(ctx, s.client, t, ev.Payload)
+	if err != nil {
+		logger.Warn("failed to resolve create ticket catalog", zap.Error(err), zap.String("ticket_id", t.ID))
+		return
+	}
+	if tpl != nil {
+		item.Template = generated.ApprovalTicketTemplate{
+			Id:             tpl.ID,
+			Name:           tpl.Name,
+			DisplayName:    tpl.DisplayName,
+			Version:        tpl.Version,
+			OsFamily:       tpl.OSFamily,
+			OsVersion:      tpl.OSVersion,
+			FromSnapshot:   tpl.FromSnapshot,
+			CatalogChanged: tpl.CatalogChanged,
+		}
+	}
+	if size != nil {
+		item.InstanceSize = generated.ApprovalTicketInstanceSize{
+			Id:             size.ID,
+			Name:           size.Name,
+			DisplayName:    size.DisplayName,
+			CpuCores:       size.CPUCores,
+			MemoryMb:       size.MemoryMB,
+			DiskGb:         size.DiskGB,
+			FromSnapshot:   size.FromSnapshot,
+			CatalogChanged: size.CatalogChanged,
+		}
+	}
+}
+
+// vmTargetInfoFromEvent projects the target VM of a DELETE, RESIZE or SNAPSHOT event.
+func vmTargetInfoFromEvent(ev *ent.DomainEvent) (vmTargetInfo, bool) {
+	if ev.EventType == string(domain.EventVMResizeRequested) {
+		var payload domain.VMResizePayload
+		if err := json.Unmarshal(ev.Payload, &payload); err != nil {
+			return vmTargetInfo{}, false
+		}
+		return vmTargetInfo{
+			VMID:   payload.VMID,
+			VMName: payload.VMName,
+			Resize: &payload,
+		}, true
+	}
+
+	var payload struct {
+		VMID   string `json:"vm_id"`
+		VMName string `json:"vm_name"`
+	}
+	if err := json.Unmarshal(ev.Payload, &payload); err != nil {
+		return vmTargetInfo{}, false
+	}
+	return vmTargetInfo{VMID: payload.VMID, VMName: payload.VMName}, true
+}
+
+// approvalListFilters translates ListApprovals query parameters into ticket
+// predicates, rejecting unknown enum values. actor backs assigned_to_me.
+func approvalListFilters(params generated.ListApprovalsParams, actor string) ([]predicate.ApprovalTicket, error) {
+	var predicates []predicate.ApprovalTicket
+
+	if len(params.Status) > 0 {
+		statuses := make([]approvalticket.Status, 0, len(params.Status))
+		for _, raw := range params.Status {
+			status := approvalticket.Status(raw)
+			if err := approvalticket.StatusValidator(status); err != nil {
+				return nil, fmt.Errorf("invalid status %q", raw)
+			}
+			statuses = append(statuses, status)
+		}
+		predicates = append(predicates, approvalticket.StatusIn(statuses...))
+	}
+	if params.OperationType != "" {
+		opType := approvalticket.OperationType(params.OperationType)
+		if err := approvalticket.OperationTypeValidator(opType); err != nil {
+			return nil, fmt.Errorf("invalid operation_type %q", params.OperationType)
+		}
+		predicates = append(predicates, approvalticket.OperationTypeEQ(opType))
+	}
+	if requester := strings.TrimSpace(params.Requester); requester != "" {
+		predicates = append(predicates, approvalticket.RequesterEQ(requester))
+	}
+	if approver := strings.TrimSpace(params.Approver); approver != "" {
+		predicates = append(predicates, approvalDeciderPredicate(approver))
+	}
+	if serviceID := strings.TrimSpace(params.ServiceId); serviceID != "" {
+		predicates = append(predicates, approvalServicePredicate(serviceID))
+	}
+	if search := strings.TrimSpace(params.Search); search != "" {
+		if utf8.RuneCountInString(search) > maxApprovalSearchLength {
+			return nil, fmt.Errorf("search must be at most %d characters", maxApprovalSearchLength)
+		}
+		predicates = append(predicates, approvalticket.Or(
+			approvalticket.ReasonContainsFold(search),
+			approvalticket.RejectReasonContainsFold(search),
+		))
+	}
+	if !params.CreatedAfter.IsZero() && !params.CreatedBefore.IsZero() && !params.CreatedAfter.Before(params.CreatedBefore) {
+		return nil, fmt.Errorf("created_after must be before created_before")
+	}
+	if !params.CreatedAfter.IsZero() {
+		predicates = append(predicates, approvalticket.CreatedAtGTE(params.CreatedAfter))
+	}
+	if !params.CreatedBefore.IsZero() {
+		predicates = append(predicates, approvalticket.CreatedAtLT(params.CreatedBefore))
+	}
+	if params.ParentOnly {
+		// Batch children are the only tickets carrying a parent reference.
+		predicates = append(predicates, approvalticket.Or(
+			approvalticket.ParentTicketIDIsNil(),
+			approvalticket.ParentTicketIDEQ(""),
+		))
+	}
+	if params.AssignedToMe {
+		predicates = append(predicates, approvalticket.AssignedApproverEQ(actor))
+	}
+	return predicates, nil
+}
+
+// approvalDeciderPredicate matches tickets the user decided, including
+// approvals recorded toward a multi-level quorum that is not yet complete.
+func approvalDeciderPredicate(approver string) predicate.ApprovalTicket {
+	return approvalticket.Or(
+		approvalticket.ApproverEQ(approver),
+		func(s *sql.Selector) {
+			decisions := sql.Select(approvaldecision.FieldTicketID).
+				From(sql.Table(approvaldecision.Table)).
+				Where(sql.EQ(approvaldecision.FieldApprover, approver))
+			s.Where(sql.In(s.C(approvalticket.FieldID), decisions))
+		},
+	)
+}
+
+// approvalServicePredicate matches tickets whose event requests a VM for the
+// service (CREATE payloads carry service_id) or targets one of its VMs.
+func approvalServicePredicate(serviceID string) predicate.ApprovalTicket {
+	return func(s *sql.Selector) {
+		vms := sql.Select(entvm.FieldID).
+			From(sql.Table(entvm.Table)).
+			Where(sql.EQ(entvm.ServiceColumn, serviceID))
+		events := sql.Select(domainevent.FieldID).
+			From(sql.Table(domainevent.Table)).
+			Where(sql.Or(
+				sql.P(func(b *sql.Builder) {
+					b.WriteString("convert_from(").Ident(domainevent.FieldPayload).WriteString(", 'UTF8')::jsonb ->> 'service_id' = ")
+					b.Arg(serviceID)
+				}),
+				sql.And(
+					sql.EQ(domainevent.FieldAggregateType, "vm"),
+					sql.In(domainevent.FieldAggregateID, vms),
+				),
+			))
+		s.Where(sql.In(s.C(approvalticket.FieldEventID), events))
+	}
+}
+
+// approvalCursor is the keyset position after the last ticket of a page,
+// handed out base64-encoded as next_cursor.
+type approvalCursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        string    `json:"id"`
+}
+
+func encodeApprovalCursor(t *ent.ApprovalTicket) string {
+	raw, _ := json.Marshal(approvalCursor{CreatedAt: t.CreatedAt, ID: t.ID})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeApprovalCursor(token string) (approvalCursor, error) {
+	var cursor approvalCursor
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return cursor, fmt.Errorf("invalid cursor")
+	}
+	if err := json.Unmarshal(raw, &cursor); err != nil || cursor.ID == "" || cursor.CreatedAt.IsZero() {
+		return cursor, fmt.Errorf("invalid cursor")
+	}
+	return cursor, nil
+}
+
+// after matches tickets ordered after the cursor under the created_at order
+// ListApprovals applies, with ID ascending as the tie-breaker.
+func (c approvalCursor) after(desc bool) predicate.ApprovalTicket {
+	beyond := approvalticket.CreatedAtGT(c.CreatedAt)
+	if desc {
+		beyond = approvalticket.CreatedAtLT(c.CreatedAt)
+	}
+	return approvalticket.Or(
+		beyond,
+		approvalticket.And(approvalticket.CreatedAtEQ(c.CreatedAt), approvalticket.IDGT(c.ID)),
+	)
+}
+
 // ApproveTicket handles POST /approvals/{ticket_id}/approve.
 func (s *Server) ApproveTicket(c *gin.Context, ticketId generated.TicketID) {
 	ctx := c.Request.Context()
@@ -131,11 +458,45 @@ func (s *Server) ApproveTicket(c *gin.Context, ticketId generated.TicketID) {
 		return
 	}
 
-	if err := s.gateway.Approve(ctx, ticketId, actor, req.SelectedClusterId, req.SelectedStorageClass); err != nil {
+	if len(req.Comment) > maxApprovalCommentLength {
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:    "INVALID_REQUEST",
+			Message: fmt.Sprintf("comment must be at most %d characters", maxApprovalCommentLength),
+		})
+		return
+	}
+
+	var childSelections map[string]approval.ChildSelection
+	if len(req.Children) > 0 {
+		childSelections = make(map[string]approval.ChildSelection, len(req.Children))
+		for childID, sel := range req.Children {
+			childSelections[childID] = approval.ChildSelection{
+				ClusterID:    sel.ClusterId,
+				StorageClass: sel.StorageClass,
+			}
+		}
+	}
+
+	resize := approval.ResizeSelection{
+		InstanceSizeID: req.Resize.InstanceSizeId,
+		CPU:            req.Resize.Cpu,
+		MemoryMB:       req.Resize.MemoryMb,
+		DiskGB:         req.Resize.DiskGb,
+	}
+	var err error
+	if resize.IsZero() {
+		err = s.gateway.ApproveWithChildSelections(
+			ctx, ticketId, actor, req.SelectedClusterId, req.SelectedStorageClass, req.Comment, childSelections,
+		)
+	} else {
+		err = s.gateway.ApproveResize(ctx, ticketId, actor, req.Comment, resize)
+	}
+	if err != nil {
 		if appErr, ok := apperrors.IsAppError(err); ok {
 			c.JSON(appErr.HTTPStatus, generated.Error{
 				Code:    appErr.Code,
 				Message: appErr.Message,
+				Params:  appErr.Params,
 			})
 			return
 		}
@@ -151,6 +512,71 @@ func (s *Server) ApproveTicket(c *gin.Context, ticketId generated.TicketID) {
 	c.Status(http.StatusNoContent)
 }
 
+// ApproveBatch handles PATCH /approvals/batch/{batch_id}/approve.
+// It approves the batch parent and dispatches its pending children, or only
+// selected_items, then returns the resulting batch status.
+func (s *Server) ApproveBatch(c *gin.Context, batchId generated.BatchID) {
+	ctx := c.Request.Context()
+	if !requireGlobalPermission(c, "approval:approve") {
+		return
+	}
+	actor := middleware.GetUserID(ctx)
+	if actor == "" {
+		c.JSON(http.StatusUnauthorized, generated.Error{Code: "UNAUTHORIZED"})
+		return
+	}
+
+	var req generated.BatchApproveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+	if len(req.Comment) > maxApprovalCommentLength {
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:    "INVALID_REQUEST",
+			Message: fmt.Sprintf("comment must be at most %d characters", maxApprovalCommentLength),
+		})
+		return
+	}
+	if len(req.SelectedItems) > maxBatchItems {
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:    "INVALID_REQUEST",
+			Message: fmt.Sprintf("selected_items must contain at most %d entries", maxBatchItems),
+		})
+		return
+	}
+
+	batchID := string(batchId)
+	err := s.gateway.ApproveBatch(
+		ctx, batchID, actor, req.SelectedClusterId, req.SelectedStorageClass, req.Comment, req.SelectedItems,
+	)
+	if err != nil {
+		if appErr, ok := apperrors.IsAppError(err); ok {
+			c.JSON(appErr.HTTPStatus, generated.Error{
+				Code:    appErr.Code,
+				Message: appErr.Message,
+				Params:  appErr.Params,
+			})
+			return
+		}
+		logger.Error("batch approval failed",
+			zap.Error(err),
+			zap.String("batch_id", batchID),
+			zap.String("actor", actor),
+		)
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "APPROVAL_FAILED"})
+		return
+	}
+
+	resp, _, err := s.loadBatchView(ctx, batchID)
+	if err != nil {
+		logger.Error("failed to load batch view after approval", zap.Error(err), zap.String("batch_id", batchID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
 // RejectTicket handles POST /approvals/{ticket_id}/reject.
 func (s *Server) RejectTicket(c *gin.Context, ticketId generated.TicketID) {
 	ctx := c.Request.Context()
@@ -189,10 +615,49 @@ func (s *Server) RejectTicket(c *gin.Context, ticketId generated.TicketID) {
 	c.Status(http.StatusNoContent)
 }
 
+// ReassignTicket handles POST /approvals/{ticket_id}/reassign.
+func (s *Server) ReassignTicket(c *gin.Context, ticketId generated.TicketID) {
+	ctx := c.Request.Context()
+	if !requireGlobalPermission(c, "approval:approve") {
+		return
+	}
+	actor := middleware.GetUserID(ctx)
+	if actor == "" {
+		c.JSON(http.StatusUnauthorized, generated.Error{Code: "UNAUTHORIZED"})
+		return
+	}
+
+	var req generated.ReassignTicketRequest
+	if err := c.ShouldBindJSON(&req); err != nil || strings.TrimSpace(req.AssigneeId) == "" {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "assignee_id is required"})
+		return
+	}
+
+	if err := s.gateway.Reassign(ctx, ticketId, actor, req.AssigneeId); err != nil {
+		if appErr, ok := apperrors.IsAppError(err); ok {
+			c.JSON(appErr.HTTPStatus, generated.Error{
+				Code:    appErr.Code,
+				Message: appErr.Message,
+				Params:  appErr.Params,
+			})
+			return
+		}
+		logger.Error("ticket reassignment failed",
+			zap.Error(err),
+			zap.String("ticket_id", ticketId),
+			zap.String("actor", actor),
+		)
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
 // CancelTicket handles POST /approvals/{ticket_id}/cancel.
 func (s *Server) CancelTicket(c *gin.Context, ticketId generated.TicketID) {
 	ctx := c.Request.Context()
-	if !requireAnyGlobalPermission(c, "approval:approve", "vm:create", "vm:delete", "vnc:access") {
+	if !requireAnyGlobalPermission(c, "approval:approve", "vm:create", "vm:delete", "vm:operate", "vnc:access") {
 		return
 	}
 	actor := middleware.GetUserID(ctx)
@@ -224,15 +689,23 @@ func (s *Server) CancelTicket(c *gin.Context, ticketId generated.TicketID) {
 // ---- Converter ----
 
 func ticketToAPI(t *ent.ApprovalTicket) generated.ApprovalTicket {
-	return generated.ApprovalTicket{
-		Id:            t.ID,
-		EventId:       t.EventID,
-		OperationType: generated.ApprovalTicketOperationType(t.OperationType),
-		Requester:     t.Requester,
-		Status:        generated.ApprovalTicketStatus(t.Status),
-		Approver:      t.Approver,
-		Reason:        t.Reason,
-		RejectReason:  t.RejectReason,
-		CreatedAt:     t.CreatedAt,
+	item := generated.ApprovalTicket{
+		Id:                t.ID,
+		EventId:           t.EventID,
+		OperationType:     generated.ApprovalTicketOperationType(t.OperationType),
+		Requester:         t.Requester,
+		Status:            generated.ApprovalTicketStatus(t.Status),
+		Approver:          t.Approver,
+		Reason:            t.Reason,
+		RejectReason:      t.RejectReason,
+		ApprovalComment:   t.ApprovalComment,
+		AssignedApprover:  t.AssignedApprover,
+		ApprovalsRequired: t.RequiredApprovals,
+		ExpiresAt:         t.ExpiresAt,
+		CreatedAt:         t.CreatedAt,
+	}
+	if t.OperationType == approvalticket.OperationTypeCREATE {
+		item.ModifiedSpec = t.ModifiedSpec
 	}
+	return item
 }
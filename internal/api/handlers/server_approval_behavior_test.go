@@ -0,0 +1,258 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"kv-shepherd.io/shepherd/ent/approvaldecision"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestListApprovals_Filters(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	client := testutil.OpenEntPostgres(t, "approval_list_filters")
+	srv := NewServer(ServerDeps{EntClient: client})
+
+	now := time.Now().UTC()
+	seed := func(id, requester, parentID string, status approvalticket.Status, op approvalticket.OperationType, age time.Duration) {
+		t.Helper()
+		create := client.ApprovalTicket.Create().
+			SetID(id).
+			SetEventID("event-" + id).
+			SetRequester(requester).
+			SetStatus(status).
+			SetOperationType(op).
+			SetCreatedAt(now.Add(-age))
+		if parentID != "" {
+			create = create.SetParentTicketID(parentID)
+		}
+		if _, err := create.Save(t.Context()); err != nil {
+			t.Fatalf("seed ticket %s: %v", id, err)
+		}
+	}
+
+	seed("standalone-new", "alice", "", approvalticket.StatusPENDING, approvalticket.OperationTypeCREATE, time.Hour)
+	seed("standalone-urgent", "bob", "", approvalticket.StatusPENDING, approvalticket.OperationTypeDELETE, 8*24*time.Hour)
+	seed("standalone-warning", "alice", "", approvalticket.StatusPENDING, approvalticket.OperationTypeCREATE, 5*24*time.Hour)
+	seed("standalone-done", "alice", "", approvalticket.StatusSUCCESS, approvalticket.OperationTypeCREATE, 30*24*time.Hour)
+	seed("batch-parent", "carol", "", approvalticket.StatusPENDING, approvalticket.OperationTypeCREATE, 2*time.Hour)
+	seed("batch-child-1", "carol", "batch-parent", approvalticket.StatusPENDING, approvalticket.OperationTypeCREATE, 2*time.Hour)
+	seed("batch-child-2", "carol", "batch-parent", approvalticket.StatusREJECTED, approvalticket.OperationTypeCREATE, 2*time.Hour)
+
+	list := func(t *testing.T, params generated.ListApprovalsParams) generated.ApprovalTicketList {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodGet, "/approvals", "", "admin-1", []string{"approval:view"})
+		srv.ListApprovals(c, params)
+		if w.Code != http.StatusOK {
+			t.Fatalf("list status = %d, want %d body=%s", w.Code, http.StatusOK, w.Body.String())
+		}
+		var resp generated.ApprovalTicketList
+		mustDecodeJSON(t, w.Body.Bytes(), &resp)
+		return resp
+	}
+	ids := func(resp generated.ApprovalTicketList) []string {
+		out := make([]string, 0, len(resp.Items))
+		for _, item := range resp.Items {
+			out = append(out, item.Id)
+		}
+		return out
+	}
+
+	testCases := []struct {
+		name      string
+		params    generated.ListApprovalsParams
+		wantIDs   []string
+		wantTotal int
+	}{
+		{
+			name:      "parent_only hides batch children",
+			params:    generated.ListApprovalsParams{ParentOnly: true},
+			wantIDs:   []string{"standalone-done", "standalone-urgent", "standalone-warning", "batch-parent", "standalone-new"},
+			wantTotal: 5,
+		},
+		{
+			name:      "multi-value status with parent_only",
+			params:    generated.ListApprovalsParams{Status: []generated.ListApprovalsParamsStatus{"SUCCESS", "REJECTED"}, ParentOnly: true},
+			wantIDs:   []string{"standalone-done"},
+			wantTotal: 1,
+		},
+		{
+			name:      "multi-value status includes children without parent_only",
+			params:    generated.ListApprovalsParams{Status: []generated.ListApprovalsParamsStatus{"SUCCESS", "REJECTED"}},
+			wantIDs:   []string{"standalone-done", "batch-child-2"},
+			wantTotal: 2,
+		},
+		{
+			name:      "operation type and requester",
+			params:    generated.ListApprovalsParams{OperationType: "CREATE", Requester: "alice", SortOrder: generated.ListApprovalsParamsSortOrderDesc},
+			wantIDs:   []string{"standalone-new", "standalone-warning", "standalone-done"},
+			wantTotal: 3,
+		},
+		{
+			name:      "created range",
+			params:    generated.ListApprovalsParams{CreatedAfter: now.Add(-6 * 24 * time.Hour), CreatedBefore: now.Add(-90 * time.Minute), ParentOnly: true},
+			wantIDs:   []string{"standalone-warning", "batch-parent"},
+			wantTotal: 2,
+		},
+		{
+			name:      "priority lists pending by urgency first",
+			params:    generated.ListApprovalsParams{SortBy: generated.ListApprovalsParamsSortByPriority, ParentOnly: true},
+			wantIDs:   []string{"standalone-urgent", "standalone-warning", "batch-parent", "standalone-new", "standalone-done"},
+			wantTotal: 5,
+		},
+		{
+			name:      "pagination total reflects filters",
+			params:    generated.ListApprovalsParams{ParentOnly: true, Page: 2, PerPage: 2},
+			wantIDs:   []string{"standalone-warning", "batch-parent"},
+			wantTotal: 5,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := list(t, tc.params)
+			got := ids(resp)
+			if len(got) != len(tc.wantIDs) {
+				t.Fatalf("ids = %v, want %v", got, tc.wantIDs)
+			}
+			for i := range got {
+				if got[i] != tc.wantIDs[i] {
+					t.Fatalf("ids = %v, want %v", got, tc.wantIDs)
+				}
+			}
+			if resp.Pagination.Total != tc.wantTotal {
+				t.Fatalf("total = %d, want %d", resp.Pagination.Total, tc.wantTotal)
+			}
+		})
+	}
+
+	c, w := newAuthedGinContext(t, http.MethodGet, "/approvals?status=BOGUS", "", "admin-1", []string{"approval:view"})
+	srv.ListApprovals(c, generated.ListApprovalsParams{Status: []generated.ListApprovalsParamsStatus{"BOGUS"}})
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("invalid status code = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestListApprovals_SearchAndCursor(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	client := testutil.OpenEntPostgres(t, "approval_list_search")
+	srv := NewServer(ServerDeps{EntClient: client})
+
+	mustCreateSystem(t, client, "sys-1", "shop", "owner-1")
+	mustCreateService(t, client, "svc-web", "web", "sys-1", "")
+	mustCreateService(t, client, "svc-db", "db", "sys-1", "")
+	mustCreateVMForService(t, client, "vm-web-01", "web-01", "svc-web")
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	seed := func(id, reason, approver, aggregateType, aggregateID, payload string, age time.Duration) {
+		t.Helper()
+		client.DomainEvent.Create().
+			SetID("event-" + id).
+			SetEventType("TEST").
+			SetAggregateType(aggregateType).
+			SetAggregateID(aggregateID).
+			SetPayload([]byte(payload)).
+			SetStatus(domainevent.StatusPENDING).
+			SetCreatedBy("alice").
+			SaveX(t.Context())
+		create := client.ApprovalTicket.Create().
+			SetID(id).
+			SetEventID("event-" + id).
+			SetRequester("alice").
+			SetReason(reason).
+			SetStatus(approvalticket.StatusPENDING).
+			SetOperationType(approvalticket.OperationTypeCREATE).
+			SetCreatedAt(now.Add(-age))
+		if approver != "" {
+			create = create.SetApprover(approver).SetStatus(approvalticket.StatusAPPROVED)
+		}
+		create.SaveX(t.Context())
+	}
+	seed("t-create-web", "Web tier for Black Friday", "", "vm", "pending", `{"service_id":"svc-web"}`, 4*time.Hour)
+	seed("t-delete-web", "decommission", "bob", "vm", "vm-web-01", `{}`, 3*time.Hour)
+	seed("t-create-db", "database replica", "", "vm", "pending", `{"service_id":"svc-db"}`, 2*time.Hour)
+	seed("t-quorum", "friday capacity", "", "vm", "pending", `{"service_id":"svc-db"}`, time.Hour)
+	// bob approved t-quorum's first level; the ticket is still pending.
+	client.ApprovalDecision.Create().
+		SetID("decision-1").
+		SetTicketID("t-quorum").
+		SetApprover("bob").
+		SetDecision(approvaldecision.DecisionAPPROVED).
+		SaveX(t.Context())
+
+	list := func(t *testing.T, params generated.ListApprovalsParams) (int, generated.ApprovalTicketList, []string) {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodGet, "/approvals", "", "admin-1", []string{"approval:view"})
+		srv.ListApprovals(c, params)
+		var resp generated.ApprovalTicketList
+		if w.Code != http.StatusOK {
+			return w.Code, resp, nil
+		}
+		mustDecodeJSON(t, w.Body.Bytes(), &resp)
+		ids := make([]string, 0, len(resp.Items))
+		for _, item := range resp.Items {
+			ids = append(ids, item.Id)
+		}
+		return w.Code, resp, ids
+	}
+
+	testCases := []struct {
+		name    string
+		params  generated.ListApprovalsParams
+		wantIDs []string
+	}{
+		{name: "approver includes partial decisions", params: generated.ListApprovalsParams{Approver: "bob"}, wantIDs: []string{"t-delete-web", "t-quorum"}},
+		{name: "service by payload and vm target", params: generated.ListApprovalsParams{ServiceId: "svc-web"}, wantIDs: []string{"t-create-web", "t-delete-web"}},
+		{name: "search is case-insensitive", params: generated.ListApprovalsParams{Search: "FRIDAY"}, wantIDs: []string{"t-create-web", "t-quorum"}},
+		{name: "filters combine", params: generated.ListApprovalsParams{ServiceId: "svc-db", Search: "replica"}, wantIDs: []string{"t-create-db"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			code, _, got := list(t, tc.params)
+			if code != http.StatusOK || strings.Join(got, ",") != strings.Join(tc.wantIDs, ",") {
+				t.Fatalf("status = %d ids = %v, want %v", code, got, tc.wantIDs)
+			}
+		})
+	}
+
+	// Walking the cursor visits every ticket exactly once, newest first.
+	var walked []string
+	params := generated.ListApprovalsParams{PerPage: 3, SortOrder: generated.ListApprovalsParamsSortOrderDesc}
+	for i := 0; ; i++ {
+		if i > 3 {
+			t.Fatalf("cursor walk did not terminate: %v", walked)
+		}
+		_, resp, got := list(t, params)
+		if resp.Pagination.Total != 4 {
+			t.Fatalf("total = %d, want 4", resp.Pagination.Total)
+		}
+		walked = append(walked, got...)
+		if resp.NextCursor == "" {
+			break
+		}
+		params.Cursor = resp.NextCursor
+	}
+	if want := "t-quorum,t-create-db,t-delete-web,t-create-web"; strings.Join(walked, ",") != want {
+		t.Fatalf("cursor walk = %v, want %s", walked, want)
+	}
+
+	for name, params := range map[string]generated.ListApprovalsParams{
+		"garbage cursor":     {Cursor: "not-a-cursor"},
+		"cursor by priority": {Cursor: params.Cursor, SortBy: generated.ListApprovalsParamsSortByPriority},
+		"search too long":    {Search: strings.Repeat("x", 201)},
+	} {
+		if code, _, _ := list(t, params); code != http.StatusBadRequest {
+			t.Fatalf("%s: status = %d, want 400", name, code)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestGetApproval_CatalogPresentation(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "approval_catalog")
+	ctx := t.Context()
+
+	spec := map[string]interface{}{"image": "quay.io/kubevirt/fedora:40"}
+	client.Template.Create().
+		SetID("tpl-fedora").
+		SetName("fedora").
+		SetDisplayName("Fedora 40").
+		SetVersion(3).
+		SetOsFamily("linux").
+		SetOsVersion("40").
+		SetEnabled(true).
+		SetCreatedBy("admin-1").
+		SetSpec(spec).
+		ExecX(ctx)
+	for _, size := range []struct {
+		id          string
+		cpu, memory int
+	}{{"size-small", 2, 4096}, {"size-large", 8, 16384}} {
+		client.InstanceSize.Create().
+			SetID(size.id).
+			SetName(size.id).
+			SetDisplayName("Size " + size.id).
+			SetCPUCores(size.cpu).
+			SetMemoryMB(size.memory).
+			SetDiskGB(40).
+			SetCreatedBy("admin-1").
+			ExecX(ctx)
+	}
+
+	mustCreateCatalogTicket := func(id string, status approvalticket.Status, modified map[string]interface{}, snapshots bool) {
+		t.Helper()
+		payload, err := domain.VMCreationPayload{
+			RequesterID:    "alice",
+			ServiceID:      "svc-1",
+			TemplateID:     "tpl-fedora",
+			InstanceSizeID: "size-small",
+			Namespace:      "dev",
+		}.ToJSON()
+		if err != nil {
+			t.Fatalf("marshal payload: %v", err)
+		}
+		client.DomainEvent.Create().
+			SetID("event-" + id).
+			SetEventType(string(domain.EventVMCreationRequested)).
+			SetAggregateType("vm").
+			SetAggregateID("svc-1").
+			SetPayload(payload).
+			SetCreatedBy("alice").
+			ExecX(ctx)
+		create := client.ApprovalTicket.Create().
+			SetID(id).
+			SetEventID("event-" + id).
+			SetRequester("alice").
+			SetOperationType(approvalticket.OperationTypeCREATE).
+			SetStatus(status).
+			SetModifiedSpec(modified)
+		if snapshots {
+			create.SetTemplateSnapshot(map[string]interface{}{
+				"id":           "tpl-fedora",
+				"name":         "fedora",
+				"display_name": "Fedora 40",
+				"version":      3,
+				"os_family":    "linux",
+				"os_version":   "40",
+				"enabled":      true,
+				"created_by":   "admin-1",
+				"spec":         spec,
+			}).SetInstanceSizeSnapshot(map[string]interface{}{
+				"id":           "size-small",
+				"name":         "size-small",
+				"display_name": "Size size-small",
+				"cpu_cores":    2,
+				"memory_mb":    4096,
+				"disk_gb":      40,
+			})
+		}
+		create.ExecX(ctx)
+	}
+	get := func(id string) generated.ApprovalTicket {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodGet, "/approvals/"+id, "", "approver-1", []string{"approval:view"})
+		srv := NewServer(ServerDeps{EntClient: client})
+		srv.GetApproval(c, id)
+		if w.Code != http.StatusOK {
+			t.Fatalf("GET /approvals/%s status = %d body=%s", id, w.Code, w.Body.String())
+		}
+		var item generated.ApprovalTicket
+		mustDecodeJSON(t, w.Body.Bytes(), &item)
+		return item
+	}
+
+	// Without snapshots the live catalog is used, with the approver's
+	// modified_spec selection and overrides applied.
+	mustCreateCatalogTicket("ticket-live", approvalticket.StatusPENDING, map[string]interface{}{
+		"instance_size_id": "size-large",
+		"memory_mb":        32768,
+	}, false)
+	live := get("ticket-live")
+	wantTemplate := generated.ApprovalTicketTemplate{
+		Id: "tpl-fedora", Name: "fedora", DisplayName: "Fedora 40", Version: 3, OsFamily: "linux", OsVersion: "40",
+	}
+	if live.Template != wantTemplate {
+		t.Fatalf("live template = %+v, want %+v", live.Template, wantTemplate)
+	}
+	wantSize := generated.ApprovalTicketInstanceSize{
+		Id: "size-large", Name: "size-large", DisplayName: "Size size-large", CpuCores: 8, MemoryMb: 32768, DiskGb: 40,
+	}
+	if live.InstanceSize != wantSize {
+		t.Fatalf("live instance size = %+v, want %+v", live.InstanceSize, wantSize)
+	}
+
+	// Snapshots win and match the live catalog.
+	mustCreateCatalogTicket("ticket-snapshot", approvalticket.StatusAPPROVED, map[string]interface{}{"cpu": 4}, true)
+	snap := get("ticket-snapshot")
+	wantTemplate.FromSnapshot = true
+	if snap.Template != wantTemplate {
+		t.Fatalf("snapshot template = %+v, want %+v", snap.Template, wantTemplate)
+	}
+	wantSize = generated.ApprovalTicketInstanceSize{
+		Id: "size-small", Name: "size-small", DisplayName: "Size size-small", CpuCores: 4, MemoryMb: 4096, DiskGb: 40, FromSnapshot: true,
+	}
+	if snap.InstanceSize != wantSize {
+		t.Fatalf("snapshot instance size = %+v, want %+v", snap.InstanceSize, wantSize)
+	}
+
+	// Editing the template and deleting the size after approval is drift;
+	// the snapshot values are still presented.
+	client.Template.UpdateOneID("tpl-fedora").
+		SetSpec(map[string]interface{}{"image": "quay.io/kubevirt/fedora:41"}).
+		ExecX(ctx)
+	client.InstanceSize.DeleteOneID("size-small").ExecX(ctx)
+	drift := get("ticket-snapshot")
+	wantTemplate.CatalogChanged = true
+	if drift.Template != wantTemplate {
+		t.Fatalf("drifted template = %+v, want %+v", drift.Template, wantTemplate)
+	}
+	wantSize.CatalogChanged = true
+	if drift.InstanceSize != wantSize {
+		t.Fatalf("drifted instance size = %+v, want %+v", drift.InstanceSize, wantSize)
+	}
+
+	// Without a snapshot a deleted catalog entry is simply omitted.
+	mustCreateCatalogTicket("ticket-gone", approvalticket.StatusPENDING, nil, false)
+	if gone := get("ticket-gone"); gone.InstanceSize != (generated.ApprovalTicketInstanceSize{}) || gone.Template.Id != "tpl-fedora" {
+		t.Fatalf("ticket without snapshot after delete = %+v / %+v", gone.Template, gone.InstanceSize)
+	}
+}
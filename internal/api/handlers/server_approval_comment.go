@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/ticketcomment"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/api/middleware"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// maxTicketCommentLength mirrors the ticket_comments.body column limit.
+const maxTicketCommentLength = 2000
+
+// ListTicketComments handles GET /approvals/{ticket_id}/comments.
+func (s *Server) ListTicketComments(c *gin.Context, ticketId generated.TicketID) {
+	ctx, _, t, ok := s.loadTicketForComments(c, ticketId, "approval:view")
+	if !ok {
+		return
+	}
+
+	items, err := s.listTicketComments(ctx, t.ID)
+	if err != nil {
+		logger.Error("failed to list ticket comments", zap.Error(err), zap.String("ticket_id", t.ID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	c.JSON(http.StatusOK, generated.TicketCommentList{Items: items})
+}
+
+// CreateTicketComment handles POST /approvals/{ticket_id}/comments.
+func (s *Server) CreateTicketComment(c *gin.Context, ticketId generated.TicketID) {
+	ctx, actor, t, ok := s.loadTicketForComments(c, ticketId, "approval:approve")
+	if !ok {
+		return
+	}
+
+	var req generated.TicketCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil || strings.TrimSpace(req.Body) == "" {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "body is required"})
+		return
+	}
+	body := strings.TrimSpace(req.Body)
+	if utf8.RuneCountInString(body) > maxTicketCommentLength {
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:    "INVALID_REQUEST",
+			Message: fmt.Sprintf("body must be at most %d characters", maxTicketCommentLength),
+		})
+		return
+	}
+
+	id, _ := uuid.NewV7()
+	comment, err := s.client.TicketComment.Create().
+		SetID(id.String()).
+		SetTicketID(t.ID).
+		SetAuthor(actor).
+		SetBody(body).
+		Save(ctx)
+	if err != nil {
+		logger.Error("failed to create ticket comment", zap.Error(err), zap.String("ticket_id", t.ID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "approval.comment.create", "approval_ticket", t.ID, actor, map[string]interface{}{
+			"comment_id": comment.ID,
+		})
+	}
+	if s.notifier != nil {
+		s.notifier.OnTicketCommented(ctx, t.ID, t.Requester, actor, body)
+	}
+
+	c.JSON(http.StatusCreated, ticketCommentToAPI(comment))
+}
+
+// DeleteTicketComment handles DELETE /approvals/{ticket_id}/comments/{comment_id}.
+// Authors can delete their own comments; platform:admin can delete any.
+func (s *Server) DeleteTicketComment(c *gin.Context, ticketId generated.TicketID, commentId generated.CommentID) {
+	ctx := c.Request.Context()
+	actor := middleware.GetUserID(ctx)
+	if strings.TrimSpace(actor) == "" {
+		c.JSON(http.StatusUnauthorized, generated.Error{Code: "UNAUTHORIZED"})
+		return
+	}
+
+	comment, err := s.client.TicketComment.Query().
+		Where(ticketcomment.IDEQ(commentId), ticketcomment.TicketIDEQ(ticketId)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "COMMENT_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to get ticket comment", zap.Error(err), zap.String("comment_id", commentId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if comment.Author != actor && !hasAnyGlobalPermission(c, "platform:admin") {
+		c.JSON(http.StatusForbidden, generated.Error{Code: "FORBIDDEN", Message: "only the author or a platform admin can delete this comment"})
+		return
+	}
+
+	if err := s.client.TicketComment.DeleteOneID(comment.ID).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "COMMENT_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to delete ticket comment", zap.Error(err), zap.String("comment_id", comment.ID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "approval.comment.delete", "approval_ticket", comment.TicketID, actor, map[string]interface{}{
+			"comment_id": comment.ID,
+			"author":     comment.Author,
+		})
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// loadTicketForComments resolves the ticket and checks the caller is its
+// requester or holds permission.
+func (s *Server) loadTicketForComments(c *gin.Context, ticketID, permission string) (context.Context, string, *ent.ApprovalTicket, bool) {
+	ctx := c.Request.Context()
+	actor := middleware.GetUserID(ctx)
+	if strings.TrimSpace(actor) == "" {
+		c.JSON(http.StatusUnauthorized, generated.Error{Code: "UNAUTHORIZED"})
+		return nil, "", nil, false
+	}
+
+	t, err := s.client.ApprovalTicket.Get(ctx, ticketID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "TICKET_NOT_FOUND"})
+			return nil, "", nil, false
+		}
+		logger.Error("failed to get approval ticket", zap.Error(err), zap.String("ticket_id", ticketID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return nil, "", nil, false
+	}
+	if t.Requester != actor && !hasAnyGlobalPermission(c, permission) {
+		c.JSON(http.StatusForbidden, generated.Error{Code: "FORBIDDEN"})
+		return nil, "", nil, false
+	}
+	return ctx, actor, t, true
+}
+
+func (s *Server) listTicketComments(ctx context.Context, ticketID string) ([]generated.TicketComment, error) {
+	rows, err := s.client.TicketComment.Query().
+		Where(ticketcomment.TicketIDEQ(ticketID)).
+		Order(ent.Asc(ticketcomment.FieldCreatedAt), ent.Asc(ticketcomment.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]generated.TicketComment, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, ticketCommentToAPI(row))
+	}
+	return items, nil
+}
+
+func ticketCommentToAPI(row *ent.TicketComment) generated.TicketComment {
+	return generated.TicketComment{
+		Id:        row.ID,
+		TicketId:  row.TicketID,
+		Author:    row.Author,
+		Body:      row.Body,
+		CreatedAt: row.CreatedAt,
+	}
+}
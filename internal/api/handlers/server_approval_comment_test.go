@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	entnotification "kv-shepherd.io/shepherd/ent/notification"
+	entuser "kv-shepherd.io/shepherd/ent/user"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/notification"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestTicketComments_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "ticket_comments")
+	for _, u := range []string{"alice", "approver-1", "approver-2"} {
+		mustCreateUser(t, client, u, u)
+	}
+	client.ApprovalTicket.Create().
+		SetID("ticket-1").
+		SetEventID("event-1").
+		SetRequester("alice").
+		SetOperationType(approvalticket.OperationTypeCREATE).
+		SetStatus(approvalticket.StatusPENDING).
+		SaveX(t.Context())
+	srv := NewServer(ServerDeps{
+		EntClient: client,
+		Audit:     audit.NewLogger(client),
+		Notifier:  notification.NewTriggers(notification.NewInboxSender(client), client),
+	})
+	approver := []string{"approval:view", "approval:approve"}
+
+	post := func(user string, perms []string, body string) (int, []byte) {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodPost, "/approvals/ticket-1/comments", body, user, perms)
+		srv.CreateTicketComment(c, "ticket-1")
+		return w.Code, w.Body.Bytes()
+	}
+
+	code, body := post("approver-1", approver, `{"body":"which cluster?"}`)
+	if code != http.StatusCreated {
+		t.Fatalf("first comment status = %d body=%s", code, body)
+	}
+	var first generated.TicketComment
+	mustDecodeJSON(t, body, &first)
+	if code, body = post("approver-2", approver, `{"body":"prod-b has room"}`); code != http.StatusCreated {
+		t.Fatalf("second comment status = %d body=%s", code, body)
+	}
+
+	// The requester and the earlier commenter hear about the second comment;
+	// its author does not.
+	notified := func(user string) int {
+		return client.Notification.Query().
+			Where(
+				entnotification.HasUserWith(entuser.IDEQ(user)),
+				entnotification.TypeEQ(entnotification.TypeAPPROVAL_COMMENT),
+			).
+			CountX(t.Context())
+	}
+	if got := []int{notified("alice"), notified("approver-1"), notified("approver-2")}; got[0] != 2 || got[1] != 1 || got[2] != 0 {
+		t.Fatalf("comment notifications alice/approver-1/approver-2 = %v, want [2 1 0]", got)
+	}
+
+	c, w := newAuthedGinContext(t, http.MethodGet, "/approvals/ticket-1", "", "alice", []string{"vm:create"})
+	srv.GetApproval(c, "ticket-1")
+	if w.Code != http.StatusOK {
+		t.Fatalf("requester get status = %d body=%s", w.Code, w.Body.String())
+	}
+	var ticket generated.ApprovalTicket
+	mustDecodeJSON(t, w.Body.Bytes(), &ticket)
+	if len(ticket.Comments) != 2 || ticket.Comments[0].Id != first.Id || ticket.Comments[1].Author != "approver-2" {
+		t.Fatalf("inline comments = %+v", ticket.Comments)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodDelete, "/approvals/ticket-1/comments/"+first.Id, "", "approver-2", approver)
+	srv.DeleteTicketComment(c, "ticket-1", first.Id)
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("delete by non-author status = %d, want 403", w.Code)
+	}
+	c, w = newAuthedGinContext(t, http.MethodDelete, "/approvals/ticket-1/comments/"+first.Id, "", "approver-1", approver)
+	srv.DeleteTicketComment(c, "ticket-1", first.Id)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("delete by author status = %d body=%s", w.Code, w.Body.String())
+	}
+	second := ticket.Comments[1].Id
+	c, w = newAuthedGinContext(t, http.MethodDelete, "/approvals/ticket-1/comments/"+second, "", "admin-1", []string{"platform:admin"})
+	srv.DeleteTicketComment(c, "ticket-1", second)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("delete by admin status = %d body=%s", w.Code, w.Body.String())
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodGet, "/approvals/ticket-1/comments", "", "approver-1", approver)
+	srv.ListTicketComments(c, "ticket-1")
+	var list generated.TicketCommentList
+	mustDecodeJSON(t, w.Body.Bytes(), &list)
+	if w.Code != http.StatusOK || len(list.Items) != 0 {
+		t.Fatalf("list after delete status = %d items=%d", w.Code, len(list.Items))
+	}
+}
+
+func TestTicketComments_Validation(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "ticket_comments_validation")
+	client.ApprovalTicket.Create().
+		SetID("ticket-1").
+		SetEventID("event-1").
+		SetRequester("alice").
+		SetOperationType(approvalticket.OperationTypeCREATE).
+		SetStatus(approvalticket.StatusPENDING).
+		SaveX(t.Context())
+	srv := NewServer(ServerDeps{EntClient: client})
+
+	tests := []struct {
+		name       string
+		ticketID   string
+		user       string
+		perms      []string
+		body       string
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "too long", ticketID: "ticket-1", user: "approver-1", perms: []string{"approval:approve"},
+			body: mustJSON(t, generated.TicketCommentRequest{Body: strings.Repeat("x", 2001)}), wantStatus: http.StatusBadRequest, wantCode: "INVALID_REQUEST"},
+		{name: "blank", ticketID: "ticket-1", user: "approver-1", perms: []string{"approval:approve"},
+			body: `{"body":"   "}`, wantStatus: http.StatusBadRequest, wantCode: "INVALID_REQUEST"},
+		{name: "unknown ticket", ticketID: "ticket-missing", user: "approver-1", perms: []string{"approval:approve"},
+			body: `{"body":"hi"}`, wantStatus: http.StatusNotFound, wantCode: "TICKET_NOT_FOUND"},
+		{name: "bystander", ticketID: "ticket-1", user: "bob", perms: []string{"vm:create"},
+			body: `{"body":"hi"}`, wantStatus: http.StatusForbidden, wantCode: "FORBIDDEN"},
+		{name: "requester may comment", ticketID: "ticket-1", user: "alice", perms: []string{"vm:create"},
+			body: `{"body":"urgent, please"}`, wantStatus: http.StatusCreated},
+	}
+	for _, tc := range tests {
+		c, w := newAuthedGinContext(t, http.MethodPost, "/approvals/"+tc.ticketID+"/comments", tc.body, tc.user, tc.perms)
+		srv.CreateTicketComment(c, tc.ticketID)
+		if w.Code != tc.wantStatus {
+			t.Fatalf("%s: status = %d, want %d body=%s", tc.name, w.Code, tc.wantStatus, w.Body.String())
+		}
+		if tc.wantCode != "" {
+			assertErrorCode(t, w.Body.Bytes(), tc.wantCode)
+		}
+	}
+}
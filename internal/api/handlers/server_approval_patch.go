@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/service/vmspec"
+)
+
+// UpdateApprovalTicket handles PATCH /approvals/{ticket_id}.
+//
+// The modified_spec replaces the ticket's one once it passes the override
+// whitelist, the spec override policy and the instance size bounds, so the
+// create worker only sees reviewed modifications.
+func (s *Server) UpdateApprovalTicket(c *gin.Context, ticketId generated.TicketID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "approval:approve")
+	if !ok {
+		return
+	}
+
+	var req generated.ApprovalTicketPatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.ModifiedSpec == nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+
+	t, err := s.client.ApprovalTicket.Get(ctx, ticketId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "TICKET_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to get approval ticket", zap.Error(err), zap.String("ticket_id", ticketId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if t.OperationType != approvalticket.OperationTypeCREATE {
+		c.JSON(http.StatusConflict, generated.Error{
+			Code:    "MODIFIED_SPEC_NOT_SUPPORTED",
+			Message: "only CREATE tickets take a modified_spec",
+			Params:  map[string]interface{}{"operation_type": string(t.OperationType)},
+		})
+		return
+	}
+	if t.Status != approvalticket.StatusPENDING {
+		c.JSON(http.StatusConflict, generated.Error{Code: "TICKET_NOT_PENDING"})
+		return
+	}
+
+	policy, err := vmspec.LoadOverridePolicy(ctx, s.client)
+	if err != nil {
+		logger.Error("failed to load spec override policy", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	violations, err := vmspec.ValidateModifiedSpec(ctx, s.client, req.ModifiedSpec, policy, hasPlatformAdmin(c))
+	if err != nil {
+		logger.Error("failed to validate modified_spec", zap.Error(err), zap.String("ticket_id", t.ID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if len(violations) > 0 {
+		fieldErrors := make([]generated.FieldError, len(violations))
+		for i, v := range violations {
+			fieldErrors[i] = generated.FieldError{Field: v.Field, Code: v.Code, Message: v.Message}
+		}
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:        "MODIFIED_SPEC_INVALID",
+			Message:     "modified_spec contains fields that cannot be set",
+			FieldErrors: fieldErrors,
+		})
+		return
+	}
+
+	update := s.client.ApprovalTicket.Update().
+		Where(approvalticket.IDEQ(t.ID), approvalticket.StatusEQ(approvalticket.StatusPENDING))
+	if len(req.ModifiedSpec) == 0 {
+		update = update.ClearModifiedSpec()
+	} else {
+		update = update.SetModifiedSpec(req.ModifiedSpec)
+	}
+	n, err := update.Save(ctx)
+	if err != nil {
+		logger.Error("failed to update modified_spec", zap.Error(err), zap.String("ticket_id", t.ID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if n == 0 {
+		// Decided between the read and the update.
+		c.JSON(http.StatusConflict, generated.Error{Code: "TICKET_NOT_PENDING"})
+		return
+	}
+	t, err = s.client.ApprovalTicket.Get(ctx, t.ID)
+	if err != nil {
+		logger.Error("failed to reload approval ticket", zap.Error(err), zap.String("ticket_id", ticketId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "approval.modified_spec.update", "approval_ticket", t.ID, actor, map[string]interface{}{
+			"modified_spec": req.ModifiedSpec,
+		})
+	}
+
+	item := ticketToAPI(t)
+	s.applyTicketCatalog(ctx, &item, t)
+	c.JSON(http.StatusOK, item)
+}
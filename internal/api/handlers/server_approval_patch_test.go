@@ -0,0 +1,201 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/auditlog"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func patchModifiedSpec(t *testing.T, srv *Server, ticketID, body string, perms []string) (int, generated.ApprovalTicket, generated.Error) {
+	t.Helper()
+	c, w := newAuthedGinContext(t, http.MethodPatch, "/approvals/"+ticketID, body, "approver-1", perms)
+	srv.UpdateApprovalTicket(c, ticketID)
+	var item generated.ApprovalTicket
+	var apiErr generated.Error
+	if w.Code == http.StatusOK {
+		mustDecodeJSON(t, w.Body.Bytes(), &item)
+	} else {
+		mustDecodeJSON(t, w.Body.Bytes(), &apiErr)
+	}
+	return w.Code, item, apiErr
+}
+
+func fieldErrorCodes(apiErr generated.Error) map[string]string {
+	out := make(map[string]string, len(apiErr.FieldErrors))
+	for _, fe := range apiErr.FieldErrors {
+		out[fe.Field] = fe.Code
+	}
+	return out
+}
+
+func TestUpdateApprovalTicket_WhitelistAndBounds(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	client := testutil.OpenEntPostgres(t, "approval_patch_modified_spec")
+	ticket := seedPreviewTicket(t, client, "tpl-fedora")
+	srv := NewServer(ServerDeps{EntClient: client, Audit: audit.NewLogger(client)})
+	approver := []string{"approval:approve"}
+
+	status, _, apiErr := patchModifiedSpec(t, srv, ticket.ID, `{"modified_spec":{
+		"cpu": 2,
+		"memory_mb": "4096",
+		"image": "quay.io/evil:latest",
+		"spec_overrides": {"spec.template.spec.hostname": "pwned"}
+	}}`, approver)
+	wantFields := map[string]string{
+		"memory_mb": "INVALID_TYPE",
+		"image":     "NOT_ALLOWED",
+		"spec_overrides.spec.template.spec.hostname": "NOT_ALLOWED",
+	}
+	if status != http.StatusBadRequest || apiErr.Code != "MODIFIED_SPEC_INVALID" || !reflect.DeepEqual(fieldErrorCodes(apiErr), wantFields) {
+		t.Fatalf("disallowed fields = %d %s %v, want 400 MODIFIED_SPEC_INVALID %v", status, apiErr.Code, fieldErrorCodes(apiErr), wantFields)
+	}
+	if got := client.ApprovalTicket.GetX(t.Context(), ticket.ID).ModifiedSpec; !reflect.DeepEqual(got, map[string]interface{}{"cpu": float64(4)}) {
+		t.Fatalf("modified_spec after rejected PATCH = %v, want it unchanged", got)
+	}
+
+	// size-small (2 CPU, 4096 MiB) is the largest enabled instance size.
+	status, _, apiErr = patchModifiedSpec(t, srv, ticket.ID, `{"modified_spec":{"cpu":8,"memory_mb":4096}}`, approver)
+	if status != http.StatusBadRequest || !reflect.DeepEqual(fieldErrorCodes(apiErr), map[string]string{"cpu": "OUT_OF_BOUNDS"}) {
+		t.Fatalf("oversized cpu = %d %v, want 400 with cpu OUT_OF_BOUNDS", status, fieldErrorCodes(apiErr))
+	}
+	status, item, _ := patchModifiedSpec(t, srv, ticket.ID, `{"modified_spec":{"cpu":8,"memory_mb":4096}}`,
+		[]string{"approval:approve", "platform:admin"})
+	if status != http.StatusOK || item.ModifiedSpec["cpu"] != float64(8) {
+		t.Fatalf("platform admin oversized cpu = %d %v, want 200", status, item.ModifiedSpec)
+	}
+
+	c, w := newAuthedGinContext(t, http.MethodPut, "/admin/spec-override-policy",
+		`{"allowed_paths":[{"path":"spec.template.spec.hostname","type":"string"}]}`, "admin-1", []string{"platform:admin"})
+	srv.PutSpecOverridePolicy(c)
+	if w.Code != http.StatusOK {
+		t.Fatalf("put policy status = %d body=%s", w.Code, w.Body.String())
+	}
+	status, _, apiErr = patchModifiedSpec(t, srv, ticket.ID,
+		`{"modified_spec":{"spec.template.spec.hostname":42}}`, approver)
+	if status != http.StatusBadRequest || !reflect.DeepEqual(fieldErrorCodes(apiErr), map[string]string{"spec.template.spec.hostname": "INVALID_TYPE"}) {
+		t.Fatalf("mistyped policy path = %d %v, want 400 INVALID_TYPE", status, fieldErrorCodes(apiErr))
+	}
+	status, item, _ = patchModifiedSpec(t, srv, ticket.ID,
+		`{"modified_spec":{"cpu":2,"spec_overrides":{"spec.template.spec.hostname":"redis"}}}`, approver)
+	if status != http.StatusOK || item.ModifiedSpec["cpu"] != float64(2) || item.InstanceSize.CpuCores != 2 {
+		t.Fatalf("allowed policy path = %d %+v, want 200", status, item)
+	}
+	if n := client.AuditLog.Query().Where(auditlog.ActionEQ("approval.modified_spec.update")).CountX(t.Context()); n != 2 {
+		t.Fatalf("approval.modified_spec.update audit entries = %d, want 2", n)
+	}
+
+	status, item, _ = patchModifiedSpec(t, srv, ticket.ID, `{"modified_spec":{}}`, approver)
+	if status != http.StatusOK || item.ModifiedSpec != nil || client.ApprovalTicket.GetX(t.Context(), ticket.ID).ModifiedSpec != nil {
+		t.Fatalf("clearing modified_spec = %d %v, want 200 and no modifications", status, item.ModifiedSpec)
+	}
+}
+
+func TestUpdateApprovalTicket_Rejections(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	client := testutil.OpenEntPostgres(t, "approval_patch_rejections")
+	ticket := seedPreviewTicket(t, client, "tpl-fedora")
+	srv := NewServer(ServerDeps{EntClient: client})
+	approver := []string{"approval:approve"}
+	body := `{"modified_spec":{"cpu":2}}`
+
+	if status, _, _ := patchModifiedSpec(t, srv, ticket.ID, body, []string{"approval:view"}); status != http.StatusForbidden {
+		t.Fatalf("PATCH without approval:approve status = %d, want 403", status)
+	}
+	if status, _, apiErr := patchModifiedSpec(t, srv, "ticket-missing", body, approver); status != http.StatusNotFound || apiErr.Code != "TICKET_NOT_FOUND" {
+		t.Fatalf("missing ticket = %d %s, want 404 TICKET_NOT_FOUND", status, apiErr.Code)
+	}
+	if status, _, apiErr := patchModifiedSpec(t, srv, ticket.ID, `{}`, approver); status != http.StatusBadRequest || apiErr.Code != "INVALID_REQUEST" {
+		t.Fatalf("PATCH without modified_spec = %d %s, want 400 INVALID_REQUEST", status, apiErr.Code)
+	}
+
+	client.ApprovalTicket.Create().
+		SetID("ticket-delete").
+		SetEventID("ev-delete").
+		SetRequester("alice").
+		SetOperationType(approvalticket.OperationTypeDELETE).
+		ExecX(t.Context())
+	if status, _, apiErr := patchModifiedSpec(t, srv, "ticket-delete", body, approver); status != http.StatusConflict || apiErr.Code != "MODIFIED_SPEC_NOT_SUPPORTED" {
+		t.Fatalf("DELETE ticket = %d %s, want 409 MODIFIED_SPEC_NOT_SUPPORTED", status, apiErr.Code)
+	}
+
+	client.ApprovalTicket.UpdateOneID(ticket.ID).SetStatus(approvalticket.StatusAPPROVED).ExecX(t.Context())
+	if status, _, apiErr := patchModifiedSpec(t, srv, ticket.ID, body, approver); status != http.StatusConflict || apiErr.Code != "TICKET_NOT_PENDING" {
+		t.Fatalf("approved ticket = %d %s, want 409 TICKET_NOT_PENDING", status, apiErr.Code)
+	}
+}
+
+func TestSpecOverridePolicy_GetPut(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	client := testutil.OpenEntPostgres(t, "spec_override_policy")
+	srv := NewServer(ServerDeps{EntClient: client, Audit: audit.NewLogger(client)})
+	admin := []string{"platform:admin"}
+
+	c, w := newAuthedGinContext(t, http.MethodGet, "/admin/spec-override-policy", "", "approver-1", []string{"approval:approve"})
+	srv.GetSpecOverridePolicy(c)
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("GET without platform:admin status = %d, want 403", w.Code)
+	}
+	c, w = newAuthedGinContext(t, http.MethodGet, "/admin/spec-override-policy", "", "admin-1", admin)
+	srv.GetSpecOverridePolicy(c)
+	var policy generated.SpecOverridePolicy
+	mustDecodeJSON(t, w.Body.Bytes(), &policy)
+	if w.Code != http.StatusOK || policy.AllowedPaths == nil || len(policy.AllowedPaths) != 0 {
+		t.Fatalf("initial policy = %d %+v, want 200 with no paths", w.Code, policy)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodPut, "/admin/spec-override-policy", `{"allowed_paths":[
+		{"path":"metadata.labels","type":"object"},
+		{"path":"spec.running","type":"date"},
+		{"path":"spec.template.spec.hostname","type":"string"},
+		{"path":"spec.template.spec.hostname","type":"string"}
+	]}`, "admin-1", admin)
+	srv.PutSpecOverridePolicy(c)
+	var apiErr generated.Error
+	mustDecodeJSON(t, w.Body.Bytes(), &apiErr)
+	wantFields := map[string]string{
+		"metadata.labels":             "INVALID_PATH",
+		"spec.running":                "INVALID_TYPE",
+		"spec.template.spec.hostname": "DUPLICATE_PATH",
+	}
+	if w.Code != http.StatusBadRequest || !reflect.DeepEqual(fieldErrorCodes(apiErr), wantFields) {
+		t.Fatalf("invalid policy = %d %v, want 400 %v", w.Code, fieldErrorCodes(apiErr), wantFields)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodPut, "/admin/spec-override-policy", `{"allowed_paths":[
+		{"path":"spec.template.spec.hostname","type":"string"},
+		{"path":"spec.running","type":"boolean"}
+	]}`, "admin-1", admin)
+	srv.PutSpecOverridePolicy(c)
+	mustDecodeJSON(t, w.Body.Bytes(), &policy)
+	want := []generated.SpecOverridePath{
+		{Path: "spec.running", Type: generated.SpecOverridePathTypeBoolean},
+		{Path: "spec.template.spec.hostname", Type: generated.SpecOverridePathTypeString},
+	}
+	if w.Code != http.StatusOK || !reflect.DeepEqual(policy.AllowedPaths, want) || policy.UpdatedBy != "admin-1" {
+		t.Fatalf("saved policy = %d %+v, want sorted %+v", w.Code, policy, want)
+	}
+	if n := client.AuditLog.Query().Where(auditlog.ActionEQ("spec_override_policy.update")).CountX(t.Context()); n != 1 {
+		t.Fatalf("spec_override_policy.update audit entries = %d, want 1", n)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodGet, "/admin/spec-override-policy", "", "admin-1", admin)
+	srv.GetSpecOverridePolicy(c)
+	mustDecodeJSON(t, w.Body.Bytes(), &policy)
+	if !reflect.DeepEqual(policy.AllowedPaths, want) {
+		t.Fatalf("GET policy = %+v, want %+v", policy.AllowedPaths, want)
+	}
+}
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"sigs.k8s.io/yaml"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/provider"
+	"kv-shepherd.io/shepherd/internal/service/vmspec"
+)
+
+// PreviewApprovalTicket handles GET /approvals/{ticket_id}/preview.
+//
+// It runs the create worker's spec assembly and renders the VirtualMachine
+// without contacting a cluster, so approvers see what approval would apply.
+func (s *Server) PreviewApprovalTicket(c *gin.Context, ticketId generated.TicketID) {
+	ctx := c.Request.Context()
+	if !requireGlobalPermission(c, "approval:view") {
+		return
+	}
+
+	t, err := s.client.ApprovalTicket.Get(ctx, ticketId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "TICKET_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to get approval ticket for preview", zap.Error(err), zap.String("ticket_id", ticketId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if t.OperationType != approvalticket.OperationTypeCREATE {
+		c.JSON(http.StatusConflict, generated.Error{
+			Code:    "PREVIEW_NOT_SUPPORTED",
+			Message: "only CREATE tickets render a VM manifest",
+			Params:  map[string]interface{}{"operation_type": string(t.OperationType)},
+		})
+		return
+	}
+	if t.Status != approvalticket.StatusPENDING {
+		c.JSON(http.StatusConflict, generated.Error{Code: "TICKET_NOT_PENDING"})
+		return
+	}
+
+	ev, err := s.client.DomainEvent.Get(ctx, t.EventID)
+	if err != nil {
+		logger.Error("failed to get domain event for preview", zap.Error(err), zap.String("ticket_id", t.ID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	// Messages below match the ones the create worker fails the ticket with.
+	var payload domain.VMCreationPayload
+	if err := json.Unmarshal(ev.Payload, &payload); err != nil {
+		writeVMSpecInvalid(c, fmt.Errorf("unmarshal payload for event %s: %w", ev.ID, err))
+		return
+	}
+	namespace := strings.TrimSpace(payload.Namespace)
+	if namespace == "" {
+		writeVMSpecInvalid(c, fmt.Errorf("event %s payload namespace is empty", ev.ID))
+		return
+	}
+
+	clusterID := strings.TrimSpace(t.SelectedClusterID)
+	built, err := vmspec.Build(ctx, s.client, vmspec.Input{
+		EventID:   ev.ID,
+		Payload:   payload,
+		Ticket:    t,
+		ClusterID: clusterID,
+	})
+	if err != nil {
+		var permanent *vmspec.PermanentError
+		if errors.As(err, &permanent) {
+			writeVMSpecInvalid(c, err)
+			return
+		}
+		logger.Error("failed to assemble vm spec for preview", zap.Error(err), zap.String("ticket_id", t.ID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	rendered, err := provider.RenderVM(namespace, built.Spec)
+	if err != nil {
+		writeVMSpecInvalid(c, err)
+		return
+	}
+
+	raw, err := json.Marshal(rendered)
+	if err != nil {
+		logger.Error("failed to encode previewed vm", zap.Error(err), zap.String("ticket_id", t.ID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	var manifest map[string]interface{}
+	if err := json.Unmarshal(raw, &manifest); err != nil {
+		logger.Error("failed to decode previewed vm", zap.Error(err), zap.String("ticket_id", t.ID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	manifestYAML, err := yaml.JSONToYAML(raw)
+	if err != nil {
+		logger.Error("failed to render previewed vm as yaml", zap.Error(err), zap.String("ticket_id", t.ID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.ApprovalPreview{
+		TicketId:     t.ID,
+		ClusterId:    clusterID,
+		Namespace:    namespace,
+		Manifest:     manifest,
+		ManifestYaml: string(manifestYAML),
+		FieldSources: vmSpecFieldSourcesToAPI(built.Sources),
+	})
+}
+
+func writeVMSpecInvalid(c *gin.Context, err error) {
+	c.JSON(http.StatusUnprocessableEntity, generated.Error{Code: "VM_SPEC_INVALID", Message: err.Error()})
+}
+
+func vmSpecFieldSourcesToAPI(sources map[string]vmspec.Source) []generated.VMSpecFieldSource {
+	out := make([]generated.VMSpecFieldSource, 0, len(sources))
+	for field, source := range sources {
+		out = append(out, generated.VMSpecFieldSource{
+			Field:  field,
+			Source: generated.VMSpecFieldSourceSource(source),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Field < out[j].Field })
+	return out
+}
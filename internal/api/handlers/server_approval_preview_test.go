@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/cluster"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/jobs"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/provider"
+	"kv-shepherd.io/shepherd/internal/service"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+// seedPreviewTicket creates a PENDING CREATE ticket whose approver raised the
+// CPU, with the catalog rows, event and reserved VM row it refers to.
+func seedPreviewTicket(t *testing.T, client *ent.Client, templateID string) *ent.ApprovalTicket {
+	t.Helper()
+	ctx := t.Context()
+
+	mustCreateSystem(t, client, "sys-shop", "shop", "alice")
+	mustCreateService(t, client, "svc-redis", "redis", "sys-shop", "cache")
+	client.Template.Create().
+		SetID("tpl-fedora").
+		SetName("fedora").
+		SetVersion(1).
+		SetCreatedBy("admin-1").
+		SetSpec(map[string]interface{}{"image": "quay.io/kubevirt/fedora:40", "cloud_init_type": "nocloud"}).
+		ExecX(ctx)
+	client.InstanceSize.Create().
+		SetID("size-small").
+		SetName("small").
+		SetCPUCores(2).
+		SetMemoryMB(4096).
+		SetSpecOverrides(map[string]interface{}{"spec.template.spec.domain.cpu.dedicatedCpuPlacement": true}).
+		SetCreatedBy("admin-1").
+		ExecX(ctx)
+
+	payload, err := json.Marshal(domain.VMCreationPayload{
+		ServiceID:      "svc-redis",
+		TemplateID:     templateID,
+		InstanceSizeID: "size-small",
+		Namespace:      "prod-shop",
+		UserData:       "#cloud-config\nhostname: redis\n",
+	})
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+	client.DomainEvent.Create().
+		SetID("ev-preview").
+		SetEventType(string(domain.EventVMCreationRequested)).
+		SetAggregateType("vm").
+		SetAggregateID("vm-preview").
+		SetPayload(payload).
+		SetStatus(domainevent.StatusPENDING).
+		SetCreatedBy("alice").
+		ExecX(ctx)
+	ticket := client.ApprovalTicket.Create().
+		SetID("ticket-preview").
+		SetEventID("ev-preview").
+		SetRequester("alice").
+		SetOperationType(approvalticket.OperationTypeCREATE).
+		SetModifiedSpec(map[string]interface{}{"cpu": 4}).
+		SaveX(ctx)
+	client.VM.Create().
+		SetID("vm-preview").
+		SetName("prod-shop-shop-redis-01").
+		SetInstance("01").
+		SetNamespace("prod-shop").
+		SetStatus(entvm.StatusPENDING).
+		SetCreatedBy("alice").
+		SetServiceID("svc-redis").
+		SetTicketID(ticket.ID).
+		ExecX(ctx)
+	return ticket
+}
+
+func TestPreviewApprovalTicket_MatchesWorkerSpec(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "approval_preview_matches_worker")
+	ticket := seedPreviewTicket(t, client, "tpl-fedora")
+	srv := NewServer(ServerDeps{EntClient: client})
+
+	c, w := newAuthedGinContext(t, http.MethodGet, "/approvals/ticket-preview/preview", "", "alice", []string{"vm:read"})
+	srv.PreviewApprovalTicket(c, ticket.ID)
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("preview without approval:view status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodGet, "/approvals/ticket-preview/preview", "", "admin-1", []string{"approval:view"})
+	srv.PreviewApprovalTicket(c, ticket.ID)
+	if w.Code != http.StatusOK {
+		t.Fatalf("preview status = %d body=%s", w.Code, w.Body.String())
+	}
+	var preview generated.ApprovalPreview
+	mustDecodeJSON(t, w.Body.Bytes(), &preview)
+	if preview.Namespace != "prod-shop" || preview.ClusterId != "" || preview.ManifestYaml == "" {
+		t.Fatalf("preview = namespace %q cluster %q yaml %d bytes", preview.Namespace, preview.ClusterId, len(preview.ManifestYaml))
+	}
+	gotSources := map[string]generated.VMSpecFieldSourceSource{}
+	for _, fs := range preview.FieldSources {
+		gotSources[fs.Field] = fs.Source
+	}
+	wantSources := map[string]generated.VMSpecFieldSourceSource{
+		"name":            generated.VMSpecFieldSourceSourceRequest,
+		"cpu":             generated.VMSpecFieldSourceSourceOverride,
+		"memory_mb":       generated.VMSpecFieldSourceSourceInstanceSize,
+		"image":           generated.VMSpecFieldSourceSourceTemplate,
+		"cloud_init_type": generated.VMSpecFieldSourceSourceTemplate,
+		"user_data":       generated.VMSpecFieldSourceSourceRequest,
+		"spec_overrides.spec.template.spec.domain.cpu.dedicatedCpuPlacement": generated.VMSpecFieldSourceSourceInstanceSize,
+	}
+	if !reflect.DeepEqual(gotSources, wantSources) {
+		t.Fatalf("field sources = %v, want %v", gotSources, wantSources)
+	}
+
+	// Approve and run the create worker: the VM it hands the provider must
+	// render to exactly the previewed manifest.
+	client.Cluster.Create().
+		SetID("cluster-a").
+		SetName("cluster-a").
+		SetAPIServerURL("https://cluster-a.example.com").
+		SetEncryptedKubeconfig([]byte("x")).
+		SetStatus(cluster.StatusHEALTHY).
+		SetCreatedBy("admin-1").
+		ExecX(t.Context())
+	mustRegisterNamespace(t, client, "prod-shop", true)
+	client.ApprovalTicket.UpdateOneID(ticket.ID).
+		SetStatus(approvalticket.StatusAPPROVED).
+		SetSelectedClusterID("cluster-a").
+		ExecX(t.Context())
+
+	mock := provider.NewMockProvider()
+	mock.SeedNamespaces("prod-shop")
+	err := jobs.NewVMCreateWorker(client, service.NewVMService(mock), nil).Work(t.Context(), &river.Job[jobs.VMCreateArgs]{
+		Args:   jobs.VMCreateArgs{EventID: "ev-preview"},
+		JobRow: &rivertype.JobRow{Attempt: 1},
+	})
+	if err != nil {
+		t.Fatalf("create worker: %v", err)
+	}
+	created, err := mock.GetVM(t.Context(), "cluster-a", "prod-shop", "prod-shop-shop-redis-01")
+	if err != nil {
+		t.Fatalf("get created vm: %v", err)
+	}
+	applied, err := provider.RenderVM("prod-shop", &created.Spec)
+	if err != nil {
+		t.Fatalf("render applied vm: %v", err)
+	}
+	raw, err := json.Marshal(applied)
+	if err != nil {
+		t.Fatalf("marshal applied vm: %v", err)
+	}
+	var appliedManifest map[string]interface{}
+	mustDecodeJSON(t, raw, &appliedManifest)
+	if !reflect.DeepEqual(preview.Manifest, appliedManifest) {
+		t.Fatalf("preview manifest differs from applied vm\npreview: %v\napplied: %v", preview.Manifest, appliedManifest)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodGet, "/approvals/ticket-preview/preview", "", "admin-1", []string{"approval:view"})
+	srv.PreviewApprovalTicket(c, ticket.ID)
+	assertStatusAndCode(t, w, http.StatusConflict, "TICKET_NOT_PENDING")
+}
+
+func TestPreviewApprovalTicket_Rejections(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "approval_preview_rejections")
+	ticket := seedPreviewTicket(t, client, "tpl-missing")
+	srv := NewServer(ServerDeps{EntClient: client})
+	view := []string{"approval:view"}
+
+	c, w := newAuthedGinContext(t, http.MethodGet, "/approvals/ticket-missing/preview", "", "admin-1", view)
+	srv.PreviewApprovalTicket(c, "ticket-missing")
+	assertStatusAndCode(t, w, http.StatusNotFound, "TICKET_NOT_FOUND")
+
+	c, w = newAuthedGinContext(t, http.MethodGet, "/approvals/ticket-preview/preview", "", "admin-1", view)
+	srv.PreviewApprovalTicket(c, ticket.ID)
+	assertStatusAndCode(t, w, http.StatusUnprocessableEntity, "VM_SPEC_INVALID")
+	var apiErr generated.Error
+	mustDecodeJSON(t, w.Body.Bytes(), &apiErr)
+	if apiErr.Message != "template tpl-missing not found" {
+		t.Fatalf("invalid spec message = %q, want the worker's failure reason", apiErr.Message)
+	}
+
+	client.ApprovalTicket.UpdateOneID(ticket.ID).SetOperationType(approvalticket.OperationTypeDELETE).ExecX(t.Context())
+	c, w = newAuthedGinContext(t, http.MethodGet, "/approvals/ticket-preview/preview", "", "admin-1", view)
+	srv.PreviewApprovalTicket(c, ticket.ID)
+	assertStatusAndCode(t, w, http.StatusConflict, "PREVIEW_NOT_SUPPORTED")
+}
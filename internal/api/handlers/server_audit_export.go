@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// auditExportCSVHeader follows the field order of the AuditLog JSON schema.
+var auditExportCSVHeader = []string{"id", "action", "resource_type", "resource_id", "actor", "details", "created_at"}
+
+// auditExportTruncatedID is the id column of the CSV truncation marker row.
+const auditExportTruncatedID = "#truncated"
+
+// auditExportTruncation is the marker ending an export cut short by the row cap.
+type auditExportTruncation struct {
+	Truncated bool `json:"truncated"`
+	MaxRows   int  `json:"max_rows"`
+}
+
+// ExportAuditLogs handles GET /audit-logs/export.
+//
+// Rows are streamed batch by batch (audit.ExportBatchSize) and flushed after
+// each batch, so the response uses chunked transfer encoding and never holds
+// the full result set in memory. At most s.auditExportMaxRows rows are
+// written; a longer result ends with an auditExportTruncation marker.
+func (s *Server) ExportAuditLogs(c *gin.Context, params generated.ExportAuditLogsParams) {
+	if !requireGlobalPermission(c, "audit:read") {
+		return
+	}
+	ctx := c.Request.Context()
+
+	format := params.Format
+	if format == "" {
+		format = generated.Ndjson
+	}
+	if format != generated.Ndjson && format != generated.Csv {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "format must be ndjson or csv"})
+		return
+	}
+	if !params.From.IsZero() && !params.To.IsZero() && !params.From.Before(params.To) {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "from must be before to"})
+		return
+	}
+
+	auditLogger := s.audit
+	if auditLogger == nil {
+		auditLogger = audit.NewLogger(s.client)
+	}
+
+	filename := fmt.Sprintf("audit-logs-%s.%s", time.Now().UTC().Format("20060102T150405Z"), format)
+	contentType := "application/x-ndjson"
+	if format == generated.Csv {
+		contentType = "text/csv; charset=utf-8"
+	}
+
+	var csvWriter *csv.Writer
+	encoder := json.NewEncoder(c.Writer)
+	started := false
+	start := func() error {
+		started = true
+		c.Header("Content-Type", contentType)
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		c.Header("Cache-Control", "no-store")
+		c.Status(http.StatusOK)
+		if format == generated.Csv {
+			csvWriter = csv.NewWriter(c.Writer)
+			return csvWriter.Write(auditExportCSVHeader)
+		}
+		return nil
+	}
+
+	filter := audit.ExportFilter{
+		From:         params.From,
+		To:           params.To,
+		Action:       params.Action,
+		Actor:        params.Actor,
+		ResourceType: params.ResourceType,
+		ResourceID:   params.ResourceId,
+		MaxRows:      s.auditExportMaxRows,
+	}
+	truncated, err := auditLogger.Export(ctx, filter, func(batch []*ent.AuditLog) error {
+		if !started {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+		for _, l := range batch {
+			if csvWriter != nil {
+				record, err := auditLogCSVRecord(l)
+				if err != nil {
+					return err
+				}
+				if err := csvWriter.Write(record); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := encoder.Encode(auditLogToAPI(l)); err != nil {
+				return err
+			}
+		}
+		if csvWriter != nil {
+			csvWriter.Flush()
+			if err := csvWriter.Error(); err != nil {
+				return err
+			}
+		}
+		c.Writer.Flush()
+		return nil
+	})
+	if err != nil {
+		if !started {
+			logger.Error("failed to export audit logs", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+			return
+		}
+		// Headers are already on the wire; the truncated stream is the only signal left.
+		logger.Error("audit log export aborted mid-stream", zap.Error(err))
+		return
+	}
+
+	if !started {
+		// Empty result set: still emit headers (and the CSV header row).
+		if err := start(); err != nil {
+			logger.Error("failed to write audit export header", zap.Error(err))
+			return
+		}
+		if csvWriter != nil {
+			csvWriter.Flush()
+		}
+		c.Writer.Flush()
+	}
+	if truncated {
+		marker := auditExportTruncation{Truncated: true, MaxRows: filter.MaxRows}
+		if err := writeAuditExportTruncation(c, csvWriter, encoder, marker); err != nil {
+			logger.Error("failed to write audit export truncation marker", zap.Error(err))
+			return
+		}
+		logger.Warn("audit log export truncated", zap.Int("max_rows", filter.MaxRows))
+	}
+}
+
+// writeAuditExportTruncation ends the stream with the truncation marker: a
+// final NDJSON line, or a CSV row carrying the marker in its details column.
+func writeAuditExportTruncation(c *gin.Context, csvWriter *csv.Writer, encoder *json.Encoder, marker auditExportTruncation) error {
+	if csvWriter == nil {
+		if err := encoder.Encode(marker); err != nil {
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	}
+	raw, err := json.Marshal(marker)
+	if err != nil {
+		return err
+	}
+	record := make([]string, len(auditExportCSVHeader))
+	record[0] = auditExportTruncatedID
+	record[5] = string(raw)
+	if err := csvWriter.Write(record); err != nil {
+		return err
+	}
+	csvWriter.Flush()
+	if err := csvWriter.Error(); err != nil {
+		return err
+	}
+	c.Writer.Flush()
+	return nil
+}
+
+func auditLogToAPI(l *ent.AuditLog) generated.AuditLog {
+	return generated.AuditLog{
+		Id:           l.ID,
+		Action:       l.Action,
+		ResourceType: l.ResourceType,
+		ResourceId:   l.ResourceID,
+		Actor:        l.Actor,
+		Details:      l.Details,
+		CreatedAt:    l.CreatedAt,
+	}
+}
+
+func auditLogCSVRecord(l *ent.AuditLog) ([]string, error) {
+	details := ""
+	if len(l.Details) > 0 {
+		raw, err := json.Marshal(l.Details)
+		if err != nil {
+			return nil, fmt.Errorf("encode details for audit log %s: %w", l.ID, err)
+		}
+		details = string(raw)
+	}
+	return []string{
+		l.ID,
+		l.Action,
+		l.ResourceType,
+		l.ResourceID,
+		l.Actor,
+		details,
+		l.CreatedAt.UTC().Format(time.RFC3339Nano),
+	}, nil
+}
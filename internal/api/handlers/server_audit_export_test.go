@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestExportAuditLogs(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	client := testutil.OpenEntPostgres(t, "audit_log_export")
+	srv := NewServer(ServerDeps{EntClient: client, Audit: audit.NewLogger(client)})
+
+	// Span several export batches to exercise keyset pagination.
+	total := 5*audit.ExportBatchSize + 7
+	base := time.Now().UTC().Add(-time.Hour)
+	builders := make([]*ent.AuditLogCreate, 0, total)
+	for i := 0; i < total; i++ {
+		builders = append(builders, client.AuditLog.Create().
+			SetID(fmt.Sprintf("audit-export-%04d", i)).
+			SetAction("vm.create").
+			SetResourceType("vm").
+			SetResourceID(fmt.Sprintf("vm-%d", i)).
+			SetActor(fmt.Sprintf("admin-%d", i%2)).
+			SetDetails(map[string]interface{}{"index": i, "note": `quoted "value", with comma`}).
+			SetCreatedAt(base.Add(time.Duration(i/2)*time.Second)))
+	}
+	if _, err := client.AuditLog.CreateBulk(builders...).Save(t.Context()); err != nil {
+		t.Fatalf("seed audit logs: %v", err)
+	}
+
+	t.Run("ndjson", func(t *testing.T) {
+		c, w := newAuthedGinContext(t, http.MethodGet, "/audit-logs/export", "", "auditor-1", []string{"audit:read"})
+		srv.ExportAuditLogs(c, generated.ExportAuditLogsParams{})
+		if w.Code != http.StatusOK {
+			t.Fatalf("status = %d, want %d body=%s", w.Code, http.StatusOK, w.Body.String())
+		}
+		if got := w.Header().Get("Content-Type"); got != "application/x-ndjson" {
+			t.Fatalf("Content-Type = %q", got)
+		}
+		if got := w.Header().Get("Content-Disposition"); !strings.HasPrefix(got, "attachment;") || !strings.Contains(got, ".ndjson") {
+			t.Fatalf("Content-Disposition = %q", got)
+		}
+
+		seen := make(map[string]bool, total)
+		scanner := bufio.NewScanner(bytes.NewReader(w.Body.Bytes()))
+		for scanner.Scan() {
+			var row generated.AuditLog
+			if err := json.Unmarshal(scanner.Bytes(), &row); err != nil {
+				t.Fatalf("decode line %q: %v", scanner.Text(), err)
+			}
+			seen[row.Id] = true
+		}
+		if len(seen) != total {
+			t.Fatalf("exported %d distinct rows, want %d", len(seen), total)
+		}
+	})
+
+	t.Run("csv with range", func(t *testing.T) {
+		from := base
+		to := base.Add(5 * time.Second) // rows 0..9
+		c, w := newAuthedGinContext(t, http.MethodGet, "/audit-logs/export?format=csv", "", "auditor-1", []string{"audit:read"})
+		srv.ExportAuditLogs(c, generated.ExportAuditLogsParams{Format: generated.Csv, From: from, To: to})
+		if w.Code != http.StatusOK {
+			t.Fatalf("status = %d, want %d body=%s", w.Code, http.StatusOK, w.Body.String())
+		}
+		if got := w.Header().Get("Content-Type"); !strings.HasPrefix(got, "text/csv") {
+			t.Fatalf("Content-Type = %q", got)
+		}
+		if got := w.Header().Get("Content-Disposition"); !strings.Contains(got, ".csv") {
+			t.Fatalf("Content-Disposition = %q", got)
+		}
+		records, err := csv.NewReader(bytes.NewReader(w.Body.Bytes())).ReadAll()
+		if err != nil {
+			t.Fatalf("parse csv: %v", err)
+		}
+		if len(records) != 11 {
+			t.Fatalf("csv rows = %d, want header + 10", len(records))
+		}
+		if strings.Join(records[0], ",") != "id,action,resource_type,resource_id,actor,details,created_at" {
+			t.Fatalf("csv header = %v", records[0])
+		}
+		if records[1][0] != "audit-export-0000" || records[1][5] != `{"index":0,"note":"quoted \"value\", with comma"}` {
+			t.Fatalf("unexpected first csv row: %v", records[1])
+		}
+	})
+
+	t.Run("csv full export with filters", func(t *testing.T) {
+		c, w := newAuthedGinContext(t, http.MethodGet, "/audit-logs/export?format=csv&actor=admin-1", "", "auditor-1", []string{"audit:read"})
+		srv.ExportAuditLogs(c, generated.ExportAuditLogsParams{Format: generated.Csv, Actor: "admin-1", Action: "vm.create", ResourceType: "vm"})
+		if w.Code != http.StatusOK {
+			t.Fatalf("status = %d, want %d body=%s", w.Code, http.StatusOK, w.Body.String())
+		}
+		records, err := csv.NewReader(bytes.NewReader(w.Body.Bytes())).ReadAll()
+		if err != nil {
+			t.Fatalf("parse csv: %v", err)
+		}
+		if len(records) != 1+total/2 {
+			t.Fatalf("csv rows = %d, want header + %d", len(records), total/2)
+		}
+		for _, record := range records[1:] {
+			var details map[string]interface{}
+			if record[4] != "admin-1" || json.Unmarshal([]byte(record[5]), &details) != nil {
+				t.Fatalf("unexpected csv row: %v", record)
+			}
+		}
+	})
+
+	t.Run("max rows ends with a truncation marker", func(t *testing.T) {
+		capped := NewServer(ServerDeps{EntClient: client, Audit: audit.NewLogger(client), AuditExportMaxRows: audit.ExportBatchSize + 1})
+
+		c, w := newAuthedGinContext(t, http.MethodGet, "/audit-logs/export", "", "auditor-1", []string{"audit:read"})
+		capped.ExportAuditLogs(c, generated.ExportAuditLogsParams{})
+		lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+		if len(lines) != audit.ExportBatchSize+2 {
+			t.Fatalf("ndjson lines = %d, want %d rows + marker", len(lines), audit.ExportBatchSize+1)
+		}
+		var marker auditExportTruncation
+		if err := json.Unmarshal([]byte(lines[len(lines)-1]), &marker); err != nil || !marker.Truncated || marker.MaxRows != audit.ExportBatchSize+1 {
+			t.Fatalf("last ndjson line = %s, want truncation marker", lines[len(lines)-1])
+		}
+
+		c, w = newAuthedGinContext(t, http.MethodGet, "/audit-logs/export?format=csv", "", "auditor-1", []string{"audit:read"})
+		capped.ExportAuditLogs(c, generated.ExportAuditLogsParams{Format: generated.Csv})
+		records, err := csv.NewReader(bytes.NewReader(w.Body.Bytes())).ReadAll()
+		if err != nil {
+			t.Fatalf("parse csv: %v", err)
+		}
+		last := records[len(records)-1]
+		if len(records) != audit.ExportBatchSize+3 || last[0] != auditExportTruncatedID || last[5] != fmt.Sprintf(`{"truncated":true,"max_rows":%d}`, audit.ExportBatchSize+1) {
+			t.Fatalf("csv rows = %d, last = %v, want header, %d rows and marker", len(records), last, audit.ExportBatchSize+1)
+		}
+
+		// An export exactly at the cap is complete and carries no marker.
+		c, w = newAuthedGinContext(t, http.MethodGet, "/audit-logs/export?actor=admin-0", "", "auditor-1", []string{"audit:read"})
+		exact := NewServer(ServerDeps{EntClient: client, Audit: audit.NewLogger(client), AuditExportMaxRows: (total + 1) / 2})
+		exact.ExportAuditLogs(c, generated.ExportAuditLogsParams{Actor: "admin-0"})
+		if body := w.Body.String(); strings.Contains(body, `"truncated"`) || strings.Count(body, "\n") != (total+1)/2 {
+			t.Fatalf("export at the cap returned %d lines, want %d without marker", strings.Count(body, "\n"), (total+1)/2)
+		}
+	})
+
+	t.Run("requires audit:read", func(t *testing.T) {
+		c, w := newAuthedGinContext(t, http.MethodGet, "/audit-logs/export", "", "user-1", []string{"vm:read"})
+		srv.ExportAuditLogs(c, generated.ExportAuditLogsParams{})
+		if w.Code != http.StatusForbidden {
+			t.Fatalf("status = %d, want %d", w.Code, http.StatusForbidden)
+		}
+	})
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestListAuditLogs_Filters(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "audit_log_list")
+	srv := NewServer(ServerDeps{EntClient: client, Audit: audit.NewLogger(client)})
+
+	base := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	rows := []struct {
+		actor, action, resourceType, resourceID string
+	}{
+		{"alice", "vm.create", "vm", "vm-1"},
+		{"alice", "vm.delete", "vm", "vm-1"},
+		{"alice", "system.create", "system", "sys-1"},
+		{"bob", "vm.create", "vm", "vm-2"},
+		{"bob", "vm.create", "vm", "vm-1"},
+	}
+	builders := make([]*ent.AuditLogCreate, 0, len(rows))
+	for i, r := range rows {
+		builders = append(builders, client.AuditLog.Create().
+			SetID(fmt.Sprintf("audit-list-%d", i)).
+			SetActor(r.actor).
+			SetAction(r.action).
+			SetResourceType(r.resourceType).
+			SetResourceID(r.resourceID).
+			SetCreatedAt(base.Add(time.Duration(i)*time.Minute)))
+	}
+	client.AuditLog.CreateBulk(builders...).SaveX(t.Context())
+
+	listAdmin := func(params generated.ListAdminAuditLogsParams, perms []string) (int, generated.AuditLogList) {
+		c, w := newAuthedGinContext(t, http.MethodGet, "/admin/audit-logs", "", "auditor-1", perms)
+		srv.ListAdminAuditLogs(c, params)
+		var out generated.AuditLogList
+		if w.Code == http.StatusOK {
+			mustDecodeJSON(t, w.Body.Bytes(), &out)
+		}
+		return w.Code, out
+	}
+	ids := func(list generated.AuditLogList) []string {
+		out := make([]string, 0, len(list.Items))
+		for _, item := range list.Items {
+			out = append(out, item.Id)
+		}
+		return out
+	}
+	auditRead := []string{"audit:read"}
+
+	tests := []struct {
+		name   string
+		params generated.ListAdminAuditLogsParams
+		want   []string
+	}{
+		{name: "all, newest first", params: generated.ListAdminAuditLogsParams{}, want: []string{"audit-list-4", "audit-list-3", "audit-list-2", "audit-list-1", "audit-list-0"}},
+		{name: "by actor", params: generated.ListAdminAuditLogsParams{Actor: "bob"}, want: []string{"audit-list-4", "audit-list-3"}},
+		{name: "by resource", params: generated.ListAdminAuditLogsParams{ResourceType: "vm", ResourceId: "vm-1"}, want: []string{"audit-list-4", "audit-list-1", "audit-list-0"}},
+		{name: "by action and actor", params: generated.ListAdminAuditLogsParams{Action: "vm.create", Actor: "alice"}, want: []string{"audit-list-0"}},
+		{name: "by time range", params: generated.ListAdminAuditLogsParams{From: base.Add(time.Minute), To: base.Add(3 * time.Minute)}, want: []string{"audit-list-2", "audit-list-1"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			code, list := listAdmin(tc.params, auditRead)
+			if code != http.StatusOK {
+				t.Fatalf("status = %d, want %d", code, http.StatusOK)
+			}
+			if got := ids(list); fmt.Sprint(got) != fmt.Sprint(tc.want) || list.Pagination.Total != len(tc.want) {
+				t.Fatalf("ids = %v total = %d, want %v", got, list.Pagination.Total, tc.want)
+			}
+		})
+	}
+
+	t.Run("admin endpoint requires audit:read", func(t *testing.T) {
+		if code, _ := listAdmin(generated.ListAdminAuditLogsParams{}, []string{"vm:read"}); code != http.StatusForbidden {
+			t.Fatalf("status = %d, want %d", code, http.StatusForbidden)
+		}
+	})
+
+	t.Run("inverted range is rejected", func(t *testing.T) {
+		code, _ := listAdmin(generated.ListAdminAuditLogsParams{From: base.Add(time.Hour), To: base}, auditRead)
+		if code != http.StatusBadRequest {
+			t.Fatalf("status = %d, want %d", code, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("user endpoint only lists own logs", func(t *testing.T) {
+		for params, want := range map[generated.ListAuditLogsParams][]string{
+			{}:                                    {"audit-list-2", "audit-list-1", "audit-list-0"},
+			{ResourceType: "vm"}:                  {"audit-list-1", "audit-list-0"},
+			{Actor: "bob"}:                        {},
+			{Actor: "alice", PerPage: 1, Page: 2}: {"audit-list-1"},
+		} {
+			c, w := newAuthedGinContext(t, http.MethodGet, "/audit-logs", "", "alice", nil)
+			srv.ListAuditLogs(c, params)
+			if w.Code != http.StatusOK {
+				t.Fatalf("params %+v: status = %d, want %d", params, w.Code, http.StatusOK)
+			}
+			var list generated.AuditLogList
+			mustDecodeJSON(t, w.Body.Bytes(), &list)
+			if got := ids(list); fmt.Sprint(got) != fmt.Sprint(want) {
+				t.Fatalf("params %+v: ids = %v, want %v", params, got, want)
+			}
+		}
+	})
+}
@@ -16,13 +16,15 @@ import (
 	"kv-shepherd.io/shepherd/internal/api/generated"
 	"kv-shepherd.io/shepherd/internal/api/middleware"
 	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/service"
 
 	"kv-shepherd.io/shepherd/ent"
 )
 
 const passwordHashCost = 12
 
-// Login handles POST /auth/login (Stage 1.5).
+// Login handles POST /auth/login (Stage 1.5). Repeated failures lock the
+// username or throttle the source IP; see loginThrottle.
 func (s *Server) Login(c *gin.Context) {
 	var req generated.LoginRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -30,40 +32,64 @@ func (s *Server) Login(c *gin.Context) {
 		return
 	}
 
+	ctx := c.Request.Context()
+	ip := c.ClientIP()
+	lock, err := s.loginThrottle.Check(ctx, req.Username, ip)
+	if err != nil {
+		logger.Error("failed to check login lockout", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if lock != nil {
+		writeLoginLocked(c, lock)
+		return
+	}
+
 	user, err := s.client.User.Query().
 		Where(entuser.UsernameEQ(req.Username)).
 		Where(entuser.EnabledEQ(true)).
-		Only(c.Request.Context())
-	if err != nil {
-		logger.Warn("login failed: invalid credentials")
-		c.JSON(http.StatusUnauthorized, generated.Error{Code: "INVALID_CREDENTIALS"})
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		logger.Error("failed to query user for login", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
 
-	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password)); err != nil {
+	// Unknown users and users without a local password are checked against a
+	// dummy hash so every failure costs one bcrypt comparison and response
+	// timing does not reveal which usernames exist.
+	hash := dummyPasswordHash()
+	if user != nil && user.PasswordHash != "" {
+		hash = []byte(user.PasswordHash)
+	}
+	if err := bcrypt.CompareHashAndPassword(hash, []byte(req.Password)); err != nil || user == nil || user.PasswordHash == "" {
 		logger.Warn("login failed: invalid credentials")
-		c.JSON(http.StatusUnauthorized, generated.Error{Code: "INVALID_CREDENTIALS"})
+		s.recordLoginFailure(c, req.Username, ip, user)
 		return
 	}
 
-	roles, permissions, err := s.loadUserRolesAndPermissions(c.Request.Context(), user.ID)
-	if err != nil {
-		logger.Error("failed to load roles", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
+	if err := s.loginThrottle.Reset(ctx, req.Username); err != nil {
+		logger.Warn("failed to reset login failures", zap.Error(err), zap.String("user_id", user.ID))
 	}
+	s.completeLogin(c, user, nil)
+}
 
-	roleNames := make([]string, len(roles))
-	for i, r := range roles {
-		roleNames[i] = r.Name
+// completeLogin issues a Shepherd JWT and refresh token for an authenticated
+// user, records the login and writes the LoginResponse. Shared by local and
+// SSO login flows.
+func (s *Server) completeLogin(c *gin.Context, user *ent.User, auditDetails map[string]interface{}) {
+	resp, ok := s.issueAccessToken(c, user)
+	if !ok {
+		return
 	}
-
-	token, expiresAt, err := middleware.GenerateToken(s.jwtCfg, user.ID, user.Username, roleNames, permissions)
+	refreshToken, refreshExpiresAt, err := s.refreshTokens.Issue(c.Request.Context(), user.ID)
 	if err != nil {
-		logger.Error("failed to generate token", zap.Error(err))
+		logger.Error("failed to issue refresh token", zap.Error(err), zap.String("user_id", user.ID))
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
+	resp.RefreshToken = refreshToken
+	resp.RefreshTokenExpiresAt = refreshExpiresAt
 
 	now := time.Now()
 	if err := s.client.User.UpdateOneID(user.ID).SetLastLoginAt(now).Exec(c.Request.Context()); err != nil {
@@ -71,7 +97,7 @@ func (s *Server) Login(c *gin.Context) {
 	}
 
 	if s.audit != nil {
-		if err := s.audit.LogAction(c.Request.Context(), "user.login", "user", user.ID, user.ID, nil); err != nil {
+		if err := s.audit.LogAction(c.Request.Context(), "user.login", "user", user.ID, user.ID, auditDetails); err != nil {
 			logger.Warn("audit log write failed",
 				zap.Error(err),
 				zap.String("action", "user.login"),
@@ -80,11 +106,44 @@ func (s *Server) Login(c *gin.Context) {
 		}
 	}
 
-	c.JSON(http.StatusOK, generated.LoginResponse{
+	c.JSON(http.StatusOK, resp)
+}
+
+// issueAccessToken generates a JWT carrying the user's current roles and
+// permissions and records its login session. On failure it writes the error
+// response and returns false.
+func (s *Server) issueAccessToken(c *gin.Context, user *ent.User) (generated.LoginResponse, bool) {
+	roles, permissions, err := s.loadUserRolesAndPermissions(c.Request.Context(), user.ID)
+	if err != nil {
+		logger.Error("failed to load roles", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return generated.LoginResponse{}, false
+	}
+
+	roleNames := make([]string, len(roles))
+	for i, r := range roles {
+		roleNames[i] = r.Name
+	}
+
+	token, claims, err := middleware.GenerateTokenWithClaims(s.jwtCfg, user.ID, user.Username, roleNames, permissions)
+	if err != nil {
+		logger.Error("failed to generate token", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return generated.LoginResponse{}, false
+	}
+	expiresAt := claims.ExpiresAt.Time
+	if err := s.loginSessions.Record(c.Request.Context(), claims.ID, user.ID, expiresAt,
+		c.Request.UserAgent(), c.ClientIP()); err != nil {
+		logger.Error("failed to record login session", zap.Error(err), zap.String("user_id", user.ID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return generated.LoginResponse{}, false
+	}
+
+	return generated.LoginResponse{
 		Token:               token,
 		ExpiresAt:           expiresAt,
 		ForcePasswordChange: user.ForcePasswordChange,
-	})
+	}, true
 }
 
 // GetCurrentUser handles GET /auth/me.
@@ -124,6 +183,8 @@ func (s *Server) GetCurrentUser(c *gin.Context) {
 }
 
 // ChangePassword handles POST /auth/change-password (Stage 1.5 forced password change).
+// Every other login session of the user is revoked; the caller's stays valid
+// until it expires, but all refresh tokens are revoked.
 func (s *Server) ChangePassword(c *gin.Context) {
 	userID := middleware.GetUserID(c.Request.Context())
 	if userID == "" {
@@ -147,6 +208,22 @@ func (s *Server) ChangePassword(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_CURRENT_PASSWORD"})
 		return
 	}
+	if !s.requirePasswordPolicy(c, "new_password", req.NewPassword, user.Username, user.Email) {
+		return
+	}
+	reused, err := s.passwordHistory.Contains(c.Request.Context(), userID, req.NewPassword, user.PasswordHash)
+	if err != nil {
+		logger.Error("failed to check password history", zap.Error(err), zap.String("user_id", userID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if reused {
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:   "PASSWORD_RECENTLY_USED",
+			Params: map[string]interface{}{"history_size": s.passwordPolicy.HistorySize},
+		})
+		return
+	}
 
 	hash, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), passwordHashCost)
 	if err != nil {
@@ -164,10 +241,30 @@ func (s *Server) ChangePassword(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
+	s.recordPasswordHistory(c, userID, string(hash))
+
+	revoked, err := s.loginSessions.RevokeUserSessions(c.Request.Context(), userID, userID,
+		middleware.GetTokenID(c.Request.Context()))
+	if err != nil {
+		logger.Error("failed to revoke login sessions after password change", zap.Error(err), zap.String("user_id", userID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	s.markTokensRevoked(revoked...)
+	revokedRefresh, err := s.refreshTokens.RevokeUser(c.Request.Context(), userID)
+	if err != nil {
+		logger.Error("failed to revoke refresh tokens after password change", zap.Error(err), zap.String("user_id", userID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
 
 	if s.audit != nil {
 		if err := s.audit.LogAction(c.Request.Context(), "user.password_change", "user", userID, userID,
-			map[string]interface{}{"reason": "user_initiated"}); err != nil {
+			map[string]interface{}{
+				"reason":                 "user_initiated",
+				"revoked_sessions":       len(revoked),
+				"revoked_refresh_tokens": revokedRefresh,
+			}); err != nil {
 			logger.Warn("audit log write failed",
 				zap.Error(err),
 				zap.String("action", "user.password_change"),
@@ -179,7 +276,9 @@ func (s *Server) ChangePassword(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
-// loadUserRolesAndPermissions fetches roles and flattened permissions for a user.
+// loadUserRolesAndPermissions fetches the roles of all of a user's bindings
+// and the permissions flattened from the global ones; scoped bindings grant
+// nothing outside their scope.
 func (s *Server) loadUserRolesAndPermissions(ctx context.Context, userID string) ([]*ent.Role, []string, error) {
 	user, err := s.client.User.Query().
 		Where(entuser.IDEQ(userID)).
@@ -197,6 +296,9 @@ func (s *Server) loadUserRolesAndPermissions(ctx context.Context, userID string)
 		if rb.Edges.Role != nil {
 			role := rb.Edges.Role
 			roles = append(roles, role)
+			if !service.IsGlobalRoleBindingScope(rb.ScopeType) {
+				continue
+			}
 			for _, p := range role.Permissions {
 				permSet[p] = struct{}{}
 			}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/service"
+)
+
+// loginLockoutActor attributes lockouts to the login throttle in audit logs.
+const loginLockoutActor = "system:login-throttle"
+
+// dummyPasswordHash is compared against on logins that have no real hash to
+// check, at the same cost as stored passwords.
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	hash, err := bcrypt.GenerateFromPassword([]byte("shepherd-login-timing-equalizer"), passwordHashCost)
+	if err != nil {
+		panic("generate dummy password hash: " + err.Error())
+	}
+	return hash
+})
+
+// recordLoginFailure counts a failed login, audits any lockout it starts
+// and writes the response: 401, or the lockout once it is in force. user is
+// nil when the username matched no enabled user.
+func (s *Server) recordLoginFailure(c *gin.Context, username, ip string, user *ent.User) {
+	ctx := c.Request.Context()
+	failure, err := s.loginThrottle.RecordFailure(ctx, username, ip)
+	if err != nil {
+		logger.Error("failed to record login failure", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil && failure.UserLocked {
+		resourceID := username
+		if user != nil {
+			resourceID = user.ID
+		}
+		if err := s.audit.LogAction(ctx, "user.account_locked", "user", resourceID, loginLockoutActor, map[string]interface{}{
+			"username":     username,
+			"known_user":   user != nil,
+			"ip_address":   ip,
+			"max_failures": s.loginThrottle.Policy().MaxFailures,
+			"locked_until": failure.Lock.LockedUntil.UTC().Format(time.RFC3339),
+		}); err != nil {
+			logger.Warn("audit log write failed", zap.Error(err), zap.String("action", "user.account_locked"))
+		}
+	}
+	if s.audit != nil && failure.IPLocked {
+		if err := s.audit.LogAction(ctx, "auth.ip_locked", "ip", ip, loginLockoutActor, map[string]interface{}{
+			"username":        username,
+			"ip_max_failures": s.loginThrottle.Policy().IPMaxFailures,
+		}); err != nil {
+			logger.Warn("audit log write failed", zap.Error(err), zap.String("action", "auth.ip_locked"))
+		}
+	}
+
+	if failure.Lock != nil {
+		writeLoginLocked(c, failure.Lock)
+		return
+	}
+	c.JSON(http.StatusUnauthorized, generated.Error{Code: "INVALID_CREDENTIALS"})
+}
+
+// writeLoginLocked writes 423 ACCOUNT_LOCKED for a locked username or 429
+// LOGIN_RATE_LIMITED for a throttled source IP.
+func writeLoginLocked(c *gin.Context, lock *service.LoginLock) {
+	retryAfter := int(math.Ceil(time.Until(lock.LockedUntil).Seconds()))
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+	status, code := http.StatusLocked, "ACCOUNT_LOCKED"
+	if lock.IP {
+		status, code = http.StatusTooManyRequests, "LOGIN_RATE_LIMITED"
+	}
+	c.Header("Retry-After", strconv.Itoa(retryAfter))
+	c.JSON(status, generated.Error{
+		Code:    code,
+		Message: "too many failed login attempts",
+		Params: map[string]interface{}{
+			"retry_after_seconds": retryAfter,
+		},
+	})
+}
+
+// UnlockUser handles POST /admin/users/{user_id}/unlock.
+func (s *Server) UnlockUser(c *gin.Context, userId generated.UserID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+
+	user, err := s.client.User.Get(ctx, userId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "USER_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to get user for unlock", zap.Error(err), zap.String("user_id", userId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	wasLocked, err := s.loginThrottle.Unlock(ctx, user.Username)
+	if err != nil {
+		logger.Error("failed to unlock user", zap.Error(err), zap.String("user_id", userId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "user.account_unlocked", "user", userId, actor, map[string]interface{}{
+			"username":   user.Username,
+			"was_locked": wasLocked,
+		})
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/auditlog"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/service"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func attemptLogin(t *testing.T, server *Server, username, password string) *httptest.ResponseRecorder {
+	t.Helper()
+	c, w := newAuthedGinContext(t, http.MethodPost, "/api/v1/auth/login",
+		`{"username":"`+username+`","password":"`+password+`"}`, "", nil)
+	server.Login(c)
+	return w
+}
+
+func newLockoutTestServer(t *testing.T, client *ent.Client) *Server {
+	t.Helper()
+	server, _ := newLoginSessionTestServer(t, client)
+	server.loginThrottle = service.NewLoginThrottle(client, service.LoginLockoutPolicy{
+		MaxFailures: 3, Duration: 15 * time.Minute, IPMaxFailures: 100,
+	})
+	return server
+}
+
+func TestLogin_LocksAccountAfterRepeatedFailures(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	client := testutil.OpenEntPostgres(t, "auth_login_lockout")
+	server := newLockoutTestServer(t, client)
+	mustCreateLocalUser(t, client, "user-1", "alice", "right-password")
+
+	// A success resets the counter.
+	for range 2 {
+		assertStatusAndCode(t, attemptLogin(t, server, "alice", "wrong"), http.StatusUnauthorized, "INVALID_CREDENTIALS")
+	}
+	if w := attemptLogin(t, server, "alice", "right-password"); w.Code != http.StatusOK {
+		t.Fatalf("login status = %d, want 200, body=%s", w.Code, w.Body.String())
+	}
+	for range 2 {
+		assertStatusAndCode(t, attemptLogin(t, server, "alice", "wrong"), http.StatusUnauthorized, "INVALID_CREDENTIALS")
+	}
+
+	w := attemptLogin(t, server, "alice", "wrong")
+	assertStatusAndCode(t, w, http.StatusLocked, "ACCOUNT_LOCKED")
+	if w.Header().Get("Retry-After") == "" {
+		t.Fatal("lockout response missing Retry-After")
+	}
+	var body generated.Error
+	mustDecodeJSON(t, w.Body.Bytes(), &body)
+	if retry, _ := body.Params["retry_after_seconds"].(float64); retry < 890 || retry > 900 {
+		t.Fatalf("retry_after_seconds = %v, want about 900", body.Params["retry_after_seconds"])
+	}
+	// The right password does not get through a lockout.
+	assertStatusAndCode(t, attemptLogin(t, server, "alice", "right-password"), http.StatusLocked, "ACCOUNT_LOCKED")
+
+	entries := client.AuditLog.Query().Where(auditlog.ActionEQ("user.account_locked")).AllX(t.Context())
+	if len(entries) != 1 || entries[0].ResourceID != "user-1" {
+		t.Fatalf("user.account_locked audit entries = %+v, want one for user-1", entries)
+	}
+
+	// Once the lockout expires, login works again.
+	client.LoginThrottle.UpdateOneID("user:alice").SetLockedUntil(time.Now().Add(-time.Second)).ExecX(t.Context())
+	if w := attemptLogin(t, server, "alice", "right-password"); w.Code != http.StatusOK {
+		t.Fatalf("login after expiry status = %d, want 200, body=%s", w.Code, w.Body.String())
+	}
+}
+
+func TestLogin_UnknownUsernameLocksLikeKnownOne(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	client := testutil.OpenEntPostgres(t, "auth_login_lockout_unknown")
+	server := newLockoutTestServer(t, client)
+
+	for range 2 {
+		assertStatusAndCode(t, attemptLogin(t, server, "ghost", "guess"), http.StatusUnauthorized, "INVALID_CREDENTIALS")
+	}
+	assertStatusAndCode(t, attemptLogin(t, server, "ghost", "guess"), http.StatusLocked, "ACCOUNT_LOCKED")
+}
+
+func TestUnlockUser(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	client := testutil.OpenEntPostgres(t, "admin_unlock_user")
+	server := newLockoutTestServer(t, client)
+	mustCreateLocalUser(t, client, "user-1", "alice", "right-password")
+	for range 3 {
+		attemptLogin(t, server, "alice", "wrong")
+	}
+	assertStatusAndCode(t, attemptLogin(t, server, "alice", "right-password"), http.StatusLocked, "ACCOUNT_LOCKED")
+
+	c, w := newAuthedGinContext(t, http.MethodPost, "/api/v1/admin/users/user-1/unlock", "", "admin-1", []string{"user:manage"})
+	server.UnlockUser(c, "user-1")
+	assertStatusAndCode(t, w, http.StatusForbidden, "FORBIDDEN")
+
+	c, w = newAuthedGinContext(t, http.MethodPost, "/api/v1/admin/users/missing/unlock", "", "admin-1", []string{"platform:admin"})
+	server.UnlockUser(c, "missing")
+	assertStatusAndCode(t, w, http.StatusNotFound, "USER_NOT_FOUND")
+
+	c, w = newAuthedGinContext(t, http.MethodPost, "/api/v1/admin/users/user-1/unlock", "", "admin-1", []string{"platform:admin"})
+	server.UnlockUser(c, "user-1")
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("unlock status = %d, want 204, body=%s", w.Code, w.Body.String())
+	}
+	if w := attemptLogin(t, server, "alice", "right-password"); w.Code != http.StatusOK {
+		t.Fatalf("login after unlock status = %d, want 200, body=%s", w.Code, w.Body.String())
+	}
+
+	entry := client.AuditLog.Query().Where(auditlog.ActionEQ("user.account_unlocked")).OnlyX(t.Context())
+	if entry.ResourceID != "user-1" || entry.Actor != "admin-1" {
+		t.Fatalf("unlock audit = %+v, want user-1 unlocked by admin-1", entry)
+	}
+}
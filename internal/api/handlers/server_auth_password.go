@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// GetPasswordPolicy handles GET /auth/password-policy.
+func (s *Server) GetPasswordPolicy(c *gin.Context) {
+	p := s.passwordPolicy
+	c.JSON(http.StatusOK, generated.PasswordPolicy{
+		Mode:             generated.PasswordPolicyMode(p.Mode),
+		MinLength:        p.MinLength,
+		MaxLength:        p.MaxLength,
+		RequireUppercase: p.RequireUppercase,
+		RequireLowercase: p.RequireLowercase,
+		RequireDigit:     p.RequireDigit,
+		RequireSpecial:   p.RequireSpecial,
+		RejectUserInfo:   p.RejectUserInfo,
+		HistorySize:      p.HistorySize,
+	})
+}
+
+// requirePasswordPolicy writes 400 PASSWORD_POLICY_VIOLATION, with one field
+// error on field per failed rule, unless password satisfies the policy.
+func (s *Server) requirePasswordPolicy(c *gin.Context, field, password, username, email string) bool {
+	violations := s.passwordPolicy.Validate(password, username, email)
+	if len(violations) == 0 {
+		return true
+	}
+	fieldErrors := make([]generated.FieldError, 0, len(violations))
+	for _, v := range violations {
+		fieldErrors = append(fieldErrors, generated.FieldError{Field: field, Code: v.Code, Message: v.Message})
+	}
+	c.JSON(http.StatusBadRequest, generated.Error{
+		Code:        "PASSWORD_POLICY_VIOLATION",
+		Message:     "password does not satisfy the password policy",
+		FieldErrors: fieldErrors,
+	})
+	return false
+}
+
+// recordPasswordHistory remembers a newly set password hash. The password is
+// already saved, so a failure is logged rather than returned.
+func (s *Server) recordPasswordHistory(c *gin.Context, userID, hash string) {
+	if err := s.passwordHistory.Record(c.Request.Context(), userID, hash); err != nil {
+		logger.Warn("failed to record password history", zap.Error(err), zap.String("user_id", userID))
+	}
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/service"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestGetPasswordPolicy(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	srv := NewServer(ServerDeps{PasswordPolicy: &service.PasswordPolicy{
+		Mode:         service.PasswordModeLegacy,
+		MinLength:    12,
+		RequireDigit: true,
+	}})
+	c, w := newAuthedGinContext(t, http.MethodGet, "/api/v1/auth/password-policy", "", "user-1", nil)
+	srv.GetPasswordPolicy(c)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200, body=%s", w.Code, w.Body.String())
+	}
+	var policy generated.PasswordPolicy
+	mustDecodeJSON(t, w.Body.Bytes(), &policy)
+	if policy.Mode != generated.Legacy || policy.MinLength != 12 || !policy.RequireDigit ||
+		policy.MaxLength != service.DefaultPasswordMaxLength || policy.HistorySize != service.DefaultPasswordHistorySize {
+		t.Fatalf("policy = %+v", policy)
+	}
+}
+
+func TestChangePassword_EnforcesPolicyAndHistory(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	client := testutil.OpenEntPostgres(t, "auth_change_password_policy")
+	server, _ := newLoginSessionTestServer(t, client)
+	mustCreateLocalUser(t, client, "user-1", "alice", "first-passphrase")
+
+	changePassword := func(oldPassword, newPassword string) *generated.Error {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodPost, "/api/v1/auth/change-password",
+			`{"old_password":"`+oldPassword+`","new_password":"`+newPassword+`"}`, "user-1", nil)
+		server.ChangePassword(c)
+		if w.Code == http.StatusNoContent {
+			return nil
+		}
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("change password status = %d, body=%s", w.Code, w.Body.String())
+		}
+		var apiErr generated.Error
+		mustDecodeJSON(t, w.Body.Bytes(), &apiErr)
+		return &apiErr
+	}
+
+	apiErr := changePassword("first-passphrase", "alice-2026")
+	if apiErr == nil || apiErr.Code != "PASSWORD_POLICY_VIOLATION" {
+		t.Fatalf("weak password error = %+v, want PASSWORD_POLICY_VIOLATION", apiErr)
+	}
+	if len(apiErr.FieldErrors) != 1 || apiErr.FieldErrors[0].Field != "new_password" || apiErr.FieldErrors[0].Code != service.PasswordRuleContainsUsername {
+		t.Fatalf("field errors = %+v, want contains_username on new_password", apiErr.FieldErrors)
+	}
+
+	// The current password predates the history but still counts.
+	if apiErr = changePassword("first-passphrase", "first-passphrase"); apiErr == nil || apiErr.Code != "PASSWORD_RECENTLY_USED" {
+		t.Fatalf("reused current password error = %+v, want PASSWORD_RECENTLY_USED", apiErr)
+	}
+	if apiErr = changePassword("first-passphrase", "second-passphrase"); apiErr != nil {
+		t.Fatalf("valid change error = %+v", apiErr)
+	}
+	if apiErr = changePassword("second-passphrase", "third-passphrase"); apiErr != nil {
+		t.Fatalf("valid change error = %+v", apiErr)
+	}
+	if apiErr = changePassword("third-passphrase", "second-passphrase"); apiErr == nil || apiErr.Code != "PASSWORD_RECENTLY_USED" {
+		t.Fatalf("reused password error = %+v, want PASSWORD_RECENTLY_USED", apiErr)
+	}
+}
+
+func TestCreateUser_EnforcesPasswordPolicy(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	client := testutil.OpenEntPostgres(t, "admin_create_user_password_policy")
+	srv := NewServer(ServerDeps{EntClient: client})
+
+	c, w := newAuthedGinContext(t, http.MethodPost, "/api/v1/admin/users",
+		`{"username":"bob","password":"password123"}`, "admin-1", []string{"user:manage"})
+	srv.CreateUser(c)
+	assertStatusAndCode(t, w, http.StatusBadRequest, "PASSWORD_POLICY_VIOLATION")
+
+	c, w = newAuthedGinContext(t, http.MethodPost, "/api/v1/admin/users",
+		`{"username":"bob","password":"bob-is-strong-now"}`, "admin-1", []string{"user:manage"})
+	srv.CreateUser(c)
+	assertStatusAndCode(t, w, http.StatusBadRequest, "PASSWORD_POLICY_VIOLATION")
+
+	c, w = newAuthedGinContext(t, http.MethodPost, "/api/v1/admin/users",
+		`{"username":"bob","password":"river-stone-lantern"}`, "admin-1", []string{"user:manage"})
+	srv.CreateUser(c)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create user status = %d, want 201, body=%s", w.Code, w.Body.String())
+	}
+	if n := client.PasswordHistory.Query().CountX(t.Context()); n != 1 {
+		t.Fatalf("password history rows = %d, want 1", n)
+	}
+}
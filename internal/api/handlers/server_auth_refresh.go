@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/service"
+)
+
+// RefreshToken handles POST /auth/refresh. The refresh token is consumed and
+// replaced; the new access token carries the user's current permissions.
+func (s *Server) RefreshToken(c *gin.Context) {
+	var req generated.RefreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil || strings.TrimSpace(req.RefreshToken) == "" {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID, refreshToken, refreshExpiresAt, err := s.refreshTokens.Rotate(ctx, req.RefreshToken)
+	if err != nil {
+		if errors.Is(err, service.ErrRefreshTokenInvalid) {
+			c.JSON(http.StatusUnauthorized, generated.Error{Code: "INVALID_REFRESH_TOKEN"})
+			return
+		}
+		logger.Error("failed to rotate refresh token", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	user, err := s.client.User.Get(ctx, userID)
+	if err != nil && !ent.IsNotFound(err) {
+		logger.Error("failed to get user for token refresh", zap.Error(err), zap.String("user_id", userID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if user == nil || !user.Enabled {
+		c.JSON(http.StatusUnauthorized, generated.Error{Code: "INVALID_REFRESH_TOKEN"})
+		return
+	}
+
+	resp, ok := s.issueAccessToken(c, user)
+	if !ok {
+		return
+	}
+	resp.RefreshToken = refreshToken
+	resp.RefreshTokenExpiresAt = refreshExpiresAt
+
+	if s.audit != nil {
+		if err := s.audit.LogAction(ctx, "user.token_refresh", "user", user.ID, user.ID, nil); err != nil {
+			logger.Warn("audit log write failed",
+				zap.Error(err),
+				zap.String("action", "user.token_refresh"),
+				zap.String("user_id", user.ID),
+			)
+		}
+	}
+	c.JSON(http.StatusOK, resp)
+}
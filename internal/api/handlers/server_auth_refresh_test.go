@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/ent/auditlog"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/service"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+// mustLoginForRefreshToken logs in and returns the issued refresh token.
+func mustLoginForRefreshToken(t *testing.T, server *Server, username, password string) string {
+	t.Helper()
+	c, w := newAuthedGinContext(t, http.MethodPost, "/api/v1/auth/login",
+		`{"username":"`+username+`","password":"`+password+`"}`, "", nil)
+	server.Login(c)
+	if w.Code != http.StatusOK {
+		t.Fatalf("login status = %d, want 200, body=%s", w.Code, w.Body.String())
+	}
+	var resp generated.LoginResponse
+	mustDecodeJSON(t, w.Body.Bytes(), &resp)
+	if resp.RefreshToken == "" || resp.RefreshTokenExpiresAt.Before(time.Now().Add(service.DefaultRefreshTokenTTL-time.Minute)) {
+		t.Fatalf("login refresh token = %q expiring %s, want one valid for 7 days", resp.RefreshToken, resp.RefreshTokenExpiresAt)
+	}
+	return resp.RefreshToken
+}
+
+func refreshWith(t *testing.T, server *Server, refreshToken string) (int, generated.LoginResponse, string) {
+	t.Helper()
+	c, w := newAuthedGinContext(t, http.MethodPost, "/api/v1/auth/refresh",
+		mustJSON(t, generated.RefreshTokenRequest{RefreshToken: refreshToken}), "", nil)
+	server.RefreshToken(c)
+	var resp generated.LoginResponse
+	var apiErr generated.Error
+	if w.Code == http.StatusOK {
+		mustDecodeJSON(t, w.Body.Bytes(), &resp)
+	} else {
+		mustDecodeJSON(t, w.Body.Bytes(), &apiErr)
+	}
+	return w.Code, resp, apiErr.Code
+}
+
+func TestRefreshToken_RotatesOneTimeTokens(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	client := testutil.OpenEntPostgres(t, "auth_refresh_rotate")
+	server, jwtCfg := newLoginSessionTestServer(t, client)
+	mustCreateLocalUser(t, client, "user-1", "alice", "secret-pass")
+	first := mustLoginForRefreshToken(t, server, "alice", "secret-pass")
+
+	status, refreshed, _ := refreshWith(t, server, first)
+	if status != http.StatusOK || refreshed.RefreshToken == "" || refreshed.RefreshToken == first {
+		t.Fatalf("refresh status = %d, refresh token rotated = %v", status, refreshed.RefreshToken != first)
+	}
+	claims, err := jwtCfg.ValidateToken(t.Context(), refreshed.Token)
+	if err != nil || claims.UserID != "user-1" {
+		t.Fatalf("refreshed access token claims = %+v, err %v; want a valid token for user-1", claims, err)
+	}
+	// The new access token is a login session like any other.
+	if session, err := client.LoginSession.Get(t.Context(), claims.ID); err != nil || session.UserID != "user-1" {
+		t.Fatalf("refreshed access token session = %+v, err %v; want one for user-1", session, err)
+	}
+
+	if status, _, code := refreshWith(t, server, first); status != http.StatusUnauthorized || code != "INVALID_REFRESH_TOKEN" {
+		t.Fatalf("reused refresh token status = %d code = %s, want 401 INVALID_REFRESH_TOKEN", status, code)
+	}
+	if status, _, _ := refreshWith(t, server, refreshed.RefreshToken); status != http.StatusOK {
+		t.Fatalf("rotated refresh token status = %d, want 200", status)
+	}
+	if n := client.AuditLog.Query().Where(auditlog.ActionEQ("user.token_refresh")).CountX(t.Context()); n != 2 {
+		t.Fatalf("user.token_refresh audit entries = %d, want 2", n)
+	}
+
+	c, w := newAuthedGinContext(t, http.MethodPost, "/api/v1/auth/refresh", `{"refresh_token":""}`, "", nil)
+	server.RefreshToken(c)
+	assertStatusAndCode(t, w, http.StatusBadRequest, "INVALID_REQUEST")
+}
+
+func TestRefreshToken_RejectsExpiredAndDisabledUser(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	client := testutil.OpenEntPostgres(t, "auth_refresh_expiry")
+	server, _ := newLoginSessionTestServer(t, client)
+	mustCreateLocalUser(t, client, "user-1", "alice", "secret-pass")
+
+	client.RefreshToken.Create().
+		SetID("refresh-expired").
+		SetUserID("user-1").
+		SetTokenHash(service.HashRefreshToken("expired-token")).
+		SetExpiresAt(time.Now().Add(-time.Minute)).
+		ExecX(t.Context())
+	if status, _, code := refreshWith(t, server, "expired-token"); status != http.StatusUnauthorized || code != "INVALID_REFRESH_TOKEN" {
+		t.Fatalf("expired refresh token status = %d code = %s, want 401 INVALID_REFRESH_TOKEN", status, code)
+	}
+	if status, _, code := refreshWith(t, server, "never-issued"); status != http.StatusUnauthorized || code != "INVALID_REFRESH_TOKEN" {
+		t.Fatalf("unknown refresh token status = %d code = %s, want 401 INVALID_REFRESH_TOKEN", status, code)
+	}
+
+	token := mustLoginForRefreshToken(t, server, "alice", "secret-pass")
+	client.User.UpdateOneID("user-1").SetEnabled(false).ExecX(t.Context())
+	if status, _, code := refreshWith(t, server, token); status != http.StatusUnauthorized || code != "INVALID_REFRESH_TOKEN" {
+		t.Fatalf("disabled user refresh status = %d code = %s, want 401 INVALID_REFRESH_TOKEN", status, code)
+	}
+}
+
+func TestRefreshToken_RevokedByPasswordChangeAndReset(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	client := testutil.OpenEntPostgres(t, "auth_refresh_revocation")
+	server, jwtCfg := newLoginSessionTestServer(t, client)
+	mustCreateLocalUser(t, client, "user-1", "alice", "secret-pass")
+	mustCreateLocalUser(t, client, "user-2", "bob", "secret-pass")
+
+	laptop := mustLoginForRefreshToken(t, server, "alice", "secret-pass")
+	bobs := mustLoginForRefreshToken(t, server, "bob", "secret-pass")
+	current := mustLogin(t, server, jwtCfg, "alice", "secret-pass", "phone-browser")
+
+	c, w := newSessionGinContext(t, http.MethodPost, "/api/v1/auth/change-password",
+		`{"old_password":"secret-pass","new_password":"Another-Secret-Pass-42"}`, "user-1", current, nil)
+	server.ChangePassword(c)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("change password status = %d, want 204, body=%s", w.Code, w.Body.String())
+	}
+	if status, _, code := refreshWith(t, server, laptop); status != http.StatusUnauthorized || code != "INVALID_REFRESH_TOKEN" {
+		t.Fatalf("refresh after password change status = %d code = %s, want 401 INVALID_REFRESH_TOKEN", status, code)
+	}
+	if status, _, _ := refreshWith(t, server, bobs); status != http.StatusOK {
+		t.Fatalf("other user's refresh after password change status = %d, want 200", status)
+	}
+
+	reset := mustLoginForRefreshToken(t, server, "alice", "Another-Secret-Pass-42")
+	c, w = newAuthedGinContext(t, http.MethodPatch, "/api/v1/admin/users/user-1/force-password-reset", "", "admin-1", []string{"user:manage"})
+	server.ForceUserPasswordReset(c, "user-1")
+	if w.Code != http.StatusOK {
+		t.Fatalf("force reset status = %d, want 200, body=%s", w.Code, w.Body.String())
+	}
+	if status, _, code := refreshWith(t, server, reset); status != http.StatusUnauthorized || code != "INVALID_REFRESH_TOKEN" {
+		t.Fatalf("refresh after forced reset status = %d code = %s, want 401 INVALID_REFRESH_TOKEN", status, code)
+	}
+}
@@ -0,0 +1,209 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/idpgroupmapping"
+	"kv-shepherd.io/shepherd/ent/role"
+	"kv-shepherd.io/shepherd/ent/rolebinding"
+	entuser "kv-shepherd.io/shepherd/ent/user"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	providerregistry "kv-shepherd.io/shepherd/internal/provider"
+)
+
+// SamlAssertionConsumer handles POST /auth/saml/{provider_id}/acs.
+// A verified assertion is exchanged for the same JWT a local login returns.
+func (s *Server) SamlAssertionConsumer(c *gin.Context, providerId generated.ProviderID) {
+	ctx := c.Request.Context()
+
+	provider, err := s.client.AuthProvider.Get(ctx, providerId)
+	if err != nil || !provider.Enabled || provider.AuthType != providerregistry.SAMLAuthType {
+		if err != nil && !ent.IsNotFound(err) {
+			logger.Error("failed to load saml auth provider", zap.Error(err), zap.String("provider_id", providerId))
+			c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+			return
+		}
+		c.JSON(http.StatusNotFound, generated.Error{Code: "AUTH_PROVIDER_NOT_FOUND"})
+		return
+	}
+
+	identity, err := providerregistry.AuthenticateSAMLResponse(provider.Config, c.Request, samlACSURL(c))
+	if err != nil {
+		logger.Warn("saml login failed", zap.Error(err), zap.String("provider_id", providerId))
+		c.JSON(http.StatusUnauthorized, generated.Error{Code: "INVALID_CREDENTIALS"})
+		return
+	}
+
+	user, err := s.provisionExternalUser(ctx, provider.ID, identity)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			c.JSON(http.StatusConflict, generated.Error{
+				Code:    "USER_CONFLICT",
+				Message: "username or email already belongs to another account",
+			})
+			return
+		}
+		logger.Error("failed to provision saml user", zap.Error(err), zap.String("provider_id", providerId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if !user.Enabled {
+		logger.Warn("saml login rejected: user disabled", zap.String("user_id", user.ID))
+		c.JSON(http.StatusUnauthorized, generated.Error{Code: "INVALID_CREDENTIALS"})
+		return
+	}
+
+	if err := applyIdPGroupMappings(ctx, s.client, provider.ID, user.ID, identity.Groups); err != nil {
+		logger.Warn("failed to apply idp group mappings",
+			zap.Error(err),
+			zap.String("provider_id", providerId),
+			zap.String("user_id", user.ID),
+		)
+	}
+
+	s.invalidatePermissions(user.ID)
+
+	s.completeLogin(c, user, map[string]interface{}{
+		"auth_provider_id": provider.ID,
+		"auth_type":        provider.AuthType,
+	})
+}
+
+// provisionExternalUser finds the user linked to (providerID, external_id) or
+// creates it on first login. Profile attributes are refreshed on every login.
+func (s *Server) provisionExternalUser(ctx context.Context, providerID string, identity *providerregistry.AuthResult) (*ent.User, error) {
+	user, created, err := upsertExternalUser(ctx, s.client, providerID, identity)
+	if err != nil {
+		return nil, err
+	}
+	if created && s.audit != nil {
+		_ = s.audit.LogAction(ctx, "user.provisioned", "user", user.ID, "system", map[string]interface{}{
+			"auth_provider_id": providerID,
+			"username":         user.Username,
+		})
+	}
+	return user, nil
+}
+
+// upsertExternalUser refreshes the email and display name of the user linked
+// to (providerID, external_id), or creates that user. created reports which.
+func upsertExternalUser(ctx context.Context, client *ent.Client, providerID string, identity *providerregistry.AuthResult) (user *ent.User, created bool, err error) {
+	existing, err := client.User.Query().
+		Where(
+			entuser.AuthProviderIDEQ(providerID),
+			entuser.ExternalIDEQ(identity.ExternalID),
+		).
+		Only(ctx)
+	switch {
+	case err == nil:
+		update := existing.Update()
+		if identity.Email != "" {
+			update = update.SetEmail(identity.Email)
+		}
+		if identity.DisplayName != "" {
+			update = update.SetDisplayName(identity.DisplayName)
+		}
+		user, err = update.Save(ctx)
+		return user, false, err
+	case !ent.IsNotFound(err):
+		return nil, false, fmt.Errorf("query external user: %w", err)
+	}
+
+	create := client.User.Create().
+		SetID(GenerateUserID()).
+		SetUsername(identity.Username).
+		SetAuthProviderID(providerID).
+		SetExternalID(identity.ExternalID)
+	if identity.Email != "" {
+		create = create.SetEmail(identity.Email)
+	}
+	if identity.DisplayName != "" {
+		create = create.SetDisplayName(identity.DisplayName)
+	}
+	user, err = create.Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return user, true, nil
+}
+
+// applyIdPGroupMappings grants the role bindings mapped to the user's IdP
+// groups. Existing bindings are left untouched; nothing is revoked here.
+func applyIdPGroupMappings(ctx context.Context, client *ent.Client, providerID, userID string, groups []string) error {
+	if len(groups) == 0 {
+		return nil
+	}
+	mappings, err := client.IdPGroupMapping.Query().
+		Where(
+			idpgroupmapping.ProviderIDEQ(providerID),
+			idpgroupmapping.ExternalGroupIDIn(groups...),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query group mappings: %w", err)
+	}
+
+	for _, m := range mappings {
+		scopeType := m.ScopeType
+		if scopeType == "" {
+			scopeType = "global"
+		}
+		dupQuery := client.RoleBinding.Query().Where(
+			rolebinding.HasUserWith(entuser.IDEQ(userID)),
+			rolebinding.HasRoleWith(role.IDEQ(m.RoleID)),
+			rolebinding.ScopeTypeEQ(scopeType),
+		)
+		if m.ScopeID == "" {
+			dupQuery = dupQuery.Where(rolebinding.ScopeIDIsNil())
+		} else {
+			dupQuery = dupQuery.Where(rolebinding.ScopeIDEQ(m.ScopeID))
+		}
+		bound, err := dupQuery.Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("check role binding for mapping %s: %w", m.ID, err)
+		}
+		if bound {
+			continue
+		}
+		id, _ := uuid.NewV7()
+		create := client.RoleBinding.Create().
+			SetID(id.String()).
+			SetUserID(userID).
+			SetRoleID(m.RoleID).
+			SetScopeType(scopeType).
+			SetCreatedBy("idp:" + providerID)
+		if m.ScopeID != "" {
+			create = create.SetScopeID(m.ScopeID)
+		}
+		if len(m.AllowedEnvironments) > 0 {
+			create = create.SetAllowedEnvironments(slices.Clone(m.AllowedEnvironments))
+		}
+		if _, err := create.Save(ctx); err != nil {
+			return fmt.Errorf("create role binding for mapping %s: %w", m.ID, err)
+		}
+	}
+	return nil
+}
+
+// samlACSURL reconstructs the externally visible ACS URL of this request,
+// honoring X-Forwarded-Proto from a TLS-terminating proxy. Providers behind
+// rewriting proxies should pin acs_url in config instead.
+func samlACSURL(c *gin.Context) string {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if proto := c.GetHeader("X-Forwarded-Proto"); proto == "http" || proto == "https" {
+		scheme = proto
+	}
+	return scheme + "://" + c.Request.Host + c.Request.URL.Path
+}
@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/api/middleware"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// ListLoginSessions handles GET /auth/sessions.
+func (s *Server) ListLoginSessions(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := middleware.GetUserID(ctx)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, generated.Error{Code: "UNAUTHORIZED"})
+		return
+	}
+
+	sessions, err := s.loginSessions.ListActive(ctx, userID)
+	if err != nil {
+		logger.Error("failed to list login sessions", zap.Error(err), zap.String("user_id", userID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	currentID := middleware.GetTokenID(ctx)
+	items := make([]generated.LoginSession, 0, len(sessions))
+	for _, session := range sessions {
+		items = append(items, loginSessionToAPI(session, currentID))
+	}
+	c.JSON(http.StatusOK, generated.LoginSessionList{Items: items})
+}
+
+// RevokeLoginSession handles DELETE /auth/sessions/{session_id}. Only the
+// caller's own sessions are visible; others are reported as not found.
+func (s *Server) RevokeLoginSession(c *gin.Context, sessionId generated.LoginSessionID) {
+	ctx := c.Request.Context()
+	userID := middleware.GetUserID(ctx)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, generated.Error{Code: "UNAUTHORIZED"})
+		return
+	}
+
+	found, err := s.loginSessions.Revoke(ctx, userID, sessionId, userID)
+	if err != nil {
+		logger.Error("failed to revoke login session", zap.Error(err), zap.String("session_id", sessionId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if !found {
+		c.JSON(http.StatusNotFound, generated.Error{Code: "LOGIN_SESSION_NOT_FOUND"})
+		return
+	}
+	s.markTokensRevoked(sessionId)
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "auth.session_revoked", "user", userID, userID, map[string]interface{}{
+			"session_id": sessionId,
+		})
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// RevokeUserSessions handles POST /admin/users/{user_id}/revoke-sessions.
+func (s *Server) RevokeUserSessions(c *gin.Context, userId generated.UserID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "user:manage")
+	if !ok {
+		return
+	}
+
+	if _, err := s.client.User.Get(ctx, userId); err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "USER_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to get user for session revocation", zap.Error(err), zap.String("user_id", userId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	revoked, err := s.loginSessions.RevokeUserSessions(ctx, userId, actor, "")
+	if err != nil {
+		logger.Error("failed to revoke user sessions", zap.Error(err), zap.String("user_id", userId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	s.markTokensRevoked(revoked...)
+	revokedRefresh, err := s.refreshTokens.RevokeUser(ctx, userId)
+	if err != nil {
+		logger.Error("failed to revoke refresh tokens", zap.Error(err), zap.String("user_id", userId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "user.sessions_revoked", "user", userId, actor, map[string]interface{}{
+			"revoked":                len(revoked),
+			"revoked_refresh_tokens": revokedRefresh,
+		})
+	}
+	c.JSON(http.StatusOK, generated.SessionRevocationResult{Revoked: len(revoked)})
+}
+
+// markTokensRevoked makes revocations effective on this replica at once
+// instead of after the revocation cache TTL.
+func (s *Server) markTokensRevoked(tokenIDs ...string) {
+	if len(tokenIDs) == 0 {
+		return
+	}
+	if cache, ok := s.jwtCfg.RevocationChecker.(*middleware.CachedRevocationChecker); ok {
+		cache.MarkRevoked(tokenIDs...)
+	}
+}
+
+// markUserTokensRevoked is markTokensRevoked for a user-wide revocation.
+func (s *Server) markUserTokensRevoked(userID string, before time.Time) {
+	if cache, ok := s.jwtCfg.RevocationChecker.(*middleware.CachedRevocationChecker); ok {
+		cache.MarkUserRevoked(userID, before)
+	}
+}
+
+func loginSessionToAPI(session *ent.LoginSession, currentID string) generated.LoginSession {
+	return generated.LoginSession{
+		Id:        session.ID,
+		CreatedAt: session.CreatedAt,
+		ExpiresAt: session.ExpiresAt,
+		UserAgent: session.UserAgent,
+		IpAddress: session.IPAddress,
+		Current:   session.ID == currentID,
+	}
+}
@@ -0,0 +1,278 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/auditlog"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/api/middleware"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/service"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func newLoginSessionTestServer(t *testing.T, client *ent.Client) (*Server, middleware.JWTConfig) {
+	t.Helper()
+	jwtCfg := middleware.JWTConfig{
+		SigningKey: []byte("login-session-test-signing-key-0123456789"),
+		Issuer:     "shepherd",
+		ExpiresIn:  time.Hour,
+		RevocationChecker: middleware.NewCachedRevocationChecker(
+			service.NewLoginSessionStore(client), time.Hour),
+	}
+	return NewServer(ServerDeps{EntClient: client, JWTCfg: jwtCfg, Audit: audit.NewLogger(client)}), jwtCfg
+}
+
+func mustCreateLocalUser(t *testing.T, client *ent.Client, id, username, password string) {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("hash password: %v", err)
+	}
+	if _, err := client.User.Create().
+		SetID(id).
+		SetUsername(username).
+		SetPasswordHash(string(hash)).
+		SetEnabled(true).
+		Save(t.Context()); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+}
+
+// mustLogin logs in and returns the issued token ID.
+func mustLogin(t *testing.T, server *Server, jwtCfg middleware.JWTConfig, username, password, userAgent string) string {
+	t.Helper()
+	c, w := newAuthedGinContext(t, http.MethodPost, "/api/v1/auth/login",
+		`{"username":"`+username+`","password":"`+password+`"}`, "", nil)
+	c.Request.Header.Set("User-Agent", userAgent)
+	server.Login(c)
+	if w.Code != http.StatusOK {
+		t.Fatalf("login status = %d, want 200, body=%s", w.Code, w.Body.String())
+	}
+	var resp generated.LoginResponse
+	mustDecodeJSON(t, w.Body.Bytes(), &resp)
+	claims, err := jwtCfg.ValidateToken(t.Context(), resp.Token)
+	if err != nil {
+		t.Fatalf("validate issued token: %v", err)
+	}
+	return claims.ID
+}
+
+// newSessionGinContext is newAuthedGinContext for a request made with the
+// token tokenID.
+func newSessionGinContext(t *testing.T, method, target, body, userID, tokenID string, permissions []string) (*gin.Context, *httptest.ResponseRecorder) {
+	t.Helper()
+	c, w := newAuthedGinContext(t, method, target, body, userID, permissions)
+	c.Request = c.Request.WithContext(middleware.SetTokenID(c.Request.Context(), tokenID))
+	return c, w
+}
+
+func TestLoginSessions_ListAndRevoke(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	client := testutil.OpenEntPostgres(t, "auth_login_sessions")
+	server, jwtCfg := newLoginSessionTestServer(t, client)
+	mustCreateLocalUser(t, client, "user-1", "alice", "secret-pass")
+	mustCreateLocalUser(t, client, "user-2", "bob", "secret-pass")
+
+	laptop := mustLogin(t, server, jwtCfg, "alice", "secret-pass", "laptop-browser")
+	phone := mustLogin(t, server, jwtCfg, "alice", "secret-pass", "phone-browser")
+	other := mustLogin(t, server, jwtCfg, "bob", "secret-pass", "bob-browser")
+
+	c, w := newSessionGinContext(t, http.MethodGet, "/api/v1/auth/sessions", "", "user-1", laptop, nil)
+	server.ListLoginSessions(c)
+	if w.Code != http.StatusOK {
+		t.Fatalf("list status = %d, want 200, body=%s", w.Code, w.Body.String())
+	}
+	var list generated.LoginSessionList
+	mustDecodeJSON(t, w.Body.Bytes(), &list)
+	if len(list.Items) != 2 {
+		t.Fatalf("list items = %+v, want alice's two sessions", list.Items)
+	}
+	for _, item := range list.Items {
+		switch item.Id {
+		case laptop:
+			if !item.Current || item.UserAgent != "laptop-browser" {
+				t.Fatalf("laptop session = %+v, want current with its user agent", item)
+			}
+		case phone:
+			if item.Current || item.UserAgent != "phone-browser" {
+				t.Fatalf("phone session = %+v, want not current with its user agent", item)
+			}
+		default:
+			t.Fatalf("unexpected session %s in alice's list", item.Id)
+		}
+	}
+
+	c, w = newSessionGinContext(t, http.MethodDelete, "/api/v1/auth/sessions/"+other, "", "user-1", laptop, nil)
+	server.RevokeLoginSession(c, other)
+	assertStatusAndCode(t, w, http.StatusNotFound, "LOGIN_SESSION_NOT_FOUND")
+
+	c, w = newSessionGinContext(t, http.MethodDelete, "/api/v1/auth/sessions/"+phone, "", "user-1", laptop, nil)
+	server.RevokeLoginSession(c, phone)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("revoke status = %d, want 204, body=%s", w.Code, w.Body.String())
+	}
+	// The cache TTL is an hour: the rejection comes from MarkRevoked.
+	if revoked, err := jwtCfg.RevocationChecker.IsRevoked(t.Context(), phone); err != nil || !revoked {
+		t.Fatalf("phone session revoked = %v (err %v), want revoked immediately", revoked, err)
+	}
+	if revoked, _ := jwtCfg.RevocationChecker.IsRevoked(t.Context(), laptop); revoked {
+		t.Fatal("laptop session revoked, want untouched")
+	}
+	if n := client.AuditLog.Query().Where(auditlog.ActionEQ("auth.session_revoked")).CountX(t.Context()); n != 1 {
+		t.Fatalf("auth.session_revoked audit entries = %d, want 1", n)
+	}
+}
+
+func TestChangePassword_RevokesOtherSessions(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	client := testutil.OpenEntPostgres(t, "auth_change_password_sessions")
+	server, jwtCfg := newLoginSessionTestServer(t, client)
+	mustCreateLocalUser(t, client, "user-1", "alice", "old-password")
+
+	current := mustLogin(t, server, jwtCfg, "alice", "old-password", "laptop")
+	stale := mustLogin(t, server, jwtCfg, "alice", "old-password", "phone")
+
+	c, w := newSessionGinContext(t, http.MethodPost, "/api/v1/auth/change-password",
+		`{"old_password":"old-password","new_password":"new-password-123"}`, "user-1", current, nil)
+	server.ChangePassword(c)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("change password status = %d, want 204, body=%s", w.Code, w.Body.String())
+	}
+
+	if revoked, _ := jwtCfg.RevocationChecker.IsRevoked(t.Context(), stale); !revoked {
+		t.Fatal("other session still valid after password change")
+	}
+	if revoked, _ := jwtCfg.RevocationChecker.IsRevoked(t.Context(), current); revoked {
+		t.Fatal("current session revoked by its own password change")
+	}
+}
+
+func TestAdminRevokeUserSessions(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	client := testutil.OpenEntPostgres(t, "admin_revoke_user_sessions")
+	server, jwtCfg := newLoginSessionTestServer(t, client)
+	mustCreateLocalUser(t, client, "user-1", "alice", "secret-pass")
+	first := mustLogin(t, server, jwtCfg, "alice", "secret-pass", "laptop")
+	second := mustLogin(t, server, jwtCfg, "alice", "secret-pass", "phone")
+
+	c, w := newAuthedGinContext(t, http.MethodPost, "/api/v1/admin/users/user-1/revoke-sessions", "", "admin-1", []string{"user:read"})
+	server.RevokeUserSessions(c, "user-1")
+	assertStatusAndCode(t, w, http.StatusForbidden, "FORBIDDEN")
+
+	c, w = newAuthedGinContext(t, http.MethodPost, "/api/v1/admin/users/missing/revoke-sessions", "", "admin-1", []string{"user:manage"})
+	server.RevokeUserSessions(c, "missing")
+	assertStatusAndCode(t, w, http.StatusNotFound, "USER_NOT_FOUND")
+
+	c, w = newAuthedGinContext(t, http.MethodPost, "/api/v1/admin/users/user-1/revoke-sessions", "", "admin-1", []string{"user:manage"})
+	server.RevokeUserSessions(c, "user-1")
+	if w.Code != http.StatusOK {
+		t.Fatalf("revoke sessions status = %d, want 200, body=%s", w.Code, w.Body.String())
+	}
+	var result generated.SessionRevocationResult
+	mustDecodeJSON(t, w.Body.Bytes(), &result)
+	if result.Revoked != 2 {
+		t.Fatalf("revoked = %d, want 2", result.Revoked)
+	}
+	for _, id := range []string{first, second} {
+		session := client.LoginSession.GetX(t.Context(), id)
+		if session.RevokedAt == nil || session.RevokedBy != "admin-1" {
+			t.Fatalf("session %s revoked_at=%v revoked_by=%q, want revoked by admin-1", id, session.RevokedAt, session.RevokedBy)
+		}
+		if revoked, _ := jwtCfg.RevocationChecker.IsRevoked(t.Context(), id); !revoked {
+			t.Fatalf("session %s still valid after admin revocation", id)
+		}
+	}
+}
+
+func TestAdminForceUserPasswordReset(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	client := testutil.OpenEntPostgres(t, "admin_force_password_reset")
+	server, jwtCfg := newLoginSessionTestServer(t, client)
+	mustCreateLocalUser(t, client, "user-1", "alice", "secret-pass")
+	session := mustLogin(t, server, jwtCfg, "alice", "secret-pass", "laptop")
+
+	// A token issued earlier with no login session row to revoke.
+	issuedAt := time.Now().Add(-time.Minute)
+	unrecorded, err := jwt.NewWithClaims(jwt.SigningMethodHS256, middleware.JWTClaims{
+		UserID:   "user-1",
+		Username: "alice",
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    jwtCfg.Issuer,
+			Subject:   "user-1",
+			ID:        "unrecorded-token",
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			NotBefore: jwt.NewNumericDate(issuedAt),
+			ExpiresAt: jwt.NewNumericDate(issuedAt.Add(time.Hour)),
+		},
+	}).SignedString(jwtCfg.SigningKey)
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	if _, err := jwtCfg.ValidateToken(t.Context(), unrecorded); err != nil {
+		t.Fatalf("unrecorded token before reset: %v", err)
+	}
+
+	path := "/api/v1/admin/users/user-1/force-password-reset"
+	c, w := newAuthedGinContext(t, http.MethodPatch, path, "", "admin-1", []string{"user:read"})
+	server.ForceUserPasswordReset(c, "user-1")
+	assertStatusAndCode(t, w, http.StatusForbidden, "FORBIDDEN")
+
+	c, w = newAuthedGinContext(t, http.MethodPatch, "/api/v1/admin/users/missing/force-password-reset", "", "admin-1", []string{"user:manage"})
+	server.ForceUserPasswordReset(c, "missing")
+	assertStatusAndCode(t, w, http.StatusNotFound, "USER_NOT_FOUND")
+
+	c, w = newAuthedGinContext(t, http.MethodPatch, path, `{"new_temporary_password":"short"}`, "admin-1", []string{"user:manage"})
+	server.ForceUserPasswordReset(c, "user-1")
+	assertStatusAndCode(t, w, http.StatusBadRequest, "PASSWORD_POLICY_VIOLATION")
+	if client.User.GetX(t.Context(), "user-1").ForcePasswordChange {
+		t.Fatal("rejected reset still set force_password_change")
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodPatch, path, `{"new_temporary_password":"temporary-passphrase"}`, "admin-1", []string{"user:manage"})
+	server.ForceUserPasswordReset(c, "user-1")
+	if w.Code != http.StatusOK {
+		t.Fatalf("force password reset status = %d, want 200, body=%s", w.Code, w.Body.String())
+	}
+	user := client.User.GetX(t.Context(), "user-1")
+	if !user.ForcePasswordChange {
+		t.Fatal("force_password_change not set")
+	}
+	if bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte("temporary-passphrase")) != nil {
+		t.Fatal("password hash not reset to the temporary password")
+	}
+	if row := client.RevokedSession.GetX(t.Context(), "user-1"); row.RevokedBy != "admin-1" {
+		t.Fatalf("revoked session revoked_by = %q, want admin-1", row.RevokedBy)
+	}
+	if _, err := jwtCfg.ValidateToken(t.Context(), unrecorded); err != middleware.ErrTokenRevoked {
+		t.Fatalf("unrecorded token after reset error = %v, want ErrTokenRevoked", err)
+	}
+	if revoked, _ := jwtCfg.RevocationChecker.IsRevoked(t.Context(), session); !revoked {
+		t.Fatal("login session still valid after forced reset")
+	}
+	entry := client.AuditLog.Query().Where(auditlog.ActionEQ("user.force_password_reset")).OnlyX(t.Context())
+	if entry.Details["password_reset"] != true || strings.Contains(fmt.Sprint(entry.Details), "temporary-passphrase") {
+		t.Fatalf("audit details = %v, want password_reset without the password", entry.Details)
+	}
+
+	// Tokens issued after the reset, even within the same second, stay valid.
+	mustLogin(t, server, jwtCfg, "alice", "temporary-passphrase", "laptop")
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/health"
 )
 
 // GetLiveness handles GET /health/live — Kubernetes liveness probe.
@@ -40,3 +41,60 @@ func (s *Server) GetReadiness(c *gin.Context) {
 		Checks: checks,
 	})
 }
+
+// GetHealthz handles GET /healthz — per-component health report.
+// Returns 200 when every component is ok, 207 when any is degraded and
+// 503 when any is down.
+func (s *Server) GetHealthz(c *gin.Context) {
+	var report health.Report
+	if s.healthReport != nil {
+		report = s.healthReport(c.Request.Context())
+	}
+	components := generated.HealthzComponents{
+		Database:         componentHealthToAPI(report, health.DatabaseCheckName),
+		RiverQueue:       componentHealthToAPI(report, health.RiverCheckName),
+		KubevirtClusters: componentHealthToAPI(report, health.ClusterCheckName),
+	}
+
+	status := worstComponentStatus(components.Database, components.RiverQueue, components.KubevirtClusters)
+	httpStatus := http.StatusOK
+	switch status {
+	case generated.ComponentHealthStatusDegraded:
+		httpStatus = http.StatusMultiStatus
+	case generated.ComponentHealthStatusDown:
+		httpStatus = http.StatusServiceUnavailable
+	}
+	c.JSON(httpStatus, generated.HealthzResponse{
+		Status:     status,
+		Components: components,
+	})
+}
+
+// componentHealthToAPI converts the named check of report; checks missing
+// from it are down.
+func componentHealthToAPI(report health.Report, name string) generated.ComponentHealth {
+	result, ok := report.Checks[name]
+	if !ok {
+		return generated.ComponentHealth{Status: generated.ComponentHealthStatusDown, Message: "health check is not registered"}
+	}
+	return generated.ComponentHealth{
+		Status:    generated.ComponentHealthStatus(result.Status),
+		LatencyMs: float64(result.LatencyMs),
+		Message:   result.Message,
+		Details:   result.Details,
+	}
+}
+
+// worstComponentStatus returns down over degraded over ok.
+func worstComponentStatus(components ...generated.ComponentHealth) generated.ComponentHealthStatus {
+	worst := generated.ComponentHealthStatusOk
+	for _, component := range components {
+		switch component.Status {
+		case generated.ComponentHealthStatusDown:
+			return generated.ComponentHealthStatusDown
+		case generated.ComponentHealthStatusDegraded:
+			worst = generated.ComponentHealthStatusDegraded
+		}
+	}
+	return worst
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/health"
+)
+
+func TestWorstComponentStatus(t *testing.T) {
+	t.Parallel()
+
+	ok := generated.ComponentHealth{Status: generated.ComponentHealthStatusOk}
+	degraded := generated.ComponentHealth{Status: generated.ComponentHealthStatusDegraded}
+	down := generated.ComponentHealth{Status: generated.ComponentHealthStatusDown}
+
+	if got := worstComponentStatus(ok, ok, ok); got != generated.ComponentHealthStatusOk {
+		t.Fatalf("all ok = %s", got)
+	}
+	if got := worstComponentStatus(ok, degraded, ok); got != generated.ComponentHealthStatusDegraded {
+		t.Fatalf("one degraded = %s", got)
+	}
+	if got := worstComponentStatus(degraded, down, ok); got != generated.ComponentHealthStatusDown {
+		t.Fatalf("one down = %s", got)
+	}
+}
+
+func TestGetHealthz_DatabaseUnavailable(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+	registry := health.NewRegistry(0)
+	for _, checker := range []health.Checker{
+		health.NewDatabaseChecker(nil),
+		health.NewRiverChecker(nil),
+		health.NewClusterChecker(nil),
+	} {
+		if err := registry.Register(checker); err != nil {
+			t.Fatalf("Register(%s) error = %v", checker.Name(), err)
+		}
+	}
+	srv := NewServer(ServerDeps{HealthReport: registry.Run})
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/healthz", nil)
+
+	srv.GetHealthz(c)
+
+	if w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusServiceUnavailable)
+	}
+	var resp generated.HealthzResponse
+	mustDecodeJSON(t, w.Body.Bytes(), &resp)
+	if resp.Status != generated.ComponentHealthStatusDown ||
+		resp.Components.Database.Status != generated.ComponentHealthStatusDown ||
+		resp.Components.RiverQueue.Status != generated.ComponentHealthStatusDown ||
+		resp.Components.KubevirtClusters.Status != generated.ComponentHealthStatusOk {
+		t.Fatalf("healthz = %+v, want database and river down, clusters ok", resp)
+	}
+}
+
+func TestGetHealthz_MapsReport(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+	report := health.Report{Checks: map[string]health.CheckResult{
+		health.DatabaseCheckName: {Status: health.StatusOK, LatencyMs: 3},
+		health.RiverCheckName: {
+			Status:  health.StatusDegraded,
+			Message: "2 job(s) running for more than 15m0s",
+			Details: map[string]string{"stuck_jobs": "2"},
+		},
+	}}
+	srv := NewServer(ServerDeps{HealthReport: func(context.Context) health.Report { return report }})
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/healthz", nil)
+
+	srv.GetHealthz(c)
+
+	var resp generated.HealthzResponse
+	mustDecodeJSON(t, w.Body.Bytes(), &resp)
+	if w.Code != http.StatusServiceUnavailable || resp.Components.Database.LatencyMs != 3 ||
+		resp.Components.RiverQueue.Status != generated.ComponentHealthStatusDegraded ||
+		resp.Components.RiverQueue.Details["stuck_jobs"] != "2" ||
+		resp.Components.KubevirtClusters.Status != generated.ComponentHealthStatusDown {
+		t.Fatalf("healthz = %d %+v, want river degraded and the unregistered cluster check down", w.Code, resp)
+	}
+}
@@ -0,0 +1,217 @@
+package handlers
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/predicate"
+	entservice "kv-shepherd.io/shepherd/ent/service"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/api/middleware"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// labelKeyPattern is the accepted label key format for VMs and services.
+var labelKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_.-]{0,62}$`)
+
+const (
+	// maxLabelValueLength bounds a single label value.
+	maxLabelValueLength = 255
+	// maxLabelsPerResource bounds the label set stored on one resource.
+	maxLabelsPerResource = 64
+)
+
+// PatchVMLabels handles PATCH /vms/{vm_id}/labels.
+func (s *Server) PatchVMLabels(c *gin.Context, vmId generated.VMID) {
+	ctx := c.Request.Context()
+	if !requireGlobalPermission(c, "vm:operate") {
+		return
+	}
+	actor := middleware.GetUserID(ctx)
+
+	var patch generated.LabelsPatchRequest
+	if err := c.ShouldBindJSON(&patch); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+
+	vm, err := s.client.VM.Get(ctx, vmId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "VM_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to get VM for label update", zap.Error(err), zap.String("vm_id", vmId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	visibility, err := s.resolveNamespaceVisibility(c)
+	if err != nil {
+		logger.Error("failed to resolve VM namespace visibility", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	visible, err := s.isNamespaceVisible(ctx, vm.Namespace, visibility)
+	if err != nil {
+		logger.Error("failed to check VM namespace visibility", zap.Error(err), zap.String("vm_id", vmId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if !visible {
+		c.JSON(http.StatusNotFound, generated.Error{Code: "VM_NOT_FOUND"})
+		return
+	}
+
+	labels, err := mergeLabels(vm.Labels, patch)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_LABELS", Message: err.Error()})
+		return
+	}
+	updated, err := vm.Update().SetLabels(labels).Save(ctx)
+	if err != nil {
+		logger.Error("failed to update VM labels", zap.Error(err), zap.String("vm_id", vmId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "vm.labels.update", "vm", vmId, actor, map[string]interface{}{
+			"old": vm.Labels,
+			"new": labels,
+		})
+	}
+
+	c.JSON(http.StatusOK, vmToAPI(updated))
+}
+
+// PatchServiceLabels handles PATCH /services/{service_id}/labels.
+// Callers also need update rights on the owning system.
+func (s *Server) PatchServiceLabels(c *gin.Context, serviceId generated.ServiceID) {
+	ctx := c.Request.Context()
+	if !requireGlobalPermission(c, "service:read") {
+		return
+	}
+
+	var patch generated.LabelsPatchRequest
+	if err := c.ShouldBindJSON(&patch); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+
+	svc, err := s.client.Service.Query().
+		Where(entservice.IDEQ(serviceId)).
+		WithSystem().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "SERVICE_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to get service for label update", zap.Error(err), zap.String("service_id", serviceId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	systemID := svc.Edges.System.ID
+	actor, ok := s.requireSystemRole(c, systemID, "update")
+	if !ok {
+		return
+	}
+
+	labels, err := mergeLabels(svc.Labels, patch)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_LABELS", Message: err.Error()})
+		return
+	}
+	updated, err := s.client.Service.UpdateOneID(serviceId).SetLabels(labels).Save(ctx)
+	if err != nil {
+		logger.Error("failed to update service labels", zap.Error(err), zap.String("service_id", serviceId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "service.labels.update", "service", serviceId, actor, map[string]interface{}{
+			"system_id": systemID,
+			"old":       svc.Labels,
+			"new":       labels,
+		})
+	}
+
+	c.JSON(http.StatusOK, serviceToAPI(updated, systemID))
+}
+
+// mergeLabels applies a JSON merge patch to existing labels: string values
+// add or overwrite a key, null values delete it. existing is not modified.
+func mergeLabels(existing map[string]string, patch generated.LabelsPatchRequest) (map[string]string, error) {
+	merged := make(map[string]string, len(existing)+len(patch))
+	maps.Copy(merged, existing)
+	for _, key := range slices.Sorted(maps.Keys(patch)) {
+		if !labelKeyPattern.MatchString(key) {
+			return nil, fmt.Errorf("invalid label key %q: must match %s", key, labelKeyPattern)
+		}
+		value := patch[key]
+		if value == nil {
+			delete(merged, key)
+			continue
+		}
+		if len(*value) > maxLabelValueLength {
+			return nil, fmt.Errorf("label %q value must be at most %d characters", key, maxLabelValueLength)
+		}
+		merged[key] = *value
+	}
+	if len(merged) > maxLabelsPerResource {
+		return nil, fmt.Errorf("at most %d labels are allowed per resource", maxLabelsPerResource)
+	}
+	return merged, nil
+}
+
+// parseLabelSelector parses "k1=v1,k2=v2" into equality terms. Every term
+// must match for a resource to be selected.
+func parseLabelSelector(raw string) (map[string]string, error) {
+	terms := map[string]string{}
+	for _, term := range strings.Split(raw, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(term, "=")
+		key = strings.TrimSpace(key)
+		if !ok || !labelKeyPattern.MatchString(key) {
+			return nil, fmt.Errorf("invalid label_selector term %q: expected key=value", term)
+		}
+		terms[key] = strings.TrimSpace(value)
+	}
+	return terms, nil
+}
+
+// vmLabelPredicates matches VMs whose labels contain every selector term.
+func vmLabelPredicates(terms map[string]string) []predicate.VM {
+	predicates := make([]predicate.VM, 0, len(terms))
+	for _, key := range slices.Sorted(maps.Keys(terms)) {
+		value := terms[key]
+		predicates = append(predicates, predicate.VM(func(s *sql.Selector) {
+			s.Where(sqljson.ValueEQ(s.C(entvm.FieldLabels), value, sqljson.Path(key)))
+		}))
+	}
+	return predicates
+}
+
+// formatLabelSelector renders selector terms in canonical (sorted) form.
+func formatLabelSelector(terms map[string]string) string {
+	parts := make([]string, 0, len(terms))
+	for _, key := range slices.Sorted(maps.Keys(terms)) {
+		parts = append(parts, key+"="+terms[key])
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,216 @@
+package handlers
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func labelValue(v string) *string { return &v }
+
+func TestMergeLabels(t *testing.T) {
+	t.Parallel()
+
+	existing := map[string]string{"env": "prod", "tier": "frontend"}
+	tests := []struct {
+		name    string
+		patch   generated.LabelsPatchRequest
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:  "merge adds new keys",
+			patch: generated.LabelsPatchRequest{"team": labelValue("payments")},
+			want:  map[string]string{"env": "prod", "tier": "frontend", "team": "payments"},
+		},
+		{
+			name:  "overwrite replaces value",
+			patch: generated.LabelsPatchRequest{"env": labelValue("staging")},
+			want:  map[string]string{"env": "staging", "tier": "frontend"},
+		},
+		{
+			name:  "null value deletes key",
+			patch: generated.LabelsPatchRequest{"tier": nil, "missing": nil},
+			want:  map[string]string{"env": "prod"},
+		},
+		{
+			name:  "empty patch keeps labels",
+			patch: generated.LabelsPatchRequest{},
+			want:  map[string]string{"env": "prod", "tier": "frontend"},
+		},
+		{name: "uppercase key", patch: generated.LabelsPatchRequest{"Env": labelValue("x")}, wantErr: true},
+		{name: "leading digit", patch: generated.LabelsPatchRequest{"1env": labelValue("x")}, wantErr: true},
+		{name: "slash in key", patch: generated.LabelsPatchRequest{"app/name": labelValue("x")}, wantErr: true},
+		{name: "invalid key on delete", patch: generated.LabelsPatchRequest{"BAD": nil}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mergeLabels(existing, tt.patch)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("mergeLabels(%v) expected error, got %v", tt.patch, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("mergeLabels(%v) error = %v", tt.patch, err)
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Fatalf("mergeLabels(%v) = %v, want %v", tt.patch, got, tt.want)
+			}
+		})
+	}
+
+	if len(existing) != 2 || existing["env"] != "prod" {
+		t.Fatalf("mergeLabels modified existing labels: %v", existing)
+	}
+}
+
+func TestParseLabelSelector(t *testing.T) {
+	t.Parallel()
+
+	terms, err := parseLabelSelector(" tier=frontend, env=prod ,")
+	if err != nil {
+		t.Fatalf("parseLabelSelector() error = %v", err)
+	}
+	if !maps.Equal(terms, map[string]string{"env": "prod", "tier": "frontend"}) {
+		t.Fatalf("parseLabelSelector() = %v", terms)
+	}
+	if got := formatLabelSelector(terms); got != "env=prod,tier=frontend" {
+		t.Fatalf("formatLabelSelector() = %q", got)
+	}
+
+	for _, raw := range []string{"env", "Env=prod", "=prod"} {
+		if _, err := parseLabelSelector(raw); err == nil {
+			t.Fatalf("parseLabelSelector(%q) expected error", raw)
+		}
+	}
+}
+
+func patchLabels(t *testing.T, perms []string, path string, body generated.LabelsPatchRequest, call func(c *gin.Context)) (int, []byte) {
+	t.Helper()
+
+	c, w := newAuthedGinContext(t, http.MethodPatch, path, mustJSON(t, body), "user-1", perms)
+	call(c)
+	return w.Code, w.Body.Bytes()
+}
+
+func TestPatchVMLabels(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "vm_labels_patch")
+	seedVMListFixture(t, client)
+	srv := NewServer(ServerDeps{EntClient: client})
+	admin := []string{"platform:admin"}
+	patchVM := func(vmID string, body generated.LabelsPatchRequest) (int, generated.VM) {
+		code, raw := patchLabels(t, admin, "/vms/"+vmID+"/labels", body, func(c *gin.Context) { srv.PatchVMLabels(c, vmID) })
+		var resp generated.VM
+		if code == http.StatusOK {
+			mustDecodeJSON(t, raw, &resp)
+		}
+		return code, resp
+	}
+
+	code, vm := patchVM("vm-a", generated.LabelsPatchRequest{"env": labelValue("prod"), "tier": labelValue("frontend")})
+	if code != http.StatusOK || !maps.Equal(vm.Labels, generated.Labels{"env": "prod", "tier": "frontend"}) {
+		t.Fatalf("initial patch status=%d labels=%v", code, vm.Labels)
+	}
+	code, vm = patchVM("vm-a", generated.LabelsPatchRequest{"tier": labelValue("backend"), "owner": labelValue("alice")})
+	if code != http.StatusOK || !maps.Equal(vm.Labels, generated.Labels{"env": "prod", "tier": "backend", "owner": "alice"}) {
+		t.Fatalf("merge/overwrite patch status=%d labels=%v", code, vm.Labels)
+	}
+	code, vm = patchVM("vm-a", generated.LabelsPatchRequest{"owner": nil})
+	if code != http.StatusOK || !maps.Equal(vm.Labels, generated.Labels{"env": "prod", "tier": "backend"}) {
+		t.Fatalf("delete patch status=%d labels=%v", code, vm.Labels)
+	}
+	stored := client.VM.GetX(t.Context(), "vm-a")
+	if !maps.Equal(stored.Labels, map[string]string{"env": "prod", "tier": "backend"}) {
+		t.Fatalf("stored labels = %v", stored.Labels)
+	}
+
+	if code, _ := patchVM("vm-a", generated.LabelsPatchRequest{"Bad Key": labelValue("x")}); code != http.StatusBadRequest {
+		t.Fatalf("invalid key status = %d, want %d", code, http.StatusBadRequest)
+	}
+	if code, _ := patchVM("vm-missing", generated.LabelsPatchRequest{"env": labelValue("prod")}); code != http.StatusNotFound {
+		t.Fatalf("missing VM status = %d, want %d", code, http.StatusNotFound)
+	}
+	code, _ = patchLabels(t, []string{"vm:read"}, "/vms/vm-a/labels", generated.LabelsPatchRequest{"env": labelValue("dev")},
+		func(c *gin.Context) { srv.PatchVMLabels(c, "vm-a") })
+	if code != http.StatusForbidden {
+		t.Fatalf("without vm:operate status = %d, want %d", code, http.StatusForbidden)
+	}
+
+	patchVM("vm-b", generated.LabelsPatchRequest{"env": labelValue("prod"), "tier": labelValue("frontend")})
+	patchVM("vm-d", generated.LabelsPatchRequest{"env": labelValue("test"), "tier": labelValue("frontend")})
+
+	for selector, wantIDs := range map[string][]string{
+		"env=prod":               {"vm-a", "vm-b"},
+		"env=prod,tier=frontend": {"vm-b"},
+		"tier=frontend":          {"vm-b", "vm-d"},
+		"team=none":              {},
+	} {
+		resp, ids := listVMIDs(t, srv, "admin-1", admin, generated.ListVMsParams{LabelSelector: selector})
+		slices.Sort(ids)
+		if !slices.Equal(ids, wantIDs) {
+			t.Fatalf("label_selector=%q ids = %v, want %v", selector, ids, wantIDs)
+		}
+		if resp.Filters.LabelSelector == "" {
+			t.Fatalf("label_selector=%q not echoed in filters", selector)
+		}
+	}
+
+	c, w := newAuthedGinContext(t, http.MethodGet, "/vms", "", "admin-1", admin)
+	srv.ListVMs(c, generated.ListVMsParams{LabelSelector: "env"})
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("malformed label_selector status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestPatchServiceLabels(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "service_labels_patch")
+	mustCreateSystem(t, client, "sys-shop", "shop", "alice")
+	mustCreateService(t, client, "svc-redis", "redis", "sys-shop", "cache")
+	srv := NewServer(ServerDeps{EntClient: client})
+	admin := []string{"platform:admin"}
+	patchService := func(perms []string, body generated.LabelsPatchRequest) (int, generated.Service) {
+		code, raw := patchLabels(t, perms, "/services/svc-redis/labels", body, func(c *gin.Context) { srv.PatchServiceLabels(c, "svc-redis") })
+		var resp generated.Service
+		if code == http.StatusOK {
+			mustDecodeJSON(t, raw, &resp)
+		}
+		return code, resp
+	}
+
+	code, svc := patchService(admin, generated.LabelsPatchRequest{"env": labelValue("prod"), "tier": labelValue("cache")})
+	if code != http.StatusOK || svc.SystemId != "sys-shop" || !maps.Equal(svc.Labels, generated.Labels{"env": "prod", "tier": "cache"}) {
+		t.Fatalf("initial patch status=%d service=%+v", code, svc)
+	}
+	code, svc = patchService(admin, generated.LabelsPatchRequest{"env": labelValue("staging"), "tier": nil})
+	if code != http.StatusOK || !maps.Equal(svc.Labels, generated.Labels{"env": "staging"}) {
+		t.Fatalf("overwrite/delete patch status=%d labels=%v", code, svc.Labels)
+	}
+
+	if code, _ := patchService([]string{"service:read"}, generated.LabelsPatchRequest{"env": labelValue("dev")}); code != http.StatusForbidden {
+		t.Fatalf("non-member status = %d, want %d", code, http.StatusForbidden)
+	}
+	if code, _ := patchService(admin, generated.LabelsPatchRequest{"-bad": labelValue("x")}); code != http.StatusBadRequest {
+		t.Fatalf("invalid key status = %d, want %d", code, http.StatusBadRequest)
+	}
+	if got := client.Service.GetX(t.Context(), "svc-redis").Labels; !maps.Equal(got, map[string]string{"env": "staging"}) {
+		t.Fatalf("stored labels = %v", got)
+	}
+}
@@ -8,7 +8,9 @@ import (
 	"go.uber.org/zap"
 
 	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/namespacequota"
 	"kv-shepherd.io/shepherd/ent/namespaceregistry"
+	"kv-shepherd.io/shepherd/ent/quota"
 	"kv-shepherd.io/shepherd/ent/vm"
 	"kv-shepherd.io/shepherd/internal/api/generated"
 	"kv-shepherd.io/shepherd/internal/pkg/logger"
@@ -112,6 +114,9 @@ func (s *Server) CreateNamespace(c *gin.Context) {
 	if req.Description != "" {
 		create = create.SetDescription(req.Description)
 	}
+	if req.RequirePowerApproval {
+		create = create.SetRequirePowerApproval(true)
+	}
 
 	ns, err := create.Save(ctx)
 	if err != nil {
@@ -192,6 +197,9 @@ func (s *Server) UpdateNamespace(c *gin.Context, namespaceId generated.Namespace
 		update = update.SetDescription(req.Description)
 	}
 	update = update.SetEnabled(req.Enabled)
+	if req.RequirePowerApproval != nil {
+		update = update.SetRequirePowerApproval(*req.RequirePowerApproval)
+	}
 
 	ns, err := update.Save(ctx)
 	if err != nil {
@@ -259,6 +267,14 @@ func (s *Server) DeleteNamespace(c *gin.Context, namespaceId generated.Namespace
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
+	if _, err := s.client.NamespaceQuota.Delete().Where(namespacequota.NamespaceNameEQ(ns.Name)).Exec(ctx); err != nil {
+		logger.Warn("failed to delete namespace quota", zap.Error(err), zap.String("namespace", ns.Name))
+	}
+	if _, err := s.client.Quota.Delete().
+		Where(quota.ScopeTypeEQ(quota.ScopeTypeNamespace), quota.ScopeIDEQ(ns.Name)).
+		Exec(ctx); err != nil {
+		logger.Warn("failed to delete namespace quota", zap.Error(err), zap.String("namespace", ns.Name))
+	}
 
 	if s.audit != nil {
 		_ = s.audit.LogAction(ctx, "namespace.delete", "namespace", namespaceId, actor, map[string]interface{}{
@@ -273,13 +289,14 @@ func (s *Server) DeleteNamespace(c *gin.Context, namespaceId generated.Namespace
 
 func namespaceToAPI(ns *ent.NamespaceRegistry) generated.NamespaceRegistry {
 	return generated.NamespaceRegistry{
-		Id:          ns.ID,
-		Name:        ns.Name,
-		Environment: generated.NamespaceRegistryEnvironment(ns.Environment),
-		Description: ns.Description,
-		Enabled:     ns.Enabled,
-		CreatedBy:   ns.CreatedBy,
-		CreatedAt:   ns.CreatedAt,
-		UpdatedAt:   ns.UpdatedAt,
+		Id:                   ns.ID,
+		Name:                 ns.Name,
+		Environment:          generated.NamespaceRegistryEnvironment(ns.Environment),
+		Description:          ns.Description,
+		Enabled:              ns.Enabled,
+		RequirePowerApproval: ns.RequirePowerApproval,
+		CreatedBy:            ns.CreatedBy,
+		CreatedAt:            ns.CreatedAt,
+		UpdatedAt:            ns.UpdatedAt,
 	}
 }
@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/ent/namespaceregistry"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// MigrateNamespaceEnvironment handles POST /admin/namespaces/{namespace_id}/migrate-environment.
+// The environment only changes once the NAMESPACE_MIGRATE ticket is approved.
+func (s *Server) MigrateNamespaceEnvironment(c *gin.Context, namespaceId generated.NamespaceID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+
+	var req generated.NamespaceMigrateEnvironmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "target_environment is required"})
+		return
+	}
+	target := namespaceregistry.Environment(req.TargetEnvironment)
+	if err := namespaceregistry.EnvironmentValidator(target); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:    "INVALID_ENVIRONMENT",
+			Message: "target_environment must be test or prod",
+		})
+		return
+	}
+
+	ns, ok := s.resolveNamespaceRef(c, namespaceId)
+	if !ok {
+		return
+	}
+	if ns.Environment == target {
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:    "NAMESPACE_ALREADY_IN_ENVIRONMENT",
+			Message: fmt.Sprintf("namespace %s is already in the %s environment", ns.Name, target),
+		})
+		return
+	}
+
+	pending, err := s.hasOpenNamespaceMigration(ctx, ns.ID)
+	if err != nil {
+		logger.Error("failed to check open namespace migrations", zap.Error(err), zap.String("namespace", ns.Name))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if pending {
+		c.JSON(http.StatusConflict, generated.Error{
+			Code:    "NAMESPACE_MIGRATION_PENDING",
+			Message: "a migration of this namespace is already awaiting approval or running",
+		})
+		return
+	}
+
+	payload := domain.NamespaceMigrationPayload{
+		NamespaceID:     ns.ID,
+		Namespace:       ns.Name,
+		FromEnvironment: string(ns.Environment),
+		ToEnvironment:   string(target),
+		Reason:          strings.TrimSpace(req.Reason),
+		Actor:           actor,
+	}
+	ticketID, err := s.createNamespaceMigrationRequest(ctx, payload)
+	if err != nil {
+		logger.Error("failed to create namespace migration request", zap.Error(err), zap.String("namespace", ns.Name))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "namespace.environment_migrate_requested", "namespace", ns.ID, actor, map[string]interface{}{
+			"name":                 ns.Name,
+			"previous_environment": payload.FromEnvironment,
+			"environment":          payload.ToEnvironment,
+			"ticket_id":            ticketID,
+		})
+	}
+	if s.notifier != nil {
+		s.notifier.OnTicketSubmitted(ctx, ticketID, actor, ns.Name)
+	}
+
+	c.JSON(http.StatusAccepted, generated.ApprovalTicketResponse{
+		TicketId: ticketID,
+		Status:   generated.ApprovalTicketResponseStatus(approvalticket.StatusPENDING),
+	})
+}
+
+// hasOpenNamespaceMigration reports whether a NAMESPACE_MIGRATE ticket for
+// the namespace is still waiting for a decision or running.
+func (s *Server) hasOpenNamespaceMigration(ctx context.Context, namespaceID string) (bool, error) {
+	eventIDs, err := s.client.DomainEvent.Query().
+		Where(
+			domainevent.AggregateTypeEQ("namespace"),
+			domainevent.AggregateIDEQ(namespaceID),
+			domainevent.EventTypeEQ(string(domain.EventNamespaceMigrationRequested)),
+		).
+		Select(domainevent.FieldID).
+		Strings(ctx)
+	if err != nil {
+		return false, err
+	}
+	if len(eventIDs) == 0 {
+		return false, nil
+	}
+
+	return s.client.ApprovalTicket.Query().
+		Where(
+			approvalticket.OperationTypeEQ(approvalticket.OperationTypeNAMESPACE_MIGRATE),
+			approvalticket.StatusIn(
+				approvalticket.StatusPENDING,
+				approvalticket.StatusAPPROVED,
+				approvalticket.StatusEXECUTING,
+			),
+			approvalticket.EventIDIn(eventIDs...),
+		).
+		Exist(ctx)
+}
+
+func (s *Server) createNamespaceMigrationRequest(ctx context.Context, payload domain.NamespaceMigrationPayload) (string, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	eventID, err := uuid.NewV7()
+	if err != nil {
+		return "", fmt.Errorf("generate event id: %w", err)
+	}
+	ticketID, err := uuid.NewV7()
+	if err != nil {
+		return "", fmt.Errorf("generate ticket id: %w", err)
+	}
+
+	payloadBytes, err := payload.ToJSON()
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := tx.DomainEvent.Create().
+		SetID(eventID.String()).
+		SetEventType(string(domain.EventNamespaceMigrationRequested)).
+		SetAggregateType("namespace").
+		SetAggregateID(payload.NamespaceID).
+		SetPayload(payloadBytes).
+		SetStatus(domainevent.StatusPENDING).
+		SetCreatedBy(payload.Actor).
+		Save(ctx); err != nil {
+		return "", err
+	}
+
+	reason := payload.Reason
+	if reason == "" {
+		reason = fmt.Sprintf("migrate namespace %s from %s to %s", payload.Namespace, payload.FromEnvironment, payload.ToEnvironment)
+	}
+	if _, err := tx.ApprovalTicket.Create().
+		SetID(ticketID.String()).
+		SetEventID(eventID.String()).
+		SetOperationType(approvalticket.OperationTypeNAMESPACE_MIGRATE).
+		SetStatus(approvalticket.StatusPENDING).
+		SetRequester(payload.Actor).
+		SetReason(reason).
+		Save(ctx); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+	return ticketID.String(), nil
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/auditlog"
+	"kv-shepherd.io/shepherd/ent/namespaceregistry"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestMigrateNamespaceEnvironment(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "namespace_migrate_handler")
+	ns := client.NamespaceRegistry.Create().
+		SetID("ns-shop").
+		SetName("dev-shop").
+		SetEnvironment(namespaceregistry.EnvironmentTest).
+		SetCreatedBy("admin-1").
+		SaveX(t.Context())
+	srv := NewServer(ServerDeps{EntClient: client, Audit: audit.NewLogger(client)})
+
+	migrate := func(ref, body string, perms []string) (int, []byte) {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodPost, "/admin/namespaces/"+ref+"/migrate-environment", body, "admin-1", perms)
+		srv.MigrateNamespaceEnvironment(c, ref)
+		return w.Code, w.Body.Bytes()
+	}
+	admin := []string{"platform:admin"}
+
+	code, body := migrate("dev-shop", `{"target_environment":"prod","reason":"go live"}`, admin)
+	if code != http.StatusAccepted {
+		t.Fatalf("migrate status = %d body=%s", code, body)
+	}
+	var resp generated.ApprovalTicketResponse
+	mustDecodeJSON(t, body, &resp)
+	ticket := client.ApprovalTicket.GetX(t.Context(), resp.TicketId)
+	if ticket.OperationType != approvalticket.OperationTypeNAMESPACE_MIGRATE || ticket.Status != approvalticket.StatusPENDING || ticket.Reason != "go live" {
+		t.Fatalf("ticket = %+v", ticket)
+	}
+	event := client.DomainEvent.GetX(t.Context(), ticket.EventID)
+	if event.EventType != string(domain.EventNamespaceMigrationRequested) || event.AggregateID != ns.ID {
+		t.Fatalf("event type=%s aggregate=%s", event.EventType, event.AggregateID)
+	}
+	var payload domain.NamespaceMigrationPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	if payload.FromEnvironment != "test" || payload.ToEnvironment != "prod" || payload.Namespace != "dev-shop" {
+		t.Fatalf("payload = %+v", payload)
+	}
+	if got := client.NamespaceRegistry.GetX(t.Context(), ns.ID).Environment; got != namespaceregistry.EnvironmentTest {
+		t.Fatalf("environment changed before approval: %s", got)
+	}
+	if n := client.AuditLog.Query().Where(auditlog.ActionEQ("namespace.environment_migrate_requested")).CountX(t.Context()); n != 1 {
+		t.Fatalf("request audit rows = %d, want 1", n)
+	}
+
+	tests := []struct {
+		name       string
+		ref        string
+		body       string
+		perms      []string
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "open ticket", ref: ns.ID, body: `{"target_environment":"prod"}`, perms: admin, wantStatus: http.StatusConflict, wantCode: "NAMESPACE_MIGRATION_PENDING"},
+		{name: "same environment", ref: "dev-shop", body: `{"target_environment":"test"}`, perms: admin, wantStatus: http.StatusBadRequest, wantCode: "NAMESPACE_ALREADY_IN_ENVIRONMENT"},
+		{name: "unknown environment", ref: "dev-shop", body: `{"target_environment":"staging"}`, perms: admin, wantStatus: http.StatusBadRequest, wantCode: "INVALID_ENVIRONMENT"},
+		{name: "missing namespace", ref: "nope", body: `{"target_environment":"prod"}`, perms: admin, wantStatus: http.StatusNotFound, wantCode: "NAMESPACE_NOT_FOUND"},
+		{name: "not admin", ref: "dev-shop", body: `{"target_environment":"prod"}`, perms: []string{"vm:create"}, wantStatus: http.StatusForbidden},
+	}
+	for _, tc := range tests {
+		code, body := migrate(tc.ref, tc.body, tc.perms)
+		if code != tc.wantStatus {
+			t.Fatalf("%s: status = %d, want %d body=%s", tc.name, code, tc.wantStatus, body)
+		}
+		if tc.wantCode != "" {
+			assertErrorCode(t, body, tc.wantCode)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/namespacequota"
+	"kv-shepherd.io/shepherd/ent/namespaceregistry"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+type namespaceQuotaRequest struct {
+	MaxVMs *int `json:"max_vms" binding:"required"`
+}
+
+// CreateNamespaceQuota handles POST /admin/namespaces/{namespace_id}/quota.
+func (s *Server) CreateNamespaceQuota(c *gin.Context, namespaceId generated.NamespaceID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+
+	ns, ok := s.resolveNamespaceRef(c, namespaceId)
+	if !ok {
+		return
+	}
+	maxVMs, ok := bindNamespaceQuotaRequest(c)
+	if !ok {
+		return
+	}
+
+	id, _ := uuid.NewV7()
+	quota, err := s.client.NamespaceQuota.Create().
+		SetID(id.String()).
+		SetNamespaceName(ns.Name).
+		SetMaxVms(maxVMs).
+		SetCreatedBy(actor).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			c.JSON(http.StatusConflict, generated.Error{Code: "NAMESPACE_QUOTA_EXISTS"})
+			return
+		}
+		logger.Error("failed to create namespace quota", zap.Error(err), zap.String("namespace", ns.Name))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "namespace.quota.create", "namespace", ns.ID, actor, map[string]interface{}{
+			"name":    ns.Name,
+			"max_vms": quota.MaxVms,
+		})
+	}
+
+	c.JSON(http.StatusCreated, namespaceQuotaToAPI(quota))
+}
+
+// UpdateNamespaceQuota handles PATCH /admin/namespaces/{namespace_id}/quota.
+func (s *Server) UpdateNamespaceQuota(c *gin.Context, namespaceId generated.NamespaceID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+
+	ns, ok := s.resolveNamespaceRef(c, namespaceId)
+	if !ok {
+		return
+	}
+	maxVMs, ok := bindNamespaceQuotaRequest(c)
+	if !ok {
+		return
+	}
+
+	existing, err := s.client.NamespaceQuota.Query().
+		Where(namespacequota.NamespaceNameEQ(ns.Name)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "NAMESPACE_QUOTA_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to query namespace quota", zap.Error(err), zap.String("namespace", ns.Name))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	quota, err := existing.Update().SetMaxVms(maxVMs).Save(ctx)
+	if err != nil {
+		logger.Error("failed to update namespace quota", zap.Error(err), zap.String("namespace", ns.Name))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "namespace.quota.update", "namespace", ns.ID, actor, map[string]interface{}{
+			"name":             ns.Name,
+			"previous_max_vms": existing.MaxVms,
+			"max_vms":          quota.MaxVms,
+		})
+	}
+
+	c.JSON(http.StatusOK, namespaceQuotaToAPI(quota))
+}
+
+// DeleteNamespaceQuota handles DELETE /admin/namespaces/{namespace_id}/quota.
+func (s *Server) DeleteNamespaceQuota(c *gin.Context, namespaceId generated.NamespaceID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+
+	ns, ok := s.resolveNamespaceRef(c, namespaceId)
+	if !ok {
+		return
+	}
+
+	deleted, err := s.client.NamespaceQuota.Delete().
+		Where(namespacequota.NamespaceNameEQ(ns.Name)).
+		Exec(ctx)
+	if err != nil {
+		logger.Error("failed to delete namespace quota", zap.Error(err), zap.String("namespace", ns.Name))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if deleted == 0 {
+		c.JSON(http.StatusNotFound, generated.Error{Code: "NAMESPACE_QUOTA_NOT_FOUND"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "namespace.quota.delete", "namespace", ns.ID, actor, map[string]interface{}{
+			"name": ns.Name,
+		})
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// resolveNamespaceRef looks up the registered namespace by ID, falling back
+// to its name so quota and migration routes can be addressed either way.
+func (s *Server) resolveNamespaceRef(c *gin.Context, ref string) (*ent.NamespaceRegistry, bool) {
+	ctx := c.Request.Context()
+	ns, err := s.client.NamespaceRegistry.Query().
+		Where(namespaceregistry.Or(
+			namespaceregistry.IDEQ(ref),
+			namespaceregistry.NameEQ(ref),
+		)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "NAMESPACE_NOT_FOUND"})
+			return nil, false
+		}
+		logger.Error("failed to resolve namespace", zap.Error(err), zap.String("namespace", ref))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return nil, false
+	}
+	return ns, true
+}
+
+func bindNamespaceQuotaRequest(c *gin.Context) (int, bool) {
+	var req namespaceQuotaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "max_vms is required"})
+		return 0, false
+	}
+	if *req.MaxVMs < 0 {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "max_vms must be >= 0"})
+		return 0, false
+	}
+	return *req.MaxVMs, true
+}
+
+func namespaceQuotaToAPI(q *ent.NamespaceQuota) generated.NamespaceQuota {
+	return generated.NamespaceQuota{
+		Id:            q.ID,
+		NamespaceName: q.NamespaceName,
+		MaxVms:        q.MaxVms,
+		CreatedBy:     q.CreatedBy,
+		CreatedAt:     q.CreatedAt,
+		UpdatedAt:     q.UpdatedAt,
+	}
+}
@@ -0,0 +1,167 @@
+package handlers
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/namespaceregistry"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// systemNamespaces are Kubernetes-managed namespaces never expected in the
+// registry, so they are left out of missing_in_registry.
+var systemNamespaces = map[string]struct{}{
+	"default":         {},
+	"kube-system":     {},
+	"kube-public":     {},
+	"kube-node-lease": {},
+}
+
+// SyncNamespaces handles POST /admin/namespaces/sync.
+func (s *Server) SyncNamespaces(c *gin.Context, params generated.SyncNamespacesParams) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "cluster:write", "cluster:manage")
+	if !ok {
+		return
+	}
+
+	cl, err := s.client.Cluster.Get(ctx, params.ClusterId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "CLUSTER_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to look up cluster", zap.Error(err), zap.String("cluster_id", params.ClusterId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if s.vmService == nil {
+		c.JSON(http.StatusServiceUnavailable, generated.Error{Code: "CLUSTER_UNAVAILABLE", Message: "no infrastructure provider configured"})
+		return
+	}
+	clusterNames, err := s.vmService.ListClusterNamespaces(ctx, cl.ID)
+	if err != nil {
+		logger.Warn("failed to list cluster namespaces", zap.Error(err), zap.String("cluster_id", cl.ID))
+		c.JSON(http.StatusServiceUnavailable, generated.Error{Code: "CLUSTER_UNAVAILABLE"})
+		return
+	}
+
+	registry, err := s.client.NamespaceRegistry.Query().
+		Order(ent.Asc(namespaceregistry.FieldName)).
+		All(ctx)
+	if err != nil {
+		logger.Error("failed to list namespace registry", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	diff := diffClusterNamespaces(registry, clusterNames, namespaceregistry.Environment(cl.Environment))
+
+	disabled := make([]string, 0)
+	if params.DisableMissing {
+		var ids []string
+		for _, ns := range diff.missingInCluster {
+			if ns.Enabled {
+				ids = append(ids, ns.ID)
+				disabled = append(disabled, ns.Name)
+			}
+		}
+		if len(ids) > 0 {
+			if err := s.client.NamespaceRegistry.Update().
+				Where(namespaceregistry.IDIn(ids...)).
+				SetEnabled(false).
+				Exec(ctx); err != nil {
+				logger.Error("failed to disable missing namespaces", zap.Error(err), zap.String("cluster_id", cl.ID))
+				c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+				return
+			}
+			for _, ns := range diff.missingInCluster {
+				ns.Enabled = false
+			}
+		}
+	}
+
+	resp := generated.NamespaceSyncResult{
+		ClusterId:         cl.ID,
+		Environment:       generated.NamespaceSyncResultEnvironment(cl.Environment),
+		MatchedCount:      diff.matched,
+		MissingInCluster:  make([]generated.NamespaceRegistry, 0, len(diff.missingInCluster)),
+		MissingInRegistry: diff.missingInRegistry,
+		Disabled:          disabled,
+	}
+	for _, ns := range diff.missingInCluster {
+		resp.MissingInCluster = append(resp.MissingInCluster, namespaceToAPI(ns))
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "namespace.sync", "cluster", cl.ID, actor, map[string]interface{}{
+			"matched_count":       diff.matched,
+			"missing_in_cluster":  namespaceNames(diff.missingInCluster),
+			"missing_in_registry": diff.missingInRegistry,
+			"disabled":            disabled,
+		})
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// namespaceSyncDiff is the reconciliation of registry entries against the
+// namespaces of one cluster.
+type namespaceSyncDiff struct {
+	matched           int
+	missingInCluster  []*ent.NamespaceRegistry
+	missingInRegistry []string
+}
+
+// diffClusterNamespaces compares the registry with a cluster's namespaces.
+// Only entries of the cluster's environment can be missing in the cluster;
+// a cluster namespace registered under any environment is not reported as
+// missing in the registry.
+func diffClusterNamespaces(
+	registry []*ent.NamespaceRegistry,
+	clusterNames []string,
+	env namespaceregistry.Environment,
+) namespaceSyncDiff {
+	onCluster := make(map[string]struct{}, len(clusterNames))
+	for _, name := range clusterNames {
+		onCluster[name] = struct{}{}
+	}
+	registered := make(map[string]struct{}, len(registry))
+	diff := namespaceSyncDiff{
+		missingInCluster:  make([]*ent.NamespaceRegistry, 0),
+		missingInRegistry: make([]string, 0),
+	}
+	for _, ns := range registry {
+		registered[ns.Name] = struct{}{}
+		if ns.Environment != env {
+			continue
+		}
+		if _, ok := onCluster[ns.Name]; ok {
+			diff.matched++
+		} else {
+			diff.missingInCluster = append(diff.missingInCluster, ns)
+		}
+	}
+	for _, name := range clusterNames {
+		if _, ok := registered[name]; ok {
+			continue
+		}
+		if _, ok := systemNamespaces[name]; ok {
+			continue
+		}
+		diff.missingInRegistry = append(diff.missingInRegistry, name)
+	}
+	sort.Strings(diff.missingInRegistry)
+	return diff
+}
+
+func namespaceNames(items []*ent.NamespaceRegistry) []string {
+	names := make([]string, 0, len(items))
+	for _, ns := range items {
+		names = append(names, ns.Name)
+	}
+	return names
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/auditlog"
+	"kv-shepherd.io/shepherd/ent/cluster"
+	"kv-shepherd.io/shepherd/ent/namespaceregistry"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/provider"
+	"kv-shepherd.io/shepherd/internal/service"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestSyncNamespaces(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "namespace_sync_handler")
+	client.Cluster.Create().
+		SetID("cluster-prod").
+		SetName("cluster-prod").
+		SetAPIServerURL("https://cluster-prod.example.com").
+		SetEncryptedKubeconfig([]byte("x")).
+		SetEnvironment(cluster.EnvironmentProd).
+		SetCreatedBy("admin-1").
+		SaveX(t.Context())
+	for name, env := range map[string]namespaceregistry.Environment{
+		"prod-shop": namespaceregistry.EnvironmentProd,
+		"prod-gone": namespaceregistry.EnvironmentProd,
+		"test-shop": namespaceregistry.EnvironmentTest,
+	} {
+		client.NamespaceRegistry.Create().
+			SetID("ns-" + name).
+			SetName(name).
+			SetEnvironment(env).
+			SetCreatedBy("admin-1").
+			SaveX(t.Context())
+	}
+
+	mock := provider.NewMockProvider()
+	mock.SeedNamespaces("default", "kube-system", "prod-shop", "prod-unregistered", "test-shop")
+	srv := NewServer(ServerDeps{EntClient: client, VMService: service.NewVMService(mock), Audit: audit.NewLogger(client)})
+
+	sync := func(params generated.SyncNamespacesParams, perms []string) (int, generated.NamespaceSyncResult, []byte) {
+		c, w := newAuthedGinContext(t, http.MethodPost, "/admin/namespaces/sync", "", "admin-1", perms)
+		srv.SyncNamespaces(c, params)
+		var resp generated.NamespaceSyncResult
+		if w.Code == http.StatusOK {
+			mustDecodeJSON(t, w.Body.Bytes(), &resp)
+		}
+		return w.Code, resp, w.Body.Bytes()
+	}
+	manage := []string{"cluster:manage"}
+
+	code, resp, raw := sync(generated.SyncNamespacesParams{ClusterId: "cluster-prod"}, manage)
+	if code != http.StatusOK {
+		t.Fatalf("sync status = %d, body=%s", code, raw)
+	}
+	if resp.MatchedCount != 1 || len(resp.MissingInCluster) != 1 || resp.MissingInCluster[0].Name != "prod-gone" {
+		t.Fatalf("sync diff = %+v, want prod-shop matched and prod-gone missing in cluster", resp)
+	}
+	// test-shop is registered (under another environment), system namespaces are skipped.
+	if !slices.Equal(resp.MissingInRegistry, []string{"prod-unregistered"}) || len(resp.Disabled) != 0 {
+		t.Fatalf("missing_in_registry=%v disabled=%v, want [prod-unregistered] and none disabled", resp.MissingInRegistry, resp.Disabled)
+	}
+	if !client.NamespaceRegistry.GetX(t.Context(), "ns-prod-gone").Enabled {
+		t.Fatal("report-only sync must not disable namespaces")
+	}
+
+	code, resp, raw = sync(generated.SyncNamespacesParams{ClusterId: "cluster-prod", DisableMissing: true}, manage)
+	if code != http.StatusOK || !slices.Equal(resp.Disabled, []string{"prod-gone"}) || resp.MissingInCluster[0].Enabled {
+		t.Fatalf("disable sync status=%d body=%s, want prod-gone disabled", code, raw)
+	}
+	if client.NamespaceRegistry.GetX(t.Context(), "ns-prod-gone").Enabled {
+		t.Fatal("prod-gone should be disabled")
+	}
+	if n := client.AuditLog.Query().Where(auditlog.ActionEQ("namespace.sync")).CountX(t.Context()); n != 2 {
+		t.Fatalf("namespace.sync audit entries = %d, want 2", n)
+	}
+
+	if code, _, _ := sync(generated.SyncNamespacesParams{ClusterId: "cluster-missing"}, manage); code != http.StatusNotFound {
+		t.Fatalf("unknown cluster status = %d, want %d", code, http.StatusNotFound)
+	}
+	if code, _, _ := sync(generated.SyncNamespacesParams{ClusterId: "cluster-prod"}, []string{"cluster:read"}); code != http.StatusForbidden {
+		t.Fatalf("read-only status = %d, want %d", code, http.StatusForbidden)
+	}
+}
+
+func TestDiffClusterNamespaces(t *testing.T) {
+	t.Parallel()
+
+	registry := []*ent.NamespaceRegistry{
+		{Name: "prod-a", Environment: namespaceregistry.EnvironmentProd},
+		{Name: "prod-b", Environment: namespaceregistry.EnvironmentProd},
+		{Name: "test-a", Environment: namespaceregistry.EnvironmentTest},
+	}
+	diff := diffClusterNamespaces(registry, []string{"kube-public", "prod-a", "zeta", "alpha"}, namespaceregistry.EnvironmentProd)
+	if diff.matched != 1 || len(diff.missingInCluster) != 1 || diff.missingInCluster[0].Name != "prod-b" {
+		t.Fatalf("diff = %+v, want prod-a matched and prod-b missing", diff)
+	}
+	if !slices.Equal(diff.missingInRegistry, []string{"alpha", "zeta"}) {
+		t.Fatalf("missing_in_registry = %v, want sorted [alpha zeta]", diff.missingInRegistry)
+	}
+}
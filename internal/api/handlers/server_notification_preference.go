@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent/notificationpreference"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/api/middleware"
+	"kv-shepherd.io/shepherd/internal/notification"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// GetNotificationPreferences handles GET /notifications/preferences.
+func (s *Server) GetNotificationPreferences(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := middleware.GetUserID(ctx)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, generated.Error{Code: "UNAUTHORIZED"})
+		return
+	}
+
+	list, err := s.notificationPreferences(ctx, userID)
+	if err != nil {
+		logger.Error("failed to load notification preferences", zap.Error(err), zap.String("user_id", userID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	c.JSON(http.StatusOK, list)
+}
+
+// PutNotificationPreferences handles PUT /notifications/preferences.
+// The request replaces every saved preference of the user; unlisted types go
+// back to the enabled default.
+func (s *Server) PutNotificationPreferences(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := middleware.GetUserID(ctx)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, generated.Error{Code: "UNAUTHORIZED"})
+		return
+	}
+
+	var req generated.NotificationPreferencesUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+	seen := make(map[string]bool, len(req.Preferences))
+	for _, pref := range req.Preferences {
+		eventType := string(pref.EventType)
+		if !slices.Contains(notification.Types, eventType) {
+			c.JSON(http.StatusBadRequest, generated.Error{
+				Code:    "INVALID_REQUEST",
+				Message: fmt.Sprintf("unknown notification type %q", eventType),
+			})
+			return
+		}
+		if seen[eventType] {
+			c.JSON(http.StatusBadRequest, generated.Error{
+				Code:    "INVALID_REQUEST",
+				Message: fmt.Sprintf("notification type %s listed more than once", eventType),
+			})
+			return
+		}
+		seen[eventType] = true
+		if !pref.Enabled && !notification.IsMutable(eventType) {
+			c.JSON(http.StatusBadRequest, generated.Error{
+				Code:    "NOTIFICATION_TYPE_NOT_MUTABLE",
+				Message: fmt.Sprintf("notifications of type %s cannot be turned off", eventType),
+				Params:  map[string]interface{}{"event_type": eventType},
+			})
+			return
+		}
+	}
+
+	if err := s.replaceNotificationPreferences(ctx, userID, req.Preferences); err != nil {
+		logger.Error("failed to save notification preferences", zap.Error(err), zap.String("user_id", userID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	list, err := s.notificationPreferences(ctx, userID)
+	if err != nil {
+		logger.Error("failed to load notification preferences", zap.Error(err), zap.String("user_id", userID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	c.JSON(http.StatusOK, list)
+}
+
+func (s *Server) replaceNotificationPreferences(ctx context.Context, userID string, prefs []generated.NotificationPreferenceUpdate) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("start preferences transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.NotificationPreference.Delete().
+		Where(notificationpreference.UserIDEQ(userID)).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("delete notification preferences: %w", err)
+	}
+	for _, pref := range prefs {
+		if _, err := tx.NotificationPreference.Create().
+			SetID(uuid.NewString()).
+			SetUserID(userID).
+			SetEventType(notificationpreference.EventType(pref.EventType)).
+			SetEnabled(pref.Enabled).
+			Save(ctx); err != nil {
+			return fmt.Errorf("create notification preference %s: %w", pref.EventType, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// notificationPreferences lists every notification type with the user's
+// setting, defaulting to enabled.
+func (s *Server) notificationPreferences(ctx context.Context, userID string) (generated.NotificationPreferenceList, error) {
+	saved, err := s.client.NotificationPreference.Query().
+		Where(notificationpreference.UserIDEQ(userID)).
+		All(ctx)
+	if err != nil {
+		return generated.NotificationPreferenceList{}, err
+	}
+	enabled := make(map[string]bool, len(saved))
+	for _, pref := range saved {
+		enabled[pref.EventType.String()] = pref.Enabled
+	}
+
+	items := make([]generated.NotificationPreference, 0, len(notification.Types))
+	for _, eventType := range notification.Types {
+		on, ok := enabled[eventType]
+		items = append(items, generated.NotificationPreference{
+			EventType: generated.NotificationType(eventType),
+			Enabled:   !ok || on,
+			Mutable:   notification.IsMutable(eventType),
+		})
+	}
+	return generated.NotificationPreferenceList{Items: items}, nil
+}
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/notification"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestNotificationPreferences(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "notification_preferences")
+	srv := NewServer(ServerDeps{EntClient: client})
+	mustCreateUser(t, client, "user-1", "user.one")
+	mustCreateUser(t, client, "user-2", "user.two")
+
+	get := func(userID string) generated.NotificationPreferenceList {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodGet, "/notifications/preferences", "", userID, nil)
+		srv.GetNotificationPreferences(c)
+		if w.Code != http.StatusOK {
+			t.Fatalf("get preferences status = %d, want %d body=%s", w.Code, http.StatusOK, w.Body.String())
+		}
+		var list generated.NotificationPreferenceList
+		mustDecodeJSON(t, w.Body.Bytes(), &list)
+		return list
+	}
+	put := func(userID string, prefs []map[string]any) *httptest.ResponseRecorder {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodPut, "/notifications/preferences", mustJSON(t, map[string]any{"preferences": prefs}), userID, nil)
+		srv.PutNotificationPreferences(c)
+		return w
+	}
+	unread := func(userID string) int {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodGet, "/notifications/unread-count", "", userID, nil)
+		srv.GetUnreadCount(c)
+		var count generated.UnreadCount
+		mustDecodeJSON(t, w.Body.Bytes(), &count)
+		return count.Count
+	}
+
+	defaults := get("user-1")
+	if len(defaults.Items) != len(notification.Types) {
+		t.Fatalf("default preferences = %d, want %d", len(defaults.Items), len(notification.Types))
+	}
+	for _, pref := range defaults.Items {
+		wantMutable := pref.EventType != generated.APPROVALCOMPLETED && pref.EventType != generated.APPROVALREJECTED
+		if !pref.Enabled || pref.Mutable != wantMutable {
+			t.Fatalf("default preference %+v, want enabled with mutable=%v", pref, wantMutable)
+		}
+	}
+
+	w := put("user-1", []map[string]any{{"event_type": "APPROVAL_REJECTED", "enabled": false}})
+	assertStatusAndCode(t, w, http.StatusBadRequest, "NOTIFICATION_TYPE_NOT_MUTABLE")
+	for _, prefs := range [][]map[string]any{
+		{{"event_type": "VM_DELETED", "enabled": false}},
+		{{"event_type": "APPROVAL_COMMENT", "enabled": false}, {"event_type": "APPROVAL_COMMENT", "enabled": true}},
+	} {
+		if w := put("user-1", prefs); w.Code != http.StatusBadRequest {
+			t.Fatalf("put %v status = %d, want %d", prefs, w.Code, http.StatusBadRequest)
+		}
+	}
+
+	w = put("user-1", []map[string]any{
+		{"event_type": "APPROVAL_PENDING", "enabled": false},
+		{"event_type": "APPROVAL_COMMENT", "enabled": false},
+		{"event_type": "APPROVAL_COMPLETED", "enabled": true},
+	})
+	if w.Code != http.StatusOK {
+		t.Fatalf("put preferences status = %d, want %d body=%s", w.Code, http.StatusOK, w.Body.String())
+	}
+	saved := map[generated.NotificationType]bool{}
+	for _, pref := range get("user-1").Items {
+		saved[pref.EventType] = pref.Enabled
+	}
+	if saved[generated.APPROVALPENDING] || saved[generated.APPROVALCOMMENT] || !saved[generated.VMSTATUSCHANGE] {
+		t.Fatalf("saved preferences = %v, want pending and comment muted, others enabled", saved)
+	}
+
+	triggers := notification.NewTriggers(notification.NewInboxSender(client), client)
+	ctx := t.Context()
+	triggers.OnTicketReassigned(ctx, "ticket-1", "user-1", "admin")
+	triggers.OnTicketReassigned(ctx, "ticket-1", "user-2", "admin")
+	triggers.OnTicketCommented(ctx, "ticket-2", "user-1", "user-2", "please resize")
+	triggers.OnTicketRejected(ctx, "ticket-3", "user-1", "admin", "quota")
+	if got := unread("user-1"); got != 1 {
+		t.Fatalf("user-1 unread = %d, want 1 (only the non-mutable rejection)", got)
+	}
+	if got := unread("user-2"); got != 1 {
+		t.Fatalf("user-2 unread = %d, want 1 (no preferences saved)", got)
+	}
+
+	// Unlisted types go back to enabled.
+	if w := put("user-1", []map[string]any{}); w.Code != http.StatusOK {
+		t.Fatalf("reset preferences status = %d, want %d", w.Code, http.StatusOK)
+	}
+	triggers.OnTicketReassigned(ctx, "ticket-4", "user-1", "admin")
+	if got := unread("user-1"); got != 2 {
+		t.Fatalf("user-1 unread after reset = %d, want 2", got)
+	}
+	if n := client.NotificationPreference.Query().CountX(ctx); n != 0 {
+		t.Fatalf("stored preferences after reset = %d, want 0", n)
+	}
+}
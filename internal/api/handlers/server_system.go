@@ -38,7 +38,14 @@ func (s *Server) ListSystems(c *gin.Context, params generated.ListSystemsParams)
 			return
 		}
 
-		if len(bindings) == 0 {
+		maintainedServices, err := s.maintainedServiceIDs(ctx, actor, "")
+		if err != nil {
+			logger.Error("failed to query maintained services", zap.Error(err), zap.String("actor", actor))
+			c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+			return
+		}
+
+		if len(bindings) == 0 && len(maintainedServices) == 0 {
 			page, perPage := defaultPagination(params.Page, params.PerPage)
 			c.JSON(http.StatusOK, generated.SystemList{
 				Items: []generated.System{},
@@ -61,7 +68,11 @@ func (s *Server) ListSystems(c *gin.Context, params generated.ListSystemsParams)
 			seen[b.ResourceID] = struct{}{}
 			systemIDs = append(systemIDs, b.ResourceID)
 		}
-		query = query.Where(entsystem.IDIn(systemIDs...))
+		// Service maintainers can see the parent system of their services.
+		query = query.Where(entsystem.Or(
+			entsystem.IDIn(systemIDs...),
+			entsystem.HasServicesWith(entservice.IDIn(maintainedServices...)),
+		))
 	}
 
 	// Pagination.
@@ -86,9 +97,22 @@ func (s *Server) ListSystems(c *gin.Context, params generated.ListSystemsParams)
 		return
 	}
 
+	systemIDs := make([]string, 0, len(systems))
+	for _, sys := range systems {
+		systemIDs = append(systemIDs, sys.ID)
+	}
+	maintainers, err := s.loadMaintainers(ctx, "system", systemIDs)
+	if err != nil {
+		logger.Error("failed to load system maintainers", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
 	items := make([]generated.System, 0, len(systems))
 	for _, sys := range systems {
-		items = append(items, systemToAPI(sys))
+		item := systemToAPI(sys)
+		item.Maintainers = maintainers[sys.ID]
+		items = append(items, item)
 	}
 
 	totalPages := (total + perPage - 1) / perPage
@@ -192,7 +216,7 @@ func (s *Server) GetSystem(c *gin.Context, systemId generated.SystemID) {
 	if !requireGlobalPermission(c, "system:read") {
 		return
 	}
-	if _, ok := s.requireSystemRole(c, systemId, "view"); !ok {
+	if _, ok := s.requireSystemView(c, systemId); !ok {
 		return
 	}
 
@@ -207,7 +231,15 @@ func (s *Server) GetSystem(c *gin.Context, systemId generated.SystemID) {
 		return
 	}
 
-	c.JSON(http.StatusOK, systemToAPI(sys))
+	maintainers, err := s.loadMaintainers(ctx, "system", []string{sys.ID})
+	if err != nil {
+		logger.Error("failed to load system maintainers", zap.Error(err), zap.String("system_id", systemId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	item := systemToAPI(sys)
+	item.Maintainers = maintainers[sys.ID]
+	c.JSON(http.StatusOK, item)
 }
 
 // UpdateSystem handles PATCH /systems/{system_id}.
@@ -275,21 +307,6 @@ func (s *Server) DeleteSystem(c *gin.Context, systemId generated.SystemID, param
 		return
 	}
 
-	// Check for child services via edge.
-	count, err := s.client.System.Query().
-		Where(entsystem.IDEQ(systemId)).
-		QueryServices().
-		Count(ctx)
-	if err != nil {
-		logger.Error("failed to count system services", zap.Error(err), zap.String("system_id", systemId))
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
-	}
-	if count > 0 {
-		c.JSON(http.StatusConflict, generated.Error{Code: "SYSTEM_HAS_SERVICES"})
-		return
-	}
-
 	sys, err := s.client.System.Get(ctx, systemId)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -309,15 +326,25 @@ func (s *Server) DeleteSystem(c *gin.Context, systemId generated.SystemID, param
 		})
 		return
 	}
+	if !validDeleteCascade(string(params.Cascade)) {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "cascade must be requests"})
+		return
+	}
 
-	if err := s.client.System.DeleteOneID(systemId).Exec(ctx); err != nil {
-		logger.Error("failed to delete system", zap.Error(err), zap.String("system_id", systemId))
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+	// Child services go with the system under the service delete guard
+	// (SERVICE_HAS_VMS / SERVICE_HAS_PENDING_REQUESTS).
+	cascade := params.Cascade == generated.DeleteSystemParamsCascade(generated.DeleteCascadeRequests)
+	result, err := s.deleteServicesGuarded(ctx, systemId, nil, cascade)
+	if err != nil {
+		respondDeleteGuardError(c, err, zap.String("system_id", systemId))
 		return
 	}
 
 	if s.audit != nil {
-		if err := s.audit.LogAction(ctx, "system.delete", "system", systemId, actor, nil); err != nil {
+		if err := s.audit.LogAction(ctx, "system.delete", "system", systemId, actor, map[string]interface{}{
+			"service_ids":          result.serviceIDs,
+			"cancelled_ticket_ids": result.cancelledTicketIDs,
+		}); err != nil {
 			logger.Warn("audit log write failed",
 				zap.Error(err),
 				zap.String("action", "system.delete"),
@@ -335,7 +362,8 @@ func (s *Server) ListServices(c *gin.Context, systemId generated.SystemID, param
 	if !requireGlobalPermission(c, "service:read") {
 		return
 	}
-	if _, ok := s.requireSystemRole(c, systemId, "view"); !ok {
+	serviceIDs, ok := s.requireSystemView(c, systemId)
+	if !ok {
 		return
 	}
 
@@ -343,6 +371,9 @@ func (s *Server) ListServices(c *gin.Context, systemId generated.SystemID, param
 	query := s.client.System.Query().
 		Where(entsystem.IDEQ(systemId)).
 		QueryServices()
+	if serviceIDs != nil {
+		query = query.Where(entservice.IDIn(serviceIDs...))
+	}
 
 	page, perPage := defaultPagination(params.Page, params.PerPage)
 	offset := (page - 1) * perPage
@@ -365,9 +396,22 @@ func (s *Server) ListServices(c *gin.Context, systemId generated.SystemID, param
 		return
 	}
 
+	ids := make([]string, 0, len(services))
+	for _, svc := range services {
+		ids = append(ids, svc.ID)
+	}
+	maintainers, err := s.loadMaintainers(ctx, "service", ids)
+	if err != nil {
+		logger.Error("failed to load service maintainers", zap.Error(err), zap.String("system_id", systemId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
 	items := make([]generated.Service, 0, len(services))
 	for _, svc := range services {
-		items = append(items, serviceToAPI(svc, systemId))
+		item := serviceToAPI(svc, systemId)
+		item.Maintainers = maintainers[svc.ID]
+		items = append(items, item)
 	}
 
 	totalPages := (total + perPage - 1) / perPage
@@ -455,7 +499,12 @@ func (s *Server) GetService(c *gin.Context, systemId generated.SystemID, service
 	if !requireGlobalPermission(c, "service:read") {
 		return
 	}
-	if _, ok := s.requireSystemRole(c, systemId, "view"); !ok {
+	serviceIDs, ok := s.requireSystemView(c, systemId)
+	if !ok {
+		return
+	}
+	if !containsServiceID(serviceIDs, serviceId) {
+		c.JSON(http.StatusForbidden, generated.Error{Code: "FORBIDDEN"})
 		return
 	}
 
@@ -480,7 +529,15 @@ func (s *Server) GetService(c *gin.Context, systemId generated.SystemID, service
 		return
 	}
 
-	c.JSON(http.StatusOK, serviceToAPI(svc, systemId))
+	maintainers, err := s.loadMaintainers(ctx, "service", []string{svc.ID})
+	if err != nil {
+		logger.Error("failed to load service maintainers", zap.Error(err), zap.String("service_id", serviceId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	item := serviceToAPI(svc, systemId)
+	item.Maintainers = maintainers[svc.ID]
+	c.JSON(http.StatusOK, item)
 }
 
 // UpdateService handles PATCH /systems/{system_id}/services/{service_id}.
@@ -589,38 +646,26 @@ func (s *Server) DeleteService(c *gin.Context, systemId generated.SystemID, serv
 		})
 		return
 	}
-
-	// Cascade constraint: must have zero child VMs.
-	vmCount, err := svc.QueryVms().Count(ctx)
-	if err != nil {
-		logger.Error("failed to count service VMs",
-			zap.Error(err),
-			zap.String("service_id", serviceId),
-		)
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
-	}
-	if vmCount > 0 {
-		c.JSON(http.StatusConflict, generated.Error{
-			Code:    "SERVICE_HAS_VMS",
-			Message: "cannot delete service with existing VMs; delete all VMs first",
-		})
+	if !validDeleteCascade(string(params.Cascade)) {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "cascade must be requests"})
 		return
 	}
 
-	// Hard delete.
-	if err := s.client.Service.DeleteOneID(serviceId).Exec(ctx); err != nil {
-		logger.Error("failed to delete service",
-			zap.Error(err),
-			zap.String("service_id", serviceId),
-		)
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+	// Cascade constraint: must have zero child VMs (SERVICE_HAS_VMS), checked
+	// in the delete transaction.
+	cascade := params.Cascade == generated.DeleteServiceParamsCascade(generated.DeleteCascadeRequests)
+	result, err := s.deleteServicesGuarded(ctx, "", []string{svc.ID}, cascade)
+	if err != nil {
+		respondDeleteGuardError(c, err, zap.String("service_id", serviceId))
 		return
 	}
 
 	if s.audit != nil {
 		if err := s.audit.LogAction(ctx, "service.delete", "service", serviceId, actor,
-			map[string]interface{}{"system_id": systemId}); err != nil {
+			map[string]interface{}{
+				"system_id":            systemId,
+				"cancelled_ticket_ids": result.cancelledTicketIDs,
+			}); err != nil {
 			logger.Warn("audit log write failed",
 				zap.Error(err),
 				zap.String("action", "service.delete"),
@@ -654,6 +699,7 @@ func serviceToAPI(svc *ent.Service, systemId string) generated.Service {
 		Description:       svc.Description,
 		SystemId:          systemId,
 		NextInstanceIndex: svc.NextInstanceIndex,
+		Labels:            svc.Labels,
 		CreatedAt:         svc.CreatedAt,
 	}
 }
@@ -182,10 +182,11 @@ func TestSystemHandler_DeleteSystem_RequiresConfirmNameMatch(t *testing.T) {
 	assertErrorCode(t, w2.Body.Bytes(), "DELETE_CONFIRMATION_REQUIRED")
 }
 
-func TestSystemHandler_DeleteSystem_ConflictWhenServicesExist(t *testing.T) {
+func TestSystemHandler_DeleteSystem_ConflictWhenServiceVMsExist(t *testing.T) {
 	srv, client := newSystemBehaviorTestServer(t)
 	sys := mustCreateSystem(t, client, "sys-del", "shop", "owner-1")
-	_ = mustCreateService(t, client, "svc-del", "redis", sys.ID, "svc")
+	svc := mustCreateService(t, client, "svc-del", "redis", sys.ID, "svc")
+	mustCreateVMForService(t, client, "vm-del-1", "shop-redis-01", svc.ID)
 	mustCreateSystemBinding(t, client, "owner-1", sys.ID, "owner")
 
 	c, w := newAuthedGinContext(
@@ -200,7 +201,10 @@ func TestSystemHandler_DeleteSystem_ConflictWhenServicesExist(t *testing.T) {
 	if w.Code != http.StatusConflict {
 		t.Fatalf("status = %d, want %d body=%s", w.Code, http.StatusConflict, w.Body.String())
 	}
-	assertErrorCode(t, w.Body.Bytes(), "SYSTEM_HAS_SERVICES")
+	assertErrorCode(t, w.Body.Bytes(), "SERVICE_HAS_VMS")
+	if _, err := client.Service.Get(t.Context(), svc.ID); err != nil {
+		t.Fatalf("service removed by rejected system delete, err=%v", err)
+	}
 }
 
 func TestSystemHandler_DeleteSystem_Success(t *testing.T) {
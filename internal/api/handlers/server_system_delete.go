@@ -0,0 +1,227 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/ent/predicate"
+	"kv-shepherd.io/shepherd/ent/quota"
+	entservice "kv-shepherd.io/shepherd/ent/service"
+	entsystem "kv-shepherd.io/shepherd/ent/system"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// deleteGuardSampleSize caps the VM names and ticket IDs reported by a
+// rejected service/system delete.
+const deleteGuardSampleSize = 5
+
+// serviceDeleteResult describes what a guarded delete removed.
+type serviceDeleteResult struct {
+	serviceIDs         []string
+	cancelledTicketIDs []string
+}
+
+// validDeleteCascade reports whether cascade is empty or "requests".
+func validDeleteCascade(cascade string) bool {
+	return cascade == "" || cascade == string(generated.DeleteCascadeRequests)
+}
+
+// deleteServicesGuarded deletes the given services (and, when systemID is
+// set, the system) in one transaction.
+//
+// The rows are locked FOR UPDATE first: a concurrent VM creation approval
+// either commits before the VM count below and rejects the delete, or fails
+// its foreign-key check on the service once the delete commits.
+//
+// Any VM of the services rejects the delete with SERVICE_HAS_VMS. Pending
+// approval tickets tied to the services reject it with
+// SERVICE_HAS_PENDING_REQUESTS unless cascadeRequests is set, in which case
+// they are cancelled in the same transaction.
+func (s *Server) deleteServicesGuarded(
+	ctx context.Context,
+	systemID string,
+	serviceIDs []string,
+	cascadeRequests bool,
+) (serviceDeleteResult, error) {
+	var result serviceDeleteResult
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return result, fmt.Errorf("start delete transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if systemID != "" {
+		if _, err := tx.ExecContext(ctx,
+			fmt.Sprintf("SELECT 1 FROM %s WHERE %s = $1 FOR UPDATE", entsystem.Table, entsystem.FieldID),
+			systemID); err != nil {
+			return result, fmt.Errorf("lock system %s: %w", systemID, err)
+		}
+		// Re-read under the lock so services added concurrently are included.
+		if serviceIDs, err = tx.Service.Query().
+			Where(entservice.HasSystemWith(entsystem.IDEQ(systemID))).
+			IDs(ctx); err != nil {
+			return result, fmt.Errorf("list services of system %s: %w", systemID, err)
+		}
+	}
+	sort.Strings(serviceIDs)
+	for _, id := range serviceIDs {
+		if _, err := tx.ExecContext(ctx,
+			fmt.Sprintf("SELECT 1 FROM %s WHERE %s = $1 FOR UPDATE", entservice.Table, entservice.FieldID),
+			id); err != nil {
+			return result, fmt.Errorf("lock service %s: %w", id, err)
+		}
+	}
+
+	if len(serviceIDs) > 0 {
+		if err := guardServiceVMs(ctx, tx, serviceIDs); err != nil {
+			return result, err
+		}
+		cancelled, err := cancelServicePendingTickets(ctx, tx, serviceIDs, cascadeRequests)
+		if err != nil {
+			return result, err
+		}
+		result.cancelledTicketIDs = cancelled
+
+		if _, err := tx.Service.Delete().Where(entservice.IDIn(serviceIDs...)).Exec(ctx); err != nil {
+			if ent.IsConstraintError(err) {
+				return result, apperrors.Conflict("SERVICE_HAS_VMS",
+					"cannot delete service with existing VMs; delete all VMs first")
+			}
+			return result, fmt.Errorf("delete services: %w", err)
+		}
+	}
+	if systemID != "" {
+		if err := tx.System.DeleteOneID(systemID).Exec(ctx); err != nil {
+			if ent.IsNotFound(err) {
+				return result, apperrors.NotFound("SYSTEM_NOT_FOUND", fmt.Sprintf("system %s not found", systemID))
+			}
+			return result, fmt.Errorf("delete system %s: %w", systemID, err)
+		}
+		if _, err := tx.Quota.Delete().
+			Where(quota.ScopeTypeEQ(quota.ScopeTypeSystem), quota.ScopeIDEQ(systemID)).
+			Exec(ctx); err != nil {
+			return result, fmt.Errorf("delete quota of system %s: %w", systemID, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return result, fmt.Errorf("commit delete transaction: %w", err)
+	}
+	result.serviceIDs = serviceIDs
+	return result, nil
+}
+
+// guardServiceVMs rejects the delete when any VM references the services.
+// VM rows are removed once deleted, so every remaining row counts.
+func guardServiceVMs(ctx context.Context, tx *ent.Tx, serviceIDs []string) error {
+	query := tx.VM.Query().Where(entvm.HasServiceWith(entservice.IDIn(serviceIDs...)))
+	count, err := query.Clone().Count(ctx)
+	if err != nil {
+		return fmt.Errorf("count service VMs: %w", err)
+	}
+	if count == 0 {
+		return nil
+	}
+	names, err := query.
+		Order(ent.Asc(entvm.FieldName)).
+		Limit(deleteGuardSampleSize).
+		Select(entvm.FieldName).
+		Strings(ctx)
+	if err != nil {
+		return fmt.Errorf("sample service VMs: %w", err)
+	}
+	blocking, err := tx.Service.Query().
+		Where(entservice.IDIn(serviceIDs...), entservice.HasVms()).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("list services with VMs: %w", err)
+	}
+	sort.Strings(blocking)
+	return apperrors.Conflict("SERVICE_HAS_VMS",
+		fmt.Sprintf("cannot delete service with %d existing VMs; delete all VMs first", count),
+	).WithParams(map[string]interface{}{
+		"vm_count":    count,
+		"vm_names":    names,
+		"service_ids": blocking,
+	})
+}
+
+// cancelServicePendingTickets cancels the pending approval tickets tied to
+// the services, or rejects the delete when cascade is false.
+func cancelServicePendingTickets(ctx context.Context, tx *ent.Tx, serviceIDs []string, cascade bool) ([]string, error) {
+	servicePredicates := make([]predicate.ApprovalTicket, 0, len(serviceIDs))
+	for _, id := range serviceIDs {
+		servicePredicates = append(servicePredicates, approvalServicePredicate(id))
+	}
+	tickets, err := tx.ApprovalTicket.Query().
+		Where(
+			approvalticket.StatusEQ(approvalticket.StatusPENDING),
+			approvalticket.Or(servicePredicates...),
+		).
+		Order(ent.Asc(approvalticket.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list pending tickets for services: %w", err)
+	}
+	if len(tickets) == 0 {
+		return nil, nil
+	}
+
+	ticketIDs := make([]string, 0, len(tickets))
+	eventIDs := make([]string, 0, len(tickets))
+	for _, ticket := range tickets {
+		ticketIDs = append(ticketIDs, ticket.ID)
+		eventIDs = append(eventIDs, ticket.EventID)
+	}
+	if !cascade {
+		sample := ticketIDs
+		if len(sample) > deleteGuardSampleSize {
+			sample = sample[:deleteGuardSampleSize]
+		}
+		return nil, apperrors.Conflict("SERVICE_HAS_PENDING_REQUESTS",
+			fmt.Sprintf("%d pending approval requests reference the service; retry with cascade=requests to cancel them", len(tickets)),
+		).WithParams(map[string]interface{}{
+			"ticket_count": len(tickets),
+			"ticket_ids":   sample,
+		})
+	}
+
+	if _, err := tx.ApprovalTicket.Update().
+		Where(approvalticket.IDIn(ticketIDs...)).
+		SetStatus(approvalticket.StatusCANCELLED).
+		SetRejectReason("service deleted").
+		Save(ctx); err != nil {
+		return nil, fmt.Errorf("cancel pending tickets: %w", err)
+	}
+	if _, err := tx.DomainEvent.Update().
+		Where(domainevent.IDIn(eventIDs...)).
+		SetStatus(domainevent.StatusCANCELLED).
+		Save(ctx); err != nil {
+		return nil, fmt.Errorf("cancel pending ticket events: %w", err)
+	}
+	return ticketIDs, nil
+}
+
+// respondDeleteGuardError writes the response for a failed guarded delete.
+func respondDeleteGuardError(c *gin.Context, err error, fields ...zap.Field) {
+	if appErr, ok := apperrors.IsAppError(err); ok {
+		c.JSON(appErr.HTTPStatus, generated.Error{
+			Code:    appErr.Code,
+			Message: appErr.Message,
+			Params:  appErr.Params,
+		})
+		return
+	}
+	logger.Error("guarded delete failed", append(fields, zap.Error(err))...)
+	c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+}
@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/domain"
+)
+
+// mustSeedServiceCreateTicket seeds a pending CREATE request for serviceID.
+func mustSeedServiceCreateTicket(t *testing.T, client *ent.Client, serviceID string) string {
+	t.Helper()
+
+	eventID := "ev-" + uuid.NewString()
+	ticketID := "ticket-" + uuid.NewString()
+	client.DomainEvent.Create().
+		SetID(eventID).
+		SetEventType(string(domain.EventVMCreationRequested)).
+		SetAggregateType("vm").
+		SetAggregateID(serviceID).
+		SetPayload([]byte(mustJSON(t, map[string]string{"service_id": serviceID, "namespace": "prod-shop"}))).
+		SetStatus(domainevent.StatusPENDING).
+		SetCreatedBy("owner-1").
+		SaveX(t.Context())
+	client.ApprovalTicket.Create().
+		SetID(ticketID).
+		SetEventID(eventID).
+		SetRequester("owner-1").
+		SetOperationType(approvalticket.OperationTypeCREATE).
+		SaveX(t.Context())
+	return ticketID
+}
+
+func TestSystemHandler_DeleteService_VMGuardReportsSample(t *testing.T) {
+	srv, client := newSystemBehaviorTestServer(t)
+	sys := mustCreateSystem(t, client, "sys-del", "shop", "owner-1")
+	svc := mustCreateService(t, client, "svc-del", "redis", sys.ID, "svc")
+	for i := 1; i <= deleteGuardSampleSize+1; i++ {
+		mustCreateVMForService(t, client, fmt.Sprintf("vm-del-%d", i), fmt.Sprintf("shop-redis-%02d", i), svc.ID)
+	}
+	mustCreateSystemBinding(t, client, "owner-1", sys.ID, "owner")
+
+	c, w := newAuthedGinContext(t, http.MethodDelete, "/systems/"+sys.ID+"/services/"+svc.ID+"?confirm=true&cascade=requests",
+		"", "owner-1", []string{"service:delete"})
+	srv.DeleteService(c, sys.ID, svc.ID, generated.DeleteServiceParams{Confirm: true, Cascade: "requests"})
+	assertStatusAndCode(t, w, http.StatusConflict, "SERVICE_HAS_VMS")
+
+	var resp generated.Error
+	mustDecodeJSON(t, w.Body.Bytes(), &resp)
+	if got, _ := resp.Params["vm_count"].(float64); int(got) != deleteGuardSampleSize+1 {
+		t.Fatalf("vm_count = %v, want %d", resp.Params["vm_count"], deleteGuardSampleSize+1)
+	}
+	names, _ := resp.Params["vm_names"].([]any)
+	if len(names) != deleteGuardSampleSize || names[0] != "shop-redis-01" {
+		t.Fatalf("vm_names = %v, want the first %d names", names, deleteGuardSampleSize)
+	}
+	if _, err := client.Service.Get(t.Context(), svc.ID); err != nil {
+		t.Fatalf("service removed despite VMs, err=%v", err)
+	}
+}
+
+func TestSystemHandler_DeleteService_PendingRequests(t *testing.T) {
+	srv, client := newSystemBehaviorTestServer(t)
+	sys := mustCreateSystem(t, client, "sys-del", "shop", "owner-1")
+	svc := mustCreateService(t, client, "svc-del", "redis", sys.ID, "svc")
+	other := mustCreateService(t, client, "svc-keep", "mysql", sys.ID, "svc")
+	mustCreateSystemBinding(t, client, "owner-1", sys.ID, "owner")
+	ticketID := mustSeedServiceCreateTicket(t, client, svc.ID)
+	otherTicketID := mustSeedServiceCreateTicket(t, client, other.ID)
+
+	deleteService := func(params generated.DeleteServiceParams) (int, []byte) {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodDelete, "/systems/"+sys.ID+"/services/"+svc.ID, "", "owner-1", []string{"service:delete"})
+		srv.DeleteService(c, sys.ID, svc.ID, params)
+		return c.Writer.Status(), w.Body.Bytes()
+	}
+
+	status, body := deleteService(generated.DeleteServiceParams{Confirm: true, Cascade: "everything"})
+	if status != http.StatusBadRequest {
+		t.Fatalf("invalid cascade status = %d, want %d body=%s", status, http.StatusBadRequest, body)
+	}
+	assertErrorCode(t, body, "INVALID_REQUEST")
+
+	// Without cascade the pending request blocks the delete.
+	status, body = deleteService(generated.DeleteServiceParams{Confirm: true})
+	if status != http.StatusConflict {
+		t.Fatalf("guarded status = %d, want %d body=%s", status, http.StatusConflict, body)
+	}
+	assertErrorCode(t, body, "SERVICE_HAS_PENDING_REQUESTS")
+	if got := client.ApprovalTicket.GetX(t.Context(), ticketID).Status; got != approvalticket.StatusPENDING {
+		t.Fatalf("ticket status after guarded delete = %s, want PENDING", got)
+	}
+
+	status, body = deleteService(generated.DeleteServiceParams{Confirm: true, Cascade: "requests"})
+	if status != http.StatusNoContent {
+		t.Fatalf("cascade status = %d, want %d body=%s", status, http.StatusNoContent, body)
+	}
+	if _, err := client.Service.Get(t.Context(), svc.ID); !ent.IsNotFound(err) {
+		t.Fatalf("service still exists after cascade delete, err=%v", err)
+	}
+	ticket := client.ApprovalTicket.GetX(t.Context(), ticketID)
+	if ticket.Status != approvalticket.StatusCANCELLED {
+		t.Fatalf("ticket status = %s, want CANCELLED", ticket.Status)
+	}
+	if got := client.DomainEvent.GetX(t.Context(), ticket.EventID).Status; got != domainevent.StatusCANCELLED {
+		t.Fatalf("ticket event status = %s, want CANCELLED", got)
+	}
+	if got := client.ApprovalTicket.GetX(t.Context(), otherTicketID).Status; got != approvalticket.StatusPENDING {
+		t.Fatalf("other service ticket status = %s, want PENDING", got)
+	}
+}
+
+func TestSystemHandler_DeleteSystem_DeletesServicesTransitively(t *testing.T) {
+	srv, client := newSystemBehaviorTestServer(t)
+	sys := mustCreateSystem(t, client, "sys-del", "shop", "owner-1")
+	svcA := mustCreateService(t, client, "svc-a", "redis", sys.ID, "svc")
+	svcB := mustCreateService(t, client, "svc-b", "mysql", sys.ID, "svc")
+	mustCreateSystemBinding(t, client, "owner-1", sys.ID, "owner")
+	ticketID := mustSeedServiceCreateTicket(t, client, svcB.ID)
+
+	deleteSystem := func(params generated.DeleteSystemParams) (int, []byte) {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodDelete, "/systems/"+sys.ID, "", "owner-1", []string{"system:delete"})
+		srv.DeleteSystem(c, sys.ID, params)
+		return c.Writer.Status(), w.Body.Bytes()
+	}
+
+	status, body := deleteSystem(generated.DeleteSystemParams{ConfirmName: "shop"})
+	if status != http.StatusConflict {
+		t.Fatalf("guarded status = %d, want %d body=%s", status, http.StatusConflict, body)
+	}
+	assertErrorCode(t, body, "SERVICE_HAS_PENDING_REQUESTS")
+	if _, err := client.Service.Get(t.Context(), svcA.ID); err != nil {
+		t.Fatalf("service removed by rejected system delete, err=%v", err)
+	}
+
+	status, body = deleteSystem(generated.DeleteSystemParams{ConfirmName: "shop", Cascade: "requests"})
+	if status != http.StatusNoContent {
+		t.Fatalf("cascade status = %d, want %d body=%s", status, http.StatusNoContent, body)
+	}
+	if _, err := client.System.Get(t.Context(), sys.ID); !ent.IsNotFound(err) {
+		t.Fatalf("system still exists after delete, err=%v", err)
+	}
+	for _, id := range []string{svcA.ID, svcB.ID} {
+		if _, err := client.Service.Get(t.Context(), id); !ent.IsNotFound(err) {
+			t.Fatalf("service %s still exists after system delete, err=%v", id, err)
+		}
+	}
+	if got := client.ApprovalTicket.GetX(t.Context(), ticketID).Status; got != approvalticket.StatusCANCELLED {
+		t.Fatalf("ticket status = %s, want CANCELLED", got)
+	}
+}
@@ -0,0 +1,408 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	rrb "kv-shepherd.io/shepherd/ent/resourcerolebinding"
+	entservice "kv-shepherd.io/shepherd/ent/service"
+	entsystem "kv-shepherd.io/shepherd/ent/system"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/api/middleware"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// Maintainers are ResourceRoleBindings with role "maintainer" on a system or
+// service. They keep a system manageable after its creator leaves without
+// needing platform admin intervention.
+
+// AddSystemMaintainer handles POST /systems/{system_id}/maintainers.
+func (s *Server) AddSystemMaintainer(c *gin.Context, systemId generated.SystemID) {
+	actor, ok := s.requireSystemExists(c, systemId)
+	if !ok {
+		return
+	}
+	if !s.requireMaintainerManager(c, actor, "system", systemId) {
+		return
+	}
+
+	binding, user, ok := s.grantMaintainer(c, actor, "system", systemId)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusCreated, toSystemMember(binding, user))
+}
+
+// RemoveSystemMaintainer handles DELETE /systems/{system_id}/maintainers/{user_id}.
+func (s *Server) RemoveSystemMaintainer(c *gin.Context, systemId generated.SystemID, userId generated.UserID) {
+	actor, ok := s.requireSystemExists(c, systemId)
+	if !ok {
+		return
+	}
+	if !s.requireMaintainerManager(c, actor, "system", systemId) {
+		return
+	}
+	if !s.revokeMaintainer(c, actor, "system", systemId, userId) {
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// AddServiceMaintainer handles POST /systems/{system_id}/services/{service_id}/maintainers.
+func (s *Server) AddServiceMaintainer(c *gin.Context, systemId generated.SystemID, serviceId generated.ServiceID) {
+	actor, ok := s.requireSystemService(c, systemId, serviceId)
+	if !ok {
+		return
+	}
+	if !s.requireMaintainerManager(c, actor, "service", serviceId) {
+		return
+	}
+
+	binding, _, ok := s.grantMaintainer(c, actor, "service", serviceId)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusCreated, generated.ServiceRoleBinding{
+		Id:        binding.ID,
+		UserId:    binding.UserID,
+		ServiceId: binding.ResourceID,
+		Role:      generated.ServiceRoleBindingRole(binding.Role.String()),
+		CreatedBy: binding.CreatedBy,
+		CreatedAt: binding.CreatedAt,
+	})
+}
+
+// RemoveServiceMaintainer handles DELETE /systems/{system_id}/services/{service_id}/maintainers/{user_id}.
+func (s *Server) RemoveServiceMaintainer(c *gin.Context, systemId generated.SystemID, serviceId generated.ServiceID, userId generated.UserID) {
+	actor, ok := s.requireSystemService(c, systemId, serviceId)
+	if !ok {
+		return
+	}
+	if !s.requireMaintainerManager(c, actor, "service", serviceId) {
+		return
+	}
+	if !s.revokeMaintainer(c, actor, "service", serviceId, userId) {
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// requireSystemExists resolves the actor and verifies the system exists.
+func (s *Server) requireSystemExists(c *gin.Context, systemID string) (string, bool) {
+	ctx := c.Request.Context()
+	actor := middleware.GetUserID(ctx)
+	if actor == "" {
+		c.JSON(http.StatusUnauthorized, generated.Error{Code: "UNAUTHORIZED"})
+		return "", false
+	}
+	exists, err := s.client.System.Query().Where(entsystem.IDEQ(systemID)).Exist(ctx)
+	if err != nil {
+		logger.Error("failed to get system for maintainer operation", zap.Error(err), zap.String("system_id", systemID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return "", false
+	}
+	if !exists {
+		c.JSON(http.StatusNotFound, generated.Error{Code: "SYSTEM_NOT_FOUND"})
+		return "", false
+	}
+	return actor, true
+}
+
+// requireSystemService resolves the actor and verifies the service belongs to the system.
+func (s *Server) requireSystemService(c *gin.Context, systemID, serviceID string) (string, bool) {
+	actor, ok := s.requireSystemExists(c, systemID)
+	if !ok {
+		return "", false
+	}
+	ctx := c.Request.Context()
+	exists, err := s.client.Service.Query().
+		Where(
+			entservice.IDEQ(serviceID),
+			entservice.HasSystemWith(entsystem.IDEQ(systemID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		logger.Error("failed to get service for maintainer operation",
+			zap.Error(err),
+			zap.String("system_id", systemID),
+			zap.String("service_id", serviceID),
+		)
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return "", false
+	}
+	if !exists {
+		c.JSON(http.StatusNotFound, generated.Error{Code: "SERVICE_NOT_FOUND"})
+		return "", false
+	}
+	return actor, true
+}
+
+// requireMaintainerManager gates maintainer mutation on the resource.
+//
+// Rules:
+// - platform:admin => allowed
+// - an existing maintainer of the resource (or of the parent system for services) => allowed
+// - global system:write combined with a resource role that may update it => allowed
+// - otherwise => 403
+//
+// system:write alone is not enough: it is granted platform-wide, so it must be
+// paired with a role on this specific resource to avoid cross-system takeover.
+func (s *Server) requireMaintainerManager(c *gin.Context, actor, resourceType, resourceID string) bool {
+	if hasPlatformAdmin(c) {
+		return true
+	}
+	ctx := c.Request.Context()
+	role, found, err := middleware.NewResourceRoleChecker(s.client).CheckResourceRole(ctx, actor, resourceType, resourceID)
+	if err != nil {
+		logger.Error("failed to check role for maintainer operation",
+			zap.Error(err),
+			zap.String("resource_type", resourceType),
+			zap.String("resource_id", resourceID),
+			zap.String("actor", actor),
+		)
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return false
+	}
+	if found && (role == middleware.ResourceRoleMaintainer ||
+		(hasAnyGlobalPermission(c, "system:write") && middleware.RoleCanPerform(role, "update"))) {
+		return true
+	}
+	c.JSON(http.StatusForbidden, generated.Error{Code: "FORBIDDEN"})
+	return false
+}
+
+// grantMaintainer binds the requested user as maintainer of the resource.
+// Member and viewer bindings are upgraded in place; owner and admin bindings
+// already outrank maintainer and are reported as a conflict.
+func (s *Server) grantMaintainer(c *gin.Context, actor, resourceType, resourceID string) (*ent.ResourceRoleBinding, *ent.User, bool) {
+	ctx := c.Request.Context()
+	var req generated.MaintainerRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.UserId == "" {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return nil, nil, false
+	}
+
+	userEnt, err := s.client.User.Get(ctx, req.UserId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "USER_NOT_FOUND"})
+			return nil, nil, false
+		}
+		logger.Error("failed to get user for maintainer add", zap.Error(err), zap.String("user_id", req.UserId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return nil, nil, false
+	}
+
+	existing, err := s.client.ResourceRoleBinding.Query().
+		Where(
+			rrb.UserIDEQ(req.UserId),
+			rrb.ResourceTypeEQ(resourceType),
+			rrb.ResourceIDEQ(resourceID),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		logger.Error("failed to query binding for maintainer add",
+			zap.Error(err),
+			zap.String("resource_type", resourceType),
+			zap.String("resource_id", resourceID),
+			zap.String("user_id", req.UserId),
+		)
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return nil, nil, false
+	}
+
+	var binding *ent.ResourceRoleBinding
+	previousRole := ""
+	switch {
+	case existing == nil:
+		id, _ := uuid.NewV7()
+		binding, err = s.client.ResourceRoleBinding.Create().
+			SetID(id.String()).
+			SetUserID(req.UserId).
+			SetResourceType(resourceType).
+			SetResourceID(resourceID).
+			SetRole(rrb.RoleMaintainer).
+			SetCreatedBy(actor).
+			Save(ctx)
+		if ent.IsConstraintError(err) {
+			c.JSON(http.StatusConflict, generated.Error{Code: "MAINTAINER_ALREADY_EXISTS"})
+			return nil, nil, false
+		}
+	case existing.Role == rrb.RoleMaintainer:
+		c.JSON(http.StatusConflict, generated.Error{Code: "MAINTAINER_ALREADY_EXISTS"})
+		return nil, nil, false
+	case existing.Role == rrb.RoleOwner || existing.Role == rrb.RoleAdmin:
+		c.JSON(http.StatusConflict, generated.Error{
+			Code:    "MEMBER_ALREADY_EXISTS",
+			Message: "user already holds a role that includes maintainer access",
+			Params:  map[string]interface{}{"role": existing.Role.String()},
+		})
+		return nil, nil, false
+	default:
+		previousRole = existing.Role.String()
+		binding, err = s.client.ResourceRoleBinding.UpdateOneID(existing.ID).
+			SetRole(rrb.RoleMaintainer).
+			Save(ctx)
+	}
+	if err != nil {
+		logger.Error("failed to add maintainer",
+			zap.Error(err),
+			zap.String("resource_type", resourceType),
+			zap.String("resource_id", resourceID),
+			zap.String("user_id", req.UserId),
+		)
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return nil, nil, false
+	}
+
+	if s.audit != nil {
+		details := map[string]interface{}{"user_id": req.UserId}
+		if previousRole != "" {
+			details["previous_role"] = previousRole
+		}
+		_ = s.audit.LogAction(ctx, resourceType+".maintainer.add", resourceType, resourceID, actor, details)
+	}
+	return binding, userEnt, true
+}
+
+// revokeMaintainer deletes the user's maintainer binding on the resource.
+func (s *Server) revokeMaintainer(c *gin.Context, actor, resourceType, resourceID, userID string) bool {
+	ctx := c.Request.Context()
+	n, err := s.client.ResourceRoleBinding.Delete().
+		Where(
+			rrb.UserIDEQ(userID),
+			rrb.ResourceTypeEQ(resourceType),
+			rrb.ResourceIDEQ(resourceID),
+			rrb.RoleEQ(rrb.RoleMaintainer),
+		).
+		Exec(ctx)
+	if err != nil {
+		logger.Error("failed to remove maintainer",
+			zap.Error(err),
+			zap.String("resource_type", resourceType),
+			zap.String("resource_id", resourceID),
+			zap.String("user_id", userID),
+		)
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return false
+	}
+	if n == 0 {
+		c.JSON(http.StatusNotFound, generated.Error{Code: "MAINTAINER_NOT_FOUND"})
+		return false
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, resourceType+".maintainer.remove", resourceType, resourceID, actor, map[string]interface{}{
+			"user_id": userID,
+		})
+	}
+	return true
+}
+
+// loadMaintainers returns maintainer user IDs keyed by resource ID.
+func (s *Server) loadMaintainers(ctx context.Context, resourceType string, resourceIDs []string) (map[string][]string, error) {
+	out := make(map[string][]string, len(resourceIDs))
+	if len(resourceIDs) == 0 {
+		return out, nil
+	}
+	bindings, err := s.client.ResourceRoleBinding.Query().
+		Where(
+			rrb.ResourceTypeEQ(resourceType),
+			rrb.ResourceIDIn(resourceIDs...),
+			rrb.RoleEQ(rrb.RoleMaintainer),
+		).
+		Order(ent.Asc(rrb.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range bindings {
+		out[b.ResourceID] = append(out[b.ResourceID], b.UserID)
+	}
+	return out, nil
+}
+
+// maintainedServiceIDs returns the services the actor maintains, optionally
+// limited to one system. Service maintainers see only their services within
+// the parent system.
+func (s *Server) maintainedServiceIDs(ctx context.Context, actor, systemID string) ([]string, error) {
+	bindingIDs, err := s.client.ResourceRoleBinding.Query().
+		Where(
+			rrb.UserIDEQ(actor),
+			rrb.ResourceTypeEQ("service"),
+			rrb.RoleEQ(rrb.RoleMaintainer),
+		).
+		Select(rrb.FieldResourceID).
+		Strings(ctx)
+	if err != nil || len(bindingIDs) == 0 {
+		return nil, err
+	}
+	query := s.client.Service.Query().Where(entservice.IDIn(bindingIDs...))
+	if systemID != "" {
+		query = query.Where(entservice.HasSystemWith(entsystem.IDEQ(systemID)))
+	}
+	return query.IDs(ctx)
+}
+
+// requireSystemView allows system viewers and maintainers of any service in
+// the system. A nil slice grants every service; otherwise the actor is limited
+// to the returned service IDs.
+func (s *Server) requireSystemView(c *gin.Context, systemID string) ([]string, bool) {
+	actor, ok := s.requireSystemExists(c, systemID)
+	if !ok {
+		return nil, false
+	}
+	if hasPlatformAdmin(c) {
+		return nil, true
+	}
+	ctx := c.Request.Context()
+	role, found, err := middleware.NewResourceRoleChecker(s.client).CheckResourceRole(ctx, actor, "system", systemID)
+	if err != nil {
+		logger.Error("failed to check system role", zap.Error(err), zap.String("system_id", systemID), zap.String("actor", actor))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return nil, false
+	}
+	if found && middleware.RoleCanPerform(role, "view") {
+		return nil, true
+	}
+
+	serviceIDs, err := s.maintainedServiceIDs(ctx, actor, systemID)
+	if err != nil {
+		logger.Error("failed to load maintained services", zap.Error(err), zap.String("system_id", systemID), zap.String("actor", actor))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return nil, false
+	}
+	if len(serviceIDs) == 0 {
+		c.JSON(http.StatusForbidden, generated.Error{Code: "FORBIDDEN"})
+		return nil, false
+	}
+	return serviceIDs, true
+}
+
+// canRequestVMForService reports whether the actor may request VMs in the
+// service: a binding on the service or its parent system must allow "create".
+// Unknown services pass so the create use case reports them as not found.
+func (s *Server) canRequestVMForService(ctx context.Context, actor, serviceID string) (bool, error) {
+	exists, err := s.client.Service.Query().Where(entservice.IDEQ(serviceID)).Exist(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		return true, nil
+	}
+	role, found, err := middleware.NewResourceRoleChecker(s.client).CheckResourceRole(ctx, actor, "service", serviceID)
+	if err != nil {
+		return false, err
+	}
+	return found && middleware.RoleCanPerform(role, "create"), nil
+}
+
+func containsServiceID(serviceIDs []string, serviceID string) bool {
+	return serviceIDs == nil || slices.Contains(serviceIDs, serviceID)
+}
@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"kv-shepherd.io/shepherd/ent"
+	rrb "kv-shepherd.io/shepherd/ent/resourcerolebinding"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+)
+
+func mustCreateServiceMaintainer(t *testing.T, client *ent.Client, userID, serviceID string) {
+	t.Helper()
+	if _, err := client.ResourceRoleBinding.Create().
+		SetID(uuid.NewString()).
+		SetUserID(userID).
+		SetResourceType("service").
+		SetResourceID(serviceID).
+		SetRole(rrb.RoleMaintainer).
+		SetCreatedBy("owner-1").
+		Save(t.Context()); err != nil {
+		t.Fatalf("create service maintainer binding: %v", err)
+	}
+}
+
+func assertStatusAndCode(t *testing.T, w *httptest.ResponseRecorder, status int, code string) {
+	t.Helper()
+	if w.Code != status {
+		t.Fatalf("status = %d, want %d body=%s", w.Code, status, w.Body.String())
+	}
+	assertErrorCode(t, w.Body.Bytes(), code)
+}
+
+func TestSystemMaintainers_Visibility(t *testing.T) {
+	srv, client := newSystemBehaviorTestServer(t)
+
+	shop := mustCreateSystem(t, client, "sys-shop", "shop", "owner-1")
+	payments := mustCreateSystem(t, client, "sys-payments", "payments", "owner-1")
+	_ = mustCreateSystem(t, client, "sys-hidden", "finance", "owner-1")
+	cart := mustCreateService(t, client, "svc-cart", "cart", payments.ID, "")
+	_ = mustCreateService(t, client, "svc-ledger", "ledger", payments.ID, "")
+	mustCreateSystemBinding(t, client, "maint-1", shop.ID, "maintainer")
+	mustCreateServiceMaintainer(t, client, "maint-1", cart.ID)
+
+	c, w := newAuthedGinContext(t, http.MethodGet, "/systems", "", "maint-1", []string{"system:read"})
+	srv.ListSystems(c, generated.ListSystemsParams{})
+	if w.Code != http.StatusOK {
+		t.Fatalf("list systems status = %d body=%s", w.Code, w.Body.String())
+	}
+	var systems generated.SystemList
+	mustDecodeJSON(t, w.Body.Bytes(), &systems)
+	got := map[string]generated.System{}
+	for _, item := range systems.Items {
+		got[item.Id] = item
+	}
+	if len(got) != 2 || got[shop.ID].Id == "" || got[payments.ID].Id == "" {
+		t.Fatalf("visible systems = %+v, want shop and payments", systems.Items)
+	}
+	if m := got[shop.ID].Maintainers; len(m) != 1 || m[0] != "maint-1" {
+		t.Fatalf("shop maintainers = %v, want [maint-1]", m)
+	}
+
+	// A service maintainer only sees the services they maintain.
+	c, w = newAuthedGinContext(t, http.MethodGet, "/systems/"+payments.ID+"/services", "", "maint-1", []string{"service:read"})
+	srv.ListServices(c, payments.ID, generated.ListServicesParams{})
+	if w.Code != http.StatusOK {
+		t.Fatalf("list services status = %d body=%s", w.Code, w.Body.String())
+	}
+	var services generated.ServiceList
+	mustDecodeJSON(t, w.Body.Bytes(), &services)
+	if len(services.Items) != 1 || services.Items[0].Id != cart.ID {
+		t.Fatalf("visible services = %+v, want only %s", services.Items, cart.ID)
+	}
+	if m := services.Items[0].Maintainers; len(m) != 1 || m[0] != "maint-1" {
+		t.Fatalf("cart maintainers = %v, want [maint-1]", m)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodGet, "/systems/"+payments.ID+"/services/svc-ledger", "", "maint-1", []string{"service:read"})
+	srv.GetService(c, payments.ID, "svc-ledger")
+	assertStatusAndCode(t, w, http.StatusForbidden, "FORBIDDEN")
+
+	c, w = newAuthedGinContext(t, http.MethodGet, "/systems/sys-hidden/services", "", "maint-1", []string{"service:read"})
+	srv.ListServices(c, "sys-hidden", generated.ListServicesParams{})
+	assertStatusAndCode(t, w, http.StatusForbidden, "FORBIDDEN")
+}
+
+func TestSystemMaintainers_MutationGate(t *testing.T) {
+	srv, client := newSystemBehaviorTestServer(t)
+
+	sys := mustCreateSystem(t, client, "sys-shop", "shop", "owner-1")
+	other := mustCreateSystem(t, client, "sys-other", "other", "owner-2")
+	cart := mustCreateService(t, client, "svc-cart", "cart", sys.ID, "")
+	for _, id := range []string{"owner-1", "owner-2", "maint-1", "member-1", "new-1", "new-2"} {
+		mustCreateUser(t, client, id, id)
+	}
+	mustCreateSystemBinding(t, client, "owner-1", sys.ID, "owner")
+	mustCreateSystemBinding(t, client, "owner-2", other.ID, "owner")
+	mustCreateSystemBinding(t, client, "maint-1", sys.ID, "maintainer")
+	mustCreateSystemBinding(t, client, "member-1", sys.ID, "member")
+
+	tests := []struct {
+		name     string
+		actor    string
+		perms    []string
+		userID   string
+		wantCode int
+		wantErr  string
+	}{
+		{name: "member without role cannot add", actor: "member-1", perms: []string{"system:write"}, userID: "new-1", wantCode: http.StatusForbidden, wantErr: "FORBIDDEN"},
+		{name: "system:write on another system cannot add", actor: "owner-2", perms: []string{"system:write"}, userID: "new-1", wantCode: http.StatusForbidden, wantErr: "FORBIDDEN"},
+		{name: "owner without system:write cannot add", actor: "owner-1", perms: []string{"system:read"}, userID: "new-1", wantCode: http.StatusForbidden, wantErr: "FORBIDDEN"},
+		{name: "owner with system:write adds", actor: "owner-1", perms: []string{"system:write"}, userID: "new-1", wantCode: http.StatusCreated},
+		{name: "existing maintainer adds", actor: "maint-1", perms: []string{"system:read"}, userID: "new-2", wantCode: http.StatusCreated},
+		{name: "duplicate maintainer conflicts", actor: "maint-1", perms: nil, userID: "new-2", wantCode: http.StatusConflict, wantErr: "MAINTAINER_ALREADY_EXISTS"},
+		{name: "owner binding is not downgraded", actor: "maint-1", perms: nil, userID: "owner-1", wantCode: http.StatusConflict, wantErr: "MEMBER_ALREADY_EXISTS"},
+		{name: "member binding is upgraded", actor: "maint-1", perms: nil, userID: "member-1", wantCode: http.StatusCreated},
+		{name: "unknown user", actor: "maint-1", perms: nil, userID: "ghost", wantCode: http.StatusNotFound, wantErr: "USER_NOT_FOUND"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c, w := newAuthedGinContext(t, http.MethodPost, "/systems/"+sys.ID+"/maintainers",
+				mustJSON(t, generated.MaintainerRequest{UserId: tc.userID}), tc.actor, tc.perms)
+			srv.AddSystemMaintainer(c, sys.ID)
+			if tc.wantErr != "" {
+				assertStatusAndCode(t, w, tc.wantCode, tc.wantErr)
+				return
+			}
+			if w.Code != tc.wantCode {
+				t.Fatalf("status = %d, want %d body=%s", w.Code, tc.wantCode, w.Body.String())
+			}
+			var member generated.SystemMember
+			mustDecodeJSON(t, w.Body.Bytes(), &member)
+			if member.UserId != tc.userID || member.Role != generated.SystemMemberRole("maintainer") {
+				t.Fatalf("member = %+v, want maintainer %s", member, tc.userID)
+			}
+		})
+	}
+
+	// System maintainers inherit maintainer management on services.
+	c, w := newAuthedGinContext(t, http.MethodPost, "/systems/"+sys.ID+"/services/"+cart.ID+"/maintainers",
+		mustJSON(t, generated.MaintainerRequest{UserId: "new-1"}), "maint-1", nil)
+	srv.AddServiceMaintainer(c, sys.ID, cart.ID)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("add service maintainer status = %d body=%s", w.Code, w.Body.String())
+	}
+
+	// member-1 was upgraded to maintainer above and may now remove others.
+	c, w = newAuthedGinContext(t, http.MethodDelete, "/systems/"+sys.ID+"/maintainers/new-2", "", "member-1", nil)
+	srv.RemoveSystemMaintainer(c, sys.ID, "new-2")
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("remove maintainer status = %d body=%s", w.Code, w.Body.String())
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodDelete, "/systems/"+sys.ID+"/maintainers/owner-1", "", "maint-1", nil)
+	srv.RemoveSystemMaintainer(c, sys.ID, "owner-1")
+	assertStatusAndCode(t, w, http.StatusNotFound, "MAINTAINER_NOT_FOUND")
+
+	c, w = newAuthedGinContext(t, http.MethodDelete, "/systems/"+sys.ID+"/maintainers/maint-1", "", "owner-2", []string{"system:write"})
+	srv.RemoveSystemMaintainer(c, sys.ID, "maint-1")
+	assertStatusAndCode(t, w, http.StatusForbidden, "FORBIDDEN")
+}
+
+func TestCreateVMRequest_RequiresServiceOwnership(t *testing.T) {
+	srv, client := newSystemBehaviorTestServer(t)
+
+	sys := mustCreateSystem(t, client, "sys-shop", "shop", "owner-1")
+	svc := mustCreateService(t, client, uuid.NewString(), "cart", sys.ID, "")
+	mustCreateSystemBinding(t, client, "viewer-1", sys.ID, "viewer")
+	// An unrestricted global role binding keeps namespace visibility out of the way.
+	user := client.User.Create().SetID("viewer-1").SetUsername("viewer-1").SetEnabled(true).SaveX(t.Context())
+	role := client.Role.Create().
+		SetID("role-requester").
+		SetName("Requester").
+		SetPermissions([]string{"vm:create"}).
+		SetEnabled(true).
+		SaveX(t.Context())
+	client.RoleBinding.Create().
+		SetID("rb-viewer-1").
+		SetUser(user).
+		SetRole(role).
+		SetScopeType("global").
+		SetCreatedBy("seed").
+		SaveX(t.Context())
+
+	body := mustJSON(t, generated.VMCreateRequest{
+		ServiceId:      uuid.MustParse(svc.ID),
+		TemplateId:     uuid.New(),
+		InstanceSizeId: uuid.New(),
+		Namespace:      "prod-shop",
+		Reason:         "capacity",
+	})
+	c, w := newAuthedGinContext(t, http.MethodPost, "/vms/request", body, "viewer-1", []string{"vm:create"})
+	srv.CreateVMRequest(c)
+	assertStatusAndCode(t, w, http.StatusForbidden, "SERVICE_FORBIDDEN")
+}
@@ -30,7 +30,7 @@ func TestStage4Hierarchy_AccessGuardsContract(t *testing.T) {
 
 	source := mustReadSource(t, serverSystemSourcePath)
 	required := []string{
-		`s.requireSystemRole(c, systemId, "view")`,
+		`s.requireSystemView(c, systemId)`,
 		`s.requireSystemRole(c, systemId, "create")`,
 		`s.requireSystemRole(c, systemId, "update")`,
 		`s.requireSystemRole(c, systemId, "delete")`,
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	entservice "kv-shepherd.io/shepherd/ent/service"
+	entsystem "kv-shepherd.io/shepherd/ent/system"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// maxTopologyVMs caps the VMs returned by a system topology request.
+const maxTopologyVMs = 1000
+
+// GetSystemTopology handles GET /admin/systems/{system_id}/topology.
+// Callers without a view role on the system only see services they maintain.
+func (s *Server) GetSystemTopology(c *gin.Context, systemId generated.SystemID) {
+	ctx := c.Request.Context()
+	if !requireGlobalPermission(c, "system:read") {
+		return
+	}
+	serviceIDs, ok := s.requireSystemView(c, systemId)
+	if !ok {
+		return
+	}
+
+	topology, err := s.loadSystemTopology(ctx, systemId, serviceIDs, maxTopologyVMs)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "SYSTEM_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to load system topology", zap.Error(err), zap.String("system_id", systemId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	c.JSON(http.StatusOK, topology)
+}
+
+// loadSystemTopology eager-loads the system, its services and their VMs in
+// one query tree. A nil serviceIDs slice loads every service. The VM edge is
+// limited to vmLimit rows across all services; per-service counts come from
+// a separate aggregate so they stay exact when the tree is truncated.
+func (s *Server) loadSystemTopology(ctx context.Context, systemID string, serviceIDs []string, vmLimit int) (generated.SystemTopology, error) {
+	var topology generated.SystemTopology
+	sys, err := s.client.System.Query().
+		Where(entsystem.IDEQ(systemID)).
+		WithServices(func(q *ent.ServiceQuery) {
+			if serviceIDs != nil {
+				q.Where(entservice.IDIn(serviceIDs...))
+			}
+			q.Order(ent.Asc(entservice.FieldName)).
+				WithVms(func(vq *ent.VMQuery) {
+					vq.Order(ent.Asc(entvm.FieldName), ent.Asc(entvm.FieldID)).
+						Limit(vmLimit + 1)
+				})
+		}).
+		Only(ctx)
+	if err != nil {
+		return topology, err
+	}
+
+	services := sys.Edges.Services
+	loadedIDs := make([]string, 0, len(services))
+	for _, svc := range services {
+		loadedIDs = append(loadedIDs, svc.ID)
+	}
+	counts, err := s.countServiceVMs(ctx, loadedIDs)
+	if err != nil {
+		return topology, err
+	}
+	maintainers, err := s.loadMaintainers(ctx, "system", []string{sys.ID})
+	if err != nil {
+		return topology, fmt.Errorf("load system maintainers: %w", err)
+	}
+	serviceMaintainers, err := s.loadMaintainers(ctx, "service", loadedIDs)
+	if err != nil {
+		return topology, fmt.Errorf("load service maintainers: %w", err)
+	}
+
+	topology.System = systemToAPI(sys)
+	topology.System.Maintainers = maintainers[sys.ID]
+	topology.Services = make([]generated.ServiceTopology, 0, len(services))
+	returned := 0
+	for _, svc := range services {
+		item := generated.ServiceTopology{
+			Service: serviceToAPI(svc, sys.ID),
+			Vms:     make([]generated.VM, 0, len(svc.Edges.Vms)),
+			VmCount: counts[svc.ID],
+		}
+		item.Service.Maintainers = serviceMaintainers[svc.ID]
+		for _, vm := range svc.Edges.Vms {
+			if returned == vmLimit {
+				topology.Truncated = true
+				break
+			}
+			apiVM := vmToAPI(vm)
+			apiVM.ServiceId = svc.ID
+			item.Vms = append(item.Vms, apiVM)
+			returned++
+		}
+		topology.VmCount += item.VmCount
+		topology.Services = append(topology.Services, item)
+	}
+	if topology.VmCount > returned {
+		topology.Truncated = true
+	}
+	return topology, nil
+}
+
+// countServiceVMs returns the number of VMs per service ID.
+func (s *Server) countServiceVMs(ctx context.Context, serviceIDs []string) (map[string]int, error) {
+	out := make(map[string]int, len(serviceIDs))
+	if len(serviceIDs) == 0 {
+		return out, nil
+	}
+	var rows []struct {
+		ServiceID string `json:"service_vms"`
+		Count     int    `json:"count"`
+	}
+	if err := s.client.VM.Query().
+		Where(entvm.HasServiceWith(entservice.IDIn(serviceIDs...))).
+		GroupBy(entvm.ServiceColumn).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("count service vms: %w", err)
+	}
+	for _, row := range rows {
+		out[row.ServiceID] = row.Count
+	}
+	return out, nil
+}
@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/ent"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestGetSystemTopology(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newSystemBehaviorTestServer(t)
+	sys := mustCreateSystem(t, client, "sys-topology", "shop", "owner-1")
+	redis := mustCreateService(t, client, "svc-topology-redis", "redis", sys.ID, "cache")
+	web := mustCreateService(t, client, "svc-topology-web", "web", sys.ID, "frontend")
+	mustCreateVMForService(t, client, "vm-topology-redis-1", "prod-shop-redis-01", redis.ID)
+	mustCreateVMForService(t, client, "vm-topology-web-1", "prod-shop-web-01", web.ID)
+	mustCreateVMForService(t, client, "vm-topology-web-2", "prod-shop-web-02", web.ID)
+	mustCreateServiceMaintainer(t, client, "maintainer-1", web.ID)
+
+	topology := func(userID string, perms []string) (int, generated.SystemTopology) {
+		c, w := newAuthedGinContext(t, http.MethodGet, "/admin/systems/"+sys.ID+"/topology", "", userID, perms)
+		srv.GetSystemTopology(c, sys.ID)
+		var resp generated.SystemTopology
+		if w.Code == http.StatusOK {
+			mustDecodeJSON(t, w.Body.Bytes(), &resp)
+		}
+		return w.Code, resp
+	}
+
+	code, resp := topology("admin-1", []string{"platform:admin"})
+	if code != http.StatusOK || resp.System.Id != sys.ID || len(resp.Services) != 2 || resp.VmCount != 3 || resp.Truncated {
+		t.Fatalf("admin topology status=%d body=%+v, want 2 services and 3 VMs", code, resp)
+	}
+	if resp.Services[0].Service.Id != redis.ID || resp.Services[1].VmCount != 2 || len(resp.Services[1].Vms) != 2 {
+		t.Fatalf("services = %+v, want redis then web with 2 VMs", resp.Services)
+	}
+	if vm := resp.Services[1].Vms[0]; vm.ServiceId != web.ID || vm.Status != generated.VMStatus(entvm.StatusRUNNING) {
+		t.Fatalf("vm = %+v, want service id and status", vm)
+	}
+
+	// A service maintainer only sees the services they maintain.
+	code, resp = topology("maintainer-1", []string{"system:read"})
+	if code != http.StatusOK || len(resp.Services) != 1 || resp.Services[0].Service.Id != web.ID || resp.VmCount != 2 {
+		t.Fatalf("maintainer topology status=%d body=%+v, want only web", code, resp)
+	}
+
+	if code, _ := topology("stranger-1", []string{"system:read"}); code != http.StatusForbidden {
+		t.Fatalf("unbound user status = %d, want %d", code, http.StatusForbidden)
+	}
+	if code, _ := topology("admin-1", []string{"vm:read"}); code != http.StatusForbidden {
+		t.Fatalf("missing system:read status = %d, want %d", code, http.StatusForbidden)
+	}
+
+	c, w := newAuthedGinContext(t, http.MethodGet, "/admin/systems/sys-missing/topology", "", "admin-1", []string{"platform:admin"})
+	srv.GetSystemTopology(c, "sys-missing")
+	assertStatusAndCode(t, w, http.StatusNotFound, "SYSTEM_NOT_FOUND")
+}
+
+func TestLoadSystemTopology_Truncated(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newSystemBehaviorTestServer(t)
+	sys := mustCreateSystem(t, client, "sys-topology-cap", "shop", "owner-1")
+	svc := mustCreateService(t, client, "svc-topology-cap", "redis", sys.ID, "cache")
+	for i := 1; i <= 3; i++ {
+		mustCreateVMForService(t, client, fmt.Sprintf("vm-topology-cap-%d", i), fmt.Sprintf("prod-shop-redis-%02d", i), svc.ID)
+	}
+
+	resp, err := srv.loadSystemTopology(t.Context(), sys.ID, nil, 2)
+	if err != nil {
+		t.Fatalf("loadSystemTopology error = %v", err)
+	}
+	if !resp.Truncated || resp.VmCount != 3 || resp.Services[0].VmCount != 3 || len(resp.Services[0].Vms) != 2 {
+		t.Fatalf("topology = %+v, want 2 of 3 VMs and truncated", resp)
+	}
+}
+
+func BenchmarkLoadSystemTopology(b *testing.B) {
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(b, "system_topology_bench")
+	srv := NewServer(ServerDeps{EntClient: client})
+	ctx := b.Context()
+
+	sys := client.System.Create().SetID("sys-bench").SetName("bench").SetCreatedBy("owner-1").SaveX(ctx)
+	const services, vmsPerService = 20, 50
+	for i := range services {
+		svc := client.Service.Create().
+			SetID(fmt.Sprintf("svc-bench-%02d", i)).
+			SetName(fmt.Sprintf("svc%02d", i)).
+			SetSystemID(sys.ID).
+			SaveX(ctx)
+		builders := make([]*ent.VMCreate, 0, vmsPerService)
+		for j := range vmsPerService {
+			builders = append(builders, client.VM.Create().
+				SetID(fmt.Sprintf("vm-bench-%02d-%02d", i, j)).
+				SetName(fmt.Sprintf("bench-%s-%02d", svc.Name, j)).
+				SetInstance(fmt.Sprintf("%02d", j)).
+				SetNamespace("ns-bench").
+				SetCreatedBy("owner-1").
+				SetServiceID(svc.ID))
+		}
+		client.VM.CreateBulk(builders...).ExecX(ctx)
+	}
+
+	for b.Loop() {
+		resp, err := srv.loadSystemTopology(ctx, sys.ID, nil, maxTopologyVMs)
+		if err != nil {
+			b.Fatalf("loadSystemTopology error = %v", err)
+		}
+		if resp.VmCount != services*vmsPerService || resp.Truncated {
+			b.Fatalf("topology vm_count=%d truncated=%v", resp.VmCount, resp.Truncated)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	entservice "kv-shepherd.io/shepherd/ent/service"
+	entsystem "kv-shepherd.io/shepherd/ent/system"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/governance/approval"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// GetSystemUsageReport handles GET /admin/systems/{system_id}/usage-report.
+// Callers without a view role on the system only see services they maintain.
+func (s *Server) GetSystemUsageReport(c *gin.Context, systemId generated.SystemID, params generated.GetSystemUsageReportParams) {
+	ctx := c.Request.Context()
+	if !requireGlobalPermission(c, "system:read") {
+		return
+	}
+	if params.From.IsZero() || params.To.IsZero() || !params.From.Before(params.To) {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "from and to are required and from must be before to"})
+		return
+	}
+	serviceIDs, ok := s.requireSystemView(c, systemId)
+	if !ok {
+		return
+	}
+
+	report, err := s.buildSystemUsageReport(ctx, systemId, serviceIDs, params.From.UTC(), params.To.UTC(), time.Now().UTC())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "SYSTEM_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to build system usage report", zap.Error(err), zap.String("system_id", systemId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	c.JSON(http.StatusOK, report)
+}
+
+// buildSystemUsageReport sums the resource hours of each service's VMs over
+// [from, to), capped at now. A nil serviceIDs slice reports every service.
+// VM resources come from the CREATE ticket that provisioned the VM, and a
+// VM stops accruing once its delete ticket finished.
+func (s *Server) buildSystemUsageReport(ctx context.Context, systemID string, serviceIDs []string, from, to, now time.Time) (generated.SystemUsageReport, error) {
+	report := generated.SystemUsageReport{SystemId: systemID, From: from, To: to, Services: []generated.ServiceUsage{}}
+	end := to
+	if now.Before(end) {
+		end = now
+	}
+
+	sys, err := s.client.System.Query().
+		Where(entsystem.IDEQ(systemID)).
+		WithServices(func(q *ent.ServiceQuery) {
+			if serviceIDs != nil {
+				q.Where(entservice.IDIn(serviceIDs...))
+			}
+			q.Order(ent.Asc(entservice.FieldName), ent.Asc(entservice.FieldID)).
+				WithVms(func(vq *ent.VMQuery) {
+					vq.Where(entvm.CreatedAtLT(end), entvm.TicketIDNEQ(""))
+				})
+		}).
+		Only(ctx)
+	if err != nil {
+		return report, err
+	}
+
+	var vmIDs, ticketIDs []string
+	for _, svc := range sys.Edges.Services {
+		for _, v := range svc.Edges.Vms {
+			vmIDs = append(vmIDs, v.ID)
+			ticketIDs = append(ticketIDs, v.TicketID)
+		}
+	}
+	usage, err := s.loadVMUsageInputs(ctx, vmIDs, ticketIDs)
+	if err != nil {
+		return report, err
+	}
+
+	for _, svc := range sys.Edges.Services {
+		item := generated.ServiceUsage{ServiceId: svc.ID, ServiceName: svc.Name}
+		for _, v := range svc.Edges.Vms {
+			size, ok := usage.resources(v.TicketID)
+			if !ok {
+				continue
+			}
+			stop := end
+			if deletedAt, ok := usage.deletedAt[v.ID]; ok && deletedAt.Before(stop) {
+				stop = deletedAt
+			}
+			hours := overlapHours(v.CreatedAt, stop, from, end)
+			if hours <= 0 {
+				continue
+			}
+			item.VmCount++
+			item.TotalCpuHours += hours * float64(size.CPUCores)
+			item.TotalMemoryGbHours += hours * float64(size.MemoryMB) / 1024
+			item.TotalDiskGbHours += hours * float64(size.DiskGB)
+		}
+		report.Services = append(report.Services, item)
+	}
+	return report, nil
+}
+
+// vmUsageInputs holds the rows needed to price a set of VMs.
+type vmUsageInputs struct {
+	tickets map[string]*ent.ApprovalTicket
+	// createEvents holds the completed CREATE events, keyed by event ID.
+	createEvents map[string]*ent.DomainEvent
+	sizes        map[string]*ent.InstanceSize
+	// deletedAt is when each deleted VM's delete ticket finished.
+	deletedAt map[string]time.Time
+}
+
+// resources returns the instance size a VM was created with, or false when
+// its creation never completed or the size cannot be resolved.
+func (in vmUsageInputs) resources(ticketID string) (approval.TicketInstanceSize, bool) {
+	ticket, ok := in.tickets[ticketID]
+	if !ok {
+		return approval.TicketInstanceSize{}, false
+	}
+	event, ok := in.createEvents[ticket.EventID]
+	if !ok {
+		return approval.TicketInstanceSize{}, false
+	}
+	return approval.TicketResources(ticket, event.Payload, in.sizes)
+}
+
+func (s *Server) loadVMUsageInputs(ctx context.Context, vmIDs, ticketIDs []string) (vmUsageInputs, error) {
+	in := vmUsageInputs{
+		tickets:      map[string]*ent.ApprovalTicket{},
+		createEvents: map[string]*ent.DomainEvent{},
+		sizes:        map[string]*ent.InstanceSize{},
+		deletedAt:    map[string]time.Time{},
+	}
+	if len(vmIDs) == 0 {
+		return in, nil
+	}
+
+	tickets, err := s.client.ApprovalTicket.Query().
+		Where(
+			approvalticket.IDIn(ticketIDs...),
+			approvalticket.OperationTypeEQ(approvalticket.OperationTypeCREATE),
+		).
+		All(ctx)
+	if err != nil {
+		return in, err
+	}
+	eventIDs := make([]string, 0, len(tickets))
+	for _, t := range tickets {
+		in.tickets[t.ID] = t
+		eventIDs = append(eventIDs, t.EventID)
+	}
+	events, err := s.client.DomainEvent.Query().
+		Where(
+			domainevent.IDIn(eventIDs...),
+			domainevent.StatusEQ(domainevent.StatusCOMPLETED),
+		).
+		All(ctx)
+	if err != nil {
+		return in, err
+	}
+	for _, ev := range events {
+		in.createEvents[ev.ID] = ev
+	}
+
+	// The catalog is small; load it whole for tickets approved before
+	// instance size snapshots were recorded.
+	sizes, err := s.client.InstanceSize.Query().All(ctx)
+	if err != nil {
+		return in, err
+	}
+	for _, size := range sizes {
+		in.sizes[size.ID] = size
+	}
+
+	deletions, err := s.client.DomainEvent.Query().
+		Where(
+			domainevent.EventTypeEQ(string(domain.EventVMDeletionRequested)),
+			domainevent.StatusEQ(domainevent.StatusCOMPLETED),
+			domainevent.AggregateIDIn(vmIDs...),
+		).
+		All(ctx)
+	if err != nil {
+		return in, err
+	}
+	if len(deletions) == 0 {
+		return in, nil
+	}
+	vmByEvent := make(map[string]string, len(deletions))
+	deletionIDs := make([]string, 0, len(deletions))
+	for _, ev := range deletions {
+		vmByEvent[ev.ID] = ev.AggregateID
+		deletionIDs = append(deletionIDs, ev.ID)
+	}
+	// The delete ticket's finished_at marks when the VM left the cluster.
+	deleteTickets, err := s.client.ApprovalTicket.Query().
+		Where(
+			approvalticket.EventIDIn(deletionIDs...),
+			approvalticket.FinishedAtNotNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return in, err
+	}
+	for _, t := range deleteTickets {
+		vmID := vmByEvent[t.EventID]
+		if prev, ok := in.deletedAt[vmID]; !ok || t.FinishedAt.Before(prev) {
+			in.deletedAt[vmID] = *t.FinishedAt
+		}
+	}
+	return in, nil
+}
+
+// overlapHours returns the hours [start, stop) and [from, to) share.
+func overlapHours(start, stop, from, to time.Time) float64 {
+	if start.Before(from) {
+		start = from
+	}
+	if stop.After(to) {
+		stop = to
+	}
+	if !stop.After(start) {
+		return 0
+	}
+	return stop.Sub(start).Hours()
+}
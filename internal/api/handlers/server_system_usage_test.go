@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+	"testing"
+	"time"
+
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/domain"
+)
+
+func TestGetSystemUsageReport(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newSystemBehaviorTestServer(t)
+	ctx := t.Context()
+	sys := mustCreateSystem(t, client, "sys-usage", "shop", "owner-1")
+	redis := mustCreateService(t, client, "svc-usage-redis", "redis", sys.ID, "cache")
+	web := mustCreateService(t, client, "svc-usage-web", "web", sys.ID, "frontend")
+	mustCreateServiceMaintainer(t, client, "maintainer-1", web.ID)
+	client.InstanceSize.Create().
+		SetID("size-usage-medium").
+		SetName("medium").
+		SetCPUCores(2).
+		SetMemoryMB(8192).
+		SetDiskGB(10).
+		SetCreatedBy("admin-1").
+		ExecX(ctx)
+
+	day := func(d, h int) time.Time { return time.Date(2026, time.January, d, h, 0, 0, 0, time.UTC) }
+	type vmTimeline struct {
+		id           string
+		createdAt    time.Time
+		createStatus domainevent.Status
+		snapshot     map[string]interface{}
+		modified     map[string]interface{}
+		deletedAt    *time.Time
+	}
+	seed := func(tl vmTimeline) {
+		t.Helper()
+		payload, err := domain.VMCreationPayload{
+			RequesterID:    "alice",
+			ServiceID:      web.ID,
+			TemplateID:     "tpl-usage",
+			InstanceSizeID: "size-usage-medium",
+			Namespace:      "dev",
+		}.ToJSON()
+		if err != nil {
+			t.Fatalf("marshal payload: %v", err)
+		}
+		client.DomainEvent.Create().
+			SetID("event-create-" + tl.id).
+			SetEventType(string(domain.EventVMCreationRequested)).
+			SetAggregateType("vm").
+			SetAggregateID(web.ID).
+			SetPayload(payload).
+			SetStatus(tl.createStatus).
+			SetCreatedBy("alice").
+			ExecX(ctx)
+		client.ApprovalTicket.Create().
+			SetID("ticket-create-" + tl.id).
+			SetEventID("event-create-" + tl.id).
+			SetRequester("alice").
+			SetOperationType(approvalticket.OperationTypeCREATE).
+			SetStatus(approvalticket.StatusSUCCESS).
+			SetInstanceSizeSnapshot(tl.snapshot).
+			SetModifiedSpec(tl.modified).
+			ExecX(ctx)
+		client.VM.Create().
+			SetID(tl.id).
+			SetName("prod-shop-" + tl.id).
+			SetInstance("01").
+			SetNamespace("dev").
+			SetCreatedBy("alice").
+			SetTicketID("ticket-create-" + tl.id).
+			SetCreatedAt(tl.createdAt).
+			SetServiceID(web.ID).
+			ExecX(ctx)
+		if tl.deletedAt == nil {
+			return
+		}
+		client.DomainEvent.Create().
+			SetID("event-delete-" + tl.id).
+			SetEventType(string(domain.EventVMDeletionRequested)).
+			SetAggregateType("vm").
+			SetAggregateID(tl.id).
+			SetPayload([]byte("{}")).
+			SetStatus(domainevent.StatusCOMPLETED).
+			SetCreatedBy("alice").
+			ExecX(ctx)
+		client.ApprovalTicket.Create().
+			SetID("ticket-delete-" + tl.id).
+			SetEventID("event-delete-" + tl.id).
+			SetRequester("alice").
+			SetOperationType(approvalticket.OperationTypeDELETE).
+			SetStatus(approvalticket.StatusSUCCESS).
+			SetFinishedAt(*tl.deletedAt).
+			ExecX(ctx)
+	}
+
+	// Running since before the window: 24h of its snapshot size.
+	seed(vmTimeline{
+		id:           "vm-usage-steady",
+		createdAt:    day(1, 12).Add(-24 * time.Hour),
+		createStatus: domainevent.StatusCOMPLETED,
+		snapshot:     map[string]interface{}{"id": "size-usage-small", "cpu_cores": 2, "memory_mb": 4096, "disk_gb": 40},
+	})
+	// Created and deleted inside the window: 6h of the live size with the
+	// approver's cpu override.
+	deletedMid := day(1, 12)
+	seed(vmTimeline{
+		id:           "vm-usage-short",
+		createdAt:    day(1, 6),
+		createStatus: domainevent.StatusCOMPLETED,
+		modified:     map[string]interface{}{"cpu": 4},
+		deletedAt:    &deletedMid,
+	})
+	// Never provisioned.
+	seed(vmTimeline{
+		id:           "vm-usage-failed",
+		createdAt:    day(1, 2),
+		createStatus: domainevent.StatusFAILED,
+		snapshot:     map[string]interface{}{"id": "size-usage-small", "cpu_cores": 2, "memory_mb": 4096, "disk_gb": 40},
+	})
+	// Gone before the window opened.
+	deletedBefore := day(1, 0).Add(-time.Hour)
+	seed(vmTimeline{
+		id:           "vm-usage-gone",
+		createdAt:    day(1, 0).Add(-48 * time.Hour),
+		createStatus: domainevent.StatusCOMPLETED,
+		modified:     map[string]interface{}{"cpu": 8},
+		deletedAt:    &deletedBefore,
+	})
+
+	report := func(userID string, perms []string, from, to time.Time) (int, generated.SystemUsageReport) {
+		c, w := newAuthedGinContext(t, http.MethodGet, "/admin/systems/"+sys.ID+"/usage-report", "", userID, perms)
+		srv.GetSystemUsageReport(c, sys.ID, generated.GetSystemUsageReportParams{From: from, To: to})
+		var resp generated.SystemUsageReport
+		if w.Code == http.StatusOK {
+			mustDecodeJSON(t, w.Body.Bytes(), &resp)
+		}
+		return w.Code, resp
+	}
+
+	code, resp := report("admin-1", []string{"platform:admin"}, day(1, 0), day(2, 0))
+	if code != http.StatusOK || len(resp.Services) != 2 {
+		t.Fatalf("admin report status=%d body=%+v, want 2 services", code, resp)
+	}
+	if got := resp.Services[0]; got.ServiceId != redis.ID || got.VmCount != 0 || got.TotalCpuHours != 0 {
+		t.Fatalf("redis usage = %+v, want an empty row", got)
+	}
+	want := generated.ServiceUsage{
+		ServiceId:          web.ID,
+		ServiceName:        "web",
+		VmCount:            2,
+		TotalCpuHours:      24*2 + 6*4,
+		TotalMemoryGbHours: 24*4 + 6*8,
+		TotalDiskGbHours:   24*40 + 6*10,
+	}
+	if got := resp.Services[1]; !usageApproxEqual(got, want) {
+		t.Fatalf("web usage = %+v, want %+v", got, want)
+	}
+
+	// A window ending before the short-lived VM existed only sees the
+	// steady VM.
+	code, resp = report("admin-1", []string{"platform:admin"}, day(1, 0), day(1, 6))
+	if code != http.StatusOK || resp.Services[1].VmCount != 1 || resp.Services[1].TotalCpuHours != 12 {
+		t.Fatalf("early window status=%d body=%+v, want 1 VM and 12 cpu hours", code, resp)
+	}
+
+	code, resp = report("maintainer-1", []string{"system:read"}, day(1, 0), day(2, 0))
+	if code != http.StatusOK || len(resp.Services) != 1 || resp.Services[0].ServiceId != web.ID {
+		t.Fatalf("maintainer report status=%d body=%+v, want only web", code, resp)
+	}
+	if code, _ := report("admin-1", []string{"vm:read"}, day(1, 0), day(2, 0)); code != http.StatusForbidden {
+		t.Fatalf("missing system:read status = %d, want %d", code, http.StatusForbidden)
+	}
+	if code, _ := report("admin-1", []string{"platform:admin"}, day(2, 0), day(1, 0)); code != http.StatusBadRequest {
+		t.Fatalf("inverted range status = %d, want %d", code, http.StatusBadRequest)
+	}
+}
+
+func TestOverlapHours(t *testing.T) {
+	t.Parallel()
+
+	at := func(h int) time.Time { return time.Date(2026, time.January, 1, h, 0, 0, 0, time.UTC) }
+	cases := []struct {
+		name                  string
+		start, stop, from, to time.Time
+		want                  float64
+	}{
+		{"inside", at(2), at(4), at(0), at(10), 2},
+		{"clipped both sides", at(0), at(12), at(2), at(6), 4},
+		{"before window", at(0), at(1), at(2), at(6), 0},
+		{"after window", at(7), at(9), at(2), at(6), 0},
+	}
+	for _, tc := range cases {
+		if got := overlapHours(tc.start, tc.stop, tc.from, tc.to); got != tc.want {
+			t.Errorf("%s: overlapHours = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func usageApproxEqual(got, want generated.ServiceUsage) bool {
+	near := func(a, b float64) bool { return math.Abs(a-b) < 1e-9 }
+	return got.ServiceId == want.ServiceId && got.ServiceName == want.ServiceName && got.VmCount == want.VmCount &&
+		near(got.TotalCpuHours, want.TotalCpuHours) &&
+		near(got.TotalMemoryGbHours, want.TotalMemoryGbHours) &&
+		near(got.TotalDiskGbHours, want.TotalDiskGbHours)
+}
@@ -1,10 +1,17 @@
 package handlers
 
 import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -13,6 +20,9 @@ import (
 	"kv-shepherd.io/shepherd/ent/domainevent"
 	"kv-shepherd.io/shepherd/ent/instancesize"
 	"kv-shepherd.io/shepherd/ent/namespaceregistry"
+	"kv-shepherd.io/shepherd/ent/predicate"
+	entservice "kv-shepherd.io/shepherd/ent/service"
+	entsystem "kv-shepherd.io/shepherd/ent/system"
 	enttemplate "kv-shepherd.io/shepherd/ent/template"
 	entvm "kv-shepherd.io/shepherd/ent/vm"
 	"kv-shepherd.io/shepherd/internal/api/generated"
@@ -21,23 +31,58 @@ import (
 	"kv-shepherd.io/shepherd/internal/jobs"
 	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
 	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/service"
 	"kv-shepherd.io/shepherd/internal/usecase"
 )
 
 // ListVMs handles GET /vms.
 func (s *Server) ListVMs(c *gin.Context, params generated.ListVMsParams) {
 	ctx := c.Request.Context()
-	if !requireGlobalPermission(c, "vm:read") {
+	scope, ok := s.requireVMPermission(c, "vm:read")
+	if !ok {
 		return
 	}
 
-	query := s.client.VM.Query()
-	visibility, err := s.resolveNamespaceVisibility(c)
+	predicates, applied, err := vmListFilters(params)
 	if err != nil {
-		logger.Error("failed to resolve VM namespace visibility", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
+		return
+	}
+	order, err := vmListOrder(params, &applied)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
 		return
 	}
+	keyset := applied.OrderBy == generated.VMListOrderByCreatedAt
+	desc := applied.SortOrder == generated.VMListFiltersSortOrderDesc
+	var cursor *vmListCursor
+	if params.Cursor != "" {
+		if !keyset {
+			c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: "cursor requires order_by=created_at"})
+			return
+		}
+		decoded, err := s.decodeVMListCursor(params.Cursor)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
+			return
+		}
+		cursor = &decoded
+	}
+
+	// Filters only ever narrow the query; visibility is applied on top.
+	// Service-scoped actors see exactly the VMs of their bound services.
+	query := s.client.VM.Query().Where(predicates...)
+	visibility := namespaceVisibility{}
+	if scope.global() {
+		visibility, err = s.resolveNamespaceVisibility(c)
+		if err != nil {
+			logger.Error("failed to resolve VM namespace visibility", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+			return
+		}
+	} else {
+		query = query.Where(entvm.HasServiceWith(entservice.IDIn(scope.serviceIDs...)))
+	}
 	if visibility.restricted {
 		visibleNamespaces, err := s.listVisibleNamespaceNames(ctx, visibility)
 		if err != nil {
@@ -55,21 +100,13 @@ func (s *Server) ListVMs(c *gin.Context, params generated.ListVMsParams) {
 					Total:      0,
 					TotalPages: 0,
 				},
+				Filters: applied,
 			})
 			return
 		}
 		query = query.Where(entvm.NamespaceIn(visibleNamespaces...))
 	}
 
-	// Filter by status.
-	if params.Status != "" {
-		query = query.Where(entvm.StatusEQ(entvm.Status(params.Status)))
-	}
-	// Filter by namespace.
-	if params.Namespace != "" {
-		query = query.Where(entvm.NamespaceEQ(params.Namespace))
-	}
-
 	page, perPage := defaultPagination(params.Page, params.PerPage)
 	offset := (page - 1) * perPage
 
@@ -80,16 +117,27 @@ func (s *Server) ListVMs(c *gin.Context, params generated.ListVMsParams) {
 		return
 	}
 
-	vms, err := query.
-		Offset(offset).
-		Limit(perPage).
-		Order(ent.Desc(entvm.FieldCreatedAt)).
-		All(ctx)
+	// Fetch one extra row to learn whether another page follows.
+	pageQuery := query.Limit(perPage + 1).Order(order...)
+	if cursor != nil {
+		pageQuery = pageQuery.Where(cursor.after(desc))
+		page = 0
+	} else {
+		pageQuery = pageQuery.Offset(offset)
+	}
+	vms, err := pageQuery.All(ctx)
 	if err != nil {
 		logger.Error("failed to list VMs", zap.Error(err), zap.Int("page", page))
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
+	var nextCursor string
+	if len(vms) > perPage {
+		vms = vms[:perPage]
+		if keyset {
+			nextCursor = s.encodeVMListCursor(vms[len(vms)-1])
+		}
+	}
 
 	items := make([]generated.VM, 0, len(vms))
 	for _, vm := range vms {
@@ -105,9 +153,163 @@ func (s *Server) ListVMs(c *gin.Context, params generated.ListVMsParams) {
 			Total:      total,
 			TotalPages: totalPages,
 		},
+		NextCursor: nextCursor,
+		Filters:    applied,
 	})
 }
 
+// vmListFilters translates list query params into VM predicates and reports
+// the normalized filters that were applied.
+func vmListFilters(params generated.ListVMsParams) ([]predicate.VM, generated.VMListFilters, error) {
+	var (
+		predicates []predicate.VM
+		applied    generated.VMListFilters
+	)
+
+	if len(params.Status) > 0 {
+		statuses := make([]entvm.Status, 0, len(params.Status))
+		for _, raw := range params.Status {
+			status := entvm.Status(raw)
+			if err := entvm.StatusValidator(status); err != nil {
+				return nil, applied, fmt.Errorf("invalid status %q", raw)
+			}
+			statuses = append(statuses, status)
+		}
+		predicates = append(predicates, entvm.StatusIn(statuses...))
+		applied.Status = params.Status
+	}
+	if namespace := strings.TrimSpace(params.Namespace); namespace != "" {
+		predicates = append(predicates, entvm.NamespaceEQ(namespace))
+		applied.Namespace = namespace
+	}
+	if clusterID := strings.TrimSpace(params.ClusterId); clusterID != "" {
+		predicates = append(predicates, entvm.ClusterIDEQ(clusterID))
+		applied.ClusterId = clusterID
+	}
+	if serviceID := strings.TrimSpace(params.ServiceId); serviceID != "" {
+		predicates = append(predicates, entvm.HasServiceWith(entservice.IDEQ(serviceID)))
+		applied.ServiceId = serviceID
+	}
+	if systemID := strings.TrimSpace(params.SystemId); systemID != "" {
+		// No system_id on VM (ADR-0015 §3) — resolve via service.system edge.
+		predicates = append(predicates, entvm.HasServiceWith(entservice.HasSystemWith(entsystem.IDEQ(systemID))))
+		applied.SystemId = systemID
+	}
+	if createdBy := strings.TrimSpace(params.CreatedBy); createdBy != "" {
+		predicates = append(predicates, entvm.CreatedByEQ(createdBy))
+		applied.CreatedBy = createdBy
+	}
+	if prefix := strings.TrimSpace(params.NamePrefix); prefix != "" {
+		predicates = append(predicates, entvm.NameHasPrefix(prefix))
+		applied.NamePrefix = prefix
+	}
+	if selector := strings.TrimSpace(params.LabelSelector); selector != "" {
+		terms, err := parseLabelSelector(selector)
+		if err != nil {
+			return nil, applied, err
+		}
+		predicates = append(predicates, vmLabelPredicates(terms)...)
+		applied.LabelSelector = formatLabelSelector(terms)
+	}
+	return predicates, applied, nil
+}
+
+// vmListOrder defaults to newest first; an explicit sort_order applies to any
+// order_by. The resolved ordering is recorded on applied.
+func vmListOrder(params generated.ListVMsParams, applied *generated.VMListFilters) ([]entvm.OrderOption, error) {
+	applied.OrderBy = params.OrderBy
+	if applied.OrderBy == "" {
+		applied.OrderBy = generated.VMListOrderByCreatedAt
+	}
+	switch params.SortOrder {
+	case "":
+		applied.SortOrder = generated.VMListFiltersSortOrderAsc
+		if applied.OrderBy == generated.VMListOrderByCreatedAt {
+			applied.SortOrder = generated.VMListFiltersSortOrderDesc
+		}
+	case generated.Asc, generated.Desc:
+		applied.SortOrder = generated.VMListFiltersSortOrder(params.SortOrder)
+	default:
+		return nil, fmt.Errorf("sort_order must be asc or desc")
+	}
+	direction := sql.OrderAsc()
+	if applied.SortOrder == generated.VMListFiltersSortOrderDesc {
+		direction = sql.OrderDesc()
+	}
+
+	var order []entvm.OrderOption
+	switch applied.OrderBy {
+	case generated.VMListOrderByCreatedAt:
+		order = append(order, entvm.ByCreatedAt(direction))
+	case generated.VMListOrderByName:
+		order = append(order, entvm.ByName(direction))
+	case generated.VMListOrderByStatus:
+		order = append(order, entvm.ByStatus(direction), entvm.ByCreatedAt(sql.OrderDesc()))
+	default:
+		return nil, fmt.Errorf("order_by must be created_at, name or status")
+	}
+	return append(order, entvm.ByID()), nil
+}
+
+// vmListCursorKeyContext separates the cursor MAC from other uses of the JWT
+// signing key.
+const vmListCursorKeyContext = "shepherd/vm-list-cursor"
+
+// vmListCursor is the keyset position after the last VM of a page. It is
+// handed out as next_cursor: base64 JSON, a dot, then its base64 HMAC.
+type vmListCursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        string    `json:"id"`
+}
+
+func (s *Server) vmListCursorMAC(payload string) []byte {
+	mac := hmac.New(sha256.New, s.jwtCfg.SigningKey)
+	mac.Write([]byte(vmListCursorKeyContext))
+	mac.Write([]byte{0})
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+func (s *Server) encodeVMListCursor(vm *ent.VM) string {
+	raw, _ := json.Marshal(vmListCursor{CreatedAt: vm.CreatedAt, ID: vm.ID})
+	payload := base64.RawURLEncoding.EncodeToString(raw)
+	return payload + "." + base64.RawURLEncoding.EncodeToString(s.vmListCursorMAC(payload))
+}
+
+// decodeVMListCursor rejects cursors this server did not sign.
+func (s *Server) decodeVMListCursor(token string) (vmListCursor, error) {
+	var cursor vmListCursor
+	payload, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return cursor, fmt.Errorf("invalid cursor")
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(mac, s.vmListCursorMAC(payload)) {
+		return cursor, fmt.Errorf("invalid cursor")
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return cursor, fmt.Errorf("invalid cursor")
+	}
+	if err := json.Unmarshal(raw, &cursor); err != nil || cursor.ID == "" || cursor.CreatedAt.IsZero() {
+		return cursor, fmt.Errorf("invalid cursor")
+	}
+	return cursor, nil
+}
+
+// after matches VMs ordered after the cursor under the created_at order
+// ListVMs applies, with ID ascending as the tie-breaker.
+func (c vmListCursor) after(desc bool) predicate.VM {
+	beyond := entvm.CreatedAtGT(c.CreatedAt)
+	if desc {
+		beyond = entvm.CreatedAtLT(c.CreatedAt)
+	}
+	return entvm.Or(
+		beyond,
+		entvm.And(entvm.CreatedAtEQ(c.CreatedAt), entvm.IDGT(c.ID)),
+	)
+}
+
 // GetVMRequestContext handles GET /vms/request-context.
 // Returns user-visible wizard context to avoid client-side fan-out and drift.
 func (s *Server) GetVMRequestContext(c *gin.Context) {
@@ -147,7 +349,7 @@ func (s *Server) GetVMRequestContext(c *gin.Context) {
 	}
 
 	templates, err := s.client.Template.Query().
-		Where(enttemplate.EnabledEQ(true)).
+		Where(enttemplate.EnabledEQ(true), enttemplate.DeprecatedAtIsNil()).
 		Order(ent.Asc(enttemplate.FieldName)).
 		All(ctx)
 	if err != nil {
@@ -219,15 +421,63 @@ func (s *Server) CreateVMRequest(c *gin.Context) {
 		})
 		return
 	}
+	if !hasPlatformAdmin(c) {
+		allowed, err := s.canRequestVMForService(ctx, actor, req.ServiceId.String())
+		if err != nil {
+			logger.Error("failed to check service ownership for VM request", zap.Error(err), zap.String("service_id", req.ServiceId.String()))
+			c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+			return
+		}
+		if !allowed {
+			c.JSON(http.StatusForbidden, generated.Error{
+				Code:    "SERVICE_FORBIDDEN",
+				Message: "VM requests require a maintainer or member binding on the service or its system",
+				Params:  map[string]interface{}{"service_id": req.ServiceId.String()},
+			})
+			return
+		}
+	}
 
-	output, err := s.createVMUC.Execute(ctx, usecase.CreateVMInput{
+	input := usecase.CreateVMInput{
 		ServiceID:      req.ServiceId.String(),
 		TemplateID:     req.TemplateId.String(),
 		InstanceSizeID: req.InstanceSizeId.String(),
 		Namespace:      req.Namespace,
 		Reason:         req.Reason,
 		RequestedBy:    actor,
-	})
+		RequestID:      req.RequestId,
+		UserData:       strings.TrimSpace(req.UserData),
+	}
+	if strings.TrimSpace(req.SourceVmId) != "" {
+		clone, ok := s.resolveCreateCloneSource(c, req, visibility)
+		if !ok {
+			return
+		}
+		input.Namespace = clone.TargetNamespace
+		input.SourceVMID = clone.VMID
+		input.SourceClusterID = clone.ClusterID
+		input.SourceNamespace = clone.Namespace
+		input.SourcePVCName = clone.PVCName
+	} else if strings.TrimSpace(req.TargetNamespace) != "" {
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:    apperrors.CodeInvalidRequestField,
+			Message: "target_namespace is only valid with source_vm_id",
+			Params:  map[string]interface{}{"field": "target_namespace"},
+		})
+		return
+	}
+	rejection, err := s.validateVMCreateCatalog(ctx, input.ServiceID, input.TemplateID, input.InstanceSizeID, input.Namespace)
+	if err != nil {
+		logger.Error("failed to validate VM request catalog references", zap.Error(err), zap.String("actor", actor))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if rejection != nil {
+		c.JSON(http.StatusBadRequest, rejection)
+		return
+	}
+
+	output, err := s.createVMUC.Execute(ctx, input)
 	if err != nil {
 		if appErr, ok := apperrors.IsAppError(err); ok {
 			// Keep endpoint contract-compatible with current OpenAPI (400 on request failure),
@@ -249,51 +499,210 @@ func (s *Server) CreateVMRequest(c *gin.Context) {
 	}
 
 	// Notification trigger: APPROVAL_PENDING → notify approvers (master-flow.md Stage 5.F).
-	if s.notifier != nil {
-		s.notifier.OnTicketSubmitted(ctx, output.TicketID, actor, req.Namespace)
+	// A request_id replay was already announced when the ticket was first submitted.
+	if s.notifier != nil && !output.Replayed {
+		s.notifier.OnTicketSubmitted(ctx, output.TicketID, actor, input.Namespace)
+	}
+
+	// A request_id replay returns the existing ticket as 200; 202 means a new submission.
+	status := http.StatusAccepted
+	if output.Replayed {
+		status = http.StatusOK
 	}
+	c.JSON(status, generated.ApprovalTicketResponse{
+		TicketId:       output.TicketID,
+		Status:         generated.ApprovalTicketResponseStatus(output.Status),
+		VmNamePreview:  output.VMNamePreview,
+		VmNameConflict: output.VMNameConflict,
+		QuotaWarnings:  s.vmRequestQuotaWarnings(ctx, input),
+	})
+}
 
-	c.JSON(http.StatusAccepted, generated.ApprovalTicketResponse{
-		TicketId: output.TicketID,
-		Status:   generated.ApprovalTicketResponseStatusPENDING,
+// vmRequestQuotaWarnings reports the quotas a VM request would exceed if it
+// were approved now. Quotas are only enforced at approval, so a lookup
+// failure is logged and yields no warnings.
+func (s *Server) vmRequestQuotaWarnings(ctx context.Context, input usecase.CreateVMInput) []generated.QuotaWarning {
+	size, err := s.client.InstanceSize.Get(ctx, input.InstanceSizeID)
+	if err != nil {
+		logger.Warn("failed to load instance size for quota warning", zap.Error(err), zap.String("instance_size_id", input.InstanceSizeID))
+		return nil
+	}
+	violations, err := service.NewQuotaChecker(s.client).Violations(ctx, service.QuotaDemand{
+		Namespace: input.Namespace,
+		ServiceID: input.ServiceID,
+		CPUCores:  size.CPUCores,
+		MemoryMB:  size.MemoryMB,
 	})
+	if err != nil {
+		logger.Warn("failed to evaluate quotas for VM request", zap.Error(err), zap.String("namespace", input.Namespace))
+		return nil
+	}
+	warnings := make([]generated.QuotaWarning, 0, len(violations))
+	for _, v := range violations {
+		warnings = append(warnings, generated.QuotaWarning{
+			ScopeType: generated.QuotaScopeType(v.ScopeType),
+			ScopeId:   v.ScopeID,
+			Resource:  generated.QuotaWarningResource(v.Resource),
+			Current:   v.Current,
+			Requested: v.Requested,
+			Limit:     v.Limit,
+		})
+	}
+	return warnings
 }
 
+// resolveCreateCloneSource validates the clone fields of a VM create request
+// and writes the error response when they are rejected.
+func (s *Server) resolveCreateCloneSource(
+	c *gin.Context,
+	req generated.VMCreateRequest,
+	visibility namespaceVisibility,
+) (*vmCloneSource, bool) {
+	clone, err := s.resolveVMCloneSource(c.Request.Context(), req.SourceVmId, req.TargetNamespace, visibility, vmScope{})
+	if err != nil {
+		if appErr, ok := err.(*batchValidationError); ok {
+			c.JSON(appErr.status, appErr.body)
+			return nil, false
+		}
+		logger.Error("failed to resolve VM clone source", zap.Error(err), zap.String("source_vm_id", req.SourceVmId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return nil, false
+	}
+	if strings.TrimSpace(req.TargetNamespace) == "" && req.Namespace != clone.Namespace {
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:    "CLONE_NAMESPACE_MISMATCH",
+			Message: "a clone stays in the source VM's namespace unless target_namespace is set",
+			Params: map[string]interface{}{
+				"namespace":        req.Namespace,
+				"source_namespace": clone.Namespace,
+			},
+		})
+		return nil, false
+	}
+	return clone, true
+}
+
+// vmRuntimeTimeout bounds the live VMI lookup made by GetVM?runtime=true.
+const vmRuntimeTimeout = 5 * time.Second
+
 // GetVM handles GET /vms/{vm_id}.
-func (s *Server) GetVM(c *gin.Context, vmId generated.VMID) {
-	ctx := c.Request.Context()
-	if !requireGlobalPermission(c, "vm:read") {
+// With runtime=true the response also carries live VMI state; a cluster
+// lookup failure degrades to runtime=null plus runtime_warning.
+func (s *Server) GetVM(c *gin.Context, vmId generated.VMID, params generated.GetVMParams) {
+	vm, ok := s.getReadableVM(c, vmId)
+	if !ok {
 		return
 	}
 
-	vm, err := s.client.VM.Get(ctx, vmId)
+	out := vmToAPI(vm)
+	if params.Runtime {
+		out.Runtime, out.RuntimeWarning = s.loadVMRuntime(c.Request.Context(), vm)
+	}
+	c.JSON(http.StatusOK, out)
+}
+
+// getReadableVM loads a VM after checking vm:read, treating VMs in invisible
+// namespaces or outside a service-scoped actor's services as not found.
+func (s *Server) getReadableVM(c *gin.Context, vmID string) (*ent.VM, bool) {
+	ctx := c.Request.Context()
+	scope, ok := s.requireVMPermission(c, "vm:read")
+	if !ok {
+		return nil, false
+	}
+
+	vm, err := s.client.VM.Get(ctx, vmID)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			c.JSON(http.StatusNotFound, generated.Error{Code: "VM_NOT_FOUND"})
-			return
+			return nil, false
 		}
-		logger.Error("failed to get VM", zap.Error(err), zap.String("vm_id", vmId))
+		logger.Error("failed to get VM", zap.Error(err), zap.String("vm_id", vmID))
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
+		return nil, false
 	}
-	visibility, err := s.resolveNamespaceVisibility(c)
-	if err != nil {
-		logger.Error("failed to resolve VM namespace visibility", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
+	var visible bool
+	if scope.global() {
+		visibility, err := s.resolveNamespaceVisibility(c)
+		if err != nil {
+			logger.Error("failed to resolve VM namespace visibility", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+			return nil, false
+		}
+		visible, err = s.isNamespaceVisible(ctx, vm.Namespace, visibility)
+	} else {
+		visible, err = s.vmInScope(ctx, vm.ID, scope)
 	}
-	visible, err := s.isNamespaceVisible(ctx, vm.Namespace, visibility)
 	if err != nil {
-		logger.Error("failed to check VM namespace visibility", zap.Error(err), zap.String("vm_id", vmId))
+		logger.Error("failed to check VM namespace visibility", zap.Error(err), zap.String("vm_id", vmID))
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
+		return nil, false
 	}
 	if !visible {
 		c.JSON(http.StatusNotFound, generated.Error{Code: "VM_NOT_FOUND"})
-		return
+		return nil, false
+	}
+	return vm, true
+}
+
+// loadVMRuntime fetches live runtime details, returning a warning instead of
+// an error so GetVM still succeeds when the cluster is unreachable.
+func (s *Server) loadVMRuntime(ctx context.Context, vm *ent.VM) (*generated.VMRuntime, string) {
+	if s.vmService == nil {
+		return nil, "runtime details are unavailable: no infrastructure provider configured"
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, vmRuntimeTimeout)
+	defer cancel()
+	runtime, err := s.vmService.GetVMRuntime(ctx, vm.ClusterID, vm.Namespace, vm.Name)
+	if err != nil {
+		logger.Warn("failed to load VM runtime details",
+			zap.Error(err),
+			zap.String("vm_id", vm.ID),
+			zap.String("cluster_id", vm.ClusterID),
+		)
+		return nil, "runtime details are unavailable: cluster could not be reached or VM is not running"
+	}
+	return vmRuntimeToAPI(runtime), ""
+}
+
+func vmSizeToAPI(size domain.VMSize) generated.VMSize {
+	return generated.VMSize{
+		InstanceSizeId:   size.InstanceSizeID,
+		InstanceSizeName: size.InstanceSizeName,
+		Cpu:              size.CPU,
+		MemoryMb:         size.MemoryMB,
+		DiskGb:           size.DiskGB,
 	}
+}
 
-	c.JSON(http.StatusOK, vmToAPI(vm))
+func vmRuntimeToAPI(runtime *domain.VMRuntime) *generated.VMRuntime {
+	out := &generated.VMRuntime{
+		Phase:               runtime.Phase,
+		NodeName:            runtime.NodeName,
+		LaunchedAt:          runtime.LaunchedAt,
+		GuestAgentConnected: runtime.GuestAgentConnected,
+		Interfaces:          make([]generated.VMRuntimeInterface, 0, len(runtime.Interfaces)),
+	}
+	for _, iface := range runtime.Interfaces {
+		ips := iface.IPAddresses
+		if ips == nil {
+			ips = []string{}
+		}
+		out.Interfaces = append(out.Interfaces, generated.VMRuntimeInterface{
+			Name:        iface.Name,
+			Mac:         iface.MAC,
+			IpAddresses: ips,
+		})
+	}
+	if runtime.GuestOS != nil {
+		out.GuestOs = generated.VMGuestOSInfo{
+			Name:          runtime.GuestOS.Name,
+			PrettyName:    runtime.GuestOS.PrettyName,
+			Version:       runtime.GuestOS.Version,
+			KernelRelease: runtime.GuestOS.KernelRelease,
+		}
+	}
+	return out
 }
 
 // DeleteVM handles DELETE /vms/{vm_id}.
@@ -313,6 +722,7 @@ func (s *Server) DeleteVM(c *gin.Context, vmId generated.VMID, params generated.
 		RequestedBy: actor,
 		Confirm:     params.Confirm,
 		ConfirmName: params.ConfirmName,
+		RequestID:   params.RequestId,
 	}
 
 	result, err := s.deleteVMUC.Execute(ctx, input)
@@ -336,71 +746,151 @@ func (s *Server) DeleteVM(c *gin.Context, vmId generated.VMID, params generated.
 		return
 	}
 
-	_ = result.Status // Keep use case output field for backward compatibility.
-
 	// Notification trigger: APPROVAL_PENDING → notify approvers for delete request.
-	if s.notifier != nil {
+	if s.notifier != nil && !result.Replayed {
 		s.notifier.OnTicketSubmitted(ctx, result.TicketID, actor, "")
 	}
 
 	c.JSON(http.StatusAccepted, generated.DeleteVMResponse{
 		TicketId: result.TicketID,
 		EventId:  result.EventID,
-		Status:   generated.DeleteVMResponseStatusPENDING,
+		Status:   generated.DeleteVMResponseStatus(result.Status),
 	})
 }
 
-// StartVM handles POST /vms/{vm_id}/start.
-// ISSUE-001: Async via River (ADR-0006). Returns 202 Accepted.
-func (s *Server) StartVM(c *gin.Context, vmId generated.VMID) {
+// MigrateVM handles POST /vms/{vm_id}/migrate.
+// Creates a MIGRATE approval ticket; the move runs in River after approval.
+func (s *Server) MigrateVM(c *gin.Context, vmId generated.VMID) {
+	ctx := c.Request.Context()
 	if !requireGlobalPermission(c, "vm:operate") {
 		return
 	}
-	vm, err := s.client.VM.Get(c.Request.Context(), vmId)
+	actor := middleware.GetUserID(ctx)
+
+	var req generated.MigrateVMRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+
+	input := usecase.MigrateVMInput{
+		VMID:            vmId,
+		TargetClusterID: req.TargetClusterId,
+		Reason:          req.Reason,
+		RequestedBy:     actor,
+	}
+
+	result, err := s.migrateVMUC.Execute(ctx, input)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			c.JSON(http.StatusNotFound, generated.Error{Code: "VM_NOT_FOUND"})
+		if appErr, ok := apperrors.IsAppError(err); ok {
+			c.JSON(appErr.HTTPStatus, generated.Error{
+				Code:    appErr.Code,
+				Message: appErr.Message,
+				Params:  appErr.Params,
+			})
 			return
 		}
-		logger.Error("failed to get VM for start", zap.Error(err), zap.String("vm_id", vmId))
+		logger.Error("VM migrate request failed",
+			zap.Error(err),
+			zap.String("vm_id", vmId),
+			zap.String("actor", actor),
+		)
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
 
-	// State guard: only STOPPED or PAUSED VMs can be started.
-	if vm.Status != entvm.StatusSTOPPED && vm.Status != entvm.StatusPAUSED {
-		c.JSON(http.StatusConflict, generated.Error{
-			Code:    "INVALID_STATE_TRANSITION",
-			Message: fmt.Sprintf("cannot start VM in %s state, must be STOPPED or PAUSED", vm.Status),
-		})
-		return
+	if s.notifier != nil {
+		s.notifier.OnTicketSubmitted(ctx, result.TicketID, actor, "")
 	}
 
-	s.enqueueVMPowerOp(c, vm, "start", domain.EventVMStartRequested)
+	c.JSON(http.StatusAccepted, generated.MigrateVMResponse{
+		TicketId: result.TicketID,
+		EventId:  result.EventID,
+		Status:   generated.MigrateVMResponseStatusPENDING,
+	})
 }
 
-// StopVM handles POST /vms/{vm_id}/stop.
-// ISSUE-001: Async via River (ADR-0006). Returns 202 Accepted.
-func (s *Server) StopVM(c *gin.Context, vmId generated.VMID) {
+// ResizeVM handles POST /vms/{vm_id}/resize.
+// Creates a RESIZE approval ticket; resources are patched in River after approval.
+func (s *Server) ResizeVM(c *gin.Context, vmId generated.VMID) {
+	ctx := c.Request.Context()
 	if !requireGlobalPermission(c, "vm:operate") {
 		return
 	}
-	vm, err := s.client.VM.Get(c.Request.Context(), vmId)
+	actor := middleware.GetUserID(ctx)
+
+	var req generated.ResizeVMRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+
+	input := usecase.ResizeVMInput{
+		VMID:           vmId,
+		InstanceSizeID: req.InstanceSizeId,
+		CPU:            req.Cpu,
+		MemoryMB:       req.MemoryMb,
+		DiskGB:         req.DiskGb,
+		Reason:         req.Reason,
+		RequestedBy:    actor,
+	}
+
+	result, err := s.resizeVMUC.Execute(ctx, input)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			c.JSON(http.StatusNotFound, generated.Error{Code: "VM_NOT_FOUND"})
+		if appErr, ok := apperrors.IsAppError(err); ok {
+			c.JSON(appErr.HTTPStatus, generated.Error{
+				Code:    appErr.Code,
+				Message: appErr.Message,
+				Params:  appErr.Params,
+			})
 			return
 		}
-		logger.Error("failed to get VM for stop", zap.Error(err), zap.String("vm_id", vmId))
+		logger.Error("VM resize request failed",
+			zap.Error(err),
+			zap.String("vm_id", vmId),
+			zap.String("actor", actor),
+		)
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
 
-	if vm.Status != entvm.StatusRUNNING {
-		c.JSON(http.StatusConflict, generated.Error{
-			Code:    "INVALID_STATE_TRANSITION",
-			Message: fmt.Sprintf("cannot stop VM in %s state, must be RUNNING", vm.Status),
-		})
+	if s.notifier != nil {
+		s.notifier.OnTicketSubmitted(ctx, result.TicketID, actor, "")
+	}
+
+	c.JSON(http.StatusAccepted, generated.ResizeVMResponse{
+		TicketId: result.TicketID,
+		EventId:  result.EventID,
+		Status:   generated.ResizeVMResponseStatusPENDING,
+		From:     vmSizeToAPI(result.From),
+		To:       vmSizeToAPI(result.To),
+	})
+}
+
+// StartVM handles POST /vms/{vm_id}/start.
+// ISSUE-001: Async via River (ADR-0006). Returns 202 Accepted.
+func (s *Server) StartVM(c *gin.Context, vmId generated.VMID) {
+	vm, ok := s.getOperableVM(c, vmId, "start")
+	if !ok {
+		return
+	}
+
+	if !requirePowerPrecondition(c, vm, "start") {
+		return
+	}
+
+	s.enqueueVMPowerOp(c, vm, "start", domain.EventVMStartRequested)
+}
+
+// StopVM handles POST /vms/{vm_id}/stop.
+// ISSUE-001: Async via River (ADR-0006). Returns 202 Accepted.
+func (s *Server) StopVM(c *gin.Context, vmId generated.VMID) {
+	vm, ok := s.getOperableVM(c, vmId, "stop")
+	if !ok {
+		return
+	}
+
+	if !requirePowerPrecondition(c, vm, "stop") {
 		return
 	}
 
@@ -410,29 +900,61 @@ func (s *Server) StopVM(c *gin.Context, vmId generated.VMID) {
 // RestartVM handles POST /vms/{vm_id}/restart.
 // ISSUE-001: Async via River (ADR-0006). Returns 202 Accepted.
 func (s *Server) RestartVM(c *gin.Context, vmId generated.VMID) {
-	if !requireGlobalPermission(c, "vm:operate") {
+	vm, ok := s.getOperableVM(c, vmId, "restart")
+	if !ok {
 		return
 	}
-	vm, err := s.client.VM.Get(c.Request.Context(), vmId)
+
+	if !requirePowerPrecondition(c, vm, "restart") {
+		return
+	}
+
+	s.enqueueVMPowerOp(c, vm, "restart", domain.EventVMRestartRequested)
+}
+
+// requirePowerPrecondition rejects a power operation the VM's current status
+// does not allow (see domain.CanApplyPowerOperation).
+func requirePowerPrecondition(c *gin.Context, vm *ent.VM, operation string) bool {
+	status := domain.VMStatus(vm.Status)
+	if domain.CanApplyPowerOperation(operation, status) {
+		return true
+	}
+	c.JSON(http.StatusConflict, generated.Error{
+		Code:    "INVALID_STATE_TRANSITION",
+		Message: domain.PowerPreconditionMessage(operation, status),
+	})
+	return false
+}
+
+// getOperableVM loads a VM after checking vm:operate, treating VMs outside a
+// service-scoped actor's services as not found.
+func (s *Server) getOperableVM(c *gin.Context, vmID, operation string) (*ent.VM, bool) {
+	ctx := c.Request.Context()
+	scope, ok := s.requireVMPermission(c, "vm:operate")
+	if !ok {
+		return nil, false
+	}
+	vm, err := s.client.VM.Get(ctx, vmID)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			c.JSON(http.StatusNotFound, generated.Error{Code: "VM_NOT_FOUND"})
-			return
+			return nil, false
 		}
-		logger.Error("failed to get VM for restart", zap.Error(err), zap.String("vm_id", vmId))
+		logger.Error("failed to get VM for "+operation, zap.Error(err), zap.String("vm_id", vmID))
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
+		return nil, false
 	}
-
-	if vm.Status != entvm.StatusRUNNING {
-		c.JSON(http.StatusConflict, generated.Error{
-			Code:    "INVALID_STATE_TRANSITION",
-			Message: fmt.Sprintf("cannot restart VM in %s state, must be RUNNING", vm.Status),
-		})
-		return
+	inScope, err := s.vmInScope(ctx, vm.ID, scope)
+	if err != nil {
+		logger.Error("failed to check VM service scope", zap.Error(err), zap.String("vm_id", vmID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return nil, false
 	}
-
-	s.enqueueVMPowerOp(c, vm, "restart", domain.EventVMRestartRequested)
+	if !inScope {
+		c.JSON(http.StatusNotFound, generated.Error{Code: "VM_NOT_FOUND"})
+		return nil, false
+	}
+	return vm, true
 }
 
 // enqueueVMPowerOp creates a DomainEvent, enqueues a River job, and returns 202 Accepted.
@@ -505,5 +1027,6 @@ func vmToAPI(vm *ent.VM) generated.VM {
 		TicketId:  vm.TicketID,
 		CreatedBy: vm.CreatedBy,
 		CreatedAt: vm.CreatedAt,
+		Labels:    vm.Labels,
 	}
 }
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	openapi_types "github.com/oapi-codegen/runtime/types"
@@ -20,13 +21,19 @@ import (
 	"kv-shepherd.io/shepherd/ent/approvalticket"
 	"kv-shepherd.io/shepherd/ent/batchapprovalticket"
 	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/ent/namespacequota"
+	"kv-shepherd.io/shepherd/ent/namespaceregistry"
 	"kv-shepherd.io/shepherd/ent/ratelimitexemption"
 	"kv-shepherd.io/shepherd/ent/ratelimituseroverride"
+	enttemplate "kv-shepherd.io/shepherd/ent/template"
+	"kv-shepherd.io/shepherd/ent/vm"
 	"kv-shepherd.io/shepherd/internal/api/generated"
 	"kv-shepherd.io/shepherd/internal/api/middleware"
 	"kv-shepherd.io/shepherd/internal/domain"
 	"kv-shepherd.io/shepherd/internal/jobs"
 	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/pkg/metrics"
+	"kv-shepherd.io/shepherd/internal/service"
 )
 
 const (
@@ -37,6 +44,9 @@ const (
 	maxGlobalBatchRequestsPerMinute = 1000
 	batchSubmitCooldown             = 2 * time.Minute
 	batchRetryAfterSeconds          = 2
+
+	// batchSubmitLockPrefix namespaces the per-actor advisory lock key.
+	batchSubmitLockPrefix = "batch_submit:"
 )
 
 var errBatchNotFound = errors.New("batch not found")
@@ -47,11 +57,17 @@ type preparedBatchChild struct {
 	payload       []byte
 	operationType approvalticket.OperationType
 	reason        string
+	// namespace is the VM namespace of a power child.
+	namespace string
+	// skipReason marks a power child persisted as CANCELLED without
+	// dispatch because its VM status does not allow the operation.
+	skipReason string
 }
 
 type batchValidationError struct {
-	status int
-	body   generated.Error
+	status     int
+	retryAfter int
+	body       generated.Error
 }
 
 func (e *batchValidationError) Error() string {
@@ -100,12 +116,14 @@ func (s *Server) submitBatch(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_BATCH_OPERATION", Message: err.Error()})
 		return
 	}
+	scope := vmScope{}
 	if op == string(generated.VMBatchOperationDELETE) {
 		if !requireGlobalPermission(c, "vm:delete") {
 			return
 		}
 	} else {
-		if !requireGlobalPermission(c, "vm:create") {
+		var ok bool
+		if scope, ok = s.requireVMPermission(c, "vm:create"); !ok {
 			return
 		}
 	}
@@ -116,6 +134,7 @@ func (s *Server) submitBatch(c *gin.Context) {
 			c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 			return
 		} else if ok {
+			recordBatchSubmission(op, metrics.SubmissionAccepted)
 			c.JSON(http.StatusAccepted, generated.VMBatchSubmitResponse{
 				BatchId:           existingID,
 				Status:            generated.VMBatchParentStatusPENDINGAPPROVAL,
@@ -126,20 +145,38 @@ func (s *Server) submitBatch(c *gin.Context) {
 		}
 	}
 
-	globalPending, userPending, err := s.pendingBatchParentCounters(ctx, actor)
+	// Limits are checked and the parent inserted under one per-actor lock so
+	// concurrent submits cannot all pass against the same pending count.
+	tx, err := s.beginBatchSubmitTx(ctx, actor)
+	if err != nil {
+		logger.Error("failed to begin batch submission tx", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	globalPending, userPending, err := s.pendingBatchParentCounters(ctx, tx.Client(), actor)
 	if err != nil {
+		_ = tx.Rollback()
 		logger.Error("failed to evaluate batch submission limits", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
-	limitPolicy, err := s.resolveBatchUserLimitPolicy(ctx, actor)
+	limitPolicy, err := s.resolveBatchUserLimitPolicy(ctx, tx.Client(), actor)
 	if err != nil {
+		_ = tx.Rollback()
 		logger.Error("failed to resolve batch user limit policy", zap.Error(err), zap.String("actor", actor))
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
-	userPendingParentExceeded := !limitPolicy.Exempt && userPending >= limitPolicy.MaxPendingParents
-	if globalPending >= maxPendingBatchParents || userPendingParentExceeded {
+	if batchParentLimitReached(globalPending, userPending, limitPolicy) {
+		_ = tx.Rollback()
+		recordBatchSubmission(op, metrics.SubmissionRateLimited)
 		c.Header("Retry-After", strconv.Itoa(batchRetryAfterSeconds))
 		contactAdmin := !limitPolicy.Exempt && limitPolicy.UsesDefault
 		c.JSON(http.StatusTooManyRequests, generated.Error{
@@ -156,11 +193,14 @@ func (s *Server) submitBatch(c *gin.Context) {
 		})
 		return
 	}
-	if extraLimit, err := s.evaluateAdditionalBatchSubmissionLimits(ctx, actor, len(req.Items), limitPolicy); err != nil {
+	if extraLimit, err := s.evaluateAdditionalBatchSubmissionLimits(ctx, tx.Client(), actor, len(req.Items), limitPolicy); err != nil {
+		_ = tx.Rollback()
 		logger.Error("failed to evaluate additional batch submission limits", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	} else if extraLimit != nil {
+		_ = tx.Rollback()
+		recordBatchSubmission(op, metrics.SubmissionRateLimited)
 		retryAfter := extraLimit.RetryAfterSeconds
 		if retryAfter <= 0 {
 			retryAfter = batchRetryAfterSeconds
@@ -185,15 +225,23 @@ func (s *Server) submitBatch(c *gin.Context) {
 		return
 	}
 
-	visibility, err := s.resolveNamespaceVisibility(c)
-	if err != nil {
-		logger.Error("failed to resolve namespace visibility for batch submit", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
+	// Service-scoped actors are bounded by their bound services instead of
+	// namespace environment visibility.
+	visibility := namespaceVisibility{}
+	if scope.global() {
+		visibility, err = s.resolveNamespaceVisibility(c)
+		if err != nil {
+			_ = tx.Rollback()
+			logger.Error("failed to resolve namespace visibility for batch submit", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+			return
+		}
 	}
 
-	children, err := s.prepareBatchChildren(ctx, actor, op, req, visibility)
+	children, err := s.prepareBatchChildren(ctx, actor, op, req, visibility, scope, !hasPlatformAdmin(c))
 	if err != nil {
+		_ = tx.Rollback()
+		recordBatchSubmission(op, metrics.SubmissionRejected)
 		if appErr, ok := err.(*batchValidationError); ok {
 			c.JSON(appErr.status, appErr.body)
 			return
@@ -214,24 +262,12 @@ func (s *Server) submitBatch(c *gin.Context) {
 	}
 	parentPayloadBytes, err := parentPayload.ToJSON()
 	if err != nil {
+		_ = tx.Rollback()
 		logger.Error("failed to marshal parent batch payload", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
 
-	tx, err := s.client.Tx(ctx)
-	if err != nil {
-		logger.Error("failed to begin batch submission tx", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
-	}
-	defer func() {
-		if v := recover(); v != nil {
-			_ = tx.Rollback()
-			panic(v)
-		}
-	}()
-
 	parentEventID := generateIDV7()
 	_, err = tx.DomainEvent.Create().
 		SetID(parentEventID).
@@ -327,6 +363,7 @@ func (s *Server) submitBatch(c *gin.Context) {
 		return
 	}
 
+	recordBatchSubmission(op, metrics.SubmissionAccepted)
 	if s.audit != nil {
 		_ = s.audit.LogAction(ctx, "vm.batch.submit", "approval_ticket", parentID, actor, map[string]interface{}{
 			"operation":  op,
@@ -359,110 +396,221 @@ func (s *Server) submitBatchPower(c *gin.Context) {
 		return
 	}
 
-	if len(req.Items) == 0 || len(req.Items) > maxBatchItems {
-		c.JSON(http.StatusBadRequest, generated.Error{
-			Code:    "INVALID_BATCH_SIZE",
-			Message: fmt.Sprintf("batch size must be between 1 and %d", maxBatchItems),
-		})
+	visibility, err := s.resolveNamespaceVisibility(c)
+	if err != nil {
+		logger.Error("failed to resolve namespace visibility for power-batch submit", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
 
-	opKey, jobOperation, childEventType, err := normalizeBatchPowerOperation(req.Operation)
+	resp, err := s.createBatchPower(ctx, actor, req, visibility)
+	recordBatchPowerSubmission(err)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, generated.Error{
-			Code:    "INVALID_BATCH_OPERATION",
-			Message: err.Error(),
-		})
+		if appErr, ok := err.(*batchValidationError); ok {
+			if appErr.retryAfter > 0 {
+				c.Header("Retry-After", strconv.Itoa(appErr.retryAfter))
+			}
+			c.JSON(appErr.status, appErr.body)
+			return
+		}
+		logger.Error("failed to submit power-batch", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
 		return
 	}
+	c.JSON(http.StatusAccepted, resp)
+}
+
+// SubmitScheduledBatchPower submits a batch power request for a cron schedule,
+// reusing the POST /vms/batch/power validation and rate limits without HTTP.
+// Schedules are admin-managed, so namespace visibility is not restricted, and
+// VMs already in the target state are skipped rather than failing the run.
+func (s *Server) SubmitScheduledBatchPower(ctx context.Context, in jobs.ScheduledBatchPowerRequest) (string, error) {
+	items := make([]generated.VMBatchPowerItem, 0, len(in.VMIDs))
+	for _, vmID := range in.VMIDs {
+		items = append(items, generated.VMBatchPowerItem{VmId: vmID})
+	}
+	req := generated.VMBatchPowerRequest{
+		Operation:   generated.VMBatchPowerAction(in.Operation),
+		RequestId:   in.RequestID,
+		Reason:      in.Reason,
+		SkipInvalid: true,
+		Items:       items,
+	}
+
+	resp, err := s.createBatchPower(ctx, in.Actor, req, namespaceVisibility{restricted: false})
+	recordBatchPowerSubmission(err)
+	if err != nil {
+		if appErr, ok := err.(*batchValidationError); ok && appErr.status == http.StatusTooManyRequests {
+			return "", fmt.Errorf("%w: %s", jobs.ErrBatchPowerThrottled, appErr.Error())
+		}
+		return "", err
+	}
+	return resp.BatchId, nil
+}
+
+// recordBatchSubmission counts a batch submission outcome by operation.
+func recordBatchSubmission(operation, status string) {
+	metrics.BatchSubmissions.WithLabelValues(strings.ToLower(operation), status).Inc()
+}
+
+// recordBatchPowerSubmission counts the outcome of createBatchPower.
+// Internal errors are not client outcomes and are not counted.
+func recordBatchPowerSubmission(err error) {
+	if err == nil {
+		recordBatchSubmission("power", metrics.SubmissionAccepted)
+		return
+	}
+	appErr, ok := err.(*batchValidationError)
+	if !ok {
+		return
+	}
+	if appErr.status == http.StatusTooManyRequests {
+		recordBatchSubmission("power", metrics.SubmissionRateLimited)
+		return
+	}
+	recordBatchSubmission("power", metrics.SubmissionRejected)
+}
+
+// createBatchPower validates, rate-limits and persists a batch power request.
+// Client-facing rejections are returned as *batchValidationError.
+func (s *Server) createBatchPower(
+	ctx context.Context,
+	actor string,
+	req generated.VMBatchPowerRequest,
+	visibility namespaceVisibility,
+) (*generated.VMBatchSubmitResponse, error) {
+	if len(req.Items) == 0 || len(req.Items) > maxBatchItems {
+		return nil, &batchValidationError{
+			status: http.StatusBadRequest,
+			body: generated.Error{
+				Code:    "INVALID_BATCH_SIZE",
+				Message: fmt.Sprintf("batch size must be between 1 and %d", maxBatchItems),
+			},
+		}
+	}
+
+	opKey, jobOperation, childEventType, err := normalizeBatchPowerOperation(req.Operation)
+	if err != nil {
+		return nil, &batchValidationError{
+			status: http.StatusBadRequest,
+			body: generated.Error{
+				Code:    "INVALID_BATCH_OPERATION",
+				Message: err.Error(),
+			},
+		}
+	}
 
 	if strings.TrimSpace(req.RequestId) != "" {
 		if existingID, ok, err := s.findBatchByRequestID(ctx, actor, opKey, req.RequestId); err != nil {
-			logger.Error("failed to query power-batch idempotency", zap.Error(err))
-			c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-			return
+			return nil, fmt.Errorf("query power-batch idempotency: %w", err)
 		} else if ok {
-			c.JSON(http.StatusAccepted, generated.VMBatchSubmitResponse{
+			return &generated.VMBatchSubmitResponse{
 				BatchId:           existingID,
 				Status:            generated.VMBatchParentStatusINPROGRESS,
 				StatusUrl:         "/api/v1/vms/batch/" + existingID,
 				RetryAfterSeconds: batchRetryAfterSeconds,
-			})
-			return
+			}, nil
 		}
 	}
 
-	globalPending, userPending, err := s.pendingBatchParentCounters(ctx, actor)
+	tx, err := s.beginBatchSubmitTx(ctx, actor)
 	if err != nil {
-		logger.Error("failed to evaluate power-batch submission limits", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
+		return nil, fmt.Errorf("begin power-batch submission tx: %w", err)
 	}
-	limitPolicy, err := s.resolveBatchUserLimitPolicy(ctx, actor)
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	globalPending, userPending, err := s.pendingBatchParentCounters(ctx, tx.Client(), actor)
 	if err != nil {
-		logger.Error("failed to resolve power-batch user limit policy", zap.Error(err), zap.String("actor", actor))
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("evaluate power-batch submission limits: %w", err)
 	}
-	userPendingParentExceeded := !limitPolicy.Exempt && userPending >= limitPolicy.MaxPendingParents
-	if globalPending >= maxPendingBatchParents || userPendingParentExceeded {
-		c.Header("Retry-After", strconv.Itoa(batchRetryAfterSeconds))
-		c.JSON(http.StatusTooManyRequests, generated.Error{
-			Code:    "BATCH_RATE_LIMITED",
-			Message: "batch power submission throttled by pending parent limits",
-			Params: map[string]interface{}{
-				"retry_after_seconds": batchRetryAfterSeconds,
-				"global_pending":      globalPending,
-				"user_pending":        userPending,
-				"user_exempted":       limitPolicy.Exempt,
-				"max_user_pending":    limitPolicy.MaxPendingParents,
+	limitPolicy, err := s.resolveBatchUserLimitPolicy(ctx, tx.Client(), actor)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("resolve power-batch user limit policy for %s: %w", actor, err)
+	}
+	if batchParentLimitReached(globalPending, userPending, limitPolicy) {
+		_ = tx.Rollback()
+		return nil, &batchValidationError{
+			status:     http.StatusTooManyRequests,
+			retryAfter: batchRetryAfterSeconds,
+			body: generated.Error{
+				Code:    "BATCH_RATE_LIMITED",
+				Message: "batch power submission throttled by pending parent limits",
+				Params: map[string]interface{}{
+					"retry_after_seconds": batchRetryAfterSeconds,
+					"global_pending":      globalPending,
+					"user_pending":        userPending,
+					"user_exempted":       limitPolicy.Exempt,
+					"max_user_pending":    limitPolicy.MaxPendingParents,
+				},
 			},
-		})
-		return
+		}
 	}
-	if extraLimit, err := s.evaluateAdditionalBatchSubmissionLimits(ctx, actor, len(req.Items), limitPolicy); err != nil {
-		logger.Error("failed to evaluate power-batch additional limits", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
+	if extraLimit, err := s.evaluateAdditionalBatchSubmissionLimits(ctx, tx.Client(), actor, len(req.Items), limitPolicy); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("evaluate power-batch additional limits: %w", err)
 	} else if extraLimit != nil {
+		_ = tx.Rollback()
 		retryAfter := extraLimit.RetryAfterSeconds
 		if retryAfter <= 0 {
 			retryAfter = batchRetryAfterSeconds
 		}
-		c.Header("Retry-After", strconv.Itoa(retryAfter))
-		c.JSON(http.StatusTooManyRequests, generated.Error{
-			Code:    "BATCH_RATE_LIMITED",
-			Message: "batch power submission throttled by additional rate limits",
-			Params: map[string]interface{}{
-				"reason":                    extraLimit.Reason,
-				"retry_after_seconds":       retryAfter,
-				"global_recent_submits":     extraLimit.GlobalRecentSubmits,
-				"user_pending_children":     extraLimit.UserPendingChildren,
-				"user_cooldown_seconds":     extraLimit.UserCooldownSeconds,
-				"requested_child_count":     len(req.Items),
-				"max_global_per_minute":     maxGlobalBatchRequestsPerMinute,
-				"max_user_pending_children": limitPolicy.MaxPendingChildren,
-				"user_exempted":             limitPolicy.Exempt,
+		return nil, &batchValidationError{
+			status:     http.StatusTooManyRequests,
+			retryAfter: retryAfter,
+			body: generated.Error{
+				Code:    "BATCH_RATE_LIMITED",
+				Message: "batch power submission throttled by additional rate limits",
+				Params: map[string]interface{}{
+					"reason":                    extraLimit.Reason,
+					"retry_after_seconds":       retryAfter,
+					"global_recent_submits":     extraLimit.GlobalRecentSubmits,
+					"user_pending_children":     extraLimit.UserPendingChildren,
+					"user_cooldown_seconds":     extraLimit.UserCooldownSeconds,
+					"requested_child_count":     len(req.Items),
+					"max_global_per_minute":     maxGlobalBatchRequestsPerMinute,
+					"max_user_pending_children": limitPolicy.MaxPendingChildren,
+					"user_exempted":             limitPolicy.Exempt,
+				},
 			},
-		})
-		return
+		}
 	}
 
-	visibility, err := s.resolveNamespaceVisibility(c)
-	if err != nil {
-		logger.Error("failed to resolve namespace visibility for power-batch submit", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
-	}
 	children, err := s.prepareBatchPowerChildren(ctx, actor, jobOperation, childEventType, req, visibility)
 	if err != nil {
-		if appErr, ok := err.(*batchValidationError); ok {
-			c.JSON(appErr.status, appErr.body)
-			return
+		_ = tx.Rollback()
+		if _, ok := err.(*batchValidationError); ok {
+			return nil, err
 		}
 		logger.Error("failed to prepare power-batch child tickets", zap.Error(err))
-		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_BATCH_ITEMS", Message: err.Error()})
-		return
+		return nil, &batchValidationError{
+			status: http.StatusBadRequest,
+			body:   generated.Error{Code: "INVALID_BATCH_ITEMS", Message: err.Error()},
+		}
+	}
+	skippedCount := countSkippedBatchChildren(children)
+	requiresApproval, err := s.powerBatchRequiresApproval(ctx, tx.Client(), children)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("resolve power-batch approval requirement: %w", err)
+	}
+	// Without approval the batch runs on submit; with it, parent and
+	// children wait PENDING like a create/delete batch.
+	parentEventStatus := domainevent.StatusPROCESSING
+	parentTicketStatus := approvalticket.StatusEXECUTING
+	projectionStatus := batchapprovalticket.StatusIN_PROGRESS
+	childTicketStatus := approvalticket.StatusEXECUTING
+	if requiresApproval {
+		parentEventStatus = domainevent.StatusPENDING
+		parentTicketStatus = approvalticket.StatusPENDING
+		projectionStatus = batchapprovalticket.StatusPENDING_APPROVAL
+		childTicketStatus = approvalticket.StatusPENDING
 	}
 
 	parentID := generateIDV7()
@@ -476,24 +624,10 @@ func (s *Server) submitBatchPower(c *gin.Context) {
 	}
 	parentPayloadBytes, err := parentPayload.ToJSON()
 	if err != nil {
-		logger.Error("failed to marshal power-batch parent payload", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("marshal power-batch parent payload: %w", err)
 	}
 
-	tx, err := s.client.Tx(ctx)
-	if err != nil {
-		logger.Error("failed to begin power-batch submission tx", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
-	}
-	defer func() {
-		if v := recover(); v != nil {
-			_ = tx.Rollback()
-			panic(v)
-		}
-	}()
-
 	parentEventID := generateIDV7()
 	_, err = tx.DomainEvent.Create().
 		SetID(parentEventID).
@@ -501,14 +635,12 @@ func (s *Server) submitBatchPower(c *gin.Context) {
 		SetAggregateType("batch").
 		SetAggregateID(parentID).
 		SetPayload(parentPayloadBytes).
-		SetStatus(domainevent.StatusPROCESSING).
+		SetStatus(parentEventStatus).
 		SetCreatedBy(actor).
 		Save(ctx)
 	if err != nil {
 		_ = tx.Rollback()
-		logger.Error("failed to create power-batch parent domain event", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
+		return nil, fmt.Errorf("create power-batch parent domain event: %w", err)
 	}
 
 	parentReason := strings.TrimSpace(req.Reason)
@@ -519,34 +651,37 @@ func (s *Server) submitBatchPower(c *gin.Context) {
 		SetID(parentID).
 		SetEventID(parentEventID).
 		SetOperationType(approvalticket.OperationTypeCREATE).
-		SetStatus(approvalticket.StatusEXECUTING).
+		SetStatus(parentTicketStatus).
 		SetRequester(actor).
 		SetReason(parentReason).
 		Save(ctx); err != nil {
 		_ = tx.Rollback()
-		logger.Error("failed to create power-batch parent approval ticket", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
+		return nil, fmt.Errorf("create power-batch parent approval ticket: %w", err)
 	}
 
 	if _, err := tx.BatchApprovalTicket.Create().
 		SetID(parentID).
 		SetBatchType(batchapprovalticket.BatchTypeBATCH_POWER).
 		SetChildCount(len(children)).
-		SetPendingCount(len(children)).
-		SetStatus(batchapprovalticket.StatusIN_PROGRESS).
+		SetPendingCount(len(children) - skippedCount).
+		SetStatus(projectionStatus).
 		SetCreatedBy(actor).
 		SetReason(parentReason).
 		SetNillableRequestID(nillableTrimmed(req.RequestId)).
 		Save(ctx); err != nil {
 		_ = tx.Rollback()
-		logger.Error("failed to create power-batch projection row", zap.Error(err), zap.String("batch_id", parentID))
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
+		return nil, fmt.Errorf("create power-batch projection row %s: %w", parentID, err)
 	}
 
-	childEventIDs := make([]string, 0, len(children))
+	childEventIDs := make([]string, 0, len(children)-skippedCount)
 	for _, child := range children {
+		eventStatus := domainevent.StatusPENDING
+		ticketStatus := childTicketStatus
+		if child.skipReason != "" {
+			eventStatus = domainevent.StatusCANCELLED
+			ticketStatus = approvalticket.StatusCANCELLED
+		}
+
 		childEventID := generateIDV7()
 		_, err := tx.DomainEvent.Create().
 			SetID(childEventID).
@@ -554,37 +689,36 @@ func (s *Server) submitBatchPower(c *gin.Context) {
 			SetAggregateType("vm").
 			SetAggregateID(child.aggregateID).
 			SetPayload(child.payload).
-			SetStatus(domainevent.StatusPENDING).
+			SetStatus(eventStatus).
 			SetCreatedBy(actor).
 			Save(ctx)
 		if err != nil {
 			_ = tx.Rollback()
-			logger.Error("failed to create power-batch child domain event", zap.Error(err))
-			c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-			return
+			return nil, fmt.Errorf("create power-batch child domain event: %w", err)
 		}
 
-		if _, err := tx.ApprovalTicket.Create().
+		ticket := tx.ApprovalTicket.Create().
 			SetID(generateIDV7()).
 			SetEventID(childEventID).
 			SetOperationType(approvalticket.OperationTypeCREATE).
-			SetStatus(approvalticket.StatusEXECUTING).
+			SetStatus(ticketStatus).
 			SetRequester(actor).
 			SetReason(child.reason).
-			SetParentTicketID(parentID).
-			Save(ctx); err != nil {
+			SetParentTicketID(parentID)
+		if child.skipReason != "" {
+			ticket.SetRejectReason(child.skipReason)
+		}
+		if _, err := ticket.Save(ctx); err != nil {
 			_ = tx.Rollback()
-			logger.Error("failed to create power-batch child approval ticket", zap.Error(err))
-			c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-			return
+			return nil, fmt.Errorf("create power-batch child approval ticket: %w", err)
+		}
+		if child.skipReason == "" && !requiresApproval {
+			childEventIDs = append(childEventIDs, childEventID)
 		}
-		childEventIDs = append(childEventIDs, childEventID)
 	}
 
 	if err := tx.Commit(); err != nil {
-		logger.Error("failed to commit power-batch submission tx", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
+		return nil, fmt.Errorf("commit power-batch submission tx: %w", err)
 	}
 
 	for _, eventID := range childEventIDs {
@@ -605,8 +739,10 @@ func (s *Server) submitBatchPower(c *gin.Context) {
 
 	if s.audit != nil {
 		_ = s.audit.LogAction(ctx, "vm.batch.power.submit", "approval_ticket", parentID, actor, map[string]interface{}{
-			"operation":  strings.ToLower(jobOperation),
-			"item_count": len(children),
+			"operation":         strings.ToLower(jobOperation),
+			"item_count":        len(children),
+			"skipped_count":     skippedCount,
+			"requires_approval": requiresApproval,
 		})
 	}
 
@@ -614,43 +750,52 @@ func (s *Server) submitBatchPower(c *gin.Context) {
 	if view, _, err := s.loadBatchView(ctx, parentID); err == nil {
 		status = view.Status
 	}
-	c.JSON(http.StatusAccepted, generated.VMBatchSubmitResponse{
+	return &generated.VMBatchSubmitResponse{
 		BatchId:           parentID,
 		Status:            status,
 		StatusUrl:         "/api/v1/vms/batch/" + parentID,
 		RetryAfterSeconds: batchRetryAfterSeconds,
-	})
+	}, nil
 }
 
 // GetVMBatch handles GET /vms/batch/{batch_id}.
 func (s *Server) GetVMBatch(c *gin.Context, batchId generated.BatchID) {
+	resp, ok := s.loadReadableBatchView(c, string(batchId))
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// loadReadableBatchView loads a batch view the caller may read: the batch
+// owner or a platform admin. Other callers get 404 so batch IDs do not leak.
+func (s *Server) loadReadableBatchView(c *gin.Context, batchID string) (generated.VMBatchStatusResponse, bool) {
 	ctx := c.Request.Context()
 	if !requireAnyGlobalPermission(c, "vm:read", "vm:create", "vm:delete", "vm:operate") {
-		return
+		return generated.VMBatchStatusResponse{}, false
 	}
 	actor := middleware.GetUserID(ctx)
 	if strings.TrimSpace(actor) == "" {
 		c.JSON(http.StatusUnauthorized, generated.Error{Code: "UNAUTHORIZED"})
-		return
+		return generated.VMBatchStatusResponse{}, false
 	}
 
-	resp, _, err := s.loadBatchView(ctx, string(batchId))
+	resp, _, err := s.loadBatchView(ctx, batchID)
 	if err != nil {
 		if ent.IsNotFound(err) || errors.Is(err, errBatchNotFound) {
 			c.JSON(http.StatusNotFound, generated.Error{Code: "BATCH_NOT_FOUND"})
-			return
+			return generated.VMBatchStatusResponse{}, false
 		}
-		logger.Error("failed to load batch view", zap.Error(err), zap.String("batch_id", string(batchId)))
+		logger.Error("failed to load batch view", zap.Error(err), zap.String("batch_id", batchID))
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
-		return
+		return generated.VMBatchStatusResponse{}, false
 	}
 
 	if !hasPlatformAdmin(c) && resp.CreatedBy != actor {
 		c.JSON(http.StatusNotFound, generated.Error{Code: "BATCH_NOT_FOUND"})
-		return
+		return generated.VMBatchStatusResponse{}, false
 	}
-
-	c.JSON(http.StatusOK, resp)
+	return resp, true
 }
 
 // RetryVMBatch handles POST /vms/batch/{batch_id}/retry.
@@ -674,6 +819,22 @@ func (s *Server) mutateBatchChildren(c *gin.Context, batchID string, action stri
 		return
 	}
 
+	// The body is optional; without it the action applies to every eligible child.
+	var req generated.VMBatchActionRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+			return
+		}
+	}
+	if len(req.TicketIds) > maxBatchItems {
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:    "INVALID_REQUEST",
+			Message: fmt.Sprintf("ticket_ids must contain at most %d entries", maxBatchItems),
+		})
+		return
+	}
+
 	resp, children, err := s.loadBatchView(ctx, batchID)
 	if err != nil {
 		if ent.IsNotFound(err) || errors.Is(err, errBatchNotFound) {
@@ -710,24 +871,12 @@ func (s *Server) mutateBatchChildren(c *gin.Context, batchID string, action stri
 	}
 	isPowerBatch := domain.EventType(parentEvent.EventType) == domain.EventBatchPowerRequested
 
-	targetIDs := make([]string, 0)
-	targetEventIDs := make([]string, 0)
-	targetChildren := make([]*ent.ApprovalTicket, 0)
-	for _, child := range children {
-		switch action {
-		case "retry":
-			if child.Status == approvalticket.StatusFAILED || child.Status == approvalticket.StatusREJECTED {
-				targetIDs = append(targetIDs, child.ID)
-				targetEventIDs = append(targetEventIDs, child.EventID)
-				targetChildren = append(targetChildren, child)
-			}
-		case "cancel":
-			if child.Status == approvalticket.StatusPENDING {
-				targetIDs = append(targetIDs, child.ID)
-				targetEventIDs = append(targetEventIDs, child.EventID)
-				targetChildren = append(targetChildren, child)
-			}
-		}
+	targetChildren, skipped := selectBatchActionTargets(children, action, req.TicketIds)
+	targetIDs := make([]string, 0, len(targetChildren))
+	targetEventIDs := make([]string, 0, len(targetChildren))
+	for _, child := range targetChildren {
+		targetIDs = append(targetIDs, child.ID)
+		targetEventIDs = append(targetEventIDs, child.EventID)
 	}
 
 	affectedCount := 0
@@ -825,12 +974,22 @@ func (s *Server) mutateBatchChildren(c *gin.Context, batchID string, action stri
 					affectedTicketIDs = append(affectedTicketIDs, child.ID)
 					continue
 				}
+				// Children approved with a per-child selection keep it on
+				// their own ticket; others inherit the parent's.
+				clusterID, storageClass := parentTicket.SelectedClusterID, parentTicket.SelectedStorageClass
+				if child.SelectedClusterID != "" {
+					clusterID = child.SelectedClusterID
+				}
+				if child.SelectedStorageClass != "" {
+					storageClass = child.SelectedStorageClass
+				}
 				if err := s.gateway.Approve(
 					ctx,
 					child.ID,
 					actor,
-					parentTicket.SelectedClusterID,
-					parentTicket.SelectedStorageClass,
+					clusterID,
+					storageClass,
+					parentTicket.ApprovalComment,
 				); err != nil {
 					message := err.Error()
 					if len(message) > 512 {
@@ -886,17 +1045,86 @@ func (s *Server) mutateBatchChildren(c *gin.Context, batchID string, action stri
 		Status:            updated.Status,
 		AffectedCount:     affectedCount,
 		AffectedTicketIds: affectedTicketIDs,
+		Skipped:           skipped,
 	})
 }
 
+// batchActionEligible reports whether a child ticket can be retried/cancelled.
+func batchActionEligible(action string, status approvalticket.Status) bool {
+	switch action {
+	case "retry":
+		return status == approvalticket.StatusFAILED || status == approvalticket.StatusREJECTED
+	case "cancel":
+		return status == approvalticket.StatusPENDING
+	default:
+		return false
+	}
+}
+
+// selectBatchActionTargets picks the children a retry/cancel applies to.
+// With no ticketIDs every eligible child is selected (legacy behavior);
+// otherwise only the listed children are, and listed IDs that are not
+// children of the batch or not eligible are reported as skipped.
+func selectBatchActionTargets(
+	children []*ent.ApprovalTicket,
+	action string,
+	ticketIDs []string,
+) ([]*ent.ApprovalTicket, []generated.VMBatchSkippedTicket) {
+	targets := make([]*ent.ApprovalTicket, 0, len(children))
+	if len(ticketIDs) == 0 {
+		for _, child := range children {
+			if batchActionEligible(action, child.Status) {
+				targets = append(targets, child)
+			}
+		}
+		return targets, nil
+	}
+
+	byID := make(map[string]*ent.ApprovalTicket, len(children))
+	for _, child := range children {
+		byID[child.ID] = child
+	}
+	skipped := make([]generated.VMBatchSkippedTicket, 0)
+	seen := make(map[string]struct{}, len(ticketIDs))
+	for _, raw := range ticketIDs {
+		id := strings.TrimSpace(raw)
+		if _, dup := seen[id]; dup {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		child, ok := byID[id]
+		switch {
+		case !ok:
+			skipped = append(skipped, generated.VMBatchSkippedTicket{
+				TicketId: id,
+				Reason:   generated.NOTINBATCH,
+			})
+		case !batchActionEligible(action, child.Status):
+			skipped = append(skipped, generated.VMBatchSkippedTicket{
+				TicketId: id,
+				Reason:   generated.INVALIDSTATE,
+				Status:   child.Status.String(),
+			})
+		default:
+			targets = append(targets, child)
+		}
+	}
+	return targets, skipped
+}
+
 func (s *Server) prepareBatchChildren(
 	ctx context.Context,
 	actor string,
 	op string,
 	req generated.VMBatchSubmitRequest,
 	visibility namespaceVisibility,
+	scope vmScope,
+	requireOwnership bool,
 ) ([]preparedBatchChild, error) {
 	children := make([]preparedBatchChild, 0, len(req.Items))
+	// plannedCreates counts CREATE items per namespace seen so far in this batch.
+	plannedCreates := make(map[string]int)
 
 	for idx, item := range req.Items {
 		itemReason := strings.TrimSpace(item.Reason)
@@ -913,6 +1141,42 @@ func (s *Server) prepareBatchChildren(
 			templateID := strings.TrimSpace(item.TemplateId.String())
 			instanceSizeID := strings.TrimSpace(item.InstanceSizeId.String())
 			namespace := strings.TrimSpace(item.Namespace)
+			// A clone boots from the source VM's root disk PVC rather than the
+			// template image and defaults to the source VM's namespace.
+			var clone *vmCloneSource
+			if strings.TrimSpace(item.SourceVmId) != "" {
+				var err error
+				clone, err = s.resolveVMCloneSource(ctx, item.SourceVmId, item.TargetNamespace, visibility, scope)
+				if err != nil {
+					if appErr, ok := err.(*batchValidationError); ok {
+						appErr.body.Message = fmt.Sprintf("create item #%d: %s", idx+1, appErr.body.Message)
+					}
+					return nil, err
+				}
+				if strings.TrimSpace(item.TargetNamespace) == "" && namespace != "" && namespace != clone.Namespace {
+					return nil, &batchValidationError{
+						status: http.StatusBadRequest,
+						body: generated.Error{
+							Code:    "CLONE_NAMESPACE_MISMATCH",
+							Message: fmt.Sprintf("create item #%d clones into the source VM's namespace unless target_namespace is set", idx+1),
+							Params: map[string]interface{}{
+								"namespace":        namespace,
+								"source_namespace": clone.Namespace,
+								"item":             idx + 1,
+							},
+						},
+					}
+				}
+				namespace = clone.TargetNamespace
+			} else if strings.TrimSpace(item.TargetNamespace) != "" {
+				return nil, &batchValidationError{
+					status: http.StatusBadRequest,
+					body: generated.Error{
+						Code:    "INVALID_BATCH_ITEM",
+						Message: fmt.Sprintf("create item #%d sets target_namespace without source_vm_id", idx+1),
+					},
+				}
+			}
 			if isZeroUUID(item.ServiceId) || isZeroUUID(item.TemplateId) || isZeroUUID(item.InstanceSizeId) || namespace == "" {
 				return nil, &batchValidationError{
 					status: http.StatusBadRequest,
@@ -922,6 +1186,16 @@ func (s *Server) prepareBatchChildren(
 					},
 				}
 			}
+			if !scope.allowsService(serviceID) {
+				return nil, &batchValidationError{
+					status: http.StatusForbidden,
+					body: generated.Error{
+						Code:    "SERVICE_FORBIDDEN",
+						Message: fmt.Sprintf("create item #%d targets service %s outside the actor's service bindings", idx+1, serviceID),
+						Params:  map[string]interface{}{"service_id": serviceID, "item": idx + 1},
+					},
+				}
+			}
 			visible, err := s.isNamespaceVisible(ctx, namespace, visibility)
 			if err != nil {
 				return nil, err
@@ -935,6 +1209,53 @@ func (s *Server) prepareBatchChildren(
 					},
 				}
 			}
+			// Globally scoped requesters still need a binding on the service or
+			// its system; service-scoped ones were checked by allowsService.
+			if requireOwnership && scope.global() {
+				allowed, err := s.canRequestVMForService(ctx, actor, serviceID)
+				if err != nil {
+					return nil, err
+				}
+				if !allowed {
+					return nil, &batchValidationError{
+						status: http.StatusForbidden,
+						body: generated.Error{
+							Code:    "SERVICE_FORBIDDEN",
+							Message: fmt.Sprintf("create item #%d targets service %s without a maintainer or member binding", idx+1, serviceID),
+							Params:  map[string]interface{}{"service_id": serviceID, "item": idx + 1},
+						},
+					}
+				}
+			}
+			rejection, err := s.validateVMCreateCatalog(ctx, serviceID, templateID, instanceSizeID, namespace)
+			if err != nil {
+				return nil, err
+			}
+			if rejection != nil {
+				rejection.Message = fmt.Sprintf("create item #%d: %s", idx+1, rejection.Message)
+				rejection.Params["item"] = idx + 1
+				return nil, &batchValidationError{status: http.StatusBadRequest, body: *rejection}
+			}
+			deprecated, err := s.client.Template.Query().
+				Where(enttemplate.IDEQ(templateID), enttemplate.DeprecatedAtNotNil()).
+				Exist(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if deprecated {
+				return nil, &batchValidationError{
+					status: http.StatusBadRequest,
+					body: generated.Error{
+						Code:    service.CodeTemplateDeprecated,
+						Message: fmt.Sprintf("create item #%d references deprecated template %s", idx+1, templateID),
+						Params:  map[string]interface{}{"template_id": templateID, "item": idx + 1},
+					},
+				}
+			}
+			if err := s.checkNamespaceQuota(ctx, namespace, plannedCreates[namespace]+1, idx); err != nil {
+				return nil, err
+			}
+			plannedCreates[namespace]++
 			payload := domain.VMCreationPayload{
 				RequesterID:    actor,
 				ServiceID:      serviceID,
@@ -943,12 +1264,17 @@ func (s *Server) prepareBatchChildren(
 				Namespace:      namespace,
 				Reason:         itemReason,
 			}
+			eventType := domain.EventVMCreationRequested
+			if clone != nil {
+				clone.applyTo(&payload)
+				eventType = domain.EventVMCloneRequested
+			}
 			payloadBytes, err := payload.ToJSON()
 			if err != nil {
 				return nil, err
 			}
 			children = append(children, preparedBatchChild{
-				eventType:     domain.EventVMCreationRequested,
+				eventType:     eventType,
 				aggregateID:   serviceID,
 				payload:       payloadBytes,
 				operationType: approvalticket.OperationTypeCREATE,
@@ -1016,6 +1342,43 @@ func (s *Server) prepareBatchChildren(
 	return children, nil
 }
 
+// checkNamespaceQuota rejects a CREATE item when the namespace's existing VMs
+// plus the creates planned so far in the batch would exceed its quota.
+// Namespaces without a quota are unlimited.
+func (s *Server) checkNamespaceQuota(ctx context.Context, namespace string, planned, idx int) error {
+	quota, err := s.client.NamespaceQuota.Query().
+		Where(namespacequota.NamespaceNameEQ(namespace)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	current, err := s.client.VM.Query().
+		Where(vm.NamespaceEQ(namespace)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+	if current+planned <= quota.MaxVms {
+		return nil
+	}
+	return &batchValidationError{
+		status: http.StatusBadRequest,
+		body: generated.Error{
+			Code:    "QUOTA_EXCEEDED",
+			Message: fmt.Sprintf("create item #%d exceeds the VM quota of namespace %q", idx+1, namespace),
+			Params: map[string]interface{}{
+				"namespace": namespace,
+				"current":   current,
+				"requested": planned,
+				"limit":     quota.MaxVms,
+			},
+		},
+	}
+}
+
 func normalizeBatchOperation(op generated.VMBatchOperation) (string, domain.EventType, error) {
 	switch op {
 	case generated.VMBatchOperationCREATE:
@@ -1087,6 +1450,25 @@ func (s *Server) prepareBatchPowerChildren(
 			}
 		}
 
+		operation := strings.ToLower(jobOperation)
+		var skipReason string
+		if status := domain.VMStatus(vmObj.Status); !domain.CanApplyPowerOperation(operation, status) {
+			if !req.SkipInvalid {
+				return nil, &batchValidationError{
+					status: http.StatusBadRequest,
+					body: generated.Error{
+						Code:    "INVALID_POWER_STATE",
+						Message: fmt.Sprintf("power item #%d: %s", idx+1, domain.PowerPreconditionMessage(operation, status)),
+						Params: map[string]interface{}{
+							"vm_id":  vmObj.ID,
+							"status": string(status),
+						},
+					},
+				}
+			}
+			skipReason = "skipped: " + domain.PowerPreconditionMessage(operation, status)
+		}
+
 		itemReason := strings.TrimSpace(item.Reason)
 		if itemReason == "" {
 			itemReason = strings.TrimSpace(req.Reason)
@@ -1100,7 +1482,7 @@ func (s *Server) prepareBatchPowerChildren(
 			VMName:    vmObj.Name,
 			ClusterID: vmObj.ClusterID,
 			Namespace: vmObj.Namespace,
-			Operation: strings.ToLower(jobOperation),
+			Operation: operation,
 			Actor:     actor,
 		}
 		payloadBytes, err := payload.ToJSON()
@@ -1113,12 +1495,57 @@ func (s *Server) prepareBatchPowerChildren(
 			payload:       payloadBytes,
 			operationType: approvalticket.OperationTypeCREATE,
 			reason:        itemReason,
+			namespace:     vmObj.Namespace,
+			skipReason:    skipReason,
 		})
 	}
 
+	if countSkippedBatchChildren(children) == len(children) {
+		return nil, &batchValidationError{
+			status: http.StatusBadRequest,
+			body: generated.Error{
+				Code:    "INVALID_POWER_STATE",
+				Message: fmt.Sprintf("no item is in a state that allows %s", strings.ToLower(jobOperation)),
+			},
+		}
+	}
 	return children, nil
 }
 
+func countSkippedBatchChildren(children []preparedBatchChild) int {
+	n := 0
+	for _, child := range children {
+		if child.skipReason != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// powerBatchRequiresApproval reports whether a power batch must be approved
+// before it runs: approval.require_power_approval is set, or the namespace
+// of a child that is not skipped requires power approval.
+func (s *Server) powerBatchRequiresApproval(ctx context.Context, client *ent.Client, children []preparedBatchChild) (bool, error) {
+	if s.requirePowerApproval {
+		return true, nil
+	}
+	namespaces := make([]string, 0, len(children))
+	for _, child := range children {
+		if child.skipReason == "" && child.namespace != "" {
+			namespaces = append(namespaces, child.namespace)
+		}
+	}
+	if len(namespaces) == 0 {
+		return false, nil
+	}
+	return client.NamespaceRegistry.Query().
+		Where(
+			namespaceregistry.NameIn(namespaces...),
+			namespaceregistry.RequirePowerApproval(true),
+		).
+		Exist(ctx)
+}
+
 func (s *Server) enqueueBatchPowerJob(ctx context.Context, eventID, operation string) error {
 	if s.riverClient == nil {
 		return fmt.Errorf("river client is not configured")
@@ -1138,8 +1565,24 @@ func batchParentEventTypes() []string {
 	}
 }
 
-func (s *Server) pendingBatchParentCounters(ctx context.Context, actor string) (int, int, error) {
-	events, err := s.client.DomainEvent.Query().
+// beginBatchSubmitTx opens the batch submission transaction and takes a
+// transaction-scoped advisory lock keyed by actor. Submissions from the same
+// actor queue on the lock until the holder commits, so each one counts the
+// pending parents the previous one inserted.
+func (s *Server) beginBatchSubmitTx(ctx context.Context, actor string) (*ent.Tx, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", batchSubmitLockPrefix+actor); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("lock batch submissions for %s: %w", actor, err)
+	}
+	return tx, nil
+}
+
+func (s *Server) pendingBatchParentCounters(ctx context.Context, client *ent.Client, actor string) (int, int, error) {
+	events, err := client.DomainEvent.Query().
 		Where(
 			domainevent.AggregateTypeEQ("batch"),
 			domainevent.EventTypeIn(batchParentEventTypes()...),
@@ -1160,6 +1603,15 @@ func (s *Server) pendingBatchParentCounters(ctx context.Context, actor string) (
 	return global, user, nil
 }
 
+// batchParentLimitReached reports whether another parent batch would exceed
+// the global or per-user pending parent limit.
+func batchParentLimitReached(globalPending, userPending int, policy batchUserLimitPolicy) bool {
+	if globalPending >= maxPendingBatchParents {
+		return true
+	}
+	return !policy.Exempt && userPending >= policy.MaxPendingParents
+}
+
 type batchSubmissionLimitViolation struct {
 	Reason              string
 	RetryAfterSeconds   int
@@ -1187,10 +1639,10 @@ func defaultBatchUserLimitPolicy() batchUserLimitPolicy {
 	}
 }
 
-func (s *Server) resolveBatchUserLimitPolicy(ctx context.Context, actor string) (batchUserLimitPolicy, error) {
+func (s *Server) resolveBatchUserLimitPolicy(ctx context.Context, client *ent.Client, actor string) (batchUserLimitPolicy, error) {
 	policy := defaultBatchUserLimitPolicy()
 
-	exemption, err := s.client.RateLimitExemption.Query().
+	exemption, err := client.RateLimitExemption.Query().
 		Where(ratelimitexemption.IDEQ(actor)).
 		Only(ctx)
 	if err != nil && !ent.IsNotFound(err) {
@@ -1198,7 +1650,7 @@ func (s *Server) resolveBatchUserLimitPolicy(ctx context.Context, actor string)
 	}
 	if err == nil {
 		if exemption.ExpiresAt != nil && exemption.ExpiresAt.Before(time.Now().UTC()) {
-			if delErr := s.client.RateLimitExemption.DeleteOneID(actor).Exec(ctx); delErr != nil {
+			if delErr := client.RateLimitExemption.DeleteOneID(actor).Exec(ctx); delErr != nil {
 				logger.Warn("failed to purge expired rate-limit exemption",
 					zap.String("user_id", actor),
 					zap.Error(delErr),
@@ -1214,7 +1666,7 @@ func (s *Server) resolveBatchUserLimitPolicy(ctx context.Context, actor string)
 		}
 	}
 
-	override, err := s.client.RateLimitUserOverride.Query().
+	override, err := client.RateLimitUserOverride.Query().
 		Where(ratelimituseroverride.IDEQ(actor)).
 		Only(ctx)
 	if err != nil && !ent.IsNotFound(err) {
@@ -1238,12 +1690,37 @@ func (s *Server) resolveBatchUserLimitPolicy(ctx context.Context, actor string)
 
 func (s *Server) evaluateAdditionalBatchSubmissionLimits(
 	ctx context.Context,
+	client *ent.Client,
 	actor string,
 	requestedChildCount int,
 	policy batchUserLimitPolicy,
 ) (*batchSubmissionLimitViolation, error) {
+	counters, err := s.loadBatchSubmissionCounters(ctx, client, actor, policy)
+	if err != nil {
+		return nil, err
+	}
+	return counters.violation(requestedChildCount, policy), nil
+}
+
+// batchSubmissionCounters is an actor's standing against the limits that
+// evaluateAdditionalBatchSubmissionLimits enforces. GET /vms/batch/limits
+// reads the same counters, so the two can never disagree.
+type batchSubmissionCounters struct {
+	GlobalRecentSubmits int
+	UserPendingChildren int
+	CooldownRemaining   time.Duration
+}
+
+func (s *Server) loadBatchSubmissionCounters(
+	ctx context.Context,
+	client *ent.Client,
+	actor string,
+	policy batchUserLimitPolicy,
+) (batchSubmissionCounters, error) {
+	var counters batchSubmissionCounters
+
 	recentSince := time.Now().UTC().Add(-time.Minute)
-	globalRecentSubmits, err := s.client.DomainEvent.Query().
+	globalRecentSubmits, err := client.DomainEvent.Query().
 		Where(
 			domainevent.AggregateTypeEQ("batch"),
 			domainevent.EventTypeIn(batchParentEventTypes()...),
@@ -1251,21 +1728,11 @@ func (s *Server) evaluateAdditionalBatchSubmissionLimits(
 		).
 		Count(ctx)
 	if err != nil {
-		return nil, err
-	}
-	if globalRecentSubmits >= maxGlobalBatchRequestsPerMinute {
-		return &batchSubmissionLimitViolation{
-			Reason:              "global_request_rate_limit",
-			RetryAfterSeconds:   60,
-			GlobalRecentSubmits: globalRecentSubmits,
-		}, nil
+		return counters, err
 	}
+	counters.GlobalRecentSubmits = globalRecentSubmits
 
-	if policy.Exempt {
-		return nil, nil
-	}
-
-	userPendingChildren, err := s.client.ApprovalTicket.Query().
+	userPendingChildren, err := client.ApprovalTicket.Query().
 		Where(
 			approvalticket.RequesterEQ(actor),
 			approvalticket.ParentTicketIDNotNil(),
@@ -1277,18 +1744,14 @@ func (s *Server) evaluateAdditionalBatchSubmissionLimits(
 		).
 		Count(ctx)
 	if err != nil {
-		return nil, err
-	}
-	if userPendingChildren+requestedChildCount > policy.MaxPendingChildren {
-		return &batchSubmissionLimitViolation{
-			Reason:              "user_pending_child_limit",
-			RetryAfterSeconds:   batchRetryAfterSeconds,
-			GlobalRecentSubmits: globalRecentSubmits,
-			UserPendingChildren: userPendingChildren,
-		}, nil
+		return counters, err
 	}
+	counters.UserPendingChildren = userPendingChildren
 
-	lastEvent, err := s.client.DomainEvent.Query().
+	if policy.Exempt {
+		return counters, nil
+	}
+	lastEvent, err := client.DomainEvent.Query().
 		Where(
 			domainevent.AggregateTypeEQ("batch"),
 			domainevent.EventTypeIn(batchParentEventTypes()...),
@@ -1297,31 +1760,102 @@ func (s *Server) evaluateAdditionalBatchSubmissionLimits(
 		Order(ent.Desc(domainevent.FieldCreatedAt)).
 		First(ctx)
 	if err != nil && !ent.IsNotFound(err) {
-		return nil, err
+		return counters, err
 	}
 	if err == nil {
-		cooldownRemaining := time.Until(lastEvent.CreatedAt.Add(policy.Cooldown))
-		if cooldownRemaining > 0 {
-			cooldownSeconds := int(math.Ceil(cooldownRemaining.Seconds()))
-			return &batchSubmissionLimitViolation{
-				Reason:              "user_submit_cooldown",
-				RetryAfterSeconds:   cooldownSeconds,
-				GlobalRecentSubmits: globalRecentSubmits,
-				UserPendingChildren: userPendingChildren,
-				UserCooldownSeconds: cooldownSeconds,
-			}, nil
+		if remaining := time.Until(lastEvent.CreatedAt.Add(policy.Cooldown)); remaining > 0 {
+			counters.CooldownRemaining = remaining
+		}
+	}
+	return counters, nil
+}
+
+// cooldownSeconds rounds the remaining cooldown up so a client that waits
+// the reported time is never rejected.
+func (c batchSubmissionCounters) cooldownSeconds() int {
+	return int(math.Ceil(c.CooldownRemaining.Seconds()))
+}
+
+// violation reports the first limit a submission of requestedChildCount
+// children would trip, or nil.
+func (c batchSubmissionCounters) violation(requestedChildCount int, policy batchUserLimitPolicy) *batchSubmissionLimitViolation {
+	if c.GlobalRecentSubmits >= maxGlobalBatchRequestsPerMinute {
+		return &batchSubmissionLimitViolation{
+			Reason:              "global_request_rate_limit",
+			RetryAfterSeconds:   60,
+			GlobalRecentSubmits: c.GlobalRecentSubmits,
 		}
 	}
 
-	return nil, nil
+	if policy.Exempt {
+		return nil
+	}
+
+	if c.UserPendingChildren+requestedChildCount > policy.MaxPendingChildren {
+		return &batchSubmissionLimitViolation{
+			Reason:              "user_pending_child_limit",
+			RetryAfterSeconds:   batchRetryAfterSeconds,
+			GlobalRecentSubmits: c.GlobalRecentSubmits,
+			UserPendingChildren: c.UserPendingChildren,
+		}
+	}
+
+	if c.CooldownRemaining > 0 {
+		cooldownSeconds := c.cooldownSeconds()
+		return &batchSubmissionLimitViolation{
+			Reason:              "user_submit_cooldown",
+			RetryAfterSeconds:   cooldownSeconds,
+			GlobalRecentSubmits: c.GlobalRecentSubmits,
+			UserPendingChildren: c.UserPendingChildren,
+			UserCooldownSeconds: cooldownSeconds,
+		}
+	}
+
+	return nil
 }
 
+// findBatchByRequestID resolves a submit idempotency key to the actor's
+// earlier batch. The projection row carries request_id under a composite
+// index, so a hit costs a single query. Power actions share BATCH_POWER, so
+// one key identifies one power batch whatever its action.
 func (s *Server) findBatchByRequestID(ctx context.Context, actor, op, requestID string) (string, bool, error) {
+	requestID = strings.TrimSpace(requestID)
+	batchID, err := s.client.BatchApprovalTicket.Query().
+		Where(
+			batchapprovalticket.CreatedByEQ(actor),
+			batchapprovalticket.RequestIDEQ(requestID),
+			batchapprovalticket.BatchTypeEQ(toBatchProjectionType(op)),
+		).
+		Order(ent.Desc(batchapprovalticket.FieldCreatedAt)).
+		FirstID(ctx)
+	if err == nil {
+		return batchID, true, nil
+	}
+	if !ent.IsNotFound(err) {
+		return "", false, err
+	}
+	return s.findLegacyBatchByRequestID(ctx, actor, op, requestID)
+}
+
+// findLegacyBatchByRequestID scans parent payloads for batches whose
+// projection has no request_id (rows written before the column was
+// populated, or parents with no projection yet). A hit backfills the
+// projection so the next lookup takes the indexed path.
+func (s *Server) findLegacyBatchByRequestID(ctx context.Context, actor, op, requestID string) (string, bool, error) {
 	events, err := s.client.DomainEvent.Query().
 		Where(
 			domainevent.AggregateTypeEQ("batch"),
 			domainevent.EventTypeIn(batchParentEventTypes()...),
 			domainevent.CreatedByEQ(actor),
+			func(sel *sql.Selector) {
+				projection := sql.Table(batchapprovalticket.Table)
+				sel.Where(sql.NotIn(
+					sel.C(domainevent.FieldAggregateID),
+					sql.Select(projection.C(batchapprovalticket.FieldID)).
+						From(projection).
+						Where(sql.NotNull(projection.C(batchapprovalticket.FieldRequestID))),
+				))
+			},
 		).
 		Order(ent.Desc(domainevent.FieldCreatedAt)).
 		All(ctx)
@@ -1347,14 +1881,29 @@ func (s *Server) findBatchByRequestID(ctx context.Context, actor, op, requestID
 		if err != nil {
 			return "", false, err
 		}
-		if parentExists {
-			return ev.AggregateID, true, nil
+		if !parentExists {
+			continue
+		}
+		if err := s.client.BatchApprovalTicket.UpdateOneID(ev.AggregateID).
+			SetRequestID(requestID).
+			Exec(ctx); err != nil && !ent.IsNotFound(err) {
+			logger.Warn("failed to backfill batch projection request_id", zap.String("batch_id", ev.AggregateID), zap.Error(err))
 		}
+		return ev.AggregateID, true, nil
 	}
 
 	return "", false, nil
 }
 
+// batchPayloadRequestID returns the idempotency key recorded on a batch parent event.
+func batchPayloadRequestID(ev *ent.DomainEvent) *string {
+	var payload domain.BatchVMRequestPayload
+	if err := json.Unmarshal(ev.Payload, &payload); err != nil {
+		return nil
+	}
+	return nillableTrimmed(payload.RequestID)
+}
+
 func (s *Server) loadBatchView(ctx context.Context, batchID string) (generated.VMBatchStatusResponse, []*ent.ApprovalTicket, error) {
 	parent, err := s.client.ApprovalTicket.Query().
 		Where(
@@ -1417,13 +1966,19 @@ func (s *Server) loadBatchView(ctx context.Context, batchID string) (generated.V
 		cancelled    int
 		pendingOnly  int
 		executing    int
+		dispatched   int
 	)
 	childStatuses := make([]generated.VMBatchChildStatus, 0, len(children))
+	now := time.Now().UTC()
 	for _, child := range children {
 		switch child.Status {
 		case approvalticket.StatusSUCCESS:
 			successCount++
-		case approvalticket.StatusFAILED, approvalticket.StatusREJECTED:
+			dispatched++
+		case approvalticket.StatusFAILED:
+			failedCount++
+			dispatched++
+		case approvalticket.StatusREJECTED:
 			failedCount++
 		case approvalticket.StatusCANCELLED:
 			cancelled++
@@ -1433,6 +1988,7 @@ func (s *Server) loadBatchView(ctx context.Context, batchID string) (generated.V
 		default:
 			pendingCount++
 			executing++
+			dispatched++
 		}
 
 		resourceID := ""
@@ -1448,7 +2004,7 @@ func (s *Server) loadBatchView(ctx context.Context, batchID string) (generated.V
 						resourceName = payload.VMName
 					}
 				}
-			case domain.EventVMCreationRequested:
+			case domain.EventVMCreationRequested, domain.EventVMCloneRequested:
 				var payload domain.VMCreationPayload
 				if err := json.Unmarshal(ev.Payload, &payload); err == nil {
 					if resourceID == "" {
@@ -1458,23 +2014,24 @@ func (s *Server) loadBatchView(ctx context.Context, batchID string) (generated.V
 			}
 		}
 
-		attemptCount := 0
-		if child.Status != approvalticket.StatusPENDING {
-			attemptCount = 1
-		}
-
-		childStatuses = append(childStatuses, generated.VMBatchChildStatus{
+		childStatus := generated.VMBatchChildStatus{
 			TicketId:     child.ID,
 			EventId:      child.EventID,
 			Status:       generated.VMBatchChildStatusStatus(child.Status),
 			ResourceId:   resourceID,
 			ResourceName: resourceName,
 			LastError:    lastError,
-			AttemptCount: attemptCount,
-		})
+			AttemptCount: child.AttemptCount,
+		}
+		applyBatchChildTiming(&childStatus, child, now)
+		childStatuses = append(childStatuses, childStatus)
 	}
 
 	status := aggregateBatchParentStatus(len(children), successCount, failedCount, pendingCount, pendingOnly, executing, cancelled)
+	if parent.Status == approvalticket.StatusPENDING && pendingOnly > 0 && dispatched > 0 {
+		// Selected children were dispatched; the rest await approval.
+		status = generated.VMBatchParentStatusPARTIALAPPROVED
+	}
 	projectionStatus := mapProjectionStatus(status)
 	if projection == nil {
 		createBuilder := s.client.BatchApprovalTicket.Create().
@@ -1486,7 +2043,8 @@ func (s *Server) loadBatchView(ctx context.Context, batchID string) (generated.V
 			SetPendingCount(pendingCount).
 			SetStatus(projectionStatus).
 			SetCreatedBy(parent.Requester).
-			SetReason(parent.Reason)
+			SetReason(parent.Reason).
+			SetNillableRequestID(batchPayloadRequestID(parentEvent))
 		if _, err := createBuilder.Save(ctx); err != nil && !ent.IsConstraintError(err) {
 			logger.Warn("failed to backfill batch projection row", zap.String("batch_id", parent.ID), zap.Error(err))
 		}
@@ -1516,9 +2074,45 @@ func (s *Server) loadBatchView(ctx context.Context, batchID string) (generated.V
 		CreatedAt:    parent.CreatedAt,
 		UpdatedAt:    parent.UpdatedAt,
 	}
+	response.StartedAt, response.CompletedAt = batchExecutionWindow(children, pendingCount)
 	return response, children, nil
 }
 
+// applyBatchChildTiming copies worker execution timestamps onto the child
+// view. duration_seconds covers the latest attempt, measured up to now while
+// the attempt is still running.
+func applyBatchChildTiming(out *generated.VMBatchChildStatus, child *ent.ApprovalTicket, now time.Time) {
+	if child.StartedAt == nil {
+		return
+	}
+	out.StartedAt = *child.StartedAt
+	end := now
+	if child.FinishedAt != nil {
+		out.FinishedAt = *child.FinishedAt
+		end = *child.FinishedAt
+	}
+	if d := end.Sub(*child.StartedAt); d > 0 {
+		out.DurationSeconds = int(d / time.Second)
+	}
+}
+
+// batchExecutionWindow derives the parent started_at (earliest child start)
+// and completed_at (latest child finish, once no child is still active).
+func batchExecutionWindow(children []*ent.ApprovalTicket, activeCount int) (startedAt, completedAt time.Time) {
+	for _, child := range children {
+		if child.StartedAt != nil && (startedAt.IsZero() || child.StartedAt.Before(startedAt)) {
+			startedAt = *child.StartedAt
+		}
+		if child.FinishedAt != nil && child.FinishedAt.After(completedAt) {
+			completedAt = *child.FinishedAt
+		}
+	}
+	if activeCount > 0 {
+		completedAt = time.Time{}
+	}
+	return startedAt, completedAt
+}
+
 func aggregateBatchParentStatus(
 	total int,
 	successCount int,
@@ -1540,7 +2134,8 @@ func aggregateBatchParentStatus(
 	if failedCount+cancelledCount == total {
 		return generated.VMBatchParentStatusFAILED
 	}
-	if pendingOnly == total {
+	if pendingOnly > 0 && pendingOnly+cancelledCount == total {
+		// Skipped power children are CANCELLED from the start.
 		return generated.VMBatchParentStatusPENDINGAPPROVAL
 	}
 	if pendingCount > 0 || executingCount > 0 {
@@ -1556,6 +2151,8 @@ func mapProjectionStatus(status generated.VMBatchParentStatus) batchapprovaltick
 	switch status {
 	case generated.VMBatchParentStatusPENDINGAPPROVAL:
 		return batchapprovalticket.StatusPENDING_APPROVAL
+	case generated.VMBatchParentStatusPARTIALAPPROVED:
+		return batchapprovalticket.StatusPARTIAL_APPROVED
 	case generated.VMBatchParentStatusINPROGRESS:
 		return batchapprovalticket.StatusIN_PROGRESS
 	case generated.VMBatchParentStatusCOMPLETED:
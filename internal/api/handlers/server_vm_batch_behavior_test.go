@@ -3,10 +3,17 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"entgo.io/ent/dialect"
+	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 	"kv-shepherd.io/shepherd/ent/domainevent"
@@ -14,6 +21,8 @@ import (
 
 	"kv-shepherd.io/shepherd/ent"
 	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/batchapprovalticket"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
 	"kv-shepherd.io/shepherd/internal/api/generated"
 	"kv-shepherd.io/shepherd/internal/domain"
 	"kv-shepherd.io/shepherd/internal/governance/approval"
@@ -249,6 +258,88 @@ func TestBatchHandler_SubmitCreate_ForbiddenWhenNamespaceInvisible(t *testing.T)
 	assertErrorCode(t, submitW.Body.Bytes(), "NAMESPACE_ENV_FORBIDDEN")
 }
 
+func TestBatchHandler_SubmitVMBatch_NamespaceQuota(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newBatchBehaviorTestServer(t)
+	serviceID, templateID, sizeID := mustCreateBatchCreatePrerequisites(t, client, "owner-1", "prod-shop")
+	vmID := mustCreateBatchDeleteTargetVM(t, client, "owner-1")
+
+	quotaCtx, quotaW := newAuthedGinContext(
+		t,
+		http.MethodPost,
+		"/admin/namespaces/prod-shop/quota",
+		`{"max_vms":1}`,
+		"admin-1",
+		[]string{"vm:create"},
+	)
+	srv.CreateNamespaceQuota(quotaCtx, "prod-shop")
+	if quotaW.Code != http.StatusForbidden {
+		t.Fatalf("non-admin quota status = %d, want %d", quotaW.Code, http.StatusForbidden)
+	}
+	quotaCtx, quotaW = newAuthedGinContext(
+		t,
+		http.MethodPost,
+		"/admin/namespaces/prod-shop/quota",
+		`{"max_vms":1}`,
+		"admin-1",
+		[]string{"platform:admin"},
+	)
+	srv.CreateNamespaceQuota(quotaCtx, "prod-shop")
+	if quotaW.Code != http.StatusCreated {
+		t.Fatalf("create quota status = %d, want %d body=%s", quotaW.Code, http.StatusCreated, quotaW.Body.String())
+	}
+
+	createBody := mustJSON(t, generated.VMBatchSubmitRequest{
+		Operation: generated.VMBatchOperationCREATE,
+		Items: []generated.VMBatchChildItem{
+			{
+				ServiceId:      serviceID,
+				TemplateId:     templateID,
+				InstanceSizeId: sizeID,
+				Namespace:      "prod-shop",
+				Reason:         "create one",
+			},
+		},
+	})
+	createCtx, createW := newAuthedGinContext(t, http.MethodPost, "/vms/batch", createBody, "owner-1", []string{"platform:admin"})
+	srv.SubmitVMBatch(createCtx)
+	if createW.Code != http.StatusBadRequest {
+		t.Fatalf("create status = %d, want %d body=%s", createW.Code, http.StatusBadRequest, createW.Body.String())
+	}
+	var errResp generated.Error
+	if err := json.Unmarshal(createW.Body.Bytes(), &errResp); err != nil {
+		t.Fatalf("decode error response: %v", err)
+	}
+	if errResp.Code != "QUOTA_EXCEEDED" {
+		t.Fatalf("error code = %q, want QUOTA_EXCEEDED", errResp.Code)
+	}
+	if errResp.Params["current"] != float64(1) || errResp.Params["limit"] != float64(1) {
+		t.Fatalf("unexpected quota params: %+v", errResp.Params)
+	}
+
+	deleteBody := mustJSON(t, generated.VMBatchSubmitRequest{
+		Operation: generated.VMBatchOperationDELETE,
+		Items:     []generated.VMBatchChildItem{{VmId: vmID, Reason: "delete one"}},
+	})
+	deleteCtx, deleteW := newAuthedGinContext(t, http.MethodPost, "/vms/batch", deleteBody, "owner-1", []string{"platform:admin"})
+	srv.SubmitVMBatch(deleteCtx)
+	if deleteW.Code != http.StatusAccepted {
+		t.Fatalf("delete status = %d, want %d body=%s", deleteW.Code, http.StatusAccepted, deleteW.Body.String())
+	}
+
+	raiseCtx, raiseW := newAuthedGinContext(t, http.MethodPatch, "/admin/namespaces/prod-shop/quota", `{"max_vms":2}`, "admin-1", []string{"platform:admin"})
+	srv.UpdateNamespaceQuota(raiseCtx, "prod-shop")
+	if raiseW.Code != http.StatusOK {
+		t.Fatalf("update quota status = %d, want %d body=%s", raiseW.Code, http.StatusOK, raiseW.Body.String())
+	}
+	createCtx, createW = newAuthedGinContext(t, http.MethodPost, "/vms/batch", createBody, "owner-2", []string{"platform:admin"})
+	srv.SubmitVMBatch(createCtx)
+	if createW.Code != http.StatusAccepted {
+		t.Fatalf("create after raise status = %d, want %d body=%s", createW.Code, http.StatusAccepted, createW.Body.String())
+	}
+}
+
 func TestBatchHandler_RetryVMBatch_Errors(t *testing.T) {
 	t.Parallel()
 
@@ -321,6 +412,106 @@ func TestBatchHandler_SubmitVMBatch_IdempotentByRequestID(t *testing.T) {
 	}
 }
 
+// queryCountingDriver counts read statements issued through an Ent client.
+type queryCountingDriver struct {
+	dialect.Driver
+	queries atomic.Int64
+}
+
+func (d *queryCountingDriver) Query(ctx context.Context, query string, args, v any) error {
+	d.queries.Add(1)
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+func TestBatchHandler_FindBatchByRequestID_IndexedLookup(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	counter := &queryCountingDriver{}
+	client := testutil.OpenEntPostgresWithDriver(t, "batch_request_id_lookup", func(drv dialect.Driver) dialect.Driver {
+		counter.Driver = drv
+		return counter
+	})
+	srv := NewServer(ServerDeps{EntClient: client})
+	ctx := t.Context()
+
+	const historical = 1000
+	const chunk = 250
+	for start := 0; start < historical; start += chunk {
+		events := make([]*ent.DomainEventCreate, 0, chunk)
+		projections := make([]*ent.BatchApprovalTicketCreate, 0, chunk)
+		for i := start; i < start+chunk; i++ {
+			batchID := fmt.Sprintf("batch-%04d", i)
+			requestID := fmt.Sprintf("req-%04d", i)
+			payload := mustJSON(t, domain.BatchVMRequestPayload{Operation: "CREATE", RequestID: requestID, SubmittedBy: "owner-1"})
+			events = append(events, client.DomainEvent.Create().
+				SetID("ev-"+batchID).
+				SetEventType(string(domain.EventBatchCreateRequested)).
+				SetAggregateType("batch").
+				SetAggregateID(batchID).
+				SetPayload([]byte(payload)).
+				SetStatus(domainevent.StatusCOMPLETED).
+				SetCreatedBy("owner-1"))
+			projections = append(projections, client.BatchApprovalTicket.Create().
+				SetID(batchID).
+				SetBatchType(batchapprovalticket.BatchTypeBATCH_CREATE).
+				SetStatus(batchapprovalticket.StatusCOMPLETED).
+				SetRequestID(requestID).
+				SetCreatedBy("owner-1"))
+		}
+		client.DomainEvent.CreateBulk(events...).ExecX(ctx)
+		client.BatchApprovalTicket.CreateBulk(projections...).ExecX(ctx)
+	}
+
+	counter.queries.Store(0)
+	batchID, ok, err := srv.findBatchByRequestID(ctx, "owner-1", "CREATE", "req-0500")
+	if err != nil || !ok || batchID != "batch-0500" {
+		t.Fatalf("findBatchByRequestID = %q, %v, %v; want batch-0500", batchID, ok, err)
+	}
+	if n := counter.queries.Load(); n != 1 {
+		t.Fatalf("indexed lookup issued %d queries, want 1", n)
+	}
+
+	// Keys are scoped per batch type and actor.
+	if _, ok, err := srv.findBatchByRequestID(ctx, "owner-1", "DELETE", "req-0500"); err != nil || ok {
+		t.Fatalf("DELETE lookup found = %v err = %v, want miss", ok, err)
+	}
+	if _, ok, err := srv.findBatchByRequestID(ctx, "owner-2", "CREATE", "req-0500"); err != nil || ok {
+		t.Fatalf("other actor lookup found = %v err = %v, want miss", ok, err)
+	}
+
+	// A parent whose projection predates request_id is found by the payload
+	// scan and backfilled onto the indexed path.
+	legacyPayload := mustJSON(t, domain.BatchVMRequestPayload{Operation: "CREATE", RequestID: "req-legacy", SubmittedBy: "owner-1"})
+	client.DomainEvent.Create().
+		SetID("ev-batch-legacy").
+		SetEventType(string(domain.EventBatchCreateRequested)).
+		SetAggregateType("batch").
+		SetAggregateID("batch-legacy").
+		SetPayload([]byte(legacyPayload)).
+		SetCreatedBy("owner-1").
+		ExecX(ctx)
+	client.ApprovalTicket.Create().
+		SetID("batch-legacy").
+		SetEventID("ev-batch-legacy").
+		SetRequester("owner-1").
+		ExecX(ctx)
+	client.BatchApprovalTicket.Create().
+		SetID("batch-legacy").
+		SetCreatedBy("owner-1").
+		ExecX(ctx)
+
+	batchID, ok, err = srv.findBatchByRequestID(ctx, "owner-1", "CREATE", "req-legacy")
+	if err != nil || !ok || batchID != "batch-legacy" {
+		t.Fatalf("legacy lookup = %q, %v, %v; want batch-legacy", batchID, ok, err)
+	}
+	counter.queries.Store(0)
+	batchID, ok, err = srv.findBatchByRequestID(ctx, "owner-1", "CREATE", "req-legacy")
+	if err != nil || !ok || batchID != "batch-legacy" || counter.queries.Load() != 1 {
+		t.Fatalf("backfilled lookup = %q, %v, %v after %d queries; want batch-legacy in 1", batchID, ok, err, counter.queries.Load())
+	}
+}
+
 func TestBatchHandler_SubmitVMBatch_RateLimitedByPendingParentCount(t *testing.T) {
 	t.Parallel()
 
@@ -474,6 +665,75 @@ func TestBatchHandler_SubmitVMBatch_RateLimitedByCooldown(t *testing.T) {
 	assertErrorCode(t, w.Body.Bytes(), "BATCH_RATE_LIMITED")
 }
 
+func TestBatchHandler_SubmitVMBatch_ConcurrentSubmitsRespectPendingParentLimit(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newBatchBehaviorTestServer(t)
+	const (
+		submitters = 8
+		maxParents = 2
+	)
+	client.RateLimitUserOverride.Create().
+		SetID("owner-1").
+		SetMaxPendingParents(maxParents).
+		SetCooldownSeconds(0).
+		SetUpdatedBy("admin-1").
+		SaveX(t.Context())
+	vmIDs := make([]string, submitters)
+	for i := range vmIDs {
+		vmIDs[i] = mustCreateBatchDeleteTargetVM(t, client, "owner-1")
+	}
+
+	contexts := make([]*gin.Context, submitters)
+	recorders := make([]*httptest.ResponseRecorder, submitters)
+	for i, vmID := range vmIDs {
+		body := mustJSON(t, generated.VMBatchSubmitRequest{
+			Operation: generated.VMBatchOperationDELETE,
+			Items:     []generated.VMBatchChildItem{{VmId: vmID}},
+		})
+		contexts[i], recorders[i] = newAuthedGinContext(t, http.MethodPost, "/vms/batch", body, "owner-1", []string{"platform:admin"})
+	}
+
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for _, c := range contexts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			srv.SubmitVMBatch(c)
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	accepted := 0
+	for i, w := range recorders {
+		switch w.Code {
+		case http.StatusAccepted:
+			accepted++
+		case http.StatusTooManyRequests:
+			var apiErr generated.Error
+			mustDecodeJSON(t, w.Body.Bytes(), &apiErr)
+			if apiErr.Code != "BATCH_RATE_LIMITED" || apiErr.Params["user_pending"] != float64(maxParents) {
+				t.Fatalf("submit #%d rejection = %+v, want BATCH_RATE_LIMITED with user_pending=%d", i, apiErr, maxParents)
+			}
+		default:
+			t.Fatalf("submit #%d status = %d body=%s", i, w.Code, w.Body.String())
+		}
+	}
+	pending := client.DomainEvent.Query().
+		Where(
+			domainevent.AggregateTypeEQ("batch"),
+			domainevent.CreatedByEQ("owner-1"),
+			domainevent.StatusIn(domainevent.StatusPENDING, domainevent.StatusPROCESSING),
+		).
+		CountX(t.Context())
+	if accepted != maxParents || pending != maxParents {
+		t.Fatalf("accepted = %d, pending parents = %d, want both %d", accepted, pending, maxParents)
+	}
+}
+
 func TestBatchHandler_RetryVMBatch_RetriesFailedDeleteChild(t *testing.T) {
 	t.Parallel()
 
@@ -543,6 +803,294 @@ func TestBatchHandler_RetryVMBatch_RetriesFailedDeleteChild(t *testing.T) {
 	}
 }
 
+func TestBatchHandler_SelectiveRetryAndCancel_MixedTicketIDs(t *testing.T) {
+	t.Parallel()
+
+	writer := &fakeDeleteAtomicWriter{}
+	srv, client := newBatchBehaviorTestServerWithGateway(t, writer)
+	vmA := mustCreateBatchDeleteTargetVM(t, client, "owner-1")
+	vmB := mustCreateBatchDeleteTargetVM(t, client, "owner-1")
+
+	submitBody := mustJSON(t, generated.VMBatchSubmitRequest{
+		Operation: generated.VMBatchOperationDELETE,
+		Items:     []generated.VMBatchChildItem{{VmId: vmA}, {VmId: vmB}},
+	})
+	submitCtx, submitW := newAuthedGinContext(t, http.MethodPost, "/vms/batch", submitBody, "owner-1", []string{"platform:admin"})
+	srv.SubmitVMBatch(submitCtx)
+	if submitW.Code != http.StatusAccepted {
+		t.Fatalf("submit status = %d, want %d body=%s", submitW.Code, http.StatusAccepted, submitW.Body.String())
+	}
+	var submitResp generated.VMBatchSubmitResponse
+	mustDecodeJSON(t, submitW.Body.Bytes(), &submitResp)
+	batchID := submitResp.BatchId
+
+	children := client.ApprovalTicket.Query().
+		Where(approvalticket.ParentTicketIDEQ(batchID)).
+		AllX(t.Context())
+	if len(children) != 2 {
+		t.Fatalf("child ticket count = %d, want 2", len(children))
+	}
+	first, second := children[0].ID, children[1].ID
+
+	act := func(action string, ticketIDs []string) generated.VMBatchActionResponse {
+		t.Helper()
+		body := mustJSON(t, generated.VMBatchActionRequest{TicketIds: ticketIDs})
+		c, w := newAuthedGinContext(t, http.MethodPost, "/vms/batch/"+batchID+"/"+action, body, "owner-1", []string{"vm:delete"})
+		if action == "retry" {
+			srv.RetryVMBatch(c, batchID)
+		} else {
+			srv.CancelVMBatch(c, batchID)
+		}
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s status = %d, want %d body=%s", action, w.Code, http.StatusOK, w.Body.String())
+		}
+		var resp generated.VMBatchActionResponse
+		mustDecodeJSON(t, w.Body.Bytes(), &resp)
+		return resp
+	}
+
+	// Cancel only the first child; the unknown ID is skipped, not fatal.
+	cancelResp := act("cancel", []string{first, "ticket-unknown"})
+	if cancelResp.AffectedCount != 1 || len(cancelResp.AffectedTicketIds) != 1 || cancelResp.AffectedTicketIds[0] != first {
+		t.Fatalf("cancel affected = %d %v, want [%s]", cancelResp.AffectedCount, cancelResp.AffectedTicketIds, first)
+	}
+	if len(cancelResp.Skipped) != 1 || cancelResp.Skipped[0].TicketId != "ticket-unknown" || cancelResp.Skipped[0].Reason != generated.NOTINBATCH {
+		t.Fatalf("cancel skipped = %+v, want ticket-unknown NOT_IN_BATCH", cancelResp.Skipped)
+	}
+	if got := client.ApprovalTicket.GetX(t.Context(), second).Status; got != approvalticket.StatusPENDING {
+		t.Fatalf("unselected child status = %q, want %q", got, approvalticket.StatusPENDING)
+	}
+
+	client.ApprovalTicket.UpdateOneID(second).
+		SetStatus(approvalticket.StatusFAILED).
+		SetRejectReason("seed failure").
+		ExecX(t.Context())
+
+	// Retry both: the cancelled child is not retryable, the failed one is.
+	retryResp := act("retry", []string{first, second})
+	if retryResp.AffectedCount != 1 || len(retryResp.AffectedTicketIds) != 1 || retryResp.AffectedTicketIds[0] != second {
+		t.Fatalf("retry affected = %d %v, want [%s]", retryResp.AffectedCount, retryResp.AffectedTicketIds, second)
+	}
+	if len(retryResp.Skipped) != 1 || retryResp.Skipped[0].TicketId != first ||
+		retryResp.Skipped[0].Reason != generated.INVALIDSTATE || retryResp.Skipped[0].Status != "CANCELLED" {
+		t.Fatalf("retry skipped = %+v, want %s INVALID_STATE CANCELLED", retryResp.Skipped, first)
+	}
+	if writer.deleteCalls != 1 {
+		t.Fatalf("delete atomic writer calls = %d, want 1", writer.deleteCalls)
+	}
+}
+
+func TestBatchHandler_ApproveBatch_PartialThenFull(t *testing.T) {
+	t.Parallel()
+
+	writer := &fakeDeleteAtomicWriter{}
+	srv, client := newBatchBehaviorTestServerWithGateway(t, writer)
+	writer.client = client
+	vmA := mustCreateBatchDeleteTargetVM(t, client, "owner-1")
+	vmB := mustCreateBatchDeleteTargetVM(t, client, "owner-1")
+	vmC := mustCreateBatchDeleteTargetVM(t, client, "owner-1")
+
+	submitBody := mustJSON(t, generated.VMBatchSubmitRequest{
+		Operation: generated.VMBatchOperationDELETE,
+		Items:     []generated.VMBatchChildItem{{VmId: vmA}, {VmId: vmB}, {VmId: vmC}},
+	})
+	submitCtx, submitW := newAuthedGinContext(t, http.MethodPost, "/vms/batch", submitBody, "owner-1", []string{"platform:admin"})
+	srv.SubmitVMBatch(submitCtx)
+	if submitW.Code != http.StatusAccepted {
+		t.Fatalf("submit status = %d, want %d body=%s", submitW.Code, http.StatusAccepted, submitW.Body.String())
+	}
+	var submitResp generated.VMBatchSubmitResponse
+	mustDecodeJSON(t, submitW.Body.Bytes(), &submitResp)
+	batchID := submitResp.BatchId
+
+	children := client.ApprovalTicket.Query().
+		Where(approvalticket.ParentTicketIDEQ(batchID)).
+		Order(ent.Asc(approvalticket.FieldCreatedAt)).
+		AllX(t.Context())
+	if len(children) != 3 {
+		t.Fatalf("child ticket count = %d, want 3", len(children))
+	}
+	first, second, third := children[0].ID, children[1].ID, children[2].ID
+	writer.failFor = map[string]bool{second: true}
+
+	approve := func(perms []string, req generated.BatchApproveRequest) *httptest.ResponseRecorder {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodPatch, "/approvals/batch/"+batchID+"/approve", mustJSON(t, req), "approver-1", perms)
+		srv.ApproveBatch(c, batchID)
+		return w
+	}
+	childStatuses := func(resp generated.VMBatchStatusResponse) map[string]generated.VMBatchChildStatusStatus {
+		out := make(map[string]generated.VMBatchChildStatusStatus, len(resp.Children))
+		for _, child := range resp.Children {
+			out[child.TicketId] = child.Status
+		}
+		return out
+	}
+	approverPerms := []string{"approval:approve"}
+
+	assertStatusAndCode(t, approve([]string{"vm:delete"}, generated.BatchApproveRequest{}), http.StatusForbidden, "FORBIDDEN")
+	w := approve(approverPerms, generated.BatchApproveRequest{SelectedItems: []string{first, "ticket-unknown"}})
+	assertStatusAndCode(t, w, http.StatusBadRequest, "VALIDATION_FAILED")
+	if writer.deleteCalls != 0 {
+		t.Fatalf("delete atomic writer calls = %d after invalid selection, want 0", writer.deleteCalls)
+	}
+
+	// Partial approval: the failed child falls back to FAILED, the unselected one stays PENDING.
+	w = approve(approverPerms, generated.BatchApproveRequest{SelectedItems: []string{first, second}, Comment: "first wave"})
+	if w.Code != http.StatusOK {
+		t.Fatalf("partial approve status = %d body=%s", w.Code, w.Body.String())
+	}
+	var resp generated.VMBatchStatusResponse
+	mustDecodeJSON(t, w.Body.Bytes(), &resp)
+	if resp.Status != generated.VMBatchParentStatusPARTIALAPPROVED {
+		t.Fatalf("batch status = %s, want PARTIAL_APPROVED", resp.Status)
+	}
+	got := childStatuses(resp)
+	if got[first] != "APPROVED" || got[second] != "FAILED" || got[third] != "PENDING" {
+		t.Fatalf("child statuses = %v, want %s APPROVED, %s FAILED, %s PENDING", got, first, second, third)
+	}
+	if parent := client.ApprovalTicket.GetX(t.Context(), batchID); parent.Status != approvalticket.StatusPENDING {
+		t.Fatalf("parent status = %s, want PENDING", parent.Status)
+	}
+	projection := client.BatchApprovalTicket.GetX(t.Context(), batchID)
+	if projection.Status != batchapprovalticket.StatusPARTIAL_APPROVED {
+		t.Fatalf("projection status = %s, want PARTIAL_APPROVED", projection.Status)
+	}
+
+	w = approve(approverPerms, generated.BatchApproveRequest{SelectedItems: []string{second}})
+	assertStatusAndCode(t, w, http.StatusBadRequest, "VALIDATION_FAILED")
+
+	// Full approval dispatches the remaining pending child.
+	w = approve(approverPerms, generated.BatchApproveRequest{})
+	if w.Code != http.StatusOK {
+		t.Fatalf("full approve status = %d body=%s", w.Code, w.Body.String())
+	}
+	mustDecodeJSON(t, w.Body.Bytes(), &resp)
+	if resp.Status != generated.VMBatchParentStatusINPROGRESS {
+		t.Fatalf("batch status = %s, want IN_PROGRESS", resp.Status)
+	}
+	if got := childStatuses(resp)[third]; got != "APPROVED" {
+		t.Fatalf("remaining child status = %s, want APPROVED", got)
+	}
+	if parent := client.ApprovalTicket.GetX(t.Context(), batchID); parent.Status != approvalticket.StatusEXECUTING {
+		t.Fatalf("parent status = %s, want EXECUTING", parent.Status)
+	}
+	if writer.deleteCalls != 3 {
+		t.Fatalf("delete atomic writer calls = %d, want 3", writer.deleteCalls)
+	}
+
+	assertStatusAndCode(t, approve(approverPerms, generated.BatchApproveRequest{}), http.StatusConflict, "BATCH_NOT_PENDING")
+}
+
+func TestBatchHandler_ApproveBatch_FullDispatchFailure(t *testing.T) {
+	t.Parallel()
+
+	writer := &fakeDeleteAtomicWriter{}
+	srv, client := newBatchBehaviorTestServerWithGateway(t, writer)
+	writer.client = client
+	vmA := mustCreateBatchDeleteTargetVM(t, client, "owner-1")
+	vmB := mustCreateBatchDeleteTargetVM(t, client, "owner-1")
+
+	submitBody := mustJSON(t, generated.VMBatchSubmitRequest{
+		Operation: generated.VMBatchOperationDELETE,
+		Items:     []generated.VMBatchChildItem{{VmId: vmA}, {VmId: vmB}},
+	})
+	submitCtx, submitW := newAuthedGinContext(t, http.MethodPost, "/vms/batch", submitBody, "owner-1", []string{"platform:admin"})
+	srv.SubmitVMBatch(submitCtx)
+	if submitW.Code != http.StatusAccepted {
+		t.Fatalf("submit status = %d, want %d body=%s", submitW.Code, http.StatusAccepted, submitW.Body.String())
+	}
+	var submitResp generated.VMBatchSubmitResponse
+	mustDecodeJSON(t, submitW.Body.Bytes(), &submitResp)
+	batchID := submitResp.BatchId
+
+	childIDs := client.ApprovalTicket.Query().
+		Where(approvalticket.ParentTicketIDEQ(batchID)).
+		IDsX(t.Context())
+	writer.failFor = map[string]bool{childIDs[0]: true, childIDs[1]: true}
+
+	c, w := newAuthedGinContext(t, http.MethodPatch, "/approvals/batch/"+batchID+"/approve", "{}", "approver-1", []string{"approval:approve"})
+	srv.ApproveBatch(c, batchID)
+	assertStatusAndCode(t, w, http.StatusBadRequest, "APPROVAL_FAILED")
+
+	parent := client.ApprovalTicket.GetX(t.Context(), batchID)
+	if parent.Status != approvalticket.StatusFAILED {
+		t.Fatalf("parent status = %s, want FAILED", parent.Status)
+	}
+	for _, id := range childIDs {
+		if got := client.ApprovalTicket.GetX(t.Context(), id).Status; got != approvalticket.StatusFAILED {
+			t.Fatalf("child %s status = %s, want FAILED", id, got)
+		}
+	}
+}
+
+func TestBatchHandler_GetVMBatch_AttemptCountsSurviveRetry(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newBatchBehaviorTestServerWithGateway(t, &fakeDeleteAtomicWriter{})
+	vmA := mustCreateBatchDeleteTargetVM(t, client, "owner-1")
+	vmB := mustCreateBatchDeleteTargetVM(t, client, "owner-1")
+
+	submitBody := mustJSON(t, generated.VMBatchSubmitRequest{
+		Operation: generated.VMBatchOperationDELETE,
+		Items:     []generated.VMBatchChildItem{{VmId: vmA}, {VmId: vmB}},
+	})
+	submitCtx, submitW := newAuthedGinContext(t, http.MethodPost, "/vms/batch", submitBody, "owner-1", []string{"platform:admin"})
+	srv.SubmitVMBatch(submitCtx)
+	if submitW.Code != http.StatusAccepted {
+		t.Fatalf("submit status = %d, want %d body=%s", submitW.Code, http.StatusAccepted, submitW.Body.String())
+	}
+	var submitResp generated.VMBatchSubmitResponse
+	mustDecodeJSON(t, submitW.Body.Bytes(), &submitResp)
+	batchID := submitResp.BatchId
+
+	children := client.ApprovalTicket.Query().
+		Where(approvalticket.ParentTicketIDEQ(batchID)).
+		AllX(t.Context())
+	if len(children) != 2 {
+		t.Fatalf("child ticket count = %d, want 2", len(children))
+	}
+	attempted, idle := children[0].ID, children[1].ID
+
+	// Simulate one failed worker attempt on the first child only.
+	startedAt := time.Now().UTC().Add(-time.Minute).Truncate(time.Second)
+	client.ApprovalTicket.UpdateOneID(attempted).
+		SetStatus(approvalticket.StatusFAILED).
+		SetRejectReason("seed failure").
+		SetAttemptCount(1).
+		SetStartedAt(startedAt).
+		SetFinishedAt(startedAt.Add(15 * time.Second)).
+		ExecX(t.Context())
+
+	retryCtx, retryW := newAuthedGinContext(t, http.MethodPost, "/vms/batch/"+batchID+"/retry", "", "owner-1", []string{"vm:delete"})
+	srv.RetryVMBatch(retryCtx, batchID)
+	if retryW.Code != http.StatusOK {
+		t.Fatalf("retry status = %d, want %d body=%s", retryW.Code, http.StatusOK, retryW.Body.String())
+	}
+
+	getCtx, getW := newAuthedGinContext(t, http.MethodGet, "/vms/batch/"+batchID, "", "owner-1", []string{"platform:admin"})
+	srv.GetVMBatch(getCtx, batchID)
+	if getW.Code != http.StatusOK {
+		t.Fatalf("get status = %d, want %d body=%s", getW.Code, http.StatusOK, getW.Body.String())
+	}
+	var resp generated.VMBatchStatusResponse
+	mustDecodeJSON(t, getW.Body.Bytes(), &resp)
+
+	byID := make(map[string]generated.VMBatchChildStatus, len(resp.Children))
+	for _, child := range resp.Children {
+		byID[child.TicketId] = child
+	}
+	if got := byID[attempted]; got.AttemptCount != 1 || !got.StartedAt.Equal(startedAt) || got.DurationSeconds != 15 {
+		t.Fatalf("retried child = %+v, want attempt_count=1 started_at=%v duration=15", got, startedAt)
+	}
+	if got := byID[idle]; got.AttemptCount != 0 || !got.StartedAt.IsZero() || !got.FinishedAt.IsZero() {
+		t.Fatalf("never-dispatched child = %+v, want zero attempts and no timestamps", got)
+	}
+	if !resp.StartedAt.Equal(startedAt) || !resp.CompletedAt.IsZero() {
+		t.Fatalf("batch window = %v..%v, want %v..zero", resp.StartedAt, resp.CompletedAt, startedAt)
+	}
+}
+
 func TestBatchHandler_SubmitVMBatchPower_EnqueueFailureFallsBackToFailed(t *testing.T) {
 	t.Parallel()
 
@@ -550,7 +1098,7 @@ func TestBatchHandler_SubmitVMBatchPower_EnqueueFailureFallsBackToFailed(t *test
 	vmID := mustCreateBatchDeleteTargetVM(t, client, "owner-1")
 
 	body := mustJSON(t, generated.VMBatchPowerRequest{
-		Operation: generated.VMBatchPowerAction("start"),
+		Operation: generated.VMBatchPowerAction("stop"),
 		Items: []generated.VMBatchPowerItem{
 			{VmId: vmID},
 		},
@@ -589,6 +1137,233 @@ func TestBatchHandler_SubmitVMBatchPower_EnqueueFailureFallsBackToFailed(t *test
 	}
 }
 
+func TestBatchHandler_SubmitVMBatchPower_PowerStatePreconditions(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newBatchBehaviorTestServer(t)
+	running := mustCreateBatchDeleteTargetVM(t, client, "owner-1")
+	stopped := mustCreateBatchDeleteTargetVM(t, client, "owner-1")
+	client.VM.UpdateOneID(stopped).SetStatus(entvm.StatusSTOPPED).ExecX(t.Context())
+
+	submit := func(op string, skipInvalid bool, vmIDs ...string) *httptest.ResponseRecorder {
+		t.Helper()
+		items := make([]generated.VMBatchPowerItem, 0, len(vmIDs))
+		for _, id := range vmIDs {
+			items = append(items, generated.VMBatchPowerItem{VmId: id})
+		}
+		body := mustJSON(t, generated.VMBatchPowerRequest{
+			Operation:   generated.VMBatchPowerAction(op),
+			SkipInvalid: skipInvalid,
+			Items:       items,
+		})
+		c, w := newAuthedGinContext(t, http.MethodPost, "/vms/batch/power", body, "owner-1", []string{"platform:admin"})
+		srv.SubmitVMBatchPower(c)
+		return w
+	}
+
+	// Strict mode rejects the whole request on the first invalid item.
+	w := submit("stop", false, running, stopped)
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("strict status = %d, want 400 body=%s", w.Code, w.Body.String())
+	}
+	assertErrorCode(t, w.Body.Bytes(), "INVALID_POWER_STATE")
+	if n := client.ApprovalTicket.Query().CountX(t.Context()); n != 0 {
+		t.Fatalf("strict rejection persisted %d tickets", n)
+	}
+
+	// Skipping still needs one valid item.
+	if w := submit("start", true, running); w.Code != http.StatusBadRequest {
+		t.Fatalf("all-invalid skip status = %d, want 400 body=%s", w.Code, w.Body.String())
+	}
+
+	w = submit("stop", true, running, stopped)
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("skip status = %d, want 202 body=%s", w.Code, w.Body.String())
+	}
+	var resp generated.VMBatchSubmitResponse
+	mustDecodeJSON(t, w.Body.Bytes(), &resp)
+	view, _, err := srv.loadBatchView(t.Context(), resp.BatchId)
+	if err != nil {
+		t.Fatalf("load batch view: %v", err)
+	}
+	byVM := make(map[string]generated.VMBatchChildStatus, len(view.Children))
+	for _, child := range view.Children {
+		byVM[child.ResourceId] = child
+	}
+	skipped := byVM[stopped]
+	if skipped.Status != generated.VMBatchChildStatusStatusCANCELLED ||
+		skipped.LastError != "skipped: cannot stop VM in STOPPED state, must be RUNNING or PAUSED" {
+		t.Fatalf("skipped child = %+v", skipped)
+	}
+	// The test server has no River client, so the valid child fails to enqueue
+	// rather than sitting alongside the skipped one as CANCELLED.
+	if got := byVM[running].Status; got != generated.VMBatchChildStatusStatusFAILED {
+		t.Fatalf("valid child status = %s, want FAILED", got)
+	}
+	event := client.DomainEvent.GetX(t.Context(), skipped.EventId)
+	if event.Status != domainevent.StatusCANCELLED {
+		t.Fatalf("skipped child event status = %s, want CANCELLED", event.Status)
+	}
+}
+
+func newPowerApprovalTestServer(t *testing.T, requirePowerApproval bool) (*Server, *ent.Client, *fakeDeleteAtomicWriter) {
+	t.Helper()
+	_ = logger.Init("error", "json")
+	client := testutil.OpenEntPostgres(t, "batch_power_approval")
+	writer := &fakeDeleteAtomicWriter{client: client}
+	return NewServer(ServerDeps{
+		EntClient:            client,
+		Gateway:              approval.NewGateway(client, nil, writer),
+		RequirePowerApproval: requirePowerApproval,
+	}), client, writer
+}
+
+func submitBatchPowerForTest(t *testing.T, srv *Server, op string, vmIDs ...string) generated.VMBatchSubmitResponse {
+	t.Helper()
+	items := make([]generated.VMBatchPowerItem, 0, len(vmIDs))
+	for _, id := range vmIDs {
+		items = append(items, generated.VMBatchPowerItem{VmId: id})
+	}
+	body := mustJSON(t, generated.VMBatchPowerRequest{
+		Operation:   generated.VMBatchPowerAction(op),
+		SkipInvalid: true,
+		Items:       items,
+	})
+	c, w := newAuthedGinContext(t, http.MethodPost, "/vms/batch/power", body, "owner-1", []string{"platform:admin"})
+	srv.SubmitVMBatchPower(c)
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("submit status = %d, want 202 body=%s", w.Code, w.Body.String())
+	}
+	var resp generated.VMBatchSubmitResponse
+	mustDecodeJSON(t, w.Body.Bytes(), &resp)
+	return resp
+}
+
+func TestBatchHandler_SubmitVMBatchPower_RequiresApproval(t *testing.T) {
+	t.Parallel()
+
+	srv, client, writer := newPowerApprovalTestServer(t, true)
+	running := mustCreateBatchDeleteTargetVM(t, client, "owner-1")
+	stopped := mustCreateBatchDeleteTargetVM(t, client, "owner-1")
+	client.VM.UpdateOneID(stopped).SetStatus(entvm.StatusSTOPPED).ExecX(t.Context())
+
+	resp := submitBatchPowerForTest(t, srv, "stop", running, stopped)
+	if resp.Status != generated.VMBatchParentStatusPENDINGAPPROVAL {
+		t.Fatalf("submit status = %s, want PENDING_APPROVAL", resp.Status)
+	}
+	parent := client.ApprovalTicket.GetX(t.Context(), resp.BatchId)
+	if parent.Status != approvalticket.StatusPENDING {
+		t.Fatalf("parent ticket status = %s, want PENDING", parent.Status)
+	}
+	if ev := client.DomainEvent.GetX(t.Context(), parent.EventID); ev.Status != domainevent.StatusPENDING {
+		t.Fatalf("parent event status = %s, want PENDING", ev.Status)
+	}
+	if got := client.BatchApprovalTicket.GetX(t.Context(), resp.BatchId).Status; got != batchapprovalticket.StatusPENDING_APPROVAL {
+		t.Fatalf("projection status = %s, want PENDING_APPROVAL", got)
+	}
+	view, _, err := srv.loadBatchView(t.Context(), resp.BatchId)
+	if err != nil {
+		t.Fatalf("load batch view: %v", err)
+	}
+	byVM := make(map[string]generated.VMBatchChildStatus, len(view.Children))
+	for _, child := range view.Children {
+		byVM[child.ResourceId] = child
+	}
+	if got := byVM[running].Status; got != generated.VMBatchChildStatusStatusPENDING {
+		t.Fatalf("valid child status = %s, want PENDING", got)
+	}
+	if got := byVM[stopped].Status; got != generated.VMBatchChildStatusStatusCANCELLED {
+		t.Fatalf("skipped child status = %s, want CANCELLED", got)
+	}
+	if len(writer.powerOps) != 0 {
+		t.Fatalf("power jobs before approval = %v, want none", writer.powerOps)
+	}
+
+	c, w := newAuthedGinContext(t, http.MethodPatch, "/approvals/batch/"+resp.BatchId+"/approve", `{}`, "approver-1", []string{"approval:approve"})
+	srv.ApproveBatch(c, resp.BatchId)
+	if w.Code != http.StatusOK {
+		t.Fatalf("approve status = %d, want 200 body=%s", w.Code, w.Body.String())
+	}
+	var approved generated.VMBatchStatusResponse
+	mustDecodeJSON(t, w.Body.Bytes(), &approved)
+	if approved.Status != generated.VMBatchParentStatusINPROGRESS {
+		t.Fatalf("approved batch status = %s, want IN_PROGRESS", approved.Status)
+	}
+	runningChild := byVM[running].TicketId
+	if len(writer.powerOps) != 1 || writer.powerOps[runningChild] != "stop" {
+		t.Fatalf("power jobs = %v, want stop for %s only", writer.powerOps, runningChild)
+	}
+	if got := client.ApprovalTicket.GetX(t.Context(), resp.BatchId).Status; got != approvalticket.StatusEXECUTING {
+		t.Fatalf("parent ticket after approval = %s, want EXECUTING", got)
+	}
+}
+
+func TestBatchHandler_SubmitVMBatchPower_NamespaceRequiresApproval(t *testing.T) {
+	t.Parallel()
+
+	srv, client, writer := newPowerApprovalTestServer(t, false)
+	vmID := mustCreateBatchDeleteTargetVM(t, client, "owner-1")
+
+	// Flag off: the batch runs on submit. The test server has no River
+	// client, so the child fails to enqueue.
+	resp := submitBatchPowerForTest(t, srv, "stop", vmID)
+	if resp.Status != generated.VMBatchParentStatusFAILED {
+		t.Fatalf("status without approval = %s, want FAILED", resp.Status)
+	}
+	if got := client.ApprovalTicket.GetX(t.Context(), resp.BatchId).Status; got != approvalticket.StatusEXECUTING {
+		t.Fatalf("parent ticket without approval = %s, want EXECUTING", got)
+	}
+
+	client.NamespaceRegistry.Create().
+		SetID("ns-prod-shop").
+		SetName("prod-shop").
+		SetEnvironment(namespaceregistry.EnvironmentProd).
+		SetCreatedBy("admin").
+		SetRequirePowerApproval(true).
+		ExecX(t.Context())
+	resp = submitBatchPowerForTest(t, srv, "stop", vmID)
+	if resp.Status != generated.VMBatchParentStatusPENDINGAPPROVAL {
+		t.Fatalf("status with namespace override = %s, want PENDING_APPROVAL", resp.Status)
+	}
+	if len(writer.powerOps) != 0 {
+		t.Fatalf("power jobs = %v, want none before approval", writer.powerOps)
+	}
+}
+
+func TestBatchHandler_RejectPendingPowerBatch(t *testing.T) {
+	t.Parallel()
+
+	srv, client, writer := newPowerApprovalTestServer(t, true)
+	vmID := mustCreateBatchDeleteTargetVM(t, client, "owner-1")
+	resp := submitBatchPowerForTest(t, srv, "restart", vmID)
+
+	c, w := newAuthedGinContext(t, http.MethodPost, "/approvals/"+resp.BatchId+"/reject",
+		`{"reason":"change freeze"}`, "approver-1", []string{"approval:approve"})
+	srv.RejectTicket(c, resp.BatchId)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("reject status = %d, want 204 body=%s", w.Code, w.Body.String())
+	}
+
+	view, children, err := srv.loadBatchView(t.Context(), resp.BatchId)
+	if err != nil {
+		t.Fatalf("load batch view: %v", err)
+	}
+	if view.Status != generated.VMBatchParentStatusFAILED {
+		t.Fatalf("rejected batch status = %s, want FAILED", view.Status)
+	}
+	for _, child := range children {
+		if child.Status != approvalticket.StatusREJECTED || child.RejectReason != "change freeze" {
+			t.Fatalf("child %s = %s %q, want REJECTED with reason", child.ID, child.Status, child.RejectReason)
+		}
+		if ev := client.DomainEvent.GetX(t.Context(), child.EventID); ev.Status != domainevent.StatusCANCELLED {
+			t.Fatalf("child event status = %s, want CANCELLED", ev.Status)
+		}
+	}
+	if len(writer.powerOps) != 0 {
+		t.Fatalf("power jobs after reject = %v, want none", writer.powerOps)
+	}
+}
+
 func TestBatchHandler_RetryVMBatch_PowerChildUnknownOperation(t *testing.T) {
 	t.Parallel()
 
@@ -842,6 +1617,12 @@ func assertErrorCode(t *testing.T, body []byte, want string) {
 
 type fakeDeleteAtomicWriter struct {
 	deleteCalls int
+	// powerOps records the power operation enqueued per batch child ticket.
+	powerOps map[string]string
+	// failFor fails the delete dispatch of the listed tickets.
+	failFor map[string]bool
+	// client, when set, marks dispatched tickets APPROVED like the real writer.
+	client *ent.Client
 }
 
 func (f *fakeDeleteAtomicWriter) ApproveCreateAndEnqueue(
@@ -855,7 +1636,128 @@ func (f *fakeDeleteAtomicWriter) ApproveCreateAndEnqueue(
 	return "vm-fake", "vm-fake", nil
 }
 
-func (f *fakeDeleteAtomicWriter) ApproveDeleteAndEnqueue(_ context.Context, _, _, _, _ string) error {
+func (f *fakeDeleteAtomicWriter) ApproveDeleteAndEnqueue(ctx context.Context, ticketID, _, _, _ string) error {
 	f.deleteCalls++
+	if f.failFor[ticketID] {
+		return fmt.Errorf("injected failure for %s", ticketID)
+	}
+	if f.client != nil {
+		return f.client.ApprovalTicket.UpdateOneID(ticketID).SetStatus(approvalticket.StatusAPPROVED).Exec(ctx)
+	}
+	return nil
+}
+
+func (f *fakeDeleteAtomicWriter) ApproveMigrateAndEnqueue(_ context.Context, _, _, _, _ string) error {
+	return nil
+}
+
+func (f *fakeDeleteAtomicWriter) ApproveResizeAndEnqueue(_ context.Context, _, _, _ string, _ map[string]interface{}) error {
+	return nil
+}
+
+func (f *fakeDeleteAtomicWriter) ApprovePowerAndEnqueue(ctx context.Context, ticketID, _, _, operation string) error {
+	if f.powerOps == nil {
+		f.powerOps = map[string]string{}
+	}
+	f.powerOps[ticketID] = operation
+	if f.client != nil {
+		return f.client.ApprovalTicket.UpdateOneID(ticketID).SetStatus(approvalticket.StatusAPPROVED).Exec(ctx)
+	}
+	return nil
+}
+
+func (f *fakeDeleteAtomicWriter) ApproveSnapshotAndEnqueue(_ context.Context, _, _, _ string) error {
 	return nil
 }
+
+func (f *fakeDeleteAtomicWriter) ApproveNamespaceMigrateAndEnqueue(_ context.Context, _, _, _ string) error {
+	return nil
+}
+
+func TestBatchHandler_GetVMBatchLimits_AgreesWithSubmitOnCooldown(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newBatchBehaviorTestServer(t)
+	vmID := mustCreateBatchDeleteTargetVM(t, client, "owner-1")
+
+	// The last batch was submitted just inside the default cooldown.
+	lastSubmit := time.Now().UTC().Add(-batchSubmitCooldown + 30*time.Second)
+	client.DomainEvent.Create().
+		SetID("ev-cooldown-" + uuid.NewString()).
+		SetEventType(string(domain.EventBatchDeleteRequested)).
+		SetAggregateType("batch").
+		SetAggregateID("batch-cooldown-" + uuid.NewString()).
+		SetPayload([]byte(`{"request_id":"old","operation":"DELETE","items":[]}`)).
+		SetStatus(domainevent.StatusCOMPLETED).
+		SetCreatedBy("owner-1").
+		SetCreatedAt(lastSubmit).
+		SaveX(t.Context())
+
+	getLimits := func(perms []string) generated.VMBatchLimits {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodGet, "/vms/batch/limits", "", "owner-1", perms)
+		srv.GetVMBatchLimits(c)
+		if w.Code != http.StatusOK {
+			t.Fatalf("limits status = %d body=%s", w.Code, w.Body.String())
+		}
+		var limits generated.VMBatchLimits
+		mustDecodeJSON(t, w.Body.Bytes(), &limits)
+		return limits
+	}
+	submit := func() *httptest.ResponseRecorder {
+		t.Helper()
+		body := mustJSON(t, generated.VMBatchSubmitRequest{
+			Operation: generated.VMBatchOperationDELETE,
+			Items:     []generated.VMBatchChildItem{{VmId: vmID}},
+		})
+		c, w := newAuthedGinContext(t, http.MethodPost, "/vms/batch", body, "owner-1", []string{"platform:admin"})
+		srv.SubmitVMBatch(c)
+		return w
+	}
+
+	limits := getLimits([]string{"vm:read"})
+	if limits.CanSubmit || limits.BlockedReason != generated.UserSubmitCooldown {
+		t.Fatalf("limits during cooldown = %+v, want blocked by user_submit_cooldown", limits)
+	}
+	if limits.CooldownRemainingSeconds <= 0 || limits.CooldownRemainingSeconds > 30 {
+		t.Fatalf("cooldown_remaining_seconds = %d, want within (0, 30]", limits.CooldownRemainingSeconds)
+	}
+	if limits.GlobalPendingParents != nil || limits.GlobalRecentSubmits != nil {
+		t.Fatalf("global counters leaked to non-admin caller: %+v", limits)
+	}
+
+	w := submit()
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("submit during cooldown = %d body=%s", w.Code, w.Body.String())
+	}
+	var apiErr generated.Error
+	mustDecodeJSON(t, w.Body.Bytes(), &apiErr)
+	if apiErr.Params["reason"] != string(limits.BlockedReason) {
+		t.Fatalf("submit reason = %v, limits reason = %s", apiErr.Params["reason"], limits.BlockedReason)
+	}
+	if got := int(apiErr.Params["user_cooldown_seconds"].(float64)); got > limits.CooldownRemainingSeconds {
+		t.Fatalf("submit cooldown = %ds, limits reported %ds", got, limits.CooldownRemainingSeconds)
+	}
+
+	// An override shorter than the elapsed time lifts the cooldown for both.
+	client.RateLimitUserOverride.Create().
+		SetID("owner-1").
+		SetCooldownSeconds(60).
+		SetUpdatedBy("admin-1").
+		SaveX(t.Context())
+	limits = getLimits([]string{"rate_limit:manage"})
+	if !limits.CanSubmit || limits.CooldownRemainingSeconds != 0 || limits.CooldownSeconds != 60 {
+		t.Fatalf("limits after override = %+v, want submittable with no cooldown", limits)
+	}
+	if limits.GlobalPendingParents == nil || limits.GlobalRecentSubmits == nil || *limits.GlobalRecentSubmits != 0 {
+		t.Fatalf("rate-limit manager global counters = %v/%v, want raw numbers", limits.GlobalPendingParents, limits.GlobalRecentSubmits)
+	}
+	if w := submit(); w.Code != http.StatusAccepted {
+		t.Fatalf("submit after override = %d body=%s", w.Code, w.Body.String())
+	}
+
+	limits = getLimits([]string{"vm:read"})
+	if limits.CanSubmit || limits.PendingParents != 1 || limits.PendingChildren != 1 {
+		t.Fatalf("limits after submit = %+v, want one pending parent and child and a fresh cooldown", limits)
+	}
+}
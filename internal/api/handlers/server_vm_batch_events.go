@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+const defaultBatchEventsInterval = 2 * time.Second
+
+// SSE event names for GET /vms/batch/{batch_id}/events.
+const (
+	batchEventSnapshot = "snapshot"
+	batchEventUpdate   = "update"
+	batchEventComplete = "complete"
+)
+
+// StreamVMBatchEvents handles GET /vms/batch/{batch_id}/events.
+//
+// The stream sends a full snapshot, then an update carrying only the children
+// whose status or attempt_count changed, and finally a full complete event
+// once the parent is terminal. Batch state is re-read every
+// batchEventsInterval; the stream also ends when the client disconnects.
+func (s *Server) StreamVMBatchEvents(c *gin.Context, batchId generated.BatchID) {
+	batchID := string(batchId)
+	view, ok := s.loadReadableBatchView(c, batchID)
+	if !ok {
+		return
+	}
+	ctx := c.Request.Context()
+
+	// Batches outlive the server write timeout; not every writer supports this.
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	sendBatchEvent(c, batchEventSnapshot, view)
+
+	ticker := time.NewTicker(s.batchEventsInterval)
+	defer ticker.Stop()
+	for !isTerminalBatchStatus(view.Status) {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		next, _, err := s.loadBatchView(ctx, batchID)
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.Warn("batch event stream aborted", zap.Error(err), zap.String("batch_id", batchID))
+			}
+			return
+		}
+		if isTerminalBatchStatus(next.Status) {
+			view = next
+			break
+		}
+		if delta, changed := batchStatusDelta(view, next); changed {
+			sendBatchEvent(c, batchEventUpdate, delta)
+		}
+		view = next
+	}
+
+	sendBatchEvent(c, batchEventComplete, view)
+}
+
+func sendBatchEvent(c *gin.Context, event string, view generated.VMBatchStatusResponse) {
+	c.SSEvent(event, view)
+	c.Writer.Flush()
+}
+
+func isTerminalBatchStatus(status generated.VMBatchParentStatus) bool {
+	switch status {
+	case generated.VMBatchParentStatusCOMPLETED,
+		generated.VMBatchParentStatusPARTIALSUCCESS,
+		generated.VMBatchParentStatusFAILED,
+		generated.VMBatchParentStatusCANCELLED:
+		return true
+	default:
+		return false
+	}
+}
+
+// batchStatusDelta returns next with children narrowed to those whose status
+// or attempt_count differs from prev. changed is false when neither any child
+// nor the parent status moved.
+func batchStatusDelta(prev, next generated.VMBatchStatusResponse) (generated.VMBatchStatusResponse, bool) {
+	before := make(map[string]generated.VMBatchChildStatus, len(prev.Children))
+	for _, child := range prev.Children {
+		before[child.TicketId] = child
+	}
+
+	changed := make([]generated.VMBatchChildStatus, 0)
+	for _, child := range next.Children {
+		old, ok := before[child.TicketId]
+		if ok && old.Status == child.Status && old.AttemptCount == child.AttemptCount {
+			continue
+		}
+		changed = append(changed, child)
+	}
+
+	delta := next
+	delta.Children = changed
+	return delta, len(changed) > 0 || prev.Status != next.Status
+}
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/api/middleware"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestStreamVMBatchEvents_SnapshotThenComplete(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "batch_events_stream")
+	srv := NewServer(ServerDeps{EntClient: client, BatchEventsInterval: 20 * time.Millisecond})
+	batchID, childID := mustSeedPowerBatchForRetry(t, client, "owner-1", "start")
+	client.ApprovalTicket.UpdateOneID(childID).SetStatus(approvalticket.StatusEXECUTING).ExecX(t.Context())
+
+	engine := gin.New()
+	engine.GET("/vms/batch/:batch_id/events", func(c *gin.Context) {
+		c.Request = c.Request.WithContext(middleware.SetUserContext(c.Request.Context(), "owner-1", "owner-1", nil))
+		c.Set("permissions", []string{"vm:operate"})
+		srv.StreamVMBatchEvents(c, c.Param("batch_id"))
+	})
+	ts := httptest.NewServer(engine)
+	t.Cleanup(ts.Close)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL+"/vms/batch/"+batchID+"/events", nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("open stream: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream") {
+		t.Fatalf("stream status = %d content-type = %q", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	reader := bufio.NewReader(resp.Body)
+
+	name, snapshot := mustReadBatchEvent(t, reader)
+	if name != batchEventSnapshot || snapshot.Status != generated.VMBatchParentStatusINPROGRESS || len(snapshot.Children) != 1 {
+		t.Fatalf("first event = %s status=%s children=%d, want snapshot IN_PROGRESS with 1 child", name, snapshot.Status, len(snapshot.Children))
+	}
+
+	client.ApprovalTicket.UpdateOneID(childID).SetStatus(approvalticket.StatusSUCCESS).ExecX(t.Context())
+
+	name, final := mustReadBatchEvent(t, reader)
+	if name != batchEventComplete || final.Status != generated.VMBatchParentStatusCOMPLETED || final.SuccessCount != 1 {
+		t.Fatalf("last event = %s status=%s success=%d, want complete COMPLETED 1", name, final.Status, final.SuccessCount)
+	}
+	if _, err := reader.ReadString('\n'); !errors.Is(err, io.EOF) {
+		t.Fatalf("stream still open after complete: err=%v", err)
+	}
+}
+
+func TestStreamVMBatchEvents_HiddenFromOtherUsers(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newBatchBehaviorTestServer(t)
+	batchID, _ := mustSeedPowerBatchForRetry(t, client, "owner-1", "start")
+
+	c, w := newAuthedGinContext(t, http.MethodGet, "/vms/batch/"+batchID+"/events", "", "intruder", []string{"vm:read"})
+	srv.StreamVMBatchEvents(c, batchID)
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d body=%s", w.Code, http.StatusNotFound, w.Body.String())
+	}
+	assertErrorCode(t, w.Body.Bytes(), "BATCH_NOT_FOUND")
+}
+
+func TestBatchStatusDelta(t *testing.T) {
+	t.Parallel()
+
+	prev := generated.VMBatchStatusResponse{
+		Status: generated.VMBatchParentStatusINPROGRESS,
+		Children: []generated.VMBatchChildStatus{
+			{TicketId: "a", Status: "EXECUTING", AttemptCount: 1, DurationSeconds: 3},
+			{TicketId: "b", Status: "PENDING"},
+		},
+	}
+
+	next := prev
+	next.Children = []generated.VMBatchChildStatus{
+		{TicketId: "a", Status: "EXECUTING", AttemptCount: 1, DurationSeconds: 5},
+		{TicketId: "b", Status: "PENDING"},
+	}
+	if _, changed := batchStatusDelta(prev, next); changed {
+		t.Fatal("duration-only change reported as a delta")
+	}
+
+	next.Children = []generated.VMBatchChildStatus{
+		{TicketId: "a", Status: "SUCCESS", AttemptCount: 1},
+		{TicketId: "b", Status: "PENDING"},
+	}
+	delta, changed := batchStatusDelta(prev, next)
+	if !changed || len(delta.Children) != 1 || delta.Children[0].TicketId != "a" {
+		t.Fatalf("delta = %v %+v, want only child a", changed, delta.Children)
+	}
+}
+
+func mustReadBatchEvent(t *testing.T, reader *bufio.Reader) (string, generated.VMBatchStatusResponse) {
+	t.Helper()
+
+	var name, data string
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatalf("read event: %v", err)
+		}
+		line = strings.TrimRight(line, "\r\n")
+		switch {
+		case line == "" && data != "":
+			var view generated.VMBatchStatusResponse
+			if err := json.Unmarshal([]byte(data), &view); err != nil {
+				t.Fatalf("decode %s event: %v; data=%s", name, err, data)
+			}
+			return name, view
+		case strings.HasPrefix(line, "event:"):
+			name = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			data += strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		}
+	}
+}
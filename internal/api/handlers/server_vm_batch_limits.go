@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/api/middleware"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// GetVMBatchLimits handles GET /vms/batch/limits.
+//
+// It runs the same counters and policy resolution as batch submission, just
+// outside the per-actor submit lock, and evaluates them for a one-item
+// batch. A concurrent submit can still win the race; the 429 from submission
+// stays authoritative.
+func (s *Server) GetVMBatchLimits(c *gin.Context) {
+	ctx := c.Request.Context()
+	actor := middleware.GetUserID(ctx)
+	if strings.TrimSpace(actor) == "" {
+		c.JSON(http.StatusUnauthorized, generated.Error{Code: "UNAUTHORIZED"})
+		return
+	}
+
+	globalPending, userPending, err := s.pendingBatchParentCounters(ctx, s.client, actor)
+	if err != nil {
+		logger.Error("failed to count pending batch parents", zap.Error(err), zap.String("actor", actor))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	policy, err := s.resolveBatchUserLimitPolicy(ctx, s.client, actor)
+	if err != nil {
+		logger.Error("failed to resolve batch user limit policy", zap.Error(err), zap.String("actor", actor))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	counters, err := s.loadBatchSubmissionCounters(ctx, s.client, actor, policy)
+	if err != nil {
+		logger.Error("failed to load batch submission counters", zap.Error(err), zap.String("actor", actor))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	resp := generated.VMBatchLimits{
+		CanSubmit:          true,
+		Exempted:           policy.Exempt,
+		ExemptionExpiresAt: effectiveExemptionExpiry(policy.ExemptionExpiresAt),
+		PendingParents:     userPending,
+		PendingChildren:    counters.UserPendingChildren,
+		MaxPendingParents:  policy.MaxPendingParents,
+		MaxPendingChildren: policy.MaxPendingChildren,
+		CooldownSeconds:    int(policy.Cooldown.Seconds()),
+		GlobalSaturated: globalPending >= maxPendingBatchParents ||
+			counters.GlobalRecentSubmits >= maxGlobalBatchRequestsPerMinute,
+	}
+	if counters.CooldownRemaining > 0 {
+		resp.CooldownRemainingSeconds = counters.cooldownSeconds()
+	}
+
+	// Mirror submission's order: pending parents first, then the additional
+	// limits for a single child.
+	if batchParentLimitReached(globalPending, userPending, policy) {
+		resp.CanSubmit = false
+		resp.RetryAfterSeconds = batchRetryAfterSeconds
+		resp.BlockedReason = generated.UserPendingParentLimit
+		if globalPending >= maxPendingBatchParents {
+			resp.BlockedReason = generated.GlobalPendingLimit
+		}
+	} else if violation := counters.violation(1, policy); violation != nil {
+		resp.CanSubmit = false
+		resp.RetryAfterSeconds = violation.RetryAfterSeconds
+		resp.BlockedReason = generated.VMBatchLimitsBlockedReason(violation.Reason)
+	}
+
+	if hasAnyGlobalPermission(c, "rate_limit:manage") {
+		recent := counters.GlobalRecentSubmits
+		resp.GlobalPendingParents = &globalPending
+		resp.GlobalRecentSubmits = &recent
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
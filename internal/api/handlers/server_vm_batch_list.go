@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/batchapprovalticket"
+	"kv-shepherd.io/shepherd/ent/predicate"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// batchListFilter holds the query filters shared by the batch list endpoints.
+type batchListFilter struct {
+	Status      generated.VMBatchParentStatus
+	BatchType   generated.VMBatchType
+	CreatedBy   string
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+}
+
+// ListAdminBatches handles GET /admin/batch.
+func (s *Server) ListAdminBatches(c *gin.Context, params generated.ListAdminBatchesParams) {
+	ctx, _, ok := requireActorWithAnyGlobalPermission(c, "platform:admin")
+	if !ok {
+		return
+	}
+	s.listBatches(c, ctx, batchListFilter{
+		Status:      params.Status,
+		BatchType:   params.BatchType,
+		CreatedBy:   strings.TrimSpace(params.CreatedBy),
+		CreatedFrom: params.CreatedFrom,
+		CreatedTo:   params.CreatedTo,
+	}, params.Page, params.PerPage)
+}
+
+// ListVMBatches handles GET /vms/batch. Callers only see batches they
+// submitted, admins included; the platform-wide view is GET /admin/batch.
+func (s *Server) ListVMBatches(c *gin.Context, params generated.ListVMBatchesParams) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "vm:read", "vm:create", "vm:delete", "vm:operate")
+	if !ok {
+		return
+	}
+	s.listBatches(c, ctx, batchListFilter{
+		Status:      params.Status,
+		BatchType:   params.BatchType,
+		CreatedBy:   actor,
+		CreatedFrom: params.CreatedFrom,
+		CreatedTo:   params.CreatedTo,
+	}, params.Page, params.PerPage)
+}
+
+// listBatches writes a page of batch summaries from the batch_approval_tickets
+// projection, newest first.
+func (s *Server) listBatches(c *gin.Context, ctx context.Context, filter batchListFilter, page, perPage int) {
+	predicates, err := batchListPredicates(filter)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
+		return
+	}
+	page, perPage = defaultPagination(page, perPage)
+	query := s.client.BatchApprovalTicket.Query().Where(predicates...)
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		logger.Error("failed to count batches", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	batches, err := query.
+		Order(batchapprovalticket.ByCreatedAt(sql.OrderDesc()), batchapprovalticket.ByID(sql.OrderDesc())).
+		Offset((page - 1) * perPage).
+		Limit(perPage).
+		All(ctx)
+	if err != nil {
+		logger.Error("failed to list batches", zap.Error(err), zap.Int("page", page))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	items := make([]generated.BatchSummary, 0, len(batches))
+	for _, batch := range batches {
+		items = append(items, batchSummaryToAPI(batch))
+	}
+	c.JSON(http.StatusOK, generated.BatchList{
+		Items: items,
+		Pagination: generated.Pagination{
+			Page:       page,
+			PerPage:    perPage,
+			Total:      total,
+			TotalPages: (total + perPage - 1) / perPage,
+		},
+	})
+}
+
+func batchListPredicates(filter batchListFilter) ([]predicate.BatchApprovalTicket, error) {
+	var predicates []predicate.BatchApprovalTicket
+	if filter.Status != "" {
+		status := batchapprovalticket.Status(filter.Status)
+		if err := batchapprovalticket.StatusValidator(status); err != nil {
+			return nil, fmt.Errorf("invalid status %q", filter.Status)
+		}
+		predicates = append(predicates, batchapprovalticket.StatusEQ(status))
+	}
+	if filter.BatchType != "" {
+		batchType := batchapprovalticket.BatchType(filter.BatchType)
+		if err := batchapprovalticket.BatchTypeValidator(batchType); err != nil {
+			return nil, fmt.Errorf("invalid batch_type %q", filter.BatchType)
+		}
+		predicates = append(predicates, batchapprovalticket.BatchTypeEQ(batchType))
+	}
+	if filter.CreatedBy != "" {
+		predicates = append(predicates, batchapprovalticket.CreatedByEQ(filter.CreatedBy))
+	}
+	if !filter.CreatedFrom.IsZero() && !filter.CreatedTo.IsZero() && !filter.CreatedFrom.Before(filter.CreatedTo) {
+		return nil, fmt.Errorf("created_from must be before created_to")
+	}
+	if !filter.CreatedFrom.IsZero() {
+		predicates = append(predicates, batchapprovalticket.CreatedAtGTE(filter.CreatedFrom))
+	}
+	if !filter.CreatedTo.IsZero() {
+		predicates = append(predicates, batchapprovalticket.CreatedAtLT(filter.CreatedTo))
+	}
+	return predicates, nil
+}
+
+func batchSummaryToAPI(b *ent.BatchApprovalTicket) generated.BatchSummary {
+	return generated.BatchSummary{
+		BatchId:      b.ID,
+		BatchType:    generated.VMBatchType(b.BatchType),
+		Status:       generated.VMBatchParentStatus(b.Status),
+		ChildCount:   b.ChildCount,
+		SuccessCount: b.SuccessCount,
+		FailedCount:  b.FailedCount,
+		PendingCount: b.PendingCount,
+		Reason:       b.Reason,
+		CreatedBy:    b.CreatedBy,
+		CreatedAt:    b.CreatedAt,
+		UpdatedAt:    b.UpdatedAt,
+	}
+}
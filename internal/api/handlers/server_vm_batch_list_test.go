@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"kv-shepherd.io/shepherd/ent/batchapprovalticket"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+)
+
+func TestBatchListEndpoints(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newBatchBehaviorTestServer(t)
+	ctx := t.Context()
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	seed := []struct {
+		id        string
+		owner     string
+		batchType batchapprovalticket.BatchType
+		status    batchapprovalticket.Status
+		age       time.Duration
+	}{
+		{"batch-a", "owner-1", batchapprovalticket.BatchTypeBATCH_CREATE, batchapprovalticket.StatusCOMPLETED, 3 * time.Hour},
+		{"batch-b", "owner-1", batchapprovalticket.BatchTypeBATCH_POWER, batchapprovalticket.StatusFAILED, 2 * time.Hour},
+		{"batch-c", "owner-2", batchapprovalticket.BatchTypeBATCH_DELETE, batchapprovalticket.StatusCOMPLETED, time.Hour},
+		{"batch-d", "owner-1", batchapprovalticket.BatchTypeBATCH_CREATE, batchapprovalticket.StatusPENDING_APPROVAL, 0},
+	}
+	for _, b := range seed {
+		client.BatchApprovalTicket.Create().
+			SetID(b.id).
+			SetBatchType(b.batchType).
+			SetStatus(b.status).
+			SetChildCount(2).
+			SetCreatedBy(b.owner).
+			SetCreatedAt(base.Add(-b.age)).
+			ExecX(ctx)
+	}
+
+	list := func(admin bool, userID string, perms []string, params generated.ListAdminBatchesParams) (int, generated.BatchList, string) {
+		t.Helper()
+		if admin {
+			c, w := newAuthedGinContext(t, http.MethodGet, "/admin/batch", "", userID, perms)
+			srv.ListAdminBatches(c, params)
+			return decodeBatchList(t, w.Code, w.Body.Bytes())
+		}
+		c, w := newAuthedGinContext(t, http.MethodGet, "/vms/batch", "", userID, perms)
+		srv.ListVMBatches(c, generated.ListVMBatchesParams{
+			Status:      params.Status,
+			BatchType:   params.BatchType,
+			CreatedFrom: params.CreatedFrom,
+			CreatedTo:   params.CreatedTo,
+			Page:        params.Page,
+			PerPage:     params.PerPage,
+		})
+		return decodeBatchList(t, w.Code, w.Body.Bytes())
+	}
+	ids := func(l generated.BatchList) []string {
+		out := make([]string, 0, len(l.Items))
+		for _, item := range l.Items {
+			out = append(out, item.BatchId)
+		}
+		return out
+	}
+	adminPerms := []string{"platform:admin"}
+
+	if code, _, body := list(true, "owner-1", []string{"vm:read"}, generated.ListAdminBatchesParams{}); code != http.StatusForbidden {
+		t.Fatalf("non-admin GET /admin/batch status = %d, body=%s", code, body)
+	}
+
+	code, all, body := list(true, "admin-1", adminPerms, generated.ListAdminBatchesParams{})
+	if code != http.StatusOK {
+		t.Fatalf("admin list status = %d, body=%s", code, body)
+	}
+	if got, want := ids(all), []string{"batch-d", "batch-c", "batch-b", "batch-a"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("admin list = %v, want %v (newest first)", got, want)
+	}
+	if all.Pagination.Total != 4 || all.Items[0].ChildCount != 2 || all.Items[0].Status != generated.VMBatchParentStatusPENDINGAPPROVAL {
+		t.Fatalf("admin list = %+v", all)
+	}
+
+	cases := []struct {
+		name   string
+		params generated.ListAdminBatchesParams
+		want   []string
+	}{
+		{"status", generated.ListAdminBatchesParams{Status: generated.VMBatchParentStatusCOMPLETED}, []string{"batch-c", "batch-a"}},
+		{"batch_type", generated.ListAdminBatchesParams{BatchType: generated.BATCHCREATE}, []string{"batch-d", "batch-a"}},
+		{"created_by", generated.ListAdminBatchesParams{CreatedBy: "owner-2"}, []string{"batch-c"}},
+		{"created range", generated.ListAdminBatchesParams{CreatedFrom: base.Add(-2 * time.Hour), CreatedTo: base}, []string{"batch-c", "batch-b"}},
+		{"pagination", generated.ListAdminBatchesParams{Page: 2, PerPage: 3}, []string{"batch-a"}},
+	}
+	for _, tc := range cases {
+		code, got, body := list(true, "admin-1", adminPerms, tc.params)
+		if code != http.StatusOK {
+			t.Fatalf("%s: status = %d, body=%s", tc.name, code, body)
+		}
+		if !reflect.DeepEqual(ids(got), tc.want) {
+			t.Fatalf("%s: ids = %v, want %v", tc.name, ids(got), tc.want)
+		}
+	}
+
+	if code, _, body := list(true, "admin-1", adminPerms, generated.ListAdminBatchesParams{Status: "BOGUS"}); code != http.StatusBadRequest {
+		t.Fatalf("invalid status code = %d, body=%s", code, body)
+	}
+	if code, _, body := list(true, "admin-1", adminPerms, generated.ListAdminBatchesParams{
+		CreatedFrom: base,
+		CreatedTo:   base.Add(-time.Hour),
+	}); code != http.StatusBadRequest {
+		t.Fatalf("inverted range code = %d, body=%s", code, body)
+	}
+
+	// The user endpoint only returns the caller's own batches.
+	code, own, body := list(false, "owner-1", []string{"vm:read"}, generated.ListAdminBatchesParams{CreatedBy: "owner-2"})
+	if code != http.StatusOK {
+		t.Fatalf("user list status = %d, body=%s", code, body)
+	}
+	if got, want := ids(own), []string{"batch-d", "batch-b", "batch-a"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("user list = %v, want %v", got, want)
+	}
+	if code, _, body := list(false, "owner-1", nil, generated.ListAdminBatchesParams{}); code != http.StatusForbidden {
+		t.Fatalf("user list without vm permission status = %d, body=%s", code, body)
+	}
+}
+
+func decodeBatchList(t *testing.T, code int, body []byte) (int, generated.BatchList, string) {
+	t.Helper()
+	var out generated.BatchList
+	if code == http.StatusOK {
+		mustDecodeJSON(t, body, &out)
+	}
+	return code, out, string(body)
+}
@@ -1,12 +1,16 @@
 package handlers
 
 import (
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
 	"kv-shepherd.io/shepherd/ent/batchapprovalticket"
 	"kv-shepherd.io/shepherd/internal/api/generated"
 	"kv-shepherd.io/shepherd/internal/domain"
@@ -163,6 +167,14 @@ func TestAggregateBatchParentStatus(t *testing.T) {
 			pendingCount: 5,
 			want:         generated.VMBatchParentStatusPENDINGAPPROVAL,
 		},
+		{
+			name:         "pending approval with skipped children",
+			total:        3,
+			pendingOnly:  2,
+			pendingCount: 2,
+			cancelled:    1,
+			want:         generated.VMBatchParentStatusPENDINGAPPROVAL,
+		},
 		{
 			name:         "in progress with executing children",
 			total:        3,
@@ -214,6 +226,11 @@ func TestMapProjectionStatus(t *testing.T) {
 			in:   generated.VMBatchParentStatusPENDINGAPPROVAL,
 			want: batchapprovalticket.StatusPENDING_APPROVAL,
 		},
+		{
+			name: "partial approved",
+			in:   generated.VMBatchParentStatusPARTIALAPPROVED,
+			want: batchapprovalticket.StatusPARTIAL_APPROVED,
+		},
 		{
 			name: "in progress",
 			in:   generated.VMBatchParentStatusINPROGRESS,
@@ -403,3 +420,116 @@ func mustOpenAPIUUID(t *testing.T, raw string) openapi_types.UUID {
 	}
 	return openapi_types.UUID(id)
 }
+
+func TestSelectBatchActionTargets(t *testing.T) {
+	t.Parallel()
+
+	children := []*ent.ApprovalTicket{
+		{ID: "c-failed", Status: approvalticket.StatusFAILED},
+		{ID: "c-rejected", Status: approvalticket.StatusREJECTED},
+		{ID: "c-pending", Status: approvalticket.StatusPENDING},
+		{ID: "c-done", Status: approvalticket.StatusSUCCESS},
+	}
+	ids := func(items []*ent.ApprovalTicket) []string {
+		out := make([]string, 0, len(items))
+		for _, item := range items {
+			out = append(out, item.ID)
+		}
+		return out
+	}
+
+	t.Run("no selection keeps legacy behavior", func(t *testing.T) {
+		t.Parallel()
+
+		targets, skipped := selectBatchActionTargets(children, "retry", nil)
+		if got := ids(targets); !slices.Equal(got, []string{"c-failed", "c-rejected"}) {
+			t.Fatalf("retry targets = %v, want [c-failed c-rejected]", got)
+		}
+		if skipped != nil {
+			t.Fatalf("skipped = %v, want nil", skipped)
+		}
+		targets, _ = selectBatchActionTargets(children, "cancel", []string{})
+		if got := ids(targets); !slices.Equal(got, []string{"c-pending"}) {
+			t.Fatalf("cancel targets = %v, want [c-pending]", got)
+		}
+	})
+
+	t.Run("mixed selection reports skipped ids", func(t *testing.T) {
+		t.Parallel()
+
+		targets, skipped := selectBatchActionTargets(children, "retry", []string{"c-rejected", "c-done", "other", "c-rejected"})
+		if got := ids(targets); !slices.Equal(got, []string{"c-rejected"}) {
+			t.Fatalf("targets = %v, want [c-rejected]", got)
+		}
+		want := []generated.VMBatchSkippedTicket{
+			{TicketId: "c-done", Reason: generated.INVALIDSTATE, Status: "SUCCESS"},
+			{TicketId: "other", Reason: generated.NOTINBATCH},
+		}
+		if !slices.Equal(skipped, want) {
+			t.Fatalf("skipped = %+v, want %+v", skipped, want)
+		}
+	})
+}
+
+func TestBatchChildTiming(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	at := func(sec int) *time.Time {
+		v := base.Add(time.Duration(sec) * time.Second)
+		return &v
+	}
+	now := *at(100)
+
+	t.Run("never dispatched child has no timing", func(t *testing.T) {
+		t.Parallel()
+
+		var out generated.VMBatchChildStatus
+		applyBatchChildTiming(&out, &ent.ApprovalTicket{Status: approvalticket.StatusPENDING}, now)
+		if !out.StartedAt.IsZero() || !out.FinishedAt.IsZero() || out.DurationSeconds != 0 {
+			t.Fatalf("timing = %+v, want zero", out)
+		}
+	})
+
+	t.Run("finished child measures start to finish", func(t *testing.T) {
+		t.Parallel()
+
+		var out generated.VMBatchChildStatus
+		applyBatchChildTiming(&out, &ent.ApprovalTicket{StartedAt: at(10), FinishedAt: at(40)}, now)
+		if !out.StartedAt.Equal(*at(10)) || !out.FinishedAt.Equal(*at(40)) || out.DurationSeconds != 30 {
+			t.Fatalf("timing = %+v, want 10..40 (30s)", out)
+		}
+	})
+
+	t.Run("running child measures up to now", func(t *testing.T) {
+		t.Parallel()
+
+		var out generated.VMBatchChildStatus
+		applyBatchChildTiming(&out, &ent.ApprovalTicket{StartedAt: at(70)}, now)
+		if !out.FinishedAt.IsZero() || out.DurationSeconds != 30 {
+			t.Fatalf("timing = %+v, want open attempt of 30s", out)
+		}
+	})
+
+	t.Run("parent window", func(t *testing.T) {
+		t.Parallel()
+
+		children := []*ent.ApprovalTicket{
+			{StartedAt: at(20), FinishedAt: at(50)},
+			{StartedAt: at(5), FinishedAt: at(30)},
+			{},
+		}
+		started, completed := batchExecutionWindow(children, 0)
+		if !started.Equal(*at(5)) || !completed.Equal(*at(50)) {
+			t.Fatalf("window = %v..%v, want %v..%v", started, completed, *at(5), *at(50))
+		}
+		started, completed = batchExecutionWindow(children, 1)
+		if !started.Equal(*at(5)) || !completed.IsZero() {
+			t.Fatalf("active window = %v..%v, want %v..zero", started, completed, *at(5))
+		}
+		started, completed = batchExecutionWindow([]*ent.ApprovalTicket{{}}, 1)
+		if !started.IsZero() || !completed.IsZero() {
+			t.Fatalf("undispatched window = %v..%v, want zero", started, completed)
+		}
+	})
+}
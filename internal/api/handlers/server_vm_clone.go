@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"kv-shepherd.io/shepherd/ent"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/service"
+)
+
+// vmCloneSource is a validated clone request: the stopped source VM, the
+// PVC backing its root disk, and the namespace the clone lands in.
+type vmCloneSource struct {
+	VMID            string
+	ClusterID       string
+	Namespace       string
+	PVCName         string
+	TargetNamespace string
+}
+
+// applyTo records the clone source on a VM creation payload.
+func (src *vmCloneSource) applyTo(payload *domain.VMCreationPayload) {
+	payload.Namespace = src.TargetNamespace
+	payload.SourceVMID = src.VMID
+	payload.SourceClusterID = src.ClusterID
+	payload.SourceNamespace = src.Namespace
+	payload.SourcePVCName = src.PVCName
+}
+
+// resolveVMCloneSource validates a clone of sourceVMID. The clone stays in
+// the source namespace unless targetNamespace is set; both namespaces must be
+// visible to the actor. Client-facing rejections are *batchValidationError.
+func (s *Server) resolveVMCloneSource(
+	ctx context.Context,
+	sourceVMID, targetNamespace string,
+	visibility namespaceVisibility,
+	scope vmScope,
+) (*vmCloneSource, error) {
+	sourceVMID = strings.TrimSpace(sourceVMID)
+	source, err := s.client.VM.Get(ctx, sourceVMID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, cloneSourceNotFound(sourceVMID)
+		}
+		return nil, fmt.Errorf("get clone source vm %s: %w", sourceVMID, err)
+	}
+	var visible bool
+	if scope.global() {
+		visible, err = s.isNamespaceVisible(ctx, source.Namespace, visibility)
+	} else {
+		visible, err = s.vmInScope(ctx, source.ID, scope)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		// Hide VMs the actor cannot see rather than confirm they exist.
+		return nil, cloneSourceNotFound(sourceVMID)
+	}
+	if source.Status != entvm.StatusSTOPPED {
+		return nil, &batchValidationError{
+			status: http.StatusConflict,
+			body: generated.Error{
+				Code:    "CLONE_SOURCE_NOT_STOPPED",
+				Message: fmt.Sprintf("source vm %s must be STOPPED to be cloned", sourceVMID),
+				Params:  map[string]interface{}{"source_vm_id": sourceVMID, "status": string(source.Status)},
+			},
+		}
+	}
+
+	target := strings.TrimSpace(targetNamespace)
+	if target == "" {
+		target = source.Namespace
+	} else if target != source.Namespace {
+		visible, err := s.isNamespaceVisible(ctx, target, visibility)
+		if err != nil {
+			return nil, err
+		}
+		if !visible {
+			return nil, &batchValidationError{
+				status: http.StatusForbidden,
+				body: generated.Error{
+					Code:    "NAMESPACE_ENV_FORBIDDEN",
+					Message: fmt.Sprintf("namespace %q is outside allowed environment visibility", target),
+				},
+			}
+		}
+	}
+
+	pvcName, err := s.vmRootDiskPVC(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	if pvcName == "" {
+		return nil, &batchValidationError{
+			status: http.StatusBadRequest,
+			body: generated.Error{
+				Code:    "CLONE_SOURCE_NO_PVC",
+				Message: fmt.Sprintf("source vm %s boots from a container disk and has no PVC to clone", sourceVMID),
+				Params:  map[string]interface{}{"source_vm_id": sourceVMID},
+			},
+		}
+	}
+
+	return &vmCloneSource{
+		VMID:            source.ID,
+		ClusterID:       source.ClusterID,
+		Namespace:       source.Namespace,
+		PVCName:         pvcName,
+		TargetNamespace: target,
+	}, nil
+}
+
+// vmRootDiskPVC resolves the PVC behind a VM's root disk from the request
+// that created it: clones own a DataVolume named after the VM, template VMs
+// boot from the template's PVC if it has one.
+func (s *Server) vmRootDiskPVC(ctx context.Context, vm *ent.VM) (string, error) {
+	ticket, err := s.client.ApprovalTicket.Get(ctx, vm.TicketID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("get creation ticket %s of vm %s: %w", vm.TicketID, vm.ID, err)
+	}
+	event, err := s.client.DomainEvent.Get(ctx, ticket.EventID)
+	if err != nil && !ent.IsNotFound(err) {
+		return "", fmt.Errorf("get creation event %s of vm %s: %w", ticket.EventID, vm.ID, err)
+	}
+	if err == nil && domain.EventType(event.EventType) == domain.EventVMCloneRequested {
+		return domain.CloneRootDiskPVCName(vm.Name), nil
+	}
+
+	templateSpec := ticket.TemplateSnapshot
+	if len(templateSpec) == 0 && event != nil {
+		var payload domain.VMCreationPayload
+		if err := json.Unmarshal(event.Payload, &payload); err == nil && payload.TemplateID != "" {
+			tpl, err := s.client.Template.Get(ctx, payload.TemplateID)
+			if err != nil && !ent.IsNotFound(err) {
+				return "", fmt.Errorf("get template %s of vm %s: %w", payload.TemplateID, vm.ID, err)
+			}
+			if err == nil {
+				templateSpec = tpl.Spec
+			}
+		}
+	}
+	return service.TemplateRootDiskPVC(templateSpec), nil
+}
+
+func cloneSourceNotFound(sourceVMID string) *batchValidationError {
+	return &batchValidationError{
+		status: http.StatusNotFound,
+		body: generated.Error{
+			Code:    "CLONE_SOURCE_NOT_FOUND",
+			Message: fmt.Sprintf("source vm %q not found", sourceVMID),
+			Params:  map[string]interface{}{"source_vm_id": sourceVMID},
+		},
+	}
+}
@@ -0,0 +1,193 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/namespaceregistry"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/service"
+	"kv-shepherd.io/shepherd/internal/testutil"
+	"kv-shepherd.io/shepherd/internal/usecase"
+)
+
+func TestCreateVMRequest_CloneFromStoppedVM(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "vm_create_clone")
+
+	serviceID := uuid.New()
+	templateID := uuid.New()
+	sizeID := uuid.New()
+	sys := mustCreateSystem(t, client, "sys-"+uuid.NewString(), "shop", "alice")
+	svc := mustCreateService(t, client, serviceID.String(), "redis", sys.ID, "cache")
+	client.Template.Create().
+		SetID(templateID.String()).
+		SetName("fedora").
+		SetVersion(1).
+		SetEnabled(true).
+		SetCreatedBy("admin-1").
+		SetSpec(map[string]interface{}{"image": "quay.io/kubevirt/fedora:40"}).
+		SaveX(t.Context())
+	client.InstanceSize.Create().
+		SetID(sizeID.String()).
+		SetName("small").
+		SetCPUCores(2).
+		SetMemoryMB(4096).
+		SetCreatedBy("admin-1").
+		SaveX(t.Context())
+	for _, ns := range []string{"dev-shop", "dev-cache"} {
+		client.NamespaceRegistry.Create().
+			SetID("ns-" + uuid.NewString()).
+			SetName(ns).
+			SetEnvironment(namespaceregistry.EnvironmentTest).
+			SetCreatedBy("admin-1").
+			SaveX(t.Context())
+	}
+
+	seedSourceVM := func(name string, status entvm.Status, templateSpec map[string]interface{}) string {
+		t.Helper()
+		ticketID := "ticket-" + uuid.NewString()
+		client.ApprovalTicket.Create().
+			SetID(ticketID).
+			SetEventID("ev-" + uuid.NewString()).
+			SetRequester("alice").
+			SetOperationType(approvalticket.OperationTypeCREATE).
+			SetStatus(approvalticket.StatusSUCCESS).
+			SetTemplateSnapshot(templateSpec).
+			SaveX(t.Context())
+		vmID := "vm-" + uuid.NewString()
+		client.VM.Create().
+			SetID(vmID).
+			SetName(name).
+			SetInstance("01").
+			SetNamespace("dev-shop").
+			SetClusterID("cluster-a").
+			SetStatus(status).
+			SetCreatedBy("alice").
+			SetTicketID(ticketID).
+			SetServiceID(svc.ID).
+			SaveX(t.Context())
+		return vmID
+	}
+	pvcSource := seedSourceVM("dev-shop-shop-redis-01", entvm.StatusSTOPPED, map[string]interface{}{"image": "pvc:golden-fedora"})
+	runningSource := seedSourceVM("dev-shop-shop-redis-02", entvm.StatusRUNNING, map[string]interface{}{"image": "pvc:golden-fedora"})
+	containerDiskSource := seedSourceVM("dev-shop-shop-redis-03", entvm.StatusSTOPPED, map[string]interface{}{"image": "quay.io/kubevirt/fedora:40"})
+
+	srv := NewServer(ServerDeps{
+		EntClient: client,
+		CreateVMUC: usecase.NewCreateVMUseCase(
+			client,
+			service.NewVMService(nil),
+			service.NewInstanceSizeService(client),
+			service.NewTemplateService(client),
+		),
+	})
+	submit := func(namespace, sourceVMID, targetNamespace string) (int, []byte) {
+		t.Helper()
+		body := generated.VMCreateRequest{
+			ServiceId:       serviceID,
+			TemplateId:      templateID,
+			InstanceSizeId:  sizeID,
+			Namespace:       namespace,
+			Reason:          "clone",
+			SourceVmId:      sourceVMID,
+			TargetNamespace: targetNamespace,
+		}
+		c, w := newAuthedGinContext(t, http.MethodPost, "/vms/request", mustJSON(t, body), "alice", []string{"vm:create", "platform:admin"})
+		srv.CreateVMRequest(c)
+		return w.Code, w.Body.Bytes()
+	}
+
+	code, body := submit("dev-shop", pvcSource, "dev-cache")
+	if code != http.StatusAccepted {
+		t.Fatalf("clone submit = %d body=%s", code, body)
+	}
+	var resp generated.ApprovalTicketResponse
+	mustDecodeJSON(t, body, &resp)
+	ticket := client.ApprovalTicket.GetX(t.Context(), resp.TicketId)
+	event := client.DomainEvent.GetX(t.Context(), ticket.EventID)
+	if event.EventType != string(domain.EventVMCloneRequested) {
+		t.Fatalf("event type = %s, want %s", event.EventType, domain.EventVMCloneRequested)
+	}
+	var payload domain.VMCreationPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		t.Fatalf("decode clone payload: %v", err)
+	}
+	want := domain.VMCreationPayload{
+		SourceVMID:      pvcSource,
+		SourceClusterID: "cluster-a",
+		SourceNamespace: "dev-shop",
+		SourcePVCName:   "golden-fedora",
+		Namespace:       "dev-cache",
+	}
+	if payload.SourceVMID != want.SourceVMID || payload.SourceClusterID != want.SourceClusterID ||
+		payload.SourceNamespace != want.SourceNamespace || payload.SourcePVCName != want.SourcePVCName ||
+		payload.Namespace != want.Namespace {
+		t.Fatalf("clone payload = %+v, want source fields of %+v", payload, want)
+	}
+
+	// A clone of a clone copies the DataVolume the first clone owns.
+	cloneVM := seedClonedVM(t, client, svc.ID, ticket)
+	code, body = submit("dev-cache", cloneVM.ID, "")
+	if code != http.StatusAccepted {
+		t.Fatalf("clone-of-clone submit = %d body=%s", code, body)
+	}
+	mustDecodeJSON(t, body, &resp)
+	event = client.DomainEvent.GetX(t.Context(), client.ApprovalTicket.GetX(t.Context(), resp.TicketId).EventID)
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		t.Fatalf("decode clone-of-clone payload: %v", err)
+	}
+	if payload.SourcePVCName != domain.CloneRootDiskPVCName(cloneVM.Name) {
+		t.Fatalf("clone-of-clone source pvc = %q, want %q", payload.SourcePVCName, domain.CloneRootDiskPVCName(cloneVM.Name))
+	}
+
+	tests := []struct {
+		name       string
+		namespace  string
+		sourceVMID string
+		target     string
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "running source", namespace: "dev-shop", sourceVMID: runningSource, wantStatus: http.StatusConflict, wantCode: "CLONE_SOURCE_NOT_STOPPED"},
+		{name: "container disk source", namespace: "dev-shop", sourceVMID: containerDiskSource, wantStatus: http.StatusBadRequest, wantCode: "CLONE_SOURCE_NO_PVC"},
+		{name: "missing source", namespace: "dev-shop", sourceVMID: "vm-missing", wantStatus: http.StatusNotFound, wantCode: "CLONE_SOURCE_NOT_FOUND"},
+		{name: "namespace mismatch", namespace: "dev-cache", sourceVMID: pvcSource, wantStatus: http.StatusBadRequest, wantCode: "CLONE_NAMESPACE_MISMATCH"},
+		{name: "target without source", namespace: "dev-shop", target: "dev-cache", wantStatus: http.StatusBadRequest, wantCode: "INVALID_REQUEST_FIELD"},
+	}
+	for _, tc := range tests {
+		code, body := submit(tc.namespace, tc.sourceVMID, tc.target)
+		if code != tc.wantStatus {
+			t.Fatalf("%s: status = %d, want %d body=%s", tc.name, code, tc.wantStatus, body)
+		}
+		assertErrorCode(t, body, tc.wantCode)
+	}
+}
+
+func seedClonedVM(t *testing.T, client *ent.Client, serviceID string, ticket *ent.ApprovalTicket) *ent.VM {
+	t.Helper()
+	client.ApprovalTicket.UpdateOneID(ticket.ID).SetStatus(approvalticket.StatusSUCCESS).ExecX(t.Context())
+	return client.VM.Create().
+		SetID("vm-" + uuid.NewString()).
+		SetName("dev-cache-shop-redis-01").
+		SetInstance("01").
+		SetNamespace("dev-cache").
+		SetClusterID("cluster-a").
+		SetStatus(entvm.StatusSTOPPED).
+		SetCreatedBy("alice").
+		SetTicketID(ticket.ID).
+		SetServiceID(serviceID).
+		SaveX(t.Context())
+}
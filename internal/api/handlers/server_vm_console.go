@@ -5,8 +5,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -17,6 +20,7 @@ import (
 	"kv-shepherd.io/shepherd/ent/domainevent"
 	"kv-shepherd.io/shepherd/ent/namespaceregistry"
 	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/ent/vmconsolesession"
 	"kv-shepherd.io/shepherd/internal/api/generated"
 	"kv-shepherd.io/shepherd/internal/api/middleware"
 	"kv-shepherd.io/shepherd/internal/domain"
@@ -34,6 +38,8 @@ type vncRequestPayload struct {
 	ClusterID   string `json:"cluster_id"`
 	Namespace   string `json:"namespace"`
 	RequesterID string `json:"requester_id"`
+	// DurationSeconds is the clamped access window granted on approval.
+	DurationSeconds int64 `json:"duration_seconds,omitempty"`
 }
 
 // RequestVMConsoleAccess handles POST /vms/{vm_id}/console/request.
@@ -48,6 +54,23 @@ func (s *Server) RequestVMConsoleAccess(c *gin.Context, vmId generated.VMID) {
 		return
 	}
 
+	var req generated.VMConsoleAccessRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+		return
+	}
+	var requested time.Duration
+	if req.DurationMinutes != nil {
+		if *req.DurationMinutes < 1 {
+			c.JSON(http.StatusBadRequest, generated.Error{
+				Code:    "INVALID_REQUEST",
+				Message: "duration_minutes must be at least 1",
+			})
+			return
+		}
+		requested = time.Duration(*req.DurationMinutes) * time.Minute
+	}
+
 	vm, err := s.client.VM.Get(ctx, vmId)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -92,7 +115,7 @@ func (s *Server) RequestVMConsoleAccess(c *gin.Context, vmId generated.VMID) {
 	}
 
 	if !decision.RequireApproval {
-		vncURL, claims, err := s.issueVNCURL(c, actor, vm)
+		vncURL, claims, err := s.issueVNCURL(c, actor, vm, "")
 		if err != nil {
 			logger.Error("failed to issue direct vnc token", zap.Error(err), zap.String("vm_id", vm.ID))
 			c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
@@ -108,13 +131,15 @@ func (s *Server) RequestVMConsoleAccess(c *gin.Context, vmId generated.VMID) {
 		}
 
 		c.JSON(http.StatusOK, generated.VMConsoleRequestResponse{
-			Status: generated.VMConsoleRequestStatusAPPROVED,
-			VncUrl: vncURL,
+			Status:    generated.VMConsoleRequestStatusAPPROVED,
+			VncUrl:    vncURL,
+			SessionId: claims.JTI,
 		})
 		return
 	}
 
-	ticketID, err := s.createVNCApprovalRequest(ctx, vm, actor)
+	window := service.ClampVNCAccessDuration(requested, s.vncMaxAccessDuration)
+	ticketID, err := s.createVNCApprovalRequest(ctx, vm, actor, window)
 	if err != nil {
 		logger.Error("failed to create vnc approval request", zap.Error(err), zap.String("vm_id", vm.ID), zap.String("actor", actor))
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
@@ -123,7 +148,8 @@ func (s *Server) RequestVMConsoleAccess(c *gin.Context, vmId generated.VMID) {
 
 	if s.audit != nil {
 		_ = s.audit.LogAction(ctx, "vnc.request_submitted", "vm", vm.ID, actor, map[string]interface{}{
-			"ticket_id": ticketID,
+			"ticket_id":        ticketID,
+			"duration_seconds": int64(window / time.Second),
 		})
 	}
 
@@ -180,7 +206,7 @@ func (s *Server) GetVMConsoleStatus(c *gin.Context, vmId generated.VMID) {
 	}
 
 	if env == namespaceregistry.EnvironmentTest {
-		vncURL, claims, err := s.issueVNCURL(c, actor, vm)
+		vncURL, claims, err := s.issueVNCURL(c, actor, vm, "")
 		if err != nil {
 			logger.Error("failed to issue test-env vnc token", zap.Error(err), zap.String("vm_id", vm.ID))
 			c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
@@ -194,8 +220,9 @@ func (s *Server) GetVMConsoleStatus(c *gin.Context, vmId generated.VMID) {
 			})
 		}
 		c.JSON(http.StatusOK, generated.VMConsoleStatusResponse{
-			Status: generated.VMConsoleStatusAPPROVED,
-			VncUrl: vncURL,
+			Status:    generated.VMConsoleStatusAPPROVED,
+			VncUrl:    vncURL,
+			SessionId: claims.JTI,
 		})
 		return
 	}
@@ -227,8 +254,13 @@ func (s *Server) GetVMConsoleStatus(c *gin.Context, vmId generated.VMID) {
 		})
 		return
 	}
+	now := time.Now().UTC()
+	if vncAccessExpired(ticket, now) {
+		writeVNCAccessExpired(c, ticket)
+		return
+	}
 
-	vncURL, claims, err := s.issueVNCURL(c, actor, vm)
+	vncURL, claims, err := s.issueVNCURL(c, actor, vm, ticket.ID)
 	if err != nil {
 		logger.Error("failed to issue approved vnc token", zap.Error(err), zap.String("vm_id", vm.ID), zap.String("ticket_id", ticket.ID))
 		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
@@ -243,11 +275,18 @@ func (s *Server) GetVMConsoleStatus(c *gin.Context, vmId generated.VMID) {
 		})
 	}
 
-	c.JSON(http.StatusOK, generated.VMConsoleStatusResponse{
-		Status:   generated.VMConsoleStatusAPPROVED,
-		TicketId: ticket.ID,
-		VncUrl:   vncURL,
-	})
+	resp := generated.VMConsoleStatusResponse{
+		Status:    generated.VMConsoleStatusAPPROVED,
+		TicketId:  ticket.ID,
+		VncUrl:    vncURL,
+		SessionId: claims.JTI,
+		ExpiresAt: ticket.ExpiresAt,
+	}
+	if ticket.ExpiresAt != nil {
+		remaining := int(ticket.ExpiresAt.Sub(now) / time.Second)
+		resp.RemainingSeconds = &remaining
+	}
+	c.JSON(http.StatusOK, resp)
 }
 
 // OpenVMVNC handles GET /vms/{vm_id}/vnc.
@@ -289,6 +328,26 @@ func (s *Server) OpenVMVNC(c *gin.Context, vmId generated.VMID) {
 		return
 	}
 
+	// Every issued credential has a session row; a missing row means the
+	// session was purged, a set revoked_at means it was revoked explicitly.
+	session, err := s.client.VMConsoleSession.Get(ctx, claims.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusUnauthorized, generated.Error{Code: "INVALID_VNC_TOKEN"})
+			return
+		}
+		logger.Error("failed to load vnc console session", zap.Error(err), zap.String("session_id", claims.ID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if session.RevokedAt != nil {
+		c.JSON(http.StatusUnauthorized, generated.Error{Code: "VNC_SESSION_REVOKED"})
+		return
+	}
+	if !s.requireVNCSessionTicketActive(c, session) {
+		return
+	}
+
 	vm, err := s.client.VM.Get(ctx, vmId)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -307,10 +366,107 @@ func (s *Server) OpenVMVNC(c *gin.Context, vmId generated.VMID) {
 	c.JSON(http.StatusOK, generated.VMVNCSessionResponse{
 		Status:        generated.SESSIONREADY,
 		VmId:          vm.ID,
-		WebsocketPath: fmt.Sprintf("/api/v1/vms/%s/vnc", vm.ID),
+		WebsocketPath: fmt.Sprintf("/api/v1/vms/%s/vnc/ws?token=%s", vm.ID, url.QueryEscape(session.ID)),
+		SessionId:     session.ID,
 	})
 }
 
+// ListVMConsoleSessions handles GET /vms/{vm_id}/console/sessions.
+// platform:admin sees all active sessions on the VM; other callers only their own.
+func (s *Server) ListVMConsoleSessions(c *gin.Context, vmId generated.VMID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "vnc:access")
+	if !ok {
+		return
+	}
+
+	exists, err := s.client.VM.Query().Where(entvm.IDEQ(vmId)).Exist(ctx)
+	if err != nil {
+		logger.Error("failed to check VM for console session list", zap.Error(err), zap.String("vm_id", vmId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if !exists {
+		c.JSON(http.StatusNotFound, generated.Error{Code: "VM_NOT_FOUND"})
+		return
+	}
+
+	query := s.client.VMConsoleSession.Query().
+		Where(
+			vmconsolesession.VMIDEQ(vmId),
+			vmconsolesession.RevokedAtIsNil(),
+			vmconsolesession.ExpiresAtGT(time.Now().UTC()),
+		)
+	if !hasPlatformAdmin(c) {
+		query = query.Where(vmconsolesession.UserIDEQ(actor))
+	}
+	sessions, err := query.Order(ent.Desc(vmconsolesession.FieldCreatedAt)).All(ctx)
+	if err != nil {
+		logger.Error("failed to list console sessions", zap.Error(err), zap.String("vm_id", vmId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	items := make([]generated.VMConsoleSession, 0, len(sessions))
+	for _, session := range sessions {
+		items = append(items, generated.VMConsoleSession{
+			SessionId: session.ID,
+			VmId:      session.VMID,
+			UserId:    session.UserID,
+			CreatedAt: session.CreatedAt,
+			ExpiresAt: session.ExpiresAt,
+		})
+	}
+	c.JSON(http.StatusOK, generated.VMConsoleSessionList{Items: items})
+}
+
+// RevokeVMConsoleSession handles DELETE /vms/{vm_id}/console/sessions/{session_id}.
+func (s *Server) RevokeVMConsoleSession(c *gin.Context, vmId generated.VMID, sessionId generated.ConsoleSessionID) {
+	ctx, actor, ok := requireActorWithAnyGlobalPermission(c, "vnc:access")
+	if !ok {
+		return
+	}
+
+	session, err := s.client.VMConsoleSession.Get(ctx, sessionId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "VNC_SESSION_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to get console session", zap.Error(err), zap.String("session_id", sessionId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if session.VMID != vmId {
+		c.JSON(http.StatusNotFound, generated.Error{Code: "VNC_SESSION_NOT_FOUND"})
+		return
+	}
+	if session.UserID != actor && !hasPlatformAdmin(c) {
+		c.JSON(http.StatusForbidden, generated.Error{Code: "FORBIDDEN"})
+		return
+	}
+	if session.RevokedAt != nil {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	if _, err := s.client.VMConsoleSession.UpdateOneID(session.ID).
+		SetRevokedAt(time.Now().UTC()).
+		SetRevokedBy(actor).
+		Save(ctx); err != nil {
+		logger.Error("failed to revoke console session", zap.Error(err), zap.String("session_id", sessionId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "vnc.session_revoked", "vm", vmId, actor, map[string]interface{}{
+			"session_id": session.ID,
+			"user_id":    session.UserID,
+		})
+	}
+	c.Status(http.StatusNoContent)
+}
+
 func (s *Server) resolveNamespaceEnvironment(ctx context.Context, namespace string) (namespaceregistry.Environment, error) {
 	ns, err := s.client.NamespaceRegistry.Query().
 		Where(namespaceregistry.NameEQ(strings.TrimSpace(namespace))).
@@ -380,7 +536,7 @@ func (s *Server) latestVNCRequest(ctx context.Context, vmID, requester string) (
 	return ticket, nil
 }
 
-func (s *Server) createVNCApprovalRequest(ctx context.Context, vm *ent.VM, actor string) (string, error) {
+func (s *Server) createVNCApprovalRequest(ctx context.Context, vm *ent.VM, actor string, window time.Duration) (string, error) {
 	tx, err := s.client.Tx(ctx)
 	if err != nil {
 		return "", err
@@ -397,10 +553,11 @@ func (s *Server) createVNCApprovalRequest(ctx context.Context, vm *ent.VM, actor
 	}
 
 	payload, err := json.Marshal(vncRequestPayload{
-		VMID:        vm.ID,
-		ClusterID:   vm.ClusterID,
-		Namespace:   vm.Namespace,
-		RequesterID: actor,
+		VMID:            vm.ID,
+		ClusterID:       vm.ClusterID,
+		Namespace:       vm.Namespace,
+		RequesterID:     actor,
+		DurationSeconds: int64(window / time.Second),
 	})
 	if err != nil {
 		return "", err
@@ -436,11 +593,23 @@ func (s *Server) createVNCApprovalRequest(ctx context.Context, vm *ent.VM, actor
 	return ticketID.String(), nil
 }
 
-func (s *Server) issueVNCURL(c *gin.Context, actor string, vm *ent.VM) (string, service.VNCTokenClaims, error) {
+// issueVNCURL issues a single-use VNC token and records it as a console
+// session (session ID = token ID) so it can be listed and revoked. ticketID
+// links the session to the approval that granted it, if any.
+func (s *Server) issueVNCURL(c *gin.Context, actor string, vm *ent.VM, ticketID string) (string, service.VNCTokenClaims, error) {
 	token, claims, err := s.vncTokens.Issue(actor, vm.ID, vm.ClusterID, vm.Namespace)
 	if err != nil {
 		return "", service.VNCTokenClaims{}, err
 	}
+	if _, err := s.client.VMConsoleSession.Create().
+		SetID(claims.JTI).
+		SetVMID(vm.ID).
+		SetUserID(actor).
+		SetExpiresAt(claims.ExpiresAt).
+		SetTicketID(ticketID).
+		Save(c.Request.Context()); err != nil {
+		return "", service.VNCTokenClaims{}, fmt.Errorf("record console session: %w", err)
+	}
 	s.setVNCBootstrapCookie(c, vm.ID, token)
 	return fmt.Sprintf("/api/v1/vms/%s/vnc", vm.ID), claims, nil
 }
@@ -492,6 +661,43 @@ func isSecureRequest(c *gin.Context) bool {
 	return strings.EqualFold(ssl, "on")
 }
 
+// vncAccessExpired reports whether an approved VNC_ACCESS ticket no longer
+// grants access: expiry already recorded, or its window has ended.
+func vncAccessExpired(ticket *ent.ApprovalTicket, now time.Time) bool {
+	if ticket.Status == approvalticket.StatusEXPIRED {
+		return true
+	}
+	return ticket.ExpiresAt != nil && !now.Before(*ticket.ExpiresAt)
+}
+
+// requireVNCSessionTicketActive writes 403 VNC_ACCESS_EXPIRED and returns
+// false when the VNC_ACCESS ticket that granted session has expired.
+// Sessions issued without approval always pass.
+func (s *Server) requireVNCSessionTicketActive(c *gin.Context, session *ent.VMConsoleSession) bool {
+	if session.TicketID == "" {
+		return true
+	}
+	ticket, err := s.client.ApprovalTicket.Get(c.Request.Context(), session.TicketID)
+	if err != nil && !ent.IsNotFound(err) {
+		logger.Error("failed to load vnc access ticket", zap.Error(err), zap.String("ticket_id", session.TicketID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return false
+	}
+	if ticket != nil && vncAccessExpired(ticket, time.Now().UTC()) {
+		writeVNCAccessExpired(c, ticket)
+		return false
+	}
+	return true
+}
+
+func writeVNCAccessExpired(c *gin.Context, ticket *ent.ApprovalTicket) {
+	c.JSON(http.StatusForbidden, generated.Error{
+		Code:    "VNC_ACCESS_EXPIRED",
+		Message: "approved console access has expired; request access again",
+		Params:  map[string]interface{}{"ticket_id": ticket.ID},
+	})
+}
+
 func writeVNCReject(c *gin.Context, code string) {
 	switch code {
 	case "FORBIDDEN":
@@ -15,6 +15,7 @@ import (
 	"kv-shepherd.io/shepherd/ent/domainevent"
 	"kv-shepherd.io/shepherd/ent/namespaceregistry"
 	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/api/generated"
 	"kv-shepherd.io/shepherd/internal/api/middleware"
 	"kv-shepherd.io/shepherd/internal/domain"
 	"kv-shepherd.io/shepherd/internal/pkg/logger"
@@ -198,6 +199,94 @@ func TestVMConsole_Status_ProductionPendingAndApproved(t *testing.T) {
 	_ = mustGetBootstrapCookie(t, approvedW, vm.ID)
 }
 
+func TestVMConsole_Request_ClampsAccessDuration(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newVMConsoleBehaviorTestServer(t)
+	srv.vncMaxAccessDuration = time.Hour
+
+	for _, tc := range []struct {
+		body        string
+		wantSeconds int64
+	}{
+		{body: "", wantSeconds: 3600},
+		{body: `{"duration_minutes":30}`, wantSeconds: 1800},
+		{body: `{"duration_minutes":600}`, wantSeconds: 3600},
+	} {
+		vm := mustCreateVMConsoleTarget(t, client, "actor-1", namespaceregistry.EnvironmentProd, entvm.StatusRUNNING)
+		c, w := newAuthedGinContext(t, http.MethodPost, fmt.Sprintf("/vms/%s/console/request", vm.ID), tc.body, "actor-1", []string{"vnc:access"})
+		srv.RequestVMConsoleAccess(c, vm.ID)
+		if w.Code != http.StatusAccepted {
+			t.Fatalf("request %q status = %d, want %d body=%s", tc.body, w.Code, http.StatusAccepted, w.Body.String())
+		}
+
+		ticketID := toStringValue(decodeJSONMap(t, w.Body.Bytes())["ticket_id"])
+		event, err := client.DomainEvent.Get(t.Context(), mustTicketEventID(t, client, ticketID))
+		if err != nil {
+			t.Fatalf("get domain event: %v", err)
+		}
+		var payload vncRequestPayload
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			t.Fatalf("decode payload: %v", err)
+		}
+		if payload.DurationSeconds != tc.wantSeconds {
+			t.Fatalf("request %q duration_seconds = %d, want %d", tc.body, payload.DurationSeconds, tc.wantSeconds)
+		}
+	}
+
+	vm := mustCreateVMConsoleTarget(t, client, "actor-1", namespaceregistry.EnvironmentProd, entvm.StatusRUNNING)
+	c, w := newAuthedGinContext(t, http.MethodPost, fmt.Sprintf("/vms/%s/console/request", vm.ID), `{"duration_minutes":0}`, "actor-1", []string{"vnc:access"})
+	srv.RequestVMConsoleAccess(c, vm.ID)
+	assertStatusAndCode(t, w, http.StatusBadRequest, "INVALID_REQUEST")
+}
+
+func TestVMConsole_ExpiredAccessIsRejected(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newVMConsoleBehaviorTestServer(t)
+	vm := mustCreateVMConsoleTarget(t, client, "actor-1", namespaceregistry.EnvironmentProd, entvm.StatusRUNNING)
+	ticketID := mustSeedPendingVNCRequest(t, client, vm.ID, vm.ClusterID, vm.Namespace, "actor-1")
+	client.ApprovalTicket.UpdateOneID(ticketID).
+		SetStatus(approvalticket.StatusAPPROVED).
+		SetApprover("admin-1").
+		SetExpiresAt(time.Now().UTC().Add(30 * time.Minute)).
+		ExecX(t.Context())
+
+	statusPath := fmt.Sprintf("/vms/%s/console/status", vm.ID)
+	c, w := newAuthedGinContext(t, http.MethodGet, statusPath, "", "actor-1", []string{"vnc:access"})
+	srv.GetVMConsoleStatus(c, vm.ID)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d body=%s", w.Code, http.StatusOK, w.Body.String())
+	}
+	var status generated.VMConsoleStatusResponse
+	mustDecodeJSON(t, w.Body.Bytes(), &status)
+	if status.ExpiresAt == nil || status.RemainingSeconds == nil || *status.RemainingSeconds <= 25*60 || *status.RemainingSeconds > 30*60 {
+		t.Fatalf("expires_at = %v remaining_seconds = %v, want about 30 minutes left", status.ExpiresAt, status.RemainingSeconds)
+	}
+	bootstrapCookie := mustGetBootstrapCookie(t, w, vm.ID)
+
+	// The window ends before the issued credential is used.
+	client.ApprovalTicket.UpdateOneID(ticketID).
+		SetExpiresAt(time.Now().UTC().Add(-time.Second)).
+		ExecX(t.Context())
+
+	c, w = newAuthedGinContext(t, http.MethodGet, fmt.Sprintf("/vms/%s/vnc", vm.ID), "", "actor-1", []string{"vnc:access"})
+	c.Request.AddCookie(&http.Cookie{Name: vncBootstrapCookieName, Value: bootstrapCookie.Value})
+	srv.OpenVMVNC(c, vm.ID)
+	assertStatusAndCode(t, w, http.StatusForbidden, "VNC_ACCESS_EXPIRED")
+
+	c, w = newAuthedGinContext(t, http.MethodGet, statusPath, "", "actor-1", []string{"vnc:access"})
+	srv.GetVMConsoleStatus(c, vm.ID)
+	assertStatusAndCode(t, w, http.StatusForbidden, "VNC_ACCESS_EXPIRED")
+
+	client.ApprovalTicket.UpdateOneID(ticketID).
+		SetStatus(approvalticket.StatusEXPIRED).
+		ExecX(t.Context())
+	c, w = newAuthedGinContext(t, http.MethodGet, statusPath, "", "actor-1", []string{"vnc:access"})
+	srv.GetVMConsoleStatus(c, vm.ID)
+	assertStatusAndCode(t, w, http.StatusForbidden, "VNC_ACCESS_EXPIRED")
+}
+
 func TestVMConsole_OpenVNC_RejectsTokenReplay(t *testing.T) {
 	t.Parallel()
 
@@ -249,6 +338,76 @@ func TestVMConsole_OpenVNC_RejectsTokenReplay(t *testing.T) {
 	assertErrorCode(t, openW2.Body.Bytes(), "VNC_TOKEN_REPLAYED")
 }
 
+func TestVMConsole_SessionsListAndRevoke(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newVMConsoleBehaviorTestServer(t)
+	vm := mustCreateVMConsoleTarget(t, client, "actor-1", namespaceregistry.EnvironmentTest, entvm.StatusRUNNING)
+	vncPerms := []string{"vnc:access"}
+
+	reqCtx, reqW := newAuthedGinContext(t, http.MethodPost, fmt.Sprintf("/vms/%s/console/request", vm.ID), "", "actor-1", vncPerms)
+	srv.RequestVMConsoleAccess(reqCtx, vm.ID)
+	if reqW.Code != http.StatusOK {
+		t.Fatalf("request status = %d, want %d body=%s", reqW.Code, http.StatusOK, reqW.Body.String())
+	}
+	sessionID := toStringValue(decodeJSONMap(t, reqW.Body.Bytes())["session_id"])
+	if sessionID == "" {
+		t.Fatal("session_id is empty")
+	}
+	bootstrapCookie := mustGetBootstrapCookie(t, reqW, vm.ID)
+
+	listSessions := func(actor string, perms []string) []generated.VMConsoleSession {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodGet, fmt.Sprintf("/vms/%s/console/sessions", vm.ID), "", actor, perms)
+		srv.ListVMConsoleSessions(c, vm.ID)
+		if w.Code != http.StatusOK {
+			t.Fatalf("list status = %d, want %d body=%s", w.Code, http.StatusOK, w.Body.String())
+		}
+		var resp generated.VMConsoleSessionList
+		mustDecodeJSON(t, w.Body.Bytes(), &resp)
+		return resp.Items
+	}
+	revoke := func(actor string, perms []string, id string) *httptest.ResponseRecorder {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodDelete, fmt.Sprintf("/vms/%s/console/sessions/%s", vm.ID, id), "", actor, perms)
+		srv.RevokeVMConsoleSession(c, vm.ID, id)
+		return w
+	}
+
+	if items := listSessions("actor-1", vncPerms); len(items) != 1 || items[0].SessionId != sessionID || items[0].UserId != "actor-1" {
+		t.Fatalf("owner sessions = %+v, want [%s]", items, sessionID)
+	}
+	if items := listSessions("actor-2", vncPerms); len(items) != 0 {
+		t.Fatalf("other user sessions = %+v, want none", items)
+	}
+	if items := listSessions("security-1", []string{"platform:admin"}); len(items) != 1 {
+		t.Fatalf("admin sessions = %+v, want 1", items)
+	}
+
+	if w := revoke("actor-2", vncPerms, sessionID); w.Code != http.StatusForbidden {
+		t.Fatalf("non-owner revoke status = %d, want %d body=%s", w.Code, http.StatusForbidden, w.Body.String())
+	}
+	if w := revoke("actor-1", vncPerms, "missing"); w.Code != http.StatusNotFound {
+		t.Fatalf("missing revoke status = %d, want %d body=%s", w.Code, http.StatusNotFound, w.Body.String())
+	}
+	for range 2 { // revoking twice is a no-op
+		if w := revoke("actor-1", vncPerms, sessionID); w.Code != http.StatusNoContent {
+			t.Fatalf("owner revoke status = %d, want %d body=%s", w.Code, http.StatusNoContent, w.Body.String())
+		}
+	}
+	if items := listSessions("actor-1", vncPerms); len(items) != 0 {
+		t.Fatalf("sessions after revoke = %+v, want none", items)
+	}
+
+	openCtx, openW := newAuthedGinContext(t, http.MethodGet, fmt.Sprintf("/vms/%s/vnc", vm.ID), "", "actor-1", vncPerms)
+	openCtx.Request.AddCookie(&http.Cookie{Name: vncBootstrapCookieName, Value: bootstrapCookie.Value})
+	srv.OpenVMVNC(openCtx, vm.ID)
+	if openW.Code != http.StatusUnauthorized {
+		t.Fatalf("open revoked status = %d, want %d body=%s", openW.Code, http.StatusUnauthorized, openW.Body.String())
+	}
+	assertErrorCode(t, openW.Body.Bytes(), "VNC_SESSION_REVOKED")
+}
+
 func newVMConsoleBehaviorTestServer(t *testing.T) (*Server, *ent.Client) {
 	t.Helper()
 	_ = logger.Init("error", "json")
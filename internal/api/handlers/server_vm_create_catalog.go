@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/namespaceregistry"
+	entservice "kv-shepherd.io/shepherd/ent/service"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
+)
+
+// validateVMCreateCatalog checks at submission that the service, template,
+// instance size and namespace a VM create request references exist and are
+// enabled, so a bad reference fails the requester rather than the approver.
+// A non-nil *generated.Error is a rejection to report as 400. Approval
+// re-validates, since the catalog can change while a ticket is pending.
+func (s *Server) validateVMCreateCatalog(ctx context.Context, serviceID, templateID, instanceSizeID, namespace string) (*generated.Error, error) {
+	serviceExists, err := s.client.Service.Query().Where(entservice.IDEQ(serviceID)).Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !serviceExists {
+		return &generated.Error{
+			Code:    apperrors.CodeServiceNotFound,
+			Message: "service does not exist",
+			Params:  map[string]interface{}{"service_id": serviceID},
+		}, nil
+	}
+
+	tmpl, err := s.client.Template.Get(ctx, templateID)
+	switch {
+	case ent.IsNotFound(err):
+		return &generated.Error{
+			Code:    apperrors.CodeTemplateNotFound,
+			Message: "template does not exist",
+			Params:  map[string]interface{}{"template_id": templateID},
+		}, nil
+	case err != nil:
+		return nil, err
+	case !tmpl.Enabled:
+		return &generated.Error{
+			Code:    apperrors.CodeTemplateDisabled,
+			Message: "template is disabled",
+			Params:  map[string]interface{}{"template_id": templateID},
+		}, nil
+	}
+
+	size, err := s.client.InstanceSize.Get(ctx, instanceSizeID)
+	switch {
+	case ent.IsNotFound(err):
+		return &generated.Error{
+			Code:    apperrors.CodeInstanceSizeNotFound,
+			Message: "instance size does not exist",
+			Params:  map[string]interface{}{"instance_size_id": instanceSizeID},
+		}, nil
+	case err != nil:
+		return nil, err
+	case !size.Enabled:
+		return &generated.Error{
+			Code:    apperrors.CodeInstanceSizeDisabled,
+			Message: "instance size is disabled",
+			Params:  map[string]interface{}{"instance_size_id": instanceSizeID},
+		}, nil
+	}
+
+	ns, err := s.client.NamespaceRegistry.Query().Where(namespaceregistry.NameEQ(namespace)).Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		return &generated.Error{
+			Code:    apperrors.CodeNamespaceNotRegistered,
+			Message: "namespace is not registered",
+			Params:  map[string]interface{}{"namespace": namespace},
+		}, nil
+	case err != nil:
+		return nil, err
+	case !ns.Enabled:
+		return &generated.Error{
+			Code:    apperrors.CodeNamespaceDisabled,
+			Message: "namespace is disabled",
+			Params:  map[string]interface{}{"namespace": namespace},
+		}, nil
+	}
+	return nil, nil
+}
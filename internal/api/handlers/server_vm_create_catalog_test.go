@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/namespaceregistry"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/service"
+	"kv-shepherd.io/shepherd/internal/testutil"
+	"kv-shepherd.io/shepherd/internal/usecase"
+)
+
+func TestCreateVMRequest_ValidatesCatalogReferences(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "vm_create_catalog")
+
+	serviceID, templateID, sizeID := mustCreateBatchCreatePrerequisites(t, client, "alice", "dev-shop")
+	client.Template.UpdateOneID(templateID.String()).
+		SetSpec(map[string]interface{}{"image": "quay.io/kubevirt/fedora:40"}).
+		ExecX(t.Context())
+	disabledTemplate := uuid.New()
+	client.Template.Create().
+		SetID(disabledTemplate.String()).
+		SetName("retired").
+		SetVersion(1).
+		SetEnabled(false).
+		SetCreatedBy("admin-1").
+		SaveX(t.Context())
+	disabledSize := uuid.New()
+	client.InstanceSize.Create().
+		SetID(disabledSize.String()).
+		SetName("huge").
+		SetCPUCores(64).
+		SetMemoryMB(262144).
+		SetEnabled(false).
+		SetCreatedBy("admin-1").
+		SaveX(t.Context())
+	mustRegisterNamespace(t, client, "dev-frozen", false)
+
+	srv := NewServer(ServerDeps{
+		EntClient: client,
+		CreateVMUC: usecase.NewCreateVMUseCase(
+			client,
+			service.NewVMService(nil),
+			service.NewInstanceSizeService(client),
+			service.NewTemplateService(client),
+		),
+	})
+	valid := generated.VMCreateRequest{
+		ServiceId:      serviceID,
+		TemplateId:     templateID,
+		InstanceSizeId: sizeID,
+		Namespace:      "dev-shop",
+		Reason:         "capacity",
+	}
+	tests := []struct {
+		name   string
+		mutate func(*generated.VMCreateRequest)
+		code   string
+		param  string
+	}{
+		{"missing service", func(r *generated.VMCreateRequest) { r.ServiceId = uuid.New() }, "SERVICE_NOT_FOUND", "service_id"},
+		{"missing template", func(r *generated.VMCreateRequest) { r.TemplateId = uuid.New() }, "TEMPLATE_NOT_FOUND", "template_id"},
+		{"disabled template", func(r *generated.VMCreateRequest) { r.TemplateId = disabledTemplate }, "TEMPLATE_DISABLED", "template_id"},
+		{"missing instance size", func(r *generated.VMCreateRequest) { r.InstanceSizeId = uuid.New() }, "INSTANCE_SIZE_NOT_FOUND", "instance_size_id"},
+		{"disabled instance size", func(r *generated.VMCreateRequest) { r.InstanceSizeId = disabledSize }, "INSTANCE_SIZE_DISABLED", "instance_size_id"},
+		{"unregistered namespace", func(r *generated.VMCreateRequest) { r.Namespace = "dev-unknown" }, "NAMESPACE_NOT_REGISTERED", "namespace"},
+		{"disabled namespace", func(r *generated.VMCreateRequest) { r.Namespace = "dev-frozen" }, "NAMESPACE_DISABLED", "namespace"},
+	}
+	for _, tc := range tests {
+		body := valid
+		tc.mutate(&body)
+		c, w := newAuthedGinContext(t, http.MethodPost, "/vms/request", mustJSON(t, body), "alice", []string{"vm:create", "platform:admin"})
+		srv.CreateVMRequest(c)
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("%s: status = %d, want %d body=%s", tc.name, w.Code, http.StatusBadRequest, w.Body.String())
+		}
+		var apiErr generated.Error
+		mustDecodeJSON(t, w.Body.Bytes(), &apiErr)
+		if apiErr.Code != tc.code {
+			t.Fatalf("%s: code = %q, want %q", tc.name, apiErr.Code, tc.code)
+		}
+		if _, ok := apiErr.Params[tc.param]; !ok {
+			t.Fatalf("%s: params = %v, want %q", tc.name, apiErr.Params, tc.param)
+		}
+	}
+	if n := client.ApprovalTicket.Query().CountX(t.Context()); n != 0 {
+		t.Fatalf("tickets after rejected submissions = %d, want 0", n)
+	}
+
+	c, w := newAuthedGinContext(t, http.MethodPost, "/vms/request", mustJSON(t, valid), "alice", []string{"vm:create", "platform:admin"})
+	srv.CreateVMRequest(c)
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("valid request status = %d, want %d body=%s", w.Code, http.StatusAccepted, w.Body.String())
+	}
+}
+
+func TestSubmitVMBatch_CreateReportsInvalidCatalogItem(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newBatchBehaviorTestServer(t)
+	serviceID, templateID, sizeID := mustCreateBatchCreatePrerequisites(t, client, "owner-1", "prod-shop")
+	disabledSize := uuid.New()
+	client.InstanceSize.Create().
+		SetID(disabledSize.String()).
+		SetName("huge").
+		SetCPUCores(64).
+		SetMemoryMB(262144).
+		SetEnabled(false).
+		SetCreatedBy("admin-1").
+		SaveX(t.Context())
+
+	item := func(size openapi_types.UUID, namespace string) generated.VMBatchChildItem {
+		return generated.VMBatchChildItem{
+			ServiceId:      serviceID,
+			TemplateId:     templateID,
+			InstanceSizeId: size,
+			Namespace:      namespace,
+		}
+	}
+	tests := []struct {
+		name  string
+		items []generated.VMBatchChildItem
+		code  string
+		item  float64
+	}{
+		{"disabled size", []generated.VMBatchChildItem{item(sizeID, "prod-shop"), item(disabledSize, "prod-shop")}, "INSTANCE_SIZE_DISABLED", 2},
+		{"unregistered namespace", []generated.VMBatchChildItem{item(sizeID, "prod-unknown"), item(sizeID, "prod-shop")}, "NAMESPACE_NOT_REGISTERED", 1},
+	}
+	for _, tc := range tests {
+		body := mustJSON(t, generated.VMBatchSubmitRequest{Operation: generated.VMBatchOperationCREATE, Items: tc.items})
+		c, w := newAuthedGinContext(t, http.MethodPost, "/vms/batch", body, "owner-1", []string{"platform:admin"})
+		srv.SubmitVMBatch(c)
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("%s: status = %d, want %d body=%s", tc.name, w.Code, http.StatusBadRequest, w.Body.String())
+		}
+		var apiErr generated.Error
+		mustDecodeJSON(t, w.Body.Bytes(), &apiErr)
+		if apiErr.Code != tc.code || apiErr.Params["item"] != tc.item {
+			t.Fatalf("%s: error = %+v, want %s for item %v", tc.name, apiErr, tc.code, tc.item)
+		}
+		if !strings.HasPrefix(apiErr.Message, "create item #") {
+			t.Fatalf("%s: message = %q, want the item index", tc.name, apiErr.Message)
+		}
+	}
+	if n := client.BatchApprovalTicket.Query().CountX(t.Context()); n != 0 {
+		t.Fatalf("batches after rejected submissions = %d, want 0", n)
+	}
+}
+
+func mustRegisterNamespace(t *testing.T, client *ent.Client, name string, enabled bool) {
+	t.Helper()
+	client.NamespaceRegistry.Create().
+		SetID("ns-" + uuid.NewString()).
+		SetName(name).
+		SetEnvironment(namespaceregistry.EnvironmentTest).
+		SetEnabled(enabled).
+		SetCreatedBy("admin-1").
+		SaveX(t.Context())
+}
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/ent/predicate"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// ListVMEvents handles GET /vms/{vm_id}/events.
+func (s *Server) ListVMEvents(c *gin.Context, vmId generated.VMID, params generated.ListVMEventsParams) {
+	vm, ok := s.getReadableVM(c, vmId)
+	if !ok {
+		return
+	}
+	ctx := c.Request.Context()
+
+	page, perPage := defaultPagination(params.Page, params.PerPage)
+	query := s.client.DomainEvent.Query().
+		Where(s.vmEventPredicate(ctx, vm))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		logger.Error("failed to count VM events", zap.Error(err), zap.String("vm_id", vmId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	events, err := query.
+		Order(ent.Desc(domainevent.FieldCreatedAt), ent.Desc(domainevent.FieldID)).
+		Offset((page - 1) * perPage).
+		Limit(perPage).
+		All(ctx)
+	if err != nil {
+		logger.Error("failed to list VM events", zap.Error(err), zap.String("vm_id", vmId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	batchIDs, err := s.batchIDsByEvent(ctx, events)
+	if err != nil {
+		logger.Error("failed to resolve VM event batches", zap.Error(err), zap.String("vm_id", vmId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	items := make([]generated.VMEvent, 0, len(events))
+	for _, event := range events {
+		items = append(items, generated.VMEvent{
+			Id:        event.ID,
+			EventType: event.EventType,
+			Status:    event.Status.String(),
+			CreatedBy: event.CreatedBy,
+			CreatedAt: event.CreatedAt,
+			Payload:   vmEventPayloadSummary(event.Payload),
+			BatchId:   batchIDs[event.ID],
+		})
+	}
+	c.JSON(http.StatusOK, generated.VMEventList{
+		Items: items,
+		Pagination: generated.Pagination{
+			Page:       page,
+			PerPage:    perPage,
+			Total:      total,
+			TotalPages: (total + perPage - 1) / perPage,
+		},
+	})
+}
+
+// vmEventPredicate matches events whose aggregate is the VM, whose payload
+// names it in vm_id, and the creation event behind the VM's ticket (creation
+// events are aggregated on the service, before the VM exists).
+func (s *Server) vmEventPredicate(ctx context.Context, vm *ent.VM) predicate.DomainEvent {
+	preds := []predicate.DomainEvent{
+		domainevent.And(
+			domainevent.AggregateTypeEQ("vm"),
+			domainevent.AggregateIDEQ(vm.ID),
+		),
+		func(sel *sql.Selector) {
+			sel.Where(sql.P(func(b *sql.Builder) {
+				b.WriteString("convert_from(").Ident(sel.C(domainevent.FieldPayload)).WriteString(", 'UTF8')::jsonb ->> 'vm_id' = ")
+				b.Arg(vm.ID)
+			}))
+		},
+	}
+	if vm.TicketID != "" {
+		if ticket, err := s.client.ApprovalTicket.Get(ctx, vm.TicketID); err == nil {
+			preds = append(preds, domainevent.IDEQ(ticket.EventID))
+		} else if !ent.IsNotFound(err) {
+			logger.Warn("failed to resolve VM creation event", zap.Error(err), zap.String("vm_id", vm.ID))
+		}
+	}
+	return domainevent.Or(preds...)
+}
+
+// batchIDsByEvent maps events created as batch children to their batch
+// (the parent ticket ID, which is also the batch ID).
+func (s *Server) batchIDsByEvent(ctx context.Context, events []*ent.DomainEvent) (map[string]string, error) {
+	if len(events) == 0 {
+		return nil, nil
+	}
+	eventIDs := make([]string, 0, len(events))
+	for _, event := range events {
+		eventIDs = append(eventIDs, event.ID)
+	}
+	tickets, err := s.client.ApprovalTicket.Query().
+		Where(
+			approvalticket.EventIDIn(eventIDs...),
+			approvalticket.ParentTicketIDNotNil(),
+			approvalticket.ParentTicketIDNEQ(""),
+		).
+		Select(approvalticket.FieldEventID, approvalticket.FieldParentTicketID).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	batchIDs := make(map[string]string, len(tickets))
+	for _, ticket := range tickets {
+		batchIDs[ticket.EventID] = ticket.ParentTicketID
+	}
+	return batchIDs, nil
+}
+
+// vmEventPayloadSummary decodes a claim-check payload with sensitive fields
+// redacted. Payloads that are not JSON objects yield an empty summary.
+func vmEventPayloadSummary(raw []byte) map[string]interface{} {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw, &fields); err != nil || fields == nil {
+		return map[string]interface{}{}
+	}
+	redacted, _ := audit.Redact(fields).(map[string]interface{})
+	return redacted
+}
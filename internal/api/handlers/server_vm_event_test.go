@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestListVMEvents(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "vm_events")
+	srv := NewServer(ServerDeps{EntClient: client})
+	seedVMListFixture(t, client)
+	ctx := t.Context()
+
+	base := time.Now().UTC().Add(-time.Hour)
+	for i, e := range []struct {
+		id, eventType, aggType, aggID, payload string
+	}{
+		{"ev-create", "VM_CREATION_REQUESTED", "service", "svc-redis", `{"service_id":"svc-redis"}`},
+		{"ev-start", "VM_START_REQUESTED", "vm", "vm-a", `{"vm_id":"vm-a"}`},
+		{"ev-other", "VM_STOP_REQUESTED", "vm", "vm-b", `{"vm_id":"vm-b"}`},
+		{"ev-vnc", "VNC_ACCESS_REQUESTED", "ticket", "t-vnc", `{"vm_id":"vm-a","token":"s3cret"}`},
+		{"ev-delete", "VM_DELETION_REQUESTED", "vm", "vm-a", `{"vm_id":"vm-a","reason":"cleanup"}`},
+	} {
+		client.DomainEvent.Create().
+			SetID(e.id).
+			SetEventType(e.eventType).
+			SetAggregateType(e.aggType).
+			SetAggregateID(e.aggID).
+			SetPayload([]byte(e.payload)).
+			SetCreatedBy("alice").
+			SetCreatedAt(base.Add(time.Duration(i) * time.Minute)).
+			SaveX(ctx)
+	}
+	client.ApprovalTicket.Create().
+		SetID("ticket-batch-child").
+		SetEventID("ev-create").
+		SetRequester("alice").
+		SetParentTicketID("batch-1").
+		SaveX(ctx)
+	client.VM.UpdateOneID("vm-a").SetTicketID("ticket-batch-child").ExecX(ctx)
+
+	list := func(page, perPage int) generated.VMEventList {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodGet, "/vms/vm-a/events", "", "admin-1", []string{"platform:admin"})
+		srv.ListVMEvents(c, "vm-a", generated.ListVMEventsParams{Page: page, PerPage: perPage})
+		if w.Code != http.StatusOK {
+			t.Fatalf("ListVMEvents status = %d, want %d body=%s", w.Code, http.StatusOK, w.Body.String())
+		}
+		var resp generated.VMEventList
+		mustDecodeJSON(t, w.Body.Bytes(), &resp)
+		return resp
+	}
+
+	var ids []string
+	first := list(1, 3)
+	second := list(2, 3)
+	for _, item := range append(first.Items, second.Items...) {
+		ids = append(ids, item.Id)
+	}
+	if want := []string{"ev-delete", "ev-vnc", "ev-start", "ev-create"}; !slices.Equal(ids, want) {
+		t.Fatalf("event ids = %v, want %v", ids, want)
+	}
+	if first.Pagination.Total != 4 || first.Pagination.TotalPages != 2 || len(second.Items) != 1 {
+		t.Fatalf("pagination = %+v (second page %d items), want total 4 over 2 pages", first.Pagination, len(second.Items))
+	}
+
+	vnc := first.Items[1]
+	if vnc.Payload["token"] != "[REDACTED]" || vnc.Payload["vm_id"] != "vm-a" {
+		t.Fatalf("vnc payload = %v, want token redacted and vm_id kept", vnc.Payload)
+	}
+	if vnc.Status != "PENDING" || vnc.CreatedBy != "alice" || vnc.EventType != "VNC_ACCESS_REQUESTED" {
+		t.Fatalf("vnc event = %+v", vnc)
+	}
+	if created := second.Items[0]; created.BatchId != "batch-1" {
+		t.Fatalf("creation event batch_id = %q, want batch-1", created.BatchId)
+	}
+
+	c, w := newAuthedGinContext(t, http.MethodGet, "/vms/vm-x/events", "", "admin-1", []string{"platform:admin"})
+	srv.ListVMEvents(c, "vm-x", generated.ListVMEventsParams{})
+	assertStatusAndCode(t, w, http.StatusNotFound, "VM_NOT_FOUND")
+
+	c, w = newAuthedGinContext(t, http.MethodGet, "/vms/vm-a/events", "", "user-1", []string{"system:read"})
+	srv.ListVMEvents(c, "vm-a", generated.ListVMEventsParams{})
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("ListVMEvents without vm:read status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+}
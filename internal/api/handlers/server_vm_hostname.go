@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/api/middleware"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/jobs"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// UpdateVMHostname handles PATCH /vms/{vm_id}/hostname.
+func (s *Server) UpdateVMHostname(c *gin.Context, vmId generated.VMID) {
+	vm, ok := s.getOperableVM(c, vmId, "hostname update")
+	if !ok {
+		return
+	}
+
+	var req generated.UpdateVMHostnameRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST", Message: err.Error()})
+		return
+	}
+	if err := domain.ValidateHostname(req.Hostname); err != nil {
+		c.JSON(http.StatusBadRequest, generated.Error{
+			Code:    "INVALID_HOSTNAME",
+			Message: err.Error(),
+			Params:  map[string]interface{}{"field": "hostname"},
+		})
+		return
+	}
+
+	// The annotation lives on the KubeVirt VM, so it must have been provisioned.
+	switch vm.Status {
+	case entvm.StatusCREATING, entvm.StatusDELETING, entvm.StatusFAILED:
+		c.JSON(http.StatusConflict, generated.Error{
+			Code:    "INVALID_STATE_TRANSITION",
+			Message: fmt.Sprintf("cannot update hostname of VM in %s state", vm.Status),
+		})
+		return
+	}
+	if vm.ClusterID == "" {
+		c.JSON(http.StatusConflict, generated.Error{
+			Code:    "INVALID_STATE_TRANSITION",
+			Message: "cannot update hostname of a VM not placed on a cluster",
+		})
+		return
+	}
+
+	ctx := c.Request.Context()
+	actor := middleware.GetUserID(ctx)
+	payload, err := domain.VMHostnameUpdatePayload{
+		VMID:             vm.ID,
+		VMName:           vm.Name,
+		ClusterID:        vm.ClusterID,
+		Namespace:        vm.Namespace,
+		Hostname:         req.Hostname,
+		PreviousHostname: vm.Hostname,
+		Actor:            actor,
+	}.ToJSON()
+	if err != nil {
+		logger.Error("failed to marshal hostname payload", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	eventID, _ := uuid.NewV7()
+	if _, err := s.client.DomainEvent.Create().
+		SetID(eventID.String()).
+		SetEventType(string(domain.EventVMHostnameUpdateRequested)).
+		SetAggregateType("vm").
+		SetAggregateID(vm.ID).
+		SetPayload(payload).
+		SetStatus(domainevent.StatusPENDING).
+		SetCreatedBy(actor).
+		Save(ctx); err != nil {
+		logger.Error("failed to create hostname domain event", zap.Error(err), zap.String("vm_id", vm.ID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	// Enqueue River job (ADR-0006).
+	if s.riverClient == nil {
+		err = fmt.Errorf("river client is not configured")
+	} else {
+		_, err = s.riverClient.Insert(ctx, jobs.VMHostnameUpdateArgs{EventID: eventID.String()}, nil)
+	}
+	if err != nil {
+		logger.Error("failed to enqueue VM hostname job", zap.Error(err), zap.String("event_id", eventID.String()))
+		_, _ = s.client.DomainEvent.UpdateOneID(eventID.String()).SetStatus(domainevent.StatusFAILED).Save(ctx)
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+
+	if s.audit != nil {
+		_ = s.audit.LogAction(ctx, "vm.hostname_update_requested", "vm", vm.ID, actor, map[string]interface{}{
+			"hostname":          req.Hostname,
+			"previous_hostname": vm.Hostname,
+		})
+	}
+
+	c.JSON(http.StatusAccepted, generated.VMHostnameUpdateResponse{
+		EventId: eventID.String(),
+		Status:  "ACCEPTED",
+	})
+}
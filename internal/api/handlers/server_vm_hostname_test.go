@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+)
+
+func TestUpdateVMHostname_Rejections(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newSystemBehaviorTestServer(t)
+	sys := mustCreateSystem(t, client, "sys-hostname", "shop", "owner-1")
+	svc := mustCreateService(t, client, "svc-hostname", "redis", sys.ID, "cache")
+	running := mustCreateVMForService(t, client, "vm-hostname-1", "ns-test-shop-redis-01", svc.ID)
+	running.Update().SetClusterID("cluster-a").ExecX(t.Context())
+	creating := mustCreateVMForService(t, client, "vm-hostname-2", "ns-test-shop-redis-02", svc.ID)
+	creating.Update().SetClusterID("cluster-a").SetStatus(entvm.StatusCREATING).ExecX(t.Context())
+	unplaced := mustCreateVMForService(t, client, "vm-hostname-3", "ns-test-shop-redis-03", svc.ID)
+	operate := []string{"vm:operate"}
+
+	tests := []struct {
+		name   string
+		vmID   string
+		body   string
+		perms  []string
+		status int
+		code   string
+	}{
+		{name: "invalid hostname", vmID: running.ID, body: `{"hostname":"redis_01"}`, perms: operate, status: http.StatusBadRequest, code: "INVALID_HOSTNAME"},
+		{name: "too long", vmID: running.ID, body: `{"hostname":"` + strings.Repeat("a", 254) + `"}`, perms: operate, status: http.StatusBadRequest, code: "INVALID_HOSTNAME"},
+		{name: "missing hostname", vmID: running.ID, body: `{}`, perms: operate, status: http.StatusBadRequest, code: "INVALID_HOSTNAME"},
+		{name: "without vm:operate", vmID: running.ID, body: `{"hostname":"redis-01"}`, perms: []string{"vm:read"}, status: http.StatusForbidden, code: "FORBIDDEN"},
+		{name: "unknown vm", vmID: "vm-missing", body: `{"hostname":"redis-01"}`, perms: operate, status: http.StatusNotFound, code: "VM_NOT_FOUND"},
+		{name: "still creating", vmID: creating.ID, body: `{"hostname":"redis-02"}`, perms: operate, status: http.StatusConflict, code: "INVALID_STATE_TRANSITION"},
+		{name: "not placed on a cluster", vmID: unplaced.ID, body: `{"hostname":"redis-03"}`, perms: operate, status: http.StatusConflict, code: "INVALID_STATE_TRANSITION"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c, w := newAuthedGinContext(t, http.MethodPatch, "/vms/"+tc.vmID+"/hostname", tc.body, "owner-1", tc.perms)
+			srv.UpdateVMHostname(c, tc.vmID)
+			assertStatusAndCode(t, w, tc.status, tc.code)
+		})
+	}
+	if got := client.VM.GetX(t.Context(), running.ID).Hostname; got != "" {
+		t.Fatalf("hostname = %q, want unchanged until the job runs", got)
+	}
+}
@@ -0,0 +1,294 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/namespaceregistry"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/api/middleware"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+// seedVMListFixture creates two systems across prod/test namespaces:
+//
+//	vm-a  shop/redis  prod-shop  cluster-a  RUNNING  alice
+//	vm-b  shop/redis  prod-shop  cluster-b  STOPPED  alice
+//	vm-c  shop/web    prod-shop  cluster-a  FAILED   bob
+//	vm-d  crm/api     test-crm   cluster-t  RUNNING  bob
+func seedVMListFixture(t *testing.T, client *ent.Client) {
+	t.Helper()
+	ctx := t.Context()
+
+	for name, env := range map[string]namespaceregistry.Environment{
+		"prod-shop": namespaceregistry.EnvironmentProd,
+		"test-crm":  namespaceregistry.EnvironmentTest,
+	} {
+		client.NamespaceRegistry.Create().
+			SetID("ns-" + name).
+			SetName(name).
+			SetEnvironment(env).
+			SetCreatedBy("admin").
+			SaveX(ctx)
+	}
+	mustCreateSystem(t, client, "sys-shop", "shop", "alice")
+	mustCreateSystem(t, client, "sys-crm", "crm", "bob")
+	mustCreateService(t, client, "svc-redis", "redis", "sys-shop", "cache")
+	mustCreateService(t, client, "svc-web", "web", "sys-shop", "frontend")
+	mustCreateService(t, client, "svc-api", "api", "sys-crm", "backend")
+
+	base := time.Now().UTC().Add(-time.Hour)
+	for i, v := range []struct {
+		id, name, namespace, cluster, service, createdBy string
+		status                                           entvm.Status
+	}{
+		{"vm-a", "prod-shop-shop-redis-01", "prod-shop", "cluster-a", "svc-redis", "alice", entvm.StatusRUNNING},
+		{"vm-b", "prod-shop-shop-redis-02", "prod-shop", "cluster-b", "svc-redis", "alice", entvm.StatusSTOPPED},
+		{"vm-c", "prod-shop-shop-web-01", "prod-shop", "cluster-a", "svc-web", "bob", entvm.StatusFAILED},
+		{"vm-d", "test-crm-crm-api-01", "test-crm", "cluster-t", "svc-api", "bob", entvm.StatusRUNNING},
+	} {
+		client.VM.Create().
+			SetID(v.id).
+			SetName(v.name).
+			SetInstance("01").
+			SetNamespace(v.namespace).
+			SetClusterID(v.cluster).
+			SetServiceID(v.service).
+			SetStatus(v.status).
+			SetCreatedBy(v.createdBy).
+			SetCreatedAt(base.Add(time.Duration(i) * time.Minute)).
+			SaveX(ctx)
+	}
+}
+
+func listVMIDs(t *testing.T, srv *Server, userID string, perms []string, params generated.ListVMsParams) (generated.VMList, []string) {
+	t.Helper()
+
+	c, w := newAuthedGinContext(t, http.MethodGet, "/vms", "", userID, perms)
+	srv.ListVMs(c, params)
+	if w.Code != http.StatusOK {
+		t.Fatalf("ListVMs(%+v) status = %d, want %d body=%s", params, w.Code, http.StatusOK, w.Body.String())
+	}
+	var resp generated.VMList
+	mustDecodeJSON(t, w.Body.Bytes(), &resp)
+	ids := make([]string, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		ids = append(ids, item.Id)
+	}
+	return resp, ids
+}
+
+func TestListVMs_Filters(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "vm_list_filters")
+	seedVMListFixture(t, client)
+	srv := NewServer(ServerDeps{EntClient: client})
+	admin := []string{"platform:admin"}
+
+	tests := []struct {
+		name    string
+		params  generated.ListVMsParams
+		wantIDs []string
+	}{
+		{name: "default newest first", wantIDs: []string{"vm-d", "vm-c", "vm-b", "vm-a"}},
+		{
+			name:    "multi status",
+			params:  generated.ListVMsParams{Status: []generated.VMStatus{generated.VMStatusSTOPPED, generated.VMStatusFAILED}},
+			wantIDs: []string{"vm-c", "vm-b"},
+		},
+		{name: "cluster", params: generated.ListVMsParams{ClusterId: "cluster-a"}, wantIDs: []string{"vm-c", "vm-a"}},
+		{name: "service", params: generated.ListVMsParams{ServiceId: "svc-redis"}, wantIDs: []string{"vm-b", "vm-a"}},
+		{name: "system via service edge", params: generated.ListVMsParams{SystemId: "sys-shop"}, wantIDs: []string{"vm-c", "vm-b", "vm-a"}},
+		{name: "namespace", params: generated.ListVMsParams{Namespace: "test-crm"}, wantIDs: []string{"vm-d"}},
+		{name: "created_by", params: generated.ListVMsParams{CreatedBy: "bob"}, wantIDs: []string{"vm-d", "vm-c"}},
+		{name: "name prefix", params: generated.ListVMsParams{NamePrefix: "prod-shop-shop-redis"}, wantIDs: []string{"vm-b", "vm-a"}},
+		{
+			name:    "combined filters",
+			params:  generated.ListVMsParams{SystemId: "sys-shop", Status: []generated.VMStatus{generated.VMStatusRUNNING}},
+			wantIDs: []string{"vm-a"},
+		},
+		{
+			name:    "order by name",
+			params:  generated.ListVMsParams{OrderBy: generated.VMListOrderByName},
+			wantIDs: []string{"vm-a", "vm-b", "vm-c", "vm-d"},
+		},
+		{
+			name:    "order by status desc",
+			params:  generated.ListVMsParams{OrderBy: generated.VMListOrderByStatus, SortOrder: generated.Desc},
+			wantIDs: []string{"vm-b", "vm-d", "vm-a", "vm-c"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, ids := listVMIDs(t, srv, "admin-1", admin, tc.params)
+			if len(ids) != len(tc.wantIDs) {
+				t.Fatalf("ids = %v, want %v", ids, tc.wantIDs)
+			}
+			for i := range ids {
+				if ids[i] != tc.wantIDs[i] {
+					t.Fatalf("ids = %v, want %v", ids, tc.wantIDs)
+				}
+			}
+		})
+	}
+}
+
+func TestListVMs_EchoesAppliedFilters(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "vm_list_applied_filters")
+	seedVMListFixture(t, client)
+	srv := NewServer(ServerDeps{EntClient: client})
+
+	resp, _ := listVMIDs(t, srv, "admin-1", []string{"platform:admin"}, generated.ListVMsParams{
+		Status:     []generated.VMStatus{generated.VMStatusRUNNING},
+		SystemId:   " sys-shop ",
+		NamePrefix: "prod",
+		OrderBy:    generated.VMListOrderByName,
+	})
+	f := resp.Filters
+	if len(f.Status) != 1 || f.SystemId != "sys-shop" || f.NamePrefix != "prod" || f.ClusterId != "" {
+		t.Fatalf("filters = %+v, want status/system_id/name_prefix echoed", f)
+	}
+	if f.OrderBy != generated.VMListOrderByName || f.SortOrder != generated.VMListFiltersSortOrderAsc {
+		t.Fatalf("ordering = %s %s, want name asc", f.OrderBy, f.SortOrder)
+	}
+
+	c, w := newAuthedGinContext(t, http.MethodGet, "/vms", "", "admin-1", []string{"platform:admin"})
+	srv.ListVMs(c, generated.ListVMsParams{Status: []generated.VMStatus{"SLEEPING"}})
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("invalid status code = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+	c, w = newAuthedGinContext(t, http.MethodGet, "/vms", "", "admin-1", []string{"platform:admin"})
+	srv.ListVMs(c, generated.ListVMsParams{OrderBy: "hostname"})
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("invalid order_by code = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestListVMs_FiltersCannotWidenVisibility(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "vm_list_filter_visibility")
+	seedVMListFixture(t, client)
+	srv := NewServer(ServerDeps{EntClient: client})
+
+	user := client.User.Create().SetID("tester").SetUsername("tester").SetEnabled(true).SaveX(t.Context())
+	role := client.Role.Create().
+		SetID("role-viewer").
+		SetName("Viewer").
+		SetPermissions([]string{"vm:read"}).
+		SetEnabled(true).
+		SaveX(t.Context())
+	client.RoleBinding.Create().
+		SetID("rb-tester").
+		SetUser(user).
+		SetRole(role).
+		SetScopeType("global").
+		SetAllowedEnvironments([]string{"test"}).
+		SetCreatedBy("seed").
+		SaveX(t.Context())
+
+	perms := []string{"vm:read"}
+	_, ids := listVMIDs(t, srv, "tester", perms, generated.ListVMsParams{})
+	if len(ids) != 1 || ids[0] != "vm-d" {
+		t.Fatalf("unfiltered ids = %v, want [vm-d]", ids)
+	}
+	for _, params := range []generated.ListVMsParams{
+		{Namespace: "prod-shop"},
+		{SystemId: "sys-shop"},
+		{ClusterId: "cluster-a"},
+		{CreatedBy: "alice"},
+	} {
+		if _, ids := listVMIDs(t, srv, "tester", perms, params); len(ids) != 0 {
+			t.Fatalf("ListVMs(%+v) ids = %v, want none outside test environment", params, ids)
+		}
+	}
+}
+
+func TestListVMs_CursorPagination(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "vm_list_cursor")
+	seedVMListFixture(t, client)
+	srv := NewServer(ServerDeps{EntClient: client, JWTCfg: middleware.JWTConfig{SigningKey: []byte("vm-list-cursor-test-key")}})
+	admin := []string{"platform:admin"}
+
+	for _, tc := range []struct {
+		sortOrder generated.ListVMsParamsSortOrder
+		wantIDs   []string
+	}{
+		{wantIDs: []string{"vm-d", "vm-c", "vm-b", "vm-a"}},
+		{sortOrder: generated.Asc, wantIDs: []string{"vm-a", "vm-b", "vm-c", "vm-d"}},
+	} {
+		var ids []string
+		params := generated.ListVMsParams{PerPage: 3, SortOrder: tc.sortOrder}
+		for pages := 0; ; pages++ {
+			if pages > len(tc.wantIDs) {
+				t.Fatalf("cursor pagination did not terminate, ids = %v", ids)
+			}
+			resp, pageIDs := listVMIDs(t, srv, "admin-1", admin, params)
+			if params.Cursor != "" && resp.Pagination.Page != 0 {
+				t.Fatalf("cursor page = %d, want 0", resp.Pagination.Page)
+			}
+			ids = append(ids, pageIDs...)
+			if resp.NextCursor == "" {
+				break
+			}
+			params.Cursor = resp.NextCursor
+		}
+		if strings.Join(ids, ",") != strings.Join(tc.wantIDs, ",") {
+			t.Fatalf("sort %q ids = %v, want %v", tc.sortOrder, ids, tc.wantIDs)
+		}
+	}
+
+	// Offset mode still hands out a cursor for the rest of the list.
+	resp, ids := listVMIDs(t, srv, "admin-1", admin, generated.ListVMsParams{Page: 1, PerPage: 2})
+	if strings.Join(ids, ",") != "vm-d,vm-c" || resp.NextCursor == "" {
+		t.Fatalf("offset page = %v next_cursor = %q", ids, resp.NextCursor)
+	}
+	cursor := resp.NextCursor
+
+	payload, sig, _ := strings.Cut(cursor, ".")
+	forged, _ := json.Marshal(map[string]any{"t": time.Now().Add(time.Hour), "id": "vm-z"})
+	for name, bad := range map[string]string{
+		"tampered payload": base64.RawURLEncoding.EncodeToString(forged) + "." + sig,
+		"unsigned":         payload,
+		"garbage":          "not-a-cursor",
+	} {
+		c, w := newAuthedGinContext(t, http.MethodGet, "/vms", "", "admin-1", admin)
+		srv.ListVMs(c, generated.ListVMsParams{Cursor: bad})
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("%s cursor status = %d, want 400", name, w.Code)
+		}
+	}
+
+	// A cursor from another signing key is rejected.
+	other := NewServer(ServerDeps{EntClient: client, JWTCfg: middleware.JWTConfig{SigningKey: []byte("another-key")}})
+	c, w := newAuthedGinContext(t, http.MethodGet, "/vms", "", "admin-1", admin)
+	other.ListVMs(c, generated.ListVMsParams{Cursor: cursor})
+	assertStatusAndCode(t, w, http.StatusBadRequest, "INVALID_REQUEST")
+
+	c, w = newAuthedGinContext(t, http.MethodGet, "/vms", "", "admin-1", admin)
+	srv.ListVMs(c, generated.ListVMsParams{Cursor: cursor, OrderBy: generated.VMListOrderByName})
+	assertStatusAndCode(t, w, http.StatusBadRequest, "INVALID_REQUEST")
+}
@@ -0,0 +1,256 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/namespaceregistry"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/ent/vmrequestidempotency"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/service"
+	"kv-shepherd.io/shepherd/internal/testutil"
+	"kv-shepherd.io/shepherd/internal/usecase"
+)
+
+func TestCreateVMRequest_RequestIDReplay(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "vm_create_request_id")
+
+	serviceID := uuid.New()
+	templateID := uuid.New()
+	sizeID := uuid.New()
+	sys := mustCreateSystem(t, client, "sys-"+uuid.NewString(), "shop", "alice")
+	mustCreateService(t, client, serviceID.String(), "redis", sys.ID, "cache")
+	client.Template.Create().
+		SetID(templateID.String()).
+		SetName("fedora").
+		SetVersion(1).
+		SetEnabled(true).
+		SetCreatedBy("admin-1").
+		SetSpec(map[string]interface{}{"image": "quay.io/kubevirt/fedora:40"}).
+		SaveX(t.Context())
+	client.InstanceSize.Create().
+		SetID(sizeID.String()).
+		SetName("small").
+		SetCPUCores(2).
+		SetMemoryMB(4096).
+		SetCreatedBy("admin-1").
+		SaveX(t.Context())
+
+	mustRegisterNamespace(t, client, "dev-shop", true)
+
+	srv := NewServer(ServerDeps{
+		EntClient: client,
+		CreateVMUC: usecase.NewCreateVMUseCase(
+			client,
+			service.NewVMService(nil),
+			service.NewInstanceSizeService(client),
+			service.NewTemplateService(client),
+		),
+	})
+	submit := func(actor, requestID string) (int, generated.ApprovalTicketResponse) {
+		t.Helper()
+		body := generated.VMCreateRequest{
+			ServiceId:      serviceID,
+			TemplateId:     templateID,
+			InstanceSizeId: sizeID,
+			Namespace:      "dev-shop",
+			Reason:         "cache",
+			RequestId:      requestID,
+		}
+		c, w := newAuthedGinContext(t, http.MethodPost, "/vms/request", mustJSON(t, body), actor, []string{"vm:create", "platform:admin"})
+		srv.CreateVMRequest(c)
+		var resp generated.ApprovalTicketResponse
+		if w.Code == http.StatusAccepted || w.Code == http.StatusOK {
+			mustDecodeJSON(t, w.Body.Bytes(), &resp)
+		}
+		return w.Code, resp
+	}
+	claimedTicket := func(actor, requestID string) string {
+		t.Helper()
+		claim := client.VMRequestIdempotency.Query().
+			Where(
+				vmrequestidempotency.CreatedByEQ(actor),
+				vmrequestidempotency.RequestIDEQ(requestID),
+			).
+			OnlyX(t.Context())
+		return claim.TicketID
+	}
+
+	code, first := submit("alice", "retry-1")
+	if code != http.StatusAccepted || first.Status != generated.ApprovalTicketResponseStatusPENDING {
+		t.Fatalf("first submit = %d %+v", code, first)
+	}
+	ticket := client.ApprovalTicket.GetX(t.Context(), first.TicketId)
+	if ticket.RequestID == nil || *ticket.RequestID != "retry-1" {
+		t.Fatalf("ticket request_id = %v, want retry-1", ticket.RequestID)
+	}
+	if got := claimedTicket("alice", "retry-1"); got != first.TicketId {
+		t.Fatalf("request_id claim ticket = %s, want %s", got, first.TicketId)
+	}
+
+	client.ApprovalTicket.UpdateOneID(first.TicketId).SetStatus(approvalticket.StatusAPPROVED).ExecX(t.Context())
+	code, replay := submit("alice", "retry-1")
+	if code != http.StatusOK || replay.TicketId != first.TicketId || replay.Status != generated.ApprovalTicketResponseStatusAPPROVED {
+		t.Fatalf("replay after approval = %d %+v, want ticket %s APPROVED", code, replay, first.TicketId)
+	}
+
+	// Keys are scoped per requester.
+	code, other := submit("bob", "retry-1")
+	if code != http.StatusAccepted || other.TicketId == first.TicketId {
+		t.Fatalf("other requester submit = %d %+v", code, other)
+	}
+	client.ApprovalTicket.UpdateOneID(other.TicketId).SetStatus(approvalticket.StatusCANCELLED).ExecX(t.Context())
+
+	client.ApprovalTicket.UpdateOneID(first.TicketId).SetStatus(approvalticket.StatusREJECTED).ExecX(t.Context())
+	code, resubmit := submit("alice", "retry-1")
+	if code != http.StatusAccepted || resubmit.TicketId == first.TicketId || resubmit.Status != generated.ApprovalTicketResponseStatusPENDING {
+		t.Fatalf("resubmit after rejection = %d %+v, want a new PENDING ticket", code, resubmit)
+	}
+	if got := claimedTicket("alice", "retry-1"); got != resubmit.TicketId {
+		t.Fatalf("request_id claim ticket = %s, want the resubmitted %s", got, resubmit.TicketId)
+	}
+
+	if n := client.ApprovalTicket.Query().Where(approvalticket.RequesterEQ("alice")).CountX(t.Context()); n != 2 {
+		t.Fatalf("alice tickets = %d, want 2", n)
+	}
+	client.ApprovalTicket.UpdateOneID(resubmit.TicketId).SetStatus(approvalticket.StatusCANCELLED).ExecX(t.Context())
+
+	// Concurrent submissions with one key produce a single ticket.
+	var wg sync.WaitGroup
+	codes := make([]int, 2)
+	tickets := make([]string, 2)
+	for i := range codes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			code, resp := submit("carol", "retry-race")
+			codes[i], tickets[i] = code, resp.TicketId
+		}()
+	}
+	wg.Wait()
+	slices.Sort(codes)
+	if codes[0] != http.StatusOK || codes[1] != http.StatusAccepted || tickets[0] != tickets[1] {
+		t.Fatalf("concurrent submits = %v %v, want one 202 and one 200 replay of the same ticket", codes, tickets)
+	}
+	if n := client.ApprovalTicket.Query().Where(approvalticket.RequesterEQ("carol")).CountX(t.Context()); n != 1 {
+		t.Fatalf("carol tickets = %d, want 1", n)
+	}
+}
+
+func TestDeleteVM_RequestIDReplay(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "vm_delete_request_id")
+
+	vmID := mustCreateRequestIDDeleteTargetVM(t, client, "alice")
+	srv := NewServer(ServerDeps{EntClient: client, DeleteVMUC: usecase.NewDeleteVMUseCase(client)})
+	deleteVM := func(requestID string) (int, generated.DeleteVMResponse, generated.Error) {
+		t.Helper()
+		target := "/vms/" + vmID + "?confirm=true&request_id=" + url.QueryEscape(requestID)
+		c, w := newAuthedGinContext(t, http.MethodDelete, target, "", "alice", []string{"vm:delete"})
+		srv.DeleteVM(c, vmID, generated.DeleteVMParams{Confirm: true, RequestId: requestID})
+		var resp generated.DeleteVMResponse
+		var apiErr generated.Error
+		if w.Code == http.StatusAccepted {
+			mustDecodeJSON(t, w.Body.Bytes(), &resp)
+		} else {
+			mustDecodeJSON(t, w.Body.Bytes(), &apiErr)
+		}
+		return w.Code, resp, apiErr
+	}
+
+	code, first, _ := deleteVM("del-1")
+	if code != http.StatusAccepted || first.Status != generated.DeleteVMResponseStatusPENDING {
+		t.Fatalf("first delete = %d %+v", code, first)
+	}
+
+	// Once approved the VM leaves STOPPED; a replay must still find the ticket.
+	client.ApprovalTicket.UpdateOneID(first.TicketId).SetStatus(approvalticket.StatusEXECUTING).ExecX(t.Context())
+	client.VM.UpdateOneID(vmID).SetStatus(entvm.StatusDELETING).ExecX(t.Context())
+	code, replay, _ := deleteVM("del-1")
+	if code != http.StatusAccepted || replay.TicketId != first.TicketId || replay.EventId != first.EventId ||
+		replay.Status != generated.DeleteVMResponseStatusEXECUTING {
+		t.Fatalf("replay after approval = %d %+v, want ticket %s EXECUTING", code, replay, first.TicketId)
+	}
+
+	client.ApprovalTicket.UpdateOneID(first.TicketId).SetStatus(approvalticket.StatusREJECTED).ExecX(t.Context())
+	client.VM.UpdateOneID(vmID).SetStatus(entvm.StatusSTOPPED).ExecX(t.Context())
+	code, resubmit, _ := deleteVM("del-1")
+	if code != http.StatusAccepted || resubmit.TicketId == first.TicketId {
+		t.Fatalf("resubmit after rejection = %d %+v, want a new ticket", code, resubmit)
+	}
+
+	code, _, apiErr := deleteVM(strings.Repeat("k", 129))
+	if code != http.StatusBadRequest {
+		t.Fatalf("oversized request_id = %d %+v, want 400", code, apiErr)
+	}
+}
+
+func TestDeleteVM_RequestIDReusedAcrossOperations(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "vm_delete_request_id_reuse")
+
+	vmID := mustCreateRequestIDDeleteTargetVM(t, client, "alice")
+	requestID := "shared-key"
+	client.ApprovalTicket.Create().
+		SetID("ticket-" + uuid.NewString()).
+		SetEventID("ev-" + uuid.NewString()).
+		SetRequester("alice").
+		SetOperationType(approvalticket.OperationTypeCREATE).
+		SetRequestID(requestID).
+		SaveX(t.Context())
+
+	srv := NewServer(ServerDeps{EntClient: client, DeleteVMUC: usecase.NewDeleteVMUseCase(client)})
+	c, w := newAuthedGinContext(t, http.MethodDelete, "/vms/"+vmID+"?confirm=true&request_id="+requestID, "", "alice", []string{"vm:delete"})
+	srv.DeleteVM(c, vmID, generated.DeleteVMParams{Confirm: true, RequestId: requestID})
+	if w.Code != http.StatusConflict {
+		t.Fatalf("reused request_id status = %d, want %d body=%s", w.Code, http.StatusConflict, w.Body.String())
+	}
+	assertErrorCode(t, w.Body.Bytes(), usecase.CodeRequestIDReused)
+}
+
+func mustCreateRequestIDDeleteTargetVM(t *testing.T, client *ent.Client, actor string) string {
+	t.Helper()
+
+	namespace := "test-" + uuid.NewString()[:8]
+	client.NamespaceRegistry.Create().
+		SetID("ns-" + uuid.NewString()).
+		SetName(namespace).
+		SetEnvironment(namespaceregistry.EnvironmentTest).
+		SetCreatedBy(actor).
+		SaveX(t.Context())
+	sys := mustCreateSystem(t, client, "sys-"+uuid.NewString(), "shop", actor)
+	svc := mustCreateService(t, client, "svc-"+uuid.NewString(), "redis", sys.ID, "cache")
+	vmID := "vm-" + uuid.NewString()
+	client.VM.Create().
+		SetID(vmID).
+		SetName(namespace + "-shop-redis-01").
+		SetInstance("01").
+		SetNamespace(namespace).
+		SetClusterID("cluster-a").
+		SetStatus(entvm.StatusSTOPPED).
+		SetCreatedBy(actor).
+		SetServiceID(svc.ID).
+		SaveX(t.Context())
+	return vmID
+}
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/testutil"
+	"kv-shepherd.io/shepherd/internal/usecase"
+)
+
+func TestResizeVM_CreatesTicketAndProjectsSizes(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "vm_resize_handler")
+	mustCreateSystem(t, client, "sys-shop", "shop", "alice")
+	mustCreateService(t, client, "svc-redis", "redis", "sys-shop", "cache")
+	client.InstanceSize.Create().
+		SetID("size-large").
+		SetName("large").
+		SetCPUCores(4).
+		SetMemoryMB(8192).
+		SetCreatedBy("admin").
+		SaveX(t.Context())
+	client.ApprovalTicket.Create().
+		SetID("ticket-create").
+		SetEventID("event-create").
+		SetRequester("alice").
+		SetStatus(approvalticket.StatusSUCCESS).
+		SetInstanceSizeSnapshot(map[string]interface{}{"id": "size-small", "name": "small", "cpu_cores": 2, "memory_mb": 4096}).
+		SaveX(t.Context())
+	client.VM.Create().
+		SetID("vm-redis").
+		SetName("prod-shop-shop-redis-01").
+		SetInstance("01").
+		SetNamespace("prod-shop").
+		SetClusterID("cluster-a").
+		SetStatus(entvm.StatusRUNNING).
+		SetCreatedBy("alice").
+		SetTicketID("ticket-create").
+		SetServiceID("svc-redis").
+		SaveX(t.Context())
+
+	srv := NewServer(ServerDeps{EntClient: client, ResizeVMUC: usecase.NewResizeVMUseCase(client)})
+	resize := func(perms []string, body generated.ResizeVMRequest) (int, []byte) {
+		c, w := newAuthedGinContext(t, http.MethodPost, "/vms/vm-redis/resize", mustJSON(t, body), "alice", perms)
+		srv.ResizeVM(c, "vm-redis")
+		return w.Code, w.Body.Bytes()
+	}
+
+	if code, _ := resize([]string{"vm:read"}, generated.ResizeVMRequest{InstanceSizeId: "size-large"}); code != http.StatusForbidden {
+		t.Fatalf("without vm:operate status = %d, want %d", code, http.StatusForbidden)
+	}
+
+	code, raw := resize([]string{"vm:operate"}, generated.ResizeVMRequest{InstanceSizeId: "size-large", Reason: "traffic"})
+	if code != http.StatusAccepted {
+		t.Fatalf("resize status = %d, want %d body=%s", code, http.StatusAccepted, raw)
+	}
+	var resp generated.ResizeVMResponse
+	mustDecodeJSON(t, raw, &resp)
+	wantFrom := generated.VMSize{InstanceSizeId: "size-small", InstanceSizeName: "small", Cpu: 2, MemoryMb: 4096}
+	wantTo := generated.VMSize{InstanceSizeId: "size-large", InstanceSizeName: "large", Cpu: 4, MemoryMb: 8192}
+	if resp.TicketId == "" || resp.Status != generated.ResizeVMResponseStatusPENDING || resp.From != wantFrom || resp.To != wantTo {
+		t.Fatalf("resize response = %+v", resp)
+	}
+
+	code, raw = resize([]string{"vm:operate"}, generated.ResizeVMRequest{Cpu: 8})
+	var apiErr generated.Error
+	mustDecodeJSON(t, raw, &apiErr)
+	if code != http.StatusConflict || apiErr.Code != usecase.CodeResizeAlreadyPending {
+		t.Fatalf("duplicate resize status=%d code=%s, want 409 %s", code, apiErr.Code, usecase.CodeResizeAlreadyPending)
+	}
+
+	c, w := newAuthedGinContext(t, http.MethodGet, "/approvals", "", "admin-1", []string{"approval:view"})
+	srv.ListApprovals(c, generated.ListApprovalsParams{Status: []generated.ListApprovalsParamsStatus{"PENDING"}})
+	if w.Code != http.StatusOK {
+		t.Fatalf("list approvals status = %d body=%s", w.Code, w.Body.String())
+	}
+	var list generated.ApprovalTicketList
+	mustDecodeJSON(t, w.Body.Bytes(), &list)
+	if len(list.Items) != 1 {
+		t.Fatalf("pending approvals = %d, want 1", len(list.Items))
+	}
+	item := list.Items[0]
+	if item.Id != resp.TicketId || item.OperationType != generated.ApprovalTicketOperationTypeRESIZE {
+		t.Fatalf("approval item = %s/%s, want %s/RESIZE", item.Id, item.OperationType, resp.TicketId)
+	}
+	if item.TargetVmId != "vm-redis" || item.TargetVmName != "prod-shop-shop-redis-01" {
+		t.Fatalf("approval target = %s/%s", item.TargetVmId, item.TargetVmName)
+	}
+	if item.Resize.From != wantFrom || item.Resize.To != wantTo {
+		t.Fatalf("approval resize = %+v", item.Resize)
+	}
+
+	// The approver's selection, once stored, replaces the requested size.
+	client.ApprovalTicket.UpdateOneID(resp.TicketId).
+		SetModifiedSpec(map[string]interface{}{"cpu": 6, "memory_mb": 12288, "disk_gb": 40}).
+		ExecX(t.Context())
+	c, w = newAuthedGinContext(t, http.MethodGet, "/approvals", "", "admin-1", []string{"approval:view"})
+	srv.ListApprovals(c, generated.ListApprovalsParams{Status: []generated.ListApprovalsParamsStatus{"PENDING"}})
+	mustDecodeJSON(t, w.Body.Bytes(), &list)
+	if len(list.Items) != 1 || list.Items[0].Resize.To != (generated.VMSize{Cpu: 6, MemoryMb: 12288, DiskGb: 40}) {
+		t.Fatalf("approval resize after selection = %+v", list.Items)
+	}
+}
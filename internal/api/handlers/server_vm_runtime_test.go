@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/provider"
+	"kv-shepherd.io/shepherd/internal/service"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+// fakeRuntimeProvider answers GetVMRuntime per cluster; clusters listed in
+// unreachable fail as if the API server could not be dialed.
+type fakeRuntimeProvider struct {
+	provider.InfrastructureProvider
+
+	unreachable map[string]bool
+}
+
+func (p *fakeRuntimeProvider) GetVMRuntime(_ context.Context, cluster, _, name string) (*domain.VMRuntime, error) {
+	if p.unreachable[cluster] {
+		return nil, fmt.Errorf("get client for cluster %s: dial tcp: connection refused", cluster)
+	}
+	return &domain.VMRuntime{
+		Phase:               "Running",
+		NodeName:            "worker-1",
+		GuestAgentConnected: true,
+		Interfaces: []domain.VMInterface{
+			{Name: "default", MAC: "02:00:00:00:00:01", IPAddresses: []string{"10.0.0.5"}},
+		},
+		GuestOS: &domain.GuestOSInfo{Name: "Fedora Linux", Version: "40", KernelRelease: "6.8.5"},
+	}, nil
+}
+
+func getVMForTest(t *testing.T, srv *Server, vmID string, params generated.GetVMParams) generated.VM {
+	t.Helper()
+
+	c, w := newAuthedGinContext(t, http.MethodGet, "/vms/"+vmID, "", "admin-1", []string{"platform:admin"})
+	srv.GetVM(c, vmID, params)
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetVM(%s) status = %d, want %d body=%s", vmID, w.Code, http.StatusOK, w.Body.String())
+	}
+	var resp generated.VM
+	mustDecodeJSON(t, w.Body.Bytes(), &resp)
+	return resp
+}
+
+func TestGetVM_Runtime(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "vm_get_runtime")
+	seedVMListFixture(t, client)
+	infra := &fakeRuntimeProvider{unreachable: map[string]bool{"cluster-b": true}}
+	srv := NewServer(ServerDeps{EntClient: client, VMService: service.NewVMService(infra)})
+
+	t.Run("not requested", func(t *testing.T) {
+		resp := getVMForTest(t, srv, "vm-a", generated.GetVMParams{})
+		if resp.Runtime != nil || resp.RuntimeWarning != "" {
+			t.Fatalf("expected no runtime details, got runtime=%+v warning=%q", resp.Runtime, resp.RuntimeWarning)
+		}
+	})
+
+	t.Run("reachable cluster", func(t *testing.T) {
+		resp := getVMForTest(t, srv, "vm-a", generated.GetVMParams{Runtime: true})
+		if resp.Runtime == nil {
+			t.Fatalf("expected runtime details, warning=%q", resp.RuntimeWarning)
+		}
+		if resp.Runtime.NodeName != "worker-1" || !resp.Runtime.GuestAgentConnected || resp.Runtime.GuestOs.Name != "Fedora Linux" {
+			t.Fatalf("unexpected runtime %+v", resp.Runtime)
+		}
+		if len(resp.Runtime.Interfaces) != 1 || resp.Runtime.Interfaces[0].IpAddresses[0] != "10.0.0.5" {
+			t.Fatalf("unexpected interfaces %+v", resp.Runtime.Interfaces)
+		}
+	})
+
+	t.Run("unreachable cluster falls back", func(t *testing.T) {
+		resp := getVMForTest(t, srv, "vm-b", generated.GetVMParams{Runtime: true})
+		if resp.Runtime != nil {
+			t.Fatalf("expected runtime=null for unreachable cluster, got %+v", resp.Runtime)
+		}
+		if resp.RuntimeWarning == "" {
+			t.Fatal("expected runtime_warning for unreachable cluster")
+		}
+		if resp.Id != "vm-b" {
+			t.Fatalf("expected stored VM record, got id %q", resp.Id)
+		}
+	})
+}
@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/api/middleware"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/jobs"
+	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/usecase"
+)
+
+// ListVMSnapshots handles GET /vms/{vm_id}/snapshots.
+func (s *Server) ListVMSnapshots(c *gin.Context, vmId generated.VMID) {
+	ctx := c.Request.Context()
+	if !requireGlobalPermission(c, "vm:read") {
+		return
+	}
+
+	items, err := s.snapshotVMUC.ListSnapshots(ctx, vmId)
+	if err != nil {
+		s.writeSnapshotError(c, err, "list VM snapshots failed", vmId)
+		return
+	}
+
+	out := generated.VMSnapshotList{Items: make([]generated.VMSnapshot, 0, len(items))}
+	for _, snap := range items {
+		out.Items = append(out.Items, snapshotToAPI(snap))
+	}
+	c.JSON(http.StatusOK, out)
+}
+
+// GetVMSnapshot handles GET /vms/{vm_id}/snapshots/{snapshot_name}.
+func (s *Server) GetVMSnapshot(c *gin.Context, vmId generated.VMID, snapshotName generated.SnapshotName) {
+	ctx := c.Request.Context()
+	if !requireGlobalPermission(c, "vm:read") {
+		return
+	}
+
+	snap, err := s.snapshotVMUC.GetSnapshot(ctx, vmId, snapshotName)
+	if err != nil {
+		s.writeSnapshotError(c, err, "get VM snapshot failed", vmId)
+		return
+	}
+	c.JSON(http.StatusOK, snapshotToAPI(snap))
+}
+
+// CreateVMSnapshot handles POST /vms/{vm_id}/snapshots.
+// Async via River (ADR-0006), optionally behind a SNAPSHOT approval ticket.
+func (s *Server) CreateVMSnapshot(c *gin.Context, vmId generated.VMID) {
+	ctx := c.Request.Context()
+	if !requireGlobalPermission(c, "vm:operate") {
+		return
+	}
+	actor := middleware.GetUserID(ctx)
+
+	var req generated.CreateVMSnapshotRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+			return
+		}
+	}
+
+	result, err := s.snapshotVMUC.CreateSnapshot(ctx, usecase.CreateSnapshotInput{
+		VMID:        vmId,
+		Name:        req.Name,
+		Reason:      req.Reason,
+		RequestedBy: actor,
+	})
+	if err != nil {
+		s.writeSnapshotError(c, err, "VM snapshot request failed", vmId)
+		return
+	}
+
+	if result.TicketID != "" {
+		if s.notifier != nil {
+			s.notifier.OnTicketSubmitted(ctx, result.TicketID, actor, "")
+		}
+	} else if err := s.enqueueSnapshotJob(ctx, result.EventID); err != nil {
+		logger.Error("failed to enqueue VM snapshot job", zap.Error(err), zap.String("event_id", result.EventID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	c.JSON(http.StatusAccepted, snapshotOperationToAPI(result))
+}
+
+// DeleteVMSnapshot handles DELETE /vms/{vm_id}/snapshots/{snapshot_name}.
+func (s *Server) DeleteVMSnapshot(c *gin.Context, vmId generated.VMID, snapshotName generated.SnapshotName) {
+	ctx := c.Request.Context()
+	if !requireGlobalPermission(c, "vm:operate") {
+		return
+	}
+
+	if err := s.snapshotVMUC.DeleteSnapshot(ctx, vmId, snapshotName, middleware.GetUserID(ctx)); err != nil {
+		s.writeSnapshotError(c, err, "VM snapshot delete failed", vmId)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// RestoreVMSnapshot handles POST /vms/{vm_id}/snapshots/{snapshot_name}/restore.
+// Async via River (ADR-0006); progress is tracked on the returned event.
+func (s *Server) RestoreVMSnapshot(c *gin.Context, vmId generated.VMID, snapshotName generated.SnapshotName) {
+	ctx := c.Request.Context()
+	if !requireGlobalPermission(c, "vm:operate") {
+		return
+	}
+	actor := middleware.GetUserID(ctx)
+
+	var req generated.RestoreVMSnapshotRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, generated.Error{Code: "INVALID_REQUEST"})
+			return
+		}
+	}
+
+	result, err := s.snapshotVMUC.RestoreSnapshot(ctx, usecase.RestoreSnapshotInput{
+		VMID:         vmId,
+		SnapshotName: snapshotName,
+		Force:        req.Force,
+		Reason:       req.Reason,
+		RequestedBy:  actor,
+	})
+	if err != nil {
+		s.writeSnapshotError(c, err, "VM snapshot restore request failed", vmId)
+		return
+	}
+
+	if err := s.enqueueSnapshotJob(ctx, result.EventID); err != nil {
+		logger.Error("failed to enqueue VM restore job", zap.Error(err), zap.String("event_id", result.EventID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	c.JSON(http.StatusAccepted, snapshotOperationToAPI(result))
+}
+
+// enqueueSnapshotJob enqueues the snapshot job for an event that needs no
+// approval, marking the event FAILED if the job cannot be inserted.
+func (s *Server) enqueueSnapshotJob(ctx context.Context, eventID string) error {
+	var err error
+	if s.riverClient == nil {
+		err = fmt.Errorf("river client is not configured")
+	} else {
+		_, err = s.riverClient.Insert(ctx, jobs.VMSnapshotArgs{EventID: eventID}, nil)
+	}
+	if err != nil {
+		_, _ = s.client.DomainEvent.UpdateOneID(eventID).SetStatus(domainevent.StatusFAILED).Save(ctx)
+	}
+	return err
+}
+
+func (s *Server) writeSnapshotError(c *gin.Context, err error, msg, vmID string) {
+	if appErr, ok := apperrors.IsAppError(err); ok {
+		c.JSON(appErr.HTTPStatus, generated.Error{
+			Code:    appErr.Code,
+			Message: appErr.Message,
+			Params:  appErr.Params,
+		})
+		return
+	}
+	logger.Error(msg, zap.Error(err), zap.String("vm_id", vmID))
+	c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+}
+
+func snapshotToAPI(snap *domain.Snapshot) generated.VMSnapshot {
+	return generated.VMSnapshot{
+		Name:      snap.Name,
+		Phase:     snap.Phase,
+		Ready:     snap.Ready,
+		Error:     snap.Error,
+		CreatedAt: snap.CreatedAt,
+	}
+}
+
+func snapshotOperationToAPI(out *usecase.SnapshotOperationOutput) generated.VMSnapshotOperationResponse {
+	return generated.VMSnapshotOperationResponse{
+		EventId:      out.EventID,
+		TicketId:     out.TicketID,
+		SnapshotName: out.SnapshotName,
+		Status:       generated.VMSnapshotOperationResponseStatus(out.Status),
+	}
+}
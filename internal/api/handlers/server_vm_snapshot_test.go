@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/provider"
+	"kv-shepherd.io/shepherd/internal/service"
+	"kv-shepherd.io/shepherd/internal/testutil"
+	"kv-shepherd.io/shepherd/internal/usecase"
+)
+
+func TestVMSnapshots_ApprovalGatedCreateListRestoreDelete(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "vm_snapshot_handler")
+	mustCreateSystem(t, client, "sys-shop", "shop", "alice")
+	mustCreateService(t, client, "svc-redis", "redis", "sys-shop", "cache")
+	vm := client.VM.Create().
+		SetID("vm-redis").
+		SetName("prod-shop-shop-redis-01").
+		SetInstance("01").
+		SetNamespace("prod-shop").
+		SetClusterID("cluster-a").
+		SetStatus(entvm.StatusRUNNING).
+		SetCreatedBy("alice").
+		SetServiceID("svc-redis").
+		SaveX(t.Context())
+
+	mock := provider.NewMockProvider()
+	mock.Seed([]*domain.VM{{Name: vm.Name, Namespace: vm.Namespace, Status: domain.VMStatusRunning}})
+	if _, err := mock.CreateSnapshot(t.Context(), vm.ClusterID, vm.Namespace, vm.Name, "nightly"); err != nil {
+		t.Fatalf("seed snapshot: %v", err)
+	}
+	srv := NewServer(ServerDeps{
+		EntClient:    client,
+		SnapshotVMUC: usecase.NewSnapshotVMUseCase(client, service.NewVMService(mock), true),
+	})
+	operate := []string{"vm:operate"}
+
+	c, w := newAuthedGinContext(t, http.MethodPost, "/vms/vm-redis/snapshots", mustJSON(t, generated.CreateVMSnapshotRequest{Name: "manual"}), "alice", []string{"vm:read"})
+	srv.CreateVMSnapshot(c, vm.ID)
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("create without vm:operate status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodPost, "/vms/vm-redis/snapshots", mustJSON(t, generated.CreateVMSnapshotRequest{Name: "manual"}), "alice", operate)
+	srv.CreateVMSnapshot(c, vm.ID)
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("create status = %d body=%s", w.Code, w.Body.String())
+	}
+	var created generated.VMSnapshotOperationResponse
+	mustDecodeJSON(t, w.Body.Bytes(), &created)
+	if created.TicketId == "" || created.Status != generated.PENDING || created.SnapshotName != "manual" {
+		t.Fatalf("create response = %+v", created)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodGet, "/approvals", "", "admin-1", []string{"approval:view"})
+	srv.ListApprovals(c, generated.ListApprovalsParams{Status: []generated.ListApprovalsParamsStatus{"PENDING"}})
+	var approvals generated.ApprovalTicketList
+	mustDecodeJSON(t, w.Body.Bytes(), &approvals)
+	if len(approvals.Items) != 1 || approvals.Items[0].OperationType != generated.ApprovalTicketOperationTypeSNAPSHOT || approvals.Items[0].TargetVmId != vm.ID {
+		t.Fatalf("pending approvals = %+v", approvals.Items)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodGet, "/vms/vm-redis/snapshots", "", "alice", []string{"vm:read"})
+	srv.ListVMSnapshots(c, vm.ID)
+	var list generated.VMSnapshotList
+	mustDecodeJSON(t, w.Body.Bytes(), &list)
+	if w.Code != http.StatusOK || len(list.Items) != 1 || list.Items[0].Name != "nightly" || !list.Items[0].Ready {
+		t.Fatalf("list status=%d body=%s", w.Code, w.Body.String())
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodGet, "/vms/vm-redis/snapshots/nightly", "", "alice", []string{"vm:read"})
+	srv.GetVMSnapshot(c, vm.ID, "nightly")
+	var snap generated.VMSnapshot
+	mustDecodeJSON(t, w.Body.Bytes(), &snap)
+	if w.Code != http.StatusOK || snap.Name != "nightly" || !snap.Ready || snap.CreatedAt.IsZero() {
+		t.Fatalf("get status=%d body=%s", w.Code, w.Body.String())
+	}
+	c, w = newAuthedGinContext(t, http.MethodGet, "/vms/vm-redis/snapshots/missing", "", "alice", []string{"vm:read"})
+	srv.GetVMSnapshot(c, vm.ID, "missing")
+	assertStatusAndCode(t, w, http.StatusNotFound, usecase.CodeSnapshotNotFound)
+
+	// The VM is RUNNING, so an unforced restore is refused up front.
+	c, w = newAuthedGinContext(t, http.MethodPost, "/vms/vm-redis/snapshots/nightly/restore", "", "alice", operate)
+	srv.RestoreVMSnapshot(c, vm.ID, "nightly")
+	var apiErr generated.Error
+	mustDecodeJSON(t, w.Body.Bytes(), &apiErr)
+	if w.Code != http.StatusConflict || apiErr.Code != usecase.CodeRestoreRequiresForce {
+		t.Fatalf("unforced restore status=%d code=%s, want 409 %s", w.Code, apiErr.Code, usecase.CodeRestoreRequiresForce)
+	}
+
+	c, w = newAuthedGinContext(t, http.MethodDelete, "/vms/vm-redis/snapshots/missing", "", "alice", operate)
+	srv.DeleteVMSnapshot(c, vm.ID, "missing")
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("delete missing status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+	c, w = newAuthedGinContext(t, http.MethodDelete, "/vms/vm-redis/snapshots/nightly", "", "alice", operate)
+	srv.DeleteVMSnapshot(c, vm.ID, "nightly")
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("delete status = %d body=%s", w.Code, w.Body.String())
+	}
+}
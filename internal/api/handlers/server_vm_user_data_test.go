@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/service"
+	"kv-shepherd.io/shepherd/internal/testutil"
+	"kv-shepherd.io/shepherd/internal/usecase"
+)
+
+func TestCreateVMRequest_UserData(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "vm_create_user_data")
+
+	serviceID := uuid.New()
+	templateID := uuid.New()
+	sizeID := uuid.New()
+	sys := mustCreateSystem(t, client, "sys-"+uuid.NewString(), "shop", "alice")
+	mustCreateService(t, client, serviceID.String(), "web", sys.ID, "frontend")
+	client.Template.Create().
+		SetID(templateID.String()).
+		SetName("fedora").
+		SetVersion(1).
+		SetEnabled(true).
+		SetCreatedBy("admin-1").
+		SetSpec(map[string]interface{}{"image": "quay.io/kubevirt/fedora:40", "cloud_init_type": "configdrive"}).
+		SaveX(t.Context())
+	client.InstanceSize.Create().
+		SetID(sizeID.String()).
+		SetName("small").
+		SetCPUCores(2).
+		SetMemoryMB(4096).
+		SetCreatedBy("admin-1").
+		SaveX(t.Context())
+
+	mustRegisterNamespace(t, client, "dev-shop", true)
+
+	srv := NewServer(ServerDeps{
+		EntClient: client,
+		CreateVMUC: usecase.NewCreateVMUseCase(
+			client,
+			service.NewVMService(nil),
+			service.NewInstanceSizeService(client),
+			service.NewTemplateService(client),
+		),
+	})
+	submit := func(userData string) (int, generated.ApprovalTicketResponse, generated.Error) {
+		t.Helper()
+		body := generated.VMCreateRequest{
+			ServiceId:      serviceID,
+			TemplateId:     templateID,
+			InstanceSizeId: sizeID,
+			Namespace:      "dev-shop",
+			Reason:         "web",
+			UserData:       userData,
+		}
+		c, w := newAuthedGinContext(t, http.MethodPost, "/vms/request", mustJSON(t, body), "alice", []string{"vm:create", "platform:admin"})
+		srv.CreateVMRequest(c)
+		var resp generated.ApprovalTicketResponse
+		var apiErr generated.Error
+		if w.Code == http.StatusAccepted {
+			mustDecodeJSON(t, w.Body.Bytes(), &resp)
+		} else {
+			mustDecodeJSON(t, w.Body.Bytes(), &apiErr)
+		}
+		return w.Code, resp, apiErr
+	}
+	const userData = "I2Nsb3VkLWNvbmZpZwpob3N0bmFtZTogd2ViLTAxCg=="
+
+	if code, _, apiErr := submit(userData); code != http.StatusBadRequest || apiErr.Code != "USER_DATA_DISABLED" {
+		t.Fatalf("user_data while disabled = %d %+v, want 400 USER_DATA_DISABLED", code, apiErr)
+	}
+
+	client.PlatformConfig.Create().SetAllowUserData(true).SetUpdatedBy("admin-1").ExecX(t.Context())
+	if code, _, apiErr := submit("not base64!"); code != http.StatusBadRequest || apiErr.Code != "INVALID_REQUEST_FIELD" {
+		t.Fatalf("invalid user_data = %d %+v, want 400 INVALID_REQUEST_FIELD", code, apiErr)
+	}
+
+	code, resp, apiErr := submit(userData)
+	if code != http.StatusAccepted {
+		t.Fatalf("user_data while allowed = %d %+v", code, apiErr)
+	}
+	ticket := client.ApprovalTicket.GetX(t.Context(), resp.TicketId)
+	event := client.DomainEvent.GetX(t.Context(), ticket.EventID)
+	var payload domain.VMCreationPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	if payload.UserData != userData {
+		t.Fatalf("payload user_data = %q, want %q", payload.UserData, userData)
+	}
+}
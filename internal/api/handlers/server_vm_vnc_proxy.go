@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/api/middleware"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/service"
+)
+
+// vncCloseTimeout bounds the close frame sent when a proxied connection ends.
+const vncCloseTimeout = time.Second
+
+// vncUpgrader keeps gorilla's same-origin check; noVNC asks for the "binary"
+// subprotocol, which carries raw RFB frames.
+var vncUpgrader = websocket.Upgrader{
+	ReadBufferSize:  32 * 1024,
+	WriteBufferSize: 32 * 1024,
+	Subprotocols:    []string{"binary"},
+}
+
+// ProxyVMVNCWebSocket handles GET /vms/{vm_id}/vnc/ws.
+//
+// All checks run before the upgrade so failures are reported as JSON errors;
+// once upgraded the handler blocks until either side closes.
+func (s *Server) ProxyVMVNCWebSocket(c *gin.Context, vmId generated.VMID, params generated.ProxyVMVNCWebSocketParams) {
+	ctx := c.Request.Context()
+	if !requireGlobalPermission(c, "vnc:access") {
+		return
+	}
+	actor := middleware.GetUserID(ctx)
+	if actor == "" {
+		c.JSON(http.StatusUnauthorized, generated.Error{Code: "UNAUTHORIZED"})
+		return
+	}
+
+	sessionID := strings.TrimSpace(params.Token)
+	if sessionID == "" {
+		c.JSON(http.StatusUnauthorized, generated.Error{Code: "INVALID_VNC_TOKEN"})
+		return
+	}
+	session, err := s.client.VMConsoleSession.Get(ctx, sessionID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusUnauthorized, generated.Error{Code: "INVALID_VNC_TOKEN"})
+			return
+		}
+		logger.Error("failed to load vnc console session", zap.Error(err), zap.String("session_id", sessionID))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if session.VMID != vmId {
+		c.JSON(http.StatusConflict, generated.Error{Code: "VNC_TOKEN_VM_MISMATCH"})
+		return
+	}
+	if session.UserID != actor {
+		c.JSON(http.StatusForbidden, generated.Error{Code: "FORBIDDEN"})
+		return
+	}
+	if session.RevokedAt != nil {
+		c.JSON(http.StatusUnauthorized, generated.Error{Code: "VNC_SESSION_REVOKED"})
+		return
+	}
+	if !time.Now().UTC().Before(session.ExpiresAt) {
+		c.JSON(http.StatusUnauthorized, generated.Error{Code: "INVALID_VNC_TOKEN"})
+		return
+	}
+	if !s.requireVNCSessionTicketActive(c, session) {
+		return
+	}
+
+	vm, err := s.client.VM.Get(ctx, vmId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, generated.Error{Code: "VM_NOT_FOUND"})
+			return
+		}
+		logger.Error("failed to get VM for vnc proxy", zap.Error(err), zap.String("vm_id", vmId))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return
+	}
+	if entvm.Status(vm.Status) != entvm.StatusRUNNING {
+		c.JSON(http.StatusConflict, generated.Error{Code: "VM_NOT_RUNNING"})
+		return
+	}
+
+	target := service.VNCProxyTarget{
+		SessionID: session.ID,
+		VMID:      vm.ID,
+		ClusterID: vm.ClusterID,
+		Namespace: vm.Namespace,
+		VMName:    vm.Name,
+	}
+	if err := s.vncProxy.Acquire(target); err != nil {
+		switch {
+		case errors.Is(err, service.ErrVNCSessionInUse):
+			c.JSON(http.StatusConflict, generated.Error{Code: "VNC_SESSION_IN_USE"})
+		default:
+			c.JSON(http.StatusTooManyRequests, generated.Error{Code: "VNC_SESSION_LIMIT"})
+		}
+		return
+	}
+	defer s.vncProxy.Release(session.ID)
+
+	upstream, err := s.vncProxy.Dial(ctx, target)
+	if err != nil {
+		logger.Warn("failed to open vnc stream", zap.Error(err), zap.String("vm_id", vm.ID), zap.String("cluster_id", vm.ClusterID))
+		c.JSON(http.StatusBadGateway, generated.Error{Code: "VNC_UPSTREAM_UNAVAILABLE"})
+		return
+	}
+
+	conn, err := vncUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// The upgrader has already written the handshake error.
+		_ = upstream.Close()
+		return
+	}
+	// The hijacked connection keeps the server's read/write timeouts.
+	_ = conn.NetConn().SetDeadline(time.Time{})
+
+	s.vncProxy.Pipe(target, &vncWebSocketConn{conn: conn}, upstream)
+}
+
+// vncWebSocketConn adapts a WebSocket to the byte stream the VNC proxy
+// pipes; each Write is sent as one binary message.
+type vncWebSocketConn struct {
+	conn   *websocket.Conn
+	reader io.Reader
+}
+
+func (w *vncWebSocketConn) Read(p []byte) (int, error) {
+	for {
+		if w.reader == nil {
+			_, r, err := w.conn.NextReader()
+			if err != nil {
+				return 0, err
+			}
+			w.reader = r
+		}
+		n, err := w.reader.Read(p)
+		if errors.Is(err, io.EOF) {
+			w.reader = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+func (w *vncWebSocketConn) Write(p []byte) (int, error) {
+	if err := w.conn.WriteMessage(websocket.BinaryMessage, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (w *vncWebSocketConn) Close() error {
+	_ = w.conn.WriteControl(websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+		time.Now().Add(vncCloseTimeout))
+	return w.conn.Close()
+}
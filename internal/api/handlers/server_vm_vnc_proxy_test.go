@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/namespaceregistry"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/api/middleware"
+	"kv-shepherd.io/shepherd/internal/service"
+)
+
+func TestProxyVMVNCWebSocket_PipesToVM(t *testing.T) {
+	t.Parallel()
+
+	_, client := newVMConsoleBehaviorTestServer(t)
+	vm := mustCreateVMConsoleTarget(t, client, "actor-1", namespaceregistry.EnvironmentTest, entvm.StatusRUNNING)
+	sessionID := mustCreateConsoleSession(t, client, vm.ID, "actor-1")
+
+	// The fake VNC endpoint echoes what it receives, prefixed with "vm:".
+	dialed := make(chan [3]string, 1)
+	proxy := service.NewVNCProxyWorker(func(_ context.Context, cluster, namespace, name string) (net.Conn, error) {
+		dialed <- [3]string{cluster, namespace, name}
+		proxySide, vmSide := net.Pipe()
+		go func() {
+			defer vmSide.Close()
+			buf := make([]byte, 64)
+			for {
+				n, err := vmSide.Read(buf)
+				if err != nil {
+					return
+				}
+				if _, err := vmSide.Write(append([]byte("vm:"), buf[:n]...)); err != nil {
+					return
+				}
+			}
+		}()
+		return proxySide, nil
+	}, 1)
+	srv := NewServer(ServerDeps{EntClient: client, VNCProxy: proxy})
+	ts := newVNCProxyTestServer(t, srv, "actor-1")
+
+	conn := mustDialVNCProxy(t, ts, vm.ID, sessionID)
+	if got := <-dialed; got != [3]string{vm.ClusterID, vm.Namespace, vm.Name} {
+		t.Fatalf("dialed %v, want the VM's cluster, namespace and name", got)
+	}
+	if err := conn.WriteMessage(websocket.BinaryMessage, []byte("hello")); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	msgType, data, err := conn.ReadMessage()
+	if err != nil || msgType != websocket.BinaryMessage || string(data) != "vm:hello" {
+		t.Fatalf("read = %d %q %v, want binary %q", msgType, data, err, "vm:hello")
+	}
+
+	// A second connection on the same session is refused while the first is open.
+	resp := mustFailDialVNCProxy(t, ts, vm.ID, sessionID)
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("second connection status = %d, want %d", resp.StatusCode, http.StatusConflict)
+	}
+
+	// Closing the client frees the slot.
+	_ = conn.Close()
+	deadline := time.Now().Add(5 * time.Second)
+	for proxy.ActiveForVM(vm.ID) != 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("proxy slot not released after client closed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestProxyVMVNCWebSocket_RejectsBeforeUpgrade(t *testing.T) {
+	t.Parallel()
+
+	_, client := newVMConsoleBehaviorTestServer(t)
+	vm := mustCreateVMConsoleTarget(t, client, "actor-1", namespaceregistry.EnvironmentTest, entvm.StatusRUNNING)
+	stopped := mustCreateVMConsoleTarget(t, client, "actor-1", namespaceregistry.EnvironmentTest, entvm.StatusSTOPPED)
+	vncPerms := []string{"vnc:access"}
+
+	failingDial := func(context.Context, string, string, string) (net.Conn, error) {
+		return nil, errors.New("cluster unreachable")
+	}
+	proxy := service.NewVNCProxyWorker(failingDial, 1)
+	srv := NewServer(ServerDeps{EntClient: client, VNCProxy: proxy})
+
+	call := func(userID string, perms []string, vmID, token string) *httptest.ResponseRecorder {
+		t.Helper()
+		c, w := newAuthedGinContext(t, http.MethodGet, fmt.Sprintf("/vms/%s/vnc/ws?token=%s", vmID, token), "", userID, perms)
+		srv.ProxyVMVNCWebSocket(c, vmID, generated.ProxyVMVNCWebSocketParams{Token: token})
+		return w
+	}
+
+	owned := mustCreateConsoleSession(t, client, vm.ID, "actor-1")
+	revoked := mustCreateConsoleSession(t, client, vm.ID, "actor-1")
+	client.VMConsoleSession.UpdateOneID(revoked).SetRevokedAt(time.Now().UTC()).SetRevokedBy("actor-1").ExecX(t.Context())
+	expired := uuid.NewString()
+	client.VMConsoleSession.Create().
+		SetID(expired).
+		SetVMID(vm.ID).
+		SetUserID("actor-1").
+		SetExpiresAt(time.Now().UTC().Add(-time.Minute)).
+		ExecX(t.Context())
+	onStopped := mustCreateConsoleSession(t, client, stopped.ID, "actor-1")
+
+	cases := []struct {
+		name   string
+		user   string
+		perms  []string
+		vmID   string
+		token  string
+		status int
+		code   string
+	}{
+		{"missing permission", "actor-1", []string{"vm:read"}, vm.ID, owned, http.StatusForbidden, "FORBIDDEN"},
+		{"unknown session", "actor-1", vncPerms, vm.ID, "missing", http.StatusUnauthorized, "INVALID_VNC_TOKEN"},
+		{"other user's session", "actor-2", vncPerms, vm.ID, owned, http.StatusForbidden, "FORBIDDEN"},
+		{"session for another vm", "actor-1", vncPerms, stopped.ID, owned, http.StatusConflict, "VNC_TOKEN_VM_MISMATCH"},
+		{"revoked session", "actor-1", vncPerms, vm.ID, revoked, http.StatusUnauthorized, "VNC_SESSION_REVOKED"},
+		{"expired session", "actor-1", vncPerms, vm.ID, expired, http.StatusUnauthorized, "INVALID_VNC_TOKEN"},
+		{"vm not running", "actor-1", vncPerms, stopped.ID, onStopped, http.StatusConflict, "VM_NOT_RUNNING"},
+		{"upstream unreachable", "actor-1", vncPerms, vm.ID, owned, http.StatusBadGateway, "VNC_UPSTREAM_UNAVAILABLE"},
+	}
+	for _, tc := range cases {
+		w := call(tc.user, tc.perms, tc.vmID, tc.token)
+		if w.Code != tc.status {
+			t.Fatalf("%s: status = %d, want %d body=%s", tc.name, w.Code, tc.status, w.Body.String())
+		}
+		assertErrorCode(t, w.Body.Bytes(), tc.code)
+	}
+	if got := proxy.ActiveForVM(vm.ID); got != 0 {
+		t.Fatalf("ActiveForVM after failed dial = %d, want 0", got)
+	}
+
+	// With the VM's only slot held by another session the limit applies.
+	if err := proxy.Acquire(service.VNCProxyTarget{SessionID: "other", VMID: vm.ID}); err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+	defer proxy.Release("other")
+	w := call("actor-1", vncPerms, vm.ID, owned)
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("over limit status = %d, want %d body=%s", w.Code, http.StatusTooManyRequests, w.Body.String())
+	}
+	assertErrorCode(t, w.Body.Bytes(), "VNC_SESSION_LIMIT")
+}
+
+func mustCreateConsoleSession(t *testing.T, client *ent.Client, vmID, userID string) string {
+	t.Helper()
+	id := uuid.NewString()
+	client.VMConsoleSession.Create().
+		SetID(id).
+		SetVMID(vmID).
+		SetUserID(userID).
+		SetExpiresAt(time.Now().UTC().Add(time.Hour)).
+		ExecX(t.Context())
+	return id
+}
+
+// newVNCProxyTestServer serves the proxy route on a real listener, as the
+// WebSocket upgrade needs a hijackable connection.
+func newVNCProxyTestServer(t *testing.T, srv *Server, userID string) *httptest.Server {
+	t.Helper()
+	r := gin.New()
+	r.GET("/vms/:vm_id/vnc/ws", func(c *gin.Context) {
+		c.Request = c.Request.WithContext(middleware.SetUserContext(c.Request.Context(), userID, userID, nil))
+		c.Set("permissions", []string{"vnc:access"})
+		srv.ProxyVMVNCWebSocket(c, c.Param("vm_id"), generated.ProxyVMVNCWebSocketParams{Token: c.Query("token")})
+	})
+	ts := httptest.NewServer(r)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func vncProxyURL(ts *httptest.Server, vmID, token string) string {
+	return "ws" + strings.TrimPrefix(ts.URL, "http") + "/vms/" + vmID + "/vnc/ws?token=" + token
+}
+
+func mustDialVNCProxy(t *testing.T, ts *httptest.Server, vmID, token string) *websocket.Conn {
+	t.Helper()
+	conn, resp, err := websocket.DefaultDialer.Dial(vncProxyURL(ts, vmID, token), nil)
+	if err != nil {
+		status := 0
+		if resp != nil {
+			status = resp.StatusCode
+		}
+		t.Fatalf("dial vnc proxy: %v (status %d)", err, status)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func mustFailDialVNCProxy(t *testing.T, ts *httptest.Server, vmID, token string) *http.Response {
+	t.Helper()
+	conn, resp, err := websocket.DefaultDialer.Dial(vncProxyURL(ts, vmID, token), nil)
+	if err == nil {
+		_ = conn.Close()
+		t.Fatal("dial vnc proxy succeeded, want a refused handshake")
+	}
+	if resp == nil {
+		t.Fatalf("dial vnc proxy: %v", err)
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+	return resp
+}
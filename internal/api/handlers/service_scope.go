@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent/resourcerolebinding"
+	entservice "kv-shepherd.io/shepherd/ent/service"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/api/middleware"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// serviceScopedVMActions maps global VM permissions that may fall back to
+// service-scoped ResourceRoleBindings onto the resource action they require.
+var serviceScopedVMActions = map[string]string{
+	"vm:read":    "view",
+	"vm:create":  "create",
+	"vm:operate": "operate",
+}
+
+// vmScope describes which VMs an actor may act on.
+// A nil serviceIDs slice means global access (no service restriction).
+type vmScope struct {
+	serviceIDs []string
+}
+
+func (v vmScope) global() bool {
+	return v.serviceIDs == nil
+}
+
+func (v vmScope) allowsService(serviceID string) bool {
+	return v.global() || slices.Contains(v.serviceIDs, serviceID)
+}
+
+// requireVMPermission enforces a VM permission, falling back to service-scoped
+// ResourceRoleBindings when the global permission is absent.
+//
+// Rules:
+// - platform:admin or the global permission => global scope
+// - otherwise, service bindings whose role allows the action => scoped to those services
+// - no qualifying binding => 403 (401 when unauthenticated)
+func (s *Server) requireVMPermission(c *gin.Context, permission string) (vmScope, bool) {
+	ctx := c.Request.Context()
+	actor := middleware.GetUserID(ctx)
+	if strings.TrimSpace(actor) == "" {
+		c.JSON(http.StatusUnauthorized, generated.Error{Code: "UNAUTHORIZED"})
+		return vmScope{}, false
+	}
+
+	if permsRaw, exists := c.Get("permissions"); exists {
+		if permList, ok := permsRaw.([]string); ok &&
+			(slices.Contains(permList, "platform:admin") || slices.Contains(permList, permission)) {
+			return vmScope{}, true
+		}
+	}
+
+	action, ok := serviceScopedVMActions[permission]
+	if !ok {
+		c.JSON(http.StatusForbidden, generated.Error{Code: "FORBIDDEN"})
+		return vmScope{}, false
+	}
+
+	bindings, err := s.client.ResourceRoleBinding.Query().
+		Where(
+			resourcerolebinding.UserIDEQ(actor),
+			resourcerolebinding.ResourceTypeEQ("service"),
+		).
+		All(ctx)
+	if err != nil {
+		logger.Error("failed to load service role bindings", zap.Error(err), zap.String("actor", actor))
+		c.JSON(http.StatusInternalServerError, generated.Error{Code: "INTERNAL_ERROR"})
+		return vmScope{}, false
+	}
+
+	serviceIDs := make([]string, 0, len(bindings))
+	for _, binding := range bindings {
+		if middleware.RoleCanPerform(middleware.ResourceRole(binding.Role.String()), action) {
+			serviceIDs = append(serviceIDs, binding.ResourceID)
+		}
+	}
+	if len(serviceIDs) == 0 {
+		c.JSON(http.StatusForbidden, generated.Error{Code: "FORBIDDEN"})
+		return vmScope{}, false
+	}
+	return vmScope{serviceIDs: serviceIDs}, true
+}
+
+// vmInScope reports whether the VM belongs to one of the scoped services.
+func (s *Server) vmInScope(ctx context.Context, vmID string, scope vmScope) (bool, error) {
+	if scope.global() {
+		return true, nil
+	}
+	return s.client.VM.Query().
+		Where(
+			entvm.IDEQ(vmID),
+			entvm.HasServiceWith(entservice.IDIn(scope.serviceIDs...)),
+		).
+		Exist(ctx)
+}
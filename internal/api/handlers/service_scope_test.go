@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/resourcerolebinding"
+	"kv-shepherd.io/shepherd/internal/api/generated"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func mustBindServiceRole(t *testing.T, client *ent.Client, userID, serviceID string, role resourcerolebinding.Role) {
+	t.Helper()
+	client.ResourceRoleBinding.Create().
+		SetID(uuid.NewString()).
+		SetUserID(userID).
+		SetResourceType("service").
+		SetResourceID(serviceID).
+		SetRole(role).
+		SetCreatedBy("admin").
+		SaveX(t.Context())
+}
+
+func TestCreateServiceRoleBinding(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "service_role_binding_create")
+	mustCreateSystem(t, client, "sys-shop", "shop", "alice")
+	mustCreateService(t, client, "svc-redis", "redis", "sys-shop", "cache")
+	client.User.Create().SetID("carol").SetUsername("carol").SetEnabled(true).SaveX(t.Context())
+	srv := NewServer(ServerDeps{EntClient: client})
+	manager := []string{"rbac:manage"}
+
+	create := func(serviceID string, req generated.ServiceRoleBindingCreateRequest, perms []string) (int, []byte) {
+		c, w := newAuthedGinContext(t, http.MethodPost, "/services/"+serviceID+"/role-bindings", mustJSON(t, req), "admin", perms)
+		srv.CreateServiceRoleBinding(c, serviceID)
+		return w.Code, w.Body.Bytes()
+	}
+
+	code, raw := create("svc-redis", generated.ServiceRoleBindingCreateRequest{UserId: "carol", Role: "member"}, manager)
+	if code != http.StatusCreated {
+		t.Fatalf("create status = %d, want %d body=%s", code, http.StatusCreated, raw)
+	}
+	var out generated.ServiceRoleBinding
+	mustDecodeJSON(t, raw, &out)
+	if out.UserId != "carol" || out.ServiceId != "svc-redis" || out.Role != "member" || out.CreatedBy != "admin" {
+		t.Fatalf("binding = %+v, want carol/svc-redis/member by admin", out)
+	}
+	stored := client.ResourceRoleBinding.GetX(t.Context(), out.Id)
+	if stored.ResourceType != "service" {
+		t.Fatalf("resource_type = %q, want service", stored.ResourceType)
+	}
+
+	tests := []struct {
+		name      string
+		serviceID string
+		req       generated.ServiceRoleBindingCreateRequest
+		perms     []string
+		wantCode  int
+		wantError string
+	}{
+		{
+			name:      "duplicate binding",
+			serviceID: "svc-redis",
+			req:       generated.ServiceRoleBindingCreateRequest{UserId: "carol", Role: "viewer"},
+			perms:     manager,
+			wantCode:  http.StatusConflict,
+			wantError: "ROLE_BINDING_ALREADY_EXISTS",
+		},
+		{
+			name:      "unknown role",
+			serviceID: "svc-redis",
+			req:       generated.ServiceRoleBindingCreateRequest{UserId: "carol", Role: "operator"},
+			perms:     manager,
+			wantCode:  http.StatusBadRequest,
+			wantError: "INVALID_ROLE",
+		},
+		{
+			name:      "unknown service",
+			serviceID: "svc-missing",
+			req:       generated.ServiceRoleBindingCreateRequest{UserId: "carol", Role: "viewer"},
+			perms:     manager,
+			wantCode:  http.StatusNotFound,
+			wantError: "SERVICE_NOT_FOUND",
+		},
+		{
+			name:      "unknown user",
+			serviceID: "svc-redis",
+			req:       generated.ServiceRoleBindingCreateRequest{UserId: "nobody", Role: "viewer"},
+			perms:     manager,
+			wantCode:  http.StatusNotFound,
+			wantError: "USER_NOT_FOUND",
+		},
+		{
+			name:      "missing rbac:manage",
+			serviceID: "svc-redis",
+			req:       generated.ServiceRoleBindingCreateRequest{UserId: "carol", Role: "viewer"},
+			perms:     []string{"rbac:read"},
+			wantCode:  http.StatusForbidden,
+			wantError: "FORBIDDEN",
+		},
+	}
+	for _, tt := range tests {
+		code, raw := create(tt.serviceID, tt.req, tt.perms)
+		if code != tt.wantCode {
+			t.Fatalf("%s: status = %d, want %d body=%s", tt.name, code, tt.wantCode, raw)
+		}
+		assertErrorCode(t, raw, tt.wantError)
+	}
+}
+
+func TestServiceScopedVMAccess(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	gin.SetMode(gin.TestMode)
+	client := testutil.OpenEntPostgres(t, "service_scoped_vm_access")
+	seedVMListFixture(t, client)
+	mustBindServiceRole(t, client, "carol", "svc-redis", resourcerolebinding.RoleViewer)
+	mustBindServiceRole(t, client, "dave", "svc-web", resourcerolebinding.RoleOwner)
+	srv := NewServer(ServerDeps{EntClient: client})
+
+	t.Run("list limited to bound service", func(t *testing.T) {
+		_, ids := listVMIDs(t, srv, "carol", []string{}, generated.ListVMsParams{})
+		if !slices.Equal(ids, []string{"vm-b", "vm-a"}) {
+			t.Fatalf("ids = %v, want [vm-b vm-a]", ids)
+		}
+		_, ids = listVMIDs(t, srv, "carol", []string{}, generated.ListVMsParams{ClusterId: "cluster-a"})
+		if !slices.Equal(ids, []string{"vm-a"}) {
+			t.Fatalf("filtered ids = %v, want [vm-a]", ids)
+		}
+	})
+
+	t.Run("no binding is forbidden", func(t *testing.T) {
+		c, w := newAuthedGinContext(t, http.MethodGet, "/vms", "", "eve", []string{})
+		srv.ListVMs(c, generated.ListVMsParams{})
+		if w.Code != http.StatusForbidden {
+			t.Fatalf("status = %d, want %d body=%s", w.Code, http.StatusForbidden, w.Body.String())
+		}
+	})
+
+	t.Run("get outside bound service is not found", func(t *testing.T) {
+		for vmID, want := range map[string]int{"vm-a": http.StatusOK, "vm-c": http.StatusNotFound} {
+			c, w := newAuthedGinContext(t, http.MethodGet, "/vms/"+vmID, "", "carol", []string{})
+			srv.GetVM(c, vmID, generated.GetVMParams{})
+			if w.Code != want {
+				t.Fatalf("GetVM(%s) status = %d, want %d body=%s", vmID, w.Code, want, w.Body.String())
+			}
+		}
+	})
+
+	t.Run("operate requires a role allowing it", func(t *testing.T) {
+		c, w := newAuthedGinContext(t, http.MethodPost, "/vms/vm-b/start", "", "carol", []string{})
+		srv.StartVM(c, "vm-b")
+		if w.Code != http.StatusForbidden {
+			t.Fatalf("viewer start status = %d, want %d body=%s", w.Code, http.StatusForbidden, w.Body.String())
+		}
+
+		c, w = newAuthedGinContext(t, http.MethodPost, "/vms/vm-b/start", "", "dave", []string{})
+		srv.StartVM(c, "vm-b")
+		if w.Code != http.StatusNotFound {
+			t.Fatalf("out-of-scope start status = %d, want %d body=%s", w.Code, http.StatusNotFound, w.Body.String())
+		}
+	})
+}
+
+func TestSubmitVMBatch_ServiceScopedCreateRejectsOtherService(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newBatchBehaviorTestServer(t)
+	allowed := uuid.New()
+	mustBindServiceRole(t, client, "carol", allowed.String(), resourcerolebinding.RoleMember)
+
+	body := mustJSON(t, generated.VMBatchSubmitRequest{
+		Operation: generated.VMBatchOperationCREATE,
+		Items: []generated.VMBatchChildItem{{
+			ServiceId:      uuid.New(),
+			TemplateId:     uuid.New(),
+			InstanceSizeId: uuid.New(),
+			Namespace:      "prod-shop",
+		}},
+	})
+	c, w := newAuthedGinContext(t, http.MethodPost, "/vms/batch", body, "carol", []string{})
+	srv.SubmitVMBatch(c)
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("status = %d, want %d body=%s", w.Code, http.StatusForbidden, w.Body.String())
+	}
+	assertErrorCode(t, w.Body.Bytes(), "SERVICE_FORBIDDEN")
+}
@@ -35,6 +35,13 @@ type TokenRevocationChecker interface {
 	IsRevoked(ctx context.Context, tokenID string) (bool, error)
 }
 
+// UserTokenRevocationChecker is optionally implemented by a
+// TokenRevocationChecker to revoke all tokens of a user at once: tokens
+// issued before the returned time are revoked. The zero time revokes none.
+type UserTokenRevocationChecker interface {
+	UserTokensRevokedBefore(ctx context.Context, userID string) (time.Time, error)
+}
+
 // JWTConfig holds JWT signing configuration.
 type JWTConfig struct {
 	SigningKey        []byte
@@ -43,19 +50,32 @@ type JWTConfig struct {
 	ExpiresIn         time.Duration
 	Leeway            time.Duration
 	RevocationChecker TokenRevocationChecker
+	// PermissionResolver, when set, supplies the permissions of each request
+	// instead of the token's login-time snapshot.
+	PermissionResolver PermissionResolver
 }
 
 // GenerateToken creates a signed JWT for the given user.
 func GenerateToken(cfg JWTConfig, userID, username string, roles, permissions []string) (string, time.Time, error) {
+	token, claims, err := GenerateTokenWithClaims(cfg, userID, username, roles, permissions)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, claims.ExpiresAt.Time, nil
+}
+
+// GenerateTokenWithClaims creates a signed JWT and returns its claims, so
+// callers can record the token ID (jti) for revocation.
+func GenerateTokenWithClaims(cfg JWTConfig, userID, username string, roles, permissions []string) (string, *JWTClaims, error) {
 	if len(cfg.SigningKey) == 0 {
-		return "", time.Time{}, ErrJWTSigningKeyMissing
+		return "", nil, ErrJWTSigningKeyMissing
 	}
 
 	now := time.Now()
 	expiresAt := now.Add(cfg.ExpiresIn)
 	tokenID, err := uuid.NewV7()
 	if err != nil {
-		return "", time.Time{}, fmt.Errorf("generate token id: %w", err)
+		return "", nil, fmt.Errorf("generate token id: %w", err)
 	}
 
 	claims := JWTClaims{
@@ -76,9 +96,9 @@ func GenerateToken(cfg JWTConfig, userID, username string, roles, permissions []
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	tokenString, err := token.SignedString(cfg.SigningKey)
 	if err != nil {
-		return "", time.Time{}, fmt.Errorf("sign token: %w", err)
+		return "", nil, fmt.Errorf("sign token: %w", err)
 	}
-	return tokenString, expiresAt, nil
+	return tokenString, &claims, nil
 }
 
 func (cfg JWTConfig) parserOptions() []jwt.ParserOption {
@@ -165,6 +185,16 @@ func (cfg JWTConfig) ValidateToken(ctx context.Context, tokenString string) (*JW
 		if revoked {
 			return nil, ErrTokenRevoked
 		}
+		if userChecker, ok := cfg.RevocationChecker.(UserTokenRevocationChecker); ok {
+			before, err := userChecker.UserTokensRevokedBefore(ctx, claims.UserID)
+			if err != nil {
+				return nil, fmt.Errorf("check user token revocation: %w", err)
+			}
+			// A token without an issue time cannot prove it postdates the revocation.
+			if !before.IsZero() && (claims.IssuedAt == nil || claims.IssuedAt.Before(before)) {
+				return nil, ErrTokenRevoked
+			}
+		}
 	}
 
 	return claims, nil
@@ -211,13 +241,27 @@ func JWTAuthWithConfig(cfg JWTConfig) gin.HandlerFunc {
 			return
 		}
 
+		permissions := claims.Permissions
+		if cfg.PermissionResolver != nil {
+			permissions, err = cfg.PermissionResolver.GlobalPermissions(c.Request.Context(), claims.UserID)
+			if err != nil {
+				// Fail closed: never fall back to a possibly revoked snapshot.
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+					"code":    "INTERNAL_ERROR",
+					"message": "permission lookup failed",
+				})
+				return
+			}
+		}
+
 		// Populate context for downstream handlers.
 		c.Set("user_id", claims.UserID)
 		c.Set("username", claims.Username)
 		c.Set("roles", claims.Roles)
-		c.Set("permissions", claims.Permissions)
+		c.Set("permissions", permissions)
+		c.Set("token_id", claims.ID)
 		c.Request = c.Request.WithContext(
-			SetUserContext(c.Request.Context(), claims.UserID, claims.Username, claims.Roles),
+			SetTokenID(SetUserContext(c.Request.Context(), claims.UserID, claims.Username, claims.Roles), claims.ID),
 		)
 
 		c.Next()
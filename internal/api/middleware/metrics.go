@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"kv-shepherd.io/shepherd/internal/pkg/metrics"
+)
+
+// unmatchedRoute labels requests that matched no registered route, so
+// arbitrary paths cannot blow up the route label's cardinality.
+const unmatchedRoute = "unmatched"
+
+// Metrics records shepherd_http_request_duration_seconds for every request,
+// labelled by the matched route template rather than the raw path.
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		metrics.HTTPRequestDuration.
+			WithLabelValues(c.Request.Method, route, strconv.Itoa(c.Writer.Status())).
+			Observe(time.Since(start).Seconds())
+	}
+}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/riverqueue/river/rivertype"
+
+	"kv-shepherd.io/shepherd/internal/pkg/metrics"
+)
+
+func TestMetrics_ScrapeAfterHandlerAndWorker(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(Metrics())
+	router.GET("/api/v1/vms/:vm_id", func(c *gin.Context) { c.Status(http.StatusNotFound) })
+	for _, path := range []string{"/api/v1/vms/vm-1", "/api/v1/vms/vm-2", "/no/such/route"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	job := &rivertype.JobRow{Kind: "metrics_scrape_test_job"}
+	if err := metrics.NewJobMiddleware().Work(context.Background(), job, func(context.Context) error { return nil }); err != nil {
+		t.Fatalf("Work() error = %v", err)
+	}
+
+	w := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	raw, err := io.ReadAll(w.Body)
+	if err != nil {
+		t.Fatalf("read scrape body: %v", err)
+	}
+	body := string(raw)
+	for _, want := range []string{
+		// Route templates, not raw paths, so both VM requests share a series.
+		`shepherd_http_request_duration_seconds_count{method="GET",route="/api/v1/vms/:vm_id",status="404"} 2`,
+		`shepherd_http_request_duration_seconds_count{method="GET",route="unmatched",status="404"} 1`,
+		`shepherd_jobs_total{kind="metrics_scrape_test_job",result="success"} 1`,
+		`shepherd_job_duration_seconds_count{kind="metrics_scrape_test_job"} 1`,
+		"# TYPE shepherd_pending_approvals gauge",
+		"# TYPE shepherd_pending_batch_parents gauge",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("scrape output missing %q", want)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultPermissionCacheTTL bounds how long resolved permissions are trusted.
+// A role binding change made on another replica takes effect within it.
+const DefaultPermissionCacheTTL = 30 * time.Second
+
+// PermissionResolver returns a user's current global permissions. When set on
+// JWTConfig it replaces the permissions snapshot taken into the token at
+// login, so role binding changes apply to tokens already issued.
+type PermissionResolver interface {
+	GlobalPermissions(ctx context.Context, userID string) ([]string, error)
+}
+
+// CachedPermissionResolver memoizes a PermissionResolver per user for the
+// TTL. Invalidate and InvalidateAll make changes made by this process
+// effective immediately.
+type CachedPermissionResolver struct {
+	inner PermissionResolver
+	ttl   time.Duration
+	now   func() time.Time
+
+	mu      sync.RWMutex
+	entries map[string]permissionEntry
+}
+
+type permissionEntry struct {
+	permissions []string
+	resolvedAt  time.Time
+}
+
+// NewCachedPermissionResolver wraps inner with a cache; non-positive ttl uses
+// DefaultPermissionCacheTTL.
+func NewCachedPermissionResolver(inner PermissionResolver, ttl time.Duration) *CachedPermissionResolver {
+	if ttl <= 0 {
+		ttl = DefaultPermissionCacheTTL
+	}
+	return &CachedPermissionResolver{
+		inner:   inner,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]permissionEntry),
+	}
+}
+
+// GlobalPermissions implements PermissionResolver.
+func (c *CachedPermissionResolver) GlobalPermissions(ctx context.Context, userID string) ([]string, error) {
+	now := c.now()
+	c.mu.RLock()
+	entry, ok := c.entries[userID]
+	c.mu.RUnlock()
+	if ok && now.Sub(entry.resolvedAt) < c.ttl {
+		return entry.permissions, nil
+	}
+
+	permissions, err := c.inner.GlobalPermissions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	if len(c.entries) >= revocationCachePruneSize {
+		for id, e := range c.entries {
+			if now.Sub(e.resolvedAt) >= c.ttl {
+				delete(c.entries, id)
+			}
+		}
+	}
+	c.entries[userID] = permissionEntry{permissions: permissions, resolvedAt: now}
+	c.mu.Unlock()
+	return permissions, nil
+}
+
+// Invalidate drops the cached permissions of userIDs.
+func (c *CachedPermissionResolver) Invalidate(userIDs ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, id := range userIDs {
+		delete(c.entries, id)
+	}
+}
+
+// InvalidateAll drops every cached entry, e.g. after a role's permissions
+// change.
+func (c *CachedPermissionResolver) InvalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.entries)
+}
@@ -0,0 +1,108 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// storePermissionResolver stands in for the role binding store.
+type storePermissionResolver struct {
+	mu          sync.Mutex
+	permissions map[string][]string
+	err         error
+	calls       int
+}
+
+func (s *storePermissionResolver) GlobalPermissions(_ context.Context, userID string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	return s.permissions[userID], s.err
+}
+
+func (s *storePermissionResolver) set(userID string, permissions ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.permissions[userID] = permissions
+}
+
+func TestJWTAuthWithConfig_ResolvesCurrentPermissions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	store := &storePermissionResolver{permissions: map[string][]string{"u-1": {"vm:read"}}}
+	cfg := JWTConfig{
+		SigningKey:         []byte("middleware-permission-key-1234567890123"),
+		Issuer:             "shepherd",
+		ExpiresIn:          time.Hour,
+		PermissionResolver: NewCachedPermissionResolver(store, time.Hour),
+	}
+	// The token's login-time snapshot still grants platform:admin.
+	token, _, err := GenerateTokenWithClaims(cfg, "u-1", "alice", nil, []string{"platform:admin"})
+	require.NoError(t, err)
+
+	serve := func() (int, []string) {
+		var permissions []string
+		router := gin.New()
+		router.Use(JWTAuthWithConfig(cfg))
+		router.GET("/me", func(c *gin.Context) {
+			permissions = c.GetStringSlice("permissions")
+			c.Status(http.StatusNoContent)
+		})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/me", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		router.ServeHTTP(w, req)
+		return w.Code, permissions
+	}
+
+	code, permissions := serve()
+	require.Equal(t, http.StatusNoContent, code)
+	assert.Equal(t, []string{"vm:read"}, permissions)
+
+	// Changes reach the request once this process invalidates the user.
+	store.set("u-1", "vm:read", "vm:operate")
+	_, permissions = serve()
+	assert.Equal(t, []string{"vm:read"}, permissions, "cached permissions replaced before invalidation")
+	cfg.PermissionResolver.(*CachedPermissionResolver).Invalidate("u-1")
+	_, permissions = serve()
+	assert.Equal(t, []string{"vm:read", "vm:operate"}, permissions)
+
+	// Lookup failures fail closed.
+	cfg.PermissionResolver.(*CachedPermissionResolver).InvalidateAll()
+	store.err = errors.New("db down")
+	code, _ = serve()
+	assert.Equal(t, http.StatusInternalServerError, code)
+}
+
+func TestCachedPermissionResolver_TTL(t *testing.T) {
+	store := &storePermissionResolver{permissions: map[string][]string{}}
+	cache := NewCachedPermissionResolver(store, time.Minute)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	_, err := cache.GlobalPermissions(ctx, "u-1")
+	require.NoError(t, err)
+
+	// Changed on another replica: visible only after the TTL.
+	store.set("u-1", "rbac:manage")
+	now = now.Add(59 * time.Second)
+	permissions, err := cache.GlobalPermissions(ctx, "u-1")
+	require.NoError(t, err)
+	assert.Empty(t, permissions)
+	assert.Equal(t, 1, store.calls)
+
+	now = now.Add(time.Second)
+	permissions, err = cache.GlobalPermissions(ctx, "u-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rbac:manage"}, permissions)
+	assert.Equal(t, 2, store.calls)
+}
@@ -51,10 +51,11 @@ func RequirePermission(permission string) gin.HandlerFunc {
 type ResourceRole string
 
 const (
-	ResourceRoleOwner  ResourceRole = "owner"
-	ResourceRoleAdmin  ResourceRole = "admin"
-	ResourceRoleMember ResourceRole = "member"
-	ResourceRoleViewer ResourceRole = "viewer"
+	ResourceRoleOwner      ResourceRole = "owner"
+	ResourceRoleAdmin      ResourceRole = "admin"
+	ResourceRoleMaintainer ResourceRole = "maintainer"
+	ResourceRoleMember     ResourceRole = "member"
+	ResourceRoleViewer     ResourceRole = "viewer"
 )
 
 // ResourceRoleChecker provides hierarchical resource permission checking.
@@ -149,6 +150,9 @@ func RoleCanPerform(role ResourceRole, action string) bool {
 		return true
 	case ResourceRoleAdmin:
 		return action != "transfer_ownership"
+	case ResourceRoleMaintainer:
+		// Maintainers run the resource but cannot delete it or hand it over.
+		return action != "transfer_ownership" && action != "delete"
 	case ResourceRoleMember:
 		return action == "view" || action == "create"
 	case ResourceRoleViewer:
@@ -21,6 +21,10 @@ func TestRoleCanPerform_Stage4Matrix(t *testing.T) {
 		{"owner can manage members", ResourceRoleOwner, "manage_members", true},
 		{"admin cannot transfer ownership", ResourceRoleAdmin, "transfer_ownership", false},
 		{"admin can manage members", ResourceRoleAdmin, "manage_members", true},
+		{"maintainer can update", ResourceRoleMaintainer, "update", true},
+		{"maintainer can manage members", ResourceRoleMaintainer, "manage_members", true},
+		{"maintainer cannot delete", ResourceRoleMaintainer, "delete", false},
+		{"maintainer cannot transfer ownership", ResourceRoleMaintainer, "transfer_ownership", false},
 		{"member can view", ResourceRoleMember, "view", true},
 		{"member can create", ResourceRoleMember, "create", true},
 		{"member cannot manage members", ResourceRoleMember, "manage_members", false},
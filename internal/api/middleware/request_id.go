@@ -17,6 +17,7 @@ const (
 	ctxKeyUserID    contextKey = "user_id"
 	ctxKeyUsername  contextKey = "username"
 	ctxKeyRoles     contextKey = "roles"
+	ctxKeyTokenID   contextKey = "token_id"
 )
 
 // RequestID injects a unique request ID into the context and response header.
@@ -52,6 +53,19 @@ func SetUserContext(ctx context.Context, userID, username string, roles []string
 	return ctx
 }
 
+// SetTokenID stores the authenticated token's ID (JWT jti) in context.
+func SetTokenID(ctx context.Context, tokenID string) context.Context {
+	return context.WithValue(ctx, ctxKeyTokenID, tokenID)
+}
+
+// GetTokenID extracts the authenticated token's ID from context.
+func GetTokenID(ctx context.Context) string {
+	if v, ok := ctx.Value(ctxKeyTokenID).(string); ok {
+		return v
+	}
+	return ""
+}
+
 // GetUserID extracts user ID from context.
 func GetUserID(ctx context.Context) string {
 	if v, ok := ctx.Value(ctxKeyUserID).(string); ok {
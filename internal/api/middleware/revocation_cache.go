@@ -0,0 +1,148 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRevocationCacheTTL bounds how long a cached "not revoked" answer
+	// is trusted. A revocation made on another replica takes effect within it.
+	DefaultRevocationCacheTTL = 30 * time.Second
+
+	revocationCachePruneSize = 10000
+)
+
+// CachedRevocationChecker memoizes a TokenRevocationChecker so the auth hot
+// path does not query the backing store on every request.
+//
+// Revoked answers are final and kept until pruned; "not revoked" answers are
+// re-checked after the TTL. MarkRevoked makes revocations issued by this
+// process effective immediately.
+//
+// When inner implements UserTokenRevocationChecker, so does the cache: the
+// per-user cutoffs are re-checked after the TTL and MarkUserRevoked applies
+// local revocations at once.
+type CachedRevocationChecker struct {
+	inner TokenRevocationChecker
+	ttl   time.Duration
+	now   func() time.Time
+
+	mu      sync.RWMutex
+	entries map[string]revocationEntry
+	users   map[string]userRevocationEntry
+}
+
+type revocationEntry struct {
+	revoked   bool
+	checkedAt time.Time
+}
+
+type userRevocationEntry struct {
+	before    time.Time
+	checkedAt time.Time
+}
+
+// NewCachedRevocationChecker wraps inner with a cache; non-positive ttl uses
+// DefaultRevocationCacheTTL.
+func NewCachedRevocationChecker(inner TokenRevocationChecker, ttl time.Duration) *CachedRevocationChecker {
+	if ttl <= 0 {
+		ttl = DefaultRevocationCacheTTL
+	}
+	return &CachedRevocationChecker{
+		inner:   inner,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]revocationEntry),
+		users:   make(map[string]userRevocationEntry),
+	}
+}
+
+// IsRevoked implements TokenRevocationChecker.
+func (c *CachedRevocationChecker) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	now := c.now()
+	c.mu.RLock()
+	entry, ok := c.entries[tokenID]
+	c.mu.RUnlock()
+	if ok && (entry.revoked || now.Sub(entry.checkedAt) < c.ttl) {
+		return entry.revoked, nil
+	}
+
+	revoked, err := c.inner.IsRevoked(ctx, tokenID)
+	if err != nil {
+		return false, err
+	}
+	c.mu.Lock()
+	c.pruneLocked(now)
+	c.entries[tokenID] = revocationEntry{revoked: revoked, checkedAt: now}
+	c.mu.Unlock()
+	return revoked, nil
+}
+
+// MarkRevoked records tokens revoked by this process without waiting for
+// the cached answers to expire.
+func (c *CachedRevocationChecker) MarkRevoked(tokenIDs ...string) {
+	now := c.now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, id := range tokenIDs {
+		c.entries[id] = revocationEntry{revoked: true, checkedAt: now}
+	}
+}
+
+// UserTokensRevokedBefore implements UserTokenRevocationChecker. It reports
+// no cutoff when the wrapped checker does not support user revocation.
+func (c *CachedRevocationChecker) UserTokensRevokedBefore(ctx context.Context, userID string) (time.Time, error) {
+	inner, ok := c.inner.(UserTokenRevocationChecker)
+	if !ok {
+		return time.Time{}, nil
+	}
+	now := c.now()
+	c.mu.RLock()
+	entry, ok := c.users[userID]
+	c.mu.RUnlock()
+	if ok && now.Sub(entry.checkedAt) < c.ttl {
+		return entry.before, nil
+	}
+
+	before, err := inner.UserTokensRevokedBefore(ctx, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	c.mu.Lock()
+	c.pruneLocked(now)
+	c.users[userID] = userRevocationEntry{before: before, checkedAt: now}
+	c.mu.Unlock()
+	return before, nil
+}
+
+// MarkUserRevoked records a user-wide revocation made by this process
+// without waiting for the cached cutoff to expire.
+func (c *CachedRevocationChecker) MarkUserRevoked(userID string, before time.Time) {
+	now := c.now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry, ok := c.users[userID]; ok && entry.before.After(before) {
+		before = entry.before
+	}
+	c.users[userID] = userRevocationEntry{before: before, checkedAt: now}
+}
+
+// pruneLocked drops stale entries once the cache grows large. Revoked
+// entries are dropped too: the backing store still answers for them.
+func (c *CachedRevocationChecker) pruneLocked(now time.Time) {
+	if len(c.entries)+len(c.users) < revocationCachePruneSize {
+		return
+	}
+	for id, entry := range c.entries {
+		if now.Sub(entry.checkedAt) >= c.ttl {
+			delete(c.entries, id)
+		}
+	}
+	for id, entry := range c.users {
+		if now.Sub(entry.checkedAt) >= c.ttl {
+			delete(c.users, id)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// storeRevocationChecker stands in for the session store: revocations can
+// change between calls and lookups are counted.
+type storeRevocationChecker struct {
+	mu      sync.Mutex
+	revoked map[string]bool
+	calls   int
+}
+
+func (s *storeRevocationChecker) IsRevoked(_ context.Context, tokenID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	return s.revoked[tokenID], nil
+}
+
+func (s *storeRevocationChecker) revoke(tokenID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.revoked[tokenID] = true
+}
+
+// userStoreRevocationChecker adds user-wide revocation cutoffs.
+type userStoreRevocationChecker struct {
+	storeRevocationChecker
+	before map[string]time.Time
+}
+
+func (s *userStoreRevocationChecker) UserTokensRevokedBefore(_ context.Context, userID string) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	return s.before[userID], nil
+}
+
+func TestJWTAuthWithConfig_RejectsRevokedToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	store := &storeRevocationChecker{revoked: map[string]bool{}}
+	cfg := JWTConfig{
+		SigningKey:        []byte("middleware-revocation-key-12345678901234"),
+		Issuer:            "shepherd",
+		ExpiresIn:         time.Hour,
+		RevocationChecker: store,
+	}
+	token, claims, err := GenerateTokenWithClaims(cfg, "u-1", "alice", nil, nil)
+	require.NoError(t, err)
+
+	serve := func() (*httptest.ResponseRecorder, string) {
+		var tokenID string
+		router := gin.New()
+		router.Use(JWTAuthWithConfig(cfg))
+		router.GET("/me", func(c *gin.Context) {
+			tokenID = GetTokenID(c.Request.Context())
+			c.Status(http.StatusNoContent)
+		})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/me", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		router.ServeHTTP(w, req)
+		return w, tokenID
+	}
+
+	w, tokenID := serve()
+	require.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, claims.ID, tokenID)
+
+	store.revoke(claims.ID)
+	w, _ = serve()
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Contains(t, w.Body.String(), "token revoked")
+}
+
+func TestCachedRevocationChecker_StalenessWindow(t *testing.T) {
+	store := &storeRevocationChecker{revoked: map[string]bool{}}
+	cache := NewCachedRevocationChecker(store, time.Minute)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	revoked, err := cache.IsRevoked(ctx, "jti-1")
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	// Revoked in the store by another replica: the cached answer stands
+	// until the TTL passes.
+	store.revoke("jti-1")
+	now = now.Add(59 * time.Second)
+	revoked, err = cache.IsRevoked(ctx, "jti-1")
+	require.NoError(t, err)
+	assert.False(t, revoked, "revocation visible before the cache TTL")
+	assert.Equal(t, 1, store.calls)
+
+	now = now.Add(time.Second)
+	revoked, err = cache.IsRevoked(ctx, "jti-1")
+	require.NoError(t, err)
+	assert.True(t, revoked, "revocation not visible after the cache TTL")
+
+	// Revoked answers are final and no longer hit the store.
+	now = now.Add(time.Hour)
+	revoked, err = cache.IsRevoked(ctx, "jti-1")
+	require.NoError(t, err)
+	assert.True(t, revoked)
+	assert.Equal(t, 2, store.calls)
+
+	// Local revocations apply at once, even over a fresh cached answer.
+	revoked, err = cache.IsRevoked(ctx, "jti-2")
+	require.NoError(t, err)
+	assert.False(t, revoked)
+	cache.MarkRevoked("jti-2")
+	revoked, err = cache.IsRevoked(ctx, "jti-2")
+	require.NoError(t, err)
+	assert.True(t, revoked)
+}
+
+func TestValidateToken_UserRevocation(t *testing.T) {
+	store := &userStoreRevocationChecker{
+		storeRevocationChecker: storeRevocationChecker{revoked: map[string]bool{}},
+		before:                 map[string]time.Time{},
+	}
+	cfg := JWTConfig{
+		SigningKey:        []byte("middleware-revocation-key-12345678901234"),
+		Issuer:            "shepherd",
+		ExpiresIn:         time.Hour,
+		RevocationChecker: NewCachedRevocationChecker(store, time.Hour),
+	}
+	token, claims, err := GenerateTokenWithClaims(cfg, "u-1", "alice", nil, nil)
+	require.NoError(t, err)
+	other, _, err := GenerateTokenWithClaims(cfg, "u-2", "bob", nil, nil)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = cfg.ValidateToken(ctx, token)
+	require.NoError(t, err)
+
+	// A cutoff equal to the issue time keeps the token valid.
+	cache := cfg.RevocationChecker.(*CachedRevocationChecker)
+	cache.MarkUserRevoked("u-1", claims.IssuedAt.Time)
+	_, err = cfg.ValidateToken(ctx, token)
+	require.NoError(t, err)
+
+	cache.MarkUserRevoked("u-1", claims.IssuedAt.Add(time.Second))
+	_, err = cfg.ValidateToken(ctx, token)
+	assert.ErrorIs(t, err, ErrTokenRevoked)
+	_, err = cfg.ValidateToken(ctx, other)
+	require.NoError(t, err, "revocation leaked to another user")
+
+	// An older cutoff never moves a local revocation back.
+	cache.MarkUserRevoked("u-1", claims.IssuedAt.Add(-time.Hour))
+	_, err = cfg.ValidateToken(ctx, token)
+	assert.ErrorIs(t, err, ErrTokenRevoked)
+}
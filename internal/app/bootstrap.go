@@ -13,8 +13,10 @@ import (
 	"kv-shepherd.io/shepherd/internal/api/handlers"
 	"kv-shepherd.io/shepherd/internal/app/modules"
 	"kv-shepherd.io/shepherd/internal/config"
+	"kv-shepherd.io/shepherd/internal/health"
 	"kv-shepherd.io/shepherd/internal/infrastructure"
 	"kv-shepherd.io/shepherd/internal/jobs"
+	"kv-shepherd.io/shepherd/internal/pkg/metrics"
 	"kv-shepherd.io/shepherd/internal/pkg/worker"
 	"kv-shepherd.io/shepherd/internal/provider"
 	_ "kv-shepherd.io/shepherd/plugins/authprovider/autoreg"
@@ -29,6 +31,16 @@ type Application struct {
 	Modules     []modules.Module
 	EntClient   *ent.Client
 	HealthCheck *provider.ClusterHealthChecker
+	Health      *health.Registry
+	Metrics     *metrics.Sampler // nil when metrics are disabled
+}
+
+// HealthReport runs the registered health checks.
+func (a *Application) HealthReport(ctx context.Context) health.Report {
+	if a.Health == nil {
+		return health.Report{}
+	}
+	return a.Health.Run(ctx)
 }
 
 // Bootstrap initializes all dependencies using module-oriented manual DI.
@@ -74,6 +86,84 @@ func Bootstrap(ctx context.Context, cfg *config.Config) (*Application, error) {
 				&river.PeriodicJobOpts{RunOnStart: true},
 			),
 		)
+		// Expired rate-limit exemptions are purged hourly and on startup.
+		infra.RiverClient.PeriodicJobs().Add(
+			river.NewPeriodicJob(
+				river.PeriodicInterval(jobs.RateLimitExemptionPurgeInterval),
+				func() (river.JobArgs, *river.InsertOpts) {
+					return jobs.RateLimitExemptionPurgeArgs{}, nil
+				},
+				&river.PeriodicJobOpts{RunOnStart: true},
+			),
+		)
+		// VNC console sessions older than the retention window are purged hourly.
+		infra.RiverClient.PeriodicJobs().Add(
+			river.NewPeriodicJob(
+				river.PeriodicInterval(jobs.VMConsoleSessionPurgeInterval),
+				func() (river.JobArgs, *river.InsertOpts) {
+					return jobs.VMConsoleSessionPurgeArgs{}, nil
+				},
+				&river.PeriodicJobOpts{RunOnStart: true},
+			),
+		)
+		// Approved VNC access past its window is expired and revoked every minute.
+		infra.RiverClient.PeriodicJobs().Add(
+			river.NewPeriodicJob(
+				river.PeriodicInterval(jobs.VNCAccessExpiryInterval),
+				func() (river.JobArgs, *river.InsertOpts) {
+					return jobs.VNCAccessExpiryArgs{}, nil
+				},
+				&river.PeriodicJobOpts{RunOnStart: true},
+			),
+		)
+		// Audit logs past audit.retention_days are archived (when configured)
+		// and deleted daily and on startup.
+		infra.RiverClient.PeriodicJobs().Add(
+			river.NewPeriodicJob(
+				river.PeriodicInterval(jobs.AuditRetentionInterval),
+				func() (river.JobArgs, *river.InsertOpts) {
+					return jobs.AuditRetentionArgs{}, nil
+				},
+				&river.PeriodicJobOpts{RunOnStart: true},
+			),
+		)
+		// Cluster status reconciliation: probe API server readiness every
+		// k8s.health_check_interval (default one minute).
+		healthInterval := cfg.K8s.HealthCheckInterval
+		if healthInterval <= 0 {
+			healthInterval = jobs.ClusterHealthCheckInterval
+		}
+		infra.RiverClient.PeriodicJobs().Add(
+			river.NewPeriodicJob(
+				river.PeriodicInterval(healthInterval),
+				func() (river.JobArgs, *river.InsertOpts) {
+					return jobs.ClusterHealthCheckArgs{}, jobs.ClusterHealthCheckInsertOpts(healthInterval)
+				},
+				&river.PeriodicJobOpts{RunOnStart: true},
+			),
+		)
+		// Auth provider sync_schedule values are evaluated every minute; due
+		// providers have their IdP groups re-synced.
+		infra.RiverClient.PeriodicJobs().Add(
+			river.NewPeriodicJob(
+				river.PeriodicInterval(jobs.AuthProviderGroupSyncInterval),
+				func() (river.JobArgs, *river.InsertOpts) {
+					return jobs.AuthProviderGroupSyncArgs{}, nil
+				},
+				nil,
+			),
+		)
+		// Pending ticket expiry runs every minute; the worker is a no-op until a
+		// TTL is set via /admin/platform-config or approval.pending_ttl.
+		infra.RiverClient.PeriodicJobs().Add(
+			river.NewPeriodicJob(
+				river.PeriodicInterval(jobs.TicketExpiryInterval),
+				func() (river.JobArgs, *river.InsertOpts) {
+					return jobs.TicketExpiryArgs{}, nil
+				},
+				nil,
+			),
+		)
 	}
 
 	approvalModule, err := modules.NewApprovalModule(infra)
@@ -83,16 +173,25 @@ func Bootstrap(ctx context.Context, cfg *config.Config) (*Application, error) {
 	}
 
 	allModules := append(baseModules, approvalModule)
-	serverDeps := modules.NewServerDeps(cfg, infra, allModules)
-	server := handlers.NewServer(serverDeps)
-
-	return &Application{
+	application := &Application{
 		Config:      cfg,
-		Router:      newRouter(cfg, server, serverDeps.JWTCfg),
 		DB:          infra.DB,
 		Pools:       infra.Pools,
 		Modules:     allModules,
 		EntClient:   infra.EntClient,
 		HealthCheck: infra.HealthCheck,
-	}, nil
+		Health:      infra.Health,
+		Metrics:     infra.Metrics,
+	}
+	serverDeps := modules.NewServerDeps(cfg, infra, allModules)
+	serverDeps.HealthReport = application.HealthReport
+	server := handlers.NewServer(serverDeps)
+	for _, mod := range allModules {
+		if binder, ok := mod.(modules.ServerBinder); ok {
+			binder.BindServer(server)
+		}
+	}
+	application.Router = newRouter(cfg, server, serverDeps.JWTCfg)
+
+	return application, nil
 }
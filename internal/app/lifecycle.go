@@ -3,32 +3,49 @@ package app
 import (
 	"context"
 	"fmt"
-	"time"
 
+	"github.com/riverqueue/river"
 	"go.uber.org/zap"
 
-	entcluster "kv-shepherd.io/shepherd/ent/cluster"
+	"kv-shepherd.io/shepherd/internal/jobs"
 	"kv-shepherd.io/shepherd/internal/pkg/logger"
-	"kv-shepherd.io/shepherd/internal/provider"
 )
 
-// Start starts all background services (River workers, health checker).
+// Start starts all background services (River workers and periodic jobs).
 func (a *Application) Start(ctx context.Context) error {
 	if a.DB != nil && a.DB.RiverClient != nil {
+		// VM status drift reconciliation against live KubeVirt state.
+		a.DB.RiverClient.PeriodicJobs().Add(
+			river.NewPeriodicJob(
+				river.PeriodicInterval(jobs.VMStatusSyncInterval),
+				func() (river.JobArgs, *river.InsertOpts) {
+					return jobs.VMStatusSyncArgs{}, nil
+				},
+				nil,
+			),
+		)
+		// Cron-style batch power schedules (ScheduledBatchJob) are evaluated every minute.
+		a.DB.RiverClient.PeriodicJobs().Add(
+			river.NewPeriodicJob(
+				river.PeriodicInterval(jobs.ScheduledBatchPowerInterval),
+				func() (river.JobArgs, *river.InsertOpts) {
+					return jobs.ScheduledBatchPowerArgs{}, nil
+				},
+				nil,
+			),
+		)
 		if err := a.DB.RiverClient.Start(ctx); err != nil {
 			return fmt.Errorf("start river client: %w", err)
 		}
 		logger.Info("River client started, jobs will now be consumed")
 	}
 
-	if a.HealthCheck != nil && a.EntClient != nil {
-		if err := a.refreshClusterHealth(ctx); err != nil {
-			return fmt.Errorf("initial cluster health refresh: %w", err)
-		}
-		go a.runClusterHealthLoop(ctx) //nolint:naked-goroutine // dedicated background lifecycle loop.
-		logger.Info("Cluster health checker started")
+	if a.Metrics != nil {
+		a.Metrics.Start(ctx)
 	}
 
+	// Persisted cluster status is reconciled by the periodic
+	// cluster_health_check River job registered in Bootstrap.
 	return nil
 }
 
@@ -39,6 +56,9 @@ func (a *Application) Shutdown() {
 	if a.HealthCheck != nil {
 		a.HealthCheck.Stop()
 	}
+	if a.Metrics != nil {
+		a.Metrics.Stop()
+	}
 
 	if a.DB != nil && a.DB.RiverClient != nil {
 		if err := a.DB.RiverClient.Stop(shutdownCtx); err != nil {
@@ -66,62 +86,3 @@ func (a *Application) Shutdown() {
 		a.DB.Close()
 	}
 }
-
-func (a *Application) runClusterHealthLoop(ctx context.Context) {
-	ticker := time.NewTicker(60 * time.Second)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case <-ticker.C:
-			if err := a.refreshClusterHealth(ctx); err != nil {
-				logger.Warn("cluster health refresh failed", zap.Error(err))
-			}
-		}
-	}
-}
-
-func (a *Application) refreshClusterHealth(ctx context.Context) error {
-	clusters, err := a.EntClient.Cluster.Query().
-		Where(entcluster.EnabledEQ(true)).
-		All(ctx)
-	if err != nil {
-		return fmt.Errorf("query enabled clusters: %w", err)
-	}
-
-	for _, cl := range clusters {
-		health := a.HealthCheck.CheckCluster(ctx, cl.ID)
-		a.HealthCheck.UpdateHealth(health)
-
-		nextStatus := mapClusterHealthStatus(health.Status)
-		update := a.EntClient.Cluster.UpdateOneID(cl.ID).SetStatus(nextStatus)
-		if health.KubeVirtVersion != "" {
-			update = update.SetKubevirtVersion(health.KubeVirtVersion)
-		}
-		if _, err := update.Save(ctx); err != nil {
-			logger.Warn("persist cluster health failed",
-				zap.String("cluster_id", cl.ID),
-				zap.String("cluster_name", cl.Name),
-				zap.String("status", nextStatus.String()),
-				zap.Error(err),
-			)
-			continue
-		}
-	}
-	return nil
-}
-
-func mapClusterHealthStatus(status provider.ClusterStatus) entcluster.Status {
-	switch status {
-	case provider.ClusterStatusHealthy:
-		return entcluster.StatusHEALTHY
-	case provider.ClusterStatusUnhealthy:
-		return entcluster.StatusUNHEALTHY
-	case provider.ClusterStatusUnreachable:
-		return entcluster.StatusUNREACHABLE
-	default:
-		return entcluster.StatusUNKNOWN
-	}
-}
@@ -2,12 +2,19 @@ package modules
 
 import (
 	"context"
+
+	"github.com/riverqueue/river"
+
+	"kv-shepherd.io/shepherd/internal/api/handlers"
+	"kv-shepherd.io/shepherd/internal/jobs"
+	"kv-shepherd.io/shepherd/internal/notification"
 )
 
 // AdminModule represents admin-domain composition
 // (clusters/templates/instance sizes). Current handlers are centralized.
 type AdminModule struct {
-	infra *Infrastructure
+	infra          *Infrastructure
+	scheduledPower *jobs.ScheduledBatchPowerWorker
 }
 
 func NewAdminModule(infra *Infrastructure) *AdminModule {
@@ -16,4 +23,32 @@ func NewAdminModule(infra *Infrastructure) *AdminModule {
 
 func (m *AdminModule) Name() string { return "admin" }
 
+func (m *AdminModule) RegisterWorkers(workers *river.Workers) {
+	if workers == nil || m == nil || m.infra == nil || m.infra.EntClient == nil {
+		return
+	}
+	var failureThreshold int
+	if m.infra.Config != nil {
+		failureThreshold = m.infra.Config.K8s.HealthFailureThreshold
+	}
+	notifier := notification.NewTriggers(notification.NewInboxSender(m.infra.EntClient), m.infra.EntClient)
+	river.AddWorker(workers, jobs.NewClusterHealthCheckerWorker(m.infra.EntClient, nil, jobs.DefaultClusterProbeTimeout).
+		WithFailureThreshold(failureThreshold).
+		WithHealthCache(m.infra.HealthCheck).
+		WithNotifier(notifier))
+	m.scheduledPower = jobs.NewScheduledBatchPowerWorker(m.infra.EntClient, nil)
+	river.AddWorker(workers, m.scheduledPower)
+	river.AddWorker(workers, jobs.NewNamespaceMigrateWorker(m.infra.EntClient, m.infra.AuditLogger))
+	river.AddWorker(workers, jobs.NewAuthProviderGroupSyncWorker(m.infra.EntClient))
+}
+
+// BindServer hands the HTTP server to scheduled batch power runs, which reuse
+// its batch submission path.
+func (m *AdminModule) BindServer(server *handlers.Server) {
+	if m == nil || m.scheduledPower == nil || server == nil {
+		return
+	}
+	m.scheduledPower.SetSubmitter(server)
+}
+
 func (m *AdminModule) Shutdown(context.Context) error { return nil }
@@ -6,14 +6,17 @@ import (
 
 	"kv-shepherd.io/shepherd/internal/api/handlers"
 	"kv-shepherd.io/shepherd/internal/governance/approval"
+	"kv-shepherd.io/shepherd/internal/jobs"
 	"kv-shepherd.io/shepherd/internal/notification"
+	"kv-shepherd.io/shepherd/internal/pkg/metrics"
 	"kv-shepherd.io/shepherd/internal/usecase"
 )
 
 // ApprovalModule wires governance approval gateway with ADR-0012 atomic writer.
 type ApprovalModule struct {
-	gateway  *approval.Gateway
-	notifier *notification.Triggers
+	gateway       *approval.Gateway
+	notifier      *notification.Triggers
+	replayEventUC *usecase.ReplayEventUseCase
 }
 
 // NewApprovalModule creates the approval module after River client is initialized.
@@ -24,13 +27,36 @@ func NewApprovalModule(infra *Infrastructure) (*ApprovalModule, error) {
 
 	atomicWriter := usecase.NewApprovalAtomicWriter(infra.Pool, infra.RiverClient)
 	gateway := approval.NewGateway(infra.EntClient, infra.AuditLogger, atomicWriter)
+	if infra.Pools != nil {
+		gateway.SetDispatchPool(infra.Pools.General)
+	}
+	if infra.Config != nil {
+		gateway.SetBatchDispatchConcurrency(infra.Config.Approval.BatchDispatchConcurrency)
+	}
 
 	// Wire notification system (ADR-0015 §20, master-flow.md Stage 5.F).
 	inboxSender := notification.NewInboxSender(infra.EntClient)
 	notifier := notification.NewTriggers(inboxSender, infra.EntClient)
+	notifier.SetWebhookDispatcher(jobs.NewWebhookDispatcher(infra.EntClient, infra.RiverClient))
+	notifier.SetEmailDispatcher(jobs.NewEmailDispatcher(infra.RiverClient))
 	gateway.SetNotifier(notifier)
+	if infra.Metrics != nil {
+		if err := infra.Metrics.Add("pending_approvals", func(ctx context.Context) error {
+			n, err := gateway.CountPending(ctx)
+			if err != nil {
+				return fmt.Errorf("count pending approvals: %w", err)
+			}
+			metrics.PendingApprovals.Set(float64(n))
+			return nil
+		}); err != nil {
+			return nil, fmt.Errorf("register pending approvals sample: %w", err)
+		}
+	}
+
+	// Event replay re-dispatches River jobs, so it lives with the River-backed module.
+	replayEvent := usecase.NewReplayEventUseCase(infra.EntClient, infra.RiverClient).WithAuditLogger(infra.AuditLogger)
 
-	return &ApprovalModule{gateway: gateway, notifier: notifier}, nil
+	return &ApprovalModule{gateway: gateway, notifier: notifier, replayEventUC: replayEvent}, nil
 }
 
 func (m *ApprovalModule) Name() string { return "approval" }
@@ -41,6 +67,7 @@ func (m *ApprovalModule) ContributeServerDeps(deps *handlers.ServerDeps) {
 	}
 	deps.Gateway = m.gateway
 	deps.Notifier = m.notifier
+	deps.ReplayEventUC = m.replayEventUC
 }
 
 func (m *ApprovalModule) Shutdown(context.Context) error { return nil }
@@ -44,6 +44,7 @@ func TestApprovalModule_WiringContract(t *testing.T) {
 		"approval.NewGateway(",
 		"notification.NewTriggers(",
 		"gateway.SetNotifier(",
+		"notifier.SetEmailDispatcher(",
 		"usecase.NewApprovalAtomicWriter(",
 	}
 	for _, fragment := range required {
@@ -5,7 +5,11 @@ import (
 	"time"
 
 	"github.com/riverqueue/river"
+	"kv-shepherd.io/shepherd/internal/config"
+	"kv-shepherd.io/shepherd/internal/governance/approval"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
 	"kv-shepherd.io/shepherd/internal/jobs"
+	"kv-shepherd.io/shepherd/internal/notification"
 )
 
 // GovernanceModule is the governance-domain composition boundary for
@@ -27,6 +31,28 @@ func (m *GovernanceModule) RegisterWorkers(workers *river.Workers) {
 		return
 	}
 	river.AddWorker(workers, jobs.NewNotificationCleanupWorker(m.infra.EntClient, 90*24*time.Hour))
+	river.AddWorker(workers, jobs.NewWebhookDeliveryWorker(m.infra.EntClient, nil).WithAuditLogger(m.infra.AuditLogger))
+	river.AddWorker(workers, jobs.NewEmailDeliveryWorker(m.infra.EntClient, notification.NewEmailChannel(m.infra.EntClient)))
+	river.AddWorker(workers, jobs.NewRateLimitExemptionPurgeWorker(m.infra.EntClient))
+	river.AddWorker(workers, jobs.NewVNCAccessExpiryWorker(m.infra.EntClient, m.infra.AuditLogger))
+
+	var pendingTTL time.Duration
+	var auditCfg config.AuditConfig
+	if m.infra.Config != nil {
+		pendingTTL = m.infra.Config.Approval.PendingTTL
+		auditCfg = m.infra.Config.Audit
+	}
+	var archive audit.ArchiveSink
+	if auditCfg.ArchiveDir != "" {
+		archive = audit.NewFileSink(auditCfg.ArchiveDir)
+	}
+	river.AddWorker(workers, jobs.NewAuditRetentionWorker(m.infra.EntClient, m.infra.AuditLogger, archive, auditCfg.RetentionDays))
+
+	// Expiry only rejects, so its gateway needs no atomic writer. The gateway
+	// carries no notifier: the worker sends the expiry-specific notification.
+	rejecter := approval.NewGateway(m.infra.EntClient, m.infra.AuditLogger, nil)
+	notifier := notification.NewTriggers(notification.NewInboxSender(m.infra.EntClient), m.infra.EntClient)
+	river.AddWorker(workers, jobs.NewTicketExpiryWorker(m.infra.EntClient, rejecter, notifier, pendingTTL))
 }
 
 func (m *GovernanceModule) Shutdown(context.Context) error { return nil }
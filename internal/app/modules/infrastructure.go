@@ -14,7 +14,9 @@ import (
 	"kv-shepherd.io/shepherd/ent/cluster"
 	"kv-shepherd.io/shepherd/internal/config"
 	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/health"
 	"kv-shepherd.io/shepherd/internal/infrastructure"
+	"kv-shepherd.io/shepherd/internal/pkg/metrics"
 	"kv-shepherd.io/shepherd/internal/pkg/worker"
 	"kv-shepherd.io/shepherd/internal/provider"
 )
@@ -31,6 +33,9 @@ type Infrastructure struct {
 	AuditLogger *audit.Logger
 	VMProvider  provider.InfrastructureProvider
 	HealthCheck *provider.ClusterHealthChecker
+	Health      *health.Registry
+	// Metrics samples database-backed gauges; nil when metrics are disabled.
+	Metrics *metrics.Sampler
 }
 
 // NewInfrastructure initializes DB/pools and shared services.
@@ -65,6 +70,25 @@ func NewInfrastructure(ctx context.Context, cfg *config.Config) (*Infrastructure
 	)
 	healthChecker := provider.NewClusterHealthChecker(clusterFactory, 60*time.Second)
 
+	healthRegistry := health.NewRegistry(health.DefaultTimeout)
+	for _, checker := range []health.Checker{
+		health.NewDatabaseChecker(db.Pool),
+		health.NewRiverChecker(db.Pool),
+		health.NewClusterChecker(healthChecker),
+	} {
+		if err := healthRegistry.Register(checker); err != nil {
+			pools.Shutdown()
+			db.Close()
+			return nil, fmt.Errorf("register health check: %w", err)
+		}
+	}
+	sampler, err := newMetricsSampler(cfg.Metrics, entClient)
+	if err != nil {
+		pools.Shutdown()
+		db.Close()
+		return nil, fmt.Errorf("init metrics sampler: %w", err)
+	}
+
 	return &Infrastructure{
 		Config:      cfg,
 		DB:          db,
@@ -75,6 +99,8 @@ func NewInfrastructure(ctx context.Context, cfg *config.Config) (*Infrastructure
 		AuditLogger: audit.NewLogger(entClient),
 		VMProvider:  vmProvider,
 		HealthCheck: healthChecker,
+		Health:      healthRegistry,
+		Metrics:     sampler,
 	}, nil
 }
 
@@ -107,7 +133,7 @@ func newClusterKubeconfigLoader(client *ent.Client) provider.KubeconfigLoader {
 		if len(cl.EncryptedKubeconfig) == 0 {
 			return nil, fmt.Errorf("cluster %s kubeconfig is empty", clusterID)
 		}
-		return cl.EncryptedKubeconfig, nil
+		return infrastructure.DecryptKubeconfig(cl.EncryptedKubeconfig, cl.EncryptionKeyID)
 	}
 }
 
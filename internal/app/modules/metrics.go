@@ -0,0 +1,82 @@
+package modules
+
+import (
+	"context"
+	"fmt"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/batchapprovalticket"
+	"kv-shepherd.io/shepherd/ent/cluster"
+	"kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/config"
+	"kv-shepherd.io/shepherd/internal/pkg/metrics"
+)
+
+// newMetricsSampler creates the gauge sampler with the sources backed by the
+// shared Ent client. It returns nil when metrics are disabled. Modules add
+// their own sources, e.g. the approval module's pending approvals.
+func newMetricsSampler(cfg config.MetricsConfig, client *ent.Client) (*metrics.Sampler, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	sampler := metrics.NewSampler(cfg.SampleInterval)
+	for name, sample := range map[string]metrics.SampleFunc{
+		"pending_batch_parents": func(ctx context.Context) error {
+			n, err := client.BatchApprovalTicket.Query().
+				Where(batchapprovalticket.StatusIn(
+					batchapprovalticket.StatusPENDING_APPROVAL,
+					batchapprovalticket.StatusPARTIAL_APPROVED,
+				)).
+				Count(ctx)
+			if err != nil {
+				return fmt.Errorf("count pending batch parents: %w", err)
+			}
+			metrics.PendingBatchParents.Set(float64(n))
+			return nil
+		},
+		"vms": func(ctx context.Context) error {
+			var rows []struct {
+				Status string `json:"status"`
+				Count  int    `json:"count"`
+			}
+			if err := client.VM.Query().
+				GroupBy(vm.FieldStatus).
+				Aggregate(ent.Count()).
+				Scan(ctx, &rows); err != nil {
+				return fmt.Errorf("count vms by status: %w", err)
+			}
+			counts := make(map[string]int, len(rows))
+			for _, row := range rows {
+				counts[row.Status] = row.Count
+			}
+			metrics.SetCounts(metrics.VMs, counts)
+			return nil
+		},
+		"clusters": func(ctx context.Context) error {
+			// Status is persisted by the cluster_health_check job, so every
+			// replica reports the same value.
+			var rows []struct {
+				Status string `json:"status"`
+				Count  int    `json:"count"`
+			}
+			if err := client.Cluster.Query().
+				Where(cluster.EnabledEQ(true)).
+				GroupBy(cluster.FieldStatus).
+				Aggregate(ent.Count()).
+				Scan(ctx, &rows); err != nil {
+				return fmt.Errorf("count clusters by status: %w", err)
+			}
+			counts := make(map[string]int, len(rows))
+			for _, row := range rows {
+				counts[row.Status] = row.Count
+			}
+			metrics.SetCounts(metrics.Clusters, counts)
+			return nil
+		},
+	} {
+		if err := sampler.Add(name, sample); err != nil {
+			return nil, err
+		}
+	}
+	return sampler, nil
+}
@@ -29,3 +29,9 @@ type ServerDepsContributor interface {
 type WorkerRegistrar interface {
 	RegisterWorkers(*river.Workers)
 }
+
+// ServerBinder is implemented by modules whose workers call back into the HTTP
+// server. Workers are registered before the server exists, so binding is deferred.
+type ServerBinder interface {
+	BindServer(*handlers.Server)
+}
@@ -6,6 +6,7 @@ import (
 	"kv-shepherd.io/shepherd/internal/api/handlers"
 	"kv-shepherd.io/shepherd/internal/api/middleware"
 	"kv-shepherd.io/shepherd/internal/config"
+	"kv-shepherd.io/shepherd/internal/service"
 )
 
 // NewServerDeps builds base server deps then lets each module contribute explicit wiring.
@@ -27,9 +28,35 @@ func NewServerDeps(cfg *config.Config, infra *Infrastructure, mods []Module) han
 			VerificationKeys: verificationKeys,
 			Issuer:           "shepherd",
 			ExpiresIn:        cfg.Session.Lifetime,
+			RevocationChecker: middleware.NewCachedRevocationChecker(
+				service.NewLoginSessionStore(infra.EntClient), cfg.Session.RevocationCacheTTL),
+			PermissionResolver: middleware.NewCachedPermissionResolver(
+				service.NewPermissionStore(infra.EntClient), cfg.Session.PermissionCacheTTL),
 		},
 		Audit:       infra.AuditLogger,
 		RiverClient: infra.RiverClient,
+
+		BatchEventsInterval:  cfg.Server.BatchEventsInterval,
+		AuditExportMaxRows:   cfg.Server.AuditExportMaxRows,
+		VNCMaxAccessDuration: cfg.Approval.VNCMaxAccessDuration,
+		VNCMaxSessionsPerVM:  cfg.Server.VNCMaxSessionsPerVM,
+		RequirePowerApproval: cfg.Approval.RequirePowerApproval,
+		PasswordPolicy: &service.PasswordPolicy{
+			Mode:             cfg.Security.PasswordPolicy.Mode,
+			MinLength:        cfg.Security.PasswordPolicy.MinLength,
+			MaxLength:        cfg.Security.PasswordPolicy.MaxLength,
+			RequireUppercase: cfg.Security.PasswordPolicy.RequireUppercase,
+			RequireLowercase: cfg.Security.PasswordPolicy.RequireLowercase,
+			RequireDigit:     cfg.Security.PasswordPolicy.RequireDigit,
+			RequireSpecial:   cfg.Security.PasswordPolicy.RequireSpecial,
+			RejectUserInfo:   cfg.Security.PasswordPolicy.RejectUserInfo,
+			HistorySize:      cfg.Security.PasswordPolicy.HistorySize,
+		},
+		LoginLockout: service.LoginLockoutPolicy{
+			MaxFailures:   cfg.Security.LoginLockout.MaxFailures,
+			Duration:      cfg.Security.LoginLockout.Duration,
+			IPMaxFailures: cfg.Security.LoginLockout.IPMaxFailures,
+		},
 	}
 	for _, mod := range mods {
 		if mod == nil {
@@ -8,16 +8,20 @@ import (
 
 	"kv-shepherd.io/shepherd/internal/api/handlers"
 	"kv-shepherd.io/shepherd/internal/jobs"
+	"kv-shepherd.io/shepherd/internal/notification"
 	"kv-shepherd.io/shepherd/internal/service"
 	"kv-shepherd.io/shepherd/internal/usecase"
 )
 
 // VMModule wires VM domain use cases/services and workers.
 type VMModule struct {
-	infra      *Infrastructure
-	vmService  *service.VMService
-	createVMUC *usecase.CreateVMUseCase
-	deleteVMUC *usecase.DeleteVMUseCase
+	infra        *Infrastructure
+	vmService    *service.VMService
+	createVMUC   *usecase.CreateVMUseCase
+	deleteVMUC   *usecase.DeleteVMUseCase
+	migrateVMUC  *usecase.MigrateVMUseCase
+	resizeVMUC   *usecase.ResizeVMUseCase
+	snapshotVMUC *usecase.SnapshotVMUseCase
 }
 
 // NewVMModule creates a VM module with explicit constructor wiring.
@@ -43,12 +47,19 @@ func NewVMModule(infra *Infrastructure) (*VMModule, error) {
 		service.NewTemplateService(infra.EntClient),
 	).WithAuditLogger(infra.AuditLogger)
 	deleteVM := usecase.NewDeleteVMUseCase(infra.EntClient).WithAuditLogger(infra.AuditLogger)
+	migrateVM := usecase.NewMigrateVMUseCase(infra.EntClient).WithAuditLogger(infra.AuditLogger)
+	resizeVM := usecase.NewResizeVMUseCase(infra.EntClient).WithAuditLogger(infra.AuditLogger)
+	requireSnapshotApproval := infra.Config != nil && infra.Config.Approval.RequireSnapshotApproval
+	snapshotVM := usecase.NewSnapshotVMUseCase(infra.EntClient, vmSvc, requireSnapshotApproval).WithAuditLogger(infra.AuditLogger)
 
 	return &VMModule{
-		infra:      infra,
-		vmService:  vmSvc,
-		createVMUC: createVM,
-		deleteVMUC: deleteVM,
+		infra:        infra,
+		vmService:    vmSvc,
+		createVMUC:   createVM,
+		deleteVMUC:   deleteVM,
+		migrateVMUC:  migrateVM,
+		resizeVMUC:   resizeVM,
+		snapshotVMUC: snapshotVM,
 	}, nil
 }
 
@@ -61,15 +72,28 @@ func (m *VMModule) ContributeServerDeps(deps *handlers.ServerDeps) {
 	deps.VMService = m.vmService
 	deps.CreateVMUC = m.createVMUC
 	deps.DeleteVMUC = m.deleteVMUC
+	deps.MigrateVMUC = m.migrateVMUC
+	deps.ResizeVMUC = m.resizeVMUC
+	deps.SnapshotVMUC = m.snapshotVMUC
 }
 
 func (m *VMModule) RegisterWorkers(workers *river.Workers) {
 	if workers == nil || m == nil || m.infra == nil {
 		return
 	}
-	river.AddWorker(workers, jobs.NewVMCreateWorker(m.infra.EntClient, m.vmService, m.infra.AuditLogger))
-	river.AddWorker(workers, jobs.NewVMDeleteWorker(m.infra.EntClient, m.vmService, m.infra.AuditLogger))
+	// Lifecycle webhooks are enqueued from inside the worker, through the
+	// River client of the running job.
+	notifier := notification.NewTriggers(notification.NewInboxSender(m.infra.EntClient), m.infra.EntClient)
+	notifier.SetWebhookDispatcher(jobs.NewWebhookDispatcher(m.infra.EntClient, jobs.WorkerContextInserter{}))
+	river.AddWorker(workers, jobs.NewVMCreateWorker(m.infra.EntClient, m.vmService, m.infra.AuditLogger).WithNotifier(notifier))
+	river.AddWorker(workers, jobs.NewVMDeleteWorker(m.infra.EntClient, m.vmService, m.infra.AuditLogger).WithNotifier(notifier))
+	river.AddWorker(workers, jobs.NewVMMigrateWorker(m.infra.EntClient, m.vmService, m.infra.AuditLogger))
+	river.AddWorker(workers, jobs.NewVMResizeWorker(m.infra.EntClient, m.vmService, m.infra.AuditLogger))
+	river.AddWorker(workers, jobs.NewVMSnapshotWorker(m.infra.EntClient, m.vmService, m.infra.AuditLogger))
 	river.AddWorker(workers, jobs.NewVMPowerWorker(m.infra.EntClient, m.vmService, m.infra.AuditLogger))
+	river.AddWorker(workers, jobs.NewVMHostnameUpdateWorker(m.infra.EntClient, m.vmService, m.infra.AuditLogger))
+	river.AddWorker(workers, jobs.NewVMStatusSyncWorker(m.infra.EntClient, m.vmService, m.infra.AuditLogger))
+	river.AddWorker(workers, jobs.NewVMConsoleSessionPurgeWorker(m.infra.EntClient, jobs.VMConsoleSessionRetention))
 }
 
 func (m *VMModule) Shutdown(context.Context) error { return nil }
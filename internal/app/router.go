@@ -16,12 +16,18 @@ import (
 // Public routes that do NOT require JWT authentication.
 var publicPrefixes = []string{
 	"/api/v1/auth/login",
+	"/api/v1/auth/refresh",
+	"/api/v1/auth/saml/",
 	"/api/v1/health/",
+	"/api/v1/healthz",
 }
 
 func newRouter(cfg *config.Config, server generated.ServerInterface, jwtCfg middleware.JWTConfig) *gin.Engine {
 	router := gin.New()
 	router.Use(gin.Recovery(), middleware.RequestID(), middleware.ErrorHandler())
+	if cfg.Metrics.Enabled {
+		router.Use(middleware.Metrics())
+	}
 
 	router.Use(cors.New(buildCORSConfig(cfg)))
 
@@ -5,9 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
-	entcluster "kv-shepherd.io/shepherd/ent/cluster"
 	"kv-shepherd.io/shepherd/internal/config"
-	"kv-shepherd.io/shepherd/internal/provider"
 )
 
 func TestSanitizeAllowedOrigins(t *testing.T) {
@@ -55,10 +53,3 @@ func TestBuildCORSConfig_UsesDefaultOriginsWhenEmpty(t *testing.T) {
 	}, corsCfg.AllowOrigins)
 	require.True(t, corsCfg.AllowCredentials)
 }
-
-func TestMapClusterHealthStatus(t *testing.T) {
-	require.Equal(t, entcluster.StatusHEALTHY, mapClusterHealthStatus(provider.ClusterStatusHealthy))
-	require.Equal(t, entcluster.StatusUNHEALTHY, mapClusterHealthStatus(provider.ClusterStatusUnhealthy))
-	require.Equal(t, entcluster.StatusUNREACHABLE, mapClusterHealthStatus(provider.ClusterStatusUnreachable))
-	require.Equal(t, entcluster.StatusUNKNOWN, mapClusterHealthStatus(provider.ClusterStatus("unexpected")))
-}
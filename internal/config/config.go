@@ -30,6 +30,9 @@ type Config struct {
 	River    RiverConfig    `mapstructure:"river"`
 	Security SecurityConfig `mapstructure:"security"`
 	Worker   WorkerConfig   `mapstructure:"worker"`
+	Approval ApprovalConfig `mapstructure:"approval"`
+	Audit    AuditConfig    `mapstructure:"audit"`
+	Metrics  MetricsConfig  `mapstructure:"metrics"`
 }
 
 // ServerConfig contains HTTP server settings.
@@ -42,6 +45,16 @@ type ServerConfig struct {
 	AllowCredentials bool          `mapstructure:"allow_credentials"`
 	// UnsafeAllowAllOrigins disables origin allowlist checks and must only be used in trusted local development.
 	UnsafeAllowAllOrigins bool `mapstructure:"unsafe_allow_all_origins"`
+	// BatchEventsInterval is how often GET /vms/batch/{id}/events re-reads batch state.
+	BatchEventsInterval time.Duration `mapstructure:"batch_events_interval"`
+	// AuditExportMaxRows caps the records one GET /audit-logs/export
+	// streams; a larger result ends with a truncation marker.
+	AuditExportMaxRows int `mapstructure:"audit_export_max_rows"`
+	// VNCMaxSessionsPerVM caps concurrent proxied VNC connections to one VM.
+	VNCMaxSessionsPerVM int `mapstructure:"vnc_max_sessions_per_vm"`
+	// MetricsPort serves GET /metrics on a separate internal listener kept off
+	// the public ingress; 0 disables it.
+	MetricsPort int `mapstructure:"metrics_port"`
 }
 
 // DatabaseConfig contains PostgreSQL connection settings.
@@ -93,12 +106,24 @@ type SessionConfig struct {
 	Cookie      string        `mapstructure:"cookie"`
 	Secure      bool          `mapstructure:"secure"`
 	HttpOnly    bool          `mapstructure:"http_only"`
+
+	// RevocationCacheTTL bounds how long a replica trusts a cached
+	// "not revoked" answer for a login token.
+	RevocationCacheTTL time.Duration `mapstructure:"revocation_cache_ttl"`
+	// PermissionCacheTTL bounds how long a replica trusts a user's resolved
+	// permissions after their role bindings change elsewhere.
+	PermissionCacheTTL time.Duration `mapstructure:"permission_cache_ttl"`
 }
 
 // K8sConfig contains Kubernetes operation settings.
 type K8sConfig struct {
 	ClusterConcurrency int           `mapstructure:"cluster_concurrency"`
 	OperationTimeout   time.Duration `mapstructure:"operation_timeout"`
+	// HealthCheckInterval is how often enabled clusters are probed.
+	HealthCheckInterval time.Duration `mapstructure:"health_check_interval"`
+	// HealthFailureThreshold is the number of consecutive failed probes
+	// before a cluster leaves HEALTHY; it suppresses single-probe flaps.
+	HealthFailureThreshold int `mapstructure:"health_failure_threshold"`
 }
 
 // LogConfig contains logging settings.
@@ -120,16 +145,28 @@ type SecurityConfig struct {
 	SessionSecret       string         `mapstructure:"session_secret"`
 	JWTVerificationKeys []string       `mapstructure:"jwt_verification_keys"`
 	PasswordPolicy      PasswordPolicy `mapstructure:"password_policy"`
+	LoginLockout        LoginLockout   `mapstructure:"login_lockout"`
+}
+
+// LoginLockout configures local login brute force protection.
+type LoginLockout struct {
+	MaxFailures   int           `mapstructure:"max_failures"`    // Consecutive failures that lock a username
+	Duration      time.Duration `mapstructure:"duration"`        // Lockout length; older failures stop counting
+	IPMaxFailures int           `mapstructure:"ip_max_failures"` // Consecutive failures that throttle a source IP
 }
 
 // PasswordPolicy defines password validation rules.
 // Default mode is "nist" (NIST 800-63B compliant).
 type PasswordPolicy struct {
 	Mode             string `mapstructure:"mode"` // "nist" (default) or "legacy"
-	RequireUppercase bool   `mapstructure:"require_uppercase"`
-	RequireLowercase bool   `mapstructure:"require_lowercase"`
-	RequireDigit     bool   `mapstructure:"require_digit"`
-	RequireSpecial   bool   `mapstructure:"require_special"`
+	MinLength        int    `mapstructure:"min_length"`
+	MaxLength        int    `mapstructure:"max_length"`
+	RequireUppercase bool   `mapstructure:"require_uppercase"` // Legacy mode only
+	RequireLowercase bool   `mapstructure:"require_lowercase"` // Legacy mode only
+	RequireDigit     bool   `mapstructure:"require_digit"`     // Legacy mode only
+	RequireSpecial   bool   `mapstructure:"require_special"`   // Legacy mode only
+	RejectUserInfo   bool   `mapstructure:"reject_user_info"`  // Reject passwords containing the username or email
+	HistorySize      int    `mapstructure:"history_size"`      // Recent passwords a change may not reuse
 }
 
 // WorkerConfig contains worker pool settings.
@@ -138,6 +175,48 @@ type WorkerConfig struct {
 	K8sPoolSize     int `mapstructure:"k8s_pool_size"`
 }
 
+// ApprovalConfig contains approval workflow settings.
+type ApprovalConfig struct {
+	// PendingTTL auto-rejects PENDING tickets older than this duration until
+	// an admin saves PlatformConfig.approval_ttl_hours, which then takes over.
+	// Zero disables auto-expiry (ADR-0005 default: no timeout processing).
+	PendingTTL time.Duration `mapstructure:"pending_ttl"`
+	// RequireSnapshotApproval routes VM snapshot creation through an
+	// approval ticket (operation_type=SNAPSHOT) instead of running it directly.
+	RequireSnapshotApproval bool `mapstructure:"require_snapshot_approval"`
+	// RequirePowerApproval routes batch power operations through batch
+	// approval instead of enqueuing them on submit. Namespaces can also
+	// require it individually (NamespaceRegistry.require_power_approval).
+	RequirePowerApproval bool `mapstructure:"require_power_approval"`
+	// BatchDispatchConcurrency bounds how many children of an approved batch
+	// are dispatched in parallel.
+	BatchDispatchConcurrency int `mapstructure:"batch_dispatch_concurrency"`
+	// VNCMaxAccessDuration caps the access window requested for VNC_ACCESS
+	// tickets; approved access expires once the window ends.
+	VNCMaxAccessDuration time.Duration `mapstructure:"vnc_max_access_duration"`
+}
+
+// AuditConfig contains audit log retention settings.
+type AuditConfig struct {
+	// RetentionDays deletes audit logs older than this many days. Values
+	// below jobs.MinAuditRetentionDays are raised to that floor.
+	RetentionDays int `mapstructure:"retention_days"`
+	// ArchiveDir, when set, archives expired audit logs as NDJSON files in
+	// this directory before they are deleted.
+	ArchiveDir string `mapstructure:"archive_dir"`
+}
+
+// MetricsConfig contains Prometheus metrics settings. The endpoint itself
+// listens on server.metrics_port.
+type MetricsConfig struct {
+	// Enabled turns on HTTP request instrumentation, the gauge sampler and
+	// the /metrics listener.
+	Enabled bool `mapstructure:"enabled"`
+	// SampleInterval is how often gauges backed by database queries are
+	// refreshed; scrapes read the last sample.
+	SampleInterval time.Duration `mapstructure:"sample_interval"`
+}
+
 var (
 	bootstrapLoggerOnce sync.Once
 	bootstrapLogger     *zap.Logger
@@ -252,12 +331,16 @@ func generateSecureRandomHex(n int) (string, error) {
 func setDefaults(v *viper.Viper) {
 	// Server
 	v.SetDefault("server.port", 8080)
+	v.SetDefault("server.metrics_port", 9090)
 	v.SetDefault("server.read_timeout", "30s")
 	v.SetDefault("server.write_timeout", "30s")
 	v.SetDefault("server.shutdown_timeout", "30s")
 	v.SetDefault("server.allowed_origins", []string{"http://localhost:3000", "http://127.0.0.1:3000"})
 	v.SetDefault("server.allow_credentials", true)
 	v.SetDefault("server.unsafe_allow_all_origins", false)
+	v.SetDefault("server.batch_events_interval", "2s")
+	v.SetDefault("server.audit_export_max_rows", 1000000)
+	v.SetDefault("server.vnc_max_sessions_per_vm", 2)
 
 	// Database (ADR-0012 shared pool)
 	v.SetDefault("database.url", "")
@@ -279,10 +362,14 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("session.cookie", "session_id")
 	v.SetDefault("session.secure", true)
 	v.SetDefault("session.http_only", true)
+	v.SetDefault("session.revocation_cache_ttl", "30s")
+	v.SetDefault("session.permission_cache_ttl", "30s")
 
 	// K8s
 	v.SetDefault("k8s.cluster_concurrency", 20)
 	v.SetDefault("k8s.operation_timeout", "5m")
+	v.SetDefault("k8s.health_check_interval", "60s")
+	v.SetDefault("k8s.health_failure_threshold", 2)
 
 	// Log
 	v.SetDefault("log.level", "info")
@@ -294,9 +381,31 @@ func setDefaults(v *viper.Viper) {
 
 	// Security (ADR-0025)
 	v.SetDefault("security.password_policy.mode", "nist")
+	v.SetDefault("security.password_policy.min_length", 8)
+	v.SetDefault("security.password_policy.max_length", 64)
+	v.SetDefault("security.password_policy.reject_user_info", true)
+	v.SetDefault("security.password_policy.history_size", 5)
+	v.SetDefault("security.login_lockout.max_failures", 5)
+	v.SetDefault("security.login_lockout.duration", "15m")
+	v.SetDefault("security.login_lockout.ip_max_failures", 20)
 	v.SetDefault("security.jwt_verification_keys", []string{})
 
 	// Worker Pool (ADR-0031)
 	v.SetDefault("worker.general_pool_size", 100)
 	v.SetDefault("worker.k8s_pool_size", 50)
+
+	// Approval (disabled by default)
+	v.SetDefault("approval.pending_ttl", "0s")
+	v.SetDefault("approval.require_snapshot_approval", false)
+	v.SetDefault("approval.require_power_approval", false)
+	v.SetDefault("approval.batch_dispatch_concurrency", 5)
+	v.SetDefault("approval.vnc_max_access_duration", "4h")
+
+	// Audit defaults
+	v.SetDefault("audit.retention_days", 365)
+	v.SetDefault("audit.archive_dir", "")
+
+	// Metrics
+	v.SetDefault("metrics.enabled", true)
+	v.SetDefault("metrics.sample_interval", "30s")
 }
@@ -20,6 +20,15 @@ func TestLoad_Defaults(t *testing.T) {
 	if cfg.Server.Port != 8080 {
 		t.Errorf("Server.Port = %d, want 8080", cfg.Server.Port)
 	}
+	if cfg.Server.MetricsPort != 9090 {
+		t.Errorf("Server.MetricsPort = %d, want 9090", cfg.Server.MetricsPort)
+	}
+	if cfg.Server.AuditExportMaxRows != 1000000 {
+		t.Errorf("Server.AuditExportMaxRows = %d, want 1000000", cfg.Server.AuditExportMaxRows)
+	}
+	if cfg.Server.VNCMaxSessionsPerVM != 2 {
+		t.Errorf("Server.VNCMaxSessionsPerVM = %d, want 2", cfg.Server.VNCMaxSessionsPerVM)
+	}
 	if cfg.Server.ReadTimeout != 30*time.Second {
 		t.Errorf("Server.ReadTimeout = %v, want 30s", cfg.Server.ReadTimeout)
 	}
@@ -30,6 +39,11 @@ func TestLoad_Defaults(t *testing.T) {
 		t.Errorf("Server.UnsafeAllowAllOrigins = %v, want false", cfg.Server.UnsafeAllowAllOrigins)
 	}
 
+	// Audit defaults
+	if cfg.Audit.RetentionDays != 365 || cfg.Audit.ArchiveDir != "" {
+		t.Errorf("Audit = %+v, want 365 retention days and no archive", cfg.Audit)
+	}
+
 	// Database defaults
 	if cfg.Database.Host != "localhost" {
 		t.Errorf("Database.Host = %q, want localhost", cfg.Database.Host)
@@ -48,6 +62,12 @@ func TestLoad_Defaults(t *testing.T) {
 	if cfg.K8s.ClusterConcurrency != 20 {
 		t.Errorf("K8s.ClusterConcurrency = %d, want 20", cfg.K8s.ClusterConcurrency)
 	}
+	if cfg.K8s.HealthCheckInterval != 60*time.Second {
+		t.Errorf("K8s.HealthCheckInterval = %v, want 60s", cfg.K8s.HealthCheckInterval)
+	}
+	if cfg.K8s.HealthFailureThreshold != 2 {
+		t.Errorf("K8s.HealthFailureThreshold = %d, want 2", cfg.K8s.HealthFailureThreshold)
+	}
 
 	// Log defaults
 	if cfg.Log.Level != "info" {
@@ -74,6 +94,14 @@ func TestLoad_Defaults(t *testing.T) {
 	if cfg.Worker.K8sPoolSize != 50 {
 		t.Errorf("Worker.K8sPoolSize = %d, want 50", cfg.Worker.K8sPoolSize)
 	}
+
+	// Approval defaults: pending ticket expiry disabled
+	if cfg.Approval.PendingTTL != 0 {
+		t.Errorf("Approval.PendingTTL = %s, want 0", cfg.Approval.PendingTTL)
+	}
+	if cfg.Approval.BatchDispatchConcurrency != 5 {
+		t.Errorf("Approval.BatchDispatchConcurrency = %d, want 5", cfg.Approval.BatchDispatchConcurrency)
+	}
 }
 
 func TestDatabaseConfig_DSN(t *testing.T) {
@@ -0,0 +1,12 @@
+package domain
+
+// ClusterNodeResources sums node resources reported by a cluster.
+// Total is node capacity; Allocatable is what the scheduler may hand out
+// after system reservations.
+type ClusterNodeResources struct {
+	NodeCount           int `json:"node_count"`
+	TotalCPUCores       int `json:"total_cpu_cores"`
+	AllocatableCPUCores int `json:"allocatable_cpu_cores"`
+	TotalMemoryMB       int `json:"total_memory_mb"`
+	AllocatableMemoryMB int `json:"allocatable_memory_mb"`
+}
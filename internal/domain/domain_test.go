@@ -86,3 +86,16 @@ func TestPowerAndDeletePayload_ToJSON(t *testing.T) {
 	require.NoError(t, json.Unmarshal(data, &gotPower))
 	require.Equal(t, powerPayload, gotPower)
 }
+
+func TestCanApplyPowerOperation(t *testing.T) {
+	require.True(t, CanApplyPowerOperation("start", VMStatusStopped))
+	require.True(t, CanApplyPowerOperation("start", VMStatusFailed))
+	require.False(t, CanApplyPowerOperation("start", VMStatusRunning))
+	require.True(t, CanApplyPowerOperation("stop", VMStatusPaused))
+	require.False(t, CanApplyPowerOperation("stop", VMStatusStopped))
+	require.True(t, CanApplyPowerOperation("restart", VMStatusRunning))
+	require.False(t, CanApplyPowerOperation("restart", VMStatusMigrating))
+	require.False(t, CanApplyPowerOperation("hibernate", VMStatusRunning))
+	require.Equal(t, "cannot stop VM in STOPPED state, must be RUNNING or PAUSED",
+		PowerPreconditionMessage("stop", VMStatusStopped))
+}
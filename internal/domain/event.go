@@ -14,6 +14,9 @@ const (
 	EventVMCreationCompleted EventType = "VM_CREATION_COMPLETED"
 	EventVMCreationFailed    EventType = "VM_CREATION_FAILED"
 
+	// VM Clone Events (creation from a stopped VM's root disk, approval-gated like creation)
+	EventVMCloneRequested EventType = "VM_CLONE_REQUESTED"
+
 	// VM Modification Events
 	EventVMModifyRequested EventType = "VM_MODIFY_REQUESTED"
 	EventVMModifyCompleted EventType = "VM_MODIFY_COMPLETED"
@@ -24,6 +27,24 @@ const (
 	EventVMDeletionCompleted EventType = "VM_DELETION_COMPLETED"
 	EventVMDeletionFailed    EventType = "VM_DELETION_FAILED"
 
+	// VM Migration Events (cross-cluster, approval-gated)
+	EventVMMigrationRequested EventType = "VM_MIGRATION_REQUESTED"
+	EventVMMigrationCompleted EventType = "VM_MIGRATION_COMPLETED"
+	EventVMMigrationFailed    EventType = "VM_MIGRATION_FAILED"
+
+	// VM Resize Events (vertical scaling, approval-gated)
+	EventVMResizeRequested EventType = "VM_RESIZE_REQUESTED"
+	EventVMResizeCompleted EventType = "VM_RESIZE_COMPLETED"
+	EventVMResizeFailed    EventType = "VM_RESIZE_FAILED"
+
+	// VM Snapshot Events (creation optionally approval-gated)
+	EventVMSnapshotCreateRequested  EventType = "VM_SNAPSHOT_CREATE_REQUESTED"
+	EventVMSnapshotCreateCompleted  EventType = "VM_SNAPSHOT_CREATE_COMPLETED"
+	EventVMSnapshotCreateFailed     EventType = "VM_SNAPSHOT_CREATE_FAILED"
+	EventVMSnapshotRestoreRequested EventType = "VM_SNAPSHOT_RESTORE_REQUESTED"
+	EventVMSnapshotRestoreCompleted EventType = "VM_SNAPSHOT_RESTORE_COMPLETED"
+	EventVMSnapshotRestoreFailed    EventType = "VM_SNAPSHOT_RESTORE_FAILED"
+
 	// Power Operations (ADR-0015 §6)
 	EventVMStartRequested   EventType = "VM_START_REQUESTED"
 	EventVMStartCompleted   EventType = "VM_START_COMPLETED"
@@ -35,6 +56,11 @@ const (
 	EventVMRestartCompleted EventType = "VM_RESTART_COMPLETED"
 	EventVMRestartFailed    EventType = "VM_RESTART_FAILED"
 
+	// Hostname
+	EventVMHostnameUpdateRequested EventType = "VM_HOSTNAME_UPDATE_REQUESTED"
+	EventVMHostnameUpdateCompleted EventType = "VM_HOSTNAME_UPDATE_COMPLETED"
+	EventVMHostnameUpdateFailed    EventType = "VM_HOSTNAME_UPDATE_FAILED"
+
 	// Batch Operations (ADR-0015 §19)
 	EventBatchCreateRequested EventType = "BATCH_CREATE_REQUESTED"
 	EventBatchCreateCompleted EventType = "BATCH_CREATE_COMPLETED"
@@ -53,6 +79,11 @@ const (
 	EventVNCAccessRequested EventType = "VNC_ACCESS_REQUESTED"
 	EventVNCAccessGranted   EventType = "VNC_ACCESS_GRANTED"
 
+	// Namespace Environment Migration
+	EventNamespaceMigrationRequested EventType = "NAMESPACE_MIGRATION_REQUESTED"
+	EventNamespaceMigrationCompleted EventType = "NAMESPACE_MIGRATION_COMPLETED"
+	EventNamespaceMigrationFailed    EventType = "NAMESPACE_MIGRATION_FAILED"
+
 	// System/Service Events
 	EventSystemCreated  EventType = "SYSTEM_CREATED"
 	EventSystemDeleted  EventType = "SYSTEM_DELETED"
@@ -93,6 +124,23 @@ type VMCreationPayload struct {
 	InstanceSizeID string `json:"instance_size_id"`
 	Namespace      string `json:"namespace"`
 	Reason         string `json:"reason"`
+	RequestID      string `json:"request_id,omitempty"` // Client idempotency key (optional)
+
+	// Clone requests (EventVMCloneRequested) boot from a copy of the source
+	// VM's root disk PVC instead of the template image.
+	SourceVMID      string `json:"source_vm_id,omitempty"`
+	SourceClusterID string `json:"source_cluster_id,omitempty"`
+	SourceNamespace string `json:"source_namespace,omitempty"`
+	SourcePVCName   string `json:"source_pvc_name,omitempty"`
+
+	// UserData is base64-encoded cloud-init user-data attached to the VM's
+	// cloud-init volume at creation.
+	UserData string `json:"user_data,omitempty"`
+}
+
+// IsClone reports whether the payload requests a clone of an existing VM.
+func (p VMCreationPayload) IsClone() bool {
+	return p.SourceVMID != ""
 }
 
 // ToJSON converts payload to JSON bytes.
@@ -117,6 +165,7 @@ type VMDeletePayload struct {
 	ClusterID string `json:"cluster_id"`
 	Namespace string `json:"namespace"`
 	Actor     string `json:"actor"`
+	RequestID string `json:"request_id,omitempty"` // Client idempotency key (optional)
 }
 
 // ToJSON converts payload to JSON bytes.
@@ -124,6 +173,100 @@ func (p VMDeletePayload) ToJSON() ([]byte, error) {
 	return json.Marshal(p)
 }
 
+// VMMigratePayload is the payload for cross-cluster VM migration events.
+type VMMigratePayload struct {
+	VMID            string `json:"vm_id"`
+	VMName          string `json:"vm_name"`
+	SourceClusterID string `json:"source_cluster_id"`
+	TargetClusterID string `json:"target_cluster_id"`
+	Namespace       string `json:"namespace"`
+	Actor           string `json:"actor"`
+}
+
+// ToJSON converts payload to JSON bytes.
+func (p VMMigratePayload) ToJSON() ([]byte, error) {
+	return json.Marshal(p)
+}
+
+// VMSize is a point-in-time snapshot of a VM's compute size.
+// InstanceSizeID is empty when the size was given as explicit cpu/memory.
+// DiskGB is the data disk size; zero means no data disk or unknown.
+type VMSize struct {
+	InstanceSizeID   string `json:"instance_size_id,omitempty"`
+	InstanceSizeName string `json:"instance_size_name,omitempty"`
+	CPU              int    `json:"cpu"`
+	MemoryMB         int    `json:"memory_mb"`
+	DiskGB           int    `json:"disk_gb,omitempty"`
+}
+
+// VMResizePayload is the payload for VM resize events.
+// From is the size at request time, To the requested size. The approver may
+// pick a different size, which is stored on the ticket (see Target).
+type VMResizePayload struct {
+	VMID      string `json:"vm_id"`
+	VMName    string `json:"vm_name"`
+	ClusterID string `json:"cluster_id"`
+	Namespace string `json:"namespace"`
+	From      VMSize `json:"from"`
+	To        VMSize `json:"to"`
+	Actor     string `json:"actor"`
+}
+
+// ToJSON converts payload to JSON bytes.
+func (p VMResizePayload) ToJSON() ([]byte, error) {
+	return json.Marshal(p)
+}
+
+// Target returns the size to apply: the approver's selection stored in the
+// ticket's modified_spec as a VMSize, or the requested size when there is none.
+func (p VMResizePayload) Target(modifiedSpec map[string]interface{}) VMSize {
+	if len(modifiedSpec) == 0 {
+		return p.To
+	}
+	raw, err := json.Marshal(modifiedSpec)
+	if err != nil {
+		return p.To
+	}
+	var selected VMSize
+	if err := json.Unmarshal(raw, &selected); err != nil || selected.CPU <= 0 || selected.MemoryMB <= 0 {
+		return p.To
+	}
+	return selected
+}
+
+// VMSnapshotPayload is the payload for VM snapshot create and restore events.
+// Force is only meaningful for restores and allows stopping a running VM.
+type VMSnapshotPayload struct {
+	VMID         string `json:"vm_id"`
+	VMName       string `json:"vm_name"`
+	ClusterID    string `json:"cluster_id"`
+	Namespace    string `json:"namespace"`
+	SnapshotName string `json:"snapshot_name"`
+	Force        bool   `json:"force,omitempty"`
+	Actor        string `json:"actor"`
+}
+
+// ToJSON converts payload to JSON bytes.
+func (p VMSnapshotPayload) ToJSON() ([]byte, error) {
+	return json.Marshal(p)
+}
+
+// NamespaceMigrationPayload is the payload for namespace environment
+// migration events. FromEnvironment is the environment at request time.
+type NamespaceMigrationPayload struct {
+	NamespaceID     string `json:"namespace_id"`
+	Namespace       string `json:"namespace"`
+	FromEnvironment string `json:"from_environment"`
+	ToEnvironment   string `json:"to_environment"`
+	Reason          string `json:"reason,omitempty"`
+	Actor           string `json:"actor"`
+}
+
+// ToJSON converts payload to JSON bytes.
+func (p NamespaceMigrationPayload) ToJSON() ([]byte, error) {
+	return json.Marshal(p)
+}
+
 // VMPowerPayload is the payload for VM power operation events.
 type VMPowerPayload struct {
 	VMID      string `json:"vm_id"`
@@ -139,6 +282,22 @@ func (p VMPowerPayload) ToJSON() ([]byte, error) {
 	return json.Marshal(p)
 }
 
+// VMHostnameUpdatePayload is the payload for VM_HOSTNAME_UPDATE_REQUESTED events.
+type VMHostnameUpdatePayload struct {
+	VMID             string `json:"vm_id"`
+	VMName           string `json:"vm_name"`
+	ClusterID        string `json:"cluster_id"`
+	Namespace        string `json:"namespace"`
+	Hostname         string `json:"hostname"`
+	PreviousHostname string `json:"previous_hostname,omitempty"`
+	Actor            string `json:"actor"`
+}
+
+// ToJSON converts payload to JSON bytes.
+func (p VMHostnameUpdatePayload) ToJSON() ([]byte, error) {
+	return json.Marshal(p)
+}
+
 // BatchVMItemPayload represents one child item in a batch request.
 type BatchVMItemPayload struct {
 	VMID           string `json:"vm_id,omitempty"`
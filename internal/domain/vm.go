@@ -5,7 +5,11 @@
 // Import Path (ADR-0016): kv-shepherd.io/shepherd/internal/domain
 package domain
 
-import "time"
+import (
+	"fmt"
+	"strings"
+	"time"
+)
 
 // VM represents a virtual machine in the domain layer.
 type VM struct {
@@ -29,6 +33,46 @@ type VMSpec struct {
 	Labels   map[string]string `json:"labels,omitempty"`
 	// SpecOverrides carries advanced KubeVirt spec path/value overrides (ADR-0018 Hybrid Model).
 	SpecOverrides map[string]interface{} `json:"spec_overrides,omitempty"`
+	// CloneSource, when set, provisions the root disk as a DataVolume cloned
+	// from an existing PVC; Image is ignored.
+	CloneSource *PVCCloneSource `json:"clone_source,omitempty"`
+	// UserData, when set, is base64-encoded cloud-init user-data delivered
+	// through a CloudInitType volume.
+	UserData      string        `json:"user_data,omitempty"`
+	CloudInitType CloudInitType `json:"cloud_init_type,omitempty"`
+}
+
+// CloudInitType selects the cloud-init datasource a VM's user-data is served by.
+type CloudInitType string
+
+const (
+	CloudInitNoCloud     CloudInitType = "nocloud"     // cloudInitNoCloud volume (default)
+	CloudInitConfigDrive CloudInitType = "configdrive" // cloudInitConfigDrive volume
+)
+
+// ParseCloudInitType parses a template cloud_init_type value; empty means
+// CloudInitNoCloud.
+func ParseCloudInitType(s string) (CloudInitType, error) {
+	switch t := CloudInitType(strings.ToLower(strings.TrimSpace(s))); t {
+	case "":
+		return CloudInitNoCloud, nil
+	case CloudInitNoCloud, CloudInitConfigDrive:
+		return t, nil
+	default:
+		return "", fmt.Errorf("unsupported cloud_init_type %q (want nocloud or configdrive)", s)
+	}
+}
+
+// PVCCloneSource identifies the PVC a cloned VM's root disk is copied from.
+type PVCCloneSource struct {
+	Namespace string `json:"namespace"`
+	PVCName   string `json:"pvc_name"`
+}
+
+// CloneRootDiskPVCName is the name of the DataVolume (and PVC) backing the
+// root disk of a VM created by cloning.
+func CloneRootDiskPVCName(vmName string) string {
+	return vmName + "-rootdisk"
 }
 
 // VMStatus represents the current status of a VM.
@@ -47,10 +91,72 @@ const (
 	// Extended states (K8s/KubeVirt specific, not in master-flow state diagram)
 	VMStatusPending   VMStatus = "PENDING"   // K8s: waiting for resources (scheduler)
 	VMStatusMigrating VMStatus = "MIGRATING" // Live migration in progress
+	VMStatusResizing  VMStatus = "RESIZING"  // Approved resize being applied
 	VMStatusPaused    VMStatus = "PAUSED"    // VM paused
 	VMStatusUnknown   VMStatus = "UNKNOWN"   // Status cannot be determined
 )
 
+// powerOperationSources lists the statuses each power operation may be
+// applied from. Anything else is either a no-op or fails in the provider.
+var powerOperationSources = map[string][]VMStatus{
+	"start":   {VMStatusStopped, VMStatusFailed},
+	"stop":    {VMStatusRunning, VMStatusPaused},
+	"restart": {VMStatusRunning, VMStatusPaused},
+}
+
+// PowerOperationSourceStatuses returns the statuses a power operation
+// (start, stop, restart) may be applied from.
+func PowerOperationSourceStatuses(operation string) []VMStatus {
+	return powerOperationSources[operation]
+}
+
+// CanApplyPowerOperation reports whether a VM in status may take the power
+// operation. Unknown operations are never applicable.
+func CanApplyPowerOperation(operation string, status VMStatus) bool {
+	for _, allowed := range powerOperationSources[operation] {
+		if status == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// PowerPreconditionMessage explains why a VM in status cannot take the power
+// operation, e.g. "cannot start VM in RUNNING state, must be STOPPED or FAILED".
+func PowerPreconditionMessage(operation string, status VMStatus) string {
+	allowed := powerOperationSources[operation]
+	names := make([]string, 0, len(allowed))
+	for _, st := range allowed {
+		names = append(names, string(st))
+	}
+	return fmt.Sprintf("cannot %s VM in %s state, must be %s", operation, status, strings.Join(names, " or "))
+}
+
+// VMRuntime is the live VirtualMachineInstance state of a running VM.
+type VMRuntime struct {
+	Phase               string        `json:"phase"`
+	NodeName            string        `json:"node_name,omitempty"`
+	LaunchedAt          *time.Time    `json:"launched_at,omitempty"`
+	Interfaces          []VMInterface `json:"interfaces"`
+	GuestAgentConnected bool          `json:"guest_agent_connected"`
+	GuestOS             *GuestOSInfo  `json:"guest_os,omitempty"`
+}
+
+// VMInterface is a VMI network interface as reported by KubeVirt.
+type VMInterface struct {
+	Name        string   `json:"name"`
+	MAC         string   `json:"mac,omitempty"`
+	IPAddresses []string `json:"ip_addresses"`
+}
+
+// GuestOSInfo is reported by the QEMU guest agent when it is connected.
+type GuestOSInfo struct {
+	Name          string `json:"name,omitempty"`
+	PrettyName    string `json:"pretty_name,omitempty"`
+	Version       string `json:"version,omitempty"`
+	KernelRelease string `json:"kernel_release,omitempty"`
+}
+
 // VMList represents a paginated list of VMs.
 type VMList struct {
 	Items      []*VM  `json:"items"`
@@ -70,10 +176,22 @@ type Snapshot struct {
 	Name      string    `json:"name"`
 	VMName    string    `json:"vm_name"`
 	Namespace string    `json:"namespace"`
+	Phase     string    `json:"phase,omitempty"`
 	Ready     bool      `json:"ready"`
+	Error     string    `json:"error,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// SnapshotRestore represents the restore of a VM from one of its snapshots.
+type SnapshotRestore struct {
+	Name         string `json:"name"`
+	VMName       string `json:"vm_name"`
+	SnapshotName string `json:"snapshot_name"`
+	Namespace    string `json:"namespace"`
+	Complete     bool   `json:"complete"`
+	Error        string `json:"error,omitempty"`
+}
+
 // Clone represents a VM clone operation.
 type Clone struct {
 	Name      string `json:"name"`
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MaxVMNameLength is the DNS-1123 label limit. KubeVirt copies the VM name
+// into the vm.kubevirt.io/name label, whose values share the same limit.
+const MaxVMNameLength = 63
+
+// vmNameHashLength is the number of hex digits in a VM name hash suffix.
+const vmNameHashLength = 8
+
+var dns1123LabelPattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// MaxHostnameLength is the RFC 1123 limit for a fully qualified hostname.
+const MaxHostnameLength = 253
+
+// hostnamePattern matches an RFC 1123 hostname: dot-separated labels of
+// letters, digits and inner hyphens.
+var hostnamePattern = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9]{0,61}[A-Za-z0-9])?(\.[A-Za-z0-9]([-A-Za-z0-9]{0,61}[A-Za-z0-9])?)*$`)
+
+// VMInstance formats an allocated instance index, e.g. 3 → "03".
+func VMInstance(index int) string {
+	return fmt.Sprintf("%02d", index)
+}
+
+// DeriveVMName builds the platform-managed VM name
+// {namespace}-{system}-{service}-{instance} (ADR-0017).
+//
+// Each part is lowercased and every character outside [a-z0-9-] becomes '-'.
+// A part that loses characters this way (e.g. unicode names) gets a hash of
+// its original value appended, so distinct names never collapse to the same
+// label. A name longer than MaxVMNameLength keeps the instance suffix and is
+// truncated deterministically before a hash of the untruncated prefix.
+func DeriveVMName(namespace, systemName, serviceName string, index int) (name, instance string) {
+	instance = VMInstance(index)
+	parts := make([]string, 0, 3)
+	for _, part := range []string{namespace, systemName, serviceName} {
+		if label := vmNameLabel(part); label != "" {
+			parts = append(parts, label)
+		}
+	}
+	prefix := strings.Join(parts, "-")
+	suffix := "-" + instance
+	if len(prefix)+len(suffix) <= MaxVMNameLength {
+		return prefix + suffix, instance
+	}
+
+	hash := vmNameHash(prefix)
+	keep := MaxVMNameLength - len(suffix) - len(hash) - 1
+	truncated := strings.TrimRight(prefix[:max(keep, 0)], "-")
+	if truncated == "" {
+		return hash + suffix, instance
+	}
+	return truncated + "-" + hash + suffix, instance
+}
+
+// ValidateVMName reports whether name is a legal DNS-1123 label of at most
+// MaxVMNameLength characters.
+func ValidateVMName(name string) error {
+	if len(name) > MaxVMNameLength {
+		return fmt.Errorf("vm name %q is %d characters, longer than %d", name, len(name), MaxVMNameLength)
+	}
+	if !dns1123LabelPattern.MatchString(name) {
+		return fmt.Errorf("vm name %q is not a DNS-1123 label", name)
+	}
+	return nil
+}
+
+// ValidateHostname reports whether hostname is a legal RFC 1123 hostname of
+// at most MaxHostnameLength characters.
+func ValidateHostname(hostname string) error {
+	if len(hostname) > MaxHostnameLength {
+		return fmt.Errorf("hostname %q is %d characters, longer than %d", hostname, len(hostname), MaxHostnameLength)
+	}
+	if !hostnamePattern.MatchString(hostname) {
+		return fmt.Errorf("hostname %q is not an RFC 1123 hostname", hostname)
+	}
+	return nil
+}
+
+// vmNameLabel sanitizes one VM name part into DNS-1123 label characters.
+func vmNameLabel(part string) string {
+	part = strings.TrimSpace(part)
+	lower := strings.ToLower(part)
+	var b strings.Builder
+	dash := false
+	for _, r := range lower {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	label := strings.TrimRight(b.String(), "-")
+	if label == lower || part == "" {
+		return label
+	}
+	if label == "" {
+		return vmNameHash(part)
+	}
+	return label + "-" + vmNameHash(part)
+}
+
+func vmNameHash(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])[:vmNameHashLength]
+}
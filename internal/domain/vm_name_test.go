@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeriveVMName_Plain(t *testing.T) {
+	name, instance := DeriveVMName("prod", "shop", "redis", 1)
+	require.Equal(t, "prod-shop-redis-01", name)
+	require.Equal(t, "01", instance)
+
+	name, instance = DeriveVMName("prod", "Shop", "redis", 123)
+	require.Equal(t, "prod-shop-redis-123", name, "uppercase is folded without a hash")
+	require.Equal(t, "123", instance)
+}
+
+func TestDeriveVMName_Unicode(t *testing.T) {
+	name, _ := DeriveVMName("prod", "订单", "redis", 1)
+	require.NoError(t, ValidateVMName(name))
+	require.Regexp(t, `^prod-[0-9a-f]{8}-redis-01$`, name)
+
+	other, _ := DeriveVMName("prod", "库存", "redis", 1)
+	require.NotEqual(t, name, other, "distinct unicode names must not collapse")
+
+	mixed, _ := DeriveVMName("prod", "shop", "café_db", 2)
+	require.NoError(t, ValidateVMName(mixed))
+	require.Regexp(t, `^prod-shop-caf-db-[0-9a-f]{8}-02$`, mixed)
+}
+
+func TestDeriveVMName_LengthLimit(t *testing.T) {
+	namespace := "team-" + strings.Repeat("n", 50)
+	name, instance := DeriveVMName(namespace, "payments", "ledger-writer", 7)
+	require.Len(t, name, MaxVMNameLength)
+	require.NoError(t, ValidateVMName(name))
+	require.True(t, strings.HasPrefix(name, "team-nnn"))
+	require.True(t, strings.HasSuffix(name, "-07"))
+	require.Equal(t, "07", instance)
+
+	again, _ := DeriveVMName(namespace, "payments", "ledger-writer", 7)
+	require.Equal(t, name, again, "truncation must be deterministic")
+
+	// Services sharing the truncated prefix still get distinct names.
+	sibling, _ := DeriveVMName(namespace, "payments", "ledger-reader", 7)
+	require.NotEqual(t, name, sibling)
+	require.Len(t, sibling, MaxVMNameLength)
+
+	wide, _ := DeriveVMName(namespace, "payments", "ledger-writer", 123456)
+	require.LessOrEqual(t, len(wide), MaxVMNameLength)
+	require.NoError(t, ValidateVMName(wide))
+	require.True(t, strings.HasSuffix(wide, "-123456"))
+}
+
+func TestDeriveVMName_Collisions(t *testing.T) {
+	a, _ := DeriveVMName("prod", "shop", "redis-a", 1)
+	b, _ := DeriveVMName("prod", "shop", "redis.a", 1)
+	c, _ := DeriveVMName("prod", "shop", "redis_a", 1)
+	require.Equal(t, "prod-shop-redis-a-01", a)
+	require.NotEqual(t, a, b)
+	require.NotEqual(t, b, c)
+
+	// Instances of one service never collide.
+	first, _ := DeriveVMName("prod", "shop", "redis", 1)
+	second, _ := DeriveVMName("prod", "shop", "redis", 2)
+	require.NotEqual(t, first, second)
+}
+
+func TestValidateVMName(t *testing.T) {
+	require.NoError(t, ValidateVMName("prod-shop-redis-01"))
+	require.Error(t, ValidateVMName("Prod-shop-redis-01"))
+	require.Error(t, ValidateVMName("-prod-01"))
+	require.Error(t, ValidateVMName("prod_shop-01"))
+	require.Error(t, ValidateVMName(strings.Repeat("a", MaxVMNameLength+1)))
+}
+
+func TestValidateHostname(t *testing.T) {
+	require.NoError(t, ValidateHostname("redis-01"))
+	require.NoError(t, ValidateHostname("Redis-01.shop.example.com"))
+	require.NoError(t, ValidateHostname("1redis"))
+	require.Error(t, ValidateHostname(""))
+	require.Error(t, ValidateHostname("-redis"))
+	require.Error(t, ValidateHostname("redis-.shop"))
+	require.Error(t, ValidateHostname("redis..shop"))
+	require.Error(t, ValidateHostname("redis_01"))
+	require.Error(t, ValidateHostname(strings.Repeat("a", 64)))
+	long := strings.Repeat(strings.Repeat("a", 63)+".", 4)
+	require.Error(t, ValidateHostname(long[:MaxHostnameLength+1]))
+}
@@ -1,7 +1,9 @@
 // Package approval implements the approval workflow gateway.
 //
 // ADR-0005: Simple approval flow — PENDING → APPROVED or REJECTED.
-// V1 scope: No multi-level chains, no timeout auto-processing.
+// V2: multi-level chains — tickets with required_approvals > 1 stay PENDING
+// until enough distinct approvers have approved (ApprovalDecision rows).
+// A single rejection still rejects immediately. No timeout auto-processing.
 //
 // Import Path (ADR-0016): kv-shepherd.io/shepherd/internal/governance/approval
 package approval
@@ -10,12 +12,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvaldecision"
 	"kv-shepherd.io/shepherd/ent/approvalticket"
 	"kv-shepherd.io/shepherd/ent/batchapprovalticket"
 	"kv-shepherd.io/shepherd/ent/domainevent"
@@ -24,6 +31,8 @@ import (
 	"kv-shepherd.io/shepherd/internal/notification"
 	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
 	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/pkg/metrics"
+	"kv-shepherd.io/shepherd/internal/pkg/worker"
 	"kv-shepherd.io/shepherd/internal/service"
 )
 
@@ -38,6 +47,11 @@ type AtomicApprovalWriter interface {
 		modifiedSpec map[string]interface{},
 	) (vmID, vmName string, err error)
 	ApproveDeleteAndEnqueue(ctx context.Context, ticketID, eventID, approver, vmID string) error
+	ApproveMigrateAndEnqueue(ctx context.Context, ticketID, eventID, approver, vmID string) error
+	ApproveResizeAndEnqueue(ctx context.Context, ticketID, eventID, approver string, modifiedSpec map[string]interface{}) error
+	ApproveSnapshotAndEnqueue(ctx context.Context, ticketID, eventID, approver string) error
+	ApprovePowerAndEnqueue(ctx context.Context, ticketID, eventID, approver, operation string) error
+	ApproveNamespaceMigrateAndEnqueue(ctx context.Context, ticketID, eventID, approver string) error
 }
 
 // Gateway orchestrates approval decisions.
@@ -47,8 +61,17 @@ type Gateway struct {
 	validator    *service.ApprovalValidator
 	atomicWriter AtomicApprovalWriter
 	notifier     *notification.Triggers // Optional: nil-safe for backward compatibility
+
+	// dispatchPool runs batch child dispatch (ADR-0031). Optional: children
+	// are dispatched one at a time without it.
+	dispatchPool             *worker.Pool
+	batchDispatchConcurrency int
 }
 
+// DefaultBatchDispatchConcurrency is how many batch children are dispatched
+// at once when no concurrency is configured.
+const DefaultBatchDispatchConcurrency = 5
+
 // NewGateway creates a new approval Gateway.
 func NewGateway(client *ent.Client, auditLogger *audit.Logger, atomicWriter AtomicApprovalWriter) *Gateway {
 	return &Gateway{
@@ -65,13 +88,98 @@ func (g *Gateway) SetNotifier(notifier *notification.Triggers) {
 	g.notifier = notifier
 }
 
+// SetDispatchPool routes batch child dispatch through a worker pool.
+// This is a setter to avoid breaking the existing constructor signature.
+func (g *Gateway) SetDispatchPool(pool *worker.Pool) {
+	g.dispatchPool = pool
+}
+
+// SetBatchDispatchConcurrency bounds how many children of an approved batch
+// are dispatched in parallel. Values below 1 restore the default.
+func (g *Gateway) SetBatchDispatchConcurrency(n int) {
+	g.batchDispatchConcurrency = n
+}
+
+func (g *Gateway) batchDispatchLimit() int {
+	if g.batchDispatchConcurrency < 1 {
+		return DefaultBatchDispatchConcurrency
+	}
+	return g.batchDispatchConcurrency
+}
+
 // Approve approves a pending ticket. Admin-determined fields set here (ADR-0017).
 // ADR-0012: ticket/domain/vm writes and River enqueue are committed atomically.
 //
+// Multi-level tickets (required_approvals > 1) record the decision and stay
+// PENDING until the quorum is reached; the approver completing the quorum
+// supplies the cluster/storage selection used for dispatch.
+//
 // Branching logic by operation_type:
 //   - CREATE: ticket APPROVED + VM record CREATING → enqueue VMCreateArgs
 //   - DELETE: ticket APPROVED + VM status DELETING → enqueue VMDeleteArgs
-func (g *Gateway) Approve(ctx context.Context, ticketID, approver string, clusterID, storageClass string) error {
+//   - MIGRATE: ticket APPROVED + VM status MIGRATING → enqueue VMMigrateArgs
+//   - RESIZE: ticket APPROVED (+ approver's size as modified_spec) → enqueue VMResizeArgs
+//   - SNAPSHOT: ticket APPROVED → enqueue VMSnapshotArgs
+//   - NAMESPACE_MIGRATE: ticket APPROVED → enqueue NamespaceMigrateArgs
+//
+// comment is an optional approver note stored on the dispatched ticket(s),
+// recorded in the audit log and included in the requester notification.
+func (g *Gateway) Approve(ctx context.Context, ticketID, approver string, clusterID, storageClass, comment string) error {
+	return g.ApproveWithChildSelections(ctx, ticketID, approver, clusterID, storageClass, comment, nil)
+}
+
+// ChildSelection is the approver's cluster/storage choice for one child of a
+// batch parent. An empty StorageClass falls back to the top-level selection.
+type ChildSelection struct {
+	ClusterID    string
+	StorageClass string
+}
+
+// CodeBatchClusterSelectionIncomplete is returned when a batch parent is
+// approved while some pending CREATE children have no cluster to land on.
+const CodeBatchClusterSelectionIncomplete = "BATCH_CLUSTER_SELECTION_INCOMPLETE"
+
+// ResizeSelection is the approver's size choice for a RESIZE ticket: either
+// InstanceSizeID from the catalog or explicit values, where zero values keep
+// the requested size.
+type ResizeSelection struct {
+	InstanceSizeID string
+	CPU            int
+	MemoryMB       int
+	DiskGB         int
+}
+
+// IsZero reports whether the approver made no selection.
+func (s ResizeSelection) IsZero() bool {
+	return s == ResizeSelection{}
+}
+
+// ApproveWithChildSelections is Approve with per-child cluster/storage
+// selections for batch parents, keyed by child ticket ID. Children without an
+// entry use clusterID/storageClass. Selections are rejected for non-batch
+// tickets and for IDs that are not children of the ticket.
+func (g *Gateway) ApproveWithChildSelections(
+	ctx context.Context,
+	ticketID, approver, clusterID, storageClass, comment string,
+	childSelections map[string]ChildSelection,
+) error {
+	return g.approve(ctx, ticketID, approver, clusterID, storageClass, comment, childSelections, ResizeSelection{})
+}
+
+// ApproveResize is Approve for RESIZE tickets where the approver applies
+// resize instead of the requested size. A zero selection approves the
+// requested size; a selection on any other ticket type is rejected.
+func (g *Gateway) ApproveResize(ctx context.Context, ticketID, approver, comment string, resize ResizeSelection) error {
+	return g.approve(ctx, ticketID, approver, "", "", comment, nil, resize)
+}
+
+func (g *Gateway) approve(
+	ctx context.Context,
+	ticketID, approver, clusterID, storageClass, comment string,
+	childSelections map[string]ChildSelection,
+	resize ResizeSelection,
+) error {
+	comment = strings.TrimSpace(comment)
 	ticket, err := g.client.ApprovalTicket.Get(ctx, ticketID)
 	if err != nil {
 		return fmt.Errorf("get ticket %s: %w", ticketID, err)
@@ -80,7 +188,49 @@ func (g *Gateway) Approve(ctx context.Context, ticketID, approver string, cluste
 	if ticket.Status != approvalticket.StatusPENDING {
 		return fmt.Errorf("ticket %s is not pending (current: %s)", ticketID, ticket.Status)
 	}
+	if len(childSelections) > 0 {
+		if err := g.validateChildSelectionTargets(ctx, ticket, childSelections); err != nil {
+			return err
+		}
+	}
+	// Resolve the selection before voting so an invalid one does not count
+	// towards a multi-level quorum.
+	var resizeSpec map[string]interface{}
+	if !resize.IsZero() {
+		if ticket.OperationType != approvalticket.OperationTypeRESIZE {
+			return apperrors.BadRequest(apperrors.CodeInvalidRequestField,
+				fmt.Sprintf("resize selection is only valid for RESIZE tickets, ticket %s is %s", ticketID, ticket.OperationType))
+		}
+		if resizeSpec, err = g.resolveResizeSelection(ctx, ticket, resize); err != nil {
+			return err
+		}
+	}
+
+	quorumReached, err := g.recordApprovalDecision(ctx, ticket, approver, clusterID, storageClass)
+	if err != nil {
+		return err
+	}
+	if !quorumReached {
+		metrics.ApprovalDecisions.WithLabelValues("partially_approved").Inc()
+		return nil
+	}
 
+	if err := g.dispatchApproved(ctx, ticket, approver, clusterID, storageClass, comment, childSelections, resizeSpec); err != nil {
+		return err
+	}
+	metrics.ApprovalDecisions.WithLabelValues("approved").Inc()
+	return nil
+}
+
+// dispatchApproved executes a ticket whose approval quorum has been reached.
+func (g *Gateway) dispatchApproved(
+	ctx context.Context,
+	ticket *ent.ApprovalTicket,
+	approver, clusterID, storageClass, comment string,
+	childSelections map[string]ChildSelection,
+	resizeSpec map[string]interface{},
+) error {
+	ticketID := ticket.ID
 	event, err := g.client.DomainEvent.Get(ctx, ticket.EventID)
 	if err != nil {
 		return fmt.Errorf("get domain event %s: %w", ticket.EventID, err)
@@ -90,23 +240,126 @@ func (g *Gateway) Approve(ctx context.Context, ticketID, approver string, cluste
 		return fmt.Errorf("resolve batch parent ticket %s: %w", ticketID, err)
 	}
 	if isBatchParent {
-		return g.approveBatchParent(ctx, ticket, event, approver, clusterID, storageClass)
+		return g.approveBatchParent(ctx, ticket, event, approver, clusterID, storageClass, comment, childSelections, nil)
 	}
 
 	// Branch by operation_type (ADR-0015 §5.D).
 	switch ticket.OperationType {
 	case approvalticket.OperationTypeDELETE:
-		return g.approveDelete(ctx, ticket, ticketID, approver)
+		return g.approveDelete(ctx, ticket, ticketID, approver, comment)
+	case approvalticket.OperationTypeMIGRATE:
+		return g.approveMigrate(ctx, ticket, event, ticketID, approver, comment)
+	case approvalticket.OperationTypeRESIZE:
+		return g.approveResize(ctx, ticket, event, ticketID, approver, comment, resizeSpec)
+	case approvalticket.OperationTypeSNAPSHOT:
+		return g.approveSnapshot(ctx, ticket, event, ticketID, approver, comment)
+	case approvalticket.OperationTypeNAMESPACE_MIGRATE:
+		return g.approveNamespaceMigrate(ctx, ticket, event, ticketID, approver, comment)
 	case approvalticket.OperationTypeVNC_ACCESS:
-		return g.approveVNC(ctx, ticket, event, ticketID, approver)
+		return g.approveVNC(ctx, ticket, event, ticketID, approver, comment)
 	default:
 		// CREATE is the default operation type.
-		return g.approveCreate(ctx, ticket, ticketID, approver, clusterID, storageClass)
+		return g.approveCreate(ctx, ticket, ticketID, approver, clusterID, storageClass, comment)
+	}
+}
+
+// recordApprovalDecision applies multi-level quorum semantics (ADR-0005 V2).
+// Returns true when the ticket has enough approvals to be dispatched.
+//
+// Single-level tickets (required_approvals <= 1) skip decision bookkeeping.
+// An approver who already voted may retry dispatch once the quorum is reached
+// (e.g. after a failed validation), but cannot vote twice on a pending chain.
+func (g *Gateway) recordApprovalDecision(
+	ctx context.Context,
+	ticket *ent.ApprovalTicket,
+	approver, clusterID, storageClass string,
+) (bool, error) {
+	if ticket.RequiredApprovals <= 1 {
+		return true, nil
+	}
+
+	alreadyVoted, err := g.client.ApprovalDecision.Query().
+		Where(
+			approvaldecision.TicketIDEQ(ticket.ID),
+			approvaldecision.ApproverEQ(approver),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("query approval decision for ticket %s: %w", ticket.ID, err)
+	}
+	if !alreadyVoted {
+		id, _ := uuid.NewV7()
+		create := g.client.ApprovalDecision.Create().
+			SetID(id.String()).
+			SetTicketID(ticket.ID).
+			SetApprover(approver).
+			SetDecision(approvaldecision.DecisionAPPROVED)
+		if v := strings.TrimSpace(clusterID); v != "" {
+			create = create.SetSelectedClusterID(v)
+		}
+		if v := strings.TrimSpace(storageClass); v != "" {
+			create = create.SetSelectedStorageClass(v)
+		}
+		if _, err := create.Save(ctx); err != nil {
+			if !ent.IsConstraintError(err) {
+				return false, fmt.Errorf("record approval decision for ticket %s: %w", ticket.ID, err)
+			}
+			// Concurrent duplicate vote by the same approver.
+			alreadyVoted = true
+		}
+	}
+
+	received, err := countApprovals(ctx, g.client, ticket.ID)
+	if err != nil {
+		return false, err
+	}
+	if received >= ticket.RequiredApprovals {
+		return true, nil
+	}
+	if alreadyVoted {
+		return false, apperrors.Conflict(
+			"APPROVAL_ALREADY_RECORDED",
+			fmt.Sprintf("approver %s already approved ticket %s", approver, ticket.ID),
+		).WithParams(map[string]interface{}{
+			"approvals_received": received,
+			"approvals_required": ticket.RequiredApprovals,
+		})
+	}
+
+	if g.auditLogger != nil {
+		_ = g.auditLogger.LogApproval(ctx, ticket.ID, "partially_approved", approver)
+	}
+	logger.Info("approval recorded, waiting for quorum",
+		zap.String("ticket_id", ticket.ID),
+		zap.String("approver", approver),
+		zap.Int("approvals_received", received),
+		zap.Int("approvals_required", ticket.RequiredApprovals),
+	)
+	return false, nil
+}
+
+// recordRejectionDecision stores the rejecting vote of a multi-level ticket (best-effort).
+func (g *Gateway) recordRejectionDecision(ctx context.Context, ticket *ent.ApprovalTicket, approver string) {
+	if ticket.RequiredApprovals <= 1 {
+		return
+	}
+	id, _ := uuid.NewV7()
+	if _, err := g.client.ApprovalDecision.Create().
+		SetID(id.String()).
+		SetTicketID(ticket.ID).
+		SetApprover(approver).
+		SetDecision(approvaldecision.DecisionREJECTED).
+		Save(ctx); err != nil && !ent.IsConstraintError(err) {
+		logger.Warn("failed to record rejection decision",
+			zap.String("ticket_id", ticket.ID),
+			zap.String("approver", approver),
+			zap.Error(err),
+		)
 	}
 }
 
 // approveCreate handles approval of CREATE tickets (original flow).
-func (g *Gateway) approveCreate(ctx context.Context, ticket *ent.ApprovalTicket, ticketID, approver, clusterID, storageClass string) error {
+func (g *Gateway) approveCreate(ctx context.Context, ticket *ent.ApprovalTicket, ticketID, approver, clusterID, storageClass, comment string) error {
 	if clusterID == "" {
 		return fmt.Errorf("selected cluster is required for create approval")
 	}
@@ -131,6 +384,10 @@ func (g *Gateway) approveCreate(ctx context.Context, ticket *ent.ApprovalTicket,
 	if effectiveInstanceSizeID == "" {
 		return fmt.Errorf("effective instance size id is empty for ticket %s", ticketID)
 	}
+	if payload.SourceClusterID != "" && payload.SourceClusterID != clusterID {
+		return fmt.Errorf("ticket %s clones a vm on cluster %s and cannot be placed on cluster %s",
+			ticketID, payload.SourceClusterID, clusterID)
+	}
 
 	if g.validator != nil {
 		if err := g.validator.ValidateApproval(ctx, clusterID, effectiveInstanceSizeID, payload.Namespace); err != nil {
@@ -146,8 +403,19 @@ func (g *Gateway) approveCreate(ctx context.Context, ticket *ent.ApprovalTicket,
 	if err != nil {
 		return fmt.Errorf("get instance size %s for ticket %s: %w", effectiveInstanceSizeID, ticketID, err)
 	}
+	if g.validator != nil {
+		demand := quotaDemand(payload, instanceSizeEntity)
+		if err := g.validator.ValidateQuota(ctx, demand); err != nil {
+			return fmt.Errorf("quota check failed for ticket %s: %w", ticketID, err)
+		}
+	}
 
-	templateSnapshot := buildTemplateSnapshot(templateEntity)
+	templateAncestors, err := LoadTemplateAncestors(ctx, g.client, templateEntity)
+	if err != nil {
+		return fmt.Errorf("resolve template %s inheritance for ticket %s: %w", effectiveTemplateID, ticketID, err)
+	}
+
+	templateSnapshot := buildTemplateSnapshot(templateEntity, templateAncestors...)
 	instanceSizeSnapshot := buildInstanceSizeSnapshot(instanceSizeEntity)
 	modifiedSpec := cloneMap(ticket.ModifiedSpec)
 
@@ -173,15 +441,16 @@ func (g *Gateway) approveCreate(ctx context.Context, ticket *ent.ApprovalTicket,
 	if err != nil {
 		return fmt.Errorf("approve create ticket %s atomically: %w", ticketID, err)
 	}
+	g.saveApprovalComment(ctx, ticketID, comment)
 
 	// Audit log (best-effort, outside transaction).
 	if g.auditLogger != nil {
-		_ = g.auditLogger.LogApproval(ctx, ticketID, "approved", approver)
+		_ = g.auditLogger.LogApprovalWithComment(ctx, ticketID, "approved", approver, comment)
 	}
 
 	// Notification trigger: APPROVAL_COMPLETED → notify requester (master-flow.md Stage 5.F).
 	if g.notifier != nil {
-		g.notifier.OnTicketApproved(ctx, ticketID, payload.RequesterID, approver)
+		g.notifier.OnTicketApproved(ctx, ticketID, payload.RequesterID, approver, comment)
 	}
 
 	logger.Info("CREATE ticket approved and job enqueued",
@@ -195,9 +464,25 @@ func (g *Gateway) approveCreate(ctx context.Context, ticket *ent.ApprovalTicket,
 	return nil
 }
 
+// saveApprovalComment stores the approver comment once the atomic approval
+// commit has succeeded (best-effort; the decision itself is already durable).
+func (g *Gateway) saveApprovalComment(ctx context.Context, ticketID, comment string) {
+	if comment == "" {
+		return
+	}
+	if _, err := g.client.ApprovalTicket.UpdateOneID(ticketID).
+		SetApprovalComment(comment).
+		Save(ctx); err != nil {
+		logger.Warn("failed to save approval comment",
+			zap.String("ticket_id", ticketID),
+			zap.Error(err),
+		)
+	}
+}
+
 // approveDelete handles approval of DELETE tickets.
 // ADR-0012: decision write + domain state + River enqueue are one atomic commit.
-func (g *Gateway) approveDelete(ctx context.Context, ticket *ent.ApprovalTicket, ticketID, approver string) error {
+func (g *Gateway) approveDelete(ctx context.Context, ticket *ent.ApprovalTicket, ticketID, approver, comment string) error {
 	// Parse the event payload to extract VM info for the delete job.
 	event, err := g.client.DomainEvent.Get(ctx, ticket.EventID)
 	if err != nil {
@@ -221,15 +506,16 @@ func (g *Gateway) approveDelete(ctx context.Context, ticket *ent.ApprovalTicket,
 	if err := g.atomicWriter.ApproveDeleteAndEnqueue(ctx, ticketID, ticket.EventID, approver, payload.VMID); err != nil {
 		return fmt.Errorf("approve delete ticket %s atomically: %w", ticketID, err)
 	}
+	g.saveApprovalComment(ctx, ticketID, comment)
 
 	// Audit log (best-effort).
 	if g.auditLogger != nil {
-		_ = g.auditLogger.LogApproval(ctx, ticketID, "delete_approved", approver)
+		_ = g.auditLogger.LogApprovalWithComment(ctx, ticketID, "delete_approved", approver, comment)
 	}
 
 	// Notification trigger: APPROVAL_COMPLETED for delete → notify requester.
 	if g.notifier != nil {
-		g.notifier.OnTicketApproved(ctx, ticketID, payload.Actor, approver)
+		g.notifier.OnTicketApproved(ctx, ticketID, payload.Actor, approver, comment)
 	}
 
 	logger.Info("DELETE ticket approved and job enqueued",
@@ -243,8 +529,301 @@ func (g *Gateway) approveDelete(ctx context.Context, ticket *ent.ApprovalTicket,
 	return nil
 }
 
+// approveMigrate handles approval of cross-cluster MIGRATE tickets.
+// ADR-0012: decision write + VM MIGRATING + River enqueue are one atomic commit.
+func (g *Gateway) approveMigrate(ctx context.Context, ticket *ent.ApprovalTicket, event *ent.DomainEvent, ticketID, approver, comment string) error {
+	if event == nil {
+		return fmt.Errorf("migrate approval requires domain event")
+	}
+	if event.EventType != string(domain.EventVMMigrationRequested) {
+		return fmt.Errorf("ticket %s is MIGRATE but domain event type is %s", ticketID, event.EventType)
+	}
+
+	var payload domain.VMMigratePayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("parse migrate event payload: %w", err)
+	}
+
+	if g.atomicWriter == nil {
+		return fmt.Errorf("atomic approval writer is not configured")
+	}
+	if err := g.atomicWriter.ApproveMigrateAndEnqueue(ctx, ticketID, ticket.EventID, approver, payload.VMID); err != nil {
+		return fmt.Errorf("approve migrate ticket %s atomically: %w", ticketID, err)
+	}
+	g.saveApprovalComment(ctx, ticketID, comment)
+
+	if g.auditLogger != nil {
+		_ = g.auditLogger.LogApprovalWithComment(ctx, ticketID, "migrate_approved", approver, comment)
+	}
+	if g.notifier != nil {
+		g.notifier.OnTicketApproved(ctx, ticketID, payload.Actor, approver, comment)
+	}
+
+	logger.Info("MIGRATE ticket approved and job enqueued",
+		zap.String("ticket_id", ticketID),
+		zap.String("approver", approver),
+		zap.String("vm_id", payload.VMID),
+		zap.String("source_cluster", payload.SourceClusterID),
+		zap.String("target_cluster", payload.TargetClusterID),
+		zap.String("event_id", ticket.EventID),
+	)
+	return nil
+}
+
+// approveResize handles approval of RESIZE tickets. resizeSpec is the
+// approver's size selection (nil to apply the requested size).
+// ADR-0012: decision write + River enqueue are one atomic commit.
+func (g *Gateway) approveResize(
+	ctx context.Context,
+	ticket *ent.ApprovalTicket,
+	event *ent.DomainEvent,
+	ticketID, approver, comment string,
+	resizeSpec map[string]interface{},
+) error {
+	if event == nil {
+		return fmt.Errorf("resize approval requires domain event")
+	}
+	if event.EventType != string(domain.EventVMResizeRequested) {
+		return fmt.Errorf("ticket %s is RESIZE but domain event type is %s", ticketID, event.EventType)
+	}
+
+	var payload domain.VMResizePayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("parse resize event payload: %w", err)
+	}
+
+	if g.atomicWriter == nil {
+		return fmt.Errorf("atomic approval writer is not configured")
+	}
+	if err := g.atomicWriter.ApproveResizeAndEnqueue(ctx, ticketID, ticket.EventID, approver, resizeSpec); err != nil {
+		return fmt.Errorf("approve resize ticket %s atomically: %w", ticketID, err)
+	}
+	g.saveApprovalComment(ctx, ticketID, comment)
+
+	if g.auditLogger != nil {
+		_ = g.auditLogger.LogApprovalWithComment(ctx, ticketID, "resize_approved", approver, comment)
+	}
+	if g.notifier != nil {
+		g.notifier.OnTicketApproved(ctx, ticketID, payload.Actor, approver, comment)
+	}
+
+	target := payload.Target(resizeSpec)
+	logger.Info("RESIZE ticket approved and job enqueued",
+		zap.String("ticket_id", ticketID),
+		zap.String("approver", approver),
+		zap.String("vm_id", payload.VMID),
+		zap.Int("to_cpu", target.CPU),
+		zap.Int("to_memory_mb", target.MemoryMB),
+		zap.Int("to_disk_gb", target.DiskGB),
+		zap.Bool("approver_selected", resizeSpec != nil),
+		zap.String("event_id", ticket.EventID),
+	)
+	return nil
+}
+
+// resolveResizeSelection turns the approver's selection into the size stored
+// as the ticket's modified_spec (see domain.VMResizePayload.Target).
+func (g *Gateway) resolveResizeSelection(
+	ctx context.Context,
+	ticket *ent.ApprovalTicket,
+	sel ResizeSelection,
+) (map[string]interface{}, error) {
+	instanceSizeID := strings.TrimSpace(sel.InstanceSizeID)
+	explicit := sel.CPU != 0 || sel.MemoryMB != 0 || sel.DiskGB != 0
+	switch {
+	case instanceSizeID != "" && explicit:
+		return nil, apperrors.BadRequest(apperrors.CodeInvalidRequestField,
+			"resize.instance_size_id cannot be combined with resize.cpu/memory_mb/disk_gb")
+	case sel.CPU < 0 || sel.MemoryMB < 0 || sel.DiskGB < 0:
+		return nil, apperrors.BadRequest(apperrors.CodeInvalidRequestField,
+			"resize.cpu, resize.memory_mb and resize.disk_gb must be positive")
+	}
+
+	event, err := g.client.DomainEvent.Get(ctx, ticket.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("get domain event %s: %w", ticket.EventID, err)
+	}
+	var payload domain.VMResizePayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return nil, fmt.Errorf("parse resize event payload: %w", err)
+	}
+
+	to := domain.VMSize{CPU: payload.To.CPU, MemoryMB: payload.To.MemoryMB, DiskGB: payload.To.DiskGB}
+	if instanceSizeID != "" {
+		size, err := g.client.InstanceSize.Get(ctx, instanceSizeID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, apperrors.NotFound("INSTANCE_SIZE_NOT_FOUND", fmt.Sprintf("instance size %s not found", instanceSizeID))
+			}
+			return nil, fmt.Errorf("get instance size %s: %w", instanceSizeID, err)
+		}
+		if !size.Enabled {
+			return nil, apperrors.BadRequest(apperrors.CodeValidationFailed, fmt.Sprintf("instance size %s is disabled", size.Name))
+		}
+		to = domain.VMSize{
+			InstanceSizeID:   size.ID,
+			InstanceSizeName: size.Name,
+			CPU:              size.CPUCores,
+			MemoryMB:         size.MemoryMB,
+			DiskGB:           size.DiskGB,
+		}
+		if to.DiskGB == 0 {
+			to.DiskGB = payload.To.DiskGB
+		}
+	} else {
+		if sel.CPU > 0 {
+			to.CPU = sel.CPU
+		}
+		if sel.MemoryMB > 0 {
+			to.MemoryMB = sel.MemoryMB
+		}
+		if sel.DiskGB > 0 {
+			to.DiskGB = sel.DiskGB
+		}
+	}
+	if to.DiskGB < payload.From.DiskGB {
+		return nil, apperrors.BadRequest(apperrors.CodeValidationFailed,
+			fmt.Sprintf("disk of VM %s cannot shrink from %d GB to %d GB", payload.VMName, payload.From.DiskGB, to.DiskGB))
+	}
+
+	raw, err := json.Marshal(to)
+	if err != nil {
+		return nil, fmt.Errorf("marshal resize selection: %w", err)
+	}
+	var spec map[string]interface{}
+	if err := json.Unmarshal(raw, &spec); err != nil {
+		return nil, fmt.Errorf("decode resize selection: %w", err)
+	}
+	return spec, nil
+}
+
+// approveSnapshot handles approval of SNAPSHOT tickets.
+// ADR-0012: decision write + River enqueue are one atomic commit.
+func (g *Gateway) approveSnapshot(ctx context.Context, ticket *ent.ApprovalTicket, event *ent.DomainEvent, ticketID, approver, comment string) error {
+	if event == nil {
+		return fmt.Errorf("snapshot approval requires domain event")
+	}
+	if event.EventType != string(domain.EventVMSnapshotCreateRequested) {
+		return fmt.Errorf("ticket %s is SNAPSHOT but domain event type is %s", ticketID, event.EventType)
+	}
+
+	var payload domain.VMSnapshotPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("parse snapshot event payload: %w", err)
+	}
+
+	if g.atomicWriter == nil {
+		return fmt.Errorf("atomic approval writer is not configured")
+	}
+	if err := g.atomicWriter.ApproveSnapshotAndEnqueue(ctx, ticketID, ticket.EventID, approver); err != nil {
+		return fmt.Errorf("approve snapshot ticket %s atomically: %w", ticketID, err)
+	}
+	g.saveApprovalComment(ctx, ticketID, comment)
+
+	if g.auditLogger != nil {
+		_ = g.auditLogger.LogApprovalWithComment(ctx, ticketID, "snapshot_approved", approver, comment)
+	}
+	if g.notifier != nil {
+		g.notifier.OnTicketApproved(ctx, ticketID, payload.Actor, approver, comment)
+	}
+
+	logger.Info("SNAPSHOT ticket approved and job enqueued",
+		zap.String("ticket_id", ticketID),
+		zap.String("approver", approver),
+		zap.String("vm_id", payload.VMID),
+		zap.String("snapshot_name", payload.SnapshotName),
+		zap.String("event_id", ticket.EventID),
+	)
+	return nil
+}
+
+// approvePower handles approval of a batch power child. Power children are
+// stored as CREATE tickets, so the operation comes from the child event.
+// ADR-0012: decision write + River enqueue are one atomic commit.
+func (g *Gateway) approvePower(ctx context.Context, ticket *ent.ApprovalTicket, approver, comment string) error {
+	event, err := g.client.DomainEvent.Get(ctx, ticket.EventID)
+	if err != nil {
+		return fmt.Errorf("get domain event %s: %w", ticket.EventID, err)
+	}
+	switch domain.EventType(event.EventType) {
+	case domain.EventVMStartRequested, domain.EventVMStopRequested, domain.EventVMRestartRequested:
+	default:
+		return fmt.Errorf("ticket %s is a power batch child but domain event type is %s", ticket.ID, event.EventType)
+	}
+
+	var payload domain.VMPowerPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("parse power event payload: %w", err)
+	}
+	if strings.TrimSpace(payload.Operation) == "" {
+		return fmt.Errorf("power event %s has no operation", event.ID)
+	}
+
+	if g.atomicWriter == nil {
+		return fmt.Errorf("atomic approval writer is not configured")
+	}
+	if err := g.atomicWriter.ApprovePowerAndEnqueue(ctx, ticket.ID, ticket.EventID, approver, payload.Operation); err != nil {
+		return fmt.Errorf("approve power ticket %s atomically: %w", ticket.ID, err)
+	}
+	g.saveApprovalComment(ctx, ticket.ID, comment)
+
+	if g.auditLogger != nil {
+		_ = g.auditLogger.LogApprovalWithComment(ctx, ticket.ID, "power_approved", approver, comment)
+	}
+
+	logger.Info("power batch child approved and job enqueued",
+		zap.String("ticket_id", ticket.ID),
+		zap.String("approver", approver),
+		zap.String("vm_id", payload.VMID),
+		zap.String("operation", payload.Operation),
+		zap.String("event_id", ticket.EventID),
+	)
+	return nil
+}
+
+// approveNamespaceMigrate handles approval of NAMESPACE_MIGRATE tickets.
+// ADR-0012: decision write + River enqueue are one atomic commit.
+func (g *Gateway) approveNamespaceMigrate(ctx context.Context, ticket *ent.ApprovalTicket, event *ent.DomainEvent, ticketID, approver, comment string) error {
+	if event == nil {
+		return fmt.Errorf("namespace migration approval requires domain event")
+	}
+	if event.EventType != string(domain.EventNamespaceMigrationRequested) {
+		return fmt.Errorf("ticket %s is NAMESPACE_MIGRATE but domain event type is %s", ticketID, event.EventType)
+	}
+
+	var payload domain.NamespaceMigrationPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("parse namespace migration event payload: %w", err)
+	}
+
+	if g.atomicWriter == nil {
+		return fmt.Errorf("atomic approval writer is not configured")
+	}
+	if err := g.atomicWriter.ApproveNamespaceMigrateAndEnqueue(ctx, ticketID, ticket.EventID, approver); err != nil {
+		return fmt.Errorf("approve namespace migration ticket %s atomically: %w", ticketID, err)
+	}
+	g.saveApprovalComment(ctx, ticketID, comment)
+
+	if g.auditLogger != nil {
+		_ = g.auditLogger.LogApprovalWithComment(ctx, ticketID, "namespace_migrate_approved", approver, comment)
+	}
+	if g.notifier != nil {
+		g.notifier.OnTicketApproved(ctx, ticketID, payload.Actor, approver, comment)
+	}
+
+	logger.Info("NAMESPACE_MIGRATE ticket approved and job enqueued",
+		zap.String("ticket_id", ticketID),
+		zap.String("approver", approver),
+		zap.String("namespace", payload.Namespace),
+		zap.String("from_environment", payload.FromEnvironment),
+		zap.String("to_environment", payload.ToEnvironment),
+		zap.String("event_id", ticket.EventID),
+	)
+	return nil
+}
+
 // approveVNC handles approval of VNC access tickets.
-func (g *Gateway) approveVNC(ctx context.Context, ticket *ent.ApprovalTicket, event *ent.DomainEvent, ticketID, approver string) error {
+func (g *Gateway) approveVNC(ctx context.Context, ticket *ent.ApprovalTicket, event *ent.DomainEvent, ticketID, approver, comment string) error {
 	if event == nil {
 		return fmt.Errorf("vnc approval requires domain event")
 	}
@@ -252,10 +831,28 @@ func (g *Gateway) approveVNC(ctx context.Context, ticket *ent.ApprovalTicket, ev
 		return fmt.Errorf("ticket %s is VNC_ACCESS but domain event type is %s", ticketID, event.EventType)
 	}
 
-	if _, err := g.client.ApprovalTicket.UpdateOneID(ticketID).
+	// The requested window was clamped when the request was made; requests
+	// recorded without one get the default maximum.
+	var payload struct {
+		DurationSeconds int64 `json:"duration_seconds"`
+	}
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("decode vnc request payload for ticket %s: %w", ticketID, err)
+	}
+	window := time.Duration(payload.DurationSeconds) * time.Second
+	if window <= 0 {
+		window = service.DefaultVNCMaxAccessDuration
+	}
+	expiresAt := time.Now().UTC().Add(window)
+
+	vncUpdater := g.client.ApprovalTicket.UpdateOneID(ticketID).
 		SetStatus(approvalticket.StatusAPPROVED).
 		SetApprover(approver).
-		Save(ctx); err != nil {
+		SetExpiresAt(expiresAt)
+	if comment != "" {
+		vncUpdater = vncUpdater.SetApprovalComment(comment)
+	}
+	if _, err := vncUpdater.Save(ctx); err != nil {
 		return fmt.Errorf("approve vnc ticket %s: %w", ticketID, err)
 	}
 	if _, err := g.client.DomainEvent.UpdateOneID(ticket.EventID).
@@ -265,21 +862,23 @@ func (g *Gateway) approveVNC(ctx context.Context, ticket *ent.ApprovalTicket, ev
 	}
 
 	if g.auditLogger != nil {
-		_ = g.auditLogger.LogApproval(ctx, ticketID, "vnc_access_approved", approver)
+		_ = g.auditLogger.LogApprovalWithComment(ctx, ticketID, "vnc_access_approved", approver, comment)
 	}
 	if g.notifier != nil {
-		g.notifier.OnTicketApproved(ctx, ticketID, ticket.Requester, approver)
+		g.notifier.OnTicketApproved(ctx, ticketID, ticket.Requester, approver, comment)
 	}
 
 	logger.Info("VNC ticket approved",
 		zap.String("ticket_id", ticketID),
 		zap.String("approver", approver),
 		zap.String("event_id", ticket.EventID),
+		zap.Time("expires_at", expiresAt),
 	)
 	return nil
 }
 
 // Reject rejects a pending ticket.
+// A single rejection is final, even when a multi-level chain has partial approvals.
 func (g *Gateway) Reject(ctx context.Context, ticketID, approver, reason string) error {
 	ticket, err := g.client.ApprovalTicket.Get(ctx, ticketID)
 	if err != nil {
@@ -299,7 +898,11 @@ func (g *Gateway) Reject(ctx context.Context, ticketID, approver, reason string)
 		return fmt.Errorf("resolve batch parent ticket %s: %w", ticketID, err)
 	}
 	if isBatchParent {
-		return g.rejectBatchParent(ctx, ticket, approver, reason)
+		if err := g.rejectBatchParent(ctx, ticket, approver, reason); err != nil {
+			return err
+		}
+		metrics.ApprovalDecisions.WithLabelValues("rejected").Inc()
+		return nil
 	}
 
 	if _, err := g.client.ApprovalTicket.UpdateOneID(ticketID).
@@ -314,6 +917,8 @@ func (g *Gateway) Reject(ctx context.Context, ticketID, approver, reason string)
 		Save(ctx); err != nil {
 		return fmt.Errorf("set domain event CANCELLED for rejected ticket %s: %w", ticketID, err)
 	}
+	g.recordRejectionDecision(ctx, ticket, approver)
+	metrics.ApprovalDecisions.WithLabelValues("rejected").Inc()
 
 	// Audit log (master-flow.md Stage 5.B)
 	if g.auditLogger != nil {
@@ -388,11 +993,133 @@ func (g *Gateway) Cancel(ctx context.Context, ticketID, requester string) error
 	return nil
 }
 
+// Batch approval error codes.
+const (
+	CodeBatchNotFound   = "BATCH_NOT_FOUND"
+	CodeBatchNotPending = "BATCH_NOT_PENDING"
+)
+
+// ApproveBatch approves batch parent batchID and dispatches its pending
+// children in one operation. A non-empty selectedItems dispatches only those
+// children; the parent then stays PENDING (PARTIAL_APPROVED in the batch
+// view) so the remaining children can be approved, rejected or cancelled
+// later. On multi-level batches the approver completing the quorum chooses
+// the items.
+func (g *Gateway) ApproveBatch(
+	ctx context.Context,
+	batchID, approver, clusterID, storageClass, comment string,
+	selectedItems []string,
+) error {
+	comment = strings.TrimSpace(comment)
+	parent, err := g.client.ApprovalTicket.Get(ctx, batchID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperrors.NotFound(CodeBatchNotFound, fmt.Sprintf("batch %s not found", batchID))
+		}
+		return fmt.Errorf("get batch parent %s: %w", batchID, err)
+	}
+	event, err := g.client.DomainEvent.Get(ctx, parent.EventID)
+	if err != nil {
+		return fmt.Errorf("get domain event %s: %w", parent.EventID, err)
+	}
+	isBatchParent, err := g.isBatchParentTicket(ctx, parent, event)
+	if err != nil {
+		return fmt.Errorf("resolve batch parent ticket %s: %w", batchID, err)
+	}
+	if !isBatchParent {
+		return apperrors.NotFound(CodeBatchNotFound, fmt.Sprintf("batch %s not found", batchID))
+	}
+	if parent.Status != approvalticket.StatusPENDING {
+		return apperrors.Conflict(CodeBatchNotPending,
+			fmt.Sprintf("batch %s is not pending (current: %s)", batchID, parent.Status))
+	}
+	selected, err := g.resolveBatchItemSelection(ctx, parent, selectedItems)
+	if err != nil {
+		return err
+	}
+
+	quorumReached, err := g.recordApprovalDecision(ctx, parent, approver, clusterID, storageClass)
+	if err != nil {
+		return err
+	}
+	if !quorumReached {
+		metrics.ApprovalDecisions.WithLabelValues("partially_approved").Inc()
+		return nil
+	}
+	if err := g.approveBatchParent(ctx, parent, event, approver, clusterID, storageClass, comment, nil, selected); err != nil {
+		return err
+	}
+	metrics.ApprovalDecisions.WithLabelValues("approved").Inc()
+	return nil
+}
+
+// resolveBatchItemSelection validates that every ID in items is a pending
+// child of parent. An empty items selects every pending child (nil set).
+func (g *Gateway) resolveBatchItemSelection(
+	ctx context.Context,
+	parent *ent.ApprovalTicket,
+	items []string,
+) (map[string]struct{}, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+	children, err := g.client.ApprovalTicket.Query().
+		Where(approvalticket.ParentTicketIDEQ(parent.ID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list child tickets for %s: %w", parent.ID, err)
+	}
+	statusByID := make(map[string]approvalticket.Status, len(children))
+	for _, child := range children {
+		statusByID[child.ID] = child.Status
+	}
+
+	selected := make(map[string]struct{}, len(items))
+	var unknown, notPending []string
+	for _, raw := range items {
+		id := strings.TrimSpace(raw)
+		if _, dup := selected[id]; dup {
+			continue
+		}
+		status, ok := statusByID[id]
+		switch {
+		case !ok:
+			unknown = append(unknown, id)
+		case status != approvalticket.StatusPENDING:
+			notPending = append(notPending, id)
+		default:
+			selected[id] = struct{}{}
+		}
+	}
+	if len(unknown) > 0 || len(notPending) > 0 {
+		params := map[string]interface{}{}
+		if len(unknown) > 0 {
+			params["unknown_children"] = unknown
+		}
+		if len(notPending) > 0 {
+			params["not_pending_children"] = notPending
+		}
+		return nil, apperrors.BadRequest(apperrors.CodeValidationFailed,
+			fmt.Sprintf("%d selected items are not pending children of batch %s", len(unknown)+len(notPending), parent.ID),
+		).WithParams(params)
+	}
+	return selected, nil
+}
+
+// approveBatchParent dispatches pending children once the parent ticket has
+// reached its quorum. Children are governed by the parent's
+// required_approvals and are not voted on individually.
+//
+// A non-nil selected restricts dispatch to those children. When pending
+// children remain outside the selection the approval is partial: the parent
+// ticket and event stay PENDING and only the projection records the progress.
 func (g *Gateway) approveBatchParent(
 	ctx context.Context,
 	parent *ent.ApprovalTicket,
 	parentEvent *ent.DomainEvent,
-	approver, clusterID, storageClass string,
+	approver, clusterID, storageClass, comment string,
+	childSelections map[string]ChildSelection,
+	selected map[string]struct{},
 ) error {
 	children, err := g.client.ApprovalTicket.Query().
 		Where(approvalticket.ParentTicketIDEQ(parent.ID)).
@@ -405,25 +1132,68 @@ func (g *Gateway) approveBatchParent(
 		return fmt.Errorf("batch parent %s has no child tickets", parent.ID)
 	}
 
-	var successCount, failedCount int
-	for _, child := range children {
+	// Power children are stored as CREATE tickets but take no cluster
+	// selection or quota; they are dispatched as power jobs.
+	powerBatch := parentEvent.EventType == string(domain.EventBatchPowerRequested)
+
+	// Resolve every CREATE child's selection up front so an incomplete
+	// selection fails before anything is dispatched.
+	selections := make(map[string]ChildSelection, len(children))
+	var missing []string
+	isSelected := func(child *ent.ApprovalTicket) bool {
 		if child.Status != approvalticket.StatusPENDING {
+			return false
+		}
+		if selected == nil {
+			return true
+		}
+		_, ok := selected[child.ID]
+		return ok
+	}
+	for _, child := range children {
+		if powerBatch || !isSelected(child) || child.OperationType != approvalticket.OperationTypeCREATE {
 			continue
 		}
+		sel := resolveChildSelection(childSelections[child.ID], clusterID, storageClass)
+		if sel.ClusterID == "" {
+			missing = append(missing, child.ID)
+			continue
+		}
+		selections[child.ID] = sel
+	}
+	if len(missing) > 0 {
+		return apperrors.BadRequest(
+			CodeBatchClusterSelectionIncomplete,
+			fmt.Sprintf("%d pending create children have no selected cluster", len(missing)),
+		).WithParams(map[string]interface{}{
+			"missing_children": missing,
+		})
+	}
 
-		var approveErr error
-		switch child.OperationType {
-		case approvalticket.OperationTypeDELETE:
-			approveErr = g.approveDelete(ctx, child, child.ID, approver)
-		default:
-			approveErr = g.approveCreate(ctx, child, child.ID, approver, clusterID, storageClass)
+	pending := make([]*ent.ApprovalTicket, 0, len(children))
+	remaining := 0
+	for _, child := range children {
+		switch {
+		case isSelected(child):
+			pending = append(pending, child)
+		case child.Status == approvalticket.StatusPENDING:
+			remaining++
 		}
-		if approveErr != nil {
-			failedCount++
-			g.markChildApprovalDispatchFailed(ctx, child, approver, approveErr)
-			continue
+	}
+	if !powerBatch {
+		if err := g.validateBatchQuota(ctx, pending); err != nil {
+			return err
 		}
-		successCount++
+	}
+	successCount, failedCount := g.dispatchBatchChildren(ctx, pending, approver, comment, selections, powerBatch)
+	if err := ctx.Err(); err != nil {
+		// Children that were not reached stay PENDING under a PENDING parent,
+		// so approving the batch again dispatches the rest.
+		return fmt.Errorf("batch parent %s dispatch interrupted after %d of %d children: %w",
+			parent.ID, successCount+failedCount, len(pending), err)
+	}
+	if remaining > 0 {
+		return g.finishPartialBatchApproval(ctx, parent, approver, comment, successCount, failedCount, remaining)
 	}
 
 	parentStatus := approvalticket.StatusFAILED
@@ -436,12 +1206,15 @@ func (g *Gateway) approveBatchParent(
 	parentUpdater := g.client.ApprovalTicket.UpdateOneID(parent.ID).
 		SetStatus(parentStatus).
 		SetApprover(approver)
-	if parent.OperationType == approvalticket.OperationTypeCREATE && strings.TrimSpace(clusterID) != "" {
+	if !powerBatch && parent.OperationType == approvalticket.OperationTypeCREATE && strings.TrimSpace(clusterID) != "" {
 		parentUpdater = parentUpdater.SetSelectedClusterID(clusterID)
 	}
-	if parent.OperationType == approvalticket.OperationTypeCREATE && strings.TrimSpace(storageClass) != "" {
+	if !powerBatch && parent.OperationType == approvalticket.OperationTypeCREATE && strings.TrimSpace(storageClass) != "" {
 		parentUpdater = parentUpdater.SetSelectedStorageClass(storageClass)
 	}
+	if comment != "" {
+		parentUpdater = parentUpdater.SetApprovalComment(comment)
+	}
 	if failedCount > 0 {
 		parentUpdater = parentUpdater.SetRejectReason(fmt.Sprintf("%d child approvals failed during dispatch", failedCount))
 	}
@@ -453,10 +1226,10 @@ func (g *Gateway) approveBatchParent(
 	}
 
 	if g.auditLogger != nil {
-		_ = g.auditLogger.LogApproval(ctx, parent.ID, "batch_approved", approver)
+		_ = g.auditLogger.LogApprovalWithComment(ctx, parent.ID, "batch_approved", approver, comment)
 	}
 	if g.notifier != nil && successCount > 0 {
-		g.notifier.OnTicketApproved(ctx, parent.ID, parent.Requester, approver)
+		g.notifier.OnTicketApproved(ctx, parent.ID, parent.Requester, approver, comment)
 	}
 	g.syncBatchProjectionByParentID(ctx, parent.ID)
 
@@ -474,6 +1247,107 @@ func (g *Gateway) approveBatchParent(
 	return nil
 }
 
+// finishPartialBatchApproval records a partial batch approval. The parent
+// ticket and event are left PENDING so the children outside the selection can
+// still be approved, rejected or cancelled through the parent.
+func (g *Gateway) finishPartialBatchApproval(
+	ctx context.Context,
+	parent *ent.ApprovalTicket,
+	approver, comment string,
+	successCount, failedCount, remaining int,
+) error {
+	if g.auditLogger != nil {
+		_ = g.auditLogger.LogApprovalWithComment(ctx, parent.ID, "batch_partially_approved", approver, comment)
+	}
+	g.syncBatchProjectionByParentID(ctx, parent.ID)
+
+	if successCount == 0 {
+		return fmt.Errorf("batch parent %s partial approval dispatch failed for all %d selected children", parent.ID, failedCount)
+	}
+	logger.Info("batch parent partially approved",
+		zap.String("ticket_id", parent.ID),
+		zap.String("approver", approver),
+		zap.Int("children_dispatched", successCount),
+		zap.Int("children_failed", failedCount),
+		zap.Int("children_remaining", remaining),
+	)
+	return nil
+}
+
+// dispatchBatchChildren approves pending batch children through the
+// dispatch pool, at most batchDispatchLimit at a time. Each child commits in
+// its own atomic-writer transaction, so children only share read-only state
+// here. Once ctx is cancelled no new child is started; in-flight ones are
+// waited for. powerBatch dispatches every child as a power job.
+func (g *Gateway) dispatchBatchChildren(
+	ctx context.Context,
+	children []*ent.ApprovalTicket,
+	approver, comment string,
+	selections map[string]ChildSelection,
+	powerBatch bool,
+) (succeeded, failed int) {
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, g.batchDispatchLimit())
+	)
+	dispatch := func(child *ent.ApprovalTicket) {
+		defer wg.Done()
+		defer func() { <-sem }()
+
+		var approveErr error
+		sel := selections[child.ID]
+		switch {
+		case powerBatch:
+			approveErr = g.approvePower(ctx, child, approver, comment)
+		case child.OperationType == approvalticket.OperationTypeDELETE:
+			approveErr = g.approveDelete(ctx, child, child.ID, approver, comment)
+		default:
+			approveErr = g.approveCreate(ctx, child, child.ID, approver, sel.ClusterID, sel.StorageClass, comment)
+		}
+		if approveErr != nil {
+			g.markChildApprovalDispatchFailed(ctx, child, approver, sel, approveErr)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if approveErr != nil {
+			failed++
+		} else {
+			succeeded++
+		}
+	}
+
+	for _, child := range children {
+		select {
+		case <-ctx.Done():
+		case sem <- struct{}{}:
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		if g.dispatchPool == nil {
+			dispatch(child)
+			continue
+		}
+		// The pool skips tasks whose context is already cancelled; submit
+		// with a detached context so every accepted task releases wg.
+		if err := g.dispatchPool.Submit(context.WithoutCancel(ctx), func(context.Context) { dispatch(child) }); err != nil {
+			logger.Warn("batch child dispatch pool unavailable, dispatching inline",
+				zap.String("ticket_id", child.ID),
+				zap.Error(err),
+			)
+			dispatch(child)
+		}
+	}
+	wg.Wait()
+	return succeeded, failed
+}
+
+// rejectBatchParent rejects the parent and all pending children immediately,
+// regardless of approvals already accumulated toward the parent's quorum.
 func (g *Gateway) rejectBatchParent(
 	ctx context.Context,
 	parent *ent.ApprovalTicket,
@@ -487,6 +1361,7 @@ func (g *Gateway) rejectBatchParent(
 		Save(ctx); err != nil {
 		return fmt.Errorf("reject batch parent ticket %s: %w", parent.ID, err)
 	}
+	g.recordRejectionDecision(ctx, parent, approver)
 	if _, err := g.client.DomainEvent.UpdateOneID(parent.EventID).
 		SetStatus(domainevent.StatusCANCELLED).
 		Save(ctx); err != nil {
@@ -582,6 +1457,7 @@ func (g *Gateway) markChildApprovalDispatchFailed(
 	ctx context.Context,
 	child *ent.ApprovalTicket,
 	approver string,
+	sel ChildSelection,
 	cause error,
 ) {
 	if child == nil {
@@ -594,11 +1470,19 @@ func (g *Gateway) markChildApprovalDispatchFailed(
 	if len(message) > 512 {
 		message = message[:512]
 	}
-	if _, err := g.client.ApprovalTicket.UpdateOneID(child.ID).
+	// Keep the child's own selection so a batch retry re-dispatches it to
+	// the same cluster instead of the parent's.
+	updater := g.client.ApprovalTicket.UpdateOneID(child.ID).
 		SetStatus(approvalticket.StatusFAILED).
 		SetApprover(approver).
-		SetRejectReason(message).
-		Save(ctx); err != nil {
+		SetRejectReason(message)
+	if sel.ClusterID != "" {
+		updater = updater.SetSelectedClusterID(sel.ClusterID)
+	}
+	if sel.StorageClass != "" {
+		updater = updater.SetSelectedStorageClass(sel.StorageClass)
+	}
+	if _, err := updater.Save(ctx); err != nil {
 		logger.Warn("failed to mark child ticket dispatch failure",
 			zap.String("ticket_id", child.ID),
 			zap.Error(err),
@@ -614,6 +1498,99 @@ func (g *Gateway) markChildApprovalDispatchFailed(
 	}
 }
 
+// validateBatchQuota checks the CREATE children about to be dispatched
+// against quotas together, before any of them is approved. Children are
+// dispatched concurrently, so checking each one alone could let the batch
+// overshoot a quota.
+func (g *Gateway) validateBatchQuota(ctx context.Context, children []*ent.ApprovalTicket) error {
+	if g.validator == nil {
+		return nil
+	}
+	demands := make([]service.QuotaDemand, 0, len(children))
+	for _, child := range children {
+		if child.OperationType != approvalticket.OperationTypeCREATE {
+			continue
+		}
+		event, err := g.client.DomainEvent.Get(ctx, child.EventID)
+		if err != nil {
+			return fmt.Errorf("get domain event %s: %w", child.EventID, err)
+		}
+		payload, err := parseVMCreatePayload(event.Payload)
+		if err != nil {
+			return fmt.Errorf("parse create payload for ticket %s: %w", child.ID, err)
+		}
+		_, instanceSizeID := resolveEffectiveSelectionIDs(payload.TemplateID, payload.InstanceSizeID, child.ModifiedSpec)
+		size, err := g.client.InstanceSize.Get(ctx, instanceSizeID)
+		if err != nil {
+			return fmt.Errorf("get instance size %s for ticket %s: %w", instanceSizeID, child.ID, err)
+		}
+		demands = append(demands, quotaDemand(payload, size))
+	}
+	return g.validator.ValidateQuota(ctx, demands...)
+}
+
+func quotaDemand(payload *vmCreatePayload, size *ent.InstanceSize) service.QuotaDemand {
+	return service.QuotaDemand{
+		Namespace: payload.Namespace,
+		ServiceID: payload.ServiceID,
+		CPUCores:  size.CPUCores,
+		MemoryMB:  size.MemoryMB,
+	}
+}
+
+// resolveChildSelection applies the top-level fallback to a per-child selection.
+func resolveChildSelection(sel ChildSelection, clusterID, storageClass string) ChildSelection {
+	out := ChildSelection{
+		ClusterID:    strings.TrimSpace(sel.ClusterID),
+		StorageClass: strings.TrimSpace(sel.StorageClass),
+	}
+	if out.ClusterID == "" {
+		out.ClusterID = strings.TrimSpace(clusterID)
+	}
+	if out.StorageClass == "" {
+		out.StorageClass = strings.TrimSpace(storageClass)
+	}
+	return out
+}
+
+// validateChildSelectionTargets rejects per-child selections that do not
+// address children of ticket, before any approval vote is recorded.
+func (g *Gateway) validateChildSelectionTargets(
+	ctx context.Context,
+	ticket *ent.ApprovalTicket,
+	childSelections map[string]ChildSelection,
+) error {
+	childIDs, err := g.client.ApprovalTicket.Query().
+		Where(approvalticket.ParentTicketIDEQ(ticket.ID)).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("list child tickets for %s: %w", ticket.ID, err)
+	}
+	if len(childIDs) == 0 {
+		return apperrors.BadRequest(apperrors.CodeValidationFailed,
+			"per-child selections are only supported for batch parent tickets")
+	}
+	known := make(map[string]struct{}, len(childIDs))
+	for _, id := range childIDs {
+		known[id] = struct{}{}
+	}
+	var unknown []string
+	for id := range childSelections {
+		if _, ok := known[id]; !ok {
+			unknown = append(unknown, id)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return apperrors.BadRequest(apperrors.CodeValidationFailed,
+			fmt.Sprintf("%d selections do not reference children of ticket %s", len(unknown), ticket.ID),
+		).WithParams(map[string]interface{}{
+			"unknown_children": unknown,
+		})
+	}
+	return nil
+}
+
 func (g *Gateway) isBatchParentTicket(
 	ctx context.Context,
 	ticket *ent.ApprovalTicket,
@@ -652,26 +1629,38 @@ func (g *Gateway) syncBatchProjectionByParentID(ctx context.Context, parentTicke
 	}
 
 	var (
-		successCount   int
-		failedCount    int
-		cancelledCount int
-		activeCount    int
+		successCount    int
+		failedCount     int
+		cancelledCount  int
+		activeCount     int
+		pendingCount    int
+		dispatchedCount int
 	)
 	for _, child := range children {
 		switch child.Status {
 		case approvalticket.StatusSUCCESS:
 			successCount++
-		case approvalticket.StatusFAILED, approvalticket.StatusREJECTED:
+			dispatchedCount++
+		case approvalticket.StatusFAILED:
+			failedCount++
+			dispatchedCount++
+		case approvalticket.StatusREJECTED:
 			failedCount++
 		case approvalticket.StatusCANCELLED:
 			cancelledCount++
+		case approvalticket.StatusPENDING:
+			activeCount++
+			pendingCount++
 		default:
 			activeCount++
+			dispatchedCount++
 		}
 	}
 
 	status := batchapprovalticket.StatusIN_PROGRESS
 	switch {
+	case pendingCount > 0 && dispatchedCount > 0:
+		status = batchapprovalticket.StatusPARTIAL_APPROVED
 	case activeCount > 0:
 		status = batchapprovalticket.StatusIN_PROGRESS
 	case successCount == len(children):
@@ -698,12 +1687,91 @@ func (g *Gateway) syncBatchProjectionByParentID(ctx context.Context, parentTicke
 	}
 }
 
+// PendingTicket is a pending ticket with its multi-level approval progress.
+type PendingTicket struct {
+	*ent.ApprovalTicket
+	ApprovalsReceived int `json:"approvals_received"`
+	ApprovalsRequired int `json:"approvals_required"`
+}
+
 // ListPending returns pending tickets sorted by creation time (oldest first).
-func (g *Gateway) ListPending(ctx context.Context) ([]*ent.ApprovalTicket, error) {
-	return g.client.ApprovalTicket.Query().
+func (g *Gateway) ListPending(ctx context.Context) ([]PendingTicket, error) {
+	tickets, err := g.client.ApprovalTicket.Query().
 		Where(approvalticket.StatusEQ(approvalticket.StatusPENDING)).
 		Order(ent.Asc(approvalticket.FieldCreatedAt)).
 		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(tickets))
+	for _, t := range tickets {
+		ids = append(ids, t.ID)
+	}
+	received, err := CountApprovalDecisions(ctx, g.client, ids...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]PendingTicket, 0, len(tickets))
+	for _, t := range tickets {
+		out = append(out, PendingTicket{
+			ApprovalTicket:    t,
+			ApprovalsReceived: received[t.ID],
+			ApprovalsRequired: t.RequiredApprovals,
+		})
+	}
+	return out, nil
+}
+
+// CountPending returns the number of top-level tickets awaiting a decision.
+// Batch children are decided through their parent and are not counted.
+func (g *Gateway) CountPending(ctx context.Context) (int, error) {
+	return g.client.ApprovalTicket.Query().
+		Where(
+			approvalticket.StatusEQ(approvalticket.StatusPENDING),
+			approvalticket.ParentTicketIDIsNil(),
+		).
+		Count(ctx)
+}
+
+// CountApprovalDecisions returns the number of APPROVED decisions per ticket ID.
+// Tickets without recorded decisions are absent from the result.
+func CountApprovalDecisions(ctx context.Context, client *ent.Client, ticketIDs ...string) (map[string]int, error) {
+	out := make(map[string]int, len(ticketIDs))
+	if len(ticketIDs) == 0 {
+		return out, nil
+	}
+	var rows []struct {
+		TicketID string `json:"ticket_id"`
+		Count    int    `json:"count"`
+	}
+	if err := client.ApprovalDecision.Query().
+		Where(
+			approvaldecision.TicketIDIn(ticketIDs...),
+			approvaldecision.DecisionEQ(approvaldecision.DecisionAPPROVED),
+		).
+		GroupBy(approvaldecision.FieldTicketID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("count approval decisions: %w", err)
+	}
+	for _, row := range rows {
+		out[row.TicketID] = row.Count
+	}
+	return out, nil
+}
+
+func countApprovals(ctx context.Context, client *ent.Client, ticketID string) (int, error) {
+	n, err := client.ApprovalDecision.Query().
+		Where(
+			approvaldecision.TicketIDEQ(ticketID),
+			approvaldecision.DecisionEQ(approvaldecision.DecisionAPPROVED),
+		).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("count approval decisions for ticket %s: %w", ticketID, err)
+	}
+	return n, nil
 }
 
 type vmCreatePayload struct {
@@ -712,6 +1780,8 @@ type vmCreatePayload struct {
 	Namespace      string `json:"namespace"`
 	RequesterID    string `json:"requester_id"`
 	InstanceSizeID string `json:"instance_size_id"`
+	// Clones copy a PVC through CDI, which only works within one cluster.
+	SourceClusterID string `json:"source_cluster_id,omitempty"`
 }
 
 func parseVMCreatePayload(raw json.RawMessage) (*vmCreatePayload, error) {
@@ -772,11 +1842,13 @@ func buildInstanceSizeSnapshot(size *ent.InstanceSize) map[string]interface{} {
 	}
 }
 
-func buildTemplateSnapshot(tpl *ent.Template) map[string]interface{} {
+// buildTemplateSnapshot captures the template for the ticket. The snapshot
+// spec is the child's spec deep-merged over its ancestors' (nearest-first).
+func buildTemplateSnapshot(tpl *ent.Template, ancestors ...*ent.Template) map[string]interface{} {
 	if tpl == nil {
 		return map[string]interface{}{}
 	}
-	return map[string]interface{}{
+	snapshot := map[string]interface{}{
 		"id":           tpl.ID,
 		"name":         tpl.Name,
 		"display_name": tpl.DisplayName,
@@ -786,8 +1858,12 @@ func buildTemplateSnapshot(tpl *ent.Template) map[string]interface{} {
 		"os_version":   tpl.OsVersion,
 		"enabled":      tpl.Enabled,
 		"created_by":   tpl.CreatedBy,
-		"spec":         cloneMap(tpl.Spec),
+		"spec":         ResolveTemplateSpec(tpl, ancestors),
 	}
+	if tpl.ParentTemplateID != nil {
+		snapshot["parent_template_id"] = *tpl.ParentTemplateID
+	}
+	return snapshot
 }
 
 func cloneMap(src map[string]interface{}) map[string]interface{} {
@@ -818,15 +1894,42 @@ func lookupStringValue(values map[string]interface{}, keys ...string) string {
 	return ""
 }
 
+// Pending-duration thresholds for PriorityTier (ADR-0015 §11).
+const (
+	PriorityUrgentAfter  = 7 * 24 * time.Hour
+	PriorityWarningAfter = 4 * 24 * time.Hour
+)
+
 // PriorityTier calculates urgency tier based on pending duration (ADR-0015 §11).
 func PriorityTier(createdAt time.Time) string {
-	days := int(time.Since(createdAt).Hours() / 24)
+	pending := time.Since(createdAt)
 	switch {
-	case days >= 7:
+	case pending >= PriorityUrgentAfter:
 		return "urgent" // Red
-	case days >= 4:
+	case pending >= PriorityWarningAfter:
 		return "warning" // Yellow
 	default:
 		return "normal" // Default
 	}
 }
+
+// OrderByPriority orders PENDING tickets by PriorityTier (urgent, warning,
+// normal) ahead of all other tickets. The tier is evaluated in SQL against
+// the same thresholds, so pagination stays stable across pages.
+func OrderByPriority(now time.Time) approvalticket.OrderOption {
+	urgentBefore := now.Add(-PriorityUrgentAfter)
+	warningBefore := now.Add(-PriorityWarningAfter)
+	return func(s *sql.Selector) {
+		status := s.C(approvalticket.FieldStatus)
+		createdAt := s.C(approvalticket.FieldCreatedAt)
+		pending := approvalticket.StatusPENDING.String()
+		s.OrderExprFunc(func(b *sql.Builder) {
+			b.WriteString("CASE WHEN ").WriteString(status).WriteString(" = ").Arg(pending).
+				WriteString(" AND ").WriteString(createdAt).WriteString(" <= ").Arg(urgentBefore).
+				WriteString(" THEN 0 WHEN ").WriteString(status).WriteString(" = ").Arg(pending).
+				WriteString(" AND ").WriteString(createdAt).WriteString(" <= ").Arg(warningBefore).
+				WriteString(" THEN 1 WHEN ").WriteString(status).WriteString(" = ").Arg(pending).
+				WriteString(" THEN 2 ELSE 3 END")
+		})
+	}
+}
@@ -3,17 +3,35 @@ package approval
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"kv-shepherd.io/shepherd/ent"
 	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/auditlog"
+	"kv-shepherd.io/shepherd/ent/batchapprovalticket"
+	"kv-shepherd.io/shepherd/ent/cluster"
 	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/ent/namespaceregistry"
+	entnotification "kv-shepherd.io/shepherd/ent/notification"
+	"kv-shepherd.io/shepherd/ent/quota"
 	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/notification"
+	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
 	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/pkg/worker"
+	"kv-shepherd.io/shepherd/internal/service"
 	"kv-shepherd.io/shepherd/internal/testutil"
 )
 
 type fakeAtomicWriter struct {
+	// mu guards the fields below; batch children are dispatched concurrently.
+	mu     sync.Mutex
 	called bool
 
 	ticketID    string
@@ -24,6 +42,17 @@ type fakeAtomicWriter struct {
 	serviceID   string
 	namespace   string
 	requesterID string
+
+	migrateVMID string
+	resized     bool
+	resizeSpec  map[string]interface{}
+	snapshotted bool
+	nsMigrated  bool
+	// powerOps records the power operation enqueued per batch child ticket.
+	powerOps map[string]string
+
+	// createSelections records clusterID/storageClass per CREATE ticket.
+	createSelections map[string]ChildSelection
 }
 
 func init() {
@@ -38,6 +67,8 @@ func (f *fakeAtomicWriter) ApproveCreateAndEnqueue(
 	_ map[string]interface{},
 	_ map[string]interface{},
 ) (string, string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
 	f.called = true
 	f.ticketID = ticketID
 	f.eventID = eventID
@@ -47,6 +78,10 @@ func (f *fakeAtomicWriter) ApproveCreateAndEnqueue(
 	f.serviceID = serviceID
 	f.namespace = namespace
 	f.requesterID = requesterID
+	if f.createSelections == nil {
+		f.createSelections = map[string]ChildSelection{}
+	}
+	f.createSelections[ticketID] = ChildSelection{ClusterID: clusterID, StorageClass: storageClass}
 	return "vm-1", "vm-name", nil
 }
 
@@ -54,6 +89,60 @@ func (f *fakeAtomicWriter) ApproveDeleteAndEnqueue(_ context.Context, _, _, _, _
 	return nil
 }
 
+func (f *fakeAtomicWriter) ApproveMigrateAndEnqueue(_ context.Context, ticketID, eventID, approver, vmID string) error {
+	f.called = true
+	f.ticketID = ticketID
+	f.eventID = eventID
+	f.approver = approver
+	f.migrateVMID = vmID
+	return nil
+}
+
+func (f *fakeAtomicWriter) ApproveResizeAndEnqueue(
+	_ context.Context,
+	ticketID, eventID, approver string,
+	modifiedSpec map[string]interface{},
+) error {
+	f.called = true
+	f.resized = true
+	f.resizeSpec = modifiedSpec
+	f.ticketID = ticketID
+	f.eventID = eventID
+	f.approver = approver
+	return nil
+}
+
+func (f *fakeAtomicWriter) ApprovePowerAndEnqueue(_ context.Context, ticketID, eventID, approver, operation string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.called = true
+	f.eventID = eventID
+	f.approver = approver
+	if f.powerOps == nil {
+		f.powerOps = map[string]string{}
+	}
+	f.powerOps[ticketID] = operation
+	return nil
+}
+
+func (f *fakeAtomicWriter) ApproveSnapshotAndEnqueue(_ context.Context, ticketID, eventID, approver string) error {
+	f.called = true
+	f.snapshotted = true
+	f.ticketID = ticketID
+	f.eventID = eventID
+	f.approver = approver
+	return nil
+}
+
+func (f *fakeAtomicWriter) ApproveNamespaceMigrateAndEnqueue(_ context.Context, ticketID, eventID, approver string) error {
+	f.called = true
+	f.nsMigrated = true
+	f.ticketID = ticketID
+	f.eventID = eventID
+	f.approver = approver
+	return nil
+}
+
 func TestGatewayApproveCreate_CallsAtomicWriterWithResolvedIDs(t *testing.T) {
 	t.Parallel()
 
@@ -126,7 +215,7 @@ func TestGatewayApproveCreate_CallsAtomicWriterWithResolvedIDs(t *testing.T) {
 	// Isolate this test to gateway orchestration; validator behavior is covered separately.
 	gw.validator = nil
 
-	if err := gw.Approve(context.Background(), ticketID, "admin-1", "cluster-1", "sc-fast"); err != nil {
+	if err := gw.Approve(context.Background(), ticketID, "admin-1", "cluster-1", "sc-fast", ""); err != nil {
 		t.Fatalf("Approve() error = %v", err)
 	}
 	if !writer.called {
@@ -180,7 +269,7 @@ func TestGatewayApproveCreate_RequiresClusterSelection(t *testing.T) {
 		Save(context.Background())
 
 	gw := NewGateway(client, nil, &fakeAtomicWriter{})
-	if err := gw.Approve(context.Background(), ticketID, "admin-1", "", ""); err == nil {
+	if err := gw.Approve(context.Background(), ticketID, "admin-1", "", "", ""); err == nil {
 		t.Fatal("Approve() expected error when cluster id is empty, got nil")
 	}
 }
@@ -217,7 +306,7 @@ func TestGatewayApproveVNC_TransitionsTicketAndEventWithoutAtomicWriter(t *testi
 
 	writer := &fakeAtomicWriter{}
 	gw := NewGateway(client, nil, writer)
-	if err := gw.Approve(context.Background(), ticketID, "admin-1", "", ""); err != nil {
+	if err := gw.Approve(context.Background(), ticketID, "admin-1", "", "", ""); err != nil {
 		t.Fatalf("Approve() error = %v", err)
 	}
 	if writer.called {
@@ -234,6 +323,10 @@ func TestGatewayApproveVNC_TransitionsTicketAndEventWithoutAtomicWriter(t *testi
 	if ticket.Approver != "admin-1" {
 		t.Fatalf("ticket approver = %s, want admin-1", ticket.Approver)
 	}
+	// The payload carries no duration_seconds, so the default window applies.
+	if ticket.ExpiresAt == nil || time.Until(*ticket.ExpiresAt) < service.DefaultVNCMaxAccessDuration-time.Minute {
+		t.Fatalf("ticket expires_at = %v, want about %s from now", ticket.ExpiresAt, service.DefaultVNCMaxAccessDuration)
+	}
 
 	event, err := client.DomainEvent.Get(context.Background(), eventID)
 	if err != nil {
@@ -244,6 +337,330 @@ func TestGatewayApproveVNC_TransitionsTicketAndEventWithoutAtomicWriter(t *testi
 	}
 }
 
+func TestGatewayApproveMigrate_CallsAtomicWriterWithVMID(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "gateway_behavior_migrate_approve")
+
+	eventID := "event-migrate-approve-1"
+	ticketID := "ticket-migrate-approve-1"
+	payloadRaw, err := domain.VMMigratePayload{
+		VMID:            "vm-1",
+		VMName:          "team-a-sys-svc-01",
+		SourceClusterID: "cluster-a",
+		TargetClusterID: "cluster-b",
+		Namespace:       "team-a",
+		Actor:           "user-1",
+	}.ToJSON()
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+	_, _ = client.DomainEvent.Create().
+		SetID(eventID).
+		SetEventType(string(domain.EventVMMigrationRequested)).
+		SetAggregateType("vm").
+		SetAggregateID("vm-1").
+		SetPayload(payloadRaw).
+		SetCreatedBy("user-1").
+		Save(context.Background())
+	_, _ = client.ApprovalTicket.Create().
+		SetID(ticketID).
+		SetEventID(eventID).
+		SetRequester("user-1").
+		SetStatus(approvalticket.StatusPENDING).
+		SetOperationType(approvalticket.OperationTypeMIGRATE).
+		SetReason("move to cluster-b").
+		Save(context.Background())
+
+	writer := &fakeAtomicWriter{}
+	gw := NewGateway(client, nil, writer)
+	if err := gw.Approve(context.Background(), ticketID, "admin-1", "", "", ""); err != nil {
+		t.Fatalf("Approve() error = %v", err)
+	}
+	if !writer.called {
+		t.Fatal("atomic writer not called for MIGRATE ticket")
+	}
+	if writer.ticketID != ticketID || writer.eventID != eventID || writer.approver != "admin-1" {
+		t.Fatalf("writer args = ticket=%s event=%s approver=%s", writer.ticketID, writer.eventID, writer.approver)
+	}
+	if writer.migrateVMID != "vm-1" {
+		t.Fatalf("writer vm id = %q, want vm-1", writer.migrateVMID)
+	}
+}
+
+func TestGatewayApproveResize_CallsAtomicWriter(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "gateway_behavior_resize_approve")
+
+	eventID := "event-resize-approve-1"
+	ticketID := "ticket-resize-approve-1"
+	payloadRaw, err := domain.VMResizePayload{
+		VMID:      "vm-1",
+		VMName:    "team-a-sys-svc-01",
+		ClusterID: "cluster-a",
+		Namespace: "team-a",
+		From:      domain.VMSize{CPU: 2, MemoryMB: 4096},
+		To:        domain.VMSize{InstanceSizeID: "size-large", CPU: 4, MemoryMB: 8192},
+		Actor:     "user-1",
+	}.ToJSON()
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+	_, _ = client.DomainEvent.Create().
+		SetID(eventID).
+		SetEventType(string(domain.EventVMResizeRequested)).
+		SetAggregateType("vm").
+		SetAggregateID("vm-1").
+		SetPayload(payloadRaw).
+		SetCreatedBy("user-1").
+		Save(context.Background())
+	_, _ = client.ApprovalTicket.Create().
+		SetID(ticketID).
+		SetEventID(eventID).
+		SetRequester("user-1").
+		SetStatus(approvalticket.StatusPENDING).
+		SetOperationType(approvalticket.OperationTypeRESIZE).
+		SetReason("needs more memory").
+		Save(context.Background())
+
+	writer := &fakeAtomicWriter{}
+	gw := NewGateway(client, nil, writer)
+	if err := gw.Approve(context.Background(), ticketID, "admin-1", "", "", ""); err != nil {
+		t.Fatalf("Approve() error = %v", err)
+	}
+	if !writer.resized {
+		t.Fatal("resize atomic writer not called for RESIZE ticket")
+	}
+	if writer.ticketID != ticketID || writer.eventID != eventID || writer.approver != "admin-1" {
+		t.Fatalf("writer args = ticket=%s event=%s approver=%s", writer.ticketID, writer.eventID, writer.approver)
+	}
+	if writer.resizeSpec != nil {
+		t.Fatalf("writer resize spec = %v, want nil without approver selection", writer.resizeSpec)
+	}
+}
+
+func TestGatewayApproveResize_ApproverSelection(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "gateway_behavior_resize_selection")
+	ctx := context.Background()
+	client.InstanceSize.Create().
+		SetID("size-xl").
+		SetName("xlarge").
+		SetCPUCores(8).
+		SetMemoryMB(16384).
+		SetCreatedBy("admin-1").
+		SaveX(ctx)
+	client.InstanceSize.Create().
+		SetID("size-retired").
+		SetName("retired").
+		SetCPUCores(16).
+		SetMemoryMB(32768).
+		SetEnabled(false).
+		SetCreatedBy("admin-1").
+		SaveX(ctx)
+
+	seedTicket := func(id string, op approvalticket.OperationType) string {
+		t.Helper()
+		payloadRaw, err := domain.VMResizePayload{
+			VMID:      "vm-1",
+			VMName:    "team-a-sys-svc-01",
+			ClusterID: "cluster-a",
+			Namespace: "team-a",
+			From:      domain.VMSize{CPU: 2, MemoryMB: 4096, DiskGB: 50},
+			To:        domain.VMSize{CPU: 4, MemoryMB: 8192, DiskGB: 50},
+			Actor:     "user-1",
+		}.ToJSON()
+		if err != nil {
+			t.Fatalf("marshal payload: %v", err)
+		}
+		client.DomainEvent.Create().
+			SetID("event-" + id).
+			SetEventType(string(domain.EventVMResizeRequested)).
+			SetAggregateType("vm").
+			SetAggregateID("vm-1").
+			SetPayload(payloadRaw).
+			SetCreatedBy("user-1").
+			SaveX(ctx)
+		client.ApprovalTicket.Create().
+			SetID(id).
+			SetEventID("event-" + id).
+			SetRequester("user-1").
+			SetStatus(approvalticket.StatusPENDING).
+			SetOperationType(op).
+			SaveX(ctx)
+		return id
+	}
+
+	tests := []struct {
+		name     string
+		op       approvalticket.OperationType
+		sel      ResizeSelection
+		want     domain.VMSize
+		wantCode string
+	}{
+		{name: "custom cpu keeps requested memory", op: approvalticket.OperationTypeRESIZE, sel: ResizeSelection{CPU: 6},
+			want: domain.VMSize{CPU: 6, MemoryMB: 8192, DiskGB: 50}},
+		{name: "catalog size", op: approvalticket.OperationTypeRESIZE, sel: ResizeSelection{InstanceSizeID: "size-xl"},
+			want: domain.VMSize{InstanceSizeID: "size-xl", InstanceSizeName: "xlarge", CPU: 8, MemoryMB: 16384, DiskGB: 50}},
+		{name: "disk grows", op: approvalticket.OperationTypeRESIZE, sel: ResizeSelection{DiskGB: 80},
+			want: domain.VMSize{CPU: 4, MemoryMB: 8192, DiskGB: 80}},
+		{name: "disk cannot shrink", op: approvalticket.OperationTypeRESIZE, sel: ResizeSelection{DiskGB: 20}, wantCode: apperrors.CodeValidationFailed},
+		{name: "catalog and custom mixed", op: approvalticket.OperationTypeRESIZE, sel: ResizeSelection{InstanceSizeID: "size-xl", CPU: 2}, wantCode: apperrors.CodeInvalidRequestField},
+		{name: "disabled catalog size", op: approvalticket.OperationTypeRESIZE, sel: ResizeSelection{InstanceSizeID: "size-retired"}, wantCode: apperrors.CodeValidationFailed},
+		{name: "unknown catalog size", op: approvalticket.OperationTypeRESIZE, sel: ResizeSelection{InstanceSizeID: "size-missing"}, wantCode: "INSTANCE_SIZE_NOT_FOUND"},
+		{name: "selection on delete ticket", op: approvalticket.OperationTypeDELETE, sel: ResizeSelection{CPU: 6}, wantCode: apperrors.CodeInvalidRequestField},
+	}
+	for i, tc := range tests {
+		ticketID := seedTicket(fmt.Sprintf("ticket-resize-selection-%d", i), tc.op)
+		writer := &fakeAtomicWriter{}
+		gw := NewGateway(client, nil, writer)
+
+		err := gw.ApproveResize(ctx, ticketID, "admin-1", "", tc.sel)
+		if tc.wantCode != "" {
+			appErr, ok := apperrors.IsAppError(err)
+			if !ok || appErr.Code != tc.wantCode {
+				t.Fatalf("%s: ApproveResize() error = %v, want code %s", tc.name, err, tc.wantCode)
+			}
+			if writer.called {
+				t.Fatalf("%s: atomic writer called for rejected selection", tc.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: ApproveResize() error = %v", tc.name, err)
+		}
+		if got := (domain.VMResizePayload{}).Target(writer.resizeSpec); got != tc.want {
+			t.Fatalf("%s: stored selection = %+v, want %+v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestGatewayApproveSnapshot_CallsAtomicWriter(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "gateway_behavior_snapshot_approve")
+
+	eventID := "event-snapshot-approve-1"
+	ticketID := "ticket-snapshot-approve-1"
+	payloadRaw, err := domain.VMSnapshotPayload{
+		VMID:         "vm-1",
+		VMName:       "team-a-sys-svc-01",
+		ClusterID:    "cluster-a",
+		Namespace:    "team-a",
+		SnapshotName: "before-upgrade",
+		Actor:        "user-1",
+	}.ToJSON()
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+	_, _ = client.DomainEvent.Create().
+		SetID(eventID).
+		SetEventType(string(domain.EventVMSnapshotCreateRequested)).
+		SetAggregateType("vm").
+		SetAggregateID("vm-1").
+		SetPayload(payloadRaw).
+		SetCreatedBy("user-1").
+		Save(context.Background())
+	_, _ = client.ApprovalTicket.Create().
+		SetID(ticketID).
+		SetEventID(eventID).
+		SetRequester("user-1").
+		SetStatus(approvalticket.StatusPENDING).
+		SetOperationType(approvalticket.OperationTypeSNAPSHOT).
+		Save(context.Background())
+
+	writer := &fakeAtomicWriter{}
+	gw := NewGateway(client, nil, writer)
+	if err := gw.Approve(context.Background(), ticketID, "admin-1", "", "", ""); err != nil {
+		t.Fatalf("Approve() error = %v", err)
+	}
+	if !writer.snapshotted {
+		t.Fatal("snapshot atomic writer not called for SNAPSHOT ticket")
+	}
+	if writer.ticketID != ticketID || writer.eventID != eventID || writer.approver != "admin-1" {
+		t.Fatalf("writer args = ticket=%s event=%s approver=%s", writer.ticketID, writer.eventID, writer.approver)
+	}
+}
+
+func TestGatewayApproveNamespaceMigrate_CallsAtomicWriter(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "gateway_behavior_ns_migrate_approve")
+
+	eventID := "event-ns-migrate-approve-1"
+	ticketID := "ticket-ns-migrate-approve-1"
+	payloadRaw, err := domain.NamespaceMigrationPayload{
+		NamespaceID:     "ns-1",
+		Namespace:       "team-a",
+		FromEnvironment: "test",
+		ToEnvironment:   "prod",
+		Actor:           "admin-0",
+	}.ToJSON()
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+	_, _ = client.DomainEvent.Create().
+		SetID(eventID).
+		SetEventType(string(domain.EventNamespaceMigrationRequested)).
+		SetAggregateType("namespace").
+		SetAggregateID("ns-1").
+		SetPayload(payloadRaw).
+		SetCreatedBy("admin-0").
+		Save(context.Background())
+	_, _ = client.ApprovalTicket.Create().
+		SetID(ticketID).
+		SetEventID(eventID).
+		SetRequester("admin-0").
+		SetStatus(approvalticket.StatusPENDING).
+		SetOperationType(approvalticket.OperationTypeNAMESPACE_MIGRATE).
+		Save(context.Background())
+
+	writer := &fakeAtomicWriter{}
+	gw := NewGateway(client, nil, writer)
+	if err := gw.Approve(context.Background(), ticketID, "admin-1", "", "", ""); err != nil {
+		t.Fatalf("Approve() error = %v", err)
+	}
+	if !writer.nsMigrated {
+		t.Fatal("namespace migration atomic writer not called for NAMESPACE_MIGRATE ticket")
+	}
+	if writer.ticketID != ticketID || writer.eventID != eventID || writer.approver != "admin-1" {
+		t.Fatalf("writer args = ticket=%s event=%s approver=%s", writer.ticketID, writer.eventID, writer.approver)
+	}
+}
+
+func TestGatewayApproveResize_RejectsMismatchedEventType(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "gateway_behavior_resize_mismatch")
+
+	_, _ = client.DomainEvent.Create().
+		SetID("event-resize-mismatch").
+		SetEventType(string(domain.EventVMMigrationRequested)).
+		SetAggregateType("vm").
+		SetAggregateID("vm-1").
+		SetPayload([]byte(`{}`)).
+		SetCreatedBy("user-1").
+		Save(context.Background())
+	_, _ = client.ApprovalTicket.Create().
+		SetID("ticket-resize-mismatch").
+		SetEventID("event-resize-mismatch").
+		SetRequester("user-1").
+		SetStatus(approvalticket.StatusPENDING).
+		SetOperationType(approvalticket.OperationTypeRESIZE).
+		Save(context.Background())
+
+	writer := &fakeAtomicWriter{}
+	gw := NewGateway(client, nil, writer)
+	if err := gw.Approve(context.Background(), "ticket-resize-mismatch", "admin-1", "", "", ""); err == nil {
+		t.Fatal("expected error for RESIZE ticket with non-resize event")
+	}
+	if writer.called {
+		t.Fatal("atomic writer must not be called on event type mismatch")
+	}
+}
+
 func TestGatewayReject_TransitionsTicketAndEvent(t *testing.T) {
 	t.Parallel()
 
@@ -388,3 +805,764 @@ func TestGatewayCancel_RequesterTransitionsTicketAndEvent(t *testing.T) {
 		t.Fatalf("event status = %s, want %s", event.Status, domainevent.StatusCANCELLED)
 	}
 }
+
+func TestGatewayApprove_MultiLevelWaitsForQuorum(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "gateway_behavior_quorum")
+
+	eventID := "event-quorum-1"
+	ticketID := "ticket-quorum-1"
+	payload := domain.VMCreationPayload{
+		RequesterID:    "user-1",
+		ServiceID:      "svc-1",
+		TemplateID:     "tpl-1",
+		InstanceSizeID: "size-1",
+		Namespace:      "team-a",
+	}
+	payloadRaw, err := payload.ToJSON()
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+	_, _ = client.DomainEvent.Create().
+		SetID(eventID).
+		SetEventType(string(domain.EventVMCreationRequested)).
+		SetAggregateType("vm").
+		SetAggregateID("svc-1").
+		SetPayload(payloadRaw).
+		SetCreatedBy("user-1").
+		Save(context.Background())
+	_, _ = client.Template.Create().
+		SetID("tpl-1").
+		SetName("tpl").
+		SetVersion(1).
+		SetCreatedBy("seed").
+		Save(context.Background())
+	_, _ = client.InstanceSize.Create().
+		SetID("size-1").
+		SetName("size").
+		SetCPUCores(2).
+		SetMemoryMB(2048).
+		SetCreatedBy("seed").
+		Save(context.Background())
+	_, err = client.ApprovalTicket.Create().
+		SetID(ticketID).
+		SetEventID(eventID).
+		SetRequester("user-1").
+		SetStatus(approvalticket.StatusPENDING).
+		SetOperationType(approvalticket.OperationTypeCREATE).
+		SetRequiredApprovals(2).
+		Save(context.Background())
+	if err != nil {
+		t.Fatalf("create ticket: %v", err)
+	}
+
+	writer := &fakeAtomicWriter{}
+	gw := NewGateway(client, nil, writer)
+	gw.validator = nil
+
+	if err := gw.Approve(context.Background(), ticketID, "admin-1", "cluster-1", "", ""); err != nil {
+		t.Fatalf("first Approve() error = %v", err)
+	}
+	if writer.called {
+		t.Fatal("atomic writer called before quorum reached")
+	}
+	pending, err := gw.ListPending(context.Background())
+	if err != nil {
+		t.Fatalf("ListPending() error = %v", err)
+	}
+	if len(pending) != 1 || pending[0].ApprovalsReceived != 1 || pending[0].ApprovalsRequired != 2 {
+		t.Fatalf("unexpected pending progress: %+v", pending)
+	}
+
+	if err := gw.Approve(context.Background(), ticketID, "admin-1", "cluster-1", "", ""); err == nil {
+		t.Fatal("duplicate Approve() by same approver expected error, got nil")
+	}
+
+	if err := gw.Approve(context.Background(), ticketID, "admin-2", "cluster-2", "", ""); err != nil {
+		t.Fatalf("second Approve() error = %v", err)
+	}
+	if !writer.called {
+		t.Fatal("atomic writer not called after quorum reached")
+	}
+	if writer.approver != "admin-2" || writer.clusterID != "cluster-2" {
+		t.Fatalf("dispatch used approver=%s cluster=%s, want quorum-completing approver", writer.approver, writer.clusterID)
+	}
+}
+
+func TestGatewayReject_MultiLevelRejectsImmediately(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "gateway_behavior_quorum_reject")
+
+	eventID := "event-quorum-reject-1"
+	ticketID := "ticket-quorum-reject-1"
+	payloadRaw, _ := json.Marshal(map[string]interface{}{
+		"requester_id":     "user-1",
+		"service_id":       "svc-1",
+		"template_id":      "tpl-1",
+		"instance_size_id": "size-1",
+		"namespace":        "team-a",
+	})
+	_, _ = client.DomainEvent.Create().
+		SetID(eventID).
+		SetEventType(string(domain.EventVMCreationRequested)).
+		SetAggregateType("vm").
+		SetAggregateID("svc-1").
+		SetPayload(payloadRaw).
+		SetCreatedBy("user-1").
+		Save(context.Background())
+	_, _ = client.ApprovalTicket.Create().
+		SetID(ticketID).
+		SetEventID(eventID).
+		SetRequester("user-1").
+		SetStatus(approvalticket.StatusPENDING).
+		SetOperationType(approvalticket.OperationTypeCREATE).
+		SetRequiredApprovals(3).
+		Save(context.Background())
+
+	gw := NewGateway(client, nil, &fakeAtomicWriter{})
+	gw.validator = nil
+	if err := gw.Approve(context.Background(), ticketID, "admin-1", "cluster-1", "", ""); err != nil {
+		t.Fatalf("Approve() error = %v", err)
+	}
+	if err := gw.Reject(context.Background(), ticketID, "admin-2", "not needed"); err != nil {
+		t.Fatalf("Reject() error = %v", err)
+	}
+
+	ticket, err := client.ApprovalTicket.Get(context.Background(), ticketID)
+	if err != nil {
+		t.Fatalf("query ticket: %v", err)
+	}
+	if ticket.Status != approvalticket.StatusREJECTED {
+		t.Fatalf("ticket status = %s, want %s", ticket.Status, approvalticket.StatusREJECTED)
+	}
+}
+
+func TestGatewayApprove_BatchParentPropagatesComment(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "gateway_behavior_comment")
+	ctx := context.Background()
+
+	if _, err := client.User.Create().SetID("user-1").SetUsername("user-1").Save(ctx); err != nil {
+		t.Fatalf("create requester: %v", err)
+	}
+	parentID := "batch-parent-1"
+	_, _ = client.DomainEvent.Create().
+		SetID("event-batch-parent-1").
+		SetEventType(string(domain.EventBatchDeleteRequested)).
+		SetAggregateType("batch").
+		SetAggregateID(parentID).
+		SetPayload([]byte(`{}`)).
+		SetCreatedBy("user-1").
+		Save(ctx)
+	if _, err := client.ApprovalTicket.Create().
+		SetID(parentID).
+		SetEventID("event-batch-parent-1").
+		SetRequester("user-1").
+		SetStatus(approvalticket.StatusPENDING).
+		SetOperationType(approvalticket.OperationTypeDELETE).
+		Save(ctx); err != nil {
+		t.Fatalf("create parent ticket: %v", err)
+	}
+	childIDs := []string{"batch-child-1", "batch-child-2"}
+	for i, childID := range childIDs {
+		payloadRaw, err := json.Marshal(map[string]string{
+			"vm_id":  "vm-" + childID,
+			"actor":  "user-1",
+			"reason": "cleanup",
+		})
+		if err != nil {
+			t.Fatalf("marshal child payload: %v", err)
+		}
+		eventID := "event-" + childID
+		_, _ = client.DomainEvent.Create().
+			SetID(eventID).
+			SetEventType(string(domain.EventVMDeletionRequested)).
+			SetAggregateType("vm").
+			SetAggregateID("vm-" + childID).
+			SetPayload(payloadRaw).
+			SetCreatedBy("user-1").
+			Save(ctx)
+		if _, err := client.ApprovalTicket.Create().
+			SetID(childID).
+			SetEventID(eventID).
+			SetRequester("user-1").
+			SetStatus(approvalticket.StatusPENDING).
+			SetOperationType(approvalticket.OperationTypeDELETE).
+			SetParentTicketID(parentID).
+			Save(ctx); err != nil {
+			t.Fatalf("create child ticket #%d: %v", i+1, err)
+		}
+	}
+
+	gw := NewGateway(client, audit.NewLogger(client), &fakeAtomicWriter{})
+	gw.SetNotifier(notification.NewTriggers(notification.NewInboxSender(client), client))
+	const comment = "approved, ping me before re-creating"
+	if err := gw.Approve(ctx, parentID, "admin-1", "", "", "  "+comment+" "); err != nil {
+		t.Fatalf("Approve() error = %v", err)
+	}
+
+	for _, id := range append([]string{parentID}, childIDs...) {
+		ticket, err := client.ApprovalTicket.Get(ctx, id)
+		if err != nil {
+			t.Fatalf("get ticket %s: %v", id, err)
+		}
+		if ticket.ApprovalComment != comment {
+			t.Fatalf("ticket %s approval_comment = %q, want %q", id, ticket.ApprovalComment, comment)
+		}
+	}
+
+	entry, err := client.AuditLog.Query().
+		Where(auditlog.ActionEQ("approval.batch_approved"), auditlog.ResourceIDEQ(parentID)).
+		Only(ctx)
+	if err != nil {
+		t.Fatalf("query batch approval audit: %v", err)
+	}
+	if entry.Details["comment"] != comment {
+		t.Fatalf("audit details = %+v, want comment %q", entry.Details, comment)
+	}
+
+	notes, err := client.Notification.Query().
+		Where(entnotification.ResourceIDEQ(parentID)).
+		All(ctx)
+	if err != nil {
+		t.Fatalf("query notifications: %v", err)
+	}
+	if len(notes) != 1 || !strings.Contains(notes[0].Message, comment) {
+		t.Fatalf("expected requester notification with comment, got %+v", notes)
+	}
+}
+
+func TestGatewayReassign_BatchParentMovesPendingChildren(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "gateway_behavior_reassign")
+	ctx := context.Background()
+
+	if _, err := client.Role.Create().
+		SetID("role-approver").
+		SetName("approver").
+		SetPermissions([]string{"approval:approve"}).
+		Save(ctx); err != nil {
+		t.Fatalf("create role: %v", err)
+	}
+	if _, err := client.User.Create().SetID("approver-2").SetUsername("approver-2").Save(ctx); err != nil {
+		t.Fatalf("create assignee: %v", err)
+	}
+	if _, err := client.User.Create().SetID("viewer-1").SetUsername("viewer-1").Save(ctx); err != nil {
+		t.Fatalf("create viewer: %v", err)
+	}
+	if _, err := client.RoleBinding.Create().
+		SetID("rb-approver-2").
+		SetUserID("approver-2").
+		SetRoleID("role-approver").
+		SetCreatedBy("admin-1").
+		Save(ctx); err != nil {
+		t.Fatalf("create role binding: %v", err)
+	}
+
+	parentID := "batch-parent-reassign"
+	_, _ = client.DomainEvent.Create().
+		SetID("event-" + parentID).
+		SetEventType(string(domain.EventBatchDeleteRequested)).
+		SetAggregateType("batch").
+		SetAggregateID(parentID).
+		SetPayload([]byte(`{}`)).
+		SetCreatedBy("user-1").
+		Save(ctx)
+	if _, err := client.ApprovalTicket.Create().
+		SetID(parentID).
+		SetEventID("event-" + parentID).
+		SetRequester("user-1").
+		SetStatus(approvalticket.StatusPENDING).
+		SetOperationType(approvalticket.OperationTypeDELETE).
+		Save(ctx); err != nil {
+		t.Fatalf("create parent ticket: %v", err)
+	}
+	children := map[string]approvalticket.Status{
+		"reassign-child-pending":  approvalticket.StatusPENDING,
+		"reassign-child-rejected": approvalticket.StatusREJECTED,
+	}
+	for childID, status := range children {
+		eventID := "event-" + childID
+		_, _ = client.DomainEvent.Create().
+			SetID(eventID).
+			SetEventType(string(domain.EventVMDeletionRequested)).
+			SetAggregateType("vm").
+			SetAggregateID("vm-" + childID).
+			SetPayload([]byte(`{"vm_id":"vm-` + childID + `"}`)).
+			SetCreatedBy("user-1").
+			Save(ctx)
+		if _, err := client.ApprovalTicket.Create().
+			SetID(childID).
+			SetEventID(eventID).
+			SetRequester("user-1").
+			SetStatus(status).
+			SetOperationType(approvalticket.OperationTypeDELETE).
+			SetParentTicketID(parentID).
+			Save(ctx); err != nil {
+			t.Fatalf("create child ticket %s: %v", childID, err)
+		}
+	}
+
+	gw := NewGateway(client, audit.NewLogger(client), &fakeAtomicWriter{})
+	gw.SetNotifier(notification.NewTriggers(notification.NewInboxSender(client), client))
+
+	if err := gw.Reassign(ctx, parentID, "admin-1", "viewer-1"); err == nil || !strings.Contains(err.Error(), "ASSIGNEE_NOT_APPROVER") {
+		t.Fatalf("Reassign() to non-approver error = %v, want ASSIGNEE_NOT_APPROVER", err)
+	}
+	if err := gw.Reassign(ctx, "reassign-child-pending", "admin-1", "approver-2"); err == nil || !strings.Contains(err.Error(), "TICKET_IS_BATCH_CHILD") {
+		t.Fatalf("Reassign() on child error = %v, want TICKET_IS_BATCH_CHILD", err)
+	}
+	if err := gw.Reassign(ctx, parentID, "admin-1", "approver-2"); err != nil {
+		t.Fatalf("Reassign() error = %v", err)
+	}
+
+	for id, want := range map[string]string{
+		parentID:                  "approver-2",
+		"reassign-child-pending":  "approver-2",
+		"reassign-child-rejected": "",
+	} {
+		ticket, err := client.ApprovalTicket.Get(ctx, id)
+		if err != nil {
+			t.Fatalf("get ticket %s: %v", id, err)
+		}
+		if ticket.AssignedApprover != want {
+			t.Fatalf("ticket %s assigned_approver = %q, want %q", id, ticket.AssignedApprover, want)
+		}
+	}
+
+	if _, err := client.AuditLog.Query().
+		Where(auditlog.ActionEQ("approval.reassigned"), auditlog.ResourceIDEQ(parentID)).
+		Only(ctx); err != nil {
+		t.Fatalf("query reassignment audit: %v", err)
+	}
+	notes, err := client.Notification.Query().
+		Where(entnotification.ResourceIDEQ(parentID)).
+		All(ctx)
+	if err != nil {
+		t.Fatalf("query notifications: %v", err)
+	}
+	if len(notes) != 1 {
+		t.Fatalf("expected one assignee notification, got %d", len(notes))
+	}
+
+	if _, err := client.ApprovalTicket.UpdateOneID(parentID).SetStatus(approvalticket.StatusREJECTED).Save(ctx); err != nil {
+		t.Fatalf("reject parent: %v", err)
+	}
+	if err := gw.Reassign(ctx, parentID, "admin-1", "approver-2"); err == nil || !strings.Contains(err.Error(), "TICKET_NOT_PENDING") {
+		t.Fatalf("Reassign() on rejected ticket error = %v, want TICKET_NOT_PENDING", err)
+	}
+}
+
+// seedCreateBatch creates a CREATE batch parent with one pending child per namespace.
+func seedCreateBatch(t *testing.T, client *ent.Client, parentID string, namespaces []string) []string {
+	t.Helper()
+	ctx := context.Background()
+
+	client.Template.Create().SetID("tpl-batch").SetName("tpl").SetVersion(1).SetCreatedBy("seed").SaveX(ctx)
+	client.InstanceSize.Create().SetID("size-batch").SetName("size").SetCPUCores(1).SetMemoryMB(1024).SetCreatedBy("seed").SaveX(ctx)
+	client.DomainEvent.Create().
+		SetID("event-" + parentID).
+		SetEventType(string(domain.EventBatchCreateRequested)).
+		SetAggregateType("batch").
+		SetAggregateID(parentID).
+		SetPayload([]byte(`{}`)).
+		SetCreatedBy("user-1").
+		SaveX(ctx)
+	client.ApprovalTicket.Create().
+		SetID(parentID).
+		SetEventID("event-" + parentID).
+		SetRequester("user-1").
+		SetOperationType(approvalticket.OperationTypeCREATE).
+		SaveX(ctx)
+
+	childIDs := make([]string, 0, len(namespaces))
+	for i, ns := range namespaces {
+		childID := fmt.Sprintf("%s-child-%d", parentID, i+1)
+		payloadRaw, err := domain.VMCreationPayload{
+			RequesterID:    "user-1",
+			ServiceID:      "svc-1",
+			TemplateID:     "tpl-batch",
+			InstanceSizeID: "size-batch",
+			Namespace:      ns,
+		}.ToJSON()
+		if err != nil {
+			t.Fatalf("marshal child payload: %v", err)
+		}
+		client.DomainEvent.Create().
+			SetID("event-" + childID).
+			SetEventType(string(domain.EventVMCreationRequested)).
+			SetAggregateType("vm").
+			SetAggregateID("svc-1").
+			SetPayload(payloadRaw).
+			SetCreatedBy("user-1").
+			SaveX(ctx)
+		client.ApprovalTicket.Create().
+			SetID(childID).
+			SetEventID("event-" + childID).
+			SetRequester("user-1").
+			SetOperationType(approvalticket.OperationTypeCREATE).
+			SetParentTicketID(parentID).
+			SaveX(ctx)
+		childIDs = append(childIDs, childID)
+	}
+	return childIDs
+}
+
+func TestGatewayApprove_BatchParentPerChildClusterSelection(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "gateway_behavior_batch_child_selection")
+	ctx := context.Background()
+	childIDs := seedCreateBatch(t, client, "batch-mixed", []string{"team-test", "team-prod", "team-test"})
+
+	writer := &fakeAtomicWriter{}
+	gw := NewGateway(client, nil, writer)
+	gw.validator = nil
+
+	err := gw.ApproveWithChildSelections(ctx, "batch-mixed", "admin-1", "cluster-test", "sc-std", "",
+		map[string]ChildSelection{
+			childIDs[1]: {ClusterID: "cluster-prod", StorageClass: "sc-fast"},
+			childIDs[2]: {ClusterID: "cluster-test-2"},
+		})
+	if err != nil {
+		t.Fatalf("ApproveWithChildSelections() error = %v", err)
+	}
+
+	want := map[string]ChildSelection{
+		childIDs[0]: {ClusterID: "cluster-test", StorageClass: "sc-std"},
+		childIDs[1]: {ClusterID: "cluster-prod", StorageClass: "sc-fast"},
+		childIDs[2]: {ClusterID: "cluster-test-2", StorageClass: "sc-std"},
+	}
+	for id, sel := range want {
+		if got := writer.createSelections[id]; got != sel {
+			t.Fatalf("child %s dispatched with %+v, want %+v", id, got, sel)
+		}
+	}
+}
+
+func TestGatewayApprove_BatchParentIncompleteClusterSelection(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "gateway_behavior_batch_selection_incomplete")
+	ctx := context.Background()
+	childIDs := seedCreateBatch(t, client, "batch-incomplete", []string{"team-test", "team-prod"})
+
+	writer := &fakeAtomicWriter{}
+	gw := NewGateway(client, nil, writer)
+	gw.validator = nil
+
+	err := gw.ApproveWithChildSelections(ctx, "batch-incomplete", "admin-1", "", "", "",
+		map[string]ChildSelection{childIDs[0]: {ClusterID: "cluster-test"}})
+	appErr, ok := apperrors.IsAppError(err)
+	if !ok || appErr.Code != CodeBatchClusterSelectionIncomplete {
+		t.Fatalf("ApproveWithChildSelections() error = %v, want %s", err, CodeBatchClusterSelectionIncomplete)
+	}
+	missing, _ := appErr.Params["missing_children"].([]string)
+	if len(missing) != 1 || missing[0] != childIDs[1] {
+		t.Fatalf("missing_children = %v, want [%s]", appErr.Params["missing_children"], childIDs[1])
+	}
+	if writer.called {
+		t.Fatal("atomic writer called despite incomplete selection")
+	}
+	for _, id := range append([]string{"batch-incomplete"}, childIDs...) {
+		ticket := client.ApprovalTicket.GetX(ctx, id)
+		if ticket.Status != approvalticket.StatusPENDING {
+			t.Fatalf("ticket %s status = %s, want PENDING", id, ticket.Status)
+		}
+	}
+
+	err = gw.ApproveWithChildSelections(ctx, "batch-incomplete", "admin-1", "cluster-test", "", "",
+		map[string]ChildSelection{"not-a-child": {ClusterID: "cluster-test"}})
+	if appErr, ok := apperrors.IsAppError(err); !ok || appErr.Code != apperrors.CodeValidationFailed {
+		t.Fatalf("unknown child selection error = %v, want %s", err, apperrors.CodeValidationFailed)
+	}
+}
+
+// slowAtomicWriter delays every CREATE dispatch and fails the listed tickets,
+// tracking how many dispatches overlap.
+type slowAtomicWriter struct {
+	fakeAtomicWriter
+	delay   time.Duration
+	failFor map[string]bool
+
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+func (w *slowAtomicWriter) ApproveCreateAndEnqueue(
+	ctx context.Context,
+	ticketID, eventID, approver, clusterID, storageClass, serviceID, namespace, requesterID string,
+	templateVersion int,
+	templateSnapshot map[string]interface{},
+	instanceSizeSnapshot map[string]interface{},
+	modifiedSpec map[string]interface{},
+) (string, string, error) {
+	n := w.inFlight.Add(1)
+	defer w.inFlight.Add(-1)
+	for {
+		peak := w.maxInFlight.Load()
+		if n <= peak || w.maxInFlight.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+	time.Sleep(w.delay)
+	if w.failFor[ticketID] {
+		return "", "", fmt.Errorf("injected failure for %s", ticketID)
+	}
+	return w.fakeAtomicWriter.ApproveCreateAndEnqueue(ctx, ticketID, eventID, approver, clusterID, storageClass,
+		serviceID, namespace, requesterID, templateVersion, templateSnapshot, instanceSizeSnapshot, modifiedSpec)
+}
+
+func TestGatewayApprove_BatchParentDispatchesChildrenInParallel(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "gateway_behavior_batch_parallel")
+	ctx := context.Background()
+	namespaces := make([]string, 10)
+	for i := range namespaces {
+		namespaces[i] = "team-test"
+	}
+	childIDs := seedCreateBatch(t, client, "batch-parallel", namespaces)
+
+	const delay = 100 * time.Millisecond
+	writer := &slowAtomicWriter{
+		delay:   delay,
+		failFor: map[string]bool{childIDs[1]: true, childIDs[4]: true, childIDs[8]: true},
+	}
+	pools, err := worker.NewPools(ctx, worker.DefaultPoolConfig())
+	if err != nil {
+		t.Fatalf("create worker pools: %v", err)
+	}
+	t.Cleanup(pools.Shutdown)
+	gw := NewGateway(client, nil, writer)
+	gw.validator = nil
+	gw.SetDispatchPool(pools.General)
+	gw.SetBatchDispatchConcurrency(5)
+
+	start := time.Now()
+	if err := gw.Approve(ctx, "batch-parallel", "admin-1", "cluster-test", "", ""); err != nil {
+		t.Fatalf("Approve() error = %v", err)
+	}
+	elapsed := time.Since(start)
+
+	// Sequential dispatch takes len(children) * delay; five workers need two rounds.
+	if sequential := time.Duration(len(childIDs)) * delay; elapsed >= sequential/2 {
+		t.Fatalf("batch dispatch took %s, want well under sequential %s", elapsed, sequential)
+	}
+	if peak := writer.maxInFlight.Load(); peak < 2 || peak > 5 {
+		t.Fatalf("max concurrent dispatches = %d, want between 2 and 5", peak)
+	}
+
+	if got := len(writer.createSelections); got != 7 {
+		t.Fatalf("dispatched children = %d, want 7", got)
+	}
+	for _, id := range childIDs {
+		ticket := client.ApprovalTicket.GetX(ctx, id)
+		if writer.failFor[id] != (ticket.Status == approvalticket.StatusFAILED) {
+			t.Fatalf("child %s status = %s, injected failure = %v", id, ticket.Status, writer.failFor[id])
+		}
+	}
+	parent := client.ApprovalTicket.GetX(ctx, "batch-parallel")
+	if parent.Status != approvalticket.StatusEXECUTING {
+		t.Fatalf("parent status = %s, want EXECUTING", parent.Status)
+	}
+	if parent.RejectReason != "3 child approvals failed during dispatch" {
+		t.Fatalf("parent reject_reason = %q", parent.RejectReason)
+	}
+}
+
+func TestGatewayApproveBatch_PartialSelection(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "gateway_behavior_batch_partial")
+	ctx := context.Background()
+	childIDs := seedCreateBatch(t, client, "batch-partial", []string{"team-test", "team-test", "team-test", "team-test"})
+	client.BatchApprovalTicket.Create().
+		SetID("batch-partial").
+		SetBatchType(batchapprovalticket.BatchTypeBATCH_CREATE).
+		SetChildCount(len(childIDs)).
+		SetPendingCount(len(childIDs)).
+		SetCreatedBy("user-1").
+		SaveX(ctx)
+
+	writer := &slowAtomicWriter{failFor: map[string]bool{childIDs[1]: true, childIDs[2]: true}}
+	gw := NewGateway(client, nil, writer)
+	gw.validator = nil
+
+	err := gw.ApproveBatch(ctx, "batch-partial", "admin-1", "cluster-test", "", "", []string{childIDs[0], "not-a-child"})
+	appErr, ok := apperrors.IsAppError(err)
+	if !ok || appErr.Code != apperrors.CodeValidationFailed {
+		t.Fatalf("ApproveBatch() unknown item error = %v, want %s", err, apperrors.CodeValidationFailed)
+	}
+	if unknown, _ := appErr.Params["unknown_children"].([]string); len(unknown) != 1 || unknown[0] != "not-a-child" {
+		t.Fatalf("unknown_children = %v, want [not-a-child]", appErr.Params["unknown_children"])
+	}
+
+	if err := gw.ApproveBatch(ctx, "batch-partial", "admin-1", "cluster-test", "", "", childIDs[:2]); err != nil {
+		t.Fatalf("ApproveBatch() partial error = %v", err)
+	}
+	if got := len(writer.createSelections); got != 1 {
+		t.Fatalf("dispatched children = %d, want 1", got)
+	}
+	if _, ok := writer.createSelections[childIDs[0]]; !ok {
+		t.Fatalf("selected child %s was not dispatched", childIDs[0])
+	}
+	if got := client.ApprovalTicket.GetX(ctx, childIDs[1]).Status; got != approvalticket.StatusFAILED {
+		t.Fatalf("failed child status = %s, want FAILED", got)
+	}
+	for _, id := range []string{"batch-partial", childIDs[2], childIDs[3]} {
+		if got := client.ApprovalTicket.GetX(ctx, id).Status; got != approvalticket.StatusPENDING {
+			t.Fatalf("ticket %s status = %s, want PENDING", id, got)
+		}
+	}
+	if got := client.BatchApprovalTicket.GetX(ctx, "batch-partial").Status; got != batchapprovalticket.StatusPARTIAL_APPROVED {
+		t.Fatalf("projection status = %s, want PARTIAL_APPROVED", got)
+	}
+
+	// A partial approval whose selected children all fail dispatch is an error.
+	if err := gw.ApproveBatch(ctx, "batch-partial", "admin-1", "cluster-test", "", "", childIDs[2:3]); err == nil {
+		t.Fatal("ApproveBatch() error = nil when every selected child failed")
+	}
+	if got := client.ApprovalTicket.GetX(ctx, "batch-partial").Status; got != approvalticket.StatusPENDING {
+		t.Fatalf("parent status = %s, want PENDING", got)
+	}
+}
+
+func TestGatewayApproveBatch_QuotaIsCumulative(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "gateway_behavior_batch_quota")
+	ctx := context.Background()
+	childIDs := seedCreateBatch(t, client, "batch-quota", []string{"team-test", "team-test", "team-test"})
+	client.Cluster.Create().
+		SetID("cluster-test").
+		SetName("cluster-test").
+		SetAPIServerURL("https://cluster-test.example.com").
+		SetEncryptedKubeconfig([]byte("x")).
+		SetEnvironment(cluster.EnvironmentTest).
+		SetStatus(cluster.StatusHEALTHY).
+		SetCreatedBy("seed").
+		SaveX(ctx)
+	client.NamespaceRegistry.Create().
+		SetID("ns-team-test").
+		SetName("team-test").
+		SetEnvironment(namespaceregistry.EnvironmentTest).
+		SetCreatedBy("seed").
+		SaveX(ctx)
+	// Each child needs one core; any single child fits, all three do not.
+	client.Quota.Create().
+		SetID("quota-team-test").
+		SetScopeType(quota.ScopeTypeNamespace).
+		SetScopeID("team-test").
+		SetMaxCPUCores(2).
+		SetCreatedBy("seed").
+		SaveX(ctx)
+
+	writer := &fakeAtomicWriter{}
+	gw := NewGateway(client, nil, writer)
+
+	err := gw.Approve(ctx, "batch-quota", "admin-1", "cluster-test", "", "")
+	appErr, ok := apperrors.IsAppError(err)
+	if !ok || appErr.Code != apperrors.CodeQuotaExceeded {
+		t.Fatalf("Approve() error = %v, want %s", err, apperrors.CodeQuotaExceeded)
+	}
+	if appErr.Params["resource"] != "cpu_cores" || appErr.Params["current"] != 0 ||
+		appErr.Params["requested"] != 3 || appErr.Params["limit"] != 2 {
+		t.Fatalf("QUOTA_EXCEEDED params = %v, want cpu_cores current 0 requested 3 limit 2", appErr.Params)
+	}
+	if writer.called {
+		t.Fatal("atomic writer called despite exceeded quota")
+	}
+	for _, id := range append([]string{"batch-quota"}, childIDs...) {
+		if got := client.ApprovalTicket.GetX(ctx, id).Status; got != approvalticket.StatusPENDING {
+			t.Fatalf("ticket %s status = %s, want PENDING", id, got)
+		}
+	}
+
+	// Selecting children that fit together dispatches them.
+	if err := gw.ApproveBatch(ctx, "batch-quota", "admin-1", "cluster-test", "", "", childIDs[:2]); err != nil {
+		t.Fatalf("ApproveBatch() within quota error = %v", err)
+	}
+	if got := len(writer.createSelections); got != 2 {
+		t.Fatalf("dispatched children = %d, want 2", got)
+	}
+}
+
+func TestGatewayApproveCreate_EnforcesInstanceSizeClusters(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "gateway_behavior_size_clusters")
+	ctx := context.Background()
+	for _, id := range []string{"cluster-cpu", "cluster-gpu"} {
+		client.Cluster.Create().
+			SetID(id).
+			SetName(id).
+			SetAPIServerURL("https://" + id + ".example.com").
+			SetEncryptedKubeconfig([]byte("x")).
+			SetEnvironment(cluster.EnvironmentTest).
+			SetStatus(cluster.StatusHEALTHY).
+			SetCreatedBy("seed").
+			SaveX(ctx)
+	}
+	client.NamespaceRegistry.Create().
+		SetID("ns-team-test").
+		SetName("team-test").
+		SetEnvironment(namespaceregistry.EnvironmentTest).
+		SetCreatedBy("seed").
+		SaveX(ctx)
+	client.Template.Create().SetID("tpl-1").SetName("tpl").SetVersion(1).SetCreatedBy("seed").SaveX(ctx)
+	client.InstanceSize.Create().SetID("size-gpu").SetName("gpu").SetCPUCores(1).SetMemoryMB(1024).SetCreatedBy("seed").SaveX(ctx)
+	client.InstanceSizeCluster.Create().
+		SetID("isc-1").
+		SetInstanceSizeID("size-gpu").
+		SetClusterID("cluster-gpu").
+		SetCreatedBy("seed").
+		SaveX(ctx)
+	payloadRaw, err := domain.VMCreationPayload{
+		RequesterID:    "user-1",
+		ServiceID:      "svc-1",
+		TemplateID:     "tpl-1",
+		InstanceSizeID: "size-gpu",
+		Namespace:      "team-test",
+	}.ToJSON()
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+	client.DomainEvent.Create().
+		SetID("event-1").
+		SetEventType(string(domain.EventVMCreationRequested)).
+		SetAggregateType("vm").
+		SetAggregateID("svc-1").
+		SetPayload(payloadRaw).
+		SetCreatedBy("user-1").
+		SaveX(ctx)
+	client.ApprovalTicket.Create().
+		SetID("ticket-1").
+		SetEventID("event-1").
+		SetRequester("user-1").
+		SetOperationType(approvalticket.OperationTypeCREATE).
+		SaveX(ctx)
+
+	writer := &fakeAtomicWriter{}
+	gw := NewGateway(client, nil, writer)
+
+	err = gw.Approve(ctx, "ticket-1", "admin-1", "cluster-cpu", "", "")
+	appErr, ok := apperrors.IsAppError(err)
+	if !ok || appErr.Code != service.CodeInstanceSizeClusterNotAllowed {
+		t.Fatalf("Approve() on unassociated cluster error = %v, want %s", err, service.CodeInstanceSizeClusterNotAllowed)
+	}
+	if writer.called {
+		t.Fatal("atomic writer called for a cluster outside the size's associations")
+	}
+
+	if err := gw.Approve(ctx, "ticket-1", "admin-1", "cluster-gpu", "", ""); err != nil {
+		t.Fatalf("Approve() on associated cluster error = %v", err)
+	}
+	if writer.clusterID != "cluster-gpu" {
+		t.Fatalf("dispatched cluster = %q, want cluster-gpu", writer.clusterID)
+	}
+}
@@ -0,0 +1,226 @@
+package approval
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/ent/namespaceregistry"
+	entuser "kv-shepherd.io/shepherd/ent/user"
+	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// Reassign delegates a pending ticket to another approver. The assignee must
+// hold approval:approve (or platform:admin) through a role binding whose
+// environment scope covers every namespace the ticket touches.
+// Batch parents are reassigned together with their pending children in one
+// transaction; children cannot be reassigned on their own.
+func (g *Gateway) Reassign(ctx context.Context, ticketID, actor, assignee string) error {
+	assignee = strings.TrimSpace(assignee)
+	if assignee == "" {
+		return apperrors.BadRequest("INVALID_REQUEST", "assignee_id is required")
+	}
+
+	ticket, err := g.client.ApprovalTicket.Get(ctx, ticketID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperrors.NotFound("TICKET_NOT_FOUND", fmt.Sprintf("ticket %s not found", ticketID))
+		}
+		return fmt.Errorf("get ticket %s: %w", ticketID, err)
+	}
+	if ticket.Status != approvalticket.StatusPENDING {
+		return apperrors.Conflict(
+			"TICKET_NOT_PENDING",
+			fmt.Sprintf("ticket %s is not pending (current: %s)", ticketID, ticket.Status),
+		)
+	}
+	if ticket.ParentTicketID != "" {
+		return apperrors.Conflict(
+			"TICKET_IS_BATCH_CHILD",
+			fmt.Sprintf("ticket %s belongs to batch %s; reassign the parent ticket instead", ticketID, ticket.ParentTicketID),
+		).WithParams(map[string]interface{}{"parent_ticket_id": ticket.ParentTicketID})
+	}
+
+	children, err := g.client.ApprovalTicket.Query().
+		Where(
+			approvalticket.ParentTicketIDEQ(ticket.ID),
+			approvalticket.StatusEQ(approvalticket.StatusPENDING),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("list pending child tickets for %s: %w", ticketID, err)
+	}
+
+	eventIDs := []string{ticket.EventID}
+	for _, child := range children {
+		eventIDs = append(eventIDs, child.EventID)
+	}
+	namespaces, err := g.ticketNamespaces(ctx, eventIDs)
+	if err != nil {
+		return fmt.Errorf("resolve namespaces for ticket %s: %w", ticketID, err)
+	}
+	if err := g.ensureEligibleApprover(ctx, assignee, namespaces); err != nil {
+		return err
+	}
+
+	tx, err := g.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin reassign tx: %w", err)
+	}
+	// Guard on PENDING inside the tx so a concurrent decision wins cleanly.
+	updated, err := tx.ApprovalTicket.Update().
+		Where(
+			approvalticket.IDEQ(ticket.ID),
+			approvalticket.StatusEQ(approvalticket.StatusPENDING),
+		).
+		SetAssignedApprover(assignee).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("reassign ticket %s: %w", ticketID, err)
+	}
+	if updated == 0 {
+		_ = tx.Rollback()
+		return apperrors.Conflict("TICKET_NOT_PENDING", fmt.Sprintf("ticket %s is no longer pending", ticketID))
+	}
+	childIDs := make([]string, 0, len(children))
+	if len(children) > 0 {
+		for _, child := range children {
+			childIDs = append(childIDs, child.ID)
+		}
+		if _, err := tx.ApprovalTicket.Update().
+			Where(
+				approvalticket.IDIn(childIDs...),
+				approvalticket.StatusEQ(approvalticket.StatusPENDING),
+			).
+			SetAssignedApprover(assignee).
+			Save(ctx); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("reassign child tickets of %s: %w", ticketID, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit reassign tx: %w", err)
+	}
+
+	if g.auditLogger != nil {
+		details := map[string]interface{}{
+			"from": ticket.AssignedApprover,
+			"to":   assignee,
+		}
+		if len(childIDs) > 0 {
+			details["child_ticket_ids"] = childIDs
+		}
+		_ = g.auditLogger.LogAction(ctx, "approval.reassigned", "approval_ticket", ticketID, actor, details)
+	}
+	if g.notifier != nil {
+		g.notifier.OnTicketReassigned(ctx, ticketID, assignee, actor)
+	}
+
+	logger.Info("Ticket reassigned",
+		zap.String("ticket_id", ticketID),
+		zap.String("from", ticket.AssignedApprover),
+		zap.String("to", assignee),
+		zap.String("actor", actor),
+		zap.Int("children", len(childIDs)),
+	)
+	return nil
+}
+
+// ticketNamespaces collects the namespaces referenced by the given domain
+// event payloads. Events without a namespace (e.g. batch envelopes) are skipped.
+func (g *Gateway) ticketNamespaces(ctx context.Context, eventIDs []string) ([]string, error) {
+	events, err := g.client.DomainEvent.Query().
+		Where(domainevent.IDIn(eventIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var namespaces []string
+	for _, event := range events {
+		var payload struct {
+			Namespace string `json:"namespace"`
+		}
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			continue
+		}
+		ns := strings.TrimSpace(payload.Namespace)
+		if ns != "" && !slices.Contains(namespaces, ns) {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	return namespaces, nil
+}
+
+// ensureEligibleApprover verifies that userID is an enabled user holding
+// approval:approve in scope for all namespaces. platform:admin bindings are
+// unrestricted; other bindings honor allowed_environments (empty = all).
+func (g *Gateway) ensureEligibleApprover(ctx context.Context, userID string, namespaces []string) error {
+	user, err := g.client.User.Query().
+		Where(entuser.IDEQ(userID)).
+		WithRoleBindings(func(q *ent.RoleBindingQuery) {
+			q.WithRole()
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperrors.BadRequest("INVALID_ASSIGNEE", fmt.Sprintf("user %s not found", userID))
+		}
+		return fmt.Errorf("get assignee %s: %w", userID, err)
+	}
+	if !user.Enabled {
+		return apperrors.BadRequest("INVALID_ASSIGNEE", fmt.Sprintf("user %s is disabled", userID))
+	}
+
+	envs := make([]string, 0, len(namespaces))
+	if len(namespaces) > 0 {
+		registered, err := g.client.NamespaceRegistry.Query().
+			Where(namespaceregistry.NameIn(namespaces...)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("resolve namespace environments: %w", err)
+		}
+		for _, ns := range registered {
+			envs = append(envs, string(ns.Environment))
+		}
+	}
+
+	for _, binding := range user.Edges.RoleBindings {
+		role := binding.Edges.Role
+		if role == nil {
+			continue
+		}
+		if slices.Contains(role.Permissions, "platform:admin") {
+			return nil
+		}
+		if !slices.Contains(role.Permissions, "approval:approve") {
+			continue
+		}
+		if len(binding.AllowedEnvironments) == 0 {
+			return nil
+		}
+		covered := true
+		for _, env := range envs {
+			if !slices.Contains(binding.AllowedEnvironments, env) {
+				covered = false
+				break
+			}
+		}
+		if covered {
+			return nil
+		}
+	}
+
+	return apperrors.Forbidden(
+		"ASSIGNEE_NOT_APPROVER",
+		fmt.Sprintf("user %s cannot approve ticket in its namespace scope", userID),
+	).WithParams(map[string]interface{}{"assignee_id": userID})
+}
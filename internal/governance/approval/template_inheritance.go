@@ -0,0 +1,80 @@
+package approval
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"kv-shepherd.io/shepherd/ent"
+)
+
+// MaxTemplateInheritanceDepth bounds a template inheritance chain, counting
+// the template itself and every ancestor up to the root.
+const MaxTemplateInheritanceDepth = 5
+
+var (
+	// ErrTemplateInheritanceCycle is returned when a parent chain loops back
+	// onto a template already visited.
+	ErrTemplateInheritanceCycle = errors.New("template inheritance cycle")
+	// ErrTemplateInheritanceTooDeep is returned when a parent chain exceeds
+	// MaxTemplateInheritanceDepth.
+	ErrTemplateInheritanceTooDeep = errors.New("template inheritance chain too deep")
+)
+
+// LoadTemplateAncestors walks parent_template_id from tpl to the root and
+// returns the ancestors nearest-first (parent, grandparent, ...).
+func LoadTemplateAncestors(ctx context.Context, client *ent.Client, tpl *ent.Template) ([]*ent.Template, error) {
+	if tpl == nil {
+		return nil, nil
+	}
+	seen := map[string]struct{}{tpl.ID: {}}
+	var ancestors []*ent.Template
+	for current := tpl; current.ParentTemplateID != nil; {
+		parentID := *current.ParentTemplateID
+		if _, ok := seen[parentID]; ok {
+			return nil, fmt.Errorf("%w: template %s", ErrTemplateInheritanceCycle, parentID)
+		}
+		if len(ancestors)+1 >= MaxTemplateInheritanceDepth {
+			return nil, fmt.Errorf("%w: limit is %d", ErrTemplateInheritanceTooDeep, MaxTemplateInheritanceDepth)
+		}
+		parent, err := client.Template.Get(ctx, parentID)
+		if err != nil {
+			return nil, fmt.Errorf("get parent template %s: %w", parentID, err)
+		}
+		seen[parentID] = struct{}{}
+		ancestors = append(ancestors, parent)
+		current = parent
+	}
+	return ancestors, nil
+}
+
+// ResolveTemplateSpec deep-merges tpl.Spec over its ancestors' specs, root
+// first, so the nearest template wins on conflicting keys. Nested objects are
+// merged key by key; any other value (including arrays) is replaced whole.
+func ResolveTemplateSpec(tpl *ent.Template, ancestors []*ent.Template) map[string]interface{} {
+	resolved := map[string]interface{}{}
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		resolved = mergeSpec(resolved, ancestors[i].Spec)
+	}
+	if tpl != nil {
+		resolved = mergeSpec(resolved, tpl.Spec)
+	}
+	return resolved
+}
+
+func mergeSpec(base, override map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(base)+len(override))
+	for k, v := range base {
+		out[k] = v
+	}
+	for k, v := range override {
+		overrideMap, overrideIsMap := v.(map[string]interface{})
+		baseMap, baseIsMap := out[k].(map[string]interface{})
+		if overrideIsMap && baseIsMap {
+			out[k] = mergeSpec(baseMap, overrideMap)
+			continue
+		}
+		out[k] = v
+	}
+	return out
+}
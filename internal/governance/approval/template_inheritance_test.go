@@ -0,0 +1,62 @@
+package approval
+
+import (
+	"reflect"
+	"testing"
+
+	"kv-shepherd.io/shepherd/ent"
+)
+
+func TestResolveTemplateSpec_MergeOrder(t *testing.T) {
+	t.Parallel()
+
+	root := &ent.Template{ID: "root", Spec: map[string]interface{}{
+		"image":         "quay.io/kubevirt/fedora:40",
+		"storage_class": "ceph-rbd",
+		"domain": map[string]interface{}{
+			"cpu":    map[string]interface{}{"model": "host-passthrough", "sockets": 1},
+			"serial": "root",
+		},
+		"tags": []interface{}{"base"},
+	}}
+	mid := &ent.Template{ID: "mid", Spec: map[string]interface{}{
+		"storage_class": "local-nvme",
+		"domain": map[string]interface{}{
+			"cpu": map[string]interface{}{"dedicatedCpuPlacement": true},
+		},
+	}}
+	child := &ent.Template{ID: "child", Spec: map[string]interface{}{
+		"domain": map[string]interface{}{
+			"cpu":    map[string]interface{}{"sockets": 2},
+			"memory": map[string]interface{}{"hugepages": map[string]interface{}{"pageSize": "1Gi"}},
+		},
+		"tags": []interface{}{"pinned"},
+	}}
+
+	got := ResolveTemplateSpec(child, []*ent.Template{mid, root})
+	want := map[string]interface{}{
+		"image":         "quay.io/kubevirt/fedora:40",
+		"storage_class": "local-nvme",
+		"domain": map[string]interface{}{
+			"cpu": map[string]interface{}{
+				"model":                 "host-passthrough",
+				"sockets":               2,
+				"dedicatedCpuPlacement": true,
+			},
+			"memory": map[string]interface{}{"hugepages": map[string]interface{}{"pageSize": "1Gi"}},
+			"serial": "root",
+		},
+		"tags": []interface{}{"pinned"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("resolved spec = %#v, want %#v", got, want)
+	}
+	if sockets := root.Spec["domain"].(map[string]interface{})["cpu"].(map[string]interface{})["sockets"]; sockets != 1 {
+		t.Fatalf("root spec mutated: sockets = %v, want 1", sockets)
+	}
+
+	snapshot := buildTemplateSnapshot(child, mid, root)
+	if !reflect.DeepEqual(snapshot["spec"], want) {
+		t.Fatalf("snapshot spec = %#v, want resolved spec", snapshot["spec"])
+	}
+}
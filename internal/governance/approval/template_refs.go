@@ -0,0 +1,102 @@
+package approval
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/ent/vm"
+)
+
+// TemplateReferences describes in-flight and materialized uses of a template.
+type TemplateReferences struct {
+	// PendingTicketIDs are PENDING CREATE tickets whose effective template
+	// (payload or modified_spec override) is the template.
+	PendingTicketIDs []string
+	// ApprovedTickets counts APPROVED CREATE tickets whose snapshot or
+	// effective template is the template.
+	ApprovedTickets int
+	// VMs counts VM rows created from tickets whose template snapshot came
+	// from the template.
+	VMs int
+}
+
+// InUse reports whether any reference blocks template deletion.
+func (r *TemplateReferences) InUse() bool {
+	return r != nil && (len(r.PendingTicketIDs) > 0 || r.ApprovedTickets > 0 || r.VMs > 0)
+}
+
+// FindTemplateReferences collects approval tickets and VMs that still depend
+// on templateID. Used by admin template deletion to avoid orphaning tickets
+// that approveCreate would later fail to resolve.
+func FindTemplateReferences(ctx context.Context, client *ent.Client, templateID string) (*TemplateReferences, error) {
+	refs := &TemplateReferences{PendingTicketIDs: []string{}}
+
+	tickets, err := client.ApprovalTicket.Query().
+		Where(
+			approvalticket.OperationTypeEQ(approvalticket.OperationTypeCREATE),
+			approvalticket.StatusIn(approvalticket.StatusPENDING, approvalticket.StatusAPPROVED),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query in-flight create tickets: %w", err)
+	}
+
+	if len(tickets) > 0 {
+		eventIDs := make([]string, 0, len(tickets))
+		for _, t := range tickets {
+			eventIDs = append(eventIDs, t.EventID)
+		}
+		events, err := client.DomainEvent.Query().
+			Where(domainevent.IDIn(eventIDs...)).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("query create ticket events: %w", err)
+		}
+		payloadTemplate := make(map[string]string, len(events))
+		for _, ev := range events {
+			// Batch parents and malformed payloads carry no template reference.
+			if payload, err := parseVMCreatePayload(ev.Payload); err == nil {
+				payloadTemplate[ev.ID] = payload.TemplateID
+			}
+		}
+
+		for _, t := range tickets {
+			effectiveTemplateID, _ := resolveEffectiveSelectionIDs(payloadTemplate[t.EventID], "", t.ModifiedSpec)
+			referenced := effectiveTemplateID == templateID ||
+				lookupStringValue(t.TemplateSnapshot, "id") == templateID
+			if !referenced {
+				continue
+			}
+			if t.Status == approvalticket.StatusPENDING {
+				refs.PendingTicketIDs = append(refs.PendingTicketIDs, t.ID)
+			} else {
+				refs.ApprovedTickets++
+			}
+		}
+	}
+
+	snapshotTicketIDs, err := client.ApprovalTicket.Query().
+		Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueEQ(approvalticket.FieldTemplateSnapshot, templateID, sqljson.Path("id")))
+		}).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query tickets by template snapshot: %w", err)
+	}
+	if len(snapshotTicketIDs) > 0 {
+		refs.VMs, err = client.VM.Query().
+			Where(vm.TicketIDIn(snapshotTicketIDs...)).
+			Count(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("count vms by template: %w", err)
+		}
+	}
+
+	return refs, nil
+}
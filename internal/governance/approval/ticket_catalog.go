@@ -0,0 +1,275 @@
+package approval
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"kv-shepherd.io/shepherd/ent"
+)
+
+// Fields compared between a ticket snapshot and the live catalog entry.
+// Bookkeeping such as enabled, sort_order and created_by is not drift.
+var (
+	templateDriftFields     = []string{"name", "display_name", "description", "version", "os_family", "os_version", "parent_template_id", "spec"}
+	instanceSizeDriftFields = []string{
+		"name", "display_name", "description", "cpu_cores", "memory_mb", "disk_gb",
+		"cpu_request", "memory_request_mb", "dedicated_cpu", "requires_gpu", "requires_sriov",
+		"requires_hugepages", "hugepages_size", "spec_overrides",
+	}
+)
+
+// TicketTemplate is the template a CREATE ticket provisions from.
+type TicketTemplate struct {
+	ID          string
+	Name        string
+	DisplayName string
+	Version     int
+	OSFamily    string
+	OSVersion   string
+	// FromSnapshot is set when the values come from the ticket's approval
+	// snapshot rather than the live catalog.
+	FromSnapshot bool
+	// CatalogChanged reports that the live template differs from the
+	// snapshot, or was deleted.
+	CatalogChanged bool
+}
+
+// TicketInstanceSize is the instance size a CREATE ticket provisions with.
+// CPU, memory and disk include the approver's modified_spec overrides.
+type TicketInstanceSize struct {
+	ID             string
+	Name           string
+	DisplayName    string
+	CPUCores       int
+	MemoryMB       int
+	DiskGB         int
+	FromSnapshot   bool
+	CatalogChanged bool
+}
+
+// ResolveTicketCatalog returns the template and instance size of a CREATE
+// ticket for presentation. Snapshots stored at approval win over the live
+// catalog, which is only consulted for tickets without one and to detect
+// drift. A catalog entry that cannot be found yields nil.
+func ResolveTicketCatalog(ctx context.Context, client *ent.Client, ticket *ent.ApprovalTicket, payloadRaw json.RawMessage) (*TicketTemplate, *TicketInstanceSize, error) {
+	var payload vmCreatePayload
+	if err := json.Unmarshal(payloadRaw, &payload); err != nil {
+		return nil, nil, fmt.Errorf("decode create payload for ticket %s: %w", ticket.ID, err)
+	}
+	templateID, instanceSizeID := resolveEffectiveSelectionIDs(payload.TemplateID, payload.InstanceSizeID, ticket.ModifiedSpec)
+
+	tpl, err := resolveTicketTemplate(ctx, client, templateID, ticket.TemplateSnapshot)
+	if err != nil {
+		return nil, nil, err
+	}
+	size, err := resolveTicketInstanceSize(ctx, client, instanceSizeID, ticket.InstanceSizeSnapshot)
+	if err != nil {
+		return nil, nil, err
+	}
+	if size != nil {
+		applyModifiedResources(size, ticket.ModifiedSpec)
+	}
+	return tpl, size, nil
+}
+
+func resolveTicketTemplate(ctx context.Context, client *ent.Client, templateID string, snapshot map[string]interface{}) (*TicketTemplate, error) {
+	if len(snapshot) > 0 {
+		out := &TicketTemplate{
+			ID:           lookupStringValue(snapshot, "id"),
+			Name:         lookupStringValue(snapshot, "name"),
+			DisplayName:  lookupStringValue(snapshot, "display_name"),
+			Version:      snapshotInt(snapshot, "version"),
+			OSFamily:     lookupStringValue(snapshot, "os_family"),
+			OSVersion:    lookupStringValue(snapshot, "os_version"),
+			FromSnapshot: true,
+		}
+		live, err := client.Template.Get(ctx, out.ID)
+		switch {
+		case ent.IsNotFound(err):
+			out.CatalogChanged = true
+		case err != nil:
+			return nil, fmt.Errorf("get template %s: %w", out.ID, err)
+		default:
+			ancestors, err := LoadTemplateAncestors(ctx, client, live)
+			if err != nil {
+				return nil, fmt.Errorf("resolve template %s inheritance: %w", out.ID, err)
+			}
+			out.CatalogChanged = snapshotDrifted(snapshot, buildTemplateSnapshot(live, ancestors...), templateDriftFields)
+		}
+		return out, nil
+	}
+
+	live, err := client.Template.Get(ctx, templateID)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get template %s: %w", templateID, err)
+	}
+	return &TicketTemplate{
+		ID:          live.ID,
+		Name:        live.Name,
+		DisplayName: live.DisplayName,
+		Version:     live.Version,
+		OSFamily:    live.OsFamily,
+		OSVersion:   live.OsVersion,
+	}, nil
+}
+
+func resolveTicketInstanceSize(ctx context.Context, client *ent.Client, instanceSizeID string, snapshot map[string]interface{}) (*TicketInstanceSize, error) {
+	if len(snapshot) > 0 {
+		out := instanceSizeFromSnapshot(snapshot)
+		live, err := client.InstanceSize.Get(ctx, out.ID)
+		switch {
+		case ent.IsNotFound(err):
+			out.CatalogChanged = true
+		case err != nil:
+			return nil, fmt.Errorf("get instance size %s: %w", out.ID, err)
+		default:
+			out.CatalogChanged = snapshotDrifted(snapshot, buildInstanceSizeSnapshot(live), instanceSizeDriftFields)
+		}
+		return &out, nil
+	}
+
+	live, err := client.InstanceSize.Get(ctx, instanceSizeID)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get instance size %s: %w", instanceSizeID, err)
+	}
+	out := instanceSizeFromEntity(live)
+	return &out, nil
+}
+
+// TicketResources returns the instance size a CREATE ticket provisioned
+// with: its snapshot, or the live size from sizes (keyed by ID) when the
+// ticket has none, with modified_spec overrides applied. It reports false
+// when neither is available.
+func TicketResources(ticket *ent.ApprovalTicket, payloadRaw json.RawMessage, sizes map[string]*ent.InstanceSize) (TicketInstanceSize, bool) {
+	var out TicketInstanceSize
+	if len(ticket.InstanceSizeSnapshot) > 0 {
+		out = instanceSizeFromSnapshot(ticket.InstanceSizeSnapshot)
+	} else {
+		var payload vmCreatePayload
+		if err := json.Unmarshal(payloadRaw, &payload); err != nil {
+			return out, false
+		}
+		_, sizeID := resolveEffectiveSelectionIDs(payload.TemplateID, payload.InstanceSizeID, ticket.ModifiedSpec)
+		live, ok := sizes[sizeID]
+		if !ok {
+			return out, false
+		}
+		out = instanceSizeFromEntity(live)
+	}
+	applyModifiedResources(&out, ticket.ModifiedSpec)
+	return out, true
+}
+
+func instanceSizeFromSnapshot(snapshot map[string]interface{}) TicketInstanceSize {
+	return TicketInstanceSize{
+		ID:           lookupStringValue(snapshot, "id"),
+		Name:         lookupStringValue(snapshot, "name"),
+		DisplayName:  lookupStringValue(snapshot, "display_name"),
+		CPUCores:     snapshotInt(snapshot, "cpu_cores"),
+		MemoryMB:     snapshotInt(snapshot, "memory_mb"),
+		DiskGB:       snapshotInt(snapshot, "disk_gb"),
+		FromSnapshot: true,
+	}
+}
+
+func instanceSizeFromEntity(size *ent.InstanceSize) TicketInstanceSize {
+	return TicketInstanceSize{
+		ID:          size.ID,
+		Name:        size.Name,
+		DisplayName: size.DisplayName,
+		CPUCores:    size.CPUCores,
+		MemoryMB:    size.MemoryMB,
+		DiskGB:      size.DiskGB,
+	}
+}
+
+// applyModifiedResources applies the approver's cpu, memory_mb and disk_gb
+// overrides, top-level or under "resources", as the VM create worker does.
+func applyModifiedResources(size *TicketInstanceSize, modifiedSpec map[string]interface{}) {
+	resources, _ := modifiedSpec["resources"].(map[string]interface{})
+	for _, src := range []map[string]interface{}{resources, modifiedSpec} {
+		if v, ok := snapshotIntOK(src, "cpu"); ok {
+			size.CPUCores = v
+		}
+		if v, ok := snapshotIntOK(src, "memory_mb"); ok {
+			size.MemoryMB = v
+		}
+		if v, ok := snapshotIntOK(src, "disk_gb"); ok && v >= 0 {
+			size.DiskGB = v
+		}
+	}
+}
+
+// snapshotDrifted compares the given fields of a stored snapshot with one
+// built from the live entity. The live side is round-tripped through JSON so
+// both carry the same value types.
+func snapshotDrifted(stored, live map[string]interface{}, fields []string) bool {
+	raw, err := json.Marshal(live)
+	if err != nil {
+		return true
+	}
+	var normalized map[string]interface{}
+	if err := json.Unmarshal(raw, &normalized); err != nil {
+		return true
+	}
+	for _, field := range fields {
+		if !reflect.DeepEqual(normalizeSnapshotValue(stored[field]), normalizeSnapshotValue(normalized[field])) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeSnapshotValue treats zero values and nil alike, since older
+// snapshots omit fields that newer ones record with their zero value.
+func normalizeSnapshotValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case string:
+		if strings.TrimSpace(t) == "" {
+			return nil
+		}
+	case map[string]interface{}:
+		if len(t) == 0 {
+			return nil
+		}
+	case bool:
+		if !t {
+			return nil
+		}
+	case float64:
+		if t == 0 {
+			return nil
+		}
+	}
+	return v
+}
+
+func snapshotInt(values map[string]interface{}, key string) int {
+	v, _ := snapshotIntOK(values, key)
+	return v
+}
+
+func snapshotIntOK(values map[string]interface{}, key string) (int, bool) {
+	switch v := values[key].(type) {
+	case float64:
+		return int(v), true
+	case int:
+		return v, true
+	case int64:
+		return int(v), true
+	case json.Number:
+		n, err := v.Int64()
+		return int(n), err == nil
+	default:
+		return 0, false
+	}
+}
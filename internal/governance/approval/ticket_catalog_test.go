@@ -0,0 +1,57 @@
+package approval
+
+import (
+	"testing"
+
+	"kv-shepherd.io/shepherd/ent"
+)
+
+func TestSnapshotDrifted(t *testing.T) {
+	t.Parallel()
+
+	size := &ent.InstanceSize{ID: "size-1", Name: "small", CPUCores: 2, MemoryMB: 4096, DiskGB: 40, Enabled: true}
+	// Stored snapshots round-trip through JSON; older ones omit zero fields.
+	stored := map[string]interface{}{
+		"id": "size-1", "name": "small", "cpu_cores": float64(2), "memory_mb": float64(4096), "disk_gb": float64(40),
+	}
+	if snapshotDrifted(stored, buildInstanceSizeSnapshot(size), instanceSizeDriftFields) {
+		t.Fatalf("unchanged instance size reported as drifted")
+	}
+
+	size.Enabled = false
+	size.SortOrder = 5
+	if snapshotDrifted(stored, buildInstanceSizeSnapshot(size), instanceSizeDriftFields) {
+		t.Fatalf("bookkeeping change reported as drifted")
+	}
+
+	size.MemoryMB = 8192
+	if !snapshotDrifted(stored, buildInstanceSizeSnapshot(size), instanceSizeDriftFields) {
+		t.Fatalf("memory change not reported as drifted")
+	}
+
+	tpl := &ent.Template{ID: "tpl-1", Name: "fedora", Version: 2, Spec: map[string]interface{}{"image": "fedora:40"}}
+	storedTpl := map[string]interface{}{
+		"id": "tpl-1", "name": "fedora", "version": float64(2), "spec": map[string]interface{}{"image": "fedora:40"},
+	}
+	if snapshotDrifted(storedTpl, buildTemplateSnapshot(tpl), templateDriftFields) {
+		t.Fatalf("unchanged template reported as drifted")
+	}
+	tpl.Spec = map[string]interface{}{"image": "fedora:41"}
+	if !snapshotDrifted(storedTpl, buildTemplateSnapshot(tpl), templateDriftFields) {
+		t.Fatalf("template spec change not reported as drifted")
+	}
+}
+
+func TestApplyModifiedResources(t *testing.T) {
+	t.Parallel()
+
+	size := &TicketInstanceSize{CPUCores: 2, MemoryMB: 4096, DiskGB: 40}
+	applyModifiedResources(size, map[string]interface{}{
+		"cpu":       float64(4),
+		"resources": map[string]interface{}{"cpu": float64(8), "memory_mb": float64(16384)},
+		"disk_gb":   float64(-1),
+	})
+	if size.CPUCores != 4 || size.MemoryMB != 16384 || size.DiskGB != 40 {
+		t.Fatalf("applyModifiedResources = %+v, want cpu 4 (top-level wins), memory 16384, disk unchanged", size)
+	}
+}
@@ -0,0 +1,145 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"kv-shepherd.io/shepherd/ent"
+)
+
+// ArchiveSink receives audit records before retention deletes them.
+//
+// Write may buffer; retention deletes a batch only after Flush returns nil,
+// so Flush must make every written record durable.
+type ArchiveSink interface {
+	Write(ctx context.Context, batch []*ent.AuditLog) error
+	Flush(ctx context.Context) error
+}
+
+// ArchiveRecord is the NDJSON shape of an archived audit record.
+type ArchiveRecord struct {
+	ID           string                 `json:"id"`
+	Action       string                 `json:"action"`
+	ResourceType string                 `json:"resource_type"`
+	ResourceID   string                 `json:"resource_id"`
+	Actor        string                 `json:"actor"`
+	Details      map[string]interface{} `json:"details,omitempty"`
+	IPAddress    string                 `json:"ip_address,omitempty"`
+	CreatedAt    time.Time              `json:"created_at"`
+}
+
+// FileSink archives audit records as NDJSON into one file per UTC day,
+// audit-archive-YYYYMMDD.ndjson under dir. Files are opened in append mode,
+// so repeated runs on the same day extend the same file.
+type FileSink struct {
+	dir string
+	now func() time.Time
+
+	mu   sync.Mutex
+	day  string
+	file *os.File
+	buf  *bufio.Writer
+}
+
+// NewFileSink creates a FileSink writing under dir, created on first write.
+func NewFileSink(dir string) *FileSink {
+	return &FileSink{dir: dir, now: time.Now}
+}
+
+// Write appends batch to the current day's archive file.
+func (s *FileSink) Write(_ context.Context, batch []*ent.AuditLog) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.open(); err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(s.buf)
+	for _, l := range batch {
+		if err := encoder.Encode(ArchiveRecord{
+			ID:           l.ID,
+			Action:       l.Action,
+			ResourceType: l.ResourceType,
+			ResourceID:   l.ResourceID,
+			Actor:        l.Actor,
+			Details:      l.Details,
+			IPAddress:    l.IPAddress,
+			CreatedAt:    l.CreatedAt.UTC(),
+		}); err != nil {
+			return fmt.Errorf("write audit archive record %s: %w", l.ID, err)
+		}
+	}
+	return nil
+}
+
+// Flush writes buffered records and syncs the archive file to disk.
+func (s *FileSink) Flush(context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	if err := s.buf.Flush(); err != nil {
+		return fmt.Errorf("flush audit archive %s: %w", s.file.Name(), err)
+	}
+	if err := s.file.Sync(); err != nil {
+		return fmt.Errorf("sync audit archive %s: %w", s.file.Name(), err)
+	}
+	return nil
+}
+
+// Close flushes and closes the open archive file, if any.
+func (s *FileSink) Close() error {
+	if err := s.Flush(context.Background()); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closeFile()
+}
+
+// open makes sure the file for the current day is open, rolling over from a
+// previous day's file. Callers hold s.mu.
+func (s *FileSink) open() error {
+	day := s.now().UTC().Format("20060102")
+	if s.file != nil && s.day == day {
+		return nil
+	}
+	if s.file != nil {
+		if err := s.buf.Flush(); err != nil {
+			return fmt.Errorf("flush audit archive %s: %w", s.file.Name(), err)
+		}
+		if err := s.closeFile(); err != nil {
+			return err
+		}
+	}
+	if err := os.MkdirAll(s.dir, 0o750); err != nil {
+		return fmt.Errorf("create audit archive dir %s: %w", s.dir, err)
+	}
+	path := filepath.Join(s.dir, "audit-archive-"+day+".ndjson")
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o640)
+	if err != nil {
+		return fmt.Errorf("open audit archive %s: %w", path, err)
+	}
+	s.day, s.file, s.buf = day, file, bufio.NewWriter(file)
+	return nil
+}
+
+func (s *FileSink) closeFile() error {
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file, s.buf = nil, nil
+	if err != nil {
+		return fmt.Errorf("close audit archive: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"kv-shepherd.io/shepherd/ent"
+)
+
+func TestFileSink_WritesNDJSONPerDay(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "archive")
+	sink := NewFileSink(dir)
+	now := time.Date(2026, 3, 1, 23, 59, 0, 0, time.UTC)
+	sink.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	batch := []*ent.AuditLog{
+		{ID: "a1", Action: "vm.create", ResourceType: "vm", ResourceID: "vm-1", Actor: "admin-1", Details: map[string]interface{}{"k": "v"}, CreatedAt: now.Add(-time.Hour)},
+		{ID: "a2", Action: "vm.delete", ResourceType: "vm", ResourceID: "vm-1", Actor: "admin-1", CreatedAt: now},
+	}
+	if err := sink.Write(ctx, batch); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if err := sink.Flush(ctx); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	now = now.Add(2 * time.Minute)
+	if err := sink.Write(ctx, batch[:1]); err != nil {
+		t.Fatalf("Write() next day error = %v", err)
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	for name, want := range map[string][]string{
+		"audit-archive-20260301.ndjson": {"a1", "a2"},
+		"audit-archive-20260302.ndjson": {"a1"},
+	} {
+		f, err := os.Open(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("open %s: %v", name, err)
+		}
+		var ids []string
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			var rec ArchiveRecord
+			if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+				t.Fatalf("%s: decode %q: %v", name, scanner.Text(), err)
+			}
+			ids = append(ids, rec.ID)
+		}
+		_ = f.Close()
+		if len(ids) != len(want) || ids[0] != want[0] {
+			t.Fatalf("%s ids = %v, want %v", name, ids, want)
+		}
+	}
+}
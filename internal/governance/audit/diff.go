@@ -0,0 +1,149 @@
+package audit
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// RedactedValue stands in for the old and new values of a sensitive field.
+const RedactedValue = "[REDACTED]"
+
+// diffIgnoredFields are bookkeeping fields that change on every update or are
+// not part of the entity's own state.
+var diffIgnoredFields = map[string]bool{
+	"edges":      true,
+	"created_at": true,
+	"updated_at": true,
+}
+
+// sensitiveKeyParts mark a field as secret when its lower-cased key contains
+// any of them.
+var sensitiveKeyParts = []string{"kubeconfig", "password", "secret", "token", "private_key", "api_key", "credential"}
+
+// sensitiveKeys are secret fields matched by exact key; cloud-init user_data
+// routinely embeds passwords and keys.
+var sensitiveKeys = map[string]bool{"user_data": true}
+
+// Change is the before/after value of one changed field.
+type Change struct {
+	Old interface{} `json:"old"`
+	New interface{} `json:"new"`
+}
+
+// Diff returns the fields that differ between two versions of an entity,
+// keyed by JSON field name. Nested objects are compared field by field under
+// dotted keys (e.g. "spec.memory_mb"); other values, lists included, are
+// compared whole. Unchanged fields are omitted and a field missing on one
+// side compares as nil. Sensitive fields are reported as changed with both
+// values replaced by RedactedValue.
+//
+// before and after are typically the Ent entity read before an update and
+// the one returned by Save. A value that does not encode to a JSON object
+// yields nil.
+func Diff(before, after interface{}) map[string]Change {
+	oldFields, ok := toFieldMap(before)
+	if !ok {
+		return nil
+	}
+	newFields, ok := toFieldMap(after)
+	if !ok {
+		return nil
+	}
+	changes := map[string]Change{}
+	diffFields("", oldFields, newFields, changes)
+	return changes
+}
+
+// ChangeDetails wraps Diff as audit details under the "changes" key.
+func ChangeDetails(before, after interface{}) map[string]interface{} {
+	return map[string]interface{}{"changes": Diff(before, after)}
+}
+
+func diffFields(prefix string, oldFields, newFields map[string]interface{}, changes map[string]Change) {
+	keys := make(map[string]bool, len(oldFields)+len(newFields))
+	for k := range oldFields {
+		keys[k] = true
+	}
+	for k := range newFields {
+		keys[k] = true
+	}
+	for k := range keys {
+		if prefix == "" && diffIgnoredFields[k] {
+			continue
+		}
+		path := prefix + k
+		oldValue, newValue := oldFields[k], newFields[k]
+		if reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+		if isSensitiveKey(k) {
+			changes[path] = Change{Old: RedactedValue, New: RedactedValue}
+			continue
+		}
+		oldObject, oldIsObject := oldValue.(map[string]interface{})
+		newObject, newIsObject := newValue.(map[string]interface{})
+		if (oldIsObject || oldValue == nil) && (newIsObject || newValue == nil) {
+			diffFields(path+".", oldObject, newObject, changes)
+			continue
+		}
+		changes[path] = Change{Old: redactNested(oldValue), New: redactNested(newValue)}
+	}
+}
+
+// Redact returns a copy of v with the value of every sensitive key, at any
+// depth, replaced by RedactedValue. v is a decoded JSON value.
+func Redact(v interface{}) interface{} {
+	return redactNested(v)
+}
+
+// redactNested masks sensitive keys inside a value reported whole, such as
+// an object that replaced a scalar.
+func redactNested(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, item := range t {
+			if isSensitiveKey(k) {
+				out[k] = RedactedValue
+			} else {
+				out[k] = redactNested(item)
+			}
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i, item := range t {
+			out[i] = redactNested(item)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	if sensitiveKeys[key] {
+		return true
+	}
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// toFieldMap decodes v's JSON encoding as an object.
+func toFieldMap(v interface{}) (map[string]interface{}, bool) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, false
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw, &fields); err != nil || fields == nil {
+		return nil, false
+	}
+	return fields, true
+}
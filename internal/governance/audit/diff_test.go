@@ -0,0 +1,98 @@
+package audit
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	type size struct {
+		Name      string                 `json:"name"`
+		MemoryMB  int                    `json:"memory_mb"`
+		Enabled   bool                   `json:"enabled"`
+		Tags      []string               `json:"tags,omitempty"`
+		Config    map[string]interface{} `json:"config,omitempty"`
+		Password  string                 `json:"password,omitempty"`
+		UpdatedAt string                 `json:"updated_at"`
+	}
+
+	tests := []struct {
+		name          string
+		before, after interface{}
+		want          map[string]Change
+	}{
+		{
+			name:   "unchanged fields are omitted",
+			before: size{Name: "e2e-small", MemoryMB: 4096, Enabled: true, UpdatedAt: "t1"},
+			after:  size{Name: "e2e-small", MemoryMB: 8192, Enabled: true, UpdatedAt: "t2"},
+			want:   map[string]Change{"memory_mb": {Old: float64(4096), New: float64(8192)}},
+		},
+		{
+			name:   "no changes",
+			before: size{Name: "a", Tags: []string{"x"}},
+			after:  size{Name: "a", Tags: []string{"x"}},
+			want:   map[string]Change{},
+		},
+		{
+			name:   "lists compare whole and cleared fields become nil",
+			before: size{Name: "a", Tags: []string{"x"}},
+			after:  size{Name: "a", Tags: []string{"x", "y"}, Password: ""},
+			want:   map[string]Change{"tags": {Old: []interface{}{"x"}, New: []interface{}{"x", "y"}}},
+		},
+		{
+			name:   "nested objects diff by dotted key with secrets redacted",
+			before: size{Config: map[string]interface{}{"issuer": "a", "client_secret": "s1", "kubeconfig": "k"}, Password: "p1"},
+			after:  size{Config: map[string]interface{}{"issuer": "b", "client_secret": "s2", "kubeconfig": "k"}, Password: "p2"},
+			want: map[string]Change{
+				"config.issuer":        {Old: "a", New: "b"},
+				"config.client_secret": {Old: RedactedValue, New: RedactedValue},
+				"password":             {Old: RedactedValue, New: RedactedValue},
+			},
+		},
+		{
+			name:   "added object reports its fields",
+			before: size{},
+			after:  size{Config: map[string]interface{}{"issuer": "a", "bind_password": "p"}},
+			want: map[string]Change{
+				"config.issuer":        {Old: nil, New: "a"},
+				"config.bind_password": {Old: RedactedValue, New: RedactedValue},
+			},
+		},
+		{
+			name:   "non-object values yield nil",
+			before: "a",
+			after:  "b",
+			want:   nil,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := Diff(tc.before, tc.after); !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("Diff() = %#v, want %#v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRedactNested(t *testing.T) {
+	t.Parallel()
+
+	got := redactNested(map[string]interface{}{
+		"servers":         []interface{}{map[string]interface{}{"host": "ldap", "Bind_Password": "p"}},
+		"token":           "t",
+		"user_data":       "I2Nsb3VkLWNvbmZpZwo=",
+		"allow_user_data": true,
+	})
+	want := map[string]interface{}{
+		"servers":         []interface{}{map[string]interface{}{"host": "ldap", "Bind_Password": RedactedValue}},
+		"token":           RedactedValue,
+		"user_data":       RedactedValue,
+		"allow_user_data": true,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("redactNested() = %#v, want %#v", got, want)
+	}
+}
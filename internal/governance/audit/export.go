@@ -0,0 +1,105 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/auditlog"
+	"kv-shepherd.io/shepherd/ent/predicate"
+)
+
+// ExportBatchSize bounds how many audit rows are loaded per export query.
+const ExportBatchSize = 500
+
+// DefaultExportMaxRows is the export row cap used when none is configured.
+const DefaultExportMaxRows = 1_000_000
+
+// ExportFilter restricts an export or listing. Zero values are unbounded;
+// From is inclusive and To is exclusive. MaxRows caps how many rows are
+// exported.
+type ExportFilter struct {
+	From         time.Time
+	To           time.Time
+	Action       string
+	Actor        string
+	ResourceType string
+	ResourceID   string
+	MaxRows      int
+}
+
+// Predicates translates the filter's non-zero fields into AuditLog predicates.
+func (f ExportFilter) Predicates() []predicate.AuditLog {
+	var preds []predicate.AuditLog
+	if !f.From.IsZero() {
+		preds = append(preds, auditlog.CreatedAtGTE(f.From))
+	}
+	if !f.To.IsZero() {
+		preds = append(preds, auditlog.CreatedAtLT(f.To))
+	}
+	if f.Action != "" {
+		preds = append(preds, auditlog.ActionEQ(f.Action))
+	}
+	if f.Actor != "" {
+		preds = append(preds, auditlog.ActorEQ(f.Actor))
+	}
+	if f.ResourceType != "" {
+		preds = append(preds, auditlog.ResourceTypeEQ(f.ResourceType))
+	}
+	if f.ResourceID != "" {
+		preds = append(preds, auditlog.ResourceIDEQ(f.ResourceID))
+	}
+	return preds
+}
+
+// Export walks every audit record matching filter in (created_at, id) order
+// and hands them to fn in batches of ExportBatchSize. Keyset pagination keeps
+// memory flat and avoids OFFSET scans on large tables. Returning an error from
+// fn stops the export. truncated reports that MaxRows rows were exported and
+// more records match.
+func (l *Logger) Export(ctx context.Context, filter ExportFilter, fn func([]*ent.AuditLog) error) (truncated bool, err error) {
+	base := filter.Predicates()
+
+	var last *ent.AuditLog
+	exported := 0
+	for {
+		preds := base
+		if last != nil {
+			preds = append(preds[:len(preds):len(preds)], auditlog.Or(
+				auditlog.CreatedAtGT(last.CreatedAt),
+				auditlog.And(auditlog.CreatedAtEQ(last.CreatedAt), auditlog.IDGT(last.ID)),
+			))
+		}
+		limit := ExportBatchSize
+		if filter.MaxRows > 0 {
+			if exported >= filter.MaxRows {
+				more, err := l.client.AuditLog.Query().Where(preds...).Exist(ctx)
+				if err != nil {
+					return false, fmt.Errorf("check audit export remainder: %w", err)
+				}
+				return more, nil
+			}
+			limit = min(limit, filter.MaxRows-exported)
+		}
+		batch, err := l.client.AuditLog.Query().
+			Where(preds...).
+			Order(ent.Asc(auditlog.FieldCreatedAt), ent.Asc(auditlog.FieldID)).
+			Limit(limit).
+			All(ctx)
+		if err != nil {
+			return false, fmt.Errorf("query audit export batch: %w", err)
+		}
+		if len(batch) == 0 {
+			return false, nil
+		}
+		if err := fn(batch); err != nil {
+			return false, err
+		}
+		exported += len(batch)
+		if len(batch) < limit {
+			return false, nil
+		}
+		last = batch[len(batch)-1]
+	}
+}
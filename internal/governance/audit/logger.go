@@ -55,6 +55,18 @@ func (l *Logger) LogApproval(ctx context.Context, ticketID, decision, actor stri
 	})
 }
 
+// LogApprovalWithComment records an approval decision together with the
+// approver's optional comment.
+func (l *Logger) LogApprovalWithComment(ctx context.Context, ticketID, decision, actor, comment string) error {
+	details := map[string]interface{}{
+		"decision": decision,
+	}
+	if comment != "" {
+		details["comment"] = comment
+	}
+	return l.LogAction(ctx, "approval."+decision, "approval_ticket", ticketID, actor, details)
+}
+
 // LogVMOperation records a VM operation.
 func (l *Logger) LogVMOperation(ctx context.Context, operation, vmID, actor string) error {
 	return l.LogAction(ctx, "vm."+operation, "vm", vmID, actor, nil)
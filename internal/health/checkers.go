@@ -0,0 +1,142 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"kv-shepherd.io/shepherd/internal/provider"
+)
+
+// Names of the built-in checkers.
+const (
+	DatabaseCheckName = "database"
+	RiverCheckName    = "river_queue"
+	ClusterCheckName  = "kubevirt_clusters"
+)
+
+const (
+	// SlowDatabaseThreshold marks the database degraded when SELECT 1 is slower.
+	SlowDatabaseThreshold = 500 * time.Millisecond
+	// StuckJobAge is how long a River job may stay running before it counts
+	// as stuck.
+	StuckJobAge = 15 * time.Minute
+)
+
+var errPoolNotConfigured = errors.New("database pool is not configured")
+
+// DatabaseChecker runs SELECT 1 against the pool.
+type DatabaseChecker struct {
+	pool *pgxpool.Pool
+}
+
+// NewDatabaseChecker creates a DatabaseChecker; a nil pool reports down.
+func NewDatabaseChecker(pool *pgxpool.Pool) *DatabaseChecker {
+	return &DatabaseChecker{pool: pool}
+}
+
+// Name implements Checker.
+func (d *DatabaseChecker) Name() string { return DatabaseCheckName }
+
+// Check implements Checker.
+func (d *DatabaseChecker) Check(ctx context.Context) error {
+	if d.pool == nil {
+		return errPoolNotConfigured
+	}
+	started := time.Now()
+	var one int
+	if err := d.pool.QueryRow(ctx, "SELECT 1").Scan(&one); err != nil {
+		return err
+	}
+	if time.Since(started) > SlowDatabaseThreshold {
+		return &Error{Degraded: true, Message: "database responded slowly"}
+	}
+	return nil
+}
+
+// RiverChecker reports River jobs that have been running for longer than
+// StuckJobAge, e.g. after a worker crashed mid-job.
+type RiverChecker struct {
+	pool *pgxpool.Pool
+}
+
+// NewRiverChecker creates a RiverChecker; a nil pool reports down.
+func NewRiverChecker(pool *pgxpool.Pool) *RiverChecker {
+	return &RiverChecker{pool: pool}
+}
+
+// Name implements Checker.
+func (r *RiverChecker) Name() string { return RiverCheckName }
+
+// Check implements Checker.
+func (r *RiverChecker) Check(ctx context.Context) error {
+	if r.pool == nil {
+		return errPoolNotConfigured
+	}
+	var stuck int
+	if err := r.pool.QueryRow(ctx,
+		`SELECT count(*) FROM river_job WHERE state = 'running' AND attempted_at < now() - make_interval(secs => $1)`,
+		StuckJobAge.Seconds(),
+	).Scan(&stuck); err != nil {
+		return err
+	}
+	if stuck > 0 {
+		return &Error{
+			Degraded: true,
+			Message:  fmt.Sprintf("%d job(s) running for more than %s", stuck, StuckJobAge),
+			Details:  map[string]string{"stuck_jobs": strconv.Itoa(stuck)},
+		}
+	}
+	return nil
+}
+
+// ClusterChecker summarizes the cached cluster health results: degraded
+// when any checked cluster is unhealthy, down when all of them are.
+// Clusters that have not been checked yet are reported but not counted.
+// No cluster is probed.
+type ClusterChecker struct {
+	cache *provider.ClusterHealthChecker
+}
+
+// NewClusterChecker creates a ClusterChecker; a nil cache reports ok.
+func NewClusterChecker(cache *provider.ClusterHealthChecker) *ClusterChecker {
+	return &ClusterChecker{cache: cache}
+}
+
+// Name implements Checker.
+func (c *ClusterChecker) Name() string { return ClusterCheckName }
+
+// Check implements Checker.
+func (c *ClusterChecker) Check(context.Context) error {
+	if c.cache == nil {
+		return nil
+	}
+	results := c.cache.Results()
+	details := make(map[string]string, len(results))
+	checked, unhealthy := 0, 0
+	for _, result := range results {
+		details[result.ClusterName] = string(result.Status)
+		switch result.Status {
+		case provider.ClusterStatusHealthy:
+			checked++
+		case provider.ClusterStatusUnhealthy, provider.ClusterStatusUnreachable:
+			checked++
+			unhealthy++
+		}
+	}
+	switch {
+	case unhealthy > 0 && unhealthy == checked:
+		return &Error{Message: "no cluster is healthy", Details: details}
+	case unhealthy > 0:
+		return &Error{
+			Degraded: true,
+			Message:  fmt.Sprintf("%d of %d clusters are unhealthy", unhealthy, checked),
+			Details:  details,
+		}
+	}
+	return nil
+}
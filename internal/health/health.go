@@ -0,0 +1,153 @@
+// Package health runs the application's component health checks. Checkers
+// are registered at startup; a Registry runs them concurrently under one
+// timeout and reports each component's status.
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTimeout bounds a whole Registry run unless NewRegistry is given one.
+const DefaultTimeout = 2 * time.Second
+
+// Component statuses, from best to worst.
+const (
+	StatusOK       = "ok"
+	StatusDegraded = "degraded"
+	StatusDown     = "down"
+)
+
+// Checker checks one component. A nil error reports it ok; an *Error can
+// report it degraded or attach details, any other error reports it down.
+type Checker interface {
+	Name() string
+	Check(ctx context.Context) error
+}
+
+// Error is a failed check with its status and per-item details.
+type Error struct {
+	// Degraded reports the component degraded rather than down.
+	Degraded bool
+	Message  string
+	// Details is per-item status, e.g. cluster name to cluster status.
+	Details map[string]string
+}
+
+func (e *Error) Error() string { return e.Message }
+
+// CheckResult is the outcome of one check.
+type CheckResult struct {
+	Status    string            `json:"status"`
+	LatencyMs int64             `json:"latency_ms"`
+	Message   string            `json:"message,omitempty"`
+	Details   map[string]string `json:"details,omitempty"`
+}
+
+// Report holds the result of every registered check, keyed by checker name.
+type Report struct {
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+// Registry holds the registered checkers.
+type Registry struct {
+	mu       sync.RWMutex
+	checkers []Checker
+	names    map[string]bool
+	timeout  time.Duration
+}
+
+// NewRegistry creates an empty Registry whose runs are bounded by timeout;
+// non-positive timeout uses DefaultTimeout.
+func NewRegistry(timeout time.Duration) *Registry {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Registry{names: map[string]bool{}, timeout: timeout}
+}
+
+// Register adds checker. Duplicate names are rejected.
+func (r *Registry) Register(checker Checker) error {
+	if checker == nil {
+		return fmt.Errorf("checker is nil")
+	}
+	name := strings.TrimSpace(checker.Name())
+	if name == "" {
+		return fmt.Errorf("checker name is empty")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.names[name] {
+		return fmt.Errorf("checker already registered: %s", name)
+	}
+	r.names[name] = true
+	r.checkers = append(r.checkers, checker)
+	return nil
+}
+
+// Run runs every check concurrently. Checks still running when the timeout
+// expires are reported down without waiting for them to return.
+func (r *Registry) Run(ctx context.Context) Report {
+	r.mu.RLock()
+	checkers := append([]Checker(nil), r.checkers...)
+	r.mu.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	type namedResult struct {
+		name   string
+		result CheckResult
+	}
+	// Buffered so checks that outlive the timeout can still send and exit.
+	results := make(chan namedResult, len(checkers))
+	started := time.Now()
+	for _, checker := range checkers {
+		go func() { //nolint:naked-goroutine // one per registered check, bounded by the run timeout
+			checkStarted := time.Now()
+			result := resultOf(checker.Check(ctx))
+			result.LatencyMs = time.Since(checkStarted).Milliseconds()
+			results <- namedResult{name: strings.TrimSpace(checker.Name()), result: result}
+		}()
+	}
+
+	report := Report{Checks: make(map[string]CheckResult, len(checkers))}
+	for range checkers {
+		select {
+		case res := <-results:
+			report.Checks[res.name] = res.result
+		case <-ctx.Done():
+			for _, checker := range checkers {
+				name := strings.TrimSpace(checker.Name())
+				if _, done := report.Checks[name]; !done {
+					report.Checks[name] = CheckResult{
+						Status:    StatusDown,
+						LatencyMs: time.Since(started).Milliseconds(),
+						Message:   "check timed out",
+					}
+				}
+			}
+			return report
+		}
+	}
+	return report
+}
+
+func resultOf(err error) CheckResult {
+	if err == nil {
+		return CheckResult{Status: StatusOK}
+	}
+	var checkErr *Error
+	if errors.As(err, &checkErr) {
+		status := StatusDown
+		if checkErr.Degraded {
+			status = StatusDegraded
+		}
+		return CheckResult{Status: status, Message: checkErr.Message, Details: checkErr.Details}
+	}
+	return CheckResult{Status: StatusDown, Message: err.Error()}
+}
@@ -0,0 +1,142 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"kv-shepherd.io/shepherd/internal/provider"
+)
+
+type funcChecker struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+func (f funcChecker) Name() string                    { return f.name }
+func (f funcChecker) Check(ctx context.Context) error { return f.check(ctx) }
+
+func TestRegistry_Register(t *testing.T) {
+	t.Parallel()
+
+	r := NewRegistry(0)
+	ok := funcChecker{name: "database", check: func(context.Context) error { return nil }}
+	if err := r.Register(ok); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	if err := r.Register(ok); err == nil {
+		t.Fatal("duplicate Register() error = nil, want rejection")
+	}
+	if err := r.Register(funcChecker{name: " "}); err == nil {
+		t.Fatal("Register() of an unnamed checker error = nil, want rejection")
+	}
+	if err := r.Register(nil); err == nil {
+		t.Fatal("Register(nil) error = nil, want rejection")
+	}
+}
+
+func TestRegistry_RunConcurrentlyWithSharedTimeout(t *testing.T) {
+	t.Parallel()
+
+	r := NewRegistry(200 * time.Millisecond)
+	// Each check waits for the others, so only concurrent runs finish.
+	barrier := make(chan struct{}, 3)
+	waitForAll := func(ctx context.Context) error {
+		barrier <- struct{}{}
+		for len(barrier) < 3 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Millisecond):
+			}
+		}
+		return nil
+	}
+	blocked := make(chan struct{})
+	defer close(blocked)
+	for _, checker := range []Checker{
+		funcChecker{name: "ok", check: waitForAll},
+		funcChecker{name: "degraded", check: func(ctx context.Context) error {
+			if err := waitForAll(ctx); err != nil {
+				return err
+			}
+			return &Error{Degraded: true, Message: "slow", Details: map[string]string{"stuck_jobs": "2"}}
+		}},
+		funcChecker{name: "down", check: func(ctx context.Context) error {
+			if err := waitForAll(ctx); err != nil {
+				return err
+			}
+			return errors.New("connection refused")
+		}},
+		// Ignores ctx; the run must not wait for it.
+		funcChecker{name: "hung", check: func(context.Context) error { <-blocked; return nil }},
+	} {
+		if err := r.Register(checker); err != nil {
+			t.Fatalf("Register() error = %v", err)
+		}
+	}
+
+	started := time.Now()
+	report := r.Run(t.Context())
+	if elapsed := time.Since(started); elapsed > time.Second {
+		t.Fatalf("Run() took %s, want it bounded by the 200ms timeout", elapsed)
+	}
+	want := map[string]string{"ok": StatusOK, "degraded": StatusDegraded, "down": StatusDown, "hung": StatusDown}
+	for name, status := range want {
+		if got := report.Checks[name].Status; got != status {
+			t.Errorf("%s status = %q, want %q (report %+v)", name, got, status, report.Checks)
+		}
+	}
+	if got := report.Checks["degraded"]; got.Message != "slow" || got.Details["stuck_jobs"] != "2" {
+		t.Errorf("degraded result = %+v, want message and details from the *Error", got)
+	}
+	if got := report.Checks["down"].Message; got != "connection refused" {
+		t.Errorf("down message = %q", got)
+	}
+	if got := report.Checks["hung"]; got.Message != "check timed out" || got.LatencyMs < 200 {
+		t.Errorf("hung result = %+v, want timed out after 200ms", got)
+	}
+}
+
+func TestClusterChecker(t *testing.T) {
+	t.Parallel()
+
+	cache := provider.NewClusterHealthChecker(nil, time.Minute)
+	checker := NewClusterChecker(cache)
+	ctx := t.Context()
+
+	if err := checker.Check(ctx); err != nil {
+		t.Fatalf("empty cache error = %v, want ok", err)
+	}
+
+	cache.UpdateHealth(&provider.ClusterHealth{ClusterName: "c-1", Status: provider.ClusterStatusHealthy})
+	cache.UpdateHealth(&provider.ClusterHealth{ClusterName: "c-2", Status: provider.ClusterStatusUnreachable})
+	cache.UpdateHealth(&provider.ClusterHealth{ClusterName: "c-3", Status: provider.ClusterStatusUnknown})
+	got := resultOf(checker.Check(ctx))
+	if got.Status != StatusDegraded {
+		t.Fatalf("one unhealthy cluster status = %s, want degraded", got.Status)
+	}
+	if got.Details["c-2"] != "UNREACHABLE" || got.Details["c-3"] != "UNKNOWN" {
+		t.Fatalf("details = %v, want per-cluster status", got.Details)
+	}
+
+	cache.UpdateHealth(&provider.ClusterHealth{ClusterName: "c-1", Status: provider.ClusterStatusUnhealthy})
+	if got := resultOf(checker.Check(ctx)); got.Status != StatusDown {
+		t.Fatalf("all unhealthy status = %s, want down", got.Status)
+	}
+
+	if err := NewClusterChecker(nil).Check(ctx); err != nil {
+		t.Fatalf("nil cache error = %v, want ok", err)
+	}
+}
+
+func TestDatabaseAndRiverCheckers_WithoutPool(t *testing.T) {
+	t.Parallel()
+
+	for _, checker := range []Checker{NewDatabaseChecker(nil), NewRiverChecker(nil)} {
+		if got := resultOf(checker.Check(t.Context())); got.Status != StatusDown || got.Message != "database pool is not configured" {
+			t.Errorf("%s without pool = %+v, want down", checker.Name(), got)
+		}
+	}
+}
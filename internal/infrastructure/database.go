@@ -20,12 +20,14 @@ import (
 	"github.com/riverqueue/river"
 	"github.com/riverqueue/river/riverdriver/riverpgxv5"
 	"github.com/riverqueue/river/rivermigrate"
+	"github.com/riverqueue/river/rivertype"
 	"go.uber.org/zap"
 
 	"kv-shepherd.io/shepherd/ent"
 	entmigrate "kv-shepherd.io/shepherd/ent/migrate"
 	"kv-shepherd.io/shepherd/internal/config"
 	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/pkg/metrics"
 )
 
 // DatabaseClients contains all database-related clients.
@@ -162,6 +164,7 @@ func (c *DatabaseClients) InitRiverClient(workers *river.Workers, cfg config.Riv
 		},
 		Workers:                     workers,
 		CompletedJobRetentionPeriod: cfg.CompletedJobRetentionPeriod,
+		Middleware:                  []rivertype.Middleware{metrics.NewJobMiddleware()},
 	})
 	if err != nil {
 		return fmt.Errorf("create river client: %w", err)
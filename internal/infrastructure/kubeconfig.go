@@ -0,0 +1,21 @@
+package infrastructure
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DecryptKubeconfig returns the plaintext kubeconfig for a stored cluster credential.
+//
+// V1 stores kubeconfig as plaintext with an empty encryption_key_id
+// (see server_admin.go). Rows carrying a key ID were written by a
+// Phase 2 AES-256-GCM writer and cannot be read by this build.
+func DecryptKubeconfig(stored []byte, encryptionKeyID string) ([]byte, error) {
+	if len(stored) == 0 {
+		return nil, fmt.Errorf("kubeconfig is empty")
+	}
+	if keyID := strings.TrimSpace(encryptionKeyID); keyID != "" {
+		return nil, fmt.Errorf("kubeconfig encrypted with key %q: decryption not supported", keyID)
+	}
+	return stored, nil
+}
@@ -0,0 +1,176 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/auditlog"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+const (
+	// AuditRetentionInterval is the periodic schedule for audit log retention.
+	AuditRetentionInterval = 24 * time.Hour
+	// MinAuditRetentionDays is the hard floor for audit log retention. A
+	// shorter configured retention is raised to it.
+	MinAuditRetentionDays = 30
+	// AuditRetentionBatchSize bounds how many rows one delete statement
+	// removes, keeping row locks short.
+	AuditRetentionBatchSize = 5000
+	// AuditRetentionBatchPause is the breather between delete batches so
+	// retention does not monopolize the audit table.
+	AuditRetentionBatchPause = 200 * time.Millisecond
+)
+
+// AuditRetentionArgs is a periodic maintenance job that deletes, and
+// optionally archives, audit logs past the retention window.
+type AuditRetentionArgs struct{}
+
+// Kind returns the job kind identifier for audit log retention.
+func (AuditRetentionArgs) Kind() string { return "audit_retention" }
+
+// InsertOpts ensures at most one retention job is enqueued per interval.
+func (AuditRetentionArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       river.QueueDefault,
+		MaxAttempts: 1,
+		UniqueOpts: river.UniqueOpts{
+			ByPeriod: AuditRetentionInterval,
+			ByQueue:  true,
+			ByArgs:   true,
+		},
+	}
+}
+
+// AuditRetentionWorker deletes audit logs older than the retention window in
+// batches of AuditRetentionBatchSize, oldest first, pausing between batches.
+// With an archive sink each batch is written and flushed to the sink before
+// it is deleted; a sink failure stops the run with the batch still in the
+// table. Every run that archived or deleted rows leaves a summary audit entry.
+type AuditRetentionWorker struct {
+	river.WorkerDefaults[AuditRetentionArgs]
+	entClient     *ent.Client
+	auditLogger   *audit.Logger
+	sink          audit.ArchiveSink
+	retentionDays int
+	batchSize     int
+	batchPause    time.Duration
+}
+
+// NewAuditRetentionWorker creates a retention worker (ADR-0013 manual DI).
+// sink may be nil to delete without archiving.
+func NewAuditRetentionWorker(entClient *ent.Client, auditLogger *audit.Logger, sink audit.ArchiveSink, retentionDays int) *AuditRetentionWorker {
+	return &AuditRetentionWorker{
+		entClient:     entClient,
+		auditLogger:   auditLogger,
+		sink:          sink,
+		retentionDays: retentionDays,
+		batchSize:     AuditRetentionBatchSize,
+		batchPause:    AuditRetentionBatchPause,
+	}
+}
+
+// Work removes audit logs created before now minus the effective retention.
+func (w *AuditRetentionWorker) Work(ctx context.Context, _ *river.Job[AuditRetentionArgs]) error {
+	if w == nil || w.entClient == nil {
+		return fmt.Errorf("audit retention worker is not initialized")
+	}
+
+	days := w.retentionDays
+	if days < MinAuditRetentionDays {
+		logger.Warn("audit retention below the minimum, using the minimum",
+			zap.Int("configured_days", w.retentionDays),
+			zap.Int("minimum_days", MinAuditRetentionDays),
+		)
+		days = MinAuditRetentionDays
+	}
+
+	started := time.Now()
+	cutoff := started.UTC().AddDate(0, 0, -days)
+	archived, deleted, err := w.purge(ctx, cutoff)
+	duration := time.Since(started)
+
+	fields := []zap.Field{
+		zap.Int("archived_rows", archived),
+		zap.Int("deleted_rows", deleted),
+		zap.Duration("duration", duration),
+		zap.String("cutoff", cutoff.Format(time.RFC3339)),
+	}
+	if (archived > 0 || deleted > 0) && w.auditLogger != nil {
+		_ = w.auditLogger.LogAction(ctx, "audit.retention", "audit_log", cutoff.Format(time.RFC3339), "system", map[string]interface{}{
+			"retention_days": days,
+			"archived_rows":  archived,
+			"deleted_rows":   deleted,
+			"duration_ms":    duration.Milliseconds(),
+			"completed":      err == nil,
+		})
+	}
+	if err != nil {
+		logger.Error("audit retention stopped", append(fields, zap.Error(err))...)
+		return err
+	}
+	logger.Info("audit retention completed", fields...)
+	return nil
+}
+
+// purge archives and deletes rows created before cutoff, batch by batch.
+func (w *AuditRetentionWorker) purge(ctx context.Context, cutoff time.Time) (archived, deleted int, err error) {
+	for {
+		var ids []string
+		if w.sink != nil {
+			batch, err := w.expiredBatch(cutoff).All(ctx)
+			if err != nil {
+				return archived, deleted, fmt.Errorf("load expired audit logs: %w", err)
+			}
+			if len(batch) == 0 {
+				return archived, deleted, nil
+			}
+			if err := w.sink.Write(ctx, batch); err != nil {
+				return archived, deleted, fmt.Errorf("archive audit logs: %w", err)
+			}
+			if err := w.sink.Flush(ctx); err != nil {
+				return archived, deleted, fmt.Errorf("flush audit archive: %w", err)
+			}
+			archived += len(batch)
+			ids = make([]string, len(batch))
+			for i, l := range batch {
+				ids[i] = l.ID
+			}
+		} else {
+			if ids, err = w.expiredBatch(cutoff).IDs(ctx); err != nil {
+				return archived, deleted, fmt.Errorf("load expired audit log ids: %w", err)
+			}
+			if len(ids) == 0 {
+				return archived, deleted, nil
+			}
+		}
+
+		n, err := w.entClient.AuditLog.Delete().Where(auditlog.IDIn(ids...)).Exec(ctx)
+		if err != nil {
+			return archived, deleted, fmt.Errorf("delete expired audit logs: %w", err)
+		}
+		deleted += n
+		if len(ids) < w.batchSize {
+			return archived, deleted, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return archived, deleted, ctx.Err()
+		case <-time.After(w.batchPause):
+		}
+	}
+}
+
+func (w *AuditRetentionWorker) expiredBatch(cutoff time.Time) *ent.AuditLogQuery {
+	return w.entClient.AuditLog.Query().
+		Where(auditlog.CreatedAtLT(cutoff)).
+		Order(ent.Asc(auditlog.FieldCreatedAt), ent.Asc(auditlog.FieldID)).
+		Limit(w.batchSize)
+}
@@ -0,0 +1,146 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/riverqueue/river"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/auditlog"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+// fakeArchiveSink records flushed batches; flushErr fails every Flush.
+type fakeArchiveSink struct {
+	pending  []string
+	flushed  []string
+	flushes  int
+	flushErr error
+}
+
+func (s *fakeArchiveSink) Write(_ context.Context, batch []*ent.AuditLog) error {
+	for _, l := range batch {
+		s.pending = append(s.pending, l.ID)
+	}
+	return nil
+}
+
+func (s *fakeArchiveSink) Flush(context.Context) error {
+	if s.flushErr != nil {
+		return s.flushErr
+	}
+	s.flushes++
+	s.flushed = append(s.flushed, s.pending...)
+	s.pending = nil
+	return nil
+}
+
+// seedAuditRetentionRows creates n audit rows created ageDays days ago.
+func seedAuditRetentionRows(t *testing.T, client *ent.Client, prefix string, n, ageDays int) {
+	t.Helper()
+
+	createdAt := time.Now().UTC().AddDate(0, 0, -ageDays)
+	builders := make([]*ent.AuditLogCreate, 0, n)
+	for i := 0; i < n; i++ {
+		builders = append(builders, client.AuditLog.Create().
+			SetID(fmt.Sprintf("%s-%02d", prefix, i)).
+			SetAction("seed."+prefix).
+			SetResourceType("vm").
+			SetResourceID(fmt.Sprintf("vm-%d", i)).
+			SetActor("admin-1").
+			SetCreatedAt(createdAt.Add(time.Duration(i)*time.Second)))
+	}
+	if _, err := client.AuditLog.CreateBulk(builders...).Save(t.Context()); err != nil {
+		t.Fatalf("seed audit logs: %v", err)
+	}
+}
+
+func TestAuditRetentionArgsInsertOpts(t *testing.T) {
+	t.Parallel()
+
+	if got := (AuditRetentionArgs{}).Kind(); got != "audit_retention" {
+		t.Fatalf("Kind() = %q, want %q", got, "audit_retention")
+	}
+	opts := (AuditRetentionArgs{}).InsertOpts()
+	if opts.MaxAttempts != 1 || opts.UniqueOpts.ByPeriod != AuditRetentionInterval {
+		t.Fatalf("InsertOpts() = %+v, want one attempt unique per %s", opts, AuditRetentionInterval)
+	}
+}
+
+func TestAuditRetentionWorker_ArchivesThenDeletesInBatches(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "audit_retention_archive")
+	seedAuditRetentionRows(t, client, "old", 7, 100)
+	seedAuditRetentionRows(t, client, "recent", 3, 10)
+
+	sink := &fakeArchiveSink{}
+	worker := NewAuditRetentionWorker(client, audit.NewLogger(client), sink, 90)
+	worker.batchSize, worker.batchPause = 3, 0
+
+	if err := worker.Work(t.Context(), &river.Job[AuditRetentionArgs]{}); err != nil {
+		t.Fatalf("Work() error = %v", err)
+	}
+
+	if len(sink.flushed) != 7 || sink.flushes != 3 || len(sink.pending) != 0 {
+		t.Fatalf("sink flushed %d rows in %d flushes (%d pending), want 7 in 3", len(sink.flushed), sink.flushes, len(sink.pending))
+	}
+	if n := client.AuditLog.Query().Where(auditlog.ActionEQ("seed.old")).CountX(t.Context()); n != 0 {
+		t.Fatalf("expired rows left = %d, want 0", n)
+	}
+	if n := client.AuditLog.Query().Where(auditlog.ActionEQ("seed.recent")).CountX(t.Context()); n != 3 {
+		t.Fatalf("recent rows left = %d, want 3", n)
+	}
+	summary := client.AuditLog.Query().Where(auditlog.ActionEQ("audit.retention")).OnlyX(t.Context())
+	if summary.Details["archived_rows"] != float64(7) || summary.Details["deleted_rows"] != float64(7) {
+		t.Fatalf("summary details = %v, want 7 archived and 7 deleted", summary.Details)
+	}
+}
+
+func TestAuditRetentionWorker_KeepsRowsWhenFlushFails(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "audit_retention_flush_fail")
+	seedAuditRetentionRows(t, client, "old", 4, 100)
+
+	sink := &fakeArchiveSink{flushErr: errors.New("disk full")}
+	worker := NewAuditRetentionWorker(client, audit.NewLogger(client), sink, 90)
+	worker.batchPause = 0
+
+	if err := worker.Work(t.Context(), &river.Job[AuditRetentionArgs]{}); err == nil {
+		t.Fatal("Work() error = nil, want flush failure")
+	}
+	if n := client.AuditLog.Query().Where(auditlog.ActionEQ("seed.old")).CountX(t.Context()); n != 4 {
+		t.Fatalf("rows left after failed flush = %d, want 4", n)
+	}
+	if n := client.AuditLog.Query().Where(auditlog.ActionEQ("audit.retention")).CountX(t.Context()); n != 0 {
+		t.Fatalf("summary entries = %d, want none when nothing was removed", n)
+	}
+}
+
+func TestAuditRetentionWorker_EnforcesMinimumRetention(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "audit_retention_floor")
+	seedAuditRetentionRows(t, client, "old", 2, MinAuditRetentionDays+5)
+	seedAuditRetentionRows(t, client, "young", 2, MinAuditRetentionDays-5)
+
+	// A misconfigured one-day retention must not reach inside the floor.
+	worker := NewAuditRetentionWorker(client, nil, nil, 1)
+	worker.batchPause = 0
+	if err := worker.Work(t.Context(), &river.Job[AuditRetentionArgs]{}); err != nil {
+		t.Fatalf("Work() error = %v", err)
+	}
+
+	if n := client.AuditLog.Query().Where(auditlog.ActionEQ("seed.old")).CountX(t.Context()); n != 0 {
+		t.Fatalf("rows past the floor left = %d, want 0", n)
+	}
+	if n := client.AuditLog.Query().Where(auditlog.ActionEQ("seed.young")).CountX(t.Context()); n != 2 {
+		t.Fatalf("rows inside the floor left = %d, want 2", n)
+	}
+}
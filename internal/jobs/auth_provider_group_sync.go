@@ -0,0 +1,337 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/authprovider"
+	"kv-shepherd.io/shepherd/ent/idpsyncedgroup"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/provider"
+)
+
+const (
+	// AuthProviderGroupSyncInterval is how often sync schedules are evaluated,
+	// and the shortest interval a sync schedule may use.
+	AuthProviderGroupSyncInterval = time.Minute
+	// AuthProviderGroupSyncTimeout bounds one provider's group fetch and apply.
+	AuthProviderGroupSyncTimeout = 2 * time.Minute
+	// AuthProviderGroupSyncMaxBackoff caps the retry delay after failures.
+	AuthProviderGroupSyncMaxBackoff = time.Hour
+
+	// defaultGroupSourceField is recorded for new groups whose adapter does
+	// not name a source field.
+	defaultGroupSourceField = "groups"
+)
+
+// ParseAuthProviderSyncSchedule parses a sync schedule: a Go duration such
+// as "6h", or a standard 5-field cron expression (descriptors like
+// "@every 6h" included).
+func ParseAuthProviderSyncSchedule(expr string) (cron.Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if d, err := time.ParseDuration(expr); err == nil {
+		if d < AuthProviderGroupSyncInterval {
+			return nil, fmt.Errorf("sync interval must be at least %s", AuthProviderGroupSyncInterval)
+		}
+		return cron.Every(d), nil
+	}
+	schedule, err := cron.ParseStandard(expr)
+	if err != nil {
+		return nil, fmt.Errorf("sync_schedule must be a duration or a cron expression: %w", err)
+	}
+	return schedule, nil
+}
+
+// NextAuthProviderGroupSync returns when a provider's groups are next due
+// for sync: the pending retry after a failure, otherwise the schedule's next
+// fire time after the last attempt (or creation).
+func NextAuthProviderGroupSync(p *ent.AuthProvider) (time.Time, error) {
+	schedule, err := ParseAuthProviderSyncSchedule(p.SyncSchedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if p.SyncRetryAt != nil {
+		return *p.SyncRetryAt, nil
+	}
+	from := p.CreatedAt
+	if p.LastSyncAt != nil {
+		from = *p.LastSyncAt
+	}
+	return schedule.Next(from.UTC()), nil
+}
+
+// authProviderSyncBackoff doubles from one minute per consecutive failure.
+func authProviderSyncBackoff(failures int) time.Duration {
+	backoff := AuthProviderGroupSyncInterval
+	for i := 1; i < failures && backoff < AuthProviderGroupSyncMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, AuthProviderGroupSyncMaxBackoff)
+}
+
+// AuthProviderGroupSyncSummary counts the changes made by one group sync.
+type AuthProviderGroupSyncSummary struct {
+	Added   int
+	Updated int
+	// Stale is the number of known groups missing from the fetched set.
+	Stale int
+}
+
+// AuthProviderGroupSyncArgs is a periodic job that re-syncs the groups of
+// providers whose sync schedule is due.
+type AuthProviderGroupSyncArgs struct{}
+
+// Kind returns the job kind identifier for auth provider group sync.
+func (AuthProviderGroupSyncArgs) Kind() string { return "auth_provider_group_sync" }
+
+// InsertOpts ensures at most one evaluation job is enqueued per interval.
+func (AuthProviderGroupSyncArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       river.QueueDefault,
+		MaxAttempts: 1,
+		UniqueOpts: river.UniqueOpts{
+			ByPeriod: AuthProviderGroupSyncInterval,
+			ByQueue:  true,
+			ByArgs:   true,
+		},
+	}
+}
+
+// AuthProviderGroupSyncWorker re-fetches the group set of enabled providers
+// with a due sync_schedule. Groups that vanished from the IdP are marked
+// stale, not deleted. A failing provider is retried with backoff and never
+// stops the others from syncing.
+type AuthProviderGroupSyncWorker struct {
+	river.WorkerDefaults[AuthProviderGroupSyncArgs]
+	entClient *ent.Client
+	resolve   func(authType string) provider.AuthProviderAdminAdapter
+	now       func() time.Time
+}
+
+// NewAuthProviderGroupSyncWorker creates an AuthProviderGroupSyncWorker (ADR-0013 manual DI).
+func NewAuthProviderGroupSyncWorker(entClient *ent.Client) *AuthProviderGroupSyncWorker {
+	return &AuthProviderGroupSyncWorker{
+		entClient: entClient,
+		resolve:   provider.ResolveAuthProviderAdminAdapter,
+		now:       time.Now,
+	}
+}
+
+// Timeout leaves room for several providers to sync in one run.
+func (w *AuthProviderGroupSyncWorker) Timeout(*river.Job[AuthProviderGroupSyncArgs]) time.Duration {
+	return 10 * time.Minute
+}
+
+// Work syncs every provider whose schedule is due.
+func (w *AuthProviderGroupSyncWorker) Work(ctx context.Context, _ *river.Job[AuthProviderGroupSyncArgs]) error {
+	if w == nil || w.entClient == nil {
+		return fmt.Errorf("auth provider group sync worker is not initialized")
+	}
+
+	providers, err := w.entClient.AuthProvider.Query().
+		Where(
+			authprovider.EnabledEQ(true),
+			authprovider.SyncScheduleNEQ(""),
+		).
+		Order(ent.Asc(authprovider.FieldID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query auth providers with sync schedules: %w", err)
+	}
+
+	now := w.now().UTC()
+	synced, failed := 0, 0
+	for _, p := range providers {
+		due, err := NextAuthProviderGroupSync(p)
+		if err != nil {
+			logger.Warn("auth provider has invalid sync schedule",
+				zap.String("provider_id", p.ID),
+				zap.String("sync_schedule", p.SyncSchedule),
+				zap.Error(err),
+			)
+			continue
+		}
+		if due.After(now) {
+			continue
+		}
+		lister, ok := w.resolve(p.AuthType).(provider.AuthProviderGroupLister)
+		if !ok {
+			continue
+		}
+		switch err := w.syncProvider(ctx, p, lister, now); {
+		case errors.Is(err, provider.ErrAuthProviderGroupListingUnsupported):
+		case err != nil:
+			failed++
+		default:
+			synced++
+		}
+	}
+
+	logger.Info("auth provider group sync completed",
+		zap.Int("providers", len(providers)),
+		zap.Int("synced", synced),
+		zap.Int("failed", failed),
+	)
+	return nil
+}
+
+// syncProvider syncs one provider and records the outcome on it.
+func (w *AuthProviderGroupSyncWorker) syncProvider(ctx context.Context, p *ent.AuthProvider, lister provider.AuthProviderGroupLister, now time.Time) error {
+	started := w.now()
+	summary, err := w.fetchAndApply(ctx, p, lister, now)
+	if errors.Is(err, provider.ErrAuthProviderGroupListingUnsupported) {
+		return err
+	}
+	duration := w.now().Sub(started)
+
+	update := w.entClient.AuthProvider.UpdateOneID(p.ID).
+		SetLastSyncAt(now).
+		SetLastSyncDurationMs(duration.Milliseconds())
+	if err != nil {
+		failures := p.SyncFailures + 1
+		retryAt := now.Add(authProviderSyncBackoff(failures))
+		// A retry never waits past the next regular run.
+		if schedule, parseErr := ParseAuthProviderSyncSchedule(p.SyncSchedule); parseErr == nil {
+			if next := schedule.Next(now); next.Before(retryAt) {
+				retryAt = next
+			}
+		}
+		logger.Warn("auth provider group sync failed",
+			zap.String("provider_id", p.ID),
+			zap.Int("failures", failures),
+			zap.Time("retry_at", retryAt),
+			zap.Error(err),
+		)
+		update.SetLastSyncError(err.Error()).
+			SetSyncFailures(failures).
+			SetSyncRetryAt(retryAt)
+	} else {
+		update.SetLastSyncAdded(summary.Added).
+			SetLastSyncUpdated(summary.Updated).
+			SetLastSyncStale(summary.Stale).
+			ClearLastSyncError().
+			SetSyncFailures(0).
+			ClearSyncRetryAt()
+	}
+	if saveErr := update.Exec(ctx); saveErr != nil {
+		logger.Error("failed to record auth provider group sync",
+			zap.String("provider_id", p.ID),
+			zap.Error(saveErr),
+		)
+	}
+	return err
+}
+
+// fetchAndApply lists the provider's groups and applies them. Adapter panics
+// are turned into errors so one plugin cannot take down the worker.
+func (w *AuthProviderGroupSyncWorker) fetchAndApply(ctx context.Context, p *ent.AuthProvider, lister provider.AuthProviderGroupLister, now time.Time) (summary AuthProviderGroupSyncSummary, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("group listing panicked: %v", r)
+		}
+	}()
+	ctx, cancel := context.WithTimeout(ctx, AuthProviderGroupSyncTimeout)
+	defer cancel()
+
+	groups, err := lister.ListGroups(ctx, p.Config)
+	if err != nil {
+		return summary, err
+	}
+	return applyAuthProviderGroups(ctx, w.entClient, p.ID, groups, now)
+}
+
+// applyAuthProviderGroups upserts the fetched groups, revives stale ones that
+// reappeared and marks known groups missing from the set as stale.
+func applyAuthProviderGroups(ctx context.Context, client *ent.Client, providerID string, groups []provider.AuthProviderGroup, now time.Time) (AuthProviderGroupSyncSummary, error) {
+	var summary AuthProviderGroupSyncSummary
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return summary, fmt.Errorf("start group sync transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	existing, err := tx.IdPSyncedGroup.Query().
+		Where(idpsyncedgroup.ProviderIDEQ(providerID)).
+		All(ctx)
+	if err != nil {
+		return summary, fmt.Errorf("list synced groups: %w", err)
+	}
+	byExternalID := make(map[string]*ent.IdPSyncedGroup, len(existing))
+	for _, g := range existing {
+		byExternalID[g.ExternalGroupID] = g
+	}
+
+	seen := make(map[string]struct{}, len(groups))
+	for _, g := range groups {
+		if _, dup := seen[g.ID]; dup {
+			continue
+		}
+		seen[g.ID] = struct{}{}
+
+		row, ok := byExternalID[g.ID]
+		if !ok {
+			sourceField := g.SourceField
+			if sourceField == "" {
+				sourceField = defaultGroupSourceField
+			}
+			id, _ := uuid.NewV7()
+			if err := tx.IdPSyncedGroup.Create().
+				SetID(id.String()).
+				SetProviderID(providerID).
+				SetExternalGroupID(g.ID).
+				SetGroupName(g.Name).
+				SetDescription(g.Description).
+				SetSourceField(sourceField).
+				SetLastSyncedAt(now).
+				Exec(ctx); err != nil {
+				return summary, fmt.Errorf("create synced group %s: %w", g.ID, err)
+			}
+			summary.Added++
+			continue
+		}
+
+		update := row.Update().SetLastSyncedAt(now)
+		changed := row.Stale || row.GroupName != g.Name || row.Description != g.Description
+		if g.SourceField != "" && g.SourceField != row.SourceField {
+			update.SetSourceField(g.SourceField)
+			changed = true
+		}
+		if changed {
+			update.SetGroupName(g.Name).
+				SetDescription(g.Description).
+				SetStale(false).
+				ClearStaleSince()
+			summary.Updated++
+		}
+		if err := update.Exec(ctx); err != nil {
+			return summary, fmt.Errorf("update synced group %s: %w", g.ID, err)
+		}
+	}
+
+	for _, row := range existing {
+		if _, ok := seen[row.ExternalGroupID]; ok {
+			continue
+		}
+		summary.Stale++
+		if row.Stale {
+			continue
+		}
+		if err := row.Update().SetStale(true).SetStaleSince(now).Exec(ctx); err != nil {
+			return summary, fmt.Errorf("mark synced group %s stale: %w", row.ExternalGroupID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return summary, fmt.Errorf("commit group sync: %w", err)
+	}
+	return summary, nil
+}
@@ -0,0 +1,198 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/riverqueue/river"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/idpsyncedgroup"
+	"kv-shepherd.io/shepherd/internal/provider"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+type fakeGroupListerAdapter struct {
+	provider.AuthProviderAdminAdapter
+	groups []provider.AuthProviderGroup
+	err    error
+	panic  bool
+	calls  int
+}
+
+func (f *fakeGroupListerAdapter) ListGroups(context.Context, map[string]interface{}) ([]provider.AuthProviderGroup, error) {
+	f.calls++
+	if f.panic {
+		panic("plugin bug")
+	}
+	return f.groups, f.err
+}
+
+func TestParseAuthProviderSyncSchedule(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2026, 3, 2, 10, 15, 0, 0, time.UTC)
+	tests := []struct {
+		expr    string
+		want    time.Time
+		wantErr bool
+	}{
+		{expr: "6h", want: from.Add(6 * time.Hour)},
+		{expr: "@every 30m", want: from.Add(30 * time.Minute)},
+		{expr: "0 3 * * *", want: time.Date(2026, 3, 3, 3, 0, 0, 0, time.UTC)},
+		{expr: "30s", wantErr: true},
+		{expr: "hourly-ish", wantErr: true},
+	}
+	for _, tc := range tests {
+		schedule, err := ParseAuthProviderSyncSchedule(tc.expr)
+		if tc.wantErr {
+			if err == nil {
+				t.Fatalf("ParseAuthProviderSyncSchedule(%q) error = nil, want error", tc.expr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("ParseAuthProviderSyncSchedule(%q) error = %v", tc.expr, err)
+		}
+		if got := schedule.Next(from); !got.Equal(tc.want) {
+			t.Fatalf("ParseAuthProviderSyncSchedule(%q).Next = %v, want %v", tc.expr, got, tc.want)
+		}
+	}
+}
+
+func TestAuthProviderSyncBackoff(t *testing.T) {
+	t.Parallel()
+
+	want := map[int]time.Duration{1: time.Minute, 2: 2 * time.Minute, 4: 8 * time.Minute, 20: AuthProviderGroupSyncMaxBackoff}
+	for failures, backoff := range want {
+		if got := authProviderSyncBackoff(failures); got != backoff {
+			t.Fatalf("authProviderSyncBackoff(%d) = %v, want %v", failures, got, backoff)
+		}
+	}
+}
+
+func TestAuthProviderGroupSyncWorker(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "auth_provider_group_sync")
+	ctx := t.Context()
+	now := time.Now().UTC().Truncate(time.Second)
+	createdAt := now.Add(-2 * time.Hour)
+
+	client.AuthProvider.Create().
+		SetID("provider-sync").
+		SetName("corp-ldap").
+		SetAuthType("fake-sync").
+		SetConfig(map[string]interface{}{}).
+		SetSyncSchedule("1h").
+		SetCreatedBy("admin-1").
+		SetCreatedAt(createdAt).
+		ExecX(ctx)
+	client.AuthProvider.Create().
+		SetID("provider-broken").
+		SetName("broken-ldap").
+		SetAuthType("fake-broken").
+		SetConfig(map[string]interface{}{}).
+		SetSyncSchedule("1h").
+		SetCreatedBy("admin-1").
+		SetCreatedAt(createdAt).
+		ExecX(ctx)
+	client.AuthProvider.Create().
+		SetID("provider-manual").
+		SetName("manual-oidc").
+		SetAuthType("fake-sync").
+		SetConfig(map[string]interface{}{}).
+		SetCreatedBy("admin-1").
+		ExecX(ctx)
+	for _, g := range []struct{ id, name string }{{"devs", "Developers"}, {"ops", "Operations"}, {"legacy", "Legacy"}} {
+		client.IdPSyncedGroup.Create().
+			SetID("grp-" + g.id).
+			SetProviderID("provider-sync").
+			SetExternalGroupID(g.id).
+			SetGroupName(g.name).
+			SetSourceField("memberOf").
+			ExecX(ctx)
+	}
+
+	syncing := &fakeGroupListerAdapter{groups: []provider.AuthProviderGroup{
+		{ID: "devs", Name: "Developers"},
+		{ID: "ops", Name: "Platform Ops"},
+		{ID: "sre", Name: "SRE", SourceField: "memberOf"},
+	}}
+	broken := &fakeGroupListerAdapter{panic: true}
+	worker := NewAuthProviderGroupSyncWorker(client)
+	worker.now = func() time.Time { return now }
+	worker.resolve = func(authType string) provider.AuthProviderAdminAdapter {
+		switch authType {
+		case "fake-sync":
+			return syncing
+		case "fake-broken":
+			return broken
+		}
+		return nil
+	}
+
+	if err := worker.Work(ctx, &river.Job[AuthProviderGroupSyncArgs]{}); err != nil {
+		t.Fatalf("Work() error = %v", err)
+	}
+	if syncing.calls != 1 {
+		t.Fatalf("ListGroups calls = %d, want 1 (providers without a schedule are skipped)", syncing.calls)
+	}
+
+	synced := client.AuthProvider.GetX(ctx, "provider-sync")
+	if synced.LastSyncAt == nil || synced.LastSyncAdded != 1 || synced.LastSyncUpdated != 1 || synced.LastSyncStale != 1 || synced.LastSyncError != "" {
+		t.Fatalf("sync summary = %+v, want 1 added, 1 updated, 1 stale", synced)
+	}
+	groups := map[string]*ent.IdPSyncedGroup{}
+	for _, g := range client.IdPSyncedGroup.Query().Where(idpsyncedgroup.ProviderIDEQ("provider-sync")).AllX(ctx) {
+		groups[g.ExternalGroupID] = g
+	}
+	if len(groups) != 4 {
+		t.Fatalf("synced groups = %d, want stale group kept alongside 3 live ones", len(groups))
+	}
+	if !groups["legacy"].Stale || groups["legacy"].StaleSince == nil {
+		t.Fatalf("legacy group = %+v, want stale", groups["legacy"])
+	}
+	if groups["ops"].GroupName != "Platform Ops" || groups["ops"].Stale {
+		t.Fatalf("ops group = %+v, want renamed", groups["ops"])
+	}
+	if groups["sre"].SourceField != "memberOf" {
+		t.Fatalf("sre group source_field = %q, want memberOf", groups["sre"].SourceField)
+	}
+
+	failed := client.AuthProvider.GetX(ctx, "provider-broken")
+	if failed.SyncFailures != 1 || failed.LastSyncError == "" || failed.SyncRetryAt == nil || !failed.SyncRetryAt.Equal(now.Add(time.Minute)) {
+		t.Fatalf("failed provider = %+v, want one failure retried after a minute", failed)
+	}
+
+	// Nothing is due until the retry window or the next scheduled run.
+	if err := worker.Work(ctx, &river.Job[AuthProviderGroupSyncArgs]{}); err != nil {
+		t.Fatalf("second Work() error = %v", err)
+	}
+	if syncing.calls != 1 || broken.calls != 1 {
+		t.Fatalf("calls = %d/%d, want no re-sync before due", syncing.calls, broken.calls)
+	}
+
+	now = now.Add(time.Minute)
+	broken.panic = false
+	broken.err = errors.New("ldap: connection refused")
+	if err := worker.Work(ctx, &river.Job[AuthProviderGroupSyncArgs]{}); err != nil {
+		t.Fatalf("retry Work() error = %v", err)
+	}
+	failed = client.AuthProvider.GetX(ctx, "provider-broken")
+	if failed.SyncFailures != 2 || !failed.SyncRetryAt.Equal(now.Add(2*time.Minute)) || failed.LastSyncError != "ldap: connection refused" {
+		t.Fatalf("failed provider = %+v, want second failure backed off two minutes", failed)
+	}
+
+	now = now.Add(2 * time.Minute)
+	broken.err = nil
+	if err := worker.Work(ctx, &river.Job[AuthProviderGroupSyncArgs]{}); err != nil {
+		t.Fatalf("recovery Work() error = %v", err)
+	}
+	recovered := client.AuthProvider.GetX(ctx, "provider-broken")
+	if recovered.SyncFailures != 0 || recovered.SyncRetryAt != nil || recovered.LastSyncError != "" {
+		t.Fatalf("recovered provider = %+v, want failure state cleared", recovered)
+	}
+}
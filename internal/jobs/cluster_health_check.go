@@ -0,0 +1,277 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"kv-shepherd.io/shepherd/ent"
+	entcluster "kv-shepherd.io/shepherd/ent/cluster"
+	"kv-shepherd.io/shepherd/internal/infrastructure"
+	"kv-shepherd.io/shepherd/internal/notification"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/provider"
+)
+
+const (
+	// ClusterHealthCheckInterval is the default periodic schedule for cluster
+	// health probes; k8s.health_check_interval overrides it.
+	ClusterHealthCheckInterval = 60 * time.Second
+
+	// DefaultClusterProbeTimeout bounds a single /readyz probe.
+	DefaultClusterProbeTimeout = 5 * time.Second
+
+	// DefaultClusterFailureThreshold is the default number of consecutive
+	// failed probes required before a cluster leaves its current status;
+	// k8s.health_failure_threshold overrides it.
+	DefaultClusterFailureThreshold = 2
+
+	// maxClusterHealthMessageLen bounds the probe error persisted on the cluster.
+	maxClusterHealthMessageLen = 512
+)
+
+// ErrClusterNotReady reports an API server that answered but failed its
+// readiness check. Such clusters are marked UNHEALTHY; any other probe error
+// (transport, TLS, credentials) marks them UNREACHABLE.
+var ErrClusterNotReady = errors.New("cluster API server not ready")
+
+// ClusterHealthCheckArgs is a periodic job that reconciles persisted cluster
+// status against API server reachability.
+type ClusterHealthCheckArgs struct{}
+
+// Kind returns the job kind identifier for cluster health checks.
+func (ClusterHealthCheckArgs) Kind() string { return "cluster_health_check" }
+
+// InsertOpts ensures at most one health check is enqueued per default interval.
+func (ClusterHealthCheckArgs) InsertOpts() river.InsertOpts {
+	return *ClusterHealthCheckInsertOpts(ClusterHealthCheckInterval)
+}
+
+// ClusterHealthCheckInsertOpts returns insert options that enqueue at most one
+// health check per interval, for periodic jobs on a configured schedule.
+func ClusterHealthCheckInsertOpts(interval time.Duration) *river.InsertOpts {
+	return &river.InsertOpts{
+		Queue:       river.QueueDefault,
+		MaxAttempts: 1,
+		UniqueOpts: river.UniqueOpts{
+			ByPeriod: interval,
+			ByQueue:  true,
+			ByArgs:   true,
+		},
+	}
+}
+
+// ClusterProbe checks API server readiness using plaintext kubeconfig bytes.
+type ClusterProbe func(ctx context.Context, kubeconfig []byte) error
+
+// ClusterHealthCheckerWorker probes every enabled cluster and persists
+// HEALTHY/UNHEALTHY/UNREACHABLE transitions together with the probe time and
+// failure message.
+type ClusterHealthCheckerWorker struct {
+	river.WorkerDefaults[ClusterHealthCheckArgs]
+	entClient   *ent.Client
+	probe       ClusterProbe
+	timeout     time.Duration
+	threshold   int
+	healthCache *provider.ClusterHealthChecker
+	notifier    *notification.Triggers
+}
+
+// NewClusterHealthCheckerWorker creates a health check worker. A nil probe
+// falls back to ProbeClusterReadyz; non-positive timeout falls back to 5s.
+func NewClusterHealthCheckerWorker(entClient *ent.Client, probe ClusterProbe, timeout time.Duration) *ClusterHealthCheckerWorker {
+	if probe == nil {
+		probe = ProbeClusterReadyz
+	}
+	if timeout <= 0 {
+		timeout = DefaultClusterProbeTimeout
+	}
+	return &ClusterHealthCheckerWorker{
+		entClient: entClient,
+		probe:     probe,
+		timeout:   timeout,
+		threshold: DefaultClusterFailureThreshold,
+	}
+}
+
+// WithFailureThreshold sets how many consecutive failed probes are required
+// before a status transition. Non-positive values keep the default.
+func (w *ClusterHealthCheckerWorker) WithFailureThreshold(threshold int) *ClusterHealthCheckerWorker {
+	if threshold > 0 {
+		w.threshold = threshold
+	}
+	return w
+}
+
+// WithNotifier notifies platform admins when a cluster becomes UNREACHABLE.
+func (w *ClusterHealthCheckerWorker) WithNotifier(notifier *notification.Triggers) *ClusterHealthCheckerWorker {
+	w.notifier = notifier
+	return w
+}
+
+// WithHealthCache records every probe result in cache, which backs the
+// in-process /healthz cluster report.
+func (w *ClusterHealthCheckerWorker) WithHealthCache(cache *provider.ClusterHealthChecker) *ClusterHealthCheckerWorker {
+	w.healthCache = cache
+	return w
+}
+
+// Work probes enabled clusters sequentially. Per-cluster failures are logged
+// and never fail the job, so one bad cluster cannot block the others.
+func (w *ClusterHealthCheckerWorker) Work(ctx context.Context, _ *river.Job[ClusterHealthCheckArgs]) error {
+	if w == nil || w.entClient == nil {
+		return fmt.Errorf("cluster health check worker is not initialized")
+	}
+
+	clusters, err := w.entClient.Cluster.Query().
+		Where(entcluster.EnabledEQ(true)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query enabled clusters: %w", err)
+	}
+
+	for _, cl := range clusters {
+		w.checkCluster(ctx, cl)
+	}
+	return nil
+}
+
+func (w *ClusterHealthCheckerWorker) checkCluster(ctx context.Context, cl *ent.Cluster) {
+	started := time.Now()
+	probeErr := w.probeCluster(ctx, cl)
+	nextStatus, nextFailures := nextClusterHealth(cl.Status, cl.HealthCheckFailures, w.threshold, probeErr)
+	w.cacheHealth(cl, nextStatus, started, probeErr)
+
+	if probeErr != nil {
+		logger.Warn("cluster health probe failed",
+			zap.String("cluster_id", cl.ID),
+			zap.String("cluster_name", cl.Name),
+			zap.Int("consecutive_failures", nextFailures),
+			zap.Error(probeErr),
+		)
+	}
+	message := clusterHealthMessage(probeErr)
+	if _, err := w.entClient.Cluster.UpdateOneID(cl.ID).
+		SetStatus(nextStatus).
+		SetHealthCheckFailures(nextFailures).
+		SetLastHealthCheckAt(started).
+		SetHealthMessage(message).
+		Save(ctx); err != nil {
+		logger.Warn("persist cluster health failed",
+			zap.String("cluster_id", cl.ID),
+			zap.String("cluster_name", cl.Name),
+			zap.String("status", nextStatus.String()),
+			zap.Error(err),
+		)
+		return
+	}
+	if nextStatus == cl.Status {
+		return
+	}
+	logger.Info("cluster status changed",
+		zap.String("cluster_id", cl.ID),
+		zap.String("cluster_name", cl.Name),
+		zap.String("from", cl.Status.String()),
+		zap.String("to", nextStatus.String()),
+	)
+	if nextStatus == entcluster.StatusUNREACHABLE && w.notifier != nil {
+		w.notifier.OnClusterUnreachable(ctx, cl.ID, cl.Name, message)
+	}
+}
+
+// clusterHealthMessage returns the persisted failure reason for a probe
+// result, truncated to maxClusterHealthMessageLen bytes.
+func clusterHealthMessage(probeErr error) string {
+	if probeErr == nil {
+		return ""
+	}
+	msg := probeErr.Error()
+	if len(msg) > maxClusterHealthMessageLen {
+		msg = strings.ToValidUTF8(msg[:maxClusterHealthMessageLen], "")
+	}
+	return msg
+}
+
+func (w *ClusterHealthCheckerWorker) cacheHealth(cl *ent.Cluster, status entcluster.Status, started time.Time, probeErr error) {
+	if w.healthCache == nil {
+		return
+	}
+	health := &provider.ClusterHealth{
+		ClusterName: cl.Name,
+		Status:      provider.ClusterStatus(status),
+		LastChecked: started,
+		Latency:     time.Since(started),
+	}
+	if probeErr != nil {
+		health.Error = probeErr.Error()
+	}
+	w.healthCache.UpdateHealth(health)
+}
+
+func (w *ClusterHealthCheckerWorker) probeCluster(ctx context.Context, cl *ent.Cluster) error {
+	kubeconfig, err := infrastructure.DecryptKubeconfig(cl.EncryptedKubeconfig, cl.EncryptionKeyID)
+	if err != nil {
+		return fmt.Errorf("load kubeconfig: %w", err)
+	}
+
+	probeCtx, cancel := context.WithTimeout(ctx, w.timeout)
+	defer cancel()
+	return w.probe(probeCtx, kubeconfig)
+}
+
+// nextClusterHealth applies the health state machine for one probe result:
+//   - success resets the failure counter and marks the cluster HEALTHY
+//   - failure increments the counter; once it reaches threshold the cluster
+//     becomes UNHEALTHY (ErrClusterNotReady) or UNREACHABLE (any other
+//     error), otherwise status is kept so a single failed probe cannot flap it
+func nextClusterHealth(current entcluster.Status, failures, threshold int, probeErr error) (entcluster.Status, int) {
+	if probeErr == nil {
+		return entcluster.StatusHEALTHY, 0
+	}
+	failures++
+	if failures < threshold {
+		return current, failures
+	}
+	if errors.Is(probeErr, ErrClusterNotReady) {
+		return entcluster.StatusUNHEALTHY, failures
+	}
+	return entcluster.StatusUNREACHABLE, failures
+}
+
+// ProbeClusterReadyz issues GET /readyz against the API server in kubeconfig.
+func ProbeClusterReadyz(ctx context.Context, kubeconfig []byte) error {
+	restCfg, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return fmt.Errorf("parse kubeconfig: %w", err)
+	}
+	httpClient, err := rest.HTTPClientFor(restCfg)
+	if err != nil {
+		return fmt.Errorf("build http client: %w", err)
+	}
+
+	url := strings.TrimRight(restCfg.Host, "/") + "/readyz"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("build readyz request: %w", err)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("readyz request: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: readyz returned status %d", ErrClusterNotReady, resp.StatusCode)
+	}
+	return nil
+}
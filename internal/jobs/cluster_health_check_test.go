@@ -0,0 +1,280 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/riverqueue/river"
+
+	"kv-shepherd.io/shepherd/ent"
+	entcluster "kv-shepherd.io/shepherd/ent/cluster"
+	entnotification "kv-shepherd.io/shepherd/ent/notification"
+	"kv-shepherd.io/shepherd/internal/notification"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestClusterHealthCheckArgsKind(t *testing.T) {
+	t.Parallel()
+
+	if got := (ClusterHealthCheckArgs{}).Kind(); got != "cluster_health_check" {
+		t.Fatalf("Kind() = %q, want %q", got, "cluster_health_check")
+	}
+}
+
+func TestNextClusterHealth(t *testing.T) {
+	t.Parallel()
+
+	probeErr := errors.New("connection refused")
+	notReady := fmt.Errorf("%w: readyz returned status 503", ErrClusterNotReady)
+	testCases := []struct {
+		name         string
+		current      entcluster.Status
+		failures     int
+		threshold    int
+		probeErr     error
+		wantStatus   entcluster.Status
+		wantFailures int
+	}{
+		{
+			name:         "success marks healthy and resets counter",
+			current:      entcluster.StatusUNHEALTHY,
+			failures:     3,
+			wantStatus:   entcluster.StatusHEALTHY,
+			wantFailures: 0,
+		},
+		{
+			name:         "first failure keeps healthy",
+			current:      entcluster.StatusHEALTHY,
+			probeErr:     probeErr,
+			wantStatus:   entcluster.StatusHEALTHY,
+			wantFailures: 1,
+		},
+		{
+			name:         "first failure keeps unknown",
+			current:      entcluster.StatusUNKNOWN,
+			probeErr:     probeErr,
+			wantStatus:   entcluster.StatusUNKNOWN,
+			wantFailures: 1,
+		},
+		{
+			name:         "second consecutive transport failure marks unreachable",
+			current:      entcluster.StatusHEALTHY,
+			failures:     1,
+			probeErr:     probeErr,
+			wantStatus:   entcluster.StatusUNREACHABLE,
+			wantFailures: 2,
+		},
+		{
+			name:         "second consecutive readyz failure marks unhealthy",
+			current:      entcluster.StatusHEALTHY,
+			failures:     1,
+			probeErr:     notReady,
+			wantStatus:   entcluster.StatusUNHEALTHY,
+			wantFailures: 2,
+		},
+		{
+			name:         "further failures follow the latest error",
+			current:      entcluster.StatusUNHEALTHY,
+			failures:     2,
+			probeErr:     probeErr,
+			wantStatus:   entcluster.StatusUNREACHABLE,
+			wantFailures: 3,
+		},
+		{
+			name:         "higher threshold suppresses second failure",
+			current:      entcluster.StatusHEALTHY,
+			failures:     1,
+			threshold:    3,
+			probeErr:     probeErr,
+			wantStatus:   entcluster.StatusHEALTHY,
+			wantFailures: 2,
+		},
+		{
+			name:         "threshold of one transitions immediately",
+			current:      entcluster.StatusHEALTHY,
+			threshold:    1,
+			probeErr:     probeErr,
+			wantStatus:   entcluster.StatusUNREACHABLE,
+			wantFailures: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			threshold := tc.threshold
+			if threshold == 0 {
+				threshold = DefaultClusterFailureThreshold
+			}
+			gotStatus, gotFailures := nextClusterHealth(tc.current, tc.failures, threshold, tc.probeErr)
+			if gotStatus != tc.wantStatus || gotFailures != tc.wantFailures {
+				t.Fatalf("nextClusterHealth() = (%s, %d), want (%s, %d)",
+					gotStatus, gotFailures, tc.wantStatus, tc.wantFailures)
+			}
+		})
+	}
+}
+
+func TestProbeClusterReadyz(t *testing.T) {
+	t.Parallel()
+
+	var healthy atomic.Bool
+	healthy.Store(true)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/readyz" {
+			http.NotFound(w, r)
+			return
+		}
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	t.Cleanup(srv.Close)
+
+	kubeconfig := []byte(fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: stub
+  cluster:
+    server: %s
+contexts:
+- name: stub
+  context:
+    cluster: stub
+    user: stub
+current-context: stub
+users:
+- name: stub
+  user:
+    token: test-token
+`, srv.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := ProbeClusterReadyz(ctx, kubeconfig); err != nil {
+		t.Fatalf("probe healthy server: %v", err)
+	}
+
+	// Walk the state machine across two failed probes against the stub.
+	healthy.Store(false)
+	status, failures := entcluster.StatusHEALTHY, 0
+	for i := 0; i < DefaultClusterFailureThreshold; i++ {
+		err := ProbeClusterReadyz(ctx, kubeconfig)
+		if !errors.Is(err, ErrClusterNotReady) {
+			t.Fatalf("probe 503 readyz error = %v, want ErrClusterNotReady", err)
+		}
+		status, failures = nextClusterHealth(status, failures, DefaultClusterFailureThreshold, err)
+		if i == 0 && status != entcluster.StatusHEALTHY {
+			t.Fatalf("status after first failure = %s, want HEALTHY", status)
+		}
+	}
+	if status != entcluster.StatusUNHEALTHY {
+		t.Fatalf("status after %d failures = %s, want UNHEALTHY", failures, status)
+	}
+
+	if err := ProbeClusterReadyz(ctx, []byte("not a kubeconfig")); err == nil {
+		t.Fatal("expected error for malformed kubeconfig")
+	}
+}
+
+func TestClusterHealthCheckerWorker_Transitions(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "cluster_health_check_worker")
+	client.Cluster.Create().
+		SetID("cluster-a").
+		SetName("cluster-a").
+		SetAPIServerURL("https://cluster-a.example.com").
+		SetEncryptedKubeconfig([]byte("x")).
+		SetStatus(entcluster.StatusHEALTHY).
+		SetCreatedBy("admin").
+		SaveX(t.Context())
+	admin := client.User.Create().SetID("admin-1").SetUsername("admin-1").SetEnabled(true).SaveX(t.Context())
+	role := client.Role.Create().
+		SetID("role-platform-admin").
+		SetName("PlatformAdmin").
+		SetPermissions([]string{"platform:admin"}).
+		SetEnabled(true).
+		SaveX(t.Context())
+	client.RoleBinding.Create().
+		SetID("rb-admin-1").
+		SetUser(admin).
+		SetRole(role).
+		SetScopeType("global").
+		SetCreatedBy("seed").
+		SaveX(t.Context())
+
+	// The fake prober replays one scripted result per run.
+	var probeErr error
+	probe := func(context.Context, []byte) error { return probeErr }
+	notifier := notification.NewTriggers(notification.NewInboxSender(client), client)
+	worker := NewClusterHealthCheckerWorker(client, probe, time.Second).
+		WithFailureThreshold(3).
+		WithNotifier(notifier)
+
+	run := func(err error) *ent.Cluster {
+		t.Helper()
+		probeErr = err
+		if err := worker.Work(t.Context(), &river.Job[ClusterHealthCheckArgs]{}); err != nil {
+			t.Fatalf("Work() error = %v", err)
+		}
+		return client.Cluster.GetX(t.Context(), "cluster-a")
+	}
+	notifications := func() int {
+		t.Helper()
+		return client.Notification.Query().
+			Where(entnotification.TypeEQ(entnotification.TypeCLUSTER_STATUS_CHANGE)).
+			CountX(t.Context())
+	}
+
+	refused := errors.New("readyz request: connection refused")
+	// Two failures stay below the threshold of three, and a success in
+	// between resets the counter, so a flapping cluster stays HEALTHY.
+	for _, err := range []error{refused, refused, nil, refused, refused} {
+		cl := run(err)
+		if cl.Status != entcluster.StatusHEALTHY {
+			t.Fatalf("status after flap = %s, want HEALTHY", cl.Status)
+		}
+	}
+	cl := client.Cluster.GetX(t.Context(), "cluster-a")
+	if cl.HealthCheckFailures != 2 || cl.LastHealthCheckAt == nil || cl.HealthMessage != refused.Error() {
+		t.Fatalf("cluster failures=%d last_checked=%v message=%q", cl.HealthCheckFailures, cl.LastHealthCheckAt, cl.HealthMessage)
+	}
+
+	if cl = run(refused); cl.Status != entcluster.StatusUNREACHABLE {
+		t.Fatalf("status after third failure = %s, want UNREACHABLE", cl.Status)
+	}
+	if n := notifications(); n != 1 {
+		t.Fatalf("cluster notifications = %d, want 1", n)
+	}
+	// Staying UNREACHABLE does not notify again.
+	run(refused)
+	if n := notifications(); n != 1 {
+		t.Fatalf("cluster notifications after repeat = %d, want 1", n)
+	}
+	sent := client.Notification.Query().OnlyX(t.Context())
+	if recipient := sent.QueryUser().OnlyIDX(t.Context()); recipient != "admin-1" || sent.ResourceType != "cluster" || sent.ResourceID != "cluster-a" {
+		t.Fatalf("notification = %+v, want admin-1 about cluster-a", sent)
+	}
+
+	// The API server answers again but is not ready: UNHEALTHY, no new notification.
+	notReady := fmt.Errorf("%w: readyz returned status 503", ErrClusterNotReady)
+	if cl = run(notReady); cl.Status != entcluster.StatusUNHEALTHY {
+		t.Fatalf("status after readyz failure = %s, want UNHEALTHY", cl.Status)
+	}
+	if n := notifications(); n != 1 {
+		t.Fatalf("cluster notifications after UNHEALTHY = %d, want 1", n)
+	}
+
+	if cl = run(nil); cl.Status != entcluster.StatusHEALTHY || cl.HealthCheckFailures != 0 || cl.HealthMessage != "" {
+		t.Fatalf("after recovery status=%s failures=%d message=%q, want HEALTHY/0/empty", cl.Status, cl.HealthCheckFailures, cl.HealthMessage)
+	}
+}
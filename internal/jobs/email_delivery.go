@@ -0,0 +1,121 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/internal/notification"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// EmailMaxAttempts caps SMTP delivery retries per recipient and event.
+const EmailMaxAttempts = 5
+
+// EmailDeliveryArgs sends one templated email to one user. The address is
+// looked up when the job runs so it follows profile changes.
+type EmailDeliveryArgs struct {
+	RecipientID string            `json:"recipient_id"`
+	Template    string            `json:"template"`
+	Data        map[string]string `json:"data"`
+}
+
+// Kind returns the job kind identifier for email delivery.
+func (EmailDeliveryArgs) Kind() string { return "email_delivery" }
+
+// InsertOpts retries failed SMTP deliveries with River's default backoff.
+func (EmailDeliveryArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       river.QueueDefault,
+		MaxAttempts: EmailMaxAttempts,
+	}
+}
+
+// EmailDeliveryWorker resolves the recipient's address, renders the template
+// and sends it through the EmailChannel. Recipients without an address,
+// disabled users and an unconfigured SMTP relay are skipped, not retried.
+type EmailDeliveryWorker struct {
+	river.WorkerDefaults[EmailDeliveryArgs]
+	entClient *ent.Client
+	channel   *notification.EmailChannel
+}
+
+// NewEmailDeliveryWorker creates a new EmailDeliveryWorker (ADR-0013 manual DI).
+func NewEmailDeliveryWorker(entClient *ent.Client, channel *notification.EmailChannel) *EmailDeliveryWorker {
+	return &EmailDeliveryWorker{entClient: entClient, channel: channel}
+}
+
+// Work delivers the email.
+func (w *EmailDeliveryWorker) Work(ctx context.Context, job *river.Job[EmailDeliveryArgs]) error {
+	args := job.Args
+	user, err := w.entClient.User.Get(ctx, args.RecipientID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return river.JobCancel(fmt.Errorf("email recipient %s not found", args.RecipientID))
+		}
+		return fmt.Errorf("get email recipient %s: %w", args.RecipientID, err)
+	}
+	if user.Email == "" || !user.Enabled {
+		logger.Debug("skipping email notification",
+			zap.String("recipient", args.RecipientID),
+			zap.String("template", args.Template),
+			zap.Bool("has_email", user.Email != ""),
+			zap.Bool("enabled", user.Enabled),
+		)
+		return nil
+	}
+
+	subject, body, err := notification.RenderEmail(args.Template, args.Data)
+	if err != nil {
+		return river.JobCancel(err)
+	}
+	if err := w.channel.Send(ctx, user.Email, subject, body); err != nil {
+		if errors.Is(err, notification.ErrSMTPNotConfigured) {
+			logger.Debug("email delivery disabled: smtp is not configured",
+				zap.String("recipient", args.RecipientID),
+				zap.String("template", args.Template),
+			)
+			return nil
+		}
+		logger.Warn("email delivery failed",
+			zap.String("recipient", args.RecipientID),
+			zap.String("template", args.Template),
+			zap.Int("attempt", job.Attempt),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
+
+// EmailDispatcher implements notification.EmailDispatcher by enqueuing one
+// EmailDeliveryArgs job per email.
+type EmailDispatcher struct {
+	inserter JobInserter
+}
+
+// NewEmailDispatcher creates a new EmailDispatcher (ADR-0013 manual DI).
+func NewEmailDispatcher(inserter JobInserter) *EmailDispatcher {
+	return &EmailDispatcher{inserter: inserter}
+}
+
+var _ notification.EmailDispatcher = (*EmailDispatcher)(nil)
+
+// DispatchEmail enqueues delivery of email.
+func (d *EmailDispatcher) DispatchEmail(ctx context.Context, email notification.EmailNotification) error {
+	if d == nil || d.inserter == nil {
+		return fmt.Errorf("email dispatcher is not initialized")
+	}
+	if _, err := d.inserter.Insert(ctx, EmailDeliveryArgs{
+		RecipientID: email.RecipientID,
+		Template:    email.Template,
+		Data:        email.Data,
+	}, nil); err != nil {
+		return fmt.Errorf("enqueue email for %s: %w", email.RecipientID, err)
+	}
+	return nil
+}
@@ -0,0 +1,258 @@
+package jobs
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+
+	"kv-shepherd.io/shepherd/ent/platformconfig"
+	"kv-shepherd.io/shepherd/internal/notification"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+// mockSMTPMessage is one message accepted by mockSMTPServer.
+type mockSMTPMessage struct {
+	auth string
+	from string
+	to   []string
+	data string
+}
+
+// mockSMTPServer speaks just enough SMTP (EHLO, AUTH PLAIN, MAIL, RCPT, DATA)
+// for net/smtp. It does not offer STARTTLS.
+type mockSMTPServer struct {
+	listener net.Listener
+	mu       sync.Mutex
+	messages []mockSMTPMessage
+}
+
+func newMockSMTPServer(t *testing.T) *mockSMTPServer {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	s := &mockSMTPServer{listener: listener}
+	go s.serve()
+	t.Cleanup(func() { _ = listener.Close() })
+	return s
+}
+
+func (s *mockSMTPServer) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *mockSMTPServer) received() []mockSMTPMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]mockSMTPMessage(nil), s.messages...)
+}
+
+func (s *mockSMTPServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *mockSMTPServer) handle(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+
+	reply("220 mock ESMTP")
+	var msg mockSMTPMessage
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch cmd {
+		case "EHLO", "HELO":
+			reply("250-mock")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			fields := strings.Fields(line)
+			if len(fields) == 3 {
+				decoded, _ := base64.StdEncoding.DecodeString(fields[2])
+				msg.auth = string(decoded)
+			}
+			reply("235 2.7.0 Authentication successful")
+		case "MAIL":
+			msg.from = strings.TrimSuffix(strings.TrimPrefix(line[len("MAIL FROM:"):], "<"), ">")
+			reply("250 OK")
+		case "RCPT":
+			msg.to = append(msg.to, strings.TrimSuffix(strings.TrimPrefix(line[len("RCPT TO:"):], "<"), ">"))
+			reply("250 OK")
+		case "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				dataLine, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(dataLine)
+			}
+			msg.data = data.String()
+			s.mu.Lock()
+			s.messages = append(s.messages, msg)
+			s.mu.Unlock()
+			msg = mockSMTPMessage{}
+			reply("250 OK")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func TestEmailDeliveryWorker(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	client := testutil.OpenEntPostgres(t, "email_delivery")
+	ctx := context.Background()
+	smtpServer := newMockSMTPServer(t)
+
+	if _, err := client.User.Create().SetID("user-1").SetUsername("alice").SetEmail("alice@example.com").Save(ctx); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	if _, err := client.User.Create().SetID("user-2").SetUsername("bob").Save(ctx); err != nil {
+		t.Fatalf("create user without email: %v", err)
+	}
+
+	worker := NewEmailDeliveryWorker(client, notification.NewEmailChannel(client))
+	job := &river.Job[EmailDeliveryArgs]{
+		JobRow: &rivertype.JobRow{Attempt: 1},
+		Args: EmailDeliveryArgs{
+			RecipientID: "user-1",
+			Template:    notification.EmailTemplateTicketRejected,
+			Data: map[string]string{
+				"ticket_id": "ticket-1",
+				"approver":  "carol",
+				"reason":    "<b>quota</b>",
+			},
+		},
+	}
+
+	// Unconfigured SMTP skips delivery without retrying.
+	if err := worker.Work(ctx, job); err != nil {
+		t.Fatalf("Work() without smtp config error = %v, want nil", err)
+	}
+	if got := len(smtpServer.received()); got != 0 {
+		t.Fatalf("messages without smtp config = %d, want 0", got)
+	}
+
+	if _, err := client.PlatformConfig.Create().
+		SetSMTPHost("127.0.0.1").
+		SetSMTPPort(smtpServer.port()).
+		SetSMTPUsername("mailer").
+		SetSMTPPassword("hunter2").
+		SetSMTPFromAddress("shepherd@example.com").
+		Save(ctx); err != nil {
+		t.Fatalf("save platform config: %v", err)
+	}
+
+	if err := worker.Work(ctx, job); err != nil {
+		t.Fatalf("Work() error = %v, want nil", err)
+	}
+	messages := smtpServer.received()
+	if len(messages) != 1 {
+		t.Fatalf("messages = %d, want 1", len(messages))
+	}
+	msg := messages[0]
+	if msg.from != "shepherd@example.com" || len(msg.to) != 1 || msg.to[0] != "alice@example.com" {
+		t.Fatalf("envelope = from %q to %v, want shepherd@example.com to alice@example.com", msg.from, msg.to)
+	}
+	if msg.auth != "\x00mailer\x00hunter2" {
+		t.Fatalf("auth = %q, want PLAIN credentials for mailer", msg.auth)
+	}
+	for _, want := range []string{
+		"Subject: Your request ticket-1 was rejected",
+		"Content-Type: text/html; charset=UTF-8",
+		"rejected by <strong>carol</strong>",
+		"Reason: &lt;b&gt;quota&lt;/b&gt;",
+	} {
+		if !strings.Contains(msg.data, want) {
+			t.Fatalf("message missing %q:\n%s", want, msg.data)
+		}
+	}
+
+	// Users without an address are skipped.
+	job.Args.RecipientID = "user-2"
+	if err := worker.Work(ctx, job); err != nil {
+		t.Fatalf("Work() for user without email error = %v, want nil", err)
+	}
+	if got := len(smtpServer.received()); got != 1 {
+		t.Fatalf("messages after user without email = %d, want 1", got)
+	}
+
+	var cancelErr *river.JobCancelError
+	job.Args.RecipientID = "missing"
+	if err := worker.Work(ctx, job); err == nil || !errors.As(err, &cancelErr) {
+		t.Fatalf("Work() for unknown user error = %v, want JobCancel", err)
+	}
+
+	// An unreachable relay is retried.
+	if _, err := client.PlatformConfig.UpdateOneID(platformconfig.DefaultID).SetSMTPPort(closedPort(t)).Save(ctx); err != nil {
+		t.Fatalf("update smtp port: %v", err)
+	}
+	job.Args.RecipientID = "user-1"
+	if err := worker.Work(ctx, job); err == nil || errors.As(err, &cancelErr) {
+		t.Fatalf("Work() with unreachable relay error = %v, want retryable error", err)
+	}
+}
+
+func TestEmailDispatcherDispatchEmail(t *testing.T) {
+	t.Parallel()
+
+	inserter := &recordingInserter{}
+	dispatcher := NewEmailDispatcher(inserter)
+	err := dispatcher.DispatchEmail(context.Background(), notification.EmailNotification{
+		RecipientID: "user-1",
+		Template:    notification.EmailTemplateTicketApproved,
+		Data:        map[string]string{"ticket_id": "ticket-1"},
+	})
+	if err != nil {
+		t.Fatalf("DispatchEmail() error = %v", err)
+	}
+	if len(inserter.args) != 1 {
+		t.Fatalf("inserted jobs = %d, want 1", len(inserter.args))
+	}
+	args, ok := inserter.args[0].(EmailDeliveryArgs)
+	if !ok || args.RecipientID != "user-1" || args.Template != notification.EmailTemplateTicketApproved || args.Data["ticket_id"] != "ticket-1" {
+		t.Fatalf("inserted args = %#v, want EmailDeliveryArgs for user-1", inserter.args[0])
+	}
+}
+
+// closedPort returns a local port with nothing listening on it.
+func closedPort(t *testing.T) int {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	port := l.Addr().(*net.TCPAddr).Port
+	_ = l.Close()
+	return port
+}
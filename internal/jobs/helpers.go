@@ -8,6 +8,8 @@ package jobs
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -17,19 +19,31 @@ import (
 	"kv-shepherd.io/shepherd/ent/domainevent"
 	"kv-shepherd.io/shepherd/internal/governance/audit"
 	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/pkg/metrics"
 )
 
 // setTicketStatusByEvent updates the approval ticket status associated with a
 // domain event. This is a best-effort operation: failures are logged but
 // not propagated, since the ticket status is an auxiliary concern.
+//
+// It also records execution progress: EXECUTING marks a worker claim (bumps
+// attempt_count, stamps started_at, clears finished_at) and SUCCESS/FAILED
+// stamp finished_at.
 func setTicketStatusByEvent(ctx context.Context, client *ent.Client, eventID string, status approvalticket.Status) {
 	if client == nil || eventID == "" {
 		return
 	}
-	if _, err := client.ApprovalTicket.Update().
+	update := client.ApprovalTicket.Update().
 		Where(approvalticket.EventIDEQ(eventID)).
-		SetStatus(status).
-		Save(ctx); err != nil {
+		SetStatus(status)
+	now := time.Now().UTC()
+	switch status {
+	case approvalticket.StatusEXECUTING:
+		update = update.AddAttemptCount(1).SetStartedAt(now).ClearFinishedAt()
+	case approvalticket.StatusSUCCESS, approvalticket.StatusFAILED:
+		update = update.SetFinishedAt(now)
+	}
+	if _, err := update.Save(ctx); err != nil {
 		logger.Warn("failed to update approval ticket status by event",
 			zap.String("event_id", eventID),
 			zap.String("status", status.String()),
@@ -39,10 +53,27 @@ func setTicketStatusByEvent(ctx context.Context, client *ent.Client, eventID str
 	syncParentBatchStatusByChildEvent(ctx, client, eventID)
 }
 
+// ticketIDByEvent returns the approval ticket of a domain event, or "" when
+// there is none or the lookup fails.
+func ticketIDByEvent(ctx context.Context, client *ent.Client, eventID string) string {
+	ids, err := client.ApprovalTicket.Query().
+		Where(approvalticket.EventIDEQ(eventID)).
+		Limit(1).
+		IDs(ctx)
+	if err != nil || len(ids) == 0 {
+		return ""
+	}
+	return ids[0]
+}
+
 // logAuditVMOp is a helper for writing VM operation audit log entries. Failures
 // are logged at warn level but never propagated. Every worker in this package
 // follows the same pattern so we centralise it here to avoid repetition.
+//
+// It also counts the operation in shepherd_vm_operations_total, deriving the
+// result from the action suffix ("_failed", "_skipped").
 func logAuditVMOp(ctx context.Context, auditLogger *audit.Logger, action, resourceID, actor, eventID string) {
+	recordVMOperation(action)
 	if auditLogger == nil {
 		return
 	}
@@ -55,6 +86,17 @@ func logAuditVMOp(ctx context.Context, auditLogger *audit.Logger, action, resour
 	}
 }
 
+// recordVMOperation splits a worker audit action into operation and result.
+func recordVMOperation(action string) {
+	operation, result := action, metrics.ResultSuccess
+	if op, ok := strings.CutSuffix(action, "_failed"); ok {
+		operation, result = op, metrics.ResultFailure
+	} else if op, ok := strings.CutSuffix(action, "_skipped"); ok {
+		operation, result = op, metrics.ResultSkipped
+	}
+	metrics.VMOperations.WithLabelValues(operation, result).Inc()
+}
+
 func syncParentBatchStatusByChildEvent(ctx context.Context, client *ent.Client, childEventID string) {
 	if client == nil || childEventID == "" {
 		return
@@ -98,6 +140,7 @@ func syncParentBatchStatus(ctx context.Context, client *ent.Client, parentTicket
 		failedCount    int
 		cancelledCount int
 		activeCount    int
+		pendingCount   int
 	)
 	for _, child := range children {
 		switch child.Status {
@@ -107,11 +150,32 @@ func syncParentBatchStatus(ctx context.Context, client *ent.Client, parentTicket
 			failedCount++
 		case approvalticket.StatusCANCELLED:
 			cancelledCount++
+		case approvalticket.StatusPENDING:
+			pendingCount++
+			activeCount++
 		default:
 			activeCount++
 		}
 	}
 
+	// A partially approved batch keeps its parent PENDING until the
+	// remaining children are decided; only the projection tracks progress.
+	if pendingCount > 0 {
+		parent, err := client.ApprovalTicket.Get(ctx, parentTicketID)
+		if err != nil {
+			logger.Warn("failed to load parent batch ticket",
+				zap.String("parent_ticket_id", parentTicketID),
+				zap.Error(err),
+			)
+			return
+		}
+		if parent.Status == approvalticket.StatusPENDING {
+			updateBatchProjection(ctx, client, parentTicketID, len(children), successCount, failedCount, activeCount,
+				batchapprovalticket.StatusPARTIAL_APPROVED)
+			return
+		}
+	}
+
 	parentStatus := approvalticket.StatusEXECUTING
 	projectionStatus := batchapprovalticket.StatusIN_PROGRESS
 	switch {
@@ -167,11 +231,21 @@ func syncParentBatchStatus(ctx context.Context, client *ent.Client, parentTicket
 		)
 	}
 
+	updateBatchProjection(ctx, client, parentTicketID, len(children), successCount, failedCount, activeCount, projectionStatus)
+}
+
+func updateBatchProjection(
+	ctx context.Context,
+	client *ent.Client,
+	parentTicketID string,
+	childCount, successCount, failedCount, pendingCount int,
+	projectionStatus batchapprovalticket.Status,
+) {
 	if _, err := client.BatchApprovalTicket.UpdateOneID(parentTicketID).
-		SetChildCount(len(children)).
+		SetChildCount(childCount).
 		SetSuccessCount(successCount).
 		SetFailedCount(failedCount).
-		SetPendingCount(activeCount).
+		SetPendingCount(pendingCount).
 		SetStatus(projectionStatus).
 		Save(ctx); err != nil {
 		if !ent.IsNotFound(err) {
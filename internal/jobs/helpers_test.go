@@ -0,0 +1,45 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestSetTicketStatusByEvent_RecordsAttempts(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "ticket_status_attempts")
+	if _, err := client.User.Create().SetID("user-1").SetUsername("user-1").Save(t.Context()); err != nil {
+		t.Fatalf("create requester: %v", err)
+	}
+	mustCreateExpiryTicket(t, client, "tkt-child", "", approvalticket.StatusAPPROVED, time.Now(), domain.EventVMDeletionRequested)
+
+	setTicketStatusByEvent(t.Context(), client, "event-tkt-child", approvalticket.StatusEXECUTING)
+	first := client.ApprovalTicket.GetX(t.Context(), "tkt-child")
+	if first.AttemptCount != 1 || first.StartedAt == nil || first.FinishedAt != nil {
+		t.Fatalf("after claim: attempts=%d started=%v finished=%v, want 1/set/nil", first.AttemptCount, first.StartedAt, first.FinishedAt)
+	}
+
+	setTicketStatusByEvent(t.Context(), client, "event-tkt-child", approvalticket.StatusFAILED)
+	failed := client.ApprovalTicket.GetX(t.Context(), "tkt-child")
+	if failed.FinishedAt == nil || failed.FinishedAt.Before(*failed.StartedAt) {
+		t.Fatalf("after failure: finished=%v, want at or after %v", failed.FinishedAt, failed.StartedAt)
+	}
+
+	// A handler retry only resets the status; the next claim counts on top.
+	client.ApprovalTicket.UpdateOneID("tkt-child").SetStatus(approvalticket.StatusPENDING).ExecX(t.Context())
+	setTicketStatusByEvent(t.Context(), client, "event-tkt-child", approvalticket.StatusEXECUTING)
+	second := client.ApprovalTicket.GetX(t.Context(), "tkt-child")
+	if second.AttemptCount != 2 || second.FinishedAt != nil || second.StartedAt.Before(*first.StartedAt) {
+		t.Fatalf("after retry claim: attempts=%d started=%v finished=%v, want 2/reset/nil", second.AttemptCount, second.StartedAt, second.FinishedAt)
+	}
+
+	setTicketStatusByEvent(t.Context(), client, "event-tkt-child", approvalticket.StatusSUCCESS)
+	if got := client.ApprovalTicket.GetX(t.Context(), "tkt-child"); got.AttemptCount != 2 || got.FinishedAt == nil {
+		t.Fatalf("after success: attempts=%d finished=%v, want 2/set", got.AttemptCount, got.FinishedAt)
+	}
+}
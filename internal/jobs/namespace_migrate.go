@@ -0,0 +1,275 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/cluster"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/ent/namespaceregistry"
+	"kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// ---------------------------------------------------------------------------
+// Job Args
+// ---------------------------------------------------------------------------
+
+// NamespaceMigrateArgs carries EventID for namespace environment migration
+// jobs (Claim-check, ADR-0009).
+type NamespaceMigrateArgs struct {
+	EventID string `json:"event_id"`
+}
+
+// Kind returns the job kind identifier for namespace environment migrations.
+func (NamespaceMigrateArgs) Kind() string { return "namespace_migrate" }
+
+// InsertOpts returns default insert options for namespace migration jobs.
+func (NamespaceMigrateArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       river.QueueDefault,
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:  true,
+			ByQueue: true,
+		},
+	}
+}
+
+// NamespaceMigrationResult is the job output of a namespace environment
+// migration. FailedVMs lists the VMs whose cluster does not belong to the
+// new environment; they keep running where they are and need to be migrated
+// or recreated by an operator.
+type NamespaceMigrationResult struct {
+	Namespace           string                        `json:"namespace"`
+	PreviousEnvironment string                        `json:"previous_environment"`
+	Environment         string                        `json:"environment"`
+	CheckedVMs          int                           `json:"checked_vms"`
+	FailedVMs           []NamespaceMigrationVMFailure `json:"failed_vms"`
+}
+
+// NamespaceMigrationVMFailure is one VM that failed the cluster cross-check.
+type NamespaceMigrationVMFailure struct {
+	VMID      string `json:"vm_id"`
+	VMName    string `json:"vm_name"`
+	ClusterID string `json:"cluster_id"`
+	Reason    string `json:"reason"`
+}
+
+// ---------------------------------------------------------------------------
+// Worker
+// ---------------------------------------------------------------------------
+
+// NamespaceMigrateWorker moves a namespace to another environment.
+//
+// Execution flow:
+//  1. Fetch DomainEvent by EventID (claim-check, ADR-0009)
+//  2. Parse NamespaceMigrationPayload
+//  3. Update NamespaceRegistry.Environment
+//  4. Cross-check every placed VM in the namespace against its cluster's
+//     environment and report mismatches in the job output
+//  5. Audit the change with the previous environment for rollback reference
+//  6. Update event status to COMPLETED or FAILED
+type NamespaceMigrateWorker struct {
+	river.WorkerDefaults[NamespaceMigrateArgs]
+	entClient   *ent.Client
+	auditLogger *audit.Logger
+}
+
+// NewNamespaceMigrateWorker creates a new NamespaceMigrateWorker (ADR-0013 manual DI).
+func NewNamespaceMigrateWorker(entClient *ent.Client, auditLogger *audit.Logger) *NamespaceMigrateWorker {
+	return &NamespaceMigrateWorker{entClient: entClient, auditLogger: auditLogger}
+}
+
+// Work executes the namespace environment migration.
+func (w *NamespaceMigrateWorker) Work(ctx context.Context, job *river.Job[NamespaceMigrateArgs]) error {
+	eventID := job.Args.EventID
+
+	logger.Info("Processing namespace migration job",
+		zap.String("event_id", eventID),
+		zap.Int64("attempt", int64(job.Attempt)),
+	)
+
+	// Step 1: Fetch DomainEvent (claim-check pattern).
+	event, err := w.entClient.DomainEvent.Get(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("fetch domain event %s: %w", eventID, err)
+	}
+	if domain.EventType(event.EventType) != domain.EventNamespaceMigrationRequested {
+		return river.JobCancel(fmt.Errorf("event %s has type %s, not a namespace migration", eventID, event.EventType))
+	}
+
+	if _, err := w.entClient.DomainEvent.UpdateOneID(eventID).
+		SetStatus(domainevent.StatusPROCESSING).
+		Save(ctx); err != nil {
+		return fmt.Errorf("set event %s to PROCESSING: %w", eventID, err)
+	}
+	setTicketStatusByEvent(ctx, w.entClient, eventID, approvalticket.StatusEXECUTING)
+
+	markFailed := func(cause error) {
+		if _, saveErr := w.entClient.DomainEvent.UpdateOneID(eventID).
+			SetStatus(domainevent.StatusFAILED).
+			Save(ctx); saveErr != nil {
+			logger.Error("failed to persist FAILED status for namespace migration event",
+				zap.String("event_id", eventID), zap.Error(saveErr))
+		}
+		setTicketStatusByEvent(ctx, w.entClient, eventID, approvalticket.StatusFAILED)
+		logger.Warn("Namespace migration job failed", zap.String("event_id", eventID), zap.Error(cause))
+	}
+
+	// Step 2: Parse payload.
+	var payload domain.NamespaceMigrationPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		err = fmt.Errorf("unmarshal namespace migration payload for event %s: %w", eventID, err)
+		markFailed(err)
+		return river.JobCancel(err)
+	}
+	target := namespaceregistry.Environment(payload.ToEnvironment)
+	if err := namespaceregistry.EnvironmentValidator(target); err != nil {
+		err = fmt.Errorf("namespace migration event %s: %w", eventID, err)
+		markFailed(err)
+		return river.JobCancel(err)
+	}
+
+	// Step 3: Update the registry. The environment recorded at request time
+	// is kept as the previous one so a retried job still reports it.
+	ns, err := w.entClient.NamespaceRegistry.Get(ctx, payload.NamespaceID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			err = fmt.Errorf("namespace %s no longer exists", payload.Namespace)
+			markFailed(err)
+			return river.JobCancel(err)
+		}
+		return fmt.Errorf("get namespace %s: %w", payload.NamespaceID, err)
+	}
+	if ns.Environment != target {
+		if _, err := ns.Update().SetEnvironment(target).Save(ctx); err != nil {
+			return fmt.Errorf("update namespace %s environment: %w", ns.Name, err)
+		}
+	}
+
+	// Step 4: Cross-check VMs against their clusters.
+	result, err := w.crossCheckVMs(ctx, ns.Name, target)
+	if err != nil {
+		return fmt.Errorf("cross-check vms in namespace %s: %w", ns.Name, err)
+	}
+	result.PreviousEnvironment = payload.FromEnvironment
+	if err := river.RecordOutput(ctx, result); err != nil {
+		logger.Warn("failed to record namespace migration output",
+			zap.String("event_id", eventID), zap.Error(err))
+	}
+
+	// Step 5: Audit with the previous environment for rollback reference.
+	w.logAudit(ctx, ns.ID, payload, eventID, result)
+
+	// Step 6: Update event status to COMPLETED.
+	if _, saveErr := w.entClient.DomainEvent.UpdateOneID(eventID).
+		SetStatus(domainevent.StatusCOMPLETED).
+		Save(ctx); saveErr != nil {
+		logger.Error("CRITICAL: namespace migrated but event status persistence failed",
+			zap.String("event_id", eventID), zap.Error(saveErr))
+	}
+	setTicketStatusByEvent(ctx, w.entClient, eventID, approvalticket.StatusSUCCESS)
+
+	logger.Info("Namespace migration job completed",
+		zap.String("event_id", eventID),
+		zap.String("namespace", ns.Name),
+		zap.String("previous_environment", payload.FromEnvironment),
+		zap.String("environment", payload.ToEnvironment),
+		zap.Int("checked_vms", result.CheckedVMs),
+		zap.Int("failed_vms", len(result.FailedVMs)),
+	)
+	return nil
+}
+
+// crossCheckVMs fails every VM in the namespace whose cluster is missing or
+// belongs to another environment. VMs not yet placed on a cluster are skipped;
+// approval places them after the namespace has moved.
+func (w *NamespaceMigrateWorker) crossCheckVMs(ctx context.Context, namespace string, target namespaceregistry.Environment) (NamespaceMigrationResult, error) {
+	result := NamespaceMigrationResult{
+		Namespace:   namespace,
+		Environment: string(target),
+		FailedVMs:   []NamespaceMigrationVMFailure{},
+	}
+
+	rows, err := w.entClient.VM.Query().
+		Where(vm.NamespaceEQ(namespace), vm.ClusterIDNEQ("")).
+		Order(ent.Asc(vm.FieldName)).
+		All(ctx)
+	if err != nil {
+		return result, err
+	}
+	clusterIDs := make([]string, 0, len(rows))
+	for _, row := range rows {
+		clusterIDs = append(clusterIDs, row.ClusterID)
+	}
+	clusters, err := w.entClient.Cluster.Query().
+		Where(cluster.IDIn(clusterIDs...)).
+		All(ctx)
+	if err != nil {
+		return result, err
+	}
+	envByCluster := make(map[string]cluster.Environment, len(clusters))
+	for _, c := range clusters {
+		envByCluster[c.ID] = c.Environment
+	}
+
+	for _, row := range rows {
+		result.CheckedVMs++
+		env, ok := envByCluster[row.ClusterID]
+		var reason string
+		switch {
+		case !ok:
+			reason = fmt.Sprintf("cluster %s not found", row.ClusterID)
+		case string(env) != string(target):
+			reason = fmt.Sprintf("cluster %s is in the %s environment", row.ClusterID, env)
+		default:
+			continue
+		}
+		result.FailedVMs = append(result.FailedVMs, NamespaceMigrationVMFailure{
+			VMID:      row.ID,
+			VMName:    row.Name,
+			ClusterID: row.ClusterID,
+			Reason:    reason,
+		})
+	}
+	return result, nil
+}
+
+func (w *NamespaceMigrateWorker) logAudit(
+	ctx context.Context,
+	namespaceID string,
+	payload domain.NamespaceMigrationPayload,
+	eventID string,
+	result NamespaceMigrationResult,
+) {
+	if w.auditLogger == nil {
+		return
+	}
+	failedVMIDs := make([]string, 0, len(result.FailedVMs))
+	for _, f := range result.FailedVMs {
+		failedVMIDs = append(failedVMIDs, f.VMID)
+	}
+	if err := w.auditLogger.LogAction(ctx, "namespace.environment_migrate", "namespace", namespaceID, payload.Actor, map[string]interface{}{
+		"namespace":            payload.Namespace,
+		"previous_environment": payload.FromEnvironment,
+		"environment":          payload.ToEnvironment,
+		"checked_vms":          result.CheckedVMs,
+		"failed_vm_ids":        failedVMIDs,
+		"event_id":             eventID,
+	}); err != nil {
+		logger.Warn("failed to write audit log",
+			zap.String("action", "namespace.environment_migrate"),
+			zap.String("event_id", eventID),
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,100 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/riverqueue/river"
+
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/auditlog"
+	"kv-shepherd.io/shepherd/ent/cluster"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/ent/namespaceregistry"
+	"kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestNamespaceMigrateWorker(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "namespace_migrate_worker")
+	for id, env := range map[string]cluster.Environment{"cluster-prod": cluster.EnvironmentProd, "cluster-test": cluster.EnvironmentTest} {
+		client.Cluster.Create().
+			SetID(id).
+			SetName(id).
+			SetAPIServerURL("https://" + id + ".example.com").
+			SetEncryptedKubeconfig([]byte("x")).
+			SetEnvironment(env).
+			SetCreatedBy("admin").
+			SaveX(t.Context())
+	}
+	ns := client.NamespaceRegistry.Create().
+		SetID("ns-prod-shop").
+		SetName("prod-shop").
+		SetEnvironment(namespaceregistry.EnvironmentTest).
+		SetCreatedBy("admin").
+		SaveX(t.Context())
+	svc := mustCreateSyncTestService(t, client)
+	mustCreateSyncTestVM(t, client, svc.ID, "vm-on-prod", "cluster-prod", vm.StatusRUNNING)
+	onTest := mustCreateSyncTestVM(t, client, svc.ID, "vm-on-test", "cluster-test", vm.StatusRUNNING)
+	gone := mustCreateSyncTestVM(t, client, svc.ID, "vm-on-gone", "cluster-gone", vm.StatusSTOPPED)
+	mustCreateSyncTestVM(t, client, svc.ID, "vm-unplaced", "", vm.StatusPENDING)
+
+	payload, err := domain.NamespaceMigrationPayload{
+		NamespaceID:     ns.ID,
+		Namespace:       ns.Name,
+		FromEnvironment: "test",
+		ToEnvironment:   "prod",
+		Actor:           "admin-1",
+	}.ToJSON()
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+	client.DomainEvent.Create().
+		SetID("event-ns-migrate").
+		SetEventType(string(domain.EventNamespaceMigrationRequested)).
+		SetAggregateType("namespace").
+		SetAggregateID(ns.ID).
+		SetPayload(payload).
+		SetStatus(domainevent.StatusPROCESSING).
+		SetCreatedBy("admin-1").
+		SaveX(t.Context())
+	client.ApprovalTicket.Create().
+		SetID("ticket-ns-migrate").
+		SetEventID("event-ns-migrate").
+		SetRequester("admin-1").
+		SetOperationType(approvalticket.OperationTypeNAMESPACE_MIGRATE).
+		SetStatus(approvalticket.StatusAPPROVED).
+		SaveX(t.Context())
+
+	worker := NewNamespaceMigrateWorker(client, audit.NewLogger(client))
+	if err := worker.Work(t.Context(), &river.Job[NamespaceMigrateArgs]{Args: NamespaceMigrateArgs{EventID: "event-ns-migrate"}}); err != nil {
+		t.Fatalf("Work() error = %v", err)
+	}
+
+	if got := client.NamespaceRegistry.GetX(t.Context(), ns.ID).Environment; got != namespaceregistry.EnvironmentProd {
+		t.Fatalf("namespace environment = %s, want prod", got)
+	}
+	if event := client.DomainEvent.GetX(t.Context(), "event-ns-migrate"); event.Status != domainevent.StatusCOMPLETED {
+		t.Fatalf("event status = %s, want COMPLETED", event.Status)
+	}
+	if ticket := client.ApprovalTicket.GetX(t.Context(), "ticket-ns-migrate"); ticket.Status != approvalticket.StatusSUCCESS {
+		t.Fatalf("ticket status = %s, want SUCCESS", ticket.Status)
+	}
+
+	entry := client.AuditLog.Query().
+		Where(auditlog.ActionEQ("namespace.environment_migrate"), auditlog.ResourceIDEQ(ns.ID)).
+		OnlyX(t.Context())
+	if entry.Actor != "admin-1" || entry.Details["previous_environment"] != "test" || entry.Details["environment"] != "prod" {
+		t.Fatalf("audit actor=%s details=%v", entry.Actor, entry.Details)
+	}
+	if checked, _ := entry.Details["checked_vms"].(float64); checked != 3 {
+		t.Fatalf("checked_vms = %v, want 3", entry.Details["checked_vms"])
+	}
+	failed, _ := entry.Details["failed_vm_ids"].([]interface{})
+	if len(failed) != 2 || failed[0] != gone.ID || failed[1] != onTest.ID {
+		t.Fatalf("failed_vm_ids = %v, want [%s %s]", entry.Details["failed_vm_ids"], gone.ID, onTest.ID)
+	}
+}
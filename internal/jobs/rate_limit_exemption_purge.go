@@ -0,0 +1,71 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/ratelimitexemption"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// RateLimitExemptionPurgeInterval is the periodic schedule for expired
+// exemption cleanup.
+const RateLimitExemptionPurgeInterval = time.Hour
+
+// RateLimitExemptionPurgeArgs is a periodic maintenance job that deletes
+// expired rate-limit exemptions. Lookups already ignore expired rows; this
+// keeps the table from accumulating exemptions of users who never return.
+type RateLimitExemptionPurgeArgs struct{}
+
+// Kind returns the job kind identifier for exemption purge.
+func (RateLimitExemptionPurgeArgs) Kind() string { return "rate_limit_exemption_purge" }
+
+// InsertOpts ensures at most one purge job is enqueued per interval.
+func (RateLimitExemptionPurgeArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       river.QueueDefault,
+		MaxAttempts: 1,
+		UniqueOpts: river.UniqueOpts{
+			ByPeriod: RateLimitExemptionPurgeInterval,
+			ByQueue:  true,
+			ByArgs:   true,
+		},
+	}
+}
+
+// RateLimitExemptionPurgeWorker bulk-deletes exemptions past expires_at.
+type RateLimitExemptionPurgeWorker struct {
+	river.WorkerDefaults[RateLimitExemptionPurgeArgs]
+	entClient *ent.Client
+}
+
+// NewRateLimitExemptionPurgeWorker creates a purge worker (ADR-0013 manual DI).
+func NewRateLimitExemptionPurgeWorker(entClient *ent.Client) *RateLimitExemptionPurgeWorker {
+	return &RateLimitExemptionPurgeWorker{entClient: entClient}
+}
+
+// Work removes expired exemptions. Rows without expires_at never expire.
+func (w *RateLimitExemptionPurgeWorker) Work(ctx context.Context, _ *river.Job[RateLimitExemptionPurgeArgs]) error {
+	if w == nil || w.entClient == nil {
+		return fmt.Errorf("rate-limit exemption purge worker is not initialized")
+	}
+
+	now := time.Now().UTC()
+	purged, err := w.entClient.RateLimitExemption.Delete().
+		Where(ratelimitexemption.ExpiresAtLT(now)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("purge rate-limit exemptions expired before %s: %w", now.Format(time.RFC3339), err)
+	}
+
+	logger.Info("rate-limit exemption purge completed",
+		zap.Int("purged_rows", purged),
+		zap.String("cutoff", now.Format(time.RFC3339)),
+	)
+	return nil
+}
@@ -0,0 +1,55 @@
+package jobs
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/riverqueue/river"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestRateLimitExemptionPurgeWorker(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "rate_limit_exemption_purge")
+	now := time.Now().UTC()
+	seed := func(userID string, expiresAt *time.Time) {
+		t.Helper()
+		create := client.RateLimitExemption.Create().
+			SetID(userID).
+			SetExemptedBy("admin-1")
+		if expiresAt != nil {
+			create = create.SetExpiresAt(*expiresAt)
+		}
+		if _, err := create.Save(t.Context()); err != nil {
+			t.Fatalf("seed exemption %s: %v", userID, err)
+		}
+	}
+	past := now.Add(-time.Minute)
+	longPast := now.Add(-30 * 24 * time.Hour)
+	future := now.Add(time.Hour)
+	seed("expired-recently", &past)
+	seed("expired-long-ago", &longPast)
+	seed("still-valid", &future)
+	seed("permanent", nil)
+
+	worker := NewRateLimitExemptionPurgeWorker(client)
+	for range 2 { // second run must be a no-op
+		if err := worker.Work(t.Context(), &river.Job[RateLimitExemptionPurgeArgs]{}); err != nil {
+			t.Fatalf("Work() error = %v", err)
+		}
+	}
+
+	remaining, err := client.RateLimitExemption.Query().
+		Order(ent.Asc("id")).
+		IDs(t.Context())
+	if err != nil {
+		t.Fatalf("list exemptions: %v", err)
+	}
+	if want := []string{"permanent", "still-valid"}; !slices.Equal(remaining, want) {
+		t.Fatalf("remaining exemptions = %v, want %v", remaining, want)
+	}
+}
@@ -0,0 +1,186 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/scheduledbatchjob"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// ScheduledBatchPowerInterval is the periodic schedule for evaluating cron
+// schedules. It is also the finest granularity a cron expression can fire at.
+const ScheduledBatchPowerInterval = time.Minute
+
+// ErrBatchPowerThrottled is returned by a BatchPowerSubmitter when batch rate
+// limits reject the submission. The schedule is retried on the next tick.
+var ErrBatchPowerThrottled = errors.New("batch power submission throttled")
+
+// ScheduledBatchPowerRequest is a batch power submission built from a schedule.
+type ScheduledBatchPowerRequest struct {
+	Actor     string
+	Operation string
+	VMIDs     []string
+	RequestID string
+	Reason    string
+}
+
+// BatchPowerSubmitter submits batch power requests outside of HTTP, applying
+// the same validation and rate limits as POST /vms/batch/power.
+type BatchPowerSubmitter interface {
+	SubmitScheduledBatchPower(ctx context.Context, req ScheduledBatchPowerRequest) (batchID string, err error)
+}
+
+// ParseCronExpression parses a standard 5-field cron expression.
+func ParseCronExpression(expr string) (cron.Schedule, error) {
+	return cron.ParseStandard(strings.TrimSpace(expr))
+}
+
+// NextScheduledRun returns the next fire time of a schedule, counted from its
+// last run (or creation when it never ran).
+func NextScheduledRun(job *ent.ScheduledBatchJob) (time.Time, error) {
+	schedule, err := ParseCronExpression(job.CronExpression)
+	if err != nil {
+		return time.Time{}, err
+	}
+	from := job.CreatedAt
+	if job.LastRunAt != nil {
+		from = *job.LastRunAt
+	}
+	return schedule.Next(from.UTC()), nil
+}
+
+// ScheduledBatchPowerArgs is a periodic job that submits due batch power schedules.
+type ScheduledBatchPowerArgs struct{}
+
+// Kind returns the job kind identifier for scheduled batch power evaluation.
+func (ScheduledBatchPowerArgs) Kind() string { return "scheduled_batch_power" }
+
+// InsertOpts ensures at most one evaluation job is enqueued per interval.
+func (ScheduledBatchPowerArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       river.QueueDefault,
+		MaxAttempts: 1,
+		UniqueOpts: river.UniqueOpts{
+			ByPeriod: ScheduledBatchPowerInterval,
+			ByQueue:  true,
+			ByArgs:   true,
+		},
+	}
+}
+
+// ScheduledBatchPowerWorker fires due ScheduledBatchJob rows. Missed runs
+// (e.g. during downtime) collapse into a single submission.
+type ScheduledBatchPowerWorker struct {
+	river.WorkerDefaults[ScheduledBatchPowerArgs]
+	entClient *ent.Client
+	submitter BatchPowerSubmitter
+	now       func() time.Time
+}
+
+// NewScheduledBatchPowerWorker creates a ScheduledBatchPowerWorker (ADR-0013 manual DI).
+// The submitter may be bound later via SetSubmitter, since the HTTP server is
+// composed after River workers are registered.
+func NewScheduledBatchPowerWorker(entClient *ent.Client, submitter BatchPowerSubmitter) *ScheduledBatchPowerWorker {
+	return &ScheduledBatchPowerWorker{
+		entClient: entClient,
+		submitter: submitter,
+		now:       time.Now,
+	}
+}
+
+// SetSubmitter binds the batch power submitter.
+func (w *ScheduledBatchPowerWorker) SetSubmitter(submitter BatchPowerSubmitter) {
+	w.submitter = submitter
+}
+
+// Work submits every enabled schedule whose next fire time has passed.
+func (w *ScheduledBatchPowerWorker) Work(ctx context.Context, _ *river.Job[ScheduledBatchPowerArgs]) error {
+	if w == nil || w.entClient == nil || w.submitter == nil {
+		return fmt.Errorf("scheduled batch power worker is not initialized")
+	}
+
+	schedules, err := w.entClient.ScheduledBatchJob.Query().
+		Where(scheduledbatchjob.EnabledEQ(true)).
+		Order(ent.Asc(scheduledbatchjob.FieldID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query scheduled batch jobs: %w", err)
+	}
+
+	now := w.now().UTC()
+	fired := 0
+	for _, schedule := range schedules {
+		if w.fire(ctx, schedule, now) {
+			fired++
+		}
+	}
+
+	logger.Info("scheduled batch power evaluation completed",
+		zap.Int("schedules", len(schedules)),
+		zap.Int("fired", fired),
+	)
+	return nil
+}
+
+// fire submits a single schedule if it is due and records the outcome.
+func (w *ScheduledBatchPowerWorker) fire(ctx context.Context, schedule *ent.ScheduledBatchJob, now time.Time) bool {
+	due, err := NextScheduledRun(schedule)
+	if err != nil {
+		logger.Warn("scheduled batch job has invalid cron expression",
+			zap.String("schedule_id", schedule.ID),
+			zap.String("cron_expression", schedule.CronExpression),
+			zap.Error(err),
+		)
+		return false
+	}
+	if due.After(now) {
+		return false
+	}
+
+	reason := strings.TrimSpace(schedule.Reason)
+	if reason == "" {
+		reason = fmt.Sprintf("scheduled batch power %s (%s)", strings.ToLower(string(schedule.Operation)), schedule.CronExpression)
+	}
+	batchID, err := w.submitter.SubmitScheduledBatchPower(ctx, ScheduledBatchPowerRequest{
+		Actor:     schedule.CreatedBy,
+		Operation: string(schedule.Operation),
+		VMIDs:     schedule.VMIds,
+		// Stable per fire time so a retried tick cannot submit twice.
+		RequestID: fmt.Sprintf("schedule:%s:%d", schedule.ID, due.Unix()),
+		Reason:    reason,
+	})
+	if errors.Is(err, ErrBatchPowerThrottled) {
+		logger.Warn("scheduled batch power submission throttled, retrying next tick",
+			zap.String("schedule_id", schedule.ID),
+			zap.Error(err),
+		)
+		return false
+	}
+
+	update := w.entClient.ScheduledBatchJob.UpdateOneID(schedule.ID).SetLastRunAt(now)
+	if err != nil {
+		logger.Warn("scheduled batch power submission failed",
+			zap.String("schedule_id", schedule.ID),
+			zap.Error(err),
+		)
+		update.SetLastError(err.Error())
+	} else {
+		update.SetLastBatchID(batchID).ClearLastError()
+	}
+	if _, saveErr := update.Save(ctx); saveErr != nil {
+		logger.Error("failed to record scheduled batch job run",
+			zap.String("schedule_id", schedule.ID),
+			zap.Error(saveErr),
+		)
+	}
+	return err == nil
+}
@@ -0,0 +1,107 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/riverqueue/river"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/scheduledbatchjob"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+type fakeBatchPowerSubmitter struct {
+	calls []ScheduledBatchPowerRequest
+	err   error
+}
+
+func (f *fakeBatchPowerSubmitter) SubmitScheduledBatchPower(_ context.Context, req ScheduledBatchPowerRequest) (string, error) {
+	f.calls = append(f.calls, req)
+	if f.err != nil {
+		return "", f.err
+	}
+	return fmt.Sprintf("batch-%d", len(f.calls)), nil
+}
+
+func TestNextScheduledRun(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2026, 3, 2, 19, 30, 0, 0, time.UTC) // Monday
+	lastRun := time.Date(2026, 3, 6, 20, 0, 0, 0, time.UTC)  // Friday
+
+	tests := []struct {
+		name string
+		job  *ent.ScheduledBatchJob
+		want time.Time
+	}{
+		{
+			name: "never ran counts from creation",
+			job:  &ent.ScheduledBatchJob{CronExpression: "0 20 * * 1-5", CreatedAt: created},
+			want: time.Date(2026, 3, 2, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "skips weekend after friday run",
+			job:  &ent.ScheduledBatchJob{CronExpression: "0 20 * * 1-5", CreatedAt: created, LastRunAt: &lastRun},
+			want: time.Date(2026, 3, 9, 20, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NextScheduledRun(tc.job)
+			if err != nil {
+				t.Fatalf("NextScheduledRun() error = %v", err)
+			}
+			if !got.Equal(tc.want) {
+				t.Fatalf("NextScheduledRun() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	if _, err := NextScheduledRun(&ent.ScheduledBatchJob{CronExpression: "nightly"}); err == nil {
+		t.Fatal("NextScheduledRun() with invalid expression error = nil, want error")
+	}
+}
+
+func TestScheduledBatchPowerWorker_ThrottledScheduleRetriesNextTick(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "scheduled_batch_power")
+	lastRun := time.Now().UTC().Add(-time.Hour)
+	client.ScheduledBatchJob.Create().
+		SetID("sched-throttled").
+		SetCronExpression("*/5 * * * *").
+		SetOperation(scheduledbatchjob.OperationSTOP).
+		SetVMIds([]string{"vm-1"}).
+		SetLastRunAt(lastRun).
+		SetCreatedBy("admin-1").
+		SaveX(t.Context())
+
+	submitter := &fakeBatchPowerSubmitter{err: fmt.Errorf("%w: pending parents", ErrBatchPowerThrottled)}
+	worker := NewScheduledBatchPowerWorker(client, submitter)
+	if err := worker.Work(t.Context(), &river.Job[ScheduledBatchPowerArgs]{}); err != nil {
+		t.Fatalf("Work() error = %v", err)
+	}
+	throttled := client.ScheduledBatchJob.GetX(t.Context(), "sched-throttled")
+	if throttled.LastRunAt == nil || !throttled.LastRunAt.Equal(lastRun.Truncate(time.Microsecond)) {
+		t.Fatalf("last_run_at = %v, want unchanged %v", throttled.LastRunAt, lastRun)
+	}
+
+	submitter.err = nil
+	if err := worker.Work(t.Context(), &river.Job[ScheduledBatchPowerArgs]{}); err != nil {
+		t.Fatalf("second Work() error = %v", err)
+	}
+	if len(submitter.calls) != 2 || submitter.calls[0].RequestID != submitter.calls[1].RequestID {
+		t.Fatalf("calls = %+v, want retry with the same request_id", submitter.calls)
+	}
+	if submitter.calls[1].Actor != "admin-1" || submitter.calls[1].Operation != "STOP" {
+		t.Fatalf("request = %+v, want STOP by admin-1", submitter.calls[1])
+	}
+	fired := client.ScheduledBatchJob.GetX(t.Context(), "sched-throttled")
+	if fired.LastBatchID != "batch-2" || !fired.LastRunAt.After(lastRun) {
+		t.Fatalf("schedule = %+v, want last_batch_id batch-2 and advanced last_run_at", fired)
+	}
+}
@@ -0,0 +1,164 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/platformconfig"
+	"kv-shepherd.io/shepherd/internal/notification"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+const (
+	// TicketExpiryInterval is the periodic schedule for pending ticket expiry.
+	TicketExpiryInterval = time.Minute
+	// TicketAutoRejectReason is recorded as reject_reason on expired tickets.
+	TicketAutoRejectReason = "auto_rejected: approval deadline exceeded"
+	// TicketExpiryActor is recorded as approver on expired tickets.
+	TicketExpiryActor = "system"
+
+	ticketExpiryPageSize = 100
+)
+
+// TicketRejecter rejects a pending ticket; satisfied by *approval.Gateway.
+type TicketRejecter interface {
+	Reject(ctx context.Context, ticketID, approver, reason string) error
+}
+
+// TicketExpiryArgs is a periodic job that rejects approval tickets left
+// PENDING longer than the configured TTL.
+type TicketExpiryArgs struct{}
+
+// Kind returns the job kind identifier for pending ticket expiry.
+func (TicketExpiryArgs) Kind() string { return "ticket_expiry" }
+
+// InsertOpts ensures at most one expiry job is enqueued per interval.
+func (TicketExpiryArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       river.QueueDefault,
+		MaxAttempts: 1,
+		UniqueOpts: river.UniqueOpts{
+			ByPeriod: TicketExpiryInterval,
+			ByQueue:  true,
+			ByArgs:   true,
+		},
+	}
+}
+
+// TicketExpiryWorker auto-rejects stale PENDING tickets. ADR-0005 deferred
+// timeout processing, so the worker is a no-op unless a positive TTL is set,
+// either via PlatformConfig.approval_ttl_hours or the static default.
+type TicketExpiryWorker struct {
+	river.WorkerDefaults[TicketExpiryArgs]
+	entClient  *ent.Client
+	rejecter   TicketRejecter
+	notifier   *notification.Triggers
+	defaultTTL time.Duration
+}
+
+// NewTicketExpiryWorker creates a TicketExpiryWorker (ADR-0013 manual DI).
+// defaultTTL applies until an admin saves the platform config; non-positive
+// values disable expiry.
+func NewTicketExpiryWorker(
+	entClient *ent.Client,
+	rejecter TicketRejecter,
+	notifier *notification.Triggers,
+	defaultTTL time.Duration,
+) *TicketExpiryWorker {
+	return &TicketExpiryWorker{
+		entClient:  entClient,
+		rejecter:   rejecter,
+		notifier:   notifier,
+		defaultTTL: defaultTTL,
+	}
+}
+
+// Work pages through top-level PENDING tickets created before the cutoff and
+// rejects them through the approval gateway, which also rejects the pending
+// children of batch parents. Rejection is guarded on PENDING, so re-running
+// the job (or racing an approver) is safe.
+func (w *TicketExpiryWorker) Work(ctx context.Context, _ *river.Job[TicketExpiryArgs]) error {
+	if w == nil || w.entClient == nil || w.rejecter == nil {
+		return fmt.Errorf("ticket expiry worker is not initialized")
+	}
+	ttl, err := w.approvalTTL(ctx)
+	if err != nil {
+		return err
+	}
+	if ttl <= 0 {
+		return nil
+	}
+
+	cutoff := time.Now().UTC().Add(-ttl)
+	rejected := 0
+	lastID := ""
+	for {
+		query := w.entClient.ApprovalTicket.Query().
+			Where(
+				approvalticket.StatusEQ(approvalticket.StatusPENDING),
+				approvalticket.CreatedAtLT(cutoff),
+				approvalticket.ParentTicketIDEQ(""),
+			)
+		if lastID != "" {
+			query = query.Where(approvalticket.IDGT(lastID))
+		}
+		page, err := query.
+			Order(ent.Asc(approvalticket.FieldID)).
+			Limit(ticketExpiryPageSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("query stale pending tickets: %w", err)
+		}
+		if len(page) == 0 {
+			break
+		}
+		for _, ticket := range page {
+			if err := w.rejecter.Reject(ctx, ticket.ID, TicketExpiryActor, TicketAutoRejectReason); err != nil {
+				logger.Warn("ticket expiry failed to reject ticket",
+					zap.String("ticket_id", ticket.ID),
+					zap.Error(err),
+				)
+				continue
+			}
+			rejected++
+			w.notify(ctx, ticket, ttl)
+		}
+		lastID = page[len(page)-1].ID
+		if len(page) < ticketExpiryPageSize {
+			break
+		}
+	}
+
+	logger.Info("ticket expiry completed",
+		zap.Int("rejected", rejected),
+		zap.String("cutoff", cutoff.Format(time.RFC3339)),
+		zap.Duration("ttl", ttl),
+	)
+	return nil
+}
+
+// approvalTTL resolves the effective TTL: the saved platform config wins,
+// otherwise the static default applies.
+func (w *TicketExpiryWorker) approvalTTL(ctx context.Context) (time.Duration, error) {
+	cfg, err := w.entClient.PlatformConfig.Get(ctx, platformconfig.DefaultID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return w.defaultTTL, nil
+		}
+		return 0, fmt.Errorf("load platform config: %w", err)
+	}
+	return time.Duration(cfg.ApprovalTTLHours) * time.Hour, nil
+}
+
+func (w *TicketExpiryWorker) notify(ctx context.Context, ticket *ent.ApprovalTicket, ttl time.Duration) {
+	if w.notifier == nil || ticket.Requester == "" {
+		return
+	}
+	w.notifier.OnTicketExpired(ctx, ticket.ID, ticket.Requester, ttl)
+}
@@ -0,0 +1,175 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/riverqueue/river"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/auditlog"
+	"kv-shepherd.io/shepherd/ent/batchapprovalticket"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	entnotification "kv-shepherd.io/shepherd/ent/notification"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/governance/approval"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/notification"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestTicketExpiryWorker_DisabledWithoutTTL(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "ticket_expiry_disabled")
+	mustCreateExpiryTicket(t, client, "tkt-stale", "", approvalticket.StatusPENDING, time.Now().Add(-48*time.Hour), domain.EventVMCreationRequested)
+	gateway := approval.NewGateway(client, nil, nil)
+
+	// No platform config and no default TTL.
+	if err := NewTicketExpiryWorker(client, gateway, nil, 0).Work(t.Context(), &river.Job[TicketExpiryArgs]{}); err != nil {
+		t.Fatalf("Work() error = %v", err)
+	}
+	// A saved zero TTL overrides the static default.
+	client.PlatformConfig.Create().SetApprovalTTLHours(0).SaveX(t.Context())
+	if err := NewTicketExpiryWorker(client, gateway, nil, time.Hour).Work(t.Context(), &river.Job[TicketExpiryArgs]{}); err != nil {
+		t.Fatalf("Work() error = %v", err)
+	}
+
+	if got := client.ApprovalTicket.GetX(t.Context(), "tkt-stale").Status; got != approvalticket.StatusPENDING {
+		t.Fatalf("ticket status = %s, want PENDING", got)
+	}
+}
+
+func TestTicketExpiryWorker_RejectsTicketsPastPlatformTTL(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "ticket_expiry")
+	if _, err := client.User.Create().SetID("user-1").SetUsername("user-1").Save(t.Context()); err != nil {
+		t.Fatalf("create requester: %v", err)
+	}
+	client.PlatformConfig.Create().SetApprovalTTLHours(24).SetUpdatedBy("admin").SaveX(t.Context())
+	stale := time.Now().Add(-25 * time.Hour)
+	young := time.Now().Add(-23 * time.Hour)
+
+	mustCreateExpiryTicket(t, client, "tkt-stale", "", approvalticket.StatusPENDING, stale, domain.EventVMCreationRequested)
+	mustCreateExpiryTicket(t, client, "tkt-young", "", approvalticket.StatusPENDING, young, domain.EventVMCreationRequested)
+	mustCreateExpiryTicket(t, client, "tkt-approved", "", approvalticket.StatusAPPROVED, stale, domain.EventVMCreationRequested)
+
+	mustCreateExpiryTicket(t, client, "batch-stale", "", approvalticket.StatusPENDING, stale, domain.EventBatchCreateRequested)
+	mustCreateExpiryTicket(t, client, "batch-stale-c1", "batch-stale", approvalticket.StatusPENDING, stale, domain.EventVMCreationRequested)
+	mustCreateExpiryTicket(t, client, "batch-stale-c2", "batch-stale", approvalticket.StatusPENDING, stale, domain.EventVMCreationRequested)
+	if _, err := client.BatchApprovalTicket.Create().
+		SetID("batch-stale").
+		SetBatchType(batchapprovalticket.BatchTypeBATCH_CREATE).
+		SetChildCount(2).
+		SetPendingCount(2).
+		SetStatus(batchapprovalticket.StatusPENDING_APPROVAL).
+		SetCreatedBy("user-1").
+		Save(t.Context()); err != nil {
+		t.Fatalf("create batch projection: %v", err)
+	}
+
+	notifier := notification.NewTriggers(notification.NewInboxSender(client), client)
+	// The static default would expire the young ticket too; the saved platform config wins.
+	worker := NewTicketExpiryWorker(client, approval.NewGateway(client, audit.NewLogger(client), nil), notifier, time.Hour)
+	for range 2 { // second run must be a no-op
+		if err := worker.Work(t.Context(), &river.Job[TicketExpiryArgs]{}); err != nil {
+			t.Fatalf("Work() error = %v", err)
+		}
+	}
+
+	want := map[string]approvalticket.Status{
+		"tkt-stale":      approvalticket.StatusREJECTED,
+		"tkt-young":      approvalticket.StatusPENDING,
+		"tkt-approved":   approvalticket.StatusAPPROVED,
+		"batch-stale":    approvalticket.StatusREJECTED,
+		"batch-stale-c1": approvalticket.StatusREJECTED,
+		"batch-stale-c2": approvalticket.StatusREJECTED,
+	}
+	for id, status := range want {
+		ticket, err := client.ApprovalTicket.Get(t.Context(), id)
+		if err != nil {
+			t.Fatalf("get ticket %s: %v", id, err)
+		}
+		if ticket.Status != status {
+			t.Fatalf("ticket %s status = %s, want %s", id, ticket.Status, status)
+		}
+		if status != approvalticket.StatusREJECTED {
+			continue
+		}
+		if ticket.RejectReason != TicketAutoRejectReason || ticket.Approver != TicketExpiryActor {
+			t.Fatalf("ticket %s reject_reason=%q approver=%q", id, ticket.RejectReason, ticket.Approver)
+		}
+	}
+
+	event, err := client.DomainEvent.Get(t.Context(), "event-tkt-stale")
+	if err != nil {
+		t.Fatalf("get event: %v", err)
+	}
+	if event.Status != domainevent.StatusCANCELLED {
+		t.Fatalf("event status = %s, want CANCELLED", event.Status)
+	}
+	projection, err := client.BatchApprovalTicket.Get(t.Context(), "batch-stale")
+	if err != nil {
+		t.Fatalf("get batch projection: %v", err)
+	}
+	if projection.PendingCount != 0 || projection.FailedCount != 2 {
+		t.Fatalf("projection pending=%d failed=%d, want pending=0 failed=2", projection.PendingCount, projection.FailedCount)
+	}
+
+	entries, err := client.AuditLog.Query().
+		Where(auditlog.ActionIn("approval.rejected", "approval.batch_rejected")).
+		Count(t.Context())
+	if err != nil {
+		t.Fatalf("count audit logs: %v", err)
+	}
+	if entries != 2 {
+		t.Fatalf("rejection audit entries = %d, want 2", entries)
+	}
+	notified, err := client.Notification.Query().
+		Where(entnotification.ResourceIDIn("tkt-stale", "batch-stale")).
+		Count(t.Context())
+	if err != nil {
+		t.Fatalf("count notifications: %v", err)
+	}
+	if notified != 2 {
+		t.Fatalf("expiry notifications = %d, want 2", notified)
+	}
+}
+
+func mustCreateExpiryTicket(
+	t *testing.T,
+	client *ent.Client,
+	id, parentID string,
+	status approvalticket.Status,
+	createdAt time.Time,
+	eventType domain.EventType,
+) {
+	t.Helper()
+
+	eventID := "event-" + id
+	if _, err := client.DomainEvent.Create().
+		SetID(eventID).
+		SetEventType(string(eventType)).
+		SetAggregateType("vm").
+		SetAggregateID(id).
+		SetPayload([]byte(`{}`)).
+		SetCreatedBy("user-1").
+		Save(t.Context()); err != nil {
+		t.Fatalf("create event %s: %v", eventID, err)
+	}
+	create := client.ApprovalTicket.Create().
+		SetID(id).
+		SetEventID(eventID).
+		SetRequester("user-1").
+		SetStatus(status).
+		SetOperationType(approvalticket.OperationTypeCREATE).
+		SetCreatedAt(createdAt)
+	if parentID != "" {
+		create = create.SetParentTicketID(parentID)
+	}
+	if _, err := create.Save(t.Context()); err != nil {
+		t.Fatalf("create ticket %s: %v", id, err)
+	}
+}
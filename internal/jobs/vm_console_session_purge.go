@@ -0,0 +1,79 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/vmconsolesession"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+const (
+	// VMConsoleSessionPurgeInterval is the periodic schedule for console
+	// session cleanup.
+	VMConsoleSessionPurgeInterval = time.Hour
+	// VMConsoleSessionRetention is how long console sessions are kept after
+	// issuance. It exceeds the VNC token TTL, so only unusable rows are purged.
+	VMConsoleSessionRetention = 24 * time.Hour
+)
+
+// VMConsoleSessionPurgeArgs is a periodic maintenance job that deletes VNC
+// console sessions older than VMConsoleSessionRetention.
+type VMConsoleSessionPurgeArgs struct{}
+
+// Kind returns the job kind identifier for console session purge.
+func (VMConsoleSessionPurgeArgs) Kind() string { return "vm_console_session_purge" }
+
+// InsertOpts ensures at most one purge job is enqueued per interval.
+func (VMConsoleSessionPurgeArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       river.QueueDefault,
+		MaxAttempts: 1,
+		UniqueOpts: river.UniqueOpts{
+			ByPeriod: VMConsoleSessionPurgeInterval,
+			ByQueue:  true,
+			ByArgs:   true,
+		},
+	}
+}
+
+// VMConsoleSessionPurgeWorker bulk-deletes console sessions past retention.
+type VMConsoleSessionPurgeWorker struct {
+	river.WorkerDefaults[VMConsoleSessionPurgeArgs]
+	entClient *ent.Client
+	retention time.Duration
+}
+
+// NewVMConsoleSessionPurgeWorker creates a purge worker (ADR-0013 manual DI).
+func NewVMConsoleSessionPurgeWorker(entClient *ent.Client, retention time.Duration) *VMConsoleSessionPurgeWorker {
+	if retention <= 0 {
+		retention = VMConsoleSessionRetention
+	}
+	return &VMConsoleSessionPurgeWorker{entClient: entClient, retention: retention}
+}
+
+// Work removes sessions created before now-retention, revoked or not.
+func (w *VMConsoleSessionPurgeWorker) Work(ctx context.Context, _ *river.Job[VMConsoleSessionPurgeArgs]) error {
+	if w == nil || w.entClient == nil {
+		return fmt.Errorf("vm console session purge worker is not initialized")
+	}
+
+	cutoff := time.Now().UTC().Add(-w.retention)
+	purged, err := w.entClient.VMConsoleSession.Delete().
+		Where(vmconsolesession.CreatedAtLT(cutoff)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("purge vm console sessions created before %s: %w", cutoff.Format(time.RFC3339), err)
+	}
+
+	logger.Info("vm console session purge completed",
+		zap.Int("purged_rows", purged),
+		zap.String("cutoff", cutoff.Format(time.RFC3339)),
+	)
+	return nil
+}
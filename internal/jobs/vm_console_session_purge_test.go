@@ -0,0 +1,55 @@
+package jobs
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/riverqueue/river"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestVMConsoleSessionPurgeWorker(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "vm_console_session_purge")
+	now := time.Now().UTC()
+	seed := func(id string, createdAt time.Time, revoked bool) {
+		t.Helper()
+		create := client.VMConsoleSession.Create().
+			SetID(id).
+			SetVMID("vm-1").
+			SetUserID("user-1").
+			SetCreatedAt(createdAt).
+			SetExpiresAt(createdAt.Add(2 * time.Hour))
+		if revoked {
+			create = create.SetRevokedAt(createdAt.Add(time.Minute)).SetRevokedBy("admin-1")
+		}
+		if _, err := create.Save(t.Context()); err != nil {
+			t.Fatalf("seed session %s: %v", id, err)
+		}
+	}
+	seed("old", now.Add(-25*time.Hour), false)
+	seed("old-revoked", now.Add(-48*time.Hour), true)
+	seed("recent", now.Add(-time.Hour), false)
+	seed("recent-revoked", now.Add(-23*time.Hour), true)
+
+	worker := NewVMConsoleSessionPurgeWorker(client, 0)
+	for range 2 { // second run must be a no-op
+		if err := worker.Work(t.Context(), &river.Job[VMConsoleSessionPurgeArgs]{}); err != nil {
+			t.Fatalf("Work() error = %v", err)
+		}
+	}
+
+	remaining, err := client.VMConsoleSession.Query().
+		Order(ent.Asc("id")).
+		IDs(t.Context())
+	if err != nil {
+		t.Fatalf("list sessions: %v", err)
+	}
+	if want := []string{"recent", "recent-revoked"}; !slices.Equal(remaining, want) {
+		t.Fatalf("remaining sessions = %v, want %v", remaining, want)
+	}
+}
@@ -3,8 +3,8 @@ package jobs
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"strconv"
 	"strings"
 
 	"github.com/riverqueue/river"
@@ -18,9 +18,11 @@ import (
 	"kv-shepherd.io/shepherd/ent/vm"
 	"kv-shepherd.io/shepherd/internal/domain"
 	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/notification"
 	"kv-shepherd.io/shepherd/internal/pkg/logger"
 	"kv-shepherd.io/shepherd/internal/provider"
 	"kv-shepherd.io/shepherd/internal/service"
+	"kv-shepherd.io/shepherd/internal/service/vmspec"
 )
 
 // ---------------------------------------------------------------------------
@@ -57,7 +59,7 @@ func (VMCreateArgs) InsertOpts() river.InsertOpts {
 //  1. Fetch DomainEvent by EventID (claim-check, ADR-0009)
 //  2. Parse VMCreationPayload
 //  3. Fetch ApprovalTicket for admin-determined fields (cluster, storage)
-//  4. Build effective spec from payload + ticket modifications
+//  4. Build effective spec from payload + ticket modifications (vmspec.Build)
 //  5. Idempotency check: detect duplicate VM by event label
 //  6. Execute K8s VM creation via VMService (outside transaction, ADR-0012)
 //  7. Update event status to COMPLETED or FAILED
@@ -66,13 +68,24 @@ type VMCreateWorker struct {
 	entClient   *ent.Client
 	vmService   *service.VMService
 	auditLogger *audit.Logger
+	notifier    *notification.Triggers
 }
 
+// ErrNamespaceMissingOnCluster fails a create event whose registry namespace
+// has been deleted on the selected cluster; its message is the failure reason.
+var ErrNamespaceMissingOnCluster = errors.New("NAMESPACE_MISSING_ON_CLUSTER")
+
 // NewVMCreateWorker creates a new VMCreateWorker with all dependencies (ADR-0013 manual DI).
 func NewVMCreateWorker(entClient *ent.Client, vmService *service.VMService, auditLogger *audit.Logger) *VMCreateWorker {
 	return &VMCreateWorker{entClient: entClient, vmService: vmService, auditLogger: auditLogger}
 }
 
+// WithNotifier enables vm.created and vm.create_failed webhook events.
+func (w *VMCreateWorker) WithNotifier(notifier *notification.Triggers) *VMCreateWorker {
+	w.notifier = notifier
+	return w
+}
+
 // findCreatedVMByEvent performs an idempotency check by searching for an
 // existing VM that was already created by a prior attempt with the same eventID.
 func (w *VMCreateWorker) findCreatedVMByEvent(
@@ -168,76 +181,27 @@ func (w *VMCreateWorker) Work(ctx context.Context, job *river.Job[VMCreateArgs])
 	}
 
 	// Step 4: Build effective spec.
-	effectiveTemplateID, effectiveInstanceSizeID := resolveEffectiveSelectionIDs(payload, ticket.ModifiedSpec)
-	if effectiveTemplateID == "" {
-		return markFailed(fmt.Errorf("event %s has empty effective template id", eventID), true)
-	}
-	if effectiveInstanceSizeID == "" {
-		return markFailed(fmt.Errorf("event %s has empty effective instance size id", eventID), true)
-	}
-
-	vmRow, err := w.entClient.VM.Query().
-		Where(vm.TicketIDEQ(ticket.ID)).
-		Only(ctx)
-	if err != nil {
-		if ent.IsNotFound(err) {
-			return markFailed(fmt.Errorf("vm row missing for ticket %s", ticket.ID), true)
-		}
-		return fmt.Errorf("query vm row for ticket %s: %w", ticket.ID, err)
-	}
-	vmName := strings.TrimSpace(vmRow.Name)
-	if vmName == "" {
-		return markFailed(fmt.Errorf("vm row for ticket %s has empty name", ticket.ID), true)
-	}
-
-	size, err := w.entClient.InstanceSize.Get(ctx, effectiveInstanceSizeID)
+	built, err := vmspec.Build(ctx, w.entClient, vmspec.Input{
+		EventID:   eventID,
+		Payload:   payload,
+		Ticket:    ticket,
+		ClusterID: clusterID,
+	})
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return markFailed(fmt.Errorf("instance size %s not found", effectiveInstanceSizeID), true)
+		var permanent *vmspec.PermanentError
+		if errors.As(err, &permanent) {
+			return markFailed(err, true)
 		}
-		return fmt.Errorf("query instance size %s: %w", effectiveInstanceSizeID, err)
+		return err
 	}
-	cpu := size.CPUCores
-	memoryMB := size.MemoryMB
-	diskGB := size.DiskGB
-	applyInstanceSizeSnapshotOverrides(&cpu, &memoryMB, &diskGB, ticket.InstanceSizeSnapshot)
-	specOverrides := resolveInstanceSizeSpecOverrides(size.SpecOverrides, ticket.InstanceSizeSnapshot)
-
-	tpl, err := w.entClient.Template.Get(ctx, effectiveTemplateID)
-	if err != nil {
-		if ent.IsNotFound(err) {
-			return markFailed(fmt.Errorf("template %s not found", effectiveTemplateID), true)
-		}
-		return fmt.Errorf("query template %s: %w", effectiveTemplateID, err)
-	}
-	templateSpec := tpl.Spec
-	if len(ticket.TemplateSnapshot) > 0 {
-		templateSpec = ticket.TemplateSnapshot
-	}
-	image, err := extractTemplateImage(templateSpec)
-	if err != nil {
-		return markFailed(fmt.Errorf("resolve image from template %s: %w", effectiveTemplateID, err), true)
-	}
-
-	spec := &domain.VMSpec{
-		Name:     vmName,
-		CPU:      cpu,
-		MemoryMB: memoryMB,
-		DiskGB:   diskGB,
-		Image:    image,
-		Labels: map[string]string{
-			"shepherd.io/service-id":  payload.ServiceID,
-			"shepherd.io/template-id": effectiveTemplateID,
-			"shepherd.io/event-id":    eventID,
-		},
-		SpecOverrides: specOverrides,
-	}
-	applyModifiedSpecOverrides(spec, ticket.ModifiedSpec)
-	if spec.CPU <= 0 || spec.MemoryMB <= 0 || strings.TrimSpace(spec.Name) == "" || strings.TrimSpace(spec.Image) == "" {
-		return markFailed(fmt.Errorf(
-			"invalid effective vm spec for event %s (name=%q cpu=%d memory_mb=%d image=%q)",
-			eventID, spec.Name, spec.CPU, spec.MemoryMB, spec.Image,
-		), true)
+	spec, vmRow := built.Spec, built.VM
+	vmName := spec.Name
+	if len(built.DroppedOverrides) > 0 {
+		logger.Warn("ignored modified_spec fields outside the override whitelist",
+			zap.String("event_id", eventID),
+			zap.String("ticket_id", ticket.ID),
+			zap.Strings("fields", built.DroppedOverrides),
+		)
 	}
 
 	// Step 5: Idempotency check.
@@ -258,7 +222,12 @@ func (w *VMCreateWorker) Work(ctx context.Context, job *river.Job[VMCreateArgs])
 		)
 	} else {
 		// Step 6: Execute K8s VM creation (outside transaction per ADR-0012).
-		vmObj, err := w.vmService.ExecuteK8sCreate(ctx, clusterID, namespace, spec)
+		var vmObj *domain.VM
+		if spec.CloneSource != nil {
+			vmObj, err = w.vmService.ExecuteK8sClone(ctx, clusterID, namespace, spec)
+		} else {
+			vmObj, err = w.vmService.ExecuteK8sCreate(ctx, clusterID, namespace, spec)
+		}
 		if err != nil {
 			// K8s VM was NOT created — safe to retry.
 			// Persist FAILED status (best-effort; original error is returned regardless).
@@ -282,6 +251,16 @@ func (w *VMCreateWorker) Work(ctx context.Context, job *river.Job[VMCreateArgs])
 
 			logAuditVMOp(ctx, w.auditLogger, "create_failed", eventID, "system", eventID)
 			setTicketStatusByEvent(ctx, w.entClient, eventID, approvalticket.StatusFAILED)
+			if w.notifier != nil {
+				w.notifier.OnVMLifecycle(ctx, notification.WebhookEventVMCreateFailed, notification.VMLifecycleEvent{
+					VMID:     vmRow.ID,
+					VMName:   vmName,
+					TicketID: ticket.ID,
+					EventID:  eventID,
+					Actor:    ticket.Requester,
+					Reason:   err.Error(),
+				})
+			}
 
 			return fmt.Errorf("execute k8s create for event %s: %w", eventID, err)
 		}
@@ -310,6 +289,15 @@ func (w *VMCreateWorker) Work(ctx context.Context, job *river.Job[VMCreateArgs])
 	setTicketStatusByEvent(ctx, w.entClient, eventID, approvalticket.StatusSUCCESS)
 
 	logAuditVMOp(ctx, w.auditLogger, "create", createdVMName, "system", eventID)
+	if w.notifier != nil {
+		w.notifier.OnVMLifecycle(ctx, notification.WebhookEventVMCreated, notification.VMLifecycleEvent{
+			VMID:     vmRow.ID,
+			VMName:   createdVMName,
+			TicketID: ticket.ID,
+			EventID:  eventID,
+			Actor:    ticket.Requester,
+		})
+	}
 
 	logger.Info("VM creation job completed",
 		zap.String("event_id", eventID),
@@ -350,7 +338,32 @@ func (w *VMCreateWorker) ensureNamespaceClusterEnvironment(
 		return fmt.Errorf("namespace %s is disabled", ns.Name)
 	}
 
-	return validateNamespaceClusterEnvironment(string(ns.Environment), string(cl.Environment))
+	if err := validateNamespaceClusterEnvironment(string(ns.Environment), string(cl.Environment)); err != nil {
+		return err
+	}
+	return w.ensureNamespaceOnCluster(ctx, cl.ID, ns.Name)
+}
+
+// ensureNamespaceOnCluster fails with ErrNamespaceMissingOnCluster when the
+// registry namespace does not exist on the target cluster. A failed lookup
+// is only logged: the create call itself surfaces cluster errors.
+func (w *VMCreateWorker) ensureNamespaceOnCluster(ctx context.Context, clusterID, namespace string) error {
+	if w.vmService == nil {
+		return nil
+	}
+	exists, err := w.vmService.NamespaceExistsOnCluster(ctx, clusterID, namespace)
+	if err != nil {
+		logger.Warn("namespace existence check failed",
+			zap.String("cluster_id", clusterID),
+			zap.String("namespace", namespace),
+			zap.Error(err),
+		)
+		return nil
+	}
+	if !exists {
+		return fmt.Errorf("%w: namespace %s does not exist on cluster %s", ErrNamespaceMissingOnCluster, namespace, clusterID)
+	}
+	return nil
 }
 
 func validateNamespaceClusterEnvironment(namespaceEnv, clusterEnv string) error {
@@ -397,302 +410,3 @@ func mapCreatedVMStatusToRow(created *domain.VM) vm.Status {
 		return vm.StatusRUNNING
 	}
 }
-
-func resolveEffectiveSelectionIDs(
-	payload domain.VMCreationPayload,
-	modifiedSpec map[string]interface{},
-) (templateID, instanceSizeID string) {
-	templateID = strings.TrimSpace(payload.TemplateID)
-	instanceSizeID = strings.TrimSpace(payload.InstanceSizeID)
-	if override := lookupStringValue(modifiedSpec, "template_id"); override != "" {
-		templateID = override
-	}
-	if override := lookupStringValue(modifiedSpec, "instance_size_id"); override != "" {
-		instanceSizeID = override
-	}
-	return templateID, instanceSizeID
-}
-
-func applyInstanceSizeSnapshotOverrides(cpu, memoryMB, diskGB *int, snapshot map[string]interface{}) {
-	if cpu == nil || memoryMB == nil || diskGB == nil {
-		return
-	}
-	if v, ok := lookupIntValue(snapshot, "cpu_cores", "cpu"); ok {
-		*cpu = v
-	}
-	if v, ok := lookupIntValue(snapshot, "memory_mb", "memory"); ok {
-		*memoryMB = v
-	}
-	if v, ok := lookupIntValue(snapshot, "disk_gb", "disk"); ok && v >= 0 {
-		*diskGB = v
-	}
-}
-
-func resolveInstanceSizeSpecOverrides(
-	baseOverrides map[string]interface{},
-	snapshot map[string]interface{},
-) map[string]interface{} {
-	if snapOverrides := extractSpecOverridesFromSnapshot(snapshot); len(snapOverrides) > 0 {
-		return snapOverrides
-	}
-	return cloneMapValues(baseOverrides)
-}
-
-func extractSpecOverridesFromSnapshot(snapshot map[string]interface{}) map[string]interface{} {
-	if len(snapshot) == 0 {
-		return nil
-	}
-	if raw, ok := lookupValue(snapshot, "spec_overrides"); ok {
-		if overrides, ok := toMap(raw); ok {
-			return cloneMapValues(overrides)
-		}
-	}
-	// Backward compatibility: some rows may already store path->value pairs directly.
-	if isLikelySpecOverrideMap(snapshot) {
-		return cloneMapValues(snapshot)
-	}
-	return nil
-}
-
-func applyModifiedSpecOverrides(spec *domain.VMSpec, modifiedSpec map[string]interface{}) {
-	if spec == nil || len(modifiedSpec) == 0 {
-		return
-	}
-	if v, ok := lookupIntValue(modifiedSpec, "cpu", "resources.cpu"); ok {
-		spec.CPU = v
-	}
-	if v, ok := lookupIntValue(modifiedSpec, "memory_mb", "resources.memory_mb"); ok {
-		spec.MemoryMB = v
-	}
-	if v, ok := lookupIntValue(modifiedSpec, "disk_gb", "resources.disk_gb"); ok && v >= 0 {
-		spec.DiskGB = v
-	}
-	if image, err := extractTemplateImage(modifiedSpec); err == nil && strings.TrimSpace(image) != "" {
-		spec.Image = image
-	}
-	spec.SpecOverrides = applySpecOverridePatches(spec.SpecOverrides, extractSpecOverridesFromModifiedSpec(modifiedSpec))
-}
-
-func extractSpecOverridesFromModifiedSpec(modifiedSpec map[string]interface{}) map[string]interface{} {
-	if len(modifiedSpec) == 0 {
-		return nil
-	}
-	overrides := map[string]interface{}{}
-	if raw, ok := lookupValue(modifiedSpec, "spec_overrides"); ok {
-		if nested, ok := toMap(raw); ok {
-			for k, v := range nested {
-				key := strings.TrimSpace(k)
-				if key == "" {
-					continue
-				}
-				overrides[key] = v
-			}
-		}
-	}
-	for k, v := range modifiedSpec {
-		key := strings.TrimSpace(k)
-		if key == "" {
-			continue
-		}
-		if key == "spec" || strings.HasPrefix(key, "spec.") {
-			overrides[key] = v
-		}
-	}
-	if len(overrides) == 0 {
-		return nil
-	}
-	return overrides
-}
-
-func applySpecOverridePatches(
-	base map[string]interface{},
-	patches map[string]interface{},
-) map[string]interface{} {
-	if len(base) == 0 && len(patches) == 0 {
-		return nil
-	}
-	merged := cloneMapValues(base)
-	for k, v := range patches {
-		merged[k] = v
-	}
-	return merged
-}
-
-func cloneMapValues(src map[string]interface{}) map[string]interface{} {
-	if len(src) == 0 {
-		return nil
-	}
-	dst := make(map[string]interface{}, len(src))
-	for k, v := range src {
-		dst[k] = v
-	}
-	return dst
-}
-
-func isLikelySpecOverrideMap(values map[string]interface{}) bool {
-	for key := range values {
-		trimmed := strings.TrimSpace(key)
-		if trimmed == "spec" || strings.HasPrefix(trimmed, "spec.") {
-			return true
-		}
-	}
-	return false
-}
-
-func extractTemplateImage(templateSpec map[string]interface{}) (string, error) {
-	if image := lookupStringValue(templateSpec, "image", "image_source.image", "source.image"); image != "" {
-		return image, nil
-	}
-	if pvc := lookupStringValue(
-		templateSpec,
-		"pvc_name",
-		"image_source.pvc_name",
-		"image_source.pvc.name",
-		"source.pvc_name",
-		"source.pvc.name",
-	); pvc != "" {
-		return "pvc:" + pvc, nil
-	}
-
-	for _, path := range []string{
-		"spec.template.spec.volumes",
-		"template.spec.volumes",
-		"volumes",
-	} {
-		raw, ok := lookupValue(templateSpec, path)
-		if !ok {
-			continue
-		}
-		if image := extractImageFromVolumes(raw); image != "" {
-			return image, nil
-		}
-	}
-
-	return "", fmt.Errorf("no supported image source found in template spec")
-}
-
-func extractImageFromVolumes(raw interface{}) string {
-	items, ok := raw.([]interface{})
-	if !ok {
-		return ""
-	}
-	for _, item := range items {
-		volume, ok := item.(map[string]interface{})
-		if !ok {
-			continue
-		}
-		if containerDisk, ok := volume["containerDisk"].(map[string]interface{}); ok {
-			if image := strings.TrimSpace(toString(containerDisk["image"])); image != "" {
-				return image
-			}
-		}
-		if pvc, ok := volume["persistentVolumeClaim"].(map[string]interface{}); ok {
-			if claimName := strings.TrimSpace(toString(pvc["claimName"])); claimName != "" {
-				return "pvc:" + claimName
-			}
-		}
-	}
-	return ""
-}
-
-func lookupStringValue(values map[string]interface{}, paths ...string) string {
-	for _, path := range paths {
-		raw, ok := lookupValue(values, path)
-		if !ok {
-			continue
-		}
-		if str := strings.TrimSpace(toString(raw)); str != "" {
-			return str
-		}
-	}
-	return ""
-}
-
-func lookupIntValue(values map[string]interface{}, paths ...string) (int, bool) {
-	for _, path := range paths {
-		raw, ok := lookupValue(values, path)
-		if !ok {
-			continue
-		}
-		if v, ok := toInt(raw); ok {
-			return v, true
-		}
-	}
-	return 0, false
-}
-
-func lookupValue(values map[string]interface{}, path string) (interface{}, bool) {
-	if len(values) == 0 || path == "" {
-		return nil, false
-	}
-	if v, ok := values[path]; ok {
-		return v, true
-	}
-	current := interface{}(values)
-	for _, segment := range strings.Split(path, ".") {
-		m, ok := current.(map[string]interface{})
-		if !ok {
-			return nil, false
-		}
-		next, ok := m[segment]
-		if !ok {
-			return nil, false
-		}
-		current = next
-	}
-	return current, true
-}
-
-func toMap(raw interface{}) (map[string]interface{}, bool) {
-	switch v := raw.(type) {
-	case map[string]interface{}:
-		return v, true
-	default:
-		return nil, false
-	}
-}
-
-func toInt(raw interface{}) (int, bool) {
-	switch v := raw.(type) {
-	case int:
-		return v, true
-	case int8:
-		return int(v), true
-	case int16:
-		return int(v), true
-	case int32:
-		return int(v), true
-	case int64:
-		return int(v), true
-	case uint:
-		return int(v), true
-	case uint8:
-		return int(v), true
-	case uint16:
-		return int(v), true
-	case uint32:
-		return int(v), true
-	case uint64:
-		return int(v), true
-	case float32:
-		return int(v), true
-	case float64:
-		return int(v), true
-	case string:
-		i, err := strconv.Atoi(strings.TrimSpace(v))
-		if err == nil {
-			return i, true
-		}
-	}
-	return 0, false
-}
-
-func toString(raw interface{}) string {
-	if raw == nil {
-		return ""
-	}
-	if v, ok := raw.(string); ok {
-		return v
-	}
-	return fmt.Sprint(raw)
-}
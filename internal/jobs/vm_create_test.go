@@ -1,191 +1,16 @@
 package jobs
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	entvm "kv-shepherd.io/shepherd/ent/vm"
 	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/provider"
+	"kv-shepherd.io/shepherd/internal/service"
 )
 
-func TestExtractTemplateImage(t *testing.T) {
-	testCases := []struct {
-		name        string
-		spec        map[string]interface{}
-		expectImage string
-		expectErr   bool
-	}{
-		{
-			name: "direct image_source containerdisk",
-			spec: map[string]interface{}{
-				"image_source": map[string]interface{}{
-					"type":  "containerdisk",
-					"image": "docker.io/kubevirt/centos:7",
-				},
-			},
-			expectImage: "docker.io/kubevirt/centos:7",
-		},
-		{
-			name: "pvc source",
-			spec: map[string]interface{}{
-				"image_source": map[string]interface{}{
-					"type":     "pvc",
-					"pvc_name": "centos-base",
-				},
-			},
-			expectImage: "pvc:centos-base",
-		},
-		{
-			name: "volumes containerDisk fallback",
-			spec: map[string]interface{}{
-				"spec": map[string]interface{}{
-					"template": map[string]interface{}{
-						"spec": map[string]interface{}{
-							"volumes": []interface{}{
-								map[string]interface{}{
-									"name": "rootdisk",
-									"containerDisk": map[string]interface{}{
-										"image": "quay.io/kubevirt/fedora:40",
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-			expectImage: "quay.io/kubevirt/fedora:40",
-		},
-		{
-			name:      "missing image source",
-			spec:      map[string]interface{}{"foo": "bar"},
-			expectErr: true,
-		},
-	}
-
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			image, err := extractTemplateImage(tc.spec)
-			if tc.expectErr {
-				if err == nil {
-					t.Fatalf("expected error, got nil")
-				}
-				return
-			}
-			if err != nil {
-				t.Fatalf("unexpected error: %v", err)
-			}
-			if image != tc.expectImage {
-				t.Fatalf("image mismatch: got %q want %q", image, tc.expectImage)
-			}
-		})
-	}
-}
-
-func TestResolveEffectiveSelectionIDs(t *testing.T) {
-	payload := domain.VMCreationPayload{
-		TemplateID:     "tpl-A",
-		InstanceSizeID: "size-A",
-	}
-	templateID, instanceSizeID := resolveEffectiveSelectionIDs(payload, map[string]interface{}{
-		"template_id":      "tpl-B",
-		"instance_size_id": "size-B",
-	})
-
-	if templateID != "tpl-B" {
-		t.Fatalf("templateID mismatch: got %q", templateID)
-	}
-	if instanceSizeID != "size-B" {
-		t.Fatalf("instanceSizeID mismatch: got %q", instanceSizeID)
-	}
-}
-
-func TestApplyModifiedSpecOverrides(t *testing.T) {
-	spec := &domain.VMSpec{
-		Name:     "vm-01",
-		CPU:      2,
-		MemoryMB: 2048,
-		DiskGB:   10,
-		Image:    "old-image:1",
-		SpecOverrides: map[string]interface{}{
-			"spec.template.spec.domain.cpu.cores": float64(2),
-		},
-	}
-
-	applyModifiedSpecOverrides(spec, map[string]interface{}{
-		"cpu":       4,
-		"memory_mb": "4096",
-		"disk_gb":   20,
-		"image_source": map[string]interface{}{
-			"image": "new-image:2",
-		},
-		"spec_overrides": map[string]interface{}{
-			"spec.template.spec.domain.memory.hugepages.pageSize": "2Mi",
-		},
-		"spec.template.spec.domain.cpu.cores": float64(4),
-	})
-
-	if spec.CPU != 4 {
-		t.Fatalf("cpu mismatch: got %d", spec.CPU)
-	}
-	if spec.MemoryMB != 4096 {
-		t.Fatalf("memory mismatch: got %d", spec.MemoryMB)
-	}
-	if spec.DiskGB != 20 {
-		t.Fatalf("disk mismatch: got %d", spec.DiskGB)
-	}
-	if spec.Image != "new-image:2" {
-		t.Fatalf("image mismatch: got %q", spec.Image)
-	}
-	if got := spec.SpecOverrides["spec.template.spec.domain.cpu.cores"]; got != float64(4) {
-		t.Fatalf("spec_overrides cpu path mismatch: got %#v", got)
-	}
-	if got := spec.SpecOverrides["spec.template.spec.domain.memory.hugepages.pageSize"]; got != "2Mi" {
-		t.Fatalf("spec_overrides hugepages path mismatch: got %#v", got)
-	}
-}
-
-func TestResolveInstanceSizeSpecOverrides(t *testing.T) {
-	base := map[string]interface{}{
-		"spec.template.spec.domain.cpu.cores": float64(2),
-	}
-	snapshot := map[string]interface{}{
-		"spec_overrides": map[string]interface{}{
-			"spec.template.spec.domain.cpu.cores":                          float64(6),
-			"spec.template.spec.domain.memory.hugepages.pageSize":          "2Mi",
-			"spec.template.spec.domain.cpu.dedicatedCpuPlacement":          true,
-			"spec.template.spec.domain.resources.requests.memory":          "3072Mi",
-			"spec.template.spec.domain.resources.limits.memory":            "4096Mi",
-			"spec.template.spec.domain.devices.gpus":                       []interface{}{map[string]interface{}{"name": "gpu0", "deviceName": "nvidia.com/A10"}},
-			"spec.template.spec.domain.devices.networkInterfaceMultiqueue": true,
-		},
-	}
-
-	got := resolveInstanceSizeSpecOverrides(base, snapshot)
-	if len(got) == 0 {
-		t.Fatalf("expected snapshot overrides, got empty map")
-	}
-	if got["spec.template.spec.domain.cpu.cores"] != float64(6) {
-		t.Fatalf("expected snapshot cpu override, got %#v", got["spec.template.spec.domain.cpu.cores"])
-	}
-	if got["spec.template.spec.domain.memory.hugepages.pageSize"] != "2Mi" {
-		t.Fatalf("expected hugepages override, got %#v", got["spec.template.spec.domain.memory.hugepages.pageSize"])
-	}
-
-	// Snapshot should override base map for determinism.
-	if len(got) == len(base) {
-		t.Fatalf("expected snapshot map to replace base overrides")
-	}
-}
-
-func TestResolveInstanceSizeSpecOverrides_BackwardCompatibleFlatSnapshot(t *testing.T) {
-	snapshot := map[string]interface{}{
-		"spec.template.spec.domain.cpu.cores": float64(8),
-	}
-	got := resolveInstanceSizeSpecOverrides(nil, snapshot)
-	if got["spec.template.spec.domain.cpu.cores"] != float64(8) {
-		t.Fatalf("expected flat snapshot override to be used, got %#v", got["spec.template.spec.domain.cpu.cores"])
-	}
-}
-
 func TestMapCreatedVMStatusToRow(t *testing.T) {
 	testCases := []struct {
 		name   string
@@ -298,3 +123,17 @@ func TestValidateNamespaceClusterEnvironment(t *testing.T) {
 		})
 	}
 }
+
+func TestEnsureNamespaceOnCluster(t *testing.T) {
+	mock := provider.NewMockProvider()
+	mock.SeedNamespaces("prod-shop")
+	w := NewVMCreateWorker(nil, service.NewVMService(mock), nil)
+
+	if err := w.ensureNamespaceOnCluster(t.Context(), "cluster-a", "prod-shop"); err != nil {
+		t.Fatalf("existing namespace: unexpected error: %v", err)
+	}
+	err := w.ensureNamespaceOnCluster(t.Context(), "cluster-a", "prod-gone")
+	if !errors.Is(err, ErrNamespaceMissingOnCluster) || !strings.HasPrefix(err.Error(), "NAMESPACE_MISSING_ON_CLUSTER") {
+		t.Fatalf("missing namespace error = %v, want NAMESPACE_MISSING_ON_CLUSTER", err)
+	}
+}
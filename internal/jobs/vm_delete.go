@@ -14,6 +14,7 @@ import (
 	"kv-shepherd.io/shepherd/ent/vm"
 	"kv-shepherd.io/shepherd/internal/domain"
 	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/notification"
 	"kv-shepherd.io/shepherd/internal/pkg/logger"
 	"kv-shepherd.io/shepherd/internal/service"
 )
@@ -60,6 +61,7 @@ type VMDeleteWorker struct {
 	entClient   *ent.Client
 	vmService   *service.VMService
 	auditLogger *audit.Logger
+	notifier    *notification.Triggers
 }
 
 // NewVMDeleteWorker creates a new VMDeleteWorker with all dependencies (ADR-0013 manual DI).
@@ -67,6 +69,12 @@ func NewVMDeleteWorker(entClient *ent.Client, vmService *service.VMService, audi
 	return &VMDeleteWorker{entClient: entClient, vmService: vmService, auditLogger: auditLogger}
 }
 
+// WithNotifier enables vm.deleted and vm.delete_failed webhook events.
+func (w *VMDeleteWorker) WithNotifier(notifier *notification.Triggers) *VMDeleteWorker {
+	w.notifier = notifier
+	return w
+}
+
 // Work executes the VM deletion.
 func (w *VMDeleteWorker) Work(ctx context.Context, job *river.Job[VMDeleteArgs]) error {
 	eventID := job.Args.EventID
@@ -120,6 +128,7 @@ func (w *VMDeleteWorker) Work(ctx context.Context, job *river.Job[VMDeleteArgs])
 		setTicketStatusByEvent(ctx, w.entClient, eventID, approvalticket.StatusFAILED)
 
 		logAuditVMOp(ctx, w.auditLogger, "delete_failed", payload.VMName, payload.Actor, eventID)
+		w.notify(ctx, notification.WebhookEventVMDeleteFailed, payload, eventID, err.Error())
 		return fmt.Errorf("execute k8s delete for event %s: %w", eventID, err)
 	}
 
@@ -146,6 +155,7 @@ func (w *VMDeleteWorker) Work(ctx context.Context, job *river.Job[VMDeleteArgs])
 	setTicketStatusByEvent(ctx, w.entClient, eventID, approvalticket.StatusSUCCESS)
 
 	logAuditVMOp(ctx, w.auditLogger, "delete", payload.VMName, payload.Actor, eventID)
+	w.notify(ctx, notification.WebhookEventVMDeleted, payload, eventID, "")
 
 	logger.Info("VM deletion job completed",
 		zap.String("event_id", eventID),
@@ -153,3 +163,17 @@ func (w *VMDeleteWorker) Work(ctx context.Context, job *river.Job[VMDeleteArgs])
 	)
 	return nil
 }
+
+func (w *VMDeleteWorker) notify(ctx context.Context, eventType string, payload domain.VMDeletePayload, eventID, reason string) {
+	if w.notifier == nil {
+		return
+	}
+	w.notifier.OnVMLifecycle(ctx, eventType, notification.VMLifecycleEvent{
+		VMID:     payload.VMID,
+		VMName:   payload.VMName,
+		TicketID: ticketIDByEvent(ctx, w.entClient, eventID),
+		EventID:  eventID,
+		Actor:    payload.Actor,
+		Reason:   reason,
+	})
+}
@@ -0,0 +1,119 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/service"
+)
+
+// VMHostnameUpdateArgs carries EventID for VM hostname update jobs (Claim-check, ADR-0009).
+type VMHostnameUpdateArgs struct {
+	EventID string `json:"event_id"`
+}
+
+// Kind returns the job kind identifier for VM hostname updates.
+func (VMHostnameUpdateArgs) Kind() string { return "vm_hostname_update" }
+
+// InsertOpts returns default insert options for VM hostname update jobs.
+func (VMHostnameUpdateArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       "vm_operations",
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:  true,
+			ByQueue: true,
+		},
+	}
+}
+
+// VMHostnameUpdateWorker applies a requested hostname to the KubeVirt VM.
+//
+// Execution flow:
+//  1. Fetch DomainEvent by EventID (claim-check, ADR-0009)
+//  2. Parse VMHostnameUpdatePayload
+//  3. Set the kubevirt.io/domain annotation via VMService (outside transaction,
+//     ADR-0012); a VM already carrying the hostname is not touched, so retries
+//     are safe
+//  4. Update VM hostname in DB
+//  5. Update event status to COMPLETED or FAILED
+type VMHostnameUpdateWorker struct {
+	river.WorkerDefaults[VMHostnameUpdateArgs]
+	entClient   *ent.Client
+	vmService   *service.VMService
+	auditLogger *audit.Logger
+}
+
+// NewVMHostnameUpdateWorker creates a new VMHostnameUpdateWorker with all dependencies (ADR-0013 manual DI).
+func NewVMHostnameUpdateWorker(entClient *ent.Client, vmService *service.VMService, auditLogger *audit.Logger) *VMHostnameUpdateWorker {
+	return &VMHostnameUpdateWorker{entClient: entClient, vmService: vmService, auditLogger: auditLogger}
+}
+
+// Work executes the VM hostname update.
+func (w *VMHostnameUpdateWorker) Work(ctx context.Context, job *river.Job[VMHostnameUpdateArgs]) error {
+	eventID := job.Args.EventID
+
+	logger.Info("Processing VM hostname update",
+		zap.String("event_id", eventID),
+		zap.Int64("attempt", int64(job.Attempt)),
+	)
+
+	event, err := w.entClient.DomainEvent.Get(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("fetch domain event %s: %w", eventID, err)
+	}
+
+	var payload domain.VMHostnameUpdatePayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return river.JobCancel(fmt.Errorf("unmarshal hostname payload for event %s: %w", eventID, err))
+	}
+
+	changed, execErr := w.vmService.UpdateVMHostname(ctx, payload.ClusterID, payload.Namespace, payload.VMName, payload.Hostname)
+	if execErr != nil {
+		if _, saveErr := w.entClient.DomainEvent.UpdateOneID(eventID).
+			SetStatus(domainevent.StatusFAILED).
+			Save(ctx); saveErr != nil {
+			logger.Error("failed to persist FAILED status for hostname event",
+				zap.String("event_id", eventID), zap.Error(saveErr))
+		}
+
+		logAuditVMOp(ctx, w.auditLogger, "hostname_update_failed", payload.VMName, payload.Actor, eventID)
+		return fmt.Errorf("execute k8s hostname update for event %s: %w", eventID, execErr)
+	}
+
+	// CRITICAL: K8s annotation already applied.
+	if _, saveErr := w.entClient.VM.UpdateOneID(payload.VMID).
+		SetHostname(payload.Hostname).
+		Save(ctx); saveErr != nil {
+		logger.Error("CRITICAL: K8s hostname update succeeded but VM hostname update failed",
+			zap.String("event_id", eventID),
+			zap.String("vm_name", payload.VMName),
+			zap.Error(saveErr))
+	}
+
+	if _, saveErr := w.entClient.DomainEvent.UpdateOneID(eventID).
+		SetStatus(domainevent.StatusCOMPLETED).
+		Save(ctx); saveErr != nil {
+		logger.Error("CRITICAL: Hostname update completed but event status persistence failed",
+			zap.String("event_id", eventID), zap.Error(saveErr))
+	}
+
+	logAuditVMOp(ctx, w.auditLogger, "hostname_update", payload.VMName, payload.Actor, eventID)
+
+	logger.Info("VM hostname update completed",
+		zap.String("event_id", eventID),
+		zap.String("vm_name", payload.VMName),
+		zap.String("hostname", payload.Hostname),
+		zap.Bool("annotation_changed", changed),
+	)
+	return nil
+}
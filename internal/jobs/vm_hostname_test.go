@@ -0,0 +1,84 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/riverqueue/river"
+
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/provider"
+	"kv-shepherd.io/shepherd/internal/service"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+// annotationCountingProvider records annotation writes on top of the mock provider.
+type annotationCountingProvider struct {
+	*provider.MockProvider
+	writes int
+}
+
+func (p *annotationCountingProvider) SetVMAnnotation(ctx context.Context, cluster, namespace, name, key, value string) error {
+	p.writes++
+	return p.MockProvider.SetVMAnnotation(ctx, cluster, namespace, name, key, value)
+}
+
+func TestVMHostnameUpdateWorker_IsIdempotent(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "vm_hostname_worker")
+	svc := mustCreateSyncTestService(t, client)
+	row := mustCreateSyncTestVM(t, client, svc.ID, "vm-hostname", "cluster-a", vm.StatusRUNNING)
+
+	mock := &annotationCountingProvider{MockProvider: provider.NewMockProvider()}
+	mock.Seed([]*domain.VM{{Name: row.Name, Namespace: row.Namespace, Status: domain.VMStatusRunning}})
+	worker := NewVMHostnameUpdateWorker(client, service.NewVMService(mock), audit.NewLogger(client))
+
+	payload, err := domain.VMHostnameUpdatePayload{
+		VMID:      row.ID,
+		VMName:    row.Name,
+		ClusterID: row.ClusterID,
+		Namespace: row.Namespace,
+		Hostname:  "redis-01.shop.example.com",
+		Actor:     "owner-1",
+	}.ToJSON()
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+	client.DomainEvent.Create().
+		SetID("event-hostname").
+		SetEventType(string(domain.EventVMHostnameUpdateRequested)).
+		SetAggregateType("vm").
+		SetAggregateID(row.ID).
+		SetPayload(payload).
+		SetStatus(domainevent.StatusPENDING).
+		SetCreatedBy("owner-1").
+		SaveX(t.Context())
+
+	job := &river.Job[VMHostnameUpdateArgs]{Args: VMHostnameUpdateArgs{EventID: "event-hostname"}}
+	for attempt := 1; attempt <= 2; attempt++ {
+		if err := worker.Work(t.Context(), job); err != nil {
+			t.Fatalf("Work() attempt %d error = %v", attempt, err)
+		}
+	}
+
+	if mock.writes != 1 {
+		t.Fatalf("annotation writes = %d, want 1 (retry must skip an applied hostname)", mock.writes)
+	}
+	annotations, err := mock.GetVMAnnotations(t.Context(), row.ClusterID, row.Namespace, row.Name)
+	if err != nil {
+		t.Fatalf("GetVMAnnotations() error = %v", err)
+	}
+	if got := annotations[service.HostnameAnnotation]; got != "redis-01.shop.example.com" {
+		t.Fatalf("%s annotation = %q, want redis-01.shop.example.com", service.HostnameAnnotation, got)
+	}
+	if got := client.VM.GetX(t.Context(), row.ID).Hostname; got != "redis-01.shop.example.com" {
+		t.Fatalf("vm hostname = %q, want redis-01.shop.example.com", got)
+	}
+	if got := client.DomainEvent.GetX(t.Context(), "event-hostname").Status; got != domainevent.StatusCOMPLETED {
+		t.Fatalf("event status = %s, want COMPLETED", got)
+	}
+}
@@ -0,0 +1,166 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/service"
+)
+
+// ---------------------------------------------------------------------------
+// Job Args
+// ---------------------------------------------------------------------------
+
+// VMMigrateArgs carries EventID for cross-cluster VM migration jobs (Claim-check, ADR-0009).
+type VMMigrateArgs struct {
+	EventID string `json:"event_id"`
+}
+
+// Kind returns the job kind identifier for VM migration.
+func (VMMigrateArgs) Kind() string { return "vm_migrate" }
+
+// InsertOpts returns default insert options for VM migration jobs.
+func (VMMigrateArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       "vm_operations",
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:  true,
+			ByQueue: true,
+		},
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Worker
+// ---------------------------------------------------------------------------
+
+// VMMigrateWorker processes cross-cluster VM migration jobs after approval.
+//
+// Execution flow:
+//  1. Fetch DomainEvent by EventID (claim-check, ADR-0009)
+//  2. Parse VMMigratePayload
+//  3. Update VM status to MIGRATING
+//  4. Execute K8s migration via VMService (outside transaction, ADR-0012)
+//  5. Persist target cluster + RUNNING, or RUNNING on the source on failure
+//  6. Update event status to COMPLETED or FAILED
+type VMMigrateWorker struct {
+	river.WorkerDefaults[VMMigrateArgs]
+	entClient   *ent.Client
+	vmService   *service.VMService
+	auditLogger *audit.Logger
+}
+
+// NewVMMigrateWorker creates a new VMMigrateWorker with all dependencies (ADR-0013 manual DI).
+func NewVMMigrateWorker(entClient *ent.Client, vmService *service.VMService, auditLogger *audit.Logger) *VMMigrateWorker {
+	return &VMMigrateWorker{entClient: entClient, vmService: vmService, auditLogger: auditLogger}
+}
+
+// Work executes the VM migration.
+func (w *VMMigrateWorker) Work(ctx context.Context, job *river.Job[VMMigrateArgs]) error {
+	eventID := job.Args.EventID
+
+	logger.Info("Processing VM migration job",
+		zap.String("event_id", eventID),
+		zap.Int64("attempt", int64(job.Attempt)),
+	)
+
+	// Step 1: Fetch DomainEvent (claim-check pattern).
+	event, err := w.entClient.DomainEvent.Get(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("fetch domain event %s: %w", eventID, err)
+	}
+	setTicketStatusByEvent(ctx, w.entClient, eventID, approvalticket.StatusEXECUTING)
+
+	// Step 2: Parse payload.
+	var payload domain.VMMigratePayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		// Permanent failure — cancel job, don't retry corrupted data.
+		_, _ = w.entClient.DomainEvent.UpdateOneID(eventID).SetStatus(domainevent.StatusFAILED).Save(ctx)
+		setTicketStatusByEvent(ctx, w.entClient, eventID, approvalticket.StatusFAILED)
+		return river.JobCancel(fmt.Errorf("unmarshal migrate payload for event %s: %w", eventID, err))
+	}
+
+	// A previous attempt may have moved the VM and then failed before the
+	// event was closed; re-running would migrate it back onto itself.
+	row, err := w.entClient.VM.Get(ctx, payload.VMID)
+	if err != nil {
+		return fmt.Errorf("get vm %s: %w", payload.VMID, err)
+	}
+	if row.ClusterID != payload.TargetClusterID {
+		// Step 3: Update VM status to MIGRATING.
+		if _, err := w.entClient.VM.UpdateOneID(payload.VMID).
+			SetStatus(vm.StatusMIGRATING).
+			Save(ctx); err != nil {
+			return fmt.Errorf("set vm %s status to MIGRATING: %w", payload.VMID, err)
+		}
+
+		// Step 4: Execute K8s migration (outside transaction per ADR-0012).
+		if _, err := w.vmService.ExecuteK8sMigration(ctx,
+			payload.SourceClusterID, payload.TargetClusterID, payload.Namespace, payload.VMName,
+		); err != nil {
+			// Migration rolled back — the VM keeps running on the source cluster.
+			if _, saveErr := w.entClient.VM.UpdateOneID(payload.VMID).
+				SetStatus(vm.StatusRUNNING).
+				Save(ctx); saveErr != nil {
+				logger.Error("failed to restore VM RUNNING status after migration failure",
+					zap.String("vm_id", payload.VMID), zap.Error(saveErr))
+			}
+			if _, saveErr := w.entClient.DomainEvent.UpdateOneID(eventID).
+				SetStatus(domainevent.StatusFAILED).
+				Save(ctx); saveErr != nil {
+				logger.Error("failed to persist FAILED status for migrate event",
+					zap.String("event_id", eventID), zap.Error(saveErr))
+			}
+			setTicketStatusByEvent(ctx, w.entClient, eventID, approvalticket.StatusFAILED)
+
+			logAuditVMOp(ctx, w.auditLogger, "migrate_failed", payload.VMName, payload.Actor, eventID)
+			return fmt.Errorf("execute k8s migration for event %s: %w", eventID, err)
+		}
+
+		// Step 5: VM now lives on the target cluster.
+		// CRITICAL: the source VM is already gone at this point. If the DB
+		// update fails we MUST NOT return error (River retry would attempt
+		// to migrate a VM that no longer exists on the source).
+		if _, saveErr := w.entClient.VM.UpdateOneID(payload.VMID).
+			SetClusterID(payload.TargetClusterID).
+			SetStatus(vm.StatusRUNNING).
+			Save(ctx); saveErr != nil {
+			logger.Error("CRITICAL: VM migrated in K8s but DB cluster update failed",
+				zap.String("event_id", eventID),
+				zap.String("vm_name", payload.VMName),
+				zap.String("target_cluster", payload.TargetClusterID),
+				zap.Error(saveErr))
+		}
+	}
+
+	// Step 6: Update event status to COMPLETED.
+	if _, saveErr := w.entClient.DomainEvent.UpdateOneID(eventID).
+		SetStatus(domainevent.StatusCOMPLETED).
+		Save(ctx); saveErr != nil {
+		logger.Error("CRITICAL: VM migrated but event status persistence failed",
+			zap.String("event_id", eventID), zap.Error(saveErr))
+	}
+	setTicketStatusByEvent(ctx, w.entClient, eventID, approvalticket.StatusSUCCESS)
+
+	logAuditVMOp(ctx, w.auditLogger, "migrate", payload.VMName, payload.Actor, eventID)
+
+	logger.Info("VM migration job completed",
+		zap.String("event_id", eventID),
+		zap.String("vm_name", payload.VMName),
+		zap.String("source_cluster", payload.SourceClusterID),
+		zap.String("target_cluster", payload.TargetClusterID),
+	)
+	return nil
+}
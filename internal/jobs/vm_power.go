@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/riverqueue/river"
 	"go.uber.org/zap"
@@ -36,6 +37,10 @@ func (VMPowerArgs) InsertOpts() river.InsertOpts {
 	return river.InsertOpts{
 		Queue:       "vm_operations",
 		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:  true,
+			ByQueue: true,
+		},
 	}
 }
 
@@ -48,9 +53,10 @@ func (VMPowerArgs) InsertOpts() river.InsertOpts {
 // Execution flow:
 //  1. Fetch DomainEvent by EventID (claim-check, ADR-0009)
 //  2. Parse VMPowerPayload
-//  3. Execute K8s power operation via VMService (outside transaction, ADR-0012)
-//  4. Update VM status in DB
-//  5. Update event status to COMPLETED or FAILED
+//  3. Re-check live VM state; skip (CANCELLED) if it no longer allows the operation
+//  4. Execute K8s power operation via VMService (outside transaction, ADR-0012)
+//  5. Update VM status in DB
+//  6. Update event status to COMPLETED or FAILED
 type VMPowerWorker struct {
 	river.WorkerDefaults[VMPowerArgs]
 	entClient   *ent.Client
@@ -90,7 +96,22 @@ func (w *VMPowerWorker) Work(ctx context.Context, job *river.Job[VMPowerArgs]) e
 	// Use operation from Args (authoritative) over payload (informational).
 	operation := job.Args.Operation
 
-	// Step 3: Execute K8s power operation (outside transaction per ADR-0012).
+	// Step 3: Re-check live state. The VM may have changed since submission
+	// (a concurrent request, a guest shutdown); acting anyway would no-op or
+	// fail deep in the provider. A failed lookup falls through to the
+	// operation, which surfaces the real error.
+	if domain.PowerOperationSourceStatuses(operation) != nil {
+		live, err := w.vmService.GetVM(ctx, payload.ClusterID, payload.Namespace, payload.VMName)
+		if err != nil {
+			logger.Warn("live state check failed, proceeding with power operation",
+				zap.String("event_id", eventID), zap.String("vm_name", payload.VMName), zap.Error(err))
+		} else if !domain.CanApplyPowerOperation(operation, live.Status) {
+			w.skipPowerOp(ctx, eventID, payload, operation, live.Status)
+			return nil
+		}
+	}
+
+	// Step 4: Execute K8s power operation (outside transaction per ADR-0012).
 	var execErr error
 	switch operation {
 	case "start":
@@ -118,7 +139,7 @@ func (w *VMPowerWorker) Work(ctx context.Context, job *river.Job[VMPowerArgs]) e
 		return fmt.Errorf("execute k8s %s for event %s: %w", operation, eventID, execErr)
 	}
 
-	// Step 4: Update VM status in DB based on operation.
+	// Step 5: Update VM status in DB based on operation.
 	// CRITICAL: K8s operation already executed.
 	targetStatus := operationToStatus(operation)
 	if _, saveErr := w.entClient.VM.UpdateOneID(payload.VMID).
@@ -131,7 +152,7 @@ func (w *VMPowerWorker) Work(ctx context.Context, job *river.Job[VMPowerArgs]) e
 			zap.Error(saveErr))
 	}
 
-	// Step 5: Update event status to COMPLETED.
+	// Step 6: Update event status to COMPLETED.
 	if _, saveErr := w.entClient.DomainEvent.UpdateOneID(eventID).
 		SetStatus(domainevent.StatusCOMPLETED).
 		Save(ctx); saveErr != nil {
@@ -150,6 +171,43 @@ func (w *VMPowerWorker) Work(ctx context.Context, job *river.Job[VMPowerArgs]) e
 	return nil
 }
 
+// skipPowerOp settles a power job whose VM no longer allows the operation:
+// the stored VM status catches up with the live one, and the event and ticket
+// are CANCELLED with the reason rather than failed, since nothing was attempted.
+func (w *VMPowerWorker) skipPowerOp(ctx context.Context, eventID string, payload domain.VMPowerPayload, operation string, live domain.VMStatus) {
+	reason := "skipped: " + domain.PowerPreconditionMessage(operation, live)
+	logger.Info("Skipping VM power operation",
+		zap.String("event_id", eventID),
+		zap.String("vm_name", payload.VMName),
+		zap.String("reason", reason),
+	)
+
+	if status := vm.Status(live); vm.StatusValidator(status) == nil {
+		if _, err := w.entClient.VM.UpdateOneID(payload.VMID).SetStatus(status).Save(ctx); err != nil {
+			logger.Warn("failed to sync VM status after skipped power op",
+				zap.String("event_id", eventID), zap.Error(err))
+		}
+	}
+	if _, err := w.entClient.DomainEvent.UpdateOneID(eventID).
+		SetStatus(domainevent.StatusCANCELLED).
+		Save(ctx); err != nil {
+		logger.Error("failed to persist CANCELLED status for power event",
+			zap.String("event_id", eventID), zap.Error(err))
+	}
+	if _, err := w.entClient.ApprovalTicket.Update().
+		Where(approvalticket.EventIDEQ(eventID)).
+		SetStatus(approvalticket.StatusCANCELLED).
+		SetRejectReason(reason).
+		SetFinishedAt(time.Now().UTC()).
+		Save(ctx); err != nil {
+		logger.Warn("failed to cancel approval ticket for skipped power op",
+			zap.String("event_id", eventID), zap.Error(err))
+	}
+	syncParentBatchStatusByChildEvent(ctx, w.entClient, eventID)
+
+	logAuditVMOp(ctx, w.auditLogger, operation+"_skipped", payload.VMName, payload.Actor, eventID)
+}
+
 // operationToStatus maps a power operation to the expected VM status after execution.
 func operationToStatus(operation string) vm.Status {
 	switch operation {
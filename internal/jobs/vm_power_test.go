@@ -0,0 +1,126 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/riverqueue/river"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/auditlog"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/provider"
+	"kv-shepherd.io/shepherd/internal/service"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+// powerCountingProvider records power calls on top of the mock provider.
+type powerCountingProvider struct {
+	*provider.MockProvider
+	calls int
+}
+
+func (p *powerCountingProvider) StartVM(ctx context.Context, cluster, namespace, name string) error {
+	p.calls++
+	return p.MockProvider.StartVM(ctx, cluster, namespace, name)
+}
+
+func (p *powerCountingProvider) StopVM(ctx context.Context, cluster, namespace, name string) error {
+	p.calls++
+	return p.MockProvider.StopVM(ctx, cluster, namespace, name)
+}
+
+func TestVMPowerWorker_RechecksLiveState(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "vm_power_worker")
+	svc := mustCreateSyncTestService(t, client)
+	// The DB still says STOPPED, but the VM was started out of band after
+	// the batch was submitted.
+	raced := mustCreateSyncTestVM(t, client, svc.ID, "vm-raced", "cluster-a", vm.StatusSTOPPED)
+	stoppable := mustCreateSyncTestVM(t, client, svc.ID, "vm-stoppable", "cluster-a", vm.StatusRUNNING)
+
+	mock := &powerCountingProvider{MockProvider: provider.NewMockProvider()}
+	mock.Seed([]*domain.VM{
+		{Name: raced.Name, Namespace: raced.Namespace, Status: domain.VMStatusRunning},
+		{Name: stoppable.Name, Namespace: stoppable.Namespace, Status: domain.VMStatusRunning},
+	})
+	worker := NewVMPowerWorker(client, service.NewVMService(mock), audit.NewLogger(client))
+
+	tests := []struct {
+		name       string
+		row        *ent.VM
+		operation  string
+		eventType  domain.EventType
+		wantCalls  int
+		wantEvent  domainevent.Status
+		wantTicket approvalticket.Status
+		wantReason string
+		wantStatus vm.Status
+	}{
+		{name: "raced start is skipped", row: raced, operation: "start", eventType: domain.EventVMStartRequested, wantCalls: 0,
+			wantEvent: domainevent.StatusCANCELLED, wantTicket: approvalticket.StatusCANCELLED,
+			wantReason: "skipped: cannot start VM in RUNNING state, must be STOPPED or FAILED", wantStatus: vm.StatusRUNNING},
+		{name: "valid stop runs", row: stoppable, operation: "stop", eventType: domain.EventVMStopRequested, wantCalls: 1,
+			wantEvent: domainevent.StatusCOMPLETED, wantTicket: approvalticket.StatusSUCCESS, wantStatus: vm.StatusSTOPPED},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			eventID := "event-" + tc.row.ID
+			payload, err := domain.VMPowerPayload{
+				VMID:      tc.row.ID,
+				VMName:    tc.row.Name,
+				ClusterID: tc.row.ClusterID,
+				Namespace: tc.row.Namespace,
+				Operation: tc.operation,
+				Actor:     "owner-1",
+			}.ToJSON()
+			if err != nil {
+				t.Fatalf("marshal payload: %v", err)
+			}
+			client.DomainEvent.Create().
+				SetID(eventID).
+				SetEventType(string(tc.eventType)).
+				SetAggregateType("vm").
+				SetAggregateID(tc.row.ID).
+				SetPayload(payload).
+				SetStatus(domainevent.StatusPENDING).
+				SetCreatedBy("owner-1").
+				SaveX(t.Context())
+			client.ApprovalTicket.Create().
+				SetID("ticket-" + tc.row.ID).
+				SetEventID(eventID).
+				SetRequester("owner-1").
+				SetStatus(approvalticket.StatusEXECUTING).
+				SaveX(t.Context())
+
+			before := mock.calls
+			job := &river.Job[VMPowerArgs]{Args: VMPowerArgs{EventID: eventID, Operation: tc.operation}}
+			if err := worker.Work(t.Context(), job); err != nil {
+				t.Fatalf("Work() error = %v", err)
+			}
+
+			if got := mock.calls - before; got != tc.wantCalls {
+				t.Fatalf("provider power calls = %d, want %d", got, tc.wantCalls)
+			}
+			if got := client.DomainEvent.GetX(t.Context(), eventID).Status; got != tc.wantEvent {
+				t.Fatalf("event status = %s, want %s", got, tc.wantEvent)
+			}
+			ticket := client.ApprovalTicket.GetX(t.Context(), "ticket-"+tc.row.ID)
+			if ticket.Status != tc.wantTicket || ticket.RejectReason != tc.wantReason {
+				t.Fatalf("ticket status=%s reason=%q, want %s %q", ticket.Status, ticket.RejectReason, tc.wantTicket, tc.wantReason)
+			}
+			if got := client.VM.GetX(t.Context(), tc.row.ID).Status; got != tc.wantStatus {
+				t.Fatalf("vm status = %s, want %s", got, tc.wantStatus)
+			}
+		})
+	}
+
+	if n := client.AuditLog.Query().Where(auditlog.ActionEQ("vm.start_skipped")).CountX(t.Context()); n != 1 {
+		t.Fatalf("start_skipped audit rows = %d, want 1", n)
+	}
+}
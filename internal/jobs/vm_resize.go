@@ -0,0 +1,193 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/service"
+)
+
+// ---------------------------------------------------------------------------
+// Job Args
+// ---------------------------------------------------------------------------
+
+// VMResizeArgs carries EventID for VM resize jobs (Claim-check, ADR-0009).
+type VMResizeArgs struct {
+	EventID string `json:"event_id"`
+}
+
+// Kind returns the job kind identifier for VM resize.
+func (VMResizeArgs) Kind() string { return "vm_resize" }
+
+// InsertOpts returns default insert options for VM resize jobs.
+func (VMResizeArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       "vm_operations",
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:  true,
+			ByQueue: true,
+		},
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Worker
+// ---------------------------------------------------------------------------
+
+// VMResizeWorker processes VM resize jobs after approval.
+//
+// Execution flow:
+//  1. Fetch DomainEvent by EventID (claim-check, ADR-0009)
+//  2. Parse VMResizePayload and resolve the approved size (ticket modified_spec)
+//  3. Re-check VM state (must still be RUNNING or STOPPED) and mark it RESIZING
+//  4. Apply the size via VMService: hot-plug/live-migrate a RUNNING VM, or
+//     patch the spec and restart when the change cannot be applied live
+//  5. Restore the VM status and update event status to COMPLETED or FAILED
+type VMResizeWorker struct {
+	river.WorkerDefaults[VMResizeArgs]
+	entClient   *ent.Client
+	vmService   *service.VMService
+	auditLogger *audit.Logger
+}
+
+// NewVMResizeWorker creates a new VMResizeWorker with all dependencies (ADR-0013 manual DI).
+func NewVMResizeWorker(entClient *ent.Client, vmService *service.VMService, auditLogger *audit.Logger) *VMResizeWorker {
+	return &VMResizeWorker{entClient: entClient, vmService: vmService, auditLogger: auditLogger}
+}
+
+// Work executes the VM resize.
+func (w *VMResizeWorker) Work(ctx context.Context, job *river.Job[VMResizeArgs]) error {
+	eventID := job.Args.EventID
+
+	logger.Info("Processing VM resize job",
+		zap.String("event_id", eventID),
+		zap.Int64("attempt", int64(job.Attempt)),
+	)
+
+	// Step 1: Fetch DomainEvent (claim-check pattern).
+	event, err := w.entClient.DomainEvent.Get(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("fetch domain event %s: %w", eventID, err)
+	}
+	setTicketStatusByEvent(ctx, w.entClient, eventID, approvalticket.StatusEXECUTING)
+
+	markFailed := func(cause error) {
+		if _, saveErr := w.entClient.DomainEvent.UpdateOneID(eventID).
+			SetStatus(domainevent.StatusFAILED).
+			Save(ctx); saveErr != nil {
+			logger.Error("failed to persist FAILED status for resize event",
+				zap.String("event_id", eventID), zap.Error(saveErr))
+		}
+		setTicketStatusByEvent(ctx, w.entClient, eventID, approvalticket.StatusFAILED)
+		logger.Warn("VM resize job failed", zap.String("event_id", eventID), zap.Error(cause))
+	}
+
+	// Step 2: Parse payload.
+	var payload domain.VMResizePayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		// Permanent failure — cancel job, don't retry corrupted data.
+		err = fmt.Errorf("unmarshal resize payload for event %s: %w", eventID, err)
+		markFailed(err)
+		return river.JobCancel(err)
+	}
+
+	target := payload.To
+	ticket, err := w.entClient.ApprovalTicket.Query().
+		Where(approvalticket.EventIDEQ(eventID)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("get resize ticket for event %s: %w", eventID, err)
+	}
+	if ticket != nil {
+		target = payload.Target(ticket.ModifiedSpec)
+	}
+
+	// Step 3: The VM may have changed state while the ticket waited for approval.
+	row, err := w.entClient.VM.Get(ctx, payload.VMID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			err = fmt.Errorf("vm %s no longer exists", payload.VMID)
+			markFailed(err)
+			return river.JobCancel(err)
+		}
+		return fmt.Errorf("get vm %s: %w", payload.VMID, err)
+	}
+	var settled vm.Status // status to restore once the resize ends
+	switch row.Status {
+	case vm.StatusRUNNING, vm.StatusSTOPPED:
+		settled = row.Status
+	case vm.StatusRESIZING:
+		// A previous attempt failed mid-way; ask the cluster whether it runs.
+		live, err := w.vmService.GetVM(ctx, row.ClusterID, row.Namespace, row.Name)
+		if err != nil {
+			return fmt.Errorf("get live state of vm %s: %w", payload.VMID, err)
+		}
+		settled = vm.StatusSTOPPED
+		if live.Status == domain.VMStatusRunning {
+			settled = vm.StatusRUNNING
+		}
+	default:
+		err = fmt.Errorf("cannot resize vm %s in %s state", payload.VMID, row.Status)
+		markFailed(err)
+		logAuditVMOp(ctx, w.auditLogger, "resize_failed", payload.VMName, payload.Actor, eventID)
+		return river.JobCancel(err)
+	}
+	if _, err := w.entClient.VM.UpdateOneID(payload.VMID).
+		SetStatus(vm.StatusRESIZING).
+		Save(ctx); err != nil {
+		return fmt.Errorf("set vm %s status to RESIZING: %w", payload.VMID, err)
+	}
+	restoreStatus := func() {
+		if _, saveErr := w.entClient.VM.UpdateOneID(payload.VMID).
+			SetStatus(settled).
+			Save(ctx); saveErr != nil {
+			logger.Error("failed to restore VM status after resize",
+				zap.String("vm_id", payload.VMID), zap.String("status", settled.String()), zap.Error(saveErr))
+		}
+	}
+
+	// Step 4: Apply the size (outside transaction per ADR-0012).
+	restarted, err := w.vmService.ExecuteK8sResize(ctx,
+		row.ClusterID, row.Namespace, row.Name, payload.From, target, settled == vm.StatusRUNNING,
+	)
+	if err != nil {
+		restoreStatus()
+		markFailed(err)
+		logAuditVMOp(ctx, w.auditLogger, "resize_failed", payload.VMName, payload.Actor, eventID)
+		return fmt.Errorf("execute k8s resize for event %s: %w", eventID, err)
+	}
+
+	// Step 5: Restore VM status and update event status to COMPLETED.
+	restoreStatus()
+	if _, saveErr := w.entClient.DomainEvent.UpdateOneID(eventID).
+		SetStatus(domainevent.StatusCOMPLETED).
+		Save(ctx); saveErr != nil {
+		logger.Error("CRITICAL: VM resized but event status persistence failed",
+			zap.String("event_id", eventID), zap.Error(saveErr))
+	}
+	setTicketStatusByEvent(ctx, w.entClient, eventID, approvalticket.StatusSUCCESS)
+
+	logAuditVMOp(ctx, w.auditLogger, "resize", payload.VMName, payload.Actor, eventID)
+
+	logger.Info("VM resize job completed",
+		zap.String("event_id", eventID),
+		zap.String("vm_name", payload.VMName),
+		zap.Int("cpu", target.CPU),
+		zap.Int("memory_mb", target.MemoryMB),
+		zap.Int("disk_gb", target.DiskGB),
+		zap.Bool("restarted", restarted),
+	)
+	return nil
+}
@@ -0,0 +1,156 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/riverqueue/river"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/provider"
+	"kv-shepherd.io/shepherd/internal/service"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+// restartCountingProvider records restarts on top of the mock provider.
+type restartCountingProvider struct {
+	*provider.MockProvider
+	restarts int
+}
+
+func (p *restartCountingProvider) RestartVM(ctx context.Context, cluster, namespace, name string) error {
+	p.restarts++
+	return p.MockProvider.RestartVM(ctx, cluster, namespace, name)
+}
+
+func seedResizeEvent(t *testing.T, client *ent.Client, eventID string, row *ent.VM, to domain.VMSize, modifiedSpec map[string]interface{}) {
+	t.Helper()
+
+	payload, err := domain.VMResizePayload{
+		VMID:      row.ID,
+		VMName:    row.Name,
+		ClusterID: row.ClusterID,
+		Namespace: row.Namespace,
+		From:      domain.VMSize{CPU: 2, MemoryMB: 4096, DiskGB: 50},
+		To:        to,
+		Actor:     "owner-1",
+	}.ToJSON()
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+	client.DomainEvent.Create().
+		SetID(eventID).
+		SetEventType(string(domain.EventVMResizeRequested)).
+		SetAggregateType("vm").
+		SetAggregateID(row.ID).
+		SetPayload(payload).
+		SetStatus(domainevent.StatusPROCESSING).
+		SetCreatedBy("owner-1").
+		SaveX(t.Context())
+	create := client.ApprovalTicket.Create().
+		SetID("ticket-" + eventID).
+		SetEventID(eventID).
+		SetRequester("owner-1").
+		SetOperationType(approvalticket.OperationTypeRESIZE).
+		SetStatus(approvalticket.StatusAPPROVED)
+	if modifiedSpec != nil {
+		create.SetModifiedSpec(modifiedSpec)
+	}
+	create.SaveX(t.Context())
+}
+
+func TestVMResizeWorker(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "vm_resize_worker")
+	svc := mustCreateSyncTestService(t, client)
+	running := mustCreateSyncTestVM(t, client, svc.ID, "vm-running", "cluster-a", vm.StatusRUNNING)
+	stopped := mustCreateSyncTestVM(t, client, svc.ID, "vm-stopped", "cluster-a", vm.StatusSTOPPED)
+	growDisk := mustCreateSyncTestVM(t, client, svc.ID, "vm-grow-disk", "cluster-a", vm.StatusRUNNING)
+	approverPick := mustCreateSyncTestVM(t, client, svc.ID, "vm-approver-pick", "cluster-a", vm.StatusRUNNING)
+	interrupted := mustCreateSyncTestVM(t, client, svc.ID, "vm-interrupted", "cluster-a", vm.StatusRESIZING)
+	deleting := mustCreateSyncTestVM(t, client, svc.ID, "vm-deleting", "cluster-a", vm.StatusDELETING)
+
+	mock := &restartCountingProvider{MockProvider: provider.NewMockProvider()}
+	var seeded []*domain.VM
+	for _, row := range []*ent.VM{running, stopped, growDisk, approverPick, interrupted, deleting} {
+		status := domain.VMStatusRunning
+		if row == stopped {
+			status = domain.VMStatusStopped
+		}
+		seeded = append(seeded, &domain.VM{
+			Name: row.Name, Namespace: row.Namespace, Status: status,
+			Spec: domain.VMSpec{CPU: 2, MemoryMB: 4096, DiskGB: 50},
+		})
+	}
+	mock.Seed(seeded)
+	worker := NewVMResizeWorker(client, service.NewVMService(mock), audit.NewLogger(client))
+
+	large := domain.VMSize{InstanceSizeID: "size-large", CPU: 4, MemoryMB: 8192, DiskGB: 50}
+	tests := []struct {
+		name         string
+		row          *ent.VM
+		to           domain.VMSize
+		modifiedSpec map[string]interface{}
+		wantErr      bool
+		wantEvent    domainevent.Status
+		wantTicket   approvalticket.Status
+		wantStatus   vm.Status
+		wantCPU      int
+		wantDiskGB   int
+		wantRestarts int
+	}{
+		{name: "running vm is resized live", row: running, to: large,
+			wantEvent: domainevent.StatusCOMPLETED, wantTicket: approvalticket.StatusSUCCESS, wantStatus: vm.StatusRUNNING, wantCPU: 4, wantDiskGB: 50, wantRestarts: 0},
+		{name: "stopped vm is patched only", row: stopped, to: large,
+			wantEvent: domainevent.StatusCOMPLETED, wantTicket: approvalticket.StatusSUCCESS, wantStatus: vm.StatusSTOPPED, wantCPU: 4, wantDiskGB: 50, wantRestarts: 0},
+		{name: "growing disk restarts running vm", row: growDisk, to: domain.VMSize{CPU: 2, MemoryMB: 4096, DiskGB: 80},
+			wantEvent: domainevent.StatusCOMPLETED, wantTicket: approvalticket.StatusSUCCESS, wantStatus: vm.StatusRUNNING, wantCPU: 2, wantDiskGB: 80, wantRestarts: 1},
+		{name: "approver selection wins", row: approverPick, to: large,
+			modifiedSpec: map[string]interface{}{"cpu": 6, "memory_mb": 12288, "disk_gb": 50},
+			wantEvent:    domainevent.StatusCOMPLETED, wantTicket: approvalticket.StatusSUCCESS, wantStatus: vm.StatusRUNNING, wantCPU: 6, wantDiskGB: 50, wantRestarts: 1},
+		{name: "interrupted resize resumes from live state", row: interrupted, to: large,
+			wantEvent: domainevent.StatusCOMPLETED, wantTicket: approvalticket.StatusSUCCESS, wantStatus: vm.StatusRUNNING, wantCPU: 4, wantDiskGB: 50, wantRestarts: 1},
+		{name: "vm left resizable state", row: deleting, to: large, wantErr: true,
+			wantEvent: domainevent.StatusFAILED, wantTicket: approvalticket.StatusFAILED, wantStatus: vm.StatusDELETING, wantCPU: 2, wantDiskGB: 50, wantRestarts: 1},
+	}
+	// Subtests share the provider, so they run sequentially and restarts accumulate.
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			eventID := "event-resize-" + tc.row.ID
+			seedResizeEvent(t, client, eventID, tc.row, tc.to, tc.modifiedSpec)
+
+			err := worker.Work(t.Context(), &river.Job[VMResizeArgs]{Args: VMResizeArgs{EventID: eventID}})
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Work() error = %v, wantErr %v", err, tc.wantErr)
+			}
+
+			event := client.DomainEvent.GetX(t.Context(), eventID)
+			if event.Status != tc.wantEvent {
+				t.Fatalf("event status = %s, want %s", event.Status, tc.wantEvent)
+			}
+			ticket := client.ApprovalTicket.GetX(t.Context(), "ticket-"+eventID)
+			if ticket.Status != tc.wantTicket {
+				t.Fatalf("ticket status = %s, want %s", ticket.Status, tc.wantTicket)
+			}
+			if row := client.VM.GetX(t.Context(), tc.row.ID); row.Status != tc.wantStatus {
+				t.Fatalf("vm status = %s, want %s", row.Status, tc.wantStatus)
+			}
+			live, err := mock.GetVM(t.Context(), tc.row.ClusterID, tc.row.Namespace, tc.row.Name)
+			if err != nil {
+				t.Fatalf("get live vm: %v", err)
+			}
+			if live.Spec.CPU != tc.wantCPU || live.Spec.DiskGB != tc.wantDiskGB {
+				t.Fatalf("live cpu/disk = %d/%d, want %d/%d", live.Spec.CPU, live.Spec.DiskGB, tc.wantCPU, tc.wantDiskGB)
+			}
+			if mock.restarts != tc.wantRestarts {
+				t.Fatalf("restarts = %d, want %d", mock.restarts, tc.wantRestarts)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/service"
+)
+
+// ---------------------------------------------------------------------------
+// Job Args
+// ---------------------------------------------------------------------------
+
+// VMSnapshotArgs carries EventID for VM snapshot create and restore jobs
+// (Claim-check, ADR-0009). The operation is derived from the event type.
+type VMSnapshotArgs struct {
+	EventID string `json:"event_id"`
+}
+
+// Kind returns the job kind identifier for VM snapshot operations.
+func (VMSnapshotArgs) Kind() string { return "vm_snapshot" }
+
+// InsertOpts returns default insert options for VM snapshot jobs.
+func (VMSnapshotArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       "vm_operations",
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:  true,
+			ByQueue: true,
+		},
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Worker
+// ---------------------------------------------------------------------------
+
+// VMSnapshotWorker creates VM snapshots and restores VMs from them.
+//
+// Execution flow:
+//  1. Fetch DomainEvent by EventID (claim-check, ADR-0009)
+//  2. Parse VMSnapshotPayload
+//  3. Re-check VM state; a restore refuses a RUNNING VM unless forced
+//  4. Create the snapshot or run the restore via VMService, waiting for it to finish
+//  5. Update event status to COMPLETED or FAILED
+type VMSnapshotWorker struct {
+	river.WorkerDefaults[VMSnapshotArgs]
+	entClient   *ent.Client
+	vmService   *service.VMService
+	auditLogger *audit.Logger
+}
+
+// NewVMSnapshotWorker creates a new VMSnapshotWorker with all dependencies (ADR-0013 manual DI).
+func NewVMSnapshotWorker(entClient *ent.Client, vmService *service.VMService, auditLogger *audit.Logger) *VMSnapshotWorker {
+	return &VMSnapshotWorker{entClient: entClient, vmService: vmService, auditLogger: auditLogger}
+}
+
+// Work executes the VM snapshot operation.
+func (w *VMSnapshotWorker) Work(ctx context.Context, job *river.Job[VMSnapshotArgs]) error {
+	eventID := job.Args.EventID
+
+	logger.Info("Processing VM snapshot job",
+		zap.String("event_id", eventID),
+		zap.Int64("attempt", int64(job.Attempt)),
+	)
+
+	// Step 1: Fetch DomainEvent (claim-check pattern).
+	event, err := w.entClient.DomainEvent.Get(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("fetch domain event %s: %w", eventID, err)
+	}
+
+	var action string
+	switch domain.EventType(event.EventType) {
+	case domain.EventVMSnapshotCreateRequested:
+		action = "snapshot"
+	case domain.EventVMSnapshotRestoreRequested:
+		action = "snapshot_restore"
+	default:
+		return river.JobCancel(fmt.Errorf("event %s has type %s, not a snapshot operation", eventID, event.EventType))
+	}
+
+	if _, err := w.entClient.DomainEvent.UpdateOneID(eventID).
+		SetStatus(domainevent.StatusPROCESSING).
+		Save(ctx); err != nil {
+		return fmt.Errorf("set event %s to PROCESSING: %w", eventID, err)
+	}
+	setTicketStatusByEvent(ctx, w.entClient, eventID, approvalticket.StatusEXECUTING)
+
+	markFailed := func(cause error) {
+		if _, saveErr := w.entClient.DomainEvent.UpdateOneID(eventID).
+			SetStatus(domainevent.StatusFAILED).
+			Save(ctx); saveErr != nil {
+			logger.Error("failed to persist FAILED status for snapshot event",
+				zap.String("event_id", eventID), zap.Error(saveErr))
+		}
+		setTicketStatusByEvent(ctx, w.entClient, eventID, approvalticket.StatusFAILED)
+		logger.Warn("VM snapshot job failed", zap.String("event_id", eventID), zap.Error(cause))
+	}
+
+	// Step 2: Parse payload.
+	var payload domain.VMSnapshotPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		// Permanent failure — cancel job, don't retry corrupted data.
+		err = fmt.Errorf("unmarshal snapshot payload for event %s: %w", eventID, err)
+		markFailed(err)
+		return river.JobCancel(err)
+	}
+
+	// Step 3: The VM may have changed state since the request was accepted.
+	row, err := w.entClient.VM.Get(ctx, payload.VMID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			err = fmt.Errorf("vm %s no longer exists", payload.VMID)
+			markFailed(err)
+			return river.JobCancel(err)
+		}
+		return fmt.Errorf("get vm %s: %w", payload.VMID, err)
+	}
+	if row.Status != vm.StatusRUNNING && row.Status != vm.StatusSTOPPED {
+		err = fmt.Errorf("cannot %s vm %s in %s state", action, payload.VMID, row.Status)
+		markFailed(err)
+		w.logAudit(ctx, action+"_failed", payload, eventID)
+		return river.JobCancel(err)
+	}
+	if action == "snapshot_restore" && row.Status == vm.StatusRUNNING && !payload.Force {
+		err = fmt.Errorf("vm %s is running; restore requires force", payload.VMID)
+		markFailed(err)
+		w.logAudit(ctx, action+"_failed", payload, eventID)
+		return river.JobCancel(err)
+	}
+
+	// Step 4: Run the operation (outside transaction per ADR-0012).
+	if action == "snapshot" {
+		_, err = w.vmService.ExecuteK8sSnapshot(ctx, row.ClusterID, row.Namespace, row.Name, payload.SnapshotName)
+	} else {
+		_, err = w.vmService.ExecuteK8sRestore(ctx, row.ClusterID, row.Namespace, row.Name, payload.SnapshotName, payload.Force)
+	}
+	if err != nil {
+		markFailed(err)
+		w.logAudit(ctx, action+"_failed", payload, eventID)
+		return fmt.Errorf("execute k8s %s for event %s: %w", action, eventID, err)
+	}
+
+	// A forced restore stops the VM; the status syncer picks up the new power
+	// state, so the DB row is not touched here.
+
+	// Step 5: Update event status to COMPLETED.
+	if _, saveErr := w.entClient.DomainEvent.UpdateOneID(eventID).
+		SetStatus(domainevent.StatusCOMPLETED).
+		Save(ctx); saveErr != nil {
+		logger.Error("CRITICAL: VM snapshot operation done but event status persistence failed",
+			zap.String("event_id", eventID), zap.Error(saveErr))
+	}
+	setTicketStatusByEvent(ctx, w.entClient, eventID, approvalticket.StatusSUCCESS)
+
+	w.logAudit(ctx, action, payload, eventID)
+
+	logger.Info("VM snapshot job completed",
+		zap.String("event_id", eventID),
+		zap.String("action", action),
+		zap.String("vm_name", payload.VMName),
+		zap.String("snapshot", payload.SnapshotName),
+	)
+	return nil
+}
+
+// logAudit records a snapshot mutation with the requesting actor and snapshot name.
+func (w *VMSnapshotWorker) logAudit(ctx context.Context, action string, payload domain.VMSnapshotPayload, eventID string) {
+	recordVMOperation(action)
+	if w.auditLogger == nil {
+		return
+	}
+	if err := w.auditLogger.LogAction(ctx, "vm."+action, "vm", payload.VMID, payload.Actor, map[string]interface{}{
+		"snapshot": payload.SnapshotName,
+		"event_id": eventID,
+	}); err != nil {
+		logger.Warn("failed to write audit log",
+			zap.String("action", action),
+			zap.String("event_id", eventID),
+			zap.Error(err),
+		)
+	}
+}
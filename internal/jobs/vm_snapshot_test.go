@@ -0,0 +1,104 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/riverqueue/river"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/auditlog"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/provider"
+	"kv-shepherd.io/shepherd/internal/service"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func seedSnapshotEvent(t *testing.T, client *ent.Client, eventID string, eventType domain.EventType, row *ent.VM, snapshotName string, force bool) {
+	t.Helper()
+
+	payload, err := domain.VMSnapshotPayload{
+		VMID:         row.ID,
+		VMName:       row.Name,
+		ClusterID:    row.ClusterID,
+		Namespace:    row.Namespace,
+		SnapshotName: snapshotName,
+		Force:        force,
+		Actor:        "owner-1",
+	}.ToJSON()
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+	client.DomainEvent.Create().
+		SetID(eventID).
+		SetEventType(string(eventType)).
+		SetAggregateType("vm").
+		SetAggregateID(row.ID).
+		SetPayload(payload).
+		SetCreatedBy("owner-1").
+		SaveX(t.Context())
+}
+
+func TestVMSnapshotWorker(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "vm_snapshot_worker")
+	svc := mustCreateSyncTestService(t, client)
+	running := mustCreateSyncTestVM(t, client, svc.ID, "vm-running", "cluster-a", vm.StatusRUNNING)
+
+	mock := provider.NewMockProvider()
+	mock.Seed([]*domain.VM{
+		{Name: running.Name, Namespace: running.Namespace, Status: domain.VMStatusRunning, Spec: domain.VMSpec{CPU: 2, MemoryMB: 4096}},
+	})
+	worker := NewVMSnapshotWorker(client, service.NewVMService(mock), audit.NewLogger(client))
+
+	tests := []struct {
+		name      string
+		eventType domain.EventType
+		snapshot  string
+		force     bool
+		wantErr   bool
+		wantEvent domainevent.Status
+		wantAudit string
+	}{
+		{name: "snapshot is created", eventType: domain.EventVMSnapshotCreateRequested, snapshot: "nightly", wantEvent: domainevent.StatusCOMPLETED, wantAudit: "vm.snapshot"},
+		{name: "running vm restore needs force", eventType: domain.EventVMSnapshotRestoreRequested, snapshot: "nightly", wantErr: true, wantEvent: domainevent.StatusFAILED, wantAudit: "vm.snapshot_restore_failed"},
+		{name: "forced restore", eventType: domain.EventVMSnapshotRestoreRequested, snapshot: "nightly", force: true, wantEvent: domainevent.StatusCOMPLETED, wantAudit: "vm.snapshot_restore"},
+		{name: "missing snapshot fails restore", eventType: domain.EventVMSnapshotRestoreRequested, snapshot: "missing", force: true, wantErr: true, wantEvent: domainevent.StatusFAILED, wantAudit: "vm.snapshot_restore_failed"},
+	}
+	// Subtests share the provider state, so they run sequentially.
+	for i, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			eventID := "event-snapshot-" + string(rune('a'+i))
+			seedSnapshotEvent(t, client, eventID, tc.eventType, running, tc.snapshot, tc.force)
+
+			err := worker.Work(t.Context(), &river.Job[VMSnapshotArgs]{Args: VMSnapshotArgs{EventID: eventID}})
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Work() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if event := client.DomainEvent.GetX(t.Context(), eventID); event.Status != tc.wantEvent {
+				t.Fatalf("event status = %s, want %s", event.Status, tc.wantEvent)
+			}
+			entry, err := client.AuditLog.Query().
+				Where(auditlog.ActionEQ(tc.wantAudit), auditlog.ResourceIDEQ(running.ID)).
+				Order(ent.Desc(auditlog.FieldCreatedAt)).
+				First(t.Context())
+			if err != nil {
+				t.Fatalf("audit %s: %v", tc.wantAudit, err)
+			}
+			if entry.Actor != "owner-1" || entry.Details["snapshot"] != tc.snapshot || entry.Details["event_id"] != eventID {
+				t.Fatalf("audit actor=%s details=%v", entry.Actor, entry.Details)
+			}
+		})
+	}
+
+	live, err := mock.GetVM(t.Context(), running.ClusterID, running.Namespace, running.Name)
+	if err != nil {
+		t.Fatalf("get live vm: %v", err)
+	}
+	if live.Status != domain.VMStatusStopped {
+		t.Fatalf("live status after forced restore = %s, want STOPPED", live.Status)
+	}
+}
@@ -0,0 +1,158 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/service"
+)
+
+// VMStatusSyncInterval is the periodic schedule for VM status reconciliation.
+const VMStatusSyncInterval = 5 * time.Minute
+
+// VMStatusSyncArgs is a periodic job that reconciles persisted VM status with
+// the live KubeVirt phase.
+type VMStatusSyncArgs struct{}
+
+// Kind returns the job kind identifier for VM status reconciliation.
+func (VMStatusSyncArgs) Kind() string { return "vm_status_sync" }
+
+// InsertOpts ensures at most one sync job is enqueued per interval.
+func (VMStatusSyncArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       river.QueueDefault,
+		MaxAttempts: 1,
+		UniqueOpts: river.UniqueOpts{
+			ByPeriod: VMStatusSyncInterval,
+			ByQueue:  true,
+			ByArgs:   true,
+		},
+	}
+}
+
+// VMStatusSyncWorker detects drift between RUNNING/CREATING VM rows and their
+// KubeVirt state (e.g. a VM crashed or was removed outside Shepherd).
+type VMStatusSyncWorker struct {
+	river.WorkerDefaults[VMStatusSyncArgs]
+	entClient   *ent.Client
+	vmService   *service.VMService
+	auditLogger *audit.Logger
+}
+
+// NewVMStatusSyncWorker creates a new VMStatusSyncWorker (ADR-0013 manual DI).
+func NewVMStatusSyncWorker(entClient *ent.Client, vmService *service.VMService, auditLogger *audit.Logger) *VMStatusSyncWorker {
+	return &VMStatusSyncWorker{entClient: entClient, vmService: vmService, auditLogger: auditLogger}
+}
+
+// Work reconciles every tracked VM. Per-VM lookup failures are logged and
+// skipped so one unreachable cluster cannot block the rest of the sweep.
+func (w *VMStatusSyncWorker) Work(ctx context.Context, _ *river.Job[VMStatusSyncArgs]) error {
+	if w == nil || w.entClient == nil || w.vmService == nil {
+		return fmt.Errorf("vm status sync worker is not initialized")
+	}
+
+	rows, err := w.entClient.VM.Query().
+		Where(vm.StatusIn(vm.StatusRUNNING, vm.StatusCREATING)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query tracked vms: %w", err)
+	}
+
+	updated := 0
+	for _, row := range rows {
+		if w.syncVM(ctx, row) {
+			updated++
+		}
+	}
+
+	logger.Info("vm status sync completed",
+		zap.Int("checked", len(rows)),
+		zap.Int("updated", updated),
+	)
+	return nil
+}
+
+// syncVM reconciles a single row and reports whether its status changed.
+func (w *VMStatusSyncWorker) syncVM(ctx context.Context, row *ent.VM) bool {
+	if strings.TrimSpace(row.ClusterID) == "" {
+		return false
+	}
+
+	live, err := w.vmService.GetVM(ctx, row.ClusterID, row.Namespace, row.Name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			logger.Warn("vm status sync lookup failed",
+				zap.String("vm_id", row.ID),
+				zap.String("cluster_id", row.ClusterID),
+				zap.Error(err),
+			)
+			return false
+		}
+		if !w.updateStatus(ctx, row, vm.StatusSTOPPED) {
+			return false
+		}
+		if w.auditLogger != nil {
+			if err := w.auditLogger.LogAction(ctx, "vm.status_sync.missing", "vm", row.ID, "system", map[string]interface{}{
+				"cluster_id":      row.ClusterID,
+				"namespace":       row.Namespace,
+				"name":            row.Name,
+				"previous_status": row.Status.String(),
+				"status":          vm.StatusSTOPPED.String(),
+			}); err != nil {
+				logger.Warn("failed to write audit log",
+					zap.String("action", "vm.status_sync.missing"),
+					zap.String("vm_id", row.ID),
+					zap.Error(err),
+				)
+			}
+		}
+		return true
+	}
+
+	next := mapLiveVMStatusToRow(live)
+	if next == row.Status {
+		return false
+	}
+	return w.updateStatus(ctx, row, next)
+}
+
+func (w *VMStatusSyncWorker) updateStatus(ctx context.Context, row *ent.VM, next vm.Status) bool {
+	if _, err := w.entClient.VM.UpdateOneID(row.ID).SetStatus(next).Save(ctx); err != nil {
+		logger.Warn("vm status sync update failed",
+			zap.String("vm_id", row.ID),
+			zap.String("status", next.String()),
+			zap.Error(err),
+		)
+		return false
+	}
+	logger.Info("vm status reconciled",
+		zap.String("vm_id", row.ID),
+		zap.String("from", row.Status.String()),
+		zap.String("to", next.String()),
+	)
+	return true
+}
+
+// mapLiveVMStatusToRow maps a provider status 1:1 onto the VM row enum.
+// Unlike mapCreatedVMStatusToRow, transient phases are kept as observed.
+func mapLiveVMStatusToRow(live *domain.VM) vm.Status {
+	if live == nil {
+		return vm.StatusUNKNOWN
+	}
+	status := vm.Status(live.Status)
+	if err := vm.StatusValidator(status); err != nil {
+		return vm.StatusUNKNOWN
+	}
+	return status
+}
@@ -0,0 +1,133 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/riverqueue/river"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/auditlog"
+	"kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/provider"
+	"kv-shepherd.io/shepherd/internal/service"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestMapLiveVMStatusToRow(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		live *domain.VM
+		want vm.Status
+	}{
+		{name: "nil", live: nil, want: vm.StatusUNKNOWN},
+		{name: "running", live: &domain.VM{Status: domain.VMStatusRunning}, want: vm.StatusRUNNING},
+		{name: "stopped", live: &domain.VM{Status: domain.VMStatusStopped}, want: vm.StatusSTOPPED},
+		{name: "pending kept as observed", live: &domain.VM{Status: domain.VMStatusPending}, want: vm.StatusPENDING},
+		{name: "unexpected value", live: &domain.VM{Status: domain.VMStatus("Crashed")}, want: vm.StatusUNKNOWN},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mapLiveVMStatusToRow(tc.live); got != tc.want {
+				t.Fatalf("mapLiveVMStatusToRow() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestVMStatusSyncWorker_ReconcilesDrift(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "vm_status_sync")
+	svc := mustCreateSyncTestService(t, client)
+
+	drifted := mustCreateSyncTestVM(t, client, svc.ID, "vm-drifted", "cluster-a", vm.StatusRUNNING)
+	inSync := mustCreateSyncTestVM(t, client, svc.ID, "vm-in-sync", "cluster-a", vm.StatusRUNNING)
+	creating := mustCreateSyncTestVM(t, client, svc.ID, "vm-creating", "cluster-a", vm.StatusCREATING)
+	missing := mustCreateSyncTestVM(t, client, svc.ID, "vm-missing", "cluster-a", vm.StatusRUNNING)
+	untracked := mustCreateSyncTestVM(t, client, svc.ID, "vm-untracked", "cluster-a", vm.StatusSTOPPED)
+
+	mock := provider.NewMockProvider()
+	mock.Seed([]*domain.VM{
+		{Name: drifted.Name, Namespace: drifted.Namespace, Status: domain.VMStatusFailed},
+		{Name: inSync.Name, Namespace: inSync.Namespace, Status: domain.VMStatusRunning},
+		{Name: creating.Name, Namespace: creating.Namespace, Status: domain.VMStatusRunning},
+	})
+
+	worker := NewVMStatusSyncWorker(client, service.NewVMService(mock), audit.NewLogger(client))
+	if err := worker.Work(t.Context(), &river.Job[VMStatusSyncArgs]{}); err != nil {
+		t.Fatalf("Work() error = %v", err)
+	}
+
+	want := map[string]vm.Status{
+		drifted.ID:   vm.StatusFAILED,
+		inSync.ID:    vm.StatusRUNNING,
+		creating.ID:  vm.StatusRUNNING,
+		missing.ID:   vm.StatusSTOPPED,
+		untracked.ID: vm.StatusSTOPPED,
+	}
+	for id, status := range want {
+		row, err := client.VM.Get(t.Context(), id)
+		if err != nil {
+			t.Fatalf("get vm %s: %v", id, err)
+		}
+		if row.Status != status {
+			t.Fatalf("vm %s status = %s, want %s", id, row.Status, status)
+		}
+	}
+
+	entries, err := client.AuditLog.Query().
+		Where(auditlog.ActionEQ("vm.status_sync.missing")).
+		All(t.Context())
+	if err != nil {
+		t.Fatalf("query audit logs: %v", err)
+	}
+	if len(entries) != 1 || entries[0].ResourceID != missing.ID {
+		t.Fatalf("expected one missing-vm audit entry for %s, got %+v", missing.ID, entries)
+	}
+}
+
+func mustCreateSyncTestService(t *testing.T, client *ent.Client) *ent.Service {
+	t.Helper()
+
+	sys, err := client.System.Create().
+		SetID("sys-sync").
+		SetName("shop").
+		SetCreatedBy("owner-1").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("create system: %v", err)
+	}
+	svc, err := client.Service.Create().
+		SetID("svc-sync").
+		SetName("redis").
+		SetSystemID(sys.ID).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("create service: %v", err)
+	}
+	return svc
+}
+
+func mustCreateSyncTestVM(t *testing.T, client *ent.Client, serviceID, name, clusterID string, status vm.Status) *ent.VM {
+	t.Helper()
+
+	row, err := client.VM.Create().
+		SetID(name).
+		SetName(name).
+		SetInstance("01").
+		SetNamespace("prod-shop").
+		SetClusterID(clusterID).
+		SetStatus(status).
+		SetCreatedBy("owner-1").
+		SetServiceID(serviceID).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("create vm %s: %v", name, err)
+	}
+	return row
+}
@@ -0,0 +1,160 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/vmconsolesession"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+const (
+	// VNCAccessExpiryInterval is the periodic schedule for VNC access expiry.
+	VNCAccessExpiryInterval = time.Minute
+	// VNCAccessExpiryActor is recorded as the revoking actor.
+	VNCAccessExpiryActor = "system"
+
+	vncAccessExpiryPageSize = 100
+)
+
+// VNCAccessExpiryArgs is a periodic job that expires approved VNC_ACCESS
+// tickets whose access window has ended.
+type VNCAccessExpiryArgs struct{}
+
+// Kind returns the job kind identifier for VNC access expiry.
+func (VNCAccessExpiryArgs) Kind() string { return "vnc_access_expiry" }
+
+// InsertOpts ensures at most one expiry job is enqueued per interval.
+func (VNCAccessExpiryArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       river.QueueDefault,
+		MaxAttempts: 1,
+		UniqueOpts: river.UniqueOpts{
+			ByPeriod: VNCAccessExpiryInterval,
+			ByQueue:  true,
+			ByArgs:   true,
+		},
+	}
+}
+
+// VNCAccessExpiryWorker moves approved VNC_ACCESS tickets past expires_at to
+// EXPIRED, revokes the console sessions they granted and audits the
+// revocation. Console handlers already refuse access past expires_at; the
+// worker makes the expiry visible and cuts off live sessions.
+type VNCAccessExpiryWorker struct {
+	river.WorkerDefaults[VNCAccessExpiryArgs]
+	entClient   *ent.Client
+	auditLogger *audit.Logger
+}
+
+// NewVNCAccessExpiryWorker creates a VNCAccessExpiryWorker (ADR-0013 manual DI).
+func NewVNCAccessExpiryWorker(entClient *ent.Client, auditLogger *audit.Logger) *VNCAccessExpiryWorker {
+	return &VNCAccessExpiryWorker{entClient: entClient, auditLogger: auditLogger}
+}
+
+// Work pages through expired approvals. The status update is guarded on
+// APPROVED, so re-running the job expires and audits each ticket once.
+func (w *VNCAccessExpiryWorker) Work(ctx context.Context, _ *river.Job[VNCAccessExpiryArgs]) error {
+	if w == nil || w.entClient == nil {
+		return fmt.Errorf("vnc access expiry worker is not initialized")
+	}
+
+	now := time.Now().UTC()
+	expired := 0
+	lastID := ""
+	for {
+		query := w.entClient.ApprovalTicket.Query().
+			Where(
+				approvalticket.OperationTypeEQ(approvalticket.OperationTypeVNC_ACCESS),
+				approvalticket.StatusEQ(approvalticket.StatusAPPROVED),
+				approvalticket.ExpiresAtLTE(now),
+			)
+		if lastID != "" {
+			query = query.Where(approvalticket.IDGT(lastID))
+		}
+		page, err := query.
+			Order(ent.Asc(approvalticket.FieldID)).
+			Limit(vncAccessExpiryPageSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("query expired vnc access tickets: %w", err)
+		}
+		if len(page) == 0 {
+			break
+		}
+		for _, ticket := range page {
+			ok, err := w.expire(ctx, ticket, now)
+			if err != nil {
+				logger.Warn("vnc access expiry failed to expire ticket",
+					zap.String("ticket_id", ticket.ID),
+					zap.Error(err),
+				)
+				continue
+			}
+			if ok {
+				expired++
+			}
+		}
+		lastID = page[len(page)-1].ID
+		if len(page) < vncAccessExpiryPageSize {
+			break
+		}
+	}
+
+	logger.Info("vnc access expiry completed", zap.Int("expired", expired))
+	return nil
+}
+
+// expire transitions one ticket and revokes its sessions. It reports false
+// when the ticket left APPROVED concurrently.
+func (w *VNCAccessExpiryWorker) expire(ctx context.Context, ticket *ent.ApprovalTicket, now time.Time) (bool, error) {
+	tx, err := w.entClient.Tx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("start expiry transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	n, err := tx.ApprovalTicket.Update().
+		Where(
+			approvalticket.IDEQ(ticket.ID),
+			approvalticket.StatusEQ(approvalticket.StatusAPPROVED),
+		).
+		SetStatus(approvalticket.StatusEXPIRED).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("set ticket EXPIRED: %w", err)
+	}
+	if n == 0 {
+		return false, nil
+	}
+	revoked, err := tx.VMConsoleSession.Update().
+		Where(
+			vmconsolesession.TicketIDEQ(ticket.ID),
+			vmconsolesession.RevokedAtIsNil(),
+		).
+		SetRevokedAt(now).
+		SetRevokedBy(VNCAccessExpiryActor).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("revoke console sessions: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("commit expiry: %w", err)
+	}
+
+	if w.auditLogger != nil {
+		_ = w.auditLogger.LogAction(ctx, "vnc.access_expired", "approval_ticket", ticket.ID, VNCAccessExpiryActor, map[string]interface{}{
+			"requester":        ticket.Requester,
+			"expires_at":       ticket.ExpiresAt.Format(time.RFC3339),
+			"revoked_sessions": revoked,
+		})
+	}
+	return true, nil
+}
@@ -0,0 +1,82 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/riverqueue/river"
+
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/auditlog"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestVNCAccessExpiryWorker(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "vnc_access_expiry")
+	ctx := t.Context()
+	now := time.Now().UTC()
+	seedTicket := func(id string, op approvalticket.OperationType, status approvalticket.Status, expiresAt time.Time) {
+		t.Helper()
+		client.ApprovalTicket.Create().
+			SetID(id).
+			SetEventID("event-" + id).
+			SetOperationType(op).
+			SetStatus(status).
+			SetRequester("user-1").
+			SetExpiresAt(expiresAt).
+			SaveX(ctx)
+	}
+	seedTicket("vnc-expired", approvalticket.OperationTypeVNC_ACCESS, approvalticket.StatusAPPROVED, now.Add(-time.Minute))
+	seedTicket("vnc-active", approvalticket.OperationTypeVNC_ACCESS, approvalticket.StatusAPPROVED, now.Add(time.Hour))
+	seedTicket("vnc-pending", approvalticket.OperationTypeVNC_ACCESS, approvalticket.StatusPENDING, now.Add(-time.Minute))
+	seedTicket("create-approved", approvalticket.OperationTypeCREATE, approvalticket.StatusAPPROVED, now.Add(-time.Minute))
+
+	seedSession := func(id, ticketID string) {
+		t.Helper()
+		client.VMConsoleSession.Create().
+			SetID(id).
+			SetVMID("vm-1").
+			SetUserID("user-1").
+			SetTicketID(ticketID).
+			SetExpiresAt(now.Add(time.Hour)).
+			SaveX(ctx)
+	}
+	seedSession("session-expired", "vnc-expired")
+	seedSession("session-active", "vnc-active")
+
+	worker := NewVNCAccessExpiryWorker(client, audit.NewLogger(client))
+	for range 2 { // second run must not expire or audit again
+		if err := worker.Work(ctx, &river.Job[VNCAccessExpiryArgs]{}); err != nil {
+			t.Fatalf("Work() error = %v", err)
+		}
+	}
+
+	for id, want := range map[string]approvalticket.Status{
+		"vnc-expired":     approvalticket.StatusEXPIRED,
+		"vnc-active":      approvalticket.StatusAPPROVED,
+		"vnc-pending":     approvalticket.StatusPENDING,
+		"create-approved": approvalticket.StatusAPPROVED,
+	} {
+		if got := client.ApprovalTicket.GetX(ctx, id).Status; got != want {
+			t.Fatalf("ticket %s status = %s, want %s", id, got, want)
+		}
+	}
+
+	revoked := client.VMConsoleSession.GetX(ctx, "session-expired")
+	if revoked.RevokedAt == nil || revoked.RevokedBy != VNCAccessExpiryActor {
+		t.Fatalf("expired ticket session revoked_at=%v revoked_by=%q, want revoked by %s", revoked.RevokedAt, revoked.RevokedBy, VNCAccessExpiryActor)
+	}
+	if active := client.VMConsoleSession.GetX(ctx, "session-active"); active.RevokedAt != nil {
+		t.Fatalf("active ticket session revoked at %v, want untouched", active.RevokedAt)
+	}
+
+	entries := client.AuditLog.Query().
+		Where(auditlog.ActionEQ("vnc.access_expired")).
+		AllX(ctx)
+	if len(entries) != 1 || entries[0].ResourceID != "vnc-expired" {
+		t.Fatalf("vnc.access_expired audit entries = %+v, want one for vnc-expired", entries)
+	}
+}
@@ -0,0 +1,253 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/webhookendpoint"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/notification"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+const (
+	// WebhookMaxAttempts caps delivery retries per endpoint and event.
+	WebhookMaxAttempts = 5
+	// WebhookRetryBaseDelay is the first retry delay; it doubles per attempt.
+	WebhookRetryBaseDelay = 10 * time.Second
+	// DefaultWebhookTimeout bounds a single delivery HTTP request.
+	DefaultWebhookTimeout = 10 * time.Second
+
+	maxWebhookErrorLen = 1024
+)
+
+// WebhookDeliveryArgs delivers one event to one webhook endpoint.
+// The signing secret is resolved at delivery time and never stored in job args.
+type WebhookDeliveryArgs struct {
+	EndpointID string          `json:"endpoint_id"`
+	DeliveryID string          `json:"delivery_id"`
+	EventType  string          `json:"event_type"`
+	Payload    json.RawMessage `json:"payload"`
+}
+
+// Kind returns the job kind identifier for webhook delivery.
+func (WebhookDeliveryArgs) Kind() string { return "webhook_delivery" }
+
+// InsertOpts enables retries with exponential backoff (see NextRetry).
+func (WebhookDeliveryArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       river.QueueDefault,
+		MaxAttempts: WebhookMaxAttempts,
+	}
+}
+
+// WebhookDeliveryWorker POSTs signed event payloads and records every attempt
+// as a WebhookDelivery row. A delivery that fails its last attempt is
+// dead-lettered with a webhook.delivery_dead_lettered audit entry.
+type WebhookDeliveryWorker struct {
+	river.WorkerDefaults[WebhookDeliveryArgs]
+	entClient   *ent.Client
+	httpClient  *http.Client
+	auditLogger *audit.Logger
+}
+
+// NewWebhookDeliveryWorker creates a new WebhookDeliveryWorker (ADR-0013 manual DI).
+// A nil httpClient falls back to one with DefaultWebhookTimeout.
+func NewWebhookDeliveryWorker(entClient *ent.Client, httpClient *http.Client) *WebhookDeliveryWorker {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: DefaultWebhookTimeout}
+	}
+	return &WebhookDeliveryWorker{entClient: entClient, httpClient: httpClient}
+}
+
+// WithAuditLogger enables dead-letter audit entries.
+func (w *WebhookDeliveryWorker) WithAuditLogger(auditLogger *audit.Logger) *WebhookDeliveryWorker {
+	w.auditLogger = auditLogger
+	return w
+}
+
+// NextRetry backs off exponentially: base, 2×base, 4×base, ...
+func (w *WebhookDeliveryWorker) NextRetry(job *river.Job[WebhookDeliveryArgs]) time.Time {
+	return time.Now().Add(webhookRetryDelay(job.Attempt))
+}
+
+func webhookRetryDelay(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	return WebhookRetryBaseDelay << (attempt - 1)
+}
+
+// Work delivers the payload. Non-2xx responses and transport errors are
+// returned so River retries; a removed or disabled endpoint cancels the job.
+func (w *WebhookDeliveryWorker) Work(ctx context.Context, job *river.Job[WebhookDeliveryArgs]) error {
+	if w == nil || w.entClient == nil {
+		return fmt.Errorf("webhook delivery worker is not initialized")
+	}
+	args := job.Args
+
+	endpoint, err := w.entClient.WebhookEndpoint.Get(ctx, args.EndpointID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return river.JobCancel(fmt.Errorf("webhook endpoint %s no longer exists", args.EndpointID))
+		}
+		return fmt.Errorf("get webhook endpoint %s: %w", args.EndpointID, err)
+	}
+	if !endpoint.Enabled {
+		return river.JobCancel(fmt.Errorf("webhook endpoint %s is disabled", args.EndpointID))
+	}
+
+	started := time.Now()
+	statusCode, deliverErr := w.post(ctx, endpoint, args)
+	w.recordAttempt(ctx, args, job.Attempt, statusCode, time.Since(started), deliverErr)
+
+	if deliverErr != nil {
+		logger.Warn("webhook delivery failed",
+			zap.String("endpoint_id", args.EndpointID),
+			zap.String("delivery_id", args.DeliveryID),
+			zap.String("event_type", args.EventType),
+			zap.Int("attempt", job.Attempt),
+			zap.Error(deliverErr),
+		)
+		if job.Attempt >= job.MaxAttempts {
+			w.deadLetter(ctx, endpoint, args, job.Attempt, deliverErr)
+		}
+		return deliverErr
+	}
+	return nil
+}
+
+// deadLetter records a delivery that exhausted its retries (best-effort).
+func (w *WebhookDeliveryWorker) deadLetter(ctx context.Context, endpoint *ent.WebhookEndpoint, args WebhookDeliveryArgs, attempts int, deliverErr error) {
+	logger.Error("webhook delivery dead-lettered",
+		zap.String("endpoint_id", args.EndpointID),
+		zap.String("delivery_id", args.DeliveryID),
+		zap.String("event_type", args.EventType),
+		zap.Int("attempts", attempts),
+		zap.Error(deliverErr),
+	)
+	if w.auditLogger == nil {
+		return
+	}
+	_ = w.auditLogger.LogAction(ctx, "webhook.delivery_dead_lettered", "webhook", endpoint.ID, "system", map[string]interface{}{
+		"url":         endpoint.URL,
+		"delivery_id": args.DeliveryID,
+		"event_type":  args.EventType,
+		"attempts":    attempts,
+		"error":       deliverErr.Error(),
+		"payload":     string(args.Payload),
+	})
+}
+
+func (w *WebhookDeliveryWorker) post(ctx context.Context, endpoint *ent.WebhookEndpoint, args WebhookDeliveryArgs) (int, error) {
+	return notification.PostWebhook(ctx, w.httpClient, endpoint.URL, endpoint.Secret, args.EventType, args.DeliveryID, args.Payload)
+}
+
+// recordAttempt writes the delivery log row (best-effort).
+func (w *WebhookDeliveryWorker) recordAttempt(ctx context.Context, args WebhookDeliveryArgs, attempt, statusCode int, elapsed time.Duration, deliverErr error) {
+	id, _ := uuid.NewV7()
+	create := w.entClient.WebhookDelivery.Create().
+		SetID(id.String()).
+		SetEndpointID(args.EndpointID).
+		SetDeliveryID(args.DeliveryID).
+		SetEventType(args.EventType).
+		SetAttempt(max(attempt, 1)).
+		SetSuccess(deliverErr == nil).
+		SetStatusCode(statusCode).
+		SetDurationMs(elapsed.Milliseconds())
+	if deliverErr != nil {
+		msg := deliverErr.Error()
+		if len(msg) > maxWebhookErrorLen {
+			msg = msg[:maxWebhookErrorLen]
+		}
+		create = create.SetError(msg)
+	}
+	if _, err := create.Save(ctx); err != nil {
+		logger.Warn("failed to record webhook delivery",
+			zap.String("endpoint_id", args.EndpointID),
+			zap.String("delivery_id", args.DeliveryID),
+			zap.Error(err),
+		)
+	}
+}
+
+// JobInserter is the subset of *river.Client used to enqueue jobs.
+type JobInserter interface {
+	Insert(ctx context.Context, args river.JobArgs, opts *river.InsertOpts) (*rivertype.JobInsertResult, error)
+}
+
+// WorkerContextInserter enqueues through the River client running the current
+// job. Workers are built before the River client exists, so dispatchers used
+// from inside a worker take this instead of the client itself.
+type WorkerContextInserter struct{}
+
+// Insert enqueues args with the client found in ctx.
+func (WorkerContextInserter) Insert(ctx context.Context, args river.JobArgs, opts *river.InsertOpts) (*rivertype.JobInsertResult, error) {
+	client, err := river.ClientFromContextSafely[pgx.Tx](ctx)
+	if err != nil {
+		return nil, err
+	}
+	return client.Insert(ctx, args, opts)
+}
+
+// WebhookDispatcher implements notification.WebhookDispatcher by enqueuing one
+// WebhookDeliveryArgs job per enabled endpoint subscribed to the event type.
+type WebhookDispatcher struct {
+	entClient *ent.Client
+	inserter  JobInserter
+}
+
+// NewWebhookDispatcher creates a new WebhookDispatcher (ADR-0013 manual DI).
+func NewWebhookDispatcher(entClient *ent.Client, inserter JobInserter) *WebhookDispatcher {
+	return &WebhookDispatcher{entClient: entClient, inserter: inserter}
+}
+
+var _ notification.WebhookDispatcher = (*WebhookDispatcher)(nil)
+
+// Dispatch enqueues deliveries for event. Endpoints are filtered in Go since
+// event_types is a JSON array and the endpoint table is small.
+func (d *WebhookDispatcher) Dispatch(ctx context.Context, event notification.WebhookEvent) error {
+	if d == nil || d.entClient == nil || d.inserter == nil {
+		return fmt.Errorf("webhook dispatcher is not initialized")
+	}
+
+	endpoints, err := d.entClient.WebhookEndpoint.Query().
+		Where(webhookendpoint.EnabledEQ(true)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query webhook endpoints: %w", err)
+	}
+
+	var payload []byte
+	for _, endpoint := range endpoints {
+		if !slices.Contains(endpoint.EventTypes, event.Type) {
+			continue
+		}
+		if payload == nil {
+			if payload, err = json.Marshal(event); err != nil {
+				return fmt.Errorf("marshal webhook event: %w", err)
+			}
+		}
+		deliveryID, _ := uuid.NewV7()
+		if _, err := d.inserter.Insert(ctx, WebhookDeliveryArgs{
+			EndpointID: endpoint.ID,
+			DeliveryID: deliveryID.String(),
+			EventType:  event.Type,
+			Payload:    payload,
+		}, nil); err != nil {
+			return fmt.Errorf("enqueue webhook delivery for endpoint %s: %w", endpoint.ID, err)
+		}
+	}
+	return nil
+}
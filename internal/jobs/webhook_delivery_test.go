@@ -0,0 +1,229 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+
+	"kv-shepherd.io/shepherd/ent/auditlog"
+	"kv-shepherd.io/shepherd/ent/webhookdelivery"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/notification"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestWebhookDeliveryArgsInsertOpts(t *testing.T) {
+	t.Parallel()
+
+	if got := (WebhookDeliveryArgs{}).Kind(); got != "webhook_delivery" {
+		t.Fatalf("Kind() = %q, want %q", got, "webhook_delivery")
+	}
+	opts := (WebhookDeliveryArgs{}).InsertOpts()
+	if opts.MaxAttempts != 5 {
+		t.Fatalf("MaxAttempts = %d, want 5", opts.MaxAttempts)
+	}
+}
+
+func TestWebhookRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	want := []time.Duration{10 * time.Second, 20 * time.Second, 40 * time.Second, 80 * time.Second, 160 * time.Second}
+	for i, expected := range want {
+		if got := webhookRetryDelay(i + 1); got != expected {
+			t.Fatalf("webhookRetryDelay(%d) = %s, want %s", i+1, got, expected)
+		}
+	}
+}
+
+func TestWebhookDeliveryWorkerWork(t *testing.T) {
+	t.Parallel()
+
+	_ = logger.Init("error", "json")
+	client := testutil.OpenEntPostgres(t, "webhook_delivery")
+	ctx := context.Background()
+
+	var (
+		mu         sync.Mutex
+		gotHeaders http.Header
+		gotBody    []byte
+		status     = http.StatusInternalServerError
+	)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		gotHeaders = r.Header.Clone()
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	defer receiver.Close()
+
+	endpoint, err := client.WebhookEndpoint.Create().
+		SetID("hook-1").
+		SetURL(receiver.URL).
+		SetSecret("s3cret").
+		SetEventTypes([]string{notification.WebhookEventTicketApproved}).
+		SetCreatedBy("admin").
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create endpoint: %v", err)
+	}
+
+	payload := json.RawMessage(`{"type":"ticket.approved","data":{"ticket_id":"t-1"}}`)
+	job := &river.Job[WebhookDeliveryArgs]{
+		JobRow: &rivertype.JobRow{Attempt: 1},
+		Args: WebhookDeliveryArgs{
+			EndpointID: endpoint.ID,
+			DeliveryID: "delivery-1",
+			EventType:  notification.WebhookEventTicketApproved,
+			Payload:    payload,
+		},
+	}
+	worker := NewWebhookDeliveryWorker(client, receiver.Client())
+
+	if err := worker.Work(ctx, job); err == nil {
+		t.Fatal("Work() error = nil on HTTP 500, want retryable error")
+	}
+
+	mu.Lock()
+	status = http.StatusNoContent
+	mu.Unlock()
+	job.Attempt = 2
+	if err := worker.Work(ctx, job); err != nil {
+		t.Fatalf("Work() error = %v, want nil", err)
+	}
+
+	mu.Lock()
+	if string(gotBody) != string(payload) {
+		t.Fatalf("body = %s, want %s", gotBody, payload)
+	}
+	if got, want := gotHeaders.Get(notification.WebhookSignatureHeader), notification.SignWebhookPayload("s3cret", payload); got != want {
+		t.Fatalf("signature = %q, want %q", got, want)
+	}
+	if got := gotHeaders.Get(notification.WebhookDeliveryHeader); got != "delivery-1" {
+		t.Fatalf("delivery header = %q, want %q", got, "delivery-1")
+	}
+	mu.Unlock()
+
+	attempts, err := client.WebhookDelivery.Query().
+		Where(webhookdelivery.DeliveryIDEQ("delivery-1")).
+		Order(webhookdelivery.ByAttempt()).
+		All(ctx)
+	if err != nil {
+		t.Fatalf("query deliveries: %v", err)
+	}
+	if len(attempts) != 2 {
+		t.Fatalf("delivery records = %d, want 2", len(attempts))
+	}
+	if attempts[0].Success || attempts[0].StatusCode != http.StatusInternalServerError || attempts[0].Error == "" {
+		t.Fatalf("first attempt = %+v, want failed HTTP 500 with error", attempts[0])
+	}
+	if !attempts[1].Success || attempts[1].StatusCode != http.StatusNoContent || attempts[1].Attempt != 2 {
+		t.Fatalf("second attempt = %+v, want successful HTTP 204 attempt 2", attempts[1])
+	}
+
+	mu.Lock()
+	status = http.StatusBadGateway
+	mu.Unlock()
+	lastJob := &river.Job[WebhookDeliveryArgs]{
+		JobRow: &rivertype.JobRow{Attempt: WebhookMaxAttempts, MaxAttempts: WebhookMaxAttempts},
+		Args: WebhookDeliveryArgs{
+			EndpointID: endpoint.ID,
+			DeliveryID: "delivery-2",
+			EventType:  notification.WebhookEventVMCreated,
+			Payload:    payload,
+		},
+	}
+	auditedWorker := NewWebhookDeliveryWorker(client, receiver.Client()).WithAuditLogger(audit.NewLogger(client))
+	if err := auditedWorker.Work(ctx, lastJob); err == nil {
+		t.Fatal("Work() error = nil on final HTTP 502, want error")
+	}
+	deadLetters, err := client.AuditLog.Query().
+		Where(auditlog.ActionEQ("webhook.delivery_dead_lettered")).
+		All(ctx)
+	if err != nil {
+		t.Fatalf("query dead-letter audit: %v", err)
+	}
+	if len(deadLetters) != 1 || deadLetters[0].ResourceID != endpoint.ID || deadLetters[0].Details["delivery_id"] != "delivery-2" {
+		t.Fatalf("dead-letter audit = %+v, want one entry for delivery-2 on %s", deadLetters, endpoint.ID)
+	}
+
+	if _, err := endpoint.Update().SetEnabled(false).Save(ctx); err != nil {
+		t.Fatalf("disable endpoint: %v", err)
+	}
+	var cancelErr *river.JobCancelError
+	if err := worker.Work(ctx, job); err == nil || !errors.As(err, &cancelErr) {
+		t.Fatalf("Work() error = %v, want JobCancel for disabled endpoint", err)
+	}
+}
+
+type recordingInserter struct {
+	args []river.JobArgs
+}
+
+func (r *recordingInserter) Insert(_ context.Context, args river.JobArgs, _ *river.InsertOpts) (*rivertype.JobInsertResult, error) {
+	r.args = append(r.args, args)
+	return &rivertype.JobInsertResult{}, nil
+}
+
+func TestWebhookDispatcherDispatch(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "webhook_dispatch")
+	ctx := context.Background()
+
+	seed := func(id string, enabled bool, eventTypes ...string) {
+		t.Helper()
+		if _, err := client.WebhookEndpoint.Create().
+			SetID(id).
+			SetURL("https://example.com/" + id).
+			SetSecret("secret").
+			SetEventTypes(eventTypes).
+			SetEnabled(enabled).
+			SetCreatedBy("admin").
+			Save(ctx); err != nil {
+			t.Fatalf("seed endpoint %s: %v", id, err)
+		}
+	}
+	seed("subscribed", true, notification.WebhookEventTicketApproved, notification.WebhookEventTicketRejected)
+	seed("other-event", true, notification.WebhookEventTicketCreated)
+	seed("disabled", false, notification.WebhookEventTicketApproved)
+
+	inserter := &recordingInserter{}
+	dispatcher := NewWebhookDispatcher(client, inserter)
+	err := dispatcher.Dispatch(ctx, notification.WebhookEvent{
+		Type:       notification.WebhookEventTicketApproved,
+		OccurredAt: time.Now(),
+		Data:       map[string]string{"ticket_id": "t-1"},
+	})
+	if err != nil {
+		t.Fatalf("Dispatch() error = %v", err)
+	}
+
+	if len(inserter.args) != 1 {
+		t.Fatalf("inserted jobs = %d, want 1", len(inserter.args))
+	}
+	args, ok := inserter.args[0].(WebhookDeliveryArgs)
+	if !ok {
+		t.Fatalf("inserted args type = %T, want WebhookDeliveryArgs", inserter.args[0])
+	}
+	if args.EndpointID != "subscribed" || args.DeliveryID == "" || args.EventType != notification.WebhookEventTicketApproved {
+		t.Fatalf("args = %+v, want delivery to subscribed endpoint", args)
+	}
+	var event notification.WebhookEvent
+	if err := json.Unmarshal(args.Payload, &event); err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	if event.Data["ticket_id"] != "t-1" {
+		t.Fatalf("payload data = %v, want ticket_id t-1", event.Data)
+	}
+}
@@ -0,0 +1,221 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"html/template"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/platformconfig"
+)
+
+// Email templates, one per notified ticket or account event.
+const (
+	EmailTemplateTicketCreated         = "ticket_created"
+	EmailTemplateTicketApproved        = "ticket_approved"
+	EmailTemplateTicketRejected        = "ticket_rejected"
+	EmailTemplatePasswordResetRequired = "password_reset_required"
+)
+
+// EmailDialTimeout bounds connecting to the SMTP relay.
+const EmailDialTimeout = 10 * time.Second
+
+// ErrSMTPNotConfigured is returned by EmailChannel.Send while PlatformConfig
+// has no SMTP host or from address.
+var ErrSMTPNotConfigured = errors.New("smtp is not configured")
+
+// SMTPConfig is the PlatformConfig SMTP section.
+type SMTPConfig struct {
+	Host        string
+	Port        int
+	Username    string
+	Password    string
+	FromAddress string
+}
+
+// Configured reports whether the relay and sender are set.
+func (c SMTPConfig) Configured() bool {
+	return c.Host != "" && c.FromAddress != ""
+}
+
+// EmailNotification asks for one templated email to one user. Data feeds the
+// template; the recipient's address is resolved at delivery time.
+type EmailNotification struct {
+	RecipientID string
+	Template    string
+	Data        map[string]string
+}
+
+// EmailDispatcher queues email notifications. Implementations must not block
+// on delivery (V1: one River job per recipient).
+type EmailDispatcher interface {
+	DispatchEmail(ctx context.Context, email EmailNotification) error
+}
+
+type emailTemplate struct {
+	subject *texttemplate.Template
+	body    *template.Template
+}
+
+var emailTemplates = map[string]emailTemplate{
+	EmailTemplateTicketCreated: {
+		subject: texttemplate.Must(texttemplate.New(EmailTemplateTicketCreated).Parse("New request {{.ticket_id}} pending approval")),
+		body: template.Must(template.New(EmailTemplateTicketCreated).Parse(
+			`<p>User <strong>{{.requester}}</strong> submitted request <code>{{.ticket_id}}</code>` +
+				`{{with .namespace}} in namespace <code>{{.}}</code>{{end}}.</p>` +
+				`<p>It is waiting for your approval.</p>`)),
+	},
+	EmailTemplateTicketApproved: {
+		subject: texttemplate.Must(texttemplate.New(EmailTemplateTicketApproved).Parse("Your request {{.ticket_id}} was approved")),
+		body: template.Must(template.New(EmailTemplateTicketApproved).Parse(
+			`<p>Your request <code>{{.ticket_id}}</code> was approved by <strong>{{.approver}}</strong>.</p>` +
+				`{{with .comment}}<p>Comment: {{.}}</p>{{end}}`)),
+	},
+	EmailTemplateTicketRejected: {
+		subject: texttemplate.Must(texttemplate.New(EmailTemplateTicketRejected).Parse("Your request {{.ticket_id}} was rejected")),
+		body: template.Must(template.New(EmailTemplateTicketRejected).Parse(
+			`<p>Your request <code>{{.ticket_id}}</code> was rejected by <strong>{{.approver}}</strong>.</p>` +
+				`{{with .reason}}<p>Reason: {{.}}</p>{{end}}`)),
+	},
+	EmailTemplatePasswordResetRequired: {
+		subject: texttemplate.Must(texttemplate.New(EmailTemplatePasswordResetRequired).Parse("You must change your password")),
+		body: template.Must(template.New(EmailTemplatePasswordResetRequired).Parse(
+			`<p>An administrator (<strong>{{.actor}}</strong>) required a password change for your account ` +
+				`<code>{{.username}}</code> and signed out all of your sessions.</p>` +
+				`<p>You will be asked to choose a new password at your next login.</p>`)),
+	},
+}
+
+// RenderEmail renders the subject and HTML body of a named template. Values
+// in data are HTML-escaped in the body; the subject is plain text.
+func RenderEmail(name string, data map[string]string) (subject, body string, err error) {
+	tmpl, ok := emailTemplates[name]
+	if !ok {
+		return "", "", fmt.Errorf("unknown email template %q", name)
+	}
+	var subjectBuf, bodyBuf bytes.Buffer
+	if err := tmpl.subject.Execute(&subjectBuf, data); err != nil {
+		return "", "", fmt.Errorf("render email subject %q: %w", name, err)
+	}
+	if err := tmpl.body.Execute(&bodyBuf, data); err != nil {
+		return "", "", fmt.Errorf("render email template %q: %w", name, err)
+	}
+	return subjectBuf.String(), bodyBuf.String(), nil
+}
+
+// EmailChannel sends HTML email through the SMTP relay in PlatformConfig.
+// The configuration is read on every Send so admin changes apply without a
+// restart.
+type EmailChannel struct {
+	client *ent.Client
+}
+
+// NewEmailChannel creates a new EmailChannel.
+func NewEmailChannel(client *ent.Client) *EmailChannel {
+	return &EmailChannel{client: client}
+}
+
+// Config returns the saved SMTP section; an unsaved PlatformConfig yields
+// the zero (unconfigured) value.
+func (e *EmailChannel) Config(ctx context.Context) (SMTPConfig, error) {
+	cfg, err := e.client.PlatformConfig.Get(ctx, platformconfig.DefaultID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return SMTPConfig{}, nil
+		}
+		return SMTPConfig{}, fmt.Errorf("get platform config: %w", err)
+	}
+	return SMTPConfig{
+		Host:        cfg.SMTPHost,
+		Port:        cfg.SMTPPort,
+		Username:    cfg.SMTPUsername,
+		Password:    cfg.SMTPPassword,
+		FromAddress: cfg.SMTPFromAddress,
+	}, nil
+}
+
+// Send delivers one HTML email to a single address. STARTTLS is used when
+// the relay offers it, and AUTH PLAIN when a username is configured.
+func (e *EmailChannel) Send(ctx context.Context, to, subject, htmlBody string) error {
+	cfg, err := e.Config(ctx)
+	if err != nil {
+		return err
+	}
+	if !cfg.Configured() {
+		return ErrSMTPNotConfigured
+	}
+	return sendSMTP(ctx, cfg, to, buildEmailMessage(cfg.FromAddress, to, subject, htmlBody))
+}
+
+func sendSMTP(ctx context.Context, cfg SMTPConfig, to string, msg []byte) error {
+	addr := net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port))
+	dialer := net.Dialer{Timeout: EmailDialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("dial smtp %s: %w", addr, err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	client, err := smtp.NewClient(conn, cfg.Host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("smtp handshake: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: cfg.Host}); err != nil {
+			return fmt.Errorf("smtp starttls: %w", err)
+		}
+	}
+	if cfg.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)); err != nil {
+			return fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+	if err := client.Mail(cfg.FromAddress); err != nil {
+		return fmt.Errorf("smtp mail from: %w", err)
+	}
+	if err := client.Rcpt(to); err != nil {
+		return fmt.Errorf("smtp rcpt to: %w", err)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+	if _, err := w.Write(msg); err != nil {
+		return fmt.Errorf("smtp write message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("smtp end message: %w", err)
+	}
+	return client.Quit()
+}
+
+func buildEmailMessage(from, to, subject, htmlBody string) []byte {
+	var buf bytes.Buffer
+	fromAddr := (&mail.Address{Address: from}).String()
+	toAddr := (&mail.Address{Address: to}).String()
+	fmt.Fprintf(&buf, "From: %s\r\n", fromAddr)
+	fmt.Fprintf(&buf, "To: %s\r\n", toAddr)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().UTC().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/html; charset=UTF-8\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(htmlBody, "\n", "\r\n"))
+	buf.WriteString("\r\n")
+	return buf.Bytes()
+}
@@ -0,0 +1,56 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/notificationpreference"
+)
+
+// Types lists every notification type in display order.
+var Types = []string{
+	TypeApprovalPending,
+	TypeApprovalCompleted,
+	TypeApprovalRejected,
+	TypeApprovalComment,
+	TypeVMStatusChange,
+	TypeClusterStatusChange,
+}
+
+// nonMutableTypes are decisions on the recipient's own requests. They are
+// always delivered, whatever the recipient's preferences say.
+var nonMutableTypes = []string{
+	TypeApprovalCompleted,
+	TypeApprovalRejected,
+}
+
+// IsMutable reports whether users may turn off notifications of notifType.
+func IsMutable(notifType string) bool {
+	return !slices.Contains(nonMutableTypes, notifType)
+}
+
+// MutedRecipients returns the recipients that turned off notifType. Users
+// without a saved preference receive everything.
+func MutedRecipients(ctx context.Context, client *ent.Client, recipientIDs []string, notifType string) (map[string]bool, error) {
+	if client == nil || len(recipientIDs) == 0 || !IsMutable(notifType) {
+		return nil, nil
+	}
+	userIDs, err := client.NotificationPreference.Query().
+		Where(
+			notificationpreference.UserIDIn(recipientIDs...),
+			notificationpreference.EventTypeEQ(notificationpreference.EventType(notifType)),
+			notificationpreference.EnabledEQ(false),
+		).
+		Select(notificationpreference.FieldUserID).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query notification preferences: %w", err)
+	}
+	muted := make(map[string]bool, len(userIDs))
+	for _, id := range userIDs {
+		muted[id] = true
+	}
+	return muted, nil
+}
@@ -21,10 +21,12 @@ import (
 
 // Type constants matching ent/schema/notification.go enum values.
 const (
-	TypeApprovalPending   = "APPROVAL_PENDING"
-	TypeApprovalCompleted = "APPROVAL_COMPLETED"
-	TypeApprovalRejected  = "APPROVAL_REJECTED"
-	TypeVMStatusChange    = "VM_STATUS_CHANGE"
+	TypeApprovalPending     = "APPROVAL_PENDING"
+	TypeApprovalCompleted   = "APPROVAL_COMPLETED"
+	TypeApprovalRejected    = "APPROVAL_REJECTED"
+	TypeApprovalComment     = "APPROVAL_COMMENT"
+	TypeVMStatusChange      = "VM_STATUS_CHANGE"
+	TypeClusterStatusChange = "CLUSTER_STATUS_CHANGE"
 )
 
 // Params holds the required fields for creating a notification.
@@ -150,8 +152,12 @@ func toEntType(t string) (entnotification.Type, error) {
 		return entnotification.TypeAPPROVAL_COMPLETED, nil
 	case TypeApprovalRejected:
 		return entnotification.TypeAPPROVAL_REJECTED, nil
+	case TypeApprovalComment:
+		return entnotification.TypeAPPROVAL_COMMENT, nil
 	case TypeVMStatusChange:
 		return entnotification.TypeVM_STATUS_CHANGE, nil
+	case TypeClusterStatusChange:
+		return entnotification.TypeCLUSTER_STATUS_CHANGE, nil
 	default:
 		return "", fmt.Errorf("unknown notification type: %s", t)
 	}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"time"
 
 	"go.uber.org/zap"
 
 	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/ticketcomment"
 	"kv-shepherd.io/shepherd/internal/pkg/logger"
 )
 
@@ -20,8 +22,10 @@ import (
 // ADR-0015 §20: Notifications are synchronous writes within the same DB
 // transaction as business operations.
 type Triggers struct {
-	sender Sender
-	client *ent.Client
+	sender   Sender
+	client   *ent.Client
+	webhooks WebhookDispatcher
+	emails   EmailDispatcher
 }
 
 // NewTriggers creates a new notification trigger service.
@@ -29,14 +33,106 @@ func NewTriggers(sender Sender, client *ent.Client) *Triggers {
 	return &Triggers{sender: sender, client: client}
 }
 
+// SetWebhookDispatcher enables outbound webhooks for ticket and VM lifecycle events.
+// This is a setter to avoid breaking the existing constructor signature.
+func (t *Triggers) SetWebhookDispatcher(dispatcher WebhookDispatcher) {
+	t.webhooks = dispatcher
+}
+
+// SetEmailDispatcher enables email delivery for ticket created, approved and
+// rejected events.
+func (t *Triggers) SetEmailDispatcher(dispatcher EmailDispatcher) {
+	t.emails = dispatcher
+}
+
+// dispatchEmail queues one templated email per recipient (best-effort, like
+// webhooks).
+func (t *Triggers) dispatchEmail(ctx context.Context, recipientIDs []string, template string, data map[string]string) {
+	if t.emails == nil {
+		return
+	}
+	for _, recipientID := range recipientIDs {
+		email := EmailNotification{RecipientID: recipientID, Template: template, Data: data}
+		if err := t.emails.DispatchEmail(ctx, email); err != nil {
+			logger.Error("failed to dispatch email notification",
+				zap.String("template", template),
+				zap.String("ticket_id", data["ticket_id"]),
+				zap.String("recipient", recipientID),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// dispatchWebhook hands the event to the webhook dispatcher (best-effort;
+// in-platform notifications are unaffected by webhook failures).
+func (t *Triggers) dispatchWebhook(ctx context.Context, eventType string, data map[string]string) {
+	if t.webhooks == nil {
+		return
+	}
+	event := WebhookEvent{
+		Type:       eventType,
+		OccurredAt: time.Now().UTC(),
+		Data:       data,
+	}
+	if err := t.webhooks.Dispatch(ctx, event); err != nil {
+		logger.Error("failed to dispatch webhook event",
+			zap.String("event_type", eventType),
+			zap.String("ticket_id", data["ticket_id"]),
+			zap.String("vm_id", data["vm_id"]),
+			zap.Error(err),
+		)
+	}
+}
+
+// send writes an in-app notification unless the recipient muted its type.
+func (t *Triggers) send(ctx context.Context, params Params) error {
+	if t.muted(ctx, []string{params.RecipientID}, params.Type)[params.RecipientID] {
+		return nil
+	}
+	return t.sender.Send(ctx, params)
+}
+
+// sendToMany writes in-app notifications to the recipients that did not
+// mute the type.
+func (t *Triggers) sendToMany(ctx context.Context, recipientIDs []string, params Params) error {
+	muted := t.muted(ctx, recipientIDs, params.Type)
+	if len(muted) > 0 {
+		recipientIDs = slices.DeleteFunc(slices.Clone(recipientIDs), func(id string) bool { return muted[id] })
+	}
+	return t.sender.SendToMany(ctx, recipientIDs, params)
+}
+
+// muted looks up who turned off notifType. A failed lookup mutes nobody, so
+// preferences can never cause a notification to be dropped silently.
+func (t *Triggers) muted(ctx context.Context, recipientIDs []string, notifType string) map[string]bool {
+	muted, err := MutedRecipients(ctx, t.client, recipientIDs, notifType)
+	if err != nil {
+		logger.Warn("failed to load notification preferences; delivering to all recipients",
+			zap.String("type", notifType),
+			zap.Error(err),
+		)
+		return nil
+	}
+	return muted
+}
+
 // OnTicketSubmitted fires when a VM request is submitted and needs approval.
-// Notifies all users who have the "approval:approve" permission.
+// Notifies and emails all users who have the "approval:approve" permission and
+// emits the ticket.created webhook event.
 //
 // master-flow.md Stage 5.F / Event: VM Request Submitted:
 //
 //	INSERT INTO notifications ... SELECT user_id FROM role_bindings
 //	WHERE role_id IN (SELECT id FROM roles WHERE permissions @> 'approval:approve')
 func (t *Triggers) OnTicketSubmitted(ctx context.Context, ticketID, requesterName, namespace string) {
+	t.dispatchWebhook(ctx, WebhookEventTicketCreated, map[string]string{
+		"ticket_id": ticketID,
+		"actor":     requesterName,
+		"requester": requesterName,
+		"namespace": namespace,
+	})
+
 	approverIDs, err := t.findApproverUserIDs(ctx)
 	if err != nil {
 		logger.Error("failed to find approvers for notification",
@@ -51,6 +147,12 @@ func (t *Triggers) OnTicketSubmitted(ctx context.Context, ticketID, requesterNam
 		return
 	}
 
+	t.dispatchEmail(ctx, approverIDs, EmailTemplateTicketCreated, map[string]string{
+		"ticket_id": ticketID,
+		"requester": requesterName,
+		"namespace": namespace,
+	})
+
 	params := Params{
 		Type:         TypeApprovalPending,
 		Title:        "New VM request pending approval",
@@ -59,7 +161,7 @@ func (t *Triggers) OnTicketSubmitted(ctx context.Context, ticketID, requesterNam
 		ResourceID:   ticketID,
 	}
 
-	if err := t.sender.SendToMany(ctx, approverIDs, params); err != nil {
+	if err := t.sendToMany(ctx, approverIDs, params); err != nil {
 		// master-flow.md: "Notification write must not be dropped silently;
 		// failures must be observable."
 		logger.Error("failed to send APPROVAL_PENDING notifications",
@@ -77,17 +179,36 @@ func (t *Triggers) OnTicketSubmitted(ctx context.Context, ticketID, requesterNam
 //
 //	INSERT INTO notifications (recipient_id, type, title, metadata)
 //	VALUES (ticket.requested_by, 'APPROVAL_COMPLETED', ...)
-func (t *Triggers) OnTicketApproved(ctx context.Context, ticketID, requesterID, approver string) {
+func (t *Triggers) OnTicketApproved(ctx context.Context, ticketID, requesterID, approver, comment string) {
+	t.dispatchWebhook(ctx, WebhookEventTicketApproved, map[string]string{
+		"ticket_id": ticketID,
+		"actor":     approver,
+		"requester": requesterID,
+		"approver":  approver,
+		"comment":   comment,
+	})
+
+	t.dispatchEmail(ctx, []string{requesterID}, EmailTemplateTicketApproved, map[string]string{
+		"ticket_id": ticketID,
+		"approver":  approver,
+		"comment":   comment,
+	})
+
+	msg := fmt.Sprintf("Your request (ticket %s) was approved by %s", ticketID, approver)
+	if comment != "" {
+		msg += fmt.Sprintf(": %s", comment)
+	}
+
 	params := Params{
 		RecipientID:  requesterID,
 		Type:         TypeApprovalCompleted,
 		Title:        "Your VM request has been approved",
-		Message:      fmt.Sprintf("Your request (ticket %s) was approved by %s", ticketID, approver),
+		Message:      msg,
 		ResourceType: "approval_ticket",
 		ResourceID:   ticketID,
 	}
 
-	if err := t.sender.Send(ctx, params); err != nil {
+	if err := t.send(ctx, params); err != nil {
 		logger.Error("failed to send APPROVAL_COMPLETED notification",
 			zap.String("ticket_id", ticketID),
 			zap.String("requester", requesterID),
@@ -99,6 +220,20 @@ func (t *Triggers) OnTicketApproved(ctx context.Context, ticketID, requesterID,
 // OnTicketRejected fires when a ticket is rejected.
 // Notifies the requester that their request was rejected.
 func (t *Triggers) OnTicketRejected(ctx context.Context, ticketID, requesterID, approver, reason string) {
+	t.dispatchWebhook(ctx, WebhookEventTicketRejected, map[string]string{
+		"ticket_id": ticketID,
+		"actor":     approver,
+		"requester": requesterID,
+		"approver":  approver,
+		"reason":    reason,
+	})
+
+	t.dispatchEmail(ctx, []string{requesterID}, EmailTemplateTicketRejected, map[string]string{
+		"ticket_id": ticketID,
+		"approver":  approver,
+		"reason":    reason,
+	})
+
 	msg := fmt.Sprintf("Your request (ticket %s) was rejected by %s", ticketID, approver)
 	if reason != "" {
 		msg += fmt.Sprintf(": %s", reason)
@@ -113,7 +248,7 @@ func (t *Triggers) OnTicketRejected(ctx context.Context, ticketID, requesterID,
 		ResourceID:   ticketID,
 	}
 
-	if err := t.sender.Send(ctx, params); err != nil {
+	if err := t.send(ctx, params); err != nil {
 		logger.Error("failed to send APPROVAL_REJECTED notification",
 			zap.String("ticket_id", ticketID),
 			zap.String("requester", requesterID),
@@ -122,6 +257,105 @@ func (t *Triggers) OnTicketRejected(ctx context.Context, ticketID, requesterID,
 	}
 }
 
+// OnTicketExpired fires when a PENDING ticket is auto-rejected by the expiry job.
+// Notifies the requester that no decision was made within the TTL.
+func (t *Triggers) OnTicketExpired(ctx context.Context, ticketID, requesterID string, ttl time.Duration) {
+	params := Params{
+		RecipientID:  requesterID,
+		Type:         TypeApprovalRejected,
+		Title:        "Your request has expired",
+		Message:      fmt.Sprintf("Your request (ticket %s) was not decided within %s and has been automatically rejected", ticketID, ttl),
+		ResourceType: "approval_ticket",
+		ResourceID:   ticketID,
+	}
+
+	if err := t.send(ctx, params); err != nil {
+		logger.Error("failed to send ticket expiry notification",
+			zap.String("ticket_id", ticketID),
+			zap.String("requester", requesterID),
+			zap.Error(err),
+		)
+	}
+}
+
+// OnTicketReassigned fires when a pending ticket is delegated to another approver.
+// Notifies the new assignee that the ticket awaits their decision.
+func (t *Triggers) OnTicketReassigned(ctx context.Context, ticketID, assigneeID, reassignedBy string) {
+	params := Params{
+		RecipientID:  assigneeID,
+		Type:         TypeApprovalPending,
+		Title:        "Approval request assigned to you",
+		Message:      fmt.Sprintf("Ticket %s was reassigned to you by %s", ticketID, reassignedBy),
+		ResourceType: "approval_ticket",
+		ResourceID:   ticketID,
+	}
+
+	if err := t.send(ctx, params); err != nil {
+		logger.Error("failed to send reassignment notification",
+			zap.String("ticket_id", ticketID),
+			zap.String("assignee", assigneeID),
+			zap.Error(err),
+		)
+	}
+}
+
+// commentPreviewLength caps how much of a comment body is copied into the
+// notification message.
+const commentPreviewLength = 200
+
+// OnTicketCommented fires when a review comment is added to a ticket.
+// Notifies the requester and everyone who commented on the ticket before,
+// except the author.
+func (t *Triggers) OnTicketCommented(ctx context.Context, ticketID, requesterID, author, body string) {
+	authors, err := t.client.TicketComment.Query().
+		Where(ticketcomment.TicketIDEQ(ticketID)).
+		Select(ticketcomment.FieldAuthor).
+		Strings(ctx)
+	if err != nil {
+		logger.Error("failed to find commenters for notification",
+			zap.String("ticket_id", ticketID),
+			zap.Error(err),
+		)
+		return
+	}
+
+	seen := map[string]struct{}{author: {}}
+	var recipientIDs []string
+	for _, uid := range append([]string{requesterID}, authors...) {
+		if uid == "" {
+			continue
+		}
+		if _, ok := seen[uid]; ok {
+			continue
+		}
+		seen[uid] = struct{}{}
+		recipientIDs = append(recipientIDs, uid)
+	}
+	if len(recipientIDs) == 0 {
+		return
+	}
+
+	preview := []rune(body)
+	if len(preview) > commentPreviewLength {
+		preview = append(preview[:commentPreviewLength], '…')
+	}
+	params := Params{
+		Type:         TypeApprovalComment,
+		Title:        fmt.Sprintf("New comment on ticket %s", ticketID),
+		Message:      fmt.Sprintf("%s commented: %s", author, string(preview)),
+		ResourceType: "approval_ticket",
+		ResourceID:   ticketID,
+	}
+
+	if err := t.sendToMany(ctx, recipientIDs, params); err != nil {
+		logger.Error("failed to send APPROVAL_COMMENT notifications",
+			zap.String("ticket_id", ticketID),
+			zap.Int("recipient_count", len(recipientIDs)),
+			zap.Error(err),
+		)
+	}
+}
+
 // OnVMStatusChanged fires when a VM changes runtime state.
 // Notifies the resource owner about the state transition.
 //
@@ -139,7 +373,7 @@ func (t *Triggers) OnVMStatusChanged(ctx context.Context, vmID, vmName, ownerID,
 		ResourceID:   vmID,
 	}
 
-	if err := t.sender.Send(ctx, params); err != nil {
+	if err := t.send(ctx, params); err != nil {
 		logger.Error("failed to send VM_STATUS_CHANGE notification",
 			zap.String("vm_id", vmID),
 			zap.String("owner", ownerID),
@@ -149,14 +383,95 @@ func (t *Triggers) OnVMStatusChanged(ctx context.Context, vmID, vmName, ownerID,
 	}
 }
 
+// VMLifecycleEvent identifies the VM and request behind a VM lifecycle webhook.
+type VMLifecycleEvent struct {
+	VMID     string
+	VMName   string
+	TicketID string
+	EventID  string
+	Actor    string
+	// Reason is the failure reason for *_failed events.
+	Reason string
+}
+
+// OnVMLifecycle emits a VM lifecycle webhook event (vm.created,
+// vm.create_failed, vm.deleted, vm.delete_failed). In-platform notifications
+// for VM state changes are sent by OnVMStatusChanged.
+func (t *Triggers) OnVMLifecycle(ctx context.Context, eventType string, e VMLifecycleEvent) {
+	data := map[string]string{
+		"vm_id":    e.VMID,
+		"vm_name":  e.VMName,
+		"event_id": e.EventID,
+		"actor":    e.Actor,
+	}
+	if e.TicketID != "" {
+		data["ticket_id"] = e.TicketID
+	}
+	if e.Reason != "" {
+		data["reason"] = e.Reason
+	}
+	t.dispatchWebhook(ctx, eventType, data)
+}
+
+// OnPasswordResetForced fires when an admin forces a user to change their
+// password. Emails the user only; the password itself is never sent.
+func (t *Triggers) OnPasswordResetForced(ctx context.Context, userID, username, actor string) {
+	t.dispatchEmail(ctx, []string{userID}, EmailTemplatePasswordResetRequired, map[string]string{
+		"username": username,
+		"actor":    actor,
+	})
+}
+
+// OnClusterUnreachable fires when the periodic health check marks a cluster
+// UNREACHABLE. Notifies all users with the "platform:admin" permission.
+func (t *Triggers) OnClusterUnreachable(ctx context.Context, clusterID, clusterName, reason string) {
+	adminIDs, err := t.findUserIDsWithPermission(ctx, "platform:admin")
+	if err != nil {
+		logger.Error("failed to find platform admins for notification",
+			zap.String("cluster_id", clusterID),
+			zap.Error(err),
+		)
+		return
+	}
+	if len(adminIDs) == 0 {
+		logger.Warn("no platform admins found for notification", zap.String("cluster_id", clusterID))
+		return
+	}
+
+	message := fmt.Sprintf("Cluster %s failed consecutive health checks and is no longer selectable for new VMs", clusterName)
+	if reason != "" {
+		message += ": " + reason
+	}
+	params := Params{
+		Type:         TypeClusterStatusChange,
+		Title:        fmt.Sprintf("Cluster %s is unreachable", clusterName),
+		Message:      message,
+		ResourceType: "cluster",
+		ResourceID:   clusterID,
+	}
+
+	if err := t.sendToMany(ctx, adminIDs, params); err != nil {
+		logger.Error("failed to send CLUSTER_STATUS_CHANGE notifications",
+			zap.String("cluster_id", clusterID),
+			zap.Int("admin_count", len(adminIDs)),
+			zap.Error(err),
+		)
+	}
+}
+
 // findApproverUserIDs queries all user IDs that have the "approval:approve" permission.
-// Ent JSON array fields don't generate DB-level Contains predicates, so we
-// query all roles with their bindings+users and filter in Go.
 //
 // master-flow.md Stage 5.F:
 //
 //	FROM role_bindings WHERE role_id IN (SELECT id FROM roles WHERE permissions @> 'approval:approve')
 func (t *Triggers) findApproverUserIDs(ctx context.Context) ([]string, error) {
+	return t.findUserIDsWithPermission(ctx, "approval:approve")
+}
+
+// findUserIDsWithPermission queries all user IDs bound to a role granting
+// permission. Ent JSON array fields don't generate DB-level Contains
+// predicates, so we query all roles with their bindings+users and filter in Go.
+func (t *Triggers) findUserIDsWithPermission(ctx context.Context, permission string) ([]string, error) {
 	// Query all roles eager-loading bindings → users.
 	roles, err := t.client.Role.Query().
 		WithRoleBindings(func(q *ent.RoleBindingQuery) {
@@ -171,8 +486,7 @@ func (t *Triggers) findApproverUserIDs(ctx context.Context) ([]string, error) {
 	var userIDs []string
 
 	for _, r := range roles {
-		// Filter: role must contain "approval:approve" permission.
-		if !slices.Contains(r.Permissions, "approval:approve") {
+		if !slices.Contains(r.Permissions, permission) {
 			continue
 		}
 		for _, b := range r.Edges.RoleBindings {
@@ -0,0 +1,92 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Webhook event types an endpoint can subscribe to.
+const (
+	WebhookEventTicketCreated  = "ticket.created"
+	WebhookEventTicketApproved = "ticket.approved"
+	WebhookEventTicketRejected = "ticket.rejected"
+	WebhookEventVMCreated      = "vm.created"
+	WebhookEventVMCreateFailed = "vm.create_failed"
+	WebhookEventVMDeleted      = "vm.deleted"
+	WebhookEventVMDeleteFailed = "vm.delete_failed"
+)
+
+// WebhookEventTest is sent by the admin test endpoint. It is not subscribable.
+const WebhookEventTest = "webhook.test"
+
+// WebhookEventTypes lists every event type accepted in endpoint subscriptions.
+var WebhookEventTypes = []string{
+	WebhookEventTicketCreated,
+	WebhookEventTicketApproved,
+	WebhookEventTicketRejected,
+	WebhookEventVMCreated,
+	WebhookEventVMCreateFailed,
+	WebhookEventVMDeleted,
+	WebhookEventVMDeleteFailed,
+}
+
+// Webhook request headers.
+const (
+	WebhookSignatureHeader = "X-Shepherd-Signature"
+	WebhookEventHeader     = "X-Shepherd-Event"
+	WebhookDeliveryHeader  = "X-Shepherd-Delivery"
+)
+
+// WebhookEvent is the JSON body POSTed to webhook endpoints. Data always
+// carries "actor" and the ticket_id and/or vm_id the event is about.
+type WebhookEvent struct {
+	Type       string            `json:"type"`
+	OccurredAt time.Time         `json:"occurred_at"`
+	Data       map[string]string `json:"data"`
+}
+
+// WebhookDispatcher fans an event out to subscribed endpoints. Implementations
+// must not block on delivery (V1: one River job per endpoint).
+type WebhookDispatcher interface {
+	Dispatch(ctx context.Context, event WebhookEvent) error
+}
+
+// SignWebhookPayload returns the X-Shepherd-Signature value for body:
+// "sha256=" followed by the hex HMAC-SHA256 of body keyed by secret.
+func SignWebhookPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// PostWebhook POSTs a signed payload to url and returns the receiver's HTTP
+// status (0 on transport errors). Non-2xx responses are errors.
+func PostWebhook(ctx context.Context, client *http.Client, url, secret, eventType, deliveryID string, payload []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return 0, fmt.Errorf("build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, eventType)
+	req.Header.Set(WebhookDeliveryHeader, deliveryID)
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(secret, payload))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook receiver returned HTTP %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
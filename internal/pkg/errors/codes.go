@@ -8,10 +8,12 @@ import "net/http"
 
 // VM error codes.
 const (
-	CodeVMNotFound   = "VM_NOT_FOUND"
-	CodeVMCreateFail = "VM_CREATION_FAILED"
-	CodeVMDeleteFail = "VM_DELETION_FAILED"
-	CodeVMModifyFail = "VM_MODIFY_FAILED"
+	CodeVMNotFound       = "VM_NOT_FOUND"
+	CodeVMCreateFail     = "VM_CREATION_FAILED"
+	CodeVMDeleteFail     = "VM_DELETION_FAILED"
+	CodeVMModifyFail     = "VM_MODIFY_FAILED"
+	CodeVMNameConflict   = "VM_NAME_CONFLICT"
+	CodeUserDataDisabled = "USER_DATA_DISABLED"
 )
 
 // System/Service error codes.
@@ -22,6 +24,17 @@ const (
 	CodeServiceExists   = "SERVICE_ALREADY_EXISTS"
 )
 
+// Catalog reference error codes, reported when a VM request names a
+// template, instance size or namespace that cannot be used.
+const (
+	CodeTemplateNotFound       = "TEMPLATE_NOT_FOUND"
+	CodeTemplateDisabled       = "TEMPLATE_DISABLED"
+	CodeInstanceSizeNotFound   = "INSTANCE_SIZE_NOT_FOUND"
+	CodeInstanceSizeDisabled   = "INSTANCE_SIZE_DISABLED"
+	CodeNamespaceNotRegistered = "NAMESPACE_NOT_REGISTERED"
+	CodeNamespaceDisabled      = "NAMESPACE_DISABLED"
+)
+
 // Cluster error codes.
 const (
 	CodeClusterUnhealthy = "CLUSTER_UNHEALTHY"
@@ -44,7 +57,7 @@ const (
 
 // Quota error codes.
 const (
-	CodeQuotaExceeded = "QUOTA_EXCEEDED" // V2+ reserved
+	CodeQuotaExceeded = "QUOTA_EXCEEDED"
 )
 
 // Auth error codes.
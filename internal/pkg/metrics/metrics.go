@@ -0,0 +1,147 @@
+// Package metrics provides the Prometheus collectors exported by KubeVirt Shepherd.
+//
+// Collectors are registered on a dedicated registry served by Handler on the
+// internal metrics port (server.metrics_port), never on the public API router.
+// Gauges that need database queries are refreshed by a Sampler, not at scrape
+// time.
+//
+// Import Path (ADR-0016): kv-shepherd.io/shepherd/internal/pkg/metrics
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Batch submission statuses used by BatchSubmissions.
+const (
+	SubmissionAccepted    = "accepted"
+	SubmissionRateLimited = "rate_limited"
+	SubmissionRejected    = "rejected"
+)
+
+// VM operation results used by VMOperations.
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+	ResultSkipped = "skipped"
+)
+
+var (
+	registry = prometheus.NewRegistry()
+
+	// BatchSubmissions counts batch submissions by operation and outcome.
+	BatchSubmissions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "shepherd_batch_submissions_total",
+		Help: "Batch VM submissions by operation and status.",
+	}, []string{"operation", "status"})
+
+	// ApprovalDecisions counts approval decisions recorded by the gateway.
+	ApprovalDecisions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "shepherd_approval_decisions_total",
+		Help: "Approval decisions by decision.",
+	}, []string{"decision"})
+
+	// VMOperations counts finished VM operations executed by River workers.
+	VMOperations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "shepherd_vm_operations_total",
+		Help: "VM operations executed by workers, by operation and result.",
+	}, []string{"operation", "result"})
+
+	// HTTPRequestDuration observes API request latency by route template,
+	// recorded by the API's metrics middleware.
+	HTTPRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "shepherd_http_request_duration_seconds",
+		Help:    "HTTP request duration by method, route and status code.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	// JobOutcomes counts worked River jobs by kind and result, recorded by
+	// JobMiddleware.
+	JobOutcomes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "shepherd_jobs_total",
+		Help: "River jobs worked, by job kind and result.",
+	}, []string{"kind", "result"})
+
+	// JobDuration observes River job execution time, recorded by JobMiddleware.
+	JobDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "shepherd_job_duration_seconds",
+		Help:    "River job execution duration by job kind.",
+		Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+	}, []string{"kind"})
+
+	// VNCProxyActive tracks proxied VNC connections currently open.
+	VNCProxyActive = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "shepherd_vnc_proxy_active_connections",
+		Help: "VNC WebSocket connections currently proxied to VMs.",
+	})
+
+	// VNCProxyDuration observes how long proxied VNC connections stay open.
+	VNCProxyDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "shepherd_vnc_proxy_connection_duration_seconds",
+		Help:    "Duration of proxied VNC connections.",
+		Buckets: []float64{1, 10, 30, 60, 300, 900, 1800, 3600, 7200, 14400},
+	})
+
+	// PendingApprovals is the sampled number of top-level approval tickets
+	// awaiting a decision.
+	PendingApprovals = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "shepherd_pending_approvals",
+		Help: "Approval tickets currently awaiting a decision.",
+	})
+
+	// PendingBatchParents is the sampled number of batches awaiting approval.
+	PendingBatchParents = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "shepherd_pending_batch_parents",
+		Help: "Batch parents currently awaiting approval.",
+	})
+
+	// VMs is the sampled number of VMs by status.
+	VMs = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "shepherd_vms",
+		Help: "VMs by status.",
+	}, []string{"status"})
+
+	// Clusters is the sampled number of clusters by health status.
+	Clusters = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "shepherd_clusters",
+		Help: "KubeVirt clusters by health status.",
+	}, []string{"status"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		BatchSubmissions,
+		ApprovalDecisions,
+		VMOperations,
+		HTTPRequestDuration,
+		JobOutcomes,
+		JobDuration,
+		VNCProxyActive,
+		VNCProxyDuration,
+		PendingApprovals,
+		PendingBatchParents,
+		VMs,
+		Clusters,
+	)
+}
+
+// Handler returns the HTTP handler serving the registry in the Prometheus
+// exposition format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// SetCounts replaces every series of vec with counts keyed by the single
+// label value, so statuses that disappeared since the last sample drop out.
+func SetCounts(vec *prometheus.GaugeVec, counts map[string]int) {
+	vec.Reset()
+	for value, n := range counts {
+		vec.WithLabelValues(value).Set(float64(n))
+	}
+}
@@ -0,0 +1,133 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/riverqueue/river/rivertype"
+
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+func init() {
+	_ = logger.Init("error", "json")
+}
+
+func scrape(t *testing.T) string {
+	t.Helper()
+	w := httptest.NewRecorder()
+	Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("scrape status = %d, want %d", w.Code, http.StatusOK)
+	}
+	body, err := io.ReadAll(w.Body)
+	if err != nil {
+		t.Fatalf("read scrape body: %v", err)
+	}
+	return string(body)
+}
+
+func TestHandler_ExposesShepherdMetrics(t *testing.T) {
+	BatchSubmissions.WithLabelValues("create", SubmissionAccepted).Inc()
+	ApprovalDecisions.WithLabelValues("approved").Inc()
+	VMOperations.WithLabelValues("start", ResultSuccess).Inc()
+	PendingApprovals.Set(3)
+
+	body := scrape(t)
+	for _, want := range []string{
+		`shepherd_batch_submissions_total{operation="create",status="accepted"}`,
+		`shepherd_approval_decisions_total{decision="approved"}`,
+		`shepherd_vm_operations_total{operation="start",result="success"}`,
+		"shepherd_pending_approvals 3",
+		"shepherd_vnc_proxy_active_connections",
+		"shepherd_vnc_proxy_connection_duration_seconds_count",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("scrape output missing %q", want)
+		}
+	}
+}
+
+func TestSampler_RefreshesGaugesAndKeepsValuesOnError(t *testing.T) {
+	sampler := NewSampler(time.Second)
+	calls := 0
+	if err := sampler.Add("test_vms", func(context.Context) error {
+		calls++
+		if calls > 1 {
+			return errors.New("db down")
+		}
+		SetCounts(VMs, map[string]int{"RUNNING": 4, "STOPPED": 1})
+		return nil
+	}); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if err := sampler.Add("test_vms", func(context.Context) error { return nil }); err == nil {
+		t.Fatal("duplicate Add() error = nil, want rejection")
+	}
+
+	sampler.SampleOnce(context.Background())
+	sampler.SampleOnce(context.Background())
+	body := scrape(t)
+	for _, want := range []string{`shepherd_vms{status="RUNNING"} 4`, `shepherd_vms{status="STOPPED"} 1`} {
+		if !strings.Contains(body, want) {
+			t.Errorf("scrape output missing %q after a failed sample", want)
+		}
+	}
+
+	SetCounts(VMs, map[string]int{"RUNNING": 2})
+	if body := scrape(t); strings.Contains(body, `shepherd_vms{status="STOPPED"}`) {
+		t.Error("SetCounts kept a status missing from the new sample")
+	}
+}
+
+func TestSampler_StartSamplesUntilStopped(t *testing.T) {
+	sampler := NewSampler(10 * time.Millisecond)
+	sampled := make(chan struct{}, 10)
+	if err := sampler.Add("tick", func(context.Context) error {
+		select {
+		case sampled <- struct{}{}:
+		default:
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	sampler.Start(context.Background())
+	defer sampler.Stop()
+	for i := 0; i < 2; i++ {
+		select {
+		case <-sampled:
+		case <-time.After(time.Second):
+			t.Fatalf("sample %d did not run", i+1)
+		}
+	}
+	sampler.Stop()
+	sampler.Stop()
+}
+
+func TestJobMiddleware_ObservesDurationAndPropagatesError(t *testing.T) {
+	wantErr := errors.New("boom")
+	job := &rivertype.JobRow{Kind: "metrics_test_job"}
+
+	err := NewJobMiddleware().Work(context.Background(), job, func(context.Context) error { return wantErr })
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("Work() error = %v, want %v", err, wantErr)
+	}
+	if got := testutil.CollectAndCount(JobDuration, "shepherd_job_duration_seconds"); got < 1 {
+		t.Fatalf("job duration series = %d, want at least 1", got)
+	}
+	body := scrape(t)
+	if !strings.Contains(body, `shepherd_job_duration_seconds_count{kind="metrics_test_job"} 1`) {
+		t.Fatal("job duration not observed under the job kind")
+	}
+	if !strings.Contains(body, `shepherd_jobs_total{kind="metrics_test_job",result="failure"} 1`) {
+		t.Fatal("failed job not counted under the job kind")
+	}
+}
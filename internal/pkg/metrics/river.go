@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// JobMiddleware is a River worker middleware recording
+// shepherd_job_duration_seconds and shepherd_jobs_total for every worked job,
+// successful or not.
+type JobMiddleware struct {
+	river.MiddlewareDefaults
+}
+
+// NewJobMiddleware creates the job duration middleware.
+func NewJobMiddleware() *JobMiddleware {
+	return &JobMiddleware{}
+}
+
+// Work times doInner and records its duration and result under the job kind.
+func (m *JobMiddleware) Work(ctx context.Context, job *rivertype.JobRow, doInner func(ctx context.Context) error) error {
+	start := time.Now()
+	err := doInner(ctx)
+	JobDuration.WithLabelValues(job.Kind).Observe(time.Since(start).Seconds())
+	result := ResultSuccess
+	if err != nil {
+		result = ResultFailure
+	}
+	JobOutcomes.WithLabelValues(job.Kind, result).Inc()
+	return err
+}
+
+var _ rivertype.WorkerMiddleware = (*JobMiddleware)(nil)
@@ -0,0 +1,109 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// DefaultSampleInterval is used when NewSampler is given a non-positive
+// interval.
+const DefaultSampleInterval = 30 * time.Second
+
+// SampleFunc refreshes one group of gauges, e.g. by counting rows.
+type SampleFunc func(ctx context.Context) error
+
+type sampleSource struct {
+	name   string
+	sample SampleFunc
+}
+
+// Sampler refreshes gauges that need database queries on an interval, so a
+// scrape only reads the last sample and never waits on the database.
+type Sampler struct {
+	mu       sync.Mutex
+	sources  []sampleSource
+	interval time.Duration
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// NewSampler creates a Sampler running every interval.
+func NewSampler(interval time.Duration) *Sampler {
+	if interval <= 0 {
+		interval = DefaultSampleInterval
+	}
+	return &Sampler{interval: interval, stopCh: make(chan struct{})}
+}
+
+// Add registers a sample source. Duplicate names are rejected.
+func (s *Sampler) Add(name string, sample SampleFunc) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("sample source name is empty")
+	}
+	if sample == nil {
+		return fmt.Errorf("sample source %s is nil", name)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, src := range s.sources {
+		if src.name == name {
+			return fmt.Errorf("sample source already registered: %s", name)
+		}
+	}
+	s.sources = append(s.sources, sampleSource{name: name, sample: sample})
+	return nil
+}
+
+// SampleOnce runs every source once, each bounded by the interval. A failing
+// source keeps its previous values.
+func (s *Sampler) SampleOnce(ctx context.Context) {
+	s.mu.Lock()
+	sources := append([]sampleSource(nil), s.sources...)
+	s.mu.Unlock()
+
+	for _, src := range sources {
+		sampleCtx, cancel := context.WithTimeout(ctx, s.interval)
+		err := src.sample(sampleCtx)
+		cancel()
+		if err != nil {
+			logger.Warn("metrics sample failed", zap.String("source", src.name), zap.Error(err))
+		}
+	}
+}
+
+// Start samples immediately and then every interval until ctx is done or
+// Stop is called.
+func (s *Sampler) Start(ctx context.Context) {
+	go func() { //nolint:naked-goroutine // single sampler loop, ended by Stop or ctx
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		s.SampleOnce(ctx)
+		for {
+			select {
+			case <-ticker.C:
+				s.SampleOnce(ctx)
+			case <-s.stopCh:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop halts periodic sampling. It is safe to call more than once.
+func (s *Sampler) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+}
@@ -3,7 +3,9 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"sort"
 	"strings"
@@ -38,6 +40,30 @@ type AuthProviderAdminAdapter interface {
 	TestConnection(ctx context.Context, config map[string]interface{}) (bool, string, error)
 	// SampleFields extracts sample fields for RBAC mapping configuration.
 	SampleFields(ctx context.Context, config map[string]interface{}) ([]AuthProviderSampleField, error)
+	// SecretFields lists the top-level config keys holding secrets (client
+	// secrets, bind passwords). Their values are never returned by the API.
+	SecretFields() []string
+}
+
+// AuthProviderGroup is one group reported by an identity provider.
+type AuthProviderGroup struct {
+	ID          string
+	Name        string
+	Description string
+	// SourceField is the claim or attribute carrying the group; empty keeps
+	// the source field already recorded for the group.
+	SourceField string
+}
+
+// ErrAuthProviderGroupListingUnsupported is returned by ListGroups when the
+// provider config does not allow listing groups.
+var ErrAuthProviderGroupListingUnsupported = errors.New("auth provider group listing is not configured")
+
+// AuthProviderGroupLister is an optional adapter extension for scheduled
+// group re-sync. ListGroups returns the provider's complete group set; groups
+// missing from it are treated as removed from the IdP.
+type AuthProviderGroupLister interface {
+	ListGroups(ctx context.Context, config map[string]interface{}) ([]AuthProviderGroup, error)
 }
 
 // AuthProviderAdminAdapterDescriber is an optional adapter extension for metadata exposure.
@@ -139,8 +165,13 @@ type genericAuthProviderAdminAdapter struct {
 	description  string
 	builtIn      bool
 	configSchema map[string]interface{}
+	secretFields []string
 }
 
+// genericAuthProviderSecretFields are the secret keys of the built-in
+// standard-contract adapters.
+var genericAuthProviderSecretFields = []string{"client_secret", "bind_password"}
+
 func builtInAuthProviderAdapters() []AuthProviderAdminAdapter {
 	schema := map[string]interface{}{
 		"type":                 "object",
@@ -150,6 +181,7 @@ func builtInAuthProviderAdapters() []AuthProviderAdminAdapter {
 			"healthcheck_url": map[string]interface{}{
 				"type": "string",
 			},
+			"groups_endpoint": map[string]interface{}{"type": "string"},
 			"sample_users": map[string]interface{}{
 				"type": "array",
 				"items": map[string]interface{}{
@@ -167,6 +199,7 @@ func builtInAuthProviderAdapters() []AuthProviderAdminAdapter {
 			description:  "Provider plugin using Shepherd standard auth-provider contract",
 			builtIn:      true,
 			configSchema: schema,
+			secretFields: genericAuthProviderSecretFields,
 		},
 		&genericAuthProviderAdminAdapter{
 			typeKey:      "oidc",
@@ -174,6 +207,7 @@ func builtInAuthProviderAdapters() []AuthProviderAdminAdapter {
 			description:  "OpenID Connect provider via standardized adapter contract",
 			builtIn:      true,
 			configSchema: schema,
+			secretFields: genericAuthProviderSecretFields,
 		},
 		&genericAuthProviderAdminAdapter{
 			typeKey:      "ldap",
@@ -181,6 +215,7 @@ func builtInAuthProviderAdapters() []AuthProviderAdminAdapter {
 			description:  "LDAP provider via standardized adapter contract",
 			builtIn:      true,
 			configSchema: schema,
+			secretFields: genericAuthProviderSecretFields,
 		},
 		&genericAuthProviderAdminAdapter{
 			typeKey:      "sso",
@@ -188,6 +223,7 @@ func builtInAuthProviderAdapters() []AuthProviderAdminAdapter {
 			description:  "Enterprise SSO provider via standardized adapter contract",
 			builtIn:      true,
 			configSchema: schema,
+			secretFields: genericAuthProviderSecretFields,
 		},
 	}
 }
@@ -204,6 +240,8 @@ func (a *genericAuthProviderAdminAdapter) Describe() AuthProviderTypeDescriptor
 	}
 }
 
+func (a *genericAuthProviderAdminAdapter) SecretFields() []string { return a.secretFields }
+
 func (a *genericAuthProviderAdminAdapter) ValidateConfig(config map[string]interface{}) error {
 	if len(config) == 0 {
 		return fmt.Errorf("config must not be empty")
@@ -301,6 +339,91 @@ func (a *genericAuthProviderAdminAdapter) SampleFields(_ context.Context, config
 	return fields, nil
 }
 
+// authProviderGroupListMaxBytes caps the groups_endpoint response body.
+const authProviderGroupListMaxBytes = 8 << 20
+
+// ListGroups fetches the group set from the configured groups_endpoint, which
+// returns a JSON array of group names or of {id, name, description,
+// source_field} objects, optionally wrapped as {"groups": [...]}.
+func (a *genericAuthProviderAdminAdapter) ListGroups(ctx context.Context, config map[string]interface{}) ([]AuthProviderGroup, error) {
+	endpoint := configStringValue(config, "groups_endpoint")
+	if endpoint == "" {
+		return nil, ErrAuthProviderGroupListingUnsupported
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid groups endpoint: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req) // #nosec G107 -- endpoint is admin-supplied configuration.
+	if err != nil {
+		return nil, fmt.Errorf("groups request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("groups endpoint status %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, authProviderGroupListMaxBytes))
+	if err != nil {
+		return nil, fmt.Errorf("read groups response: %w", err)
+	}
+	return parseAuthProviderGroups(body)
+}
+
+func parseAuthProviderGroups(body []byte) ([]AuthProviderGroup, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		var wrapped struct {
+			Groups []json.RawMessage `json:"groups"`
+		}
+		if wrapErr := json.Unmarshal(body, &wrapped); wrapErr != nil || wrapped.Groups == nil {
+			return nil, fmt.Errorf("groups response must be a JSON array or {\"groups\": [...]}")
+		}
+		raw = wrapped.Groups
+	}
+
+	groups := make([]AuthProviderGroup, 0, len(raw))
+	for i, item := range raw {
+		var name string
+		if json.Unmarshal(item, &name) == nil {
+			name = strings.TrimSpace(name)
+			if name == "" {
+				return nil, fmt.Errorf("group %d is empty", i)
+			}
+			groups = append(groups, AuthProviderGroup{ID: name, Name: name})
+			continue
+		}
+		var obj struct {
+			ID          string `json:"id"`
+			Name        string `json:"name"`
+			Description string `json:"description"`
+			SourceField string `json:"source_field"`
+		}
+		if err := json.Unmarshal(item, &obj); err != nil {
+			return nil, fmt.Errorf("group %d must be a string or an object", i)
+		}
+		group := AuthProviderGroup{
+			ID:          strings.TrimSpace(obj.ID),
+			Name:        strings.TrimSpace(obj.Name),
+			Description: strings.TrimSpace(obj.Description),
+			SourceField: strings.TrimSpace(obj.SourceField),
+		}
+		if group.ID == "" {
+			group.ID = group.Name
+		}
+		if group.ID == "" {
+			return nil, fmt.Errorf("group %d has no id or name", i)
+		}
+		if group.Name == "" {
+			group.Name = group.ID
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
 func configStringValue(config map[string]interface{}, keys ...string) string {
 	for _, key := range keys {
 		v, ok := config[key]
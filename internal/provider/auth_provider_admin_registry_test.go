@@ -2,6 +2,9 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"slices"
 	"testing"
 )
@@ -24,6 +27,8 @@ func (a *testAuthProviderAdapter) SampleFields(_ context.Context, _ map[string]i
 	return nil, nil
 }
 
+func (a *testAuthProviderAdapter) SecretFields() []string { return nil }
+
 func TestAuthProviderAdminRegistryBuiltinsAndStrictRegistration(t *testing.T) {
 	t.Parallel()
 
@@ -58,3 +63,36 @@ func TestAuthProviderAdminRegistryBuiltinsAndStrictRegistration(t *testing.T) {
 		t.Fatal("expected duplicate adapter registration to fail")
 	}
 }
+
+func TestGenericAuthProviderListGroups(t *testing.T) {
+	t.Parallel()
+
+	adapter, ok := ResolveAuthProviderAdminAdapter("ldap").(AuthProviderGroupLister)
+	if !ok {
+		t.Fatal("ldap adapter does not list groups")
+	}
+	if _, err := adapter.ListGroups(t.Context(), map[string]interface{}{}); !errors.Is(err, ErrAuthProviderGroupListingUnsupported) {
+		t.Fatalf("ListGroups without groups_endpoint error = %v, want ErrAuthProviderGroupListingUnsupported", err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"groups":["devs",{"id":"cn=ops","name":"Ops","source_field":"memberOf"},{"name":"sre"}]}`))
+	}))
+	defer srv.Close()
+	groups, err := adapter.ListGroups(t.Context(), map[string]interface{}{"groups_endpoint": srv.URL})
+	if err != nil {
+		t.Fatalf("ListGroups error = %v", err)
+	}
+	want := []AuthProviderGroup{
+		{ID: "devs", Name: "devs"},
+		{ID: "cn=ops", Name: "Ops", SourceField: "memberOf"},
+		{ID: "sre", Name: "sre"},
+	}
+	if !slices.Equal(groups, want) {
+		t.Fatalf("ListGroups = %+v, want %+v", groups, want)
+	}
+
+	if _, err := parseAuthProviderGroups([]byte(`[{"description":"nameless"}]`)); err == nil {
+		t.Fatal("parseAuthProviderGroups accepted a group without id or name")
+	}
+}
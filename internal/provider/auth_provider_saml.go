@@ -0,0 +1,361 @@
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/crewjam/saml"
+)
+
+// SAMLAuthType is the auth_providers.auth_type key of the SAML 2.0 adapter.
+const SAMLAuthType = "saml"
+
+// Default SAML attribute names used when the provider config does not
+// override them. Values follow the common Azure AD / Keycloak conventions.
+const (
+	defaultSAMLUsernameAttribute    = "uid"
+	defaultSAMLEmailAttribute       = "email"
+	defaultSAMLDisplayNameAttribute = "displayName"
+	defaultSAMLGroupsAttribute      = "groups"
+
+	samlMetadataMaxBytes = 1 << 20
+)
+
+func init() {
+	if err := RegisterAuthProviderAdminAdapter(&samlAuthProviderAdminAdapter{}); err != nil {
+		panic(fmt.Sprintf("register saml auth provider adapter: %v", err))
+	}
+}
+
+// samlAuthProviderAdminAdapter implements the SAML 2.0 Web Browser SSO profile.
+//
+// Required config keys describe the IdP: entity_id (expected assertion Issuer),
+// sso_url (IdP SSO endpoint) and certificate (PEM signing certificate).
+// Optional keys: metadata_xml / metadata_url (IdP metadata for connection
+// tests), sp_entity_id (expected Audience, defaults to the ACS URL), acs_url,
+// and *_attribute overrides for username, email, display name and groups.
+type samlAuthProviderAdminAdapter struct{}
+
+func (a *samlAuthProviderAdminAdapter) Type() string { return SAMLAuthType }
+
+func (a *samlAuthProviderAdminAdapter) Describe() AuthProviderTypeDescriptor {
+	str := map[string]interface{}{"type": "string"}
+	return AuthProviderTypeDescriptor{
+		Type:        SAMLAuthType,
+		DisplayName: "SAML 2.0",
+		Description: "SAML 2.0 identity provider (HTTP-POST assertion consumer)",
+		BuiltIn:     true,
+		ConfigSchema: map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": true,
+			"required":             []string{"entity_id", "sso_url", "certificate"},
+			"properties": map[string]interface{}{
+				"entity_id":              str,
+				"sso_url":                str,
+				"certificate":            str,
+				"metadata_xml":           str,
+				"metadata_url":           str,
+				"sp_entity_id":           str,
+				"acs_url":                str,
+				"username_attribute":     str,
+				"email_attribute":        str,
+				"display_name_attribute": str,
+				"groups_attribute":       str,
+			},
+		},
+	}
+}
+
+// SecretFields is empty: the SAML config holds only the IdP's public signing
+// certificate.
+func (a *samlAuthProviderAdminAdapter) SecretFields() []string { return nil }
+
+func (a *samlAuthProviderAdminAdapter) ValidateConfig(config map[string]interface{}) error {
+	for _, key := range []string{"entity_id", "sso_url", "certificate"} {
+		if configStringValue(config, key) == "" {
+			return fmt.Errorf("%s is required", key)
+		}
+	}
+	if err := validateAbsoluteHTTPURL(configStringValue(config, "sso_url")); err != nil {
+		return fmt.Errorf("sso_url: %w", err)
+	}
+	for _, key := range []string{"metadata_url", "acs_url"} {
+		if raw := configStringValue(config, key); raw != "" {
+			if err := validateAbsoluteHTTPURL(raw); err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+		}
+	}
+	if _, err := parseSAMLCertificate(configStringValue(config, "certificate")); err != nil {
+		return fmt.Errorf("certificate: %w", err)
+	}
+	return nil
+}
+
+// TestConnection verifies the configured signing certificate and, when IdP
+// metadata is available, that the metadata advertises the same entity ID and
+// certificate. Validation failures are reported as (false, message, nil).
+func (a *samlAuthProviderAdminAdapter) TestConnection(ctx context.Context, config map[string]interface{}) (bool, string, error) {
+	if err := a.ValidateConfig(config); err != nil {
+		return false, "invalid configuration: " + err.Error(), nil
+	}
+	cert, _ := parseSAMLCertificate(configStringValue(config, "certificate"))
+	now := time.Now()
+	if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+		return false, fmt.Sprintf("certificate is not valid at %s (valid %s to %s)",
+			now.UTC().Format(time.RFC3339),
+			cert.NotBefore.UTC().Format(time.RFC3339),
+			cert.NotAfter.UTC().Format(time.RFC3339),
+		), nil
+	}
+
+	raw, source, err := loadSAMLMetadata(ctx, config)
+	if err != nil {
+		return false, err.Error(), nil
+	}
+	if raw == nil {
+		return true, "certificate valid (no IdP metadata configured)", nil
+	}
+
+	var metadata saml.EntityDescriptor
+	if err := xml.Unmarshal(raw, &metadata); err != nil {
+		return false, fmt.Sprintf("parse IdP metadata from %s: %v", source, err), nil
+	}
+	if entityID := configStringValue(config, "entity_id"); metadata.EntityID != entityID {
+		return false, fmt.Sprintf("metadata entityID %q does not match entity_id %q", metadata.EntityID, entityID), nil
+	}
+	signing, err := samlMetadataSigningCertificates(&metadata)
+	if err != nil {
+		return false, err.Error(), nil
+	}
+	if !slices.ContainsFunc(signing, cert.Equal) {
+		return false, "certificate is not listed as a signing key in IdP metadata", nil
+	}
+	return true, fmt.Sprintf("IdP metadata from %s matches configured certificate", source), nil
+}
+
+// SampleFields reuses the generic sample_users extraction; SAML assertions
+// are only observed at login time, so admins seed samples in config.
+func (a *samlAuthProviderAdminAdapter) SampleFields(ctx context.Context, config map[string]interface{}) ([]AuthProviderSampleField, error) {
+	return (&genericAuthProviderAdminAdapter{}).SampleFields(ctx, config)
+}
+
+// AuthenticateSAMLResponse validates the SAMLResponse posted to the assertion
+// consumer service and maps the assertion to an AuthResult. The configured
+// certificate is the only trust anchor; metadata is never consulted here.
+// acsURL is used when the provider config does not pin acs_url.
+func AuthenticateSAMLResponse(config map[string]interface{}, req *http.Request, acsURL string) (*AuthResult, error) {
+	if err := (&samlAuthProviderAdminAdapter{}).ValidateConfig(config); err != nil {
+		return nil, fmt.Errorf("invalid saml config: %w", err)
+	}
+	cert, _ := parseSAMLCertificate(configStringValue(config, "certificate"))
+
+	if pinned := configStringValue(config, "acs_url"); pinned != "" {
+		acsURL = pinned
+	}
+	acs, err := url.Parse(acsURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse acs url: %w", err)
+	}
+
+	sp := saml.ServiceProvider{
+		EntityID:          configStringValue(config, "sp_entity_id"),
+		AcsURL:            *acs,
+		IDPMetadata:       samlIDPDescriptor(config, cert),
+		AllowIDPInitiated: true,
+	}
+	if sp.EntityID == "" {
+		sp.EntityID = acs.String()
+	}
+
+	assertion, err := sp.ParseResponse(req, nil)
+	if err != nil {
+		// InvalidResponseError hides the actual cause from Error().
+		if invalid, ok := err.(*saml.InvalidResponseError); ok {
+			return nil, fmt.Errorf("invalid saml response: %w", invalid.PrivateErr)
+		}
+		return nil, fmt.Errorf("invalid saml response: %w", err)
+	}
+	if assertion.Subject == nil || assertion.Subject.NameID == nil || strings.TrimSpace(assertion.Subject.NameID.Value) == "" {
+		return nil, fmt.Errorf("saml assertion has no subject NameID")
+	}
+
+	attrs := map[string][]string{}
+	for _, statement := range assertion.AttributeStatements {
+		for _, attr := range statement.Attributes {
+			for _, v := range attr.Values {
+				value := strings.TrimSpace(v.Value)
+				if value == "" {
+					continue
+				}
+				for _, key := range []string{attr.Name, attr.FriendlyName} {
+					if key != "" {
+						attrs[key] = append(attrs[key], value)
+					}
+				}
+			}
+		}
+	}
+	first := func(configKey, fallback string) string {
+		name := configStringValue(config, configKey)
+		if name == "" {
+			name = fallback
+		}
+		if values := attrs[name]; len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+	groupsAttr := configStringValue(config, "groups_attribute")
+	if groupsAttr == "" {
+		groupsAttr = defaultSAMLGroupsAttribute
+	}
+
+	nameID := strings.TrimSpace(assertion.Subject.NameID.Value)
+	result := &AuthResult{
+		Username:    first("username_attribute", defaultSAMLUsernameAttribute),
+		Email:       first("email_attribute", defaultSAMLEmailAttribute),
+		DisplayName: first("display_name_attribute", defaultSAMLDisplayNameAttribute),
+		Groups:      slices.Compact(slices.Sorted(slices.Values(attrs[groupsAttr]))),
+		ExternalID:  nameID,
+		RawClaims:   make(map[string]interface{}, len(attrs)),
+	}
+	if result.Username == "" {
+		result.Username = nameID
+	}
+	for k, v := range attrs {
+		result.RawClaims[k] = v
+	}
+	return result, nil
+}
+
+// samlIDPDescriptor builds the IdP metadata the service provider validates
+// against from the admin-supplied entity_id, sso_url and certificate.
+func samlIDPDescriptor(config map[string]interface{}, cert *x509.Certificate) *saml.EntityDescriptor {
+	return &saml.EntityDescriptor{
+		EntityID: configStringValue(config, "entity_id"),
+		IDPSSODescriptors: []saml.IDPSSODescriptor{{
+			SSODescriptor: saml.SSODescriptor{
+				RoleDescriptor: saml.RoleDescriptor{
+					ProtocolSupportEnumeration: "urn:oasis:names:tc:SAML:2.0:protocol",
+					KeyDescriptors: []saml.KeyDescriptor{{
+						Use: "signing",
+						KeyInfo: saml.KeyInfo{
+							X509Data: saml.X509Data{
+								X509Certificates: []saml.X509Certificate{
+									{Data: base64.StdEncoding.EncodeToString(cert.Raw)},
+								},
+							},
+						},
+					}},
+				},
+			},
+			SingleSignOnServices: []saml.Endpoint{{
+				Binding:  saml.HTTPRedirectBinding,
+				Location: configStringValue(config, "sso_url"),
+			}},
+		}},
+	}
+}
+
+// loadSAMLMetadata returns inline metadata_xml or fetches metadata_url.
+// A nil slice with nil error means no metadata is configured.
+func loadSAMLMetadata(ctx context.Context, config map[string]interface{}) ([]byte, string, error) {
+	if inline := configStringValue(config, "metadata_xml"); inline != "" {
+		return []byte(inline), "metadata_xml", nil
+	}
+	endpoint := configStringValue(config, "metadata_url")
+	if endpoint == "" {
+		return nil, "", nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid metadata_url")
+	}
+	client := &http.Client{Timeout: 8 * time.Second}
+	resp, err := client.Do(req) // #nosec G107 -- endpoint is admin-supplied configuration.
+	if err != nil {
+		return nil, "", fmt.Errorf("fetch IdP metadata failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, "", fmt.Errorf("fetch IdP metadata: status %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, samlMetadataMaxBytes))
+	if err != nil {
+		return nil, "", fmt.Errorf("read IdP metadata: %v", err)
+	}
+	return body, "metadata_url", nil
+}
+
+func samlMetadataSigningCertificates(metadata *saml.EntityDescriptor) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for _, idp := range metadata.IDPSSODescriptors {
+		for _, key := range idp.KeyDescriptors {
+			if key.Use != "" && key.Use != "signing" {
+				continue
+			}
+			for _, c := range key.KeyInfo.X509Data.X509Certificates {
+				der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(c.Data), ""))
+				if err != nil {
+					return nil, fmt.Errorf("decode metadata certificate: %v", err)
+				}
+				parsed, err := x509.ParseCertificate(der)
+				if err != nil {
+					return nil, fmt.Errorf("parse metadata certificate: %v", err)
+				}
+				certs = append(certs, parsed)
+			}
+		}
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("IdP metadata has no signing certificate")
+	}
+	return certs, nil
+}
+
+// parseSAMLCertificate accepts a PEM block or the bare base64 body commonly
+// copied out of IdP metadata.
+func parseSAMLCertificate(raw string) (*x509.Certificate, error) {
+	raw = strings.TrimSpace(raw)
+	var der []byte
+	if block, _ := pem.Decode([]byte(raw)); block != nil {
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("unexpected PEM block %q", block.Type)
+		}
+		der = block.Bytes
+	} else {
+		decoded, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(raw), ""))
+		if err != nil {
+			return nil, fmt.Errorf("not a PEM or base64 encoded certificate")
+		}
+		der = decoded
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("parse x509 certificate: %v", err)
+	}
+	return cert, nil
+}
+
+func validateAbsoluteHTTPURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("invalid url")
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("must be an absolute http(s) url")
+	}
+	return nil
+}
@@ -2,9 +2,12 @@ package provider
 
 import (
 	"context"
+	"net"
 
+	k8sv1 "k8s.io/api/core/v1"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 )
 
 // VirtualMachineClient abstracts KubeVirt VM operations.
@@ -27,6 +30,32 @@ type VirtualMachineInstanceClient interface {
 	List(ctx context.Context, namespace string, opts k8smetav1.ListOptions) (*kubevirtv1.VirtualMachineInstanceList, error)
 	Pause(ctx context.Context, namespace, name string, opts *kubevirtv1.PauseOptions) error
 	Unpause(ctx context.Context, namespace, name string, opts *kubevirtv1.UnpauseOptions) error
+	// VNC opens the VMI's VNC subresource stream; the caller closes it.
+	VNC(namespace, name string) (net.Conn, error)
+}
+
+// VirtualMachineSnapshotClient abstracts KubeVirt VirtualMachineSnapshot operations.
+type VirtualMachineSnapshotClient interface {
+	Get(ctx context.Context, namespace, name string, opts k8smetav1.GetOptions) (*snapshotv1.VirtualMachineSnapshot, error)
+	List(ctx context.Context, namespace string, opts k8smetav1.ListOptions) (*snapshotv1.VirtualMachineSnapshotList, error)
+	Create(ctx context.Context, namespace string, snapshot *snapshotv1.VirtualMachineSnapshot, opts k8smetav1.CreateOptions) (*snapshotv1.VirtualMachineSnapshot, error)
+	Delete(ctx context.Context, namespace, name string, opts k8smetav1.DeleteOptions) error
+}
+
+// VirtualMachineRestoreClient abstracts KubeVirt VirtualMachineRestore operations.
+type VirtualMachineRestoreClient interface {
+	Get(ctx context.Context, namespace, name string, opts k8smetav1.GetOptions) (*snapshotv1.VirtualMachineRestore, error)
+	Create(ctx context.Context, namespace string, restore *snapshotv1.VirtualMachineRestore, opts k8smetav1.CreateOptions) (*snapshotv1.VirtualMachineRestore, error)
+}
+
+// NodeClient abstracts Kubernetes Node reads.
+type NodeClient interface {
+	List(ctx context.Context, opts k8smetav1.ListOptions) (*k8sv1.NodeList, error)
+}
+
+// NamespaceClient abstracts Kubernetes Namespace reads.
+type NamespaceClient interface {
+	List(ctx context.Context, opts k8smetav1.ListOptions) (*k8sv1.NamespaceList, error)
 }
 
 // KubeVirtClusterClient provides kubevirt clients for a specific cluster.
@@ -34,6 +63,10 @@ type VirtualMachineInstanceClient interface {
 type KubeVirtClusterClient interface {
 	VM() VirtualMachineClient
 	VMI() VirtualMachineInstanceClient
+	Snapshot() VirtualMachineSnapshotClient
+	Restore() VirtualMachineRestoreClient
+	Node() NodeClient
+	Namespace() NamespaceClient
 }
 
 // ClusterClientFactory creates KubeVirtClusterClient for a given cluster name.
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -27,6 +28,7 @@ type ClusterHealth struct {
 	Status          ClusterStatus `json:"status"`
 	KubeVirtVersion string        `json:"kubevirt_version,omitempty"`
 	LastChecked     time.Time     `json:"last_checked"`
+	Latency         time.Duration `json:"latency"`
 	Error           string        `json:"error,omitempty"`
 }
 
@@ -56,6 +58,7 @@ func (c *ClusterHealthChecker) CheckCluster(ctx context.Context, clusterName str
 		ClusterName: clusterName,
 		LastChecked: time.Now(),
 	}
+	defer func() { health.Latency = time.Since(health.LastChecked) }()
 
 	client, err := c.clientFactory(clusterName)
 	if err != nil {
@@ -93,6 +96,18 @@ func (c *ClusterHealthChecker) GetHealth(clusterName string) *ClusterHealth {
 	}
 }
 
+// Results returns a snapshot of all cached health results, sorted by cluster name.
+func (c *ClusterHealthChecker) Results() []ClusterHealth {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	results := make([]ClusterHealth, 0, len(c.results))
+	for _, h := range c.results {
+		results = append(results, *h)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].ClusterName < results[j].ClusterName })
+	return results
+}
+
 // UpdateHealth stores a health check result.
 func (c *ClusterHealthChecker) UpdateHealth(health *ClusterHealth) {
 	c.mu.Lock()
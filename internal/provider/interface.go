@@ -8,6 +8,7 @@ package provider
 
 import (
 	"context"
+	"net"
 
 	"kv-shepherd.io/shepherd/internal/domain"
 )
@@ -42,7 +43,11 @@ type SnapshotProvider interface {
 	GetSnapshot(ctx context.Context, cluster, namespace, name string) (*domain.Snapshot, error)
 	ListSnapshots(ctx context.Context, cluster, namespace, vmName string) ([]*domain.Snapshot, error)
 	DeleteSnapshot(ctx context.Context, cluster, namespace, name string) error
-	RestoreFromSnapshot(ctx context.Context, cluster, namespace, snapshotName, targetVMName string) (*domain.VM, error)
+	// RestoreSnapshot restores vmName in place from one of its snapshots. With
+	// stopTarget a running VM is stopped first; otherwise the restore waits
+	// for the VM to be stopped.
+	RestoreSnapshot(ctx context.Context, cluster, namespace, vmName, snapshotName string, stopTarget bool) (*domain.SnapshotRestore, error)
+	GetSnapshotRestore(ctx context.Context, cluster, namespace, name string) (*domain.SnapshotRestore, error)
 }
 
 // CloneProvider provides clone capabilities (RFC-0014).
@@ -75,6 +80,40 @@ type ConsoleProvider interface {
 	GetSerialConsole(ctx context.Context, cluster, namespace, name string) (*domain.ConsoleConnection, error)
 }
 
+// VNCStreamProvider opens raw VNC streams to running VMs.
+type VNCStreamProvider interface {
+	OpenVNCStream(ctx context.Context, cluster, namespace, name string) (net.Conn, error)
+}
+
+// RuntimeProvider exposes live VirtualMachineInstance state.
+type RuntimeProvider interface {
+	GetVMRuntime(ctx context.Context, cluster, namespace, name string) (*domain.VMRuntime, error)
+}
+
+// CapacityProvider reports node resources of a cluster.
+type CapacityProvider interface {
+	GetClusterNodeResources(ctx context.Context, cluster string) (*domain.ClusterNodeResources, error)
+}
+
+// NamespaceProvider lists the namespaces that exist on a cluster.
+type NamespaceProvider interface {
+	ListNamespaces(ctx context.Context, cluster string) ([]string, error)
+}
+
+// ResizeProvider changes CPU/memory of a running VM without a restart.
+type ResizeProvider interface {
+	// LiveResizeVM hot-plugs the new CPU/memory, live-migrating the VM when
+	// its node cannot absorb the change. restartRequired reports a change
+	// that only takes effect on the next boot.
+	LiveResizeVM(ctx context.Context, cluster, namespace, name string, cpu, memoryMB int) (restartRequired bool, err error)
+}
+
+// AnnotationProvider reads and writes VirtualMachine annotations.
+type AnnotationProvider interface {
+	GetVMAnnotations(ctx context.Context, cluster, namespace, name string) (map[string]string, error)
+	SetVMAnnotation(ctx context.Context, cluster, namespace, name, key, value string) error
+}
+
 // KubeVirtProvider is the combined interface for KubeVirt operations.
 type KubeVirtProvider interface {
 	InfrastructureProvider
@@ -83,6 +122,11 @@ type KubeVirtProvider interface {
 	MigrationProvider
 	InstanceTypeProvider
 	ConsoleProvider
+	RuntimeProvider
+	CapacityProvider
+	NamespaceProvider
+	ResizeProvider
+	AnnotationProvider
 }
 
 // ListOptions contains options for list operations.
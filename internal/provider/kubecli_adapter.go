@@ -3,12 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net"
 	"strings"
 	"sync"
 
+	k8sv1 "k8s.io/api/core/v1"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/clientcmd"
 	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 )
 
@@ -86,6 +89,22 @@ func (c *kubevirtClusterClient) VMI() VirtualMachineInstanceClient {
 	return &kubevirtVMIClient{client: c.client}
 }
 
+func (c *kubevirtClusterClient) Snapshot() VirtualMachineSnapshotClient {
+	return &kubevirtSnapshotClient{client: c.client}
+}
+
+func (c *kubevirtClusterClient) Restore() VirtualMachineRestoreClient {
+	return &kubevirtRestoreClient{client: c.client}
+}
+
+func (c *kubevirtClusterClient) Node() NodeClient {
+	return &kubevirtNodeClient{client: c.client}
+}
+
+func (c *kubevirtClusterClient) Namespace() NamespaceClient {
+	return &kubevirtNamespaceClient{client: c.client}
+}
+
 type kubevirtVMClient struct {
 	client kubecli.KubevirtClient
 }
@@ -141,3 +160,59 @@ func (c *kubevirtVMIClient) Pause(ctx context.Context, namespace, name string, o
 func (c *kubevirtVMIClient) Unpause(ctx context.Context, namespace, name string, opts *kubevirtv1.UnpauseOptions) error {
 	return c.client.VirtualMachineInstance(namespace).Unpause(ctx, name, opts)
 }
+
+func (c *kubevirtVMIClient) VNC(namespace, name string) (net.Conn, error) {
+	stream, err := c.client.VirtualMachineInstance(namespace).VNC(name, false)
+	if err != nil {
+		return nil, err
+	}
+	return stream.AsConn(), nil
+}
+
+type kubevirtSnapshotClient struct {
+	client kubecli.KubevirtClient
+}
+
+func (c *kubevirtSnapshotClient) Get(ctx context.Context, namespace, name string, opts k8smetav1.GetOptions) (*snapshotv1.VirtualMachineSnapshot, error) {
+	return c.client.VirtualMachineSnapshot(namespace).Get(ctx, name, opts)
+}
+
+func (c *kubevirtSnapshotClient) List(ctx context.Context, namespace string, opts k8smetav1.ListOptions) (*snapshotv1.VirtualMachineSnapshotList, error) {
+	return c.client.VirtualMachineSnapshot(namespace).List(ctx, opts)
+}
+
+func (c *kubevirtSnapshotClient) Create(ctx context.Context, namespace string, snapshot *snapshotv1.VirtualMachineSnapshot, opts k8smetav1.CreateOptions) (*snapshotv1.VirtualMachineSnapshot, error) {
+	return c.client.VirtualMachineSnapshot(namespace).Create(ctx, snapshot, opts)
+}
+
+func (c *kubevirtSnapshotClient) Delete(ctx context.Context, namespace, name string, opts k8smetav1.DeleteOptions) error {
+	return c.client.VirtualMachineSnapshot(namespace).Delete(ctx, name, opts)
+}
+
+type kubevirtRestoreClient struct {
+	client kubecli.KubevirtClient
+}
+
+func (c *kubevirtRestoreClient) Get(ctx context.Context, namespace, name string, opts k8smetav1.GetOptions) (*snapshotv1.VirtualMachineRestore, error) {
+	return c.client.VirtualMachineRestore(namespace).Get(ctx, name, opts)
+}
+
+func (c *kubevirtRestoreClient) Create(ctx context.Context, namespace string, restore *snapshotv1.VirtualMachineRestore, opts k8smetav1.CreateOptions) (*snapshotv1.VirtualMachineRestore, error) {
+	return c.client.VirtualMachineRestore(namespace).Create(ctx, restore, opts)
+}
+
+type kubevirtNodeClient struct {
+	client kubecli.KubevirtClient
+}
+
+func (c *kubevirtNodeClient) List(ctx context.Context, opts k8smetav1.ListOptions) (*k8sv1.NodeList, error) {
+	return c.client.CoreV1().Nodes().List(ctx, opts)
+}
+
+type kubevirtNamespaceClient struct {
+	client kubecli.KubevirtClient
+}
+
+func (c *kubevirtNamespaceClient) List(ctx context.Context, opts k8smetav1.ListOptions) (*k8sv1.NamespaceList, error) {
+	return c.client.CoreV1().Namespaces().List(ctx, opts)
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// KubeconfigProbeTimeout bounds a whole kubeconfig connectivity probe.
+const KubeconfigProbeTimeout = 5 * time.Second
+
+// ErrInvalidKubeconfig marks a kubeconfig that cannot be turned into a client
+// configuration, as opposed to one whose API server cannot be used.
+var ErrInvalidKubeconfig = errors.New("invalid kubeconfig")
+
+// KubeconfigProbe checks that kubeconfig grants access to its API server and
+// returns the server's git version.
+type KubeconfigProbe func(ctx context.Context, kubeconfig []byte) (serverVersion string, err error)
+
+// ProbeKubeconfig lists namespaces (GET /api/v1/namespaces?limit=1) with the
+// kubeconfig credentials, then reads GET /version. The caller's deadline
+// applies, capped at KubeconfigProbeTimeout.
+func ProbeKubeconfig(ctx context.Context, kubeconfig []byte) (string, error) {
+	restCfg, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidKubeconfig, err)
+	}
+	httpClient, err := rest.HTTPClientFor(restCfg)
+	if err != nil {
+		return "", fmt.Errorf("%w: build http client: %v", ErrInvalidKubeconfig, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, KubeconfigProbeTimeout)
+	defer cancel()
+
+	host := strings.TrimRight(restCfg.Host, "/")
+	if _, err := probeGet(ctx, httpClient, host+"/api/v1/namespaces?limit=1"); err != nil {
+		return "", fmt.Errorf("list namespaces: %w", err)
+	}
+	body, err := probeGet(ctx, httpClient, host+"/version")
+	if err != nil {
+		return "", fmt.Errorf("read server version: %w", err)
+	}
+	var version struct {
+		GitVersion string `json:"gitVersion"`
+	}
+	if err := json.Unmarshal(body, &version); err != nil {
+		return "", fmt.Errorf("decode server version: %w", err)
+	}
+	return version.GitVersion, nil
+}
+
+func probeGet(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API server returned status %d", resp.StatusCode)
+	}
+	return body, nil
+}
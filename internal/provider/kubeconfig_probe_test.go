@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func probeTestKubeconfig(server string) []byte {
+	return []byte(fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: %s
+    insecure-skip-tls-verify: true
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+current-context: test
+users:
+- name: test
+  user:
+    token: test-token
+`, server))
+}
+
+func TestProbeKubeconfig(t *testing.T) {
+	t.Parallel()
+
+	newAPIServer := func(namespacesStatus int) *httptest.Server {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/api/v1/namespaces", func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer test-token" || r.URL.Query().Get("limit") != "1" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(namespacesStatus)
+			_, _ = w.Write([]byte(`{"kind":"NamespaceList","items":[]}`))
+		})
+		mux.HandleFunc("/version", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"major":"1","minor":"33","gitVersion":"v1.33.2"}`))
+		})
+		srv := httptest.NewTLSServer(mux)
+		t.Cleanup(srv.Close)
+		return srv
+	}
+
+	t.Run("reachable", func(t *testing.T) {
+		t.Parallel()
+		version, err := ProbeKubeconfig(t.Context(), probeTestKubeconfig(newAPIServer(http.StatusOK).URL))
+		if err != nil || version != "v1.33.2" {
+			t.Fatalf("ProbeKubeconfig() = %q, %v; want v1.33.2, nil", version, err)
+		}
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		t.Parallel()
+		_, err := ProbeKubeconfig(t.Context(), probeTestKubeconfig(newAPIServer(http.StatusForbidden).URL))
+		if err == nil || errors.Is(err, ErrInvalidKubeconfig) {
+			t.Fatalf("ProbeKubeconfig() error = %v, want a connectivity error", err)
+		}
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		t.Parallel()
+		srv := newAPIServer(http.StatusOK)
+		srv.Close()
+		_, err := ProbeKubeconfig(t.Context(), probeTestKubeconfig(srv.URL))
+		if err == nil || errors.Is(err, ErrInvalidKubeconfig) {
+			t.Fatalf("ProbeKubeconfig() error = %v, want a connectivity error", err)
+		}
+	})
+
+	t.Run("invalid kubeconfig", func(t *testing.T) {
+		t.Parallel()
+		_, err := ProbeKubeconfig(t.Context(), []byte("clusters: ["))
+		if !errors.Is(err, ErrInvalidKubeconfig) {
+			t.Fatalf("ProbeKubeconfig() error = %v, want ErrInvalidKubeconfig", err)
+		}
+	})
+}
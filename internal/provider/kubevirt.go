@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"net"
+	"sort"
 	"strings"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	kubevirtv1 "kubevirt.io/api/core/v1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kv-shepherd.io/shepherd/internal/domain"
 )
@@ -67,6 +70,93 @@ func (p *KubeVirtProviderImpl) GetVM(ctx context.Context, cluster, namespace, na
 	return p.mapper.MapVM(vm, vmi)
 }
 
+// GetVMRuntime retrieves live VirtualMachineInstance state. A stopped VM has
+// no VMI, which surfaces as a NotFound error from the cluster.
+func (p *KubeVirtProviderImpl) GetVMRuntime(ctx context.Context, cluster, namespace, name string) (*domain.VMRuntime, error) {
+	client, err := p.clientFactory(cluster)
+	if err != nil {
+		return nil, fmt.Errorf("get client for cluster %s: %w", cluster, err)
+	}
+
+	vmi, err := client.VMI().Get(ctx, namespace, name, k8smetav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get vmi %s/%s: %w", namespace, name, err)
+	}
+	return p.mapper.MapVMRuntime(vmi)
+}
+
+// OpenVNCStream connects to the VNC subresource of the VM's running VMI.
+// The stream is long-lived, so no operation timeout applies to it.
+func (p *KubeVirtProviderImpl) OpenVNCStream(ctx context.Context, cluster, namespace, name string) (net.Conn, error) {
+	client, err := p.clientFactory(cluster)
+	if err != nil {
+		return nil, fmt.Errorf("get client for cluster %s: %w", cluster, err)
+	}
+
+	conn, err := client.VMI().VNC(namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("open vnc stream %s/%s: %w", namespace, name, err)
+	}
+	return conn, nil
+}
+
+// GetClusterNodeResources sums capacity and allocatable CPU/memory over all
+// nodes of the cluster. CPU is rounded down to whole cores and memory to MiB.
+func (p *KubeVirtProviderImpl) GetClusterNodeResources(ctx context.Context, cluster string) (*domain.ClusterNodeResources, error) {
+	client, err := p.clientFactory(cluster)
+	if err != nil {
+		return nil, fmt.Errorf("get client for cluster %s: %w", cluster, err)
+	}
+
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+	nodes, err := client.Node().List(ctx, k8smetav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list nodes: %w", err)
+	}
+	return sumNodeResources(nodes.Items), nil
+}
+
+// ListNamespaces returns the sorted names of all namespaces on the cluster.
+func (p *KubeVirtProviderImpl) ListNamespaces(ctx context.Context, cluster string) ([]string, error) {
+	client, err := p.clientFactory(cluster)
+	if err != nil {
+		return nil, fmt.Errorf("get client for cluster %s: %w", cluster, err)
+	}
+
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+	list, err := client.Namespace().List(ctx, k8smetav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list namespaces: %w", err)
+	}
+	names := make([]string, 0, len(list.Items))
+	for i := range list.Items {
+		names = append(names, list.Items[i].Name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func sumNodeResources(nodes []k8sv1.Node) *domain.ClusterNodeResources {
+	var totalCPU, allocCPU, totalMem, allocMem int64
+	for i := range nodes {
+		status := nodes[i].Status
+		totalCPU += status.Capacity.Cpu().MilliValue()
+		allocCPU += status.Allocatable.Cpu().MilliValue()
+		totalMem += status.Capacity.Memory().Value()
+		allocMem += status.Allocatable.Memory().Value()
+	}
+	const mib = 1024 * 1024
+	return &domain.ClusterNodeResources{
+		NodeCount:           len(nodes),
+		TotalCPUCores:       int(totalCPU / 1000),
+		AllocatableCPUCores: int(allocCPU / 1000),
+		TotalMemoryMB:       int(totalMem / mib),
+		AllocatableMemoryMB: int(allocMem / mib),
+	}
+}
+
 // ListVMs lists VMs in the specified namespace.
 func (p *KubeVirtProviderImpl) ListVMs(ctx context.Context, cluster, namespace string, opts ListOptions) (*domain.VMList, error) {
 	client, err := p.clientFactory(cluster)
@@ -249,6 +339,20 @@ func (p *KubeVirtProviderImpl) ValidateSpec(ctx context.Context, cluster, namesp
 	return &domain.ValidationResult{Valid: true}, nil
 }
 
+// RenderVM returns the VirtualMachine CreateVM would submit for spec,
+// without contacting a cluster. Approval previews use it.
+func RenderVM(namespace string, spec *domain.VMSpec) (*kubevirtv1.VirtualMachine, error) {
+	vm, err := buildVMFromSpec(namespace, spec)
+	if err != nil {
+		return nil, fmt.Errorf("build vm from spec: %w", err)
+	}
+	vm.TypeMeta = k8smetav1.TypeMeta{
+		APIVersion: kubevirtv1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+		Kind:       kubevirtv1.VirtualMachineGroupVersionKind.Kind,
+	}
+	return vm, nil
+}
+
 // buildVMFromSpec creates a KubeVirt VM object from a domain spec.
 func buildVMFromSpec(namespace string, spec *domain.VMSpec) (*kubevirtv1.VirtualMachine, error) {
 	if spec == nil {
@@ -264,20 +368,38 @@ func buildVMFromSpec(namespace string, spec *domain.VMSpec) (*kubevirtv1.Virtual
 	if spec.MemoryMB <= 0 {
 		return nil, fmt.Errorf("vm memory_mb must be > 0")
 	}
-	image := strings.TrimSpace(spec.Image)
-	if image == "" {
-		return nil, fmt.Errorf("vm image is required")
+
+	var (
+		volumes     []kubevirtv1.Volume
+		disks       []kubevirtv1.Disk
+		dataVolumes []kubevirtv1.DataVolumeTemplateSpec
+		err         error
+	)
+	if spec.CloneSource != nil {
+		volumes, disks, dataVolumes, err = buildCloneDisksAndVolumes(name, spec.CloneSource, spec.DiskGB)
+	} else {
+		image := strings.TrimSpace(spec.Image)
+		if image == "" {
+			return nil, fmt.Errorf("vm image is required")
+		}
+		volumes, disks, err = buildDisksAndVolumes(image, spec.DiskGB)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if spec.UserData != "" {
+		volume, disk, err := buildCloudInitDiskAndVolume(spec.CloudInitType, spec.UserData)
+		if err != nil {
+			return nil, err
+		}
+		volumes = append(volumes, volume)
+		disks = append(disks, disk)
 	}
 
 	running := true
 	cpuQty := resource.MustParse(fmt.Sprintf("%d", spec.CPU))
 	memQty := resource.MustParse(fmt.Sprintf("%dMi", spec.MemoryMB))
 
-	volumes, disks, err := buildDisksAndVolumes(image, spec.DiskGB)
-	if err != nil {
-		return nil, err
-	}
-
 	vm := &kubevirtv1.VirtualMachine{
 		ObjectMeta: k8smetav1.ObjectMeta{
 			Name:      name,
@@ -285,7 +407,8 @@ func buildVMFromSpec(namespace string, spec *domain.VMSpec) (*kubevirtv1.Virtual
 			Labels:    spec.Labels,
 		},
 		Spec: kubevirtv1.VirtualMachineSpec{
-			Running: &running,
+			Running:             &running,
+			DataVolumeTemplates: dataVolumes,
 			Template: &kubevirtv1.VirtualMachineInstanceTemplateSpec{
 				ObjectMeta: k8smetav1.ObjectMeta{
 					Labels: spec.Labels,
@@ -369,10 +492,31 @@ func applySpecToVM(vm *kubevirtv1.VirtualMachine, spec *domain.VMSpec) error {
 			vm.Spec.Template.Spec.Volumes = mergeManagedVolumes(vm.Spec.Template.Spec.Volumes, volumes)
 			vm.Spec.Template.Spec.Domain.Devices.Disks = mergeManagedDisks(vm.Spec.Template.Spec.Domain.Devices.Disks, disks)
 		}
+	} else if spec.DiskGB > 0 {
+		resizeDataDisk(vm, spec.DiskGB)
 	}
 	return applySpecOverrides(vm, spec.SpecOverrides)
 }
 
+// resizeDataDisk sets the managed data disk to diskGB, adding it when the VM
+// has none. The data disk is an emptyDisk, so a running VM sees the new
+// capacity after its next boot. A data disk of another kind is left alone.
+func resizeDataDisk(vm *kubevirtv1.VirtualMachine, diskGB int) {
+	for i := range vm.Spec.Template.Spec.Volumes {
+		volume := &vm.Spec.Template.Spec.Volumes[i]
+		if volume.Name != "datadisk" {
+			continue
+		}
+		if volume.EmptyDisk != nil {
+			volume.EmptyDisk.Capacity = resource.MustParse(fmt.Sprintf("%dGi", diskGB))
+		}
+		return
+	}
+	volumes, disks := managedDisksAndVolumes(kubevirtv1.VolumeSource{}, diskGB)
+	vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, volumes[1])
+	vm.Spec.Template.Spec.Domain.Devices.Disks = append(vm.Spec.Template.Spec.Domain.Devices.Disks, disks[1])
+}
+
 func applySpecOverrides(vm *kubevirtv1.VirtualMachine, overrides map[string]interface{}) error {
 	if vm == nil || len(overrides) == 0 {
 		return nil
@@ -497,32 +641,19 @@ func setUnstructuredPath(root map[string]interface{}, path string, value interfa
 }
 
 func buildDisksAndVolumes(image string, diskGB int) ([]kubevirtv1.Volume, []kubevirtv1.Disk, error) {
-	const (
-		rootVolumeName = "rootdisk"
-		dataVolumeName = "datadisk"
-	)
 	image = strings.TrimSpace(image)
 	if image == "" {
 		return nil, nil, fmt.Errorf("vm image is required")
 	}
 
-	rootDisk := kubevirtv1.Disk{
-		Name: rootVolumeName,
-		DiskDevice: kubevirtv1.DiskDevice{
-			Disk: &kubevirtv1.DiskTarget{Bus: kubevirtv1.DiskBusVirtio},
-		},
-	}
-
-	rootVolume := kubevirtv1.Volume{
-		Name: rootVolumeName,
-	}
+	var rootSource kubevirtv1.VolumeSource
 	switch {
 	case strings.HasPrefix(image, "pvc:"):
 		claimName := strings.TrimSpace(strings.TrimPrefix(image, "pvc:"))
 		if claimName == "" {
 			return nil, nil, fmt.Errorf("pvc image reference is empty")
 		}
-		rootVolume.VolumeSource = kubevirtv1.VolumeSource{
+		rootSource = kubevirtv1.VolumeSource{
 			PersistentVolumeClaim: &kubevirtv1.PersistentVolumeClaimVolumeSource{
 				PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
 					ClaimName: claimName,
@@ -530,13 +661,89 @@ func buildDisksAndVolumes(image string, diskGB int) ([]kubevirtv1.Volume, []kube
 			},
 		}
 	default:
-		rootVolume.VolumeSource = kubevirtv1.VolumeSource{
+		rootSource = kubevirtv1.VolumeSource{
 			ContainerDisk: &kubevirtv1.ContainerDiskSource{
 				Image: image,
 			},
 		}
 	}
 
+	volumes, disks := managedDisksAndVolumes(rootSource, diskGB)
+	return volumes, disks, nil
+}
+
+// buildCloneDisksAndVolumes boots the root disk from a DataVolume that CDI
+// clones from src. The clone's size is inferred from the source PVC.
+func buildCloneDisksAndVolumes(
+	vmName string,
+	src *domain.PVCCloneSource,
+	diskGB int,
+) ([]kubevirtv1.Volume, []kubevirtv1.Disk, []kubevirtv1.DataVolumeTemplateSpec, error) {
+	if src == nil || strings.TrimSpace(src.PVCName) == "" || strings.TrimSpace(src.Namespace) == "" {
+		return nil, nil, nil, fmt.Errorf("clone source namespace and pvc name are required")
+	}
+
+	dvName := domain.CloneRootDiskPVCName(vmName)
+	dataVolumes := []kubevirtv1.DataVolumeTemplateSpec{
+		{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: dvName},
+			Spec: cdiv1beta1.DataVolumeSpec{
+				Source: &cdiv1beta1.DataVolumeSource{
+					PVC: &cdiv1beta1.DataVolumeSourcePVC{
+						Namespace: strings.TrimSpace(src.Namespace),
+						Name:      strings.TrimSpace(src.PVCName),
+					},
+				},
+				Storage: &cdiv1beta1.StorageSpec{},
+			},
+		},
+	}
+	volumes, disks := managedDisksAndVolumes(kubevirtv1.VolumeSource{
+		DataVolume: &kubevirtv1.DataVolumeSource{Name: dvName},
+	}, diskGB)
+	return volumes, disks, dataVolumes, nil
+}
+
+// buildCloudInitDiskAndVolume serves base64 user-data to the guest through
+// the datasource selected by cloudInitType (NoCloud when empty).
+func buildCloudInitDiskAndVolume(cloudInitType domain.CloudInitType, userData string) (kubevirtv1.Volume, kubevirtv1.Disk, error) {
+	const cloudInitVolumeName = "cloudinitdisk"
+
+	var source kubevirtv1.VolumeSource
+	switch cloudInitType {
+	case "", domain.CloudInitNoCloud:
+		source.CloudInitNoCloud = &kubevirtv1.CloudInitNoCloudSource{UserDataBase64: userData}
+	case domain.CloudInitConfigDrive:
+		source.CloudInitConfigDrive = &kubevirtv1.CloudInitConfigDriveSource{UserDataBase64: userData}
+	default:
+		return kubevirtv1.Volume{}, kubevirtv1.Disk{}, fmt.Errorf("unsupported cloud_init_type %q", cloudInitType)
+	}
+	disk := kubevirtv1.Disk{
+		Name: cloudInitVolumeName,
+		DiskDevice: kubevirtv1.DiskDevice{
+			Disk: &kubevirtv1.DiskTarget{Bus: kubevirtv1.DiskBusVirtio},
+		},
+	}
+	return kubevirtv1.Volume{Name: cloudInitVolumeName, VolumeSource: source}, disk, nil
+}
+
+func managedDisksAndVolumes(rootSource kubevirtv1.VolumeSource, diskGB int) ([]kubevirtv1.Volume, []kubevirtv1.Disk) {
+	const (
+		rootVolumeName = "rootdisk"
+		dataVolumeName = "datadisk"
+	)
+
+	rootDisk := kubevirtv1.Disk{
+		Name: rootVolumeName,
+		DiskDevice: kubevirtv1.DiskDevice{
+			Disk: &kubevirtv1.DiskTarget{Bus: kubevirtv1.DiskBusVirtio},
+		},
+	}
+	rootVolume := kubevirtv1.Volume{
+		Name:         rootVolumeName,
+		VolumeSource: rootSource,
+	}
+
 	volumes := []kubevirtv1.Volume{rootVolume}
 	disks := []kubevirtv1.Disk{rootDisk}
 
@@ -559,7 +766,7 @@ func buildDisksAndVolumes(image string, diskGB int) ([]kubevirtv1.Volume, []kube
 		disks = append(disks, dataDisk)
 	}
 
-	return volumes, disks, nil
+	return volumes, disks
 }
 
 func mergeManagedVolumes(existing, desired []kubevirtv1.Volume) []kubevirtv1.Volume {
@@ -590,3 +797,42 @@ func isManagedVMStorageName(name string) bool {
 		return false
 	}
 }
+
+// GetVMAnnotations returns the annotations of a VirtualMachine.
+func (p *KubeVirtProviderImpl) GetVMAnnotations(ctx context.Context, cluster, namespace, name string) (map[string]string, error) {
+	client, err := p.clientFactory(cluster)
+	if err != nil {
+		return nil, fmt.Errorf("get client for cluster %s: %w", cluster, err)
+	}
+
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+	vm, err := client.VM().Get(ctx, namespace, name, k8smetav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get vm %s/%s: %w", namespace, name, err)
+	}
+	return vm.Annotations, nil
+}
+
+// SetVMAnnotation sets a single annotation on a VirtualMachine.
+func (p *KubeVirtProviderImpl) SetVMAnnotation(ctx context.Context, cluster, namespace, name, key, value string) error {
+	client, err := p.clientFactory(cluster)
+	if err != nil {
+		return fmt.Errorf("get client for cluster %s: %w", cluster, err)
+	}
+
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+	vm, err := client.VM().Get(ctx, namespace, name, k8smetav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("get vm %s/%s: %w", namespace, name, err)
+	}
+	if vm.Annotations == nil {
+		vm.Annotations = make(map[string]string)
+	}
+	vm.Annotations[key] = value
+	if _, err := client.VM().Update(ctx, namespace, vm, k8smetav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("update vm %s/%s annotation %s: %w", namespace, name, key, err)
+	}
+	return nil
+}
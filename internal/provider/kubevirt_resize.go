@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+)
+
+// liveResizePollInterval is how often LiveResizeVM re-reads the VM while
+// waiting for the controller to process the new spec.
+var liveResizePollInterval = 2 * time.Second
+
+// LiveResizeVM patches CPU sockets and guest memory of a running VM. With the
+// cluster's LiveUpdate rollout strategy KubeVirt hot-plugs the change and
+// live-migrates the VMI when the node cannot absorb it. Once the controller
+// has observed the new generation, a RestartRequired condition means the
+// change is staged for the next boot. A VM the controller did not observe
+// within the operation timeout is reported as restart-required as well.
+func (p *KubeVirtProviderImpl) LiveResizeVM(ctx context.Context, cluster, namespace, name string, cpu, memoryMB int) (bool, error) {
+	if cpu <= 0 || memoryMB <= 0 {
+		return false, fmt.Errorf("live resize: cpu and memory must be positive, got %d/%dMB", cpu, memoryMB)
+	}
+	client, err := p.clientFactory(cluster)
+	if err != nil {
+		return false, fmt.Errorf("get client for cluster %s: %w", cluster, err)
+	}
+	opCtx, cancel := p.withTimeout(ctx)
+	defer cancel()
+
+	existing, err := client.VM().Get(opCtx, namespace, name, k8smetav1.GetOptions{})
+	if err != nil {
+		return false, fmt.Errorf("get vm %s/%s for live resize: %w", namespace, name, err)
+	}
+	applyLiveResize(existing, cpu, memoryMB)
+	updated, err := client.VM().Update(opCtx, namespace, existing, k8smetav1.UpdateOptions{})
+	if err != nil {
+		return false, fmt.Errorf("update vm %s/%s for live resize: %w", namespace, name, err)
+	}
+
+	generation := updated.Generation
+	for {
+		if updated.Status.DesiredGeneration >= generation {
+			return vmRestartRequired(updated), nil
+		}
+		select {
+		case <-opCtx.Done():
+			return true, nil
+		case <-time.After(liveResizePollInterval):
+		}
+		if updated, err = client.VM().Get(opCtx, namespace, name, k8smetav1.GetOptions{}); err != nil {
+			if opCtx.Err() != nil {
+				return true, nil
+			}
+			return false, fmt.Errorf("get vm %s/%s after live resize: %w", namespace, name, err)
+		}
+	}
+}
+
+// applyLiveResize sets the live-updatable CPU/memory fields. CPUs are
+// hot-plugged as sockets, so the per-socket core count is kept when cpu is a
+// multiple of it; otherwise the topology becomes cpu sockets of one core,
+// which KubeVirt can only apply on reboot. Explicit resource requirements
+// already on the VM are kept in step with the new size.
+func applyLiveResize(vm *kubevirtv1.VirtualMachine, cpu, memoryMB int) {
+	if vm.Spec.Template == nil {
+		vm.Spec.Template = &kubevirtv1.VirtualMachineInstanceTemplateSpec{}
+	}
+	domainSpec := &vm.Spec.Template.Spec.Domain
+
+	if domainSpec.CPU == nil {
+		domainSpec.CPU = &kubevirtv1.CPU{}
+	}
+	cores := domainSpec.CPU.Cores
+	if cores == 0 {
+		cores = 1
+	}
+	if uint32(cpu)%cores == 0 {
+		domainSpec.CPU.Cores = cores
+		domainSpec.CPU.Sockets = uint32(cpu) / cores
+	} else {
+		domainSpec.CPU.Cores = 1
+		domainSpec.CPU.Sockets = uint32(cpu)
+	}
+
+	memQty := resource.MustParse(fmt.Sprintf("%dMi", memoryMB))
+	if domainSpec.Memory == nil {
+		domainSpec.Memory = &kubevirtv1.Memory{}
+	}
+	domainSpec.Memory.Guest = &memQty
+
+	cpuQty := resource.MustParse(fmt.Sprintf("%d", cpu))
+	for _, list := range []k8sv1.ResourceList{domainSpec.Resources.Requests, domainSpec.Resources.Limits} {
+		if _, ok := list[k8sv1.ResourceCPU]; ok {
+			list[k8sv1.ResourceCPU] = cpuQty
+		}
+		if _, ok := list[k8sv1.ResourceMemory]; ok {
+			list[k8sv1.ResourceMemory] = memQty
+		}
+	}
+}
+
+// vmRestartRequired reports whether KubeVirt staged spec changes that only
+// apply on the next boot.
+func vmRestartRequired(vm *kubevirtv1.VirtualMachine) bool {
+	for _, cond := range vm.Status.Conditions {
+		if cond.Type == kubevirtv1.VirtualMachineRestartRequired && cond.Status == k8sv1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
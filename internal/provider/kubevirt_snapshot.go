@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kv-shepherd.io/shepherd/internal/domain"
+)
+
+// CreateSnapshot creates a VirtualMachineSnapshot of vmName. The snapshot is
+// taken asynchronously; poll GetSnapshot until it reports Ready.
+func (p *KubeVirtProviderImpl) CreateSnapshot(ctx context.Context, cluster, namespace, vmName, snapshotName string) (*domain.Snapshot, error) {
+	client, err := p.clientFactory(cluster)
+	if err != nil {
+		return nil, fmt.Errorf("get client for cluster %s: %w", cluster, err)
+	}
+	opCtx, cancel := p.withTimeout(ctx)
+	defer cancel()
+
+	snap := &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: k8smetav1.ObjectMeta{
+			Name:      snapshotName,
+			Namespace: namespace,
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: virtualMachineRef(vmName),
+		},
+	}
+	created, err := client.Snapshot().Create(opCtx, namespace, snap, k8smetav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("create snapshot %s/%s: %w", namespace, snapshotName, err)
+	}
+	return p.mapper.MapSnapshot(created)
+}
+
+// GetSnapshot retrieves a VirtualMachineSnapshot.
+func (p *KubeVirtProviderImpl) GetSnapshot(ctx context.Context, cluster, namespace, name string) (*domain.Snapshot, error) {
+	client, err := p.clientFactory(cluster)
+	if err != nil {
+		return nil, fmt.Errorf("get client for cluster %s: %w", cluster, err)
+	}
+	opCtx, cancel := p.withTimeout(ctx)
+	defer cancel()
+
+	snap, err := client.Snapshot().Get(opCtx, namespace, name, k8smetav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get snapshot %s/%s: %w", namespace, name, err)
+	}
+	return p.mapper.MapSnapshot(snap)
+}
+
+// ListSnapshots lists the snapshots taken of vmName, oldest first.
+func (p *KubeVirtProviderImpl) ListSnapshots(ctx context.Context, cluster, namespace, vmName string) ([]*domain.Snapshot, error) {
+	client, err := p.clientFactory(cluster)
+	if err != nil {
+		return nil, fmt.Errorf("get client for cluster %s: %w", cluster, err)
+	}
+	opCtx, cancel := p.withTimeout(ctx)
+	defer cancel()
+
+	list, err := client.Snapshot().List(opCtx, namespace, k8smetav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list snapshots in %s: %w", namespace, err)
+	}
+
+	result := make([]*domain.Snapshot, 0, len(list.Items))
+	for i := range list.Items {
+		snap := &list.Items[i]
+		if snap.Spec.Source.Kind != kubevirtv1.VirtualMachineGroupVersionKind.Kind || snap.Spec.Source.Name != vmName {
+			continue
+		}
+		mapped, err := p.mapper.MapSnapshot(snap)
+		if err != nil {
+			return nil, fmt.Errorf("map snapshot %s: %w", snap.Name, err)
+		}
+		result = append(result, mapped)
+	}
+	sortSnapshotsByCreation(result)
+	return result, nil
+}
+
+// DeleteSnapshot deletes a VirtualMachineSnapshot.
+func (p *KubeVirtProviderImpl) DeleteSnapshot(ctx context.Context, cluster, namespace, name string) error {
+	client, err := p.clientFactory(cluster)
+	if err != nil {
+		return fmt.Errorf("get client for cluster %s: %w", cluster, err)
+	}
+	opCtx, cancel := p.withTimeout(ctx)
+	defer cancel()
+
+	if err := client.Snapshot().Delete(opCtx, namespace, name, k8smetav1.DeleteOptions{}); err != nil {
+		return fmt.Errorf("delete snapshot %s/%s: %w", namespace, name, err)
+	}
+	return nil
+}
+
+// RestoreSnapshot creates a VirtualMachineRestore of snapshotName onto vmName.
+// The restore runs asynchronously; poll GetSnapshotRestore until Complete.
+func (p *KubeVirtProviderImpl) RestoreSnapshot(
+	ctx context.Context,
+	cluster, namespace, vmName, snapshotName string,
+	stopTarget bool,
+) (*domain.SnapshotRestore, error) {
+	client, err := p.clientFactory(cluster)
+	if err != nil {
+		return nil, fmt.Errorf("get client for cluster %s: %w", cluster, err)
+	}
+	opCtx, cancel := p.withTimeout(ctx)
+	defer cancel()
+
+	policy := snapshotv1.VirtualMachineRestoreWaitGracePeriodAndFail
+	if stopTarget {
+		policy = snapshotv1.VirtualMachineRestoreStopTarget
+	}
+	restore := &snapshotv1.VirtualMachineRestore{
+		ObjectMeta: k8smetav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-restore-%d", snapshotName, time.Now().Unix()),
+			Namespace: namespace,
+		},
+		Spec: snapshotv1.VirtualMachineRestoreSpec{
+			Target:                     virtualMachineRef(vmName),
+			VirtualMachineSnapshotName: snapshotName,
+			TargetReadinessPolicy:      &policy,
+		},
+	}
+	created, err := client.Restore().Create(opCtx, namespace, restore, k8smetav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("restore %s/%s from snapshot %s: %w", namespace, vmName, snapshotName, err)
+	}
+	return p.mapper.MapSnapshotRestore(created)
+}
+
+// GetSnapshotRestore retrieves a VirtualMachineRestore.
+func (p *KubeVirtProviderImpl) GetSnapshotRestore(ctx context.Context, cluster, namespace, name string) (*domain.SnapshotRestore, error) {
+	client, err := p.clientFactory(cluster)
+	if err != nil {
+		return nil, fmt.Errorf("get client for cluster %s: %w", cluster, err)
+	}
+	opCtx, cancel := p.withTimeout(ctx)
+	defer cancel()
+
+	restore, err := client.Restore().Get(opCtx, namespace, name, k8smetav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get restore %s/%s: %w", namespace, name, err)
+	}
+	return p.mapper.MapSnapshotRestore(restore)
+}
+
+func virtualMachineRef(vmName string) k8sv1.TypedLocalObjectReference {
+	apiGroup := kubevirtv1.VirtualMachineGroupVersionKind.Group
+	return k8sv1.TypedLocalObjectReference{
+		APIGroup: &apiGroup,
+		Kind:     kubevirtv1.VirtualMachineGroupVersionKind.Kind,
+		Name:     vmName,
+	}
+}
+
+// sortSnapshotsByCreation orders snapshots oldest first, by name on ties.
+func sortSnapshotsByCreation(snapshots []*domain.Snapshot) {
+	slices.SortStableFunc(snapshots, func(a, b *domain.Snapshot) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+}
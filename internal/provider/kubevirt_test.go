@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	kubevirtv1 "kubevirt.io/api/core/v1"
 
 	"kv-shepherd.io/shepherd/internal/domain"
 )
@@ -99,6 +101,104 @@ func TestBuildVMFromSpec_ValidPVCSource(t *testing.T) {
 	}
 }
 
+func TestBuildVMFromSpec_CloneSource(t *testing.T) {
+	spec := &domain.VMSpec{
+		Name:        "vm-clone",
+		CPU:         2,
+		MemoryMB:    4096,
+		CloneSource: &domain.PVCCloneSource{Namespace: "dev-ns", PVCName: "vm-source-rootdisk"},
+	}
+
+	vm, err := buildVMFromSpec("prod-ns", spec)
+	if err != nil {
+		t.Fatalf("buildVMFromSpec returned error: %v", err)
+	}
+
+	if len(vm.Spec.DataVolumeTemplates) != 1 {
+		t.Fatalf("expected 1 data volume template, got %d", len(vm.Spec.DataVolumeTemplates))
+	}
+	dv := vm.Spec.DataVolumeTemplates[0]
+	if dv.Name != "vm-clone-rootdisk" {
+		t.Fatalf("data volume name mismatch: got %q", dv.Name)
+	}
+	if dv.Spec.Source == nil || dv.Spec.Source.PVC == nil {
+		t.Fatalf("expected data volume pvc clone source")
+	}
+	if dv.Spec.Source.PVC.Namespace != "dev-ns" || dv.Spec.Source.PVC.Name != "vm-source-rootdisk" {
+		t.Fatalf("clone source mismatch: got %s/%s", dv.Spec.Source.PVC.Namespace, dv.Spec.Source.PVC.Name)
+	}
+	root := vm.Spec.Template.Spec.Volumes[0]
+	if root.Name != "rootdisk" || root.VolumeSource.DataVolume == nil || root.VolumeSource.DataVolume.Name != dv.Name {
+		t.Fatalf("expected rootdisk backed by data volume %q, got %+v", dv.Name, root.VolumeSource)
+	}
+
+	spec.CloneSource = &domain.PVCCloneSource{Namespace: "dev-ns"}
+	if _, err := buildVMFromSpec("prod-ns", spec); err == nil {
+		t.Fatalf("expected error for clone source without pvc name")
+	}
+}
+
+func TestBuildVMFromSpec_CloudInitUserData(t *testing.T) {
+	const userData = "I2Nsb3VkLWNvbmZpZwpob3N0bmFtZTogdm0tMDEK" // #cloud-config\nhostname: vm-01\n
+
+	testCases := []struct {
+		name          string
+		cloudInitType domain.CloudInitType
+		wantConfig    bool
+	}{
+		{name: "default nocloud", cloudInitType: ""},
+		{name: "nocloud", cloudInitType: domain.CloudInitNoCloud},
+		{name: "configdrive", cloudInitType: domain.CloudInitConfigDrive, wantConfig: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			vm, err := buildVMFromSpec("dev-ns", &domain.VMSpec{
+				Name:          "vm-01",
+				CPU:           1,
+				MemoryMB:      512,
+				Image:         "quay.io/containerdisks/fedora:latest",
+				UserData:      userData,
+				CloudInitType: tc.cloudInitType,
+			})
+			if err != nil {
+				t.Fatalf("buildVMFromSpec returned error: %v", err)
+			}
+			volumes := vm.Spec.Template.Spec.Volumes
+			disks := vm.Spec.Template.Spec.Domain.Devices.Disks
+			if len(volumes) != 2 || len(disks) != 2 {
+				t.Fatalf("expected rootdisk and cloudinitdisk, got %d volumes / %d disks", len(volumes), len(disks))
+			}
+			ci := volumes[1]
+			if ci.Name != "cloudinitdisk" || disks[1].Name != ci.Name {
+				t.Fatalf("cloud-init volume/disk mismatch: volume=%q disk=%q", ci.Name, disks[1].Name)
+			}
+			if tc.wantConfig {
+				if ci.CloudInitConfigDrive == nil || ci.CloudInitNoCloud != nil {
+					t.Fatalf("expected cloudInitConfigDrive source, got %+v", ci.VolumeSource)
+				}
+				if ci.CloudInitConfigDrive.UserDataBase64 != userData {
+					t.Fatalf("user data mismatch: got %q", ci.CloudInitConfigDrive.UserDataBase64)
+				}
+				return
+			}
+			if ci.CloudInitNoCloud == nil || ci.CloudInitConfigDrive != nil {
+				t.Fatalf("expected cloudInitNoCloud source, got %+v", ci.VolumeSource)
+			}
+			if ci.CloudInitNoCloud.UserDataBase64 != userData {
+				t.Fatalf("user data mismatch: got %q", ci.CloudInitNoCloud.UserDataBase64)
+			}
+		})
+	}
+
+	vm, err := buildVMFromSpec("dev-ns", &domain.VMSpec{Name: "vm-02", CPU: 1, MemoryMB: 512, Image: "img"})
+	if err != nil {
+		t.Fatalf("buildVMFromSpec returned error: %v", err)
+	}
+	if n := len(vm.Spec.Template.Spec.Volumes); n != 1 {
+		t.Fatalf("expected no cloud-init volume without user data, got %d volumes", n)
+	}
+}
+
 func TestBuildVMFromSpec_ValidationErrors(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -195,3 +295,125 @@ func TestBuildVMFromSpec_RejectsInvalidSpecOverridePath(t *testing.T) {
 		t.Fatalf("expected error for invalid override path, got nil")
 	}
 }
+
+func TestSumNodeResources(t *testing.T) {
+	node := func(capCPU, allocCPU, capMem, allocMem string) k8sv1.Node {
+		return k8sv1.Node{Status: k8sv1.NodeStatus{
+			Capacity: k8sv1.ResourceList{
+				k8sv1.ResourceCPU:    resource.MustParse(capCPU),
+				k8sv1.ResourceMemory: resource.MustParse(capMem),
+			},
+			Allocatable: k8sv1.ResourceList{
+				k8sv1.ResourceCPU:    resource.MustParse(allocCPU),
+				k8sv1.ResourceMemory: resource.MustParse(allocMem),
+			},
+		}}
+	}
+
+	got := sumNodeResources([]k8sv1.Node{
+		node("32", "31500m", "128Gi", "125Gi"),
+		node("16", "15750m", "64Gi", "62Gi"),
+	})
+	want := domain.ClusterNodeResources{
+		NodeCount:           2,
+		TotalCPUCores:       48,
+		AllocatableCPUCores: 47,
+		TotalMemoryMB:       196608,
+		AllocatableMemoryMB: 191488,
+	}
+	if *got != want {
+		t.Fatalf("sumNodeResources() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestApplyLiveResize(t *testing.T) {
+	tests := []struct {
+		name        string
+		cores       uint32
+		cpu         int
+		wantSockets uint32
+		wantCores   uint32
+	}{
+		{name: "multiple of cores adds sockets", cores: 2, cpu: 6, wantSockets: 3, wantCores: 2},
+		{name: "no topology uses single-core sockets", cpu: 4, wantSockets: 4, wantCores: 1},
+		{name: "odd cpu count flattens topology", cores: 2, cpu: 3, wantSockets: 3, wantCores: 1},
+	}
+	for _, tc := range tests {
+		vm, err := buildVMFromSpec("test-ns", &domain.VMSpec{Name: "vm-01", CPU: 2, MemoryMB: 2048, Image: "docker.io/kubevirt/fedora:40"})
+		if err != nil {
+			t.Fatalf("%s: buildVMFromSpec returned error: %v", tc.name, err)
+		}
+		vm.Spec.Template.Spec.Domain.CPU = &kubevirtv1.CPU{Cores: tc.cores}
+
+		applyLiveResize(vm, tc.cpu, 4096)
+
+		cpu := vm.Spec.Template.Spec.Domain.CPU
+		if cpu.Sockets != tc.wantSockets || cpu.Cores != tc.wantCores {
+			t.Fatalf("%s: topology = %d sockets x %d cores, want %d x %d", tc.name, cpu.Sockets, cpu.Cores, tc.wantSockets, tc.wantCores)
+		}
+		if guest := vm.Spec.Template.Spec.Domain.Memory.Guest; guest == nil || guest.Cmp(resource.MustParse("4096Mi")) != 0 {
+			t.Fatalf("%s: guest memory = %v, want 4096Mi", tc.name, guest)
+		}
+		limit := vm.Spec.Template.Spec.Domain.Resources.Limits[k8sv1.ResourceMemory]
+		if limit.Cmp(resource.MustParse("4096Mi")) != 0 {
+			t.Fatalf("%s: memory limit = %s, want 4096Mi", tc.name, limit.String())
+		}
+	}
+}
+
+func TestVMRestartRequired(t *testing.T) {
+	vm := &kubevirtv1.VirtualMachine{}
+	if vmRestartRequired(vm) {
+		t.Fatal("vm without conditions reported restart required")
+	}
+	vm.Status.Conditions = []kubevirtv1.VirtualMachineCondition{
+		{Type: kubevirtv1.VirtualMachineRestartRequired, Status: k8sv1.ConditionTrue},
+	}
+	if !vmRestartRequired(vm) {
+		t.Fatal("RestartRequired condition not detected")
+	}
+}
+
+func TestApplySpecToVM_ResizesDataDisk(t *testing.T) {
+	withDisk, err := buildVMFromSpec("test-ns", &domain.VMSpec{Name: "vm-01", CPU: 2, MemoryMB: 2048, DiskGB: 20, Image: "docker.io/kubevirt/fedora:40"})
+	if err != nil {
+		t.Fatalf("buildVMFromSpec returned error: %v", err)
+	}
+	withoutDisk, err := buildVMFromSpec("test-ns", &domain.VMSpec{Name: "vm-02", CPU: 2, MemoryMB: 2048, Image: "docker.io/kubevirt/fedora:40"})
+	if err != nil {
+		t.Fatalf("buildVMFromSpec returned error: %v", err)
+	}
+
+	for _, vm := range []*kubevirtv1.VirtualMachine{withDisk, withoutDisk} {
+		if err := applySpecToVM(vm, &domain.VMSpec{CPU: 2, MemoryMB: 2048, DiskGB: 40}); err != nil {
+			t.Fatalf("%s: applySpecToVM returned error: %v", vm.Name, err)
+		}
+		var dataDisks int
+		for _, volume := range vm.Spec.Template.Spec.Volumes {
+			if volume.Name != "datadisk" {
+				continue
+			}
+			dataDisks++
+			if volume.EmptyDisk == nil || volume.EmptyDisk.Capacity.Cmp(resource.MustParse("40Gi")) != 0 {
+				t.Fatalf("%s: data disk = %+v, want 40Gi emptyDisk", vm.Name, volume.VolumeSource)
+			}
+		}
+		if dataDisks != 1 || len(vm.Spec.Template.Spec.Domain.Devices.Disks) != 2 {
+			t.Fatalf("%s: %d data volumes / %d disks, want 1 / 2", vm.Name, dataDisks, len(vm.Spec.Template.Spec.Domain.Devices.Disks))
+		}
+	}
+}
+
+func TestRenderVM(t *testing.T) {
+	vm, err := RenderVM("prod-shop", &domain.VMSpec{Name: "vm-1", CPU: 2, MemoryMB: 2048, Image: "docker.io/kubevirt/fedora:40"})
+	if err != nil {
+		t.Fatalf("RenderVM() error = %v", err)
+	}
+	if vm.APIVersion != "kubevirt.io/v1" || vm.Kind != "VirtualMachine" || vm.Namespace != "prod-shop" {
+		t.Fatalf("RenderVM() type/namespace = %s %s %s", vm.APIVersion, vm.Kind, vm.Namespace)
+	}
+	if _, err := RenderVM("prod-shop", &domain.VMSpec{Name: "vm-1", CPU: 2, MemoryMB: 2048}); err == nil ||
+		err.Error() != "build vm from spec: vm image is required" {
+		t.Fatalf("RenderVM() without image error = %v", err)
+	}
+}
@@ -2,9 +2,10 @@ package provider
 
 import (
 	"fmt"
-	"time"
 
+	k8sv1 "k8s.io/api/core/v1"
 	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 
 	"kv-shepherd.io/shepherd/internal/domain"
 )
@@ -79,15 +80,109 @@ func (m *KubeVirtMapper) MapVMList(vms []kubevirtv1.VirtualMachine, vmis []kubev
 	}, nil
 }
 
+// MapVMRuntime maps live VMI status to a domain VMRuntime.
+func (m *KubeVirtMapper) MapVMRuntime(vmi *kubevirtv1.VirtualMachineInstance) (*domain.VMRuntime, error) {
+	if vmi == nil {
+		return nil, fmt.Errorf("mapper: vmi is nil")
+	}
+
+	runtime := &domain.VMRuntime{
+		Phase:      string(vmi.Status.Phase),
+		NodeName:   vmi.Status.NodeName,
+		Interfaces: make([]domain.VMInterface, 0, len(vmi.Status.Interfaces)),
+	}
+	for _, ts := range vmi.Status.PhaseTransitionTimestamps {
+		if ts.Phase == kubevirtv1.Running && !ts.PhaseTransitionTimestamp.IsZero() {
+			launchedAt := ts.PhaseTransitionTimestamp.Time
+			runtime.LaunchedAt = &launchedAt
+		}
+	}
+	for _, iface := range vmi.Status.Interfaces {
+		ips := iface.IPs
+		if len(ips) == 0 && iface.IP != "" {
+			ips = []string{iface.IP}
+		}
+		if ips == nil {
+			ips = []string{}
+		}
+		runtime.Interfaces = append(runtime.Interfaces, domain.VMInterface{
+			Name:        iface.Name,
+			MAC:         iface.MAC,
+			IPAddresses: ips,
+		})
+	}
+	for _, cond := range vmi.Status.Conditions {
+		if cond.Type == kubevirtv1.VirtualMachineInstanceAgentConnected && cond.Status == k8sv1.ConditionTrue {
+			runtime.GuestAgentConnected = true
+		}
+	}
+	// Guest OS info is only populated while the guest agent is connected.
+	if runtime.GuestAgentConnected && vmi.Status.GuestOSInfo.Name != "" {
+		runtime.GuestOS = &domain.GuestOSInfo{
+			Name:          vmi.Status.GuestOSInfo.Name,
+			PrettyName:    vmi.Status.GuestOSInfo.PrettyName,
+			Version:       vmi.Status.GuestOSInfo.Version,
+			KernelRelease: vmi.Status.GuestOSInfo.KernelRelease,
+		}
+	}
+	return runtime, nil
+}
+
 // MapSnapshot maps a VirtualMachineSnapshot to a domain Snapshot.
-func (m *KubeVirtMapper) MapSnapshot(name, vmName, namespace string, ready bool, createdAt time.Time) *domain.Snapshot {
-	return &domain.Snapshot{
-		Name:      name,
-		VMName:    vmName,
-		Namespace: namespace,
-		Ready:     ready,
-		CreatedAt: createdAt,
+func (m *KubeVirtMapper) MapSnapshot(snap *snapshotv1.VirtualMachineSnapshot) (*domain.Snapshot, error) {
+	if snap == nil {
+		return nil, fmt.Errorf("mapper: snapshot is nil")
+	}
+
+	result := &domain.Snapshot{
+		Name:      snap.Name,
+		VMName:    snap.Spec.Source.Name,
+		Namespace: snap.Namespace,
+		CreatedAt: snap.CreationTimestamp.Time,
 	}
+	if snap.Status == nil {
+		return result, nil
+	}
+	result.Phase = string(snap.Status.Phase)
+	if snap.Status.ReadyToUse != nil {
+		result.Ready = *snap.Status.ReadyToUse
+	}
+	if snap.Status.CreationTime != nil {
+		result.CreatedAt = snap.Status.CreationTime.Time
+	}
+	if snap.Status.Error != nil && snap.Status.Error.Message != nil {
+		result.Error = *snap.Status.Error.Message
+	}
+	return result, nil
+}
+
+// MapSnapshotRestore maps a VirtualMachineRestore to a domain SnapshotRestore.
+func (m *KubeVirtMapper) MapSnapshotRestore(restore *snapshotv1.VirtualMachineRestore) (*domain.SnapshotRestore, error) {
+	if restore == nil {
+		return nil, fmt.Errorf("mapper: restore is nil")
+	}
+
+	result := &domain.SnapshotRestore{
+		Name:         restore.Name,
+		VMName:       restore.Spec.Target.Name,
+		SnapshotName: restore.Spec.VirtualMachineSnapshotName,
+		Namespace:    restore.Namespace,
+	}
+	if restore.Status == nil {
+		return result, nil
+	}
+	if restore.Status.Complete != nil {
+		result.Complete = *restore.Status.Complete
+	}
+	for _, cond := range restore.Status.Conditions {
+		if cond.Type == snapshotv1.ConditionFailure && cond.Status == k8sv1.ConditionTrue {
+			result.Error = cond.Reason
+			if cond.Message != "" {
+				result.Error = cond.Message
+			}
+		}
+	}
+	return result, nil
 }
 
 // mapVMStatus extracts VM status from K8s objects.
@@ -4,20 +4,41 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"kv-shepherd.io/shepherd/internal/domain"
 )
 
-// MockProvider implements InfrastructureProvider for testing without a K8s cluster.
+// MockProvider implements InfrastructureProvider, SnapshotProvider,
+// CapacityProvider, NamespaceProvider, ResizeProvider and AnnotationProvider
+// for testing without a K8s cluster.
+// Snapshots are ready and restores complete as soon as they are created, and
+// live resizes never require a restart.
 type MockProvider struct {
-	vms map[string]*domain.VM // key: namespace/name
-	mu  sync.RWMutex
+	vms         map[string]*domain.VM              // key: namespace/name
+	snapshots   map[string]*mockSnapshot           // key: namespace/name
+	restores    map[string]*domain.SnapshotRestore // key: namespace/name
+	annotations map[string]map[string]string       // key: namespace/name
+	nodes       domain.ClusterNodeResources
+	namespaces  []string
+	mu          sync.RWMutex
+}
+
+type mockSnapshot struct {
+	snapshot *domain.Snapshot
+	spec     domain.VMSpec
 }
 
 // NewMockProvider creates a new MockProvider.
 func NewMockProvider() *MockProvider {
 	return &MockProvider{
-		vms: make(map[string]*domain.VM),
+		vms:         make(map[string]*domain.VM),
+		snapshots:   make(map[string]*mockSnapshot),
+		restores:    make(map[string]*domain.SnapshotRestore),
+		annotations: make(map[string]map[string]string),
 	}
 }
 
@@ -31,11 +52,30 @@ func (p *MockProvider) Seed(vms []*domain.VM) {
 	}
 }
 
+// SeedNodeResources sets the node resources reported for every cluster.
+func (p *MockProvider) SeedNodeResources(nodes domain.ClusterNodeResources) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.nodes = nodes
+}
+
+// SeedNamespaces sets the namespaces reported for every cluster.
+func (p *MockProvider) SeedNamespaces(namespaces ...string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.namespaces = append([]string(nil), namespaces...)
+}
+
 // Reset clears all mock data.
 func (p *MockProvider) Reset() {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 	p.vms = make(map[string]*domain.VM)
+	p.snapshots = make(map[string]*mockSnapshot)
+	p.restores = make(map[string]*domain.SnapshotRestore)
+	p.annotations = make(map[string]map[string]string)
+	p.nodes = domain.ClusterNodeResources{}
+	p.namespaces = nil
 }
 
 func (p *MockProvider) Name() string { return "mock" }
@@ -47,7 +87,7 @@ func (p *MockProvider) GetVM(_ context.Context, _, namespace, name string) (*dom
 	key := namespace + "/" + name
 	vm, ok := p.vms[key]
 	if !ok {
-		return nil, fmt.Errorf("vm %s not found", key)
+		return nil, apierrors.NewNotFound(schema.GroupResource{Group: "kubevirt.io", Resource: "virtualmachines"}, key)
 	}
 	return vm, nil
 }
@@ -106,6 +146,7 @@ func (p *MockProvider) DeleteVM(_ context.Context, _, namespace, name string) er
 		return fmt.Errorf("vm %s not found", key)
 	}
 	delete(p.vms, key)
+	delete(p.annotations, key)
 	return nil
 }
 
@@ -133,6 +174,164 @@ func (p *MockProvider) ValidateSpec(_ context.Context, _, _ string, _ *domain.VM
 	return &domain.ValidationResult{Valid: true}, nil
 }
 
+func (p *MockProvider) GetClusterNodeResources(_ context.Context, _ string) (*domain.ClusterNodeResources, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	nodes := p.nodes
+	return &nodes, nil
+}
+
+func (p *MockProvider) ListNamespaces(_ context.Context, _ string) ([]string, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return append([]string(nil), p.namespaces...), nil
+}
+
+func (p *MockProvider) LiveResizeVM(_ context.Context, _, namespace, name string, cpu, memoryMB int) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	key := namespace + "/" + name
+	vm, ok := p.vms[key]
+	if !ok {
+		return false, fmt.Errorf("vm %s not found", key)
+	}
+	vm.Spec.CPU = cpu
+	vm.Spec.MemoryMB = memoryMB
+	return false, nil
+}
+
+func (p *MockProvider) GetVMAnnotations(_ context.Context, _, namespace, name string) (map[string]string, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	key := namespace + "/" + name
+	if _, ok := p.vms[key]; !ok {
+		return nil, fmt.Errorf("vm %s not found", key)
+	}
+	out := make(map[string]string, len(p.annotations[key]))
+	for k, v := range p.annotations[key] {
+		out[k] = v
+	}
+	return out, nil
+}
+
+func (p *MockProvider) SetVMAnnotation(_ context.Context, _, namespace, name, annotation, value string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	key := namespace + "/" + name
+	if _, ok := p.vms[key]; !ok {
+		return fmt.Errorf("vm %s not found", key)
+	}
+	if p.annotations[key] == nil {
+		p.annotations[key] = make(map[string]string)
+	}
+	p.annotations[key][annotation] = value
+	return nil
+}
+
+func (p *MockProvider) CreateSnapshot(_ context.Context, _, namespace, vmName, snapshotName string) (*domain.Snapshot, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	vm, ok := p.vms[namespace+"/"+vmName]
+	if !ok {
+		return nil, fmt.Errorf("vm %s/%s not found", namespace, vmName)
+	}
+	key := namespace + "/" + snapshotName
+	if _, exists := p.snapshots[key]; exists {
+		return nil, apierrors.NewAlreadyExists(schema.GroupResource{Group: "snapshot.kubevirt.io", Resource: "virtualmachinesnapshots"}, key)
+	}
+	snap := &domain.Snapshot{
+		Name:      snapshotName,
+		VMName:    vmName,
+		Namespace: namespace,
+		Phase:     "Succeeded",
+		Ready:     true,
+		CreatedAt: time.Now().UTC(),
+	}
+	p.snapshots[key] = &mockSnapshot{snapshot: snap, spec: vm.Spec}
+	return snap, nil
+}
+
+func (p *MockProvider) GetSnapshot(_ context.Context, _, namespace, name string) (*domain.Snapshot, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	key := namespace + "/" + name
+	snap, ok := p.snapshots[key]
+	if !ok {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Group: "snapshot.kubevirt.io", Resource: "virtualmachinesnapshots"}, key)
+	}
+	return snap.snapshot, nil
+}
+
+func (p *MockProvider) ListSnapshots(_ context.Context, _, namespace, vmName string) ([]*domain.Snapshot, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	items := make([]*domain.Snapshot, 0)
+	for _, snap := range p.snapshots {
+		if snap.snapshot.Namespace == namespace && snap.snapshot.VMName == vmName {
+			items = append(items, snap.snapshot)
+		}
+	}
+	sortSnapshotsByCreation(items)
+	return items, nil
+}
+
+func (p *MockProvider) DeleteSnapshot(_ context.Context, _, namespace, name string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	key := namespace + "/" + name
+	if _, ok := p.snapshots[key]; !ok {
+		return apierrors.NewNotFound(schema.GroupResource{Group: "snapshot.kubevirt.io", Resource: "virtualmachinesnapshots"}, key)
+	}
+	delete(p.snapshots, key)
+	return nil
+}
+
+func (p *MockProvider) RestoreSnapshot(
+	_ context.Context,
+	_, namespace, vmName, snapshotName string,
+	stopTarget bool,
+) (*domain.SnapshotRestore, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	vm, ok := p.vms[namespace+"/"+vmName]
+	if !ok {
+		return nil, fmt.Errorf("vm %s/%s not found", namespace, vmName)
+	}
+	snap, ok := p.snapshots[namespace+"/"+snapshotName]
+	if !ok {
+		return nil, fmt.Errorf("snapshot %s/%s not found", namespace, snapshotName)
+	}
+	restore := &domain.SnapshotRestore{
+		Name:         fmt.Sprintf("%s-restore-%d", snapshotName, len(p.restores)+1),
+		VMName:       vmName,
+		SnapshotName: snapshotName,
+		Namespace:    namespace,
+	}
+	switch {
+	case vm.Status == domain.VMStatusRunning && !stopTarget:
+		restore.Error = "target VM is running"
+	default:
+		if vm.Status == domain.VMStatusRunning {
+			vm.Status = domain.VMStatusStopped
+		}
+		vm.Spec = snap.spec
+		restore.Complete = true
+	}
+	p.restores[namespace+"/"+restore.Name] = restore
+	return restore, nil
+}
+
+func (p *MockProvider) GetSnapshotRestore(_ context.Context, _, namespace, name string) (*domain.SnapshotRestore, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	key := namespace + "/" + name
+	restore, ok := p.restores[key]
+	if !ok {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Group: "snapshot.kubevirt.io", Resource: "virtualmachinerestores"}, key)
+	}
+	return restore, nil
+}
+
 func (p *MockProvider) setStatus(namespace, name string, status domain.VMStatus) error {
 	p.mu.Lock()
 	defer p.mu.Unlock()
@@ -130,6 +130,174 @@ func (q *Queries) ApproveDeleteTicket(ctx context.Context, arg ApproveDeleteTick
 	return result.RowsAffected(), nil
 }
 
+const approveMigrateTicket = `-- name: ApproveMigrateTicket :execrows
+UPDATE approval_tickets
+SET
+    status = 'APPROVED',
+    approver = $1,
+    updated_at = NOW()
+WHERE
+    id = $2
+    AND event_id = $3
+    AND status = 'PENDING'
+    AND operation_type = 'MIGRATE'
+`
+
+type ApproveMigrateTicketParams struct {
+	Approver pgtype.Text `db:"approver" json:"approver"`
+	ID       string      `db:"id" json:"id"`
+	EventID  string      `db:"event_id" json:"event_id"`
+}
+
+func (q *Queries) ApproveMigrateTicket(ctx context.Context, arg ApproveMigrateTicketParams) (int64, error) {
+	result, err := q.db.Exec(ctx, approveMigrateTicket, arg.Approver, arg.ID, arg.EventID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const approveNamespaceMigrateTicket = `-- name: ApproveNamespaceMigrateTicket :execrows
+UPDATE approval_tickets
+SET
+    status = 'APPROVED',
+    approver = $1,
+    updated_at = NOW()
+WHERE
+    id = $2
+    AND event_id = $3
+    AND status = 'PENDING'
+    AND operation_type = 'NAMESPACE_MIGRATE'
+`
+
+type ApproveNamespaceMigrateTicketParams struct {
+	Approver pgtype.Text `db:"approver" json:"approver"`
+	ID       string      `db:"id" json:"id"`
+	EventID  string      `db:"event_id" json:"event_id"`
+}
+
+func (q *Queries) ApproveNamespaceMigrateTicket(ctx context.Context, arg ApproveNamespaceMigrateTicketParams) (int64, error) {
+	result, err := q.db.Exec(ctx, approveNamespaceMigrateTicket, arg.Approver, arg.ID, arg.EventID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const approvePowerTicket = `-- name: ApprovePowerTicket :execrows
+UPDATE approval_tickets
+SET
+    status = 'APPROVED',
+    approver = $1,
+    updated_at = NOW()
+WHERE
+    id = $2
+    AND event_id = $3
+    AND status = 'PENDING'
+    AND operation_type = 'CREATE'
+    AND parent_ticket_id IS NOT NULL
+`
+
+type ApprovePowerTicketParams struct {
+	Approver pgtype.Text `db:"approver" json:"approver"`
+	ID       string      `db:"id" json:"id"`
+	EventID  string      `db:"event_id" json:"event_id"`
+}
+
+// Batch power children are stored with operation_type CREATE.
+func (q *Queries) ApprovePowerTicket(ctx context.Context, arg ApprovePowerTicketParams) (int64, error) {
+	result, err := q.db.Exec(ctx, approvePowerTicket, arg.Approver, arg.ID, arg.EventID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const approveResizeTicket = `-- name: ApproveResizeTicket :execrows
+UPDATE approval_tickets
+SET
+    status = 'APPROVED',
+    approver = $1,
+    modified_spec = COALESCE($2::jsonb, modified_spec),
+    updated_at = NOW()
+WHERE
+    id = $3
+    AND event_id = $4
+    AND status = 'PENDING'
+    AND operation_type = 'RESIZE'
+`
+
+type ApproveResizeTicketParams struct {
+	Approver     pgtype.Text `db:"approver" json:"approver"`
+	ModifiedSpec []byte      `db:"modified_spec" json:"modified_spec"`
+	ID           string      `db:"id" json:"id"`
+	EventID      string      `db:"event_id" json:"event_id"`
+}
+
+func (q *Queries) ApproveResizeTicket(ctx context.Context, arg ApproveResizeTicketParams) (int64, error) {
+	result, err := q.db.Exec(ctx, approveResizeTicket,
+		arg.Approver,
+		arg.ModifiedSpec,
+		arg.ID,
+		arg.EventID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const approveSnapshotTicket = `-- name: ApproveSnapshotTicket :execrows
+UPDATE approval_tickets
+SET
+    status = 'APPROVED',
+    approver = $1,
+    updated_at = NOW()
+WHERE
+    id = $2
+    AND event_id = $3
+    AND status = 'PENDING'
+    AND operation_type = 'SNAPSHOT'
+`
+
+type ApproveSnapshotTicketParams struct {
+	Approver pgtype.Text `db:"approver" json:"approver"`
+	ID       string      `db:"id" json:"id"`
+	EventID  string      `db:"event_id" json:"event_id"`
+}
+
+func (q *Queries) ApproveSnapshotTicket(ctx context.Context, arg ApproveSnapshotTicketParams) (int64, error) {
+	result, err := q.db.Exec(ctx, approveSnapshotTicket, arg.Approver, arg.ID, arg.EventID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const findVMByNamespaceName = `-- name: FindVMByNamespaceName :one
+SELECT id, status
+FROM vms
+WHERE namespace = $1 AND name = $2
+LIMIT 1
+`
+
+type FindVMByNamespaceNameParams struct {
+	Namespace string `db:"namespace" json:"namespace"`
+	Name      string `db:"name" json:"name"`
+}
+
+type FindVMByNamespaceNameRow struct {
+	ID     string `db:"id" json:"id"`
+	Status string `db:"status" json:"status"`
+}
+
+func (q *Queries) FindVMByNamespaceName(ctx context.Context, arg FindVMByNamespaceNameParams) (FindVMByNamespaceNameRow, error) {
+	row := q.db.QueryRow(ctx, findVMByNamespaceName, arg.Namespace, arg.Name)
+	var i FindVMByNamespaceNameRow
+	err := row.Scan(&i.ID, &i.Status)
+	return i, err
+}
+
 const insertVM = `-- name: InsertVM :exec
 INSERT INTO vms (
     id,
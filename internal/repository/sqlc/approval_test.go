@@ -8,9 +8,12 @@ import (
 	"os"
 	"regexp"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/require"
@@ -40,6 +43,93 @@ func TestQueries_AllocateServiceInstance(t *testing.T) {
 	require.EqualValues(t, 2, nextIndex)
 }
 
+// TestQueries_ConcurrentCreateApprovalsAllocateDistinctInstances runs the
+// ApprovalAtomicWriter CREATE sequence for two tickets of one service in
+// parallel transactions and expects distinct instance indexes.
+func TestQueries_ConcurrentCreateApprovalsAllocateDistinctInstances(t *testing.T) {
+	ctx := context.Background()
+	q, pool := newSQLCTestQueries(t, "concurrent_allocate")
+
+	systemID := "sys-concurrent"
+	serviceID := "svc-concurrent"
+	seedSystemAndService(t, ctx, pool, systemID, serviceID, 3)
+
+	const approvals = 2
+	for i := 1; i <= approvals; i++ {
+		seedDomainEvent(t, ctx, pool, fmt.Sprintf("event-concurrent-%d", i), "PENDING")
+		seedApprovalTicket(t, ctx, pool, fmt.Sprintf("ticket-concurrent-%d", i), fmt.Sprintf("event-concurrent-%d", i), "CREATE", "PENDING")
+	}
+
+	approve := func(i int, ready *sync.WaitGroup) (string, error) {
+		tx, err := pool.Begin(ctx)
+		if err != nil {
+			return "", err
+		}
+		defer func() { _ = tx.Rollback(ctx) }()
+		qtx := q.WithTx(tx)
+
+		ticketID := fmt.Sprintf("ticket-concurrent-%d", i)
+		eventID := fmt.Sprintf("event-concurrent-%d", i)
+		if _, err := qtx.ApproveCreateTicket(ctx, ApproveCreateTicketParams{
+			Approver:          pgtype.Text{String: "admin-1", Valid: true},
+			SelectedClusterID: pgtype.Text{String: "cluster-a", Valid: true},
+			ID:                ticketID,
+			EventID:           eventID,
+		}); err != nil {
+			return "", err
+		}
+		if _, err := qtx.SetDomainEventStatus(ctx, SetDomainEventStatusParams{ID: eventID, Status: "PROCESSING"}); err != nil {
+			return "", err
+		}
+		// Both transactions are open before either allocates.
+		ready.Done()
+		ready.Wait()
+
+		allocated, err := qtx.AllocateServiceInstance(ctx, serviceID)
+		if err != nil {
+			return "", err
+		}
+		instance := fmt.Sprintf("%02d", allocated.AllocatedIndex)
+		if err := qtx.InsertVM(ctx, InsertVMParams{
+			ID:         fmt.Sprintf("vm-concurrent-%d", i),
+			Name:       fmt.Sprintf("dev-ns-%s-%s-%s", allocated.SystemName, allocated.ServiceName, instance),
+			Instance:   instance,
+			Namespace:  "dev-ns",
+			ClusterID:  pgtype.Text{String: "cluster-a", Valid: true},
+			CreatedBy:  "requester-1",
+			TicketID:   pgtype.Text{String: ticketID, Valid: true},
+			ServiceVms: serviceID,
+		}); err != nil {
+			return "", err
+		}
+		return instance, tx.Commit(ctx)
+	}
+
+	var (
+		ready, done sync.WaitGroup
+		instances   [approvals]string
+		errs        [approvals]error
+	)
+	ready.Add(approvals)
+	done.Add(approvals)
+	for i := range approvals {
+		go func() {
+			defer done.Done()
+			instances[i], errs[i] = approve(i+1, &ready)
+		}()
+	}
+	done.Wait()
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	require.ElementsMatch(t, []string{"03", "04"}, instances[:])
+
+	var nextIndex int32
+	require.NoError(t, pool.QueryRow(ctx, `SELECT next_instance_index FROM services WHERE id=$1`, serviceID).Scan(&nextIndex))
+	require.EqualValues(t, 5, nextIndex)
+}
+
 func TestQueries_ApproveCreateTicket(t *testing.T) {
 	ctx := context.Background()
 	q, pool := newSQLCTestQueries(t, "approve_create_ticket")
@@ -141,6 +231,111 @@ func TestQueries_ApproveDeleteTicket(t *testing.T) {
 	require.Equal(t, "admin-delete", approver.String)
 }
 
+func TestQueries_ApproveMigrateTicket(t *testing.T) {
+	ctx := context.Background()
+	q, pool := newSQLCTestQueries(t, "approve_migrate_ticket")
+
+	migrateTicketID := "ticket-migrate-1"
+	seedApprovalTicket(t, ctx, pool, migrateTicketID, "event-migrate-1", "MIGRATE", "PENDING")
+	deleteTicketID := "ticket-migrate-2"
+	seedApprovalTicket(t, ctx, pool, deleteTicketID, "event-migrate-2", "DELETE", "PENDING")
+
+	rows, err := q.ApproveMigrateTicket(ctx, ApproveMigrateTicketParams{
+		Approver: pgtype.Text{String: "admin-migrate", Valid: true},
+		ID:       migrateTicketID,
+		EventID:  "event-migrate-1",
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, rows)
+
+	rows, err = q.ApproveMigrateTicket(ctx, ApproveMigrateTicketParams{
+		Approver: pgtype.Text{String: "admin-migrate", Valid: true},
+		ID:       deleteTicketID,
+		EventID:  "event-migrate-2",
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, rows, "operation type mismatch must not be approved")
+}
+
+func TestQueries_ApproveNamespaceMigrateTicket(t *testing.T) {
+	ctx := context.Background()
+	q, pool := newSQLCTestQueries(t, "approve_namespace_migrate_ticket")
+
+	nsTicketID := "ticket-ns-migrate-1"
+	seedApprovalTicket(t, ctx, pool, nsTicketID, "event-ns-migrate-1", "NAMESPACE_MIGRATE", "PENDING")
+	vmTicketID := "ticket-ns-migrate-2"
+	seedApprovalTicket(t, ctx, pool, vmTicketID, "event-ns-migrate-2", "MIGRATE", "PENDING")
+
+	rows, err := q.ApproveNamespaceMigrateTicket(ctx, ApproveNamespaceMigrateTicketParams{
+		Approver: pgtype.Text{String: "admin-ns", Valid: true},
+		ID:       nsTicketID,
+		EventID:  "event-ns-migrate-1",
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, rows)
+
+	rows, err = q.ApproveNamespaceMigrateTicket(ctx, ApproveNamespaceMigrateTicketParams{
+		Approver: pgtype.Text{String: "admin-ns", Valid: true},
+		ID:       vmTicketID,
+		EventID:  "event-ns-migrate-2",
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, rows, "operation type mismatch must not be approved")
+}
+
+func TestQueries_ApprovePowerTicket(t *testing.T) {
+	ctx := context.Background()
+	q, pool := newSQLCTestQueries(t, "approve_power_ticket")
+
+	seedApprovalTicket(t, ctx, pool, "ticket-power-parent", "event-power-parent", "CREATE", "PENDING")
+	childTicketID := "ticket-power-1"
+	seedApprovalTicket(t, ctx, pool, childTicketID, "event-power-1", "CREATE", "PENDING")
+	_, err := pool.Exec(ctx, `UPDATE approval_tickets SET parent_ticket_id = 'ticket-power-parent' WHERE id = $1`, childTicketID)
+	require.NoError(t, err)
+
+	rows, err := q.ApprovePowerTicket(ctx, ApprovePowerTicketParams{
+		Approver: pgtype.Text{String: "admin-power", Valid: true},
+		ID:       childTicketID,
+		EventID:  "event-power-1",
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, rows)
+
+	rows, err = q.ApprovePowerTicket(ctx, ApprovePowerTicketParams{
+		Approver: pgtype.Text{String: "admin-power", Valid: true},
+		ID:       "ticket-power-parent",
+		EventID:  "event-power-parent",
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, rows, "a ticket without parent must not be approved as a power child")
+}
+
+func TestQueries_ApproveResizeTicket(t *testing.T) {
+	ctx := context.Background()
+	q, pool := newSQLCTestQueries(t, "approve_resize_ticket")
+
+	resizeTicketID := "ticket-resize-1"
+	seedApprovalTicket(t, ctx, pool, resizeTicketID, "event-resize-1", "RESIZE", "PENDING")
+	migrateTicketID := "ticket-resize-2"
+	seedApprovalTicket(t, ctx, pool, migrateTicketID, "event-resize-2", "MIGRATE", "PENDING")
+
+	rows, err := q.ApproveResizeTicket(ctx, ApproveResizeTicketParams{
+		Approver: pgtype.Text{String: "admin-resize", Valid: true},
+		ID:       resizeTicketID,
+		EventID:  "event-resize-1",
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, rows)
+
+	rows, err = q.ApproveResizeTicket(ctx, ApproveResizeTicketParams{
+		Approver: pgtype.Text{String: "admin-resize", Valid: true},
+		ID:       migrateTicketID,
+		EventID:  "event-resize-2",
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, rows, "operation type mismatch must not be approved")
+}
+
 func TestQueries_InsertVM(t *testing.T) {
 	ctx := context.Background()
 	q, pool := newSQLCTestQueries(t, "insert_vm")
@@ -182,6 +377,37 @@ func TestQueries_InsertVM(t *testing.T) {
 	require.Equal(t, "user-1", createdBy)
 }
 
+func TestQueries_FindVMByNamespaceName(t *testing.T) {
+	ctx := context.Background()
+	q, pool := newSQLCTestQueries(t, "find_vm_name")
+
+	serviceID := "svc-find-vm"
+	seedSystemAndService(t, ctx, pool, "sys-find-vm", serviceID, 1)
+	seedVM(t, ctx, pool, "vm-find-1", serviceID, "RUNNING")
+
+	row, err := q.FindVMByNamespaceName(ctx, FindVMByNamespaceNameParams{Namespace: "dev", Name: "vm-name"})
+	require.NoError(t, err)
+	require.Equal(t, "vm-find-1", row.ID)
+	require.Equal(t, "RUNNING", row.Status)
+
+	// The same name in another namespace is not a conflict.
+	_, err = q.FindVMByNamespaceName(ctx, FindVMByNamespaceNameParams{Namespace: "prod", Name: "vm-name"})
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	// The unique index rejects a second VM with the same name in the namespace.
+	err = q.InsertVM(ctx, InsertVMParams{
+		ID:         "vm-find-2",
+		Name:       "vm-name",
+		Instance:   "02",
+		Namespace:  "dev",
+		CreatedBy:  "user-1",
+		ServiceVms: serviceID,
+	})
+	var pgErr *pgconn.PgError
+	require.ErrorAs(t, err, &pgErr)
+	require.Equal(t, "23505", pgErr.Code)
+}
+
 func TestQueries_SetDomainEventStatus(t *testing.T) {
 	ctx := context.Background()
 	q, pool := newSQLCTestQueries(t, "set_domain_event_status")
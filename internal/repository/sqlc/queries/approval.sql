@@ -34,6 +34,69 @@ WHERE
     AND status = 'PENDING'
     AND operation_type = 'DELETE';
 
+-- name: ApproveMigrateTicket :execrows
+UPDATE approval_tickets
+SET
+    status = 'APPROVED',
+    approver = sqlc.arg(approver),
+    updated_at = NOW()
+WHERE
+    id = sqlc.arg(id)
+    AND event_id = sqlc.arg(event_id)
+    AND status = 'PENDING'
+    AND operation_type = 'MIGRATE';
+
+-- name: ApprovePowerTicket :execrows
+-- Batch power children are stored with operation_type CREATE.
+UPDATE approval_tickets
+SET
+    status = 'APPROVED',
+    approver = sqlc.arg(approver),
+    updated_at = NOW()
+WHERE
+    id = sqlc.arg(id)
+    AND event_id = sqlc.arg(event_id)
+    AND status = 'PENDING'
+    AND operation_type = 'CREATE'
+    AND parent_ticket_id IS NOT NULL;
+
+-- name: ApproveResizeTicket :execrows
+UPDATE approval_tickets
+SET
+    status = 'APPROVED',
+    approver = sqlc.arg(approver),
+    modified_spec = COALESCE(sqlc.narg(modified_spec)::jsonb, modified_spec),
+    updated_at = NOW()
+WHERE
+    id = sqlc.arg(id)
+    AND event_id = sqlc.arg(event_id)
+    AND status = 'PENDING'
+    AND operation_type = 'RESIZE';
+
+-- name: ApproveSnapshotTicket :execrows
+UPDATE approval_tickets
+SET
+    status = 'APPROVED',
+    approver = sqlc.arg(approver),
+    updated_at = NOW()
+WHERE
+    id = sqlc.arg(id)
+    AND event_id = sqlc.arg(event_id)
+    AND status = 'PENDING'
+    AND operation_type = 'SNAPSHOT';
+
+-- name: ApproveNamespaceMigrateTicket :execrows
+UPDATE approval_tickets
+SET
+    status = 'APPROVED',
+    approver = sqlc.arg(approver),
+    updated_at = NOW()
+WHERE
+    id = sqlc.arg(id)
+    AND event_id = sqlc.arg(event_id)
+    AND status = 'PENDING'
+    AND operation_type = 'NAMESPACE_MIGRATE';
+
 -- name: SetDomainEventStatus :execrows
 UPDATE domain_events
 SET status = $2
@@ -56,6 +119,12 @@ SELECT
 FROM allocated
 JOIN systems ON systems.id = allocated.system_services;
 
+-- name: FindVMByNamespaceName :one
+SELECT id, status
+FROM vms
+WHERE namespace = $1 AND name = $2
+LIMIT 1;
+
 -- name: InsertVM :exec
 INSERT INTO vms (
     id,
@@ -67,3 +67,5 @@ CREATE TABLE vms (
     ticket_id text,
     service_vms text NOT NULL REFERENCES services(id)
 );
+
+CREATE UNIQUE INDEX vm_namespace_name ON vms (namespace, name);
@@ -4,29 +4,44 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 
 	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
 	"kv-shepherd.io/shepherd/ent/cluster"
+	"kv-shepherd.io/shepherd/ent/instancesizecluster"
 	"kv-shepherd.io/shepherd/ent/namespaceregistry"
+	"kv-shepherd.io/shepherd/ent/predicate"
+	"kv-shepherd.io/shepherd/ent/vm"
 	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
 )
 
 // ApprovalValidator performs pre-approval checks per master-flow.md Stage 5.B.
 type ApprovalValidator struct {
 	client *ent.Client
+	quotas *QuotaChecker
 }
 
 // NewApprovalValidator creates a new ApprovalValidator.
 func NewApprovalValidator(client *ent.Client) *ApprovalValidator {
-	return &ApprovalValidator{client: client}
+	return &ApprovalValidator{client: client, quotas: NewQuotaChecker(client)}
+}
+
+// ValidateQuota hard-fails with QUOTA_EXCEEDED when the VMs to be created
+// would exceed a system or namespace quota. Pass every create of one approval
+// together so a batch is checked cumulatively.
+func (v *ApprovalValidator) ValidateQuota(ctx context.Context, demands ...QuotaDemand) error {
+	return v.quotas.Enforce(ctx, demands...)
 }
 
 // ValidateApproval checks:
 // 1. Selected cluster exists and is healthy
 // 2. Namespace environment matches cluster environment (ADR-0015 §15)
 // 3. Instance size overcommit + dedicatedCpuPlacement constraint
+// 4. Selected cluster has CPU/memory headroom for the instance size
+// Instance sizes with cluster associations may only land on those clusters.
 // Returns nil if validation passes.
 func (v *ApprovalValidator) ValidateApproval(
 	ctx context.Context,
@@ -50,9 +65,8 @@ func (v *ApprovalValidator) ValidateApproval(
 			}
 			return fmt.Errorf("query cluster: %w", err)
 		}
-		if cl.Status != cluster.StatusHEALTHY {
-			return apperrors.BadRequest(apperrors.CodeValidationFailed,
-				fmt.Sprintf("cluster %s is not healthy (status: %s)", cl.Name, cl.Status))
+		if err := CheckClusterHealthy(cl); err != nil {
+			return err
 		}
 		clusterCapSet = buildClusterCapabilitySet(cl.EnabledFeatures)
 		clusterDisplayID = cl.Name
@@ -113,11 +127,212 @@ func (v *ApprovalValidator) ValidateApproval(
 				)
 			}
 		}
+
+		if err := v.validateInstanceSizeCluster(ctx, size, cl); err != nil {
+			return err
+		}
+
+		// 4. Validate cluster capacity headroom.
+		if cl != nil {
+			if err := v.validateClusterCapacity(ctx, cl, size); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
 }
 
+// CodeClusterCapacityExceeded is returned when an approval would over-commit
+// the selected cluster beyond its configured capacity.
+const CodeClusterCapacityExceeded = "CLUSTER_CAPACITY_EXCEEDED"
+
+// CodeInstanceSizeClusterNotAllowed is returned when an approval places an
+// instance size on a cluster outside its associated clusters.
+const CodeInstanceSizeClusterNotAllowed = "INSTANCE_SIZE_CLUSTER_NOT_ALLOWED"
+
+// validateInstanceSizeCluster enforces the size's cluster associations. A
+// size without associations is available on every cluster.
+func (v *ApprovalValidator) validateInstanceSizeCluster(ctx context.Context, size *ent.InstanceSize, cl *ent.Cluster) error {
+	allowed, err := v.client.InstanceSizeCluster.Query().
+		Where(instancesizecluster.InstanceSizeIDEQ(size.ID)).
+		Order(ent.Asc(instancesizecluster.FieldClusterID)).
+		Select(instancesizecluster.FieldClusterID).
+		Strings(ctx)
+	if err != nil {
+		return fmt.Errorf("query instance size clusters: %w", err)
+	}
+	if len(allowed) == 0 {
+		return nil
+	}
+	if cl == nil {
+		return apperrors.BadRequest(apperrors.CodeValidationFailed,
+			"selected cluster is required for instance size cluster restrictions")
+	}
+	if slices.Contains(allowed, cl.ID) {
+		return nil
+	}
+	return apperrors.BadRequest(CodeInstanceSizeClusterNotAllowed,
+		fmt.Sprintf("instance size %s is not available on cluster %s", size.Name, cl.Name),
+	).WithParams(map[string]interface{}{
+		"instance_size_id": size.ID,
+		"cluster_id":       cl.ID,
+		"allowed_clusters": allowed,
+	})
+}
+
+// ClusterCapacity is the configured capacity of a cluster.
+// Zero totals mean the resource is not tracked and never blocks approval.
+type ClusterCapacity struct {
+	TotalCPUCores         int
+	TotalMemoryMB         int
+	CPUOvercommitRatio    float64
+	MemoryOvercommitRatio float64
+}
+
+// ClusterAllocation is a CPU/memory sum (allocated or requested).
+type ClusterAllocation struct {
+	CPUCores int
+	MemoryMB int
+}
+
+// CheckClusterCapacity verifies allocated+requested fits within
+// total*overcommit_ratio for every tracked resource. Exact fit passes.
+func CheckClusterCapacity(clusterName string, capacity ClusterCapacity, allocated, requested ClusterAllocation) error {
+	checks := []struct {
+		resource  string
+		total     int
+		ratio     float64
+		allocated int
+		requested int
+	}{
+		{"cpu_cores", capacity.TotalCPUCores, capacity.CPUOvercommitRatio, allocated.CPUCores, requested.CPUCores},
+		{"memory_mb", capacity.TotalMemoryMB, capacity.MemoryOvercommitRatio, allocated.MemoryMB, requested.MemoryMB},
+	}
+	for _, chk := range checks {
+		if chk.total <= 0 {
+			continue
+		}
+		ratio := chk.ratio
+		if ratio <= 0 {
+			ratio = 1
+		}
+		allocatable := int(float64(chk.total) * ratio)
+		available := allocatable - chk.allocated
+		if available < 0 {
+			available = 0
+		}
+		if chk.requested <= available {
+			continue
+		}
+		return apperrors.Conflict(CodeClusterCapacityExceeded,
+			fmt.Sprintf("cluster %s has insufficient %s: requested %d, available %d", clusterName, chk.resource, chk.requested, available)).
+			WithParams(map[string]interface{}{
+				"cluster":     clusterName,
+				"resource":    chk.resource,
+				"requested":   chk.requested,
+				"available":   available,
+				"allocated":   chk.allocated,
+				"allocatable": allocatable,
+			})
+	}
+	return nil
+}
+
+// CheckClusterHealthy refuses clusters the periodic health check has not
+// marked HEALTHY, including the last probe failure when one is recorded.
+func CheckClusterHealthy(cl *ent.Cluster) error {
+	if cl.Status == cluster.StatusHEALTHY {
+		return nil
+	}
+	msg := fmt.Sprintf("cluster %s is not healthy (status: %s); select a HEALTHY cluster", cl.Name, cl.Status)
+	if cl.HealthMessage != "" {
+		msg += ": last health check failed: " + cl.HealthMessage
+	}
+	return apperrors.Conflict(apperrors.CodeClusterUnhealthy, msg)
+}
+
+func (v *ApprovalValidator) validateClusterCapacity(ctx context.Context, cl *ent.Cluster, size *ent.InstanceSize) error {
+	if cl.TotalCPUCores <= 0 && cl.TotalMemoryMB <= 0 {
+		return nil
+	}
+	allocated, err := v.clusterAllocation(ctx, cl.ID)
+	if err != nil {
+		return err
+	}
+	name := cl.Name
+	if name == "" {
+		name = cl.ID
+	}
+	return CheckClusterCapacity(name,
+		ClusterCapacity{
+			TotalCPUCores:         cl.TotalCPUCores,
+			TotalMemoryMB:         cl.TotalMemoryMB,
+			CPUOvercommitRatio:    cl.CPUOvercommitRatio,
+			MemoryOvercommitRatio: cl.MemoryOvercommitRatio,
+		},
+		allocated,
+		ClusterAllocation{CPUCores: size.CPUCores, MemoryMB: size.MemoryMB},
+	)
+}
+
+// clusterAllocation sums the instance size snapshots of all non-terminal VMs
+// recorded for the cluster.
+func (v *ApprovalValidator) clusterAllocation(ctx context.Context, clusterID string) (ClusterAllocation, error) {
+	return vmAllocation(ctx, v.client, vm.ClusterIDEQ(clusterID), vm.StatusNEQ(vm.StatusFAILED))
+}
+
+// vmAllocation sums the instance size snapshots of the VMs matching preds.
+// VM rows carry no resources themselves, so the snapshot on the originating
+// approval ticket is the source of truth.
+func vmAllocation(ctx context.Context, client *ent.Client, preds ...predicate.VM) (ClusterAllocation, error) {
+	var total ClusterAllocation
+
+	ticketIDs, err := client.VM.Query().
+		Where(preds...).
+		Where(vm.TicketIDNEQ("")).
+		Select(vm.FieldTicketID).
+		Strings(ctx)
+	if err != nil {
+		return total, fmt.Errorf("query cluster vms: %w", err)
+	}
+	if len(ticketIDs) == 0 {
+		return total, nil
+	}
+
+	tickets, err := client.ApprovalTicket.Query().
+		Where(approvalticket.IDIn(ticketIDs...)).
+		Select(approvalticket.FieldID, approvalticket.FieldInstanceSizeSnapshot).
+		All(ctx)
+	if err != nil {
+		return total, fmt.Errorf("query vm tickets: %w", err)
+	}
+	snapshots := make(map[string]map[string]interface{}, len(tickets))
+	for _, t := range tickets {
+		snapshots[t.ID] = t.InstanceSizeSnapshot
+	}
+	// Iterate per VM so each running instance is counted once.
+	for _, id := range ticketIDs {
+		snapshot := snapshots[id]
+		total.CPUCores += snapshotInt(snapshot, "cpu_cores")
+		total.MemoryMB += snapshotInt(snapshot, "memory_mb")
+	}
+	return total, nil
+}
+
+func snapshotInt(snapshot map[string]interface{}, key string) int {
+	switch n := snapshot[key].(type) {
+	case int:
+		return n
+	case int64:
+		return int(n)
+	case float64:
+		return int(n)
+	default:
+		return 0
+	}
+}
+
 func validateNamespaceClusterEnvironment(namespaceEnv, clusterEnv string) error {
 	nsEnv := strings.TrimSpace(strings.ToLower(namespaceEnv))
 	clEnv := strings.TrimSpace(strings.ToLower(clusterEnv))
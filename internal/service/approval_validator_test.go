@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/cluster"
 	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
 )
 
@@ -103,3 +104,82 @@ func TestValidateNamespaceClusterEnvironment(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckClusterHealthy(t *testing.T) {
+	require.NoError(t, CheckClusterHealthy(&ent.Cluster{Name: "prod-a", Status: cluster.StatusHEALTHY}))
+
+	for _, status := range []cluster.Status{cluster.StatusUNKNOWN, cluster.StatusUNHEALTHY, cluster.StatusUNREACHABLE} {
+		err := CheckClusterHealthy(&ent.Cluster{Name: "prod-a", Status: status, HealthMessage: "readyz request: connection refused"})
+		appErr, ok := apperrors.IsAppError(err)
+		require.True(t, ok, "status %s", status)
+		require.Equal(t, apperrors.CodeClusterUnhealthy, appErr.Code)
+		require.Contains(t, appErr.Message, string(status))
+		require.Contains(t, appErr.Message, "connection refused")
+	}
+}
+
+func TestCheckClusterCapacity(t *testing.T) {
+	testCases := []struct {
+		name          string
+		capacity      ClusterCapacity
+		allocated     ClusterAllocation
+		requested     ClusterAllocation
+		wantErr       bool
+		wantResource  string
+		wantAvailable int
+	}{
+		{
+			name:      "exact fit passes",
+			capacity:  ClusterCapacity{TotalCPUCores: 16, TotalMemoryMB: 65536, CPUOvercommitRatio: 1, MemoryOvercommitRatio: 1},
+			allocated: ClusterAllocation{CPUCores: 12, MemoryMB: 49152},
+			requested: ClusterAllocation{CPUCores: 4, MemoryMB: 16384},
+		},
+		{
+			name:          "one core over capacity blocked",
+			capacity:      ClusterCapacity{TotalCPUCores: 16, TotalMemoryMB: 65536, CPUOvercommitRatio: 1, MemoryOvercommitRatio: 1},
+			allocated:     ClusterAllocation{CPUCores: 13, MemoryMB: 1024},
+			requested:     ClusterAllocation{CPUCores: 4, MemoryMB: 1024},
+			wantErr:       true,
+			wantResource:  "cpu_cores",
+			wantAvailable: 3,
+		},
+		{
+			name:      "overcommit ratio extends cpu capacity",
+			capacity:  ClusterCapacity{TotalCPUCores: 16, TotalMemoryMB: 65536, CPUOvercommitRatio: 2, MemoryOvercommitRatio: 1},
+			allocated: ClusterAllocation{CPUCores: 28, MemoryMB: 1024},
+			requested: ClusterAllocation{CPUCores: 4, MemoryMB: 1024},
+		},
+		{
+			name:          "memory exceeded despite cpu overcommit",
+			capacity:      ClusterCapacity{TotalCPUCores: 16, TotalMemoryMB: 65536, CPUOvercommitRatio: 4, MemoryOvercommitRatio: 1.5},
+			allocated:     ClusterAllocation{CPUCores: 2, MemoryMB: 90112},
+			requested:     ClusterAllocation{CPUCores: 2, MemoryMB: 16384},
+			wantErr:       true,
+			wantResource:  "memory_mb",
+			wantAvailable: 8192,
+		},
+		{
+			name:      "capacity unset is exempt",
+			capacity:  ClusterCapacity{CPUOvercommitRatio: 1, MemoryOvercommitRatio: 1},
+			allocated: ClusterAllocation{CPUCores: 1000, MemoryMB: 1 << 20},
+			requested: ClusterAllocation{CPUCores: 64, MemoryMB: 262144},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := CheckClusterCapacity("cluster-a", tc.capacity, tc.allocated, tc.requested)
+			if !tc.wantErr {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			appErr, ok := apperrors.IsAppError(err)
+			require.True(t, ok)
+			require.Equal(t, CodeClusterCapacityExceeded, appErr.Code)
+			require.Equal(t, 409, appErr.HTTPStatus)
+			require.Equal(t, tc.wantResource, appErr.Params["resource"])
+			require.Equal(t, tc.wantAvailable, appErr.Params["available"])
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/provider"
+)
+
+// clusterCapacityCacheTTL bounds how long a capacity report is reused, so an
+// approval UI polling the endpoint does not list nodes on every request.
+var clusterCapacityCacheTTL = 30 * time.Second
+
+// ClusterCapacityReport is the live capacity of a cluster as seen by approvers.
+// Allocatable figures are node allocatable minus the resources requested by
+// VMs Shepherd is still creating there.
+type ClusterCapacityReport struct {
+	TotalCPUCores       int
+	AllocatableCPUCores int
+	TotalMemoryMB       int
+	AllocatableMemoryMB int
+	VMCount             int
+	NodeCount           int
+}
+
+type capacityCacheEntry struct {
+	report    ClusterCapacityReport
+	expiresAt time.Time
+}
+
+// GetClusterCapacity lists the cluster's nodes through the provider and
+// subtracts in-flight CREATING VMs recorded in client. Reports are cached per
+// cluster for clusterCapacityCacheTTL; errors are not cached.
+func (s *VMService) GetClusterCapacity(ctx context.Context, client *ent.Client, clusterID string) (*ClusterCapacityReport, error) {
+	capacities, ok := s.infra.(provider.CapacityProvider)
+	if !ok {
+		return nil, fmt.Errorf("get cluster capacity: provider %s does not support capacity lookup", s.infra.Type())
+	}
+
+	now := time.Now()
+	s.capacityMu.Lock()
+	if entry, hit := s.capacityCache[clusterID]; hit && now.Before(entry.expiresAt) {
+		s.capacityMu.Unlock()
+		report := entry.report
+		return &report, nil
+	}
+	s.capacityMu.Unlock()
+
+	nodes, err := capacities.GetClusterNodeResources(ctx, clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("get cluster capacity: %w", err)
+	}
+	inFlight, err := vmAllocation(ctx, client, vm.ClusterIDEQ(clusterID), vm.StatusEQ(vm.StatusCREATING))
+	if err != nil {
+		return nil, fmt.Errorf("get cluster capacity: %w", err)
+	}
+	vmCount, err := client.VM.Query().
+		Where(vm.ClusterIDEQ(clusterID), vm.StatusNEQ(vm.StatusFAILED)).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("count cluster vms: %w", err)
+	}
+
+	report := ClusterCapacityReport{
+		TotalCPUCores:       nodes.TotalCPUCores,
+		AllocatableCPUCores: max(nodes.AllocatableCPUCores-inFlight.CPUCores, 0),
+		TotalMemoryMB:       nodes.TotalMemoryMB,
+		AllocatableMemoryMB: max(nodes.AllocatableMemoryMB-inFlight.MemoryMB, 0),
+		VMCount:             vmCount,
+		NodeCount:           nodes.NodeCount,
+	}
+
+	s.capacityMu.Lock()
+	defer s.capacityMu.Unlock()
+	if s.capacityCache == nil {
+		s.capacityCache = make(map[string]capacityCacheEntry)
+	}
+	for k, entry := range s.capacityCache {
+		if !now.Before(entry.expiresAt) {
+			delete(s.capacityCache, k)
+		}
+	}
+	s.capacityCache[clusterID] = capacityCacheEntry{report: report, expiresAt: now.Add(clusterCapacityCacheTTL)}
+	return &report, nil
+}
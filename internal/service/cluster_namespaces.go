@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kv-shepherd.io/shepherd/internal/provider"
+)
+
+// clusterNamespaceCacheTTL bounds how long a cluster's namespace list is
+// reused by NamespaceExistsOnCluster, so a burst of approved creates does not
+// list namespaces once per VM.
+var clusterNamespaceCacheTTL = 30 * time.Second
+
+type namespaceCacheEntry struct {
+	names     map[string]struct{}
+	expiresAt time.Time
+}
+
+// ListClusterNamespaces lists the namespaces on a cluster through the provider,
+// bypassing the cache, and refreshes the cached set for the cluster.
+func (s *VMService) ListClusterNamespaces(ctx context.Context, clusterID string) ([]string, error) {
+	namespaces, ok := s.infra.(provider.NamespaceProvider)
+	if !ok {
+		return nil, fmt.Errorf("list cluster namespaces: provider %s does not support namespace lookup", s.infra.Type())
+	}
+	names, err := namespaces.ListNamespaces(ctx, clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("list cluster namespaces: %w", err)
+	}
+	s.cacheClusterNamespaces(clusterID, names)
+	return names, nil
+}
+
+// NamespaceExistsOnCluster reports whether namespace exists on the cluster.
+// Positive answers are served from a per-cluster cache for
+// clusterNamespaceCacheTTL; a miss always re-lists, so a namespace created
+// moments ago is not reported missing. Providers without namespace listing
+// report every namespace as present.
+func (s *VMService) NamespaceExistsOnCluster(ctx context.Context, clusterID, namespace string) (bool, error) {
+	if _, ok := s.infra.(provider.NamespaceProvider); !ok {
+		return true, nil
+	}
+
+	s.namespaceMu.Lock()
+	entry, hit := s.namespaceCache[clusterID]
+	s.namespaceMu.Unlock()
+	if hit && time.Now().Before(entry.expiresAt) {
+		if _, exists := entry.names[namespace]; exists {
+			return true, nil
+		}
+	}
+
+	names, err := s.ListClusterNamespaces(ctx, clusterID)
+	if err != nil {
+		return false, err
+	}
+	for _, name := range names {
+		if name == namespace {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *VMService) cacheClusterNamespaces(clusterID string, names []string) {
+	set := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		set[name] = struct{}{}
+	}
+
+	now := time.Now()
+	s.namespaceMu.Lock()
+	defer s.namespaceMu.Unlock()
+	if s.namespaceCache == nil {
+		s.namespaceCache = make(map[string]namespaceCacheEntry)
+	}
+	for k, entry := range s.namespaceCache {
+		if !now.Before(entry.expiresAt) {
+			delete(s.namespaceCache, k)
+		}
+	}
+	s.namespaceCache[clusterID] = namespaceCacheEntry{names: set, expiresAt: now.Add(clusterNamespaceCacheTTL)}
+}
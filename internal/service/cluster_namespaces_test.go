@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"kv-shepherd.io/shepherd/internal/provider"
+)
+
+type countingNamespaceProvider struct {
+	*provider.MockProvider
+	lists int
+}
+
+func (p *countingNamespaceProvider) ListNamespaces(ctx context.Context, cluster string) ([]string, error) {
+	p.lists++
+	return p.MockProvider.ListNamespaces(ctx, cluster)
+}
+
+func TestNamespaceExistsOnCluster(t *testing.T) {
+	mock := &countingNamespaceProvider{MockProvider: provider.NewMockProvider()}
+	mock.SeedNamespaces("prod-shop")
+	svc := NewVMService(mock)
+
+	exists, err := svc.NamespaceExistsOnCluster(t.Context(), "cluster-a", "prod-shop")
+	require.NoError(t, err)
+	require.True(t, exists)
+	exists, err = svc.NamespaceExistsOnCluster(t.Context(), "cluster-a", "prod-shop")
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.Equal(t, 1, mock.lists, "positive answer should be served from cache")
+
+	// A miss re-lists, so a namespace created after the first lookup is found.
+	mock.SeedNamespaces("prod-shop", "prod-new")
+	exists, err = svc.NamespaceExistsOnCluster(t.Context(), "cluster-a", "prod-new")
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.Equal(t, 2, mock.lists)
+
+	exists, err = svc.NamespaceExistsOnCluster(t.Context(), "cluster-a", "prod-gone")
+	require.NoError(t, err)
+	require.False(t, exists)
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/loginsession"
+)
+
+// loginSessionUserAgentMaxLen mirrors the LoginSession.user_agent column limit.
+const loginSessionUserAgentMaxLen = 512
+
+// LoginSessionStore records issued login tokens and answers revocation
+// checks for the JWT middleware (middleware.TokenRevocationChecker).
+type LoginSessionStore struct {
+	client *ent.Client
+}
+
+// NewLoginSessionStore creates a LoginSessionStore.
+func NewLoginSessionStore(client *ent.Client) *LoginSessionStore {
+	return &LoginSessionStore{client: client}
+}
+
+// Record stores a login session for the token tokenID.
+func (s *LoginSessionStore) Record(ctx context.Context, tokenID, userID string, expiresAt time.Time, userAgent, ipAddress string) error {
+	if len(userAgent) > loginSessionUserAgentMaxLen {
+		userAgent = userAgent[:loginSessionUserAgentMaxLen]
+	}
+	if err := s.client.LoginSession.Create().
+		SetID(tokenID).
+		SetUserID(userID).
+		SetExpiresAt(expiresAt.UTC()).
+		SetUserAgent(userAgent).
+		SetIPAddress(ipAddress).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("record login session: %w", err)
+	}
+	return nil
+}
+
+// IsRevoked reports whether the session for tokenID was revoked. Tokens
+// without a session row are not revoked.
+func (s *LoginSessionStore) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	return s.client.LoginSession.Query().
+		Where(
+			loginsession.IDEQ(tokenID),
+			loginsession.RevokedAtNotNil(),
+		).
+		Exist(ctx)
+}
+
+// RevokeUserSessions revokes every active session of userID except
+// exceptTokenID (empty revokes all) and returns the revoked token IDs.
+func (s *LoginSessionStore) RevokeUserSessions(ctx context.Context, userID, actor, exceptTokenID string) ([]string, error) {
+	now := time.Now().UTC()
+	query := s.client.LoginSession.Query().
+		Where(
+			loginsession.UserIDEQ(userID),
+			loginsession.RevokedAtIsNil(),
+			loginsession.ExpiresAtGT(now),
+		)
+	if exceptTokenID != "" {
+		query = query.Where(loginsession.IDNEQ(exceptTokenID))
+	}
+	ids, err := query.IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list active login sessions: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	if _, err := s.client.LoginSession.Update().
+		Where(
+			loginsession.IDIn(ids...),
+			loginsession.RevokedAtIsNil(),
+		).
+		SetRevokedAt(now).
+		SetRevokedBy(actor).
+		Save(ctx); err != nil {
+		return nil, fmt.Errorf("revoke login sessions: %w", err)
+	}
+	return ids, nil
+}
+
+// RevokeUserTokensBefore revokes every token of userID issued before
+// before, whether or not it has a session row. Tokens carry whole-second
+// issue times, so before is truncated to the second: tokens issued within
+// that second stay valid and must be revoked by session row.
+func (s *LoginSessionStore) RevokeUserTokensBefore(ctx context.Context, userID, actor string, before time.Time) error {
+	before = before.UTC().Truncate(time.Second)
+	row, err := s.client.RevokedSession.Get(ctx, userID)
+	if ent.IsNotFound(err) {
+		err = s.client.RevokedSession.Create().
+			SetID(userID).
+			SetRevokedBefore(before).
+			SetRevokedBy(actor).
+			Exec(ctx)
+		if !ent.IsConstraintError(err) {
+			if err != nil {
+				return fmt.Errorf("create revoked session %s: %w", userID, err)
+			}
+			return nil
+		}
+		// Created concurrently: move that row forward instead.
+		row, err = s.client.RevokedSession.Get(ctx, userID)
+	}
+	if err != nil {
+		return fmt.Errorf("get revoked session %s: %w", userID, err)
+	}
+	if !before.After(row.RevokedBefore) {
+		return nil
+	}
+	if err := s.client.RevokedSession.UpdateOneID(userID).
+		SetRevokedBefore(before).
+		SetRevokedBy(actor).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("update revoked session %s: %w", userID, err)
+	}
+	return nil
+}
+
+// UserTokensRevokedBefore returns the time before which tokens of userID are
+// revoked, or the zero time when none are.
+func (s *LoginSessionStore) UserTokensRevokedBefore(ctx context.Context, userID string) (time.Time, error) {
+	row, err := s.client.RevokedSession.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, fmt.Errorf("get revoked session %s: %w", userID, err)
+	}
+	return row.RevokedBefore, nil
+}
+
+// ListActive returns the unexpired, unrevoked sessions of userID, newest first.
+func (s *LoginSessionStore) ListActive(ctx context.Context, userID string) ([]*ent.LoginSession, error) {
+	sessions, err := s.client.LoginSession.Query().
+		Where(
+			loginsession.UserIDEQ(userID),
+			loginsession.RevokedAtIsNil(),
+			loginsession.ExpiresAtGT(time.Now().UTC()),
+		).
+		Order(ent.Desc(loginsession.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list login sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+// Revoke revokes the session tokenID owned by userID. It returns false when
+// the user has no such session; revoking an already revoked one succeeds.
+func (s *LoginSessionStore) Revoke(ctx context.Context, userID, tokenID, actor string) (bool, error) {
+	session, err := s.client.LoginSession.Query().
+		Where(
+			loginsession.IDEQ(tokenID),
+			loginsession.UserIDEQ(userID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("get login session: %w", err)
+	}
+	if session.RevokedAt != nil {
+		return true, nil
+	}
+	if err := s.client.LoginSession.UpdateOneID(tokenID).
+		SetRevokedAt(time.Now().UTC()).
+		SetRevokedBy(actor).
+		Exec(ctx); err != nil {
+		return false, fmt.Errorf("revoke login session: %w", err)
+	}
+	return true, nil
+}
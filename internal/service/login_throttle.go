@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/loginthrottle"
+)
+
+const (
+	// DefaultLoginMaxFailures is the number of consecutive failed logins for
+	// one username that locks it.
+	DefaultLoginMaxFailures = 5
+	// DefaultLoginLockoutDuration is how long a lockout lasts. Failures older
+	// than it no longer count towards a lockout.
+	DefaultLoginLockoutDuration = 15 * time.Minute
+	// DefaultLoginIPMaxFailures is the number of consecutive failed logins
+	// from one source IP, across usernames, that throttles the IP.
+	DefaultLoginIPMaxFailures = 20
+)
+
+// LoginLockoutPolicy configures LoginThrottle. Non-positive values use the
+// defaults above.
+type LoginLockoutPolicy struct {
+	MaxFailures   int
+	Duration      time.Duration
+	IPMaxFailures int
+}
+
+func (p LoginLockoutPolicy) withDefaults() LoginLockoutPolicy {
+	if p.MaxFailures <= 0 {
+		p.MaxFailures = DefaultLoginMaxFailures
+	}
+	if p.Duration <= 0 {
+		p.Duration = DefaultLoginLockoutDuration
+	}
+	if p.IPMaxFailures <= 0 {
+		p.IPMaxFailures = DefaultLoginIPMaxFailures
+	}
+	return p
+}
+
+// LoginLock describes an active lockout.
+type LoginLock struct {
+	// IP is true when the source IP, not the username, is locked.
+	IP          bool
+	LockedUntil time.Time
+}
+
+// LoginFailure is the outcome of recording a failed login.
+type LoginFailure struct {
+	// UserLocked and IPLocked report lockouts started by this failure.
+	UserLocked bool
+	IPLocked   bool
+	// Lock is the lockout now in force, if any.
+	Lock *LoginLock
+}
+
+// LoginThrottle locks local login for a username or source IP after
+// repeated failures. State lives in the login_throttles table so every
+// replica enforces the same lockouts.
+type LoginThrottle struct {
+	client *ent.Client
+	policy LoginLockoutPolicy
+	now    func() time.Time
+}
+
+// NewLoginThrottle creates a LoginThrottle.
+func NewLoginThrottle(client *ent.Client, policy LoginLockoutPolicy) *LoginThrottle {
+	return &LoginThrottle{client: client, policy: policy.withDefaults(), now: time.Now}
+}
+
+// Policy returns the effective lockout policy.
+func (t *LoginThrottle) Policy() LoginLockoutPolicy {
+	return t.policy
+}
+
+func loginThrottleUserKey(username string) string { return "user:" + username }
+
+func loginThrottleIPKey(ip string) string { return "ip:" + ip }
+
+// Check returns the lockout blocking a login for username from ip, or nil.
+func (t *LoginThrottle) Check(ctx context.Context, username, ip string) (*LoginLock, error) {
+	now := t.now()
+	until, err := t.lockedUntil(ctx, loginThrottleUserKey(username), now)
+	if err != nil {
+		return nil, err
+	}
+	if until != nil {
+		return &LoginLock{LockedUntil: *until}, nil
+	}
+	if ip == "" {
+		return nil, nil
+	}
+	if until, err = t.lockedUntil(ctx, loginThrottleIPKey(ip), now); err != nil || until == nil {
+		return nil, err
+	}
+	return &LoginLock{IP: true, LockedUntil: *until}, nil
+}
+
+// RecordFailure counts a failed login for username and ip.
+func (t *LoginThrottle) RecordFailure(ctx context.Context, username, ip string) (LoginFailure, error) {
+	var result LoginFailure
+	now := t.now()
+	userUntil, err := t.recordFailure(ctx, loginThrottleUserKey(username), t.policy.MaxFailures, now)
+	if err != nil {
+		return result, err
+	}
+	if userUntil != nil {
+		result.UserLocked = true
+		result.Lock = &LoginLock{LockedUntil: *userUntil}
+	}
+	if ip == "" {
+		return result, nil
+	}
+	ipUntil, err := t.recordFailure(ctx, loginThrottleIPKey(ip), t.policy.IPMaxFailures, now)
+	if err != nil {
+		return result, err
+	}
+	if ipUntil != nil {
+		result.IPLocked = true
+		if result.Lock == nil {
+			result.Lock = &LoginLock{IP: true, LockedUntil: *ipUntil}
+		}
+	}
+	return result, nil
+}
+
+// Reset clears the failure counter of username after a successful login.
+func (t *LoginThrottle) Reset(ctx context.Context, username string) error {
+	if _, err := t.client.LoginThrottle.Delete().
+		Where(loginthrottle.IDEQ(loginThrottleUserKey(username))).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("reset login failures: %w", err)
+	}
+	return nil
+}
+
+// Unlock clears the lockout and failure counter of username. It reports
+// whether a lockout was in force.
+func (t *LoginThrottle) Unlock(ctx context.Context, username string) (bool, error) {
+	key := loginThrottleUserKey(username)
+	until, err := t.lockedUntil(ctx, key, t.now())
+	if err != nil {
+		return false, err
+	}
+	if err := t.Reset(ctx, username); err != nil {
+		return false, err
+	}
+	return until != nil, nil
+}
+
+func (t *LoginThrottle) lockedUntil(ctx context.Context, key string, now time.Time) (*time.Time, error) {
+	row, err := t.client.LoginThrottle.Get(ctx, key)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get login throttle %s: %w", key, err)
+	}
+	if row.LockedUntil == nil || !row.LockedUntil.After(now) {
+		return nil, nil
+	}
+	return row.LockedUntil, nil
+}
+
+// recordFailure counts one failure for key and returns the lockout it
+// started, if the count reached threshold.
+func (t *LoginThrottle) recordFailure(ctx context.Context, key string, threshold int, now time.Time) (*time.Time, error) {
+	row, err := t.client.LoginThrottle.Get(ctx, key)
+	if ent.IsNotFound(err) {
+		row, err = t.client.LoginThrottle.Create().
+			SetID(key).
+			SetFailures(1).
+			SetLastFailureAt(now).
+			Save(ctx)
+		if ent.IsConstraintError(err) {
+			// Created concurrently: count this failure on that row.
+			row, err = t.client.LoginThrottle.Get(ctx, key)
+		} else if err == nil {
+			return t.lockIfReached(ctx, row, threshold, now)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get login throttle %s: %w", key, err)
+	}
+	if row.LockedUntil != nil && row.LockedUntil.After(now) {
+		return nil, nil
+	}
+
+	update := t.client.LoginThrottle.UpdateOneID(key).SetLastFailureAt(now)
+	if row.LockedUntil != nil || now.Sub(row.LastFailureAt) >= t.policy.Duration {
+		// The previous lockout ended or the failures went stale: start over.
+		update = update.SetFailures(1).ClearLockedUntil()
+	} else {
+		update = update.AddFailures(1)
+	}
+	if row, err = update.Save(ctx); err != nil {
+		return nil, fmt.Errorf("record login failure %s: %w", key, err)
+	}
+	return t.lockIfReached(ctx, row, threshold, now)
+}
+
+func (t *LoginThrottle) lockIfReached(ctx context.Context, row *ent.LoginThrottle, threshold int, now time.Time) (*time.Time, error) {
+	if row.Failures < threshold {
+		return nil, nil
+	}
+	until := now.Add(t.policy.Duration)
+	if err := t.client.LoginThrottle.UpdateOneID(row.ID).
+		SetLockedUntil(until).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("lock %s: %w", row.ID, err)
+	}
+	return &until, nil
+}
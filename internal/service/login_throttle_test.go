@@ -0,0 +1,96 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestLoginThrottle_LockoutExpiryAndReset(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "login_throttle")
+	ctx := t.Context()
+	throttle := NewLoginThrottle(client, LoginLockoutPolicy{MaxFailures: 3, Duration: 15 * time.Minute, IPMaxFailures: 5})
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	throttle.now = func() time.Time { return now }
+
+	fail := func(username, ip string) LoginFailure {
+		t.Helper()
+		failure, err := throttle.RecordFailure(ctx, username, ip)
+		if err != nil {
+			t.Fatalf("RecordFailure() error = %v", err)
+		}
+		return failure
+	}
+	check := func(username, ip string) *LoginLock {
+		t.Helper()
+		lock, err := throttle.Check(ctx, username, ip)
+		if err != nil {
+			t.Fatalf("Check() error = %v", err)
+		}
+		return lock
+	}
+
+	// A success between failures resets the counter.
+	fail("alice", "10.0.0.1")
+	fail("alice", "10.0.0.1")
+	if err := throttle.Reset(ctx, "alice"); err != nil {
+		t.Fatalf("Reset() error = %v", err)
+	}
+	if failure := fail("alice", "10.0.0.2"); failure.UserLocked {
+		t.Fatal("locked after one failure following a reset")
+	}
+
+	// Failures older than the lockout duration stop counting.
+	now = now.Add(16 * time.Minute)
+	fail("alice", "10.0.0.2")
+	if failure := fail("alice", "10.0.0.2"); failure.UserLocked {
+		t.Fatal("stale failure counted towards the lockout")
+	}
+	failure := fail("alice", "10.0.0.2")
+	if !failure.UserLocked || failure.Lock == nil || failure.Lock.IP {
+		t.Fatalf("third consecutive failure = %+v, want username locked", failure)
+	}
+	if want := now.Add(15 * time.Minute); !failure.Lock.LockedUntil.Equal(want) {
+		t.Fatalf("locked until %v, want %v", failure.Lock.LockedUntil, want)
+	}
+	if lock := check("alice", "10.0.0.9"); lock == nil || lock.IP {
+		t.Fatalf("Check() = %+v, want username lock from any IP", lock)
+	}
+
+	// The lockout expires and the next failure starts a fresh count.
+	now = now.Add(15 * time.Minute)
+	if lock := check("alice", "10.0.0.9"); lock != nil {
+		t.Fatalf("Check() after expiry = %+v, want unlocked", lock)
+	}
+	if failure := fail("alice", "10.0.0.9"); failure.UserLocked {
+		t.Fatal("locked again by the first failure after expiry")
+	}
+
+	// The source IP is throttled across usernames.
+	for i, username := range []string{"u1", "u2", "u3", "u4"} {
+		if failure := fail(username, "10.0.0.3"); failure.IPLocked {
+			t.Fatalf("IP locked after %d failures, want 5", i+1)
+		}
+	}
+	if failure := fail("u5", "10.0.0.3"); !failure.IPLocked || failure.Lock == nil || !failure.Lock.IP {
+		t.Fatalf("fifth failure from one IP = %+v, want IP locked", failure)
+	}
+	if lock := check("bob", "10.0.0.3"); lock == nil || !lock.IP {
+		t.Fatalf("Check() = %+v, want IP lock for any username", lock)
+	}
+
+	// Unlock clears the username lockout early.
+	fail("carol", "")
+	fail("carol", "")
+	fail("carol", "")
+	unlocked, err := throttle.Unlock(ctx, "carol")
+	if err != nil || !unlocked {
+		t.Fatalf("Unlock() = %v, %v, want true", unlocked, err)
+	}
+	if lock := check("carol", ""); lock != nil {
+		t.Fatalf("Check() after unlock = %+v, want unlocked", lock)
+	}
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/passwordhistory"
+)
+
+// PasswordHistory remembers the last size password hashes of each local
+// user.
+type PasswordHistory struct {
+	client *ent.Client
+	size   int
+}
+
+// NewPasswordHistory creates a PasswordHistory keeping size hashes per user;
+// non-positive size uses DefaultPasswordHistorySize.
+func NewPasswordHistory(client *ent.Client, size int) *PasswordHistory {
+	if size <= 0 {
+		size = DefaultPasswordHistorySize
+	}
+	return &PasswordHistory{client: client, size: size}
+}
+
+// Contains reports whether password matches one of the user's remembered
+// hashes or currentHash, which covers users whose password predates the
+// history.
+func (h *PasswordHistory) Contains(ctx context.Context, userID, password, currentHash string) (bool, error) {
+	entries, err := h.client.PasswordHistory.Query().
+		Where(passwordhistory.UserIDEQ(userID)).
+		Order(ent.Desc(passwordhistory.FieldCreatedAt), ent.Desc(passwordhistory.FieldID)).
+		Limit(h.size).
+		All(ctx)
+	if err != nil {
+		return false, fmt.Errorf("list password history: %w", err)
+	}
+	hashes := make([]string, 0, len(entries)+1)
+	if currentHash != "" {
+		hashes = append(hashes, currentHash)
+	}
+	for _, e := range entries {
+		if e.PasswordHash != currentHash {
+			hashes = append(hashes, e.PasswordHash)
+		}
+	}
+	for _, hash := range hashes {
+		if bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Record remembers hash as the user's newest password and forgets hashes
+// beyond the history size.
+func (h *PasswordHistory) Record(ctx context.Context, userID, hash string) error {
+	id, _ := uuid.NewV7()
+	if err := h.client.PasswordHistory.Create().
+		SetID(id.String()).
+		SetUserID(userID).
+		SetPasswordHash(hash).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("record password history: %w", err)
+	}
+	// UUIDv7 IDs sort by creation, breaking created_at ties.
+	keep, err := h.client.PasswordHistory.Query().
+		Where(passwordhistory.UserIDEQ(userID)).
+		Order(ent.Desc(passwordhistory.FieldCreatedAt), ent.Desc(passwordhistory.FieldID)).
+		Limit(h.size).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("list password history: %w", err)
+	}
+	if _, err := h.client.PasswordHistory.Delete().
+		Where(
+			passwordhistory.UserIDEQ(userID),
+			passwordhistory.IDNotIn(keep...),
+		).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("prune password history: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"kv-shepherd.io/shepherd/ent/passwordhistory"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestPasswordHistory_ContainsAndPrune(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "password_history")
+	ctx := t.Context()
+	history := NewPasswordHistory(client, 2)
+
+	hash := func(password string) string {
+		t.Helper()
+		h, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+		if err != nil {
+			t.Fatalf("hash password: %v", err)
+		}
+		return string(h)
+	}
+	contains := func(password, currentHash string) bool {
+		t.Helper()
+		found, err := history.Contains(ctx, "user-1", password, currentHash)
+		if err != nil {
+			t.Fatalf("Contains() error = %v", err)
+		}
+		return found
+	}
+
+	// The current password counts even before anything is recorded.
+	current := hash("first-passphrase")
+	if !contains("first-passphrase", current) {
+		t.Fatal("current password not reported as used")
+	}
+
+	for _, password := range []string{"first-passphrase", "second-passphrase", "third-passphrase"} {
+		if err := history.Record(ctx, "user-1", hash(password)); err != nil {
+			t.Fatalf("Record(%q) error = %v", password, err)
+		}
+	}
+	if n := client.PasswordHistory.Query().Where(passwordhistory.UserIDEQ("user-1")).CountX(ctx); n != 2 {
+		t.Fatalf("history rows = %d, want 2 after pruning", n)
+	}
+	if !contains("second-passphrase", "") || !contains("third-passphrase", "") {
+		t.Fatal("recent passwords not reported as used")
+	}
+	if contains("first-passphrase", "") {
+		t.Fatal("pruned password still reported as used")
+	}
+	if found, err := history.Contains(ctx, "user-2", "third-passphrase", ""); err != nil || found {
+		t.Fatalf("Contains() for another user = %v, %v; want false", found, err)
+	}
+}
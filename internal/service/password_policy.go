@@ -0,0 +1,168 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Password policy modes (docs/design/phases/00-prerequisites.md).
+const (
+	// PasswordModeNIST follows NIST SP 800-63B: length and blocklist rules,
+	// no composition rules.
+	PasswordModeNIST = "nist"
+	// PasswordModeLegacy additionally enforces the configured character
+	// classes.
+	PasswordModeLegacy = "legacy"
+)
+
+const (
+	DefaultPasswordMinLength   = 8
+	DefaultPasswordMaxLength   = 64
+	DefaultPasswordHistorySize = 5
+
+	// bcryptMaxPasswordBytes is the longest input bcrypt accepts.
+	bcryptMaxPasswordBytes = 72
+	// minUserInfoMatchLen keeps very short usernames from rejecting most
+	// passwords.
+	minUserInfoMatchLen = 3
+)
+
+// Password policy violation codes, returned as field error codes.
+const (
+	PasswordRuleMinLength        = "min_length"
+	PasswordRuleMaxLength        = "max_length"
+	PasswordRuleBlocklisted      = "blocklisted"
+	PasswordRuleRequireUppercase = "require_uppercase"
+	PasswordRuleRequireLowercase = "require_lowercase"
+	PasswordRuleRequireDigit     = "require_digit"
+	PasswordRuleRequireSpecial   = "require_special"
+	PasswordRuleContainsUsername = "contains_username"
+	PasswordRuleContainsEmail    = "contains_email"
+)
+
+// commonPasswords is a short blocklist of the most guessed passwords
+// (NIST SP 800-63B §3.1.1.2). Entries are lower case.
+var commonPasswords = map[string]struct{}{
+	"password": {}, "password1": {}, "password123": {}, "passw0rd": {},
+	"12345678": {}, "123456789": {}, "1234567890": {}, "87654321": {},
+	"qwertyui": {}, "qwerty123": {}, "iloveyou": {}, "11111111": {},
+	"00000000": {}, "abc12345": {}, "letmein1": {}, "welcome1": {},
+	"admin123": {}, "administrator": {}, "changeme": {}, "shepherd": {},
+}
+
+// PasswordPolicy is the server-side password policy for local users.
+type PasswordPolicy struct {
+	Mode             string
+	MinLength        int
+	MaxLength        int
+	RequireUppercase bool
+	RequireLowercase bool
+	RequireDigit     bool
+	RequireSpecial   bool
+	// RejectUserInfo rejects passwords containing the username or email.
+	RejectUserInfo bool
+	// HistorySize is how many recent passwords a change may not reuse.
+	HistorySize int
+}
+
+// DefaultPasswordPolicy returns the NIST policy used when none is configured.
+func DefaultPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{
+		Mode:           PasswordModeNIST,
+		MinLength:      DefaultPasswordMinLength,
+		MaxLength:      DefaultPasswordMaxLength,
+		RejectUserInfo: true,
+		HistorySize:    DefaultPasswordHistorySize,
+	}
+}
+
+// WithDefaults fills unset limits from DefaultPasswordPolicy. A non-positive
+// HistorySize keeps its default; the mode falls back to NIST unless legacy.
+func (p PasswordPolicy) WithDefaults() PasswordPolicy {
+	if p.Mode != PasswordModeLegacy {
+		p.Mode = PasswordModeNIST
+	}
+	if p.MinLength <= 0 {
+		p.MinLength = DefaultPasswordMinLength
+	}
+	if p.MaxLength <= 0 {
+		p.MaxLength = DefaultPasswordMaxLength
+	}
+	if p.HistorySize <= 0 {
+		p.HistorySize = DefaultPasswordHistorySize
+	}
+	return p
+}
+
+// PasswordViolation is one failed policy rule.
+type PasswordViolation struct {
+	Code    string
+	Message string
+}
+
+// Validate checks password against the policy for the user identified by
+// username and email (either may be empty) and returns every failed rule.
+func (p PasswordPolicy) Validate(password, username, email string) []PasswordViolation {
+	var violations []PasswordViolation
+	add := func(code, format string, args ...any) {
+		violations = append(violations, PasswordViolation{Code: code, Message: fmt.Sprintf(format, args...)})
+	}
+
+	length := utf8.RuneCountInString(password)
+	if length < p.MinLength {
+		add(PasswordRuleMinLength, "must be at least %d characters", p.MinLength)
+	}
+	if length > p.MaxLength || len(password) > bcryptMaxPasswordBytes {
+		add(PasswordRuleMaxLength, "must be at most %d characters (%d bytes)", p.MaxLength, bcryptMaxPasswordBytes)
+	}
+	lower := strings.ToLower(password)
+	if _, blocked := commonPasswords[lower]; blocked {
+		add(PasswordRuleBlocklisted, "is a commonly used password")
+	}
+
+	if p.Mode == PasswordModeLegacy {
+		var upper, lowerCase, digit, special bool
+		for _, r := range password {
+			switch {
+			case unicode.IsUpper(r):
+				upper = true
+			case unicode.IsLower(r):
+				lowerCase = true
+			case unicode.IsDigit(r):
+				digit = true
+			case !unicode.IsSpace(r):
+				special = true
+			}
+		}
+		if p.RequireUppercase && !upper {
+			add(PasswordRuleRequireUppercase, "must contain an uppercase letter")
+		}
+		if p.RequireLowercase && !lowerCase {
+			add(PasswordRuleRequireLowercase, "must contain a lowercase letter")
+		}
+		if p.RequireDigit && !digit {
+			add(PasswordRuleRequireDigit, "must contain a digit")
+		}
+		if p.RequireSpecial && !special {
+			add(PasswordRuleRequireSpecial, "must contain a special character")
+		}
+	}
+
+	if p.RejectUserInfo {
+		if containsUserInfo(lower, username) {
+			add(PasswordRuleContainsUsername, "must not contain the username")
+		}
+		localPart, _, _ := strings.Cut(email, "@")
+		if containsUserInfo(lower, email) || containsUserInfo(lower, localPart) {
+			add(PasswordRuleContainsEmail, "must not contain the email address")
+		}
+	}
+	return violations
+}
+
+func containsUserInfo(lowerPassword, info string) bool {
+	info = strings.ToLower(strings.TrimSpace(info))
+	return utf8.RuneCountInString(info) >= minUserInfoMatchLen && strings.Contains(lowerPassword, info)
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestPasswordPolicy_Validate(t *testing.T) {
+	t.Parallel()
+
+	nist := DefaultPasswordPolicy()
+	legacy := PasswordPolicy{
+		Mode:             PasswordModeLegacy,
+		RequireUppercase: true,
+		RequireLowercase: true,
+		RequireDigit:     true,
+		RequireSpecial:   true,
+	}.WithDefaults()
+
+	tests := []struct {
+		name     string
+		policy   PasswordPolicy
+		password string
+		username string
+		email    string
+		want     []string
+	}{
+		{name: "valid passphrase", policy: nist, password: "correct horse battery", username: "alice", email: "alice@example.com"},
+		{name: "too short", policy: nist, password: "Ab1!xyz", want: []string{PasswordRuleMinLength}},
+		{name: "too long", policy: nist, password: strings.Repeat("a", 65), want: []string{PasswordRuleMaxLength}},
+		{name: "over bcrypt byte limit", policy: PasswordPolicy{MaxLength: 100}.WithDefaults(), password: strings.Repeat("é", 40), want: []string{PasswordRuleMaxLength}},
+		{name: "blocklisted any case", policy: nist, password: "PassWord123", want: []string{PasswordRuleBlocklisted}},
+		{name: "contains username", policy: nist, password: "my-alice-secret", username: "Alice", want: []string{PasswordRuleContainsUsername}},
+		{name: "short username ignored", policy: nist, password: "jo-secret-phrase", username: "jo"},
+		{name: "contains email local part", policy: nist, password: "bob.smith-2026!", email: "bob.smith@example.com", want: []string{PasswordRuleContainsEmail}},
+		{name: "user info allowed", policy: PasswordPolicy{}.WithDefaults(), password: "my-alice-secret", username: "alice"},
+		{name: "nist ignores classes", policy: PasswordPolicy{Mode: PasswordModeNIST, RequireDigit: true}.WithDefaults(), password: "no digits here"},
+		{name: "legacy valid", policy: legacy, password: "Tr0ub4dor&3"},
+		{name: "legacy missing uppercase", policy: legacy, password: "tr0ub4dor&3", want: []string{PasswordRuleRequireUppercase}},
+		{name: "legacy missing lowercase", policy: legacy, password: "TR0UB4DOR&3", want: []string{PasswordRuleRequireLowercase}},
+		{name: "legacy missing digit", policy: legacy, password: "Troubador&x", want: []string{PasswordRuleRequireDigit}},
+		{name: "legacy missing special", policy: legacy, password: "Tr0ub4dor33", want: []string{PasswordRuleRequireSpecial}},
+		{name: "every failure reported", policy: legacy, password: "abc", want: []string{PasswordRuleMinLength, PasswordRuleRequireUppercase, PasswordRuleRequireDigit, PasswordRuleRequireSpecial}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var got []string
+			for _, v := range tt.policy.Validate(tt.password, tt.username, tt.email) {
+				got = append(got, v.Code)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("Validate() codes = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPasswordPolicy_WithDefaults(t *testing.T) {
+	t.Parallel()
+
+	got := PasswordPolicy{Mode: "bogus", MinLength: 12}.WithDefaults()
+	if got.Mode != PasswordModeNIST || got.MinLength != 12 || got.MaxLength != DefaultPasswordMaxLength || got.HistorySize != DefaultPasswordHistorySize {
+		t.Fatalf("WithDefaults() = %+v", got)
+	}
+}
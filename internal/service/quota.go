@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/predicate"
+	"kv-shepherd.io/shepherd/ent/quota"
+	entservice "kv-shepherd.io/shepherd/ent/service"
+	entsystem "kv-shepherd.io/shepherd/ent/system"
+	"kv-shepherd.io/shepherd/ent/vm"
+	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
+)
+
+// QuotaUsage is the VM count and aggregate resources held in a quota scope.
+type QuotaUsage struct {
+	VMs      int
+	CPUCores int
+	MemoryMB int
+}
+
+// QuotaDemand is a single VM a create request or approval would add.
+type QuotaDemand struct {
+	Namespace string
+	ServiceID string
+	CPUCores  int
+	MemoryMB  int
+}
+
+// QuotaViolation describes one quota limit a set of demands would exceed.
+type QuotaViolation struct {
+	ScopeType string
+	ScopeID   string
+	Resource  string
+	Current   int
+	Requested int
+	Limit     int
+}
+
+// Params returns the violation as error/warning params.
+func (v QuotaViolation) Params() map[string]interface{} {
+	return map[string]interface{}{
+		"scope_type": v.ScopeType,
+		"scope_id":   v.ScopeID,
+		"resource":   v.Resource,
+		"current":    v.Current,
+		"requested":  v.Requested,
+		"limit":      v.Limit,
+	}
+}
+
+// Message returns a human-readable description of the violation.
+func (v QuotaViolation) Message() string {
+	return fmt.Sprintf("%s %s quota exceeded for %s: current %d + requested %d > limit %d",
+		v.ScopeType, v.ScopeID, v.Resource, v.Current, v.Requested, v.Limit)
+}
+
+// QuotaChecker evaluates system and namespace quotas against current usage.
+type QuotaChecker struct {
+	client *ent.Client
+}
+
+// NewQuotaChecker creates a new QuotaChecker.
+func NewQuotaChecker(client *ent.Client) *QuotaChecker {
+	return &QuotaChecker{client: client}
+}
+
+// Usage returns what is counted against the quota scope: every non-FAILED VM
+// with the resources of its approval snapshot. VM rows are inserted in the
+// approval transaction with status CREATING, so tickets that are approved
+// but not yet provisioned are counted through them.
+func (q *QuotaChecker) Usage(ctx context.Context, scopeType quota.ScopeType, scopeID string) (QuotaUsage, error) {
+	var scope predicate.VM
+	switch scopeType {
+	case quota.ScopeTypeNamespace:
+		scope = vm.NamespaceEQ(scopeID)
+	case quota.ScopeTypeSystem:
+		scope = vm.HasServiceWith(entservice.HasSystemWith(entsystem.IDEQ(scopeID)))
+	default:
+		return QuotaUsage{}, fmt.Errorf("unknown quota scope type %q", scopeType)
+	}
+	preds := []predicate.VM{scope, vm.StatusNEQ(vm.StatusFAILED)}
+
+	count, err := q.client.VM.Query().Where(preds...).Count(ctx)
+	if err != nil {
+		return QuotaUsage{}, fmt.Errorf("count %s %s vms: %w", scopeType, scopeID, err)
+	}
+	alloc, err := vmAllocation(ctx, q.client, preds...)
+	if err != nil {
+		return QuotaUsage{}, err
+	}
+	return QuotaUsage{VMs: count, CPUCores: alloc.CPUCores, MemoryMB: alloc.MemoryMB}, nil
+}
+
+// Violations checks demands against the quotas of their namespaces and the
+// systems owning their services. Demands sharing a scope are summed, so a
+// batch is evaluated cumulatively rather than child by child.
+func (q *QuotaChecker) Violations(ctx context.Context, demands ...QuotaDemand) ([]QuotaViolation, error) {
+	if len(demands) == 0 {
+		return nil, nil
+	}
+
+	serviceIDs := make([]string, 0, len(demands))
+	for _, d := range demands {
+		if d.ServiceID != "" {
+			serviceIDs = append(serviceIDs, d.ServiceID)
+		}
+	}
+	systemOf := make(map[string]string, len(serviceIDs))
+	if len(serviceIDs) > 0 {
+		services, err := q.client.Service.Query().
+			Where(entservice.IDIn(serviceIDs...)).
+			WithSystem().
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("query services for quota: %w", err)
+		}
+		for _, svc := range services {
+			if svc.Edges.System != nil {
+				systemOf[svc.ID] = svc.Edges.System.ID
+			}
+		}
+	}
+
+	type scopeKey struct {
+		scopeType quota.ScopeType
+		scopeID   string
+	}
+	requested := make(map[scopeKey]QuotaUsage)
+	add := func(key scopeKey, d QuotaDemand) {
+		sum := requested[key]
+		sum.VMs++
+		sum.CPUCores += d.CPUCores
+		sum.MemoryMB += d.MemoryMB
+		requested[key] = sum
+	}
+	var namespaces, systems []string
+	for _, d := range demands {
+		if d.Namespace != "" {
+			key := scopeKey{quota.ScopeTypeNamespace, d.Namespace}
+			if _, seen := requested[key]; !seen {
+				namespaces = append(namespaces, d.Namespace)
+			}
+			add(key, d)
+		}
+		if systemID := systemOf[d.ServiceID]; systemID != "" {
+			key := scopeKey{quota.ScopeTypeSystem, systemID}
+			if _, seen := requested[key]; !seen {
+				systems = append(systems, systemID)
+			}
+			add(key, d)
+		}
+	}
+
+	quotas, err := q.client.Quota.Query().
+		Where(quota.Or(
+			quota.And(quota.ScopeTypeEQ(quota.ScopeTypeSystem), quota.ScopeIDIn(systems...)),
+			quota.And(quota.ScopeTypeEQ(quota.ScopeTypeNamespace), quota.ScopeIDIn(namespaces...)),
+		)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query quotas: %w", err)
+	}
+	sort.Slice(quotas, func(i, j int) bool {
+		if quotas[i].ScopeType != quotas[j].ScopeType {
+			return quotas[i].ScopeType > quotas[j].ScopeType // system before namespace
+		}
+		return quotas[i].ScopeID < quotas[j].ScopeID
+	})
+
+	var violations []QuotaViolation
+	for _, qt := range quotas {
+		usage, err := q.Usage(ctx, qt.ScopeType, qt.ScopeID)
+		if err != nil {
+			return nil, err
+		}
+		want := requested[scopeKey{qt.ScopeType, qt.ScopeID}]
+		checks := []struct {
+			resource  string
+			limit     *int
+			current   int
+			requested int
+		}{
+			{"vms", qt.MaxVms, usage.VMs, want.VMs},
+			{"cpu_cores", qt.MaxCPUCores, usage.CPUCores, want.CPUCores},
+			{"memory_mb", qt.MaxMemoryMB, usage.MemoryMB, want.MemoryMB},
+		}
+		for _, chk := range checks {
+			if chk.limit == nil || chk.current+chk.requested <= *chk.limit {
+				continue
+			}
+			violations = append(violations, QuotaViolation{
+				ScopeType: string(qt.ScopeType),
+				ScopeID:   qt.ScopeID,
+				Resource:  chk.resource,
+				Current:   chk.current,
+				Requested: chk.requested,
+				Limit:     *chk.limit,
+			})
+		}
+	}
+	return violations, nil
+}
+
+// Enforce returns QUOTA_EXCEEDED for the first quota the demands would exceed.
+func (q *QuotaChecker) Enforce(ctx context.Context, demands ...QuotaDemand) error {
+	violations, err := q.Violations(ctx, demands...)
+	if err != nil {
+		return err
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	v := violations[0]
+	return apperrors.Conflict(apperrors.CodeQuotaExceeded, v.Message()).WithParams(v.Params())
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/refreshtoken"
+)
+
+// DefaultRefreshTokenTTL is how long a refresh token can be exchanged.
+const DefaultRefreshTokenTTL = 7 * 24 * time.Hour
+
+// ErrRefreshTokenInvalid is returned for refresh tokens that are unknown,
+// expired, revoked or already used.
+var ErrRefreshTokenInvalid = errors.New("refresh token is invalid")
+
+// RefreshTokenStore issues one-time refresh tokens and exchanges them for
+// new ones. Tokens are only stored as SHA-256 hashes.
+type RefreshTokenStore struct {
+	client *ent.Client
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+// NewRefreshTokenStore creates a RefreshTokenStore; non-positive ttl uses
+// DefaultRefreshTokenTTL.
+func NewRefreshTokenStore(client *ent.Client, ttl time.Duration) *RefreshTokenStore {
+	if ttl <= 0 {
+		ttl = DefaultRefreshTokenTTL
+	}
+	return &RefreshTokenStore{client: client, ttl: ttl, now: time.Now}
+}
+
+// HashRefreshToken returns the stored form of token.
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// Issue creates a refresh token for userID and returns it with its expiry.
+func (s *RefreshTokenStore) Issue(ctx context.Context, userID string) (string, time.Time, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", time.Time{}, fmt.Errorf("generate refresh token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+	id, _ := uuid.NewV7()
+	expiresAt := s.now().UTC().Add(s.ttl)
+	if err := s.client.RefreshToken.Create().
+		SetID(id.String()).
+		SetUserID(userID).
+		SetTokenHash(HashRefreshToken(token)).
+		SetExpiresAt(expiresAt).
+		Exec(ctx); err != nil {
+		return "", time.Time{}, fmt.Errorf("record refresh token: %w", err)
+	}
+	return token, expiresAt, nil
+}
+
+// Rotate consumes token and issues its replacement. It returns the owning
+// user ID with the new token and its expiry, or ErrRefreshTokenInvalid.
+// Consuming is a conditional update, so concurrent exchanges of one token
+// let exactly one succeed; if issuing then fails, the user logs in again.
+func (s *RefreshTokenStore) Rotate(ctx context.Context, token string) (string, string, time.Time, error) {
+	if token == "" {
+		return "", "", time.Time{}, ErrRefreshTokenInvalid
+	}
+	hash := HashRefreshToken(token)
+	now := s.now().UTC()
+
+	used, err := s.client.RefreshToken.Update().
+		Where(
+			refreshtoken.TokenHashEQ(hash),
+			refreshtoken.RevokedAtIsNil(),
+			refreshtoken.ExpiresAtGT(now),
+		).
+		SetRevokedAt(now).
+		Save(ctx)
+	if err != nil {
+		return "", "", time.Time{}, fmt.Errorf("consume refresh token: %w", err)
+	}
+	if used == 0 {
+		return "", "", time.Time{}, ErrRefreshTokenInvalid
+	}
+	row, err := s.client.RefreshToken.Query().Where(refreshtoken.TokenHashEQ(hash)).Only(ctx)
+	if err != nil {
+		return "", "", time.Time{}, fmt.Errorf("get refresh token: %w", err)
+	}
+	next, expiresAt, err := s.Issue(ctx, row.UserID)
+	if err != nil {
+		return "", "", time.Time{}, err
+	}
+	return row.UserID, next, expiresAt, nil
+}
+
+// RevokeUser revokes every usable refresh token of userID and returns how
+// many were revoked.
+func (s *RefreshTokenStore) RevokeUser(ctx context.Context, userID string) (int, error) {
+	now := s.now().UTC()
+	n, err := s.client.RefreshToken.Update().
+		Where(
+			refreshtoken.UserIDEQ(userID),
+			refreshtoken.RevokedAtIsNil(),
+			refreshtoken.ExpiresAtGT(now),
+		).
+		SetRevokedAt(now).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("revoke refresh tokens: %w", err)
+	}
+	return n, nil
+}
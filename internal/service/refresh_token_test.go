@@ -0,0 +1,58 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestRefreshTokenStore_RotateExpireRevoke(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "refresh_token_store")
+	ctx := t.Context()
+	store := NewRefreshTokenStore(client, 0)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+
+	first, expiresAt, err := store.Issue(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	if want := now.Add(DefaultRefreshTokenTTL); !expiresAt.Equal(want) {
+		t.Fatalf("expires_at = %s, want %s", expiresAt, want)
+	}
+
+	userID, second, _, err := store.Rotate(ctx, first)
+	if err != nil || userID != "user-1" || second == "" || second == first {
+		t.Fatalf("Rotate() = %q, %q, %v; want a new token for user-1", userID, second, err)
+	}
+	if _, _, _, err := store.Rotate(ctx, first); !errors.Is(err, ErrRefreshTokenInvalid) {
+		t.Fatalf("reused token error = %v, want ErrRefreshTokenInvalid", err)
+	}
+
+	now = now.Add(DefaultRefreshTokenTTL + time.Second)
+	if _, _, _, err := store.Rotate(ctx, second); !errors.Is(err, ErrRefreshTokenInvalid) {
+		t.Fatalf("expired token error = %v, want ErrRefreshTokenInvalid", err)
+	}
+
+	third, _, err := store.Issue(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	other, _, err := store.Issue(ctx, "user-2")
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	if n, err := store.RevokeUser(ctx, "user-1"); err != nil || n != 1 {
+		t.Fatalf("RevokeUser() = %d, %v; want 1 revoked", n, err)
+	}
+	if _, _, _, err := store.Rotate(ctx, third); !errors.Is(err, ErrRefreshTokenInvalid) {
+		t.Fatalf("revoked token error = %v, want ErrRefreshTokenInvalid", err)
+	}
+	if userID, _, _, err := store.Rotate(ctx, other); err != nil || userID != "user-2" {
+		t.Fatalf("other user's Rotate() = %q, %v; want it unaffected", userID, err)
+	}
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/namespaceregistry"
+	"kv-shepherd.io/shepherd/ent/rolebinding"
+	entservice "kv-shepherd.io/shepherd/ent/service"
+	"kv-shepherd.io/shepherd/ent/system"
+	entuser "kv-shepherd.io/shepherd/ent/user"
+)
+
+// Role binding scope types. A global binding grants its role's permissions
+// everywhere; a scoped binding only applies to the named system, service or
+// namespace and never widens the holder's global permissions.
+const (
+	RoleBindingScopeGlobal    = "global"
+	RoleBindingScopeSystem    = "system"
+	RoleBindingScopeService   = "service"
+	RoleBindingScopeNamespace = "namespace"
+)
+
+var (
+	// ErrInvalidRoleBindingScope is returned for an unknown scope type or a
+	// scope_id that does not fit the scope type.
+	ErrInvalidRoleBindingScope = errors.New("invalid role binding scope")
+	// ErrRoleBindingScopeNotFound is returned when the scope target does not exist.
+	ErrRoleBindingScopeNotFound = errors.New("role binding scope target not found")
+)
+
+// IsGlobalRoleBindingScope reports whether scopeType grants global
+// permissions. An empty scope type predates scope validation and has always
+// meant global.
+func IsGlobalRoleBindingScope(scopeType string) bool {
+	return scopeType == "" || scopeType == RoleBindingScopeGlobal
+}
+
+// NormalizeRoleBindingScope checks that scopeType is known and that scopeID
+// names an existing system or service (by ID) or namespace (by ID or name),
+// and returns the scope_id to store: namespaces are stored by name. Global
+// bindings take no scope_id.
+func NormalizeRoleBindingScope(ctx context.Context, client *ent.Client, scopeType, scopeID string) (string, error) {
+	if IsGlobalRoleBindingScope(scopeType) {
+		if scopeID != "" {
+			return "", fmt.Errorf("%w: global bindings take no scope_id", ErrInvalidRoleBindingScope)
+		}
+		return "", nil
+	}
+	switch scopeType {
+	case RoleBindingScopeSystem, RoleBindingScopeService, RoleBindingScopeNamespace:
+	default:
+		return "", fmt.Errorf("%w: unknown scope_type %q", ErrInvalidRoleBindingScope, scopeType)
+	}
+	if scopeID == "" {
+		return "", fmt.Errorf("%w: scope_id is required for %s scope", ErrInvalidRoleBindingScope, scopeType)
+	}
+
+	var (
+		found bool
+		err   error
+	)
+	switch scopeType {
+	case RoleBindingScopeSystem:
+		found, err = client.System.Query().Where(system.IDEQ(scopeID)).Exist(ctx)
+	case RoleBindingScopeService:
+		found, err = client.Service.Query().Where(entservice.IDEQ(scopeID)).Exist(ctx)
+	case RoleBindingScopeNamespace:
+		var ns *ent.NamespaceRegistry
+		ns, err = client.NamespaceRegistry.Query().
+			Where(namespaceregistry.Or(namespaceregistry.IDEQ(scopeID), namespaceregistry.NameEQ(scopeID))).
+			First(ctx)
+		if err == nil {
+			return ns.Name, nil
+		}
+		if ent.IsNotFound(err) {
+			err = nil
+		}
+	}
+	if err != nil {
+		return "", fmt.Errorf("look up %s %s: %w", scopeType, scopeID, err)
+	}
+	if !found {
+		return "", fmt.Errorf("%w: %s %q", ErrRoleBindingScopeNotFound, scopeType, scopeID)
+	}
+	return scopeID, nil
+}
+
+// ScopePermissions is the flattened permission set a user holds in one scope.
+type ScopePermissions struct {
+	ScopeType   string
+	ScopeID     string
+	Roles       []string
+	Permissions []string
+}
+
+// PermissionStore resolves a user's permissions from their role bindings,
+// reading the current bindings rather than the snapshot in a login token.
+type PermissionStore struct {
+	client *ent.Client
+}
+
+// NewPermissionStore creates a PermissionStore.
+func NewPermissionStore(client *ent.Client) *PermissionStore {
+	return &PermissionStore{client: client}
+}
+
+// GlobalPermissions returns the sorted permissions granted to userID by
+// global bindings (middleware.PermissionResolver). Unknown users have none.
+func (s *PermissionStore) GlobalPermissions(ctx context.Context, userID string) ([]string, error) {
+	scopes, err := s.EffectivePermissions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(scopes) == 0 || scopes[0].ScopeType != RoleBindingScopeGlobal {
+		return []string{}, nil
+	}
+	return scopes[0].Permissions, nil
+}
+
+// EffectivePermissions flattens the bindings of userID into one entry per
+// scope: the global scope first (when bound), then scoped entries ordered by
+// scope type and ID. Roles and permissions are sorted and deduplicated.
+func (s *PermissionStore) EffectivePermissions(ctx context.Context, userID string) ([]ScopePermissions, error) {
+	bindings, err := s.client.RoleBinding.Query().
+		Where(rolebinding.HasUserWith(entuser.IDEQ(userID))).
+		WithRole().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list role bindings of %s: %w", userID, err)
+	}
+
+	type scopeKey struct{ scopeType, scopeID string }
+	type scopeSets struct {
+		roles       map[string]struct{}
+		permissions map[string]struct{}
+	}
+	sets := make(map[scopeKey]*scopeSets)
+	for _, binding := range bindings {
+		if binding.Edges.Role == nil {
+			continue
+		}
+		key := scopeKey{scopeType: binding.ScopeType, scopeID: binding.ScopeID}
+		if IsGlobalRoleBindingScope(key.scopeType) {
+			key = scopeKey{scopeType: RoleBindingScopeGlobal}
+		}
+		set, ok := sets[key]
+		if !ok {
+			set = &scopeSets{roles: map[string]struct{}{}, permissions: map[string]struct{}{}}
+			sets[key] = set
+		}
+		set.roles[binding.Edges.Role.Name] = struct{}{}
+		for _, p := range binding.Edges.Role.Permissions {
+			set.permissions[p] = struct{}{}
+		}
+	}
+
+	out := make([]ScopePermissions, 0, len(sets))
+	for key, set := range sets {
+		out = append(out, ScopePermissions{
+			ScopeType:   key.scopeType,
+			ScopeID:     key.scopeID,
+			Roles:       sortedKeys(set.roles),
+			Permissions: sortedKeys(set.permissions),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		gi, gj := out[i].ScopeType == RoleBindingScopeGlobal, out[j].ScopeType == RoleBindingScopeGlobal
+		if gi != gj {
+			return gi
+		}
+		if out[i].ScopeType != out[j].ScopeType {
+			return out[i].ScopeType < out[j].ScopeType
+		}
+		return out[i].ScopeID < out[j].ScopeID
+	})
+	return out, nil
+}
+
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -3,11 +3,16 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"kv-shepherd.io/shepherd/ent"
 	"kv-shepherd.io/shepherd/ent/template"
+	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
 )
 
+// CodeTemplateDeprecated is returned when a new request references a deprecated template.
+const CodeTemplateDeprecated = "TEMPLATE_DEPRECATED"
+
 // TemplateService handles template business logic (ADR-0007, ADR-0018).
 // Templates define OS image source and cloud-init only.
 // No Go Template variables (removed per ADR-0018).
@@ -78,3 +83,16 @@ func (s *TemplateService) CreateTemplate(ctx context.Context, id, name, createdB
 	}
 	return t, nil
 }
+
+// EnsureTemplateRequestable rejects templates that may not back new VM requests.
+func EnsureTemplateRequestable(t *ent.Template) error {
+	if t != nil && t.DeprecatedAt != nil {
+		return apperrors.BadRequest(CodeTemplateDeprecated,
+			fmt.Sprintf("template %s v%d is deprecated", t.Name, t.Version)).
+			WithParams(map[string]interface{}{
+				"template_id":   t.ID,
+				"deprecated_at": t.DeprecatedAt.UTC().Format(time.RFC3339),
+			})
+	}
+	return nil
+}
@@ -0,0 +1,270 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"kv-shepherd.io/shepherd/internal/domain"
+	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
+)
+
+// CodeTemplateSpecInvalid is returned when an admin-supplied template spec
+// cannot be consumed by the VM create worker.
+const CodeTemplateSpecInvalid = "TEMPLATE_SPEC_INVALID"
+
+// Field-level violation codes reported by ValidateTemplateSpec.
+const (
+	TemplateViolationImageSourceMissing = "IMAGE_SOURCE_MISSING"
+	TemplateViolationVolumesNotList     = "VOLUMES_NOT_LIST"
+	TemplateViolationVolumeNotObject    = "VOLUME_NOT_OBJECT"
+	TemplateViolationVolumeNameMissing  = "VOLUME_NAME_MISSING"
+	TemplateViolationVolumeSourceType   = "VOLUME_SOURCE_NOT_OBJECT"
+	TemplateViolationTemplateNotObject  = "TEMPLATE_NOT_OBJECT"
+	TemplateViolationUnknownField       = "UNKNOWN_FIELD"
+	TemplateViolationCloudInitType      = "CLOUD_INIT_TYPE_INVALID"
+)
+
+// Image source paths resolved by the VM create worker (internal/jobs/vm_create.go).
+var (
+	templateImagePaths = []string{"image", "image_source.image", "source.image"}
+	templatePVCPaths   = []string{
+		"pvc_name",
+		"image_source.pvc_name",
+		"image_source.pvc.name",
+		"source.pvc_name",
+		"source.pvc.name",
+	}
+	templateVolumePaths = []string{
+		"spec.template.spec.volumes",
+		"template.spec.volumes",
+		"volumes",
+	}
+	templateVolumeSourceKeys = []string{"containerDisk", "persistentVolumeClaim"}
+)
+
+// Allowed keys of a KubeVirt VirtualMachineInstanceTemplateSpec.
+var allowedTemplateKeys = map[string]struct{}{
+	"metadata": {},
+	"spec":     {},
+}
+
+// ValidateTemplateSpec checks an admin-supplied template spec before it is persisted.
+//
+// Rules:
+//  1. spec must resolve to an image source the VM create worker supports
+//     (image, pvc_name, or a containerDisk/persistentVolumeClaim volume)
+//  2. every volume entry must be an object with a name
+//  3. spec.template (or template) only accepts metadata/spec keys
+//  4. cloud_init_type, when set, is nocloud or configdrive
+//
+// Returns an AppError with code TEMPLATE_SPEC_INVALID carrying all violations.
+func ValidateTemplateSpec(spec map[string]interface{}) error {
+	violations := make([]apperrors.FieldError, 0)
+
+	hasImage := false
+	for _, path := range templateImagePaths {
+		if templateStringValue(spec, path) != "" {
+			hasImage = true
+			break
+		}
+	}
+	if !hasImage {
+		for _, path := range templatePVCPaths {
+			if templateStringValue(spec, path) != "" {
+				hasImage = true
+				break
+			}
+		}
+	}
+
+	for _, path := range templateVolumePaths {
+		raw, ok := getSpecOverrideValue(spec, path)
+		if !ok {
+			continue
+		}
+		found, volumeViolations := validateTemplateVolumes(path, raw)
+		violations = append(violations, volumeViolations...)
+		if found {
+			hasImage = true
+		}
+	}
+	if !hasImage {
+		violations = append(violations, apperrors.FieldError{
+			Field:   "spec",
+			Code:    TemplateViolationImageSourceMissing,
+			Message: "spec must define image, pvc_name, or a containerDisk/persistentVolumeClaim volume",
+		})
+	}
+
+	for _, path := range []string{"spec.template", "template"} {
+		raw, ok := getSpecOverrideValue(spec, path)
+		if !ok {
+			continue
+		}
+		violations = append(violations, validateTemplateKeys(path, raw)...)
+	}
+
+	if raw, ok := spec["cloud_init_type"]; ok {
+		value, isString := raw.(string)
+		if _, err := domain.ParseCloudInitType(value); !isString || err != nil {
+			violations = append(violations, apperrors.FieldError{
+				Field:   "cloud_init_type",
+				Code:    TemplateViolationCloudInitType,
+				Message: "cloud_init_type must be nocloud or configdrive",
+			})
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+	return apperrors.BadRequest(CodeTemplateSpecInvalid, "template spec failed validation").
+		WithParams(map[string]interface{}{"violations": violations}).
+		WithFieldErrors(violations)
+}
+
+// validateTemplateVolumes reports malformed volume entries and whether any
+// entry resolves to an image source.
+func validateTemplateVolumes(path string, raw interface{}) (bool, []apperrors.FieldError) {
+	items, ok := raw.([]interface{})
+	if !ok {
+		return false, []apperrors.FieldError{{
+			Field:   path,
+			Code:    TemplateViolationVolumesNotList,
+			Message: "volumes must be a list",
+		}}
+	}
+
+	found := false
+	violations := make([]apperrors.FieldError, 0)
+	for i, item := range items {
+		field := fmt.Sprintf("%s[%d]", path, i)
+		volume, ok := item.(map[string]interface{})
+		if !ok {
+			violations = append(violations, apperrors.FieldError{
+				Field:   field,
+				Code:    TemplateViolationVolumeNotObject,
+				Message: "volume entry must be an object",
+			})
+			continue
+		}
+		if templateStringValue(volume, "name") == "" {
+			violations = append(violations, apperrors.FieldError{
+				Field:   field + ".name",
+				Code:    TemplateViolationVolumeNameMissing,
+				Message: "volume entry must have a name",
+			})
+		}
+		for _, key := range templateVolumeSourceKeys {
+			src, present := volume[key]
+			if !present {
+				continue
+			}
+			srcMap, ok := src.(map[string]interface{})
+			if !ok {
+				violations = append(violations, apperrors.FieldError{
+					Field:   field + "." + key,
+					Code:    TemplateViolationVolumeSourceType,
+					Message: key + " must be an object",
+				})
+				continue
+			}
+			switch key {
+			case "containerDisk":
+				if templateStringValue(srcMap, "image") != "" {
+					found = true
+				}
+			case "persistentVolumeClaim":
+				if templateStringValue(srcMap, "claimName") != "" {
+					found = true
+				}
+			}
+		}
+	}
+	return found, violations
+}
+
+func validateTemplateKeys(path string, raw interface{}) []apperrors.FieldError {
+	tpl, ok := raw.(map[string]interface{})
+	if !ok {
+		return []apperrors.FieldError{{
+			Field:   path,
+			Code:    TemplateViolationTemplateNotObject,
+			Message: "template must be an object",
+		}}
+	}
+
+	keys := make([]string, 0, len(tpl))
+	for key := range tpl {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	violations := make([]apperrors.FieldError, 0)
+	for _, key := range keys {
+		if _, ok := allowedTemplateKeys[key]; ok {
+			continue
+		}
+		violations = append(violations, apperrors.FieldError{
+			Field:   path + "." + key,
+			Code:    TemplateViolationUnknownField,
+			Message: "unknown template field",
+		})
+	}
+	return violations
+}
+
+func templateStringValue(values map[string]interface{}, path string) string {
+	raw, ok := getSpecOverrideValue(values, path)
+	if !ok || raw == nil {
+		return ""
+	}
+	return strings.TrimSpace(fmt.Sprint(raw))
+}
+
+// TemplateRootDiskPVC returns the PVC a VM created from spec boots from, or
+// "" when its root disk is a container disk. Sources are resolved in the
+// same order as the VM create worker.
+func TemplateRootDiskPVC(spec map[string]interface{}) string {
+	for _, path := range templateImagePaths {
+		if image := templateStringValue(spec, path); image != "" {
+			return pvcFromImage(image)
+		}
+	}
+	for _, path := range templatePVCPaths {
+		if claim := templateStringValue(spec, path); claim != "" {
+			return claim
+		}
+	}
+	for _, path := range templateVolumePaths {
+		raw, ok := getSpecOverrideValue(spec, path)
+		if !ok {
+			continue
+		}
+		items, ok := raw.([]interface{})
+		if !ok {
+			continue
+		}
+		for _, item := range items {
+			volume, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if image := templateStringValue(volume, "containerDisk.image"); image != "" {
+				return ""
+			}
+			if claim := templateStringValue(volume, "persistentVolumeClaim.claimName"); claim != "" {
+				return claim
+			}
+		}
+	}
+	return ""
+}
+
+func pvcFromImage(image string) string {
+	if !strings.HasPrefix(image, "pvc:") {
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimPrefix(image, "pvc:"))
+}
@@ -0,0 +1,242 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
+)
+
+func TestValidateTemplateSpec_ImageSourceVariants(t *testing.T) {
+	volumeSpec := func(volume map[string]interface{}) []interface{} {
+		return []interface{}{volume}
+	}
+
+	testCases := []struct {
+		name string
+		spec map[string]interface{}
+	}{
+		{
+			name: "top-level image",
+			spec: map[string]interface{}{"image": "quay.io/kubevirt/fedora:40"},
+		},
+		{
+			name: "image_source.image",
+			spec: map[string]interface{}{
+				"image_source": map[string]interface{}{"type": "containerdisk", "image": "docker.io/kubevirt/centos:7"},
+			},
+		},
+		{
+			name: "source.image",
+			spec: map[string]interface{}{
+				"source": map[string]interface{}{"image": "docker.io/kubevirt/centos:7"},
+			},
+		},
+		{
+			name: "flattened image_source.image",
+			spec: map[string]interface{}{"image_source.image": "docker.io/kubevirt/centos:7"},
+		},
+		{
+			name: "top-level pvc_name",
+			spec: map[string]interface{}{"pvc_name": "centos-base"},
+		},
+		{
+			name: "image_source.pvc_name",
+			spec: map[string]interface{}{
+				"image_source": map[string]interface{}{"type": "pvc", "pvc_name": "centos-base"},
+			},
+		},
+		{
+			name: "image_source.pvc.name",
+			spec: map[string]interface{}{
+				"image_source": map[string]interface{}{"pvc": map[string]interface{}{"name": "centos-base"}},
+			},
+		},
+		{
+			name: "source.pvc_name",
+			spec: map[string]interface{}{
+				"source": map[string]interface{}{"pvc_name": "centos-base"},
+			},
+		},
+		{
+			name: "source.pvc.name",
+			spec: map[string]interface{}{
+				"source": map[string]interface{}{"pvc": map[string]interface{}{"name": "centos-base"}},
+			},
+		},
+		{
+			name: "spec.template.spec.volumes containerDisk",
+			spec: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"template": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"volumes": volumeSpec(map[string]interface{}{
+								"name":          "rootdisk",
+								"containerDisk": map[string]interface{}{"image": "quay.io/kubevirt/fedora:40"},
+							}),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "template.spec.volumes persistentVolumeClaim",
+			spec: map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"volumes": volumeSpec(map[string]interface{}{
+							"name":                  "rootdisk",
+							"persistentVolumeClaim": map[string]interface{}{"claimName": "centos-base"},
+						}),
+					},
+				},
+			},
+		},
+		{
+			name: "top-level volumes containerDisk",
+			spec: map[string]interface{}{
+				"volumes": volumeSpec(map[string]interface{}{
+					"name":          "rootdisk",
+					"containerDisk": map[string]interface{}{"image": "quay.io/kubevirt/fedora:40"},
+				}),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, ValidateTemplateSpec(tc.spec))
+		})
+	}
+}
+
+func TestValidateTemplateSpec_Violations(t *testing.T) {
+	testCases := []struct {
+		name       string
+		spec       map[string]interface{}
+		wantFields map[string]string
+	}{
+		{
+			name:       "missing image source",
+			spec:       map[string]interface{}{"os": "linux"},
+			wantFields: map[string]string{"spec": TemplateViolationImageSourceMissing},
+		},
+		{
+			name: "volumes not a list",
+			spec: map[string]interface{}{
+				"image":   "quay.io/kubevirt/fedora:40",
+				"volumes": map[string]interface{}{"name": "rootdisk"},
+			},
+			wantFields: map[string]string{"volumes": TemplateViolationVolumesNotList},
+		},
+		{
+			name: "malformed volume entries",
+			spec: map[string]interface{}{
+				"image": "quay.io/kubevirt/fedora:40",
+				"volumes": []interface{}{
+					"rootdisk",
+					map[string]interface{}{"containerDisk": "quay.io/kubevirt/fedora:40"},
+				},
+			},
+			wantFields: map[string]string{
+				"volumes[0]":               TemplateViolationVolumeNotObject,
+				"volumes[1].name":          TemplateViolationVolumeNameMissing,
+				"volumes[1].containerDisk": TemplateViolationVolumeSourceType,
+			},
+		},
+		{
+			name: "unknown spec.template key",
+			spec: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"template": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"volumes": []interface{}{
+								map[string]interface{}{
+									"name":          "rootdisk",
+									"containerDisk": map[string]interface{}{"image": "quay.io/kubevirt/fedora:40"},
+								},
+							},
+						},
+						"domain": map[string]interface{}{},
+					},
+				},
+			},
+			wantFields: map[string]string{"spec.template.domain": TemplateViolationUnknownField},
+		},
+		{
+			name: "unsupported cloud_init_type",
+			spec: map[string]interface{}{
+				"image":           "quay.io/kubevirt/fedora:40",
+				"cloud_init_type": "sysprep",
+			},
+			wantFields: map[string]string{"cloud_init_type": TemplateViolationCloudInitType},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateTemplateSpec(tc.spec)
+			require.Error(t, err)
+
+			appErr, ok := apperrors.IsAppError(err)
+			require.True(t, ok)
+			require.Equal(t, CodeTemplateSpecInvalid, appErr.Code)
+			require.Contains(t, appErr.Params, "violations")
+
+			got := make(map[string]string, len(appErr.FieldErrors))
+			for _, fe := range appErr.FieldErrors {
+				got[fe.Field] = fe.Code
+			}
+			require.Equal(t, tc.wantFields, got)
+		})
+	}
+}
+
+func TestTemplateRootDiskPVC(t *testing.T) {
+	testCases := []struct {
+		name string
+		spec map[string]interface{}
+		want string
+	}{
+		{name: "container disk image", spec: map[string]interface{}{"image": "quay.io/kubevirt/fedora:40"}, want: ""},
+		{name: "pvc image reference", spec: map[string]interface{}{"image": "pvc:golden-fedora"}, want: "golden-fedora"},
+		{
+			name: "image_source.pvc.name",
+			spec: map[string]interface{}{
+				"image_source": map[string]interface{}{"pvc": map[string]interface{}{"name": "golden-centos"}},
+			},
+			want: "golden-centos",
+		},
+		{
+			name: "persistentVolumeClaim volume",
+			spec: map[string]interface{}{
+				"volumes": []interface{}{
+					map[string]interface{}{
+						"name":                  "rootdisk",
+						"persistentVolumeClaim": map[string]interface{}{"claimName": "golden-ubuntu"},
+					},
+				},
+			},
+			want: "golden-ubuntu",
+		},
+		{
+			name: "containerDisk volume",
+			spec: map[string]interface{}{
+				"volumes": []interface{}{
+					map[string]interface{}{
+						"name":          "rootdisk",
+						"containerDisk": map[string]interface{}{"image": "quay.io/kubevirt/fedora:40"},
+					},
+				},
+			},
+			want: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, TemplateRootDiskPVC(tc.spec))
+		})
+	}
+}
@@ -3,13 +3,18 @@ package service
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"kv-shepherd.io/shepherd/ent"
+	entservice "kv-shepherd.io/shepherd/ent/service"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/domain"
 )
 
 // VMNamingService generates platform-managed VM names per ADR-0017/master-flow Stage 5.C.
 // Pattern: {namespace}-{system_name}-{service_name}-{instance_index}
 // Example: prod-shop-redis-01
+// Names longer than a DNS-1123 label are truncated by domain.DeriveVMName.
 type VMNamingService struct {
 	client *ent.Client
 }
@@ -20,11 +25,19 @@ func NewVMNamingService(client *ent.Client) *VMNamingService {
 }
 
 // GenerateVMName generates a unique VM name and increments the service's next_instance_index.
-// This MUST be called within a transaction to ensure atomicity.
+//
+// The index is allocated with a single UPDATE ... SET next_instance_index =
+// next_instance_index + 1, so concurrent callers never read the same value.
+// The row lock taken by the UPDATE serializes callers, so there is no
+// read-compare-write window and no conflict to retry.
+// Call it within the transaction that inserts the VM row so a rollback
+// releases nothing but a gap (indexes are never reused, ADR-0015 §2).
 func (s *VMNamingService) GenerateVMName(ctx context.Context, namespace string, serviceID string) (name string, instance string, err error) {
-	svcEnt, err := s.client.Service.Get(ctx, serviceID)
+	svcEnt, err := s.client.Service.UpdateOneID(serviceID).
+		AddNextInstanceIndex(1).
+		Save(ctx)
 	if err != nil {
-		return "", "", fmt.Errorf("service not found: %s: %w", serviceID, err)
+		return "", "", fmt.Errorf("allocate instance index for service %s: %w", serviceID, err)
 	}
 
 	sysEnt, err := svcEnt.QuerySystem().Only(ctx)
@@ -32,22 +45,60 @@ func (s *VMNamingService) GenerateVMName(ctx context.Context, namespace string,
 		return "", "", fmt.Errorf("system not found for service %s: %w", serviceID, err)
 	}
 
-	// Get current index and increment (permanently incrementing, no reset per ADR-0015 §2).
-	idx := svcEnt.NextInstanceIndex
-
-	// Format instance as zero-padded 2-digit string.
-	instance = fmt.Sprintf("%02d", idx)
+	name, instance = domain.DeriveVMName(namespace, sysEnt.Name, svcEnt.Name, svcEnt.NextInstanceIndex-1)
+	if err := domain.ValidateVMName(name); err != nil {
+		return "", "", err
+	}
+	return name, instance, nil
+}
 
-	// Generate name: {namespace}-{system}-{service}-{idx}
-	name = fmt.Sprintf("%s-%s-%s-%s", namespace, sysEnt.Name, svcEnt.Name, instance)
+// InstanceReindexResult reports a next_instance_index repair.
+type InstanceReindexResult struct {
+	PreviousNextIndex int
+	NextIndex         int
+	// MaxInstance is the highest numeric instance among the service's VMs, 0 if none.
+	MaxInstance int
+}
 
-	// Increment next_instance_index atomically.
-	err = s.client.Service.UpdateOneID(serviceID).
-		SetNextInstanceIndex(idx + 1).
-		Exec(ctx)
+// ReindexInstances repairs next_instance_index from the service's existing VMs.
+//
+// The counter only moves forward: it becomes max(current, highest instance + 1),
+// so names of deleted VMs are still never reused (ADR-0015 §2).
+// This MUST be called with a transactional client: the service row is locked
+// FOR UPDATE so concurrent allocations wait until the repair commits.
+func (s *VMNamingService) ReindexInstances(ctx context.Context, serviceID string) (InstanceReindexResult, error) {
+	var result InstanceReindexResult
+	if _, err := s.client.ExecContext(ctx,
+		fmt.Sprintf("SELECT 1 FROM %s WHERE %s = $1 FOR UPDATE", entservice.Table, entservice.FieldID),
+		serviceID); err != nil {
+		return result, fmt.Errorf("lock service %s: %w", serviceID, err)
+	}
+	svcEnt, err := s.client.Service.Get(ctx, serviceID)
+	if err != nil {
+		return result, err
+	}
+	instances, err := s.client.VM.Query().
+		Where(entvm.HasServiceWith(entservice.IDEQ(serviceID))).
+		Select(entvm.FieldInstance).
+		Strings(ctx)
 	if err != nil {
-		return "", "", fmt.Errorf("increment instance index: %w", err)
+		return result, fmt.Errorf("list instances of service %s: %w", serviceID, err)
+	}
+	for _, instance := range instances {
+		// Non-numeric instances (e.g. imported VMs) cannot collide with allocated ones.
+		if n, err := strconv.Atoi(instance); err == nil && n > result.MaxInstance {
+			result.MaxInstance = n
+		}
 	}
 
-	return name, instance, nil
+	result.PreviousNextIndex = svcEnt.NextInstanceIndex
+	result.NextIndex = max(svcEnt.NextInstanceIndex, result.MaxInstance+1)
+	if result.NextIndex != result.PreviousNextIndex {
+		if err := s.client.Service.UpdateOneID(serviceID).
+			SetNextInstanceIndex(result.NextIndex).
+			Exec(ctx); err != nil {
+			return result, fmt.Errorf("update next instance index of service %s: %w", serviceID, err)
+		}
+	}
+	return result, nil
 }
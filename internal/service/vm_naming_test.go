@@ -0,0 +1,49 @@
+package service
+
+import (
+	"sync"
+	"testing"
+
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestGenerateVMName_ConcurrentCallersGetDistinctIndexes(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "vm_naming_concurrent")
+	sys := client.System.Create().SetID("sys-naming").SetName("shop").SetCreatedBy("owner-1").SaveX(t.Context())
+	client.Service.Create().SetID("svc-naming").SetName("redis").SetSystemID(sys.ID).SaveX(t.Context())
+
+	const callers = 20
+	naming := NewVMNamingService(client)
+	var (
+		wg        sync.WaitGroup
+		start     = make(chan struct{})
+		instances = make([]string, callers)
+		errs      = make([]error, callers)
+	)
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			_, instances[i], errs[i] = naming.GenerateVMName(t.Context(), "prod", "svc-naming")
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	seen := make(map[string]bool, callers)
+	for i, instance := range instances {
+		if errs[i] != nil {
+			t.Fatalf("caller %d: GenerateVMName() error = %v", i, errs[i])
+		}
+		if seen[instance] {
+			t.Fatalf("instance %q allocated twice", instance)
+		}
+		seen[instance] = true
+	}
+	if got := client.Service.GetX(t.Context(), "svc-naming").NextInstanceIndex; got != 1+callers {
+		t.Fatalf("next_instance_index = %d, want %d", got, 1+callers)
+	}
+}
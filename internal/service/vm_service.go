@@ -10,6 +10,9 @@ package service
 import (
 	"context"
 	"fmt"
+	"net"
+	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -23,6 +26,24 @@ import (
 // Depends on narrow interfaces (ADR-0024), not monolithic KubeVirtProvider.
 type VMService struct {
 	infra provider.InfrastructureProvider
+
+	runtimeMu    sync.Mutex
+	runtimeCache map[string]runtimeCacheEntry
+
+	capacityMu    sync.Mutex
+	capacityCache map[string]capacityCacheEntry
+
+	namespaceMu    sync.Mutex
+	namespaceCache map[string]namespaceCacheEntry
+}
+
+// runtimeCacheTTL bounds how long a live VMI lookup is reused, so repeated
+// GetVM?runtime=true polling does not hit the cluster API on every request.
+var runtimeCacheTTL = 5 * time.Second
+
+type runtimeCacheEntry struct {
+	runtime   *domain.VMRuntime
+	expiresAt time.Time
 }
 
 // NewVMService creates a new VMService.
@@ -54,6 +75,51 @@ func (s *VMService) GetVM(ctx context.Context, cluster, namespace, name string)
 	return vm, nil
 }
 
+// OpenVNCStream opens a raw VNC stream to a running VM.
+func (s *VMService) OpenVNCStream(ctx context.Context, cluster, namespace, name string) (net.Conn, error) {
+	streams, ok := s.infra.(provider.VNCStreamProvider)
+	if !ok {
+		return nil, fmt.Errorf("open vnc stream: provider %s does not support vnc streams", s.infra.Type())
+	}
+	return streams.OpenVNCStream(ctx, cluster, namespace, name)
+}
+
+// GetVMRuntime retrieves live VMI state for a VM, reusing a lookup made within
+// runtimeCacheTTL. Errors are not cached.
+func (s *VMService) GetVMRuntime(ctx context.Context, cluster, namespace, name string) (*domain.VMRuntime, error) {
+	runtimes, ok := s.infra.(provider.RuntimeProvider)
+	if !ok {
+		return nil, fmt.Errorf("get vm runtime: provider %s does not support runtime lookup", s.infra.Type())
+	}
+
+	key := cluster + "/" + namespace + "/" + name
+	now := time.Now()
+	s.runtimeMu.Lock()
+	if entry, hit := s.runtimeCache[key]; hit && now.Before(entry.expiresAt) {
+		s.runtimeMu.Unlock()
+		return entry.runtime, nil
+	}
+	s.runtimeMu.Unlock()
+
+	runtime, err := runtimes.GetVMRuntime(ctx, cluster, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("get vm runtime: %w", err)
+	}
+
+	s.runtimeMu.Lock()
+	defer s.runtimeMu.Unlock()
+	if s.runtimeCache == nil {
+		s.runtimeCache = make(map[string]runtimeCacheEntry)
+	}
+	for k, entry := range s.runtimeCache {
+		if !now.Before(entry.expiresAt) {
+			delete(s.runtimeCache, k)
+		}
+	}
+	s.runtimeCache[key] = runtimeCacheEntry{runtime: runtime, expiresAt: now.Add(runtimeCacheTTL)}
+	return runtime, nil
+}
+
 // ListVMs lists VMs with filtering.
 func (s *VMService) ListVMs(ctx context.Context, cluster, namespace string, opts provider.ListOptions) (*domain.VMList, error) {
 	list, err := s.infra.ListVMs(ctx, cluster, namespace, opts)
@@ -84,6 +150,35 @@ func (s *VMService) ExecuteK8sCreate(ctx context.Context, cluster, namespace str
 	return vm, nil
 }
 
+// ExecuteK8sClone creates a VM whose root disk is cloned from an existing
+// PVC through a CDI DataVolume (outside transaction). The source VM must be
+// stopped so the copy is consistent; cross-namespace clones need CDI clone
+// permission on the source namespace.
+func (s *VMService) ExecuteK8sClone(ctx context.Context, cluster, namespace string, spec *domain.VMSpec) (*domain.VM, error) {
+	if spec == nil || spec.CloneSource == nil {
+		return nil, fmt.Errorf("execute k8s clone: clone source is required")
+	}
+	vm, err := s.infra.CreateVM(ctx, cluster, namespace, spec)
+	if err != nil {
+		logger.Error("K8s VM clone failed",
+			zap.String("cluster", cluster),
+			zap.String("namespace", namespace),
+			zap.String("source_namespace", spec.CloneSource.Namespace),
+			zap.String("source_pvc", spec.CloneSource.PVCName),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("execute k8s clone: %w", err)
+	}
+
+	logger.Info("VM cloned on K8s",
+		zap.String("cluster", cluster),
+		zap.String("namespace", namespace),
+		zap.String("name", vm.Name),
+		zap.String("source_pvc", spec.CloneSource.PVCName),
+	)
+	return vm, nil
+}
+
 // StartVM starts a VM.
 func (s *VMService) StartVM(ctx context.Context, cluster, namespace, name string) error {
 	return s.infra.StartVM(ctx, cluster, namespace, name)
@@ -103,3 +198,196 @@ func (s *VMService) RestartVM(ctx context.Context, cluster, namespace, name stri
 func (s *VMService) DeleteVM(ctx context.Context, cluster, namespace, name string) error {
 	return s.infra.DeleteVM(ctx, cluster, namespace, name)
 }
+
+// HostnameAnnotation is the VirtualMachine annotation carrying the guest
+// domain hostname.
+const HostnameAnnotation = "kubevirt.io/domain"
+
+// UpdateVMHostname sets the hostname annotation on the VM (outside
+// transaction) and reports whether it changed. Idempotent: a VM already
+// annotated with hostname is left untouched.
+func (s *VMService) UpdateVMHostname(ctx context.Context, cluster, namespace, name, hostname string) (bool, error) {
+	annotations, ok := s.infra.(provider.AnnotationProvider)
+	if !ok {
+		return false, fmt.Errorf("update vm hostname: provider %s does not support annotations", s.infra.Type())
+	}
+	current, err := annotations.GetVMAnnotations(ctx, cluster, namespace, name)
+	if err != nil {
+		return false, fmt.Errorf("update vm hostname: %w", err)
+	}
+	if current[HostnameAnnotation] == hostname {
+		return false, nil
+	}
+	if err := annotations.SetVMAnnotation(ctx, cluster, namespace, name, HostnameAnnotation, hostname); err != nil {
+		return false, fmt.Errorf("update vm hostname: %w", err)
+	}
+	return true, nil
+}
+
+// ExecuteK8sResize applies the size to the VM (outside transaction) and
+// reports whether a running VM had to be restarted.
+//
+// CPU/memory of a running VM are hot-plugged when the provider supports it
+// (KubeVirt live-migrates the VM if needed). Otherwise, when the data disk
+// grows, or when the provider stages the change for the next boot, the spec
+// is patched and a running VM is restarted so the new size takes effect.
+func (s *VMService) ExecuteK8sResize(ctx context.Context, cluster, namespace, name string, from, to domain.VMSize, running bool) (bool, error) {
+	if to.CPU <= 0 || to.MemoryMB <= 0 {
+		return false, fmt.Errorf("execute k8s resize: cpu and memory must be positive, got %d/%dMB", to.CPU, to.MemoryMB)
+	}
+	diskChanged := to.DiskGB > 0 && to.DiskGB != from.DiskGB
+	if live, ok := s.infra.(provider.ResizeProvider); ok && running && !diskChanged {
+		restartRequired, err := live.LiveResizeVM(ctx, cluster, namespace, name, to.CPU, to.MemoryMB)
+		if err != nil {
+			return false, fmt.Errorf("execute k8s live resize: %w", err)
+		}
+		if !restartRequired {
+			return false, nil
+		}
+		logger.Info("live resize staged for next boot, restarting VM",
+			zap.String("cluster", cluster),
+			zap.String("namespace", namespace),
+			zap.String("name", name),
+		)
+	} else if _, err := s.infra.UpdateVM(ctx, cluster, namespace, name, &domain.VMSpec{
+		CPU:      to.CPU,
+		MemoryMB: to.MemoryMB,
+		DiskGB:   to.DiskGB,
+	}); err != nil {
+		return false, fmt.Errorf("execute k8s resize: %w", err)
+	}
+	if !running {
+		return false, nil
+	}
+	if err := s.infra.RestartVM(ctx, cluster, namespace, name); err != nil {
+		return false, fmt.Errorf("restart vm after resize: %w", err)
+	}
+	return true, nil
+}
+
+// migrationPollInterval is how often ExecuteK8sMigration re-reads snapshot
+// and target VM state while waiting.
+var migrationPollInterval = 5 * time.Second
+
+// migrationReadyTimeout bounds each wait phase of ExecuteK8sMigration.
+const migrationReadyTimeout = 10 * time.Minute
+
+// ExecuteK8sMigration moves a VM from sourceCluster to targetCluster (outside transaction).
+//
+// The source VM is snapshotted first when the provider supports snapshots, so
+// its disks have a restore point. The VM is then recreated from the source spec
+// in the same namespace of the target cluster and the source is deleted only
+// once the target reports RUNNING. If any step before that fails, the partial
+// target VM is removed and the source is left running.
+func (s *VMService) ExecuteK8sMigration(ctx context.Context, sourceCluster, targetCluster, namespace, name string) (*domain.VM, error) {
+	if sourceCluster == targetCluster {
+		return nil, fmt.Errorf("execute k8s migration: source and target cluster are both %q", sourceCluster)
+	}
+
+	source, err := s.infra.GetVM(ctx, sourceCluster, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("execute k8s migration: get source vm: %w", err)
+	}
+
+	snapshotName := ""
+	if snapshots, ok := s.infra.(provider.SnapshotProvider); ok {
+		snapshotName = fmt.Sprintf("%s-migrate-%d", name, time.Now().Unix())
+		if _, err := snapshots.CreateSnapshot(ctx, sourceCluster, namespace, name, snapshotName); err != nil {
+			return nil, fmt.Errorf("execute k8s migration: snapshot source vm: %w", err)
+		}
+		if err := s.waitForSnapshot(ctx, snapshots, sourceCluster, namespace, snapshotName); err != nil {
+			return nil, fmt.Errorf("execute k8s migration: %w", err)
+		}
+	}
+
+	spec := source.Spec
+	spec.Name = name
+	if _, err := s.infra.CreateVM(ctx, targetCluster, namespace, &spec); err != nil {
+		return nil, fmt.Errorf("execute k8s migration: create target vm: %w", err)
+	}
+	target, err := s.waitForRunning(ctx, targetCluster, namespace, name)
+	if err != nil {
+		if delErr := s.infra.DeleteVM(ctx, targetCluster, namespace, name); delErr != nil {
+			logger.Error("failed to remove partial target VM after migration failure",
+				zap.String("cluster", targetCluster),
+				zap.String("namespace", namespace),
+				zap.String("name", name),
+				zap.Error(delErr),
+			)
+		}
+		return nil, fmt.Errorf("execute k8s migration: %w", err)
+	}
+
+	// The target is serving from here on; a leftover source VM or snapshot is
+	// a cleanup problem, not a failed migration.
+	if err := s.infra.DeleteVM(ctx, sourceCluster, namespace, name); err != nil {
+		logger.Error("VM migrated but source VM deletion failed",
+			zap.String("cluster", sourceCluster),
+			zap.String("namespace", namespace),
+			zap.String("name", name),
+			zap.Error(err),
+		)
+	}
+	if snapshotName != "" {
+		snapshots := s.infra.(provider.SnapshotProvider)
+		if err := snapshots.DeleteSnapshot(ctx, sourceCluster, namespace, snapshotName); err != nil {
+			logger.Warn("failed to delete migration snapshot",
+				zap.String("cluster", sourceCluster),
+				zap.String("snapshot", snapshotName),
+				zap.Error(err),
+			)
+		}
+	}
+
+	logger.Info("VM migrated between clusters",
+		zap.String("source_cluster", sourceCluster),
+		zap.String("target_cluster", targetCluster),
+		zap.String("namespace", namespace),
+		zap.String("name", name),
+	)
+	return target, nil
+}
+
+func (s *VMService) waitForSnapshot(ctx context.Context, snapshots provider.SnapshotProvider, cluster, namespace, name string) error {
+	ctx, cancel := context.WithTimeout(ctx, migrationReadyTimeout)
+	defer cancel()
+	for {
+		snap, err := snapshots.GetSnapshot(ctx, cluster, namespace, name)
+		if err != nil {
+			return fmt.Errorf("get snapshot %s: %w", name, err)
+		}
+		if snap.Ready {
+			return nil
+		}
+		if snap.Phase == "Failed" {
+			return fmt.Errorf("snapshot %s failed: %s", name, snap.Error)
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait for snapshot %s: %w", name, ctx.Err())
+		case <-time.After(migrationPollInterval):
+		}
+	}
+}
+
+func (s *VMService) waitForRunning(ctx context.Context, cluster, namespace, name string) (*domain.VM, error) {
+	ctx, cancel := context.WithTimeout(ctx, migrationReadyTimeout)
+	defer cancel()
+	for {
+		vm, err := s.infra.GetVM(ctx, cluster, namespace, name)
+		if err != nil {
+			return nil, fmt.Errorf("get target vm: %w", err)
+		}
+		switch vm.Status {
+		case domain.VMStatusRunning:
+			return vm, nil
+		case domain.VMStatusFailed:
+			return nil, fmt.Errorf("target vm %s/%s failed to start", namespace, name)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("wait for target vm %s/%s: %w", namespace, name, ctx.Err())
+		case <-time.After(migrationPollInterval):
+		}
+	}
+}
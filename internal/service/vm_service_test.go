@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/provider"
+)
+
+func init() {
+	_ = logger.Init("error", "json")
+}
+
+// clusterAwareProvider keys VMs by cluster so migrations can be observed.
+// Methods not exercised by the migration path are left to the embedded nil
+// interface and panic if called.
+type clusterAwareProvider struct {
+	provider.InfrastructureProvider
+
+	mu            sync.Mutex
+	vms           map[string]*domain.VM
+	snapshots     map[string]*domain.Snapshot
+	targetStatus  domain.VMStatus
+	failCreateFor string
+}
+
+func newClusterAwareProvider() *clusterAwareProvider {
+	return &clusterAwareProvider{
+		vms:          map[string]*domain.VM{},
+		snapshots:    map[string]*domain.Snapshot{},
+		targetStatus: domain.VMStatusRunning,
+	}
+}
+
+func vmKey(cluster, namespace, name string) string {
+	return cluster + "/" + namespace + "/" + name
+}
+
+func (p *clusterAwareProvider) GetVM(_ context.Context, cluster, namespace, name string) (*domain.VM, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	vm, ok := p.vms[vmKey(cluster, namespace, name)]
+	if !ok {
+		return nil, fmt.Errorf("vm %s not found", vmKey(cluster, namespace, name))
+	}
+	return vm, nil
+}
+
+func (p *clusterAwareProvider) CreateVM(_ context.Context, cluster, namespace string, spec *domain.VMSpec) (*domain.VM, error) {
+	if cluster == p.failCreateFor {
+		return nil, fmt.Errorf("cluster %s rejected vm", cluster)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	vm := &domain.VM{Name: spec.Name, Namespace: namespace, Cluster: cluster, Status: p.targetStatus, Spec: *spec}
+	p.vms[vmKey(cluster, namespace, spec.Name)] = vm
+	return vm, nil
+}
+
+func (p *clusterAwareProvider) DeleteVM(_ context.Context, cluster, namespace, name string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.vms, vmKey(cluster, namespace, name))
+	return nil
+}
+
+func (p *clusterAwareProvider) CreateSnapshot(_ context.Context, _, namespace, vmName, snapshotName string) (*domain.Snapshot, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	snap := &domain.Snapshot{Name: snapshotName, VMName: vmName, Namespace: namespace, Ready: true}
+	p.snapshots[snapshotName] = snap
+	return snap, nil
+}
+
+func (p *clusterAwareProvider) GetSnapshot(_ context.Context, _, _, name string) (*domain.Snapshot, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	snap, ok := p.snapshots[name]
+	if !ok {
+		return nil, fmt.Errorf("snapshot %s not found", name)
+	}
+	return snap, nil
+}
+
+func (p *clusterAwareProvider) ListSnapshots(context.Context, string, string, string) ([]*domain.Snapshot, error) {
+	return nil, nil
+}
+
+func (p *clusterAwareProvider) DeleteSnapshot(_ context.Context, _, _, name string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.snapshots, name)
+	return nil
+}
+
+func (p *clusterAwareProvider) RestoreSnapshot(context.Context, string, string, string, string, bool) (*domain.SnapshotRestore, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (p *clusterAwareProvider) GetSnapshotRestore(context.Context, string, string, string) (*domain.SnapshotRestore, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (p *clusterAwareProvider) seed(cluster string, vm *domain.VM) {
+	p.vms[vmKey(cluster, vm.Namespace, vm.Name)] = vm
+}
+
+func TestExecuteK8sMigration(t *testing.T) {
+	t.Parallel()
+
+	source := func() *domain.VM {
+		return &domain.VM{
+			Name:      "vm-1",
+			Namespace: "team-a",
+			Cluster:   "cluster-a",
+			Status:    domain.VMStatusRunning,
+			Spec:      domain.VMSpec{Name: "vm-1", CPU: 2, MemoryMB: 2048, Image: "fedora"},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		setup         func(p *clusterAwareProvider)
+		wantErr       string
+		wantOnSource  bool
+		wantOnTarget  bool
+		wantSnapshots int
+	}{
+		{
+			name:         "moves vm to target cluster",
+			wantOnTarget: true,
+		},
+		{
+			name:          "target create failure keeps source",
+			setup:         func(p *clusterAwareProvider) { p.failCreateFor = "cluster-b" },
+			wantErr:       "create target vm",
+			wantOnSource:  true,
+			wantSnapshots: 1,
+		},
+		{
+			name:          "target failing to start is rolled back",
+			setup:         func(p *clusterAwareProvider) { p.targetStatus = domain.VMStatusFailed },
+			wantErr:       "failed to start",
+			wantOnSource:  true,
+			wantSnapshots: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := newClusterAwareProvider()
+			p.seed("cluster-a", source())
+			if tc.setup != nil {
+				tc.setup(p)
+			}
+
+			vm, err := NewVMService(p).ExecuteK8sMigration(context.Background(), "cluster-a", "cluster-b", "team-a", "vm-1")
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("ExecuteK8sMigration() error = %v, want containing %q", err, tc.wantErr)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("ExecuteK8sMigration() error = %v", err)
+				}
+				if vm.Cluster != "cluster-b" || vm.Spec.CPU != 2 {
+					t.Fatalf("migrated vm = %+v, want cluster-b copy of source spec", vm)
+				}
+			}
+
+			if _, ok := p.vms[vmKey("cluster-a", "team-a", "vm-1")]; ok != tc.wantOnSource {
+				t.Fatalf("vm on source = %v, want %v", ok, tc.wantOnSource)
+			}
+			if _, ok := p.vms[vmKey("cluster-b", "team-a", "vm-1")]; ok != tc.wantOnTarget {
+				t.Fatalf("vm on target = %v, want %v", ok, tc.wantOnTarget)
+			}
+			if len(p.snapshots) != tc.wantSnapshots {
+				t.Fatalf("snapshots = %d, want %d", len(p.snapshots), tc.wantSnapshots)
+			}
+		})
+	}
+}
+
+func TestExecuteK8sMigration_RejectsSameCluster(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewVMService(newClusterAwareProvider()).ExecuteK8sMigration(context.Background(), "cluster-a", "cluster-a", "team-a", "vm-1")
+	if err == nil {
+		t.Fatal("expected error for same source and target cluster")
+	}
+}
+
+// runtimeProvider serves GetVMRuntime and counts lookups.
+type runtimeProvider struct {
+	provider.InfrastructureProvider
+
+	mu    sync.Mutex
+	calls int
+	err   error
+}
+
+func (p *runtimeProvider) GetVMRuntime(_ context.Context, _, _, name string) (*domain.VMRuntime, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.calls++
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &domain.VMRuntime{Phase: "Running", NodeName: "node-" + name}, nil
+}
+
+func TestGetVMRuntime_CachesPerVM(t *testing.T) {
+	infra := &runtimeProvider{}
+	svc := NewVMService(infra)
+	ctx := context.Background()
+
+	first, err := svc.GetVMRuntime(ctx, "cluster-a", "dev", "vm-1")
+	if err != nil {
+		t.Fatalf("GetVMRuntime() error = %v", err)
+	}
+	second, err := svc.GetVMRuntime(ctx, "cluster-a", "dev", "vm-1")
+	if err != nil {
+		t.Fatalf("GetVMRuntime() second call error = %v", err)
+	}
+	if first != second || infra.calls != 1 {
+		t.Fatalf("expected cached runtime on second call, provider calls = %d", infra.calls)
+	}
+
+	if _, err := svc.GetVMRuntime(ctx, "cluster-a", "dev", "vm-2"); err != nil {
+		t.Fatalf("GetVMRuntime(vm-2) error = %v", err)
+	}
+	if infra.calls != 2 {
+		t.Fatalf("expected separate lookup per VM, provider calls = %d", infra.calls)
+	}
+}
+
+func TestGetVMRuntime_DoesNotCacheErrors(t *testing.T) {
+	infra := &runtimeProvider{err: fmt.Errorf("dial tcp: connection refused")}
+	svc := NewVMService(infra)
+	ctx := context.Background()
+
+	if _, err := svc.GetVMRuntime(ctx, "cluster-a", "dev", "vm-1"); err == nil {
+		t.Fatal("expected error from unreachable cluster")
+	}
+	infra.err = nil
+	runtime, err := svc.GetVMRuntime(ctx, "cluster-a", "dev", "vm-1")
+	if err != nil {
+		t.Fatalf("GetVMRuntime() after recovery error = %v", err)
+	}
+	if runtime.NodeName != "node-vm-1" || infra.calls != 2 {
+		t.Fatalf("unexpected runtime %+v after %d calls", runtime, infra.calls)
+	}
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/provider"
+)
+
+// ErrSnapshotsUnsupported is returned when the configured provider cannot
+// manage VM snapshots.
+var ErrSnapshotsUnsupported = errors.New("provider does not support vm snapshots")
+
+func (s *VMService) snapshots() (provider.SnapshotProvider, error) {
+	snapshots, ok := s.infra.(provider.SnapshotProvider)
+	if !ok {
+		return nil, ErrSnapshotsUnsupported
+	}
+	return snapshots, nil
+}
+
+// ListSnapshots lists the snapshots of a VM, oldest first.
+func (s *VMService) ListSnapshots(ctx context.Context, cluster, namespace, vmName string) ([]*domain.Snapshot, error) {
+	snapshots, err := s.snapshots()
+	if err != nil {
+		return nil, err
+	}
+	items, err := snapshots.ListSnapshots(ctx, cluster, namespace, vmName)
+	if err != nil {
+		return nil, fmt.Errorf("list snapshots: %w", err)
+	}
+	return items, nil
+}
+
+// GetSnapshot retrieves a single snapshot.
+func (s *VMService) GetSnapshot(ctx context.Context, cluster, namespace, name string) (*domain.Snapshot, error) {
+	snapshots, err := s.snapshots()
+	if err != nil {
+		return nil, err
+	}
+	return snapshots.GetSnapshot(ctx, cluster, namespace, name)
+}
+
+// DeleteSnapshot deletes a snapshot.
+func (s *VMService) DeleteSnapshot(ctx context.Context, cluster, namespace, name string) error {
+	snapshots, err := s.snapshots()
+	if err != nil {
+		return err
+	}
+	return snapshots.DeleteSnapshot(ctx, cluster, namespace, name)
+}
+
+// ExecuteK8sSnapshot snapshots a VM and waits until the snapshot is ready
+// (outside transaction).
+func (s *VMService) ExecuteK8sSnapshot(ctx context.Context, cluster, namespace, vmName, snapshotName string) (*domain.Snapshot, error) {
+	snapshots, err := s.snapshots()
+	if err != nil {
+		return nil, fmt.Errorf("execute k8s snapshot: %w", err)
+	}
+	if _, err := snapshots.CreateSnapshot(ctx, cluster, namespace, vmName, snapshotName); err != nil {
+		return nil, fmt.Errorf("execute k8s snapshot: %w", err)
+	}
+	if err := s.waitForSnapshot(ctx, snapshots, cluster, namespace, snapshotName); err != nil {
+		return nil, fmt.Errorf("execute k8s snapshot: %w", err)
+	}
+	snap, err := snapshots.GetSnapshot(ctx, cluster, namespace, snapshotName)
+	if err != nil {
+		return nil, fmt.Errorf("execute k8s snapshot: %w", err)
+	}
+
+	logger.Info("VM snapshot ready",
+		zap.String("cluster", cluster),
+		zap.String("namespace", namespace),
+		zap.String("vm_name", vmName),
+		zap.String("snapshot", snapshotName),
+	)
+	return snap, nil
+}
+
+// ExecuteK8sRestore restores a VM in place from one of its snapshots and waits
+// for the restore to complete (outside transaction). force lets KubeVirt stop
+// a running VM first; without it a running VM fails the restore.
+func (s *VMService) ExecuteK8sRestore(ctx context.Context, cluster, namespace, vmName, snapshotName string, force bool) (*domain.SnapshotRestore, error) {
+	snapshots, err := s.snapshots()
+	if err != nil {
+		return nil, fmt.Errorf("execute k8s restore: %w", err)
+	}
+	restore, err := snapshots.RestoreSnapshot(ctx, cluster, namespace, vmName, snapshotName, force)
+	if err != nil {
+		return nil, fmt.Errorf("execute k8s restore: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, migrationReadyTimeout)
+	defer cancel()
+	for {
+		switch {
+		case restore.Error != "":
+			return nil, fmt.Errorf("execute k8s restore: restore %s failed: %s", restore.Name, restore.Error)
+		case restore.Complete:
+			logger.Info("VM restored from snapshot",
+				zap.String("cluster", cluster),
+				zap.String("namespace", namespace),
+				zap.String("vm_name", vmName),
+				zap.String("snapshot", snapshotName),
+			)
+			return restore, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("execute k8s restore: wait for restore %s: %w", restore.Name, ctx.Err())
+		case <-time.After(migrationPollInterval):
+		}
+		if restore, err = snapshots.GetSnapshotRestore(ctx, cluster, namespace, restore.Name); err != nil {
+			return nil, fmt.Errorf("execute k8s restore: get restore: %w", err)
+		}
+	}
+}
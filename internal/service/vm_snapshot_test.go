@@ -0,0 +1,68 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/provider"
+)
+
+func TestExecuteK8sSnapshotAndRestore(t *testing.T) {
+	t.Parallel()
+
+	mock := provider.NewMockProvider()
+	mock.Seed([]*domain.VM{
+		{Name: "web-01", Namespace: "prod", Status: domain.VMStatusRunning, Spec: domain.VMSpec{CPU: 2, MemoryMB: 4096}},
+	})
+	svc := NewVMService(mock)
+	ctx := t.Context()
+
+	snap, err := svc.ExecuteK8sSnapshot(ctx, "cluster-a", "prod", "web-01", "before-upgrade")
+	if err != nil {
+		t.Fatalf("ExecuteK8sSnapshot() error = %v", err)
+	}
+	if !snap.Ready || snap.VMName != "web-01" {
+		t.Fatalf("snapshot = %+v, want ready snapshot of web-01", snap)
+	}
+	if _, err := mock.UpdateVM(ctx, "cluster-a", "prod", "web-01", &domain.VMSpec{CPU: 8, MemoryMB: 16384}); err != nil {
+		t.Fatalf("update vm: %v", err)
+	}
+
+	if _, err := svc.ExecuteK8sRestore(ctx, "cluster-a", "prod", "web-01", "before-upgrade", false); err == nil {
+		t.Fatal("restore of running vm without force succeeded, want error")
+	}
+	if _, err := svc.ExecuteK8sRestore(ctx, "cluster-a", "prod", "web-01", "before-upgrade", true); err != nil {
+		t.Fatalf("forced ExecuteK8sRestore() error = %v", err)
+	}
+	vm, err := mock.GetVM(ctx, "cluster-a", "prod", "web-01")
+	if err != nil {
+		t.Fatalf("get vm: %v", err)
+	}
+	if vm.Status != domain.VMStatusStopped || vm.Spec.CPU != 2 || vm.Spec.MemoryMB != 4096 {
+		t.Fatalf("restored vm status=%s spec=%+v, want STOPPED with 2 CPU / 4096 MB", vm.Status, vm.Spec)
+	}
+
+	list, err := svc.ListSnapshots(ctx, "cluster-a", "prod", "web-01")
+	if err != nil || len(list) != 1 {
+		t.Fatalf("ListSnapshots() = %d items, err %v; want 1", len(list), err)
+	}
+	if err := svc.DeleteSnapshot(ctx, "cluster-a", "prod", "before-upgrade"); err != nil {
+		t.Fatalf("DeleteSnapshot() error = %v", err)
+	}
+}
+
+func TestSnapshots_UnsupportedProvider(t *testing.T) {
+	t.Parallel()
+
+	// Embedding only the base interface hides the mock's snapshot methods.
+	svc := NewVMService(struct {
+		provider.InfrastructureProvider
+	}{provider.NewMockProvider()})
+	if _, err := svc.ListSnapshots(t.Context(), "cluster-a", "prod", "web-01"); !errors.Is(err, ErrSnapshotsUnsupported) {
+		t.Fatalf("ListSnapshots() error = %v, want ErrSnapshotsUnsupported", err)
+	}
+	if _, err := svc.ExecuteK8sSnapshot(t.Context(), "cluster-a", "prod", "web-01", "snap"); !errors.Is(err, ErrSnapshotsUnsupported) {
+		t.Fatalf("ExecuteK8sSnapshot() error = %v, want ErrSnapshotsUnsupported", err)
+	}
+}
@@ -0,0 +1,358 @@
+package vmspec
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/instancesize"
+	"kv-shepherd.io/shepherd/ent/platformconfig"
+	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
+)
+
+// OverrideType is the JSON type a modified_spec entry accepts.
+type OverrideType string
+
+const (
+	OverrideTypeString  OverrideType = "string"
+	OverrideTypeInteger OverrideType = "integer"
+	OverrideTypeNumber  OverrideType = "number"
+	OverrideTypeBoolean OverrideType = "boolean"
+	OverrideTypeObject  OverrideType = "object"
+	OverrideTypeArray   OverrideType = "array"
+)
+
+// Valid reports whether t is a known type.
+func (t OverrideType) Valid() bool {
+	switch t {
+	case OverrideTypeString, OverrideTypeInteger, OverrideTypeNumber,
+		OverrideTypeBoolean, OverrideTypeObject, OverrideTypeArray:
+		return true
+	}
+	return false
+}
+
+func (t OverrideType) matches(value interface{}) bool {
+	switch t {
+	case OverrideTypeString:
+		_, ok := value.(string)
+		return ok
+	case OverrideTypeInteger:
+		_, ok := integerValue(value)
+		return ok
+	case OverrideTypeNumber:
+		_, ok := toFloat(value)
+		return ok
+	case OverrideTypeBoolean:
+		_, ok := value.(bool)
+		return ok
+	case OverrideTypeObject:
+		_, ok := value.(map[string]interface{})
+		return ok
+	case OverrideTypeArray:
+		_, ok := value.([]interface{})
+		return ok
+	}
+	return false
+}
+
+// OverridePolicy maps the spec.* paths approvers may set in modified_spec
+// to the type each accepts. A path also admits the paths beneath it, with
+// values of any type.
+type OverridePolicy map[string]OverrideType
+
+func (p OverridePolicy) lookup(path string) (OverrideType, bool) {
+	if t, ok := p[path]; ok {
+		return t, true
+	}
+	for allowed := range p {
+		if strings.HasPrefix(path, allowed+".") {
+			return "", true
+		}
+	}
+	return "", false
+}
+
+// ValidOverridePath reports whether path can be whitelisted: a dotted
+// spec.* path without empty segments.
+func ValidOverridePath(path string) bool {
+	if !strings.HasPrefix(path, "spec.") {
+		return false
+	}
+	for _, segment := range strings.Split(path, ".") {
+		if strings.TrimSpace(segment) != segment || segment == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// LoadOverridePolicy reads the platform's spec override policy. Without
+// one, no spec.* path may be overridden.
+func LoadOverridePolicy(ctx context.Context, client *ent.Client) (OverridePolicy, error) {
+	cfg, err := client.PlatformConfig.Get(ctx, platformconfig.DefaultID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return OverridePolicy{}, nil
+		}
+		return nil, fmt.Errorf("get spec override policy: %w", err)
+	}
+	policy := make(OverridePolicy, len(cfg.SpecOverridePolicy))
+	for path, t := range cfg.SpecOverridePolicy {
+		policy[path] = OverrideType(t)
+	}
+	return policy, nil
+}
+
+// Violation codes of modified_spec entries.
+const (
+	ViolationNotAllowed  = "NOT_ALLOWED"
+	ViolationInvalidType = "INVALID_TYPE"
+	ViolationOutOfBounds = "OUT_OF_BOUNDS"
+)
+
+// Violation is a rejected modified_spec entry. Field is the key, or
+// spec_overrides.<path> for entries of the nested spec_overrides map.
+type Violation struct {
+	Field   string
+	Code    string
+	Message string
+}
+
+// modifiedSpecFields are the modified_spec keys besides spec.* paths.
+var modifiedSpecFields = map[string]OverrideType{
+	"cpu":              OverrideTypeInteger,
+	"memory_mb":        OverrideTypeInteger,
+	"disk_gb":          OverrideTypeInteger,
+	"template_id":      OverrideTypeString,
+	"instance_size_id": OverrideTypeString,
+}
+
+// CheckModifiedSpec returns the entries of modifiedSpec that are not
+// whitelisted or have the wrong type, sorted by field. Besides the keys in
+// modifiedSpecFields, spec.* paths allowed by policy may be given as keys
+// or inside a spec_overrides object.
+func CheckModifiedSpec(modifiedSpec map[string]interface{}, policy OverridePolicy) []Violation {
+	_, violations := checkModifiedSpec(modifiedSpec, policy)
+	return violations
+}
+
+// FilterModifiedSpec returns modifiedSpec without the entries
+// CheckModifiedSpec rejects, and the rejected fields.
+func FilterModifiedSpec(modifiedSpec map[string]interface{}, policy OverridePolicy) (map[string]interface{}, []string) {
+	kept, violations := checkModifiedSpec(modifiedSpec, policy)
+	if len(violations) == 0 {
+		return modifiedSpec, nil
+	}
+	dropped := make([]string, len(violations))
+	for i, v := range violations {
+		dropped[i] = v.Field
+	}
+	return kept, dropped
+}
+
+func checkModifiedSpec(modifiedSpec map[string]interface{}, policy OverridePolicy) (map[string]interface{}, []Violation) {
+	if len(modifiedSpec) == 0 {
+		return nil, nil
+	}
+	kept := make(map[string]interface{}, len(modifiedSpec))
+	var violations []Violation
+	for key, value := range modifiedSpec {
+		switch {
+		case key == "spec_overrides":
+			nested, ok := value.(map[string]interface{})
+			if !ok {
+				violations = append(violations, Violation{
+					Field: key, Code: ViolationInvalidType, Message: "spec_overrides must be an object",
+				})
+				continue
+			}
+			keptNested := make(map[string]interface{}, len(nested))
+			for path, v := range nested {
+				if violation, ok := checkOverridePath(key+"."+path, path, v, policy); !ok {
+					violations = append(violations, violation)
+					continue
+				}
+				keptNested[path] = v
+			}
+			if len(keptNested) > 0 {
+				kept[key] = keptNested
+			}
+		case strings.HasPrefix(key, "spec."):
+			if violation, ok := checkOverridePath(key, key, value, policy); !ok {
+				violations = append(violations, violation)
+				continue
+			}
+			kept[key] = value
+		default:
+			t, known := modifiedSpecFields[key]
+			if !known {
+				violations = append(violations, Violation{
+					Field: key, Code: ViolationNotAllowed, Message: key + " is not a modifiable field",
+				})
+				continue
+			}
+			if msg := checkFieldValue(key, t, value); msg != "" {
+				violations = append(violations, Violation{Field: key, Code: ViolationInvalidType, Message: msg})
+				continue
+			}
+			kept[key] = value
+		}
+	}
+	sort.Slice(violations, func(i, j int) bool { return violations[i].Field < violations[j].Field })
+	return kept, violations
+}
+
+func checkOverridePath(field, path string, value interface{}, policy OverridePolicy) (Violation, bool) {
+	t, ok := policy.lookup(strings.TrimSpace(path))
+	if !ok {
+		return Violation{
+			Field: field, Code: ViolationNotAllowed, Message: path + " is not allowed by the spec override policy",
+		}, false
+	}
+	if t != "" && !t.matches(value) {
+		return Violation{
+			Field: field, Code: ViolationInvalidType, Message: fmt.Sprintf("%s must be of type %s", path, t),
+		}, false
+	}
+	return Violation{}, true
+}
+
+func checkFieldValue(key string, t OverrideType, value interface{}) string {
+	if t == OverrideTypeString {
+		if s, ok := value.(string); !ok || strings.TrimSpace(s) == "" {
+			return key + " must be a non-empty string"
+		}
+		return ""
+	}
+	n, ok := integerValue(value)
+	if !ok {
+		return key + " must be an integer"
+	}
+	if key == "disk_gb" {
+		if n < 0 {
+			return key + " must not be negative"
+		}
+		return ""
+	}
+	if n <= 0 {
+		return key + " must be positive"
+	}
+	return ""
+}
+
+// ValidateModifiedSpec runs CheckModifiedSpec and checks that referenced
+// templates and instance sizes exist and are enabled. Unless unbounded, cpu
+// and memory_mb may not exceed the largest enabled instance size.
+func ValidateModifiedSpec(
+	ctx context.Context,
+	client *ent.Client,
+	modifiedSpec map[string]interface{},
+	policy OverridePolicy,
+	unbounded bool,
+) ([]Violation, error) {
+	violations := CheckModifiedSpec(modifiedSpec, policy)
+	rejected := make(map[string]bool, len(violations))
+	for _, v := range violations {
+		rejected[v.Field] = true
+	}
+
+	if id, ok := modifiedSpec["template_id"].(string); ok && !rejected["template_id"] {
+		tpl, err := client.Template.Get(ctx, strings.TrimSpace(id))
+		switch {
+		case ent.IsNotFound(err):
+			violations = append(violations, Violation{
+				Field: "template_id", Code: apperrors.CodeTemplateNotFound, Message: "template " + id + " not found",
+			})
+		case err != nil:
+			return nil, fmt.Errorf("get template %s: %w", id, err)
+		case !tpl.Enabled:
+			violations = append(violations, Violation{
+				Field: "template_id", Code: apperrors.CodeTemplateDisabled, Message: "template " + id + " is disabled",
+			})
+		}
+	}
+	if id, ok := modifiedSpec["instance_size_id"].(string); ok && !rejected["instance_size_id"] {
+		size, err := client.InstanceSize.Get(ctx, strings.TrimSpace(id))
+		switch {
+		case ent.IsNotFound(err):
+			violations = append(violations, Violation{
+				Field: "instance_size_id", Code: apperrors.CodeInstanceSizeNotFound, Message: "instance size " + id + " not found",
+			})
+		case err != nil:
+			return nil, fmt.Errorf("get instance size %s: %w", id, err)
+		case !size.Enabled:
+			violations = append(violations, Violation{
+				Field: "instance_size_id", Code: apperrors.CodeInstanceSizeDisabled, Message: "instance size " + id + " is disabled",
+			})
+		}
+	}
+
+	_, hasCPU := modifiedSpec["cpu"]
+	_, hasMemory := modifiedSpec["memory_mb"]
+	if !unbounded && ((hasCPU && !rejected["cpu"]) || (hasMemory && !rejected["memory_mb"])) {
+		sizes, err := client.InstanceSize.Query().Where(instancesize.EnabledEQ(true)).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list enabled instance sizes: %w", err)
+		}
+		maxCPU, maxMemoryMB := 0, 0
+		for _, size := range sizes {
+			maxCPU = max(maxCPU, size.CPUCores)
+			maxMemoryMB = max(maxMemoryMB, size.MemoryMB)
+		}
+		for _, bound := range []struct {
+			field string
+			limit int
+		}{{"cpu", maxCPU}, {"memory_mb", maxMemoryMB}} {
+			if rejected[bound.field] {
+				continue
+			}
+			if n, ok := integerValue(modifiedSpec[bound.field]); ok && n > bound.limit {
+				violations = append(violations, Violation{
+					Field:   bound.field,
+					Code:    ViolationOutOfBounds,
+					Message: fmt.Sprintf("%s must not exceed %d, the largest enabled instance size", bound.field, bound.limit),
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(violations, func(i, j int) bool { return violations[i].Field < violations[j].Field })
+	return violations, nil
+}
+
+// integerValue returns value as an int when it is a whole JSON number.
+func integerValue(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case int32:
+		return int(v), true
+	case int64:
+		return int(v), true
+	}
+	f, ok := toFloat(value)
+	if !ok || f != math.Trunc(f) || math.IsInf(f, 0) {
+		return 0, false
+	}
+	return int(f), true
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
@@ -0,0 +1,168 @@
+package vmspec
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"kv-shepherd.io/shepherd/ent/platformconfig"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func violationSummary(violations []Violation) map[string]string {
+	out := make(map[string]string, len(violations))
+	for _, v := range violations {
+		out[v.Field] = v.Code
+	}
+	return out
+}
+
+func TestCheckModifiedSpec(t *testing.T) {
+	t.Parallel()
+
+	policy := OverridePolicy{
+		"spec.template.spec.domain.cpu.dedicatedCpuPlacement": OverrideTypeBoolean,
+		"spec.template.metadata.labels":                       OverrideTypeObject,
+	}
+	got := violationSummary(CheckModifiedSpec(map[string]interface{}{
+		"cpu":              float64(4),
+		"memory_mb":        4096.5,
+		"disk_gb":          float64(-1),
+		"template_id":      "tpl-1",
+		"instance_size_id": " ",
+		"image":            "quay.io/evil:latest",
+		"resources":        map[string]interface{}{"cpu": 64},
+		"spec":             map[string]interface{}{"running": true},
+		"spec.template.spec.domain.cpu.dedicatedCpuPlacement": "yes",
+		"spec.template.metadata.labels.team":                  "db",
+		"spec.template.spec.hostname":                         "pwned",
+		"spec_overrides": map[string]interface{}{
+			"spec.template.spec.domain.cpu.dedicatedCpuPlacement": true,
+			"spec.template.spec.nodeSelector":                     map[string]interface{}{"gpu": "true"},
+		},
+	}, policy))
+	want := map[string]string{
+		"memory_mb":        ViolationInvalidType,
+		"disk_gb":          ViolationInvalidType,
+		"instance_size_id": ViolationInvalidType,
+		"image":            ViolationNotAllowed,
+		"resources":        ViolationNotAllowed,
+		"spec":             ViolationNotAllowed,
+		"spec.template.spec.domain.cpu.dedicatedCpuPlacement": ViolationInvalidType,
+		"spec.template.spec.hostname":                         ViolationNotAllowed,
+		"spec_overrides.spec.template.spec.nodeSelector":      ViolationNotAllowed,
+	}
+	if !maps.Equal(got, want) {
+		t.Fatalf("CheckModifiedSpec() = %v, want %v", got, want)
+	}
+
+	if got := CheckModifiedSpec(map[string]interface{}{"cpu": 4, "spec_overrides": "x"}, nil); len(got) != 1 || got[0].Field != "spec_overrides" {
+		t.Fatalf("non-object spec_overrides violations = %+v", got)
+	}
+	if got := CheckModifiedSpec(nil, policy); len(got) != 0 {
+		t.Fatalf("empty modified_spec violations = %+v, want none", got)
+	}
+}
+
+func TestFilterModifiedSpec(t *testing.T) {
+	t.Parallel()
+
+	policy := OverridePolicy{"spec.template.spec.domain.cpu.dedicatedCpuPlacement": OverrideTypeBoolean}
+	kept, dropped := FilterModifiedSpec(map[string]interface{}{
+		"cpu":   4,
+		"image": "quay.io/evil:latest",
+		"spec_overrides": map[string]interface{}{
+			"spec.template.spec.domain.cpu.dedicatedCpuPlacement": true,
+			"spec.template.spec.hostname":                         "pwned",
+		},
+	}, policy)
+	if want := []string{"image", "spec_overrides.spec.template.spec.hostname"}; !slices.Equal(dropped, want) {
+		t.Fatalf("dropped = %v, want %v", dropped, want)
+	}
+	nested, _ := kept["spec_overrides"].(map[string]interface{})
+	if len(kept) != 2 || kept["cpu"] != 4 || len(nested) != 1 || nested["spec.template.spec.domain.cpu.dedicatedCpuPlacement"] != true {
+		t.Fatalf("kept = %v", kept)
+	}
+
+	spec := map[string]interface{}{"cpu": 4}
+	if kept, dropped := FilterModifiedSpec(spec, nil); dropped != nil || !maps.Equal(kept, spec) {
+		t.Fatalf("FilterModifiedSpec() of a clean spec = %v, %v", kept, dropped)
+	}
+}
+
+func TestValidOverridePath(t *testing.T) {
+	t.Parallel()
+
+	for path, want := range map[string]bool{
+		"spec.template.spec.domain.cpu.dedicatedCpuPlacement": true,
+		"spec.running":    true,
+		"spec":            false,
+		"spec.":           false,
+		"spec..x":         false,
+		"metadata.labels": false,
+		"spec. running":   false,
+	} {
+		if got := ValidOverridePath(path); got != want {
+			t.Errorf("ValidOverridePath(%q) = %v, want %v", path, got, want)
+		}
+	}
+}
+
+func TestValidateModifiedSpec(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "vmspec_validate_modified_spec")
+	ctx := t.Context()
+	client.Template.Create().SetID("tpl-1").SetName("fedora").SetVersion(1).SetCreatedBy("admin-1").ExecX(ctx)
+	client.Template.Create().SetID("tpl-old").SetName("centos").SetVersion(1).SetEnabled(false).SetCreatedBy("admin-1").ExecX(ctx)
+	client.InstanceSize.Create().SetID("size-large").SetName("large").SetCPUCores(8).SetMemoryMB(16384).SetCreatedBy("admin-1").ExecX(ctx)
+	client.InstanceSize.Create().SetID("size-huge").SetName("huge").SetCPUCores(64).SetMemoryMB(262144).SetEnabled(false).SetCreatedBy("admin-1").ExecX(ctx)
+
+	violations, err := ValidateModifiedSpec(ctx, client, map[string]interface{}{
+		"cpu": 8, "memory_mb": 16384, "template_id": "tpl-1", "instance_size_id": "size-large",
+	}, nil, false)
+	if err != nil || len(violations) != 0 {
+		t.Fatalf("in-bounds ValidateModifiedSpec() = %+v, %v; want no violations", violations, err)
+	}
+
+	violations, err = ValidateModifiedSpec(ctx, client, map[string]interface{}{
+		"cpu": 9, "memory_mb": 32768, "template_id": "tpl-old", "instance_size_id": "size-missing",
+	}, nil, false)
+	if err != nil {
+		t.Fatalf("ValidateModifiedSpec() error = %v", err)
+	}
+	want := map[string]string{
+		"cpu":              ViolationOutOfBounds,
+		"memory_mb":        ViolationOutOfBounds,
+		"template_id":      "TEMPLATE_DISABLED",
+		"instance_size_id": "INSTANCE_SIZE_NOT_FOUND",
+	}
+	if got := violationSummary(violations); !maps.Equal(got, want) {
+		t.Fatalf("out-of-bounds violations = %v, want %v", got, want)
+	}
+
+	violations, err = ValidateModifiedSpec(ctx, client, map[string]interface{}{"cpu": 32, "memory_mb": 65536}, nil, true)
+	if err != nil || len(violations) != 0 {
+		t.Fatalf("unbounded ValidateModifiedSpec() = %+v, %v; want no violations", violations, err)
+	}
+}
+
+func TestLoadOverridePolicy(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "vmspec_load_override_policy")
+	ctx := t.Context()
+	policy, err := LoadOverridePolicy(ctx, client)
+	if err != nil || len(policy) != 0 {
+		t.Fatalf("LoadOverridePolicy() without config = %v, %v; want an empty policy", policy, err)
+	}
+
+	client.PlatformConfig.Create().
+		SetID(platformconfig.DefaultID).
+		SetSpecOverridePolicy(map[string]string{"spec.running": "boolean"}).
+		ExecX(ctx)
+	policy, err = LoadOverridePolicy(ctx, client)
+	if err != nil || !maps.Equal(policy, OverridePolicy{"spec.running": OverrideTypeBoolean}) {
+		t.Fatalf("LoadOverridePolicy() = %v, %v", policy, err)
+	}
+}
@@ -0,0 +1,320 @@
+package vmspec
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"kv-shepherd.io/shepherd/internal/domain"
+)
+
+func resolveEffectiveSelectionIDs(
+	payload domain.VMCreationPayload,
+	modifiedSpec map[string]interface{},
+) (templateID, instanceSizeID string) {
+	templateID = strings.TrimSpace(payload.TemplateID)
+	instanceSizeID = strings.TrimSpace(payload.InstanceSizeID)
+	if override := lookupStringValue(modifiedSpec, "template_id"); override != "" {
+		templateID = override
+	}
+	if override := lookupStringValue(modifiedSpec, "instance_size_id"); override != "" {
+		instanceSizeID = override
+	}
+	return templateID, instanceSizeID
+}
+
+func applyInstanceSizeSnapshotOverrides(cpu, memoryMB, diskGB *int, snapshot map[string]interface{}) {
+	if cpu == nil || memoryMB == nil || diskGB == nil {
+		return
+	}
+	if v, ok := lookupIntValue(snapshot, "cpu_cores", "cpu"); ok {
+		*cpu = v
+	}
+	if v, ok := lookupIntValue(snapshot, "memory_mb", "memory"); ok {
+		*memoryMB = v
+	}
+	if v, ok := lookupIntValue(snapshot, "disk_gb", "disk"); ok && v >= 0 {
+		*diskGB = v
+	}
+}
+
+func resolveInstanceSizeSpecOverrides(
+	baseOverrides map[string]interface{},
+	snapshot map[string]interface{},
+) map[string]interface{} {
+	if snapOverrides := extractSpecOverridesFromSnapshot(snapshot); len(snapOverrides) > 0 {
+		return snapOverrides
+	}
+	return cloneMapValues(baseOverrides)
+}
+
+func extractSpecOverridesFromSnapshot(snapshot map[string]interface{}) map[string]interface{} {
+	if len(snapshot) == 0 {
+		return nil
+	}
+	if raw, ok := lookupValue(snapshot, "spec_overrides"); ok {
+		if overrides, ok := toMap(raw); ok {
+			return cloneMapValues(overrides)
+		}
+	}
+	// Backward compatibility: some rows may already store path->value pairs directly.
+	if isLikelySpecOverrideMap(snapshot) {
+		return cloneMapValues(snapshot)
+	}
+	return nil
+}
+
+// applyModifiedSpecOverrides applies the approver's modified_spec to spec and
+// returns the fields it set, named as in Result.Sources.
+func applyModifiedSpecOverrides(spec *domain.VMSpec, modifiedSpec map[string]interface{}) []string {
+	if spec == nil || len(modifiedSpec) == 0 {
+		return nil
+	}
+	var fields []string
+	if v, ok := lookupIntValue(modifiedSpec, "cpu", "resources.cpu"); ok {
+		spec.CPU = v
+		fields = append(fields, "cpu")
+	}
+	if v, ok := lookupIntValue(modifiedSpec, "memory_mb", "resources.memory_mb"); ok {
+		spec.MemoryMB = v
+		fields = append(fields, "memory_mb")
+	}
+	if v, ok := lookupIntValue(modifiedSpec, "disk_gb", "resources.disk_gb"); ok && v >= 0 {
+		spec.DiskGB = v
+		fields = append(fields, "disk_gb")
+	}
+	if image, err := extractTemplateImage(modifiedSpec); err == nil && strings.TrimSpace(image) != "" {
+		spec.Image = image
+		fields = append(fields, "image")
+	}
+	patches := extractSpecOverridesFromModifiedSpec(modifiedSpec)
+	for path := range patches {
+		fields = append(fields, "spec_overrides."+path)
+	}
+	spec.SpecOverrides = applySpecOverridePatches(spec.SpecOverrides, patches)
+	return fields
+}
+
+func extractSpecOverridesFromModifiedSpec(modifiedSpec map[string]interface{}) map[string]interface{} {
+	if len(modifiedSpec) == 0 {
+		return nil
+	}
+	overrides := map[string]interface{}{}
+	if raw, ok := lookupValue(modifiedSpec, "spec_overrides"); ok {
+		if nested, ok := toMap(raw); ok {
+			for k, v := range nested {
+				key := strings.TrimSpace(k)
+				if key == "" {
+					continue
+				}
+				overrides[key] = v
+			}
+		}
+	}
+	for k, v := range modifiedSpec {
+		key := strings.TrimSpace(k)
+		if key == "" {
+			continue
+		}
+		if key == "spec" || strings.HasPrefix(key, "spec.") {
+			overrides[key] = v
+		}
+	}
+	if len(overrides) == 0 {
+		return nil
+	}
+	return overrides
+}
+
+func applySpecOverridePatches(
+	base map[string]interface{},
+	patches map[string]interface{},
+) map[string]interface{} {
+	if len(base) == 0 && len(patches) == 0 {
+		return nil
+	}
+	merged := cloneMapValues(base)
+	for k, v := range patches {
+		merged[k] = v
+	}
+	return merged
+}
+
+func cloneMapValues(src map[string]interface{}) map[string]interface{} {
+	if len(src) == 0 {
+		return nil
+	}
+	dst := make(map[string]interface{}, len(src))
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
+
+func isLikelySpecOverrideMap(values map[string]interface{}) bool {
+	for key := range values {
+		trimmed := strings.TrimSpace(key)
+		if trimmed == "spec" || strings.HasPrefix(trimmed, "spec.") {
+			return true
+		}
+	}
+	return false
+}
+
+func extractTemplateImage(templateSpec map[string]interface{}) (string, error) {
+	if image := lookupStringValue(templateSpec, "image", "image_source.image", "source.image"); image != "" {
+		return image, nil
+	}
+	if pvc := lookupStringValue(
+		templateSpec,
+		"pvc_name",
+		"image_source.pvc_name",
+		"image_source.pvc.name",
+		"source.pvc_name",
+		"source.pvc.name",
+	); pvc != "" {
+		return "pvc:" + pvc, nil
+	}
+
+	for _, path := range []string{
+		"spec.template.spec.volumes",
+		"template.spec.volumes",
+		"volumes",
+	} {
+		raw, ok := lookupValue(templateSpec, path)
+		if !ok {
+			continue
+		}
+		if image := extractImageFromVolumes(raw); image != "" {
+			return image, nil
+		}
+	}
+
+	return "", fmt.Errorf("no supported image source found in template spec")
+}
+
+func extractImageFromVolumes(raw interface{}) string {
+	items, ok := raw.([]interface{})
+	if !ok {
+		return ""
+	}
+	for _, item := range items {
+		volume, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if containerDisk, ok := volume["containerDisk"].(map[string]interface{}); ok {
+			if image := strings.TrimSpace(toString(containerDisk["image"])); image != "" {
+				return image
+			}
+		}
+		if pvc, ok := volume["persistentVolumeClaim"].(map[string]interface{}); ok {
+			if claimName := strings.TrimSpace(toString(pvc["claimName"])); claimName != "" {
+				return "pvc:" + claimName
+			}
+		}
+	}
+	return ""
+}
+
+func lookupStringValue(values map[string]interface{}, paths ...string) string {
+	for _, path := range paths {
+		raw, ok := lookupValue(values, path)
+		if !ok {
+			continue
+		}
+		if str := strings.TrimSpace(toString(raw)); str != "" {
+			return str
+		}
+	}
+	return ""
+}
+
+func lookupIntValue(values map[string]interface{}, paths ...string) (int, bool) {
+	for _, path := range paths {
+		raw, ok := lookupValue(values, path)
+		if !ok {
+			continue
+		}
+		if v, ok := toInt(raw); ok {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+func lookupValue(values map[string]interface{}, path string) (interface{}, bool) {
+	if len(values) == 0 || path == "" {
+		return nil, false
+	}
+	if v, ok := values[path]; ok {
+		return v, true
+	}
+	current := interface{}(values)
+	for _, segment := range strings.Split(path, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		next, ok := m[segment]
+		if !ok {
+			return nil, false
+		}
+		current = next
+	}
+	return current, true
+}
+
+func toMap(raw interface{}) (map[string]interface{}, bool) {
+	switch v := raw.(type) {
+	case map[string]interface{}:
+		return v, true
+	default:
+		return nil, false
+	}
+}
+
+func toInt(raw interface{}) (int, bool) {
+	switch v := raw.(type) {
+	case int:
+		return v, true
+	case int8:
+		return int(v), true
+	case int16:
+		return int(v), true
+	case int32:
+		return int(v), true
+	case int64:
+		return int(v), true
+	case uint:
+		return int(v), true
+	case uint8:
+		return int(v), true
+	case uint16:
+		return int(v), true
+	case uint32:
+		return int(v), true
+	case uint64:
+		return int(v), true
+	case float32:
+		return int(v), true
+	case float64:
+		return int(v), true
+	case string:
+		i, err := strconv.Atoi(strings.TrimSpace(v))
+		if err == nil {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func toString(raw interface{}) string {
+	if raw == nil {
+		return ""
+	}
+	if v, ok := raw.(string); ok {
+		return v
+	}
+	return fmt.Sprint(raw)
+}
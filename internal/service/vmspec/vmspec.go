@@ -0,0 +1,214 @@
+// Package vmspec assembles the domain.VMSpec an approved VM create request is
+// applied with: template, instance size, the ticket's submission snapshots and
+// the approver's modified_spec (master-flow.md Stage 5.C). The create worker
+// and the approval preview share it so the two cannot drift.
+package vmspec
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/domain"
+)
+
+// Source names where a field of the assembled spec came from.
+type Source string
+
+const (
+	// SourceRequest marks values taken from the create request itself, such
+	// as the VM name reserved at submission, user_data or a clone source.
+	SourceRequest Source = "request"
+	// SourceTemplate marks values from the template or its ticket snapshot.
+	SourceTemplate Source = "template"
+	// SourceInstanceSize marks values from the instance size or its ticket snapshot.
+	SourceInstanceSize Source = "instance_size"
+	// SourceOverride marks values the approver set in the ticket's modified_spec.
+	SourceOverride Source = "override"
+)
+
+// Input is a CREATE ticket with the payload of its event.
+type Input struct {
+	EventID string
+	Payload domain.VMCreationPayload
+	Ticket  *ent.ApprovalTicket
+	// ClusterID is the selected cluster. Clones cannot leave their source
+	// cluster; the check is skipped while no cluster is selected.
+	ClusterID string
+}
+
+// Result is an assembled spec.
+type Result struct {
+	Spec *domain.VMSpec
+	// VM is the row reserved for the VM when the request was submitted.
+	VM             *ent.VM
+	TemplateID     string
+	InstanceSizeID string
+	// Sources maps each set field of Spec to where its value came from.
+	// spec_overrides entries are keyed "spec_overrides.<path>".
+	Sources map[string]Source
+	// DroppedOverrides are the modified_spec fields ignored because the
+	// override whitelist or spec override policy rejects them.
+	DroppedOverrides []string
+}
+
+// PermanentError is an assembly failure retrying cannot fix, such as a
+// missing template; the create worker fails the event instead of retrying.
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string { return e.Err.Error() }
+
+func (e *PermanentError) Unwrap() error { return e.Err }
+
+func permanent(format string, args ...interface{}) error {
+	return &PermanentError{Err: fmt.Errorf(format, args...)}
+}
+
+// Build assembles the spec for in. It only reads the database; clones take
+// their root disk from the source VM PVC instead of the template image.
+// modified_spec fields CheckModifiedSpec rejects are dropped, not applied.
+func Build(ctx context.Context, client *ent.Client, in Input) (*Result, error) {
+	payload, ticket := in.Payload, in.Ticket
+	policy, err := LoadOverridePolicy(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	modifiedSpec, dropped := FilterModifiedSpec(ticket.ModifiedSpec, policy)
+	templateID, instanceSizeID := resolveEffectiveSelectionIDs(payload, modifiedSpec)
+	if templateID == "" {
+		return nil, permanent("event %s has empty effective template id", in.EventID)
+	}
+	if instanceSizeID == "" {
+		return nil, permanent("event %s has empty effective instance size id", in.EventID)
+	}
+
+	vmRow, err := client.VM.Query().
+		Where(vm.TicketIDEQ(ticket.ID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, permanent("vm row missing for ticket %s", ticket.ID)
+		}
+		return nil, fmt.Errorf("query vm row for ticket %s: %w", ticket.ID, err)
+	}
+	vmName := strings.TrimSpace(vmRow.Name)
+	if vmName == "" {
+		return nil, permanent("vm row for ticket %s has empty name", ticket.ID)
+	}
+
+	size, err := client.InstanceSize.Get(ctx, instanceSizeID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, permanent("instance size %s not found", instanceSizeID)
+		}
+		return nil, fmt.Errorf("query instance size %s: %w", instanceSizeID, err)
+	}
+	cpu := size.CPUCores
+	memoryMB := size.MemoryMB
+	diskGB := size.DiskGB
+	applyInstanceSizeSnapshotOverrides(&cpu, &memoryMB, &diskGB, ticket.InstanceSizeSnapshot)
+	specOverrides := resolveInstanceSizeSpecOverrides(size.SpecOverrides, ticket.InstanceSizeSnapshot)
+
+	sources := map[string]Source{
+		"name":      SourceRequest,
+		"cpu":       SourceInstanceSize,
+		"memory_mb": SourceInstanceSize,
+	}
+	if diskGB > 0 {
+		sources["disk_gb"] = SourceInstanceSize
+	}
+	for path := range specOverrides {
+		sources["spec_overrides."+path] = SourceInstanceSize
+	}
+
+	// DataVolume clones cannot cross clusters.
+	var image string
+	var cloneSource *domain.PVCCloneSource
+	cloudInitType := domain.CloudInitNoCloud
+	if payload.IsClone() {
+		if payload.SourceClusterID != "" && in.ClusterID != "" && payload.SourceClusterID != in.ClusterID {
+			return nil, permanent(
+				"event %s clones vm %s from cluster %s but cluster %s was selected",
+				in.EventID, payload.SourceVMID, payload.SourceClusterID, in.ClusterID,
+			)
+		}
+		if strings.TrimSpace(payload.SourceNamespace) == "" || strings.TrimSpace(payload.SourcePVCName) == "" {
+			return nil, permanent("event %s clone payload has no source pvc", in.EventID)
+		}
+		cloneSource = &domain.PVCCloneSource{
+			Namespace: payload.SourceNamespace,
+			PVCName:   payload.SourcePVCName,
+		}
+		sources["clone_source"] = SourceRequest
+	} else {
+		tpl, err := client.Template.Get(ctx, templateID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, permanent("template %s not found", templateID)
+			}
+			return nil, fmt.Errorf("query template %s: %w", templateID, err)
+		}
+		templateSpec := tpl.Spec
+		if len(ticket.TemplateSnapshot) > 0 {
+			templateSpec = ticket.TemplateSnapshot
+		}
+		image, err = extractTemplateImage(templateSpec)
+		if err != nil {
+			return nil, permanent("resolve image from template %s: %w", templateID, err)
+		}
+		sources["image"] = SourceTemplate
+		if payload.UserData != "" {
+			cloudInitType, err = domain.ParseCloudInitType(lookupStringValue(templateSpec, "cloud_init_type"))
+			if err != nil {
+				return nil, permanent("template %s: %w", templateID, err)
+			}
+			sources["cloud_init_type"] = SourceTemplate
+		}
+	}
+
+	spec := &domain.VMSpec{
+		Name:     vmName,
+		CPU:      cpu,
+		MemoryMB: memoryMB,
+		DiskGB:   diskGB,
+		Image:    image,
+		Labels: map[string]string{
+			"shepherd.io/service-id":  payload.ServiceID,
+			"shepherd.io/template-id": templateID,
+			"shepherd.io/event-id":    in.EventID,
+		},
+		SpecOverrides: specOverrides,
+		CloneSource:   cloneSource,
+	}
+	if payload.UserData != "" {
+		spec.UserData = payload.UserData
+		spec.CloudInitType = cloudInitType
+		sources["user_data"] = SourceRequest
+	}
+	if payload.IsClone() {
+		spec.Labels["shepherd.io/source-vm-id"] = payload.SourceVMID
+	}
+	for _, field := range applyModifiedSpecOverrides(spec, modifiedSpec) {
+		sources[field] = SourceOverride
+	}
+	if spec.CPU <= 0 || spec.MemoryMB <= 0 || strings.TrimSpace(spec.Name) == "" ||
+		(spec.CloneSource == nil && strings.TrimSpace(spec.Image) == "") {
+		return nil, permanent(
+			"invalid effective vm spec for event %s (name=%q cpu=%d memory_mb=%d image=%q)",
+			in.EventID, spec.Name, spec.CPU, spec.MemoryMB, spec.Image,
+		)
+	}
+
+	return &Result{
+		Spec:             spec,
+		VM:               vmRow,
+		TemplateID:       templateID,
+		InstanceSizeID:   instanceSizeID,
+		Sources:          sources,
+		DroppedOverrides: dropped,
+	}, nil
+}
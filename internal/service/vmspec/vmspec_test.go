@@ -0,0 +1,299 @@
+package vmspec
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/platformconfig"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func TestExtractTemplateImage(t *testing.T) {
+	testCases := []struct {
+		name        string
+		spec        map[string]interface{}
+		expectImage string
+		expectErr   bool
+	}{
+		{
+			name: "direct image_source containerdisk",
+			spec: map[string]interface{}{
+				"image_source": map[string]interface{}{
+					"type":  "containerdisk",
+					"image": "docker.io/kubevirt/centos:7",
+				},
+			},
+			expectImage: "docker.io/kubevirt/centos:7",
+		},
+		{
+			name: "pvc source",
+			spec: map[string]interface{}{
+				"image_source": map[string]interface{}{
+					"type":     "pvc",
+					"pvc_name": "centos-base",
+				},
+			},
+			expectImage: "pvc:centos-base",
+		},
+		{
+			name: "volumes containerDisk fallback",
+			spec: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"template": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"volumes": []interface{}{
+								map[string]interface{}{
+									"name": "rootdisk",
+									"containerDisk": map[string]interface{}{
+										"image": "quay.io/kubevirt/fedora:40",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectImage: "quay.io/kubevirt/fedora:40",
+		},
+		{
+			name:      "missing image source",
+			spec:      map[string]interface{}{"foo": "bar"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			image, err := extractTemplateImage(tc.spec)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if image != tc.expectImage {
+				t.Fatalf("image mismatch: got %q want %q", image, tc.expectImage)
+			}
+		})
+	}
+}
+
+func TestResolveEffectiveSelectionIDs(t *testing.T) {
+	payload := domain.VMCreationPayload{
+		TemplateID:     "tpl-A",
+		InstanceSizeID: "size-A",
+	}
+	templateID, instanceSizeID := resolveEffectiveSelectionIDs(payload, map[string]interface{}{
+		"template_id":      "tpl-B",
+		"instance_size_id": "size-B",
+	})
+
+	if templateID != "tpl-B" {
+		t.Fatalf("templateID mismatch: got %q", templateID)
+	}
+	if instanceSizeID != "size-B" {
+		t.Fatalf("instanceSizeID mismatch: got %q", instanceSizeID)
+	}
+}
+
+func TestApplyModifiedSpecOverrides(t *testing.T) {
+	spec := &domain.VMSpec{
+		Name:     "vm-01",
+		CPU:      2,
+		MemoryMB: 2048,
+		DiskGB:   10,
+		Image:    "old-image:1",
+		SpecOverrides: map[string]interface{}{
+			"spec.template.spec.domain.cpu.cores": float64(2),
+		},
+	}
+
+	fields := applyModifiedSpecOverrides(spec, map[string]interface{}{
+		"cpu":       4,
+		"memory_mb": "4096",
+		"disk_gb":   20,
+		"image_source": map[string]interface{}{
+			"image": "new-image:2",
+		},
+		"spec_overrides": map[string]interface{}{
+			"spec.template.spec.domain.memory.hugepages.pageSize": "2Mi",
+		},
+		"spec.template.spec.domain.cpu.cores": float64(4),
+	})
+
+	if spec.CPU != 4 {
+		t.Fatalf("cpu mismatch: got %d", spec.CPU)
+	}
+	if spec.MemoryMB != 4096 {
+		t.Fatalf("memory mismatch: got %d", spec.MemoryMB)
+	}
+	if spec.DiskGB != 20 {
+		t.Fatalf("disk mismatch: got %d", spec.DiskGB)
+	}
+	if spec.Image != "new-image:2" {
+		t.Fatalf("image mismatch: got %q", spec.Image)
+	}
+	if got := spec.SpecOverrides["spec.template.spec.domain.cpu.cores"]; got != float64(4) {
+		t.Fatalf("spec_overrides cpu path mismatch: got %#v", got)
+	}
+	if got := spec.SpecOverrides["spec.template.spec.domain.memory.hugepages.pageSize"]; got != "2Mi" {
+		t.Fatalf("spec_overrides hugepages path mismatch: got %#v", got)
+	}
+	slices.Sort(fields)
+	want := []string{
+		"cpu", "disk_gb", "image", "memory_mb",
+		"spec_overrides.spec.template.spec.domain.cpu.cores",
+		"spec_overrides.spec.template.spec.domain.memory.hugepages.pageSize",
+	}
+	if !slices.Equal(fields, want) {
+		t.Fatalf("overridden fields = %v, want %v", fields, want)
+	}
+}
+
+func TestResolveInstanceSizeSpecOverrides(t *testing.T) {
+	base := map[string]interface{}{
+		"spec.template.spec.domain.cpu.cores": float64(2),
+	}
+	snapshot := map[string]interface{}{
+		"spec_overrides": map[string]interface{}{
+			"spec.template.spec.domain.cpu.cores":                          float64(6),
+			"spec.template.spec.domain.memory.hugepages.pageSize":          "2Mi",
+			"spec.template.spec.domain.cpu.dedicatedCpuPlacement":          true,
+			"spec.template.spec.domain.resources.requests.memory":          "3072Mi",
+			"spec.template.spec.domain.resources.limits.memory":            "4096Mi",
+			"spec.template.spec.domain.devices.gpus":                       []interface{}{map[string]interface{}{"name": "gpu0", "deviceName": "nvidia.com/A10"}},
+			"spec.template.spec.domain.devices.networkInterfaceMultiqueue": true,
+		},
+	}
+
+	got := resolveInstanceSizeSpecOverrides(base, snapshot)
+	if len(got) == 0 {
+		t.Fatalf("expected snapshot overrides, got empty map")
+	}
+	if got["spec.template.spec.domain.cpu.cores"] != float64(6) {
+		t.Fatalf("expected snapshot cpu override, got %#v", got["spec.template.spec.domain.cpu.cores"])
+	}
+	if got["spec.template.spec.domain.memory.hugepages.pageSize"] != "2Mi" {
+		t.Fatalf("expected hugepages override, got %#v", got["spec.template.spec.domain.memory.hugepages.pageSize"])
+	}
+
+	// Snapshot should override base map for determinism.
+	if len(got) == len(base) {
+		t.Fatalf("expected snapshot map to replace base overrides")
+	}
+}
+
+func TestResolveInstanceSizeSpecOverrides_BackwardCompatibleFlatSnapshot(t *testing.T) {
+	snapshot := map[string]interface{}{
+		"spec.template.spec.domain.cpu.cores": float64(8),
+	}
+	got := resolveInstanceSizeSpecOverrides(nil, snapshot)
+	if got["spec.template.spec.domain.cpu.cores"] != float64(8) {
+		t.Fatalf("expected flat snapshot override to be used, got %#v", got["spec.template.spec.domain.cpu.cores"])
+	}
+}
+
+func TestBuild(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "vmspec_build")
+	ctx := t.Context()
+	sys := client.System.Create().SetID("sys-1").SetName("shop").SetCreatedBy("alice").SaveX(ctx)
+	client.Service.Create().SetID("svc-1").SetName("redis").SetSystemID(sys.ID).ExecX(ctx)
+	client.Template.Create().
+		SetID("tpl-1").
+		SetName("fedora").
+		SetVersion(1).
+		SetCreatedBy("admin-1").
+		SetSpec(map[string]interface{}{"image": "quay.io/kubevirt/fedora:40"}).
+		ExecX(ctx)
+	client.InstanceSize.Create().
+		SetID("size-1").
+		SetName("small").
+		SetCPUCores(2).
+		SetMemoryMB(4096).
+		SetSpecOverrides(map[string]interface{}{"spec.template.spec.domain.cpu.dedicatedCpuPlacement": true}).
+		SetCreatedBy("admin-1").
+		ExecX(ctx)
+	ticket := client.ApprovalTicket.Create().
+		SetID("ticket-1").
+		SetEventID("ev-1").
+		SetRequester("alice").
+		SetOperationType(approvalticket.OperationTypeCREATE).
+		SetModifiedSpec(map[string]interface{}{"cpu": 4}).
+		SaveX(ctx)
+	client.VM.Create().
+		SetID("vm-1").
+		SetName("prod-shop-shop-redis-01").
+		SetInstance("01").
+		SetNamespace("prod-shop").
+		SetClusterID("cluster-a").
+		SetStatus(entvm.StatusCREATING).
+		SetCreatedBy("alice").
+		SetServiceID("svc-1").
+		SetTicketID(ticket.ID).
+		ExecX(ctx)
+	payload := domain.VMCreationPayload{ServiceID: "svc-1", TemplateID: "tpl-1", InstanceSizeID: "size-1", Namespace: "prod-shop"}
+
+	got, err := Build(ctx, client, Input{EventID: "ev-1", Payload: payload, Ticket: ticket, ClusterID: "cluster-a"})
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	if got.VM.ID != "vm-1" || got.Spec.Name != "prod-shop-shop-redis-01" || got.Spec.CPU != 4 || got.Spec.MemoryMB != 4096 ||
+		got.Spec.Image != "quay.io/kubevirt/fedora:40" || got.Spec.Labels["shepherd.io/event-id"] != "ev-1" {
+		t.Fatalf("Build() = vm %s spec %+v", got.VM.ID, got.Spec)
+	}
+	wantSources := map[string]Source{
+		"name":      SourceRequest,
+		"cpu":       SourceOverride,
+		"memory_mb": SourceInstanceSize,
+		"image":     SourceTemplate,
+		"spec_overrides.spec.template.spec.domain.cpu.dedicatedCpuPlacement": SourceInstanceSize,
+	}
+	if !maps.Equal(got.Sources, wantSources) {
+		t.Fatalf("Sources = %v, want %v", got.Sources, wantSources)
+	}
+
+	if got.DroppedOverrides != nil {
+		t.Fatalf("DroppedOverrides = %v, want none", got.DroppedOverrides)
+	}
+
+	// Fields outside the whitelist and spec override policy are dropped,
+	// even when the ticket row was written around the PATCH validation.
+	ticket.ModifiedSpec = map[string]interface{}{
+		"cpu":                         4,
+		"image":                       "quay.io/evil:latest",
+		"spec.template.spec.hostname": "pwned",
+		"spec.running":                false,
+	}
+	client.PlatformConfig.Create().
+		SetID(platformconfig.DefaultID).
+		SetSpecOverridePolicy(map[string]string{"spec.running": "boolean"}).
+		ExecX(ctx)
+	got, err = Build(ctx, client, Input{EventID: "ev-1", Payload: payload, Ticket: ticket, ClusterID: "cluster-a"})
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	if want := []string{"image", "spec.template.spec.hostname"}; !slices.Equal(got.DroppedOverrides, want) {
+		t.Fatalf("DroppedOverrides = %v, want %v", got.DroppedOverrides, want)
+	}
+	if got.Spec.Image != "quay.io/kubevirt/fedora:40" || got.Spec.CPU != 4 ||
+		got.Spec.SpecOverrides["spec.template.spec.hostname"] != nil || got.Spec.SpecOverrides["spec.running"] != false {
+		t.Fatalf("Build() with disallowed overrides = %+v", got.Spec)
+	}
+
+	payload.TemplateID = "tpl-missing"
+	_, err = Build(ctx, client, Input{EventID: "ev-1", Payload: payload, Ticket: ticket, ClusterID: "cluster-a"})
+	var permanentErr *PermanentError
+	if !errors.As(err, &permanentErr) || err.Error() != "template tpl-missing not found" {
+		t.Fatalf("missing template error = %v, want a permanent \"template tpl-missing not found\"", err)
+	}
+}
@@ -10,8 +10,23 @@ import (
 const (
 	// Stage 6 baseline token TTL (master-flow.md Stage 6).
 	DefaultVNCTokenTTL = 2 * time.Hour
+	// DefaultVNCMaxAccessDuration bounds how long an approved VNC_ACCESS
+	// ticket grants console access.
+	DefaultVNCMaxAccessDuration = 4 * time.Hour
 )
 
+// ClampVNCAccessDuration returns the access window for a VNC request.
+// Unset requests get the maximum; longer requests are cut down to it.
+func ClampVNCAccessDuration(requested, maxDuration time.Duration) time.Duration {
+	if maxDuration <= 0 {
+		maxDuration = DefaultVNCMaxAccessDuration
+	}
+	if requested <= 0 || requested > maxDuration {
+		return maxDuration
+	}
+	return requested
+}
+
 // VNCDecision captures Stage 6 request decision outcome.
 type VNCDecision struct {
 	Allowed         bool
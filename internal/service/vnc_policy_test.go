@@ -114,3 +114,30 @@ func TestBuildVNCTokenClaims(t *testing.T) {
 		}
 	})
 }
+
+func TestClampVNCAccessDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		requested time.Duration
+		max       time.Duration
+		want      time.Duration
+	}{
+		{name: "unset request gets max", requested: 0, max: time.Hour, want: time.Hour},
+		{name: "short request kept", requested: 15 * time.Minute, max: time.Hour, want: 15 * time.Minute},
+		{name: "long request clamped", requested: 8 * time.Hour, max: time.Hour, want: time.Hour},
+		{name: "unset max uses default", requested: 12 * time.Hour, max: 0, want: DefaultVNCMaxAccessDuration},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := ClampVNCAccessDuration(tc.requested, tc.max); got != tc.want {
+				t.Fatalf("ClampVNCAccessDuration(%s, %s) = %s, want %s", tc.requested, tc.max, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/pkg/metrics"
+)
+
+// DefaultVNCMaxSessionsPerVM caps concurrent proxied VNC connections to one VM.
+const DefaultVNCMaxSessionsPerVM = 2
+
+var (
+	ErrVNCSessionInUse  = errors.New("vnc session is already connected")
+	ErrVNCSessionsLimit = errors.New("vnc session limit reached for vm")
+	ErrVNCDialerMissing = errors.New("vnc dialer is not configured")
+)
+
+// VNCDialer opens a raw VNC stream to a VM on a cluster.
+type VNCDialer func(ctx context.Context, cluster, namespace, name string) (net.Conn, error)
+
+// VNCProxyTarget identifies the console session being proxied and its VM.
+type VNCProxyTarget struct {
+	SessionID string
+	VMID      string
+	ClusterID string
+	Namespace string
+	VMName    string
+}
+
+type vncProxyConn struct {
+	vmID      string
+	startedAt time.Time
+}
+
+// VNCProxyWorker pipes client VNC connections to the KubeVirt VNC
+// subresource of the target VM. Active connections are tracked per console
+// session, so a session carries at most one connection and a VM at most
+// maxPerVM.
+type VNCProxyWorker struct {
+	dial     VNCDialer
+	maxPerVM int
+
+	// admitMu serializes Acquire so concurrent connects cannot overshoot
+	// the per-VM limit; active itself is read lock-free.
+	admitMu sync.Mutex
+	active  sync.Map // session ID -> vncProxyConn
+}
+
+// NewVNCProxyWorker creates a proxy dialing VMs with dial. maxPerVM <= 0
+// uses DefaultVNCMaxSessionsPerVM.
+func NewVNCProxyWorker(dial VNCDialer, maxPerVM int) *VNCProxyWorker {
+	if maxPerVM <= 0 {
+		maxPerVM = DefaultVNCMaxSessionsPerVM
+	}
+	return &VNCProxyWorker{dial: dial, maxPerVM: maxPerVM}
+}
+
+// Acquire reserves a connection slot for target. Callers must Release the
+// session once the connection ends or fails to start.
+func (w *VNCProxyWorker) Acquire(target VNCProxyTarget) error {
+	w.admitMu.Lock()
+	defer w.admitMu.Unlock()
+
+	if _, ok := w.active.Load(target.SessionID); ok {
+		return ErrVNCSessionInUse
+	}
+	if w.ActiveForVM(target.VMID) >= w.maxPerVM {
+		return ErrVNCSessionsLimit
+	}
+	w.active.Store(target.SessionID, vncProxyConn{vmID: target.VMID, startedAt: time.Now()})
+	metrics.VNCProxyActive.Inc()
+	return nil
+}
+
+// Release frees the slot held by sessionID.
+func (w *VNCProxyWorker) Release(sessionID string) {
+	if _, ok := w.active.LoadAndDelete(sessionID); ok {
+		metrics.VNCProxyActive.Dec()
+	}
+}
+
+// ActiveForVM returns the number of open proxied connections to vmID.
+func (w *VNCProxyWorker) ActiveForVM(vmID string) int {
+	n := 0
+	w.active.Range(func(_, value any) bool {
+		if value.(vncProxyConn).vmID == vmID {
+			n++
+		}
+		return true
+	})
+	return n
+}
+
+// Dial opens the upstream VNC stream for target.
+func (w *VNCProxyWorker) Dial(ctx context.Context, target VNCProxyTarget) (net.Conn, error) {
+	if w.dial == nil {
+		return nil, ErrVNCDialerMissing
+	}
+	return w.dial(ctx, target.ClusterID, target.Namespace, target.VMName)
+}
+
+// Pipe copies bytes between client and upstream in both directions until
+// either side closes or fails, then closes both and logs the connection's
+// duration and traffic.
+func (w *VNCProxyWorker) Pipe(target VNCProxyTarget, client, upstream io.ReadWriteCloser) {
+	startedAt := time.Now()
+	if conn, ok := w.active.Load(target.SessionID); ok {
+		startedAt = conn.(vncProxyConn).startedAt
+	}
+
+	var closeOnce sync.Once
+	closeBoth := func() {
+		closeOnce.Do(func() {
+			_ = client.Close()
+			_ = upstream.Close()
+		})
+	}
+
+	// The caller's goroutine copies client to VM; one goroutine copies back.
+	// Streams last as long as the console is open, so they would starve a
+	// shared worker pool; they are bounded by maxPerVM instead.
+	var toClient int64
+	downstreamDone := make(chan struct{})
+	go func() { //nolint:naked-goroutine // per-connection stream copy, bounded by maxPerVM
+		defer close(downstreamDone)
+		toClient, _ = io.Copy(client, upstream)
+		closeBoth()
+	}()
+	toUpstream, _ := io.Copy(upstream, client)
+	closeBoth()
+	<-downstreamDone
+
+	duration := time.Since(startedAt)
+	metrics.VNCProxyDuration.Observe(duration.Seconds())
+	logger.Info("vnc proxy connection closed",
+		zap.String("session_id", target.SessionID),
+		zap.String("vm_id", target.VMID),
+		zap.String("cluster_id", target.ClusterID),
+		zap.Duration("duration", duration),
+		zap.Int64("bytes_to_vm", toUpstream),
+		zap.Int64("bytes_to_client", toClient),
+	)
+}
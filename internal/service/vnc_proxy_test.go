@@ -0,0 +1,98 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+func TestVNCProxyWorker_AcquireEnforcesLimits(t *testing.T) {
+	t.Parallel()
+
+	w := NewVNCProxyWorker(nil, 0)
+	target := func(session, vm string) VNCProxyTarget { return VNCProxyTarget{SessionID: session, VMID: vm} }
+
+	if err := w.Acquire(target("s1", "vm-1")); err != nil {
+		t.Fatalf("first acquire: %v", err)
+	}
+	if err := w.Acquire(target("s1", "vm-1")); !errors.Is(err, ErrVNCSessionInUse) {
+		t.Fatalf("same session acquire err = %v, want %v", err, ErrVNCSessionInUse)
+	}
+	if err := w.Acquire(target("s2", "vm-1")); err != nil {
+		t.Fatalf("second session acquire: %v", err)
+	}
+	if err := w.Acquire(target("s3", "vm-1")); !errors.Is(err, ErrVNCSessionsLimit) {
+		t.Fatalf("third session acquire err = %v, want %v", err, ErrVNCSessionsLimit)
+	}
+	// The limit is per VM.
+	if err := w.Acquire(target("s4", "vm-2")); err != nil {
+		t.Fatalf("other vm acquire: %v", err)
+	}
+
+	w.Release("s1")
+	w.Release("s1") // releasing twice is a no-op
+	if got := w.ActiveForVM("vm-1"); got != 1 {
+		t.Fatalf("ActiveForVM after release = %d, want 1", got)
+	}
+	if err := w.Acquire(target("s3", "vm-1")); err != nil {
+		t.Fatalf("acquire after release: %v", err)
+	}
+}
+
+func TestVNCProxyWorker_DialWithoutDialer(t *testing.T) {
+	t.Parallel()
+
+	w := NewVNCProxyWorker(nil, 1)
+	if _, err := w.Dial(context.Background(), VNCProxyTarget{SessionID: "s1"}); !errors.Is(err, ErrVNCDialerMissing) {
+		t.Fatalf("Dial err = %v, want %v", err, ErrVNCDialerMissing)
+	}
+}
+
+func TestVNCProxyWorker_PipeCopiesAndClosesBothSides(t *testing.T) {
+	t.Parallel()
+	_ = logger.Init("error", "json")
+
+	clientSide, clientProxy := net.Pipe()
+	upstreamProxy, upstreamSide := net.Pipe()
+	w := NewVNCProxyWorker(nil, 1)
+	target := VNCProxyTarget{SessionID: "s1", VMID: "vm-1"}
+	if err := w.Acquire(target); err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		w.Pipe(target, clientProxy, upstreamProxy)
+		close(done)
+	}()
+
+	// Client to VM.
+	go func() { _, _ = clientSide.Write([]byte("RFB 003.008\n")) }()
+	buf := make([]byte, 12)
+	if _, err := io.ReadFull(upstreamSide, buf); err != nil || !bytes.Equal(buf, []byte("RFB 003.008\n")) {
+		t.Fatalf("upstream read = %q, %v", buf, err)
+	}
+	// VM to client.
+	go func() { _, _ = upstreamSide.Write([]byte("ok")) }()
+	buf = make([]byte, 2)
+	if _, err := io.ReadFull(clientSide, buf); err != nil || string(buf) != "ok" {
+		t.Fatalf("client read = %q, %v", buf, err)
+	}
+
+	// Closing the VM side ends the pipe and closes the client side too.
+	_ = upstreamSide.Close()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Pipe did not return after upstream closed")
+	}
+	if _, err := clientSide.Read(make([]byte, 1)); err == nil {
+		t.Fatal("client side still open after upstream closed")
+	}
+}
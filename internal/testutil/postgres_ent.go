@@ -23,7 +23,14 @@ var nonIdentChars = regexp.MustCompile(`[^a-z0-9_]+`)
 
 // OpenEntPostgres opens an Ent test client backed by PostgreSQL with isolated schema per test.
 // It fails fast when TEST_DATABASE_URL/DATABASE_URL is missing to enforce ADR PostgreSQL-only tests.
-func OpenEntPostgres(t *testing.T, prefix string) *ent.Client {
+func OpenEntPostgres(t testing.TB, prefix string) *ent.Client {
+	t.Helper()
+	return OpenEntPostgresWithDriver(t, prefix, nil)
+}
+
+// OpenEntPostgresWithDriver is OpenEntPostgres with the SQL driver passed
+// through wrap, so a test can observe the statements the client issues.
+func OpenEntPostgresWithDriver(t testing.TB, prefix string, wrap func(dialect.Driver) dialect.Driver) *ent.Client {
 	t.Helper()
 
 	dsn := strings.TrimSpace(os.Getenv("TEST_DATABASE_URL"))
@@ -65,9 +72,14 @@ func OpenEntPostgres(t *testing.T, prefix string) *ent.Client {
 	}
 	t.Cleanup(func() { _ = testDB.Close() })
 
-	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(entsql.OpenDB(dialect.Postgres, testDB))))
+	drv := entsql.OpenDB(dialect.Postgres, testDB)
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(drv)))
 	t.Cleanup(func() { _ = client.Close() })
-	return client
+	if wrap == nil {
+		return client
+	}
+	// Migrate through the plain driver; only the test's own statements are wrapped.
+	return ent.NewClient(ent.Driver(wrap(drv)))
 }
 
 func dsnWithSearchPath(dsn, schema string) (string, error) {
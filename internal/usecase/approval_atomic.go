@@ -8,16 +8,20 @@ package usecase
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/riverqueue/river"
 
+	"kv-shepherd.io/shepherd/internal/domain"
 	"kv-shepherd.io/shepherd/internal/jobs"
+	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
 	sqlcrepo "kv-shepherd.io/shepherd/internal/repository/sqlc"
 )
 
@@ -115,8 +119,13 @@ func (w *ApprovalAtomicWriter) ApproveCreateAndEnqueue(
 		return "", "", fmt.Errorf("allocate service instance for service %s: %w", serviceID, err)
 	}
 
-	instance := fmt.Sprintf("%02d", allocated.AllocatedIndex)
-	vmName = fmt.Sprintf("%s-%s-%s-%s", namespace, allocated.SystemName, allocated.ServiceName, instance)
+	vmName, instance := domain.DeriveVMName(namespace, allocated.SystemName, allocated.ServiceName, int(allocated.AllocatedIndex))
+	if err := domain.ValidateVMName(vmName); err != nil {
+		return "", "", apperrors.BadRequest(apperrors.CodeNameInvalid, err.Error())
+	}
+	if err := checkVMNameAvailable(ctx, qtx, namespace, vmName); err != nil {
+		return "", "", err
+	}
 
 	vmUUID, err := uuid.NewV7()
 	if err != nil {
@@ -135,6 +144,10 @@ func (w *ApprovalAtomicWriter) ApproveCreateAndEnqueue(
 		TicketID:   pgtype.Text{String: ticketID, Valid: true},
 		ServiceVms: serviceID,
 	}); err != nil {
+		if isUniqueViolation(err) {
+			// A concurrent approval took the name after the check above.
+			return "", "", vmNameConflict(namespace, vmName, "")
+		}
 		return "", "", fmt.Errorf("insert vm %s: %w", vmID, err)
 	}
 
@@ -151,6 +164,44 @@ func (w *ApprovalAtomicWriter) ApproveCreateAndEnqueue(
 	return vmID, vmName, nil
 }
 
+// checkVMNameAvailable rejects a derived name that any VM row in namespace
+// already holds, whichever cluster it runs on. Rows of deleted VMs that are
+// still DELETING count too: they keep the name in the vm_namespace_name index.
+func checkVMNameAvailable(ctx context.Context, qtx *sqlcrepo.Queries, namespace, vmName string) error {
+	existing, err := qtx.FindVMByNamespaceName(ctx, sqlcrepo.FindVMByNamespaceNameParams{
+		Namespace: namespace,
+		Name:      vmName,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("check vm name %s/%s: %w", namespace, vmName, err)
+	}
+	return vmNameConflict(namespace, vmName, existing.ID)
+}
+
+func vmNameConflict(namespace, vmName, existingVMID string) error {
+	params := map[string]interface{}{
+		"namespace": namespace,
+		"vm_name":   vmName,
+	}
+	if existingVMID != "" {
+		params["existing_vm_id"] = existingVMID
+	}
+	return apperrors.Conflict(apperrors.CodeVMNameConflict,
+		fmt.Sprintf("vm name %s is already in use in namespace %s", vmName, namespace),
+	).WithParams(params)
+}
+
+// pgUniqueViolation is the PostgreSQL unique_violation SQLSTATE.
+const pgUniqueViolation = "23505"
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation
+}
+
 func marshalJSONOrNull(value map[string]interface{}) ([]byte, error) {
 	if len(value) == 0 {
 		return nil, nil
@@ -230,6 +281,319 @@ func (w *ApprovalAtomicWriter) ApproveDeleteAndEnqueue(
 	return nil
 }
 
+// ApproveMigrateAndEnqueue atomically:
+// 1) marks ticket APPROVED,
+// 2) marks event PROCESSING,
+// 3) marks VM MIGRATING,
+// 4) inserts River vm_migrate job via InsertTx.
+func (w *ApprovalAtomicWriter) ApproveMigrateAndEnqueue(
+	ctx context.Context,
+	ticketID, eventID, approver, vmID string,
+) error {
+	if w.pool == nil || w.riverClient == nil || w.queries == nil {
+		return fmt.Errorf("approval atomic writer is not initialized")
+	}
+	if strings.TrimSpace(ticketID) == "" || strings.TrimSpace(eventID) == "" ||
+		strings.TrimSpace(approver) == "" || strings.TrimSpace(vmID) == "" {
+		return fmt.Errorf("approve migrate input is incomplete")
+	}
+
+	tx, err := w.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin approval migrate tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := w.queries.WithTx(tx)
+
+	affected, err := qtx.ApproveMigrateTicket(ctx, sqlcrepo.ApproveMigrateTicketParams{
+		Approver: pgtype.Text{String: approver, Valid: true},
+		ID:       ticketID,
+		EventID:  eventID,
+	})
+	if err != nil {
+		return fmt.Errorf("approve migrate ticket %s: %w", ticketID, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("approve migrate ticket %s: not pending or operation type mismatch", ticketID)
+	}
+
+	affected, err = qtx.SetDomainEventStatus(ctx, sqlcrepo.SetDomainEventStatusParams{
+		ID:     eventID,
+		Status: "PROCESSING",
+	})
+	if err != nil {
+		return fmt.Errorf("set event %s to PROCESSING: %w", eventID, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("domain event %s not found", eventID)
+	}
+
+	affected, err = qtx.SetVMStatus(ctx, sqlcrepo.SetVMStatusParams{
+		ID:     vmID,
+		Status: "MIGRATING",
+	})
+	if err != nil {
+		return fmt.Errorf("set vm %s status to MIGRATING: %w", vmID, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("vm %s not found", vmID)
+	}
+
+	if _, err := w.riverClient.InsertTx(ctx, tx, jobs.VMMigrateArgs{
+		EventID: eventID,
+	}, nil); err != nil {
+		return fmt.Errorf("enqueue vm_migrate for event %s: %w", eventID, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit approval migrate tx: %w", err)
+	}
+	return nil
+}
+
+// ApproveResizeAndEnqueue atomically:
+// 1) marks ticket APPROVED with the approver's size selection as modified_spec,
+// 2) marks event PROCESSING,
+// 3) inserts River vm_resize job via InsertTx.
+//
+// VM status is left unchanged; the worker marks the VM RESIZING while it runs.
+func (w *ApprovalAtomicWriter) ApproveResizeAndEnqueue(
+	ctx context.Context,
+	ticketID, eventID, approver string,
+	modifiedSpec map[string]interface{},
+) error {
+	if w.pool == nil || w.riverClient == nil || w.queries == nil {
+		return fmt.Errorf("approval atomic writer is not initialized")
+	}
+	if strings.TrimSpace(ticketID) == "" || strings.TrimSpace(eventID) == "" || strings.TrimSpace(approver) == "" {
+		return fmt.Errorf("approve resize input is incomplete")
+	}
+
+	tx, err := w.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin approval resize tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := w.queries.WithTx(tx)
+	modifiedSpecBytes, err := marshalJSONOrNull(modifiedSpec)
+	if err != nil {
+		return fmt.Errorf("marshal modified spec: %w", err)
+	}
+
+	affected, err := qtx.ApproveResizeTicket(ctx, sqlcrepo.ApproveResizeTicketParams{
+		Approver:     pgtype.Text{String: approver, Valid: true},
+		ModifiedSpec: modifiedSpecBytes,
+		ID:           ticketID,
+		EventID:      eventID,
+	})
+	if err != nil {
+		return fmt.Errorf("approve resize ticket %s: %w", ticketID, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("approve resize ticket %s: not pending or operation type mismatch", ticketID)
+	}
+
+	affected, err = qtx.SetDomainEventStatus(ctx, sqlcrepo.SetDomainEventStatusParams{
+		ID:     eventID,
+		Status: "PROCESSING",
+	})
+	if err != nil {
+		return fmt.Errorf("set event %s to PROCESSING: %w", eventID, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("domain event %s not found", eventID)
+	}
+
+	if _, err := w.riverClient.InsertTx(ctx, tx, jobs.VMResizeArgs{
+		EventID: eventID,
+	}, nil); err != nil {
+		return fmt.Errorf("enqueue vm_resize for event %s: %w", eventID, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit approval resize tx: %w", err)
+	}
+	return nil
+}
+
+// ApproveSnapshotAndEnqueue atomically:
+// 1) marks ticket APPROVED,
+// 2) marks event PROCESSING,
+// 3) inserts River vm_snapshot job via InsertTx.
+func (w *ApprovalAtomicWriter) ApproveSnapshotAndEnqueue(
+	ctx context.Context,
+	ticketID, eventID, approver string,
+) error {
+	if w.pool == nil || w.riverClient == nil || w.queries == nil {
+		return fmt.Errorf("approval atomic writer is not initialized")
+	}
+	if strings.TrimSpace(ticketID) == "" || strings.TrimSpace(eventID) == "" || strings.TrimSpace(approver) == "" {
+		return fmt.Errorf("approve snapshot input is incomplete")
+	}
+
+	tx, err := w.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin approval snapshot tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := w.queries.WithTx(tx)
+
+	affected, err := qtx.ApproveSnapshotTicket(ctx, sqlcrepo.ApproveSnapshotTicketParams{
+		Approver: pgtype.Text{String: approver, Valid: true},
+		ID:       ticketID,
+		EventID:  eventID,
+	})
+	if err != nil {
+		return fmt.Errorf("approve snapshot ticket %s: %w", ticketID, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("approve snapshot ticket %s: not pending or operation type mismatch", ticketID)
+	}
+
+	affected, err = qtx.SetDomainEventStatus(ctx, sqlcrepo.SetDomainEventStatusParams{
+		ID:     eventID,
+		Status: "PROCESSING",
+	})
+	if err != nil {
+		return fmt.Errorf("set event %s to PROCESSING: %w", eventID, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("domain event %s not found", eventID)
+	}
+
+	if _, err := w.riverClient.InsertTx(ctx, tx, jobs.VMSnapshotArgs{
+		EventID: eventID,
+	}, nil); err != nil {
+		return fmt.Errorf("enqueue vm_snapshot for event %s: %w", eventID, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit approval snapshot tx: %w", err)
+	}
+	return nil
+}
+
+// ApprovePowerAndEnqueue atomically:
+// 1) marks a batch power child ticket APPROVED,
+// 2) marks event PROCESSING,
+// 3) inserts River vm_power job via InsertTx.
+func (w *ApprovalAtomicWriter) ApprovePowerAndEnqueue(
+	ctx context.Context,
+	ticketID, eventID, approver, operation string,
+) error {
+	if w.pool == nil || w.riverClient == nil || w.queries == nil {
+		return fmt.Errorf("approval atomic writer is not initialized")
+	}
+	if strings.TrimSpace(ticketID) == "" || strings.TrimSpace(eventID) == "" ||
+		strings.TrimSpace(approver) == "" || strings.TrimSpace(operation) == "" {
+		return fmt.Errorf("approve power input is incomplete")
+	}
+
+	tx, err := w.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin approval power tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := w.queries.WithTx(tx)
+
+	affected, err := qtx.ApprovePowerTicket(ctx, sqlcrepo.ApprovePowerTicketParams{
+		Approver: pgtype.Text{String: approver, Valid: true},
+		ID:       ticketID,
+		EventID:  eventID,
+	})
+	if err != nil {
+		return fmt.Errorf("approve power ticket %s: %w", ticketID, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("approve power ticket %s: not a pending batch child", ticketID)
+	}
+
+	affected, err = qtx.SetDomainEventStatus(ctx, sqlcrepo.SetDomainEventStatusParams{
+		ID:     eventID,
+		Status: "PROCESSING",
+	})
+	if err != nil {
+		return fmt.Errorf("set event %s to PROCESSING: %w", eventID, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("domain event %s not found", eventID)
+	}
+
+	if _, err := w.riverClient.InsertTx(ctx, tx, jobs.VMPowerArgs{
+		EventID:   eventID,
+		Operation: operation,
+	}, nil); err != nil {
+		return fmt.Errorf("enqueue vm_power for event %s: %w", eventID, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit approval power tx: %w", err)
+	}
+	return nil
+}
+
+// ApproveNamespaceMigrateAndEnqueue atomically:
+// 1) marks ticket APPROVED,
+// 2) marks event PROCESSING,
+// 3) inserts River namespace_migrate job via InsertTx.
+func (w *ApprovalAtomicWriter) ApproveNamespaceMigrateAndEnqueue(
+	ctx context.Context,
+	ticketID, eventID, approver string,
+) error {
+	if w.pool == nil || w.riverClient == nil || w.queries == nil {
+		return fmt.Errorf("approval atomic writer is not initialized")
+	}
+	if strings.TrimSpace(ticketID) == "" || strings.TrimSpace(eventID) == "" || strings.TrimSpace(approver) == "" {
+		return fmt.Errorf("approve namespace migration input is incomplete")
+	}
+
+	tx, err := w.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin approval namespace migration tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := w.queries.WithTx(tx)
+
+	affected, err := qtx.ApproveNamespaceMigrateTicket(ctx, sqlcrepo.ApproveNamespaceMigrateTicketParams{
+		Approver: pgtype.Text{String: approver, Valid: true},
+		ID:       ticketID,
+		EventID:  eventID,
+	})
+	if err != nil {
+		return fmt.Errorf("approve namespace migration ticket %s: %w", ticketID, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("approve namespace migration ticket %s: not pending or operation type mismatch", ticketID)
+	}
+
+	affected, err = qtx.SetDomainEventStatus(ctx, sqlcrepo.SetDomainEventStatusParams{
+		ID:     eventID,
+		Status: "PROCESSING",
+	})
+	if err != nil {
+		return fmt.Errorf("set event %s to PROCESSING: %w", eventID, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("domain event %s not found", eventID)
+	}
+
+	if _, err := w.riverClient.InsertTx(ctx, tx, jobs.NamespaceMigrateArgs{
+		EventID: eventID,
+	}, nil); err != nil {
+		return fmt.Errorf("enqueue namespace_migrate for event %s: %w", eventID, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit approval namespace migration tx: %w", err)
+	}
+	return nil
+}
+
 func (w *ApprovalAtomicWriter) validateCreateInput(
 	ticketID, eventID, approver, clusterID, serviceID, namespace, requesterID string,
 ) error {
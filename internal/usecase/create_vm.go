@@ -8,7 +8,9 @@ package usecase
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -18,6 +20,9 @@ import (
 	"kv-shepherd.io/shepherd/ent"
 	"kv-shepherd.io/shepherd/ent/approvalticket"
 	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/ent/platformconfig"
+	entservice "kv-shepherd.io/shepherd/ent/service"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
 	"kv-shepherd.io/shepherd/internal/domain"
 	"kv-shepherd.io/shepherd/internal/governance/audit"
 	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
@@ -33,6 +38,18 @@ type CreateVMInput struct {
 	Namespace      string `json:"namespace"`
 	Reason         string `json:"reason"`
 	RequestedBy    string `json:"requested_by"`
+	RequestID      string `json:"request_id,omitempty"` // Client idempotency key
+
+	// Clone source, validated by the caller. When SourceVMID is set the VM is
+	// created from a copy of SourcePVCName instead of the template image.
+	SourceVMID      string `json:"source_vm_id,omitempty"`
+	SourceClusterID string `json:"source_cluster_id,omitempty"`
+	SourceNamespace string `json:"source_namespace,omitempty"`
+	SourcePVCName   string `json:"source_pvc_name,omitempty"`
+
+	// UserData is base64-encoded cloud-init user-data, accepted only while
+	// the platform config allows it.
+	UserData string `json:"user_data,omitempty"`
 }
 
 // CreateVMOutput represents the output of a VM creation request.
@@ -40,6 +57,12 @@ type CreateVMOutput struct {
 	TicketID string `json:"ticket_id"`
 	EventID  string `json:"event_id"`
 	Status   string `json:"status"`
+	Replayed bool   `json:"replayed"` // Existing ticket returned for a repeated request_id
+
+	// Advisory VM name check at submission. The name is final only once the
+	// instance index is allocated at approval, where conflicts are enforced.
+	VMNamePreview  string `json:"vm_name_preview,omitempty"`
+	VMNameConflict bool   `json:"vm_name_conflict,omitempty"`
 }
 
 // CreateVMUseCase orchestrates VM creation.
@@ -77,16 +100,38 @@ func (uc *CreateVMUseCase) WithAuditLogger(al *audit.Logger) *CreateVMUseCase {
 // Phase 1: Creates DomainEvent + ApprovalTicket in atomic transaction.
 // Phase 2: After approval, K8s create is executed by River worker.
 // master-flow.md Stage 5.A: includes duplicate pending guard + audit log.
+// A request_id that matches one of the requester's open tickets replays that
+// ticket instead of submitting a new one. The key is claimed in the
+// vm_request_idempotencies table inside the create transaction, so
+// concurrent submissions with one key yield a single ticket.
 func (uc *CreateVMUseCase) Execute(ctx context.Context, input CreateVMInput) (*CreateVMOutput, error) {
 	if uc.templateSvc == nil {
 		return nil, fmt.Errorf("template service is not configured")
 	}
 
-	// Validate template exists
-	_, err := uc.templateSvc.GetByID(ctx, input.TemplateID)
+	requestID, err := normalizeRequestID(input.RequestID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkUserData(ctx, input.UserData); err != nil {
+		return nil, err
+	}
+	claim, replayed, err := uc.findCreateReplay(ctx, input.RequestedBy, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if replayed != nil {
+		return replayCreateOutput(replayed), nil
+	}
+
+	// Validate template exists and is not deprecated
+	tpl, err := uc.templateSvc.GetByID(ctx, input.TemplateID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid template: %w", err)
 	}
+	if err := service.EnsureTemplateRequestable(tpl); err != nil {
+		return nil, err
+	}
 
 	// Validate instance size exists
 	_, err = uc.instanceSizeSvc.GetByID(ctx, input.InstanceSizeID)
@@ -100,6 +145,11 @@ func (uc *CreateVMUseCase) Execute(ctx context.Context, input CreateVMInput) (*C
 	if err != nil {
 		return nil, fmt.Errorf("check duplicate create request: %w", err)
 	}
+	if existingTicket != nil && requestID != "" && existingTicket.Requester == input.RequestedBy &&
+		existingTicket.RequestID != nil && *existingTicket.RequestID == requestID {
+		// A concurrent submission with this key committed after the replay lookup.
+		return replayCreateOutput(existingTicket), nil
+	}
 	if existingTicket != nil {
 		return nil, apperrors.Conflict(
 			apperrors.CodeDuplicateRequest,
@@ -112,14 +162,26 @@ func (uc *CreateVMUseCase) Execute(ctx context.Context, input CreateVMInput) (*C
 		})
 	}
 
+	vmNamePreview, vmNameConflict := uc.previewVMName(ctx, input)
+
 	// Create domain event payload
 	payload := domain.VMCreationPayload{
-		RequesterID:    input.RequestedBy,
-		ServiceID:      input.ServiceID,
-		TemplateID:     input.TemplateID,
-		InstanceSizeID: input.InstanceSizeID,
-		Namespace:      input.Namespace,
-		Reason:         input.Reason,
+		RequesterID:     input.RequestedBy,
+		ServiceID:       input.ServiceID,
+		TemplateID:      input.TemplateID,
+		InstanceSizeID:  input.InstanceSizeID,
+		Namespace:       input.Namespace,
+		Reason:          input.Reason,
+		RequestID:       requestID,
+		SourceVMID:      input.SourceVMID,
+		SourceClusterID: input.SourceClusterID,
+		SourceNamespace: input.SourceNamespace,
+		SourcePVCName:   input.SourcePVCName,
+		UserData:        input.UserData,
+	}
+	eventType := domain.EventVMCreationRequested
+	if payload.IsClone() {
+		eventType = domain.EventVMCloneRequested
 	}
 
 	payloadBytes, err := payload.ToJSON()
@@ -133,7 +195,7 @@ func (uc *CreateVMUseCase) Execute(ctx context.Context, input CreateVMInput) (*C
 		// Create domain event
 		event, err := tx.DomainEvent.Create().
 			SetID(generateID()).
-			SetEventType(string(domain.EventVMCreationRequested)).
+			SetEventType(string(eventType)).
 			SetAggregateType("vm").
 			SetAggregateID(input.ServiceID).
 			SetPayload(payloadBytes).
@@ -151,27 +213,38 @@ func (uc *CreateVMUseCase) Execute(ctx context.Context, input CreateVMInput) (*C
 			SetOperationType(approvalticket.OperationTypeCREATE).
 			SetRequester(input.RequestedBy).
 			SetReason(input.Reason).
+			SetNillableRequestID(optionalRequestID(requestID)).
 			Save(ctx)
 		if err != nil {
 			return fmt.Errorf("create approval ticket: %w", err)
 		}
 		ticketID = ticket.ID
 
+		if requestID != "" {
+			return claimCreateRequestID(ctx, tx, claim, input.RequestedBy, requestID, ticket.ID, event.ID)
+		}
 		return nil
 	})
 
 	if txErr != nil {
+		if ticket, ok := uc.replayAfterCreateRace(ctx, input.RequestedBy, requestID, txErr); ok {
+			return replayCreateOutput(ticket), nil
+		}
 		return nil, fmt.Errorf("create vm request: %w", txErr)
 	}
 
 	// Audit log (master-flow.md Stage 5.A)
 	if uc.auditLogger != nil {
-		_ = uc.auditLogger.LogAction(ctx, "vm.request", "approval_ticket", ticketID, input.RequestedBy, map[string]interface{}{
+		details := map[string]interface{}{
 			"service_id":       input.ServiceID,
 			"template_id":      input.TemplateID,
 			"instance_size_id": input.InstanceSizeID,
 			"namespace":        input.Namespace,
-		})
+		}
+		if payload.IsClone() {
+			details["source_vm_id"] = input.SourceVMID
+		}
+		_ = uc.auditLogger.LogAction(ctx, "vm.request", "approval_ticket", ticketID, input.RequestedBy, details)
 	}
 
 	logger.Info("VM creation request submitted",
@@ -181,16 +254,96 @@ func (uc *CreateVMUseCase) Execute(ctx context.Context, input CreateVMInput) (*C
 	)
 
 	return &CreateVMOutput{
-		TicketID: ticketID,
-		EventID:  eventID,
-		Status:   "PENDING",
+		TicketID:       ticketID,
+		EventID:        eventID,
+		Status:         "PENDING",
+		VMNamePreview:  vmNamePreview,
+		VMNameConflict: vmNameConflict,
 	}, nil
 }
 
+// previewVMName derives the name the VM would get from the service's next
+// instance index and reports whether a VM with that name already exists in
+// the namespace on any cluster. The check is advisory: lookup failures are
+// logged and never block the request.
+func (uc *CreateVMUseCase) previewVMName(ctx context.Context, input CreateVMInput) (string, bool) {
+	namespace := strings.TrimSpace(input.Namespace)
+	svc, err := uc.entClient.Service.Query().
+		Where(entservice.IDEQ(strings.TrimSpace(input.ServiceID))).
+		WithSystem().
+		Only(ctx)
+	if err != nil || svc.Edges.System == nil {
+		logger.Warn("skip VM name preview: service lookup failed",
+			zap.String("service_id", input.ServiceID),
+			zap.Error(err),
+		)
+		return "", false
+	}
+
+	name, _ := domain.DeriveVMName(namespace, svc.Edges.System.Name, svc.Name, svc.NextInstanceIndex)
+	exists, err := uc.entClient.VM.Query().
+		Where(entvm.NamespaceEQ(namespace), entvm.NameEQ(name)).
+		Exist(ctx)
+	if err != nil {
+		logger.Warn("skip VM name conflict check",
+			zap.String("vm_name", name),
+			zap.String("namespace", namespace),
+			zap.Error(err),
+		)
+		return name, false
+	}
+	return name, exists
+}
+
+// findCreateReplay returns the requester's request_id claim and the open
+// ticket to replay, if any. Open tickets without a claim (submitted before
+// claims existed) are still found through the ticket's own request_id.
+func (uc *CreateVMUseCase) findCreateReplay(
+	ctx context.Context,
+	requester, requestID string,
+) (*ent.VMRequestIdempotency, *ent.ApprovalTicket, error) {
+	claim, ticket, err := findCreateRequestClaim(ctx, uc.entClient, requester, requestID)
+	if err != nil || ticket != nil {
+		return claim, ticket, err
+	}
+	ticket, err = findOpenTicketByRequestID(ctx, uc.entClient, requester, requestID, approvalticket.OperationTypeCREATE)
+	return claim, ticket, err
+}
+
+// replayAfterCreateRace resolves a lost request_id race: a unique violation
+// on the claim or ticket index, or a claim taken over concurrently.
+func (uc *CreateVMUseCase) replayAfterCreateRace(
+	ctx context.Context,
+	requester, requestID string,
+	txErr error,
+) (*ent.ApprovalTicket, bool) {
+	if requestID == "" || (!ent.IsConstraintError(txErr) && !errors.Is(txErr, errRequestIDClaimLost)) {
+		return nil, false
+	}
+	_, ticket, err := uc.findCreateReplay(ctx, requester, requestID)
+	if err != nil || ticket == nil {
+		return nil, false
+	}
+	return ticket, true
+}
+
+func replayCreateOutput(ticket *ent.ApprovalTicket) *CreateVMOutput {
+	return &CreateVMOutput{
+		TicketID: ticket.ID,
+		EventID:  ticket.EventID,
+		Status:   string(ticket.Status),
+		Replayed: true,
+	}
+}
+
 func (uc *CreateVMUseCase) findPendingCreateDuplicate(
 	ctx context.Context,
 	input CreateVMInput,
 ) (*ent.ApprovalTicket, error) {
+	// Clones are told apart by their source VM, so only template creates are guarded.
+	if input.SourceVMID != "" {
+		return nil, nil
+	}
 	events, err := uc.entClient.DomainEvent.Query().
 		Where(
 			domainevent.EventTypeEQ(string(domain.EventVMCreationRequested)),
@@ -271,3 +424,24 @@ func generateID() string {
 	}
 	return id.String()
 }
+
+// checkUserData rejects cloud-init user data that is not valid base64 or
+// that the platform config does not allow.
+func (uc *CreateVMUseCase) checkUserData(ctx context.Context, userData string) error {
+	if userData == "" {
+		return nil
+	}
+	if _, err := base64.StdEncoding.DecodeString(userData); err != nil {
+		return apperrors.BadRequest(apperrors.CodeInvalidRequestField, "user_data must be base64-encoded").
+			WithParams(map[string]interface{}{"field": "user_data"})
+	}
+	cfg, err := uc.entClient.PlatformConfig.Get(ctx, platformconfig.DefaultID)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("load platform config: %w", err)
+	}
+	if cfg == nil || !cfg.AllowUserData {
+		return apperrors.BadRequest(apperrors.CodeUserDataDisabled, "cloud-init user_data is disabled by the platform config").
+			WithParams(map[string]interface{}{"field": "user_data"})
+	}
+	return nil
+}
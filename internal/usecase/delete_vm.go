@@ -32,6 +32,7 @@ type DeleteVMInput struct {
 	ConfirmName string `json:"confirm_name"`
 	Reason      string `json:"reason"`
 	RequestedBy string `json:"requested_by"`
+	RequestID   string `json:"request_id,omitempty"` // Client idempotency key
 }
 
 // DeleteVMOutput represents the output of a VM deletion request.
@@ -39,6 +40,7 @@ type DeleteVMOutput struct {
 	TicketID string `json:"ticket_id"`
 	EventID  string `json:"event_id"`
 	Status   string `json:"status"`
+	Replayed bool   `json:"replayed"` // Existing ticket returned for a repeated request_id
 }
 
 // DeleteVMUseCase orchestrates VM deletion through the approval flow.
@@ -64,7 +66,21 @@ func (uc *DeleteVMUseCase) WithAuditLogger(al *audit.Logger) *DeleteVMUseCase {
 // Phase 1: Validates VM state and confirmation.
 // Phase 2: Creates DomainEvent + ApprovalTicket (operation_type=DELETE) in atomic transaction.
 // Phase 3: After admin approval, Gateway enqueues River job for K8s deletion.
+// A request_id matching one of the requester's open tickets replays that
+// ticket before any state checks, since the VM may already be deleting.
 func (uc *DeleteVMUseCase) Execute(ctx context.Context, input DeleteVMInput) (*DeleteVMOutput, error) {
+	requestID, err := normalizeRequestID(input.RequestID)
+	if err != nil {
+		return nil, err
+	}
+	replayed, err := findOpenTicketByRequestID(ctx, uc.entClient, input.RequestedBy, requestID, approvalticket.OperationTypeDELETE)
+	if err != nil {
+		return nil, err
+	}
+	if replayed != nil {
+		return replayDeleteOutput(replayed), nil
+	}
+
 	// Step 1: Fetch VM and validate state.
 	vm, err := uc.entClient.VM.Get(ctx, input.VMID)
 	if err != nil {
@@ -112,6 +128,7 @@ func (uc *DeleteVMUseCase) Execute(ctx context.Context, input DeleteVMInput) (*D
 		ClusterID: vm.ClusterID,
 		Namespace: vm.Namespace,
 		Actor:     input.RequestedBy,
+		RequestID: requestID,
 	}
 	payloadBytes, err := payload.ToJSON()
 	if err != nil {
@@ -147,6 +164,7 @@ func (uc *DeleteVMUseCase) Execute(ctx context.Context, input DeleteVMInput) (*D
 			SetOperationType(approvalticket.OperationTypeDELETE).
 			SetRequester(input.RequestedBy).
 			SetReason(reason).
+			SetNillableRequestID(optionalRequestID(requestID)).
 			Save(ctx)
 		if err != nil {
 			return fmt.Errorf("create approval ticket: %w", err)
@@ -157,6 +175,9 @@ func (uc *DeleteVMUseCase) Execute(ctx context.Context, input DeleteVMInput) (*D
 	})
 
 	if txErr != nil {
+		if ticket, ok := replayAfterInsertRace(ctx, uc.entClient, input.RequestedBy, requestID, approvalticket.OperationTypeDELETE, txErr); ok {
+			return replayDeleteOutput(ticket), nil
+		}
 		return nil, fmt.Errorf("create vm delete request: %w", txErr)
 	}
 
@@ -182,6 +203,15 @@ func (uc *DeleteVMUseCase) Execute(ctx context.Context, input DeleteVMInput) (*D
 	}, nil
 }
 
+func replayDeleteOutput(ticket *ent.ApprovalTicket) *DeleteVMOutput {
+	return &DeleteVMOutput{
+		TicketID: ticket.ID,
+		EventID:  ticket.EventID,
+		Status:   string(ticket.Status),
+		Replayed: true,
+	}
+}
+
 func (uc *DeleteVMUseCase) resolveNamespaceEnvironment(ctx context.Context, namespace string) (namespaceregistry.Environment, error) {
 	name := strings.TrimSpace(namespace)
 	if name == "" {
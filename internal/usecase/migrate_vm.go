@@ -0,0 +1,253 @@
+// Package usecase — MigrateVMUseCase orchestrates the cross-cluster VM migration approval flow.
+//
+// ADR-0012: Atomic transaction for DomainEvent + ApprovalTicket.
+//
+// Import Path (ADR-0016): kv-shepherd.io/shepherd/internal/usecase
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/cluster"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/ent/namespaceregistry"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// MigrateVMInput represents the input for requesting a VM migration.
+type MigrateVMInput struct {
+	VMID            string `json:"vm_id"`
+	TargetClusterID string `json:"target_cluster_id"`
+	Reason          string `json:"reason"`
+	RequestedBy     string `json:"requested_by"`
+}
+
+// MigrateVMOutput represents the output of a VM migration request.
+type MigrateVMOutput struct {
+	TicketID string `json:"ticket_id"`
+	EventID  string `json:"event_id"`
+	Status   string `json:"status"`
+}
+
+// MigrateVMUseCase orchestrates VM migration between clusters through the approval flow.
+// Flow: User requests migration → DomainEvent + ApprovalTicket (MIGRATE) created →
+// Admin approves → River job moves the VM to the target cluster.
+type MigrateVMUseCase struct {
+	entClient   *ent.Client
+	auditLogger *audit.Logger
+}
+
+// NewMigrateVMUseCase creates a new MigrateVMUseCase.
+func NewMigrateVMUseCase(entClient *ent.Client) *MigrateVMUseCase {
+	return &MigrateVMUseCase{entClient: entClient}
+}
+
+// WithAuditLogger sets the audit logger (optional dependency).
+func (uc *MigrateVMUseCase) WithAuditLogger(al *audit.Logger) *MigrateVMUseCase {
+	uc.auditLogger = al
+	return uc
+}
+
+// Execute runs the VM migration request use case.
+// Phase 1: Validates VM state and the target cluster.
+// Phase 2: Creates DomainEvent + ApprovalTicket (operation_type=MIGRATE) in atomic transaction.
+// Phase 3: After admin approval, Gateway enqueues River job for the K8s migration.
+func (uc *MigrateVMUseCase) Execute(ctx context.Context, input MigrateVMInput) (*MigrateVMOutput, error) {
+	targetClusterID := strings.TrimSpace(input.TargetClusterID)
+	if targetClusterID == "" {
+		return nil, apperrors.BadRequest(apperrors.CodeInvalidRequestField, "target_cluster_id is required")
+	}
+
+	// Step 1: Fetch VM and validate state.
+	vm, err := uc.entClient.VM.Get(ctx, input.VMID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.NotFound(apperrors.CodeVMNotFound, fmt.Sprintf("VM %s not found", input.VMID))
+		}
+		return nil, fmt.Errorf("get VM %s: %w", input.VMID, err)
+	}
+	if vm.Status != entvm.StatusRUNNING {
+		return nil, apperrors.Conflict("INVALID_STATE_TRANSITION",
+			fmt.Sprintf("cannot migrate VM in %s state, must be RUNNING", vm.Status))
+	}
+	if vm.ClusterID == targetClusterID {
+		return nil, apperrors.BadRequest(apperrors.CodeValidationFailed,
+			fmt.Sprintf("VM %s is already on cluster %s", vm.Name, targetClusterID))
+	}
+
+	// Step 2: Target cluster must be usable for the VM's namespace environment.
+	if err := uc.validateTargetCluster(ctx, vm.Namespace, targetClusterID); err != nil {
+		return nil, err
+	}
+
+	// Step 3: Duplicate pending guard — same resource + same operation.
+	existingTicket, err := uc.findPendingMigrateDuplicate(ctx, input.VMID)
+	if err != nil {
+		return nil, fmt.Errorf("check duplicate migrate request: %w", err)
+	}
+	if existingTicket != nil {
+		return nil, apperrors.Conflict(
+			apperrors.CodeDuplicateRequest,
+			"a pending VM migrate request already exists for this resource",
+		).WithParams(map[string]interface{}{
+			"existing_ticket_id": existingTicket.ID,
+			"operation":          "MIGRATE_VM",
+			"resource_id":        input.VMID,
+		})
+	}
+
+	// Step 4: Build domain event payload.
+	payload := domain.VMMigratePayload{
+		VMID:            input.VMID,
+		VMName:          vm.Name,
+		SourceClusterID: vm.ClusterID,
+		TargetClusterID: targetClusterID,
+		Namespace:       vm.Namespace,
+		Actor:           input.RequestedBy,
+	}
+	payloadBytes, err := payload.ToJSON()
+	if err != nil {
+		return nil, fmt.Errorf("marshal migrate payload: %w", err)
+	}
+
+	// Step 5: Atomic transaction — DomainEvent + ApprovalTicket (ADR-0012).
+	reason := input.Reason
+	if reason == "" {
+		reason = fmt.Sprintf("Request to migrate VM %s to cluster %s", vm.Name, targetClusterID)
+	}
+
+	var eventID, ticketID string
+	txErr := withTx(ctx, uc.entClient, func(tx *ent.Tx) error {
+		event, err := tx.DomainEvent.Create().
+			SetID(generateID()).
+			SetEventType(string(domain.EventVMMigrationRequested)).
+			SetAggregateType("vm").
+			SetAggregateID(input.VMID).
+			SetPayload(payloadBytes).
+			SetCreatedBy(input.RequestedBy).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create domain event: %w", err)
+		}
+		eventID = event.ID
+
+		ticket, err := tx.ApprovalTicket.Create().
+			SetID(generateID()).
+			SetEventID(event.ID).
+			SetOperationType(approvalticket.OperationTypeMIGRATE).
+			SetRequester(input.RequestedBy).
+			SetReason(reason).
+			SetSelectedClusterID(targetClusterID).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create approval ticket: %w", err)
+		}
+		ticketID = ticket.ID
+
+		return nil
+	})
+
+	if txErr != nil {
+		return nil, fmt.Errorf("create vm migrate request: %w", txErr)
+	}
+
+	// Step 6: Audit log (best-effort, outside transaction).
+	if uc.auditLogger != nil {
+		_ = uc.auditLogger.LogAction(ctx, "vm.migrate_requested", "approval_ticket", ticketID, input.RequestedBy, map[string]interface{}{
+			"vm_id":          input.VMID,
+			"vm_name":        vm.Name,
+			"source_cluster": vm.ClusterID,
+			"target_cluster": targetClusterID,
+		})
+	}
+
+	logger.Info("VM migration request submitted (pending approval)",
+		zap.String("event_id", eventID),
+		zap.String("ticket_id", ticketID),
+		zap.String("vm_id", input.VMID),
+		zap.String("target_cluster", targetClusterID),
+		zap.String("requester", input.RequestedBy),
+	)
+
+	return &MigrateVMOutput{
+		TicketID: ticketID,
+		EventID:  eventID,
+		Status:   "PENDING",
+	}, nil
+}
+
+func (uc *MigrateVMUseCase) validateTargetCluster(ctx context.Context, namespace, clusterID string) error {
+	cl, err := uc.entClient.Cluster.Get(ctx, clusterID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperrors.NotFound(apperrors.CodeClusterNotFound, fmt.Sprintf("cluster %s not found", clusterID))
+		}
+		return fmt.Errorf("get cluster %s: %w", clusterID, err)
+	}
+	if !cl.Enabled || cl.Status != cluster.StatusHEALTHY {
+		return apperrors.Conflict(apperrors.CodeClusterUnhealthy,
+			fmt.Sprintf("cluster %s is not available for migration (status: %s)", cl.Name, cl.Status))
+	}
+
+	ns, err := uc.entClient.NamespaceRegistry.Query().
+		Where(
+			namespaceregistry.NameEQ(strings.TrimSpace(namespace)),
+			namespaceregistry.EnabledEQ(true),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperrors.BadRequest(
+				"NAMESPACE_ENVIRONMENT_NOT_FOUND",
+				fmt.Sprintf("namespace %q is not registered or disabled", namespace),
+			)
+		}
+		return fmt.Errorf("query namespace registry for %q: %w", namespace, err)
+	}
+	if string(ns.Environment) != string(cl.Environment) {
+		return apperrors.BadRequest(
+			"NAMESPACE_CLUSTER_ENV_MISMATCH",
+			fmt.Sprintf("namespace environment %q does not match target cluster environment %q", ns.Environment, cl.Environment),
+		)
+	}
+	return nil
+}
+
+func (uc *MigrateVMUseCase) findPendingMigrateDuplicate(ctx context.Context, vmID string) (*ent.ApprovalTicket, error) {
+	eventIDs, err := uc.entClient.DomainEvent.Query().
+		Where(
+			domainevent.EventTypeEQ(string(domain.EventVMMigrationRequested)),
+			domainevent.AggregateTypeEQ("vm"),
+			domainevent.AggregateIDEQ(strings.TrimSpace(vmID)),
+			domainevent.StatusEQ(domainevent.StatusPENDING),
+		).
+		IDs(ctx)
+	if err != nil || len(eventIDs) == 0 {
+		return nil, err
+	}
+
+	ticket, err := uc.entClient.ApprovalTicket.Query().
+		Where(
+			approvalticket.EventIDIn(eventIDs...),
+			approvalticket.OperationTypeEQ(approvalticket.OperationTypeMIGRATE),
+			approvalticket.StatusEQ(approvalticket.StatusPENDING),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return ticket, nil
+}
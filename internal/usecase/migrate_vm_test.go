@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"testing"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/cluster"
+	"kv-shepherd.io/shepherd/ent/namespaceregistry"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/domain"
+	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+func seedMigrateFixture(t *testing.T, client *ent.Client, vmStatus entvm.Status) *ent.VM {
+	t.Helper()
+	ctx := t.Context()
+
+	sys := client.System.Create().SetID("sys-migrate").SetName("shop").SetCreatedBy("owner-1").SaveX(ctx)
+	svc := client.Service.Create().SetID("svc-migrate").SetName("redis").SetSystemID(sys.ID).SaveX(ctx)
+	client.NamespaceRegistry.Create().
+		SetID("ns-migrate").
+		SetName("prod-shop").
+		SetEnvironment(namespaceregistry.EnvironmentProd).
+		SetCreatedBy("admin").
+		SaveX(ctx)
+	for _, c := range []struct {
+		id     string
+		env    cluster.Environment
+		status cluster.Status
+	}{
+		{id: "cluster-a", env: cluster.EnvironmentProd, status: cluster.StatusHEALTHY},
+		{id: "cluster-b", env: cluster.EnvironmentProd, status: cluster.StatusHEALTHY},
+		{id: "cluster-test", env: cluster.EnvironmentTest, status: cluster.StatusHEALTHY},
+		{id: "cluster-down", env: cluster.EnvironmentProd, status: cluster.StatusUNREACHABLE},
+	} {
+		client.Cluster.Create().
+			SetID(c.id).
+			SetName(c.id).
+			SetAPIServerURL("https://" + c.id + ".example.com").
+			SetEncryptedKubeconfig([]byte("x")).
+			SetEnvironment(c.env).
+			SetStatus(c.status).
+			SetCreatedBy("admin").
+			SaveX(ctx)
+	}
+	return client.VM.Create().
+		SetID("vm-migrate").
+		SetName("prod-shop-shop-redis-01").
+		SetInstance("01").
+		SetNamespace("prod-shop").
+		SetClusterID("cluster-a").
+		SetStatus(vmStatus).
+		SetCreatedBy("owner-1").
+		SetServiceID(svc.ID).
+		SaveX(ctx)
+}
+
+func TestMigrateVMUseCase_CreatesMigrateTicket(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "migrate_vm_usecase")
+	vm := seedMigrateFixture(t, client, entvm.StatusRUNNING)
+
+	uc := NewMigrateVMUseCase(client)
+	out, err := uc.Execute(t.Context(), MigrateVMInput{
+		VMID:            vm.ID,
+		TargetClusterID: "cluster-b",
+		RequestedBy:     "owner-1",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	ticket, err := client.ApprovalTicket.Get(t.Context(), out.TicketID)
+	if err != nil {
+		t.Fatalf("get ticket: %v", err)
+	}
+	if ticket.OperationType != approvalticket.OperationTypeMIGRATE || ticket.Status != approvalticket.StatusPENDING {
+		t.Fatalf("ticket = %s/%s, want MIGRATE/PENDING", ticket.OperationType, ticket.Status)
+	}
+	event, err := client.DomainEvent.Get(t.Context(), out.EventID)
+	if err != nil {
+		t.Fatalf("get event: %v", err)
+	}
+	if event.EventType != string(domain.EventVMMigrationRequested) {
+		t.Fatalf("event type = %s, want %s", event.EventType, domain.EventVMMigrationRequested)
+	}
+
+	_, err = uc.Execute(t.Context(), MigrateVMInput{
+		VMID:            vm.ID,
+		TargetClusterID: "cluster-b",
+		RequestedBy:     "owner-1",
+	})
+	appErr, ok := apperrors.IsAppError(err)
+	if !ok || appErr.Code != apperrors.CodeDuplicateRequest {
+		t.Fatalf("second Execute() error = %v, want %s", err, apperrors.CodeDuplicateRequest)
+	}
+}
+
+func TestMigrateVMUseCase_Rejections(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		vmStatus entvm.Status
+		target   string
+		wantCode string
+	}{
+		{name: "missing target", vmStatus: entvm.StatusRUNNING, wantCode: apperrors.CodeInvalidRequestField},
+		{name: "vm not running", vmStatus: entvm.StatusSTOPPED, target: "cluster-b", wantCode: "INVALID_STATE_TRANSITION"},
+		{name: "same cluster", vmStatus: entvm.StatusRUNNING, target: "cluster-a", wantCode: apperrors.CodeValidationFailed},
+		{name: "unknown cluster", vmStatus: entvm.StatusRUNNING, target: "cluster-x", wantCode: apperrors.CodeClusterNotFound},
+		{name: "unhealthy cluster", vmStatus: entvm.StatusRUNNING, target: "cluster-down", wantCode: apperrors.CodeClusterUnhealthy},
+		{name: "environment mismatch", vmStatus: entvm.StatusRUNNING, target: "cluster-test", wantCode: "NAMESPACE_CLUSTER_ENV_MISMATCH"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := testutil.OpenEntPostgres(t, "migrate_vm_usecase_reject")
+			vm := seedMigrateFixture(t, client, tc.vmStatus)
+
+			_, err := NewMigrateVMUseCase(client).Execute(t.Context(), MigrateVMInput{
+				VMID:            vm.ID,
+				TargetClusterID: tc.target,
+				RequestedBy:     "owner-1",
+			})
+			appErr, ok := apperrors.IsAppError(err)
+			if !ok || appErr.Code != tc.wantCode {
+				t.Fatalf("Execute() error = %v, want code %s", err, tc.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+// Package usecase — ReplayEventUseCase re-dispatches River jobs from stored DomainEvents.
+//
+// ADR-0009: DomainEvent is the claim-check record, so a lost or discarded job
+// can be rebuilt from the event ID alone.
+//
+// Import Path (ADR-0016): kv-shepherd.io/shepherd/internal/usecase
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	"kv-shepherd.io/shepherd/internal/jobs"
+	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// Replay error codes.
+const (
+	CodeEventNotFound          = "EVENT_NOT_FOUND"
+	CodeEventNotReplayable     = "EVENT_NOT_REPLAYABLE"
+	CodeEventTypeNotReplayable = "EVENT_TYPE_NOT_REPLAYABLE"
+	CodeEventNotApproved       = "EVENT_NOT_APPROVED"
+)
+
+// ReplayEventInput represents a request to re-dispatch a DomainEvent's job.
+type ReplayEventInput struct {
+	EventID string `json:"event_id"`
+	DryRun  bool   `json:"dry_run"`
+	Actor   string `json:"actor"`
+}
+
+// ReplayEventOutput describes the job built for the event and, unless DryRun
+// is set, the outcome of inserting it.
+type ReplayEventOutput struct {
+	EventID        string        `json:"event_id"`
+	EventType      string        `json:"event_type"`
+	PreviousStatus string        `json:"previous_status"`
+	JobKind        string        `json:"job_kind"`
+	Queue          string        `json:"queue"`
+	JobArgs        river.JobArgs `json:"-"`
+	DryRun         bool          `json:"dry_run"`
+	JobID          int64         `json:"job_id"`
+	Duplicate      bool          `json:"duplicate"` // River skipped the insert: an identical job is still live
+}
+
+// ReplayEventUseCase re-enqueues the River job for a PENDING or FAILED
+// DomainEvent and moves the event back to PENDING. Job args are unique by
+// value, so replaying while the original job is still live is a no-op.
+type ReplayEventUseCase struct {
+	entClient   *ent.Client
+	inserter    jobs.JobInserter
+	auditLogger *audit.Logger
+}
+
+// NewReplayEventUseCase creates a new ReplayEventUseCase.
+func NewReplayEventUseCase(entClient *ent.Client, inserter jobs.JobInserter) *ReplayEventUseCase {
+	return &ReplayEventUseCase{entClient: entClient, inserter: inserter}
+}
+
+// WithAuditLogger sets the audit logger (optional dependency).
+func (uc *ReplayEventUseCase) WithAuditLogger(al *audit.Logger) *ReplayEventUseCase {
+	uc.auditLogger = al
+	return uc
+}
+
+// Execute validates the event and enqueues its job (or only describes it for a dry run).
+func (uc *ReplayEventUseCase) Execute(ctx context.Context, input ReplayEventInput) (*ReplayEventOutput, error) {
+	event, err := uc.entClient.DomainEvent.Get(ctx, input.EventID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.NotFound(CodeEventNotFound, fmt.Sprintf("domain event %s not found", input.EventID))
+		}
+		return nil, fmt.Errorf("get domain event %s: %w", input.EventID, err)
+	}
+	if event.Status != domainevent.StatusPENDING && event.Status != domainevent.StatusFAILED {
+		return nil, apperrors.Conflict(
+			CodeEventNotReplayable,
+			fmt.Sprintf("cannot replay event in %s state, must be PENDING or FAILED", event.Status),
+		).WithParams(map[string]interface{}{"status": string(event.Status)})
+	}
+
+	args, needsApproval, ok := replayJobArgs(event)
+	if !ok {
+		return nil, apperrors.BadRequest(
+			CodeEventTypeNotReplayable,
+			fmt.Sprintf("event type %s has no replayable job", event.EventType),
+		).WithParams(map[string]interface{}{"event_type": event.EventType})
+	}
+	if err := uc.ensureApproved(ctx, event.ID, needsApproval); err != nil {
+		return nil, err
+	}
+
+	out := &ReplayEventOutput{
+		EventID:        event.ID,
+		EventType:      event.EventType,
+		PreviousStatus: string(event.Status),
+		JobKind:        args.Kind(),
+		Queue:          replayJobQueue(args),
+		JobArgs:        args,
+		DryRun:         input.DryRun,
+	}
+	if input.DryRun {
+		return out, nil
+	}
+	if uc.inserter == nil {
+		return nil, fmt.Errorf("river client is not configured")
+	}
+
+	if event.Status != domainevent.StatusPENDING {
+		if err := uc.entClient.DomainEvent.UpdateOneID(event.ID).
+			SetStatus(domainevent.StatusPENDING).
+			Exec(ctx); err != nil {
+			return nil, fmt.Errorf("reset event %s to PENDING: %w", event.ID, err)
+		}
+	}
+	res, err := uc.inserter.Insert(ctx, args, nil)
+	if err != nil {
+		if event.Status != domainevent.StatusPENDING {
+			_, _ = uc.entClient.DomainEvent.UpdateOneID(event.ID).SetStatus(event.Status).Save(ctx)
+		}
+		return nil, fmt.Errorf("enqueue %s job for event %s: %w", args.Kind(), event.ID, err)
+	}
+	if res != nil {
+		out.Duplicate = res.UniqueSkippedAsDuplicate
+		if res.Job != nil {
+			out.JobID = res.Job.ID
+		}
+	}
+
+	if uc.auditLogger != nil {
+		_ = uc.auditLogger.LogAction(ctx, "domain_event.replay", "domain_event", event.ID, input.Actor, map[string]interface{}{
+			"event_type":      event.EventType,
+			"previous_status": string(event.Status),
+			"job_kind":        out.JobKind,
+			"job_id":          out.JobID,
+			"duplicate":       out.Duplicate,
+		})
+	}
+
+	logger.Info("domain event replayed",
+		zap.String("event_id", event.ID),
+		zap.String("job_kind", out.JobKind),
+		zap.Int64("job_id", out.JobID),
+		zap.Bool("duplicate", out.Duplicate),
+		zap.String("actor", input.Actor),
+	)
+	return out, nil
+}
+
+// ensureApproved keeps replay from bypassing governance: every ticket bound
+// to the event must have been approved, and approval-gated operations must
+// have one.
+func (uc *ReplayEventUseCase) ensureApproved(ctx context.Context, eventID string, required bool) error {
+	tickets, err := uc.entClient.ApprovalTicket.Query().
+		Where(approvalticket.EventIDEQ(eventID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("load tickets for event %s: %w", eventID, err)
+	}
+	if required && len(tickets) == 0 {
+		return apperrors.Conflict(CodeEventNotApproved, "event has no approval ticket")
+	}
+	for _, ticket := range tickets {
+		switch ticket.Status {
+		case approvalticket.StatusAPPROVED, approvalticket.StatusEXECUTING, approvalticket.StatusFAILED:
+		default:
+			return apperrors.Conflict(
+				CodeEventNotApproved,
+				fmt.Sprintf("approval ticket %s is %s", ticket.ID, ticket.Status),
+			).WithParams(map[string]interface{}{
+				"ticket_id":     ticket.ID,
+				"ticket_status": string(ticket.Status),
+			})
+		}
+	}
+	return nil
+}
+
+// replayJobArgs maps an event type to the River job that executes it and
+// reports whether the operation is approval-gated.
+func replayJobArgs(event *ent.DomainEvent) (river.JobArgs, bool, bool) {
+	switch domain.EventType(event.EventType) {
+	case domain.EventVMCreationRequested, domain.EventVMCloneRequested:
+		return jobs.VMCreateArgs{EventID: event.ID}, true, true
+	case domain.EventVMDeletionRequested:
+		return jobs.VMDeleteArgs{EventID: event.ID}, true, true
+	case domain.EventVMStartRequested:
+		return jobs.VMPowerArgs{EventID: event.ID, Operation: "start"}, false, true
+	case domain.EventVMStopRequested:
+		return jobs.VMPowerArgs{EventID: event.ID, Operation: "stop"}, false, true
+	case domain.EventVMRestartRequested:
+		return jobs.VMPowerArgs{EventID: event.ID, Operation: "restart"}, false, true
+	case domain.EventVMHostnameUpdateRequested:
+		return jobs.VMHostnameUpdateArgs{EventID: event.ID}, false, true
+	default:
+		return nil, false, false
+	}
+}
+
+func replayJobQueue(args river.JobArgs) string {
+	if withOpts, ok := args.(river.JobArgsWithInsertOpts); ok {
+		if queue := withOpts.InsertOpts().Queue; queue != "" {
+			return queue
+		}
+	}
+	return river.QueueDefault
+}
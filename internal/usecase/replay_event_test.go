@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/jobs"
+	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+// uniqueInserter mimics River's ByArgs uniqueness: while a job with the same
+// kind and args is live, further inserts are skipped as duplicates.
+type uniqueInserter struct {
+	live   map[string]int64
+	nextID int64
+}
+
+func (u *uniqueInserter) Insert(_ context.Context, args river.JobArgs, _ *river.InsertOpts) (*rivertype.JobInsertResult, error) {
+	var byArgs bool
+	if withOpts, ok := args.(river.JobArgsWithInsertOpts); ok {
+		byArgs = withOpts.InsertOpts().UniqueOpts.ByArgs
+	}
+	raw, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+	key := args.Kind() + ":" + string(raw)
+	if id, ok := u.live[key]; ok && byArgs {
+		return &rivertype.JobInsertResult{Job: &rivertype.JobRow{ID: id}, UniqueSkippedAsDuplicate: true}, nil
+	}
+	u.nextID++
+	u.live[key] = u.nextID
+	return &rivertype.JobInsertResult{Job: &rivertype.JobRow{ID: u.nextID}}, nil
+}
+
+func seedReplayEvent(t *testing.T, client *ent.Client, id string, eventType domain.EventType, status domainevent.Status) {
+	t.Helper()
+	client.DomainEvent.Create().
+		SetID(id).
+		SetEventType(string(eventType)).
+		SetAggregateType("vm").
+		SetAggregateID("vm-" + id).
+		SetPayload([]byte(`{}`)).
+		SetStatus(status).
+		SetCreatedBy("owner-1").
+		SaveX(t.Context())
+}
+
+func TestReplayEventUseCase_DoesNotDuplicateLiveJobs(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "replay_event_usecase")
+	seedReplayEvent(t, client, "ev-create", domain.EventVMCreationRequested, domainevent.StatusFAILED)
+	client.ApprovalTicket.Create().
+		SetID("ticket-create").
+		SetEventID("ev-create").
+		SetRequester("owner-1").
+		SetStatus(approvalticket.StatusFAILED).
+		SaveX(t.Context())
+	seedReplayEvent(t, client, "ev-stop", domain.EventVMStopRequested, domainevent.StatusPENDING)
+
+	inserter := &uniqueInserter{live: map[string]int64{}}
+	uc := NewReplayEventUseCase(client, inserter)
+
+	dry, err := uc.Execute(t.Context(), ReplayEventInput{EventID: "ev-create", DryRun: true, Actor: "admin"})
+	if err != nil {
+		t.Fatalf("dry run error = %v", err)
+	}
+	if dry.JobKind != "vm_create" || dry.Queue != "vm_operations" || len(inserter.live) != 0 {
+		t.Fatalf("dry run = %+v, inserted %d jobs", dry, len(inserter.live))
+	}
+	if got := client.DomainEvent.GetX(t.Context(), "ev-create").Status; got != domainevent.StatusFAILED {
+		t.Fatalf("event status after dry run = %s, want FAILED", got)
+	}
+
+	first, err := uc.Execute(t.Context(), ReplayEventInput{EventID: "ev-create", Actor: "admin"})
+	if err != nil {
+		t.Fatalf("first replay error = %v", err)
+	}
+	if first.Duplicate || first.JobID == 0 || first.PreviousStatus != "FAILED" {
+		t.Fatalf("first replay = %+v", first)
+	}
+	if got := client.DomainEvent.GetX(t.Context(), "ev-create").Status; got != domainevent.StatusPENDING {
+		t.Fatalf("event status after replay = %s, want PENDING", got)
+	}
+
+	// The event is PENDING again, so it stays replayable; the live job must absorb the retry.
+	second, err := uc.Execute(t.Context(), ReplayEventInput{EventID: "ev-create", Actor: "admin"})
+	if err != nil {
+		t.Fatalf("second replay error = %v", err)
+	}
+	if !second.Duplicate || second.JobID != first.JobID || len(inserter.live) != 1 {
+		t.Fatalf("second replay = %+v, jobs = %d, want duplicate of job %d", second, len(inserter.live), first.JobID)
+	}
+
+	for i := 0; i < 2; i++ {
+		out, err := uc.Execute(t.Context(), ReplayEventInput{EventID: "ev-stop", Actor: "admin"})
+		if err != nil {
+			t.Fatalf("power replay %d error = %v", i, err)
+		}
+		args, ok := out.JobArgs.(jobs.VMPowerArgs)
+		if !ok || args.Operation != "stop" {
+			t.Fatalf("power replay args = %#v", out.JobArgs)
+		}
+		if out.Duplicate != (i == 1) {
+			t.Fatalf("power replay %d duplicate = %v", i, out.Duplicate)
+		}
+	}
+	if len(inserter.live) != 2 {
+		t.Fatalf("live jobs = %d, want 2", len(inserter.live))
+	}
+}
+
+func TestReplayEventUseCase_Rejections(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "replay_event_usecase_reject")
+	seedReplayEvent(t, client, "ev-done", domain.EventVMStartRequested, domainevent.StatusCOMPLETED)
+	seedReplayEvent(t, client, "ev-migrate", domain.EventVMMigrationRequested, domainevent.StatusFAILED)
+	seedReplayEvent(t, client, "ev-unapproved", domain.EventVMDeletionRequested, domainevent.StatusPENDING)
+	client.ApprovalTicket.Create().
+		SetID("ticket-unapproved").
+		SetEventID("ev-unapproved").
+		SetRequester("owner-1").
+		SetOperationType(approvalticket.OperationTypeDELETE).
+		SaveX(t.Context())
+	seedReplayEvent(t, client, "ev-orphan", domain.EventVMCreationRequested, domainevent.StatusPENDING)
+
+	inserter := &uniqueInserter{live: map[string]int64{}}
+	uc := NewReplayEventUseCase(client, inserter)
+	tests := []struct {
+		eventID  string
+		wantCode string
+	}{
+		{eventID: "ev-missing", wantCode: CodeEventNotFound},
+		{eventID: "ev-done", wantCode: CodeEventNotReplayable},
+		{eventID: "ev-migrate", wantCode: CodeEventTypeNotReplayable},
+		{eventID: "ev-unapproved", wantCode: CodeEventNotApproved},
+		{eventID: "ev-orphan", wantCode: CodeEventNotApproved},
+	}
+	for _, tc := range tests {
+		_, err := uc.Execute(t.Context(), ReplayEventInput{EventID: tc.eventID, Actor: "admin"})
+		appErr, ok := apperrors.IsAppError(err)
+		if !ok || appErr.Code != tc.wantCode {
+			t.Fatalf("Execute(%s) error = %v, want code %s", tc.eventID, err, tc.wantCode)
+		}
+	}
+	if len(inserter.live) != 0 {
+		t.Fatalf("rejected replays inserted %d jobs", len(inserter.live))
+	}
+}
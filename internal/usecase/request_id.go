@@ -0,0 +1,182 @@
+// Package usecase — client idempotency keys for single-VM approval requests.
+//
+// Import Path (ADR-0016): kv-shepherd.io/shepherd/internal/usecase
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/vmrequestidempotency"
+	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
+)
+
+// CodeRequestIDReused is returned when a request_id is replayed against a
+// different operation than the open ticket it was first submitted with.
+const CodeRequestIDReused = "REQUEST_ID_REUSED"
+
+// maxRequestIDLen mirrors the approval_tickets.request_id column size.
+const maxRequestIDLen = 128
+
+// openTicketStatuses are the ticket states covered by the partial unique
+// index on (requester, request_id). Terminal tickets release the key.
+var openTicketStatuses = []approvalticket.Status{
+	approvalticket.StatusPENDING,
+	approvalticket.StatusAPPROVED,
+	approvalticket.StatusEXECUTING,
+}
+
+func normalizeRequestID(requestID string) (string, error) {
+	requestID = strings.TrimSpace(requestID)
+	if len(requestID) > maxRequestIDLen {
+		return "", apperrors.BadRequest(
+			apperrors.CodeInvalidRequestField,
+			fmt.Sprintf("request_id must be at most %d characters", maxRequestIDLen),
+		).WithParams(map[string]interface{}{"field": "request_id", "max_length": maxRequestIDLen})
+	}
+	return requestID, nil
+}
+
+func optionalRequestID(requestID string) *string {
+	if requestID == "" {
+		return nil
+	}
+	return &requestID
+}
+
+// findOpenTicketByRequestID returns the requester's open ticket submitted
+// under requestID, or nil when there is none. A hit for another operation
+// type is reported as a conflict rather than replayed.
+func findOpenTicketByRequestID(
+	ctx context.Context,
+	client *ent.Client,
+	requester, requestID string,
+	op approvalticket.OperationType,
+) (*ent.ApprovalTicket, error) {
+	if requestID == "" {
+		return nil, nil
+	}
+	ticket, err := client.ApprovalTicket.Query().
+		Where(
+			approvalticket.RequesterEQ(requester),
+			approvalticket.RequestIDEQ(requestID),
+			approvalticket.StatusIn(openTicketStatuses...),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("find ticket by request_id: %w", err)
+	}
+	if ticket.OperationType != op {
+		return nil, apperrors.Conflict(
+			CodeRequestIDReused,
+			"request_id is already in use by another open request",
+		).WithParams(map[string]interface{}{
+			"existing_ticket_id": ticket.ID,
+			"operation":          string(ticket.OperationType),
+		})
+	}
+	return ticket, nil
+}
+
+// replayAfterInsertRace resolves a unique violation on the request_id index,
+// raised when a concurrent submission with the same key committed first.
+func replayAfterInsertRace(
+	ctx context.Context,
+	client *ent.Client,
+	requester, requestID string,
+	op approvalticket.OperationType,
+	txErr error,
+) (*ent.ApprovalTicket, bool) {
+	if requestID == "" || !ent.IsConstraintError(txErr) {
+		return nil, false
+	}
+	ticket, err := findOpenTicketByRequestID(ctx, client, requester, requestID, op)
+	if err != nil || ticket == nil {
+		return nil, false
+	}
+	return ticket, true
+}
+
+// errRequestIDClaimLost reports that a concurrent create took over a closed
+// request_id claim first.
+var errRequestIDClaimLost = errors.New("request_id claim taken by a concurrent request")
+
+// findCreateRequestClaim returns the requester's request_id claim for VM
+// creates and the ticket it points at when that ticket is still open.
+// The ticket is nil when there is no claim or the claim can be taken over.
+func findCreateRequestClaim(
+	ctx context.Context,
+	client *ent.Client,
+	requester, requestID string,
+) (*ent.VMRequestIdempotency, *ent.ApprovalTicket, error) {
+	if requestID == "" {
+		return nil, nil, nil
+	}
+	claim, err := client.VMRequestIdempotency.Query().
+		Where(
+			vmrequestidempotency.CreatedByEQ(requester),
+			vmrequestidempotency.RequestIDEQ(requestID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("find request_id claim: %w", err)
+	}
+	ticket, err := client.ApprovalTicket.Get(ctx, claim.TicketID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return claim, nil, nil
+		}
+		return nil, nil, fmt.Errorf("load request_id claim ticket: %w", err)
+	}
+	if !slices.Contains(openTicketStatuses, ticket.Status) {
+		return claim, nil, nil
+	}
+	return claim, ticket, nil
+}
+
+// claimCreateRequestID records ticketID as the holder of the requester's
+// request_id within the create transaction. Without an existing claim the
+// row is inserted, so a concurrent insert fails on the unique index; a closed
+// claim is taken over only if it still points at the ticket that was read.
+func claimCreateRequestID(
+	ctx context.Context,
+	tx *ent.Tx,
+	claim *ent.VMRequestIdempotency,
+	requester, requestID, ticketID, eventID string,
+) error {
+	if claim == nil {
+		return tx.VMRequestIdempotency.Create().
+			SetID(generateID()).
+			SetCreatedBy(requester).
+			SetRequestID(requestID).
+			SetTicketID(ticketID).
+			SetEventID(eventID).
+			Exec(ctx)
+	}
+	n, err := tx.VMRequestIdempotency.Update().
+		Where(
+			vmrequestidempotency.IDEQ(claim.ID),
+			vmrequestidempotency.TicketIDEQ(claim.TicketID),
+		).
+		SetTicketID(ticketID).
+		SetEventID(eventID).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("take over request_id claim: %w", err)
+	}
+	if n == 0 {
+		return errRequestIDClaimLost
+	}
+	return nil
+}
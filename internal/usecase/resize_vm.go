@@ -0,0 +1,361 @@
+// Package usecase — ResizeVMUseCase orchestrates the VM resize (vertical scaling) approval flow.
+//
+// ADR-0012: Atomic transaction for DomainEvent + ApprovalTicket.
+//
+// Import Path (ADR-0016): kv-shepherd.io/shepherd/internal/usecase
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+)
+
+// CodeResizeAlreadyPending is returned when a VM already has a pending resize ticket.
+const CodeResizeAlreadyPending = "RESIZE_ALREADY_PENDING"
+
+// ResizeVMInput represents the input for requesting a VM resize.
+// Exactly one of InstanceSizeID or CPU/MemoryMB/DiskGB must be given; an
+// explicit request may set any subset and keep the other values unchanged.
+type ResizeVMInput struct {
+	VMID           string `json:"vm_id"`
+	InstanceSizeID string `json:"instance_size_id"`
+	CPU            int    `json:"cpu"`
+	MemoryMB       int    `json:"memory_mb"`
+	DiskGB         int    `json:"disk_gb"`
+	Reason         string `json:"reason"`
+	RequestedBy    string `json:"requested_by"`
+}
+
+// ResizeVMOutput represents the output of a VM resize request.
+type ResizeVMOutput struct {
+	TicketID string        `json:"ticket_id"`
+	EventID  string        `json:"event_id"`
+	Status   string        `json:"status"`
+	From     domain.VMSize `json:"from"`
+	To       domain.VMSize `json:"to"`
+}
+
+// ResizeVMUseCase orchestrates VM CPU/memory/disk changes through the approval flow.
+// Flow: User requests resize → DomainEvent + ApprovalTicket (RESIZE) created →
+// Admin approves (optionally picking another size) → River job applies the size.
+type ResizeVMUseCase struct {
+	entClient   *ent.Client
+	auditLogger *audit.Logger
+}
+
+// NewResizeVMUseCase creates a new ResizeVMUseCase.
+func NewResizeVMUseCase(entClient *ent.Client) *ResizeVMUseCase {
+	return &ResizeVMUseCase{entClient: entClient}
+}
+
+// WithAuditLogger sets the audit logger (optional dependency).
+func (uc *ResizeVMUseCase) WithAuditLogger(al *audit.Logger) *ResizeVMUseCase {
+	uc.auditLogger = al
+	return uc
+}
+
+// Execute runs the VM resize request use case.
+// Phase 1: Validates VM state and the requested size.
+// Phase 2: Creates DomainEvent + ApprovalTicket (operation_type=RESIZE) in atomic transaction.
+// Phase 3: After admin approval, Gateway enqueues River job for the K8s resize.
+func (uc *ResizeVMUseCase) Execute(ctx context.Context, input ResizeVMInput) (*ResizeVMOutput, error) {
+	instanceSizeID := strings.TrimSpace(input.InstanceSizeID)
+	explicit := input.CPU != 0 || input.MemoryMB != 0 || input.DiskGB != 0
+	switch {
+	case instanceSizeID == "" && !explicit:
+		return nil, apperrors.BadRequest(apperrors.CodeInvalidRequestField, "instance_size_id or cpu/memory_mb/disk_gb is required")
+	case instanceSizeID != "" && explicit:
+		return nil, apperrors.BadRequest(apperrors.CodeInvalidRequestField, "instance_size_id cannot be combined with cpu/memory_mb/disk_gb")
+	case input.CPU < 0 || input.MemoryMB < 0 || input.DiskGB < 0:
+		return nil, apperrors.BadRequest(apperrors.CodeInvalidRequestField, "cpu, memory_mb and disk_gb must be positive")
+	}
+
+	// Step 1: Fetch VM and validate state.
+	vm, err := uc.entClient.VM.Get(ctx, input.VMID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.NotFound(apperrors.CodeVMNotFound, fmt.Sprintf("VM %s not found", input.VMID))
+		}
+		return nil, fmt.Errorf("get VM %s: %w", input.VMID, err)
+	}
+	if vm.Status != entvm.StatusRUNNING && vm.Status != entvm.StatusSTOPPED {
+		return nil, apperrors.Conflict("INVALID_STATE_TRANSITION",
+			fmt.Sprintf("cannot resize VM in %s state, must be RUNNING or STOPPED", vm.Status))
+	}
+
+	// Step 2: Duplicate pending guard — at most one pending resize per VM.
+	existingTicket, err := uc.findPendingResize(ctx, input.VMID)
+	if err != nil {
+		return nil, fmt.Errorf("check pending resize request: %w", err)
+	}
+	if existingTicket != nil {
+		return nil, apperrors.Conflict(
+			CodeResizeAlreadyPending,
+			"a resize request is already pending for this VM",
+		).WithParams(map[string]interface{}{
+			"existing_ticket_id": existingTicket.ID,
+			"resource_id":        input.VMID,
+		})
+	}
+
+	// Step 3: Snapshot current and target sizes.
+	from, err := uc.currentSize(ctx, vm)
+	if err != nil {
+		return nil, fmt.Errorf("resolve current size of VM %s: %w", input.VMID, err)
+	}
+	to, err := uc.targetSize(ctx, input, from)
+	if err != nil {
+		return nil, err
+	}
+	if to.CPU == from.CPU && to.MemoryMB == from.MemoryMB && to.DiskGB == from.DiskGB {
+		return nil, apperrors.BadRequest(apperrors.CodeValidationFailed,
+			fmt.Sprintf("VM %s already has %d CPU / %d MB memory / %d GB disk", vm.Name, to.CPU, to.MemoryMB, to.DiskGB))
+	}
+	if to.DiskGB < from.DiskGB {
+		return nil, apperrors.BadRequest(apperrors.CodeValidationFailed,
+			fmt.Sprintf("disk of VM %s cannot shrink from %d GB to %d GB", vm.Name, from.DiskGB, to.DiskGB))
+	}
+
+	// Step 4: Build domain event payload.
+	payload := domain.VMResizePayload{
+		VMID:      input.VMID,
+		VMName:    vm.Name,
+		ClusterID: vm.ClusterID,
+		Namespace: vm.Namespace,
+		From:      from,
+		To:        to,
+		Actor:     input.RequestedBy,
+	}
+	payloadBytes, err := payload.ToJSON()
+	if err != nil {
+		return nil, fmt.Errorf("marshal resize payload: %w", err)
+	}
+
+	// Step 5: Atomic transaction — DomainEvent + ApprovalTicket (ADR-0012).
+	reason := input.Reason
+	if reason == "" {
+		reason = fmt.Sprintf("Request to resize VM %s from %d CPU / %d MB to %d CPU / %d MB",
+			vm.Name, from.CPU, from.MemoryMB, to.CPU, to.MemoryMB)
+	}
+
+	var eventID, ticketID string
+	txErr := withTx(ctx, uc.entClient, func(tx *ent.Tx) error {
+		event, err := tx.DomainEvent.Create().
+			SetID(generateID()).
+			SetEventType(string(domain.EventVMResizeRequested)).
+			SetAggregateType("vm").
+			SetAggregateID(input.VMID).
+			SetPayload(payloadBytes).
+			SetCreatedBy(input.RequestedBy).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create domain event: %w", err)
+		}
+		eventID = event.ID
+
+		ticket, err := tx.ApprovalTicket.Create().
+			SetID(generateID()).
+			SetEventID(event.ID).
+			SetOperationType(approvalticket.OperationTypeRESIZE).
+			SetRequester(input.RequestedBy).
+			SetReason(reason).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create approval ticket: %w", err)
+		}
+		ticketID = ticket.ID
+
+		return nil
+	})
+
+	if txErr != nil {
+		return nil, fmt.Errorf("create vm resize request: %w", txErr)
+	}
+
+	// Step 6: Audit log (best-effort, outside transaction).
+	if uc.auditLogger != nil {
+		_ = uc.auditLogger.LogAction(ctx, "vm.resize_requested", "approval_ticket", ticketID, input.RequestedBy, map[string]interface{}{
+			"vm_id":         input.VMID,
+			"vm_name":       vm.Name,
+			"from_cpu":      from.CPU,
+			"from_memory":   from.MemoryMB,
+			"to_cpu":        to.CPU,
+			"to_memory":     to.MemoryMB,
+			"to_disk_gb":    to.DiskGB,
+			"instance_size": to.InstanceSizeID,
+		})
+	}
+
+	logger.Info("VM resize request submitted (pending approval)",
+		zap.String("event_id", eventID),
+		zap.String("ticket_id", ticketID),
+		zap.String("vm_id", input.VMID),
+		zap.Int("to_cpu", to.CPU),
+		zap.Int("to_memory_mb", to.MemoryMB),
+		zap.String("requester", input.RequestedBy),
+	)
+
+	return &ResizeVMOutput{
+		TicketID: ticketID,
+		EventID:  eventID,
+		Status:   "PENDING",
+		From:     from,
+		To:       to,
+	}, nil
+}
+
+// targetSize resolves the requested size; explicit values not given keep
+// the current value, as does the disk of an instance size without one.
+func (uc *ResizeVMUseCase) targetSize(ctx context.Context, input ResizeVMInput, from domain.VMSize) (domain.VMSize, error) {
+	instanceSizeID := strings.TrimSpace(input.InstanceSizeID)
+	if instanceSizeID == "" {
+		to := domain.VMSize{CPU: input.CPU, MemoryMB: input.MemoryMB, DiskGB: input.DiskGB}
+		if to.CPU == 0 {
+			to.CPU = from.CPU
+		}
+		if to.MemoryMB == 0 {
+			to.MemoryMB = from.MemoryMB
+		}
+		if to.DiskGB == 0 {
+			to.DiskGB = from.DiskGB
+		}
+		if to.CPU <= 0 || to.MemoryMB <= 0 {
+			return domain.VMSize{}, apperrors.BadRequest(apperrors.CodeInvalidRequestField,
+				"current size is unknown; both cpu and memory_mb are required")
+		}
+		return to, nil
+	}
+
+	size, err := uc.entClient.InstanceSize.Get(ctx, instanceSizeID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.VMSize{}, apperrors.NotFound("INSTANCE_SIZE_NOT_FOUND", fmt.Sprintf("instance size %s not found", instanceSizeID))
+		}
+		return domain.VMSize{}, fmt.Errorf("get instance size %s: %w", instanceSizeID, err)
+	}
+	if !size.Enabled {
+		return domain.VMSize{}, apperrors.BadRequest(apperrors.CodeValidationFailed, fmt.Sprintf("instance size %s is disabled", size.Name))
+	}
+	to := domain.VMSize{
+		InstanceSizeID:   size.ID,
+		InstanceSizeName: size.Name,
+		CPU:              size.CPUCores,
+		MemoryMB:         size.MemoryMB,
+		DiskGB:           size.DiskGB,
+	}
+	if to.DiskGB == 0 {
+		to.DiskGB = from.DiskGB
+	}
+	return to, nil
+}
+
+// currentSize returns the size the VM was last resized to (as approved),
+// falling back to the instance size snapshot on its creation ticket. An
+// unknown size is returned as zero values.
+func (uc *ResizeVMUseCase) currentSize(ctx context.Context, vm *ent.VM) (domain.VMSize, error) {
+	lastResize, err := uc.entClient.DomainEvent.Query().
+		Where(
+			domainevent.EventTypeEQ(string(domain.EventVMResizeRequested)),
+			domainevent.AggregateTypeEQ("vm"),
+			domainevent.AggregateIDEQ(vm.ID),
+			domainevent.StatusEQ(domainevent.StatusCOMPLETED),
+		).
+		Order(ent.Desc(domainevent.FieldCreatedAt)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return domain.VMSize{}, err
+	}
+	if lastResize != nil {
+		var payload domain.VMResizePayload
+		if err := json.Unmarshal(lastResize.Payload, &payload); err == nil {
+			ticket, err := uc.entClient.ApprovalTicket.Query().
+				Where(approvalticket.EventIDEQ(lastResize.ID)).
+				Only(ctx)
+			if err != nil && !ent.IsNotFound(err) {
+				return domain.VMSize{}, err
+			}
+			if ticket != nil {
+				return payload.Target(ticket.ModifiedSpec), nil
+			}
+			return payload.To, nil
+		}
+	}
+
+	if vm.TicketID == "" {
+		return domain.VMSize{}, nil
+	}
+	ticket, err := uc.entClient.ApprovalTicket.Get(ctx, vm.TicketID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.VMSize{}, nil
+		}
+		return domain.VMSize{}, err
+	}
+	return sizeFromInstanceSnapshot(ticket.InstanceSizeSnapshot), nil
+}
+
+func sizeFromInstanceSnapshot(snapshot map[string]interface{}) domain.VMSize {
+	var size domain.VMSize
+	size.InstanceSizeID, _ = snapshot["id"].(string)
+	size.InstanceSizeName, _ = snapshot["name"].(string)
+	size.CPU = snapshotInt(snapshot["cpu_cores"])
+	size.MemoryMB = snapshotInt(snapshot["memory_mb"])
+	size.DiskGB = snapshotInt(snapshot["disk_gb"])
+	return size
+}
+
+// snapshotInt reads a number from a JSON-decoded snapshot map.
+func snapshotInt(v interface{}) int {
+	switch n := v.(type) {
+	case float64:
+		return int(n)
+	case int:
+		return n
+	case json.Number:
+		i, _ := n.Int64()
+		return int(i)
+	default:
+		return 0
+	}
+}
+
+func (uc *ResizeVMUseCase) findPendingResize(ctx context.Context, vmID string) (*ent.ApprovalTicket, error) {
+	eventIDs, err := uc.entClient.DomainEvent.Query().
+		Where(
+			domainevent.EventTypeEQ(string(domain.EventVMResizeRequested)),
+			domainevent.AggregateTypeEQ("vm"),
+			domainevent.AggregateIDEQ(strings.TrimSpace(vmID)),
+		).
+		IDs(ctx)
+	if err != nil || len(eventIDs) == 0 {
+		return nil, err
+	}
+
+	ticket, err := uc.entClient.ApprovalTicket.Query().
+		Where(
+			approvalticket.EventIDIn(eventIDs...),
+			approvalticket.OperationTypeEQ(approvalticket.OperationTypeRESIZE),
+			approvalticket.StatusIn(approvalticket.StatusPENDING, approvalticket.StatusAPPROVED, approvalticket.StatusEXECUTING),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return ticket, nil
+}
@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"encoding/json"
+	"testing"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/domain"
+	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+// seedResizeFixture creates a VM whose creation ticket snapshotted the
+// "small" instance size (2 CPU / 4096 MB / 20 GB), plus a "large" size to
+// resize to.
+func seedResizeFixture(t *testing.T, client *ent.Client, vmStatus entvm.Status) *ent.VM {
+	t.Helper()
+	ctx := t.Context()
+
+	sys := client.System.Create().SetID("sys-resize").SetName("shop").SetCreatedBy("owner-1").SaveX(ctx)
+	svc := client.Service.Create().SetID("svc-resize").SetName("redis").SetSystemID(sys.ID).SaveX(ctx)
+	client.InstanceSize.Create().
+		SetID("size-large").
+		SetName("large").
+		SetCPUCores(4).
+		SetMemoryMB(8192).
+		SetCreatedBy("admin").
+		SaveX(ctx)
+	client.InstanceSize.Create().
+		SetID("size-disabled").
+		SetName("legacy").
+		SetCPUCores(8).
+		SetMemoryMB(16384).
+		SetEnabled(false).
+		SetCreatedBy("admin").
+		SaveX(ctx)
+	client.ApprovalTicket.Create().
+		SetID("ticket-create-resize").
+		SetEventID("event-create-resize").
+		SetRequester("owner-1").
+		SetStatus(approvalticket.StatusSUCCESS).
+		SetInstanceSizeSnapshot(map[string]interface{}{
+			"id":        "size-small",
+			"name":      "small",
+			"cpu_cores": 2,
+			"memory_mb": 4096,
+			"disk_gb":   20,
+		}).
+		SaveX(ctx)
+	return client.VM.Create().
+		SetID("vm-resize").
+		SetName("prod-shop-shop-redis-01").
+		SetInstance("01").
+		SetNamespace("prod-shop").
+		SetClusterID("cluster-a").
+		SetStatus(vmStatus).
+		SetCreatedBy("owner-1").
+		SetTicketID("ticket-create-resize").
+		SetServiceID(svc.ID).
+		SaveX(ctx)
+}
+
+func TestResizeVMUseCase_SnapshotsSizesAndBlocksDuplicates(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "resize_vm_usecase")
+	vm := seedResizeFixture(t, client, entvm.StatusRUNNING)
+
+	uc := NewResizeVMUseCase(client)
+	out, err := uc.Execute(t.Context(), ResizeVMInput{
+		VMID:           vm.ID,
+		InstanceSizeID: "size-large",
+		RequestedBy:    "owner-1",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	ticket, err := client.ApprovalTicket.Get(t.Context(), out.TicketID)
+	if err != nil {
+		t.Fatalf("get ticket: %v", err)
+	}
+	if ticket.OperationType != approvalticket.OperationTypeRESIZE || ticket.Status != approvalticket.StatusPENDING {
+		t.Fatalf("ticket = %s/%s, want RESIZE/PENDING", ticket.OperationType, ticket.Status)
+	}
+	event, err := client.DomainEvent.Get(t.Context(), out.EventID)
+	if err != nil {
+		t.Fatalf("get event: %v", err)
+	}
+	if event.EventType != string(domain.EventVMResizeRequested) {
+		t.Fatalf("event type = %s, want %s", event.EventType, domain.EventVMResizeRequested)
+	}
+	var payload domain.VMResizePayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		t.Fatalf("unmarshal payload: %v", err)
+	}
+	wantFrom := domain.VMSize{InstanceSizeID: "size-small", InstanceSizeName: "small", CPU: 2, MemoryMB: 4096, DiskGB: 20}
+	wantTo := domain.VMSize{InstanceSizeID: "size-large", InstanceSizeName: "large", CPU: 4, MemoryMB: 8192, DiskGB: 20}
+	if payload.From != wantFrom || payload.To != wantTo {
+		t.Fatalf("payload sizes = %+v -> %+v, want %+v -> %+v", payload.From, payload.To, wantFrom, wantTo)
+	}
+
+	_, err = uc.Execute(t.Context(), ResizeVMInput{VMID: vm.ID, CPU: 8, RequestedBy: "owner-1"})
+	appErr, ok := apperrors.IsAppError(err)
+	if !ok || appErr.Code != CodeResizeAlreadyPending || appErr.HTTPStatus != 409 {
+		t.Fatalf("second Execute() error = %v, want 409 %s", err, CodeResizeAlreadyPending)
+	}
+}
+
+func TestResizeVMUseCase_ExplicitSizeFromLastCompletedResize(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "resize_vm_usecase_explicit")
+	vm := seedResizeFixture(t, client, entvm.StatusSTOPPED)
+
+	// A completed resize supersedes the creation ticket snapshot, and the
+	// approver's selection on its ticket supersedes the requested size.
+	prior, _ := domain.VMResizePayload{
+		VMID: vm.ID,
+		From: domain.VMSize{CPU: 2, MemoryMB: 4096},
+		To:   domain.VMSize{CPU: 3, MemoryMB: 6144},
+	}.ToJSON()
+	client.DomainEvent.Create().
+		SetID("event-resize-prior").
+		SetEventType(string(domain.EventVMResizeRequested)).
+		SetAggregateType("vm").
+		SetAggregateID(vm.ID).
+		SetPayload(prior).
+		SetStatus(domainevent.StatusCOMPLETED).
+		SetCreatedBy("owner-1").
+		SaveX(t.Context())
+	client.ApprovalTicket.Create().
+		SetID("ticket-resize-prior").
+		SetEventID("event-resize-prior").
+		SetRequester("owner-1").
+		SetOperationType(approvalticket.OperationTypeRESIZE).
+		SetStatus(approvalticket.StatusSUCCESS).
+		SetModifiedSpec(map[string]interface{}{"cpu": 5, "memory_mb": 6144, "disk_gb": 30}).
+		SaveX(t.Context())
+
+	out, err := NewResizeVMUseCase(client).Execute(t.Context(), ResizeVMInput{
+		VMID:        vm.ID,
+		MemoryMB:    12288,
+		DiskGB:      40,
+		RequestedBy: "owner-1",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if out.From != (domain.VMSize{CPU: 5, MemoryMB: 6144, DiskGB: 30}) {
+		t.Fatalf("from = %+v, want approved size of last resize", out.From)
+	}
+	if out.To != (domain.VMSize{CPU: 5, MemoryMB: 12288, DiskGB: 40}) {
+		t.Fatalf("to = %+v, want cpu kept and memory/disk changed", out.To)
+	}
+}
+
+func TestResizeVMUseCase_Rejections(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		vmStatus entvm.Status
+		input    ResizeVMInput
+		wantCode string
+	}{
+		{name: "nothing requested", vmStatus: entvm.StatusRUNNING, wantCode: apperrors.CodeInvalidRequestField},
+		{
+			name:     "size and explicit combined",
+			vmStatus: entvm.StatusRUNNING,
+			input:    ResizeVMInput{InstanceSizeID: "size-large", CPU: 4},
+			wantCode: apperrors.CodeInvalidRequestField,
+		},
+		{name: "negative cpu", vmStatus: entvm.StatusRUNNING, input: ResizeVMInput{CPU: -1}, wantCode: apperrors.CodeInvalidRequestField},
+		{name: "vm creating", vmStatus: entvm.StatusCREATING, input: ResizeVMInput{CPU: 4}, wantCode: "INVALID_STATE_TRANSITION"},
+		{name: "vm migrating", vmStatus: entvm.StatusMIGRATING, input: ResizeVMInput{CPU: 4}, wantCode: "INVALID_STATE_TRANSITION"},
+		{name: "unknown size", vmStatus: entvm.StatusRUNNING, input: ResizeVMInput{InstanceSizeID: "size-x"}, wantCode: "INSTANCE_SIZE_NOT_FOUND"},
+		{name: "disabled size", vmStatus: entvm.StatusRUNNING, input: ResizeVMInput{InstanceSizeID: "size-disabled"}, wantCode: apperrors.CodeValidationFailed},
+		{name: "unchanged size", vmStatus: entvm.StatusRUNNING, input: ResizeVMInput{CPU: 2, MemoryMB: 4096}, wantCode: apperrors.CodeValidationFailed},
+		{name: "disk shrink", vmStatus: entvm.StatusRUNNING, input: ResizeVMInput{DiskGB: 10}, wantCode: apperrors.CodeValidationFailed},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := testutil.OpenEntPostgres(t, "resize_vm_usecase_reject")
+			vm := seedResizeFixture(t, client, tc.vmStatus)
+
+			input := tc.input
+			input.VMID = vm.ID
+			input.RequestedBy = "owner-1"
+			_, err := NewResizeVMUseCase(client).Execute(t.Context(), input)
+			appErr, ok := apperrors.IsAppError(err)
+			if !ok || appErr.Code != tc.wantCode {
+				t.Fatalf("Execute() error = %v, want code %s", err, tc.wantCode)
+			}
+		})
+	}
+}
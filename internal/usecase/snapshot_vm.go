@@ -0,0 +1,366 @@
+// Package usecase — SnapshotVMUseCase orchestrates VM snapshot create, restore, list and delete.
+//
+// ADR-0012: Atomic transaction for DomainEvent + ApprovalTicket when snapshot
+// creation requires approval.
+//
+// Import Path (ADR-0016): kv-shepherd.io/shepherd/internal/usecase
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
+	"kv-shepherd.io/shepherd/internal/pkg/logger"
+	"kv-shepherd.io/shepherd/internal/service"
+)
+
+// Snapshot error codes.
+const (
+	CodeSnapshotNotFound         = "SNAPSHOT_NOT_FOUND"
+	CodeSnapshotAlreadyExists    = "SNAPSHOT_ALREADY_EXISTS"
+	CodeSnapshotOperationPending = "SNAPSHOT_OPERATION_PENDING"
+	CodeSnapshotsUnsupported     = "SNAPSHOTS_UNSUPPORTED"
+	CodeRestoreRequiresForce     = "RESTORE_REQUIRES_FORCE"
+)
+
+// CreateSnapshotInput represents the input for snapshotting a VM.
+// Name defaults to "<vm name>-snap-<unix seconds>".
+type CreateSnapshotInput struct {
+	VMID        string `json:"vm_id"`
+	Name        string `json:"name"`
+	Reason      string `json:"reason"`
+	RequestedBy string `json:"requested_by"`
+}
+
+// RestoreSnapshotInput represents the input for restoring a VM from a snapshot.
+// Force allows restoring a RUNNING VM, which is stopped first.
+type RestoreSnapshotInput struct {
+	VMID         string `json:"vm_id"`
+	SnapshotName string `json:"snapshot_name"`
+	Force        bool   `json:"force"`
+	Reason       string `json:"reason"`
+	RequestedBy  string `json:"requested_by"`
+}
+
+// SnapshotOperationOutput represents an accepted snapshot create or restore.
+// TicketID is set only when the operation waits for approval; otherwise the
+// caller enqueues the snapshot job for EventID.
+type SnapshotOperationOutput struct {
+	EventID      string `json:"event_id"`
+	TicketID     string `json:"ticket_id,omitempty"`
+	SnapshotName string `json:"snapshot_name"`
+	Status       string `json:"status"`
+}
+
+// SnapshotVMUseCase manages VM snapshots.
+// Flow: User requests snapshot → DomainEvent (+ ApprovalTicket SNAPSHOT when
+// approval is required) → River job creates the snapshot. Restores never
+// need approval and run through the same job.
+type SnapshotVMUseCase struct {
+	entClient       *ent.Client
+	vmService       *service.VMService
+	requireApproval bool
+	auditLogger     *audit.Logger
+}
+
+// NewSnapshotVMUseCase creates a new SnapshotVMUseCase.
+func NewSnapshotVMUseCase(entClient *ent.Client, vmService *service.VMService, requireApproval bool) *SnapshotVMUseCase {
+	return &SnapshotVMUseCase{entClient: entClient, vmService: vmService, requireApproval: requireApproval}
+}
+
+// WithAuditLogger sets the audit logger (optional dependency).
+func (uc *SnapshotVMUseCase) WithAuditLogger(al *audit.Logger) *SnapshotVMUseCase {
+	uc.auditLogger = al
+	return uc
+}
+
+// CreateSnapshot requests a snapshot of a RUNNING or STOPPED VM.
+func (uc *SnapshotVMUseCase) CreateSnapshot(ctx context.Context, input CreateSnapshotInput) (*SnapshotOperationOutput, error) {
+	vm, err := uc.snapshotTarget(ctx, input.VMID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		name = fmt.Sprintf("%s-snap-%d", vm.Name, time.Now().Unix())
+	}
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return nil, apperrors.BadRequest(apperrors.CodeNameInvalid,
+			fmt.Sprintf("invalid snapshot name %q: %s", name, strings.Join(errs, "; ")))
+	}
+	if _, err := uc.vmService.GetSnapshot(ctx, vm.ClusterID, vm.Namespace, name); err == nil {
+		return nil, apperrors.Conflict(CodeSnapshotAlreadyExists, fmt.Sprintf("snapshot %s already exists", name))
+	} else if !k8serrors.IsNotFound(err) {
+		return nil, snapshotProviderError(err, "check snapshot "+name)
+	}
+
+	out, err := uc.submit(ctx, vm, domain.EventVMSnapshotCreateRequested, name, false, input.RequestedBy, input.Reason, uc.requireApproval)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("VM snapshot requested",
+		zap.String("event_id", out.EventID),
+		zap.String("ticket_id", out.TicketID),
+		zap.String("vm_id", vm.ID),
+		zap.String("snapshot", name),
+		zap.String("requester", input.RequestedBy),
+	)
+	return out, nil
+}
+
+// RestoreSnapshot requests an in-place restore of a VM from one of its
+// snapshots. A RUNNING VM is refused unless Force is set.
+func (uc *SnapshotVMUseCase) RestoreSnapshot(ctx context.Context, input RestoreSnapshotInput) (*SnapshotOperationOutput, error) {
+	vm, err := uc.snapshotTarget(ctx, input.VMID)
+	if err != nil {
+		return nil, err
+	}
+	if vm.Status == entvm.StatusRUNNING && !input.Force {
+		return nil, apperrors.Conflict(CodeRestoreRequiresForce,
+			"VM is RUNNING; stop it first or pass force to stop it during the restore")
+	}
+	if _, err := uc.vmSnapshot(ctx, vm, input.SnapshotName); err != nil {
+		return nil, err
+	}
+
+	out, err := uc.submit(ctx, vm, domain.EventVMSnapshotRestoreRequested, input.SnapshotName, input.Force, input.RequestedBy, input.Reason, false)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("VM snapshot restore requested",
+		zap.String("event_id", out.EventID),
+		zap.String("vm_id", vm.ID),
+		zap.String("snapshot", input.SnapshotName),
+		zap.Bool("force", input.Force),
+		zap.String("requester", input.RequestedBy),
+	)
+	return out, nil
+}
+
+// ListSnapshots lists the snapshots of a VM, oldest first.
+func (uc *SnapshotVMUseCase) ListSnapshots(ctx context.Context, vmID string) ([]*domain.Snapshot, error) {
+	vm, err := uc.getVM(ctx, vmID)
+	if err != nil {
+		return nil, err
+	}
+	items, err := uc.vmService.ListSnapshots(ctx, vm.ClusterID, vm.Namespace, vm.Name)
+	if err != nil {
+		return nil, snapshotProviderError(err, "list snapshots")
+	}
+	return items, nil
+}
+
+// GetSnapshot returns one of a VM's snapshots as the cluster reports it.
+func (uc *SnapshotVMUseCase) GetSnapshot(ctx context.Context, vmID, snapshotName string) (*domain.Snapshot, error) {
+	vm, err := uc.getVM(ctx, vmID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.vmSnapshot(ctx, vm, snapshotName)
+}
+
+// DeleteSnapshot deletes one of a VM's snapshots.
+func (uc *SnapshotVMUseCase) DeleteSnapshot(ctx context.Context, vmID, snapshotName, actor string) error {
+	vm, err := uc.getVM(ctx, vmID)
+	if err != nil {
+		return err
+	}
+	if _, err := uc.vmSnapshot(ctx, vm, snapshotName); err != nil {
+		return err
+	}
+	if err := uc.vmService.DeleteSnapshot(ctx, vm.ClusterID, vm.Namespace, snapshotName); err != nil {
+		return snapshotProviderError(err, "delete snapshot "+snapshotName)
+	}
+
+	if uc.auditLogger != nil {
+		_ = uc.auditLogger.LogAction(ctx, "vm.snapshot_deleted", "vm", vm.ID, actor, map[string]interface{}{
+			"snapshot": snapshotName,
+		})
+	}
+	logger.Info("VM snapshot deleted",
+		zap.String("vm_id", vm.ID),
+		zap.String("snapshot", snapshotName),
+		zap.String("actor", actor),
+	)
+	return nil
+}
+
+// submit records the DomainEvent, plus a SNAPSHOT ApprovalTicket when
+// withTicket is set, in one transaction (ADR-0012).
+func (uc *SnapshotVMUseCase) submit(
+	ctx context.Context,
+	vm *ent.VM,
+	eventType domain.EventType,
+	snapshotName string,
+	force bool,
+	actor, reason string,
+	withTicket bool,
+) (*SnapshotOperationOutput, error) {
+	// At most one snapshot operation per VM at a time: a restore racing a
+	// snapshot of the same disks gives unpredictable results.
+	pending, err := uc.entClient.DomainEvent.Query().
+		Where(
+			domainevent.EventTypeIn(
+				string(domain.EventVMSnapshotCreateRequested),
+				string(domain.EventVMSnapshotRestoreRequested),
+			),
+			domainevent.AggregateTypeEQ("vm"),
+			domainevent.AggregateIDEQ(vm.ID),
+			domainevent.StatusIn(domainevent.StatusPENDING, domainevent.StatusPROCESSING),
+		).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("check pending snapshot operation: %w", err)
+	}
+	if pending != nil {
+		return nil, apperrors.Conflict(
+			CodeSnapshotOperationPending,
+			"a snapshot operation is already in progress for this VM",
+		).WithParams(map[string]interface{}{
+			"existing_event_id": pending.ID,
+			"resource_id":       vm.ID,
+		})
+	}
+
+	payloadBytes, err := domain.VMSnapshotPayload{
+		VMID:         vm.ID,
+		VMName:       vm.Name,
+		ClusterID:    vm.ClusterID,
+		Namespace:    vm.Namespace,
+		SnapshotName: snapshotName,
+		Force:        force,
+		Actor:        actor,
+	}.ToJSON()
+	if err != nil {
+		return nil, fmt.Errorf("marshal snapshot payload: %w", err)
+	}
+
+	var eventID, ticketID string
+	txErr := withTx(ctx, uc.entClient, func(tx *ent.Tx) error {
+		event, err := tx.DomainEvent.Create().
+			SetID(generateID()).
+			SetEventType(string(eventType)).
+			SetAggregateType("vm").
+			SetAggregateID(vm.ID).
+			SetPayload(payloadBytes).
+			SetCreatedBy(actor).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create domain event: %w", err)
+		}
+		eventID = event.ID
+		if !withTicket {
+			return nil
+		}
+
+		if reason == "" {
+			reason = fmt.Sprintf("Request to snapshot VM %s as %s", vm.Name, snapshotName)
+		}
+		ticket, err := tx.ApprovalTicket.Create().
+			SetID(generateID()).
+			SetEventID(event.ID).
+			SetOperationType(approvalticket.OperationTypeSNAPSHOT).
+			SetRequester(actor).
+			SetReason(reason).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create approval ticket: %w", err)
+		}
+		ticketID = ticket.ID
+		return nil
+	})
+	if txErr != nil {
+		return nil, fmt.Errorf("create vm snapshot request: %w", txErr)
+	}
+
+	action := "vm.snapshot_requested"
+	if eventType == domain.EventVMSnapshotRestoreRequested {
+		action = "vm.snapshot_restore_requested"
+	}
+	if uc.auditLogger != nil {
+		_ = uc.auditLogger.LogAction(ctx, action, "vm", vm.ID, actor, map[string]interface{}{
+			"snapshot":  snapshotName,
+			"event_id":  eventID,
+			"ticket_id": ticketID,
+			"force":     force,
+		})
+	}
+
+	status := "ACCEPTED"
+	if ticketID != "" {
+		status = "PENDING"
+	}
+	return &SnapshotOperationOutput{
+		EventID:      eventID,
+		TicketID:     ticketID,
+		SnapshotName: snapshotName,
+		Status:       status,
+	}, nil
+}
+
+// snapshotTarget returns the VM if it is in a state snapshots can act on.
+func (uc *SnapshotVMUseCase) snapshotTarget(ctx context.Context, vmID string) (*ent.VM, error) {
+	vm, err := uc.getVM(ctx, vmID)
+	if err != nil {
+		return nil, err
+	}
+	if vm.Status != entvm.StatusRUNNING && vm.Status != entvm.StatusSTOPPED {
+		return nil, apperrors.Conflict("INVALID_STATE_TRANSITION",
+			fmt.Sprintf("cannot snapshot or restore VM in %s state, must be RUNNING or STOPPED", vm.Status))
+	}
+	return vm, nil
+}
+
+func (uc *SnapshotVMUseCase) getVM(ctx context.Context, vmID string) (*ent.VM, error) {
+	vm, err := uc.entClient.VM.Get(ctx, vmID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.NotFound(apperrors.CodeVMNotFound, fmt.Sprintf("VM %s not found", vmID))
+		}
+		return nil, fmt.Errorf("get VM %s: %w", vmID, err)
+	}
+	return vm, nil
+}
+
+// vmSnapshot returns the named snapshot if it was taken of vm.
+func (uc *SnapshotVMUseCase) vmSnapshot(ctx context.Context, vm *ent.VM, name string) (*domain.Snapshot, error) {
+	name = strings.TrimSpace(name)
+	notFound := apperrors.NotFound(CodeSnapshotNotFound, fmt.Sprintf("snapshot %s not found for VM %s", name, vm.Name))
+	if name == "" {
+		return nil, notFound
+	}
+	snap, err := uc.vmService.GetSnapshot(ctx, vm.ClusterID, vm.Namespace, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, notFound
+		}
+		return nil, snapshotProviderError(err, "get snapshot "+name)
+	}
+	if snap.VMName != vm.Name {
+		return nil, notFound
+	}
+	return snap, nil
+}
+
+func snapshotProviderError(err error, op string) error {
+	if errors.Is(err, service.ErrSnapshotsUnsupported) {
+		return apperrors.New(CodeSnapshotsUnsupported, "the VM's cluster provider does not support snapshots", http.StatusNotImplemented)
+	}
+	return fmt.Errorf("%s: %w", op, err)
+}
@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"testing"
+
+	"kv-shepherd.io/shepherd/ent"
+	"kv-shepherd.io/shepherd/ent/approvalticket"
+	"kv-shepherd.io/shepherd/ent/auditlog"
+	"kv-shepherd.io/shepherd/ent/domainevent"
+	entvm "kv-shepherd.io/shepherd/ent/vm"
+	"kv-shepherd.io/shepherd/internal/domain"
+	"kv-shepherd.io/shepherd/internal/governance/audit"
+	apperrors "kv-shepherd.io/shepherd/internal/pkg/errors"
+	"kv-shepherd.io/shepherd/internal/provider"
+	"kv-shepherd.io/shepherd/internal/service"
+	"kv-shepherd.io/shepherd/internal/testutil"
+)
+
+// seedSnapshotFixture creates a VM in the DB and on the mock provider.
+func seedSnapshotFixture(t *testing.T, client *ent.Client, vmStatus entvm.Status) (*ent.VM, *provider.MockProvider) {
+	t.Helper()
+	ctx := t.Context()
+
+	sys := client.System.Create().SetID("sys-snap").SetName("shop").SetCreatedBy("owner-1").SaveX(ctx)
+	svc := client.Service.Create().SetID("svc-snap").SetName("redis").SetSystemID(sys.ID).SaveX(ctx)
+	vm := client.VM.Create().
+		SetID("vm-snap").
+		SetName("prod-shop-shop-redis-01").
+		SetInstance("01").
+		SetNamespace("prod-shop").
+		SetClusterID("cluster-a").
+		SetStatus(vmStatus).
+		SetCreatedBy("owner-1").
+		SetServiceID(svc.ID).
+		SaveX(ctx)
+
+	mock := provider.NewMockProvider()
+	mock.Seed([]*domain.VM{{Name: vm.Name, Namespace: vm.Namespace, Status: domain.VMStatusRunning}})
+	return vm, mock
+}
+
+func TestSnapshotVMUseCase_CreateWithAndWithoutApproval(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "snapshot_vm_usecase_create")
+	vm, mock := seedSnapshotFixture(t, client, entvm.StatusRUNNING)
+	vmSvc := service.NewVMService(mock)
+
+	direct := NewSnapshotVMUseCase(client, vmSvc, false).WithAuditLogger(audit.NewLogger(client))
+	out, err := direct.CreateSnapshot(t.Context(), CreateSnapshotInput{VMID: vm.ID, Name: "before-upgrade", RequestedBy: "owner-1"})
+	if err != nil {
+		t.Fatalf("CreateSnapshot() error = %v", err)
+	}
+	if out.TicketID != "" || out.Status != "ACCEPTED" || out.SnapshotName != "before-upgrade" {
+		t.Fatalf("direct output = %+v, want ACCEPTED without ticket", out)
+	}
+	if n := client.ApprovalTicket.Query().CountX(t.Context()); n != 0 {
+		t.Fatalf("tickets = %d, want 0 without approval", n)
+	}
+	entry := client.AuditLog.Query().Where(auditlog.ActionEQ("vm.snapshot_requested")).OnlyX(t.Context())
+	if entry.Actor != "owner-1" || entry.Details["snapshot"] != "before-upgrade" {
+		t.Fatalf("audit entry actor=%s details=%v", entry.Actor, entry.Details)
+	}
+
+	// The first event is still PENDING, so a second operation is refused.
+	_, err = direct.CreateSnapshot(t.Context(), CreateSnapshotInput{VMID: vm.ID, Name: "second", RequestedBy: "owner-1"})
+	if appErr, ok := apperrors.IsAppError(err); !ok || appErr.Code != CodeSnapshotOperationPending {
+		t.Fatalf("second CreateSnapshot() error = %v, want %s", err, CodeSnapshotOperationPending)
+	}
+	client.DomainEvent.UpdateOneID(out.EventID).SetStatus(domainevent.StatusCOMPLETED).ExecX(t.Context())
+
+	gated := NewSnapshotVMUseCase(client, vmSvc, true)
+	out, err = gated.CreateSnapshot(t.Context(), CreateSnapshotInput{VMID: vm.ID, RequestedBy: "owner-1"})
+	if err != nil {
+		t.Fatalf("gated CreateSnapshot() error = %v", err)
+	}
+	if out.TicketID == "" || out.Status != "PENDING" || out.SnapshotName == "" {
+		t.Fatalf("gated output = %+v, want PENDING ticket with generated name", out)
+	}
+	ticket := client.ApprovalTicket.GetX(t.Context(), out.TicketID)
+	if ticket.OperationType != approvalticket.OperationTypeSNAPSHOT || ticket.EventID != out.EventID {
+		t.Fatalf("ticket = %s for event %s, want SNAPSHOT for %s", ticket.OperationType, ticket.EventID, out.EventID)
+	}
+}
+
+func TestSnapshotVMUseCase_RestoreAndDelete(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "snapshot_vm_usecase_restore")
+	vm, mock := seedSnapshotFixture(t, client, entvm.StatusRUNNING)
+	if _, err := mock.CreateSnapshot(t.Context(), vm.ClusterID, vm.Namespace, vm.Name, "nightly"); err != nil {
+		t.Fatalf("seed snapshot: %v", err)
+	}
+	uc := NewSnapshotVMUseCase(client, service.NewVMService(mock), false).WithAuditLogger(audit.NewLogger(client))
+
+	_, err := uc.RestoreSnapshot(t.Context(), RestoreSnapshotInput{VMID: vm.ID, SnapshotName: "nightly", RequestedBy: "owner-1"})
+	if appErr, ok := apperrors.IsAppError(err); !ok || appErr.Code != CodeRestoreRequiresForce {
+		t.Fatalf("unforced restore error = %v, want %s", err, CodeRestoreRequiresForce)
+	}
+	_, err = uc.RestoreSnapshot(t.Context(), RestoreSnapshotInput{VMID: vm.ID, SnapshotName: "missing", Force: true, RequestedBy: "owner-1"})
+	if appErr, ok := apperrors.IsAppError(err); !ok || appErr.Code != CodeSnapshotNotFound {
+		t.Fatalf("missing snapshot restore error = %v, want %s", err, CodeSnapshotNotFound)
+	}
+
+	out, err := uc.RestoreSnapshot(t.Context(), RestoreSnapshotInput{VMID: vm.ID, SnapshotName: "nightly", Force: true, RequestedBy: "owner-1"})
+	if err != nil {
+		t.Fatalf("forced RestoreSnapshot() error = %v", err)
+	}
+	event := client.DomainEvent.GetX(t.Context(), out.EventID)
+	if event.EventType != string(domain.EventVMSnapshotRestoreRequested) || out.TicketID != "" {
+		t.Fatalf("restore event type = %s ticket = %q", event.EventType, out.TicketID)
+	}
+
+	if snap, err := uc.GetSnapshot(t.Context(), vm.ID, "nightly"); err != nil || !snap.Ready || snap.VMName != vm.Name {
+		t.Fatalf("GetSnapshot() = %+v, err %v; want the ready nightly snapshot", snap, err)
+	}
+	_, err = uc.GetSnapshot(t.Context(), vm.ID, "missing")
+	if appErr, ok := apperrors.IsAppError(err); !ok || appErr.Code != CodeSnapshotNotFound {
+		t.Fatalf("missing GetSnapshot() error = %v, want %s", err, CodeSnapshotNotFound)
+	}
+
+	if err := uc.DeleteSnapshot(t.Context(), vm.ID, "nightly", "owner-1"); err != nil {
+		t.Fatalf("DeleteSnapshot() error = %v", err)
+	}
+	if items, err := uc.ListSnapshots(t.Context(), vm.ID); err != nil || len(items) != 0 {
+		t.Fatalf("ListSnapshots() = %d items, err %v; want none", len(items), err)
+	}
+	entry := client.AuditLog.Query().Where(auditlog.ActionEQ("vm.snapshot_deleted")).OnlyX(t.Context())
+	if entry.Actor != "owner-1" || entry.Details["snapshot"] != "nightly" {
+		t.Fatalf("delete audit actor=%s details=%v", entry.Actor, entry.Details)
+	}
+}
+
+func TestSnapshotVMUseCase_Rejections(t *testing.T) {
+	t.Parallel()
+
+	client := testutil.OpenEntPostgres(t, "snapshot_vm_usecase_reject")
+	vm, mock := seedSnapshotFixture(t, client, entvm.StatusMIGRATING)
+	uc := NewSnapshotVMUseCase(client, service.NewVMService(mock), false)
+
+	_, err := uc.CreateSnapshot(t.Context(), CreateSnapshotInput{VMID: vm.ID, RequestedBy: "owner-1"})
+	if appErr, ok := apperrors.IsAppError(err); !ok || appErr.Code != "INVALID_STATE_TRANSITION" {
+		t.Fatalf("migrating vm error = %v, want INVALID_STATE_TRANSITION", err)
+	}
+
+	client.VM.UpdateOneID(vm.ID).SetStatus(entvm.StatusSTOPPED).ExecX(t.Context())
+	_, err = uc.CreateSnapshot(t.Context(), CreateSnapshotInput{VMID: vm.ID, Name: "Not_A_Label", RequestedBy: "owner-1"})
+	if appErr, ok := apperrors.IsAppError(err); !ok || appErr.Code != apperrors.CodeNameInvalid {
+		t.Fatalf("invalid name error = %v, want %s", err, apperrors.CodeNameInvalid)
+	}
+
+	_, err = uc.ListSnapshots(t.Context(), "vm-missing")
+	if appErr, ok := apperrors.IsAppError(err); !ok || appErr.Code != apperrors.CodeVMNotFound {
+		t.Fatalf("missing vm error = %v, want %s", err, apperrors.CodeVMNotFound)
+	}
+}
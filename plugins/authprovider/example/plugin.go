@@ -45,6 +45,11 @@ func (a *Adapter) Describe() authproviderplugin.AdminTypeDescriptor {
 	}
 }
 
+// SecretFields reports client_secret so the API never returns it.
+func (a *Adapter) SecretFields() []string {
+	return []string{"client_secret"}
+}
+
 func (a *Adapter) ValidateConfig(config map[string]interface{}) error {
 	issuer := strings.TrimSpace(toString(config["issuer"]))
 	if issuer == "" {
@@ -57,3 +57,8 @@ func (a *Adapter) TestConnection(_ context.Context, config map[string]interface{
 func (a *Adapter) SampleFields(_ context.Context, _ map[string]interface{}) ([]authproviderplugin.AdminSampleField, error) {
 	return nil, nil
 }
+
+// SecretFields lists the config keys holding secrets; the API redacts them.
+func (a *Adapter) SecretFields() []string {
+	return nil
+}
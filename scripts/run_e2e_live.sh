@@ -84,7 +84,7 @@ export SECURITY_ENCRYPTION_KEY="${SECURITY_ENCRYPTION_KEY:-0123456789abcdef01234
 # test harness to prevent CORS false negatives unrelated to product behavior.
 export SERVER_UNSAFE_ALLOW_ALL_ORIGINS="${SERVER_UNSAFE_ALLOW_ALL_ORIGINS:-true}"
 export E2E_USERNAME="${E2E_USERNAME:-${E2E_ADMIN_USERNAME:-e2e-admin}}"
-export E2E_PASSWORD="${E2E_PASSWORD:-${E2E_ADMIN_PASSWORD:-e2e-admin-123}}"
+export E2E_PASSWORD="${E2E_PASSWORD:-${E2E_ADMIN_PASSWORD:-Shepherd-E2E-Pass-2026}}"
 
 SERVER_PID=""
 cleanup() {
@@ -3,7 +3,7 @@
 /**
  * Audit Log Viewer — admin page.
  *
- * OpenAPI: GET /audit-logs (listAuditLogs)
+ * OpenAPI: GET /admin/audit-logs (listAdminAuditLogs)
  * ADR-0019: Audit log with data masking (redaction compliance)
  * ADR-0021: Uses typed api client — token injection handled by middleware.
  */
@@ -90,7 +90,7 @@ export default function AuditLogPage() {
     const { data, isLoading, refetch } = useApiGet<AuditLogList>(
         ['audit-logs', page, pageSize, filters],
         () =>
-            api.GET('/audit-logs', {
+            api.GET('/admin/audit-logs', {
                 params: {
                     query: {
                         page,
@@ -34,6 +34,7 @@ export const VM_STATUS_MAP: Record<
     FAILED: { color: 'red', badge: 'error' },
     PENDING: { color: 'gold', badge: 'warning' },
     MIGRATING: { color: 'blue', badge: 'processing' },
+    RESIZING: { color: 'geekblue', badge: 'processing' },
     PAUSED: { color: 'purple', badge: 'warning' },
     UNKNOWN: { color: 'default', badge: 'default' },
 };
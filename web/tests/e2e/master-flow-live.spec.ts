@@ -1,7 +1,7 @@
 import { expect, test, type Page } from '@playwright/test';
 
 const e2eUsername = process.env.E2E_USERNAME ?? 'e2e-admin';
-const e2ePassword = process.env.E2E_PASSWORD ?? 'e2e-admin-123';
+const e2ePassword = process.env.E2E_PASSWORD ?? 'Shepherd-E2E-Pass-2026';
 const e2eSystemName = process.env.E2E_SYSTEM ?? 'e2e-system';
 const e2eServiceName = process.env.E2E_SERVICE ?? 'e2e-service';
 const runningVMID = process.env.E2E_VM_RUNNING_ID ?? 'vm-e2e-running';